@@ -0,0 +1,61 @@
+CREATE TABLE IF NOT EXISTS architecturemodeling.vendor_contracts (
+    id VARCHAR(255) NOT NULL,
+    tenant_id VARCHAR(50) NOT NULL,
+    vendor_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    notice_period_days INT NOT NULL,
+    notice_deadline DATE NOT NULL,
+    value_amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+    value_currency VARCHAR(3) NOT NULL DEFAULT '',
+    owner VARCHAR(100) NOT NULL,
+    notes VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    is_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+    deleted_at TIMESTAMP,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_vendor_contracts_vendor
+    ON architecturemodeling.vendor_contracts(tenant_id, vendor_id) WHERE is_deleted = FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_vendor_contracts_notice_deadline
+    ON architecturemodeling.vendor_contracts(tenant_id, notice_deadline) WHERE is_deleted = FALSE;
+
+ALTER TABLE architecturemodeling.vendor_contracts ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturemodeling.vendor_contracts;
+CREATE POLICY tenant_isolation_policy ON architecturemodeling.vendor_contracts
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+CREATE TABLE IF NOT EXISTS architecturemodeling.vendor_contract_components (
+    tenant_id VARCHAR(50) NOT NULL,
+    contract_id VARCHAR(255) NOT NULL,
+    component_id VARCHAR(255) NOT NULL,
+    PRIMARY KEY (tenant_id, contract_id, component_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_vendor_contract_components_component
+    ON architecturemodeling.vendor_contract_components(tenant_id, component_id);
+
+ALTER TABLE architecturemodeling.vendor_contract_components ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturemodeling.vendor_contract_components;
+CREATE POLICY tenant_isolation_policy ON architecturemodeling.vendor_contract_components
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturemodeling.vendor_contracts TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturemodeling.vendor_contract_components TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturemodeling.vendor_contracts TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturemodeling.vendor_contract_components TO easi_admin';
+    END IF;
+END $$;
@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS metamodel.custom_relation_types (
+    id VARCHAR(255) NOT NULL,
+    tenant_id VARCHAR(50) NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    line_style VARCHAR(20) NOT NULL DEFAULT 'solid',
+    color VARCHAR(7) NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    modified_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_custom_relation_types_active
+    ON metamodel.custom_relation_types(tenant_id) WHERE active = TRUE;
+
+ALTER TABLE metamodel.custom_relation_types ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON metamodel.custom_relation_types;
+CREATE POLICY tenant_isolation_policy ON metamodel.custom_relation_types
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+CREATE TABLE IF NOT EXISTS architecturemodeling.custom_relation_type_cache (
+    id VARCHAR(255) NOT NULL,
+    tenant_id VARCHAR(50) NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    line_style VARCHAR(20) NOT NULL DEFAULT 'solid',
+    color VARCHAR(7) NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    PRIMARY KEY (tenant_id, id)
+);
+
+ALTER TABLE architecturemodeling.custom_relation_type_cache ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturemodeling.custom_relation_type_cache;
+CREATE POLICY tenant_isolation_policy ON architecturemodeling.custom_relation_type_cache
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON metamodel.custom_relation_types TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturemodeling.custom_relation_type_cache TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON metamodel.custom_relation_types TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturemodeling.custom_relation_type_cache TO easi_admin';
+    END IF;
+END $$;
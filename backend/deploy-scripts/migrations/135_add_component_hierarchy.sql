@@ -0,0 +1,9 @@
+ALTER TABLE architecturemodeling.application_components ADD COLUMN IF NOT EXISTS parent_id VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_application_components_parent
+    ON architecturemodeling.application_components(tenant_id, parent_id) WHERE parent_id IS NOT NULL;
+
+ALTER TABLE capabilitymapping.capability_component_cache ADD COLUMN IF NOT EXISTS parent_id VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_capability_component_cache_parent
+    ON capabilitymapping.capability_component_cache(tenant_id, parent_id) WHERE parent_id IS NOT NULL;
@@ -0,0 +1,95 @@
+CREATE TABLE IF NOT EXISTS metamodel.classification_dimensions (
+    id VARCHAR(255) NOT NULL,
+    tenant_id VARCHAR(50) NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    allowed_values TEXT[] NOT NULL DEFAULT '{}',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    modified_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, id)
+);
+
+ALTER TABLE metamodel.classification_dimensions ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON metamodel.classification_dimensions;
+CREATE POLICY tenant_isolation_policy ON metamodel.classification_dimensions
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+CREATE TABLE IF NOT EXISTS architecturemodeling.classification_dimension_cache (
+    id VARCHAR(255) NOT NULL,
+    tenant_id VARCHAR(50) NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    allowed_values TEXT[] NOT NULL DEFAULT '{}',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    PRIMARY KEY (tenant_id, id)
+);
+
+ALTER TABLE architecturemodeling.classification_dimension_cache ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturemodeling.classification_dimension_cache;
+CREATE POLICY tenant_isolation_policy ON architecturemodeling.classification_dimension_cache
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+CREATE TABLE IF NOT EXISTS architecturemodeling.application_component_tags (
+    tenant_id VARCHAR(50) NOT NULL,
+    component_id VARCHAR(255) NOT NULL,
+    tag VARCHAR(50) NOT NULL,
+    added_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, component_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_component_tags_tag
+    ON architecturemodeling.application_component_tags(tenant_id, tag);
+
+ALTER TABLE architecturemodeling.application_component_tags ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturemodeling.application_component_tags;
+CREATE POLICY tenant_isolation_policy ON architecturemodeling.application_component_tags
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+CREATE TABLE IF NOT EXISTS architecturemodeling.application_component_classifications (
+    tenant_id VARCHAR(50) NOT NULL,
+    component_id VARCHAR(255) NOT NULL,
+    dimension_id VARCHAR(255) NOT NULL,
+    value VARCHAR(50) NOT NULL,
+    classified_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, component_id, dimension_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_component_classifications_value
+    ON architecturemodeling.application_component_classifications(tenant_id, dimension_id, value);
+
+ALTER TABLE architecturemodeling.application_component_classifications ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturemodeling.application_component_classifications;
+CREATE POLICY tenant_isolation_policy ON architecturemodeling.application_component_classifications
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+-- Full-text search over components. The expressions must match the ones used by
+-- ApplicationComponentReadModel.Search for the planner to pick these indexes.
+CREATE INDEX IF NOT EXISTS idx_application_components_fulltext
+    ON architecturemodeling.application_components
+    USING GIN (to_tsvector('simple', name || ' ' || COALESCE(description, '')));
+
+CREATE INDEX IF NOT EXISTS idx_application_component_experts_fulltext
+    ON architecturemodeling.application_component_experts
+    USING GIN (to_tsvector('simple', expert_name));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON metamodel.classification_dimensions TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturemodeling.classification_dimension_cache TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturemodeling.application_component_tags TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturemodeling.application_component_classifications TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON metamodel.classification_dimensions TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturemodeling.classification_dimension_cache TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturemodeling.application_component_tags TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturemodeling.application_component_classifications TO easi_admin';
+    END IF;
+END $$;
@@ -0,0 +1,43 @@
+-- Capability depth is configured per tenant in the meta-model (one to six levels),
+-- so the fixed L1-L4 constraint from 008 moves into the domain.
+ALTER TABLE capabilitymapping.capabilities DROP CONSTRAINT IF EXISTS capabilities_level_check;
+ALTER TABLE capabilitymapping.capabilities ADD CONSTRAINT capabilities_level_check
+    CHECK (level IN ('L1', 'L2', 'L3', 'L4', 'L5', 'L6'));
+
+CREATE TABLE IF NOT EXISTS metamodel.capability_hierarchies (
+    tenant_id VARCHAR(50) NOT NULL PRIMARY KEY,
+    level_labels TEXT[] NOT NULL,
+    modified_at TIMESTAMP NOT NULL,
+    modified_by VARCHAR(255) NOT NULL
+);
+
+ALTER TABLE metamodel.capability_hierarchies ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON metamodel.capability_hierarchies;
+CREATE POLICY tenant_isolation_policy ON metamodel.capability_hierarchies
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+CREATE TABLE IF NOT EXISTS capabilitymapping.cm_capability_hierarchy_cache (
+    tenant_id VARCHAR(50) NOT NULL PRIMARY KEY,
+    level_labels TEXT[] NOT NULL
+);
+
+ALTER TABLE capabilitymapping.cm_capability_hierarchy_cache ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON capabilitymapping.cm_capability_hierarchy_cache;
+CREATE POLICY tenant_isolation_policy ON capabilitymapping.cm_capability_hierarchy_cache
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON metamodel.capability_hierarchies TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON capabilitymapping.cm_capability_hierarchy_cache TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON metamodel.capability_hierarchies TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON capabilitymapping.cm_capability_hierarchy_cache TO easi_admin';
+    END IF;
+END $$;
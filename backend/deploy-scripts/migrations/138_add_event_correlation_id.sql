@@ -0,0 +1,9 @@
+-- Migration: Add correlation id to events
+-- Description: Groups events produced by one logical change (e.g. a bulk edit) so the
+-- audit log can present them as a single correlated change. Existing events stay uncorrelated.
+
+ALTER TABLE infrastructure.events ADD COLUMN IF NOT EXISTS correlation_id VARCHAR(36);
+
+CREATE INDEX IF NOT EXISTS idx_events_tenant_correlation
+    ON infrastructure.events(tenant_id, correlation_id)
+    WHERE correlation_id IS NOT NULL;
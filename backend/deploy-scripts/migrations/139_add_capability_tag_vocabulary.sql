@@ -0,0 +1,41 @@
+-- Tenant-level capability tag vocabulary. When restricted, capabilities can only
+-- be tagged with vocabulary terms; capabilitymapping keeps a cache of the terms.
+CREATE TABLE IF NOT EXISTS metamodel.capability_tag_vocabularies (
+    tenant_id VARCHAR(50) NOT NULL PRIMARY KEY,
+    terms JSONB NOT NULL DEFAULT '[]',
+    restricted BOOLEAN NOT NULL DEFAULT FALSE,
+    modified_at TIMESTAMP NOT NULL,
+    modified_by VARCHAR(255) NOT NULL
+);
+
+ALTER TABLE metamodel.capability_tag_vocabularies ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON metamodel.capability_tag_vocabularies;
+CREATE POLICY tenant_isolation_policy ON metamodel.capability_tag_vocabularies
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+CREATE TABLE IF NOT EXISTS capabilitymapping.cm_capability_tag_vocabulary_cache (
+    tenant_id VARCHAR(50) NOT NULL PRIMARY KEY,
+    terms JSONB NOT NULL DEFAULT '[]',
+    restricted BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+ALTER TABLE capabilitymapping.cm_capability_tag_vocabulary_cache ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON capabilitymapping.cm_capability_tag_vocabulary_cache;
+CREATE POLICY tenant_isolation_policy ON capabilitymapping.cm_capability_tag_vocabulary_cache
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON metamodel.capability_tag_vocabularies TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON capabilitymapping.cm_capability_tag_vocabulary_cache TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON metamodel.capability_tag_vocabularies TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON capabilitymapping.cm_capability_tag_vocabulary_cache TO easi_admin';
+    END IF;
+END $$;
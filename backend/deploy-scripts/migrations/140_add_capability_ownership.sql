@@ -0,0 +1,63 @@
+-- RACI ownership of capabilities by users (auth) and internal teams
+-- (architecturemodeling). Parties are cached locally so assignments can be
+-- validated and listed with current names without crossing contexts.
+CREATE TABLE IF NOT EXISTS capabilitymapping.cm_ownership_party_cache (
+    tenant_id VARCHAR(50) NOT NULL,
+    party_type VARCHAR(10) NOT NULL CHECK (party_type IN ('user', 'team')),
+    party_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    PRIMARY KEY (tenant_id, party_type, party_id)
+);
+
+ALTER TABLE capabilitymapping.cm_ownership_party_cache ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON capabilitymapping.cm_ownership_party_cache;
+CREATE POLICY tenant_isolation_policy ON capabilitymapping.cm_ownership_party_cache
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+CREATE TABLE IF NOT EXISTS capabilitymapping.capability_owners (
+    tenant_id VARCHAR(50) NOT NULL,
+    capability_id VARCHAR(255) NOT NULL,
+    party_type VARCHAR(10) NOT NULL CHECK (party_type IN ('user', 'team')),
+    party_id VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('Responsible', 'Accountable', 'Consulted', 'Informed')),
+    assigned_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, capability_id, party_type, party_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_capability_owners_party
+    ON capabilitymapping.capability_owners(tenant_id, party_type, party_id);
+
+ALTER TABLE capabilitymapping.capability_owners ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON capabilitymapping.capability_owners;
+CREATE POLICY tenant_isolation_policy ON capabilitymapping.capability_owners
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+INSERT INTO capabilitymapping.cm_ownership_party_cache (tenant_id, party_type, party_id, name, email, active)
+SELECT tenant_id, 'user', id::text, COALESCE(name, ''), email, status = 'active'
+FROM auth.users
+ON CONFLICT (tenant_id, party_type, party_id) DO UPDATE
+SET name = EXCLUDED.name, email = EXCLUDED.email, active = EXCLUDED.active;
+
+INSERT INTO capabilitymapping.cm_ownership_party_cache (tenant_id, party_type, party_id, name, email, active)
+SELECT tenant_id, 'team', id, name, '', NOT COALESCE(is_deleted, false)
+FROM architecturemodeling.internal_teams
+ON CONFLICT (tenant_id, party_type, party_id) DO UPDATE
+SET name = EXCLUDED.name, active = EXCLUDED.active;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON capabilitymapping.cm_ownership_party_cache TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON capabilitymapping.capability_owners TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON capabilitymapping.cm_ownership_party_cache TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON capabilitymapping.capability_owners TO easi_admin';
+    END IF;
+END $$;
@@ -0,0 +1,15 @@
+-- Business domains can be nested into divisions/segments and carry a set of
+-- domain architects. domain_architect_id is kept as the lead architect for
+-- existing consumers.
+ALTER TABLE capabilitymapping.business_domains
+    ADD COLUMN IF NOT EXISTS parent_id VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS domain_architect_ids TEXT[] NOT NULL DEFAULT '{}';
+
+UPDATE capabilitymapping.business_domains
+SET domain_architect_ids = ARRAY[domain_architect_id]
+WHERE domain_architect_id IS NOT NULL
+  AND domain_architect_id <> ''
+  AND cardinality(domain_architect_ids) = 0;
+
+CREATE INDEX IF NOT EXISTS idx_business_domains_parent
+    ON capabilitymapping.business_domains (tenant_id, parent_id);
@@ -0,0 +1,77 @@
+-- Industry reference capability models (BIAN, APQC PCF, TOGAF...) loaded as
+-- read-only catalogues, and the mappings of our capabilities onto their entries.
+CREATE TABLE IF NOT EXISTS capabilitymapping.reference_models (
+    tenant_id VARCHAR(50) NOT NULL,
+    id VARCHAR(255) NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    framework VARCHAR(20) NOT NULL CHECK (framework IN ('BIAN', 'APQC', 'TOGAF', 'Custom')),
+    version VARCHAR(100) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    entry_count INTEGER NOT NULL DEFAULT 0,
+    imported_by VARCHAR(255) NOT NULL DEFAULT '',
+    imported_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS capabilitymapping.reference_model_entries (
+    tenant_id VARCHAR(50) NOT NULL,
+    model_id VARCHAR(255) NOT NULL,
+    code VARCHAR(100) NOT NULL,
+    name VARCHAR(500) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    parent_code VARCHAR(100),
+    level INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (tenant_id, model_id, code)
+);
+
+CREATE TABLE IF NOT EXISTS capabilitymapping.capability_reference_mappings (
+    tenant_id VARCHAR(50) NOT NULL,
+    id VARCHAR(255) NOT NULL,
+    capability_id VARCHAR(255) NOT NULL,
+    model_id VARCHAR(255) NOT NULL,
+    entry_code VARCHAR(100) NOT NULL,
+    mapped_by VARCHAR(255) NOT NULL DEFAULT '',
+    mapped_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_capability_reference_mappings_unique
+    ON capabilitymapping.capability_reference_mappings (tenant_id, capability_id, model_id, entry_code);
+CREATE INDEX IF NOT EXISTS idx_capability_reference_mappings_model
+    ON capabilitymapping.capability_reference_mappings (tenant_id, model_id, entry_code);
+
+ALTER TABLE capabilitymapping.reference_models ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON capabilitymapping.reference_models;
+CREATE POLICY tenant_isolation_policy ON capabilitymapping.reference_models
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+ALTER TABLE capabilitymapping.reference_model_entries ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON capabilitymapping.reference_model_entries;
+CREATE POLICY tenant_isolation_policy ON capabilitymapping.reference_model_entries
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+ALTER TABLE capabilitymapping.capability_reference_mappings ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON capabilitymapping.capability_reference_mappings;
+CREATE POLICY tenant_isolation_policy ON capabilitymapping.capability_reference_mappings
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON capabilitymapping.reference_models TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON capabilitymapping.reference_model_entries TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON capabilitymapping.capability_reference_mappings TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON capabilitymapping.reference_models TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON capabilitymapping.reference_model_entries TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON capabilitymapping.capability_reference_mappings TO easi_admin';
+    END IF;
+END $$;
@@ -0,0 +1,72 @@
+ALTER TABLE architecturedirection.capability_journeys
+    ADD COLUMN IF NOT EXISTS started_with_unmet_predecessor_ids TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE TABLE IF NOT EXISTS architecturedirection.capability_journey_dependencies (
+    tenant_id VARCHAR(50) NOT NULL,
+    journey_id VARCHAR(255) NOT NULL,
+    predecessor_id VARCHAR(255) NOT NULL,
+    dependency_type VARCHAR(20) NOT NULL,
+    added_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, journey_id, predecessor_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_capability_journey_dependencies_predecessor
+    ON architecturedirection.capability_journey_dependencies(tenant_id, predecessor_id);
+
+ALTER TABLE architecturedirection.capability_journey_dependencies ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturedirection.capability_journey_dependencies;
+CREATE POLICY tenant_isolation_policy ON architecturedirection.capability_journey_dependencies
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+CREATE TABLE IF NOT EXISTS architecturedirection.journey_programmes (
+    tenant_id VARCHAR(50) NOT NULL,
+    id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    PRIMARY KEY (tenant_id, id)
+);
+
+ALTER TABLE architecturedirection.journey_programmes ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturedirection.journey_programmes;
+CREATE POLICY tenant_isolation_policy ON architecturedirection.journey_programmes
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+-- A journey belongs to at most one programme, hence the key on journey_id alone.
+CREATE TABLE IF NOT EXISTS architecturedirection.journey_programme_members (
+    tenant_id VARCHAR(50) NOT NULL,
+    journey_id VARCHAR(255) NOT NULL,
+    programme_id VARCHAR(255) NOT NULL,
+    added_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, journey_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_journey_programme_members_programme
+    ON architecturedirection.journey_programme_members(tenant_id, programme_id);
+
+ALTER TABLE architecturedirection.journey_programme_members ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturedirection.journey_programme_members;
+CREATE POLICY tenant_isolation_policy ON architecturedirection.journey_programme_members
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturedirection.capability_journey_dependencies TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturedirection.journey_programmes TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturedirection.journey_programme_members TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturedirection.capability_journey_dependencies TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturedirection.journey_programmes TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturedirection.journey_programme_members TO easi_admin';
+    END IF;
+END $$;
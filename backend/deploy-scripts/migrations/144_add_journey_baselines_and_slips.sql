@@ -0,0 +1,123 @@
+ALTER TABLE architecturedirection.capability_journeys
+    ADD COLUMN IF NOT EXISTS baseline_year INT,
+    ADD COLUMN IF NOT EXISTS baseline_quarter SMALLINT,
+    ADD COLUMN IF NOT EXISTS baselined_at TIMESTAMP;
+
+ALTER TABLE architecturedirection.capability_journey_milestones
+    ADD COLUMN IF NOT EXISTS baseline_year INT,
+    ADD COLUMN IF NOT EXISTS baseline_quarter SMALLINT;
+
+-- One row per target change made after the journey was baselined. milestone_id
+-- is empty for changes to the journey's own target period.
+CREATE TABLE IF NOT EXISTS architecturedirection.capability_journey_slips (
+    tenant_id VARCHAR(50) NOT NULL,
+    journey_id VARCHAR(255) NOT NULL,
+    milestone_id VARCHAR(255) NOT NULL DEFAULT '',
+    from_year INT,
+    from_quarter SMALLINT,
+    to_year INT,
+    to_quarter SMALLINT,
+    moved_by VARCHAR(255) NOT NULL,
+    moved_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, journey_id, milestone_id, moved_at)
+);
+
+ALTER TABLE architecturedirection.capability_journey_slips ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturedirection.capability_journey_slips;
+CREATE POLICY tenant_isolation_policy ON architecturedirection.capability_journey_slips
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+-- Backfill from the event store so journeys started before this migration get
+-- the baseline and slip history the projector would have recorded. The
+-- baseline is the target in effect when the journey was started; a milestone
+-- added later is baselined at the target it was added with.
+WITH started AS (
+    SELECT DISTINCT ON (tenant_id, aggregate_id) tenant_id, aggregate_id AS journey_id, version, occurred_at
+    FROM infrastructure.events
+    WHERE event_type = 'JourneyStarted'
+    ORDER BY tenant_id, aggregate_id, version
+),
+baseline AS (
+    SELECT DISTINCT ON (s.tenant_id, s.journey_id) s.tenant_id, s.journey_id, s.occurred_at,
+           (e.event_data->'targetPeriod'->>'year')::INT AS target_year,
+           (e.event_data->'targetPeriod'->>'quarter')::INT AS target_quarter
+    FROM started s
+    JOIN infrastructure.events e ON e.tenant_id = s.tenant_id AND e.aggregate_id = s.journey_id
+    WHERE e.event_type IN ('JourneyPlanned', 'JourneyDetailsUpdated') AND e.version < s.version
+    ORDER BY s.tenant_id, s.journey_id, e.version DESC
+)
+UPDATE architecturedirection.capability_journeys cj
+SET baseline_year = b.target_year, baseline_quarter = b.target_quarter, baselined_at = b.occurred_at
+FROM baseline b
+WHERE cj.tenant_id = b.tenant_id AND cj.id = b.journey_id AND cj.baselined_at IS NULL;
+
+WITH started AS (
+    SELECT DISTINCT ON (tenant_id, aggregate_id) tenant_id, aggregate_id AS journey_id, version
+    FROM infrastructure.events
+    WHERE event_type = 'JourneyStarted'
+    ORDER BY tenant_id, aggregate_id, version
+),
+baseline AS (
+    SELECT DISTINCT ON (e.tenant_id, e.aggregate_id, e.event_data->>'milestoneId')
+           e.tenant_id, e.aggregate_id AS journey_id, e.event_data->>'milestoneId' AS milestone_id,
+           (e.event_data->'targetPeriod'->>'year')::INT AS target_year,
+           (e.event_data->'targetPeriod'->>'quarter')::INT AS target_quarter
+    FROM started s
+    JOIN infrastructure.events e ON e.tenant_id = s.tenant_id AND e.aggregate_id = s.journey_id
+    WHERE e.event_type = 'JourneyMilestoneAdded'
+       OR (e.event_type = 'JourneyMilestoneUpdated' AND e.version < s.version)
+    ORDER BY e.tenant_id, e.aggregate_id, e.event_data->>'milestoneId', e.version DESC
+)
+UPDATE architecturedirection.capability_journey_milestones m
+SET baseline_year = b.target_year, baseline_quarter = b.target_quarter
+FROM baseline b
+WHERE m.tenant_id = b.tenant_id AND m.journey_id = b.journey_id AND m.milestone_id = b.milestone_id
+  AND m.baseline_year IS NULL AND m.baseline_quarter IS NULL;
+
+-- Every target change after the start, for the journey and for each
+-- milestone, compared with the target set by the event before it.
+WITH started AS (
+    SELECT DISTINCT ON (tenant_id, aggregate_id) tenant_id, aggregate_id AS journey_id, version
+    FROM infrastructure.events
+    WHERE event_type = 'JourneyStarted'
+    ORDER BY tenant_id, aggregate_id, version
+),
+targets AS (
+    SELECT e.tenant_id, e.aggregate_id AS journey_id, e.event_type, e.version, e.occurred_at,
+           CASE WHEN e.event_type LIKE 'JourneyMilestone%' THEN e.event_data->>'milestoneId' ELSE '' END AS milestone_id,
+           (e.event_data->'targetPeriod'->>'year')::INT AS to_year,
+           (e.event_data->'targetPeriod'->>'quarter')::INT AS to_quarter,
+           COALESCE(e.event_data->>'updatedBy', '') AS moved_by
+    FROM infrastructure.events e
+    WHERE e.event_type IN ('JourneyPlanned', 'JourneyDetailsUpdated', 'JourneyMilestoneAdded', 'JourneyMilestoneUpdated')
+),
+moves AS (
+    SELECT t.*,
+           LAG(t.to_year) OVER w AS from_year,
+           LAG(t.to_quarter) OVER w AS from_quarter
+    FROM targets t
+    WINDOW w AS (PARTITION BY t.tenant_id, t.journey_id, t.milestone_id ORDER BY t.version)
+)
+INSERT INTO architecturedirection.capability_journey_slips
+    (tenant_id, journey_id, milestone_id, from_year, from_quarter, to_year, to_quarter, moved_by, moved_at)
+SELECT mv.tenant_id, mv.journey_id, mv.milestone_id, mv.from_year, mv.from_quarter, mv.to_year, mv.to_quarter,
+       mv.moved_by, mv.occurred_at
+FROM moves mv
+JOIN started s ON s.tenant_id = mv.tenant_id AND s.journey_id = mv.journey_id AND mv.version > s.version
+WHERE mv.event_type IN ('JourneyDetailsUpdated', 'JourneyMilestoneUpdated')
+  AND (mv.from_year, mv.from_quarter) IS DISTINCT FROM (mv.to_year, mv.to_quarter)
+  AND EXISTS (SELECT 1 FROM architecturedirection.capability_journeys cj
+              WHERE cj.tenant_id = mv.tenant_id AND cj.id = mv.journey_id)
+ON CONFLICT DO NOTHING;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturedirection.capability_journey_slips TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturedirection.capability_journey_slips TO easi_admin';
+    END IF;
+END $$;
@@ -0,0 +1,51 @@
+ALTER TABLE architecturedirection.directions
+    ADD COLUMN IF NOT EXISTS review_quorum INT;
+
+-- One row per required reviewer of the current proposal. verdict stays NULL
+-- until the reviewer records approve or object.
+CREATE TABLE IF NOT EXISTS architecturedirection.direction_reviews (
+    tenant_id VARCHAR(50) NOT NULL,
+    direction_id VARCHAR(255) NOT NULL,
+    reviewer_id VARCHAR(255) NOT NULL,
+    verdict VARCHAR(20),
+    comment TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMP,
+    PRIMARY KEY (tenant_id, direction_id, reviewer_id)
+);
+
+-- The architecture decision record captured when a direction is agreed.
+CREATE TABLE IF NOT EXISTS architecturedirection.direction_decision_records (
+    tenant_id VARCHAR(50) NOT NULL,
+    direction_id VARCHAR(255) NOT NULL,
+    context TEXT NOT NULL DEFAULT '',
+    decision TEXT NOT NULL DEFAULT '',
+    consequences TEXT NOT NULL DEFAULT '',
+    decided_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, direction_id)
+);
+
+ALTER TABLE architecturedirection.direction_reviews ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturedirection.direction_reviews;
+CREATE POLICY tenant_isolation_policy ON architecturedirection.direction_reviews
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+ALTER TABLE architecturedirection.direction_decision_records ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturedirection.direction_decision_records;
+CREATE POLICY tenant_isolation_policy ON architecturedirection.direction_decision_records
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturedirection.direction_reviews TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturedirection.direction_decision_records TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturedirection.direction_reviews TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturedirection.direction_decision_records TO easi_admin';
+    END IF;
+END $$;
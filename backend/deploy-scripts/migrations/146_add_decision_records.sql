@@ -0,0 +1,82 @@
+-- Migration: Add architecture decision records
+-- Description: decisionrecords schema with the ADR log and the architecture
+-- elements (capabilities, enterprise capabilities, components, directions and
+-- journeys) each decision applies to
+
+CREATE SCHEMA IF NOT EXISTS decisionrecords;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT USAGE ON SCHEMA decisionrecords TO easi_app';
+        EXECUTE 'ALTER DEFAULT PRIVILEGES IN SCHEMA decisionrecords GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO easi_app';
+        EXECUTE 'ALTER DEFAULT PRIVILEGES IN SCHEMA decisionrecords GRANT USAGE, SELECT ON SEQUENCES TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON SCHEMA decisionrecords TO easi_admin';
+        EXECUTE 'ALTER DEFAULT PRIVILEGES IN SCHEMA decisionrecords GRANT ALL PRIVILEGES ON TABLES TO easi_admin';
+        EXECUTE 'ALTER DEFAULT PRIVILEGES IN SCHEMA decisionrecords GRANT ALL PRIVILEGES ON SEQUENCES TO easi_admin';
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS decisionrecords.decision_records (
+    tenant_id VARCHAR(50) NOT NULL,
+    id VARCHAR(255) NOT NULL,
+    number INTEGER NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    context TEXT NOT NULL DEFAULT '',
+    options JSONB NOT NULL DEFAULT '[]'::jsonb,
+    decision TEXT NOT NULL DEFAULT '',
+    consequences TEXT NOT NULL DEFAULT '',
+    supersedes_id VARCHAR(255),
+    superseded_by_id VARCHAR(255),
+    status_reason TEXT NOT NULL DEFAULT '',
+    proposed_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    decided_at TIMESTAMP,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_decision_records_number
+    ON decisionrecords.decision_records(tenant_id, number);
+CREATE INDEX IF NOT EXISTS idx_decision_records_status
+    ON decisionrecords.decision_records(tenant_id, status);
+
+ALTER TABLE decisionrecords.decision_records ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON decisionrecords.decision_records;
+CREATE POLICY tenant_isolation_policy ON decisionrecords.decision_records
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+CREATE TABLE IF NOT EXISTS decisionrecords.decision_record_subjects (
+    tenant_id VARCHAR(50) NOT NULL,
+    decision_record_id VARCHAR(255) NOT NULL,
+    subject_type VARCHAR(30) NOT NULL,
+    subject_id VARCHAR(255) NOT NULL,
+    subject_name VARCHAR(500) NOT NULL DEFAULT '',
+    linked_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, decision_record_id, subject_type, subject_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_decision_record_subjects_subject
+    ON decisionrecords.decision_record_subjects(tenant_id, subject_id);
+
+ALTER TABLE decisionrecords.decision_record_subjects ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON decisionrecords.decision_record_subjects;
+CREATE POLICY tenant_isolation_policy ON decisionrecords.decision_record_subjects
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA decisionrecords TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON ALL TABLES IN SCHEMA decisionrecords TO easi_admin';
+    END IF;
+END $$;
@@ -0,0 +1,35 @@
+-- The TIME suggestion an architect last rejected for each realisation. The
+-- review queue hides a suggestion while its evidence fingerprint still
+-- matches the one stored here.
+CREATE TABLE IF NOT EXISTS architecturedirection.time_suggestion_rejections (
+    id VARCHAR(255) NOT NULL,
+    tenant_id VARCHAR(50) NOT NULL,
+    capability_id VARCHAR(255) NOT NULL,
+    component_id VARCHAR(255) NOT NULL,
+    suggested_grade VARCHAR(20) NOT NULL,
+    evidence_fingerprint VARCHAR(64) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    rejected_by VARCHAR(255) NOT NULL,
+    rejected_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_time_suggestion_rejections_per_pair
+    ON architecturedirection.time_suggestion_rejections(tenant_id, capability_id, component_id);
+
+ALTER TABLE architecturedirection.time_suggestion_rejections ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturedirection.time_suggestion_rejections;
+CREATE POLICY tenant_isolation_policy ON architecturedirection.time_suggestion_rejections
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturedirection.time_suggestion_rejections TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturedirection.time_suggestion_rejections TO easi_admin';
+    END IF;
+END $$;
@@ -0,0 +1,79 @@
+-- One row per grade change on a TIME assessment. grade is NULL for the row that
+-- closes an assessment (removed, transferred away, or its capability or
+-- component deleted).
+CREATE TABLE IF NOT EXISTS architecturedirection.time_assessment_history (
+    tenant_id VARCHAR(50) NOT NULL,
+    time_assessment_id VARCHAR(255) NOT NULL,
+    sequence INTEGER NOT NULL,
+    capability_id VARCHAR(255) NOT NULL,
+    component_id VARCHAR(255) NOT NULL,
+    grade VARCHAR(20),
+    previous_grade VARCHAR(20),
+    rationale TEXT NOT NULL DEFAULT '',
+    changed_by VARCHAR(255) NOT NULL,
+    changed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, time_assessment_id, sequence)
+);
+
+CREATE INDEX IF NOT EXISTS idx_time_assessment_history_pair
+    ON architecturedirection.time_assessment_history(tenant_id, capability_id, component_id);
+
+CREATE INDEX IF NOT EXISTS idx_time_assessment_history_changed_at
+    ON architecturedirection.time_assessment_history(tenant_id, changed_at);
+
+ALTER TABLE architecturedirection.time_assessment_history ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturedirection.time_assessment_history;
+CREATE POLICY tenant_isolation_policy ON architecturedirection.time_assessment_history
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+-- Backfill from the event store so assessments made before this migration
+-- keep their full history.
+INSERT INTO architecturedirection.time_assessment_history
+    (tenant_id, time_assessment_id, sequence, capability_id, component_id, grade, previous_grade, rationale, changed_by, changed_at)
+SELECT tenant_id, aggregate_id, sequence, capability_id, component_id, grade,
+       LAG(grade) OVER (PARTITION BY tenant_id, aggregate_id ORDER BY sequence),
+       rationale, changed_by, occurred_at
+FROM (
+    SELECT e.tenant_id, e.aggregate_id,
+           ROW_NUMBER() OVER (PARTITION BY e.tenant_id, e.aggregate_id ORDER BY e.version) AS sequence,
+           e.event_data->>'capabilityId' AS capability_id,
+           e.event_data->>'componentId' AS component_id,
+           CASE WHEN e.event_type = 'TimeAssessmentRecorded' THEN e.event_data->>'grade' END AS grade,
+           COALESCE(e.event_data->>'rationale', '') AS rationale,
+           COALESCE(e.event_data->>'assessedBy', e.event_data->>'removedBy', '') AS changed_by,
+           e.occurred_at
+    FROM infrastructure.events e
+    WHERE e.event_type IN ('TimeAssessmentRecorded', 'TimeAssessmentRemoved')
+) AS replayed
+ON CONFLICT (tenant_id, time_assessment_id, sequence) DO NOTHING;
+
+-- Assessments dropped because their capability or component was deleted left
+-- no event of their own; close them at the deletion.
+INSERT INTO architecturedirection.time_assessment_history
+    (tenant_id, time_assessment_id, sequence, capability_id, component_id, grade, previous_grade, rationale, changed_by, changed_at)
+SELECT h.tenant_id, h.time_assessment_id, h.sequence + 1, h.capability_id, h.component_id, NULL, h.grade, '',
+       'system:reference-deleted',
+       COALESCE((SELECT MIN(d.occurred_at) FROM infrastructure.events d
+                 WHERE d.tenant_id = h.tenant_id
+                   AND ((d.event_type = 'CapabilityDeleted' AND d.aggregate_id = h.capability_id)
+                     OR (d.event_type = 'ApplicationComponentDeleted' AND d.aggregate_id = h.component_id))),
+                CURRENT_TIMESTAMP)
+FROM architecturedirection.time_assessment_history h
+WHERE h.grade IS NOT NULL
+  AND h.sequence = (SELECT MAX(latest.sequence) FROM architecturedirection.time_assessment_history latest
+                    WHERE latest.tenant_id = h.tenant_id AND latest.time_assessment_id = h.time_assessment_id)
+  AND NOT EXISTS (SELECT 1 FROM architecturedirection.time_assessments ta
+                  WHERE ta.tenant_id = h.tenant_id AND ta.id = h.time_assessment_id)
+ON CONFLICT (tenant_id, time_assessment_id, sequence) DO NOTHING;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturedirection.time_assessment_history TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturedirection.time_assessment_history TO easi_admin';
+    END IF;
+END $$;
@@ -0,0 +1,39 @@
+-- Register of time-limited exceptions allowing a non-standard application to
+-- keep realising a capability. Revoked exceptions stay for the record; expiry
+-- is computed when the register is read.
+CREATE TABLE IF NOT EXISTS architecturedirection.standard_exceptions (
+    id VARCHAR(255) NOT NULL,
+    tenant_id VARCHAR(50) NOT NULL,
+    capability_id VARCHAR(255) NOT NULL,
+    component_id VARCHAR(255) NOT NULL,
+    justification TEXT NOT NULL,
+    approver_id VARCHAR(255) NOT NULL,
+    expires_on DATE NOT NULL,
+    granted_by VARCHAR(255) NOT NULL,
+    granted_at TIMESTAMP NOT NULL,
+    renewed_at TIMESTAMP,
+    revoked_by VARCHAR(255),
+    revoked_at TIMESTAMP,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_standard_exceptions_unrevoked_pair
+    ON architecturedirection.standard_exceptions(tenant_id, capability_id, component_id)
+    WHERE revoked_at IS NULL;
+
+ALTER TABLE architecturedirection.standard_exceptions ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturedirection.standard_exceptions;
+CREATE POLICY tenant_isolation_policy ON architecturedirection.standard_exceptions
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturedirection.standard_exceptions TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturedirection.standard_exceptions TO easi_admin';
+    END IF;
+END $$;
@@ -0,0 +1,59 @@
+-- Tenant-defined journey templates: default milestones per journey kind with
+-- targets relative to the quarter a journey is planned in. Only the current
+-- version is kept here; journeys copy the milestones when they are seeded.
+CREATE TABLE IF NOT EXISTS architecturedirection.journey_templates (
+    id VARCHAR(255) NOT NULL,
+    tenant_id VARCHAR(50) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    version INT NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    revised_by VARCHAR(255),
+    revised_at TIMESTAMP,
+    retired_by VARCHAR(255),
+    retired_at TIMESTAMP,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_journey_templates_kind
+    ON architecturedirection.journey_templates(tenant_id, kind);
+
+CREATE TABLE IF NOT EXISTS architecturedirection.journey_template_milestones (
+    tenant_id VARCHAR(50) NOT NULL,
+    template_id VARCHAR(255) NOT NULL,
+    position INT NOT NULL,
+    label VARCHAR(200) NOT NULL,
+    offset_quarters SMALLINT NOT NULL,
+    PRIMARY KEY (tenant_id, template_id, position)
+);
+
+ALTER TABLE architecturedirection.capability_journeys
+    ADD COLUMN IF NOT EXISTS template_id VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS template_version INT;
+
+ALTER TABLE architecturedirection.journey_templates ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturedirection.journey_templates;
+CREATE POLICY tenant_isolation_policy ON architecturedirection.journey_templates
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+ALTER TABLE architecturedirection.journey_template_milestones ENABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation_policy ON architecturedirection.journey_template_milestones;
+CREATE POLICY tenant_isolation_policy ON architecturedirection.journey_template_milestones
+    FOR ALL TO easi_app
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_app') THEN
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturedirection.journey_templates TO easi_app';
+        EXECUTE 'GRANT SELECT, INSERT, UPDATE, DELETE ON architecturedirection.journey_template_milestones TO easi_app';
+    END IF;
+    IF EXISTS (SELECT FROM pg_catalog.pg_user WHERE usename = 'easi_admin') THEN
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturedirection.journey_templates TO easi_admin';
+        EXECUTE 'GRANT ALL PRIVILEGES ON architecturedirection.journey_template_milestones TO easi_admin';
+    END IF;
+END $$;
@@ -0,0 +1,6 @@
+-- The decision an architecture direction is agreed with is now kept as an
+-- accepted decision record by the decision records context, which creates it
+-- from the DirectionAgreed event. The direction-owned copy is no longer read
+-- or written.
+
+DROP TABLE IF EXISTS architecturedirection.direction_decision_records;
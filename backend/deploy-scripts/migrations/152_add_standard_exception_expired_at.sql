@@ -0,0 +1,8 @@
+-- Expiry of a standard exception is now recorded by a scheduled sweep that
+-- raises StandardExceptionExpired once per approval. expired_at is set when
+-- that event is projected and cleared again when the exception is renewed.
+ALTER TABLE architecturedirection.standard_exceptions ADD COLUMN IF NOT EXISTS expired_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_standard_exceptions_due_to_expire
+    ON architecturedirection.standard_exceptions (tenant_id, expires_on)
+    WHERE revoked_at IS NULL AND expired_at IS NULL;
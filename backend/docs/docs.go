@@ -2927,59 +2927,8 @@ const docTemplate = `{
             }
         },
         "/capabilities/{id}/experts": {
-            "post": {
-                "description": "Associates a subject matter expert with a capability",
-                "consumes": [
-                    "application/json"
-                ],
-                "tags": [
-                    "capabilities"
-                ],
-                "summary": "Add an expert to a capability",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Capability ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Expert data",
-                        "name": "expert",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_capabilitymapping_infrastructure_api.AddCapabilityExpertRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/easi_backend_internal_shared_api.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/easi_backend_internal_shared_api.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/easi_backend_internal_shared_api.ErrorResponse"
-                        }
-                    }
-                }
-            },
             "delete": {
-                "description": "Removes a subject matter expert from a capability",
+                "description": "Removes a legacy free-text expert from a capability. New experts are assigned as Consulted owners through /capabilities/{id}/owners.",
                 "tags": [
                     "capabilities"
                 ],
@@ -3278,7 +3227,7 @@ const docTemplate = `{
         },
         "/capabilities/{id}/metadata": {
             "put": {
-                "description": "Updates metadata fields like maturity level, ownership, and strategy alignment",
+                "description": "Updates maturity, ownership model and status. The free-text primaryOwner and eaOwner are deprecated and ignored; owners are assigned through /capabilities/{id}/owners.",
                 "consumes": [
                     "application/json"
                 ],
@@ -14608,13 +14557,17 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "eaOwner": {
-                    "type": "string"
+                    "description": "Legacy free text, no longer written. Owners are listed under x-owners.",
+                    "type": "string",
+                    "x-deprecated": true
                 },
                 "experts": {
+                    "description": "Legacy free text, no longer written. Experts are Consulted owners under x-owners.",
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/easi_backend_internal_capabilitymapping_application_readmodels.ExpertDTO"
-                    }
+                    },
+                    "x-deprecated": true
                 },
                 "id": {
                     "type": "string"
@@ -14641,7 +14594,9 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "primaryOwner": {
-                    "type": "string"
+                    "description": "Legacy free text, no longer written. Owners are listed under x-owners.",
+                    "type": "string",
+                    "x-deprecated": true
                 },
                 "status": {
                     "type": "string"
@@ -16580,20 +16535,6 @@ const docTemplate = `{
                 }
             }
         },
-        "internal_capabilitymapping_infrastructure_api.AddCapabilityExpertRequest": {
-            "type": "object",
-            "properties": {
-                "contactInfo": {
-                    "type": "string"
-                },
-                "expertName": {
-                    "type": "string"
-                },
-                "expertRole": {
-                    "type": "string"
-                }
-            }
-        },
         "internal_capabilitymapping_infrastructure_api.AddCapabilityTagRequest": {
             "type": "object",
             "properties": {
@@ -17153,7 +17094,9 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "eaOwner": {
-                    "type": "string"
+                    "description": "Deprecated: ignored. Assign owners through /capabilities/{id}/owners.",
+                    "type": "string",
+                    "x-deprecated": true
                 },
                 "maturityLevel": {
                     "type": "string"
@@ -17165,7 +17108,9 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "primaryOwner": {
-                    "type": "string"
+                    "description": "Deprecated: ignored. Assign owners through /capabilities/{id}/owners.",
+                    "type": "string",
+                    "x-deprecated": true
                 },
                 "status": {
                     "type": "string"
@@ -5,6 +5,7 @@ import (
 	amPL "easi/backend/internal/architecturemodeling/publishedlanguage"
 	avPL "easi/backend/internal/architectureviews/publishedlanguage"
 	cmPL "easi/backend/internal/capabilitymapping/publishedlanguage"
+	drPL "easi/backend/internal/decisionrecords/publishedlanguage"
 	eaPL "easi/backend/internal/enterprisearchitecture/publishedlanguage"
 	mmPL "easi/backend/internal/metamodel/publishedlanguage"
 	vsPL "easi/backend/internal/valuestreams/publishedlanguage"
@@ -18,6 +19,7 @@ var allowedContexts = []func() []AgentToolSpec{
 	vsPL.AgentTools,
 	mmPL.AgentTools,
 	adPL.AgentTools,
+	drPL.AgentTools,
 }
 
 func CollectToolSpecs(providers ...func() []AgentToolSpec) []AgentToolSpec {
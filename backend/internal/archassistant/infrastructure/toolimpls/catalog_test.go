@@ -65,9 +65,9 @@ func TestContextOwnedCatalogs_ContainAllTools(t *testing.T) {
 }
 
 func TestContextOwnedCatalogs_ToolCounts(t *testing.T) {
-	assert.Len(t, amPL.AgentTools(), 26, "architecturemodeling")
-	assert.Len(t, cmPL.AgentTools(), 34, "capabilitymapping")
+	assert.Len(t, amPL.AgentTools(), 34, "architecturemodeling")
+	assert.Len(t, cmPL.AgentTools(), 50, "capabilitymapping")
 	assert.Len(t, vsPL.AgentTools(), 9, "valuestreams")
 	assert.Len(t, eaPL.AgentTools(), 12, "enterprisearchitecture")
-	assert.Len(t, mmPL.AgentTools(), 2, "metamodel")
+	assert.Len(t, mmPL.AgentTools(), 4, "metamodel")
 }
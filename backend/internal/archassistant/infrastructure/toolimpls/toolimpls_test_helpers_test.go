@@ -1,9 +1,10 @@
 package toolimpls_test
 
 var coreContextExpectedSpecToolNames = []string{
-	"list_applications", "get_application_details",
+	"list_applications", "get_application_details", "get_application_hierarchy",
+	"search_applications", "list_classification_dimensions", "find_duplicate_applications",
 	"create_application", "update_application", "delete_application",
-	"create_application_relation", "delete_application_relation",
+	"list_relation_types", "create_application_relation", "delete_application_relation",
 	"list_vendors", "get_vendor_details",
 	"list_acquired_entities", "get_acquired_entity_details",
 	"list_internal_teams", "get_internal_team_details",
@@ -14,23 +15,29 @@ var coreContextExpectedSpecToolNames = []string{
 	"create_acquired_entity", "update_acquired_entity",
 	"create_vendor", "update_vendor",
 	"create_internal_team", "update_internal_team",
+	"list_vendor_contracts", "get_vendor_contract_details", "list_upcoming_notice_deadlines",
 	"list_capabilities", "get_capability_details",
 	"create_capability", "update_capability", "delete_capability",
 	"realize_capability", "unrealize_capability",
-	"list_business_domains", "get_business_domain_details",
+	"list_business_domains", "get_business_domain_details", "get_business_domain_children", "get_business_domain_kpis",
 	"create_business_domain", "update_business_domain",
 	"assign_capability_to_domain", "remove_capability_from_domain",
 	"list_capability_dependencies", "create_capability_dependency", "delete_capability_dependency",
+	"get_capability_upstream_dependencies", "get_capability_downstream_impact",
+	"get_capability_dependency_criticality", "get_fragile_capability_dependencies",
 	"get_capability_children",
 	"get_domain_capability_realizations",
 	"get_strategy_importance", "set_strategy_importance",
 	"get_application_fit_scores", "set_application_fit_score",
 	"get_strategic_fit_analysis",
+	"list_reference_models", "get_reference_model", "get_reference_model_coverage",
+	"get_capability_reference_mappings", "map_capability_to_reference",
 	"get_capability_metadata_index", "get_capability_maturity_levels",
 	"get_capability_statuses", "get_capability_ownership_models",
-	"get_capability_expert_roles",
+	"get_capability_expert_roles", "list_capability_tags",
+	"get_capability_owners", "get_my_capabilities",
 	"update_capability_metadata",
-	"get_capability_realizations", "get_capabilities_by_application", "get_capability_business_domains",
+	"get_capability_realizations", "get_capability_heatmap", "get_capability_coverage_gaps", "get_capabilities_by_application", "get_capability_business_domains",
 	"get_domain_importance_overview", "get_fit_scores_by_pillar",
 	"list_enterprise_capabilities", "get_enterprise_capability_details",
 	"create_enterprise_capability", "update_enterprise_capability", "delete_enterprise_capability",
@@ -43,13 +50,18 @@ var coreContextExpectedSpecToolNames = []string{
 	"get_value_stream_capabilities",
 	"create_value_stream_stage", "update_value_stream_stage",
 	"reorder_value_stream_stages", "add_stage_capability",
-	"get_strategy_pillars", "get_maturity_scale",
+	"get_strategy_pillars", "get_maturity_scale", "get_capability_hierarchy", "get_capability_tag_vocabulary",
 }
 
 var architectureDirectionSpecToolNames = []string{
 	"get_time_assessment_for_realization", "list_time_assessments", "get_time_assessment_rollups",
+	"get_time_assessment_history", "get_time_assessment_trend",
 	"get_realization_role_for_capability_component", "list_realization_roles",
 	"get_capability_journey", "get_capability_journey_history", "list_capability_journeys",
+	"list_journey_programmes", "get_journey_programme", "get_journey_roadmap",
+	"list_journey_slips", "get_journey_slip_history", "get_target_state",
+	"list_standard_exceptions", "get_standard_exception", "get_standard_compliance",
+	"list_journey_templates", "get_journey_template",
 }
 
 var allExpectedSpecToolNames = append(
@@ -18,6 +18,7 @@ import (
 	viewsAPI "easi/backend/internal/architectureviews/infrastructure/api"
 	authPL "easi/backend/internal/auth/publishedlanguage"
 	capabilityAPI "easi/backend/internal/capabilitymapping/infrastructure/api"
+	decisionRecordsAPI "easi/backend/internal/decisionrecords/infrastructure/api"
 	enterpriseArchAPI "easi/backend/internal/enterprisearchitecture/infrastructure/api"
 	metamodelAPI "easi/backend/internal/metamodel/infrastructure/api"
 	sharedAPI "easi/backend/internal/shared/api"
@@ -88,6 +89,13 @@ func buildToolCatalogTestRouter(t *testing.T) chi.Router {
 		t.Fatalf("architecture direction routes: %v", err)
 	}
 
+	if err := decisionRecordsAPI.SetupDecisionRecordRoutes(decisionRecordsAPI.RoutesDeps{
+		Router: r, CommandBus: commandBus, EventStore: es, EventBus: eventBus,
+		HATEOAS: hateoas, AuthMiddleware: auth,
+	}); err != nil {
+		t.Fatalf("decision record routes: %v", err)
+	}
+
 	if err := metamodelAPI.SetupMetaModelRoutes(metamodelAPI.MetaModelRoutesDeps{
 		Router: r, CommandBus: commandBus, EventStore: es, EventBus: eventBus,
 		Hateoas: hateoas, AuthMiddleware: auth,
@@ -199,10 +207,29 @@ func TestToolCatalog_MethodMatchesAccessClass(t *testing.T) {
 }
 
 var excludedRoutes = map[string]string{
-	"POST /capabilities/*/experts":                                  "expert management — operational, not architecture exploration",
 	"DELETE /capabilities/*/experts":                                "expert management — operational, not architecture exploration",
 	"POST /capabilities/*/tags":                                     "tag management — operational, not architecture exploration",
+	"DELETE /capabilities/*/tags/*":                                 "tag management — operational, not architecture exploration",
+	"POST /capability-tags/rename":                                  "tag management — operational, not architecture exploration",
+	"POST /capability-tags/merge":                                   "tag management — operational, not architecture exploration",
+	"PUT /capabilities/*/owners/*/*":                                "ownership management — operational, not architecture exploration",
+	"DELETE /capabilities/*/owners/*/*":                             "ownership management — operational, not architecture exploration",
+	"POST /capability-owners/reassign":                              "ownership management — operational, not architecture exploration",
 	"PATCH /capabilities/*/parent":                                  "hierarchy reparenting — complex operation, not suitable for agent",
+	"POST /capabilities/*/merge":                                    "capability merge — map reorganisation, reserved for human via UI",
+	"POST /capabilities/*/split":                                    "capability split — map reorganisation, reserved for human via UI",
+	"POST /capabilities/bulk":                                       "bulk edit — batch change across many items, reserved for human via UI",
+	"POST /capability-realizations/bulk":                            "bulk edit — batch change across many items, reserved for human via UI",
+	"POST /reference-models":                                        "reference model import — bulk catalogue upload, reserved for human via UI",
+	"DELETE /reference-models/*":                                    "delete reference model — removes every mapping onto it, reserved for UI",
+	"POST /reference-models/*/seed-business-domains":                "business domain seeding — batch creation, reserved for human via UI",
+	"DELETE /capabilities/*/reference-mappings/*":                   "reference mapping management — operational, not architecture exploration",
+	"PATCH /components/*/parent":                                    "hierarchy reparenting — complex operation, not suitable for agent",
+	"POST /components/*/merge":                                      "merging duplicates — destructive, cross-context operation, not suitable for agent",
+	"POST /components/*/tags":                                       "tag management — operational, not architecture exploration",
+	"DELETE /components/*/tags/*":                                   "tag management — operational, not architecture exploration",
+	"PUT /components/*/classifications/*":                           "classification management — operational, not architecture exploration",
+	"DELETE /components/*/classifications/*":                        "classification management — operational, not architecture exploration",
 	"GET /capabilities/*/delete-impact":                             "delete impact analysis — UI pre-deletion preview, reserved for UI",
 	"GET /capabilities/*/dependencies/incoming":                     "per-capability view — use list_capability_dependencies instead",
 	"GET /capabilities/*/dependencies/outgoing":                     "per-capability view — use list_capability_dependencies instead",
@@ -210,6 +237,7 @@ var excludedRoutes = map[string]string{
 	"GET /capability-realizations/by-component/*":                   "realizations by component — available in get_application_details",
 	"PUT /capability-realizations/*":                                "update realization level — fine-grained, use realize_capability",
 	"DELETE /business-domains/*":                                    "delete domain — high-impact, reserved for UI",
+	"PATCH /business-domains/*/parent":                              "restructure domain hierarchy — reserved for UI",
 	"GET /business-domains/*/capabilities":                          "capabilities in domain — available in get_business_domain_details",
 	"GET /business-domains/*/capabilities/*/importance":             "per-domain-capability importance — use get_strategy_importance",
 	"PUT /business-domains/*/capabilities/*/importance/*":           "update importance — fine-grained, use set_strategy_importance",
@@ -231,22 +259,38 @@ var excludedRoutes = map[string]string{
 	"DELETE /acquired-entities/*":                                   "origin entity delete — high-impact cascading operation, reserved for UI",
 	"DELETE /vendors/*":                                             "origin entity delete — high-impact cascading operation, reserved for UI",
 	"DELETE /internal-teams/*":                                      "origin entity delete — high-impact cascading operation, reserved for UI",
+	"POST /vendors/*/contracts":                                     "vendor contract capture — commercial record, reserved for UI",
+	"PUT /vendors/*/contracts/*":                                    "vendor contract edit — commercial record, reserved for UI",
+	"DELETE /vendors/*/contracts/*":                                 "vendor contract delete — commercial record, reserved for UI",
 	"PUT /enterprise-capabilities/*/target-maturity":                "set target maturity — fine-grained, reserved for UI",
 	"PUT /enterprise-capabilities/*/strategic-importance/*":         "update importance — fine-grained, use set_enterprise_strategic_importance",
 	"DELETE /enterprise-capabilities/*/strategic-importance/*":      "remove importance — fine-grained, reserved for UI",
 	"GET /meta-model/configurations/*":                              "metamodel config by ID — use get_maturity_scale instead",
 	"GET /meta-model/strategy-pillars/*":                            "single pillar detail — use get_strategy_pillars for all",
 	"PUT /meta-model/maturity-scale":                                "metamodel write — blocked by permission ceiling",
+	"PUT /meta-model/capability-hierarchy":                          "metamodel write — blocked by permission ceiling",
+	"PUT /meta-model/capability-tag-vocabulary":                     "metamodel write — blocked by permission ceiling",
 	"POST /meta-model/maturity-scale/reset":                         "metamodel write — blocked by permission ceiling",
 	"PATCH /meta-model/strategy-pillars":                            "metamodel write — blocked by permission ceiling",
 	"POST /meta-model/strategy-pillars":                             "metamodel write — blocked by permission ceiling",
 	"PUT /meta-model/strategy-pillars/*":                            "metamodel write — blocked by permission ceiling",
 	"DELETE /meta-model/strategy-pillars/*":                         "metamodel write — blocked by permission ceiling",
 	"PUT /meta-model/strategy-pillars/*/fit-configuration":          "metamodel write — blocked by permission ceiling",
+	"GET /meta-model/relation-types":                                "metamodel relation type admin view — use list_relation_types",
+	"GET /meta-model/relation-types/*":                              "single custom relation type — use list_relation_types",
+	"POST /meta-model/relation-types":                               "metamodel write — blocked by permission ceiling",
+	"PUT /meta-model/relation-types/*":                              "metamodel write — blocked by permission ceiling",
+	"DELETE /meta-model/relation-types/*":                           "metamodel write — blocked by permission ceiling",
+	"GET /meta-model/classification-dimensions":                     "metamodel classification admin view — use list_classification_dimensions",
+	"GET /meta-model/classification-dimensions/*":                   "single classification dimension — use list_classification_dimensions",
+	"POST /meta-model/classification-dimensions":                    "metamodel write — blocked by permission ceiling",
+	"PUT /meta-model/classification-dimensions/*":                   "metamodel write — blocked by permission ceiling",
+	"DELETE /meta-model/classification-dimensions/*":                "metamodel write — blocked by permission ceiling",
 	"POST /enterprise-capabilities/*/direction":                     "direction capture — architect-only deliberation, reserved for human via UI",
 	"PUT /enterprise-capabilities/*/direction":                      "direction edits — architect-only deliberation, reserved for human via UI",
 	"POST /enterprise-capabilities/*/direction/propose":             "direction advance to proposed — architect-only deliberation, reserved for human via UI",
 	"POST /enterprise-capabilities/*/direction/agree":               "direction advance to agreed — architect-only deliberation, reserved for human via UI",
+	"POST /enterprise-capabilities/*/direction/reviews":             "direction review verdict — reviewer deliberation, reserved for human via UI",
 	"POST /enterprise-capabilities/*/direction/reject":              "direction rejection — architect-only deliberation, reserved for human via UI",
 	"POST /enterprise-capabilities/*/direction/sources":             "direction source addition — architect-only deliberation, reserved for human via UI",
 	"DELETE /enterprise-capabilities/*/direction/sources/*":         "direction source exclusion — architect-only deliberation, reserved for human via UI",
@@ -255,6 +299,7 @@ var excludedRoutes = map[string]string{
 	"GET /enterprise-capabilities/*/standard-application/history":   "standard history — UI helper; main get_standard_application_for_enterprise_capability tool returns the current state",
 	"PUT /capabilities/*/components/*/time-assessment":              "TIME assessment set/change — architect-only deliberation, reserved for human via UI",
 	"DELETE /capabilities/*/components/*/time-assessment":           "TIME assessment removal — architect-only deliberation, reserved for human via UI",
+	"POST /time-suggestion-reviews":                                 "TIME suggestion review decisions — architect-only deliberation, reserved for human via UI",
 	"PUT /capabilities/*/components/*/realization-role":             "realization role assign/change — architect-only deliberation, reserved for human via UI",
 	"DELETE /capabilities/*/components/*/realization-role":          "realization role clear — architect-only deliberation, reserved for human via UI",
 	"POST /capabilities/*/journey":                                  "journey capture — architect-only deliberation, reserved for human via UI",
@@ -267,6 +312,25 @@ var excludedRoutes = map[string]string{
 	"POST /capability-journeys/*/milestones":                        "journey milestone add — architect-only deliberation, reserved for human via UI",
 	"PUT /capability-journeys/*/milestones/*":                       "journey milestone edit — architect-only deliberation, reserved for human via UI",
 	"DELETE /capability-journeys/*/milestones/*":                    "journey milestone removal — architect-only deliberation, reserved for human via UI",
+	"POST /capability-journeys/*/dependencies":                      "journey dependency add — architect-only deliberation, reserved for human via UI",
+	"DELETE /capability-journeys/*/dependencies/*":                  "journey dependency removal — architect-only deliberation, reserved for human via UI",
+	"POST /journey-programmes":                                      "journey programme creation — architect-only deliberation, reserved for human via UI",
+	"PUT /journey-programmes/*":                                     "journey programme edit — architect-only deliberation, reserved for human via UI",
+	"DELETE /journey-programmes/*":                                  "journey programme deletion — architect-only deliberation, reserved for human via UI",
+	"POST /standard-exceptions":                                     "standard exception grant — architect-only deliberation, reserved for human via UI",
+	"PUT /standard-exceptions/*":                                    "standard exception renewal — architect-only deliberation, reserved for human via UI",
+	"DELETE /standard-exceptions/*":                                 "standard exception revocation — architect-only deliberation, reserved for human via UI",
+	"POST /journey-templates":                                       "journey template creation — architect-only deliberation, reserved for human via UI",
+	"PUT /journey-templates/*":                                      "journey template revision — architect-only deliberation, reserved for human via UI",
+	"DELETE /journey-templates/*":                                   "journey template retirement — architect-only deliberation, reserved for human via UI",
+	"POST /journey-programmes/*/journeys":                           "journey programme membership — architect-only deliberation, reserved for human via UI",
+	"DELETE /journey-programmes/*/journeys/*":                       "journey programme membership — architect-only deliberation, reserved for human via UI",
+	"POST /decision-records":                                        "decision record proposal — architect-only deliberation, reserved for human via UI",
+	"PUT /decision-records/*":                                       "decision record revision — architect-only deliberation, reserved for human via UI",
+	"POST /decision-records/*/accept":                               "decision record acceptance — architect-only deliberation, reserved for human via UI",
+	"POST /decision-records/*/deprecate":                            "decision record deprecation — architect-only deliberation, reserved for human via UI",
+	"POST /decision-records/*/subjects":                             "decision record subject link — architect-only deliberation, reserved for human via UI",
+	"DELETE /decision-records/*/subjects/*/*":                       "decision record subject unlink — architect-only deliberation, reserved for human via UI",
 	"DELETE /value-streams/*":                                       "value stream delete — high-impact, reserved for UI",
 	"DELETE /value-streams/*/stages/*":                              "stage delete — reserved for UI",
 	"DELETE /value-streams/*/stages/*/capabilities/*":               "stage-capability unmapping — reserved for UI",
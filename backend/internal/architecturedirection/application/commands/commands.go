@@ -1,5 +1,7 @@
 package commands
 
+import "time"
+
 type CaptureDirection struct {
 	EnterpriseCapabilityID string
 	Type                   string
@@ -10,9 +12,20 @@ type CaptureDirection struct {
 
 func (c CaptureDirection) CommandName() string { return "CaptureDirection" }
 
+// AdvanceDirection proposes or agrees a direction. Reviewers and Quorum apply
+// when proposing; without reviewers the domain architects of the source
+// capabilities review. The decision record sections and AgreedBy apply when
+// agreeing.
 type AdvanceDirection struct {
-	DirectionID  string
-	TargetStatus string
+	DirectionID          string
+	TargetStatus         string
+	ReviewerIDs          []string
+	Quorum               int
+	ProposedBy           string
+	DecisionContext      string
+	Decision             string
+	DecisionConsequences string
+	AgreedBy             string
 }
 
 func (c AdvanceDirection) CommandName() string { return "AdvanceDirection" }
@@ -23,6 +36,15 @@ type RejectDirection struct {
 
 func (c RejectDirection) CommandName() string { return "RejectDirection" }
 
+type ReviewDirection struct {
+	DirectionID string
+	ReviewerID  string
+	Verdict     string
+	Comment     string
+}
+
+func (c ReviewDirection) CommandName() string { return "ReviewDirection" }
+
 type UpdateDirection struct {
 	DirectionID string
 	Narrative   *string
@@ -47,6 +69,17 @@ type RemoveDirectionSource struct {
 
 func (c RemoveDirectionSource) CommandName() string { return "RemoveDirectionSource" }
 
+// RehomeDirectionSource replaces a direction's source capability that was merged
+// or split with the capability that takes over from it.
+type RehomeDirectionSource struct {
+	DirectionID      string
+	FromCapabilityID string
+	ToCapabilityID   string
+	Actor            string
+}
+
+func (c RehomeDirectionSource) CommandName() string { return "RehomeDirectionSource" }
+
 type SetStandardApplication struct {
 	EnterpriseCapabilityID string
 	ApplicationID          string
@@ -73,6 +106,39 @@ type RemoveTimeAssessment struct {
 
 func (c RemoveTimeAssessment) CommandName() string { return "RemoveTimeAssessment" }
 
+// RejectTimeSuggestion dismisses the computed TIME suggestion for a pair until
+// the evidence identified by EvidenceFingerprint changes.
+type RejectTimeSuggestion struct {
+	CapabilityID        string
+	ComponentID         string
+	SuggestedGrade      string
+	EvidenceFingerprint string
+	Reason              string
+	RejectedBy          string
+}
+
+func (c RejectTimeSuggestion) CommandName() string { return "RejectTimeSuggestion" }
+
+// TransferTimeAssessment moves an assessment to another component, another
+// capability, or both. An empty ToCapabilityID keeps the capability.
+type TransferTimeAssessment struct {
+	CapabilityID    string
+	ToCapabilityID  string
+	FromComponentID string
+	ToComponentID   string
+	RealizationID   string
+	TransferredBy   string
+}
+
+func (c TransferTimeAssessment) TargetCapabilityID() string {
+	if c.ToCapabilityID != "" {
+		return c.ToCapabilityID
+	}
+	return c.CapabilityID
+}
+
+func (c TransferTimeAssessment) CommandName() string { return "TransferTimeAssessment" }
+
 type AssignRealizationRole struct {
 	CapabilityID string
 	ComponentID  string
@@ -101,7 +167,10 @@ type PlanJourney struct {
 	TargetDomainID   string
 	TargetParentID   string
 	ResultingName    string
-	PlannedBy        string
+	// TemplateID optionally seeds the journey's milestones from the current
+	// version of a journey template of the same kind.
+	TemplateID string
+	PlannedBy  string
 }
 
 func (c PlanJourney) CommandName() string { return "PlanJourney" }
@@ -156,6 +225,17 @@ func (c ChangeJourneySourceApplications) CommandName() string {
 	return "ChangeJourneySourceApplications"
 }
 
+// RehomeCapabilityJourney moves the active journey of a capability that was merged
+// or split onto the capability that takes over from it.
+type RehomeCapabilityJourney struct {
+	FromCapabilityID string
+	ToCapabilityID   string
+	Reason           string
+	Actor            string
+}
+
+func (c RehomeCapabilityJourney) CommandName() string { return "RehomeCapabilityJourney" }
+
 type AddJourneyMilestone struct {
 	JourneyID     string
 	Label         string
@@ -186,3 +266,127 @@ type RemoveJourneyMilestone struct {
 }
 
 func (c RemoveJourneyMilestone) CommandName() string { return "RemoveJourneyMilestone" }
+
+type AddJourneyDependency struct {
+	JourneyID      string
+	PredecessorID  string
+	DependencyType string
+	Actor          string
+}
+
+func (c AddJourneyDependency) CommandName() string { return "AddJourneyDependency" }
+
+type RemoveJourneyDependency struct {
+	JourneyID     string
+	PredecessorID string
+	Actor         string
+}
+
+func (c RemoveJourneyDependency) CommandName() string { return "RemoveJourneyDependency" }
+
+type CreateJourneyProgramme struct {
+	Name        string
+	Description string
+	Actor       string
+}
+
+func (c CreateJourneyProgramme) CommandName() string { return "CreateJourneyProgramme" }
+
+type UpdateJourneyProgramme struct {
+	ProgrammeID string
+	Name        string
+	Description string
+	Actor       string
+}
+
+func (c UpdateJourneyProgramme) CommandName() string { return "UpdateJourneyProgramme" }
+
+type DeleteJourneyProgramme struct {
+	ProgrammeID string
+	Actor       string
+}
+
+func (c DeleteJourneyProgramme) CommandName() string { return "DeleteJourneyProgramme" }
+
+type AddJourneyToProgramme struct {
+	ProgrammeID string
+	JourneyID   string
+	Actor       string
+}
+
+func (c AddJourneyToProgramme) CommandName() string { return "AddJourneyToProgramme" }
+
+type RemoveJourneyFromProgramme struct {
+	ProgrammeID string
+	JourneyID   string
+	Actor       string
+}
+
+func (c RemoveJourneyFromProgramme) CommandName() string { return "RemoveJourneyFromProgramme" }
+
+type TemplateMilestoneInput struct {
+	Label          string
+	OffsetQuarters int
+}
+
+type CreateJourneyTemplate struct {
+	Kind       string
+	Name       string
+	Milestones []TemplateMilestoneInput
+	Actor      string
+}
+
+func (c CreateJourneyTemplate) CommandName() string { return "CreateJourneyTemplate" }
+
+type ReviseJourneyTemplate struct {
+	TemplateID string
+	Name       string
+	Milestones []TemplateMilestoneInput
+	Actor      string
+}
+
+func (c ReviseJourneyTemplate) CommandName() string { return "ReviseJourneyTemplate" }
+
+type RetireJourneyTemplate struct {
+	TemplateID string
+	Actor      string
+}
+
+func (c RetireJourneyTemplate) CommandName() string { return "RetireJourneyTemplate" }
+
+type GrantStandardException struct {
+	CapabilityID  string
+	ComponentID   string
+	Justification string
+	ApproverID    string
+	ExpiresOn     time.Time
+	Actor         string
+}
+
+func (c GrantStandardException) CommandName() string { return "GrantStandardException" }
+
+type RenewStandardException struct {
+	ExceptionID   string
+	Justification string
+	ApproverID    string
+	ExpiresOn     time.Time
+	Actor         string
+}
+
+func (c RenewStandardException) CommandName() string { return "RenewStandardException" }
+
+type RevokeStandardException struct {
+	ExceptionID string
+	Actor       string
+}
+
+func (c RevokeStandardException) CommandName() string { return "RevokeStandardException" }
+
+// ExpireStandardException records that an exception's expiry date has passed.
+// It is issued by the expiry sweep, not by users.
+type ExpireStandardException struct {
+	ExceptionID string
+	Now         time.Time
+}
+
+func (c ExpireStandardException) CommandName() string { return "ExpireStandardException" }
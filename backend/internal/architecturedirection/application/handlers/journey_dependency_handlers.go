@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type JourneyExistenceLookup interface {
+	JourneyExists(ctx context.Context, journeyID string) (bool, error)
+}
+
+type JourneyDependencyGraphLookup interface {
+	JourneyExistenceLookup
+	DependencyGraph(ctx context.Context) (services.JourneyDependencyGraph, error)
+}
+
+type AddJourneyDependencyHandler struct {
+	repo  CapabilityJourneyRepository
+	graph JourneyDependencyGraphLookup
+}
+
+func NewAddJourneyDependencyHandler(repo CapabilityJourneyRepository, graph JourneyDependencyGraphLookup) *AddJourneyDependencyHandler {
+	return &AddJourneyDependencyHandler{repo: repo, graph: graph}
+}
+
+func (h *AddJourneyDependencyHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.AddJourneyDependency)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	predecessorID, err := valueobjects.NewCapabilityJourneyIDFromString(command.PredecessorID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	depType, err := valueobjects.NewJourneyDependencyType(command.DependencyType)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.verifyAcyclic(ctx, command.JourneyID, predecessorID.Value()); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	journey, err := h.repo.GetByID(ctx, command.JourneyID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := journey.AddDependency(predecessorID, depType, command.Actor); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, journey); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.EmptyResult(), nil
+}
+
+func (h *AddJourneyDependencyHandler) verifyAcyclic(ctx context.Context, journeyID, predecessorID string) error {
+	if err := requireReferenceExists(ctx, h.graph.JourneyExists, predecessorID); err != nil {
+		return err
+	}
+	if journeyID == predecessorID {
+		return aggregates.ErrJourneyDependsOnItself
+	}
+	graph, err := h.graph.DependencyGraph(ctx)
+	if err != nil {
+		return err
+	}
+	if graph.CreatesCycle(journeyID, predecessorID) {
+		return services.ErrJourneyDependencyCycle
+	}
+	return nil
+}
+
+func NewRemoveJourneyDependencyHandler(repo CapabilityJourneyRepository) cqrs.CommandHandler {
+	return &journeyMutationHandler[*commands.RemoveJourneyDependency]{
+		repo:        repo,
+		journeyIDOf: func(c *commands.RemoveJourneyDependency) string { return c.JourneyID },
+		apply: func(c *commands.RemoveJourneyDependency, j *aggregates.CapabilityJourney) error {
+			return j.RemoveDependency(c.PredecessorID, c.Actor)
+		},
+	}
+}
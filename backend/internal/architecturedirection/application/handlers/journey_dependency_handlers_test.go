@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubDependencyGraphLookup struct {
+	exists bool
+	graph  services.JourneyDependencyGraph
+}
+
+func (s *stubDependencyGraphLookup) JourneyExists(_ context.Context, _ string) (bool, error) {
+	return s.exists, nil
+}
+
+func (s *stubDependencyGraphLookup) DependencyGraph(_ context.Context) (services.JourneyDependencyGraph, error) {
+	return s.graph, nil
+}
+
+func addDependencyCmd(journeyID, predecessorID string) *commands.AddJourneyDependency {
+	return &commands.AddJourneyDependency{
+		JourneyID:      journeyID,
+		PredecessorID:  predecessorID,
+		DependencyType: valueobjects.JourneyDependencyFinishToStart,
+		Actor:          "a@example.com",
+	}
+}
+
+func TestAddJourneyDependencyHandler_Valid_AddsDependency(t *testing.T) {
+	j := plannedJourneyFixture(t)
+	repo := &mockCapabilityJourneyRepository{loaded: j}
+	predecessorID := uuid.New().String()
+
+	_, err := NewAddJourneyDependencyHandler(repo, &stubDependencyGraphLookup{exists: true, graph: services.JourneyDependencyGraph{}}).
+		Handle(context.Background(), addDependencyCmd(j.ID(), predecessorID))
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Contains(t, repo.saved[0].Dependencies(), predecessorID)
+}
+
+func TestAddJourneyDependencyHandler_Rejected(t *testing.T) {
+	j := plannedJourneyFixture(t)
+	predecessorID := uuid.New().String()
+	cases := []struct {
+		name    string
+		lookup  *stubDependencyGraphLookup
+		cmd     *commands.AddJourneyDependency
+		wantErr error
+	}{
+		{
+			name:    "unknown predecessor",
+			lookup:  &stubDependencyGraphLookup{exists: false},
+			cmd:     addDependencyCmd(j.ID(), predecessorID),
+			wantErr: services.ErrReferencedEntityNotFound,
+		},
+		{
+			name:    "cycle through existing dependencies",
+			lookup:  &stubDependencyGraphLookup{exists: true, graph: services.JourneyDependencyGraph{predecessorID: {j.ID()}}},
+			cmd:     addDependencyCmd(j.ID(), predecessorID),
+			wantErr: services.ErrJourneyDependencyCycle,
+		},
+		{
+			name:    "self dependency",
+			lookup:  &stubDependencyGraphLookup{exists: true},
+			cmd:     addDependencyCmd(j.ID(), j.ID()),
+			wantErr: aggregates.ErrJourneyDependsOnItself,
+		},
+		{
+			name:   "unknown dependency type",
+			lookup: &stubDependencyGraphLookup{exists: true},
+			cmd: &commands.AddJourneyDependency{
+				JourneyID: j.ID(), PredecessorID: predecessorID, DependencyType: "finish-to-finish",
+			},
+			wantErr: valueobjects.ErrInvalidJourneyDependencyType,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &mockCapabilityJourneyRepository{loaded: j}
+
+			_, err := NewAddJourneyDependencyHandler(repo, tc.lookup).Handle(context.Background(), tc.cmd)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Empty(t, repo.saved)
+		})
+	}
+}
+
+func TestRemoveJourneyDependencyHandler_UnknownPredecessor_Fails(t *testing.T) {
+	j := plannedJourneyFixture(t)
+	repo := &mockCapabilityJourneyRepository{loaded: j}
+
+	_, err := NewRemoveJourneyDependencyHandler(repo).Handle(context.Background(),
+		&commands.RemoveJourneyDependency{JourneyID: j.ID(), PredecessorID: uuid.New().String(), Actor: "a@example.com"})
+
+	assert.ErrorIs(t, err, aggregates.ErrJourneyDependencyNotFound)
+	assert.Empty(t, repo.saved)
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+var ErrJourneyInAnotherProgramme = errors.New("journey already belongs to another programme")
+
+type JourneyProgrammeRepository interface {
+	Save(ctx context.Context, p *aggregates.JourneyProgramme) error
+	GetByID(ctx context.Context, id string) (*aggregates.JourneyProgramme, error)
+}
+
+type ProgrammeMembershipLookup interface {
+	FindProgrammeIDForJourney(ctx context.Context, journeyID string) (string, bool, error)
+}
+
+type CreateJourneyProgrammeHandler struct {
+	repo JourneyProgrammeRepository
+}
+
+func NewCreateJourneyProgrammeHandler(repo JourneyProgrammeRepository) *CreateJourneyProgrammeHandler {
+	return &CreateJourneyProgrammeHandler{repo: repo}
+}
+
+func (h *CreateJourneyProgrammeHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.CreateJourneyProgramme)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	name, description, err := parseProgrammeNaming(command.Name, command.Description)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	programme := aggregates.NewJourneyProgramme(aggregates.JourneyProgrammeFacts{
+		ID:          valueobjects.NewJourneyProgrammeID(),
+		Name:        name,
+		Description: description,
+		CreatedBy:   command.Actor,
+	})
+	if err := h.repo.Save(ctx, programme); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.NewResult(programme.ID()), nil
+}
+
+type programmeMutationHandler[T cqrs.Command] struct {
+	repo          JourneyProgrammeRepository
+	programmeIDOf func(T) string
+	apply         func(context.Context, T, *aggregates.JourneyProgramme) error
+}
+
+func (h *programmeMutationHandler[T]) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(T)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	programme, err := h.repo.GetByID(ctx, h.programmeIDOf(command))
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.apply(ctx, command, programme); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, programme); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.EmptyResult(), nil
+}
+
+func NewUpdateJourneyProgrammeHandler(repo JourneyProgrammeRepository) cqrs.CommandHandler {
+	return &programmeMutationHandler[*commands.UpdateJourneyProgramme]{
+		repo:          repo,
+		programmeIDOf: func(c *commands.UpdateJourneyProgramme) string { return c.ProgrammeID },
+		apply: func(_ context.Context, c *commands.UpdateJourneyProgramme, p *aggregates.JourneyProgramme) error {
+			name, description, err := parseProgrammeNaming(c.Name, c.Description)
+			if err != nil {
+				return err
+			}
+			return p.Update(name, description, c.Actor)
+		},
+	}
+}
+
+func NewDeleteJourneyProgrammeHandler(repo JourneyProgrammeRepository) cqrs.CommandHandler {
+	return &programmeMutationHandler[*commands.DeleteJourneyProgramme]{
+		repo:          repo,
+		programmeIDOf: func(c *commands.DeleteJourneyProgramme) string { return c.ProgrammeID },
+		apply: func(_ context.Context, c *commands.DeleteJourneyProgramme, p *aggregates.JourneyProgramme) error {
+			return p.Delete(c.Actor)
+		},
+	}
+}
+
+// NewAddJourneyToProgrammeHandler checks the one-programme-per-journey rule
+// against the read model before touching the aggregate.
+func NewAddJourneyToProgrammeHandler(repo JourneyProgrammeRepository, membership ProgrammeMembershipLookup, journeys JourneyExistenceLookup) cqrs.CommandHandler {
+	return &programmeMutationHandler[*commands.AddJourneyToProgramme]{
+		repo:          repo,
+		programmeIDOf: func(c *commands.AddJourneyToProgramme) string { return c.ProgrammeID },
+		apply: func(ctx context.Context, c *commands.AddJourneyToProgramme, p *aggregates.JourneyProgramme) error {
+			journeyID, err := valueobjects.NewCapabilityJourneyIDFromString(c.JourneyID)
+			if err != nil {
+				return err
+			}
+			if err := requireReferenceExists(ctx, journeys.JourneyExists, journeyID.Value()); err != nil {
+				return err
+			}
+			if err := ensureNotInOtherProgramme(ctx, membership, journeyID.Value(), p.ID()); err != nil {
+				return err
+			}
+			return p.AddJourney(journeyID, c.Actor)
+		},
+	}
+}
+
+func NewRemoveJourneyFromProgrammeHandler(repo JourneyProgrammeRepository) cqrs.CommandHandler {
+	return &programmeMutationHandler[*commands.RemoveJourneyFromProgramme]{
+		repo:          repo,
+		programmeIDOf: func(c *commands.RemoveJourneyFromProgramme) string { return c.ProgrammeID },
+		apply: func(_ context.Context, c *commands.RemoveJourneyFromProgramme, p *aggregates.JourneyProgramme) error {
+			return p.RemoveJourney(c.JourneyID, c.Actor)
+		},
+	}
+}
+
+func ensureNotInOtherProgramme(ctx context.Context, membership ProgrammeMembershipLookup, journeyID, programmeID string) error {
+	currentID, found, err := membership.FindProgrammeIDForJourney(ctx, journeyID)
+	if err != nil {
+		return err
+	}
+	if found && currentID != programmeID {
+		return ErrJourneyInAnotherProgramme
+	}
+	return nil
+}
+
+func parseProgrammeNaming(rawName, rawDescription string) (valueobjects.ProgrammeName, sharedvo.Description, error) {
+	name, err := valueobjects.NewProgrammeName(rawName)
+	if err != nil {
+		return valueobjects.ProgrammeName{}, sharedvo.Description{}, err
+	}
+	description, err := sharedvo.NewDescription(rawDescription)
+	if err != nil {
+		return valueobjects.ProgrammeName{}, sharedvo.Description{}, err
+	}
+	return name, description, nil
+}
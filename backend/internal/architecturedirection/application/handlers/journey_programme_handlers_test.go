@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockJourneyProgrammeRepository struct {
+	saved  []*aggregates.JourneyProgramme
+	loaded *aggregates.JourneyProgramme
+}
+
+func (m *mockJourneyProgrammeRepository) Save(_ context.Context, p *aggregates.JourneyProgramme) error {
+	m.saved = append(m.saved, p)
+	return nil
+}
+
+func (m *mockJourneyProgrammeRepository) GetByID(_ context.Context, _ string) (*aggregates.JourneyProgramme, error) {
+	return m.loaded, nil
+}
+
+type stubProgrammeMembershipLookup struct {
+	journeyExists bool
+	programmeID   string
+}
+
+func (s *stubProgrammeMembershipLookup) JourneyExists(_ context.Context, _ string) (bool, error) {
+	return s.journeyExists, nil
+}
+
+func (s *stubProgrammeMembershipLookup) FindProgrammeIDForJourney(_ context.Context, _ string) (string, bool, error) {
+	return s.programmeID, s.programmeID != "", nil
+}
+
+func programmeFixture(t *testing.T) *aggregates.JourneyProgramme {
+	t.Helper()
+	name, err := valueobjects.NewProgrammeName("ERP consolidation")
+	require.NoError(t, err)
+	p := aggregates.NewJourneyProgramme(aggregates.JourneyProgrammeFacts{
+		ID:        valueobjects.NewJourneyProgrammeID(),
+		Name:      name,
+		CreatedBy: "a@example.com",
+	})
+	p.MarkChangesAsCommitted()
+	return p
+}
+
+func TestCreateJourneyProgrammeHandler_ReturnsCreatedID(t *testing.T) {
+	repo := &mockJourneyProgrammeRepository{}
+
+	result, err := NewCreateJourneyProgrammeHandler(repo).Handle(context.Background(),
+		&commands.CreateJourneyProgramme{Name: "ERP consolidation", Actor: "a@example.com"})
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, repo.saved[0].ID(), result.CreatedID)
+}
+
+func TestCreateJourneyProgrammeHandler_BlankName_Fails(t *testing.T) {
+	repo := &mockJourneyProgrammeRepository{}
+
+	_, err := NewCreateJourneyProgrammeHandler(repo).Handle(context.Background(), &commands.CreateJourneyProgramme{Name: "  "})
+
+	assert.ErrorIs(t, err, valueobjects.ErrProgrammeNameRequired)
+	assert.Empty(t, repo.saved)
+}
+
+func TestAddJourneyToProgrammeHandler(t *testing.T) {
+	cases := []struct {
+		name    string
+		lookup  *stubProgrammeMembershipLookup
+		wantErr error
+	}{
+		{name: "free journey", lookup: &stubProgrammeMembershipLookup{journeyExists: true}},
+		{name: "unknown journey", lookup: &stubProgrammeMembershipLookup{}, wantErr: services.ErrReferencedEntityNotFound},
+		{
+			name:    "journey in another programme",
+			lookup:  &stubProgrammeMembershipLookup{journeyExists: true, programmeID: uuid.New().String()},
+			wantErr: ErrJourneyInAnotherProgramme,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := programmeFixture(t)
+			repo := &mockJourneyProgrammeRepository{loaded: p}
+			journeyID := uuid.New().String()
+
+			_, err := NewAddJourneyToProgrammeHandler(repo, tc.lookup, tc.lookup).Handle(context.Background(),
+				&commands.AddJourneyToProgramme{ProgrammeID: p.ID(), JourneyID: journeyID, Actor: "a@example.com"})
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, repo.saved)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, repo.saved, 1)
+			assert.Equal(t, []string{journeyID}, repo.saved[0].JourneyIDs())
+		})
+	}
+}
+
+func TestDeleteJourneyProgrammeHandler_Deletes(t *testing.T) {
+	p := programmeFixture(t)
+	repo := &mockJourneyProgrammeRepository{loaded: p}
+
+	_, err := NewDeleteJourneyProgrammeHandler(repo).Handle(context.Background(),
+		&commands.DeleteJourneyProgramme{ProgrammeID: p.ID(), Actor: "a@example.com"})
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.True(t, repo.saved[0].IsDeleted())
+}
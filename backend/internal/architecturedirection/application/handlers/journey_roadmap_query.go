@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+)
+
+type RoadmapJourneyReader interface {
+	GetAllCurrent(ctx context.Context) ([]readmodels.CapabilityJourneyDTO, error)
+}
+
+type RoadmapProgrammeReader interface {
+	GetByID(ctx context.Context, id string) (*readmodels.JourneyProgrammeDTO, error)
+}
+
+type JourneyRoadmapRequest struct {
+	BusinessDomainID string
+	ProgrammeID      string
+	Kinds            []valueobjects.JourneyKind
+}
+
+type RoadmapMilestone struct {
+	ID     string
+	Label  string
+	Status string
+	Due    time.Time
+}
+
+type RoadmapJourney struct {
+	ID             string
+	CapabilityID   string
+	CapabilityName string
+	Kind           string
+	Status         string
+	Progress       int
+	Start          time.Time
+	Finish         time.Time
+	Milestones     []RoadmapMilestone
+}
+
+type JourneyRoadmap struct {
+	Request          JourneyRoadmapRequest
+	Journeys         []RoadmapJourney
+	UnscheduledCount int
+	ProgrammeName    string
+}
+
+// JourneyRoadmapQuery assembles the schedule of active journeys for export.
+// Only journeys with a target period are scheduled; the rest are counted so
+// the caller can tell the roadmap is incomplete.
+type JourneyRoadmapQuery struct {
+	journeys     RoadmapJourneyReader
+	programmes   RoadmapProgrammeReader
+	domainExists services.DomainExists
+	inDomain     services.CapabilityEffectivelyInDomain
+}
+
+func NewJourneyRoadmapQuery(
+	journeys RoadmapJourneyReader,
+	programmes RoadmapProgrammeReader,
+	domainExists services.DomainExists,
+	inDomain services.CapabilityEffectivelyInDomain,
+) *JourneyRoadmapQuery {
+	return &JourneyRoadmapQuery{journeys: journeys, programmes: programmes, domainExists: domainExists, inDomain: inDomain}
+}
+
+func (q *JourneyRoadmapQuery) Execute(ctx context.Context, req JourneyRoadmapRequest) (*JourneyRoadmap, error) {
+	roadmap := &JourneyRoadmap{Request: req, Journeys: []RoadmapJourney{}}
+	if req.BusinessDomainID != "" && q.domainExists != nil {
+		if err := requireDomainExists(ctx, q.domainExists, req.BusinessDomainID); err != nil {
+			return nil, err
+		}
+	}
+	members, err := q.programmeMembers(ctx, req.ProgrammeID, roadmap)
+	if err != nil {
+		return nil, err
+	}
+	journeys, err := q.journeys.GetAllCurrent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, journey := range journeys {
+		included, err := q.matches(ctx, req, members, journey)
+		if err != nil {
+			return nil, err
+		}
+		if !included {
+			continue
+		}
+		scheduled, ok := scheduleJourney(journey)
+		if !ok {
+			roadmap.UnscheduledCount++
+			continue
+		}
+		roadmap.Journeys = append(roadmap.Journeys, scheduled)
+	}
+	sortRoadmap(roadmap.Journeys)
+	return roadmap, nil
+}
+
+func (q *JourneyRoadmapQuery) programmeMembers(ctx context.Context, programmeID string, roadmap *JourneyRoadmap) (map[string]bool, error) {
+	if programmeID == "" {
+		return nil, nil
+	}
+	programme, err := q.programmes.GetByID(ctx, programmeID)
+	if err != nil {
+		return nil, err
+	}
+	if programme == nil {
+		return nil, services.ErrReferencedEntityNotFound
+	}
+	roadmap.ProgrammeName = programme.Name
+	members := make(map[string]bool, len(programme.Journeys))
+	for _, j := range programme.Journeys {
+		members[j.JourneyID] = true
+	}
+	return members, nil
+}
+
+func (q *JourneyRoadmapQuery) matches(ctx context.Context, req JourneyRoadmapRequest, members map[string]bool, journey readmodels.CapabilityJourneyDTO) (bool, error) {
+	status, err := valueobjects.NewJourneyStatus(journey.Status)
+	if err != nil || !status.IsActive() {
+		return false, nil
+	}
+	if members != nil && !members[journey.ID] {
+		return false, nil
+	}
+	if !kindSelected(req.Kinds, journey.Kind) {
+		return false, nil
+	}
+	if req.BusinessDomainID == "" || q.inDomain == nil {
+		return true, nil
+	}
+	return q.inDomain(ctx, journey.CapabilityID, req.BusinessDomainID)
+}
+
+func kindSelected(kinds []valueobjects.JourneyKind, kind string) bool {
+	if len(kinds) == 0 {
+		return true
+	}
+	for _, k := range kinds {
+		if k.Value() == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// scheduleJourney places a journey on the calendar: it runs from its actual
+// start (or, while still planned, the start of its target quarter) to the end
+// of its target quarter. A journey started after its target quarter collapses
+// to its start so the bar never runs backwards.
+func scheduleJourney(journey readmodels.CapabilityJourneyDTO) (RoadmapJourney, bool) {
+	period, ok := targetPeriodOf(journey.TargetPeriod)
+	if !ok {
+		return RoadmapJourney{}, false
+	}
+	start, finish := period.StartDate(), period.EndDate()
+	if journey.StartedAt != nil {
+		start = dateOnly(*journey.StartedAt)
+	}
+	if start.After(finish) {
+		finish = start
+	}
+	scheduled := RoadmapJourney{
+		ID:             journey.ID,
+		CapabilityID:   journey.CapabilityID,
+		CapabilityName: journey.CapabilityName,
+		Kind:           journey.Kind,
+		Status:         journey.Status,
+		Start:          start,
+		Finish:         finish,
+		Milestones:     []RoadmapMilestone{},
+	}
+	if journey.Progress != nil {
+		scheduled.Progress = *journey.Progress
+	}
+	for _, m := range journey.Milestones {
+		due, ok := targetPeriodOf(m.TargetPeriod)
+		if !ok {
+			continue
+		}
+		scheduled.Milestones = append(scheduled.Milestones, RoadmapMilestone{
+			ID: m.ID, Label: m.Label, Status: m.Status, Due: due.EndDate(),
+		})
+	}
+	return scheduled, true
+}
+
+func targetPeriodOf(dto *readmodels.TargetPeriodDTO) (valueobjects.TargetPeriod, bool) {
+	if dto == nil {
+		return valueobjects.TargetPeriod{}, false
+	}
+	period, err := valueobjects.NewTargetPeriod(dto.Year, dto.Quarter)
+	if err != nil {
+		return valueobjects.TargetPeriod{}, false
+	}
+	return period, true
+}
+
+func dateOnly(t time.Time) time.Time {
+	utc := t.UTC()
+	return time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func sortRoadmap(journeys []RoadmapJourney) {
+	sort.SliceStable(journeys, func(i, j int) bool {
+		if !journeys[i].Start.Equal(journeys[j].Start) {
+			return journeys[i].Start.Before(journeys[j].Start)
+		}
+		if !journeys[i].Finish.Equal(journeys[j].Finish) {
+			return journeys[i].Finish.Before(journeys[j].Finish)
+		}
+		return journeys[i].CapabilityName < journeys[j].CapabilityName
+	})
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRoadmapJourneys []readmodels.CapabilityJourneyDTO
+
+func (s stubRoadmapJourneys) GetAllCurrent(context.Context) ([]readmodels.CapabilityJourneyDTO, error) {
+	return s, nil
+}
+
+type stubRoadmapProgrammes map[string]*readmodels.JourneyProgrammeDTO
+
+func (s stubRoadmapProgrammes) GetByID(_ context.Context, id string) (*readmodels.JourneyProgrammeDTO, error) {
+	return s[id], nil
+}
+
+func roadmapJourney(id, kind, status string, period *readmodels.TargetPeriodDTO) readmodels.CapabilityJourneyDTO {
+	return readmodels.CapabilityJourneyDTO{
+		ID: id, CapabilityID: "cap-" + id, CapabilityName: "Capability " + id,
+		Kind: kind, Status: status, TargetPeriod: period,
+	}
+}
+
+func TestJourneyRoadmapQuery_SchedulesActiveJourneysAndCountsUnscheduled(t *testing.T) {
+	startedAt := time.Date(2026, time.February, 10, 14, 30, 0, 0, time.UTC)
+	inFlight := roadmapJourney("a", "migration", "in-flight", &readmodels.TargetPeriodDTO{Year: 2026, Quarter: 3})
+	inFlight.StartedAt = &startedAt
+	inFlight.Milestones = []readmodels.CapabilityJourneyMilestoneDTO{
+		{ID: "m1", Label: "Cutover", Status: "planned", TargetPeriod: &readmodels.TargetPeriodDTO{Year: 2026, Quarter: 2}},
+		{ID: "m2", Label: "Undated", Status: "planned"},
+	}
+	query := NewJourneyRoadmapQuery(stubRoadmapJourneys{
+		inFlight,
+		roadmapJourney("b", "consolidation", "planned", &readmodels.TargetPeriodDTO{Year: 2026, Quarter: 1}),
+		roadmapJourney("c", "migration", "planned", nil),
+		roadmapJourney("d", "migration", "done", &readmodels.TargetPeriodDTO{Year: 2025, Quarter: 4}),
+	}, stubRoadmapProgrammes{}, nil, nil)
+
+	roadmap, err := query.Execute(context.Background(), JourneyRoadmapRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, roadmap.Journeys, 2)
+	assert.Equal(t, "b", roadmap.Journeys[0].ID)
+	assert.Equal(t, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), roadmap.Journeys[0].Start)
+	assert.Equal(t, time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC), roadmap.Journeys[0].Finish)
+
+	started := roadmap.Journeys[1]
+	assert.Equal(t, time.Date(2026, time.February, 10, 0, 0, 0, 0, time.UTC), started.Start)
+	assert.Equal(t, time.Date(2026, time.September, 30, 0, 0, 0, 0, time.UTC), started.Finish)
+	require.Len(t, started.Milestones, 1)
+	assert.Equal(t, time.Date(2026, time.June, 30, 0, 0, 0, 0, time.UTC), started.Milestones[0].Due)
+	assert.Equal(t, 1, roadmap.UnscheduledCount)
+}
+
+func TestJourneyRoadmapQuery_FiltersByKindProgrammeAndDomain(t *testing.T) {
+	period := &readmodels.TargetPeriodDTO{Year: 2027, Quarter: 1}
+	journeys := stubRoadmapJourneys{
+		roadmapJourney("a", "migration", "planned", period),
+		roadmapJourney("b", "migration", "planned", period),
+		roadmapJourney("c", "carve-out", "planned", period),
+	}
+	programmes := stubRoadmapProgrammes{"p1": {ID: "p1", Name: "Core", Journeys: []readmodels.ProgrammeJourneyDTO{{JourneyID: "a"}, {JourneyID: "c"}}}}
+	inDomain := services.CapabilityEffectivelyInDomain(func(_ context.Context, capabilityID, _ string) (bool, error) {
+		return capabilityID != "cap-b", nil
+	})
+	query := NewJourneyRoadmapQuery(journeys, programmes, nil, inDomain)
+	migration, err := valueobjects.NewJourneyKind("migration")
+	require.NoError(t, err)
+
+	byKind, err := query.Execute(context.Background(), JourneyRoadmapRequest{Kinds: []valueobjects.JourneyKind{migration}})
+	require.NoError(t, err)
+	assert.Len(t, byKind.Journeys, 2)
+
+	byProgramme, err := query.Execute(context.Background(), JourneyRoadmapRequest{ProgrammeID: "p1", Kinds: []valueobjects.JourneyKind{migration}})
+	require.NoError(t, err)
+	require.Len(t, byProgramme.Journeys, 1)
+	assert.Equal(t, "a", byProgramme.Journeys[0].ID)
+	assert.Equal(t, "Core", byProgramme.ProgrammeName)
+
+	byDomain, err := query.Execute(context.Background(), JourneyRoadmapRequest{BusinessDomainID: "dom-1"})
+	require.NoError(t, err)
+	assert.Len(t, byDomain.Journeys, 2)
+}
+
+func TestJourneyRoadmapQuery_UnknownProgramme_ReturnsNotFound(t *testing.T) {
+	query := NewJourneyRoadmapQuery(stubRoadmapJourneys{}, stubRoadmapProgrammes{}, nil, nil)
+
+	_, err := query.Execute(context.Background(), JourneyRoadmapRequest{ProgrammeID: "missing"})
+
+	assert.ErrorIs(t, err, services.ErrReferencedEntityNotFound)
+}
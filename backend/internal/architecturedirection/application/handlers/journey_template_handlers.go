@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type JourneyTemplateRepository interface {
+	Save(ctx context.Context, t *aggregates.JourneyTemplate) error
+	GetByID(ctx context.Context, id string) (*aggregates.JourneyTemplate, error)
+}
+
+type CreateJourneyTemplateHandler struct {
+	repo JourneyTemplateRepository
+}
+
+func NewCreateJourneyTemplateHandler(repo JourneyTemplateRepository) *CreateJourneyTemplateHandler {
+	return &CreateJourneyTemplateHandler{repo: repo}
+}
+
+func (h *CreateJourneyTemplateHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.CreateJourneyTemplate)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	kind, err := valueobjects.NewJourneyKind(command.Kind)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	name, milestones, err := parseJourneyTemplateVersion(command.Name, command.Milestones)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	template, err := aggregates.NewJourneyTemplate(aggregates.JourneyTemplateFacts{
+		ID:         valueobjects.NewJourneyTemplateID(),
+		Kind:       kind,
+		Name:       name,
+		Milestones: milestones,
+		CreatedBy:  command.Actor,
+	})
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, template); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.NewResult(template.ID()), nil
+}
+
+type journeyTemplateMutationHandler[T cqrs.Command] struct {
+	repo         JourneyTemplateRepository
+	templateIDOf func(T) string
+	apply        func(T, *aggregates.JourneyTemplate) error
+}
+
+func (h *journeyTemplateMutationHandler[T]) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(T)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	template, err := h.repo.GetByID(ctx, h.templateIDOf(command))
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.apply(command, template); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, template); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.EmptyResult(), nil
+}
+
+func NewReviseJourneyTemplateHandler(repo JourneyTemplateRepository) cqrs.CommandHandler {
+	return &journeyTemplateMutationHandler[*commands.ReviseJourneyTemplate]{
+		repo:         repo,
+		templateIDOf: func(c *commands.ReviseJourneyTemplate) string { return c.TemplateID },
+		apply: func(c *commands.ReviseJourneyTemplate, t *aggregates.JourneyTemplate) error {
+			name, milestones, err := parseJourneyTemplateVersion(c.Name, c.Milestones)
+			if err != nil {
+				return err
+			}
+			return t.Revise(name, milestones, c.Actor)
+		},
+	}
+}
+
+func NewRetireJourneyTemplateHandler(repo JourneyTemplateRepository) cqrs.CommandHandler {
+	return &journeyTemplateMutationHandler[*commands.RetireJourneyTemplate]{
+		repo:         repo,
+		templateIDOf: func(c *commands.RetireJourneyTemplate) string { return c.TemplateID },
+		apply: func(c *commands.RetireJourneyTemplate, t *aggregates.JourneyTemplate) error {
+			return t.Retire(c.Actor)
+		},
+	}
+}
+
+func parseJourneyTemplateVersion(rawName string, inputs []commands.TemplateMilestoneInput) (valueobjects.JourneyTemplateName, []valueobjects.TemplateMilestone, error) {
+	name, err := valueobjects.NewJourneyTemplateName(rawName)
+	if err != nil {
+		return valueobjects.JourneyTemplateName{}, nil, err
+	}
+	milestones := make([]valueobjects.TemplateMilestone, len(inputs))
+	for i, input := range inputs {
+		m, err := valueobjects.NewTemplateMilestone(input.Label, input.OffsetQuarters)
+		if err != nil {
+			return valueobjects.JourneyTemplateName{}, nil, err
+		}
+		milestones[i] = m
+	}
+	return name, milestones, nil
+}
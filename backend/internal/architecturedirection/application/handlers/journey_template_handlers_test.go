@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockJourneyTemplateRepository struct {
+	saved  []*aggregates.JourneyTemplate
+	loaded *aggregates.JourneyTemplate
+}
+
+func (m *mockJourneyTemplateRepository) Save(_ context.Context, t *aggregates.JourneyTemplate) error {
+	m.saved = append(m.saved, t)
+	return nil
+}
+
+func (m *mockJourneyTemplateRepository) GetByID(_ context.Context, _ string) (*aggregates.JourneyTemplate, error) {
+	return m.loaded, nil
+}
+
+func migrationTemplateCmd() *commands.CreateJourneyTemplate {
+	return &commands.CreateJourneyTemplate{
+		Kind: valueobjects.JourneyKindMigration,
+		Name: "SaaS migration",
+		Milestones: []commands.TemplateMilestoneInput{
+			{Label: "Vendor selection", OffsetQuarters: 1},
+			{Label: "Data migration", OffsetQuarters: 2},
+			{Label: "Parallel run", OffsetQuarters: 3},
+			{Label: "Decommission", OffsetQuarters: 4},
+		},
+		Actor: "architect@example.com",
+	}
+}
+
+func journeyTemplateFixture(t *testing.T) *aggregates.JourneyTemplate {
+	t.Helper()
+	repo := &mockJourneyTemplateRepository{}
+	_, err := NewCreateJourneyTemplateHandler(repo).Handle(context.Background(), migrationTemplateCmd())
+	require.NoError(t, err)
+	template := repo.saved[0]
+	template.MarkChangesAsCommitted()
+	return template
+}
+
+func TestCreateJourneyTemplateHandler_ReturnsCreatedID(t *testing.T) {
+	repo := &mockJourneyTemplateRepository{}
+
+	result, err := NewCreateJourneyTemplateHandler(repo).Handle(context.Background(), migrationTemplateCmd())
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, repo.saved[0].ID(), result.CreatedID)
+	assert.Len(t, repo.saved[0].Milestones(), 4)
+}
+
+func TestCreateJourneyTemplateHandler_InvalidInput_Fails(t *testing.T) {
+	cases := []struct {
+		name   string
+		mutate func(*commands.CreateJourneyTemplate)
+		want   error
+	}{
+		{"unknown kind", func(c *commands.CreateJourneyTemplate) { c.Kind = "rewrite" }, valueobjects.ErrInvalidJourneyKind},
+		{"blank name", func(c *commands.CreateJourneyTemplate) { c.Name = " " }, valueobjects.ErrJourneyTemplateNameRequired},
+		{"negative offset", func(c *commands.CreateJourneyTemplate) { c.Milestones[0].OffsetQuarters = -1 }, valueobjects.ErrInvalidTemplateMilestoneOffset},
+		{"no milestones", func(c *commands.CreateJourneyTemplate) { c.Milestones = nil }, aggregates.ErrJourneyTemplateHasNoMilestones},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &mockJourneyTemplateRepository{}
+			cmd := migrationTemplateCmd()
+			tc.mutate(cmd)
+
+			_, err := NewCreateJourneyTemplateHandler(repo).Handle(context.Background(), cmd)
+
+			assert.ErrorIs(t, err, tc.want)
+			assert.Empty(t, repo.saved)
+		})
+	}
+}
+
+func TestReviseJourneyTemplateHandler_SavesNewVersion(t *testing.T) {
+	repo := &mockJourneyTemplateRepository{loaded: journeyTemplateFixture(t)}
+
+	_, err := NewReviseJourneyTemplateHandler(repo).Handle(context.Background(), &commands.ReviseJourneyTemplate{
+		TemplateID: repo.loaded.ID(),
+		Name:       "SaaS migration",
+		Milestones: []commands.TemplateMilestoneInput{{Label: "Cut-over", OffsetQuarters: 2}},
+		Actor:      "architect@example.com",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, 2, repo.saved[0].Version())
+}
+
+func TestRetireJourneyTemplateHandler_RetiresOnce(t *testing.T) {
+	repo := &mockJourneyTemplateRepository{loaded: journeyTemplateFixture(t)}
+	cmd := &commands.RetireJourneyTemplate{TemplateID: repo.loaded.ID(), Actor: "architect@example.com"}
+
+	_, err := NewRetireJourneyTemplateHandler(repo).Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	assert.True(t, repo.loaded.IsRetired())
+
+	_, err = NewRetireJourneyTemplateHandler(repo).Handle(context.Background(), cmd)
+	assert.ErrorIs(t, err, aggregates.ErrJourneyTemplateRetired)
+}
+
+func TestPlanJourneyHandler_FromTemplate_SeedsMilestonesRelativeToPlanningQuarter(t *testing.T) {
+	journeys := &mockCapabilityJourneyRepository{}
+	templates := &mockJourneyTemplateRepository{loaded: journeyTemplateFixture(t)}
+	plannedOn := time.Date(2026, time.November, 3, 0, 0, 0, 0, time.UTC)
+	h := NewPlanJourneyHandler(journeys, &mockActiveJourneyLookup{}, allExistingRefs(), templates, fixedNow(plannedOn))
+	cmd := validPlanJourneyCmd()
+	cmd.TemplateID = templates.loaded.ID()
+
+	_, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.Len(t, journeys.saved, 1)
+	journey := journeys.saved[0]
+	assert.Equal(t, &aggregates.JourneyTemplateOrigin{ID: templates.loaded.ID(), Version: 1}, journey.Template())
+	milestones := journey.Milestones()
+	require.Len(t, milestones, 4)
+	assert.Equal(t, "Vendor selection", milestones[0].Label())
+	assert.Equal(t, 2027, milestones[0].TargetPeriod().Year())
+	assert.Equal(t, 1, milestones[0].TargetPeriod().Quarter())
+	assert.Equal(t, "Decommission", milestones[3].Label())
+	assert.Equal(t, 4, milestones[3].TargetPeriod().Quarter())
+	assert.Equal(t, valueobjects.MilestoneStatusPlanned, milestones[3].Status().Value())
+}
+
+func TestPlanJourneyHandler_FromTemplateOfOtherKind_Fails(t *testing.T) {
+	journeys := &mockCapabilityJourneyRepository{}
+	templates := &mockJourneyTemplateRepository{loaded: journeyTemplateFixture(t)}
+	h := NewPlanJourneyHandler(journeys, &mockActiveJourneyLookup{}, allExistingRefs(), templates, time.Now)
+	cmd := validPlanJourneyCmd()
+	cmd.Kind = valueobjects.JourneyKindConsolidation
+	cmd.TemplateID = templates.loaded.ID()
+
+	_, err := h.Handle(context.Background(), cmd)
+
+	assert.ErrorIs(t, err, aggregates.ErrJourneyTemplateKindMismatch)
+	assert.Empty(t, journeys.saved)
+}
+
+func TestPlanJourneyHandler_RevisedTemplateDoesNotTouchEarlierJourney(t *testing.T) {
+	journeys := &mockCapabilityJourneyRepository{}
+	templates := &mockJourneyTemplateRepository{loaded: journeyTemplateFixture(t)}
+	h := NewPlanJourneyHandler(journeys, &mockActiveJourneyLookup{}, allExistingRefs(), templates, time.Now)
+	cmd := validPlanJourneyCmd()
+	cmd.TemplateID = templates.loaded.ID()
+	_, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	_, err = NewReviseJourneyTemplateHandler(templates).Handle(context.Background(), &commands.ReviseJourneyTemplate{
+		TemplateID: templates.loaded.ID(),
+		Name:       "SaaS migration",
+		Milestones: []commands.TemplateMilestoneInput{{Label: "Cut-over", OffsetQuarters: 2}},
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, journeys.saved[0].Milestones(), 4)
+	assert.Equal(t, 1, journeys.saved[0].Template().Version)
+}
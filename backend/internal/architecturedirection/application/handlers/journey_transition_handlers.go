@@ -32,12 +32,41 @@ func (h *journeyMutationHandler[T]) Handle(ctx context.Context, cmd cqrs.Command
 	return cqrs.EmptyResult(), nil
 }
 
-func NewStartJourneyHandler(repo CapabilityJourneyRepository) cqrs.CommandHandler {
-	return &journeyMutationHandler[*commands.StartJourney]{
-		repo:        repo,
-		journeyIDOf: func(c *commands.StartJourney) string { return c.JourneyID },
-		apply:       func(c *commands.StartJourney, j *aggregates.CapabilityJourney) error { return j.Start(c.Actor) },
+type UnmetPredecessorLookup interface {
+	UnmetPredecessorIDs(ctx context.Context, journeyID string) ([]string, error)
+}
+
+// StartJourneyHandler never refuses a start because of dependencies; it hands the
+// unmet predecessors to the aggregate so the start is recorded with a warning.
+type StartJourneyHandler struct {
+	repo         CapabilityJourneyRepository
+	dependencies UnmetPredecessorLookup
+}
+
+func NewStartJourneyHandler(repo CapabilityJourneyRepository, dependencies UnmetPredecessorLookup) *StartJourneyHandler {
+	return &StartJourneyHandler{repo: repo, dependencies: dependencies}
+}
+
+func (h *StartJourneyHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.StartJourney)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
 	}
+	unmet, err := h.dependencies.UnmetPredecessorIDs(ctx, command.JourneyID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	journey, err := h.repo.GetByID(ctx, command.JourneyID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := journey.Start(command.Actor, unmet...); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, journey); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.EmptyResult(), nil
 }
 
 func NewCompleteJourneyHandler(repo CapabilityJourneyRepository) cqrs.CommandHandler {
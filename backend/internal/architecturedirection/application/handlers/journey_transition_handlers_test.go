@@ -7,6 +7,7 @@ import (
 
 	"easi/backend/internal/architecturedirection/application/commands"
 	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/events"
 	"easi/backend/internal/architecturedirection/domain/valueobjects"
 
 	"github.com/google/uuid"
@@ -55,7 +56,7 @@ func TestJourneyTransitionHandlers_ValidTransition_Succeeds(t *testing.T) {
 			name:  "start from planned",
 			setup: func(*testing.T, *aggregates.CapabilityJourney) {},
 			handle: func(repo *mockCapabilityJourneyRepository, journeyID string) error {
-				_, err := NewStartJourneyHandler(repo).Handle(context.Background(), &commands.StartJourney{JourneyID: journeyID, Actor: "a@example.com"})
+				_, err := NewStartJourneyHandler(repo, &stubUnmetPredecessorLookup{}).Handle(context.Background(), &commands.StartJourney{JourneyID: journeyID, Actor: "a@example.com"})
 				return err
 			},
 			wantStatus: valueobjects.JourneyStatusInFlight,
@@ -112,7 +113,7 @@ func TestJourneyTransitionHandlers_ValidTransition_Succeeds(t *testing.T) {
 func TestStartJourneyHandler_NotFound_Fails(t *testing.T) {
 	repo := &mockCapabilityJourneyRepository{getErr: errors.New("not found")}
 
-	_, err := NewStartJourneyHandler(repo).Handle(context.Background(), &commands.StartJourney{JourneyID: uuid.New().String()})
+	_, err := NewStartJourneyHandler(repo, &stubUnmetPredecessorLookup{}).Handle(context.Background(), &commands.StartJourney{JourneyID: uuid.New().String()})
 
 	assert.Error(t, err)
 	assert.Empty(t, repo.saved)
@@ -142,6 +143,41 @@ func TestAbandonJourneyHandler_AlreadyTerminal_Fails(t *testing.T) {
 
 func TestJourneyTransitionHandlers_InvalidCommandType_Fails(t *testing.T) {
 	repo := &mockCapabilityJourneyRepository{}
-	_, err := NewStartJourneyHandler(repo).Handle(context.Background(), &commands.CompleteJourney{})
+	_, err := NewStartJourneyHandler(repo, &stubUnmetPredecessorLookup{}).Handle(context.Background(), &commands.CompleteJourney{})
 	assert.Error(t, err)
 }
+
+type stubUnmetPredecessorLookup struct {
+	unmet []string
+	err   error
+}
+
+func (s *stubUnmetPredecessorLookup) UnmetPredecessorIDs(_ context.Context, _ string) ([]string, error) {
+	return s.unmet, s.err
+}
+
+func TestStartJourneyHandler_UnmetPredecessors_StartsWithWarning(t *testing.T) {
+	j := plannedJourneyFixture(t)
+	repo := &mockCapabilityJourneyRepository{loaded: j}
+	predecessorID := uuid.New().String()
+
+	_, err := NewStartJourneyHandler(repo, &stubUnmetPredecessorLookup{unmet: []string{predecessorID}}).
+		Handle(context.Background(), &commands.StartJourney{JourneyID: j.ID(), Actor: "a@example.com"})
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, valueobjects.JourneyStatusInFlight, repo.saved[0].Status().Value())
+	started, ok := repo.saved[0].GetUncommittedChanges()[0].(events.JourneyStarted)
+	require.True(t, ok)
+	assert.Equal(t, []string{predecessorID}, started.UnmetPredecessorIDs)
+}
+
+func TestStartJourneyHandler_LookupFails_DoesNotStart(t *testing.T) {
+	repo := &mockCapabilityJourneyRepository{loaded: plannedJourneyFixture(t)}
+
+	_, err := NewStartJourneyHandler(repo, &stubUnmetPredecessorLookup{err: errors.New("db down")}).
+		Handle(context.Background(), &commands.StartJourney{JourneyID: uuid.New().String(), Actor: "a@example.com"})
+
+	assert.Error(t, err)
+	assert.Empty(t, repo.saved)
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"time"
 
 	"easi/backend/internal/architecturedirection/application/commands"
 	"easi/backend/internal/architecturedirection/domain/aggregates"
@@ -9,6 +10,8 @@ import (
 	"easi/backend/internal/architecturedirection/domain/valueobjects"
 	"easi/backend/internal/shared/cqrs"
 	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+
+	"github.com/google/uuid"
 )
 
 type CapabilityJourneyRepository interface {
@@ -20,14 +23,20 @@ type ActiveJourneyLookup interface {
 	FindActiveJourneyIDForCapability(ctx context.Context, capabilityID string) (string, bool, error)
 }
 
+type JourneyTemplateLookup interface {
+	GetByID(ctx context.Context, id string) (*aggregates.JourneyTemplate, error)
+}
+
 type PlanJourneyHandler struct {
-	repo   CapabilityJourneyRepository
-	lookup ActiveJourneyLookup
-	refs   JourneyReferenceChecks
+	repo      CapabilityJourneyRepository
+	lookup    ActiveJourneyLookup
+	refs      JourneyReferenceChecks
+	templates JourneyTemplateLookup
+	now       func() time.Time
 }
 
-func NewPlanJourneyHandler(repo CapabilityJourneyRepository, lookup ActiveJourneyLookup, refs JourneyReferenceChecks) *PlanJourneyHandler {
-	return &PlanJourneyHandler{repo: repo, lookup: lookup, refs: refs}
+func NewPlanJourneyHandler(repo CapabilityJourneyRepository, lookup ActiveJourneyLookup, refs JourneyReferenceChecks, templates JourneyTemplateLookup, now func() time.Time) *PlanJourneyHandler {
+	return &PlanJourneyHandler{repo: repo, lookup: lookup, refs: refs, templates: templates, now: now}
 }
 
 func (h *PlanJourneyHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
@@ -45,6 +54,9 @@ func (h *PlanJourneyHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs
 	if err := h.verifyReferences(ctx, facts); err != nil {
 		return cqrs.EmptyResult(), err
 	}
+	if err := h.seedFromTemplate(ctx, command, &facts); err != nil {
+		return cqrs.EmptyResult(), err
+	}
 	journey, err := aggregates.PlanCapabilityJourney(facts)
 	if err != nil {
 		return cqrs.EmptyResult(), err
@@ -66,6 +78,43 @@ func (h *PlanJourneyHandler) ensureNoActiveJourney(ctx context.Context, capabili
 	return nil
 }
 
+// seedFromTemplate copies the current version of the requested template into
+// the journey's milestones, targeted relative to the quarter it is planned in.
+func (h *PlanJourneyHandler) seedFromTemplate(ctx context.Context, cmd *commands.PlanJourney, facts *aggregates.CapabilityJourneyFacts) error {
+	if cmd.TemplateID == "" {
+		return nil
+	}
+	template, err := h.templates.GetByID(ctx, cmd.TemplateID)
+	if err != nil {
+		return err
+	}
+	plannedIn, err := valueobjects.TargetPeriodContaining(h.now())
+	if err != nil {
+		return err
+	}
+	seeded, err := template.Seed(facts.Kind, plannedIn)
+	if err != nil {
+		return err
+	}
+	status, err := valueobjects.NewMilestoneStatus(valueobjects.MilestoneStatusPlanned)
+	if err != nil {
+		return err
+	}
+	facts.Template = &aggregates.JourneyTemplateOrigin{ID: template.ID(), Version: template.Version()}
+	facts.Milestones = make([]aggregates.MilestoneFacts, len(seeded))
+	for i, m := range seeded {
+		target := m.TargetPeriod
+		facts.Milestones[i] = aggregates.MilestoneFacts{
+			MilestoneID:  uuid.New().String(),
+			Label:        m.Label,
+			TargetPeriod: &target,
+			Status:       status,
+			Actor:        cmd.PlannedBy,
+		}
+	}
+	return nil
+}
+
 func (h *PlanJourneyHandler) verifyReferences(ctx context.Context, facts aggregates.CapabilityJourneyFacts) error {
 	if err := requireCapabilityExists(ctx, h.refs.CapabilityExists, facts.CapabilityID.Value()); err != nil {
 		return err
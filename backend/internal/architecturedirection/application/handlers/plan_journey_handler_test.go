@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"easi/backend/internal/architecturedirection/application/commands"
 	"easi/backend/internal/architecturedirection/domain/aggregates"
@@ -76,7 +77,7 @@ func validPlanJourneyCmd() *commands.PlanJourney {
 func TestPlanJourneyHandler_ValidMigration_CreatesJourney(t *testing.T) {
 	repo := &mockCapabilityJourneyRepository{}
 	lookup := &mockActiveJourneyLookup{exists: false}
-	h := NewPlanJourneyHandler(repo, lookup, allExistingRefs())
+	h := NewPlanJourneyHandler(repo, lookup, allExistingRefs(), nil, time.Now)
 
 	cmd := validPlanJourneyCmd()
 	result, err := h.Handle(context.Background(), cmd)
@@ -91,7 +92,7 @@ func TestPlanJourneyHandler_ActiveJourneyExists_ReturnsErrorCarryingExistingID(t
 	repo := &mockCapabilityJourneyRepository{}
 	existingID := uuid.New().String()
 	lookup := &mockActiveJourneyLookup{id: existingID, exists: true}
-	h := NewPlanJourneyHandler(repo, lookup, allExistingRefs())
+	h := NewPlanJourneyHandler(repo, lookup, allExistingRefs(), nil, time.Now)
 
 	_, err := h.Handle(context.Background(), validPlanJourneyCmd())
 
@@ -107,7 +108,7 @@ func TestPlanJourneyHandler_CapabilityDoesNotExist_Fails(t *testing.T) {
 	lookup := &mockActiveJourneyLookup{exists: false}
 	refs := allExistingRefs()
 	refs.CapabilityExists = neverExists
-	h := NewPlanJourneyHandler(repo, lookup, refs)
+	h := NewPlanJourneyHandler(repo, lookup, refs, nil, time.Now)
 
 	_, err := h.Handle(context.Background(), validPlanJourneyCmd())
 
@@ -131,7 +132,7 @@ func TestPlanJourneyHandler_ComponentDoesNotExist_Fails(t *testing.T) {
 			missingComponentID := tc.missingCompFn(cmd)
 			refs := allExistingRefs()
 			refs.ComponentExists = func(_ context.Context, id string) (bool, error) { return id != missingComponentID, nil }
-			h := NewPlanJourneyHandler(repo, lookup, refs)
+			h := NewPlanJourneyHandler(repo, lookup, refs, nil, time.Now)
 
 			_, err := h.Handle(context.Background(), cmd)
 
@@ -155,7 +156,7 @@ func TestPlanJourneyHandler_KindCardinalityViolation_Fails(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			repo := &mockCapabilityJourneyRepository{}
 			lookup := &mockActiveJourneyLookup{exists: false}
-			h := NewPlanJourneyHandler(repo, lookup, allExistingRefs())
+			h := NewPlanJourneyHandler(repo, lookup, allExistingRefs(), nil, time.Now)
 			cmd := validPlanJourneyCmd()
 			cmd.Kind = tc.kind
 			ids := make([]string, tc.sources)
@@ -196,7 +197,7 @@ func TestPlanJourneyHandler_AggregateInvariantViolations_Fail(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			repo := &mockCapabilityJourneyRepository{}
 			lookup := &mockActiveJourneyLookup{exists: false}
-			h := NewPlanJourneyHandler(repo, lookup, allExistingRefs())
+			h := NewPlanJourneyHandler(repo, lookup, allExistingRefs(), nil, time.Now)
 			cmd := validPlanJourneyCmd()
 			tc.mutate(cmd)
 
@@ -224,7 +225,7 @@ func TestPlanJourneyHandler_ValidMove_VerifiesDomainAndParent(t *testing.T) {
 		checkedCapability, checkedDomain = capabilityID, domainID
 		return true, nil
 	}
-	h := NewPlanJourneyHandler(repo, lookup, refs)
+	h := NewPlanJourneyHandler(repo, lookup, refs, nil, time.Now)
 
 	_, err := h.Handle(context.Background(), cmd)
 
@@ -246,7 +247,7 @@ func TestPlanJourneyHandler_MoveParentNotEffectivelyInDomain_Fails(t *testing.T)
 
 	refs := allExistingRefs()
 	refs.CapabilityEffectivelyInDomain = func(_ context.Context, _, _ string) (bool, error) { return false, nil }
-	h := NewPlanJourneyHandler(repo, lookup, refs)
+	h := NewPlanJourneyHandler(repo, lookup, refs, nil, time.Now)
 
 	_, err := h.Handle(context.Background(), cmd)
 
@@ -257,7 +258,7 @@ func TestPlanJourneyHandler_MoveParentNotEffectivelyInDomain_Fails(t *testing.T)
 func TestPlanJourneyHandler_LookupError_Fails(t *testing.T) {
 	repo := &mockCapabilityJourneyRepository{}
 	lookup := &mockActiveJourneyLookup{err: errors.New("db down")}
-	h := NewPlanJourneyHandler(repo, lookup, allExistingRefs())
+	h := NewPlanJourneyHandler(repo, lookup, allExistingRefs(), nil, time.Now)
 
 	_, err := h.Handle(context.Background(), validPlanJourneyCmd())
 
@@ -268,7 +269,7 @@ func TestPlanJourneyHandler_LookupError_Fails(t *testing.T) {
 func TestPlanJourneyHandler_InvalidCommandType_Fails(t *testing.T) {
 	repo := &mockCapabilityJourneyRepository{}
 	lookup := &mockActiveJourneyLookup{}
-	h := NewPlanJourneyHandler(repo, lookup, allExistingRefs())
+	h := NewPlanJourneyHandler(repo, lookup, allExistingRefs(), nil, time.Now)
 
 	_, err := h.Handle(context.Background(), &commands.StartJourney{})
 
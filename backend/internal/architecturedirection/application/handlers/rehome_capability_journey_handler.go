@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+// RehomeCapabilityJourneyHandler carries an active journey over to the capability
+// that replaces its own. When the replacement already has an active journey, that
+// one wins and the orphaned journey is left to go stale with its capability.
+type RehomeCapabilityJourneyHandler struct {
+	repo   CapabilityJourneyRepository
+	lookup ActiveJourneyLookup
+}
+
+func NewRehomeCapabilityJourneyHandler(repo CapabilityJourneyRepository, lookup ActiveJourneyLookup) *RehomeCapabilityJourneyHandler {
+	return &RehomeCapabilityJourneyHandler{repo: repo, lookup: lookup}
+}
+
+func (h *RehomeCapabilityJourneyHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.RehomeCapabilityJourney)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	journeyID, exists, err := h.lookup.FindActiveJourneyIDForCapability(ctx, command.FromCapabilityID)
+	if err != nil || !exists {
+		return cqrs.EmptyResult(), err
+	}
+	existingID, targetHasJourney, err := h.lookup.FindActiveJourneyIDForCapability(ctx, command.ToCapabilityID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if targetHasJourney {
+		log.Printf("Capability %s already has active journey %s; journey %s stays on %s",
+			command.ToCapabilityID, existingID, journeyID, command.FromCapabilityID)
+		return cqrs.EmptyResult(), nil
+	}
+
+	target, err := valueobjects.NewPhysicalCapabilityRef(command.ToCapabilityID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	journey, err := h.repo.GetByID(ctx, journeyID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := journey.ChangeCapability(target, command.Reason, command.Actor); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, journey); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.NewResult(journeyID), nil
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type capabilityJourneyLookup struct {
+	byCapability map[string]string
+}
+
+func (m *capabilityJourneyLookup) FindActiveJourneyIDForCapability(_ context.Context, capabilityID string) (string, bool, error) {
+	id, ok := m.byCapability[capabilityID]
+	return id, ok, nil
+}
+
+func TestRehomeCapabilityJourneyHandler_MovesActiveJourney(t *testing.T) {
+	journey := plannedJourneyFixture(t)
+	fromID, toID := journey.CapabilityID().Value(), uuid.New().String()
+	repo := &mockCapabilityJourneyRepository{loaded: journey}
+	lookup := &capabilityJourneyLookup{byCapability: map[string]string{fromID: journey.ID()}}
+
+	result, err := NewRehomeCapabilityJourneyHandler(repo, lookup).Handle(context.Background(), &commands.RehomeCapabilityJourney{
+		FromCapabilityID: fromID, ToCapabilityID: toID, Reason: "merged", Actor: "architect@example.com",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, toID, repo.saved[0].CapabilityID().Value())
+	assert.Equal(t, journey.ID(), result.CreatedID)
+}
+
+func TestRehomeCapabilityJourneyHandler_TargetHasActiveJourney_LeavesJourney(t *testing.T) {
+	journey := plannedJourneyFixture(t)
+	fromID, toID := journey.CapabilityID().Value(), uuid.New().String()
+	repo := &mockCapabilityJourneyRepository{loaded: journey}
+	lookup := &capabilityJourneyLookup{byCapability: map[string]string{fromID: journey.ID(), toID: uuid.New().String()}}
+
+	_, err := NewRehomeCapabilityJourneyHandler(repo, lookup).Handle(context.Background(), &commands.RehomeCapabilityJourney{
+		FromCapabilityID: fromID, ToCapabilityID: toID, Reason: "merged",
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, repo.saved)
+}
+
+func TestRehomeCapabilityJourneyHandler_NoActiveJourney_NoOp(t *testing.T) {
+	repo := &mockCapabilityJourneyRepository{}
+	lookup := &capabilityJourneyLookup{byCapability: map[string]string{}}
+
+	_, err := NewRehomeCapabilityJourneyHandler(repo, lookup).Handle(context.Background(), &commands.RehomeCapabilityJourney{
+		FromCapabilityID: uuid.New().String(), ToCapabilityID: uuid.New().String(), Reason: "split",
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, repo.saved)
+}
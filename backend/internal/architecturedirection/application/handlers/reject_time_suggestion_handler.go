@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+type TimeSuggestionRejectionRepository interface {
+	Save(ctx context.Context, r *aggregates.TimeSuggestionRejection) error
+	GetByID(ctx context.Context, id string) (*aggregates.TimeSuggestionRejection, error)
+}
+
+type RejectTimeSuggestionHandler struct {
+	repo   TimeSuggestionRejectionRepository
+	lookup ExistingTimeAssessmentLookup
+}
+
+func NewRejectTimeSuggestionHandler(repo TimeSuggestionRejectionRepository, lookup ExistingTimeAssessmentLookup) *RejectTimeSuggestionHandler {
+	return &RejectTimeSuggestionHandler{repo: repo, lookup: lookup}
+}
+
+func (h *RejectTimeSuggestionHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.RejectTimeSuggestion)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	facts, err := parseRejectTimeSuggestion(command)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	existingID, exists, err := h.lookup.FindAggregateIDForPair(ctx, command.CapabilityID, command.ComponentID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if !exists {
+		rejection, err := aggregates.NewTimeSuggestionRejection(facts)
+		if err != nil {
+			return cqrs.EmptyResult(), err
+		}
+		return h.save(ctx, rejection)
+	}
+	rejection, err := h.repo.GetByID(ctx, existingID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := rejection.Reject(facts.SuggestedGrade, facts.EvidenceFingerprint, facts.Reason, facts.RejectedBy); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return h.save(ctx, rejection)
+}
+
+func (h *RejectTimeSuggestionHandler) save(ctx context.Context, rejection *aggregates.TimeSuggestionRejection) (cqrs.CommandResult, error) {
+	if err := h.repo.Save(ctx, rejection); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.NewResult(rejection.ID()), nil
+}
+
+func parseRejectTimeSuggestion(command *commands.RejectTimeSuggestion) (aggregates.TimeSuggestionRejectionFacts, error) {
+	capability, err := valueobjects.NewPhysicalCapabilityRef(command.CapabilityID)
+	if err != nil {
+		return aggregates.TimeSuggestionRejectionFacts{}, err
+	}
+	component, err := valueobjects.NewApplicationRef(command.ComponentID)
+	if err != nil {
+		return aggregates.TimeSuggestionRejectionFacts{}, err
+	}
+	grade, err := valueobjects.NewTimeGrade(command.SuggestedGrade)
+	if err != nil {
+		return aggregates.TimeSuggestionRejectionFacts{}, err
+	}
+	reason, err := sharedvo.NewDescription(command.Reason)
+	if err != nil {
+		return aggregates.TimeSuggestionRejectionFacts{}, err
+	}
+	return aggregates.TimeSuggestionRejectionFacts{
+		CapabilityID:        capability,
+		ComponentID:         component,
+		SuggestedGrade:      grade,
+		EvidenceFingerprint: command.EvidenceFingerprint,
+		Reason:              reason,
+		RejectedBy:          command.RejectedBy,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTimeSuggestionRejectionRepository struct {
+	saved  []*aggregates.TimeSuggestionRejection
+	loaded *aggregates.TimeSuggestionRejection
+}
+
+func (m *mockTimeSuggestionRejectionRepository) Save(_ context.Context, r *aggregates.TimeSuggestionRejection) error {
+	m.saved = append(m.saved, r)
+	return nil
+}
+
+func (m *mockTimeSuggestionRejectionRepository) GetByID(_ context.Context, _ string) (*aggregates.TimeSuggestionRejection, error) {
+	return m.loaded, nil
+}
+
+func validRejectSuggestionCmd() *commands.RejectTimeSuggestion {
+	return &commands.RejectTimeSuggestion{
+		CapabilityID:        uuid.New().String(),
+		ComponentID:         uuid.New().String(),
+		SuggestedGrade:      valueobjects.TimeGradeEliminate,
+		EvidenceFingerprint: "fp-1",
+		Reason:              "replacement is already contracted",
+		RejectedBy:          "architect@example.com",
+	}
+}
+
+func TestRejectTimeSuggestionHandler_FirstRejection_CreatesAggregate(t *testing.T) {
+	repo := &mockTimeSuggestionRejectionRepository{}
+	handler := NewRejectTimeSuggestionHandler(repo, &mockExistingTimeAssessmentLookup{})
+
+	result, err := handler.Handle(context.Background(), validRejectSuggestionCmd())
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, repo.saved[0].ID(), result.CreatedID)
+	assert.Equal(t, "fp-1", repo.saved[0].EvidenceFingerprint())
+}
+
+func TestRejectTimeSuggestionHandler_LaterRejection_ReplacesRememberedSuggestion(t *testing.T) {
+	first := validRejectSuggestionCmd()
+	existing, err := aggregates.NewTimeSuggestionRejection(aggregates.TimeSuggestionRejectionFacts{
+		CapabilityID:        mustPhysicalCapabilityRef(t, first.CapabilityID),
+		ComponentID:         mustNewApplicationRef(t, first.ComponentID),
+		SuggestedGrade:      mustTimeGrade(t, valueobjects.TimeGradeMigrate),
+		EvidenceFingerprint: "fp-0",
+		RejectedBy:          "a@example.com",
+	})
+	require.NoError(t, err)
+	existing.MarkChangesAsCommitted()
+	repo := &mockTimeSuggestionRejectionRepository{loaded: existing}
+	handler := NewRejectTimeSuggestionHandler(repo, &mockExistingTimeAssessmentLookup{id: existing.ID(), exists: true})
+
+	result, err := handler.Handle(context.Background(), first)
+
+	require.NoError(t, err)
+	assert.Equal(t, existing.ID(), result.CreatedID)
+	assert.Equal(t, "fp-1", existing.EvidenceFingerprint())
+	assert.Equal(t, valueobjects.TimeGradeEliminate, existing.SuggestedGrade().Value())
+}
+
+func TestRejectTimeSuggestionHandler_InvalidGrade_FailsWithoutSaving(t *testing.T) {
+	repo := &mockTimeSuggestionRejectionRepository{}
+	handler := NewRejectTimeSuggestionHandler(repo, &mockExistingTimeAssessmentLookup{})
+	cmd := validRejectSuggestionCmd()
+	cmd.SuggestedGrade = "eliminate"
+
+	_, err := handler.Handle(context.Background(), cmd)
+
+	assert.ErrorIs(t, err, valueobjects.ErrInvalidTimeGrade)
+	assert.Empty(t, repo.saved)
+}
+
+func mustTimeGrade(t *testing.T, v string) valueobjects.TimeGrade {
+	t.Helper()
+	g, err := valueobjects.NewTimeGrade(v)
+	require.NoError(t, err)
+	return g
+}
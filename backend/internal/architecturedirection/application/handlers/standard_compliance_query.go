@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/services"
+)
+
+type StandardExceptionReader interface {
+	GetAll(ctx context.Context) ([]readmodels.StandardExceptionDTO, error)
+}
+
+type StandardComplianceSources struct {
+	Landscape  services.CurrentLandscapeSource
+	Journeys   RoadmapJourneyReader
+	Roles      TargetStateRoleReader
+	Directions TargetStateDirectionReader
+	Exceptions StandardExceptionReader
+}
+
+// StandardComplianceQuery checks today's direct realisations against the
+// standards recorded by realisation roles and agreed directions. With a
+// business domain, only capabilities effectively in that domain are checked.
+type StandardComplianceQuery struct {
+	sources      StandardComplianceSources
+	domainExists services.DomainExists
+	now          func() time.Time
+}
+
+func NewStandardComplianceQuery(sources StandardComplianceSources, domainExists services.DomainExists, now func() time.Time) *StandardComplianceQuery {
+	return &StandardComplianceQuery{sources: sources, domainExists: domainExists, now: now}
+}
+
+func (q *StandardComplianceQuery) Execute(ctx context.Context, businessDomainID string) (*readmodels.StandardComplianceReport, error) {
+	if businessDomainID != "" && q.domainExists != nil {
+		if err := requireDomainExists(ctx, q.domainExists, businessDomainID); err != nil {
+			return nil, err
+		}
+	}
+	inputs, err := q.loadInputs(ctx, businessDomainID)
+	if err != nil {
+		return nil, err
+	}
+	report := readmodels.BuildStandardCompliance(inputs)
+	return &report, nil
+}
+
+func (q *StandardComplianceQuery) loadInputs(ctx context.Context, businessDomainID string) (readmodels.StandardComplianceInputs, error) {
+	inputs := readmodels.StandardComplianceInputs{Now: q.now().UTC()}
+	landscape, err := q.sources.Landscape.GetCurrentLandscape(ctx)
+	if err != nil {
+		return inputs, err
+	}
+	for _, capability := range landscape {
+		if businessDomainID == "" || capability.BusinessDomainID == businessDomainID {
+			inputs.Landscape = append(inputs.Landscape, capability)
+		}
+	}
+	if inputs.Roles, err = q.sources.Roles.GetAll(ctx); err != nil {
+		return inputs, err
+	}
+	if inputs.Directions, err = q.sources.Directions.GetAgreedDirectionStandards(ctx); err != nil {
+		return inputs, err
+	}
+	if inputs.Journeys, err = q.sources.Journeys.GetAllCurrent(ctx); err != nil {
+		return inputs, err
+	}
+	inputs.Exceptions, err = q.sources.Exceptions.GetAll(ctx)
+	return inputs, err
+}
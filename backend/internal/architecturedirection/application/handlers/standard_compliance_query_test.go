@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubStandardExceptions []readmodels.StandardExceptionDTO
+
+func (s stubStandardExceptions) GetAll(context.Context) ([]readmodels.StandardExceptionDTO, error) {
+	return s, nil
+}
+
+func newStandardComplianceFixture(domainExists services.DomainExists) *StandardComplianceQuery {
+	return NewStandardComplianceQuery(StandardComplianceSources{
+		Landscape: stubLandscape{
+			{ID: "cap-1", BusinessDomainID: "dom-1", Applications: []services.LandscapeApplication{{ID: "app-std"}, {ID: "app-old"}}},
+			{ID: "cap-2", BusinessDomainID: "dom-2", Applications: []services.LandscapeApplication{{ID: "app-std"}, {ID: "app-other"}}},
+		},
+		Journeys: stubRoadmapJourneys{},
+		Roles: stubTargetRoles{
+			{CapabilityID: "cap-1", ComponentID: "app-std", Role: valueobjects.RealizationRoleStandard},
+			{CapabilityID: "cap-2", ComponentID: "app-std", Role: valueobjects.RealizationRoleStandard},
+		},
+		Directions: stubDirectionStandards{},
+		Exceptions: stubStandardExceptions{{
+			ID: "exc-1", CapabilityID: "cap-1", ComponentID: "app-old",
+			ExpiresOn: time.Date(2026, time.April, 30, 0, 0, 0, 0, time.UTC),
+		}},
+	}, domainExists, fixedNow(time.Date(2026, time.May, 15, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestStandardComplianceQuery_ReportsFindingsAndExpiredExceptionSignals(t *testing.T) {
+	report, err := newStandardComplianceFixture(nil).Execute(context.Background(), "")
+
+	require.NoError(t, err)
+	require.Len(t, report.Capabilities, 2)
+	assert.Equal(t, readmodels.ComplianceSummaryDTO{NonCompliant: 1, ExceptionExpired: 1}, report.Summary)
+	require.Len(t, report.Signals, 1)
+	assert.Equal(t, "exc-1", report.Signals[0].ExceptionID)
+}
+
+func TestStandardComplianceQuery_FiltersByBusinessDomain(t *testing.T) {
+	report, err := newStandardComplianceFixture(nil).Execute(context.Background(), "dom-2")
+
+	require.NoError(t, err)
+	require.Len(t, report.Capabilities, 1)
+	assert.Equal(t, "cap-2", report.Capabilities[0].CapabilityID)
+	assert.Empty(t, report.Signals)
+}
+
+func TestStandardComplianceQuery_UnknownDomain_Fails(t *testing.T) {
+	missingDomain := services.DomainExists(func(context.Context, string) (bool, error) { return false, nil })
+
+	_, err := newStandardComplianceFixture(missingDomain).Execute(context.Background(), "dom-x")
+
+	assert.ErrorIs(t, err, services.ErrReferencedEntityNotFound)
+}
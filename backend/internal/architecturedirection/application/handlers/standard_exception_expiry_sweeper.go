@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/services"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+type ExceptionsDueToExpire interface {
+	GetIDsDueToExpire(ctx context.Context, now time.Time) ([]string, error)
+}
+
+type ExpiryCommandDispatcher interface {
+	Dispatch(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error)
+}
+
+// StandardExceptionExpirySweeper records the expiry of every approved exception
+// whose date has passed, so StandardExceptionExpired is published once per
+// approval instead of expiry only being worked out when the register is read.
+type StandardExceptionExpirySweeper struct {
+	tenants  services.ActiveTenants
+	due      ExceptionsDueToExpire
+	commands ExpiryCommandDispatcher
+	now      func() time.Time
+}
+
+func NewStandardExceptionExpirySweeper(
+	tenants services.ActiveTenants,
+	due ExceptionsDueToExpire,
+	commandBus ExpiryCommandDispatcher,
+	now func() time.Time,
+) *StandardExceptionExpirySweeper {
+	return &StandardExceptionExpirySweeper{tenants: tenants, due: due, commands: commandBus, now: now}
+}
+
+// Run sweeps once straight away and then on every interval until ctx ends.
+func (s *StandardExceptionExpirySweeper) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Sweep(ctx); err != nil {
+			log.Printf("architecturedirection: standard exception expiry sweep: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep expires the due exceptions of every active tenant. A failure in one
+// tenant or exception does not stop the others; the errors are returned
+// together and the next sweep retries what is still due.
+func (s *StandardExceptionExpirySweeper) Sweep(ctx context.Context) error {
+	tenantIDs, err := s.tenants(ctx)
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	var errs []error
+	for _, id := range tenantIDs {
+		tenantID, err := sharedvo.NewTenantID(id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", id, err))
+			continue
+		}
+		if err := s.sweepTenant(sharedctx.WithTenant(ctx, tenantID), now); err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *StandardExceptionExpirySweeper) sweepTenant(ctx context.Context, now time.Time) error {
+	exceptionIDs, err := s.due.GetIDsDueToExpire(ctx, now)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, id := range exceptionIDs {
+		if _, err := s.commands.Dispatch(ctx, &commands.ExpireStandardException{ExceptionID: id, Now: now}); err != nil {
+			errs = append(errs, fmt.Errorf("exception %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
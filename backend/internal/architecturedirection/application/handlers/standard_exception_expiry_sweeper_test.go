@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type dueExceptionsByTenant map[string][]string
+
+func (d dueExceptionsByTenant) GetIDsDueToExpire(ctx context.Context, _ time.Time) ([]string, error) {
+	tenant, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d[tenant.Value()], nil
+}
+
+type recordingExpiryDispatcher struct {
+	expired []string
+	failFor string
+}
+
+func (r *recordingExpiryDispatcher) Dispatch(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	expire := cmd.(*commands.ExpireStandardException)
+	tenant, _ := sharedctx.GetTenant(ctx)
+	if expire.ExceptionID == r.failFor {
+		return cqrs.EmptyResult(), errors.New("event store unavailable")
+	}
+	r.expired = append(r.expired, tenant.Value()+"/"+expire.ExceptionID)
+	return cqrs.EmptyResult(), nil
+}
+
+func TestStandardExceptionExpirySweeper_ExpiresDueExceptionsOfEveryTenant(t *testing.T) {
+	now := time.Date(2027, 3, 1, 6, 0, 0, 0, time.UTC)
+	tenants := func(context.Context) ([]string, error) { return []string{"acme", "globex"}, nil }
+	dispatcher := &recordingExpiryDispatcher{failFor: "exc-2"}
+	sweeper := NewStandardExceptionExpirySweeper(tenants,
+		dueExceptionsByTenant{"acme": {"exc-1", "exc-2"}, "globex": {"exc-3"}},
+		dispatcher, func() time.Time { return now })
+
+	err := sweeper.Sweep(context.Background())
+
+	require.Error(t, err, "the failed exception is reported for the next sweep to retry")
+	assert.Contains(t, err.Error(), "exc-2")
+	assert.Equal(t, []string{"acme/exc-1", "globex/exc-3"}, dispatcher.expired)
+}
+
+func TestStandardExceptionExpirySweeper_TenantLookupFailureStopsTheSweep(t *testing.T) {
+	tenants := func(context.Context) ([]string, error) { return nil, errors.New("platform database down") }
+	dispatcher := &recordingExpiryDispatcher{}
+	sweeper := NewStandardExceptionExpirySweeper(tenants, dueExceptionsByTenant{}, dispatcher, time.Now)
+
+	assert.Error(t, sweeper.Sweep(context.Background()))
+	assert.Empty(t, dispatcher.expired)
+}
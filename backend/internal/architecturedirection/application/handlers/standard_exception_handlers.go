@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+var ErrStandardExceptionAlreadyGranted = errors.New("an exception is already registered for this application on this capability")
+
+type StandardExceptionRepository interface {
+	Save(ctx context.Context, e *aggregates.StandardException) error
+	GetByID(ctx context.Context, id string) (*aggregates.StandardException, error)
+}
+
+type StandardExceptionPairLookup interface {
+	FindUnrevokedExceptionIDForPair(ctx context.Context, capabilityID, componentID string) (string, bool, error)
+}
+
+// GrantStandardExceptionHandler registers an exception for an existing direct
+// realisation. A pair with an unrevoked exception, expired or not, must have
+// it renewed rather than granted again.
+type GrantStandardExceptionHandler struct {
+	repo              StandardExceptionRepository
+	pairs             StandardExceptionPairLookup
+	directRealization services.DirectRealizationLookup
+}
+
+func NewGrantStandardExceptionHandler(
+	repo StandardExceptionRepository,
+	pairs StandardExceptionPairLookup,
+	directRealization services.DirectRealizationLookup,
+) *GrantStandardExceptionHandler {
+	return &GrantStandardExceptionHandler{repo: repo, pairs: pairs, directRealization: directRealization}
+}
+
+func (h *GrantStandardExceptionHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.GrantStandardException)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	facts, err := parseGrantStandardException(command)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.verifyGrantable(ctx, facts.CapabilityID.Value(), facts.ComponentID.Value()); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	exception, err := aggregates.NewStandardException(facts)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, exception); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.NewResult(exception.ID()), nil
+}
+
+func (h *GrantStandardExceptionHandler) verifyGrantable(ctx context.Context, capabilityID, componentID string) error {
+	if _, exists, err := h.directRealization(ctx, capabilityID, componentID); err != nil {
+		return err
+	} else if !exists {
+		return services.ErrReferencedEntityNotFound
+	}
+	_, granted, err := h.pairs.FindUnrevokedExceptionIDForPair(ctx, capabilityID, componentID)
+	if err != nil {
+		return err
+	}
+	if granted {
+		return ErrStandardExceptionAlreadyGranted
+	}
+	return nil
+}
+
+func parseGrantStandardException(command *commands.GrantStandardException) (aggregates.StandardExceptionFacts, error) {
+	capability, err := valueobjects.NewPhysicalCapabilityRef(command.CapabilityID)
+	if err != nil {
+		return aggregates.StandardExceptionFacts{}, err
+	}
+	component, err := valueobjects.NewApplicationRef(command.ComponentID)
+	if err != nil {
+		return aggregates.StandardExceptionFacts{}, err
+	}
+	justification, expiresOn, err := parseExceptionApproval(command.Justification, command.ExpiresOn)
+	if err != nil {
+		return aggregates.StandardExceptionFacts{}, err
+	}
+	return aggregates.StandardExceptionFacts{
+		ID:            valueobjects.NewStandardExceptionID(),
+		CapabilityID:  capability,
+		ComponentID:   component,
+		Justification: justification,
+		ApproverID:    command.ApproverID,
+		ExpiresOn:     expiresOn,
+		GrantedBy:     command.Actor,
+	}, nil
+}
+
+type standardExceptionMutationHandler[T cqrs.Command] struct {
+	repo          StandardExceptionRepository
+	exceptionIDOf func(T) string
+	apply         func(T, *aggregates.StandardException) error
+}
+
+func (h *standardExceptionMutationHandler[T]) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(T)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	exception, err := h.repo.GetByID(ctx, h.exceptionIDOf(command))
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.apply(command, exception); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, exception); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.EmptyResult(), nil
+}
+
+func NewRenewStandardExceptionHandler(repo StandardExceptionRepository) cqrs.CommandHandler {
+	return &standardExceptionMutationHandler[*commands.RenewStandardException]{
+		repo:          repo,
+		exceptionIDOf: func(c *commands.RenewStandardException) string { return c.ExceptionID },
+		apply: func(c *commands.RenewStandardException, e *aggregates.StandardException) error {
+			justification, expiresOn, err := parseExceptionApproval(c.Justification, c.ExpiresOn)
+			if err != nil {
+				return err
+			}
+			return e.Renew(justification, c.ApproverID, expiresOn, c.Actor)
+		},
+	}
+}
+
+func NewRevokeStandardExceptionHandler(repo StandardExceptionRepository) cqrs.CommandHandler {
+	return &standardExceptionMutationHandler[*commands.RevokeStandardException]{
+		repo:          repo,
+		exceptionIDOf: func(c *commands.RevokeStandardException) string { return c.ExceptionID },
+		apply: func(c *commands.RevokeStandardException, e *aggregates.StandardException) error {
+			return e.Revoke(c.Actor)
+		},
+	}
+}
+
+func NewExpireStandardExceptionHandler(repo StandardExceptionRepository) cqrs.CommandHandler {
+	return &standardExceptionMutationHandler[*commands.ExpireStandardException]{
+		repo:          repo,
+		exceptionIDOf: func(c *commands.ExpireStandardException) string { return c.ExceptionID },
+		apply: func(c *commands.ExpireStandardException, e *aggregates.StandardException) error {
+			return e.Expire(c.Now)
+		},
+	}
+}
+
+func parseExceptionApproval(rawJustification string, rawExpiresOn time.Time) (valueobjects.ExceptionJustification, valueobjects.ExceptionExpiry, error) {
+	justification, err := valueobjects.NewExceptionJustification(rawJustification)
+	if err != nil {
+		return valueobjects.ExceptionJustification{}, valueobjects.ExceptionExpiry{}, err
+	}
+	expiresOn, err := valueobjects.NewExceptionExpiry(rawExpiresOn)
+	if err != nil {
+		return valueobjects.ExceptionJustification{}, valueobjects.ExceptionExpiry{}, err
+	}
+	return justification, expiresOn, nil
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStandardExceptionRepository struct {
+	saved  []*aggregates.StandardException
+	loaded *aggregates.StandardException
+}
+
+func (m *mockStandardExceptionRepository) Save(_ context.Context, e *aggregates.StandardException) error {
+	m.saved = append(m.saved, e)
+	return nil
+}
+
+func (m *mockStandardExceptionRepository) GetByID(_ context.Context, _ string) (*aggregates.StandardException, error) {
+	return m.loaded, nil
+}
+
+type stubStandardExceptionPairs struct{ exceptionID string }
+
+func (s stubStandardExceptionPairs) FindUnrevokedExceptionIDForPair(_ context.Context, _, _ string) (string, bool, error) {
+	return s.exceptionID, s.exceptionID != "", nil
+}
+
+func grantExceptionCmd() *commands.GrantStandardException {
+	return &commands.GrantStandardException{
+		CapabilityID:  uuid.New().String(),
+		ComponentID:   uuid.New().String(),
+		Justification: "Contract runs until next year",
+		ApproverID:    "cio@example.com",
+		ExpiresOn:     time.Now().UTC().AddDate(0, 6, 0),
+		Actor:         "a@example.com",
+	}
+}
+
+func TestGrantStandardExceptionHandler(t *testing.T) {
+	cases := []struct {
+		name    string
+		direct  services.DirectRealizationLookup
+		pairs   stubStandardExceptionPairs
+		mutate  func(*commands.GrantStandardException)
+		wantErr error
+	}{
+		{name: "direct realisation without exception", direct: alwaysDirect("real-1")},
+		{name: "no direct realisation", direct: neverDirect(), wantErr: services.ErrReferencedEntityNotFound},
+		{
+			name: "pair already excepted", direct: alwaysDirect("real-1"),
+			pairs: stubStandardExceptionPairs{exceptionID: uuid.New().String()}, wantErr: ErrStandardExceptionAlreadyGranted,
+		},
+		{
+			name: "blank justification", direct: alwaysDirect("real-1"),
+			mutate:  func(c *commands.GrantStandardException) { c.Justification = " " },
+			wantErr: valueobjects.ErrExceptionJustificationRequired,
+		},
+		{
+			name: "expiry in the past", direct: alwaysDirect("real-1"),
+			mutate:  func(c *commands.GrantStandardException) { c.ExpiresOn = time.Now().UTC().AddDate(0, 0, -2) },
+			wantErr: aggregates.ErrExceptionExpiryInPast,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &mockStandardExceptionRepository{}
+			cmd := grantExceptionCmd()
+			if tc.mutate != nil {
+				tc.mutate(cmd)
+			}
+
+			result, err := NewGrantStandardExceptionHandler(repo, tc.pairs, tc.direct).Handle(context.Background(), cmd)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, repo.saved)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, repo.saved, 1)
+			assert.Equal(t, repo.saved[0].ID(), result.CreatedID)
+			assert.Equal(t, cmd.ComponentID, repo.saved[0].ComponentID().Value())
+		})
+	}
+}
+
+func TestRenewAndRevokeStandardExceptionHandlers(t *testing.T) {
+	repo := &mockStandardExceptionRepository{}
+	_, err := NewGrantStandardExceptionHandler(repo, stubStandardExceptionPairs{}, alwaysDirect("real-1")).
+		Handle(context.Background(), grantExceptionCmd())
+	require.NoError(t, err)
+	exception := repo.saved[0]
+	repo.loaded = exception
+	newExpiry := time.Now().UTC().AddDate(1, 0, 0)
+
+	_, err = NewRenewStandardExceptionHandler(repo).Handle(context.Background(), &commands.RenewStandardException{
+		ExceptionID: exception.ID(), Justification: "Migration slipped", ApproverID: "cto@example.com",
+		ExpiresOn: newExpiry, Actor: "a@example.com",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "cto@example.com", exception.ApproverID())
+
+	_, err = NewRevokeStandardExceptionHandler(repo).Handle(context.Background(),
+		&commands.RevokeStandardException{ExceptionID: exception.ID(), Actor: "a@example.com"})
+	require.NoError(t, err)
+	assert.True(t, exception.IsRevoked())
+	assert.Len(t, repo.saved, 3)
+}
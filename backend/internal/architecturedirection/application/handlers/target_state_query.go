@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+)
+
+// TargetStateNextQuarters is how far the "next" horizon reaches beyond the
+// current quarter.
+const TargetStateNextQuarters = 4
+
+var (
+	ErrTargetQuarterInPast        = errors.New("a target quarter cannot be before the current quarter")
+	ErrTargetHorizonAndQuarterSet = errors.New("choose either a horizon or a target quarter, not both")
+)
+
+type TargetStateRoleReader interface {
+	GetAll(ctx context.Context) ([]readmodels.RealizationRoleDTO, error)
+}
+
+type TargetStateDirectionReader interface {
+	GetAgreedDirectionStandards(ctx context.Context) ([]readmodels.DirectionStandardDTO, error)
+}
+
+// TargetStateRequest picks how far ahead to look, either as a horizon or as a
+// target quarter. With neither, every planned change is applied.
+type TargetStateRequest struct {
+	Horizon          *valueobjects.Horizon
+	TargetQuarter    *valueobjects.TargetPeriod
+	BusinessDomainID string
+}
+
+type TargetState struct {
+	Horizon string
+	// Through is the last quarter whose journeys are applied; nil for the
+	// later horizon, which also applies journeys without a target period.
+	Through      *readmodels.TargetPeriodDTO
+	Capabilities []readmodels.TargetCapabilityDTO
+}
+
+// TargetStateQuery projects the landscape forward. Active journeys due by
+// the cut-off quarter and agreed directions whose horizon has been reached
+// are applied to today's capabilities and realisations. The now horizon ends
+// with the current quarter and next reaches TargetStateNextQuarters further;
+// a target quarter reaches the horizon it falls in. The business domain
+// filter applies to where capabilities end up, so arriving moves are
+// included and departing ones are not.
+type TargetStateQuery struct {
+	landscape    services.CurrentLandscapeSource
+	journeys     RoadmapJourneyReader
+	roles        TargetStateRoleReader
+	directions   TargetStateDirectionReader
+	domainExists services.DomainExists
+	now          func() time.Time
+}
+
+type TargetStateSources struct {
+	Landscape  services.CurrentLandscapeSource
+	Journeys   RoadmapJourneyReader
+	Roles      TargetStateRoleReader
+	Directions TargetStateDirectionReader
+}
+
+func NewTargetStateQuery(sources TargetStateSources, domainExists services.DomainExists, now func() time.Time) *TargetStateQuery {
+	return &TargetStateQuery{
+		landscape:    sources.Landscape,
+		journeys:     sources.Journeys,
+		roles:        sources.Roles,
+		directions:   sources.Directions,
+		domainExists: domainExists,
+		now:          now,
+	}
+}
+
+func (q *TargetStateQuery) Execute(ctx context.Context, req TargetStateRequest) (*TargetState, error) {
+	horizon, cutoff, err := targetStateReach(req, q.now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if req.BusinessDomainID != "" && q.domainExists != nil {
+		if err := requireDomainExists(ctx, q.domainExists, req.BusinessDomainID); err != nil {
+			return nil, err
+		}
+	}
+	inputs, err := q.loadInputs(ctx, horizon, cutoff)
+	if err != nil {
+		return nil, err
+	}
+	state := &TargetState{Horizon: horizon, Capabilities: []readmodels.TargetCapabilityDTO{}}
+	if cutoff != nil {
+		year, quarter := periodOfOrdinal(*cutoff)
+		state.Through = &readmodels.TargetPeriodDTO{Year: year, Quarter: quarter}
+	}
+	for _, capability := range readmodels.BuildTargetState(inputs) {
+		if req.BusinessDomainID == "" || capability.BusinessDomainID == req.BusinessDomainID {
+			state.Capabilities = append(state.Capabilities, capability)
+		}
+	}
+	return state, nil
+}
+
+func (q *TargetStateQuery) loadInputs(ctx context.Context, horizon string, cutoff *int) (readmodels.TargetStateInputs, error) {
+	var inputs readmodels.TargetStateInputs
+	var err error
+	if inputs.Landscape, err = q.landscape.GetCurrentLandscape(ctx); err != nil {
+		return inputs, err
+	}
+	if inputs.Roles, err = q.roles.GetAll(ctx); err != nil {
+		return inputs, err
+	}
+	directions, err := q.directions.GetAgreedDirectionStandards(ctx)
+	if err != nil {
+		return inputs, err
+	}
+	for _, direction := range directions {
+		if horizonRank(direction.Horizon) <= horizonRank(horizon) {
+			inputs.Directions = append(inputs.Directions, direction)
+		}
+	}
+	journeys, err := q.journeys.GetAllCurrent(ctx)
+	if err != nil {
+		return inputs, err
+	}
+	for _, journey := range journeys {
+		if journeyLandsBy(journey, cutoff) {
+			inputs.Journeys = append(inputs.Journeys, journey)
+		}
+	}
+	return inputs, nil
+}
+
+// targetStateReach turns the request into a horizon and the ordinal of the
+// last quarter to apply journeys from; a nil cut-off means no limit.
+func targetStateReach(req TargetStateRequest, now time.Time) (string, *int, error) {
+	current := quarterOrdinal(now.Year(), int(now.Month()-1)/3+1)
+	next := current + TargetStateNextQuarters
+	if req.TargetQuarter != nil {
+		if req.Horizon != nil {
+			return "", nil, ErrTargetHorizonAndQuarterSet
+		}
+		target := quarterOrdinal(req.TargetQuarter.Year(), req.TargetQuarter.Quarter())
+		switch {
+		case target < current:
+			return "", nil, ErrTargetQuarterInPast
+		case target == current:
+			return valueobjects.HorizonNow, &target, nil
+		case target <= next:
+			return valueobjects.HorizonNext, &target, nil
+		default:
+			return valueobjects.HorizonLater, &target, nil
+		}
+	}
+	if req.Horizon == nil {
+		return valueobjects.HorizonLater, nil, nil
+	}
+	switch req.Horizon.Value() {
+	case valueobjects.HorizonNow:
+		return valueobjects.HorizonNow, &current, nil
+	case valueobjects.HorizonNext:
+		return valueobjects.HorizonNext, &next, nil
+	default:
+		return valueobjects.HorizonLater, nil, nil
+	}
+}
+
+func journeyLandsBy(journey readmodels.CapabilityJourneyDTO, cutoff *int) bool {
+	status, err := valueobjects.NewJourneyStatus(journey.Status)
+	if err != nil || !status.IsActive() {
+		return false
+	}
+	if cutoff == nil {
+		return true
+	}
+	period, ok := targetPeriodOf(journey.TargetPeriod)
+	return ok && quarterOrdinal(period.Year(), period.Quarter()) <= *cutoff
+}
+
+func horizonRank(horizon string) int {
+	switch horizon {
+	case valueobjects.HorizonNow:
+		return 0
+	case valueobjects.HorizonNext:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func periodOfOrdinal(ordinal int) (int, int) {
+	return ordinal / 4, ordinal%4 + 1
+}
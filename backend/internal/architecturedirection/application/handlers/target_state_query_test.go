@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubLandscape []services.LandscapeCapability
+
+func (s stubLandscape) GetCurrentLandscape(context.Context) ([]services.LandscapeCapability, error) {
+	return s, nil
+}
+
+type stubTargetRoles []readmodels.RealizationRoleDTO
+
+func (s stubTargetRoles) GetAll(context.Context) ([]readmodels.RealizationRoleDTO, error) {
+	return s, nil
+}
+
+type stubDirectionStandards []readmodels.DirectionStandardDTO
+
+func (s stubDirectionStandards) GetAgreedDirectionStandards(context.Context) ([]readmodels.DirectionStandardDTO, error) {
+	return s, nil
+}
+
+func mustHorizon(t *testing.T, value string) *valueobjects.Horizon {
+	t.Helper()
+	horizon, err := valueobjects.NewHorizon(value)
+	require.NoError(t, err)
+	return &horizon
+}
+
+func migrationTo(id, status, toApp string, period *readmodels.TargetPeriodDTO) readmodels.CapabilityJourneyDTO {
+	journey := roadmapJourney(id, valueobjects.JourneyKindMigration, status, period)
+	journey.FromApplications = []readmodels.JourneyApplicationRefDTO{{ComponentID: "app-old"}}
+	journey.ToApplication = readmodels.JourneyApplicationRefDTO{ComponentID: toApp}
+	return journey
+}
+
+func newTargetStateFixture(domainExists services.DomainExists) *TargetStateQuery {
+	landscape := stubLandscape{}
+	for _, id := range []string{"soon", "next", "later", "unscheduled", "done"} {
+		landscape = append(landscape, services.LandscapeCapability{
+			ID: "cap-" + id, BusinessDomainID: "dom-1",
+			Applications: []services.LandscapeApplication{{ID: "app-old"}},
+		})
+	}
+	landscape = append(landscape, services.LandscapeCapability{
+		ID: "cap-legacy", BusinessDomainID: "dom-2", Applications: []services.LandscapeApplication{{ID: "app-legacy"}},
+	})
+	return NewTargetStateQuery(TargetStateSources{
+		Landscape: landscape,
+		Journeys: stubRoadmapJourneys{
+			migrationTo("soon", valueobjects.JourneyStatusInFlight, "app-soon", &readmodels.TargetPeriodDTO{Year: 2026, Quarter: 2}),
+			migrationTo("next", valueobjects.JourneyStatusPlanned, "app-next", &readmodels.TargetPeriodDTO{Year: 2027, Quarter: 2}),
+			migrationTo("later", valueobjects.JourneyStatusPlanned, "app-later", &readmodels.TargetPeriodDTO{Year: 2028, Quarter: 1}),
+			migrationTo("unscheduled", valueobjects.JourneyStatusPlanned, "app-unscheduled", nil),
+			migrationTo("done", valueobjects.JourneyStatusDone, "app-done", &readmodels.TargetPeriodDTO{Year: 2025, Quarter: 4}),
+		},
+		Roles: stubTargetRoles{{CapabilityID: "cap-legacy", ComponentID: "app-legacy", Role: valueobjects.RealizationRoleLegacy}},
+		Directions: stubDirectionStandards{{
+			DirectionID: "dir-1", Horizon: valueobjects.HorizonNext,
+			StandardApplicationID: "app-std", SourceCapabilityIDs: []string{"cap-legacy"},
+		}},
+	}, domainExists, fixedNow(time.Date(2026, time.May, 15, 0, 0, 0, 0, time.UTC)))
+}
+
+func targetApps(state *TargetState) map[string][]string {
+	apps := map[string][]string{}
+	for _, capability := range state.Capabilities {
+		ids := []string{}
+		for _, app := range capability.Applications {
+			ids = append(ids, app.ComponentID)
+		}
+		apps[capability.CapabilityID] = ids
+	}
+	return apps
+}
+
+func TestTargetStateQuery_HorizonsApplyJourneysAndDirectionsDueByThen(t *testing.T) {
+	query := newTargetStateFixture(nil)
+
+	now, err := query.Execute(context.Background(), TargetStateRequest{Horizon: mustHorizon(t, valueobjects.HorizonNow)})
+	require.NoError(t, err)
+	assert.Equal(t, &readmodels.TargetPeriodDTO{Year: 2026, Quarter: 2}, now.Through)
+	assert.Equal(t, map[string][]string{
+		"cap-soon": {"app-soon"}, "cap-next": {"app-old"}, "cap-later": {"app-old"},
+		"cap-unscheduled": {"app-old"}, "cap-done": {"app-old"}, "cap-legacy": {"app-legacy"},
+	}, targetApps(now))
+
+	next, err := query.Execute(context.Background(), TargetStateRequest{Horizon: mustHorizon(t, valueobjects.HorizonNext)})
+	require.NoError(t, err)
+	assert.Equal(t, &readmodels.TargetPeriodDTO{Year: 2027, Quarter: 2}, next.Through)
+	assert.Equal(t, []string{"app-next"}, targetApps(next)["cap-next"])
+	assert.Equal(t, []string{"app-old"}, targetApps(next)["cap-later"])
+	assert.Equal(t, []string{"app-std"}, targetApps(next)["cap-legacy"], "the next-horizon direction applies")
+
+	later, err := query.Execute(context.Background(), TargetStateRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, valueobjects.HorizonLater, later.Horizon)
+	assert.Nil(t, later.Through)
+	assert.Equal(t, []string{"app-later"}, targetApps(later)["cap-later"])
+	assert.Equal(t, []string{"app-unscheduled"}, targetApps(later)["cap-unscheduled"])
+	assert.Equal(t, []string{"app-old"}, targetApps(later)["cap-done"], "finished journeys are already in today's model")
+}
+
+func TestTargetStateQuery_TargetQuarterSetsCutOffAndHorizon(t *testing.T) {
+	query := newTargetStateFixture(nil)
+	quarter, err := valueobjects.NewTargetPeriod(2027, 1)
+	require.NoError(t, err)
+
+	state, err := query.Execute(context.Background(), TargetStateRequest{TargetQuarter: &quarter, BusinessDomainID: "dom-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, valueobjects.HorizonNext, state.Horizon)
+	assert.Equal(t, []string{"app-old"}, targetApps(state)["cap-next"], "due after the target quarter")
+	assert.Equal(t, []string{"app-soon"}, targetApps(state)["cap-soon"])
+	assert.NotContains(t, targetApps(state), "cap-legacy", "outside the business domain")
+}
+
+func TestTargetStateQuery_RejectsInvalidRequests(t *testing.T) {
+	missingDomain := services.DomainExists(func(context.Context, string) (bool, error) { return false, nil })
+	query := newTargetStateFixture(missingDomain)
+	past, err := valueobjects.NewTargetPeriod(2026, 1)
+	require.NoError(t, err)
+	future, err := valueobjects.NewTargetPeriod(2027, 1)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		req  TargetStateRequest
+		want error
+	}{
+		{"past quarter", TargetStateRequest{TargetQuarter: &past}, ErrTargetQuarterInPast},
+		{"horizon and quarter", TargetStateRequest{TargetQuarter: &future, Horizon: mustHorizon(t, valueobjects.HorizonNow)}, ErrTargetHorizonAndQuarterSet},
+		{"unknown domain", TargetStateRequest{BusinessDomainID: "dom-x"}, services.ErrReferencedEntityNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := query.Execute(context.Background(), tc.req)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+)
+
+const (
+	DefaultTimeGradeTrendQuarters = 8
+	MaxTimeGradeTrendQuarters     = 40
+)
+
+var (
+	ErrTrendRangeReversed   = errors.New("trend start quarter must not be after its end quarter")
+	ErrTrendRangeTooLong    = fmt.Errorf("a trend can span at most %d quarters", MaxTimeGradeTrendQuarters)
+	ErrTrendQuarterInFuture = errors.New("a trend cannot extend past the current quarter")
+)
+
+type TimeAssessmentHistoryReader interface {
+	GetAllHistory(ctx context.Context) ([]readmodels.TimeAssessmentHistoryEntryDTO, error)
+}
+
+// TimeGradeTrendRequest selects the quarters to report. A missing To means the
+// current quarter; a missing From means the DefaultTimeGradeTrendQuarters
+// quarters ending at To.
+type TimeGradeTrendRequest struct {
+	From             *valueobjects.TargetPeriod
+	To               *valueobjects.TargetPeriod
+	BusinessDomainID string
+}
+
+// TimeGradeTrendQuery counts, at each quarter end, how many realisations held
+// each TIME grade. The current quarter is reported as of now. Domain filtering
+// uses the capability's placement today, not where it sat at the time.
+type TimeGradeTrendQuery struct {
+	history      TimeAssessmentHistoryReader
+	domainExists services.DomainExists
+	inDomain     services.CapabilityEffectivelyInDomain
+	now          func() time.Time
+}
+
+func NewTimeGradeTrendQuery(
+	history TimeAssessmentHistoryReader,
+	domainExists services.DomainExists,
+	inDomain services.CapabilityEffectivelyInDomain,
+	now func() time.Time,
+) *TimeGradeTrendQuery {
+	return &TimeGradeTrendQuery{history: history, domainExists: domainExists, inDomain: inDomain, now: now}
+}
+
+func (q *TimeGradeTrendQuery) Execute(ctx context.Context, req TimeGradeTrendRequest) ([]readmodels.TimeGradeTrendPointDTO, error) {
+	now := q.now().UTC()
+	periods, err := trendPeriods(req, now)
+	if err != nil {
+		return nil, err
+	}
+	if req.BusinessDomainID != "" && q.domainExists != nil {
+		if err := requireDomainExists(ctx, q.domainExists, req.BusinessDomainID); err != nil {
+			return nil, err
+		}
+	}
+	history, err := q.history.GetAllHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	history, err = q.inBusinessDomain(ctx, history, req.BusinessDomainID)
+	if err != nil {
+		return nil, err
+	}
+	return readmodels.BuildTimeGradeTrend(history, periods), nil
+}
+
+func (q *TimeGradeTrendQuery) inBusinessDomain(ctx context.Context, history []readmodels.TimeAssessmentHistoryEntryDTO, domainID string) ([]readmodels.TimeAssessmentHistoryEntryDTO, error) {
+	if domainID == "" || q.inDomain == nil {
+		return history, nil
+	}
+	membership := map[string]bool{}
+	filtered := []readmodels.TimeAssessmentHistoryEntryDTO{}
+	for _, entry := range history {
+		inDomain, checked := membership[entry.CapabilityID]
+		if !checked {
+			var err error
+			if inDomain, err = q.inDomain(ctx, entry.CapabilityID, domainID); err != nil {
+				return nil, err
+			}
+			membership[entry.CapabilityID] = inDomain
+		}
+		if inDomain {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered, nil
+}
+
+func trendPeriods(req TimeGradeTrendRequest, now time.Time) ([]readmodels.TimeGradeTrendPointDTO, error) {
+	current := quarterOrdinal(now.Year(), int(now.Month()-1)/3+1)
+	to := current
+	if req.To != nil {
+		to = quarterOrdinal(req.To.Year(), req.To.Quarter())
+	}
+	from := to - DefaultTimeGradeTrendQuarters + 1
+	if req.From != nil {
+		from = quarterOrdinal(req.From.Year(), req.From.Quarter())
+	}
+	switch {
+	case to > current:
+		return nil, ErrTrendQuarterInFuture
+	case from > to:
+		return nil, ErrTrendRangeReversed
+	case to-from+1 > MaxTimeGradeTrendQuarters:
+		return nil, ErrTrendRangeTooLong
+	}
+
+	periods := make([]readmodels.TimeGradeTrendPointDTO, 0, to-from+1)
+	for ordinal := from; ordinal <= to; ordinal++ {
+		year, quarter := periodOfOrdinal(ordinal)
+		asOf := time.Date(year, time.Month(quarter*3+1), 1, 0, 0, 0, 0, time.UTC)
+		if asOf.After(now) {
+			asOf = now
+		}
+		periods = append(periods, readmodels.TimeGradeTrendPointDTO{Year: year, Quarter: quarter, AsOf: asOf})
+	}
+	return periods, nil
+}
+
+func quarterOrdinal(year, quarter int) int {
+	return year*4 + quarter - 1
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubAssessmentHistory []readmodels.TimeAssessmentHistoryEntryDTO
+
+func (s stubAssessmentHistory) GetAllHistory(context.Context) ([]readmodels.TimeAssessmentHistoryEntryDTO, error) {
+	return s, nil
+}
+
+func graded(assessmentID, capabilityID, grade string, at time.Time) readmodels.TimeAssessmentHistoryEntryDTO {
+	return readmodels.TimeAssessmentHistoryEntryDTO{TimeAssessmentID: assessmentID, CapabilityID: capabilityID, Grade: &grade, ChangedAt: at}
+}
+
+func mustTargetPeriod(t *testing.T, year, quarter int) *valueobjects.TargetPeriod {
+	t.Helper()
+	period, err := valueobjects.NewTargetPeriod(year, quarter)
+	require.NoError(t, err)
+	return &period
+}
+
+func fixedNow(at time.Time) func() time.Time {
+	return func() time.Time { return at }
+}
+
+func TestTimeGradeTrendQuery_DefaultsToRecentQuartersEndingNow(t *testing.T) {
+	now := time.Date(2026, time.May, 15, 12, 0, 0, 0, time.UTC)
+	query := NewTimeGradeTrendQuery(stubAssessmentHistory{
+		graded("ta-1", "cap-1", valueobjects.TimeGradeEliminate, time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)),
+		graded("ta-1", "cap-1", valueobjects.TimeGradeMigrate, time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)),
+	}, nil, nil, fixedNow(now))
+
+	trend, err := query.Execute(context.Background(), TimeGradeTrendRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, trend, DefaultTimeGradeTrendQuarters)
+	assert.Equal(t, 2024, trend[0].Year)
+	assert.Equal(t, 3, trend[0].Quarter)
+	last := trend[len(trend)-1]
+	assert.Equal(t, 2026, last.Year)
+	assert.Equal(t, 2, last.Quarter)
+	assert.Equal(t, now, last.AsOf, "the current quarter is reported as of now")
+	assert.Equal(t, readmodels.TimeGradeCounts{Migrate: 1}, last.Counts)
+	assert.Equal(t, readmodels.TimeGradeCounts{Eliminate: 1}, trend[len(trend)-2].Counts)
+}
+
+func TestTimeGradeTrendQuery_FiltersByBusinessDomain(t *testing.T) {
+	now := time.Date(2026, time.January, 10, 0, 0, 0, 0, time.UTC)
+	at := time.Date(2025, time.November, 1, 0, 0, 0, 0, time.UTC)
+	checks := 0
+	inDomain := services.CapabilityEffectivelyInDomain(func(_ context.Context, capabilityID, _ string) (bool, error) {
+		checks++
+		return capabilityID == "cap-in", nil
+	})
+	domainExists := services.DomainExists(func(context.Context, string) (bool, error) { return true, nil })
+	query := NewTimeGradeTrendQuery(stubAssessmentHistory{
+		graded("ta-1", "cap-in", valueobjects.TimeGradeEliminate, at),
+		graded("ta-2", "cap-in", valueobjects.TimeGradeTolerate, at),
+		graded("ta-3", "cap-out", valueobjects.TimeGradeEliminate, at),
+	}, domainExists, inDomain, fixedNow(now))
+
+	trend, err := query.Execute(context.Background(), TimeGradeTrendRequest{
+		From: mustTargetPeriod(t, 2025, 4), To: mustTargetPeriod(t, 2025, 4), BusinessDomainID: "dom-1",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, trend, 1)
+	assert.Equal(t, readmodels.TimeGradeCounts{Tolerate: 1, Eliminate: 1}, trend[0].Counts)
+	assert.Equal(t, 2, checks, "domain membership is checked once per capability")
+}
+
+func TestTimeGradeTrendQuery_RejectsInvalidRanges(t *testing.T) {
+	now := time.Date(2026, time.January, 10, 0, 0, 0, 0, time.UTC)
+	missingDomain := services.DomainExists(func(context.Context, string) (bool, error) { return false, nil })
+	query := NewTimeGradeTrendQuery(stubAssessmentHistory{}, missingDomain, nil, fixedNow(now))
+
+	cases := []struct {
+		name string
+		req  TimeGradeTrendRequest
+		want error
+	}{
+		{"future quarter", TimeGradeTrendRequest{To: mustTargetPeriod(t, 2026, 2)}, ErrTrendQuarterInFuture},
+		{"reversed", TimeGradeTrendRequest{From: mustTargetPeriod(t, 2025, 4), To: mustTargetPeriod(t, 2025, 2)}, ErrTrendRangeReversed},
+		{"too long", TimeGradeTrendRequest{From: mustTargetPeriod(t, 2010, 1)}, ErrTrendRangeTooLong},
+		{"unknown domain", TimeGradeTrendRequest{BusinessDomainID: "dom-x"}, services.ErrReferencedEntityNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := query.Execute(context.Background(), tc.req)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+const (
+	TimeSuggestionAccept   = "accept"
+	TimeSuggestionReject   = "reject"
+	TimeSuggestionOverride = "override"
+
+	MaxTimeSuggestionDecisions = 200
+)
+
+var (
+	ErrNoTimeSuggestionDecisions       = errors.New("at least one review decision is required")
+	ErrTooManyTimeSuggestionDecisions  = fmt.Errorf("at most %d review decisions can be applied at once", MaxTimeSuggestionDecisions)
+	ErrInvalidTimeSuggestionAction     = errors.New("review action must be one of accept, reject, override")
+	ErrOverrideGradeRequired           = errors.New("overriding a time suggestion requires a grade")
+	ErrDuplicateTimeSuggestionDecision = errors.New("each capability and component pair can only be reviewed once per request")
+	ErrTimeSuggestionNotPendingForPair = errors.New("no pending time suggestion exists for this capability and component pair")
+)
+
+type ReviewCommandDispatcher interface {
+	Dispatch(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error)
+}
+
+type CurrentTimeAssessmentReader interface {
+	GetAll(ctx context.Context) ([]readmodels.TimeAssessmentDTO, error)
+}
+
+type TimeSuggestionRejectionReader interface {
+	GetAll(ctx context.Context) ([]readmodels.TimeSuggestionRejectionDTO, error)
+}
+
+// PendingTimeSuggestion is a suggestion that disagrees with the realisation's
+// current grade and has not been rejected on the same evidence.
+// CurrentGrade is empty when the realisation has never been assessed.
+type PendingTimeSuggestion struct {
+	services.TimeSuggestion
+	CurrentGrade        string
+	EvidenceFingerprint string
+}
+
+// TimeSuggestionReviewQueue lists the computed TIME suggestions an architect
+// still has to act on.
+type TimeSuggestionReviewQueue struct {
+	suggestions services.TimeSuggestionSource
+	assessments CurrentTimeAssessmentReader
+	rejections  TimeSuggestionRejectionReader
+}
+
+func NewTimeSuggestionReviewQueue(
+	suggestions services.TimeSuggestionSource,
+	assessments CurrentTimeAssessmentReader,
+	rejections TimeSuggestionRejectionReader,
+) *TimeSuggestionReviewQueue {
+	return &TimeSuggestionReviewQueue{suggestions: suggestions, assessments: assessments, rejections: rejections}
+}
+
+type realizationPair struct {
+	capabilityID string
+	componentID  string
+}
+
+func (q *TimeSuggestionReviewQueue) Pending(ctx context.Context) ([]PendingTimeSuggestion, error) {
+	suggestions, err := q.suggestions.GetAllSuggestions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentGrades, err := q.currentGrades(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rejected, err := q.rejectedFingerprints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := []PendingTimeSuggestion{}
+	for _, s := range suggestions {
+		if s.SuggestedGrade == "" {
+			continue
+		}
+		pair := realizationPair{capabilityID: s.CapabilityID, componentID: s.ComponentID}
+		current := currentGrades[pair]
+		fingerprint := s.Fingerprint()
+		if current == s.SuggestedGrade || rejected[pair] == fingerprint {
+			continue
+		}
+		pending = append(pending, PendingTimeSuggestion{TimeSuggestion: s, CurrentGrade: current, EvidenceFingerprint: fingerprint})
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		if pending[i].CapabilityName != pending[j].CapabilityName {
+			return pending[i].CapabilityName < pending[j].CapabilityName
+		}
+		return pending[i].ComponentName < pending[j].ComponentName
+	})
+	return pending, nil
+}
+
+func (q *TimeSuggestionReviewQueue) currentGrades(ctx context.Context) (map[realizationPair]string, error) {
+	assessments, err := q.assessments.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	grades := make(map[realizationPair]string, len(assessments))
+	for _, a := range assessments {
+		grades[realizationPair{capabilityID: a.CapabilityID, componentID: a.ComponentID}] = a.Grade
+	}
+	return grades, nil
+}
+
+func (q *TimeSuggestionReviewQueue) rejectedFingerprints(ctx context.Context) (map[realizationPair]string, error) {
+	rejections, err := q.rejections.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fingerprints := make(map[realizationPair]string, len(rejections))
+	for _, r := range rejections {
+		fingerprints[realizationPair{capabilityID: r.CapabilityID, componentID: r.ComponentID}] = r.EvidenceFingerprint
+	}
+	return fingerprints, nil
+}
+
+type TimeSuggestionDecision struct {
+	CapabilityID string
+	ComponentID  string
+	Action       string
+	Grade        string
+	Rationale    string
+}
+
+// TimeSuggestionOutcome reports what a single decision did. Err is set when
+// the decision could not be applied; the rest of the batch still is.
+type TimeSuggestionOutcome struct {
+	Decision     TimeSuggestionDecision
+	AppliedGrade string
+	Err          error
+}
+
+// TimeSuggestionReviewer applies a batch of review decisions against the
+// current queue. Accepting or overriding records a TIME assessment; rejecting,
+// or overriding with a different grade, remembers the dismissed suggestion.
+type TimeSuggestionReviewer struct {
+	queue      *TimeSuggestionReviewQueue
+	commandBus ReviewCommandDispatcher
+}
+
+func NewTimeSuggestionReviewer(queue *TimeSuggestionReviewQueue, commandBus ReviewCommandDispatcher) *TimeSuggestionReviewer {
+	return &TimeSuggestionReviewer{queue: queue, commandBus: commandBus}
+}
+
+func (r *TimeSuggestionReviewer) Review(ctx context.Context, decisions []TimeSuggestionDecision, reviewedBy string) ([]TimeSuggestionOutcome, error) {
+	if err := validateTimeSuggestionDecisions(decisions); err != nil {
+		return nil, err
+	}
+	pending, err := r.queue.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byPair := make(map[realizationPair]PendingTimeSuggestion, len(pending))
+	for _, p := range pending {
+		byPair[realizationPair{capabilityID: p.CapabilityID, componentID: p.ComponentID}] = p
+	}
+
+	outcomes := make([]TimeSuggestionOutcome, len(decisions))
+	for i, d := range decisions {
+		outcomes[i] = TimeSuggestionOutcome{Decision: d}
+		suggestion, found := byPair[realizationPair{capabilityID: d.CapabilityID, componentID: d.ComponentID}]
+		if !found {
+			outcomes[i].Err = ErrTimeSuggestionNotPendingForPair
+			continue
+		}
+		outcomes[i].AppliedGrade, outcomes[i].Err = r.apply(ctx, d, suggestion, reviewedBy)
+	}
+	return outcomes, nil
+}
+
+func (r *TimeSuggestionReviewer) apply(ctx context.Context, d TimeSuggestionDecision, s PendingTimeSuggestion, reviewedBy string) (string, error) {
+	if d.Action == TimeSuggestionReject {
+		return "", r.reject(ctx, d, s, reviewedBy)
+	}
+	grade, rationale := d.Grade, d.Rationale
+	if d.Action == TimeSuggestionAccept || d.Grade == s.SuggestedGrade {
+		grade, rationale = s.SuggestedGrade, acceptedRationale(d, s)
+	}
+	if err := r.assess(ctx, d, grade, rationale, reviewedBy); err != nil {
+		return "", err
+	}
+	if grade == s.SuggestedGrade {
+		return grade, nil
+	}
+	return grade, r.reject(ctx, d, s, reviewedBy)
+}
+
+func (r *TimeSuggestionReviewer) assess(ctx context.Context, d TimeSuggestionDecision, grade, rationale, reviewedBy string) error {
+	_, err := r.commandBus.Dispatch(ctx, &commands.AssessRealization{
+		CapabilityID: d.CapabilityID,
+		ComponentID:  d.ComponentID,
+		Grade:        grade,
+		Rationale:    rationale,
+		AssessedBy:   reviewedBy,
+	})
+	return err
+}
+
+func (r *TimeSuggestionReviewer) reject(ctx context.Context, d TimeSuggestionDecision, s PendingTimeSuggestion, reviewedBy string) error {
+	_, err := r.commandBus.Dispatch(ctx, &commands.RejectTimeSuggestion{
+		CapabilityID:        d.CapabilityID,
+		ComponentID:         d.ComponentID,
+		SuggestedGrade:      s.SuggestedGrade,
+		EvidenceFingerprint: s.EvidenceFingerprint,
+		Reason:              d.Rationale,
+		RejectedBy:          reviewedBy,
+	})
+	return err
+}
+
+func acceptedRationale(d TimeSuggestionDecision, s PendingTimeSuggestion) string {
+	if d.Rationale != "" {
+		return d.Rationale
+	}
+	return fmt.Sprintf("Accepted the suggested grade (%s confidence: technical gap %s, functional gap %s).",
+		s.Confidence, formatGap(s.TechnicalGap), formatGap(s.FunctionalGap))
+}
+
+func formatGap(gap *float64) string {
+	if gap == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.1f", *gap)
+}
+
+func validateTimeSuggestionDecisions(decisions []TimeSuggestionDecision) error {
+	if len(decisions) == 0 {
+		return ErrNoTimeSuggestionDecisions
+	}
+	if len(decisions) > MaxTimeSuggestionDecisions {
+		return ErrTooManyTimeSuggestionDecisions
+	}
+	seen := make(map[realizationPair]bool, len(decisions))
+	for _, d := range decisions {
+		if err := validateTimeSuggestionDecision(d); err != nil {
+			return err
+		}
+		pair := realizationPair{capabilityID: d.CapabilityID, componentID: d.ComponentID}
+		if seen[pair] {
+			return ErrDuplicateTimeSuggestionDecision
+		}
+		seen[pair] = true
+	}
+	return nil
+}
+
+func validateTimeSuggestionDecision(d TimeSuggestionDecision) error {
+	switch d.Action {
+	case TimeSuggestionAccept, TimeSuggestionReject:
+		return nil
+	case TimeSuggestionOverride:
+		if d.Grade == "" {
+			return ErrOverrideGradeRequired
+		}
+		_, err := valueobjects.NewTimeGrade(d.Grade)
+		return err
+	default:
+		return ErrInvalidTimeSuggestionAction
+	}
+}
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubTimeSuggestionSource []services.TimeSuggestion
+
+func (s stubTimeSuggestionSource) GetAllSuggestions(context.Context) ([]services.TimeSuggestion, error) {
+	return s, nil
+}
+
+type stubCurrentAssessments []readmodels.TimeAssessmentDTO
+
+func (s stubCurrentAssessments) GetAll(context.Context) ([]readmodels.TimeAssessmentDTO, error) {
+	return s, nil
+}
+
+type stubRejections []readmodels.TimeSuggestionRejectionDTO
+
+func (s stubRejections) GetAll(context.Context) ([]readmodels.TimeSuggestionRejectionDTO, error) {
+	return s, nil
+}
+
+type recordingDispatcher struct {
+	dispatched []cqrs.Command
+	failOn     string
+}
+
+func (d *recordingDispatcher) Dispatch(_ context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	if cmd.CommandName() == d.failOn {
+		return cqrs.EmptyResult(), errors.New("dispatch failed")
+	}
+	d.dispatched = append(d.dispatched, cmd)
+	return cqrs.EmptyResult(), nil
+}
+
+func suggestion(capability, component, grade string, fitScore int) services.TimeSuggestion {
+	return services.TimeSuggestion{
+		CapabilityID:   capability,
+		CapabilityName: "Cap " + capability,
+		ComponentID:    component,
+		ComponentName:  "App " + component,
+		SuggestedGrade: grade,
+		Confidence:     "MEDIUM",
+		Evidence: []services.PillarEvidence{
+			{PillarID: "p-tech", FitType: "TECHNICAL", Importance: 4, FitScore: fitScore, Gap: float64(4 - fitScore)},
+		},
+	}
+}
+
+func TestTimeSuggestionReviewQueue_Pending_ListsOnlySuggestionsThatDisagree(t *testing.T) {
+	unassessed := suggestion("c1", "a1", valueobjects.TimeGradeMigrate, 1)
+	differs := suggestion("c2", "a2", valueobjects.TimeGradeEliminate, 1)
+	agrees := suggestion("c3", "a3", valueobjects.TimeGradeInvest, 4)
+	inconclusive := suggestion("c4", "a4", "", 1)
+	queue := NewTimeSuggestionReviewQueue(
+		stubTimeSuggestionSource{differs, agrees, inconclusive, unassessed},
+		stubCurrentAssessments{
+			{CapabilityID: "c2", ComponentID: "a2", Grade: valueobjects.TimeGradeTolerate},
+			{CapabilityID: "c3", ComponentID: "a3", Grade: valueobjects.TimeGradeInvest},
+		},
+		stubRejections{},
+	)
+
+	pending, err := queue.Pending(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, "c1", pending[0].CapabilityID, "sorted by capability name")
+	assert.Empty(t, pending[0].CurrentGrade)
+	assert.Equal(t, "c2", pending[1].CapabilityID)
+	assert.Equal(t, valueobjects.TimeGradeTolerate, pending[1].CurrentGrade)
+	assert.Equal(t, differs.Fingerprint(), pending[1].EvidenceFingerprint)
+}
+
+func TestTimeSuggestionReviewQueue_Pending_RejectionHoldsUntilScoresChange(t *testing.T) {
+	rejected := suggestion("c1", "a1", valueobjects.TimeGradeMigrate, 1)
+	rejections := stubRejections{{CapabilityID: "c1", ComponentID: "a1", EvidenceFingerprint: rejected.Fingerprint()}}
+
+	sameScores := NewTimeSuggestionReviewQueue(stubTimeSuggestionSource{rejected}, stubCurrentAssessments{}, rejections)
+	pending, err := sameScores.Pending(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, pending, "a rejected suggestion must not resurface on the same evidence")
+
+	rescored := suggestion("c1", "a1", valueobjects.TimeGradeMigrate, 2)
+	changedScores := NewTimeSuggestionReviewQueue(stubTimeSuggestionSource{rescored}, stubCurrentAssessments{}, rejections)
+	pending, err = changedScores.Pending(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, pending, 1, "a changed score must bring the suggestion back")
+}
+
+func newReviewerFor(dispatcher *recordingDispatcher, suggestions ...services.TimeSuggestion) *TimeSuggestionReviewer {
+	queue := NewTimeSuggestionReviewQueue(stubTimeSuggestionSource(suggestions), stubCurrentAssessments{}, stubRejections{})
+	return NewTimeSuggestionReviewer(queue, dispatcher)
+}
+
+func TestTimeSuggestionReviewer_AppliesEachActionInBulk(t *testing.T) {
+	dispatcher := &recordingDispatcher{}
+	s1 := suggestion("c1", "a1", valueobjects.TimeGradeMigrate, 1)
+	s2 := suggestion("c2", "a2", valueobjects.TimeGradeEliminate, 1)
+	s3 := suggestion("c3", "a3", valueobjects.TimeGradeTolerate, 1)
+	reviewer := newReviewerFor(dispatcher, s1, s2, s3)
+
+	outcomes, err := reviewer.Review(context.Background(), []TimeSuggestionDecision{
+		{CapabilityID: "c1", ComponentID: "a1", Action: TimeSuggestionAccept},
+		{CapabilityID: "c2", ComponentID: "a2", Action: TimeSuggestionReject, Rationale: "decommission planned elsewhere"},
+		{CapabilityID: "c3", ComponentID: "a3", Action: TimeSuggestionOverride, Grade: valueobjects.TimeGradeInvest, Rationale: "strategic"},
+	}, "architect@example.com")
+
+	require.NoError(t, err)
+	require.Len(t, outcomes, 3)
+	for _, o := range outcomes {
+		assert.NoError(t, o.Err)
+	}
+	assert.Equal(t, valueobjects.TimeGradeMigrate, outcomes[0].AppliedGrade)
+	assert.Empty(t, outcomes[1].AppliedGrade)
+	assert.Equal(t, valueobjects.TimeGradeInvest, outcomes[2].AppliedGrade)
+
+	require.Len(t, dispatcher.dispatched, 4)
+	accepted := dispatcher.dispatched[0].(*commands.AssessRealization)
+	assert.Equal(t, valueobjects.TimeGradeMigrate, accepted.Grade)
+	assert.Contains(t, accepted.Rationale, "Accepted the suggested grade")
+	rejected := dispatcher.dispatched[1].(*commands.RejectTimeSuggestion)
+	assert.Equal(t, s2.Fingerprint(), rejected.EvidenceFingerprint)
+	assert.Equal(t, "decommission planned elsewhere", rejected.Reason)
+	overridden := dispatcher.dispatched[2].(*commands.AssessRealization)
+	assert.Equal(t, valueobjects.TimeGradeInvest, overridden.Grade)
+	dismissed := dispatcher.dispatched[3].(*commands.RejectTimeSuggestion)
+	assert.Equal(t, valueobjects.TimeGradeTolerate, dismissed.SuggestedGrade, "an override also dismisses the suggestion it replaced")
+}
+
+func TestTimeSuggestionReviewer_ReportsPerItemFailures(t *testing.T) {
+	dispatcher := &recordingDispatcher{failOn: "AssessRealization"}
+	reviewer := newReviewerFor(dispatcher, suggestion("c1", "a1", valueobjects.TimeGradeMigrate, 1), suggestion("c2", "a2", valueobjects.TimeGradeMigrate, 1))
+
+	outcomes, err := reviewer.Review(context.Background(), []TimeSuggestionDecision{
+		{CapabilityID: "c1", ComponentID: "a1", Action: TimeSuggestionAccept},
+		{CapabilityID: "c2", ComponentID: "a2", Action: TimeSuggestionReject},
+		{CapabilityID: "c9", ComponentID: "a9", Action: TimeSuggestionReject},
+	}, "architect@example.com")
+
+	require.NoError(t, err)
+	assert.Error(t, outcomes[0].Err)
+	assert.Empty(t, outcomes[0].AppliedGrade)
+	assert.NoError(t, outcomes[1].Err)
+	assert.ErrorIs(t, outcomes[2].Err, ErrTimeSuggestionNotPendingForPair)
+}
+
+func TestTimeSuggestionReviewer_InvalidBatch_AppliesNothing(t *testing.T) {
+	cases := []struct {
+		name      string
+		decisions []TimeSuggestionDecision
+		want      error
+	}{
+		{"empty", nil, ErrNoTimeSuggestionDecisions},
+		{"unknown action", []TimeSuggestionDecision{{CapabilityID: "c1", ComponentID: "a1", Action: "approve"}}, ErrInvalidTimeSuggestionAction},
+		{"override without grade", []TimeSuggestionDecision{{CapabilityID: "c1", ComponentID: "a1", Action: TimeSuggestionOverride}}, ErrOverrideGradeRequired},
+		{"override with invalid grade", []TimeSuggestionDecision{{CapabilityID: "c1", ComponentID: "a1", Action: TimeSuggestionOverride, Grade: "keep"}}, valueobjects.ErrInvalidTimeGrade},
+		{"duplicate pair", []TimeSuggestionDecision{
+			{CapabilityID: "c1", ComponentID: "a1", Action: TimeSuggestionAccept},
+			{CapabilityID: "c1", ComponentID: "a1", Action: TimeSuggestionReject},
+		}, ErrDuplicateTimeSuggestionDecision},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dispatcher := &recordingDispatcher{}
+			reviewer := newReviewerFor(dispatcher, suggestion("c1", "a1", valueobjects.TimeGradeMigrate, 1))
+
+			_, err := reviewer.Review(context.Background(), tc.decisions, "architect@example.com")
+
+			assert.ErrorIs(t, err, tc.want)
+			assert.Empty(t, dispatcher.dispatched)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+// TransferTimeAssessmentHandler moves a TIME assessment along with the realization it
+// belongs to, when that realization is reassigned to another component or moved to
+// another capability. The grade, rationale and assessor carry over; if the target pair
+// is already assessed, its assessment wins.
+type TransferTimeAssessmentHandler struct {
+	repo   TimeAssessmentRepository
+	lookup ExistingTimeAssessmentLookup
+}
+
+func NewTransferTimeAssessmentHandler(repo TimeAssessmentRepository, lookup ExistingTimeAssessmentLookup) *TransferTimeAssessmentHandler {
+	return &TransferTimeAssessmentHandler{repo: repo, lookup: lookup}
+}
+
+func (h *TransferTimeAssessmentHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.TransferTimeAssessment)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	sourceID, exists, err := h.lookup.FindAggregateIDForPair(ctx, command.CapabilityID, command.FromComponentID)
+	if err != nil || !exists {
+		return cqrs.EmptyResult(), err
+	}
+	source, err := h.repo.GetByID(ctx, sourceID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	_, targetAssessed, err := h.lookup.FindAggregateIDForPair(ctx, command.TargetCapabilityID(), command.ToComponentID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	var transferred *aggregates.TimeAssessment
+	if !targetAssessed {
+		if transferred, err = h.copyToTarget(source, command); err != nil {
+			return cqrs.EmptyResult(), err
+		}
+		if err := h.repo.Save(ctx, transferred); err != nil {
+			return cqrs.EmptyResult(), err
+		}
+	}
+
+	if err := source.Remove(command.TransferredBy); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, source); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if transferred == nil {
+		return cqrs.EmptyResult(), nil
+	}
+	return cqrs.NewResult(transferred.ID()), nil
+}
+
+func (h *TransferTimeAssessmentHandler) copyToTarget(source *aggregates.TimeAssessment, command *commands.TransferTimeAssessment) (*aggregates.TimeAssessment, error) {
+	capability, err := valueobjects.NewPhysicalCapabilityRef(command.TargetCapabilityID())
+	if err != nil {
+		return nil, err
+	}
+	component, err := valueobjects.NewApplicationRef(command.ToComponentID)
+	if err != nil {
+		return nil, err
+	}
+	return aggregates.NewTimeAssessment(aggregates.TimeAssessmentFacts{
+		CapabilityID:  capability,
+		ComponentID:   component,
+		RealizationID: command.RealizationID,
+		Grade:         source.Grade(),
+		Rationale:     source.Rationale(),
+		AssessedBy:    source.AssessedBy(),
+	})
+}
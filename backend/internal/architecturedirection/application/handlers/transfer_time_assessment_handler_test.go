@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pairTimeAssessmentLookup struct {
+	byComponent map[string]string
+}
+
+func (m *pairTimeAssessmentLookup) FindAggregateIDForPair(_ context.Context, _, componentID string) (string, bool, error) {
+	id, ok := m.byComponent[componentID]
+	return id, ok, nil
+}
+
+func transferCmd(capID, fromID, toID string) *commands.TransferTimeAssessment {
+	return &commands.TransferTimeAssessment{
+		CapabilityID:    capID,
+		FromComponentID: fromID,
+		ToComponentID:   toID,
+		RealizationID:   uuid.New().String(),
+		TransferredBy:   "system:realization-reassigned",
+	}
+}
+
+func TestTransferTimeAssessmentHandler_TargetUnassessed_CopiesAndRemovesSource(t *testing.T) {
+	capID, fromID, toID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	source := buildExistingTimeAssessment(t, capID, fromID, valueobjects.TimeGradeMigrate)
+	repo := &mockTimeAssessmentRepository{loaded: source}
+	lookup := &pairTimeAssessmentLookup{byComponent: map[string]string{fromID: source.ID()}}
+
+	result, err := NewTransferTimeAssessmentHandler(repo, lookup).Handle(context.Background(), transferCmd(capID, fromID, toID))
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 2)
+	copied := repo.saved[0]
+	assert.Equal(t, toID, copied.ComponentID().Value())
+	assert.Equal(t, source.Grade(), copied.Grade())
+	assert.Equal(t, source.Rationale(), copied.Rationale())
+	assert.Equal(t, copied.ID(), result.CreatedID)
+	assert.True(t, repo.saved[1].IsRemoved())
+}
+
+func TestTransferTimeAssessmentHandler_TargetAlreadyAssessed_OnlyRemovesSource(t *testing.T) {
+	capID, fromID, toID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	source := buildExistingTimeAssessment(t, capID, fromID, valueobjects.TimeGradeMigrate)
+	repo := &mockTimeAssessmentRepository{loaded: source}
+	lookup := &pairTimeAssessmentLookup{byComponent: map[string]string{fromID: source.ID(), toID: uuid.New().String()}}
+
+	_, err := NewTransferTimeAssessmentHandler(repo, lookup).Handle(context.Background(), transferCmd(capID, fromID, toID))
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.True(t, repo.saved[0].IsRemoved())
+}
+
+func TestTransferTimeAssessmentHandler_NoSourceAssessment_NoOp(t *testing.T) {
+	repo := &mockTimeAssessmentRepository{}
+	lookup := &pairTimeAssessmentLookup{byComponent: map[string]string{}}
+
+	_, err := NewTransferTimeAssessmentHandler(repo, lookup).Handle(context.Background(), transferCmd(uuid.New().String(), uuid.New().String(), uuid.New().String()))
+
+	require.NoError(t, err)
+	assert.False(t, repo.getCalled)
+	assert.Empty(t, repo.saved)
+}
+
+type capabilityPairLookup struct {
+	byPair map[string]string
+}
+
+func (m *capabilityPairLookup) FindAggregateIDForPair(_ context.Context, capabilityID, componentID string) (string, bool, error) {
+	id, ok := m.byPair[capabilityID+"/"+componentID]
+	return id, ok, nil
+}
+
+func TestTransferTimeAssessmentHandler_ToOtherCapability_CopiesOntoTargetCapability(t *testing.T) {
+	fromCapID, toCapID, componentID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	source := buildExistingTimeAssessment(t, fromCapID, componentID, valueobjects.TimeGradeInvest)
+	repo := &mockTimeAssessmentRepository{loaded: source}
+	lookup := &capabilityPairLookup{byPair: map[string]string{fromCapID + "/" + componentID: source.ID()}}
+
+	cmd := transferCmd(fromCapID, componentID, componentID)
+	cmd.ToCapabilityID = toCapID
+	_, err := NewTransferTimeAssessmentHandler(repo, lookup).Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 2)
+	copied := repo.saved[0]
+	assert.Equal(t, toCapID, copied.CapabilityID().Value())
+	assert.Equal(t, componentID, copied.ComponentID().Value())
+	assert.Equal(t, source.Grade(), copied.Grade())
+	assert.True(t, repo.saved[1].IsRemoved())
+}
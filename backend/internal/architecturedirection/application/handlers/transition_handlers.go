@@ -3,6 +3,8 @@ package handlers
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"easi/backend/internal/architecturedirection/application/commands"
 	"easi/backend/internal/architecturedirection/domain/aggregates"
@@ -12,7 +14,11 @@ import (
 	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
 )
 
-var ErrUnknownAdvanceTarget = errors.New("advance target must be 'proposed' or 'agreed'")
+var (
+	ErrUnknownAdvanceTarget = errors.New("advance target must be 'proposed' or 'agreed'")
+	ErrProposerCannotReview = errors.New("the proposer cannot review their own direction")
+	ErrUnknownReviewer      = errors.New("reviewer is not an active user")
+)
 
 type DirectionLoaderRepository interface {
 	DirectionRepository
@@ -43,11 +49,172 @@ func (h *mutationHandler[T]) Handle(ctx context.Context, cmd cqrs.Command) (cqrs
 	return cqrs.EmptyResult(), nil
 }
 
-func NewAdvanceDirectionHandler(repo DirectionLoaderRepository) cqrs.CommandHandler {
-	return &mutationHandler[*commands.AdvanceDirection]{
+// ReviewerSources look up who can review a proposal. Any of them may be nil
+// when the composition root has no lookup to offer.
+type ReviewerSources struct {
+	Architects   services.CapabilityDomainArchitects
+	ActiveUser   services.ActiveUser
+	TenantAdmins services.TenantAdmins
+}
+
+type AdvanceDirectionHandler struct {
+	repo    DirectionLoaderRepository
+	sources ReviewerSources
+}
+
+func NewAdvanceDirectionHandler(repo DirectionLoaderRepository, sources ReviewerSources) *AdvanceDirectionHandler {
+	return &AdvanceDirectionHandler{repo: repo, sources: sources}
+}
+
+func (h *AdvanceDirectionHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.AdvanceDirection)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	direction, err := h.repo.GetByID(ctx, command.DirectionID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.advance(ctx, command, direction); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, direction); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.EmptyResult(), nil
+}
+
+func (h *AdvanceDirectionHandler) advance(ctx context.Context, c *commands.AdvanceDirection, d *aggregates.Direction) error {
+	switch c.TargetStatus {
+	case valueobjects.DirectionStatusProposed:
+		return h.propose(ctx, c, d)
+	case valueobjects.DirectionStatusAgreed:
+		record, err := valueobjects.NewDecisionRecord(c.DecisionContext, c.Decision, c.DecisionConsequences)
+		if err != nil {
+			return err
+		}
+		return d.Agree(record, c.AgreedBy)
+	default:
+		return ErrUnknownAdvanceTarget
+	}
+}
+
+// propose submits the direction to the named reviewers or, when none are
+// named, to the architects of every source capability's business domain.
+// When no architect other than the proposer exists the tenant's admins
+// review it instead; the proposer never reviews their own direction, so a
+// proposal nobody else can review is rejected.
+func (h *AdvanceDirectionHandler) propose(ctx context.Context, c *commands.AdvanceDirection, d *aggregates.Direction) error {
+	reviewers, err := h.reviewers(ctx, c, d)
+	if err != nil {
+		return err
+	}
+	panel, err := valueobjects.NewReviewPanel(reviewers, c.Quorum)
+	if err != nil {
+		return err
+	}
+	return d.Propose(panel)
+}
+
+func (h *AdvanceDirectionHandler) reviewers(ctx context.Context, c *commands.AdvanceDirection, d *aggregates.Direction) ([]string, error) {
+	if len(c.ReviewerIDs) > 0 {
+		return h.namedReviewers(ctx, c)
+	}
+	architects, err := h.sourceDomainArchitects(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	reviewers, err := h.activeOthers(ctx, c.ProposedBy, architects)
+	if err != nil || len(reviewers) > 0 {
+		return reviewers, err
+	}
+	if h.sources.TenantAdmins == nil {
+		return nil, nil
+	}
+	admins, err := h.sources.TenantAdmins(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return h.activeOthers(ctx, c.ProposedBy, admins)
+}
+
+func (h *AdvanceDirectionHandler) namedReviewers(ctx context.Context, c *commands.AdvanceDirection) ([]string, error) {
+	reviewers := make([]string, 0, len(c.ReviewerIDs))
+	for _, id := range c.ReviewerIDs {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if id == c.ProposedBy {
+			return nil, ErrProposerCannotReview
+		}
+		active, err := h.isActiveUser(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !active {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownReviewer, id)
+		}
+		reviewers = append(reviewers, id)
+	}
+	return reviewers, nil
+}
+
+// sourceDomainArchitects collects the architects of the source capabilities'
+// business domains.
+func (h *AdvanceDirectionHandler) sourceDomainArchitects(ctx context.Context, d *aggregates.Direction) ([]string, error) {
+	if h.sources.Architects == nil {
+		return nil, nil
+	}
+	var all []string
+	for _, source := range d.SourceCapabilityIDs() {
+		architects, err := h.sources.Architects(ctx, source.Value())
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, architects...)
+	}
+	return all, nil
+}
+
+// activeOthers keeps the active candidates other than the proposer, once each.
+func (h *AdvanceDirectionHandler) activeOthers(ctx context.Context, proposedBy string, candidates []string) ([]string, error) {
+	seen := map[string]bool{proposedBy: true}
+	var reviewers []string
+	for _, id := range candidates {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		active, err := h.isActiveUser(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if active {
+			reviewers = append(reviewers, id)
+		}
+	}
+	return reviewers, nil
+}
+
+func (h *AdvanceDirectionHandler) isActiveUser(ctx context.Context, id string) (bool, error) {
+	if h.sources.ActiveUser == nil {
+		return true, nil
+	}
+	return h.sources.ActiveUser(ctx, id)
+}
+
+func NewReviewDirectionHandler(repo DirectionLoaderRepository) cqrs.CommandHandler {
+	return &mutationHandler[*commands.ReviewDirection]{
 		repo:          repo,
-		directionIDOf: func(c *commands.AdvanceDirection) string { return c.DirectionID },
-		apply:         applyAdvance,
+		directionIDOf: func(c *commands.ReviewDirection) string { return c.DirectionID },
+		apply: func(c *commands.ReviewDirection, d *aggregates.Direction) error {
+			verdict, err := valueobjects.NewReviewVerdict(c.Verdict)
+			if err != nil {
+				return err
+			}
+			return d.RecordReview(c.ReviewerID, verdict, c.Comment)
+		},
 	}
 }
 
@@ -67,17 +234,6 @@ func NewUpdateDirectionHandler(repo DirectionLoaderRepository) cqrs.CommandHandl
 	}
 }
 
-func applyAdvance(c *commands.AdvanceDirection, d *aggregates.Direction) error {
-	switch c.TargetStatus {
-	case valueobjects.DirectionStatusProposed:
-		return d.Propose()
-	case valueobjects.DirectionStatusAgreed:
-		return d.Agree()
-	default:
-		return ErrUnknownAdvanceTarget
-	}
-}
-
 func applyUpdateDirection(c *commands.UpdateDirection, d *aggregates.Direction) error {
 	if err := applyOptional(c.Narrative, sharedvo.NewDescription, d.UpdateNarrative); err != nil {
 		return err
@@ -133,6 +289,24 @@ func NewRemoveDirectionSourceHandler(repo DirectionLoaderRepository) cqrs.Comman
 	}
 }
 
+func NewRehomeDirectionSourceHandler(repo DirectionLoaderRepository) cqrs.CommandHandler {
+	return &mutationHandler[*commands.RehomeDirectionSource]{
+		repo:          repo,
+		directionIDOf: func(c *commands.RehomeDirectionSource) string { return c.DirectionID },
+		apply: func(c *commands.RehomeDirectionSource, d *aggregates.Direction) error {
+			from, err := valueobjects.NewPhysicalCapabilityRef(c.FromCapabilityID)
+			if err != nil {
+				return err
+			}
+			to, err := valueobjects.NewPhysicalCapabilityRef(c.ToCapabilityID)
+			if err != nil {
+				return err
+			}
+			return d.RehomeSourceCapability(from, to, c.Actor)
+		},
+	}
+}
+
 func applyOptional[Raw any, Parsed any](
 	value *Raw,
 	parse func(Raw) (Parsed, error),
@@ -80,8 +80,8 @@ func TestAdvanceDirectionHandler(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			d := draftFixture(t)
 			repo := &fakeRepoWithLoad{direction: d}
-			_, err := NewAdvanceDirectionHandler(repo).Handle(context.Background(),
-				&commands.AdvanceDirection{DirectionID: d.ID(), TargetStatus: c.target})
+			_, err := NewAdvanceDirectionHandler(repo, ReviewerSources{}).Handle(context.Background(),
+				&commands.AdvanceDirection{DirectionID: d.ID(), TargetStatus: c.target, ReviewerIDs: []string{"reviewer-1"}})
 			if c.expectErr != nil {
 				assert.ErrorIs(t, err, c.expectErr)
 				return
@@ -95,13 +95,156 @@ func TestAdvanceDirectionHandler(t *testing.T) {
 
 func TestAdvanceDirectionHandler_NotFound(t *testing.T) {
 	repo := &fakeRepoWithLoad{loadErr: errors.New("not found")}
-	_, err := NewAdvanceDirectionHandler(repo).Handle(context.Background(), &commands.AdvanceDirection{
+	_, err := NewAdvanceDirectionHandler(repo, ReviewerSources{}).Handle(context.Background(), &commands.AdvanceDirection{
 		DirectionID:  uuid.New().String(),
 		TargetStatus: "proposed",
 	})
 	assert.Error(t, err)
 }
 
+func TestAdvanceDirectionHandler_ProposeDefaultsReviewersToSourceDomainArchitects(t *testing.T) {
+	d := draftFixture(t)
+	repo := &fakeRepoWithLoad{direction: d}
+	architects := func(_ context.Context, capabilityID string) ([]string, error) {
+		if capabilityID == d.SourceCapabilityIDs()[0].Value() {
+			return []string{"arch-a", "arch-b"}, nil
+		}
+		return []string{"arch-b"}, nil
+	}
+
+	_, err := NewAdvanceDirectionHandler(repo, ReviewerSources{Architects: architects}).Handle(context.Background(),
+		&commands.AdvanceDirection{DirectionID: d.ID(), TargetStatus: "proposed"})
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, []string{"arch-a", "arch-b"}, repo.saved[0].ReviewPanel().Reviewers())
+	assert.Equal(t, 2, repo.saved[0].ReviewPanel().Quorum())
+}
+
+func TestAdvanceDirectionHandler_ProposeLeavesProposerOffDefaultPanel(t *testing.T) {
+	d := draftFixture(t)
+	repo := &fakeRepoWithLoad{direction: d}
+	architects := func(context.Context, string) ([]string, error) { return []string{"arch-a", "arch-b"}, nil }
+
+	_, err := NewAdvanceDirectionHandler(repo, ReviewerSources{Architects: architects}).Handle(context.Background(),
+		&commands.AdvanceDirection{DirectionID: d.ID(), TargetStatus: "proposed", ProposedBy: "arch-a"})
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, []string{"arch-b"}, repo.saved[0].ReviewPanel().Reviewers())
+}
+
+func TestAdvanceDirectionHandler_ProposeWithoutOtherArchitectsFallsBackToTenantAdmins(t *testing.T) {
+	d := draftFixture(t)
+	repo := &fakeRepoWithLoad{direction: d}
+	architects := func(context.Context, string) ([]string, error) { return []string{"arch-a"}, nil }
+	admins := func(context.Context) ([]string, error) { return []string{"arch-a", "admin-gone", "admin-1"}, nil }
+	activeUser := func(_ context.Context, id string) (bool, error) { return id != "admin-gone", nil }
+
+	_, err := NewAdvanceDirectionHandler(repo, ReviewerSources{Architects: architects, ActiveUser: activeUser, TenantAdmins: admins}).
+		Handle(context.Background(), &commands.AdvanceDirection{DirectionID: d.ID(), TargetStatus: "proposed", ProposedBy: "arch-a"})
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, []string{"admin-1"}, repo.saved[0].ReviewPanel().Reviewers())
+	assert.False(t, repo.saved[0].QuorumMet())
+}
+
+func TestAdvanceDirectionHandler_ProposeWithNobodyButTheProposerIsRejected(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		architects []string
+		admins     []string
+	}{
+		{name: "no architects or admins"},
+		{name: "the proposer is the only architect and admin", architects: []string{"arch-a"}, admins: []string{"arch-a"}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := draftFixture(t)
+			repo := &fakeRepoWithLoad{direction: d}
+			sources := ReviewerSources{
+				Architects:   func(context.Context, string) ([]string, error) { return c.architects, nil },
+				TenantAdmins: func(context.Context) ([]string, error) { return c.admins, nil },
+			}
+
+			_, err := NewAdvanceDirectionHandler(repo, sources).Handle(context.Background(),
+				&commands.AdvanceDirection{DirectionID: d.ID(), TargetStatus: "proposed", ProposedBy: "arch-a"})
+
+			assert.ErrorIs(t, err, valueobjects.ErrReviewersRequired)
+			assert.Empty(t, repo.saved)
+		})
+	}
+}
+
+func TestAdvanceDirectionHandler_ProposeRejectsProposerAsReviewer(t *testing.T) {
+	d := draftFixture(t)
+	repo := &fakeRepoWithLoad{direction: d}
+
+	_, err := NewAdvanceDirectionHandler(repo, ReviewerSources{}).Handle(context.Background(), &commands.AdvanceDirection{
+		DirectionID: d.ID(), TargetStatus: "proposed", ReviewerIDs: []string{" arch-a "}, Quorum: 1, ProposedBy: "arch-a",
+	})
+
+	assert.ErrorIs(t, err, ErrProposerCannotReview)
+	assert.Empty(t, repo.saved)
+}
+
+func TestAdvanceDirectionHandler_ProposeRejectsUnknownReviewer(t *testing.T) {
+	d := draftFixture(t)
+	repo := &fakeRepoWithLoad{direction: d}
+	activeUser := func(_ context.Context, id string) (bool, error) { return id == "reviewer-1", nil }
+
+	_, err := NewAdvanceDirectionHandler(repo, ReviewerSources{ActiveUser: activeUser}).Handle(context.Background(), &commands.AdvanceDirection{
+		DirectionID: d.ID(), TargetStatus: "proposed", ReviewerIDs: []string{"reviewer-1", "ghost"}, ProposedBy: "arch-a",
+	})
+
+	assert.ErrorIs(t, err, ErrUnknownReviewer)
+	assert.Empty(t, repo.saved)
+}
+
+func TestAdvanceDirectionHandler_ProposeDropsInactiveDefaultArchitects(t *testing.T) {
+	d := draftFixture(t)
+	repo := &fakeRepoWithLoad{direction: d}
+	architects := func(context.Context, string) ([]string, error) { return []string{"arch-gone", "arch-b"}, nil }
+	activeUser := func(_ context.Context, id string) (bool, error) { return id != "arch-gone", nil }
+
+	_, err := NewAdvanceDirectionHandler(repo, ReviewerSources{Architects: architects, ActiveUser: activeUser}).Handle(context.Background(),
+		&commands.AdvanceDirection{DirectionID: d.ID(), TargetStatus: "proposed", ProposedBy: "arch-a"})
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, []string{"arch-b"}, repo.saved[0].ReviewPanel().Reviewers())
+}
+
+func TestReviewDirectionHandler_ApprovalMeetsQuorumForAgree(t *testing.T) {
+	d := draftFixture(t)
+	repo := &fakeRepoWithLoad{direction: d}
+	advance := NewAdvanceDirectionHandler(repo, ReviewerSources{})
+	_, err := advance.Handle(context.Background(),
+		&commands.AdvanceDirection{DirectionID: d.ID(), TargetStatus: "proposed", ReviewerIDs: []string{"reviewer-1"}})
+	require.NoError(t, err)
+
+	_, err = advance.Handle(context.Background(), &commands.AdvanceDirection{DirectionID: d.ID(), TargetStatus: "agreed"})
+	assert.ErrorIs(t, err, aggregates.ErrReviewQuorumNotMet)
+
+	_, err = NewReviewDirectionHandler(repo).Handle(context.Background(),
+		&commands.ReviewDirection{DirectionID: d.ID(), ReviewerID: "reviewer-1", Verdict: "approve", Comment: "Agreed."})
+	require.NoError(t, err)
+
+	_, err = advance.Handle(context.Background(), &commands.AdvanceDirection{
+		DirectionID: d.ID(), TargetStatus: "agreed", Decision: "Merge the ledgers.",
+	})
+	require.NoError(t, err)
+	assert.True(t, d.Status().IsAgreed())
+}
+
+func TestReviewDirectionHandler_InvalidVerdict(t *testing.T) {
+	d := draftFixture(t)
+	repo := &fakeRepoWithLoad{direction: d}
+	_, err := NewReviewDirectionHandler(repo).Handle(context.Background(),
+		&commands.ReviewDirection{DirectionID: d.ID(), ReviewerID: "reviewer-1", Verdict: "maybe"})
+	assert.ErrorIs(t, err, valueobjects.ErrInvalidReviewVerdict)
+}
+
 func TestRejectDirectionHandler_FromDraft(t *testing.T) {
 	d := draftFixture(t)
 	repo := &fakeRepoWithLoad{direction: d}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"time"
 
 	"easi/backend/internal/architecturedirection/application/readmodels"
 	"easi/backend/internal/architecturedirection/domain/events"
@@ -21,6 +22,12 @@ type CapabilityJourneyStore interface {
 	AddMilestone(ctx context.Context, p readmodels.JourneyMilestoneUpsertParams) error
 	UpdateMilestone(ctx context.Context, p readmodels.JourneyMilestoneUpsertParams) error
 	RemoveMilestone(ctx context.Context, journeyID, milestoneID string) error
+	ChangeCapability(ctx context.Context, journeyID string, capabilityID readmodels.CapabilityID) error
+	AddDependency(ctx context.Context, p readmodels.JourneyDependencyParams) error
+	RemoveDependency(ctx context.Context, journeyID, predecessorID string) error
+	RecordUnmetPredecessorsAtStart(ctx context.Context, journeyID string, predecessorIDs []string) error
+	RecordBaseline(ctx context.Context, journeyID string, baselinedAt time.Time) error
+	RecordTargetChange(ctx context.Context, p readmodels.JourneyTargetChangeParams) error
 }
 
 type CapabilityJourneyProjector struct {
@@ -64,6 +71,9 @@ func (p *CapabilityJourneyProjector) ProjectEvent(ctx context.Context, eventType
 		pl.JourneySourceApplicationsChanged: func(ctx context.Context, data []byte) error {
 			return handleProjection(ctx, data, p.applyJourneySourceApplicationsChanged)
 		},
+		pl.JourneyCapabilityChanged: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyJourneyCapabilityChanged)
+		},
 		pl.JourneyMilestoneAdded: func(ctx context.Context, data []byte) error {
 			return handleProjection(ctx, data, p.applyJourneyMilestoneAdded)
 		},
@@ -73,6 +83,12 @@ func (p *CapabilityJourneyProjector) ProjectEvent(ctx context.Context, eventType
 		pl.JourneyMilestoneRemoved: func(ctx context.Context, data []byte) error {
 			return handleProjection(ctx, data, p.applyJourneyMilestoneRemoved)
 		},
+		pl.JourneyDependencyAdded: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyJourneyDependencyAdded)
+		},
+		pl.JourneyDependencyRemoved: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyJourneyDependencyRemoved)
+		},
 	}
 	if handler, exists := handlers[eventType]; exists {
 		return handler(ctx, eventData)
@@ -94,15 +110,26 @@ func (p *CapabilityJourneyProjector) applyJourneyPlanned(ctx context.Context, ev
 		TargetDomainID:   evt.TargetDomainID,
 		TargetParentID:   evt.TargetParentID,
 		ResultingName:    evt.ResultingName,
+		TemplateID:       evt.TemplateID,
+		TemplateVersion:  evt.TemplateVersion,
 		PlannedBy:        evt.PlannedBy,
 		PlannedAt:        evt.OccurredOn,
 	})
 }
 
 func (p *CapabilityJourneyProjector) applyJourneyStarted(ctx context.Context, evt events.JourneyStarted) error {
-	return p.readModel.UpdateStatus(ctx, readmodels.UpdateJourneyStatusParams{
+	if err := p.readModel.UpdateStatus(ctx, readmodels.UpdateJourneyStatusParams{
 		JourneyID: evt.ID, Status: "in-flight", Column: readmodels.JourneyTimestampStarted, OccurredAt: evt.OccurredOn,
-	})
+	}); err != nil {
+		return err
+	}
+	if err := p.readModel.RecordBaseline(ctx, evt.ID, evt.OccurredOn); err != nil {
+		return err
+	}
+	if len(evt.UnmetPredecessorIDs) == 0 {
+		return nil
+	}
+	return p.readModel.RecordUnmetPredecessorsAtStart(ctx, evt.ID, evt.UnmetPredecessorIDs)
 }
 
 func (p *CapabilityJourneyProjector) applyJourneyCompleted(ctx context.Context, evt events.JourneyCompleted) error {
@@ -121,8 +148,15 @@ func (p *CapabilityJourneyProjector) applyJourneyProgressUpdated(ctx context.Con
 	return p.readModel.UpdateProgress(ctx, evt.ID, evt.Progress)
 }
 
+// applyJourneyDetailsUpdated records the target move before overwriting the
+// target, since the slip entry is derived from the previously projected one.
 func (p *CapabilityJourneyProjector) applyJourneyDetailsUpdated(ctx context.Context, evt events.JourneyDetailsUpdated) error {
 	year, quarter := targetPeriodParts(evt.TargetPeriod)
+	if err := p.readModel.RecordTargetChange(ctx, readmodels.JourneyTargetChangeParams{
+		JourneyID: evt.ID, TargetYear: year, TargetQuarter: quarter, MovedBy: evt.UpdatedBy, MovedAt: evt.OccurredOn,
+	}); err != nil {
+		return err
+	}
 	return p.readModel.UpdateDetails(ctx, readmodels.UpdateJourneyDetailsParams{
 		JourneyID: evt.ID, Note: evt.Note, TargetYear: year, TargetQuarter: quarter, ResultingName: evt.ResultingName,
 	})
@@ -132,6 +166,10 @@ func (p *CapabilityJourneyProjector) applyJourneySourceApplicationsChanged(ctx c
 	return p.readModel.ReplaceSources(ctx, evt.ID, evt.FromComponentIDs)
 }
 
+func (p *CapabilityJourneyProjector) applyJourneyCapabilityChanged(ctx context.Context, evt events.JourneyCapabilityChanged) error {
+	return p.readModel.ChangeCapability(ctx, evt.ID, readmodels.CapabilityID(evt.ToCapabilityID))
+}
+
 func (p *CapabilityJourneyProjector) applyJourneyMilestoneAdded(ctx context.Context, evt events.JourneyMilestoneAdded) error {
 	year, quarter := targetPeriodParts(evt.TargetPeriod)
 	return p.readModel.AddMilestone(ctx, readmodels.JourneyMilestoneUpsertParams{
@@ -142,6 +180,12 @@ func (p *CapabilityJourneyProjector) applyJourneyMilestoneAdded(ctx context.Cont
 
 func (p *CapabilityJourneyProjector) applyJourneyMilestoneUpdated(ctx context.Context, evt events.JourneyMilestoneUpdated) error {
 	year, quarter := targetPeriodParts(evt.TargetPeriod)
+	if err := p.readModel.RecordTargetChange(ctx, readmodels.JourneyTargetChangeParams{
+		JourneyID: evt.ID, MilestoneID: evt.MilestoneID, TargetYear: year, TargetQuarter: quarter,
+		MovedBy: evt.UpdatedBy, MovedAt: evt.OccurredOn,
+	}); err != nil {
+		return err
+	}
 	return p.readModel.UpdateMilestone(ctx, readmodels.JourneyMilestoneUpsertParams{
 		JourneyID: evt.ID, MilestoneID: evt.MilestoneID, Label: evt.Label,
 		TargetYear: year, TargetQuarter: quarter, Status: evt.Status, UpdatedAt: evt.OccurredOn,
@@ -152,6 +196,16 @@ func (p *CapabilityJourneyProjector) applyJourneyMilestoneRemoved(ctx context.Co
 	return p.readModel.RemoveMilestone(ctx, evt.ID, evt.MilestoneID)
 }
 
+func (p *CapabilityJourneyProjector) applyJourneyDependencyAdded(ctx context.Context, evt events.JourneyDependencyAdded) error {
+	return p.readModel.AddDependency(ctx, readmodels.JourneyDependencyParams{
+		JourneyID: evt.ID, PredecessorID: evt.PredecessorID, DependencyType: evt.DependencyType, AddedAt: evt.OccurredOn,
+	})
+}
+
+func (p *CapabilityJourneyProjector) applyJourneyDependencyRemoved(ctx context.Context, evt events.JourneyDependencyRemoved) error {
+	return p.readModel.RemoveDependency(ctx, evt.ID, evt.PredecessorID)
+}
+
 func targetPeriodParts(tp *events.TargetPeriodData) (*int, *int) {
 	if tp == nil {
 		return nil, nil
@@ -23,6 +23,13 @@ type mockCapabilityJourneyStore struct {
 	milestoneAdds   []capabilityJourneyMilestoneUpsert
 	milestoneEdits  []capabilityJourneyMilestoneUpsert
 	milestoneRemove []string
+	capabilityMoves map[string]readmodels.CapabilityID
+	dependencyAdds  []readmodels.JourneyDependencyParams
+	dependencyDrops []string
+	unmetAtStart    map[string][]string
+	baselined       []string
+	targetChanges   []readmodels.JourneyTargetChangeParams
+	writeTrail      []string
 }
 
 type capabilityJourneyStatusUpdate struct {
@@ -70,6 +77,7 @@ func (m *mockCapabilityJourneyStore) UpdateProgress(_ context.Context, _ string,
 }
 
 func (m *mockCapabilityJourneyStore) UpdateDetails(_ context.Context, p readmodels.UpdateJourneyDetailsParams) error {
+	m.writeTrail = append(m.writeTrail, "details")
 	m.detailsUpdates = append(m.detailsUpdates, capabilityJourneyDetailsUpdate{p.JourneyID, p.Note, p.TargetYear, p.TargetQuarter, p.ResultingName})
 	return nil
 }
@@ -85,15 +93,53 @@ func (m *mockCapabilityJourneyStore) AddMilestone(_ context.Context, p readmodel
 }
 
 func (m *mockCapabilityJourneyStore) UpdateMilestone(_ context.Context, p readmodels.JourneyMilestoneUpsertParams) error {
+	m.writeTrail = append(m.writeTrail, "milestone")
 	m.milestoneEdits = append(m.milestoneEdits, capabilityJourneyMilestoneUpsert{p.JourneyID, p.MilestoneID, p.Label, p.TargetYear, p.TargetQuarter, p.Status})
 	return nil
 }
 
+func (m *mockCapabilityJourneyStore) ChangeCapability(_ context.Context, journeyID string, capabilityID readmodels.CapabilityID) error {
+	if m.capabilityMoves == nil {
+		m.capabilityMoves = map[string]readmodels.CapabilityID{}
+	}
+	m.capabilityMoves[journeyID] = capabilityID
+	return nil
+}
+
 func (m *mockCapabilityJourneyStore) RemoveMilestone(_ context.Context, _, milestoneID string) error {
 	m.milestoneRemove = append(m.milestoneRemove, milestoneID)
 	return nil
 }
 
+func (m *mockCapabilityJourneyStore) AddDependency(_ context.Context, p readmodels.JourneyDependencyParams) error {
+	m.dependencyAdds = append(m.dependencyAdds, p)
+	return nil
+}
+
+func (m *mockCapabilityJourneyStore) RemoveDependency(_ context.Context, _, predecessorID string) error {
+	m.dependencyDrops = append(m.dependencyDrops, predecessorID)
+	return nil
+}
+
+func (m *mockCapabilityJourneyStore) RecordUnmetPredecessorsAtStart(_ context.Context, journeyID string, predecessorIDs []string) error {
+	if m.unmetAtStart == nil {
+		m.unmetAtStart = map[string][]string{}
+	}
+	m.unmetAtStart[journeyID] = predecessorIDs
+	return nil
+}
+
+func (m *mockCapabilityJourneyStore) RecordBaseline(_ context.Context, journeyID string, _ time.Time) error {
+	m.baselined = append(m.baselined, journeyID)
+	return nil
+}
+
+func (m *mockCapabilityJourneyStore) RecordTargetChange(_ context.Context, p readmodels.JourneyTargetChangeParams) error {
+	m.writeTrail = append(m.writeTrail, "target-change")
+	m.targetChanges = append(m.targetChanges, p)
+	return nil
+}
+
 func TestCapabilityJourneyProjector_JourneyPlanned_InsertsJourney(t *testing.T) {
 	store := &mockCapabilityJourneyStore{}
 	projector := NewCapabilityJourneyProjector(store)
@@ -107,6 +153,8 @@ func TestCapabilityJourneyProjector_JourneyPlanned_InsertsJourney(t *testing.T)
 		ToComponentID:    toApp,
 		Note:             "moving on",
 		TargetPeriod:     &events.TargetPeriodData{Year: 2027, Quarter: 2},
+		TemplateID:       "template-1",
+		TemplateVersion:  3,
 		PlannedBy:        "architect@example.com",
 	})
 
@@ -114,6 +162,8 @@ func TestCapabilityJourneyProjector_JourneyPlanned_InsertsJourney(t *testing.T)
 
 	require.Len(t, store.inserted, 1)
 	assert.Equal(t, id, store.inserted[0].ID)
+	assert.Equal(t, "template-1", store.inserted[0].TemplateID)
+	assert.Equal(t, 3, store.inserted[0].TemplateVersion)
 	assert.Equal(t, capID, store.inserted[0].CapabilityID)
 	assert.Equal(t, "migration", store.inserted[0].Kind)
 	assert.Equal(t, []string{fromApp}, store.inserted[0].FromComponentIDs)
@@ -122,6 +172,19 @@ func TestCapabilityJourneyProjector_JourneyPlanned_InsertsJourney(t *testing.T)
 	assert.Equal(t, 2027, *store.inserted[0].TargetYear)
 }
 
+func TestCapabilityJourneyProjector_JourneyCapabilityChanged_ChangesCapability(t *testing.T) {
+	store := &mockCapabilityJourneyStore{}
+	projector := NewCapabilityJourneyProjector(store)
+
+	id, fromID, toID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	evt := events.NewJourneyCapabilityChanged(events.JourneyCapabilityChangedFields{
+		ID: id, FromCapabilityID: fromID, ToCapabilityID: toID, Reason: "merged", ChangedBy: "a@example.com",
+	})
+	require.NoError(t, projector.Handle(context.Background(), evt))
+
+	assert.Equal(t, map[string]readmodels.CapabilityID{id: readmodels.CapabilityID(toID)}, store.capabilityMoves)
+}
+
 func TestCapabilityJourneyProjector_JourneyStarted_UpdatesStatus(t *testing.T) {
 	store := &mockCapabilityJourneyStore{}
 	projector := NewCapabilityJourneyProjector(store)
@@ -132,6 +195,39 @@ func TestCapabilityJourneyProjector_JourneyStarted_UpdatesStatus(t *testing.T) {
 	require.Len(t, store.statusUpdates, 1)
 	assert.Equal(t, "in-flight", store.statusUpdates[0].status)
 	assert.Equal(t, readmodels.JourneyTimestampStarted, store.statusUpdates[0].column)
+	assert.Equal(t, []string{evt.ID}, store.baselined)
+	assert.Empty(t, store.unmetAtStart)
+}
+
+func TestCapabilityJourneyProjector_JourneyStartedWithUnmetPredecessors_RecordsWarning(t *testing.T) {
+	store := &mockCapabilityJourneyStore{}
+	projector := NewCapabilityJourneyProjector(store)
+
+	id, predecessorID := uuid.New().String(), uuid.New().String()
+	evt := events.NewJourneyStarted(events.JourneyStartedFields{
+		ID: id, StartedBy: "a@example.com", UnmetPredecessorIDs: []string{predecessorID},
+	})
+	require.NoError(t, projector.Handle(context.Background(), evt))
+
+	assert.Equal(t, map[string][]string{id: {predecessorID}}, store.unmetAtStart)
+}
+
+func TestCapabilityJourneyProjector_DependencyEvents_MaintainDependencies(t *testing.T) {
+	store := &mockCapabilityJourneyStore{}
+	projector := NewCapabilityJourneyProjector(store)
+
+	id, predecessorID := uuid.New().String(), uuid.New().String()
+	require.NoError(t, projector.Handle(context.Background(), events.NewJourneyDependencyAdded(events.JourneyDependencyAddedFields{
+		ID: id, PredecessorID: predecessorID, DependencyType: "finish-to-start", AddedBy: "a@example.com",
+	})))
+	require.NoError(t, projector.Handle(context.Background(), events.NewJourneyDependencyRemoved(events.JourneyDependencyRemovedFields{
+		ID: id, PredecessorID: predecessorID, RemovedBy: "a@example.com",
+	})))
+
+	require.Len(t, store.dependencyAdds, 1)
+	assert.Equal(t, id, store.dependencyAdds[0].JourneyID)
+	assert.Equal(t, "finish-to-start", store.dependencyAdds[0].DependencyType)
+	assert.Equal(t, []string{predecessorID}, store.dependencyDrops)
 }
 
 func TestCapabilityJourneyProjector_JourneyCompleted_UpdatesStatus(t *testing.T) {
@@ -184,6 +280,28 @@ func TestCapabilityJourneyProjector_JourneyDetailsUpdated_UpdatesDetails(t *test
 	assert.Equal(t, 2028, *store.detailsUpdates[0].targetYear)
 }
 
+func TestCapabilityJourneyProjector_TargetEdits_RecordSlipBeforeOverwritingTarget(t *testing.T) {
+	store := &mockCapabilityJourneyStore{}
+	projector := NewCapabilityJourneyProjector(store)
+
+	journeyID, milestoneID := uuid.New().String(), uuid.New().String()
+	require.NoError(t, projector.Handle(context.Background(), events.NewJourneyDetailsUpdated(events.JourneyDetailsUpdatedFields{
+		ID: journeyID, TargetPeriod: &events.TargetPeriodData{Year: 2028, Quarter: 2}, UpdatedBy: "a@example.com",
+	})))
+	require.NoError(t, projector.Handle(context.Background(), events.NewJourneyMilestoneUpdated(events.JourneyMilestoneFields{
+		ID: journeyID, MilestoneID: milestoneID, Label: "Cutover", Status: "planned", Actor: "b@example.com",
+	})))
+
+	assert.Equal(t, []string{"target-change", "details", "target-change", "milestone"}, store.writeTrail)
+	require.Len(t, store.targetChanges, 2)
+	assert.Equal(t, "", store.targetChanges[0].MilestoneID)
+	assert.Equal(t, 2, *store.targetChanges[0].TargetQuarter)
+	assert.Equal(t, "a@example.com", store.targetChanges[0].MovedBy)
+	assert.Equal(t, milestoneID, store.targetChanges[1].MilestoneID)
+	assert.Nil(t, store.targetChanges[1].TargetYear)
+	assert.Equal(t, "b@example.com", store.targetChanges[1].MovedBy)
+}
+
 func TestCapabilityJourneyProjector_JourneySourceApplicationsChanged_ReplacesSources(t *testing.T) {
 	store := &mockCapabilityJourneyStore{}
 	projector := NewCapabilityJourneyProjector(store)
@@ -0,0 +1,62 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type DirectionsBySourceFinder interface {
+	GetDirectionIDsBySourceCapability(ctx context.Context, capabilityID string) ([]string, error)
+}
+
+// CapabilityReorganisedDirectionReactor keeps directions' source capabilities, the
+// bridge to their enterprise capability, pointing at live capabilities: a merged
+// source is replaced by the survivor, a split source by the first part, matching
+// where its journey goes.
+type CapabilityReorganisedDirectionReactor struct {
+	directions DirectionsBySourceFinder
+	commands   CommandDispatcher
+}
+
+func NewCapabilityReorganisedDirectionReactor(directions DirectionsBySourceFinder, commandDispatcher CommandDispatcher) *CapabilityReorganisedDirectionReactor {
+	return &CapabilityReorganisedDirectionReactor{directions: directions, commands: commandDispatcher}
+}
+
+func (r *CapabilityReorganisedDirectionReactor) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return r.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (r *CapabilityReorganisedDirectionReactor) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	payload, relevant, err := parseCapabilityReorganised(eventType, eventData)
+	if err != nil || !relevant {
+		return err
+	}
+	toID, _, actor, ok := payload.successor(eventType)
+	if !ok {
+		return nil
+	}
+
+	directionIDs, err := r.directions.GetDirectionIDsBySourceCapability(ctx, payload.ID)
+	if err != nil {
+		return fmt.Errorf("load directions sourced from capability %s: %w", payload.ID, err)
+	}
+	for _, directionID := range directionIDs {
+		if _, err := r.commands.Dispatch(ctx, &commands.RehomeDirectionSource{
+			DirectionID: directionID, FromCapabilityID: payload.ID, ToCapabilityID: toID, Actor: actor,
+		}); err != nil {
+			return fmt.Errorf("rehome source capability %s of direction %s: %w", payload.ID, directionID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package projectors
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDirectionsBySourceFinder struct {
+	bySource map[string][]string
+}
+
+func (f *fakeDirectionsBySourceFinder) GetDirectionIDsBySourceCapability(_ context.Context, capabilityID string) ([]string, error) {
+	return f.bySource[capabilityID], nil
+}
+
+func TestCapabilityReorganisedDirectionReactor_Merge_RehomesEverySourcingDirection(t *testing.T) {
+	mergedID, survivorID := uuid.New().String(), uuid.New().String()
+	finder := &fakeDirectionsBySourceFinder{bySource: map[string][]string{mergedID: {"dir-1", "dir-2"}}}
+	dispatcher := &fakeDispatcher{}
+
+	err := NewCapabilityReorganisedDirectionReactor(finder, dispatcher).ProjectEvent(context.Background(), "CapabilityMergedInto",
+		[]byte(`{"id":"`+mergedID+`","survivorId":"`+survivorID+`","mergedBy":"a@example.com"}`))
+
+	require.NoError(t, err)
+	require.Len(t, dispatcher.dispatched, 2)
+	assert.Equal(t, &commands.RehomeDirectionSource{
+		DirectionID: "dir-1", FromCapabilityID: mergedID, ToCapabilityID: survivorID, Actor: "a@example.com",
+	}, dispatcher.dispatched[0])
+	assert.Equal(t, "dir-2", dispatcher.dispatched[1].(*commands.RehomeDirectionSource).DirectionID)
+}
+
+func TestCapabilityReorganisedDirectionReactor_Split_RehomesToFirstPart(t *testing.T) {
+	sourceID, firstID, secondID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	finder := &fakeDirectionsBySourceFinder{bySource: map[string][]string{sourceID: {"dir-1"}}}
+	dispatcher := &fakeDispatcher{}
+
+	err := NewCapabilityReorganisedDirectionReactor(finder, dispatcher).ProjectEvent(context.Background(), "CapabilitySplit",
+		[]byte(`{"id":"`+sourceID+`","parts":[{"id":"`+firstID+`"},{"id":"`+secondID+`"}],"splitBy":"a@example.com"}`))
+
+	require.NoError(t, err)
+	require.Len(t, dispatcher.dispatched, 1)
+	assert.Equal(t, &commands.RehomeDirectionSource{
+		DirectionID: "dir-1", FromCapabilityID: sourceID, ToCapabilityID: firstID, Actor: "a@example.com",
+	}, dispatcher.dispatched[0])
+}
+
+func TestCapabilityReorganisedDirectionReactor_NoSourcingDirections_DispatchesNothing(t *testing.T) {
+	dispatcher := &fakeDispatcher{}
+
+	err := NewCapabilityReorganisedDirectionReactor(&fakeDirectionsBySourceFinder{}, dispatcher).ProjectEvent(context.Background(), "CapabilityMergedInto",
+		[]byte(`{"id":"`+uuid.New().String()+`","survivorId":"`+uuid.New().String()+`"}`))
+
+	require.NoError(t, err)
+	assert.Empty(t, dispatcher.dispatched)
+}
@@ -0,0 +1,90 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	cmPL "easi/backend/internal/capabilitymapping/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const (
+	journeyRehomedByMerge = "merged"
+	journeyRehomedBySplit = "split"
+)
+
+// CapabilityReorganisedJourneyReactor keeps journeys attached when capabilities are
+// reorganised: a merged capability's journey moves to the survivor, a split
+// capability's journey to the first part, which receives everything not
+// distributed explicitly.
+type CapabilityReorganisedJourneyReactor struct {
+	commands CommandDispatcher
+}
+
+func NewCapabilityReorganisedJourneyReactor(commandDispatcher CommandDispatcher) *CapabilityReorganisedJourneyReactor {
+	return &CapabilityReorganisedJourneyReactor{commands: commandDispatcher}
+}
+
+func (r *CapabilityReorganisedJourneyReactor) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return r.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+type capabilityReorganisedPayload struct {
+	ID         string `json:"id"`
+	SurvivorID string `json:"survivorId"`
+	MergedBy   string `json:"mergedBy"`
+	SplitBy    string `json:"splitBy"`
+	Parts      []struct {
+		ID string `json:"id"`
+	} `json:"parts"`
+}
+
+// successor resolves the capability that takes over from a merged or split one:
+// the survivor of a merge, or the first part of a split.
+func (p capabilityReorganisedPayload) successor(eventType string) (capabilityID, reason, actor string, ok bool) {
+	switch {
+	case eventType == cmPL.CapabilityMergedInto:
+		return p.SurvivorID, journeyRehomedByMerge, p.MergedBy, true
+	case eventType == cmPL.CapabilitySplit && len(p.Parts) > 0:
+		return p.Parts[0].ID, journeyRehomedBySplit, p.SplitBy, true
+	default:
+		return "", "", "", false
+	}
+}
+
+func parseCapabilityReorganised(eventType string, eventData []byte) (capabilityReorganisedPayload, bool, error) {
+	if eventType != cmPL.CapabilityMergedInto && eventType != cmPL.CapabilitySplit {
+		return capabilityReorganisedPayload{}, false, nil
+	}
+	var payload capabilityReorganisedPayload
+	if err := json.Unmarshal(eventData, &payload); err != nil {
+		return payload, false, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+	}
+	return payload, true, nil
+}
+
+func (r *CapabilityReorganisedJourneyReactor) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	payload, relevant, err := parseCapabilityReorganised(eventType, eventData)
+	if err != nil || !relevant {
+		return err
+	}
+	toID, reason, actor, ok := payload.successor(eventType)
+	if !ok {
+		return nil
+	}
+
+	cmd := &commands.RehomeCapabilityJourney{FromCapabilityID: payload.ID, ToCapabilityID: toID, Reason: reason, Actor: actor}
+	if _, err := r.commands.Dispatch(ctx, cmd); err != nil {
+		return fmt.Errorf("rehome journey of capability %s: %w", payload.ID, err)
+	}
+	return nil
+}
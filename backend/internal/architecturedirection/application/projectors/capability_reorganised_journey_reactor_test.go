@@ -0,0 +1,49 @@
+package projectors
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapabilityReorganisedJourneyReactor_Merge_RehomesToSurvivor(t *testing.T) {
+	mergedID, survivorID := uuid.New().String(), uuid.New().String()
+	dispatcher := &fakeDispatcher{}
+
+	err := NewCapabilityReorganisedJourneyReactor(dispatcher).ProjectEvent(context.Background(), "CapabilityMergedInto",
+		[]byte(`{"id":"`+mergedID+`","survivorId":"`+survivorID+`","mergedBy":"a@example.com"}`))
+
+	require.NoError(t, err)
+	require.Len(t, dispatcher.dispatched, 1)
+	assert.Equal(t, &commands.RehomeCapabilityJourney{
+		FromCapabilityID: mergedID, ToCapabilityID: survivorID, Reason: "merged", Actor: "a@example.com",
+	}, dispatcher.dispatched[0])
+}
+
+func TestCapabilityReorganisedJourneyReactor_Split_RehomesToFirstPart(t *testing.T) {
+	sourceID, firstID, secondID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	dispatcher := &fakeDispatcher{}
+
+	err := NewCapabilityReorganisedJourneyReactor(dispatcher).ProjectEvent(context.Background(), "CapabilitySplit",
+		[]byte(`{"id":"`+sourceID+`","parts":[{"id":"`+firstID+`"},{"id":"`+secondID+`"}],"splitBy":"a@example.com"}`))
+
+	require.NoError(t, err)
+	require.Len(t, dispatcher.dispatched, 1)
+	assert.Equal(t, &commands.RehomeCapabilityJourney{
+		FromCapabilityID: sourceID, ToCapabilityID: firstID, Reason: "split", Actor: "a@example.com",
+	}, dispatcher.dispatched[0])
+}
+
+func TestCapabilityReorganisedJourneyReactor_OtherEventTypes_Ignored(t *testing.T) {
+	dispatcher := &fakeDispatcher{}
+
+	err := NewCapabilityReorganisedJourneyReactor(dispatcher).ProjectEvent(context.Background(), "CapabilityDeleted", []byte(`{"id":"x"}`))
+
+	require.NoError(t, err)
+	assert.Empty(t, dispatcher.dispatched)
+}
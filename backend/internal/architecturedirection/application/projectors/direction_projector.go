@@ -28,6 +28,8 @@ type DirectionStore interface {
 	UpdateField(ctx context.Context, u readmodels.FieldUpdate) error
 	UpdatePlacements(ctx context.Context, u readmodels.PlacementsUpdate) error
 	ReplaceSourceCapabilities(ctx context.Context, u readmodels.SourceCapabilitiesUpdate) error
+	StartReview(ctx context.Context, u readmodels.ReviewPanelUpdate) error
+	RecordReview(ctx context.Context, u readmodels.ReviewVerdictUpdate) error
 }
 
 type DirectionProjector struct {
@@ -51,13 +53,14 @@ func (p *DirectionProjector) Handle(ctx context.Context, event domain.DomainEven
 func (p *DirectionProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
 	handlers := map[string]func(context.Context, []byte) error{
 		pl.DirectionDrafted:                   p.handleDrafted,
-		pl.DirectionProposed:                  p.handleStatusEvent(valueobjects.DirectionStatusProposed),
+		pl.DirectionProposed:                  p.handleProposed,
 		pl.DirectionAgreed:                    p.handleStatusEvent(valueobjects.DirectionStatusAgreed),
 		pl.DirectionRejected:                  p.handleStatusEvent(valueobjects.DirectionStatusRejected),
 		pl.DirectionNarrativeUpdated:          p.handleNarrativeUpdated,
 		pl.DirectionHorizonChanged:            p.handleHorizonChanged,
 		pl.DirectionPlacementsChanged:         p.handlePlacementsChanged,
 		pl.DirectionSourceCapabilitiesChanged: p.handleSourcesChanged,
+		pl.DirectionReviewRecorded:            p.handleReviewRecorded,
 	}
 	if handler, exists := handlers[eventType]; exists {
 		return handler(ctx, eventData)
@@ -106,6 +109,34 @@ func (p *DirectionProjector) handleStatusEvent(status string) func(context.Conte
 	}
 }
 
+func (p *DirectionProjector) handleProposed(ctx context.Context, eventData []byte) error {
+	if err := p.handleStatusEvent(valueobjects.DirectionStatusProposed)(ctx, eventData); err != nil {
+		return err
+	}
+	return handleProjection(ctx, eventData, func(ctx context.Context, e events.DirectionProposed) error {
+		if len(e.ReviewerIDs) == 0 {
+			return nil
+		}
+		return p.readModel.StartReview(ctx, readmodels.ReviewPanelUpdate{
+			DirectionID: readmodels.DirectionID(e.ID),
+			ReviewerIDs: e.ReviewerIDs,
+			Quorum:      e.Quorum,
+		})
+	})
+}
+
+func (p *DirectionProjector) handleReviewRecorded(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, e events.DirectionReviewRecorded) error {
+		return p.readModel.RecordReview(ctx, readmodels.ReviewVerdictUpdate{
+			DirectionID: readmodels.DirectionID(e.ID),
+			ReviewerID:  e.ReviewerID,
+			Verdict:     e.Verdict,
+			Comment:     e.Comment,
+			ReviewedAt:  e.OccurredOn,
+		})
+	})
+}
+
 func (p *DirectionProjector) handleNarrativeUpdated(ctx context.Context, eventData []byte) error {
 	return handleProjection(ctx, eventData, func(ctx context.Context, e events.DirectionNarrativeUpdated) error {
 		return p.readModel.UpdateField(ctx, readmodels.FieldUpdate{
@@ -18,6 +18,8 @@ type mockDirectionStore struct {
 	fieldUpdates       map[readmodels.DirectionField]map[readmodels.DirectionID]string
 	placementUpdates   map[readmodels.DirectionID][]readmodels.DirectionPlacementDTO
 	sourceReplaceCalls map[readmodels.DirectionID][]readmodels.CapabilityID
+	reviewPanels       []readmodels.ReviewPanelUpdate
+	reviewVerdicts     []readmodels.ReviewVerdictUpdate
 }
 
 func newMockDirectionStore() *mockDirectionStore {
@@ -49,6 +51,15 @@ func (m *mockDirectionStore) ReplaceSourceCapabilities(_ context.Context, u read
 	return nil
 }
 
+func (m *mockDirectionStore) StartReview(_ context.Context, u readmodels.ReviewPanelUpdate) error {
+	m.reviewPanels = append(m.reviewPanels, u)
+	return nil
+}
+func (m *mockDirectionStore) RecordReview(_ context.Context, u readmodels.ReviewVerdictUpdate) error {
+	m.reviewVerdicts = append(m.reviewVerdicts, u)
+	return nil
+}
+
 func projectViaJSON(t *testing.T, projector *DirectionProjector, eventType string, payload map[string]interface{}) error {
 	t.Helper()
 	data, err := json.Marshal(payload)
@@ -94,16 +105,43 @@ func TestDirectionProjector_StatusEvents_UpdateStatus(t *testing.T) {
 	id := uuid.New().String()
 	directionID := readmodels.DirectionID(id)
 
-	require.NoError(t, projectViaJSON(t, projector, "DirectionProposed", events.NewDirectionProposed(id).EventData()))
+	require.NoError(t, projectViaJSON(t, projector, "DirectionProposed", events.NewDirectionProposed(id, []string{"r1"}, 1).EventData()))
 	assert.Equal(t, "proposed", store.fieldUpdates[readmodels.DirectionFieldStatus][directionID])
 
-	require.NoError(t, projectViaJSON(t, projector, "DirectionAgreed", events.NewDirectionAgreed(id).EventData()))
+	require.NoError(t, projectViaJSON(t, projector, "DirectionAgreed", events.NewDirectionAgreed(id, events.DecisionRecordData{Decision: "Merge."}, "").EventData()))
 	assert.Equal(t, "agreed", store.fieldUpdates[readmodels.DirectionFieldStatus][directionID])
 
 	require.NoError(t, projectViaJSON(t, projector, "DirectionRejected", events.NewDirectionRejected(id).EventData()))
 	assert.Equal(t, "rejected", store.fieldUpdates[readmodels.DirectionFieldStatus][directionID])
 }
 
+func TestDirectionProjector_ReviewWorkflow(t *testing.T) {
+	store := newMockDirectionStore()
+	projector := NewDirectionProjector(store)
+	id := uuid.New().String()
+	directionID := readmodels.DirectionID(id)
+
+	proposed := events.NewDirectionProposed(id, []string{"r1", "r2"}, 1)
+	require.NoError(t, projectViaJSON(t, projector, proposed.EventType(), proposed.EventData()))
+	require.Len(t, store.reviewPanels, 1)
+	assert.Equal(t, readmodels.ReviewPanelUpdate{DirectionID: directionID, ReviewerIDs: []string{"r1", "r2"}, Quorum: 1}, store.reviewPanels[0])
+
+	reviewed := events.NewDirectionReviewRecorded(id, "r1", "approve", "Fine by me.")
+	require.NoError(t, projectViaJSON(t, projector, reviewed.EventType(), reviewed.EventData()))
+	require.Len(t, store.reviewVerdicts, 1)
+	assert.Equal(t, "r1", store.reviewVerdicts[0].ReviewerID)
+	assert.Equal(t, "approve", store.reviewVerdicts[0].Verdict)
+	assert.Equal(t, "Fine by me.", store.reviewVerdicts[0].Comment)
+}
+
+func TestDirectionProjector_LegacyProposalStartsNoReview(t *testing.T) {
+	store := newMockDirectionStore()
+	projector := NewDirectionProjector(store)
+
+	require.NoError(t, projectViaJSON(t, projector, "DirectionProposed", map[string]interface{}{"id": uuid.New().String()}))
+	assert.Empty(t, store.reviewPanels)
+}
+
 func TestDirectionProjector_NarrativeUpdated(t *testing.T) {
 	store := newMockDirectionStore()
 	projector := NewDirectionProjector(store)
@@ -0,0 +1,86 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/events"
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type JourneyProgrammeStore interface {
+	Insert(ctx context.Context, p readmodels.InsertJourneyProgrammeParams) error
+	Update(ctx context.Context, id, name, description string, updatedAt time.Time) error
+	Delete(ctx context.Context, id string) error
+	AddMember(ctx context.Context, programmeID, journeyID string, addedAt time.Time) error
+	RemoveMember(ctx context.Context, programmeID, journeyID string) error
+}
+
+type JourneyProgrammeProjector struct {
+	readModel JourneyProgrammeStore
+}
+
+func NewJourneyProgrammeProjector(readModel JourneyProgrammeStore) *JourneyProgrammeProjector {
+	return &JourneyProgrammeProjector{readModel: readModel}
+}
+
+func (p *JourneyProgrammeProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *JourneyProgrammeProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	handlers := map[string]func(context.Context, []byte) error{
+		pl.JourneyProgrammeCreated: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyCreated)
+		},
+		pl.JourneyProgrammeUpdated: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyUpdated)
+		},
+		pl.JourneyProgrammeJourneyAdded: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyJourneyAdded)
+		},
+		pl.JourneyProgrammeJourneyRemoved: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyJourneyRemoved)
+		},
+		pl.JourneyProgrammeDeleted: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyDeleted)
+		},
+	}
+	if handler, exists := handlers[eventType]; exists {
+		return handler(ctx, eventData)
+	}
+	return nil
+}
+
+func (p *JourneyProgrammeProjector) applyCreated(ctx context.Context, evt events.JourneyProgrammeCreated) error {
+	return p.readModel.Insert(ctx, readmodels.InsertJourneyProgrammeParams{
+		ID: evt.ID, Name: evt.Name, Description: evt.Description, CreatedBy: evt.CreatedBy, CreatedAt: evt.OccurredOn,
+	})
+}
+
+func (p *JourneyProgrammeProjector) applyUpdated(ctx context.Context, evt events.JourneyProgrammeUpdated) error {
+	return p.readModel.Update(ctx, evt.ID, evt.Name, evt.Description, evt.OccurredOn)
+}
+
+func (p *JourneyProgrammeProjector) applyJourneyAdded(ctx context.Context, evt events.JourneyProgrammeJourneyAdded) error {
+	return p.readModel.AddMember(ctx, evt.ID, evt.JourneyID, evt.OccurredOn)
+}
+
+func (p *JourneyProgrammeProjector) applyJourneyRemoved(ctx context.Context, evt events.JourneyProgrammeJourneyRemoved) error {
+	return p.readModel.RemoveMember(ctx, evt.ID, evt.JourneyID)
+}
+
+func (p *JourneyProgrammeProjector) applyDeleted(ctx context.Context, evt events.JourneyProgrammeDeleted) error {
+	return p.readModel.Delete(ctx, evt.ID)
+}
@@ -0,0 +1,105 @@
+package projectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/events"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockJourneyProgrammeStore struct {
+	inserted []readmodels.InsertJourneyProgrammeParams
+	renamed  map[string]string
+	deleted  []string
+	members  map[string][]string
+}
+
+func (m *mockJourneyProgrammeStore) Insert(_ context.Context, p readmodels.InsertJourneyProgrammeParams) error {
+	m.inserted = append(m.inserted, p)
+	return nil
+}
+
+func (m *mockJourneyProgrammeStore) Update(_ context.Context, id, name, _ string, _ time.Time) error {
+	if m.renamed == nil {
+		m.renamed = map[string]string{}
+	}
+	m.renamed[id] = name
+	return nil
+}
+
+func (m *mockJourneyProgrammeStore) Delete(_ context.Context, id string) error {
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+func (m *mockJourneyProgrammeStore) AddMember(_ context.Context, programmeID, journeyID string, _ time.Time) error {
+	if m.members == nil {
+		m.members = map[string][]string{}
+	}
+	m.members[programmeID] = append(m.members[programmeID], journeyID)
+	return nil
+}
+
+func (m *mockJourneyProgrammeStore) RemoveMember(_ context.Context, programmeID, journeyID string) error {
+	remaining := []string{}
+	for _, id := range m.members[programmeID] {
+		if id != journeyID {
+			remaining = append(remaining, id)
+		}
+	}
+	m.members[programmeID] = remaining
+	return nil
+}
+
+func TestJourneyProgrammeProjector_CreatedAndUpdated_MaintainProgramme(t *testing.T) {
+	store := &mockJourneyProgrammeStore{}
+	projector := NewJourneyProgrammeProjector(store)
+
+	id := uuid.New().String()
+	require.NoError(t, projector.Handle(context.Background(), events.NewJourneyProgrammeCreated(events.JourneyProgrammeCreatedFields{
+		ID: id, Name: "Core banking", Description: "Replace the mainframe", CreatedBy: "a@example.com",
+	})))
+	require.NoError(t, projector.Handle(context.Background(), events.NewJourneyProgrammeUpdated(events.JourneyProgrammeUpdatedFields{
+		ID: id, Name: "Core platform", UpdatedBy: "a@example.com",
+	})))
+
+	require.Len(t, store.inserted, 1)
+	assert.Equal(t, "Core banking", store.inserted[0].Name)
+	assert.Equal(t, "a@example.com", store.inserted[0].CreatedBy)
+	assert.Equal(t, map[string]string{id: "Core platform"}, store.renamed)
+}
+
+func TestJourneyProgrammeProjector_MembershipEvents_MaintainMembers(t *testing.T) {
+	store := &mockJourneyProgrammeStore{}
+	projector := NewJourneyProgrammeProjector(store)
+
+	id, kept, dropped := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	for _, journeyID := range []string{kept, dropped} {
+		require.NoError(t, projector.Handle(context.Background(), events.NewJourneyProgrammeJourneyAdded(events.JourneyProgrammeJourneyAddedFields{
+			ID: id, JourneyID: journeyID, AddedBy: "a@example.com",
+		})))
+	}
+	require.NoError(t, projector.Handle(context.Background(), events.NewJourneyProgrammeJourneyRemoved(events.JourneyProgrammeJourneyRemovedFields{
+		ID: id, JourneyID: dropped, RemovedBy: "a@example.com",
+	})))
+
+	assert.Equal(t, []string{kept}, store.members[id])
+}
+
+func TestJourneyProgrammeProjector_Deleted_DeletesProgramme(t *testing.T) {
+	store := &mockJourneyProgrammeStore{}
+	projector := NewJourneyProgrammeProjector(store)
+
+	id := uuid.New().String()
+	require.NoError(t, projector.Handle(context.Background(), events.NewJourneyProgrammeDeleted(events.JourneyProgrammeDeletedFields{
+		ID: id, DeletedBy: "a@example.com",
+	})))
+
+	assert.Equal(t, []string{id}, store.deleted)
+}
@@ -0,0 +1,91 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/events"
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type JourneyTemplateStore interface {
+	Insert(ctx context.Context, p readmodels.InsertJourneyTemplateParams) error
+	Revise(ctx context.Context, p readmodels.ReviseJourneyTemplateParams) error
+	Retire(ctx context.Context, id, retiredBy string, retiredAt time.Time) error
+}
+
+type JourneyTemplateProjector struct {
+	readModel JourneyTemplateStore
+}
+
+func NewJourneyTemplateProjector(readModel JourneyTemplateStore) *JourneyTemplateProjector {
+	return &JourneyTemplateProjector{readModel: readModel}
+}
+
+func (p *JourneyTemplateProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *JourneyTemplateProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	handlers := map[string]func(context.Context, []byte) error{
+		pl.JourneyTemplateCreated: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyCreated)
+		},
+		pl.JourneyTemplateRevised: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyRevised)
+		},
+		pl.JourneyTemplateRetired: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyRetired)
+		},
+	}
+	if handler, exists := handlers[eventType]; exists {
+		return handler(ctx, eventData)
+	}
+	return nil
+}
+
+func (p *JourneyTemplateProjector) applyCreated(ctx context.Context, evt events.JourneyTemplateCreated) error {
+	return p.readModel.Insert(ctx, readmodels.InsertJourneyTemplateParams{
+		ID:         evt.ID,
+		Kind:       evt.Kind,
+		Name:       evt.Name,
+		Version:    evt.Version,
+		Milestones: templateMilestoneDTOs(evt.Milestones),
+		CreatedBy:  evt.CreatedBy,
+		CreatedAt:  evt.OccurredOn,
+	})
+}
+
+func (p *JourneyTemplateProjector) applyRevised(ctx context.Context, evt events.JourneyTemplateRevised) error {
+	return p.readModel.Revise(ctx, readmodels.ReviseJourneyTemplateParams{
+		ID:         evt.ID,
+		Name:       evt.Name,
+		Version:    evt.Version,
+		Milestones: templateMilestoneDTOs(evt.Milestones),
+		RevisedBy:  evt.RevisedBy,
+		RevisedAt:  evt.OccurredOn,
+	})
+}
+
+func (p *JourneyTemplateProjector) applyRetired(ctx context.Context, evt events.JourneyTemplateRetired) error {
+	return p.readModel.Retire(ctx, evt.ID, evt.RetiredBy, evt.OccurredOn)
+}
+
+func templateMilestoneDTOs(milestones []events.TemplateMilestoneData) []readmodels.TemplateMilestoneDTO {
+	out := make([]readmodels.TemplateMilestoneDTO, len(milestones))
+	for i, m := range milestones {
+		out[i] = readmodels.TemplateMilestoneDTO{Label: m.Label, OffsetQuarters: m.OffsetQuarters}
+	}
+	return out
+}
@@ -0,0 +1,62 @@
+package projectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/events"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockJourneyTemplateStore struct {
+	inserted []readmodels.InsertJourneyTemplateParams
+	revised  []readmodels.ReviseJourneyTemplateParams
+	retired  []string
+}
+
+func (m *mockJourneyTemplateStore) Insert(_ context.Context, p readmodels.InsertJourneyTemplateParams) error {
+	m.inserted = append(m.inserted, p)
+	return nil
+}
+
+func (m *mockJourneyTemplateStore) Revise(_ context.Context, p readmodels.ReviseJourneyTemplateParams) error {
+	m.revised = append(m.revised, p)
+	return nil
+}
+
+func (m *mockJourneyTemplateStore) Retire(_ context.Context, id, _ string, _ time.Time) error {
+	m.retired = append(m.retired, id)
+	return nil
+}
+
+func TestJourneyTemplateProjector_LifecycleEvents_MaintainTemplate(t *testing.T) {
+	store := &mockJourneyTemplateStore{}
+	projector := NewJourneyTemplateProjector(store)
+	ctx := context.Background()
+
+	id := uuid.New().String()
+	require.NoError(t, projector.Handle(ctx, events.NewJourneyTemplateCreated(events.JourneyTemplateCreatedFields{
+		ID: id, Kind: "migration", Name: "SaaS migration", Version: 1, CreatedBy: "a@example.com",
+		Milestones: []events.TemplateMilestoneData{{Label: "Vendor selection", OffsetQuarters: 1}},
+	})))
+	require.NoError(t, projector.Handle(ctx, events.NewJourneyTemplateRevised(events.JourneyTemplateRevisedFields{
+		ID: id, Name: "SaaS migration", Version: 2, RevisedBy: "a@example.com",
+		Milestones: []events.TemplateMilestoneData{{Label: "Parallel run", OffsetQuarters: 3}},
+	})))
+	require.NoError(t, projector.Handle(ctx, events.NewJourneyTemplateRetired(events.JourneyTemplateRetiredFields{
+		ID: id, RetiredBy: "a@example.com",
+	})))
+
+	require.Len(t, store.inserted, 1)
+	assert.Equal(t, "migration", store.inserted[0].Kind)
+	assert.Equal(t, []readmodels.TemplateMilestoneDTO{{Label: "Vendor selection", OffsetQuarters: 1}}, store.inserted[0].Milestones)
+	require.Len(t, store.revised, 1)
+	assert.Equal(t, 2, store.revised[0].Version)
+	assert.Equal(t, []readmodels.TemplateMilestoneDTO{{Label: "Parallel run", OffsetQuarters: 3}}, store.revised[0].Milestones)
+	assert.Equal(t, []string{id}, store.retired)
+}
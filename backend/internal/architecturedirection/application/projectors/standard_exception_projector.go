@@ -0,0 +1,91 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/events"
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type StandardExceptionStore interface {
+	Insert(ctx context.Context, p readmodels.InsertStandardExceptionParams) error
+	Renew(ctx context.Context, p readmodels.RenewStandardExceptionParams) error
+	Revoke(ctx context.Context, id, revokedBy string, revokedAt time.Time) error
+	MarkExpired(ctx context.Context, id string, expiredAt time.Time) error
+}
+
+type StandardExceptionProjector struct {
+	readModel StandardExceptionStore
+}
+
+func NewStandardExceptionProjector(readModel StandardExceptionStore) *StandardExceptionProjector {
+	return &StandardExceptionProjector{readModel: readModel}
+}
+
+func (p *StandardExceptionProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *StandardExceptionProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	handlers := map[string]func(context.Context, []byte) error{
+		pl.StandardExceptionGranted: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyGranted)
+		},
+		pl.StandardExceptionRenewed: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyRenewed)
+		},
+		pl.StandardExceptionRevoked: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyRevoked)
+		},
+		pl.StandardExceptionExpired: func(ctx context.Context, data []byte) error {
+			return handleProjection(ctx, data, p.applyExpired)
+		},
+	}
+	if handler, exists := handlers[eventType]; exists {
+		return handler(ctx, eventData)
+	}
+	return nil
+}
+
+func (p *StandardExceptionProjector) applyGranted(ctx context.Context, evt events.StandardExceptionGranted) error {
+	return p.readModel.Insert(ctx, readmodels.InsertStandardExceptionParams{
+		ID:            evt.ID,
+		CapabilityID:  evt.CapabilityID,
+		ComponentID:   evt.ComponentID,
+		Justification: evt.Justification,
+		ApproverID:    evt.ApproverID,
+		ExpiresOn:     evt.ExpiresOn,
+		GrantedBy:     evt.GrantedBy,
+		GrantedAt:     evt.OccurredOn,
+	})
+}
+
+func (p *StandardExceptionProjector) applyRenewed(ctx context.Context, evt events.StandardExceptionRenewed) error {
+	return p.readModel.Renew(ctx, readmodels.RenewStandardExceptionParams{
+		ID:            evt.ID,
+		Justification: evt.Justification,
+		ApproverID:    evt.ApproverID,
+		ExpiresOn:     evt.ExpiresOn,
+		RenewedAt:     evt.OccurredOn,
+	})
+}
+
+func (p *StandardExceptionProjector) applyRevoked(ctx context.Context, evt events.StandardExceptionRevoked) error {
+	return p.readModel.Revoke(ctx, evt.ID, evt.RevokedBy, evt.OccurredOn)
+}
+
+func (p *StandardExceptionProjector) applyExpired(ctx context.Context, evt events.StandardExceptionExpired) error {
+	return p.readModel.MarkExpired(ctx, evt.ID, evt.OccurredOn)
+}
@@ -0,0 +1,75 @@
+package projectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/events"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStandardExceptionStore struct {
+	inserted []readmodels.InsertStandardExceptionParams
+	renewed  []readmodels.RenewStandardExceptionParams
+	revoked  map[string]string
+	expired  []string
+}
+
+func (m *mockStandardExceptionStore) Insert(_ context.Context, p readmodels.InsertStandardExceptionParams) error {
+	m.inserted = append(m.inserted, p)
+	return nil
+}
+
+func (m *mockStandardExceptionStore) Renew(_ context.Context, p readmodels.RenewStandardExceptionParams) error {
+	m.renewed = append(m.renewed, p)
+	return nil
+}
+
+func (m *mockStandardExceptionStore) Revoke(_ context.Context, id, revokedBy string, _ time.Time) error {
+	if m.revoked == nil {
+		m.revoked = map[string]string{}
+	}
+	m.revoked[id] = revokedBy
+	return nil
+}
+
+func (m *mockStandardExceptionStore) MarkExpired(_ context.Context, id string, _ time.Time) error {
+	m.expired = append(m.expired, id)
+	return nil
+}
+
+func TestStandardExceptionProjector_MaintainsRegister(t *testing.T) {
+	store := &mockStandardExceptionStore{}
+	projector := NewStandardExceptionProjector(store)
+	id := uuid.New().String()
+	expiresOn := time.Date(2027, time.June, 30, 0, 0, 0, 0, time.UTC)
+	renewedTo := time.Date(2027, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, projector.Handle(context.Background(), events.NewStandardExceptionGranted(events.StandardExceptionGrantedFields{
+		ID: id, CapabilityID: "cap-1", ComponentID: "app-1", Justification: "Contract",
+		ApproverID: "cio@example.com", ExpiresOn: expiresOn, GrantedBy: "a@example.com",
+	})))
+	require.NoError(t, projector.Handle(context.Background(), events.NewStandardExceptionRenewed(events.StandardExceptionRenewedFields{
+		ID: id, Justification: "Slipped", ApproverID: "cto@example.com", ExpiresOn: renewedTo, RenewedBy: "a@example.com",
+	})))
+	require.NoError(t, projector.Handle(context.Background(), events.NewStandardExceptionExpired(events.StandardExceptionExpiredFields{
+		ID: id, CapabilityID: "cap-1", ComponentID: "app-1", ExpiresOn: renewedTo,
+	})))
+	require.NoError(t, projector.Handle(context.Background(), events.NewStandardExceptionRevoked(events.StandardExceptionRevokedFields{
+		ID: id, RevokedBy: "a@example.com",
+	})))
+
+	require.Len(t, store.inserted, 1)
+	assert.Equal(t, "app-1", store.inserted[0].ComponentID)
+	assert.True(t, expiresOn.Equal(store.inserted[0].ExpiresOn))
+	require.Len(t, store.renewed, 1)
+	assert.Equal(t, "cto@example.com", store.renewed[0].ApproverID)
+	assert.True(t, renewedTo.Equal(store.renewed[0].ExpiresOn))
+	assert.Equal(t, []string{id}, store.expired)
+	assert.Equal(t, map[string]string{id: "a@example.com"}, store.revoked)
+}
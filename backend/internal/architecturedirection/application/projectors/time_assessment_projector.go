@@ -15,6 +15,7 @@ import (
 type TimeAssessmentStore interface {
 	UpsertCurrent(ctx context.Context, p readmodels.UpsertTimeAssessmentParams) error
 	Delete(ctx context.Context, id string) error
+	AppendHistory(ctx context.Context, p readmodels.AppendTimeAssessmentHistoryParams) error
 }
 
 type TimeAssessmentProjector struct {
@@ -51,7 +52,7 @@ func (p *TimeAssessmentProjector) handleRecorded(ctx context.Context, eventData
 	if err := json.Unmarshal(eventData, &evt); err != nil {
 		return fmt.Errorf("unmarshal TimeAssessmentRecorded payload: %w", err)
 	}
-	return p.readModel.UpsertCurrent(ctx, readmodels.UpsertTimeAssessmentParams{
+	if err := p.readModel.UpsertCurrent(ctx, readmodels.UpsertTimeAssessmentParams{
 		ID:            evt.ID,
 		CapabilityID:  evt.CapabilityID,
 		ComponentID:   evt.ComponentID,
@@ -60,6 +61,17 @@ func (p *TimeAssessmentProjector) handleRecorded(ctx context.Context, eventData
 		Rationale:     evt.Rationale,
 		AssessedBy:    evt.AssessedBy,
 		AssessedAt:    evt.OccurredOn,
+	}); err != nil {
+		return err
+	}
+	return p.readModel.AppendHistory(ctx, readmodels.AppendTimeAssessmentHistoryParams{
+		TimeAssessmentID: evt.ID,
+		CapabilityID:     evt.CapabilityID,
+		ComponentID:      evt.ComponentID,
+		Grade:            evt.Grade,
+		Rationale:        evt.Rationale,
+		ChangedBy:        evt.AssessedBy,
+		ChangedAt:        evt.OccurredOn,
 	})
 }
 
@@ -68,5 +80,14 @@ func (p *TimeAssessmentProjector) handleRemoved(ctx context.Context, eventData [
 	if err := json.Unmarshal(eventData, &evt); err != nil {
 		return fmt.Errorf("unmarshal TimeAssessmentRemoved payload: %w", err)
 	}
-	return p.readModel.Delete(ctx, evt.ID)
+	if err := p.readModel.Delete(ctx, evt.ID); err != nil {
+		return err
+	}
+	return p.readModel.AppendHistory(ctx, readmodels.AppendTimeAssessmentHistoryParams{
+		TimeAssessmentID: evt.ID,
+		CapabilityID:     evt.CapabilityID,
+		ComponentID:      evt.ComponentID,
+		ChangedBy:        evt.RemovedBy,
+		ChangedAt:        evt.OccurredOn,
+	})
 }
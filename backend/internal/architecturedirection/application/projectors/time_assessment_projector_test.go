@@ -16,10 +16,12 @@ import (
 )
 
 type mockTimeAssessmentStore struct {
-	upserts   []readmodels.UpsertTimeAssessmentParams
-	deletedID []string
-	upsertErr error
-	deleteErr error
+	upserts    []readmodels.UpsertTimeAssessmentParams
+	deletedID  []string
+	history    []readmodels.AppendTimeAssessmentHistoryParams
+	upsertErr  error
+	deleteErr  error
+	historyErr error
 }
 
 func (m *mockTimeAssessmentStore) UpsertCurrent(_ context.Context, p readmodels.UpsertTimeAssessmentParams) error {
@@ -38,6 +40,14 @@ func (m *mockTimeAssessmentStore) Delete(_ context.Context, id string) error {
 	return nil
 }
 
+func (m *mockTimeAssessmentStore) AppendHistory(_ context.Context, p readmodels.AppendTimeAssessmentHistoryParams) error {
+	if m.historyErr != nil {
+		return m.historyErr
+	}
+	m.history = append(m.history, p)
+	return nil
+}
+
 func projectTimeAssessmentEvent(t *testing.T, projector *TimeAssessmentProjector, eventType string, payload map[string]interface{}) error {
 	t.Helper()
 	data, err := json.Marshal(payload)
@@ -74,6 +84,12 @@ func TestTimeAssessmentProjector_Recorded_UpsertsRow(t *testing.T) {
 	assert.Equal(t, "carve-out candidate", store.upserts[0].Rationale)
 	assert.Equal(t, "a@example.com", store.upserts[0].AssessedBy)
 	assert.False(t, store.upserts[0].AssessedAt.IsZero())
+
+	require.Len(t, store.history, 1)
+	assert.Equal(t, id, store.history[0].TimeAssessmentID)
+	assert.Equal(t, valueobjects.TimeGradeMigrate, store.history[0].Grade)
+	assert.Equal(t, "a@example.com", store.history[0].ChangedBy)
+	assert.Equal(t, store.upserts[0].AssessedAt, store.history[0].ChangedAt)
 }
 
 func TestTimeAssessmentProjector_Removed_DeletesRow(t *testing.T) {
@@ -91,6 +107,10 @@ func TestTimeAssessmentProjector_Removed_DeletesRow(t *testing.T) {
 	require.NoError(t, projectTimeAssessmentEvent(t, projector, evt.EventType(), evt.EventData()))
 
 	assert.Equal(t, []string{id}, store.deletedID)
+	require.Len(t, store.history, 1)
+	assert.Equal(t, id, store.history[0].TimeAssessmentID)
+	assert.Empty(t, store.history[0].Grade, "a removal closes the assessment's history")
+	assert.Equal(t, "a@example.com", store.history[0].ChangedBy)
 }
 
 func TestTimeAssessmentProjector_UnknownEvent_NoOp(t *testing.T) {
@@ -127,6 +147,8 @@ func TestTimeAssessmentProjector_StoreErrors_Propagate(t *testing.T) {
 	}{
 		{"upsert error on recorded", &mockTimeAssessmentStore{upsertErr: errors.New("db")}, recorded.EventType(), recorded.EventData()},
 		{"delete error on removed", &mockTimeAssessmentStore{deleteErr: errors.New("db")}, removed.EventType(), removed.EventData()},
+		{"history error on recorded", &mockTimeAssessmentStore{historyErr: errors.New("db")}, recorded.EventType(), recorded.EventData()},
+		{"history error on removed", &mockTimeAssessmentStore{historyErr: errors.New("db")}, removed.EventType(), removed.EventData()},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,90 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	cmPL "easi/backend/internal/capabilitymapping/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const (
+	transferredBySystemRealizationReassigned = "system:realization-reassigned"
+	transferredBySystemRealizationMoved      = "system:realization-moved"
+)
+
+type TimeAssessmentReassignmentReactor struct {
+	commands CommandDispatcher
+}
+
+func NewTimeAssessmentReassignmentReactor(commandDispatcher CommandDispatcher) *TimeAssessmentReassignmentReactor {
+	return &TimeAssessmentReassignmentReactor{commands: commandDispatcher}
+}
+
+func (r *TimeAssessmentReassignmentReactor) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return r.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (r *TimeAssessmentReassignmentReactor) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	switch eventType {
+	case cmPL.SystemRealizationReassigned:
+		return r.transferReassigned(ctx, eventData)
+	case cmPL.SystemRealizationMoved:
+		return r.transferMoved(ctx, eventData)
+	}
+	return nil
+}
+
+func (r *TimeAssessmentReassignmentReactor) transferReassigned(ctx context.Context, eventData []byte) error {
+	var payload struct {
+		ID              string `json:"id"`
+		CapabilityID    string `json:"capabilityId"`
+		FromComponentID string `json:"fromComponentId"`
+		ToComponentID   string `json:"toComponentId"`
+	}
+	if err := json.Unmarshal(eventData, &payload); err != nil {
+		return fmt.Errorf("unmarshal SystemRealizationReassigned payload: %w", err)
+	}
+	if _, err := r.commands.Dispatch(ctx, &commands.TransferTimeAssessment{
+		CapabilityID:    payload.CapabilityID,
+		FromComponentID: payload.FromComponentID,
+		ToComponentID:   payload.ToComponentID,
+		RealizationID:   payload.ID,
+		TransferredBy:   transferredBySystemRealizationReassigned,
+	}); err != nil {
+		return fmt.Errorf("transfer time assessment for reassigned realization %s: %w", payload.ID, err)
+	}
+	return nil
+}
+
+func (r *TimeAssessmentReassignmentReactor) transferMoved(ctx context.Context, eventData []byte) error {
+	var payload struct {
+		ID               string `json:"id"`
+		ComponentID      string `json:"componentId"`
+		FromCapabilityID string `json:"fromCapabilityId"`
+		ToCapabilityID   string `json:"toCapabilityId"`
+	}
+	if err := json.Unmarshal(eventData, &payload); err != nil {
+		return fmt.Errorf("unmarshal SystemRealizationMoved payload: %w", err)
+	}
+	if _, err := r.commands.Dispatch(ctx, &commands.TransferTimeAssessment{
+		CapabilityID:    payload.FromCapabilityID,
+		ToCapabilityID:  payload.ToCapabilityID,
+		FromComponentID: payload.ComponentID,
+		ToComponentID:   payload.ComponentID,
+		RealizationID:   payload.ID,
+		TransferredBy:   transferredBySystemRealizationMoved,
+	}); err != nil {
+		return fmt.Errorf("transfer time assessment for moved realization %s: %w", payload.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package projectors
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeAssessmentReassignmentReactor_DispatchesTransfer(t *testing.T) {
+	realizationID, capID, fromID, toID := uuid.New().String(), uuid.New().String(), uuid.New().String(), uuid.New().String()
+	dispatcher := &fakeDispatcher{}
+	reactor := NewTimeAssessmentReassignmentReactor(dispatcher)
+
+	err := reactor.ProjectEvent(context.Background(), "SystemRealizationReassigned",
+		[]byte(`{"id":"`+realizationID+`","capabilityId":"`+capID+`","fromComponentId":"`+fromID+`","toComponentId":"`+toID+`"}`))
+
+	require.NoError(t, err)
+	require.Len(t, dispatcher.dispatched, 1)
+	assert.Equal(t, &commands.TransferTimeAssessment{
+		CapabilityID:    capID,
+		FromComponentID: fromID,
+		ToComponentID:   toID,
+		RealizationID:   realizationID,
+		TransferredBy:   "system:realization-reassigned",
+	}, dispatcher.dispatched[0])
+}
+
+func TestTimeAssessmentReassignmentReactor_RealizationMoved_DispatchesTransferToCapability(t *testing.T) {
+	realizationID, componentID, fromCapID, toCapID := uuid.New().String(), uuid.New().String(), uuid.New().String(), uuid.New().String()
+	dispatcher := &fakeDispatcher{}
+	reactor := NewTimeAssessmentReassignmentReactor(dispatcher)
+
+	err := reactor.ProjectEvent(context.Background(), "SystemRealizationMoved",
+		[]byte(`{"id":"`+realizationID+`","componentId":"`+componentID+`","fromCapabilityId":"`+fromCapID+`","toCapabilityId":"`+toCapID+`"}`))
+
+	require.NoError(t, err)
+	require.Len(t, dispatcher.dispatched, 1)
+	assert.Equal(t, &commands.TransferTimeAssessment{
+		CapabilityID:    fromCapID,
+		ToCapabilityID:  toCapID,
+		FromComponentID: componentID,
+		ToComponentID:   componentID,
+		RealizationID:   realizationID,
+		TransferredBy:   "system:realization-moved",
+	}, dispatcher.dispatched[0])
+}
+
+func TestTimeAssessmentReassignmentReactor_OtherEventTypes_Ignored(t *testing.T) {
+	dispatcher := &fakeDispatcher{}
+	reactor := NewTimeAssessmentReassignmentReactor(dispatcher)
+
+	err := reactor.ProjectEvent(context.Background(), "SystemRealizationDeleted", []byte(`{"id":"x"}`))
+
+	require.NoError(t, err)
+	assert.Empty(t, dispatcher.dispatched)
+}
@@ -0,0 +1,55 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/events"
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type TimeSuggestionRejectionStore interface {
+	Upsert(ctx context.Context, dto readmodels.TimeSuggestionRejectionDTO) error
+}
+
+type TimeSuggestionRejectionProjector struct {
+	readModel TimeSuggestionRejectionStore
+}
+
+func NewTimeSuggestionRejectionProjector(readModel TimeSuggestionRejectionStore) *TimeSuggestionRejectionProjector {
+	return &TimeSuggestionRejectionProjector{readModel: readModel}
+}
+
+func (p *TimeSuggestionRejectionProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *TimeSuggestionRejectionProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	if eventType != pl.TimeSuggestionRejected {
+		return nil
+	}
+	var evt events.TimeSuggestionRejected
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return fmt.Errorf("unmarshal TimeSuggestionRejected payload: %w", err)
+	}
+	return p.readModel.Upsert(ctx, readmodels.TimeSuggestionRejectionDTO{
+		ID:                  evt.ID,
+		CapabilityID:        evt.CapabilityID,
+		ComponentID:         evt.ComponentID,
+		SuggestedGrade:      evt.SuggestedGrade,
+		EvidenceFingerprint: evt.EvidenceFingerprint,
+		Reason:              evt.Reason,
+		RejectedBy:          evt.RejectedBy,
+		RejectedAt:          evt.OccurredOn,
+	})
+}
@@ -0,0 +1,61 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/events"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTimeSuggestionRejectionStore struct {
+	upserts []readmodels.TimeSuggestionRejectionDTO
+}
+
+func (m *mockTimeSuggestionRejectionStore) Upsert(_ context.Context, dto readmodels.TimeSuggestionRejectionDTO) error {
+	m.upserts = append(m.upserts, dto)
+	return nil
+}
+
+func TestTimeSuggestionRejectionProjector_Rejected_UpsertsRow(t *testing.T) {
+	store := &mockTimeSuggestionRejectionStore{}
+	projector := NewTimeSuggestionRejectionProjector(store)
+	id, capID, compID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+
+	evt := events.NewTimeSuggestionRejected(events.TimeSuggestionRejectedFields{
+		ID:                  id,
+		CapabilityID:        capID,
+		ComponentID:         compID,
+		SuggestedGrade:      valueobjects.TimeGradeEliminate,
+		EvidenceFingerprint: "fp",
+		Reason:              "replacement already contracted",
+		RejectedBy:          "a@example.com",
+	})
+	data, err := json.Marshal(evt.EventData())
+	require.NoError(t, err)
+	require.NoError(t, projector.ProjectEvent(context.Background(), evt.EventType(), data))
+
+	require.Len(t, store.upserts, 1)
+	got := store.upserts[0]
+	assert.Equal(t, id, got.ID)
+	assert.Equal(t, capID, got.CapabilityID)
+	assert.Equal(t, compID, got.ComponentID)
+	assert.Equal(t, valueobjects.TimeGradeEliminate, got.SuggestedGrade)
+	assert.Equal(t, "fp", got.EvidenceFingerprint)
+	assert.Equal(t, "replacement already contracted", got.Reason)
+	assert.False(t, got.RejectedAt.IsZero())
+}
+
+func TestTimeSuggestionRejectionProjector_UnknownEventIgnored(t *testing.T) {
+	store := &mockTimeSuggestionRejectionStore{}
+	projector := NewTimeSuggestionRejectionProjector(store)
+
+	require.NoError(t, projector.ProjectEvent(context.Background(), "SomethingElse", []byte(`{}`)))
+	assert.Empty(t, store.upserts)
+}
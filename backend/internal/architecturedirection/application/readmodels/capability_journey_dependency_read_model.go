@@ -0,0 +1,141 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+)
+
+func (rm *CapabilityJourneyReadModel) AddDependency(ctx context.Context, p JourneyDependencyParams) error {
+	return rm.tenantExec(ctx,
+		`INSERT INTO architecturedirection.capability_journey_dependencies
+		 (tenant_id, journey_id, predecessor_id, dependency_type, added_at)
+		 VALUES ($1, $2, $3, $4, $5)
+		 ON CONFLICT (tenant_id, journey_id, predecessor_id) DO UPDATE SET dependency_type = EXCLUDED.dependency_type`,
+		func(t string) []any { return []any{t, p.JourneyID, p.PredecessorID, p.DependencyType, p.AddedAt} },
+	)
+}
+
+func (rm *CapabilityJourneyReadModel) RemoveDependency(ctx context.Context, journeyID, predecessorID string) error {
+	return rm.tenantExec(ctx,
+		`DELETE FROM architecturedirection.capability_journey_dependencies
+		 WHERE tenant_id = $1 AND journey_id = $2 AND predecessor_id = $3`,
+		func(t string) []any { return []any{t, journeyID, predecessorID} },
+	)
+}
+
+func (rm *CapabilityJourneyReadModel) RecordUnmetPredecessorsAtStart(ctx context.Context, journeyID string, predecessorIDs []string) error {
+	return rm.tenantExec(ctx,
+		`UPDATE architecturedirection.capability_journeys SET started_with_unmet_predecessor_ids = $1
+		 WHERE tenant_id = $2 AND id = $3`,
+		func(t string) []any { return []any{pq.Array(predecessorIDs), t, journeyID} },
+	)
+}
+
+func (rm *CapabilityJourneyReadModel) JourneyExists(ctx context.Context, journeyID string) (bool, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return false, err
+	}
+	var exists bool
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM architecturedirection.capability_journeys WHERE tenant_id = $1 AND id = $2)`,
+			tenantID, journeyID,
+		).Scan(&exists)
+	})
+	return exists, err
+}
+
+func (rm *CapabilityJourneyReadModel) DependencyGraph(ctx context.Context) (services.JourneyDependencyGraph, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	graph := services.JourneyDependencyGraph{}
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT journey_id, predecessor_id FROM architecturedirection.capability_journey_dependencies WHERE tenant_id = $1`,
+			tenantID,
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+		for rows.Next() {
+			var journeyID, predecessorID string
+			if err := rows.Scan(&journeyID, &predecessorID); err != nil {
+				return err
+			}
+			graph[journeyID] = append(graph[journeyID], predecessorID)
+		}
+		return rows.Err()
+	})
+	return graph, err
+}
+
+func (rm *CapabilityJourneyReadModel) UnmetPredecessorIDs(ctx context.Context, journeyID string) ([]string, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var dependencies []JourneyDependencyDTO
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		loaded, err := loadJourneyDependencies(ctx, tx, tenantID, journeyID)
+		dependencies = loaded
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	var unmet []string
+	for _, d := range dependencies {
+		if !d.Satisfied {
+			unmet = append(unmet, d.PredecessorID)
+		}
+	}
+	return unmet, nil
+}
+
+func loadJourneyDependencies(ctx context.Context, tx *sql.Tx, tenantID, journeyID string) ([]JourneyDependencyDTO, error) {
+	rows, err := tx.QueryContext(ctx,
+		`SELECT d.predecessor_id, COALESCE(p.capability_name, ''), COALESCE(p.status, ''), d.dependency_type
+		 FROM architecturedirection.capability_journey_dependencies d
+		 LEFT JOIN architecturedirection.capability_journeys p ON p.tenant_id = d.tenant_id AND p.id = d.predecessor_id
+		 WHERE d.tenant_id = $1 AND d.journey_id = $2
+		 ORDER BY d.added_at, d.predecessor_id`,
+		tenantID, journeyID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	out := []JourneyDependencyDTO{}
+	for rows.Next() {
+		var d JourneyDependencyDTO
+		if err := rows.Scan(&d.PredecessorID, &d.PredecessorCapabilityName, &d.PredecessorStatus, &d.DependencyType); err != nil {
+			return nil, err
+		}
+		d.Satisfied = dependencySatisfied(d.DependencyType, d.PredecessorStatus)
+		out = append(out, d)
+	}
+	return out, rows.Err()
+}
+
+// dependencySatisfied defers to the domain rule; rows that no longer parse (for
+// instance a predecessor missing from the read model) count as unmet.
+func dependencySatisfied(dependencyType, predecessorStatus string) bool {
+	depType, err := valueobjects.NewJourneyDependencyType(dependencyType)
+	if err != nil {
+		return false
+	}
+	status, err := valueobjects.NewJourneyStatus(predecessorStatus)
+	if err != nil {
+		return false
+	}
+	return depType.SatisfiedBy(status)
+}
@@ -32,7 +32,26 @@ type CapabilityJourneyMilestoneDTO struct {
 	Label        string           `json:"label"`
 	TargetPeriod *TargetPeriodDTO `json:"targetPeriod"`
 	Status       string           `json:"status"`
-	Links        types.Links      `json:"_links,omitempty"`
+	// BaselineTargetPeriod is the target committed to when the journey started,
+	// or when the milestone was added to an in-flight journey.
+	BaselineTargetPeriod *TargetPeriodDTO `json:"baselineTargetPeriod,omitempty"`
+	Links                types.Links      `json:"_links,omitempty"`
+}
+
+// JourneyTemplateOriginDTO names the journey template version whose milestones
+// the journey was seeded with.
+type JourneyTemplateOriginDTO struct {
+	TemplateID string `json:"templateId"`
+	Version    int    `json:"version"`
+}
+
+type JourneyDependencyDTO struct {
+	PredecessorID             string      `json:"predecessorId"`
+	PredecessorCapabilityName string      `json:"predecessorCapabilityName"`
+	PredecessorStatus         string      `json:"predecessorStatus"`
+	DependencyType            string      `json:"dependencyType"`
+	Satisfied                 bool        `json:"satisfied"`
+	Links                     types.Links `json:"_links,omitempty"`
 }
 
 type CapabilityJourneyDTO struct {
@@ -49,14 +68,23 @@ type CapabilityJourneyDTO struct {
 	ToApplication    JourneyApplicationRefDTO        `json:"toApplication"`
 	Move             *JourneyMoveDTO                 `json:"move,omitempty"`
 	Milestones       []CapabilityJourneyMilestoneDTO `json:"milestones"`
-	PlannedBy        string                          `json:"plannedBy"`
-	PlannedByName    string                          `json:"plannedByName"`
-	PlannedAt        time.Time                       `json:"plannedAt"`
-	UpdatedAt        *time.Time                      `json:"updatedAt,omitempty"`
-	StartedAt        *time.Time                      `json:"startedAt"`
-	CompletedAt      *time.Time                      `json:"completedAt"`
-	AbandonedAt      *time.Time                      `json:"abandonedAt"`
-	Links            types.Links                     `json:"_links,omitempty"`
+	Dependencies     []JourneyDependencyDTO          `json:"dependencies"`
+	Template         *JourneyTemplateOriginDTO       `json:"template,omitempty"`
+	// BaselineTargetPeriod is the target period agreed when the journey was
+	// started; it stays fixed while TargetPeriod moves.
+	BaselineTargetPeriod *TargetPeriodDTO `json:"baselineTargetPeriod,omitempty"`
+	BaselinedAt          *time.Time       `json:"baselinedAt,omitempty"`
+	// StartedWithUnmetPredecessorIDs is the warning recorded when the journey
+	// was started before all of its predecessors allowed it.
+	StartedWithUnmetPredecessorIDs []string    `json:"startedWithUnmetPredecessorIds,omitempty"`
+	PlannedBy                      string      `json:"plannedBy"`
+	PlannedByName                  string      `json:"plannedByName"`
+	PlannedAt                      time.Time   `json:"plannedAt"`
+	UpdatedAt                      *time.Time  `json:"updatedAt,omitempty"`
+	StartedAt                      *time.Time  `json:"startedAt"`
+	CompletedAt                    *time.Time  `json:"completedAt"`
+	AbandonedAt                    *time.Time  `json:"abandonedAt"`
+	Links                          types.Links `json:"_links,omitempty"`
 }
 
 type InsertJourneyParams struct {
@@ -71,6 +99,8 @@ type InsertJourneyParams struct {
 	TargetDomainID   string
 	TargetParentID   string
 	ResultingName    string
+	TemplateID       string
+	TemplateVersion  int
 	PlannedBy        string
 	PlannedAt        time.Time
 }
@@ -113,3 +143,10 @@ type JourneyMilestoneUpsertParams struct {
 	Status        string
 	UpdatedAt     time.Time
 }
+
+type JourneyDependencyParams struct {
+	JourneyID      string
+	PredecessorID  string
+	DependencyType string
+	AddedAt        time.Time
+}
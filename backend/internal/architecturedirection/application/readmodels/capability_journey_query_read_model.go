@@ -143,7 +143,9 @@ const journeyColumns = `id, capability_id, COALESCE(capability_name, ''), capabi
 	to_component_id, COALESCE(to_component_name, ''), to_component_stale,
 	COALESCE(target_domain_id, ''), COALESCE(target_domain_name, ''), target_domain_stale,
 	COALESCE(target_parent_id, ''), COALESCE(target_parent_name, ''), target_parent_stale, resulting_name,
-	planned_by, planned_by_name, planned_at, updated_at, started_at, completed_at, abandoned_at`
+	planned_by, planned_by_name, planned_at, updated_at, started_at, completed_at, abandoned_at,
+	started_with_unmet_predecessor_ids, baseline_year, baseline_quarter, baselined_at,
+	COALESCE(template_id, ''), template_version`
 
 type journeyRowScanner interface {
 	Scan(dest ...any) error
@@ -152,6 +154,10 @@ type journeyRowScanner interface {
 type journeyScanBuffer struct {
 	progress                                       sql.NullInt64
 	targetYear, targetQuarter                      sql.NullInt64
+	baselineYear, baselineQuarter                  sql.NullInt64
+	baselinedAt                                    sql.NullTime
+	templateID                                     string
+	templateVersion                                sql.NullInt64
 	move                                           JourneyMoveDTO
 	updatedAt, startedAt, completedAt, abandonedAt sql.NullTime
 }
@@ -165,6 +171,7 @@ func scanJourney(row journeyRowScanner) (CapabilityJourneyDTO, error) {
 	applyJourneyScanBuffer(&dto, raw)
 	dto.FromApplications = []JourneyApplicationRefDTO{}
 	dto.Milestones = []CapabilityJourneyMilestoneDTO{}
+	dto.Dependencies = []JourneyDependencyDTO{}
 	return dto, nil
 }
 
@@ -176,29 +183,33 @@ func journeyScanTargets(dto *CapabilityJourneyDTO, raw *journeyScanBuffer) []any
 		&raw.move.TargetDomainID, &raw.move.TargetDomainName, &raw.move.TargetDomainStale,
 		&raw.move.TargetParentID, &raw.move.TargetParentName, &raw.move.TargetParentStale, &raw.move.ResultingName,
 		&dto.PlannedBy, &dto.PlannedByName, &dto.PlannedAt, &raw.updatedAt, &raw.startedAt, &raw.completedAt, &raw.abandonedAt,
+		pq.Array(&dto.StartedWithUnmetPredecessorIDs), &raw.baselineYear, &raw.baselineQuarter, &raw.baselinedAt,
+		&raw.templateID, &raw.templateVersion,
 	}
 }
 
 func applyJourneyScanBuffer(dto *CapabilityJourneyDTO, raw journeyScanBuffer) {
 	applyJourneyProgress(dto, raw.progress)
-	applyJourneyTargetPeriod(dto, raw.targetYear, raw.targetQuarter)
+	dto.TargetPeriod = nullablePeriod(raw.targetYear, raw.targetQuarter)
+	dto.BaselineTargetPeriod = nullablePeriod(raw.baselineYear, raw.baselineQuarter)
 	applyJourneyMove(dto, raw.move)
+	applyJourneyTemplate(dto, raw)
 	applyJourneyTimestamps(dto, raw)
 }
 
-func applyJourneyProgress(dto *CapabilityJourneyDTO, progress sql.NullInt64) {
-	if !progress.Valid {
+func applyJourneyTemplate(dto *CapabilityJourneyDTO, raw journeyScanBuffer) {
+	if raw.templateID == "" {
 		return
 	}
-	v := int(progress.Int64)
-	dto.Progress = &v
+	dto.Template = &JourneyTemplateOriginDTO{TemplateID: raw.templateID, Version: int(raw.templateVersion.Int64)}
 }
 
-func applyJourneyTargetPeriod(dto *CapabilityJourneyDTO, year, quarter sql.NullInt64) {
-	if !year.Valid || !quarter.Valid {
+func applyJourneyProgress(dto *CapabilityJourneyDTO, progress sql.NullInt64) {
+	if !progress.Valid {
 		return
 	}
-	dto.TargetPeriod = &TargetPeriodDTO{Year: int(year.Int64), Quarter: int(quarter.Int64)}
+	v := int(progress.Int64)
+	dto.Progress = &v
 }
 
 func applyJourneyMove(dto *CapabilityJourneyDTO, move JourneyMoveDTO) {
@@ -212,6 +223,9 @@ func applyJourneyTimestamps(dto *CapabilityJourneyDTO, raw journeyScanBuffer) {
 	if raw.updatedAt.Valid {
 		dto.UpdatedAt = &raw.updatedAt.Time
 	}
+	if raw.baselinedAt.Valid {
+		dto.BaselinedAt = &raw.baselinedAt.Time
+	}
 	if raw.startedAt.Valid {
 		dto.StartedAt = &raw.startedAt.Time
 	}
@@ -258,6 +272,11 @@ func (rm *CapabilityJourneyReadModel) hydrateJourney(ctx context.Context, tx *sq
 		return err
 	}
 	dto.Milestones = milestones
+	dependencies, err := loadJourneyDependencies(ctx, tx, tenantID, dto.ID)
+	if err != nil {
+		return err
+	}
+	dto.Dependencies = dependencies
 	return nil
 }
 
@@ -285,7 +304,7 @@ func loadJourneySources(ctx context.Context, tx *sql.Tx, tenantID, journeyID str
 
 func loadJourneyMilestones(ctx context.Context, tx *sql.Tx, tenantID, journeyID string) ([]CapabilityJourneyMilestoneDTO, error) {
 	rows, err := tx.QueryContext(ctx,
-		`SELECT milestone_id, label, target_year, target_quarter, status
+		`SELECT milestone_id, label, target_year, target_quarter, baseline_year, baseline_quarter, status
 		 FROM architecturedirection.capability_journey_milestones
 		 WHERE tenant_id = $1 AND journey_id = $2 ORDER BY position`,
 		tenantID, journeyID,
@@ -297,13 +316,12 @@ func loadJourneyMilestones(ctx context.Context, tx *sql.Tx, tenantID, journeyID
 	out := []CapabilityJourneyMilestoneDTO{}
 	for rows.Next() {
 		var m CapabilityJourneyMilestoneDTO
-		var year, quarter sql.NullInt64
-		if err := rows.Scan(&m.ID, &m.Label, &year, &quarter, &m.Status); err != nil {
+		var year, quarter, baselineYear, baselineQuarter sql.NullInt64
+		if err := rows.Scan(&m.ID, &m.Label, &year, &quarter, &baselineYear, &baselineQuarter, &m.Status); err != nil {
 			return nil, err
 		}
-		if year.Valid && quarter.Valid {
-			m.TargetPeriod = &TargetPeriodDTO{Year: int(year.Int64), Quarter: int(quarter.Int64)}
-		}
+		m.TargetPeriod = nullablePeriod(year, quarter)
+		m.BaselineTargetPeriod = nullablePeriod(baselineYear, baselineQuarter)
 		out = append(out, m)
 	}
 	return out, rows.Err()
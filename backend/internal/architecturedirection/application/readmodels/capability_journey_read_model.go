@@ -30,10 +30,12 @@ func (rm *CapabilityJourneyReadModel) InsertJourney(ctx context.Context, p Inser
 			`INSERT INTO architecturedirection.capability_journeys
 			 (tenant_id, id, capability_id, kind, status, target_year, target_quarter, note,
 			  planned_by, planned_by_name, planned_at, capability_name, to_component_id, to_component_name,
-			  target_domain_id, target_domain_name, target_parent_id, target_parent_name, resulting_name)
+			  target_domain_id, target_domain_name, target_parent_id, target_parent_name, resulting_name,
+			  template_id, template_version)
 			 SELECT $1, $2, $3, $4, 'planned', $5, $6, $7, $8, COALESCE(usr.name, ''), $9,
 			   COALESCE(cap.name, ''), $10, COALESCE(comp.name, ''),
-			   NULLIF($11, ''), COALESCE(dom.name, ''), NULLIF($12, ''), COALESCE(parent.name, ''), $13
+			   NULLIF($11, ''), COALESCE(dom.name, ''), NULLIF($12, ''), COALESCE(parent.name, ''), $13,
+			   NULLIF($14, ''), NULLIF($15, 0)
 			 FROM (SELECT 1) AS stub
 			 LEFT JOIN architecturedirection.reference_name_cache cap
 			   ON cap.tenant_id = $1 AND cap.entity_type = 'capability' AND cap.entity_id = $3
@@ -47,6 +49,7 @@ func (rm *CapabilityJourneyReadModel) InsertJourney(ctx context.Context, p Inser
 			   ON usr.tenant_id = $1 AND usr.entity_type = 'user' AND usr.entity_id = $8`,
 			tenantID, p.ID, p.CapabilityID, p.Kind, nullableInt(p.TargetYear), nullableInt(p.TargetQuarter), p.Note,
 			p.PlannedBy, p.PlannedAt, p.ToComponentID, p.TargetDomainID, p.TargetParentID, p.ResultingName,
+			p.TemplateID, p.TemplateVersion,
 		); err != nil {
 			return mapJourneyInsertError(err)
 		}
@@ -157,8 +160,14 @@ func (rm *CapabilityJourneyReadModel) AddMilestone(ctx context.Context, p Journe
 		}
 		_, err := tx.ExecContext(ctx,
 			`INSERT INTO architecturedirection.capability_journey_milestones
-			 (tenant_id, journey_id, milestone_id, position, label, target_year, target_quarter, status, updated_at)
-			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			 (tenant_id, journey_id, milestone_id, position, label, target_year, target_quarter, status, updated_at,
+			  baseline_year, baseline_quarter)
+			 SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9,
+			   CASE WHEN baselined.yes THEN $6::INT END, CASE WHEN baselined.yes THEN $7::INT END
+			 FROM (SELECT EXISTS (
+			   SELECT 1 FROM architecturedirection.capability_journeys
+			   WHERE tenant_id = $1 AND id = $2 AND baselined_at IS NOT NULL
+			 ) AS yes) AS baselined`,
 			tenantID, p.JourneyID, p.MilestoneID, nextPosition, p.Label, nullableInt(p.TargetYear), nullableInt(p.TargetQuarter), p.Status, p.UpdatedAt,
 		)
 		return err
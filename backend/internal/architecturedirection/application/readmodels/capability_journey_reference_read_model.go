@@ -37,6 +37,19 @@ func (rm *CapabilityJourneyReadModel) UpdateCapabilityName(ctx context.Context,
 	)
 }
 
+func (rm *CapabilityJourneyReadModel) ChangeCapability(ctx context.Context, journeyID string, capabilityID CapabilityID) error {
+	return rm.tenantExec(ctx,
+		`UPDATE architecturedirection.capability_journeys
+		 SET capability_id = $1,
+		     capability_name = COALESCE((SELECT name FROM architecturedirection.reference_name_cache
+		       WHERE tenant_id = $2 AND entity_type = 'capability' AND entity_id = $1), ''),
+		     capability_stale = FALSE,
+		     updated_at = CURRENT_TIMESTAMP
+		 WHERE tenant_id = $2 AND id = $3`,
+		func(t string) []any { return []any{string(capabilityID), t, journeyID} },
+	)
+}
+
 func (rm *CapabilityJourneyReadModel) MarkCapabilityStale(ctx context.Context, capabilityID CapabilityID) error {
 	return rm.tenantExec(ctx,
 		`UPDATE architecturedirection.capability_journeys
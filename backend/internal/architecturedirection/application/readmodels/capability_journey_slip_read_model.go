@@ -0,0 +1,224 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+
+	"easi/backend/internal/shared/types"
+)
+
+type JourneySlipEntryDTO struct {
+	MilestoneID    string           `json:"milestoneId,omitempty"`
+	MilestoneLabel string           `json:"milestoneLabel,omitempty"`
+	From           *TargetPeriodDTO `json:"from"`
+	To             *TargetPeriodDTO `json:"to"`
+	QuartersMoved  *int             `json:"quartersMoved"`
+	MovedBy        string           `json:"movedBy"`
+	MovedByName    string           `json:"movedByName"`
+	MovedAt        time.Time        `json:"movedAt"`
+}
+
+type JourneySlipDTO struct {
+	JourneyID       string                `json:"journeyId"`
+	CapabilityID    string                `json:"capabilityId"`
+	CapabilityName  string                `json:"capabilityName"`
+	Kind            string                `json:"kind"`
+	MilestoneID     string                `json:"milestoneId,omitempty"`
+	MilestoneLabel  string                `json:"milestoneLabel,omitempty"`
+	Baseline        TargetPeriodDTO       `json:"baseline"`
+	Current         TargetPeriodDTO       `json:"current"`
+	QuartersSlipped int                   `json:"quartersSlipped"`
+	LastMovedBy     string                `json:"lastMovedBy,omitempty"`
+	LastMovedByName string                `json:"lastMovedByName,omitempty"`
+	LastMovedAt     *time.Time            `json:"lastMovedAt,omitempty"`
+	History         []JourneySlipEntryDTO `json:"history"`
+	Links           types.Links           `json:"_links,omitempty"`
+}
+
+type JourneyTargetChangeParams struct {
+	JourneyID     string
+	MilestoneID   string
+	TargetYear    *int
+	TargetQuarter *int
+	MovedBy       string
+	MovedAt       time.Time
+}
+
+// RecordBaseline freezes the journey's and its milestones' current targets as
+// the commitment made when the journey was started.
+func (rm *CapabilityJourneyReadModel) RecordBaseline(ctx context.Context, journeyID string, baselinedAt time.Time) error {
+	return rm.withTx(ctx, func(tx *sql.Tx, tenantID string) error {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE architecturedirection.capability_journeys
+			 SET baseline_year = target_year, baseline_quarter = target_quarter, baselined_at = $1
+			 WHERE tenant_id = $2 AND id = $3 AND baselined_at IS NULL`,
+			baselinedAt, tenantID, journeyID,
+		); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			`UPDATE architecturedirection.capability_journey_milestones
+			 SET baseline_year = target_year, baseline_quarter = target_quarter
+			 WHERE tenant_id = $1 AND journey_id = $2`,
+			tenantID, journeyID,
+		)
+		return err
+	})
+}
+
+// RecordTargetChange appends a slip entry when a baselined journey's (or one of
+// its milestones') target differs from the one currently projected. It must run
+// before the new target is written.
+func (rm *CapabilityJourneyReadModel) RecordTargetChange(ctx context.Context, p JourneyTargetChangeParams) error {
+	if p.MilestoneID == "" {
+		return rm.tenantExec(ctx,
+			`INSERT INTO architecturedirection.capability_journey_slips
+			 (tenant_id, journey_id, milestone_id, from_year, from_quarter, to_year, to_quarter, moved_by, moved_at)
+			 SELECT cj.tenant_id, cj.id, '', cj.target_year, cj.target_quarter, $3::INT, $4::INT, $5, $6
+			 FROM architecturedirection.capability_journeys cj
+			 WHERE cj.tenant_id = $1 AND cj.id = $2 AND cj.baselined_at IS NOT NULL
+			   AND (cj.target_year, cj.target_quarter::INT) IS DISTINCT FROM ($3::INT, $4::INT)
+			 ON CONFLICT DO NOTHING`,
+			func(t string) []any {
+				return []any{t, p.JourneyID, nullableInt(p.TargetYear), nullableInt(p.TargetQuarter), p.MovedBy, p.MovedAt}
+			},
+		)
+	}
+	return rm.tenantExec(ctx,
+		`INSERT INTO architecturedirection.capability_journey_slips
+		 (tenant_id, journey_id, milestone_id, from_year, from_quarter, to_year, to_quarter, moved_by, moved_at)
+		 SELECT m.tenant_id, m.journey_id, m.milestone_id, m.target_year, m.target_quarter, $4::INT, $5::INT, $6, $7
+		 FROM architecturedirection.capability_journey_milestones m
+		 JOIN architecturedirection.capability_journeys cj ON cj.tenant_id = m.tenant_id AND cj.id = m.journey_id
+		 WHERE m.tenant_id = $1 AND m.journey_id = $2 AND m.milestone_id = $3 AND cj.baselined_at IS NOT NULL
+		   AND (m.target_year, m.target_quarter::INT) IS DISTINCT FROM ($4::INT, $5::INT)
+		 ON CONFLICT DO NOTHING`,
+		func(t string) []any {
+			return []any{t, p.JourneyID, p.MilestoneID, nullableInt(p.TargetYear), nullableInt(p.TargetQuarter), p.MovedBy, p.MovedAt}
+		},
+	)
+}
+
+func (rm *CapabilityJourneyReadModel) GetSlipHistory(ctx context.Context, journeyID string) ([]JourneySlipEntryDTO, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := []JourneySlipEntryDTO{}
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		byJourney, err := loadJourneySlipEntries(ctx, tx, tenantID, []string{journeyID})
+		entries = append(entries, byJourney[journeyID]...)
+		return err
+	})
+	return entries, err
+}
+
+// GetSlipReport lists the in-flight journeys and milestones whose current target
+// is later than their baseline, most slipped first.
+func (rm *CapabilityJourneyReadModel) GetSlipReport(ctx context.Context) ([]JourneySlipDTO, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var report []JourneySlipDTO
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		candidates, err := loadSlipCandidates(ctx, tx, tenantID)
+		if err != nil {
+			return err
+		}
+		journeyIDs := make([]string, 0, len(candidates))
+		for _, c := range candidates {
+			journeyIDs = append(journeyIDs, c.JourneyID)
+		}
+		history, err := loadJourneySlipEntries(ctx, tx, tenantID, journeyIDs)
+		if err != nil {
+			return err
+		}
+		report = BuildSlipReport(candidates, history)
+		return nil
+	})
+	return report, err
+}
+
+func loadSlipCandidates(ctx context.Context, tx *sql.Tx, tenantID string) ([]JourneySlipDTO, error) {
+	rows, err := tx.QueryContext(ctx,
+		`SELECT cj.id, cj.capability_id, COALESCE(cj.capability_name, ''), cj.kind, '', '',
+		        cj.baseline_year, cj.baseline_quarter, cj.target_year, cj.target_quarter
+		 FROM architecturedirection.capability_journeys cj
+		 WHERE cj.tenant_id = $1 AND cj.status = 'in-flight'
+		   AND cj.baseline_year IS NOT NULL AND cj.target_year IS NOT NULL
+		 UNION ALL
+		 SELECT cj.id, cj.capability_id, COALESCE(cj.capability_name, ''), cj.kind, m.milestone_id, m.label,
+		        m.baseline_year, m.baseline_quarter, m.target_year, m.target_quarter
+		 FROM architecturedirection.capability_journey_milestones m
+		 JOIN architecturedirection.capability_journeys cj ON cj.tenant_id = m.tenant_id AND cj.id = m.journey_id
+		 WHERE m.tenant_id = $1 AND cj.status = 'in-flight'
+		   AND m.baseline_year IS NOT NULL AND m.target_year IS NOT NULL`,
+		tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	candidates := []JourneySlipDTO{}
+	for rows.Next() {
+		var c JourneySlipDTO
+		if err := rows.Scan(&c.JourneyID, &c.CapabilityID, &c.CapabilityName, &c.Kind, &c.MilestoneID, &c.MilestoneLabel,
+			&c.Baseline.Year, &c.Baseline.Quarter, &c.Current.Year, &c.Current.Quarter); err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, rows.Err()
+}
+
+func loadJourneySlipEntries(ctx context.Context, tx *sql.Tx, tenantID string, journeyIDs []string) (map[string][]JourneySlipEntryDTO, error) {
+	byJourney := map[string][]JourneySlipEntryDTO{}
+	if len(journeyIDs) == 0 {
+		return byJourney, nil
+	}
+	rows, err := tx.QueryContext(ctx,
+		`SELECT s.journey_id, s.milestone_id, COALESCE(m.label, ''),
+		        s.from_year, s.from_quarter, s.to_year, s.to_quarter,
+		        s.moved_by, COALESCE(usr.name, ''), s.moved_at
+		 FROM architecturedirection.capability_journey_slips s
+		 LEFT JOIN architecturedirection.capability_journey_milestones m
+		   ON m.tenant_id = s.tenant_id AND m.journey_id = s.journey_id AND m.milestone_id = s.milestone_id
+		 LEFT JOIN architecturedirection.reference_name_cache usr
+		   ON usr.tenant_id = s.tenant_id AND usr.entity_type = 'user' AND usr.entity_id = s.moved_by
+		 WHERE s.tenant_id = $1 AND s.journey_id = ANY($2)
+		 ORDER BY s.moved_at`,
+		tenantID, pq.Array(journeyIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var journeyID string
+		var e JourneySlipEntryDTO
+		var fromYear, fromQuarter, toYear, toQuarter sql.NullInt64
+		if err := rows.Scan(&journeyID, &e.MilestoneID, &e.MilestoneLabel,
+			&fromYear, &fromQuarter, &toYear, &toQuarter, &e.MovedBy, &e.MovedByName, &e.MovedAt); err != nil {
+			return nil, err
+		}
+		e.From = nullablePeriod(fromYear, fromQuarter)
+		e.To = nullablePeriod(toYear, toQuarter)
+		if e.From != nil && e.To != nil {
+			moved := periodOrdinal(e.To) - periodOrdinal(e.From)
+			e.QuartersMoved = &moved
+		}
+		byJourney[journeyID] = append(byJourney[journeyID], e)
+	}
+	return byJourney, rows.Err()
+}
+
+func nullablePeriod(year, quarter sql.NullInt64) *TargetPeriodDTO {
+	if !year.Valid || !quarter.Valid {
+		return nil
+	}
+	return &TargetPeriodDTO{Year: int(year.Int64), Quarter: int(quarter.Int64)}
+}
@@ -62,6 +62,7 @@ type DirectionDTO struct {
 	Placements             []DirectionPlacementDTO        `json:"placements"`
 	CreatedAt              time.Time                      `json:"createdAt"`
 	UpdatedAt              *time.Time                     `json:"updatedAt,omitempty"`
+	Review                 *DirectionReviewDTO            `json:"review,omitempty"`
 	Links                  types.Links                    `json:"_links,omitempty"`
 }
 
@@ -335,6 +336,66 @@ func (rm *DirectionReadModel) ActiveDirectionSourcesAcrossECs(ctx context.Contex
 	return out, nil
 }
 
+// DirectionStandardDTO pairs an agreed direction with the standard application
+// set on its enterprise capability.
+type DirectionStandardDTO struct {
+	DirectionID             string
+	EnterpriseCapabilityID  string
+	Horizon                 string
+	StandardApplicationID   string
+	StandardApplicationName string
+	SourceCapabilityIDs     []string
+}
+
+// GetAgreedDirectionStandards lists the agreed directions whose enterprise
+// capability has a standard application that still exists.
+func (rm *DirectionReadModel) GetAgreedDirectionStandards(ctx context.Context) ([]DirectionStandardDTO, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := []DirectionStandardDTO{}
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT d.id, d.enterprise_capability_id, d.horizon, sa.application_id, COALESCE(sa.application_name, ''), s.capability_id
+			 FROM architecturedirection.directions d
+			 JOIN architecturedirection.standard_applications sa
+			   ON sa.tenant_id = d.tenant_id AND sa.enterprise_capability_id = d.enterprise_capability_id
+			 JOIN architecturedirection.direction_source_capabilities s
+			   ON s.tenant_id = d.tenant_id AND s.direction_id = d.id
+			 WHERE d.tenant_id = $1 AND d.status = 'agreed' AND NOT sa.application_stale
+			 ORDER BY d.id, s.capability_id`,
+			tenantID,
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		indexByDirection := map[string]int{}
+		for rows.Next() {
+			var dto DirectionStandardDTO
+			var capabilityID string
+			if err := rows.Scan(&dto.DirectionID, &dto.EnterpriseCapabilityID, &dto.Horizon,
+				&dto.StandardApplicationID, &dto.StandardApplicationName, &capabilityID); err != nil {
+				return err
+			}
+			index, exists := indexByDirection[dto.DirectionID]
+			if !exists {
+				out = append(out, dto)
+				index = len(out) - 1
+				indexByDirection[dto.DirectionID] = index
+			}
+			out[index].SourceCapabilityIDs = append(out[index].SourceCapabilityIDs, capabilityID)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load agreed direction standards: %w", err)
+	}
+	return out, nil
+}
+
 func (rm *DirectionReadModel) HasActiveDirectionForEnterpriseCapability(ctx context.Context, enterpriseCapabilityID string) (bool, error) {
 	tenantID, err := tenantOf(ctx)
 	if err != nil {
@@ -351,6 +412,39 @@ func (rm *DirectionReadModel) HasActiveDirectionForEnterpriseCapability(ctx cont
 	return exists, err
 }
 
+// GetDirectionIDsBySourceCapability lists the directions, in any status but
+// rejected, that name the capability as one of their sources.
+func (rm *DirectionReadModel) GetDirectionIDsBySourceCapability(ctx context.Context, capabilityID string) ([]string, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT d.id FROM architecturedirection.directions d
+			 JOIN architecturedirection.direction_source_capabilities s
+			   ON s.tenant_id = d.tenant_id AND s.direction_id = d.id
+			 WHERE d.tenant_id = $1 AND s.capability_id = $2 AND d.status != 'rejected'
+			 ORDER BY d.id`,
+			tenantID, capabilityID,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		return rows.Err()
+	})
+	return ids, err
+}
+
 type fetchSpec struct {
 	query string
 	args  func(tenantID string) []any
@@ -429,11 +523,16 @@ func (rm *DirectionReadModel) fetchDirection(ctx context.Context, spec fetchSpec
 		if scanErr != nil {
 			return scanErr
 		}
-		sources, srcErr := loadSourcesForDirection(ctx, tx, sourceFetchKey{tenantID: tenantID, directionID: DirectionID(direction.ID)})
+		key := sourceFetchKey{tenantID: tenantID, directionID: DirectionID(direction.ID)}
+		sources, srcErr := loadSourcesForDirection(ctx, tx, key)
 		if srcErr != nil {
 			return srcErr
 		}
 		direction.SourceCapabilities = sources
+		var loadErr error
+		if direction.Review, loadErr = loadDirectionReview(ctx, tx, key); loadErr != nil {
+			return loadErr
+		}
 		dto = &direction
 		return nil
 	})
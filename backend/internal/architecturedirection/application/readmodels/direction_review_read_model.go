@@ -0,0 +1,147 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type DirectionReviewerDTO struct {
+	ReviewerID   string     `json:"reviewerId"`
+	ReviewerName string     `json:"reviewerName,omitempty"`
+	Verdict      string     `json:"verdict,omitempty"`
+	Comment      string     `json:"comment,omitempty"`
+	ReviewedAt   *time.Time `json:"reviewedAt,omitempty"`
+}
+
+type DirectionReviewDTO struct {
+	Quorum     int                    `json:"quorum"`
+	Approvals  int                    `json:"approvals"`
+	Objections int                    `json:"objections"`
+	QuorumMet  bool                   `json:"quorumMet"`
+	Reviewers  []DirectionReviewerDTO `json:"reviewers"`
+}
+
+// IsReviewer reports whether the user is one of the proposal's required reviewers.
+func (r *DirectionReviewDTO) IsReviewer(userID string) bool {
+	if r == nil {
+		return false
+	}
+	for _, reviewer := range r.Reviewers {
+		if reviewer.ReviewerID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+type ReviewPanelUpdate struct {
+	DirectionID DirectionID
+	ReviewerIDs []string
+	Quorum      int
+}
+
+type ReviewVerdictUpdate struct {
+	DirectionID DirectionID
+	ReviewerID  string
+	Verdict     string
+	Comment     string
+	ReviewedAt  time.Time
+}
+
+// StartReview replaces the reviewers of the direction with the panel of a new
+// proposal; earlier verdicts do not carry over.
+func (rm *DirectionReadModel) StartReview(ctx context.Context, u ReviewPanelUpdate) error {
+	return rm.withTx(ctx, func(tx *sql.Tx, tenantID string) error {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE architecturedirection.directions SET review_quorum = $1 WHERE tenant_id = $2 AND id = $3`,
+			u.Quorum, tenantID, string(u.DirectionID),
+		); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx,
+			`DELETE FROM architecturedirection.direction_reviews WHERE tenant_id = $1 AND direction_id = $2`,
+			tenantID, string(u.DirectionID),
+		); err != nil {
+			return err
+		}
+		for _, reviewerID := range u.ReviewerIDs {
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO architecturedirection.direction_reviews (tenant_id, direction_id, reviewer_id)
+				 VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`,
+				tenantID, string(u.DirectionID), reviewerID,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (rm *DirectionReadModel) RecordReview(ctx context.Context, u ReviewVerdictUpdate) error {
+	return rm.tenantExec(ctx,
+		`UPDATE architecturedirection.direction_reviews SET verdict = $1, comment = $2, reviewed_at = $3
+		 WHERE tenant_id = $4 AND direction_id = $5 AND reviewer_id = $6`,
+		func(t string) []any {
+			return []any{u.Verdict, u.Comment, u.ReviewedAt, t, string(u.DirectionID), u.ReviewerID}
+		},
+	)
+}
+
+// SummarizeReview counts the recorded verdicts against the quorum. The quorum
+// is met once enough reviewers approved and none still objects.
+func SummarizeReview(quorum int, reviewers []DirectionReviewerDTO) *DirectionReviewDTO {
+	review := &DirectionReviewDTO{Quorum: quorum, Reviewers: reviewers}
+	for _, r := range reviewers {
+		switch r.Verdict {
+		case "approve":
+			review.Approvals++
+		case "object":
+			review.Objections++
+		}
+	}
+	review.QuorumMet = review.Approvals >= quorum && review.Objections == 0
+	return review
+}
+
+func loadDirectionReview(ctx context.Context, tx *sql.Tx, key sourceFetchKey) (*DirectionReviewDTO, error) {
+	var quorum sql.NullInt64
+	if err := tx.QueryRowContext(ctx,
+		`SELECT review_quorum FROM architecturedirection.directions WHERE tenant_id = $1 AND id = $2`,
+		key.tenantID, string(key.directionID),
+	).Scan(&quorum); err != nil {
+		return nil, err
+	}
+	if !quorum.Valid {
+		return nil, nil
+	}
+	rows, err := tx.QueryContext(ctx,
+		`SELECT r.reviewer_id, COALESCE(usr.name, ''), COALESCE(r.verdict, ''), r.comment, r.reviewed_at
+		 FROM architecturedirection.direction_reviews r
+		 LEFT JOIN architecturedirection.reference_name_cache usr
+		   ON usr.tenant_id = r.tenant_id AND usr.entity_type = 'user' AND usr.entity_id = r.reviewer_id
+		 WHERE r.tenant_id = $1 AND r.direction_id = $2
+		 ORDER BY r.reviewer_id`,
+		key.tenantID, string(key.directionID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	reviewers := []DirectionReviewerDTO{}
+	for rows.Next() {
+		var r DirectionReviewerDTO
+		var reviewedAt sql.NullTime
+		if err := rows.Scan(&r.ReviewerID, &r.ReviewerName, &r.Verdict, &r.Comment, &reviewedAt); err != nil {
+			return nil, err
+		}
+		if reviewedAt.Valid {
+			r.ReviewedAt = &reviewedAt.Time
+		}
+		reviewers = append(reviewers, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return SummarizeReview(int(quorum.Int64), reviewers), nil
+}
@@ -0,0 +1,32 @@
+package readmodels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeReview_QuorumNeedsApprovalsAndNoObjections(t *testing.T) {
+	cases := []struct {
+		name      string
+		verdicts  []string
+		quorumMet bool
+	}{
+		{"no verdicts yet", []string{"", ""}, false},
+		{"one approval short", []string{"approve", ""}, false},
+		{"quorum of approvals", []string{"approve", "approve"}, true},
+		{"objection outstanding", []string{"approve", "approve", "object"}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			reviewers := make([]DirectionReviewerDTO, len(tc.verdicts))
+			for i, v := range tc.verdicts {
+				reviewers[i] = DirectionReviewerDTO{ReviewerID: string(rune('a' + i)), Verdict: v}
+			}
+			review := SummarizeReview(2, reviewers)
+			assert.Equal(t, tc.quorumMet, review.QuorumMet)
+			assert.True(t, review.IsReviewer("a"))
+			assert.False(t, review.IsReviewer("z"))
+		})
+	}
+}
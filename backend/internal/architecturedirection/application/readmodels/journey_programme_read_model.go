@@ -0,0 +1,298 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"easi/backend/internal/infrastructure/database"
+	"easi/backend/internal/shared/types"
+)
+
+type BlockingJourneyDTO struct {
+	JourneyID      string `json:"journeyId"`
+	CapabilityName string `json:"capabilityName"`
+	Status         string `json:"status"`
+	DependencyType string `json:"dependencyType"`
+}
+
+type ProgrammeJourneyDTO struct {
+	JourneyID      string               `json:"journeyId"`
+	CapabilityID   string               `json:"capabilityId"`
+	CapabilityName string               `json:"capabilityName"`
+	Kind           string               `json:"kind"`
+	Status         string               `json:"status"`
+	Progress       *int                 `json:"progress"`
+	TargetPeriod   *TargetPeriodDTO     `json:"targetPeriod"`
+	BlockedBy      []BlockingJourneyDTO `json:"blockedBy"`
+	Links          types.Links          `json:"_links,omitempty"`
+}
+
+type ProgrammeRollupDTO struct {
+	JourneyCount         int              `json:"journeyCount"`
+	Progress             *int             `json:"progress"`
+	EarliestTargetPeriod *TargetPeriodDTO `json:"earliestTargetPeriod"`
+	LatestTargetPeriod   *TargetPeriodDTO `json:"latestTargetPeriod"`
+	StatusCounts         map[string]int   `json:"statusCounts"`
+	BlockedJourneyCount  int              `json:"blockedJourneyCount"`
+}
+
+type JourneyProgrammeDTO struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	CreatedBy   string                `json:"createdBy"`
+	CreatedAt   time.Time             `json:"createdAt"`
+	UpdatedAt   *time.Time            `json:"updatedAt,omitempty"`
+	Rollup      ProgrammeRollupDTO    `json:"rollup"`
+	Journeys    []ProgrammeJourneyDTO `json:"journeys"`
+	Links       types.Links           `json:"_links,omitempty"`
+}
+
+type InsertJourneyProgrammeParams struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedBy   string
+	CreatedAt   time.Time
+}
+
+type JourneyProgrammeReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewJourneyProgrammeReadModel(db *database.TenantAwareDB) *JourneyProgrammeReadModel {
+	return &JourneyProgrammeReadModel{db: db}
+}
+
+func (rm *JourneyProgrammeReadModel) Insert(ctx context.Context, p InsertJourneyProgrammeParams) error {
+	return rm.tenantExec(ctx,
+		`INSERT INTO architecturedirection.journey_programmes (tenant_id, id, name, description, created_by, created_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 ON CONFLICT (tenant_id, id) DO NOTHING`,
+		func(t string) []any { return []any{t, p.ID, p.Name, p.Description, p.CreatedBy, p.CreatedAt} },
+	)
+}
+
+func (rm *JourneyProgrammeReadModel) Update(ctx context.Context, id, name, description string, updatedAt time.Time) error {
+	return rm.tenantExec(ctx,
+		`UPDATE architecturedirection.journey_programmes SET name = $1, description = $2, updated_at = $3
+		 WHERE tenant_id = $4 AND id = $5`,
+		func(t string) []any { return []any{name, description, updatedAt, t, id} },
+	)
+}
+
+func (rm *JourneyProgrammeReadModel) Delete(ctx context.Context, id string) error {
+	return rm.withTx(ctx, func(tx *sql.Tx, tenantID string) error {
+		if _, err := tx.ExecContext(ctx,
+			`DELETE FROM architecturedirection.journey_programme_members WHERE tenant_id = $1 AND programme_id = $2`,
+			tenantID, id,
+		); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			`DELETE FROM architecturedirection.journey_programmes WHERE tenant_id = $1 AND id = $2`,
+			tenantID, id,
+		)
+		return err
+	})
+}
+
+func (rm *JourneyProgrammeReadModel) AddMember(ctx context.Context, programmeID, journeyID string, addedAt time.Time) error {
+	return rm.tenantExec(ctx,
+		`INSERT INTO architecturedirection.journey_programme_members (tenant_id, journey_id, programme_id, added_at)
+		 VALUES ($1, $2, $3, $4)
+		 ON CONFLICT (tenant_id, journey_id) DO NOTHING`,
+		func(t string) []any { return []any{t, journeyID, programmeID, addedAt} },
+	)
+}
+
+func (rm *JourneyProgrammeReadModel) RemoveMember(ctx context.Context, programmeID, journeyID string) error {
+	return rm.tenantExec(ctx,
+		`DELETE FROM architecturedirection.journey_programme_members
+		 WHERE tenant_id = $1 AND journey_id = $2 AND programme_id = $3`,
+		func(t string) []any { return []any{t, journeyID, programmeID} },
+	)
+}
+
+func (rm *JourneyProgrammeReadModel) FindProgrammeIDForJourney(ctx context.Context, journeyID string) (string, bool, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	var programmeID string
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx,
+			`SELECT programme_id FROM architecturedirection.journey_programme_members WHERE tenant_id = $1 AND journey_id = $2`,
+			tenantID, journeyID,
+		).Scan(&programmeID)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return programmeID, true, nil
+}
+
+func (rm *JourneyProgrammeReadModel) GetAll(ctx context.Context) ([]JourneyProgrammeDTO, error) {
+	return rm.queryProgrammes(ctx, `WHERE tenant_id = $1 ORDER BY name`)
+}
+
+func (rm *JourneyProgrammeReadModel) GetByID(ctx context.Context, id string) (*JourneyProgrammeDTO, error) {
+	programmes, err := rm.queryProgrammes(ctx, `WHERE tenant_id = $1 AND id = $2`, id)
+	if err != nil || len(programmes) == 0 {
+		return nil, err
+	}
+	return &programmes[0], nil
+}
+
+func (rm *JourneyProgrammeReadModel) queryProgrammes(ctx context.Context, where string, args ...any) ([]JourneyProgrammeDTO, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	programmes := []JourneyProgrammeDTO{}
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		loaded, err := scanProgrammes(ctx, tx,
+			`SELECT id, name, description, created_by, created_at, updated_at
+			 FROM architecturedirection.journey_programmes `+where,
+			append([]any{tenantID}, args...)...,
+		)
+		if err != nil {
+			return err
+		}
+		for i := range loaded {
+			journeys, err := loadProgrammeJourneys(ctx, tx, tenantID, loaded[i].ID)
+			if err != nil {
+				return err
+			}
+			loaded[i].Journeys = journeys
+			loaded[i].Rollup = SummarizeProgramme(journeys)
+		}
+		programmes = loaded
+		return nil
+	})
+	return programmes, err
+}
+
+func scanProgrammes(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]JourneyProgrammeDTO, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	out := []JourneyProgrammeDTO{}
+	for rows.Next() {
+		var dto JourneyProgrammeDTO
+		var updatedAt sql.NullTime
+		if err := rows.Scan(&dto.ID, &dto.Name, &dto.Description, &dto.CreatedBy, &dto.CreatedAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		if updatedAt.Valid {
+			dto.UpdatedAt = &updatedAt.Time
+		}
+		out = append(out, dto)
+	}
+	return out, rows.Err()
+}
+
+func loadProgrammeJourneys(ctx context.Context, tx *sql.Tx, tenantID, programmeID string) ([]ProgrammeJourneyDTO, error) {
+	rows, err := tx.QueryContext(ctx,
+		`SELECT j.id, j.capability_id, COALESCE(j.capability_name, ''), j.kind, j.status, j.progress, j.target_year, j.target_quarter
+		 FROM architecturedirection.journey_programme_members m
+		 JOIN architecturedirection.capability_journeys j ON j.tenant_id = m.tenant_id AND j.id = m.journey_id
+		 WHERE m.tenant_id = $1 AND m.programme_id = $2
+		 ORDER BY j.target_year NULLS LAST, j.target_quarter NULLS LAST, j.capability_name`,
+		tenantID, programmeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	journeys, err := scanProgrammeJourneys(rows)
+	if err != nil {
+		return nil, err
+	}
+	for i := range journeys {
+		blockers, err := loadBlockingJourneys(ctx, tx, tenantID, journeys[i])
+		if err != nil {
+			return nil, err
+		}
+		journeys[i].BlockedBy = blockers
+	}
+	return journeys, nil
+}
+
+func scanProgrammeJourneys(rows *sql.Rows) ([]ProgrammeJourneyDTO, error) {
+	defer func() { _ = rows.Close() }()
+	out := []ProgrammeJourneyDTO{}
+	for rows.Next() {
+		var dto ProgrammeJourneyDTO
+		var progress, year, quarter sql.NullInt64
+		if err := rows.Scan(&dto.JourneyID, &dto.CapabilityID, &dto.CapabilityName, &dto.Kind, &dto.Status, &progress, &year, &quarter); err != nil {
+			return nil, err
+		}
+		if progress.Valid {
+			v := int(progress.Int64)
+			dto.Progress = &v
+		}
+		if year.Valid && quarter.Valid {
+			dto.TargetPeriod = &TargetPeriodDTO{Year: int(year.Int64), Quarter: int(quarter.Int64)}
+		}
+		dto.BlockedBy = []BlockingJourneyDTO{}
+		out = append(out, dto)
+	}
+	return out, rows.Err()
+}
+
+// loadBlockingJourneys only reports blockers for journeys that still have to
+// run; a finished or abandoned journey is no longer waiting on anything.
+func loadBlockingJourneys(ctx context.Context, tx *sql.Tx, tenantID string, journey ProgrammeJourneyDTO) ([]BlockingJourneyDTO, error) {
+	if !isActiveJourneyStatus(journey.Status) {
+		return []BlockingJourneyDTO{}, nil
+	}
+	dependencies, err := loadJourneyDependencies(ctx, tx, tenantID, journey.JourneyID)
+	if err != nil {
+		return nil, err
+	}
+	blockers := []BlockingJourneyDTO{}
+	for _, d := range dependencies {
+		if d.Satisfied {
+			continue
+		}
+		blockers = append(blockers, BlockingJourneyDTO{
+			JourneyID:      d.PredecessorID,
+			CapabilityName: d.PredecessorCapabilityName,
+			Status:         d.PredecessorStatus,
+			DependencyType: d.DependencyType,
+		})
+	}
+	return blockers, nil
+}
+
+func (rm *JourneyProgrammeReadModel) tenantExec(ctx context.Context, query string, argsFn func(tenantID string) []any) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = rm.db.ExecContext(ctx, query, argsFn(tenantID)...)
+	return err
+}
+
+func (rm *JourneyProgrammeReadModel) withTx(ctx context.Context, fn func(tx *sql.Tx, tenantID string) error) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := rm.db.BeginTxWithTenant(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx, tenantID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
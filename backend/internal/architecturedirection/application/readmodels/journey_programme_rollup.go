@@ -0,0 +1,72 @@
+package readmodels
+
+import "easi/backend/internal/architecturedirection/domain/valueobjects"
+
+// SummarizeProgramme rolls member journeys up into programme-level figures.
+// Abandoned journeys are counted by status but excluded from progress, since
+// they will never contribute delivered change. A done journey counts as 100%
+// and an active journey without reported progress as 0%.
+func SummarizeProgramme(journeys []ProgrammeJourneyDTO) ProgrammeRollupDTO {
+	rollup := ProgrammeRollupDTO{
+		JourneyCount: len(journeys),
+		StatusCounts: map[string]int{},
+	}
+	progressTotal, progressCount := 0, 0
+	for _, j := range journeys {
+		rollup.StatusCounts[j.Status]++
+		if len(j.BlockedBy) > 0 {
+			rollup.BlockedJourneyCount++
+		}
+		if j.Status != valueobjects.JourneyStatusAbandoned {
+			progressTotal += journeyProgressContribution(j)
+			progressCount++
+		}
+		rollup.EarliestTargetPeriod = earlierPeriod(rollup.EarliestTargetPeriod, j.TargetPeriod)
+		rollup.LatestTargetPeriod = laterPeriod(rollup.LatestTargetPeriod, j.TargetPeriod)
+	}
+	if progressCount > 0 {
+		average := progressTotal / progressCount
+		rollup.Progress = &average
+	}
+	return rollup
+}
+
+func journeyProgressContribution(j ProgrammeJourneyDTO) int {
+	if j.Status == valueobjects.JourneyStatusDone {
+		return 100
+	}
+	if j.Progress == nil {
+		return 0
+	}
+	return *j.Progress
+}
+
+func isActiveJourneyStatus(status string) bool {
+	return status == valueobjects.JourneyStatusPlanned || status == valueobjects.JourneyStatusInFlight
+}
+
+func periodOrdinal(p *TargetPeriodDTO) int {
+	return p.Year*4 + p.Quarter
+}
+
+func earlierPeriod(current, candidate *TargetPeriodDTO) *TargetPeriodDTO {
+	if candidate == nil {
+		return current
+	}
+	if current == nil || periodOrdinal(candidate) < periodOrdinal(current) {
+		c := *candidate
+		return &c
+	}
+	return current
+}
+
+func laterPeriod(current, candidate *TargetPeriodDTO) *TargetPeriodDTO {
+	if candidate == nil {
+		return current
+	}
+	if current == nil || periodOrdinal(candidate) > periodOrdinal(current) {
+		c := *candidate
+		return &c
+	}
+	return current
+}
@@ -0,0 +1,45 @@
+package readmodels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestSummarizeProgramme_AveragesProgressExcludingAbandoned(t *testing.T) {
+	rollup := SummarizeProgramme([]ProgrammeJourneyDTO{
+		{Status: "done"},
+		{Status: "in-flight", Progress: intPtr(50)},
+		{Status: "planned"},
+		{Status: "abandoned", Progress: intPtr(90)},
+	})
+
+	require.NotNil(t, rollup.Progress)
+	assert.Equal(t, 50, *rollup.Progress)
+	assert.Equal(t, 4, rollup.JourneyCount)
+	assert.Equal(t, map[string]int{"done": 1, "in-flight": 1, "planned": 1, "abandoned": 1}, rollup.StatusCounts)
+}
+
+func TestSummarizeProgramme_ReportsTargetPeriodSpanAndBlockedCount(t *testing.T) {
+	rollup := SummarizeProgramme([]ProgrammeJourneyDTO{
+		{Status: "planned", TargetPeriod: &TargetPeriodDTO{Year: 2027, Quarter: 2}, BlockedBy: []BlockingJourneyDTO{{JourneyID: "j-1"}}},
+		{Status: "planned", TargetPeriod: &TargetPeriodDTO{Year: 2026, Quarter: 4}},
+		{Status: "planned"},
+		{Status: "in-flight", TargetPeriod: &TargetPeriodDTO{Year: 2027, Quarter: 1}},
+	})
+
+	assert.Equal(t, &TargetPeriodDTO{Year: 2026, Quarter: 4}, rollup.EarliestTargetPeriod)
+	assert.Equal(t, &TargetPeriodDTO{Year: 2027, Quarter: 2}, rollup.LatestTargetPeriod)
+	assert.Equal(t, 1, rollup.BlockedJourneyCount)
+}
+
+func TestSummarizeProgramme_EmptyProgrammeHasNoProgress(t *testing.T) {
+	rollup := SummarizeProgramme(nil)
+
+	assert.Nil(t, rollup.Progress)
+	assert.Nil(t, rollup.EarliestTargetPeriod)
+	assert.Zero(t, rollup.JourneyCount)
+}
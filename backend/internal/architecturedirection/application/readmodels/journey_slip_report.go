@@ -0,0 +1,37 @@
+package readmodels
+
+import "sort"
+
+// BuildSlipReport keeps the candidates whose current target is later than
+// their baseline and attaches the target moves recorded for each. A target
+// pulled in or back on baseline is not a slip, even if it moved on the way.
+func BuildSlipReport(candidates []JourneySlipDTO, history map[string][]JourneySlipEntryDTO) []JourneySlipDTO {
+	report := []JourneySlipDTO{}
+	for _, c := range candidates {
+		c.QuartersSlipped = periodOrdinal(&c.Current) - periodOrdinal(&c.Baseline)
+		if c.QuartersSlipped <= 0 {
+			continue
+		}
+		c.History = []JourneySlipEntryDTO{}
+		for _, e := range history[c.JourneyID] {
+			if e.MilestoneID == c.MilestoneID {
+				c.History = append(c.History, e)
+			}
+		}
+		if n := len(c.History); n > 0 {
+			last := c.History[n-1]
+			c.LastMovedBy, c.LastMovedByName, c.LastMovedAt = last.MovedBy, last.MovedByName, &last.MovedAt
+		}
+		report = append(report, c)
+	}
+	sort.SliceStable(report, func(i, j int) bool {
+		if report[i].QuartersSlipped != report[j].QuartersSlipped {
+			return report[i].QuartersSlipped > report[j].QuartersSlipped
+		}
+		if report[i].CapabilityName != report[j].CapabilityName {
+			return report[i].CapabilityName < report[j].CapabilityName
+		}
+		return report[i].MilestoneLabel < report[j].MilestoneLabel
+	})
+	return report
+}
@@ -0,0 +1,51 @@
+package readmodels
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSlipReport_KeepsOnlyTargetsLaterThanBaseline(t *testing.T) {
+	candidates := []JourneySlipDTO{
+		{JourneyID: "j-1", CapabilityName: "Billing", Baseline: TargetPeriodDTO{Year: 2026, Quarter: 3}, Current: TargetPeriodDTO{Year: 2027, Quarter: 1}},
+		{JourneyID: "j-1", CapabilityName: "Billing", MilestoneID: "m-1", MilestoneLabel: "Cutover", Baseline: TargetPeriodDTO{Year: 2026, Quarter: 2}, Current: TargetPeriodDTO{Year: 2026, Quarter: 3}},
+		{JourneyID: "j-2", CapabilityName: "Payroll", Baseline: TargetPeriodDTO{Year: 2026, Quarter: 4}, Current: TargetPeriodDTO{Year: 2026, Quarter: 4}},
+		{JourneyID: "j-3", CapabilityName: "Ledger", Baseline: TargetPeriodDTO{Year: 2026, Quarter: 4}, Current: TargetPeriodDTO{Year: 2026, Quarter: 2}},
+	}
+
+	report := BuildSlipReport(candidates, nil)
+
+	require.Len(t, report, 2)
+	assert.Equal(t, "", report[0].MilestoneID)
+	assert.Equal(t, 2, report[0].QuartersSlipped)
+	assert.Equal(t, "m-1", report[1].MilestoneID)
+	assert.Equal(t, 1, report[1].QuartersSlipped)
+	assert.Empty(t, report[0].History)
+	assert.Nil(t, report[0].LastMovedAt)
+}
+
+func TestBuildSlipReport_AttachesOwnMovesAndLastMover(t *testing.T) {
+	first := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)
+	history := map[string][]JourneySlipEntryDTO{
+		"j-1": {
+			{MovedBy: "alice@example.com", MovedByName: "Alice", MovedAt: first},
+			{MilestoneID: "m-1", MovedBy: "carol@example.com", MovedAt: first},
+			{MovedBy: "bob@example.com", MovedByName: "Bob", MovedAt: second},
+		},
+	}
+
+	report := BuildSlipReport([]JourneySlipDTO{
+		{JourneyID: "j-1", Baseline: TargetPeriodDTO{Year: 2026, Quarter: 1}, Current: TargetPeriodDTO{Year: 2026, Quarter: 2}},
+	}, history)
+
+	require.Len(t, report, 1)
+	assert.Len(t, report[0].History, 2)
+	assert.Equal(t, "bob@example.com", report[0].LastMovedBy)
+	assert.Equal(t, "Bob", report[0].LastMovedByName)
+	require.NotNil(t, report[0].LastMovedAt)
+	assert.Equal(t, second, *report[0].LastMovedAt)
+}
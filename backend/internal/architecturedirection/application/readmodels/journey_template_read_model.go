@@ -0,0 +1,228 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"easi/backend/internal/infrastructure/database"
+	"easi/backend/internal/shared/types"
+)
+
+type TemplateMilestoneDTO struct {
+	Label          string `json:"label"`
+	OffsetQuarters int    `json:"offsetQuarters"`
+}
+
+type JourneyTemplateDTO struct {
+	ID         string                 `json:"id"`
+	Kind       string                 `json:"kind"`
+	Name       string                 `json:"name"`
+	Version    int                    `json:"version"`
+	Milestones []TemplateMilestoneDTO `json:"milestones"`
+	Retired    bool                   `json:"retired"`
+	CreatedBy  string                 `json:"createdBy"`
+	CreatedAt  time.Time              `json:"createdAt"`
+	RevisedBy  string                 `json:"revisedBy,omitempty"`
+	RevisedAt  *time.Time             `json:"revisedAt,omitempty"`
+	RetiredAt  *time.Time             `json:"retiredAt,omitempty"`
+	Links      types.Links            `json:"_links,omitempty"`
+}
+
+type InsertJourneyTemplateParams struct {
+	ID         string
+	Kind       string
+	Name       string
+	Version    int
+	Milestones []TemplateMilestoneDTO
+	CreatedBy  string
+	CreatedAt  time.Time
+}
+
+type ReviseJourneyTemplateParams struct {
+	ID         string
+	Name       string
+	Version    int
+	Milestones []TemplateMilestoneDTO
+	RevisedBy  string
+	RevisedAt  time.Time
+}
+
+type JourneyTemplateReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewJourneyTemplateReadModel(db *database.TenantAwareDB) *JourneyTemplateReadModel {
+	return &JourneyTemplateReadModel{db: db}
+}
+
+func (rm *JourneyTemplateReadModel) Insert(ctx context.Context, p InsertJourneyTemplateParams) error {
+	return rm.withTx(ctx, func(tx *sql.Tx, tenantID string) error {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO architecturedirection.journey_templates (tenant_id, id, kind, name, version, created_by, created_at)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7)
+			 ON CONFLICT (tenant_id, id) DO NOTHING`,
+			tenantID, p.ID, p.Kind, p.Name, p.Version, p.CreatedBy, p.CreatedAt,
+		); err != nil {
+			return err
+		}
+		return replaceTemplateMilestones(ctx, tx, tenantID, p.ID, p.Milestones)
+	})
+}
+
+func (rm *JourneyTemplateReadModel) Revise(ctx context.Context, p ReviseJourneyTemplateParams) error {
+	return rm.withTx(ctx, func(tx *sql.Tx, tenantID string) error {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE architecturedirection.journey_templates
+			 SET name = $1, version = $2, revised_by = $3, revised_at = $4
+			 WHERE tenant_id = $5 AND id = $6`,
+			p.Name, p.Version, p.RevisedBy, p.RevisedAt, tenantID, p.ID,
+		); err != nil {
+			return err
+		}
+		return replaceTemplateMilestones(ctx, tx, tenantID, p.ID, p.Milestones)
+	})
+}
+
+func (rm *JourneyTemplateReadModel) Retire(ctx context.Context, id, retiredBy string, retiredAt time.Time) error {
+	return rm.tenantExec(ctx,
+		`UPDATE architecturedirection.journey_templates SET retired_by = $1, retired_at = $2
+		 WHERE tenant_id = $3 AND id = $4`,
+		func(t string) []any { return []any{retiredBy, retiredAt, t, id} },
+	)
+}
+
+// GetAll lists the templates still available for seeding journeys, optionally
+// only those for one journey kind.
+func (rm *JourneyTemplateReadModel) GetAll(ctx context.Context, kind string) ([]JourneyTemplateDTO, error) {
+	return rm.queryTemplates(ctx,
+		`WHERE tenant_id = $1 AND retired_at IS NULL AND ($2 = '' OR kind = $2) ORDER BY kind, name`, kind)
+}
+
+func (rm *JourneyTemplateReadModel) GetByID(ctx context.Context, id string) (*JourneyTemplateDTO, error) {
+	templates, err := rm.queryTemplates(ctx, `WHERE tenant_id = $1 AND id = $2`, id)
+	if err != nil || len(templates) == 0 {
+		return nil, err
+	}
+	return &templates[0], nil
+}
+
+func (rm *JourneyTemplateReadModel) queryTemplates(ctx context.Context, where string, args ...any) ([]JourneyTemplateDTO, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	templates := []JourneyTemplateDTO{}
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		loaded, err := scanJourneyTemplates(ctx, tx,
+			`SELECT id, kind, name, version, created_by, created_at, COALESCE(revised_by, ''), revised_at, retired_at
+			 FROM architecturedirection.journey_templates `+where,
+			append([]any{tenantID}, args...)...,
+		)
+		if err != nil {
+			return err
+		}
+		for i := range loaded {
+			milestones, err := loadTemplateMilestones(ctx, tx, tenantID, loaded[i].ID)
+			if err != nil {
+				return err
+			}
+			loaded[i].Milestones = milestones
+		}
+		templates = loaded
+		return nil
+	})
+	return templates, err
+}
+
+func scanJourneyTemplates(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]JourneyTemplateDTO, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	out := []JourneyTemplateDTO{}
+	for rows.Next() {
+		var dto JourneyTemplateDTO
+		var revisedAt, retiredAt sql.NullTime
+		if err := rows.Scan(&dto.ID, &dto.Kind, &dto.Name, &dto.Version, &dto.CreatedBy, &dto.CreatedAt,
+			&dto.RevisedBy, &revisedAt, &retiredAt); err != nil {
+			return nil, err
+		}
+		if revisedAt.Valid {
+			dto.RevisedAt = &revisedAt.Time
+		}
+		if retiredAt.Valid {
+			dto.RetiredAt = &retiredAt.Time
+			dto.Retired = true
+		}
+		out = append(out, dto)
+	}
+	return out, rows.Err()
+}
+
+func loadTemplateMilestones(ctx context.Context, tx *sql.Tx, tenantID, templateID string) ([]TemplateMilestoneDTO, error) {
+	rows, err := tx.QueryContext(ctx,
+		`SELECT label, offset_quarters FROM architecturedirection.journey_template_milestones
+		 WHERE tenant_id = $1 AND template_id = $2 ORDER BY position`,
+		tenantID, templateID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	out := []TemplateMilestoneDTO{}
+	for rows.Next() {
+		var m TemplateMilestoneDTO
+		if err := rows.Scan(&m.Label, &m.OffsetQuarters); err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+func replaceTemplateMilestones(ctx context.Context, tx *sql.Tx, tenantID, templateID string, milestones []TemplateMilestoneDTO) error {
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM architecturedirection.journey_template_milestones WHERE tenant_id = $1 AND template_id = $2`,
+		tenantID, templateID,
+	); err != nil {
+		return err
+	}
+	for position, m := range milestones {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO architecturedirection.journey_template_milestones
+			 (tenant_id, template_id, position, label, offset_quarters)
+			 VALUES ($1, $2, $3, $4, $5)`,
+			tenantID, templateID, position, m.Label, m.OffsetQuarters,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rm *JourneyTemplateReadModel) tenantExec(ctx context.Context, query string, argsFn func(tenantID string) []any) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = rm.db.ExecContext(ctx, query, argsFn(tenantID)...)
+	return err
+}
+
+func (rm *JourneyTemplateReadModel) withTx(ctx context.Context, fn func(tx *sql.Tx, tenantID string) error) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := rm.db.BeginTxWithTenant(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx, tenantID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
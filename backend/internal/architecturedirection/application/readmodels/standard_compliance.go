@@ -0,0 +1,280 @@
+package readmodels
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/shared/types"
+)
+
+// Where an exception stands when the register is read.
+const (
+	ExceptionStatusActive  = "active"
+	ExceptionStatusExpired = "expired"
+	ExceptionStatusRevoked = "revoked"
+)
+
+// How a non-standard realisation without a journey away from it is covered.
+const (
+	ComplianceNonCompliant     = "non-compliant"
+	ComplianceExcepted         = "excepted"
+	ComplianceExceptionExpired = "exception-expired"
+)
+
+// Where a capability's standard application comes from.
+const (
+	StandardSourceRealizationRole = "realization-role"
+	StandardSourceDirection       = "direction"
+)
+
+const SignalExceptionExpired = "exception-expired"
+
+type ComplianceApplicationDTO struct {
+	ComponentID   string `json:"componentId"`
+	ComponentName string `json:"componentName"`
+	Source        string `json:"source"`
+}
+
+type ComplianceFindingDTO struct {
+	ComponentID        string      `json:"componentId"`
+	ComponentName      string      `json:"componentName"`
+	Role               string      `json:"role,omitempty"`
+	Status             string      `json:"status"`
+	ExceptionID        string      `json:"exceptionId,omitempty"`
+	ExceptionExpiresOn *time.Time  `json:"exceptionExpiresOn,omitempty"`
+	Links              types.Links `json:"_links,omitempty"`
+}
+
+type CapabilityComplianceDTO struct {
+	CapabilityID         string                     `json:"capabilityId"`
+	Name                 string                     `json:"name"`
+	BusinessDomainID     string                     `json:"businessDomainId,omitempty"`
+	BusinessDomainName   string                     `json:"businessDomainName,omitempty"`
+	StandardApplications []ComplianceApplicationDTO `json:"standardApplications"`
+	Findings             []ComplianceFindingDTO     `json:"findings"`
+	Links                types.Links                `json:"_links,omitempty"`
+}
+
+// ComplianceSignalDTO is a question for governance computed from the register;
+// like the landscape signals it is never stored and disappears once the
+// underlying data is fixed.
+type ComplianceSignalDTO struct {
+	Type           string      `json:"type"`
+	Text           string      `json:"text"`
+	CapabilityID   string      `json:"capabilityId"`
+	CapabilityName string      `json:"capabilityName"`
+	ComponentID    string      `json:"componentId"`
+	ComponentName  string      `json:"componentName"`
+	ExceptionID    string      `json:"exceptionId"`
+	Links          types.Links `json:"_links,omitempty"`
+}
+
+type ComplianceSummaryDTO struct {
+	NonCompliant     int `json:"nonCompliant"`
+	Excepted         int `json:"excepted"`
+	ExceptionExpired int `json:"exceptionExpired"`
+}
+
+type StandardComplianceInputs struct {
+	Landscape  []services.LandscapeCapability
+	Roles      []RealizationRoleDTO
+	Directions []DirectionStandardDTO
+	Journeys   []CapabilityJourneyDTO
+	Exceptions []StandardExceptionDTO
+	Now        time.Time
+}
+
+type StandardComplianceReport struct {
+	Capabilities []CapabilityComplianceDTO
+	Signals      []ComplianceSignalDTO
+	Summary      ComplianceSummaryDTO
+}
+
+// ExceptionStatus tells whether an exception still holds at the given moment.
+// It is a view of the recorded state: revoked, or expired once the expiry
+// sweep has recorded it. Until the sweep has run the expiry date decides, so
+// an exception holds through the whole of its expiry date and no longer.
+func ExceptionStatus(exception StandardExceptionDTO, now time.Time) string {
+	if exception.RevokedAt != nil {
+		return ExceptionStatusRevoked
+	}
+	if exception.ExpiredAt != nil {
+		return ExceptionStatusExpired
+	}
+	expiry, err := valueobjects.NewExceptionExpiry(exception.ExpiresOn)
+	if err != nil || expiry.HasPassed(now) {
+		return ExceptionStatusExpired
+	}
+	return ExceptionStatusActive
+}
+
+// BuildStandardCompliance lists, per capability that has a standard, the
+// applications directly realising it that are not its standard and that no
+// active journey on the capability moves away from. A capability's standards
+// are the applications holding the standard realisation role on it and the
+// standard application of every agreed direction sourcing it. Unrevoked
+// exceptions mark a finding excepted while they hold; once one has expired
+// the finding says so and an exception-expired signal is raised.
+func BuildStandardCompliance(in StandardComplianceInputs) StandardComplianceReport {
+	standards := standardsByCapability(in.Roles, in.Directions)
+	roles := rolesByCapability(in.Roles)
+	leaving := journeyFromApplications(in.Journeys)
+	exceptions := unrevokedExceptionsByPair(in.Exceptions)
+
+	report := StandardComplianceReport{Capabilities: []CapabilityComplianceDTO{}, Signals: []ComplianceSignalDTO{}}
+	for _, capability := range sortedLandscape(in.Landscape) {
+		capabilityStandards := standards[capability.ID]
+		if len(capabilityStandards) == 0 {
+			continue
+		}
+		entry := CapabilityComplianceDTO{
+			CapabilityID:         capability.ID,
+			Name:                 capability.Name,
+			BusinessDomainID:     capability.BusinessDomainID,
+			BusinessDomainName:   capability.BusinessDomainName,
+			StandardApplications: capabilityStandards,
+			Findings:             []ComplianceFindingDTO{},
+		}
+		for _, app := range capability.Applications {
+			if isStandard(capabilityStandards, app.ID) || leaving[capability.ID][app.ID] {
+				continue
+			}
+			finding := ComplianceFindingDTO{
+				ComponentID:   app.ID,
+				ComponentName: app.Name,
+				Role:          roles[capability.ID][app.ID],
+				Status:        ComplianceNonCompliant,
+			}
+			if exception, ok := exceptions[capability.ID+"/"+app.ID]; ok {
+				report.Signals = append(report.Signals, applyException(&finding, capability, exception, in.Now)...)
+			}
+			report.Summary.count(finding.Status)
+			entry.Findings = append(entry.Findings, finding)
+		}
+		if len(entry.Findings) > 0 {
+			report.Capabilities = append(report.Capabilities, entry)
+		}
+	}
+	return report
+}
+
+func applyException(finding *ComplianceFindingDTO, capability services.LandscapeCapability, exception StandardExceptionDTO, now time.Time) []ComplianceSignalDTO {
+	expiresOn := exception.ExpiresOn
+	finding.ExceptionID = exception.ID
+	finding.ExceptionExpiresOn = &expiresOn
+	if ExceptionStatus(exception, now) == ExceptionStatusActive {
+		finding.Status = ComplianceExcepted
+		return nil
+	}
+	finding.Status = ComplianceExceptionExpired
+	return []ComplianceSignalDTO{{
+		Type: SignalExceptionExpired,
+		Text: fmt.Sprintf("The exception allowing %s to realise %s instead of its standard expired on %s",
+			finding.ComponentName, capability.Name, expiresOn.Format("2006-01-02")),
+		CapabilityID:   capability.ID,
+		CapabilityName: capability.Name,
+		ComponentID:    finding.ComponentID,
+		ComponentName:  finding.ComponentName,
+		ExceptionID:    exception.ID,
+	}}
+}
+
+func (s *ComplianceSummaryDTO) count(status string) {
+	switch status {
+	case ComplianceExcepted:
+		s.Excepted++
+	case ComplianceExceptionExpired:
+		s.ExceptionExpired++
+	default:
+		s.NonCompliant++
+	}
+}
+
+func standardsByCapability(roles []RealizationRoleDTO, directions []DirectionStandardDTO) map[string][]ComplianceApplicationDTO {
+	standards := map[string][]ComplianceApplicationDTO{}
+	add := func(capabilityID string, app ComplianceApplicationDTO) {
+		if !isStandard(standards[capabilityID], app.ComponentID) {
+			standards[capabilityID] = append(standards[capabilityID], app)
+		}
+	}
+	for _, role := range roles {
+		if role.Role == valueobjects.RealizationRoleStandard {
+			add(role.CapabilityID, ComplianceApplicationDTO{
+				ComponentID: role.ComponentID, ComponentName: role.ComponentName, Source: StandardSourceRealizationRole,
+			})
+		}
+	}
+	for _, direction := range directions {
+		if direction.StandardApplicationID == "" {
+			continue
+		}
+		for _, capabilityID := range direction.SourceCapabilityIDs {
+			add(capabilityID, ComplianceApplicationDTO{
+				ComponentID:   direction.StandardApplicationID,
+				ComponentName: direction.StandardApplicationName,
+				Source:        StandardSourceDirection,
+			})
+		}
+	}
+	return standards
+}
+
+func rolesByCapability(roles []RealizationRoleDTO) map[string]map[string]string {
+	out := map[string]map[string]string{}
+	for _, role := range roles {
+		if out[role.CapabilityID] == nil {
+			out[role.CapabilityID] = map[string]string{}
+		}
+		out[role.CapabilityID][role.ComponentID] = role.Role
+	}
+	return out
+}
+
+func journeyFromApplications(journeys []CapabilityJourneyDTO) map[string]map[string]bool {
+	out := map[string]map[string]bool{}
+	for _, journey := range journeys {
+		if !isActiveJourneyStatus(journey.Status) {
+			continue
+		}
+		if out[journey.CapabilityID] == nil {
+			out[journey.CapabilityID] = map[string]bool{}
+		}
+		for _, from := range journey.FromApplications {
+			out[journey.CapabilityID][from.ComponentID] = true
+		}
+	}
+	return out
+}
+
+func unrevokedExceptionsByPair(exceptions []StandardExceptionDTO) map[string]StandardExceptionDTO {
+	out := make(map[string]StandardExceptionDTO, len(exceptions))
+	for _, exception := range exceptions {
+		if exception.RevokedAt == nil {
+			out[exception.CapabilityID+"/"+exception.ComponentID] = exception
+		}
+	}
+	return out
+}
+
+func sortedLandscape(landscape []services.LandscapeCapability) []services.LandscapeCapability {
+	sorted := append([]services.LandscapeCapability(nil), landscape...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].BusinessDomainName != sorted[j].BusinessDomainName {
+			return sorted[i].BusinessDomainName < sorted[j].BusinessDomainName
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+func isStandard(standards []ComplianceApplicationDTO, componentID string) bool {
+	for _, standard := range standards {
+		if standard.ComponentID == componentID {
+			return true
+		}
+	}
+	return false
+}
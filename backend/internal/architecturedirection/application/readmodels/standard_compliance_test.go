@@ -0,0 +1,128 @@
+package readmodels
+
+import (
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var complianceNow = time.Date(2026, time.May, 15, 9, 0, 0, 0, time.UTC)
+
+func exceptionFor(id, capabilityID, componentID string, expiresOn time.Time) StandardExceptionDTO {
+	return StandardExceptionDTO{ID: id, CapabilityID: capabilityID, ComponentID: componentID, ExpiresOn: expiresOn}
+}
+
+func findingStatuses(report StandardComplianceReport) map[string]map[string]string {
+	out := map[string]map[string]string{}
+	for _, capability := range report.Capabilities {
+		out[capability.CapabilityID] = map[string]string{}
+		for _, finding := range capability.Findings {
+			out[capability.CapabilityID][finding.ComponentID] = finding.Status
+		}
+	}
+	return out
+}
+
+func TestBuildStandardCompliance_ListsNonStandardRealisationsWithoutJourney(t *testing.T) {
+	report := BuildStandardCompliance(StandardComplianceInputs{
+		Landscape: []services.LandscapeCapability{
+			landscapeCapability("cap-role", "", "dom-1", "app-std", "app-legacy", "app-other"),
+			landscapeCapability("cap-direction", "", "dom-1", "app-ec", "app-old"),
+			landscapeCapability("cap-leaving", "", "dom-1", "app-std", "app-migrating", "app-done"),
+			landscapeCapability("cap-ungoverned", "", "dom-1", "app-x"),
+			landscapeCapability("cap-compliant", "", "dom-1", "app-std"),
+		},
+		Roles: []RealizationRoleDTO{
+			roleOf("cap-role", "app-std", valueobjects.RealizationRoleStandard),
+			roleOf("cap-role", "app-legacy", valueobjects.RealizationRoleLegacy),
+			roleOf("cap-leaving", "app-std", valueobjects.RealizationRoleStandard),
+			roleOf("cap-compliant", "app-std", valueobjects.RealizationRoleStandard),
+		},
+		Directions: []DirectionStandardDTO{{
+			DirectionID: "dir-1", StandardApplicationID: "app-ec", StandardApplicationName: "EC standard",
+			SourceCapabilityIDs: []string{"cap-direction"},
+		}},
+		Journeys: []CapabilityJourneyDTO{
+			{ID: "j-1", CapabilityID: "cap-leaving", Status: valueobjects.JourneyStatusPlanned,
+				FromApplications: []JourneyApplicationRefDTO{{ComponentID: "app-migrating"}}},
+			{ID: "j-2", CapabilityID: "cap-leaving", Status: valueobjects.JourneyStatusDone,
+				FromApplications: []JourneyApplicationRefDTO{{ComponentID: "app-done"}}},
+		},
+		Now: complianceNow,
+	})
+
+	assert.Equal(t, map[string]map[string]string{
+		"cap-role":      {"app-legacy": ComplianceNonCompliant, "app-other": ComplianceNonCompliant},
+		"cap-direction": {"app-old": ComplianceNonCompliant},
+		"cap-leaving":   {"app-done": ComplianceNonCompliant},
+	}, findingStatuses(report), "an active journey away covers an app, a finished one does not")
+	assert.Equal(t, ComplianceSummaryDTO{NonCompliant: 4}, report.Summary)
+	for _, capability := range report.Capabilities {
+		if capability.CapabilityID == "cap-direction" {
+			assert.Equal(t, []ComplianceApplicationDTO{{ComponentID: "app-ec", ComponentName: "EC standard", Source: StandardSourceDirection}},
+				capability.StandardApplications)
+		}
+		if capability.CapabilityID == "cap-role" {
+			assert.Equal(t, valueobjects.RealizationRoleLegacy, capability.Findings[0].Role)
+		}
+	}
+	assert.Empty(t, report.Signals)
+}
+
+func TestBuildStandardCompliance_ExceptionsCoverFindingsUntilTheyExpire(t *testing.T) {
+	revokedAt := complianceNow.AddDate(0, -1, 0)
+	revoked := exceptionFor("exc-revoked", "cap-1", "app-revoked", complianceNow.AddDate(0, 3, 0))
+	revoked.RevokedAt = &revokedAt
+
+	report := BuildStandardCompliance(StandardComplianceInputs{
+		Landscape: []services.LandscapeCapability{
+			landscapeCapability("cap-1", "", "dom-1", "app-std", "app-held", "app-today", "app-lapsed", "app-revoked"),
+		},
+		Roles: []RealizationRoleDTO{roleOf("cap-1", "app-std", valueobjects.RealizationRoleStandard)},
+		Exceptions: []StandardExceptionDTO{
+			exceptionFor("exc-held", "cap-1", "app-held", complianceNow.AddDate(0, 3, 0)),
+			exceptionFor("exc-today", "cap-1", "app-today", time.Date(2026, time.May, 15, 0, 0, 0, 0, time.UTC)),
+			exceptionFor("exc-lapsed", "cap-1", "app-lapsed", time.Date(2026, time.May, 14, 0, 0, 0, 0, time.UTC)),
+			revoked,
+		},
+		Now: complianceNow,
+	})
+
+	assert.Equal(t, map[string]string{
+		"app-held":    ComplianceExcepted,
+		"app-today":   ComplianceExcepted,
+		"app-lapsed":  ComplianceExceptionExpired,
+		"app-revoked": ComplianceNonCompliant,
+	}, findingStatuses(report)["cap-1"])
+	assert.Equal(t, ComplianceSummaryDTO{NonCompliant: 1, Excepted: 2, ExceptionExpired: 1}, report.Summary)
+	require.Len(t, report.Signals, 1)
+	signal := report.Signals[0]
+	assert.Equal(t, SignalExceptionExpired, signal.Type)
+	assert.Equal(t, "exc-lapsed", signal.ExceptionID)
+	assert.Equal(t, "app-lapsed", signal.ComponentID)
+	assert.Contains(t, signal.Text, "expired on 2026-05-14")
+}
+
+func TestExceptionStatus(t *testing.T) {
+	revokedAt := complianceNow
+	revoked := exceptionFor("exc-1", "cap-1", "app-1", complianceNow.AddDate(1, 0, 0))
+	revoked.RevokedAt = &revokedAt
+
+	assert.Equal(t, ExceptionStatusActive, ExceptionStatus(exceptionFor("exc-1", "cap-1", "app-1", complianceNow), complianceNow))
+	assert.Equal(t, ExceptionStatusExpired, ExceptionStatus(exceptionFor("exc-1", "cap-1", "app-1", complianceNow.AddDate(0, 0, -1)), complianceNow))
+	assert.Equal(t, ExceptionStatusRevoked, ExceptionStatus(revoked, complianceNow))
+}
+
+func TestExceptionStatus_RecordedExpiryWins(t *testing.T) {
+	expiredAt := complianceNow
+	recorded := exceptionFor("exc-1", "cap-1", "app-1", complianceNow)
+	recorded.ExpiredAt = &expiredAt
+
+	assert.Equal(t, ExceptionStatusExpired, ExceptionStatus(recorded, complianceNow.Add(-time.Hour)),
+		"a reader whose clock lags the sweep still sees the recorded expiry")
+}
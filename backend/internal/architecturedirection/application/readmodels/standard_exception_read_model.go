@@ -0,0 +1,234 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"easi/backend/internal/infrastructure/database"
+	"easi/backend/internal/shared/types"
+)
+
+type StandardExceptionDTO struct {
+	ID             string      `json:"id"`
+	CapabilityID   string      `json:"capabilityId"`
+	CapabilityName string      `json:"capabilityName"`
+	ComponentID    string      `json:"componentId"`
+	ComponentName  string      `json:"componentName"`
+	Justification  string      `json:"justification"`
+	ApproverID     string      `json:"approverId"`
+	ApproverName   string      `json:"approverName"`
+	ExpiresOn      time.Time   `json:"expiresOn"`
+	Status         string      `json:"status"`
+	GrantedBy      string      `json:"grantedBy"`
+	GrantedAt      time.Time   `json:"grantedAt"`
+	RenewedAt      *time.Time  `json:"renewedAt,omitempty"`
+	RevokedBy      string      `json:"revokedBy,omitempty"`
+	RevokedAt      *time.Time  `json:"revokedAt,omitempty"`
+	ExpiredAt      *time.Time  `json:"expiredAt,omitempty"`
+	Links          types.Links `json:"_links,omitempty"`
+}
+
+type InsertStandardExceptionParams struct {
+	ID            string
+	CapabilityID  string
+	ComponentID   string
+	Justification string
+	ApproverID    string
+	ExpiresOn     time.Time
+	GrantedBy     string
+	GrantedAt     time.Time
+}
+
+type RenewStandardExceptionParams struct {
+	ID            string
+	Justification string
+	ApproverID    string
+	ExpiresOn     time.Time
+	RenewedAt     time.Time
+}
+
+// StandardExceptionReadModel is the register of exceptions. Revoked ones stay
+// listed for the record. ExpiredAt is set once the expiry sweep has recorded
+// the exception's expiry; Status is left empty here and derived by
+// ExceptionStatus when the register is read.
+type StandardExceptionReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewStandardExceptionReadModel(db *database.TenantAwareDB) *StandardExceptionReadModel {
+	return &StandardExceptionReadModel{db: db}
+}
+
+func (rm *StandardExceptionReadModel) Insert(ctx context.Context, p InsertStandardExceptionParams) error {
+	return rm.tenantExec(ctx,
+		`INSERT INTO architecturedirection.standard_exceptions
+		 (tenant_id, id, capability_id, component_id, justification, approver_id, expires_on, granted_by, granted_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		 ON CONFLICT (tenant_id, id) DO NOTHING`,
+		func(t string) []any {
+			return []any{t, p.ID, p.CapabilityID, p.ComponentID, p.Justification, p.ApproverID, p.ExpiresOn, p.GrantedBy, p.GrantedAt}
+		},
+	)
+}
+
+func (rm *StandardExceptionReadModel) Renew(ctx context.Context, p RenewStandardExceptionParams) error {
+	return rm.tenantExec(ctx,
+		`UPDATE architecturedirection.standard_exceptions
+		 SET justification = $1, approver_id = $2, expires_on = $3, renewed_at = $4, expired_at = NULL
+		 WHERE tenant_id = $5 AND id = $6`,
+		func(t string) []any { return []any{p.Justification, p.ApproverID, p.ExpiresOn, p.RenewedAt, t, p.ID} },
+	)
+}
+
+func (rm *StandardExceptionReadModel) Revoke(ctx context.Context, id, revokedBy string, revokedAt time.Time) error {
+	return rm.tenantExec(ctx,
+		`UPDATE architecturedirection.standard_exceptions SET revoked_by = $1, revoked_at = $2
+		 WHERE tenant_id = $3 AND id = $4`,
+		func(t string) []any { return []any{revokedBy, revokedAt, t, id} },
+	)
+}
+
+func (rm *StandardExceptionReadModel) MarkExpired(ctx context.Context, id string, expiredAt time.Time) error {
+	return rm.tenantExec(ctx,
+		`UPDATE architecturedirection.standard_exceptions SET expired_at = $1
+		 WHERE tenant_id = $2 AND id = $3`,
+		func(t string) []any { return []any{expiredAt, t, id} },
+	)
+}
+
+// GetIDsDueToExpire lists the unrevoked exceptions whose expiry date is
+// before the given day and whose expiry has not been recorded yet.
+func (rm *StandardExceptionReadModel) GetIDsDueToExpire(ctx context.Context, now time.Time) ([]string, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT id FROM architecturedirection.standard_exceptions
+			 WHERE tenant_id = $1 AND revoked_at IS NULL AND expired_at IS NULL AND expires_on < $2
+			 ORDER BY expires_on, id`,
+			tenantID, now.UTC().Format("2006-01-02"),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		return rows.Err()
+	})
+	return ids, err
+}
+
+func (rm *StandardExceptionReadModel) FindUnrevokedExceptionIDForPair(ctx context.Context, capabilityID, componentID string) (string, bool, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	var id string
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx,
+			`SELECT id FROM architecturedirection.standard_exceptions
+			 WHERE tenant_id = $1 AND capability_id = $2 AND component_id = $3 AND revoked_at IS NULL`,
+			tenantID, capabilityID, componentID,
+		).Scan(&id)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return id, true, nil
+}
+
+// GetAll lists the register, soonest expiry first.
+func (rm *StandardExceptionReadModel) GetAll(ctx context.Context) ([]StandardExceptionDTO, error) {
+	return rm.queryExceptions(ctx, `ORDER BY e.expires_on, e.granted_at`)
+}
+
+func (rm *StandardExceptionReadModel) GetByID(ctx context.Context, id string) (*StandardExceptionDTO, error) {
+	exceptions, err := rm.queryExceptions(ctx, `AND e.id = $2`, id)
+	if err != nil || len(exceptions) == 0 {
+		return nil, err
+	}
+	return &exceptions[0], nil
+}
+
+func (rm *StandardExceptionReadModel) queryExceptions(ctx context.Context, filter string, args ...any) ([]StandardExceptionDTO, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exceptions := []StandardExceptionDTO{}
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT e.id, e.capability_id, COALESCE(cap.name, ''), e.component_id, COALESCE(comp.name, ''),
+			        e.justification, e.approver_id, COALESCE(usr.name, ''), e.expires_on,
+			        e.granted_by, e.granted_at, e.renewed_at, COALESCE(e.revoked_by, ''), e.revoked_at, e.expired_at
+			 FROM architecturedirection.standard_exceptions e
+			 LEFT JOIN architecturedirection.reference_name_cache cap
+			   ON cap.tenant_id = e.tenant_id AND cap.entity_type = 'capability' AND cap.entity_id = e.capability_id
+			 LEFT JOIN architecturedirection.reference_name_cache comp
+			   ON comp.tenant_id = e.tenant_id AND comp.entity_type = 'application' AND comp.entity_id = e.component_id
+			 LEFT JOIN architecturedirection.reference_name_cache usr
+			   ON usr.tenant_id = e.tenant_id AND usr.entity_type = 'user' AND usr.entity_id = e.approver_id
+			 WHERE e.tenant_id = $1 `+filter,
+			append([]any{tenantID}, args...)...,
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			dto, err := scanStandardException(rows)
+			if err != nil {
+				return err
+			}
+			exceptions = append(exceptions, dto)
+		}
+		return rows.Err()
+	})
+	return exceptions, err
+}
+
+func scanStandardException(rows *sql.Rows) (StandardExceptionDTO, error) {
+	var dto StandardExceptionDTO
+	var renewedAt, revokedAt, expiredAt sql.NullTime
+	if err := rows.Scan(&dto.ID, &dto.CapabilityID, &dto.CapabilityName, &dto.ComponentID, &dto.ComponentName,
+		&dto.Justification, &dto.ApproverID, &dto.ApproverName, &dto.ExpiresOn,
+		&dto.GrantedBy, &dto.GrantedAt, &renewedAt, &dto.RevokedBy, &revokedAt, &expiredAt); err != nil {
+		return dto, err
+	}
+	dto.ExpiresOn = dto.ExpiresOn.UTC()
+	if renewedAt.Valid {
+		dto.RenewedAt = &renewedAt.Time
+	}
+	if revokedAt.Valid {
+		dto.RevokedAt = &revokedAt.Time
+	}
+	if expiredAt.Valid {
+		dto.ExpiredAt = &expiredAt.Time
+	}
+	return dto, nil
+}
+
+func (rm *StandardExceptionReadModel) tenantExec(ctx context.Context, query string, argsFn func(tenantID string) []any) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = rm.db.ExecContext(ctx, query, argsFn(tenantID)...)
+	return err
+}
@@ -0,0 +1,265 @@
+package readmodels
+
+import (
+	"easi/backend/internal/architecturedirection/domain/services"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/shared/types"
+)
+
+// What brought an application into, or took it out of, a capability's target
+// state. Applications realising the capability today carry no change.
+const (
+	TargetChangeJourney         = "journey"
+	TargetChangeDirection       = "direction"
+	TargetChangeRealizationRole = "realization-role"
+)
+
+type TargetApplicationDTO struct {
+	ComponentID   string `json:"componentId"`
+	ComponentName string `json:"componentName"`
+	Change        string `json:"change,omitempty"`
+}
+
+type TargetMoveOriginDTO struct {
+	Name               string `json:"name"`
+	ParentID           string `json:"parentId,omitempty"`
+	BusinessDomainID   string `json:"businessDomainId,omitempty"`
+	BusinessDomainName string `json:"businessDomainName,omitempty"`
+}
+
+type TargetCapabilityDTO struct {
+	CapabilityID        string                 `json:"capabilityId"`
+	Name                string                 `json:"name"`
+	ParentID            string                 `json:"parentId,omitempty"`
+	BusinessDomainID    string                 `json:"businessDomainId,omitempty"`
+	BusinessDomainName  string                 `json:"businessDomainName,omitempty"`
+	Applications        []TargetApplicationDTO `json:"applications"`
+	RetiredApplications []TargetApplicationDTO `json:"retiredApplications"`
+	JourneyID           string                 `json:"journeyId,omitempty"`
+	JourneyKind         string                 `json:"journeyKind,omitempty"`
+	DirectionID         string                 `json:"directionId,omitempty"`
+	MovedFrom           *TargetMoveOriginDTO   `json:"movedFrom,omitempty"`
+	Links               types.Links            `json:"_links,omitempty"`
+}
+
+// TargetStateInputs is the current landscape and the changes to apply to it.
+// Journeys and directions must already be narrowed to the ones that land
+// within the chosen horizon.
+type TargetStateInputs struct {
+	Landscape  []services.LandscapeCapability
+	Roles      []RealizationRoleDTO
+	Directions []DirectionStandardDTO
+	Journeys   []CapabilityJourneyDTO
+}
+
+// BuildTargetState applies the planned changes to the current landscape, in
+// order of increasing specificity: standard realisation roles retire legacy
+// ones, agreed directions swap legacy applications in their source
+// capabilities for the enterprise capability's standard application, and
+// journeys swap their from-applications for their target application or
+// re-parent the capability they move.
+func BuildTargetState(in TargetStateInputs) []TargetCapabilityDTO {
+	b := newTargetStateBuilder(in.Landscape, in.Roles)
+	b.applyRealizationRoles()
+	for _, direction := range in.Directions {
+		b.applyDirection(direction)
+	}
+	for _, journey := range in.Journeys {
+		b.applyJourney(journey)
+	}
+	return b.resolveDomains()
+}
+
+type targetStateBuilder struct {
+	capabilities []*TargetCapabilityDTO
+	byID         map[string]*TargetCapabilityDTO
+	roles        map[string]map[string]string
+	domainNames  map[string]string
+}
+
+func newTargetStateBuilder(landscape []services.LandscapeCapability, roles []RealizationRoleDTO) *targetStateBuilder {
+	b := &targetStateBuilder{
+		byID:        make(map[string]*TargetCapabilityDTO, len(landscape)),
+		roles:       map[string]map[string]string{},
+		domainNames: map[string]string{},
+	}
+	for _, capability := range landscape {
+		target := &TargetCapabilityDTO{
+			CapabilityID:        capability.ID,
+			Name:                capability.Name,
+			ParentID:            capability.ParentID,
+			BusinessDomainID:    capability.BusinessDomainID,
+			BusinessDomainName:  capability.BusinessDomainName,
+			Applications:        make([]TargetApplicationDTO, 0, len(capability.Applications)),
+			RetiredApplications: []TargetApplicationDTO{},
+		}
+		for _, app := range capability.Applications {
+			target.Applications = append(target.Applications, TargetApplicationDTO{ComponentID: app.ID, ComponentName: app.Name})
+		}
+		b.capabilities = append(b.capabilities, target)
+		b.byID[capability.ID] = target
+		if capability.BusinessDomainID != "" {
+			b.domainNames[capability.BusinessDomainID] = capability.BusinessDomainName
+		}
+	}
+	for _, role := range roles {
+		if b.roles[role.CapabilityID] == nil {
+			b.roles[role.CapabilityID] = map[string]string{}
+		}
+		b.roles[role.CapabilityID][role.ComponentID] = role.Role
+	}
+	return b
+}
+
+func (b *targetStateBuilder) applyRealizationRoles() {
+	for _, capability := range b.capabilities {
+		if b.hasRole(capability, valueobjects.RealizationRoleStandard) {
+			b.retireLegacy(capability, TargetChangeRealizationRole)
+		}
+	}
+}
+
+func (b *targetStateBuilder) applyDirection(direction DirectionStandardDTO) {
+	for _, capabilityID := range direction.SourceCapabilityIDs {
+		capability, ok := b.byID[capabilityID]
+		if !ok || !b.hasRole(capability, valueobjects.RealizationRoleLegacy) {
+			continue
+		}
+		b.retireLegacy(capability, TargetChangeDirection)
+		addApplication(capability, TargetApplicationDTO{
+			ComponentID:   direction.StandardApplicationID,
+			ComponentName: direction.StandardApplicationName,
+			Change:        TargetChangeDirection,
+		})
+		capability.DirectionID = direction.DirectionID
+	}
+}
+
+func (b *targetStateBuilder) applyJourney(journey CapabilityJourneyDTO) {
+	capability, ok := b.byID[journey.CapabilityID]
+	if !ok {
+		return
+	}
+	capability.JourneyID, capability.JourneyKind = journey.ID, journey.Kind
+	if journey.Kind == valueobjects.JourneyKindMove {
+		b.applyMove(capability, journey.Move)
+		return
+	}
+	for _, from := range journey.FromApplications {
+		retireApplication(capability, from.ComponentID, TargetChangeJourney)
+	}
+	if journey.ToApplication.ComponentID != "" && !journey.ToApplication.Stale {
+		addApplication(capability, TargetApplicationDTO{
+			ComponentID:   journey.ToApplication.ComponentID,
+			ComponentName: journey.ToApplication.ComponentName,
+			Change:        TargetChangeJourney,
+		})
+	}
+}
+
+// applyMove re-parents the capability; its business domain is resolved once
+// every move is known, because a parent may be moving too.
+func (b *targetStateBuilder) applyMove(capability *TargetCapabilityDTO, move *JourneyMoveDTO) {
+	if move == nil {
+		return
+	}
+	origin := TargetMoveOriginDTO{
+		Name:               capability.Name,
+		ParentID:           capability.ParentID,
+		BusinessDomainID:   capability.BusinessDomainID,
+		BusinessDomainName: capability.BusinessDomainName,
+	}
+	capability.ParentID = move.TargetParentID
+	if move.ResultingName != "" {
+		capability.Name = move.ResultingName
+	}
+	if move.TargetParentID == "" {
+		capability.BusinessDomainID = move.TargetDomainID
+	}
+	if move.TargetDomainID != "" && !move.TargetDomainStale {
+		b.domainNames[move.TargetDomainID] = move.TargetDomainName
+	}
+	capability.MovedFrom = &origin
+}
+
+// resolveDomains places every capability in the business domain of its
+// top-most ancestor after the moves, the same way effective domain membership
+// works today. A move already carried out in capability mapping changes
+// nothing, so it is not reported as one.
+func (b *targetStateBuilder) resolveDomains() []TargetCapabilityDTO {
+	resolved := map[string]string{}
+	var resolve func(id string, visiting map[string]bool) string
+	resolve = func(id string, visiting map[string]bool) string {
+		if domainID, ok := resolved[id]; ok {
+			return domainID
+		}
+		capability := b.byID[id]
+		domainID := capability.BusinessDomainID
+		parent, hasParent := b.byID[capability.ParentID]
+		if hasParent && !visiting[id] {
+			visiting[id] = true
+			domainID = resolve(parent.CapabilityID, visiting)
+		}
+		resolved[id] = domainID
+		return domainID
+	}
+
+	out := make([]TargetCapabilityDTO, len(b.capabilities))
+	for i, capability := range b.capabilities {
+		domainID := resolve(capability.CapabilityID, map[string]bool{})
+		if capability.MovedFrom != nil && capability.MovedFrom.BusinessDomainID == domainID &&
+			capability.MovedFrom.ParentID == capability.ParentID && capability.MovedFrom.Name == capability.Name {
+			capability.MovedFrom = nil
+		}
+		capability.BusinessDomainID = domainID
+		capability.BusinessDomainName = b.domainNames[domainID]
+		out[i] = *capability
+	}
+	return out
+}
+
+func (b *targetStateBuilder) hasRole(capability *TargetCapabilityDTO, role string) bool {
+	roles := b.roles[capability.CapabilityID]
+	for _, app := range capability.Applications {
+		if roles[app.ComponentID] == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *targetStateBuilder) retireLegacy(capability *TargetCapabilityDTO, change string) {
+	roles := b.roles[capability.CapabilityID]
+	for _, app := range append([]TargetApplicationDTO(nil), capability.Applications...) {
+		if roles[app.ComponentID] == valueobjects.RealizationRoleLegacy {
+			retireApplication(capability, app.ComponentID, change)
+		}
+	}
+}
+
+func retireApplication(capability *TargetCapabilityDTO, componentID, change string) {
+	for i, app := range capability.Applications {
+		if app.ComponentID != componentID {
+			continue
+		}
+		capability.Applications = append(capability.Applications[:i], capability.Applications[i+1:]...)
+		app.Change = change
+		capability.RetiredApplications = append(capability.RetiredApplications, app)
+		return
+	}
+}
+
+func addApplication(capability *TargetCapabilityDTO, app TargetApplicationDTO) {
+	for _, existing := range capability.Applications {
+		if existing.ComponentID == app.ComponentID {
+			return
+		}
+	}
+	for i, retired := range capability.RetiredApplications {
+		if retired.ComponentID == app.ComponentID {
+			capability.RetiredApplications = append(capability.RetiredApplications[:i], capability.RetiredApplications[i+1:]...)
+			break
+		}
+	}
+	capability.Applications = append(capability.Applications, app)
+}
@@ -0,0 +1,164 @@
+package readmodels
+
+import (
+	"testing"
+
+	"easi/backend/internal/architecturedirection/domain/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func landscapeCapability(id, parentID, domainID string, appIDs ...string) services.LandscapeCapability {
+	capability := services.LandscapeCapability{
+		ID: id, Name: "Name " + id, ParentID: parentID,
+		BusinessDomainID: domainID, BusinessDomainName: "Domain " + domainID,
+	}
+	for _, appID := range appIDs {
+		capability.Applications = append(capability.Applications, services.LandscapeApplication{ID: appID, Name: "App " + appID})
+	}
+	return capability
+}
+
+func roleOf(capabilityID, componentID, role string) RealizationRoleDTO {
+	return RealizationRoleDTO{CapabilityID: capabilityID, ComponentID: componentID, Role: role}
+}
+
+func appIDs(apps []TargetApplicationDTO) []string {
+	ids := []string{}
+	for _, app := range apps {
+		ids = append(ids, app.ComponentID)
+	}
+	return ids
+}
+
+func targetByID(t *testing.T, state []TargetCapabilityDTO, id string) TargetCapabilityDTO {
+	t.Helper()
+	for _, capability := range state {
+		if capability.CapabilityID == id {
+			return capability
+		}
+	}
+	require.Failf(t, "capability missing from target state", "id %s", id)
+	return TargetCapabilityDTO{}
+}
+
+func TestBuildTargetState_UnchangedLandscapeIsReturnedAsIs(t *testing.T) {
+	state := BuildTargetState(TargetStateInputs{
+		Landscape: []services.LandscapeCapability{landscapeCapability("cap-1", "", "dom-1", "app-a", "app-b")},
+	})
+
+	require.Len(t, state, 1)
+	assert.Equal(t, []string{"app-a", "app-b"}, appIDs(state[0].Applications))
+	assert.Empty(t, state[0].RetiredApplications)
+	assert.Equal(t, "dom-1", state[0].BusinessDomainID)
+	assert.Nil(t, state[0].MovedFrom)
+}
+
+func TestBuildTargetState_StandardRoleRetiresLegacyApplications(t *testing.T) {
+	state := BuildTargetState(TargetStateInputs{
+		Landscape: []services.LandscapeCapability{
+			landscapeCapability("cap-1", "", "dom-1", "app-std", "app-old", "app-other"),
+			landscapeCapability("cap-2", "", "dom-1", "app-old"),
+		},
+		Roles: []RealizationRoleDTO{
+			roleOf("cap-1", "app-std", "standard"),
+			roleOf("cap-1", "app-old", "legacy"),
+			roleOf("cap-2", "app-old", "legacy"),
+		},
+	})
+
+	first := targetByID(t, state, "cap-1")
+	assert.Equal(t, []string{"app-std", "app-other"}, appIDs(first.Applications))
+	require.Len(t, first.RetiredApplications, 1)
+	assert.Equal(t, TargetChangeRealizationRole, first.RetiredApplications[0].Change)
+	assert.Equal(t, []string{"app-old"}, appIDs(targetByID(t, state, "cap-2").Applications),
+		"legacy stays where no standard is designated")
+}
+
+func TestBuildTargetState_AgreedDirectionReplacesLegacyWithStandardApplication(t *testing.T) {
+	state := BuildTargetState(TargetStateInputs{
+		Landscape: []services.LandscapeCapability{
+			landscapeCapability("cap-1", "", "dom-1", "app-old", "app-keep"),
+			landscapeCapability("cap-2", "", "dom-1", "app-unclassified"),
+		},
+		Roles: []RealizationRoleDTO{roleOf("cap-1", "app-old", "legacy")},
+		Directions: []DirectionStandardDTO{{
+			DirectionID: "dir-1", StandardApplicationID: "app-std", StandardApplicationName: "Standard",
+			SourceCapabilityIDs: []string{"cap-1", "cap-2"},
+		}},
+	})
+
+	first := targetByID(t, state, "cap-1")
+	assert.Equal(t, []string{"app-keep", "app-std"}, appIDs(first.Applications))
+	assert.Equal(t, TargetChangeDirection, first.Applications[1].Change)
+	assert.Equal(t, "dir-1", first.DirectionID)
+	second := targetByID(t, state, "cap-2")
+	assert.Equal(t, []string{"app-unclassified"}, appIDs(second.Applications), "nothing legacy to replace")
+	assert.Empty(t, second.DirectionID)
+}
+
+func TestBuildTargetState_JourneysSwapApplications(t *testing.T) {
+	state := BuildTargetState(TargetStateInputs{
+		Landscape: []services.LandscapeCapability{
+			landscapeCapability("cap-1", "", "dom-1", "app-a", "app-b", "app-c"),
+		},
+		Journeys: []CapabilityJourneyDTO{{
+			ID: "j-1", CapabilityID: "cap-1", Kind: "consolidation",
+			FromApplications: []JourneyApplicationRefDTO{{ComponentID: "app-a"}, {ComponentID: "app-b"}},
+			ToApplication:    JourneyApplicationRefDTO{ComponentID: "app-new", ComponentName: "New"},
+		}},
+	})
+
+	capability := targetByID(t, state, "cap-1")
+	assert.Equal(t, []string{"app-c", "app-new"}, appIDs(capability.Applications))
+	assert.Equal(t, []string{"app-a", "app-b"}, appIDs(capability.RetiredApplications))
+	assert.Equal(t, "j-1", capability.JourneyID)
+	assert.Equal(t, "consolidation", capability.JourneyKind)
+}
+
+func TestBuildTargetState_MoveReparentsCapabilityAndItsDescendants(t *testing.T) {
+	state := BuildTargetState(TargetStateInputs{
+		Landscape: []services.LandscapeCapability{
+			landscapeCapability("l1-a", "", "dom-a"),
+			landscapeCapability("l2-moving", "l1-a", "dom-a", "app-1"),
+			landscapeCapability("l3-child", "l2-moving", "dom-a"),
+			landscapeCapability("l1-b", "", "dom-b"),
+		},
+		Journeys: []CapabilityJourneyDTO{{
+			ID: "j-move", CapabilityID: "l2-moving", Kind: "move",
+			Move: &JourneyMoveDTO{TargetDomainID: "dom-b", TargetDomainName: "Domain dom-b", TargetParentID: "l1-b", ResultingName: "Renamed"},
+		}},
+	})
+
+	moved := targetByID(t, state, "l2-moving")
+	assert.Equal(t, "l1-b", moved.ParentID)
+	assert.Equal(t, "Renamed", moved.Name)
+	assert.Equal(t, "dom-b", moved.BusinessDomainID)
+	assert.Equal(t, []string{"app-1"}, appIDs(moved.Applications))
+	require.NotNil(t, moved.MovedFrom)
+	assert.Equal(t, TargetMoveOriginDTO{Name: "Name l2-moving", ParentID: "l1-a", BusinessDomainID: "dom-a", BusinessDomainName: "Domain dom-a"}, *moved.MovedFrom)
+	assert.Equal(t, "dom-b", targetByID(t, state, "l3-child").BusinessDomainID, "descendants move with their parent")
+	assert.Equal(t, "dom-a", targetByID(t, state, "l1-a").BusinessDomainID)
+}
+
+func TestBuildTargetState_MoveToDomainRootAndAlreadyExecutedMove(t *testing.T) {
+	state := BuildTargetState(TargetStateInputs{
+		Landscape: []services.LandscapeCapability{
+			landscapeCapability("l1-a", "", "dom-a"),
+			landscapeCapability("l2-root", "l1-a", "dom-a"),
+			landscapeCapability("l1-done", "", "dom-b"),
+		},
+		Journeys: []CapabilityJourneyDTO{
+			{ID: "j-1", CapabilityID: "l2-root", Kind: "move", Move: &JourneyMoveDTO{TargetDomainID: "dom-c", TargetDomainName: "Domain C"}},
+			{ID: "j-2", CapabilityID: "l1-done", Kind: "move", Move: &JourneyMoveDTO{TargetDomainID: "dom-b", TargetDomainName: "Domain dom-b"}},
+		},
+	})
+
+	promoted := targetByID(t, state, "l2-root")
+	assert.Empty(t, promoted.ParentID)
+	assert.Equal(t, "dom-c", promoted.BusinessDomainID)
+	assert.Equal(t, "Domain C", promoted.BusinessDomainName)
+	assert.NotNil(t, promoted.MovedFrom)
+	assert.Nil(t, targetByID(t, state, "l1-done").MovedFrom, "a move already carried out is not reported")
+}
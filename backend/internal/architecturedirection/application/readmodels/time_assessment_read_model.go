@@ -56,6 +56,31 @@ type UpsertTimeAssessmentParams struct {
 	AssessedAt    time.Time
 }
 
+// TimeAssessmentHistoryEntryDTO is one change to a TIME assessment. Grade is
+// nil on the entry that closed the assessment: removed, transferred away, or
+// dropped with its capability or component.
+type TimeAssessmentHistoryEntryDTO struct {
+	TimeAssessmentID string    `json:"timeAssessmentId"`
+	CapabilityID     string    `json:"capabilityId"`
+	ComponentID      string    `json:"componentId"`
+	Grade            *string   `json:"grade"`
+	PreviousGrade    *string   `json:"previousGrade"`
+	Rationale        string    `json:"rationale"`
+	ChangedBy        string    `json:"changedBy"`
+	ChangedByName    string    `json:"changedByName"`
+	ChangedAt        time.Time `json:"changedAt"`
+}
+
+type AppendTimeAssessmentHistoryParams struct {
+	TimeAssessmentID string
+	CapabilityID     string
+	ComponentID      string
+	Grade            string
+	Rationale        string
+	ChangedBy        string
+	ChangedAt        time.Time
+}
+
 type TimeAssessmentReadModel struct {
 	db *database.TenantAwareDB
 }
@@ -119,18 +144,90 @@ func (rm *TimeAssessmentReadModel) Delete(ctx context.Context, id string) error
 	)
 }
 
+// DeleteByCapabilityID and DeleteByComponentID drop assessments whose
+// reference was deleted. No assessment event is raised for these, so the
+// history entry that closes each one is written here.
 func (rm *TimeAssessmentReadModel) DeleteByCapabilityID(ctx context.Context, capabilityID string) error {
 	return rm.tenantExec(ctx,
-		`DELETE FROM architecturedirection.time_assessments WHERE tenant_id = $1 AND capability_id = $2`,
-		func(t string) []any { return []any{t, capabilityID} },
+		deleteByCapabilityAndCloseHistory,
+		func(t string) []any { return []any{t, capabilityID, closedByCapabilityDeleted} },
 	)
 }
 
 func (rm *TimeAssessmentReadModel) DeleteByComponentID(ctx context.Context, componentID string) error {
 	return rm.tenantExec(ctx,
-		`DELETE FROM architecturedirection.time_assessments WHERE tenant_id = $1 AND component_id = $2`,
-		func(t string) []any { return []any{t, componentID} },
+		deleteByComponentAndCloseHistory,
+		func(t string) []any { return []any{t, componentID, closedByComponentDeleted} },
+	)
+}
+
+const (
+	closedByCapabilityDeleted = "system:capability-deleted"
+	closedByComponentDeleted  = "system:component-deleted"
+)
+
+const deleteByCapabilityAndCloseHistory = `WITH removed AS (
+	  DELETE FROM architecturedirection.time_assessments
+	  WHERE tenant_id = $1 AND capability_id = $2
+	  RETURNING tenant_id, id, capability_id, component_id, grade
 	)
+	INSERT INTO architecturedirection.time_assessment_history
+	(tenant_id, time_assessment_id, sequence, capability_id, component_id, grade, previous_grade, rationale, changed_by, changed_at)
+	SELECT r.tenant_id, r.id,
+	  COALESCE((SELECT MAX(h.sequence) FROM architecturedirection.time_assessment_history h
+	            WHERE h.tenant_id = r.tenant_id AND h.time_assessment_id = r.id), 0) + 1,
+	  r.capability_id, r.component_id, NULL, r.grade, '', $3, now() AT TIME ZONE 'UTC'
+	FROM removed r`
+
+const deleteByComponentAndCloseHistory = `WITH removed AS (
+	  DELETE FROM architecturedirection.time_assessments
+	  WHERE tenant_id = $1 AND component_id = $2
+	  RETURNING tenant_id, id, capability_id, component_id, grade
+	)
+	INSERT INTO architecturedirection.time_assessment_history
+	(tenant_id, time_assessment_id, sequence, capability_id, component_id, grade, previous_grade, rationale, changed_by, changed_at)
+	SELECT r.tenant_id, r.id,
+	  COALESCE((SELECT MAX(h.sequence) FROM architecturedirection.time_assessment_history h
+	            WHERE h.tenant_id = r.tenant_id AND h.time_assessment_id = r.id), 0) + 1,
+	  r.capability_id, r.component_id, NULL, r.grade, '', $3, now() AT TIME ZONE 'UTC'
+	FROM removed r`
+
+func (rm *TimeAssessmentReadModel) AppendHistory(ctx context.Context, p AppendTimeAssessmentHistoryParams) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+	grade := sql.NullString{}
+	if p.Grade != "" {
+		grade = sql.NullString{String: p.Grade, Valid: true}
+	}
+	tx, err := rm.db.BeginTxWithTenant(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var lastSequence int
+	var previousGrade sql.NullString
+	err = tx.QueryRowContext(ctx,
+		`SELECT sequence, grade FROM architecturedirection.time_assessment_history
+		 WHERE tenant_id = $1 AND time_assessment_id = $2
+		 ORDER BY sequence DESC LIMIT 1`,
+		tenantID, p.TimeAssessmentID,
+	).Scan(&lastSequence, &previousGrade)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO architecturedirection.time_assessment_history
+		 (tenant_id, time_assessment_id, sequence, capability_id, component_id, grade, previous_grade, rationale, changed_by, changed_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		tenantID, p.TimeAssessmentID, lastSequence+1, p.CapabilityID, p.ComponentID, grade, previousGrade,
+		p.Rationale, p.ChangedBy, p.ChangedAt,
+	); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func (rm *TimeAssessmentReadModel) CacheCapabilityName(ctx context.Context, capabilityID, name string) error {
@@ -375,6 +472,58 @@ func applyGradeCount(counts *TimeGradeCounts, grade string, count int) {
 	}
 }
 
+const timeAssessmentHistorySelect = `SELECT h.time_assessment_id, h.capability_id, h.component_id, h.grade, h.previous_grade,
+	h.rationale, h.changed_by, COALESCE(usr.name, h.changed_by), h.changed_at
+	FROM architecturedirection.time_assessment_history h
+	LEFT JOIN architecturedirection.reference_name_cache usr
+	  ON usr.tenant_id = h.tenant_id AND usr.entity_type = 'user' AND usr.entity_id = h.changed_by`
+
+// GetHistoryByPair returns every change made to the pair's assessments, newest
+// first. A pair removed and later re-assessed spans more than one assessment.
+func (rm *TimeAssessmentReadModel) GetHistoryByPair(ctx context.Context, capabilityID, componentID string) ([]TimeAssessmentHistoryEntryDTO, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rm.history(ctx,
+		`WHERE h.tenant_id = $1 AND h.capability_id = $2 AND h.component_id = $3
+		 ORDER BY h.changed_at DESC, h.sequence DESC`,
+		tenantID, capabilityID, componentID)
+}
+
+// GetAllHistory returns the tenant's full assessment history, oldest first.
+func (rm *TimeAssessmentReadModel) GetAllHistory(ctx context.Context) ([]TimeAssessmentHistoryEntryDTO, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rm.history(ctx,
+		`WHERE h.tenant_id = $1
+		 ORDER BY h.changed_at, h.time_assessment_id, h.sequence`,
+		tenantID)
+}
+
+func (rm *TimeAssessmentReadModel) history(ctx context.Context, filter string, args ...any) ([]TimeAssessmentHistoryEntryDTO, error) {
+	entries := []TimeAssessmentHistoryEntryDTO{}
+	err := rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, timeAssessmentHistorySelect+"\n"+filter, args...)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			entry, scanErr := scanTimeAssessmentHistoryEntry(rows)
+			if scanErr != nil {
+				return scanErr
+			}
+			entries = append(entries, entry)
+		}
+		return rows.Err()
+	})
+	return entries, err
+}
+
 type timeAssessmentRowScanner interface {
 	Scan(dest ...any) error
 }
@@ -385,3 +534,17 @@ func scanTimeAssessment(row timeAssessmentRowScanner) (TimeAssessmentDTO, error)
 		&dto.Grade, &dto.Rationale, &dto.AssessedBy, &dto.AssessedByName, &dto.AssessedAt, &dto.Stale)
 	return dto, err
 }
+
+func scanTimeAssessmentHistoryEntry(row timeAssessmentRowScanner) (TimeAssessmentHistoryEntryDTO, error) {
+	var entry TimeAssessmentHistoryEntryDTO
+	var grade, previousGrade sql.NullString
+	err := row.Scan(&entry.TimeAssessmentID, &entry.CapabilityID, &entry.ComponentID, &grade, &previousGrade,
+		&entry.Rationale, &entry.ChangedBy, &entry.ChangedByName, &entry.ChangedAt)
+	if grade.Valid {
+		entry.Grade = &grade.String
+	}
+	if previousGrade.Valid {
+		entry.PreviousGrade = &previousGrade.String
+	}
+	return entry, err
+}
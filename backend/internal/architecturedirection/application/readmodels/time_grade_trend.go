@@ -0,0 +1,53 @@
+package readmodels
+
+import (
+	"sort"
+	"time"
+)
+
+type TimeGradeTrendPointDTO struct {
+	Year    int             `json:"year"`
+	Quarter int             `json:"quarter"`
+	AsOf    time.Time       `json:"asOf"`
+	Counts  TimeGradeCounts `json:"counts"`
+	Total   int             `json:"total"`
+}
+
+// BuildTimeGradeTrend replays the assessment history and, for each period,
+// counts the assessments whose latest change before asOf left them graded.
+// Periods must be in chronological order.
+func BuildTimeGradeTrend(history []TimeAssessmentHistoryEntryDTO, periods []TimeGradeTrendPointDTO) []TimeGradeTrendPointDTO {
+	ordered := make([]TimeAssessmentHistoryEntryDTO, len(history))
+	copy(ordered, history)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].ChangedAt.Before(ordered[j].ChangedAt) })
+
+	current := map[string]string{}
+	next := 0
+	trend := make([]TimeGradeTrendPointDTO, len(periods))
+	for i, period := range periods {
+		for ; next < len(ordered) && ordered[next].ChangedAt.Before(period.AsOf); next++ {
+			entry := ordered[next]
+			if entry.Grade == nil {
+				delete(current, entry.TimeAssessmentID)
+				continue
+			}
+			current[entry.TimeAssessmentID] = *entry.Grade
+		}
+		trend[i] = TimeGradeTrendPointDTO{Year: period.Year, Quarter: period.Quarter, AsOf: period.AsOf}
+		trend[i].Counts = countGrades(current)
+		trend[i].Total = len(current)
+	}
+	return trend
+}
+
+func countGrades(grades map[string]string) TimeGradeCounts {
+	perGrade := map[string]int{}
+	for _, grade := range grades {
+		perGrade[grade]++
+	}
+	var counts TimeGradeCounts
+	for grade, count := range perGrade {
+		applyGradeCount(&counts, grade, count)
+	}
+	return counts
+}
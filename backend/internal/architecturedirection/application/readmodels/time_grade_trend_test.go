@@ -0,0 +1,60 @@
+package readmodels
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gradeChange(assessmentID, grade string, at time.Time) TimeAssessmentHistoryEntryDTO {
+	entry := TimeAssessmentHistoryEntryDTO{TimeAssessmentID: assessmentID, ChangedAt: at}
+	if grade != "" {
+		entry.Grade = &grade
+	}
+	return entry
+}
+
+func quarterPoint(year, quarter int) TimeGradeTrendPointDTO {
+	return TimeGradeTrendPointDTO{
+		Year:    year,
+		Quarter: quarter,
+		AsOf:    time.Date(year, time.Month(quarter*3+1), 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestBuildTimeGradeTrend_CountsLatestGradeAtEachQuarterEnd(t *testing.T) {
+	history := []TimeAssessmentHistoryEntryDTO{
+		gradeChange("ta-1", "Eliminate", time.Date(2025, time.February, 10, 0, 0, 0, 0, time.UTC)),
+		gradeChange("ta-2", "Eliminate", time.Date(2025, time.March, 5, 0, 0, 0, 0, time.UTC)),
+		gradeChange("ta-3", "Invest", time.Date(2025, time.March, 31, 23, 0, 0, 0, time.UTC)),
+		gradeChange("ta-1", "Migrate", time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)),
+		gradeChange("ta-2", "", time.Date(2025, time.August, 20, 0, 0, 0, 0, time.UTC)),
+	}
+
+	trend := BuildTimeGradeTrend(history, []TimeGradeTrendPointDTO{
+		quarterPoint(2024, 4), quarterPoint(2025, 1), quarterPoint(2025, 2), quarterPoint(2025, 3),
+	})
+
+	require.Len(t, trend, 4)
+	assert.Equal(t, TimeGradeCounts{}, trend[0].Counts, "nothing assessed before the first change")
+	assert.Equal(t, TimeGradeCounts{Invest: 1, Eliminate: 2}, trend[1].Counts, "a change on the last day of the quarter counts")
+	assert.Equal(t, TimeGradeCounts{Invest: 1, Migrate: 1, Eliminate: 1}, trend[2].Counts)
+	assert.Equal(t, TimeGradeCounts{Invest: 1, Migrate: 1}, trend[3].Counts, "a closed assessment drops out")
+	assert.Equal(t, 2, trend[3].Total)
+	assert.Equal(t, 2025, trend[3].Year)
+	assert.Equal(t, 3, trend[3].Quarter)
+}
+
+func TestBuildTimeGradeTrend_ReassessedAfterRemovalCountsAgain(t *testing.T) {
+	history := []TimeAssessmentHistoryEntryDTO{
+		gradeChange("ta-1", "Tolerate", time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC)),
+		gradeChange("ta-1", "", time.Date(2025, time.February, 10, 0, 0, 0, 0, time.UTC)),
+		gradeChange("ta-2", "Eliminate", time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)),
+	}
+
+	trend := BuildTimeGradeTrend(history, []TimeGradeTrendPointDTO{quarterPoint(2025, 1)})
+
+	assert.Equal(t, TimeGradeCounts{Eliminate: 1}, trend[0].Counts)
+}
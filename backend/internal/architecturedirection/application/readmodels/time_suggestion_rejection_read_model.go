@@ -0,0 +1,103 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"easi/backend/internal/infrastructure/database"
+)
+
+type TimeSuggestionRejectionDTO struct {
+	ID                  string    `json:"id"`
+	CapabilityID        string    `json:"capabilityId"`
+	ComponentID         string    `json:"componentId"`
+	SuggestedGrade      string    `json:"suggestedGrade"`
+	EvidenceFingerprint string    `json:"evidenceFingerprint"`
+	Reason              string    `json:"reason"`
+	RejectedBy          string    `json:"rejectedBy"`
+	RejectedAt          time.Time `json:"rejectedAt"`
+}
+
+type TimeSuggestionRejectionReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewTimeSuggestionRejectionReadModel(db *database.TenantAwareDB) *TimeSuggestionRejectionReadModel {
+	return &TimeSuggestionRejectionReadModel{db: db}
+}
+
+func (rm *TimeSuggestionRejectionReadModel) Upsert(ctx context.Context, dto TimeSuggestionRejectionDTO) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = rm.db.ExecContext(ctx,
+		`INSERT INTO architecturedirection.time_suggestion_rejections
+		 (id, tenant_id, capability_id, component_id, suggested_grade, evidence_fingerprint, reason, rejected_by, rejected_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		 ON CONFLICT (tenant_id, id) DO UPDATE SET
+		   suggested_grade = EXCLUDED.suggested_grade,
+		   evidence_fingerprint = EXCLUDED.evidence_fingerprint,
+		   reason = EXCLUDED.reason,
+		   rejected_by = EXCLUDED.rejected_by,
+		   rejected_at = EXCLUDED.rejected_at`,
+		dto.ID, tenantID, dto.CapabilityID, dto.ComponentID, dto.SuggestedGrade, dto.EvidenceFingerprint,
+		dto.Reason, dto.RejectedBy, dto.RejectedAt,
+	)
+	return err
+}
+
+func (rm *TimeSuggestionRejectionReadModel) FindAggregateIDForPair(ctx context.Context, capabilityID, componentID string) (string, bool, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	var id string
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx,
+			`SELECT id FROM architecturedirection.time_suggestion_rejections
+			 WHERE tenant_id = $1 AND capability_id = $2 AND component_id = $3`,
+			tenantID, capabilityID, componentID,
+		).Scan(&id)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return id, true, nil
+}
+
+func (rm *TimeSuggestionRejectionReadModel) GetAll(ctx context.Context) ([]TimeSuggestionRejectionDTO, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rejections := []TimeSuggestionRejectionDTO{}
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT id, capability_id, component_id, suggested_grade, evidence_fingerprint, reason, rejected_by, rejected_at
+			 FROM architecturedirection.time_suggestion_rejections
+			 WHERE tenant_id = $1`,
+			tenantID,
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto TimeSuggestionRejectionDTO
+			if err := rows.Scan(&dto.ID, &dto.CapabilityID, &dto.ComponentID, &dto.SuggestedGrade,
+				&dto.EvidenceFingerprint, &dto.Reason, &dto.RejectedBy, &dto.RejectedAt); err != nil {
+				return err
+			}
+			rejections = append(rejections, dto)
+		}
+		return rows.Err()
+	})
+	return rejections, err
+}
@@ -18,6 +18,10 @@ var (
 	ErrInvalidJourneyTransition        = errors.New("journey transition not allowed from its current status")
 	ErrJourneyFrozen                   = errors.New("journey is frozen and can no longer be edited")
 	ErrJourneyMilestoneNotFound        = errors.New("milestone not found on journey")
+	ErrJourneyCapabilityUnchanged      = errors.New("journey is already planned for this capability")
+	ErrJourneyDependsOnItself          = errors.New("journey cannot depend on itself")
+	ErrJourneyDependencyExists         = errors.New("journey already depends on this predecessor")
+	ErrJourneyDependencyNotFound       = errors.New("dependency not found on journey")
 	ErrCorruptedCapabilityJourneyEvent = errors.New("corrupted event store: cannot rehydrate capability journey")
 	ErrUnknownCapabilityJourneyEvent   = errors.New("unknown event type for capability journey aggregate")
 )
@@ -36,6 +40,8 @@ type CapabilityJourney struct {
 	targetDomain  *valueobjects.BusinessDomainRef
 	targetParent  *valueobjects.PhysicalCapabilityRef
 	resultingName string
+	dependencies  map[string]valueobjects.JourneyDependencyType
+	template      *JourneyTemplateOrigin
 }
 
 type CapabilityJourneyFacts struct {
@@ -50,6 +56,17 @@ type CapabilityJourneyFacts struct {
 	TargetParent  *valueobjects.PhysicalCapabilityRef
 	ResultingName string
 	PlannedBy     string
+	// Template and Milestones are set when the journey is seeded from a journey
+	// template; the milestones are copied so later template revisions leave
+	// this journey alone.
+	Template   *JourneyTemplateOrigin
+	Milestones []MilestoneFacts
+}
+
+// JourneyTemplateOrigin names the template version a journey was seeded from.
+type JourneyTemplateOrigin struct {
+	ID      string
+	Version int
 }
 
 type MilestoneFacts struct {
@@ -78,6 +95,11 @@ func PlanCapabilityJourney(facts CapabilityJourneyFacts) (*CapabilityJourney, er
 	if err != nil {
 		return nil, err
 	}
+	milestones, err := buildSeededMilestones(facts.Milestones)
+	if err != nil {
+		return nil, err
+	}
+	templateID, templateVersion := templateOriginParts(facts.Template)
 
 	aggregate := &CapabilityJourney{
 		AggregateRoot: domain.NewAggregateRootWithID(facts.ID.Value()),
@@ -93,8 +115,13 @@ func PlanCapabilityJourney(facts CapabilityJourneyFacts) (*CapabilityJourney, er
 		TargetDomainID:   optionalRefValue(facts.TargetDomain),
 		TargetParentID:   optionalRefValue(facts.TargetParent),
 		ResultingName:    resultingName,
+		TemplateID:       templateID,
+		TemplateVersion:  templateVersion,
 		PlannedBy:        facts.PlannedBy,
 	}))
+	for i, m := range milestones {
+		aggregate.raise(events.NewJourneyMilestoneAdded(aggregate.milestoneEventFields(m, facts.Milestones[i])))
+	}
 	return aggregate, nil
 }
 
@@ -115,11 +142,17 @@ func LoadCapabilityJourneyFromHistory(eventHistory []domain.DomainEvent) (*Capab
 	return aggregate, nil
 }
 
-func (j *CapabilityJourney) Start(actor string) error {
+// Start moves a planned journey in flight. Unmet predecessors do not block the
+// start; they are recorded on the event so the warning survives in history.
+func (j *CapabilityJourney) Start(actor string, unmetPredecessorIDs ...string) error {
 	if !j.status.CanStart() {
 		return ErrInvalidJourneyTransition
 	}
-	j.raise(events.NewJourneyStarted(events.JourneyStartedFields{ID: j.ID(), StartedBy: actor}))
+	j.raise(events.NewJourneyStarted(events.JourneyStartedFields{
+		ID:                  j.ID(),
+		StartedBy:           actor,
+		UnmetPredecessorIDs: unmetPredecessorIDs,
+	}))
 	return nil
 }
 
@@ -223,6 +256,61 @@ func (j *CapabilityJourney) RemoveMilestone(milestoneID, actor string) error {
 	return nil
 }
 
+// AddDependency records that this journey waits on a predecessor journey. Cycles
+// span several aggregates and are rejected by the caller before this is invoked.
+func (j *CapabilityJourney) AddDependency(predecessorID valueobjects.CapabilityJourneyID, depType valueobjects.JourneyDependencyType, actor string) error {
+	if err := j.requireActive(); err != nil {
+		return err
+	}
+	if predecessorID.Value() == j.ID() {
+		return ErrJourneyDependsOnItself
+	}
+	if _, exists := j.dependencies[predecessorID.Value()]; exists {
+		return ErrJourneyDependencyExists
+	}
+	j.raise(events.NewJourneyDependencyAdded(events.JourneyDependencyAddedFields{
+		ID:             j.ID(),
+		PredecessorID:  predecessorID.Value(),
+		DependencyType: depType.Value(),
+		AddedBy:        actor,
+	}))
+	return nil
+}
+
+func (j *CapabilityJourney) RemoveDependency(predecessorID, actor string) error {
+	if err := j.requireActive(); err != nil {
+		return err
+	}
+	if _, exists := j.dependencies[predecessorID]; !exists {
+		return ErrJourneyDependencyNotFound
+	}
+	j.raise(events.NewJourneyDependencyRemoved(events.JourneyDependencyRemovedFields{
+		ID:            j.ID(),
+		PredecessorID: predecessorID,
+		RemovedBy:     actor,
+	}))
+	return nil
+}
+
+// ChangeCapability re-homes an active journey when its capability is merged into
+// or split into another one. The reason records which reorganisation caused it.
+func (j *CapabilityJourney) ChangeCapability(capabilityID valueobjects.PhysicalCapabilityRef, reason, actor string) error {
+	if err := j.requireActive(); err != nil {
+		return err
+	}
+	if capabilityID.Value() == j.capabilityID.Value() {
+		return ErrJourneyCapabilityUnchanged
+	}
+	j.raise(events.NewJourneyCapabilityChanged(events.JourneyCapabilityChangedFields{
+		ID:               j.ID(),
+		FromCapabilityID: j.capabilityID.Value(),
+		ToCapabilityID:   capabilityID.Value(),
+		Reason:           reason,
+		ChangedBy:        actor,
+	}))
+	return nil
+}
+
 func (j *CapabilityJourney) CapabilityID() valueobjects.PhysicalCapabilityRef { return j.capabilityID }
 func (j *CapabilityJourney) Kind() valueobjects.JourneyKind                   { return j.kind }
 func (j *CapabilityJourney) Status() valueobjects.JourneyStatus               { return j.status }
@@ -236,12 +324,22 @@ func (j *CapabilityJourney) TargetParent() *valueobjects.PhysicalCapabilityRef {
 }
 func (j *CapabilityJourney) ResultingName() string { return j.resultingName }
 
+func (j *CapabilityJourney) Template() *JourneyTemplateOrigin { return j.template }
+
 func (j *CapabilityJourney) FromApps() []valueobjects.ApplicationRef {
 	out := make([]valueobjects.ApplicationRef, len(j.fromApps))
 	copy(out, j.fromApps)
 	return out
 }
 
+func (j *CapabilityJourney) Dependencies() map[string]valueobjects.JourneyDependencyType {
+	out := make(map[string]valueobjects.JourneyDependencyType, len(j.dependencies))
+	for id, depType := range j.dependencies {
+		out[id] = depType
+	}
+	return out
+}
+
 func (j *CapabilityJourney) Milestones() []entities.Milestone {
 	out := make([]entities.Milestone, len(j.milestones))
 	copy(out, j.milestones)
@@ -341,12 +439,19 @@ func (j *CapabilityJourney) applyFieldUpdate(event domain.DomainEvent) error {
 		return j.applyDetailsUpdated(evt)
 	case events.JourneySourceApplicationsChanged:
 		return j.applySourceApplicationsChanged(evt)
+	case events.JourneyCapabilityChanged:
+		return j.applyCapabilityChanged(evt)
 	case events.JourneyMilestoneAdded:
 		return j.applyMilestoneUpsert(milestoneSnapshot{id: evt.MilestoneID, label: evt.Label, targetPeriod: evt.TargetPeriod, status: evt.Status})
 	case events.JourneyMilestoneUpdated:
 		return j.applyMilestoneUpsert(milestoneSnapshot{id: evt.MilestoneID, label: evt.Label, targetPeriod: evt.TargetPeriod, status: evt.Status})
 	case events.JourneyMilestoneRemoved:
 		return j.applyMilestoneRemoved(evt)
+	case events.JourneyDependencyAdded:
+		return j.applyDependencyAdded(evt)
+	case events.JourneyDependencyRemoved:
+		delete(j.dependencies, evt.PredecessorID)
+		return nil
 	default:
 		return fmt.Errorf("%w: %T", ErrUnknownCapabilityJourneyEvent, event)
 	}
@@ -376,8 +481,13 @@ func (j *CapabilityJourney) applyPlanned(evt events.JourneyPlanned) error {
 	j.targetDomain = destination.targetDomain
 	j.targetParent = destination.targetParent
 	j.resultingName = evt.ResultingName
+	j.template = nil
+	if evt.TemplateID != "" {
+		j.template = &JourneyTemplateOrigin{ID: evt.TemplateID, Version: evt.TemplateVersion}
+	}
 	j.status = status
 	j.milestones = []entities.Milestone{}
+	j.dependencies = map[string]valueobjects.JourneyDependencyType{}
 	j.progress = nil
 	return nil
 }
@@ -415,6 +525,24 @@ func (j *CapabilityJourney) applySourceApplicationsChanged(evt events.JourneySou
 	return nil
 }
 
+func (j *CapabilityJourney) applyCapabilityChanged(evt events.JourneyCapabilityChanged) error {
+	capabilityID, err := valueobjects.NewPhysicalCapabilityRef(evt.ToCapabilityID)
+	if err != nil {
+		return fmt.Errorf("%w: capability ref %q: %v", ErrCorruptedCapabilityJourneyEvent, evt.ToCapabilityID, err)
+	}
+	j.capabilityID = capabilityID
+	return nil
+}
+
+func (j *CapabilityJourney) applyDependencyAdded(evt events.JourneyDependencyAdded) error {
+	depType, err := valueobjects.NewJourneyDependencyType(evt.DependencyType)
+	if err != nil {
+		return fmt.Errorf("%w: dependency type %q: %v", ErrCorruptedCapabilityJourneyEvent, evt.DependencyType, err)
+	}
+	j.dependencies[evt.PredecessorID] = depType
+	return nil
+}
+
 type milestoneSnapshot struct {
 	id           string
 	label        string
@@ -536,6 +664,25 @@ func validateMoveFields(facts CapabilityJourneyFacts) (string, error) {
 	return name.Value(), nil
 }
 
+func buildSeededMilestones(facts []MilestoneFacts) ([]entities.Milestone, error) {
+	milestones := make([]entities.Milestone, len(facts))
+	for i, f := range facts {
+		m, err := entities.NewMilestone(f.MilestoneID, f.Label, f.TargetPeriod, f.Status)
+		if err != nil {
+			return nil, err
+		}
+		milestones[i] = m
+	}
+	return milestones, nil
+}
+
+func templateOriginParts(origin *JourneyTemplateOrigin) (string, int) {
+	if origin == nil {
+		return "", 0
+	}
+	return origin.ID, origin.Version
+}
+
 func decodeMilestone(snapshot milestoneSnapshot) (entities.Milestone, error) {
 	targetPeriod, err := decodeTargetPeriod(snapshot.targetPeriod)
 	if err != nil {
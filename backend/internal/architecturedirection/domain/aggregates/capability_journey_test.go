@@ -682,3 +682,162 @@ func (unknownCapabilityJourneyEventForTest) AggregateID() string               {
 func (unknownCapabilityJourneyEventForTest) EventType() string                 { return "UnknownEvent" }
 func (unknownCapabilityJourneyEventForTest) EventData() map[string]interface{} { return nil }
 func (unknownCapabilityJourneyEventForTest) OccurredAt() time.Time             { return time.Time{} }
+
+func TestCapabilityJourney_ChangeCapability_RehomesActiveJourney(t *testing.T) {
+	j := inFlightJourney(t)
+	fromID := j.CapabilityID().Value()
+	target, err := valueobjects.NewPhysicalCapabilityRef(uuid.New().String())
+	require.NoError(t, err)
+
+	require.NoError(t, j.ChangeCapability(target, "merged", "architect@example.com"))
+
+	assert.Equal(t, target.Value(), j.CapabilityID().Value())
+	changes := j.GetUncommittedChanges()
+	evt, ok := changes[len(changes)-1].(events.JourneyCapabilityChanged)
+	require.True(t, ok)
+	assert.Equal(t, fromID, evt.FromCapabilityID)
+	assert.Equal(t, "merged", evt.Reason)
+
+	reloaded, err := LoadCapabilityJourneyFromHistory(changes)
+	require.NoError(t, err)
+	assert.Equal(t, target.Value(), reloaded.CapabilityID().Value())
+}
+
+func TestCapabilityJourney_ChangeCapability_Rejected(t *testing.T) {
+	j := plannedJourney(t)
+	assert.ErrorIs(t, j.ChangeCapability(j.CapabilityID(), "merged", "a@example.com"), ErrJourneyCapabilityUnchanged)
+
+	target, err := valueobjects.NewPhysicalCapabilityRef(uuid.New().String())
+	require.NoError(t, err)
+	assert.ErrorIs(t, doneJourney(t).ChangeCapability(target, "merged", "a@example.com"), ErrJourneyFrozen)
+}
+
+func newDependencyType(t *testing.T, v string) valueobjects.JourneyDependencyType {
+	t.Helper()
+	d, err := valueobjects.NewJourneyDependencyType(v)
+	require.NoError(t, err)
+	return d
+}
+
+func TestCapabilityJourney_AddDependency_Succeeds(t *testing.T) {
+	j := plannedJourney(t)
+	predecessor := valueobjects.NewCapabilityJourneyID()
+
+	require.NoError(t, j.AddDependency(predecessor, newDependencyType(t, valueobjects.JourneyDependencyStartToStart), journeyActor))
+
+	deps := j.Dependencies()
+	require.Contains(t, deps, predecessor.Value())
+	assert.Equal(t, valueobjects.JourneyDependencyStartToStart, deps[predecessor.Value()].Value())
+	evt, ok := j.GetUncommittedChanges()[0].(events.JourneyDependencyAdded)
+	require.True(t, ok)
+	assert.Equal(t, predecessor.Value(), evt.PredecessorID)
+	assert.Equal(t, journeyActor, evt.AddedBy)
+}
+
+func TestCapabilityJourney_AddDependency_Rejected(t *testing.T) {
+	finishToStart := newDependencyType(t, valueobjects.JourneyDependencyFinishToStart)
+
+	j := plannedJourney(t)
+	self, err := valueobjects.NewCapabilityJourneyIDFromString(j.ID())
+	require.NoError(t, err)
+	assert.ErrorIs(t, j.AddDependency(self, finishToStart, journeyActor), ErrJourneyDependsOnItself)
+
+	predecessor := valueobjects.NewCapabilityJourneyID()
+	require.NoError(t, j.AddDependency(predecessor, finishToStart, journeyActor))
+	assert.ErrorIs(t, j.AddDependency(predecessor, finishToStart, journeyActor), ErrJourneyDependencyExists)
+
+	assert.ErrorIs(t, doneJourney(t).AddDependency(predecessor, finishToStart, journeyActor), ErrJourneyFrozen)
+}
+
+func TestCapabilityJourney_RemoveDependency(t *testing.T) {
+	j := plannedJourney(t)
+	predecessor := valueobjects.NewCapabilityJourneyID()
+	require.NoError(t, j.AddDependency(predecessor, newDependencyType(t, valueobjects.JourneyDependencyFinishToStart), journeyActor))
+
+	require.NoError(t, j.RemoveDependency(predecessor.Value(), journeyActor))
+
+	assert.Empty(t, j.Dependencies())
+	assert.ErrorIs(t, j.RemoveDependency(predecessor.Value(), journeyActor), ErrJourneyDependencyNotFound)
+}
+
+func TestCapabilityJourney_Start_RecordsUnmetPredecessors(t *testing.T) {
+	j := plannedJourney(t)
+
+	require.NoError(t, j.Start(journeyActor, "journey-0"))
+
+	evt, ok := j.GetUncommittedChanges()[0].(events.JourneyStarted)
+	require.True(t, ok)
+	assert.Equal(t, []string{"journey-0"}, evt.UnmetPredecessorIDs)
+	assert.Equal(t, valueobjects.JourneyStatusInFlight, j.Status().Value())
+}
+
+func TestLoadCapabilityJourneyFromHistory_ReconstructsDependencies(t *testing.T) {
+	j, err := planWith(t, journeyOpts{})
+	require.NoError(t, err)
+	kept := valueobjects.NewCapabilityJourneyID()
+	dropped := valueobjects.NewCapabilityJourneyID()
+	require.NoError(t, j.AddDependency(kept, newDependencyType(t, valueobjects.JourneyDependencyFinishToStart), journeyActor))
+	require.NoError(t, j.AddDependency(dropped, newDependencyType(t, valueobjects.JourneyDependencyStartToStart), journeyActor))
+	require.NoError(t, j.RemoveDependency(dropped.Value(), journeyActor))
+
+	loaded, err := LoadCapabilityJourneyFromHistory(j.GetUncommittedChanges())
+
+	require.NoError(t, err)
+	deps := loaded.Dependencies()
+	require.Len(t, deps, 1)
+	assert.Equal(t, valueobjects.JourneyDependencyFinishToStart, deps[kept.Value()].Value())
+}
+
+func TestPlanCapabilityJourney_SeededFromTemplate_AddsMilestonesAndRecordsOrigin(t *testing.T) {
+	period := newTargetPeriodVO(t, 2027, 1)
+	seeded := plannedMilestoneFacts(t, "ms-1", "Vendor selection")
+	seeded.TargetPeriod = &period
+
+	j, err := PlanCapabilityJourney(CapabilityJourneyFacts{
+		ID:           valueobjects.NewCapabilityJourneyID(),
+		CapabilityID: newCapabilityRef(t),
+		Kind:         newJourneyKind(t, valueobjects.JourneyKindMigration),
+		FromApps:     newComponentRefs(t, 1),
+		ToApp:        newComponentRef(t),
+		PlannedBy:    journeyActor,
+		Template:     &JourneyTemplateOrigin{ID: "template-1", Version: 2},
+		Milestones:   []MilestoneFacts{seeded},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, &JourneyTemplateOrigin{ID: "template-1", Version: 2}, j.Template())
+	require.Len(t, j.Milestones(), 1)
+	assert.Equal(t, "Vendor selection", j.Milestones()[0].Label())
+	changes := j.GetUncommittedChanges()
+	require.Len(t, changes, 2)
+	planned, ok := changes[0].(events.JourneyPlanned)
+	require.True(t, ok)
+	assert.Equal(t, "template-1", planned.TemplateID)
+	assert.Equal(t, 2, planned.TemplateVersion)
+	added, ok := changes[1].(events.JourneyMilestoneAdded)
+	require.True(t, ok)
+	assert.Equal(t, "ms-1", added.MilestoneID)
+	assert.Equal(t, &events.TargetPeriodData{Year: 2027, Quarter: 1}, added.TargetPeriod)
+
+	loaded, err := LoadCapabilityJourneyFromHistory(changes)
+	require.NoError(t, err)
+	assert.Equal(t, j.Template(), loaded.Template())
+	assert.Len(t, loaded.Milestones(), 1)
+}
+
+func TestPlanCapabilityJourney_InvalidSeededMilestone_RaisesNothing(t *testing.T) {
+	seeded := plannedMilestoneFacts(t, "ms-1", "   ")
+
+	j, err := PlanCapabilityJourney(CapabilityJourneyFacts{
+		ID:           valueobjects.NewCapabilityJourneyID(),
+		CapabilityID: newCapabilityRef(t),
+		Kind:         newJourneyKind(t, valueobjects.JourneyKindMigration),
+		FromApps:     newComponentRefs(t, 1),
+		ToApp:        newComponentRef(t),
+		PlannedBy:    journeyActor,
+		Milestones:   []MilestoneFacts{seeded},
+	})
+
+	assert.Nil(t, j)
+	assert.Error(t, err)
+}
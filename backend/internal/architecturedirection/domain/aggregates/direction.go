@@ -3,6 +3,7 @@ package aggregates
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"easi/backend/internal/architecturedirection/domain/events"
 	"easi/backend/internal/architecturedirection/domain/valueobjects"
@@ -18,6 +19,9 @@ var (
 	ErrDirectionAgreedImmutable       = errors.New("agreed directions are immutable; reject and replace to change")
 	ErrDirectionSourceSetFrozen       = errors.New("source set modifications are only allowed on draft directions")
 	ErrSourceCapabilityNotInDirection = errors.New("capability is not a source of this direction")
+	ErrDirectionNotUnderReview        = errors.New("reviews can only be recorded while a direction is proposed")
+	ErrNotARequiredReviewer           = errors.New("only a required reviewer of the proposal can review it")
+	ErrReviewQuorumNotMet             = errors.New("review quorum has not approved the proposal or an objection is outstanding")
 )
 
 type Direction struct {
@@ -29,6 +33,8 @@ type Direction struct {
 	horizon                valueobjects.Horizon
 	status                 valueobjects.DirectionStatus
 	narrative              sharedvo.Description
+	reviewPanel            valueobjects.ReviewPanel
+	reviews                map[string]valueobjects.ReviewVerdict
 }
 
 type DraftParams struct {
@@ -78,30 +84,82 @@ func LoadDirectionFromHistory(eventHistory []domain.DomainEvent) (*Direction, er
 	return aggregate, nil
 }
 
-func (d *Direction) Propose() error {
+// Propose submits the direction for review by the given panel. It can only be
+// agreed once the panel's quorum has approved it. A direction proposed before
+// reviews were introduced has no panel and is proposed again to name one.
+func (d *Direction) Propose(panel valueobjects.ReviewPanel) error {
+	if err := d.requireProposable(); err != nil {
+		return err
+	}
+	if panel.IsEmpty() {
+		return valueobjects.ErrReviewersRequired
+	}
+	d.raise(events.NewDirectionProposed(d.ID(), panel.Reviewers(), panel.Quorum()))
+	return nil
+}
+
+func (d *Direction) requireProposable() error {
 	proposed, _ := valueobjects.NewDirectionStatus(valueobjects.DirectionStatusProposed)
-	if err := d.requireTransition(proposed); err != nil {
+	awaitingPanel := d.status.IsProposed() && d.reviewPanel.IsEmpty()
+	if err := d.requireTransition(proposed); err != nil && !awaitingPanel {
 		return err
 	}
 	if d.narrative.IsEmpty() {
 		return ErrNarrativeRequiredToPropose
 	}
-	if err := validateSourceCardinality(d.directionType, d.sourceCapabilityIDs); err != nil {
-		return err
+	return validateSourceCardinality(d.directionType, d.sourceCapabilityIDs)
+}
+
+// RecordReview records a reviewer's verdict on the proposal. A reviewer may
+// change their verdict until the direction is agreed or rejected.
+func (d *Direction) RecordReview(reviewerID string, verdict valueobjects.ReviewVerdict, comment string) error {
+	if !d.status.IsProposed() {
+		return ErrDirectionNotUnderReview
 	}
-	d.raise(events.NewDirectionProposed(d.ID()))
+	if !d.reviewPanel.Includes(reviewerID) {
+		return ErrNotARequiredReviewer
+	}
+	d.raise(events.NewDirectionReviewRecorded(d.ID(), reviewerID, verdict.Value(), strings.TrimSpace(comment)))
 	return nil
 }
 
-func (d *Direction) Agree() error {
+// Agree accepts the proposal once its review quorum is met and records the
+// decision. Empty context and decision sections default to the narrative and
+// a summary of the direction.
+func (d *Direction) Agree(record valueobjects.DecisionRecord, agreedBy string) error {
 	agreed, _ := valueobjects.NewDirectionStatus(valueobjects.DirectionStatusAgreed)
 	if err := d.requireTransition(agreed); err != nil {
 		return err
 	}
-	d.raise(events.NewDirectionAgreed(d.ID()))
+	if !d.QuorumMet() {
+		return ErrReviewQuorumNotMet
+	}
+	record = record.WithDefaults(d.narrative.Value(),
+		fmt.Sprintf("Adopt the %s direction on the %s horizon.", d.directionType.Value(), d.horizon.Value()))
+	d.raise(events.NewDirectionAgreed(d.ID(), events.DecisionRecordData{
+		Context:      record.Context(),
+		Decision:     record.Decision(),
+		Consequences: record.Consequences(),
+	}, agreedBy))
 	return nil
 }
 
+// QuorumMet reports whether enough reviewers approved and none still objects.
+// A direction without a panel has nobody to approve it and never meets quorum.
+func (d *Direction) QuorumMet() bool {
+	if d.reviewPanel.IsEmpty() {
+		return false
+	}
+	approvals := 0
+	for _, verdict := range d.reviews {
+		if verdict.IsObjection() {
+			return false
+		}
+		approvals++
+	}
+	return approvals >= d.reviewPanel.Quorum()
+}
+
 func (d *Direction) Reject() error {
 	if !d.status.CanReject() {
 		return ErrInvalidStatusTransition
@@ -155,6 +213,27 @@ func (d *Direction) RemoveSourceCapability(ref valueobjects.PhysicalCapabilityRe
 	return nil
 }
 
+// RehomeSourceCapability follows a source capability that was merged or split onto
+// the capability that takes over from it. Unlike editing the sources it applies in
+// every status, since the direction itself is unchanged; a direction that already
+// lists the replacement simply drops the old source.
+func (d *Direction) RehomeSourceCapability(from, to valueobjects.PhysicalCapabilityRef, actor string) error {
+	if !d.hasSource(from) {
+		return nil
+	}
+	updated := make([]string, 0, len(d.sourceCapabilityIDs))
+	for _, existing := range d.sourceCapabilityIDs {
+		switch {
+		case existing.Value() != from.Value():
+			updated = append(updated, existing.Value())
+		case !d.hasSource(to):
+			updated = append(updated, to.Value())
+		}
+	}
+	d.raise(events.NewDirectionSourceCapabilitiesChanged(d.ID(), updated, actor))
+	return nil
+}
+
 func (d *Direction) hasSource(ref valueobjects.PhysicalCapabilityRef) bool {
 	for _, existing := range d.sourceCapabilityIDs {
 		if existing.Value() == ref.Value() {
@@ -171,6 +250,11 @@ func (d *Direction) Type() valueobjects.DirectionType     { return d.directionTy
 func (d *Direction) Status() valueobjects.DirectionStatus { return d.status }
 func (d *Direction) Horizon() valueobjects.Horizon        { return d.horizon }
 func (d *Direction) Narrative() sharedvo.Description      { return d.narrative }
+func (d *Direction) Review(reviewerID string) (valueobjects.ReviewVerdict, bool) {
+	verdict, ok := d.reviews[reviewerID]
+	return verdict, ok
+}
+func (d *Direction) ReviewPanel() valueobjects.ReviewPanel { return d.reviewPanel }
 func (d *Direction) SourceCapabilityIDs() []valueobjects.PhysicalCapabilityRef {
 	out := make([]valueobjects.PhysicalCapabilityRef, len(d.sourceCapabilityIDs))
 	copy(out, d.sourceCapabilityIDs)
@@ -209,12 +293,31 @@ func (d *Direction) apply(event domain.DomainEvent) error {
 	if drafted, ok := event.(events.DirectionDrafted); ok {
 		return d.applyDrafted(drafted)
 	}
+	if proposed, ok := event.(events.DirectionProposed); ok {
+		if err := d.applyReviewPanel(proposed); err != nil {
+			return err
+		}
+	}
 	if ok, err := d.applyStatusTransition(event); ok {
 		return err
 	}
 	return d.applyFieldUpdate(event)
 }
 
+func (d *Direction) applyReviewPanel(evt events.DirectionProposed) error {
+	d.reviews = map[string]valueobjects.ReviewVerdict{}
+	if len(evt.ReviewerIDs) == 0 {
+		d.reviewPanel = valueobjects.ReviewPanel{}
+		return nil
+	}
+	panel, err := valueobjects.NewReviewPanel(evt.ReviewerIDs, evt.Quorum)
+	if err != nil {
+		return fmt.Errorf("%w: review panel: %v", domain.ErrCorruptedEvent, err)
+	}
+	d.reviewPanel = panel
+	return nil
+}
+
 func (d *Direction) applyStatusTransition(event domain.DomainEvent) (bool, error) {
 	var target string
 	switch event.(type) {
@@ -245,7 +348,21 @@ func (d *Direction) applyFieldUpdate(event domain.DomainEvent) error {
 		return d.applySourceCapabilitiesChanged(evt)
 	case events.DirectionPlacementsChanged:
 		return d.applyPlacementsChanged(evt)
+	case events.DirectionReviewRecorded:
+		return d.applyReviewRecorded(evt)
+	}
+	return nil
+}
+
+func (d *Direction) applyReviewRecorded(evt events.DirectionReviewRecorded) error {
+	verdict, err := valueobjects.NewReviewVerdict(evt.Verdict)
+	if err != nil {
+		return fmt.Errorf("%w: review verdict %q: %v", domain.ErrCorruptedEvent, evt.Verdict, err)
+	}
+	if d.reviews == nil {
+		d.reviews = map[string]valueobjects.ReviewVerdict{}
 	}
+	d.reviews[evt.ReviewerID] = verdict
 	return nil
 }
 
@@ -132,15 +132,30 @@ func draftConsolidate(t *testing.T) *Direction {
 func agreedConsolidate(t *testing.T) *Direction {
 	t.Helper()
 	d := draftConsolidate(t)
-	require.NoError(t, d.Propose())
-	require.NoError(t, d.Agree())
+	require.NoError(t, d.Propose(newPanel(t, "reviewer-1")))
+	require.NoError(t, d.RecordReview("reviewer-1", newVerdict(t, "approve"), ""))
+	require.NoError(t, d.Agree(valueobjects.DecisionRecord{}, ""))
 	d.MarkChangesAsCommitted()
 	return d
 }
 
+func newPanel(t *testing.T, reviewers ...string) valueobjects.ReviewPanel {
+	t.Helper()
+	panel, err := valueobjects.NewReviewPanel(reviewers, 0)
+	require.NoError(t, err)
+	return panel
+}
+
+func newVerdict(t *testing.T, v string) valueobjects.ReviewVerdict {
+	t.Helper()
+	verdict, err := valueobjects.NewReviewVerdict(v)
+	require.NoError(t, err)
+	return verdict
+}
+
 func TestPropose_FromDraft_WithNarrative_Succeeds(t *testing.T) {
 	d := draftConsolidate(t)
-	err := d.Propose()
+	err := d.Propose(newPanel(t, "reviewer-1"))
 	require.NoError(t, err)
 	assert.True(t, d.Status().IsProposed())
 	uncommitted := d.GetUncommittedChanges()
@@ -153,7 +168,7 @@ func TestPropose_WithoutNarrative_Fails(t *testing.T) {
 	require.NoError(t, err)
 	d.MarkChangesAsCommitted()
 
-	err = d.Propose()
+	err = d.Propose(newPanel(t, "reviewer-1"))
 	assert.ErrorIs(t, err, ErrNarrativeRequiredToPropose)
 }
 
@@ -177,7 +192,7 @@ func TestPropose_EnforcesTypeSourceCardinality(t *testing.T) {
 			require.NoError(t, err, "draft capture does not enforce cardinality (R8)")
 			d.MarkChangesAsCommitted()
 
-			err = d.Propose()
+			err = d.Propose(newPanel(t, "reviewer-1"))
 			if tc.wantErr != nil {
 				assert.ErrorIs(t, err, tc.wantErr)
 				return
@@ -190,29 +205,103 @@ func TestPropose_EnforcesTypeSourceCardinality(t *testing.T) {
 
 func TestPropose_FromAgreed_Fails(t *testing.T) {
 	d := agreedConsolidate(t)
-	err := d.Propose()
+	err := d.Propose(newPanel(t, "reviewer-1"))
 	assert.ErrorIs(t, err, ErrInvalidStatusTransition)
 }
 
 func TestAgree_FromProposed_Succeeds(t *testing.T) {
 	d := draftConsolidate(t)
-	require.NoError(t, d.Propose())
+	require.NoError(t, d.Propose(newPanel(t, "reviewer-1")))
+	require.NoError(t, d.RecordReview("reviewer-1", newVerdict(t, "approve"), "Looks right."))
 	d.MarkChangesAsCommitted()
 
-	err := d.Agree()
+	err := d.Agree(valueobjects.DecisionRecord{}, "architect@example.com")
 	require.NoError(t, err)
 	assert.True(t, d.Status().IsAgreed())
 	uncommitted := d.GetUncommittedChanges()
 	assert.Len(t, uncommitted, 1)
-	assert.Equal(t, "DirectionAgreed", uncommitted[0].EventType())
+	agreed, ok := uncommitted[0].(events.DirectionAgreed)
+	require.True(t, ok)
+	require.NotNil(t, agreed.DecisionRecord)
+	assert.Equal(t, "We consolidate.", agreed.DecisionRecord.Context, "context defaults to the narrative")
+	assert.Equal(t, "Adopt the consolidate direction on the next horizon.", agreed.DecisionRecord.Decision)
+	assert.Equal(t, "architect@example.com", agreed.AgreedBy)
 }
 
 func TestAgree_FromDraft_Fails(t *testing.T) {
 	d := draftConsolidate(t)
-	err := d.Agree()
+	err := d.Agree(valueobjects.DecisionRecord{}, "")
 	assert.ErrorIs(t, err, ErrInvalidStatusTransition)
 }
 
+func TestPropose_WithoutReviewers_Fails(t *testing.T) {
+	d := draftConsolidate(t)
+	err := d.Propose(valueobjects.ReviewPanel{})
+	assert.ErrorIs(t, err, valueobjects.ErrReviewersRequired)
+}
+
+func TestPropose_AlreadyUnderReview_Fails(t *testing.T) {
+	d := draftConsolidate(t)
+	panel, err := valueobjects.NewReviewPanel([]string{"r1"}, 1)
+	require.NoError(t, err)
+	require.NoError(t, d.Propose(panel))
+
+	assert.ErrorIs(t, d.Propose(panel), ErrInvalidStatusTransition)
+}
+
+func TestAgree_RequiresQuorumOfApprovals(t *testing.T) {
+	d := draftConsolidate(t)
+	panel, err := valueobjects.NewReviewPanel([]string{"r1", "r2", "r3"}, 2)
+	require.NoError(t, err)
+	require.NoError(t, d.Propose(panel))
+
+	require.NoError(t, d.RecordReview("r1", newVerdict(t, "approve"), ""))
+	assert.ErrorIs(t, d.Agree(valueobjects.DecisionRecord{}, ""), ErrReviewQuorumNotMet)
+
+	require.NoError(t, d.RecordReview("r2", newVerdict(t, "approve"), ""))
+	record, err := valueobjects.NewDecisionRecord("Two ledgers.", "Merge into one.", "Migrate history.")
+	require.NoError(t, err)
+	require.NoError(t, d.Agree(record, "architect@example.com"))
+	assert.True(t, d.Status().IsAgreed())
+}
+
+func TestAgree_BlockedWhileAnObjectionStands(t *testing.T) {
+	d := draftConsolidate(t)
+	require.NoError(t, d.Propose(newPanel(t, "r1", "r2")))
+	require.NoError(t, d.RecordReview("r1", newVerdict(t, "approve"), ""))
+	require.NoError(t, d.RecordReview("r2", newVerdict(t, "object"), "Billing is out of scope."))
+	assert.ErrorIs(t, d.Agree(valueobjects.DecisionRecord{}, ""), ErrReviewQuorumNotMet)
+
+	require.NoError(t, d.RecordReview("r2", newVerdict(t, "approve"), "Scope clarified."))
+	require.NoError(t, d.Agree(valueobjects.DecisionRecord{}, ""))
+}
+
+func TestRecordReview_OnlyRequiredReviewersWhileProposed(t *testing.T) {
+	d := draftConsolidate(t)
+	assert.ErrorIs(t, d.RecordReview("r1", newVerdict(t, "approve"), ""), ErrDirectionNotUnderReview)
+
+	require.NoError(t, d.Propose(newPanel(t, "r1")))
+	assert.ErrorIs(t, d.RecordReview("someone-else", newVerdict(t, "approve"), ""), ErrNotARequiredReviewer)
+}
+
+func TestAgree_LegacyProposalWithoutPanel_MustBeProposedAgainWithReviewers(t *testing.T) {
+	fresh, err := draftWith(t, draftOpts{sourceCount: 2, narrative: "Legacy."})
+	require.NoError(t, err)
+	hist := append(fresh.GetUncommittedChanges(), events.NewDirectionProposed(fresh.ID(), nil, 0))
+
+	loaded, err := LoadDirectionFromHistory(hist)
+	require.NoError(t, err)
+	assert.True(t, loaded.ReviewPanel().IsEmpty())
+	assert.False(t, loaded.QuorumMet())
+	assert.ErrorIs(t, loaded.Agree(valueobjects.DecisionRecord{}, ""), ErrReviewQuorumNotMet)
+
+	panel, err := valueobjects.NewReviewPanel([]string{"r1"}, 1)
+	require.NoError(t, err)
+	require.NoError(t, loaded.Propose(panel))
+	require.NoError(t, loaded.RecordReview("r1", newVerdict(t, "approve"), ""))
+	require.NoError(t, loaded.Agree(valueobjects.DecisionRecord{}, ""))
+}
+
 func TestReject_FromDraft_Succeeds(t *testing.T) {
 	d := draftConsolidate(t)
 	err := d.Reject()
@@ -223,7 +312,7 @@ func TestReject_FromDraft_Succeeds(t *testing.T) {
 
 func TestReject_FromProposed_Succeeds(t *testing.T) {
 	d := draftConsolidate(t)
-	require.NoError(t, d.Propose())
+	require.NoError(t, d.Propose(newPanel(t, "reviewer-1")))
 	d.MarkChangesAsCommitted()
 
 	err := d.Reject()
@@ -304,7 +393,7 @@ func TestAddSourceCapability_OnAgreed_Fails(t *testing.T) {
 
 func TestAddSourceCapability_OnProposed_Fails(t *testing.T) {
 	d := draftConsolidate(t)
-	require.NoError(t, d.Propose())
+	require.NoError(t, d.Propose(newPanel(t, "reviewer-1")))
 	d.MarkChangesAsCommitted()
 	err := d.AddSourceCapability(newPhysicalRef(t), "architect@dfds.com")
 	assert.ErrorIs(t, err, ErrDirectionSourceSetFrozen)
@@ -350,20 +439,52 @@ func TestRemoveSourceCapability_OnAgreed_Fails(t *testing.T) {
 
 func TestRemoveSourceCapability_OnProposed_Fails(t *testing.T) {
 	d := draftConsolidate(t)
-	require.NoError(t, d.Propose())
+	require.NoError(t, d.Propose(newPanel(t, "reviewer-1")))
 	d.MarkChangesAsCommitted()
 	err := d.RemoveSourceCapability(d.SourceCapabilityIDs()[0], "architect@dfds.com")
 	assert.ErrorIs(t, err, ErrDirectionSourceSetFrozen)
 }
 
+func TestRehomeSourceCapability_OnAgreed_ReplacesSource(t *testing.T) {
+	d := agreedConsolidate(t)
+	merged := d.SourceCapabilityIDs()[0]
+	survivor := newPhysicalRef(t)
+
+	err := d.RehomeSourceCapability(merged, survivor, "architect@dfds.com")
+
+	require.NoError(t, err)
+	sources := refsToStrings(d.SourceCapabilityIDs())
+	assert.Equal(t, survivor.Value(), sources[0], "the replacement takes the merged source's place")
+	assert.NotContains(t, sources, merged.Value())
+	assert.Len(t, sources, 2)
+}
+
+func TestRehomeSourceCapability_OntoExistingSource_DropsOldSource(t *testing.T) {
+	d := agreedConsolidate(t)
+	sources := d.SourceCapabilityIDs()
+
+	require.NoError(t, d.RehomeSourceCapability(sources[0], sources[1], "architect@dfds.com"))
+
+	assert.Equal(t, []string{sources[1].Value()}, refsToStrings(d.SourceCapabilityIDs()))
+}
+
+func TestRehomeSourceCapability_NotASource_IsNoOp(t *testing.T) {
+	d := agreedConsolidate(t)
+
+	require.NoError(t, d.RehomeSourceCapability(newPhysicalRef(t), newPhysicalRef(t), "architect@dfds.com"))
+
+	assert.Empty(t, d.GetUncommittedChanges())
+}
+
 func TestLoadFromHistory_ReconstructsStatus(t *testing.T) {
 	fresh, err := draftWith(t, draftOpts{sourceCount: 2, narrative: "Some narrative."})
 	require.NoError(t, err)
-	require.NoError(t, fresh.Propose())
-	require.NoError(t, fresh.Agree())
+	require.NoError(t, fresh.Propose(newPanel(t, "reviewer-1")))
+	require.NoError(t, fresh.RecordReview("reviewer-1", newVerdict(t, "approve"), ""))
+	require.NoError(t, fresh.Agree(valueobjects.DecisionRecord{}, ""))
 
 	hist := fresh.GetUncommittedChanges()
-	require.Len(t, hist, 3)
+	require.Len(t, hist, 4)
 
 	loaded, err := LoadDirectionFromHistory(hist)
 	require.NoError(t, err)
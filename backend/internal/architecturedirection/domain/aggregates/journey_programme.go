@@ -0,0 +1,195 @@
+package aggregates
+
+import (
+	"errors"
+	"fmt"
+
+	"easi/backend/internal/architecturedirection/domain/events"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	domain "easi/backend/internal/shared/eventsourcing"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+var (
+	ErrJourneyProgrammeDeleted        = errors.New("journey programme has been deleted")
+	ErrJourneyAlreadyInProgramme      = errors.New("journey is already a member of this programme")
+	ErrJourneyNotInProgramme          = errors.New("journey is not a member of this programme")
+	ErrCorruptedJourneyProgrammeEvent = errors.New("corrupted event store: cannot rehydrate journey programme")
+	ErrUnknownJourneyProgrammeEvent   = errors.New("unknown event type for journey programme aggregate")
+)
+
+// JourneyProgramme groups capability journeys that are delivered together.
+// A journey belongs to at most one programme; that cross-aggregate rule is
+// enforced by the command handler and the read model, not here.
+type JourneyProgramme struct {
+	domain.AggregateRoot
+	name        valueobjects.ProgrammeName
+	description sharedvo.Description
+	journeyIDs  []string
+	deleted     bool
+}
+
+type JourneyProgrammeFacts struct {
+	ID          valueobjects.JourneyProgrammeID
+	Name        valueobjects.ProgrammeName
+	Description sharedvo.Description
+	CreatedBy   string
+}
+
+func NewJourneyProgramme(facts JourneyProgrammeFacts) *JourneyProgramme {
+	aggregate := &JourneyProgramme{
+		AggregateRoot: domain.NewAggregateRootWithID(facts.ID.Value()),
+	}
+	aggregate.raise(events.NewJourneyProgrammeCreated(events.JourneyProgrammeCreatedFields{
+		ID:          facts.ID.Value(),
+		Name:        facts.Name.Value(),
+		Description: facts.Description.Value(),
+		CreatedBy:   facts.CreatedBy,
+	}))
+	return aggregate
+}
+
+func LoadJourneyProgrammeFromHistory(eventHistory []domain.DomainEvent) (*JourneyProgramme, error) {
+	aggregate := &JourneyProgramme{
+		AggregateRoot: domain.NewAggregateRoot(),
+	}
+	var applyErr error
+	aggregate.LoadFromHistory(eventHistory, func(event domain.DomainEvent) {
+		if applyErr != nil {
+			return
+		}
+		applyErr = aggregate.apply(event)
+	})
+	if applyErr != nil {
+		return nil, applyErr
+	}
+	return aggregate, nil
+}
+
+func (p *JourneyProgramme) Update(name valueobjects.ProgrammeName, description sharedvo.Description, actor string) error {
+	if p.deleted {
+		return ErrJourneyProgrammeDeleted
+	}
+	p.raise(events.NewJourneyProgrammeUpdated(events.JourneyProgrammeUpdatedFields{
+		ID:          p.ID(),
+		Name:        name.Value(),
+		Description: description.Value(),
+		UpdatedBy:   actor,
+	}))
+	return nil
+}
+
+func (p *JourneyProgramme) AddJourney(journeyID valueobjects.CapabilityJourneyID, actor string) error {
+	if p.deleted {
+		return ErrJourneyProgrammeDeleted
+	}
+	if p.HasJourney(journeyID.Value()) {
+		return ErrJourneyAlreadyInProgramme
+	}
+	p.raise(events.NewJourneyProgrammeJourneyAdded(events.JourneyProgrammeJourneyAddedFields{
+		ID:        p.ID(),
+		JourneyID: journeyID.Value(),
+		AddedBy:   actor,
+	}))
+	return nil
+}
+
+func (p *JourneyProgramme) RemoveJourney(journeyID, actor string) error {
+	if p.deleted {
+		return ErrJourneyProgrammeDeleted
+	}
+	if !p.HasJourney(journeyID) {
+		return ErrJourneyNotInProgramme
+	}
+	p.raise(events.NewJourneyProgrammeJourneyRemoved(events.JourneyProgrammeJourneyRemovedFields{
+		ID:        p.ID(),
+		JourneyID: journeyID,
+		RemovedBy: actor,
+	}))
+	return nil
+}
+
+// Delete dissolves the programme. Its journeys are untouched and become
+// available for other programmes.
+func (p *JourneyProgramme) Delete(actor string) error {
+	if p.deleted {
+		return ErrJourneyProgrammeDeleted
+	}
+	p.raise(events.NewJourneyProgrammeDeleted(events.JourneyProgrammeDeletedFields{
+		ID:        p.ID(),
+		DeletedBy: actor,
+	}))
+	return nil
+}
+
+func (p *JourneyProgramme) Name() valueobjects.ProgrammeName  { return p.name }
+func (p *JourneyProgramme) Description() sharedvo.Description { return p.description }
+func (p *JourneyProgramme) IsDeleted() bool                   { return p.deleted }
+
+func (p *JourneyProgramme) JourneyIDs() []string {
+	out := make([]string, len(p.journeyIDs))
+	copy(out, p.journeyIDs)
+	return out
+}
+
+func (p *JourneyProgramme) HasJourney(journeyID string) bool {
+	for _, id := range p.journeyIDs {
+		if id == journeyID {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *JourneyProgramme) raise(event domain.DomainEvent) {
+	if err := p.apply(event); err != nil {
+		panic(fmt.Sprintf("architecturedirection: in-process apply failed: %v", err))
+	}
+	p.RaiseEvent(event)
+}
+
+func (p *JourneyProgramme) apply(event domain.DomainEvent) error {
+	switch evt := event.(type) {
+	case events.JourneyProgrammeCreated:
+		p.AggregateRoot = domain.NewAggregateRootWithID(evt.ID)
+		p.journeyIDs = []string{}
+		return p.applyNaming(evt.Name, evt.Description)
+	case events.JourneyProgrammeUpdated:
+		return p.applyNaming(evt.Name, evt.Description)
+	case events.JourneyProgrammeJourneyAdded:
+		p.journeyIDs = append(p.journeyIDs, evt.JourneyID)
+		return nil
+	case events.JourneyProgrammeJourneyRemoved:
+		p.applyJourneyRemoved(evt.JourneyID)
+		return nil
+	case events.JourneyProgrammeDeleted:
+		p.deleted = true
+		return nil
+	default:
+		return fmt.Errorf("%w: %T", ErrUnknownJourneyProgrammeEvent, event)
+	}
+}
+
+func (p *JourneyProgramme) applyNaming(rawName, rawDescription string) error {
+	name, err := valueobjects.NewProgrammeName(rawName)
+	if err != nil {
+		return fmt.Errorf("%w: name %q: %v", ErrCorruptedJourneyProgrammeEvent, rawName, err)
+	}
+	description, err := sharedvo.NewDescription(rawDescription)
+	if err != nil {
+		return fmt.Errorf("%w: description: %v", ErrCorruptedJourneyProgrammeEvent, err)
+	}
+	p.name = name
+	p.description = description
+	return nil
+}
+
+func (p *JourneyProgramme) applyJourneyRemoved(journeyID string) {
+	remaining := make([]string, 0, len(p.journeyIDs))
+	for _, id := range p.journeyIDs {
+		if id != journeyID {
+			remaining = append(remaining, id)
+		}
+	}
+	p.journeyIDs = remaining
+}
@@ -0,0 +1,81 @@
+package aggregates
+
+import (
+	"testing"
+
+	"easi/backend/internal/architecturedirection/domain/events"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newProgrammeName(t *testing.T, v string) valueobjects.ProgrammeName {
+	t.Helper()
+	n, err := valueobjects.NewProgrammeName(v)
+	require.NoError(t, err)
+	return n
+}
+
+func newProgramme(t *testing.T) *JourneyProgramme {
+	t.Helper()
+	return NewJourneyProgramme(JourneyProgrammeFacts{
+		ID:          valueobjects.NewJourneyProgrammeID(),
+		Name:        newProgrammeName(t, "ERP consolidation"),
+		Description: newRationale(t, "Retire the regional ERPs"),
+		CreatedBy:   journeyActor,
+	})
+}
+
+func TestNewJourneyProgramme_RaisesCreated(t *testing.T) {
+	p := newProgramme(t)
+
+	assert.Equal(t, "ERP consolidation", p.Name().Value())
+	assert.Empty(t, p.JourneyIDs())
+	evt, ok := p.GetUncommittedChanges()[0].(events.JourneyProgrammeCreated)
+	require.True(t, ok)
+	assert.Equal(t, p.ID(), evt.ID)
+	assert.Equal(t, journeyActor, evt.CreatedBy)
+}
+
+func TestJourneyProgramme_AddAndRemoveJourney(t *testing.T) {
+	p := newProgramme(t)
+	journeyID := valueobjects.NewCapabilityJourneyID()
+
+	require.NoError(t, p.AddJourney(journeyID, journeyActor))
+	assert.ErrorIs(t, p.AddJourney(journeyID, journeyActor), ErrJourneyAlreadyInProgramme)
+	assert.Equal(t, []string{journeyID.Value()}, p.JourneyIDs())
+
+	require.NoError(t, p.RemoveJourney(journeyID.Value(), journeyActor))
+	assert.ErrorIs(t, p.RemoveJourney(journeyID.Value(), journeyActor), ErrJourneyNotInProgramme)
+	assert.Empty(t, p.JourneyIDs())
+}
+
+func TestJourneyProgramme_DeletedRejectsFurtherChanges(t *testing.T) {
+	p := newProgramme(t)
+	require.NoError(t, p.Delete(journeyActor))
+
+	assert.True(t, p.IsDeleted())
+	assert.ErrorIs(t, p.Delete(journeyActor), ErrJourneyProgrammeDeleted)
+	assert.ErrorIs(t, p.Update(newProgrammeName(t, "Renamed"), newRationale(t, ""), journeyActor), ErrJourneyProgrammeDeleted)
+	assert.ErrorIs(t, p.AddJourney(valueobjects.NewCapabilityJourneyID(), journeyActor), ErrJourneyProgrammeDeleted)
+}
+
+func TestLoadJourneyProgrammeFromHistory_ReconstructsState(t *testing.T) {
+	p := newProgramme(t)
+	kept := valueobjects.NewCapabilityJourneyID()
+	dropped := valueobjects.NewCapabilityJourneyID()
+	require.NoError(t, p.Update(newProgrammeName(t, "Finance platform"), newRationale(t, "One ledger"), journeyActor))
+	require.NoError(t, p.AddJourney(kept, journeyActor))
+	require.NoError(t, p.AddJourney(dropped, journeyActor))
+	require.NoError(t, p.RemoveJourney(dropped.Value(), journeyActor))
+
+	loaded, err := LoadJourneyProgrammeFromHistory(p.GetUncommittedChanges())
+
+	require.NoError(t, err)
+	assert.Equal(t, p.ID(), loaded.ID())
+	assert.Equal(t, "Finance platform", loaded.Name().Value())
+	assert.Equal(t, "One ledger", loaded.Description().Value())
+	assert.Equal(t, []string{kept.Value()}, loaded.JourneyIDs())
+	assert.Empty(t, loaded.GetUncommittedChanges())
+}
@@ -0,0 +1,209 @@
+package aggregates
+
+import (
+	"errors"
+	"fmt"
+
+	"easi/backend/internal/architecturedirection/domain/events"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const MaxJourneyTemplateMilestones = 20
+
+var (
+	ErrJourneyTemplateHasNoMilestones   = errors.New("journey template needs at least one milestone")
+	ErrJourneyTemplateTooManyMilestones = errors.New("journey template exceeds the maximum of 20 milestones")
+	ErrJourneyTemplateRetired           = errors.New("journey template has been retired")
+	ErrJourneyTemplateKindMismatch      = errors.New("journey template is for a different journey kind")
+	ErrCorruptedJourneyTemplateEvent    = errors.New("corrupted event store: cannot rehydrate journey template")
+	ErrUnknownJourneyTemplateEvent      = errors.New("unknown event type for journey template aggregate")
+)
+
+// JourneyTemplate holds the default milestones for one kind of journey. Every
+// revision bumps the version; journeys copy the milestones when they are
+// planned, so revising or retiring a template never rewrites them.
+type JourneyTemplate struct {
+	domain.AggregateRoot
+	kind       valueobjects.JourneyKind
+	name       valueobjects.JourneyTemplateName
+	milestones []valueobjects.TemplateMilestone
+	version    int
+	retired    bool
+}
+
+type JourneyTemplateFacts struct {
+	ID         valueobjects.JourneyTemplateID
+	Kind       valueobjects.JourneyKind
+	Name       valueobjects.JourneyTemplateName
+	Milestones []valueobjects.TemplateMilestone
+	CreatedBy  string
+}
+
+// SeededMilestone is a template milestone resolved against the quarter a
+// journey is planned in.
+type SeededMilestone struct {
+	Label        string
+	TargetPeriod valueobjects.TargetPeriod
+}
+
+func NewJourneyTemplate(facts JourneyTemplateFacts) (*JourneyTemplate, error) {
+	if err := validateTemplateMilestones(facts.Milestones); err != nil {
+		return nil, err
+	}
+	aggregate := &JourneyTemplate{
+		AggregateRoot: domain.NewAggregateRootWithID(facts.ID.Value()),
+	}
+	aggregate.raise(events.NewJourneyTemplateCreated(events.JourneyTemplateCreatedFields{
+		ID:         facts.ID.Value(),
+		Kind:       facts.Kind.Value(),
+		Name:       facts.Name.Value(),
+		Milestones: templateMilestonesToData(facts.Milestones),
+		Version:    1,
+		CreatedBy:  facts.CreatedBy,
+	}))
+	return aggregate, nil
+}
+
+func LoadJourneyTemplateFromHistory(eventHistory []domain.DomainEvent) (*JourneyTemplate, error) {
+	aggregate := &JourneyTemplate{
+		AggregateRoot: domain.NewAggregateRoot(),
+	}
+	var applyErr error
+	aggregate.LoadFromHistory(eventHistory, func(event domain.DomainEvent) {
+		if applyErr != nil {
+			return
+		}
+		applyErr = aggregate.apply(event)
+	})
+	if applyErr != nil {
+		return nil, applyErr
+	}
+	return aggregate, nil
+}
+
+// Revise replaces the name and milestones with a new version of the template.
+func (t *JourneyTemplate) Revise(name valueobjects.JourneyTemplateName, milestones []valueobjects.TemplateMilestone, actor string) error {
+	if t.retired {
+		return ErrJourneyTemplateRetired
+	}
+	if err := validateTemplateMilestones(milestones); err != nil {
+		return err
+	}
+	t.raise(events.NewJourneyTemplateRevised(events.JourneyTemplateRevisedFields{
+		ID:         t.ID(),
+		Name:       name.Value(),
+		Milestones: templateMilestonesToData(milestones),
+		Version:    t.version + 1,
+		RevisedBy:  actor,
+	}))
+	return nil
+}
+
+// Retire stops the template from seeding new journeys. Journeys already seeded
+// from it keep their milestones.
+func (t *JourneyTemplate) Retire(actor string) error {
+	if t.retired {
+		return ErrJourneyTemplateRetired
+	}
+	t.raise(events.NewJourneyTemplateRetired(events.JourneyTemplateRetiredFields{
+		ID:        t.ID(),
+		RetiredBy: actor,
+	}))
+	return nil
+}
+
+// Seed resolves the current version's milestones for a journey of the given
+// kind planned in the given quarter.
+func (t *JourneyTemplate) Seed(kind valueobjects.JourneyKind, plannedIn valueobjects.TargetPeriod) ([]SeededMilestone, error) {
+	if t.retired {
+		return nil, ErrJourneyTemplateRetired
+	}
+	if !t.kind.Equals(kind) {
+		return nil, ErrJourneyTemplateKindMismatch
+	}
+	seeded := make([]SeededMilestone, len(t.milestones))
+	for i, m := range t.milestones {
+		target, err := m.TargetFrom(plannedIn)
+		if err != nil {
+			return nil, err
+		}
+		seeded[i] = SeededMilestone{Label: m.Label(), TargetPeriod: target}
+	}
+	return seeded, nil
+}
+
+func (t *JourneyTemplate) Kind() valueobjects.JourneyKind         { return t.kind }
+func (t *JourneyTemplate) Name() valueobjects.JourneyTemplateName { return t.name }
+func (t *JourneyTemplate) Version() int                           { return t.version }
+func (t *JourneyTemplate) IsRetired() bool                        { return t.retired }
+
+func (t *JourneyTemplate) Milestones() []valueobjects.TemplateMilestone {
+	out := make([]valueobjects.TemplateMilestone, len(t.milestones))
+	copy(out, t.milestones)
+	return out
+}
+
+func (t *JourneyTemplate) raise(event domain.DomainEvent) {
+	if err := t.apply(event); err != nil {
+		panic(fmt.Sprintf("architecturedirection: in-process apply failed: %v", err))
+	}
+	t.RaiseEvent(event)
+}
+
+func (t *JourneyTemplate) apply(event domain.DomainEvent) error {
+	switch evt := event.(type) {
+	case events.JourneyTemplateCreated:
+		kind, err := valueobjects.NewJourneyKind(evt.Kind)
+		if err != nil {
+			return fmt.Errorf("%w: kind %q: %v", ErrCorruptedJourneyTemplateEvent, evt.Kind, err)
+		}
+		t.AggregateRoot = domain.NewAggregateRootWithID(evt.ID)
+		t.kind = kind
+		return t.applyVersion(evt.Name, evt.Milestones, evt.Version)
+	case events.JourneyTemplateRevised:
+		return t.applyVersion(evt.Name, evt.Milestones, evt.Version)
+	case events.JourneyTemplateRetired:
+		t.retired = true
+		return nil
+	default:
+		return fmt.Errorf("%w: %T", ErrUnknownJourneyTemplateEvent, event)
+	}
+}
+
+func (t *JourneyTemplate) applyVersion(rawName string, rawMilestones []events.TemplateMilestoneData, version int) error {
+	name, err := valueobjects.NewJourneyTemplateName(rawName)
+	if err != nil {
+		return fmt.Errorf("%w: name %q: %v", ErrCorruptedJourneyTemplateEvent, rawName, err)
+	}
+	milestones := make([]valueobjects.TemplateMilestone, len(rawMilestones))
+	for i, raw := range rawMilestones {
+		m, err := valueobjects.NewTemplateMilestone(raw.Label, raw.OffsetQuarters)
+		if err != nil {
+			return fmt.Errorf("%w: milestone %q: %v", ErrCorruptedJourneyTemplateEvent, raw.Label, err)
+		}
+		milestones[i] = m
+	}
+	t.name = name
+	t.milestones = milestones
+	t.version = version
+	return nil
+}
+
+func validateTemplateMilestones(milestones []valueobjects.TemplateMilestone) error {
+	if len(milestones) == 0 {
+		return ErrJourneyTemplateHasNoMilestones
+	}
+	if len(milestones) > MaxJourneyTemplateMilestones {
+		return ErrJourneyTemplateTooManyMilestones
+	}
+	return nil
+}
+
+func templateMilestonesToData(milestones []valueobjects.TemplateMilestone) []events.TemplateMilestoneData {
+	out := make([]events.TemplateMilestoneData, len(milestones))
+	for i, m := range milestones {
+		out[i] = events.TemplateMilestoneData{Label: m.Label(), OffsetQuarters: m.OffsetQuarters()}
+	}
+	return out
+}
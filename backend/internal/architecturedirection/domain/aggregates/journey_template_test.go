@@ -0,0 +1,138 @@
+package aggregates
+
+import (
+	"testing"
+
+	"easi/backend/internal/architecturedirection/domain/events"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	domain "easi/backend/internal/shared/eventsourcing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTemplateName(t *testing.T, v string) valueobjects.JourneyTemplateName {
+	t.Helper()
+	n, err := valueobjects.NewJourneyTemplateName(v)
+	require.NoError(t, err)
+	return n
+}
+
+func templateMilestones(t *testing.T, labelsAndOffsets ...any) []valueobjects.TemplateMilestone {
+	t.Helper()
+	out := make([]valueobjects.TemplateMilestone, 0, len(labelsAndOffsets)/2)
+	for i := 0; i < len(labelsAndOffsets); i += 2 {
+		m, err := valueobjects.NewTemplateMilestone(labelsAndOffsets[i].(string), labelsAndOffsets[i+1].(int))
+		require.NoError(t, err)
+		out = append(out, m)
+	}
+	return out
+}
+
+func newMigrationTemplate(t *testing.T) *JourneyTemplate {
+	t.Helper()
+	tpl, err := NewJourneyTemplate(JourneyTemplateFacts{
+		ID:         valueobjects.NewJourneyTemplateID(),
+		Kind:       newJourneyKind(t, valueobjects.JourneyKindMigration),
+		Name:       newTemplateName(t, "SaaS migration"),
+		Milestones: templateMilestones(t, "Vendor selection", 1, "Data migration", 3, "Decommission", 5),
+		CreatedBy:  journeyActor,
+	})
+	require.NoError(t, err)
+	return tpl
+}
+
+func TestNewJourneyTemplate_StartsAtVersionOne(t *testing.T) {
+	tpl := newMigrationTemplate(t)
+
+	assert.Equal(t, 1, tpl.Version())
+	assert.Equal(t, valueobjects.JourneyKindMigration, tpl.Kind().Value())
+	assert.Len(t, tpl.Milestones(), 3)
+	evt, ok := tpl.GetUncommittedChanges()[0].(events.JourneyTemplateCreated)
+	require.True(t, ok)
+	assert.Equal(t, tpl.ID(), evt.ID)
+	assert.Equal(t, journeyActor, evt.CreatedBy)
+	assert.Equal(t, events.TemplateMilestoneData{Label: "Data migration", OffsetQuarters: 3}, evt.Milestones[1])
+}
+
+func TestNewJourneyTemplate_MilestoneCountBounds(t *testing.T) {
+	facts := JourneyTemplateFacts{
+		ID:   valueobjects.NewJourneyTemplateID(),
+		Kind: newJourneyKind(t, valueobjects.JourneyKindMigration),
+		Name: newTemplateName(t, "Empty"),
+	}
+	_, err := NewJourneyTemplate(facts)
+	assert.ErrorIs(t, err, ErrJourneyTemplateHasNoMilestones)
+
+	for i := 0; i <= MaxJourneyTemplateMilestones; i++ {
+		facts.Milestones = append(facts.Milestones, templateMilestones(t, "Step", 1)...)
+	}
+	_, err = NewJourneyTemplate(facts)
+	assert.ErrorIs(t, err, ErrJourneyTemplateTooManyMilestones)
+}
+
+func TestJourneyTemplate_Revise_BumpsVersion(t *testing.T) {
+	tpl := newMigrationTemplate(t)
+
+	require.NoError(t, tpl.Revise(newTemplateName(t, "SaaS migration v2"), templateMilestones(t, "Parallel run", 2), journeyActor))
+
+	assert.Equal(t, 2, tpl.Version())
+	assert.Equal(t, "SaaS migration v2", tpl.Name().Value())
+	require.Len(t, tpl.Milestones(), 1)
+	assert.Equal(t, "Parallel run", tpl.Milestones()[0].Label())
+}
+
+func TestJourneyTemplate_Seed_ResolvesOffsetsFromPlanningQuarter(t *testing.T) {
+	tpl := newMigrationTemplate(t)
+
+	seeded, err := tpl.Seed(newJourneyKind(t, valueobjects.JourneyKindMigration), newTargetPeriodVO(t, 2026, 4))
+	require.NoError(t, err)
+
+	require.Len(t, seeded, 3)
+	assert.Equal(t, "Vendor selection", seeded[0].Label)
+	assert.True(t, seeded[0].TargetPeriod.Equals(newTargetPeriodVO(t, 2027, 1)))
+	assert.True(t, seeded[2].TargetPeriod.Equals(newTargetPeriodVO(t, 2028, 1)))
+}
+
+func TestJourneyTemplate_Seed_RejectsOtherKind(t *testing.T) {
+	tpl := newMigrationTemplate(t)
+
+	_, err := tpl.Seed(newJourneyKind(t, valueobjects.JourneyKindCarveOut), newTargetPeriodVO(t, 2026, 4))
+	assert.ErrorIs(t, err, ErrJourneyTemplateKindMismatch)
+}
+
+func TestJourneyTemplate_RetiredRejectsFurtherUse(t *testing.T) {
+	tpl := newMigrationTemplate(t)
+	require.NoError(t, tpl.Retire(journeyActor))
+
+	assert.True(t, tpl.IsRetired())
+	assert.ErrorIs(t, tpl.Retire(journeyActor), ErrJourneyTemplateRetired)
+	assert.ErrorIs(t, tpl.Revise(newTemplateName(t, "Again"), templateMilestones(t, "Step", 1), journeyActor), ErrJourneyTemplateRetired)
+	_, err := tpl.Seed(newJourneyKind(t, valueobjects.JourneyKindMigration), newTargetPeriodVO(t, 2026, 4))
+	assert.ErrorIs(t, err, ErrJourneyTemplateRetired)
+}
+
+func TestLoadJourneyTemplateFromHistory_RestoresLatestVersion(t *testing.T) {
+	tpl := newMigrationTemplate(t)
+	require.NoError(t, tpl.Revise(newTemplateName(t, "SaaS migration v2"), templateMilestones(t, "Parallel run", 2), journeyActor))
+
+	loaded, err := LoadJourneyTemplateFromHistory(tpl.GetUncommittedChanges())
+	require.NoError(t, err)
+
+	assert.Equal(t, tpl.ID(), loaded.ID())
+	assert.Equal(t, 2, loaded.Version())
+	assert.Equal(t, "SaaS migration v2", loaded.Name().Value())
+}
+
+func TestLoadJourneyTemplateFromHistory_CorruptedMilestone(t *testing.T) {
+	history := []domain.DomainEvent{events.NewJourneyTemplateCreated(events.JourneyTemplateCreatedFields{
+		ID:         valueobjects.NewJourneyTemplateID().Value(),
+		Kind:       valueobjects.JourneyKindMigration,
+		Name:       "SaaS migration",
+		Milestones: []events.TemplateMilestoneData{{Label: "", OffsetQuarters: 1}},
+		Version:    1,
+	})}
+
+	_, err := LoadJourneyTemplateFromHistory(history)
+	assert.ErrorIs(t, err, ErrCorruptedJourneyTemplateEvent)
+}
@@ -0,0 +1,214 @@
+package aggregates
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"easi/backend/internal/architecturedirection/domain/events"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var (
+	ErrExceptionApproverRequired       = errors.New("an exception must name the person who approved it")
+	ErrExceptionExpiryInPast           = errors.New("an exception cannot expire in the past")
+	ErrStandardExceptionRevoked        = errors.New("standard exception has been revoked")
+	ErrStandardExceptionNotExpired     = errors.New("standard exception has not reached its expiry date")
+	ErrCorruptedStandardExceptionEvent = errors.New("corrupted event store: cannot rehydrate standard exception")
+	ErrUnknownStandardExceptionEvent   = errors.New("unknown event type for standard exception aggregate")
+)
+
+// StandardException is an approved, time-limited permission for a
+// non-standard application to keep realising a capability. Once its expiry
+// date has passed it no longer counts, and the expiry sweep records that with
+// an expired event. At most one unrevoked exception exists per (capability,
+// component) pair; that cross-aggregate rule is enforced by the command
+// handler.
+type StandardException struct {
+	domain.AggregateRoot
+	capabilityID  valueobjects.PhysicalCapabilityRef
+	componentID   valueobjects.ApplicationRef
+	justification valueobjects.ExceptionJustification
+	approverID    string
+	expiresOn     valueobjects.ExceptionExpiry
+	revoked       bool
+	expired       bool
+}
+
+type StandardExceptionFacts struct {
+	ID            valueobjects.StandardExceptionID
+	CapabilityID  valueobjects.PhysicalCapabilityRef
+	ComponentID   valueobjects.ApplicationRef
+	Justification valueobjects.ExceptionJustification
+	ApproverID    string
+	ExpiresOn     valueobjects.ExceptionExpiry
+	GrantedBy     string
+}
+
+func NewStandardException(facts StandardExceptionFacts) (*StandardException, error) {
+	approverID, err := validateExceptionApproval(facts.ApproverID, facts.ExpiresOn)
+	if err != nil {
+		return nil, err
+	}
+	aggregate := &StandardException{
+		AggregateRoot: domain.NewAggregateRootWithID(facts.ID.Value()),
+	}
+	aggregate.raise(events.NewStandardExceptionGranted(events.StandardExceptionGrantedFields{
+		ID:            facts.ID.Value(),
+		CapabilityID:  facts.CapabilityID.Value(),
+		ComponentID:   facts.ComponentID.Value(),
+		Justification: facts.Justification.Value(),
+		ApproverID:    approverID,
+		ExpiresOn:     facts.ExpiresOn.Date(),
+		GrantedBy:     facts.GrantedBy,
+	}))
+	return aggregate, nil
+}
+
+func LoadStandardExceptionFromHistory(eventHistory []domain.DomainEvent) (*StandardException, error) {
+	aggregate := &StandardException{
+		AggregateRoot: domain.NewAggregateRoot(),
+	}
+	var applyErr error
+	aggregate.LoadFromHistory(eventHistory, func(event domain.DomainEvent) {
+		if applyErr != nil {
+			return
+		}
+		applyErr = aggregate.apply(event)
+	})
+	if applyErr != nil {
+		return nil, applyErr
+	}
+	return aggregate, nil
+}
+
+// Renew records a fresh approval with a new expiry date. An exception that
+// has already expired can be renewed; a revoked one cannot.
+func (e *StandardException) Renew(justification valueobjects.ExceptionJustification, approverID string, expiresOn valueobjects.ExceptionExpiry, actor string) error {
+	if e.revoked {
+		return ErrStandardExceptionRevoked
+	}
+	approverID, err := validateExceptionApproval(approverID, expiresOn)
+	if err != nil {
+		return err
+	}
+	e.raise(events.NewStandardExceptionRenewed(events.StandardExceptionRenewedFields{
+		ID:            e.ID(),
+		Justification: justification.Value(),
+		ApproverID:    approverID,
+		ExpiresOn:     expiresOn.Date(),
+		RenewedBy:     actor,
+	}))
+	return nil
+}
+
+func (e *StandardException) Revoke(actor string) error {
+	if e.revoked {
+		return ErrStandardExceptionRevoked
+	}
+	e.raise(events.NewStandardExceptionRevoked(events.StandardExceptionRevokedFields{
+		ID:        e.ID(),
+		RevokedBy: actor,
+	}))
+	return nil
+}
+
+// Expire records that the exception's expiry date has passed. It is recorded
+// once per approval: expiring again is a no-op, while a renewal starts a new
+// approval that can expire in turn.
+func (e *StandardException) Expire(now time.Time) error {
+	if e.revoked {
+		return ErrStandardExceptionRevoked
+	}
+	if !e.expiresOn.HasPassed(now) {
+		return ErrStandardExceptionNotExpired
+	}
+	if e.expired {
+		return nil
+	}
+	e.raise(events.NewStandardExceptionExpired(events.StandardExceptionExpiredFields{
+		ID:           e.ID(),
+		CapabilityID: e.capabilityID.Value(),
+		ComponentID:  e.componentID.Value(),
+		ExpiresOn:    e.expiresOn.Date(),
+	}))
+	return nil
+}
+
+func validateExceptionApproval(approverID string, expiresOn valueobjects.ExceptionExpiry) (string, error) {
+	trimmed := strings.TrimSpace(approverID)
+	if trimmed == "" {
+		return "", ErrExceptionApproverRequired
+	}
+	if expiresOn.HasPassed(time.Now()) {
+		return "", ErrExceptionExpiryInPast
+	}
+	return trimmed, nil
+}
+
+func (e *StandardException) CapabilityID() valueobjects.PhysicalCapabilityRef { return e.capabilityID }
+func (e *StandardException) ComponentID() valueobjects.ApplicationRef         { return e.componentID }
+func (e *StandardException) Justification() valueobjects.ExceptionJustification {
+	return e.justification
+}
+func (e *StandardException) ApproverID() string                      { return e.approverID }
+func (e *StandardException) ExpiresOn() valueobjects.ExceptionExpiry { return e.expiresOn }
+func (e *StandardException) IsRevoked() bool                         { return e.revoked }
+func (e *StandardException) IsExpired() bool                         { return e.expired }
+
+func (e *StandardException) raise(event domain.DomainEvent) {
+	if err := e.apply(event); err != nil {
+		panic(fmt.Sprintf("architecturedirection: in-process apply failed: %v", err))
+	}
+	e.RaiseEvent(event)
+}
+
+func (e *StandardException) apply(event domain.DomainEvent) error {
+	switch evt := event.(type) {
+	case events.StandardExceptionGranted:
+		return e.applyGranted(evt)
+	case events.StandardExceptionRenewed:
+		e.expired = false
+		return e.applyApproval(evt.Justification, evt.ApproverID, evt.ExpiresOn)
+	case events.StandardExceptionRevoked:
+		e.revoked = true
+		return nil
+	case events.StandardExceptionExpired:
+		e.expired = true
+		return nil
+	default:
+		return fmt.Errorf("%w: %T", ErrUnknownStandardExceptionEvent, event)
+	}
+}
+
+func (e *StandardException) applyGranted(evt events.StandardExceptionGranted) error {
+	capabilityID, err := valueobjects.NewPhysicalCapabilityRef(evt.CapabilityID)
+	if err != nil {
+		return fmt.Errorf("%w: capability ref %q: %v", ErrCorruptedStandardExceptionEvent, evt.CapabilityID, err)
+	}
+	componentID, err := valueobjects.NewApplicationRef(evt.ComponentID)
+	if err != nil {
+		return fmt.Errorf("%w: component ref %q: %v", ErrCorruptedStandardExceptionEvent, evt.ComponentID, err)
+	}
+	e.AggregateRoot = domain.NewAggregateRootWithID(evt.ID)
+	e.capabilityID = capabilityID
+	e.componentID = componentID
+	return e.applyApproval(evt.Justification, evt.ApproverID, evt.ExpiresOn)
+}
+
+func (e *StandardException) applyApproval(rawJustification, approverID string, rawExpiresOn time.Time) error {
+	justification, err := valueobjects.NewExceptionJustification(rawJustification)
+	if err != nil {
+		return fmt.Errorf("%w: justification: %v", ErrCorruptedStandardExceptionEvent, err)
+	}
+	expiresOn, err := valueobjects.NewExceptionExpiry(rawExpiresOn)
+	if err != nil {
+		return fmt.Errorf("%w: expiry: %v", ErrCorruptedStandardExceptionEvent, err)
+	}
+	e.justification = justification
+	e.approverID = approverID
+	e.expiresOn = expiresOn
+	return nil
+}
@@ -0,0 +1,143 @@
+package aggregates
+
+import (
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/domain/events"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newJustification(t *testing.T, v string) valueobjects.ExceptionJustification {
+	t.Helper()
+	j, err := valueobjects.NewExceptionJustification(v)
+	require.NoError(t, err)
+	return j
+}
+
+func expiryIn(t *testing.T, days int) valueobjects.ExceptionExpiry {
+	t.Helper()
+	e, err := valueobjects.NewExceptionExpiry(time.Now().UTC().AddDate(0, 0, days))
+	require.NoError(t, err)
+	return e
+}
+
+func standardExceptionFacts(t *testing.T) StandardExceptionFacts {
+	t.Helper()
+	return StandardExceptionFacts{
+		ID:            valueobjects.NewStandardExceptionID(),
+		CapabilityID:  newCapabilityRef(t),
+		ComponentID:   newComponentRef(t),
+		Justification: newJustification(t, "Contract runs until next year"),
+		ApproverID:    " cio@example.com ",
+		ExpiresOn:     expiryIn(t, 90),
+		GrantedBy:     journeyActor,
+	}
+}
+
+func TestNewStandardException_RaisesGranted(t *testing.T) {
+	facts := standardExceptionFacts(t)
+
+	e, err := NewStandardException(facts)
+
+	require.NoError(t, err)
+	assert.Equal(t, "cio@example.com", e.ApproverID())
+	assert.True(t, e.ExpiresOn().Equals(facts.ExpiresOn))
+	evt, ok := e.GetUncommittedChanges()[0].(events.StandardExceptionGranted)
+	require.True(t, ok)
+	assert.Equal(t, facts.ID.Value(), evt.ID)
+	assert.Equal(t, facts.CapabilityID.Value(), evt.CapabilityID)
+	assert.Equal(t, journeyActor, evt.GrantedBy)
+}
+
+func TestNewStandardException_RequiresApproverAndFutureExpiry(t *testing.T) {
+	facts := standardExceptionFacts(t)
+	facts.ApproverID = "  "
+	_, err := NewStandardException(facts)
+	assert.ErrorIs(t, err, ErrExceptionApproverRequired)
+
+	facts = standardExceptionFacts(t)
+	facts.ExpiresOn = expiryIn(t, -1)
+	_, err = NewStandardException(facts)
+	assert.ErrorIs(t, err, ErrExceptionExpiryInPast)
+
+	facts.ExpiresOn = expiryIn(t, 0)
+	_, err = NewStandardException(facts)
+	assert.NoError(t, err, "an exception may expire at the end of today")
+}
+
+func TestStandardException_RenewMovesExpiry(t *testing.T) {
+	e, err := NewStandardException(standardExceptionFacts(t))
+	require.NoError(t, err)
+	later := expiryIn(t, 365)
+
+	require.NoError(t, e.Renew(newJustification(t, "Migration slipped"), "cto@example.com", later, journeyActor))
+
+	assert.True(t, e.ExpiresOn().Equals(later))
+	assert.Equal(t, "Migration slipped", e.Justification().Value())
+	assert.Equal(t, "cto@example.com", e.ApproverID())
+	assert.ErrorIs(t, e.Renew(newJustification(t, "Again"), "cto@example.com", expiryIn(t, -3), journeyActor), ErrExceptionExpiryInPast)
+}
+
+func TestStandardException_RevokedRejectsFurtherChanges(t *testing.T) {
+	e, err := NewStandardException(standardExceptionFacts(t))
+	require.NoError(t, err)
+	require.NoError(t, e.Revoke(journeyActor))
+
+	assert.True(t, e.IsRevoked())
+	assert.ErrorIs(t, e.Revoke(journeyActor), ErrStandardExceptionRevoked)
+	assert.ErrorIs(t, e.Renew(newJustification(t, "Late"), "cto@example.com", expiryIn(t, 30), journeyActor), ErrStandardExceptionRevoked)
+}
+
+func TestStandardException_ExpireIsRecordedOncePerApproval(t *testing.T) {
+	e, err := NewStandardException(standardExceptionFacts(t))
+	require.NoError(t, err)
+	e.MarkChangesAsCommitted()
+	afterExpiry := time.Now().UTC().AddDate(0, 0, 91)
+
+	assert.ErrorIs(t, e.Expire(time.Now()), ErrStandardExceptionNotExpired)
+	require.NoError(t, e.Expire(afterExpiry))
+	require.NoError(t, e.Expire(afterExpiry), "expiring again is a no-op")
+
+	require.Len(t, e.GetUncommittedChanges(), 1)
+	expired, ok := e.GetUncommittedChanges()[0].(events.StandardExceptionExpired)
+	require.True(t, ok)
+	assert.Equal(t, e.CapabilityID().Value(), expired.CapabilityID)
+	assert.Equal(t, e.ComponentID().Value(), expired.ComponentID)
+	assert.True(t, e.IsExpired())
+
+	require.NoError(t, e.Renew(newJustification(t, "Migration slipped"), "cto@example.com", expiryIn(t, 30), journeyActor))
+	assert.False(t, e.IsExpired(), "a renewal starts a new approval")
+	require.NoError(t, e.Expire(afterExpiry))
+	assert.Len(t, e.GetUncommittedChanges(), 3)
+}
+
+func TestStandardException_RevokedDoesNotExpire(t *testing.T) {
+	e, err := NewStandardException(standardExceptionFacts(t))
+	require.NoError(t, err)
+	require.NoError(t, e.Revoke(journeyActor))
+
+	assert.ErrorIs(t, e.Expire(time.Now().UTC().AddDate(1, 0, 0)), ErrStandardExceptionRevoked)
+}
+
+func TestLoadStandardExceptionFromHistory_ReconstructsState(t *testing.T) {
+	facts := standardExceptionFacts(t)
+	e, err := NewStandardException(facts)
+	require.NoError(t, err)
+	later := expiryIn(t, 180)
+	require.NoError(t, e.Renew(newJustification(t, "Migration slipped"), "cto@example.com", later, journeyActor))
+
+	loaded, err := LoadStandardExceptionFromHistory(e.GetUncommittedChanges())
+
+	require.NoError(t, err)
+	assert.Equal(t, facts.ID.Value(), loaded.ID())
+	assert.True(t, loaded.CapabilityID().Equals(facts.CapabilityID))
+	assert.True(t, loaded.ComponentID().Equals(facts.ComponentID))
+	assert.True(t, loaded.ExpiresOn().Equals(later))
+	assert.Equal(t, "cto@example.com", loaded.ApproverID())
+	assert.False(t, loaded.IsRevoked())
+	assert.Empty(t, loaded.GetUncommittedChanges())
+}
@@ -0,0 +1,155 @@
+package aggregates
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"easi/backend/internal/architecturedirection/domain/events"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	domain "easi/backend/internal/shared/eventsourcing"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+var (
+	ErrEvidenceFingerprintRequired           = errors.New("a rejected time suggestion must carry the fingerprint of its evidence")
+	ErrCorruptedTimeSuggestionRejectionEvent = errors.New("corrupted event store: cannot rehydrate time suggestion rejection")
+	ErrUnknownTimeSuggestionRejectionEvent   = errors.New("unknown event type for time suggestion rejection aggregate")
+)
+
+// TimeSuggestionRejection remembers the latest TIME suggestion an architect
+// dismissed for a (capability, component) pair. There is one per pair; a
+// later rejection of a changed suggestion replaces the remembered one.
+type TimeSuggestionRejection struct {
+	domain.AggregateRoot
+	capabilityID        valueobjects.PhysicalCapabilityRef
+	componentID         valueobjects.ApplicationRef
+	suggestedGrade      valueobjects.TimeGrade
+	evidenceFingerprint string
+	reason              sharedvo.Description
+	rejectedBy          string
+	rejectedAt          time.Time
+}
+
+type TimeSuggestionRejectionFacts struct {
+	CapabilityID        valueobjects.PhysicalCapabilityRef
+	ComponentID         valueobjects.ApplicationRef
+	SuggestedGrade      valueobjects.TimeGrade
+	EvidenceFingerprint string
+	Reason              sharedvo.Description
+	RejectedBy          string
+}
+
+func NewTimeSuggestionRejection(facts TimeSuggestionRejectionFacts) (*TimeSuggestionRejection, error) {
+	if facts.EvidenceFingerprint == "" {
+		return nil, ErrEvidenceFingerprintRequired
+	}
+	id := valueobjects.NewTimeSuggestionRejectionID()
+	aggregate := &TimeSuggestionRejection{
+		AggregateRoot: domain.NewAggregateRootWithID(id.Value()),
+	}
+	aggregate.raise(rejectedEvent(id.Value(), facts))
+	return aggregate, nil
+}
+
+func LoadTimeSuggestionRejectionFromHistory(eventHistory []domain.DomainEvent) (*TimeSuggestionRejection, error) {
+	aggregate := &TimeSuggestionRejection{
+		AggregateRoot: domain.NewAggregateRoot(),
+	}
+	var applyErr error
+	aggregate.LoadFromHistory(eventHistory, func(event domain.DomainEvent) {
+		if applyErr != nil {
+			return
+		}
+		applyErr = aggregate.apply(event)
+	})
+	if applyErr != nil {
+		return nil, applyErr
+	}
+	return aggregate, nil
+}
+
+// Reject records a further rejection for the same pair, typically of a
+// suggestion that resurfaced after its scores changed.
+func (r *TimeSuggestionRejection) Reject(suggestedGrade valueobjects.TimeGrade, evidenceFingerprint string, reason sharedvo.Description, rejectedBy string) error {
+	if evidenceFingerprint == "" {
+		return ErrEvidenceFingerprintRequired
+	}
+	r.raise(rejectedEvent(r.ID(), TimeSuggestionRejectionFacts{
+		CapabilityID:        r.capabilityID,
+		ComponentID:         r.componentID,
+		SuggestedGrade:      suggestedGrade,
+		EvidenceFingerprint: evidenceFingerprint,
+		Reason:              reason,
+		RejectedBy:          rejectedBy,
+	}))
+	return nil
+}
+
+func rejectedEvent(id string, facts TimeSuggestionRejectionFacts) events.TimeSuggestionRejected {
+	return events.NewTimeSuggestionRejected(events.TimeSuggestionRejectedFields{
+		ID:                  id,
+		CapabilityID:        facts.CapabilityID.Value(),
+		ComponentID:         facts.ComponentID.Value(),
+		SuggestedGrade:      facts.SuggestedGrade.Value(),
+		EvidenceFingerprint: facts.EvidenceFingerprint,
+		Reason:              facts.Reason.Value(),
+		RejectedBy:          facts.RejectedBy,
+	})
+}
+
+func (r *TimeSuggestionRejection) CapabilityID() valueobjects.PhysicalCapabilityRef {
+	return r.capabilityID
+}
+func (r *TimeSuggestionRejection) ComponentID() valueobjects.ApplicationRef { return r.componentID }
+func (r *TimeSuggestionRejection) SuggestedGrade() valueobjects.TimeGrade   { return r.suggestedGrade }
+func (r *TimeSuggestionRejection) EvidenceFingerprint() string              { return r.evidenceFingerprint }
+func (r *TimeSuggestionRejection) Reason() sharedvo.Description             { return r.reason }
+func (r *TimeSuggestionRejection) RejectedBy() string                       { return r.rejectedBy }
+func (r *TimeSuggestionRejection) RejectedAt() time.Time                    { return r.rejectedAt }
+
+func (r *TimeSuggestionRejection) raise(event domain.DomainEvent) {
+	if err := r.apply(event); err != nil {
+		panic(fmt.Sprintf("architecturedirection: in-process apply failed: %v", err))
+	}
+	r.RaiseEvent(event)
+}
+
+func (r *TimeSuggestionRejection) apply(event domain.DomainEvent) error {
+	switch evt := event.(type) {
+	case events.TimeSuggestionRejected:
+		return r.applyRejected(evt)
+	default:
+		return fmt.Errorf("%w: %T", ErrUnknownTimeSuggestionRejectionEvent, event)
+	}
+}
+
+func (r *TimeSuggestionRejection) applyRejected(evt events.TimeSuggestionRejected) error {
+	capabilityID, err := valueobjects.NewPhysicalCapabilityRef(evt.CapabilityID)
+	if err != nil {
+		return fmt.Errorf("%w: capability ref %q: %v", ErrCorruptedTimeSuggestionRejectionEvent, evt.CapabilityID, err)
+	}
+	componentID, err := valueobjects.NewApplicationRef(evt.ComponentID)
+	if err != nil {
+		return fmt.Errorf("%w: component ref %q: %v", ErrCorruptedTimeSuggestionRejectionEvent, evt.ComponentID, err)
+	}
+	grade, err := valueobjects.NewTimeGrade(evt.SuggestedGrade)
+	if err != nil {
+		return fmt.Errorf("%w: suggested grade %q: %v", ErrCorruptedTimeSuggestionRejectionEvent, evt.SuggestedGrade, err)
+	}
+	reason, err := sharedvo.NewDescription(evt.Reason)
+	if err != nil {
+		return fmt.Errorf("%w: reason: %v", ErrCorruptedTimeSuggestionRejectionEvent, err)
+	}
+	if r.ID() != evt.ID {
+		r.AggregateRoot = domain.NewAggregateRootWithID(evt.ID)
+	}
+	r.capabilityID = capabilityID
+	r.componentID = componentID
+	r.suggestedGrade = grade
+	r.evidenceFingerprint = evt.EvidenceFingerprint
+	r.reason = reason
+	r.rejectedBy = evt.RejectedBy
+	r.rejectedAt = evt.OccurredOn
+	return nil
+}
@@ -0,0 +1,76 @@
+package aggregates
+
+import (
+	"testing"
+
+	"easi/backend/internal/architecturedirection/domain/events"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTimeSuggestionRejection(t *testing.T) *TimeSuggestionRejection {
+	t.Helper()
+	rejection, err := NewTimeSuggestionRejection(TimeSuggestionRejectionFacts{
+		CapabilityID:        newCapabilityRef(t),
+		ComponentID:         newComponentRef(t),
+		SuggestedGrade:      newGrade(t, valueobjects.TimeGradeEliminate),
+		EvidenceFingerprint: "fp-1",
+		Reason:              newRationale(t, "contract renewed until 2028"),
+		RejectedBy:          "a@example.com",
+	})
+	require.NoError(t, err)
+	return rejection
+}
+
+func TestNewTimeSuggestionRejection_RaisesRejectedEvent(t *testing.T) {
+	rejection := newTimeSuggestionRejection(t)
+
+	changes := rejection.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	evt, ok := changes[0].(events.TimeSuggestionRejected)
+	require.True(t, ok)
+	assert.Equal(t, rejection.ID(), evt.ID)
+	assert.Equal(t, valueobjects.TimeGradeEliminate, evt.SuggestedGrade)
+	assert.Equal(t, "fp-1", evt.EvidenceFingerprint)
+	assert.Equal(t, "contract renewed until 2028", evt.Reason)
+	assert.Equal(t, "a@example.com", evt.RejectedBy)
+}
+
+func TestNewTimeSuggestionRejection_WithoutFingerprint_Fails(t *testing.T) {
+	_, err := NewTimeSuggestionRejection(TimeSuggestionRejectionFacts{
+		CapabilityID:   newCapabilityRef(t),
+		ComponentID:    newComponentRef(t),
+		SuggestedGrade: newGrade(t, valueobjects.TimeGradeMigrate),
+		RejectedBy:     "a@example.com",
+	})
+	assert.ErrorIs(t, err, ErrEvidenceFingerprintRequired)
+}
+
+func TestTimeSuggestionRejection_Reject_ReplacesRememberedSuggestion(t *testing.T) {
+	rejection := newTimeSuggestionRejection(t)
+	rejection.MarkChangesAsCommitted()
+
+	err := rejection.Reject(newGrade(t, valueobjects.TimeGradeMigrate), "fp-2", newRationale(t, ""), "b@example.com")
+
+	require.NoError(t, err)
+	assert.Equal(t, valueobjects.TimeGradeMigrate, rejection.SuggestedGrade().Value())
+	assert.Equal(t, "fp-2", rejection.EvidenceFingerprint())
+	assert.Equal(t, "b@example.com", rejection.RejectedBy())
+	require.Len(t, rejection.GetUncommittedChanges(), 1)
+}
+
+func TestLoadTimeSuggestionRejectionFromHistory_RehydratesLatest(t *testing.T) {
+	rejection := newTimeSuggestionRejection(t)
+	require.NoError(t, rejection.Reject(newGrade(t, valueobjects.TimeGradeMigrate), "fp-2", newRationale(t, "still fine"), "b@example.com"))
+
+	loaded, err := LoadTimeSuggestionRejectionFromHistory(rejection.GetUncommittedChanges())
+
+	require.NoError(t, err)
+	assert.Equal(t, rejection.ID(), loaded.ID())
+	assert.Equal(t, rejection.CapabilityID(), loaded.CapabilityID())
+	assert.Equal(t, "fp-2", loaded.EvidenceFingerprint())
+	assert.Equal(t, "still fine", loaded.Reason().Value())
+	assert.Empty(t, loaded.GetUncommittedChanges())
+}
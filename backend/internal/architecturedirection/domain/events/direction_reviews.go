@@ -0,0 +1,38 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type DirectionReviewRecorded struct {
+	domain.BaseEvent
+	ID         string    `json:"id"`
+	ReviewerID string    `json:"reviewerId"`
+	Verdict    string    `json:"verdict"`
+	Comment    string    `json:"comment"`
+	OccurredOn time.Time `json:"occurredOn"`
+}
+
+func NewDirectionReviewRecorded(id, reviewerID, verdict, comment string) DirectionReviewRecorded {
+	return DirectionReviewRecorded{
+		BaseEvent:  domain.NewBaseEvent(id),
+		ID:         id,
+		ReviewerID: reviewerID,
+		Verdict:    verdict,
+		Comment:    comment,
+		OccurredOn: time.Now().UTC(),
+	}
+}
+func (e DirectionReviewRecorded) EventType() string { return pl.DirectionReviewRecorded }
+func (e DirectionReviewRecorded) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"reviewerId": e.ReviewerID,
+		"verdict":    e.Verdict,
+		"comment":    e.Comment,
+		"occurredOn": e.OccurredOn,
+	}
+}
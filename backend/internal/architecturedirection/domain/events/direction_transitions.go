@@ -7,40 +7,67 @@ import (
 	domain "easi/backend/internal/shared/eventsourcing"
 )
 
+// DirectionProposed names the reviewers whose approval the proposal needs.
+// Proposals recorded before reviews were introduced carry no reviewers.
 type DirectionProposed struct {
 	domain.BaseEvent
-	ID         string    `json:"id"`
-	OccurredOn time.Time `json:"occurredOn"`
+	ID          string    `json:"id"`
+	ReviewerIDs []string  `json:"reviewerIds,omitempty"`
+	Quorum      int       `json:"quorum,omitempty"`
+	OccurredOn  time.Time `json:"occurredOn"`
 }
 
-func NewDirectionProposed(id string) DirectionProposed {
+func NewDirectionProposed(id string, reviewerIDs []string, quorum int) DirectionProposed {
 	return DirectionProposed{
-		BaseEvent:  domain.NewBaseEvent(id),
-		ID:         id,
-		OccurredOn: time.Now().UTC(),
+		BaseEvent:   domain.NewBaseEvent(id),
+		ID:          id,
+		ReviewerIDs: reviewerIDs,
+		Quorum:      quorum,
+		OccurredOn:  time.Now().UTC(),
 	}
 }
 func (e DirectionProposed) EventType() string { return pl.DirectionProposed }
 func (e DirectionProposed) EventData() map[string]interface{} {
-	return map[string]interface{}{"id": e.ID, "occurredOn": e.OccurredOn}
+	return map[string]interface{}{"id": e.ID, "reviewerIds": e.ReviewerIDs, "quorum": e.Quorum, "occurredOn": e.OccurredOn}
+}
+
+type DecisionRecordData struct {
+	Context      string `json:"context"`
+	Decision     string `json:"decision"`
+	Consequences string `json:"consequences"`
 }
 
+// DirectionAgreed carries the decision the direction was agreed with; the
+// decision records context keeps it as an accepted decision record.
+// Directions agreed before reviews were introduced carry no decision.
 type DirectionAgreed struct {
 	domain.BaseEvent
-	ID         string    `json:"id"`
-	OccurredOn time.Time `json:"occurredOn"`
+	ID             string              `json:"id"`
+	DecisionRecord *DecisionRecordData `json:"decisionRecord,omitempty"`
+	AgreedBy       string              `json:"agreedBy,omitempty"`
+	OccurredOn     time.Time           `json:"occurredOn"`
 }
 
-func NewDirectionAgreed(id string) DirectionAgreed {
+func NewDirectionAgreed(id string, record DecisionRecordData, agreedBy string) DirectionAgreed {
 	return DirectionAgreed{
-		BaseEvent:  domain.NewBaseEvent(id),
-		ID:         id,
-		OccurredOn: time.Now().UTC(),
+		BaseEvent:      domain.NewBaseEvent(id),
+		ID:             id,
+		DecisionRecord: &record,
+		AgreedBy:       agreedBy,
+		OccurredOn:     time.Now().UTC(),
 	}
 }
 func (e DirectionAgreed) EventType() string { return pl.DirectionAgreed }
 func (e DirectionAgreed) EventData() map[string]interface{} {
-	return map[string]interface{}{"id": e.ID, "occurredOn": e.OccurredOn}
+	data := map[string]interface{}{"id": e.ID, "agreedBy": e.AgreedBy, "occurredOn": e.OccurredOn}
+	if e.DecisionRecord != nil {
+		data["decisionRecord"] = map[string]interface{}{
+			"context":      e.DecisionRecord.Context,
+			"decision":     e.DecisionRecord.Decision,
+			"consequences": e.DecisionRecord.Consequences,
+		}
+	}
+	return data
 }
 
 type DirectionRejected struct {
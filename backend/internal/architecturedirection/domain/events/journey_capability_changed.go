@@ -0,0 +1,60 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// JourneyCapabilityChanged re-homes an active journey onto another capability when
+// the one it was planned for is merged or split.
+type JourneyCapabilityChanged struct {
+	domain.BaseEvent
+	ID               string    `json:"id"`
+	FromCapabilityID string    `json:"fromCapabilityId"`
+	ToCapabilityID   string    `json:"toCapabilityId"`
+	Reason           string    `json:"reason"`
+	ChangedBy        string    `json:"changedBy"`
+	OccurredOn       time.Time `json:"occurredOn"`
+}
+
+type JourneyCapabilityChangedFields struct {
+	ID               string
+	FromCapabilityID string
+	ToCapabilityID   string
+	Reason           string
+	ChangedBy        string
+}
+
+func NewJourneyCapabilityChanged(f JourneyCapabilityChangedFields) JourneyCapabilityChanged {
+	return JourneyCapabilityChanged{
+		BaseEvent:        domain.NewBaseEvent(f.ID),
+		ID:               f.ID,
+		FromCapabilityID: f.FromCapabilityID,
+		ToCapabilityID:   f.ToCapabilityID,
+		Reason:           f.Reason,
+		ChangedBy:        f.ChangedBy,
+		OccurredOn:       time.Now().UTC(),
+	}
+}
+
+func (e JourneyCapabilityChanged) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e JourneyCapabilityChanged) EventType() string { return pl.JourneyCapabilityChanged }
+
+func (e JourneyCapabilityChanged) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":               e.ID,
+		"fromCapabilityId": e.FromCapabilityID,
+		"toCapabilityId":   e.ToCapabilityID,
+		"reason":           e.Reason,
+		"changedBy":        e.ChangedBy,
+		"occurredOn":       e.OccurredOn,
+	}
+}
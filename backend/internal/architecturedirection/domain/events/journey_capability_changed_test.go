@@ -0,0 +1,25 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJourneyCapabilityChanged_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	evt := NewJourneyCapabilityChanged(JourneyCapabilityChangedFields{
+		ID: "journey-1", FromCapabilityID: "cap-old", ToCapabilityID: "cap-new", Reason: "merged", ChangedBy: "architect@example.com",
+	})
+
+	assert.Equal(t, "journey-1", evt.AggregateID())
+	assert.Equal(t, pl.JourneyCapabilityChanged, evt.EventType())
+	assert.Equal(t, "architect@example.com", evt.ChangedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "cap-old", data["fromCapabilityId"])
+	assert.Equal(t, "cap-new", data["toCapabilityId"])
+	assert.Equal(t, "merged", data["reason"])
+}
@@ -0,0 +1,54 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type JourneyDependencyAdded struct {
+	domain.BaseEvent
+	ID             string    `json:"id"`
+	PredecessorID  string    `json:"predecessorId"`
+	DependencyType string    `json:"dependencyType"`
+	AddedBy        string    `json:"addedBy"`
+	OccurredOn     time.Time `json:"occurredOn"`
+}
+
+type JourneyDependencyAddedFields struct {
+	ID             string
+	PredecessorID  string
+	DependencyType string
+	AddedBy        string
+}
+
+func NewJourneyDependencyAdded(f JourneyDependencyAddedFields) JourneyDependencyAdded {
+	return JourneyDependencyAdded{
+		BaseEvent:      domain.NewBaseEvent(f.ID),
+		ID:             f.ID,
+		PredecessorID:  f.PredecessorID,
+		DependencyType: f.DependencyType,
+		AddedBy:        f.AddedBy,
+		OccurredOn:     time.Now().UTC(),
+	}
+}
+
+func (e JourneyDependencyAdded) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e JourneyDependencyAdded) EventType() string { return pl.JourneyDependencyAdded }
+
+func (e JourneyDependencyAdded) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":             e.ID,
+		"predecessorId":  e.PredecessorID,
+		"dependencyType": e.DependencyType,
+		"addedBy":        e.AddedBy,
+		"occurredOn":     e.OccurredOn,
+	}
+}
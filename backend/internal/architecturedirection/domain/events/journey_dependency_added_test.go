@@ -0,0 +1,31 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJourneyDependencyAdded_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	evt := NewJourneyDependencyAdded(JourneyDependencyAddedFields{
+		ID:             "journey-2",
+		PredecessorID:  "journey-1",
+		DependencyType: "finish-to-start",
+		AddedBy:        "architect@example.com",
+	})
+
+	assert.Equal(t, "journey-2", evt.AggregateID())
+	assert.Equal(t, pl.JourneyDependencyAdded, evt.EventType())
+	assert.Equal(t, "journey-1", evt.PredecessorID)
+	assert.Equal(t, "finish-to-start", evt.DependencyType)
+	assert.Equal(t, "architect@example.com", evt.AddedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "journey-2", data["id"])
+	assert.Equal(t, "journey-1", data["predecessorId"])
+	assert.Equal(t, "finish-to-start", data["dependencyType"])
+	assert.Equal(t, "architect@example.com", data["addedBy"])
+}
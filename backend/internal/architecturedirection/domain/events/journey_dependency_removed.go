@@ -0,0 +1,50 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type JourneyDependencyRemoved struct {
+	domain.BaseEvent
+	ID            string    `json:"id"`
+	PredecessorID string    `json:"predecessorId"`
+	RemovedBy     string    `json:"removedBy"`
+	OccurredOn    time.Time `json:"occurredOn"`
+}
+
+type JourneyDependencyRemovedFields struct {
+	ID            string
+	PredecessorID string
+	RemovedBy     string
+}
+
+func NewJourneyDependencyRemoved(f JourneyDependencyRemovedFields) JourneyDependencyRemoved {
+	return JourneyDependencyRemoved{
+		BaseEvent:     domain.NewBaseEvent(f.ID),
+		ID:            f.ID,
+		PredecessorID: f.PredecessorID,
+		RemovedBy:     f.RemovedBy,
+		OccurredOn:    time.Now().UTC(),
+	}
+}
+
+func (e JourneyDependencyRemoved) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e JourneyDependencyRemoved) EventType() string { return pl.JourneyDependencyRemoved }
+
+func (e JourneyDependencyRemoved) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":            e.ID,
+		"predecessorId": e.PredecessorID,
+		"removedBy":     e.RemovedBy,
+		"occurredOn":    e.OccurredOn,
+	}
+}
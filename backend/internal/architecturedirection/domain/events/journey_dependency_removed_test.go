@@ -0,0 +1,28 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJourneyDependencyRemoved_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	evt := NewJourneyDependencyRemoved(JourneyDependencyRemovedFields{
+		ID:            "journey-2",
+		PredecessorID: "journey-1",
+		RemovedBy:     "architect@example.com",
+	})
+
+	assert.Equal(t, "journey-2", evt.AggregateID())
+	assert.Equal(t, pl.JourneyDependencyRemoved, evt.EventType())
+	assert.Equal(t, "journey-1", evt.PredecessorID)
+	assert.Equal(t, "architect@example.com", evt.RemovedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "journey-2", data["id"])
+	assert.Equal(t, "journey-1", data["predecessorId"])
+	assert.Equal(t, "architect@example.com", data["removedBy"])
+}
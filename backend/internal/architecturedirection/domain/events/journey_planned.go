@@ -19,6 +19,8 @@ type JourneyPlanned struct {
 	TargetDomainID   string            `json:"targetDomainId,omitempty"`
 	TargetParentID   string            `json:"targetParentId,omitempty"`
 	ResultingName    string            `json:"resultingName,omitempty"`
+	TemplateID       string            `json:"templateId,omitempty"`
+	TemplateVersion  int               `json:"templateVersion,omitempty"`
 	PlannedBy        string            `json:"plannedBy"`
 	OccurredOn       time.Time         `json:"occurredOn"`
 }
@@ -34,6 +36,8 @@ type JourneyPlannedFields struct {
 	TargetDomainID   string
 	TargetParentID   string
 	ResultingName    string
+	TemplateID       string
+	TemplateVersion  int
 	PlannedBy        string
 }
 
@@ -50,6 +54,8 @@ func NewJourneyPlanned(f JourneyPlannedFields) JourneyPlanned {
 		TargetDomainID:   f.TargetDomainID,
 		TargetParentID:   f.TargetParentID,
 		ResultingName:    f.ResultingName,
+		TemplateID:       f.TemplateID,
+		TemplateVersion:  f.TemplateVersion,
 		PlannedBy:        f.PlannedBy,
 		OccurredOn:       time.Now().UTC(),
 	}
@@ -76,6 +82,8 @@ func (e JourneyPlanned) EventData() map[string]interface{} {
 		"targetDomainId":   e.TargetDomainID,
 		"targetParentId":   e.TargetParentID,
 		"resultingName":    e.ResultingName,
+		"templateId":       e.TemplateID,
+		"templateVersion":  e.TemplateVersion,
 		"plannedBy":        e.PlannedBy,
 		"occurredOn":       e.OccurredOn,
 	}
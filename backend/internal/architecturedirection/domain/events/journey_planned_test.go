@@ -86,3 +86,22 @@ func TestJourneyPlanned_EventData_NilTargetPeriod(t *testing.T) {
 
 	assert.Nil(t, data["targetPeriod"])
 }
+
+func TestNewJourneyPlanned_RecordsTemplateOrigin(t *testing.T) {
+	evt := NewJourneyPlanned(JourneyPlannedFields{
+		ID:               "journey-3",
+		CapabilityID:     "cap-3",
+		Kind:             "migration",
+		FromComponentIDs: []string{"seabook"},
+		ToComponentID:    "phoenix",
+		TemplateID:       "template-1",
+		TemplateVersion:  2,
+		PlannedBy:        "architect@example.com",
+	})
+
+	assert.Equal(t, "template-1", evt.TemplateID)
+	assert.Equal(t, 2, evt.TemplateVersion)
+	data := evt.EventData()
+	assert.Equal(t, "template-1", data["templateId"])
+	assert.Equal(t, 2, data["templateVersion"])
+}
@@ -0,0 +1,54 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type JourneyProgrammeCreated struct {
+	domain.BaseEvent
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedBy   string    `json:"createdBy"`
+	OccurredOn  time.Time `json:"occurredOn"`
+}
+
+type JourneyProgrammeCreatedFields struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedBy   string
+}
+
+func NewJourneyProgrammeCreated(f JourneyProgrammeCreatedFields) JourneyProgrammeCreated {
+	return JourneyProgrammeCreated{
+		BaseEvent:   domain.NewBaseEvent(f.ID),
+		ID:          f.ID,
+		Name:        f.Name,
+		Description: f.Description,
+		CreatedBy:   f.CreatedBy,
+		OccurredOn:  time.Now().UTC(),
+	}
+}
+
+func (e JourneyProgrammeCreated) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e JourneyProgrammeCreated) EventType() string { return pl.JourneyProgrammeCreated }
+
+func (e JourneyProgrammeCreated) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          e.ID,
+		"name":        e.Name,
+		"description": e.Description,
+		"createdBy":   e.CreatedBy,
+		"occurredOn":  e.OccurredOn,
+	}
+}
@@ -0,0 +1,31 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJourneyProgrammeCreated_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	evt := NewJourneyProgrammeCreated(JourneyProgrammeCreatedFields{
+		ID:          "programme-1",
+		Name:        "ERP consolidation",
+		Description: "Retire the regional ERPs",
+		CreatedBy:   "architect@example.com",
+	})
+
+	assert.Equal(t, "programme-1", evt.AggregateID())
+	assert.Equal(t, pl.JourneyProgrammeCreated, evt.EventType())
+	assert.Equal(t, "ERP consolidation", evt.Name)
+	assert.Equal(t, "Retire the regional ERPs", evt.Description)
+	assert.Equal(t, "architect@example.com", evt.CreatedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "programme-1", data["id"])
+	assert.Equal(t, "ERP consolidation", data["name"])
+	assert.Equal(t, "Retire the regional ERPs", data["description"])
+	assert.Equal(t, "architect@example.com", data["createdBy"])
+}
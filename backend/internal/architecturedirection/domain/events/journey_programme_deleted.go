@@ -0,0 +1,46 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type JourneyProgrammeDeleted struct {
+	domain.BaseEvent
+	ID         string    `json:"id"`
+	DeletedBy  string    `json:"deletedBy"`
+	OccurredOn time.Time `json:"occurredOn"`
+}
+
+type JourneyProgrammeDeletedFields struct {
+	ID        string
+	DeletedBy string
+}
+
+func NewJourneyProgrammeDeleted(f JourneyProgrammeDeletedFields) JourneyProgrammeDeleted {
+	return JourneyProgrammeDeleted{
+		BaseEvent:  domain.NewBaseEvent(f.ID),
+		ID:         f.ID,
+		DeletedBy:  f.DeletedBy,
+		OccurredOn: time.Now().UTC(),
+	}
+}
+
+func (e JourneyProgrammeDeleted) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e JourneyProgrammeDeleted) EventType() string { return pl.JourneyProgrammeDeleted }
+
+func (e JourneyProgrammeDeleted) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"deletedBy":  e.DeletedBy,
+		"occurredOn": e.OccurredOn,
+	}
+}
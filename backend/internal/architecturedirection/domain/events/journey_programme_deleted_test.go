@@ -0,0 +1,25 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJourneyProgrammeDeleted_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	evt := NewJourneyProgrammeDeleted(JourneyProgrammeDeletedFields{
+		ID:        "programme-1",
+		DeletedBy: "architect@example.com",
+	})
+
+	assert.Equal(t, "programme-1", evt.AggregateID())
+	assert.Equal(t, pl.JourneyProgrammeDeleted, evt.EventType())
+	assert.Equal(t, "architect@example.com", evt.DeletedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "programme-1", data["id"])
+	assert.Equal(t, "architect@example.com", data["deletedBy"])
+}
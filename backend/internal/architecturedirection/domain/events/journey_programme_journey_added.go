@@ -0,0 +1,50 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type JourneyProgrammeJourneyAdded struct {
+	domain.BaseEvent
+	ID         string    `json:"id"`
+	JourneyID  string    `json:"journeyId"`
+	AddedBy    string    `json:"addedBy"`
+	OccurredOn time.Time `json:"occurredOn"`
+}
+
+type JourneyProgrammeJourneyAddedFields struct {
+	ID        string
+	JourneyID string
+	AddedBy   string
+}
+
+func NewJourneyProgrammeJourneyAdded(f JourneyProgrammeJourneyAddedFields) JourneyProgrammeJourneyAdded {
+	return JourneyProgrammeJourneyAdded{
+		BaseEvent:  domain.NewBaseEvent(f.ID),
+		ID:         f.ID,
+		JourneyID:  f.JourneyID,
+		AddedBy:    f.AddedBy,
+		OccurredOn: time.Now().UTC(),
+	}
+}
+
+func (e JourneyProgrammeJourneyAdded) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e JourneyProgrammeJourneyAdded) EventType() string { return pl.JourneyProgrammeJourneyAdded }
+
+func (e JourneyProgrammeJourneyAdded) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"journeyId":  e.JourneyID,
+		"addedBy":    e.AddedBy,
+		"occurredOn": e.OccurredOn,
+	}
+}
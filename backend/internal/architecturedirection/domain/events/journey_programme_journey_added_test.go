@@ -0,0 +1,28 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJourneyProgrammeJourneyAdded_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	evt := NewJourneyProgrammeJourneyAdded(JourneyProgrammeJourneyAddedFields{
+		ID:        "programme-1",
+		JourneyID: "journey-1",
+		AddedBy:   "architect@example.com",
+	})
+
+	assert.Equal(t, "programme-1", evt.AggregateID())
+	assert.Equal(t, pl.JourneyProgrammeJourneyAdded, evt.EventType())
+	assert.Equal(t, "journey-1", evt.JourneyID)
+	assert.Equal(t, "architect@example.com", evt.AddedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "programme-1", data["id"])
+	assert.Equal(t, "journey-1", data["journeyId"])
+	assert.Equal(t, "architect@example.com", data["addedBy"])
+}
@@ -0,0 +1,50 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type JourneyProgrammeJourneyRemoved struct {
+	domain.BaseEvent
+	ID         string    `json:"id"`
+	JourneyID  string    `json:"journeyId"`
+	RemovedBy  string    `json:"removedBy"`
+	OccurredOn time.Time `json:"occurredOn"`
+}
+
+type JourneyProgrammeJourneyRemovedFields struct {
+	ID        string
+	JourneyID string
+	RemovedBy string
+}
+
+func NewJourneyProgrammeJourneyRemoved(f JourneyProgrammeJourneyRemovedFields) JourneyProgrammeJourneyRemoved {
+	return JourneyProgrammeJourneyRemoved{
+		BaseEvent:  domain.NewBaseEvent(f.ID),
+		ID:         f.ID,
+		JourneyID:  f.JourneyID,
+		RemovedBy:  f.RemovedBy,
+		OccurredOn: time.Now().UTC(),
+	}
+}
+
+func (e JourneyProgrammeJourneyRemoved) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e JourneyProgrammeJourneyRemoved) EventType() string { return pl.JourneyProgrammeJourneyRemoved }
+
+func (e JourneyProgrammeJourneyRemoved) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"journeyId":  e.JourneyID,
+		"removedBy":  e.RemovedBy,
+		"occurredOn": e.OccurredOn,
+	}
+}
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJourneyProgrammeJourneyRemoved_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	evt := NewJourneyProgrammeJourneyRemoved(JourneyProgrammeJourneyRemovedFields{
+		ID:        "programme-1",
+		JourneyID: "journey-1",
+		RemovedBy: "architect@example.com",
+	})
+
+	assert.Equal(t, "programme-1", evt.AggregateID())
+	assert.Equal(t, pl.JourneyProgrammeJourneyRemoved, evt.EventType())
+	assert.Equal(t, "journey-1", evt.JourneyID)
+	assert.Equal(t, "architect@example.com", evt.RemovedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "programme-1", data["id"])
+	assert.Equal(t, "journey-1", data["journeyId"])
+	assert.Equal(t, "architect@example.com", data["removedBy"])
+}
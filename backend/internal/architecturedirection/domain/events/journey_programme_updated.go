@@ -0,0 +1,54 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type JourneyProgrammeUpdated struct {
+	domain.BaseEvent
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	UpdatedBy   string    `json:"updatedBy"`
+	OccurredOn  time.Time `json:"occurredOn"`
+}
+
+type JourneyProgrammeUpdatedFields struct {
+	ID          string
+	Name        string
+	Description string
+	UpdatedBy   string
+}
+
+func NewJourneyProgrammeUpdated(f JourneyProgrammeUpdatedFields) JourneyProgrammeUpdated {
+	return JourneyProgrammeUpdated{
+		BaseEvent:   domain.NewBaseEvent(f.ID),
+		ID:          f.ID,
+		Name:        f.Name,
+		Description: f.Description,
+		UpdatedBy:   f.UpdatedBy,
+		OccurredOn:  time.Now().UTC(),
+	}
+}
+
+func (e JourneyProgrammeUpdated) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e JourneyProgrammeUpdated) EventType() string { return pl.JourneyProgrammeUpdated }
+
+func (e JourneyProgrammeUpdated) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          e.ID,
+		"name":        e.Name,
+		"description": e.Description,
+		"updatedBy":   e.UpdatedBy,
+		"occurredOn":  e.OccurredOn,
+	}
+}
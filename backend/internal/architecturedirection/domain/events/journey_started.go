@@ -9,22 +9,27 @@ import (
 
 type JourneyStarted struct {
 	domain.BaseEvent
-	ID         string    `json:"id"`
-	StartedBy  string    `json:"startedBy"`
-	OccurredOn time.Time `json:"occurredOn"`
+	ID        string `json:"id"`
+	StartedBy string `json:"startedBy"`
+	// UnmetPredecessorIDs lists the predecessors whose dependency was not yet
+	// satisfied when the journey was started anyway.
+	UnmetPredecessorIDs []string  `json:"unmetPredecessorIds,omitempty"`
+	OccurredOn          time.Time `json:"occurredOn"`
 }
 
 type JourneyStartedFields struct {
-	ID        string
-	StartedBy string
+	ID                  string
+	StartedBy           string
+	UnmetPredecessorIDs []string
 }
 
 func NewJourneyStarted(f JourneyStartedFields) JourneyStarted {
 	return JourneyStarted{
-		BaseEvent:  domain.NewBaseEvent(f.ID),
-		ID:         f.ID,
-		StartedBy:  f.StartedBy,
-		OccurredOn: time.Now().UTC(),
+		BaseEvent:           domain.NewBaseEvent(f.ID),
+		ID:                  f.ID,
+		StartedBy:           f.StartedBy,
+		UnmetPredecessorIDs: f.UnmetPredecessorIDs,
+		OccurredOn:          time.Now().UTC(),
 	}
 }
 
@@ -39,8 +44,9 @@ func (e JourneyStarted) EventType() string { return pl.JourneyStarted }
 
 func (e JourneyStarted) EventData() map[string]interface{} {
 	return map[string]interface{}{
-		"id":         e.ID,
-		"startedBy":  e.StartedBy,
-		"occurredOn": e.OccurredOn,
+		"id":                  e.ID,
+		"startedBy":           e.StartedBy,
+		"unmetPredecessorIds": e.UnmetPredecessorIDs,
+		"occurredOn":          e.OccurredOn,
 	}
 }
@@ -21,3 +21,14 @@ func TestNewJourneyStarted_CarriesActorAndTimestamp_Rule13(t *testing.T) {
 	assert.Equal(t, "journey-1", data["id"])
 	assert.Equal(t, "architect@example.com", data["startedBy"])
 }
+
+func TestNewJourneyStarted_CarriesUnmetPredecessors(t *testing.T) {
+	evt := NewJourneyStarted(JourneyStartedFields{
+		ID:                  "journey-1",
+		StartedBy:           "architect@example.com",
+		UnmetPredecessorIDs: []string{"journey-0"},
+	})
+
+	assert.Equal(t, []string{"journey-0"}, evt.UnmetPredecessorIDs)
+	assert.Equal(t, []string{"journey-0"}, evt.EventData()["unmetPredecessorIds"])
+}
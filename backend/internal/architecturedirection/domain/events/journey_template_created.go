@@ -0,0 +1,62 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type JourneyTemplateCreated struct {
+	domain.BaseEvent
+	ID         string                  `json:"id"`
+	Kind       string                  `json:"kind"`
+	Name       string                  `json:"name"`
+	Milestones []TemplateMilestoneData `json:"milestones"`
+	Version    int                     `json:"version"`
+	CreatedBy  string                  `json:"createdBy"`
+	OccurredOn time.Time               `json:"occurredOn"`
+}
+
+type JourneyTemplateCreatedFields struct {
+	ID         string
+	Kind       string
+	Name       string
+	Milestones []TemplateMilestoneData
+	Version    int
+	CreatedBy  string
+}
+
+func NewJourneyTemplateCreated(f JourneyTemplateCreatedFields) JourneyTemplateCreated {
+	return JourneyTemplateCreated{
+		BaseEvent:  domain.NewBaseEvent(f.ID),
+		ID:         f.ID,
+		Kind:       f.Kind,
+		Name:       f.Name,
+		Milestones: f.Milestones,
+		Version:    f.Version,
+		CreatedBy:  f.CreatedBy,
+		OccurredOn: time.Now().UTC(),
+	}
+}
+
+func (e JourneyTemplateCreated) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e JourneyTemplateCreated) EventType() string { return pl.JourneyTemplateCreated }
+
+func (e JourneyTemplateCreated) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"kind":       e.Kind,
+		"name":       e.Name,
+		"milestones": templateMilestonesEventData(e.Milestones),
+		"version":    e.Version,
+		"createdBy":  e.CreatedBy,
+		"occurredOn": e.OccurredOn,
+	}
+}
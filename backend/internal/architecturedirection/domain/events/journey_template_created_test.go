@@ -0,0 +1,34 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJourneyTemplateCreated_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	evt := NewJourneyTemplateCreated(JourneyTemplateCreatedFields{
+		ID:         "template-1",
+		Kind:       "migration",
+		Name:       "SaaS migration",
+		Milestones: []TemplateMilestoneData{{Label: "Vendor selection", OffsetQuarters: 1}},
+		Version:    1,
+		CreatedBy:  "architect@example.com",
+	})
+
+	assert.Equal(t, "template-1", evt.AggregateID())
+	assert.Equal(t, pl.JourneyTemplateCreated, evt.EventType())
+	assert.Equal(t, "migration", evt.Kind)
+	assert.Equal(t, 1, evt.Version)
+	assert.Equal(t, "architect@example.com", evt.CreatedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "template-1", data["id"])
+	assert.Equal(t, "SaaS migration", data["name"])
+	assert.Equal(t, []map[string]interface{}{{"label": "Vendor selection", "offsetQuarters": 1}}, data["milestones"])
+	assert.Equal(t, 1, data["version"])
+	assert.Equal(t, "architect@example.com", data["createdBy"])
+}
@@ -0,0 +1,14 @@
+package events
+
+type TemplateMilestoneData struct {
+	Label          string `json:"label"`
+	OffsetQuarters int    `json:"offsetQuarters"`
+}
+
+func templateMilestonesEventData(milestones []TemplateMilestoneData) []map[string]interface{} {
+	out := make([]map[string]interface{}, len(milestones))
+	for i, m := range milestones {
+		out[i] = map[string]interface{}{"label": m.Label, "offsetQuarters": m.OffsetQuarters}
+	}
+	return out
+}
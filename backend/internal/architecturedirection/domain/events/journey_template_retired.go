@@ -0,0 +1,46 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type JourneyTemplateRetired struct {
+	domain.BaseEvent
+	ID         string    `json:"id"`
+	RetiredBy  string    `json:"retiredBy"`
+	OccurredOn time.Time `json:"occurredOn"`
+}
+
+type JourneyTemplateRetiredFields struct {
+	ID        string
+	RetiredBy string
+}
+
+func NewJourneyTemplateRetired(f JourneyTemplateRetiredFields) JourneyTemplateRetired {
+	return JourneyTemplateRetired{
+		BaseEvent:  domain.NewBaseEvent(f.ID),
+		ID:         f.ID,
+		RetiredBy:  f.RetiredBy,
+		OccurredOn: time.Now().UTC(),
+	}
+}
+
+func (e JourneyTemplateRetired) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e JourneyTemplateRetired) EventType() string { return pl.JourneyTemplateRetired }
+
+func (e JourneyTemplateRetired) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"retiredBy":  e.RetiredBy,
+		"occurredOn": e.OccurredOn,
+	}
+}
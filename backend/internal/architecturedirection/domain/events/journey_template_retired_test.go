@@ -0,0 +1,25 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJourneyTemplateRetired_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	evt := NewJourneyTemplateRetired(JourneyTemplateRetiredFields{
+		ID:        "template-1",
+		RetiredBy: "architect@example.com",
+	})
+
+	assert.Equal(t, "template-1", evt.AggregateID())
+	assert.Equal(t, pl.JourneyTemplateRetired, evt.EventType())
+	assert.Equal(t, "architect@example.com", evt.RetiredBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "template-1", data["id"])
+	assert.Equal(t, "architect@example.com", data["retiredBy"])
+}
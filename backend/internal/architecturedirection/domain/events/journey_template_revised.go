@@ -0,0 +1,60 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// JourneyTemplateRevised carries the complete new version of the template.
+// Journeys seeded from earlier versions keep the milestones they were given.
+type JourneyTemplateRevised struct {
+	domain.BaseEvent
+	ID         string                  `json:"id"`
+	Name       string                  `json:"name"`
+	Milestones []TemplateMilestoneData `json:"milestones"`
+	Version    int                     `json:"version"`
+	RevisedBy  string                  `json:"revisedBy"`
+	OccurredOn time.Time               `json:"occurredOn"`
+}
+
+type JourneyTemplateRevisedFields struct {
+	ID         string
+	Name       string
+	Milestones []TemplateMilestoneData
+	Version    int
+	RevisedBy  string
+}
+
+func NewJourneyTemplateRevised(f JourneyTemplateRevisedFields) JourneyTemplateRevised {
+	return JourneyTemplateRevised{
+		BaseEvent:  domain.NewBaseEvent(f.ID),
+		ID:         f.ID,
+		Name:       f.Name,
+		Milestones: f.Milestones,
+		Version:    f.Version,
+		RevisedBy:  f.RevisedBy,
+		OccurredOn: time.Now().UTC(),
+	}
+}
+
+func (e JourneyTemplateRevised) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e JourneyTemplateRevised) EventType() string { return pl.JourneyTemplateRevised }
+
+func (e JourneyTemplateRevised) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"name":       e.Name,
+		"milestones": templateMilestonesEventData(e.Milestones),
+		"version":    e.Version,
+		"revisedBy":  e.RevisedBy,
+		"occurredOn": e.OccurredOn,
+	}
+}
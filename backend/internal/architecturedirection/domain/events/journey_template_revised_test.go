@@ -0,0 +1,31 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJourneyTemplateRevised_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	evt := NewJourneyTemplateRevised(JourneyTemplateRevisedFields{
+		ID:         "template-1",
+		Name:       "SaaS migration",
+		Milestones: []TemplateMilestoneData{{Label: "Parallel run", OffsetQuarters: 3}},
+		Version:    2,
+		RevisedBy:  "architect@example.com",
+	})
+
+	assert.Equal(t, "template-1", evt.AggregateID())
+	assert.Equal(t, pl.JourneyTemplateRevised, evt.EventType())
+	assert.Equal(t, 2, evt.Version)
+	assert.Equal(t, "architect@example.com", evt.RevisedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "template-1", data["id"])
+	assert.Equal(t, []map[string]interface{}{{"label": "Parallel run", "offsetQuarters": 3}}, data["milestones"])
+	assert.Equal(t, 2, data["version"])
+	assert.Equal(t, "architect@example.com", data["revisedBy"])
+}
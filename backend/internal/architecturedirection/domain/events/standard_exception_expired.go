@@ -0,0 +1,57 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// StandardExceptionExpired records that an exception stopped holding once its
+// expiry date passed, so the non-standard application counts against its
+// capability's standard again.
+type StandardExceptionExpired struct {
+	domain.BaseEvent
+	ID           string    `json:"id"`
+	CapabilityID string    `json:"capabilityId"`
+	ComponentID  string    `json:"componentId"`
+	ExpiresOn    time.Time `json:"expiresOn"`
+	OccurredOn   time.Time `json:"occurredOn"`
+}
+
+type StandardExceptionExpiredFields struct {
+	ID           string
+	CapabilityID string
+	ComponentID  string
+	ExpiresOn    time.Time
+}
+
+func NewStandardExceptionExpired(f StandardExceptionExpiredFields) StandardExceptionExpired {
+	return StandardExceptionExpired{
+		BaseEvent:    domain.NewBaseEvent(f.ID),
+		ID:           f.ID,
+		CapabilityID: f.CapabilityID,
+		ComponentID:  f.ComponentID,
+		ExpiresOn:    f.ExpiresOn,
+		OccurredOn:   time.Now().UTC(),
+	}
+}
+
+func (e StandardExceptionExpired) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e StandardExceptionExpired) EventType() string { return pl.StandardExceptionExpired }
+
+func (e StandardExceptionExpired) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":           e.ID,
+		"capabilityId": e.CapabilityID,
+		"componentId":  e.ComponentID,
+		"expiresOn":    e.ExpiresOn,
+		"occurredOn":   e.OccurredOn,
+	}
+}
@@ -0,0 +1,68 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// StandardExceptionGranted records an approved, time-limited permission for a
+// non-standard application to keep realising a capability.
+type StandardExceptionGranted struct {
+	domain.BaseEvent
+	ID            string    `json:"id"`
+	CapabilityID  string    `json:"capabilityId"`
+	ComponentID   string    `json:"componentId"`
+	Justification string    `json:"justification"`
+	ApproverID    string    `json:"approverId"`
+	ExpiresOn     time.Time `json:"expiresOn"`
+	GrantedBy     string    `json:"grantedBy"`
+	OccurredOn    time.Time `json:"occurredOn"`
+}
+
+type StandardExceptionGrantedFields struct {
+	ID            string
+	CapabilityID  string
+	ComponentID   string
+	Justification string
+	ApproverID    string
+	ExpiresOn     time.Time
+	GrantedBy     string
+}
+
+func NewStandardExceptionGranted(f StandardExceptionGrantedFields) StandardExceptionGranted {
+	return StandardExceptionGranted{
+		BaseEvent:     domain.NewBaseEvent(f.ID),
+		ID:            f.ID,
+		CapabilityID:  f.CapabilityID,
+		ComponentID:   f.ComponentID,
+		Justification: f.Justification,
+		ApproverID:    f.ApproverID,
+		ExpiresOn:     f.ExpiresOn,
+		GrantedBy:     f.GrantedBy,
+		OccurredOn:    time.Now().UTC(),
+	}
+}
+
+func (e StandardExceptionGranted) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e StandardExceptionGranted) EventType() string { return pl.StandardExceptionGranted }
+
+func (e StandardExceptionGranted) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":            e.ID,
+		"capabilityId":  e.CapabilityID,
+		"componentId":   e.ComponentID,
+		"justification": e.Justification,
+		"approverId":    e.ApproverID,
+		"expiresOn":     e.ExpiresOn,
+		"grantedBy":     e.GrantedBy,
+		"occurredOn":    e.OccurredOn,
+	}
+}
@@ -0,0 +1,34 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStandardExceptionGranted_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	expiresOn := time.Date(2027, time.June, 30, 0, 0, 0, 0, time.UTC)
+	evt := NewStandardExceptionGranted(StandardExceptionGrantedFields{
+		ID:            "exception-1",
+		CapabilityID:  "cap-1",
+		ComponentID:   "app-1",
+		Justification: "Contract runs until mid 2027",
+		ApproverID:    "cio@example.com",
+		ExpiresOn:     expiresOn,
+		GrantedBy:     "architect@example.com",
+	})
+
+	assert.Equal(t, "exception-1", evt.AggregateID())
+	assert.Equal(t, pl.StandardExceptionGranted, evt.EventType())
+	assert.Equal(t, "architect@example.com", evt.GrantedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "cap-1", data["capabilityId"])
+	assert.Equal(t, "app-1", data["componentId"])
+	assert.Equal(t, "cio@example.com", data["approverId"])
+	assert.Equal(t, expiresOn, data["expiresOn"])
+}
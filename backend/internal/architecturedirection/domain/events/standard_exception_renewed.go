@@ -0,0 +1,60 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// StandardExceptionRenewed records a fresh approval that moves an exception's
+// expiry date, with the justification for keeping it longer.
+type StandardExceptionRenewed struct {
+	domain.BaseEvent
+	ID            string    `json:"id"`
+	Justification string    `json:"justification"`
+	ApproverID    string    `json:"approverId"`
+	ExpiresOn     time.Time `json:"expiresOn"`
+	RenewedBy     string    `json:"renewedBy"`
+	OccurredOn    time.Time `json:"occurredOn"`
+}
+
+type StandardExceptionRenewedFields struct {
+	ID            string
+	Justification string
+	ApproverID    string
+	ExpiresOn     time.Time
+	RenewedBy     string
+}
+
+func NewStandardExceptionRenewed(f StandardExceptionRenewedFields) StandardExceptionRenewed {
+	return StandardExceptionRenewed{
+		BaseEvent:     domain.NewBaseEvent(f.ID),
+		ID:            f.ID,
+		Justification: f.Justification,
+		ApproverID:    f.ApproverID,
+		ExpiresOn:     f.ExpiresOn,
+		RenewedBy:     f.RenewedBy,
+		OccurredOn:    time.Now().UTC(),
+	}
+}
+
+func (e StandardExceptionRenewed) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e StandardExceptionRenewed) EventType() string { return pl.StandardExceptionRenewed }
+
+func (e StandardExceptionRenewed) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":            e.ID,
+		"justification": e.Justification,
+		"approverId":    e.ApproverID,
+		"expiresOn":     e.ExpiresOn,
+		"renewedBy":     e.RenewedBy,
+		"occurredOn":    e.OccurredOn,
+	}
+}
@@ -0,0 +1,30 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStandardExceptionRenewed_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	expiresOn := time.Date(2027, time.December, 31, 0, 0, 0, 0, time.UTC)
+	evt := NewStandardExceptionRenewed(StandardExceptionRenewedFields{
+		ID:            "exception-1",
+		Justification: "Migration slipped a quarter",
+		ApproverID:    "cio@example.com",
+		ExpiresOn:     expiresOn,
+		RenewedBy:     "architect@example.com",
+	})
+
+	assert.Equal(t, "exception-1", evt.AggregateID())
+	assert.Equal(t, pl.StandardExceptionRenewed, evt.EventType())
+	assert.Equal(t, "architect@example.com", evt.RenewedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "Migration slipped a quarter", data["justification"])
+	assert.Equal(t, expiresOn, data["expiresOn"])
+}
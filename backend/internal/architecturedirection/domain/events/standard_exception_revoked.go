@@ -0,0 +1,46 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type StandardExceptionRevoked struct {
+	domain.BaseEvent
+	ID         string    `json:"id"`
+	RevokedBy  string    `json:"revokedBy"`
+	OccurredOn time.Time `json:"occurredOn"`
+}
+
+type StandardExceptionRevokedFields struct {
+	ID        string
+	RevokedBy string
+}
+
+func NewStandardExceptionRevoked(f StandardExceptionRevokedFields) StandardExceptionRevoked {
+	return StandardExceptionRevoked{
+		BaseEvent:  domain.NewBaseEvent(f.ID),
+		ID:         f.ID,
+		RevokedBy:  f.RevokedBy,
+		OccurredOn: time.Now().UTC(),
+	}
+}
+
+func (e StandardExceptionRevoked) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e StandardExceptionRevoked) EventType() string { return pl.StandardExceptionRevoked }
+
+func (e StandardExceptionRevoked) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"revokedBy":  e.RevokedBy,
+		"occurredOn": e.OccurredOn,
+	}
+}
@@ -0,0 +1,25 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStandardExceptionRevoked_CarriesActorAndTimestamp_Rule13(t *testing.T) {
+	evt := NewStandardExceptionRevoked(StandardExceptionRevokedFields{
+		ID:        "exception-1",
+		RevokedBy: "architect@example.com",
+	})
+
+	assert.Equal(t, "exception-1", evt.AggregateID())
+	assert.Equal(t, pl.StandardExceptionRevoked, evt.EventType())
+	assert.Equal(t, "architect@example.com", evt.RevokedBy)
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "exception-1", data["id"])
+	assert.Equal(t, "architect@example.com", data["revokedBy"])
+}
@@ -0,0 +1,69 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// TimeSuggestionRejected records that an architect dismissed a computed TIME
+// suggestion for a realisation. EvidenceFingerprint pins the scores the
+// suggestion was based on, so the rejection lapses once they change.
+type TimeSuggestionRejected struct {
+	domain.BaseEvent
+	ID                  string    `json:"id"`
+	CapabilityID        string    `json:"capabilityId"`
+	ComponentID         string    `json:"componentId"`
+	SuggestedGrade      string    `json:"suggestedGrade"`
+	EvidenceFingerprint string    `json:"evidenceFingerprint"`
+	Reason              string    `json:"reason"`
+	RejectedBy          string    `json:"rejectedBy"`
+	OccurredOn          time.Time `json:"occurredOn"`
+}
+
+type TimeSuggestionRejectedFields struct {
+	ID                  string
+	CapabilityID        string
+	ComponentID         string
+	SuggestedGrade      string
+	EvidenceFingerprint string
+	Reason              string
+	RejectedBy          string
+}
+
+func NewTimeSuggestionRejected(f TimeSuggestionRejectedFields) TimeSuggestionRejected {
+	return TimeSuggestionRejected{
+		BaseEvent:           domain.NewBaseEvent(f.ID),
+		ID:                  f.ID,
+		CapabilityID:        f.CapabilityID,
+		ComponentID:         f.ComponentID,
+		SuggestedGrade:      f.SuggestedGrade,
+		EvidenceFingerprint: f.EvidenceFingerprint,
+		Reason:              f.Reason,
+		RejectedBy:          f.RejectedBy,
+		OccurredOn:          time.Now().UTC(),
+	}
+}
+
+func (e TimeSuggestionRejected) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e TimeSuggestionRejected) EventType() string { return pl.TimeSuggestionRejected }
+
+func (e TimeSuggestionRejected) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":                  e.ID,
+		"capabilityId":        e.CapabilityID,
+		"componentId":         e.ComponentID,
+		"suggestedGrade":      e.SuggestedGrade,
+		"evidenceFingerprint": e.EvidenceFingerprint,
+		"reason":              e.Reason,
+		"rejectedBy":          e.RejectedBy,
+		"occurredOn":          e.OccurredOn,
+	}
+}
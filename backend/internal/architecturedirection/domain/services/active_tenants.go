@@ -0,0 +1,7 @@
+package services
+
+import "context"
+
+// ActiveTenants returns the IDs of the tenants whose data background work,
+// such as the exception expiry sweep, should visit.
+type ActiveTenants func(ctx context.Context) ([]string, error)
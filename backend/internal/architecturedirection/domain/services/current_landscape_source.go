@@ -0,0 +1,25 @@
+package services
+
+import "context"
+
+// LandscapeApplication is an application that directly realises a capability
+// today.
+type LandscapeApplication struct {
+	ID   string
+	Name string
+}
+
+// LandscapeCapability is one domain capability as capability mapping holds it
+// today: where it sits and which applications directly realise it.
+type LandscapeCapability struct {
+	ID                 string
+	Name               string
+	ParentID           string
+	BusinessDomainID   string
+	BusinessDomainName string
+	Applications       []LandscapeApplication
+}
+
+type CurrentLandscapeSource interface {
+	GetCurrentLandscape(ctx context.Context) ([]LandscapeCapability, error)
+}
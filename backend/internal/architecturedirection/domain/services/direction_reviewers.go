@@ -0,0 +1,15 @@
+package services
+
+import "context"
+
+// CapabilityDomainArchitects returns the architects of the business domain a
+// capability is effectively placed in, or none when it is not in a domain.
+type CapabilityDomainArchitects func(ctx context.Context, capabilityID string) ([]string, error)
+
+// ActiveUser reports whether the ID belongs to an active user of the tenant,
+// the only people who can sign in to review a proposal.
+type ActiveUser func(ctx context.Context, userID string) (bool, error)
+
+// TenantAdmins returns the active administrators of the tenant, who review a
+// proposal when its source domains have no other architect.
+type TenantAdmins func(ctx context.Context) ([]string, error)
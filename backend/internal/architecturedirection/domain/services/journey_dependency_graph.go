@@ -0,0 +1,31 @@
+package services
+
+import "errors"
+
+var ErrJourneyDependencyCycle = errors.New("journey dependency would create a cycle")
+
+// JourneyDependencyGraph maps each journey to the journeys it depends on.
+type JourneyDependencyGraph map[string][]string
+
+// CreatesCycle reports whether making successorID depend on predecessorID would
+// close a loop, i.e. whether successorID is already upstream of predecessorID.
+func (g JourneyDependencyGraph) CreatesCycle(successorID, predecessorID string) bool {
+	if successorID == predecessorID {
+		return true
+	}
+	visited := map[string]bool{}
+	pending := []string{predecessorID}
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if current == successorID {
+			return true
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		pending = append(pending, g[current]...)
+	}
+	return false
+}
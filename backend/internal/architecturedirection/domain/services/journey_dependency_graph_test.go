@@ -0,0 +1,40 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJourneyDependencyGraph_CreatesCycle(t *testing.T) {
+	graph := JourneyDependencyGraph{
+		"c": {"b"},
+		"b": {"a"},
+		"d": {"a"},
+	}
+
+	cases := []struct {
+		name        string
+		successor   string
+		predecessor string
+		want        bool
+	}{
+		{"self dependency", "a", "a", true},
+		{"direct back edge", "a", "b", true},
+		{"transitive back edge", "a", "c", true},
+		{"parallel branch", "d", "b", false},
+		{"new root", "e", "c", false},
+		{"downstream edge", "c", "d", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, graph.CreatesCycle(tc.successor, tc.predecessor))
+		})
+	}
+}
+
+func TestJourneyDependencyGraph_ToleratesExistingLoops(t *testing.T) {
+	graph := JourneyDependencyGraph{"a": {"b"}, "b": {"a"}}
+
+	assert.False(t, graph.CreatesCycle("x", "a"))
+}
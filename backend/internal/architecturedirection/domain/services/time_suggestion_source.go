@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PillarEvidence is one strategy pillar's contribution to a TIME suggestion:
+// the capability's importance on the pillar against the application's fit.
+type PillarEvidence struct {
+	PillarID   string
+	PillarName string
+	FitType    string
+	Importance int
+	FitScore   int
+	Gap        float64
+}
+
+// TimeSuggestion is a TIME grade computed from fit gaps for a direct
+// realisation. SuggestedGrade is empty when there is too little evidence.
+type TimeSuggestion struct {
+	CapabilityID   string
+	CapabilityName string
+	ComponentID    string
+	ComponentName  string
+	SuggestedGrade string
+	Confidence     string
+	TechnicalGap   *float64
+	FunctionalGap  *float64
+	Evidence       []PillarEvidence
+}
+
+type TimeSuggestionSource interface {
+	GetAllSuggestions(ctx context.Context) ([]TimeSuggestion, error)
+}
+
+// Fingerprint identifies the scores a suggestion was computed from. It is
+// independent of pillar names and evidence order, so it only changes when a
+// score, a pillar's fit type or the suggested grade itself changes.
+func (s TimeSuggestion) Fingerprint() string {
+	parts := make([]string, len(s.Evidence))
+	for i, e := range s.Evidence {
+		parts[i] = fmt.Sprintf("%s:%s:%d:%d", e.PillarID, e.FitType, e.Importance, e.FitScore)
+	}
+	sort.Strings(parts)
+	sum := sha256.Sum256([]byte(s.SuggestedGrade + "|" + strings.Join(parts, ",")))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeSuggestion_Fingerprint(t *testing.T) {
+	base := TimeSuggestion{
+		SuggestedGrade: "Migrate",
+		Evidence: []PillarEvidence{
+			{PillarID: "p-tech", PillarName: "Technology", FitType: "TECHNICAL", Importance: 4, FitScore: 1, Gap: 3},
+			{PillarID: "p-func", PillarName: "Function", FitType: "FUNCTIONAL", Importance: 3, FitScore: 3, Gap: 0},
+		},
+	}
+
+	reordered := base
+	reordered.Evidence = []PillarEvidence{base.Evidence[1], base.Evidence[0]}
+	renamed := withEvidence(base, 0, func(e *PillarEvidence) { e.PillarName = "Tech stack" })
+	rescored := withEvidence(base, 0, func(e *PillarEvidence) { e.FitScore = 2 })
+	regraded := base
+	regraded.SuggestedGrade = "Eliminate"
+
+	assert.Equal(t, base.Fingerprint(), reordered.Fingerprint(), "evidence order must not matter")
+	assert.Equal(t, base.Fingerprint(), renamed.Fingerprint(), "pillar names must not matter")
+	assert.NotEqual(t, base.Fingerprint(), rescored.Fingerprint(), "a changed score must change the fingerprint")
+	assert.NotEqual(t, base.Fingerprint(), regraded.Fingerprint(), "a changed grade must change the fingerprint")
+}
+
+func withEvidence(s TimeSuggestion, i int, change func(*PillarEvidence)) TimeSuggestion {
+	s.Evidence = append([]PillarEvidence(nil), s.Evidence...)
+	change(&s.Evidence[i])
+	return s
+}
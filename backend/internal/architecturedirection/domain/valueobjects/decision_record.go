@@ -0,0 +1,58 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const MaxDecisionRecordSectionLength = 4000
+
+var ErrDecisionRecordSectionTooLong = errors.New("decision record sections cannot exceed 4000 characters")
+
+// DecisionRecord is the decision a direction is agreed with: the context that
+// forced it, the decision itself and its consequences. Empty sections are
+// filled in by the direction from its narrative. The direction only hands it
+// on; the decision records context keeps it as an accepted decision record.
+type DecisionRecord struct {
+	context      string
+	decision     string
+	consequences string
+}
+
+func NewDecisionRecord(context, decision, consequences string) (DecisionRecord, error) {
+	record := DecisionRecord{
+		context:      strings.TrimSpace(context),
+		decision:     strings.TrimSpace(decision),
+		consequences: strings.TrimSpace(consequences),
+	}
+	for _, section := range []string{record.context, record.decision, record.consequences} {
+		if len(section) > MaxDecisionRecordSectionLength {
+			return DecisionRecord{}, ErrDecisionRecordSectionTooLong
+		}
+	}
+	return record, nil
+}
+
+func (r DecisionRecord) Context() string      { return r.context }
+func (r DecisionRecord) Decision() string     { return r.decision }
+func (r DecisionRecord) Consequences() string { return r.consequences }
+
+// WithDefaults fills empty context and decision sections.
+func (r DecisionRecord) WithDefaults(context, decision string) DecisionRecord {
+	if r.context == "" {
+		r.context = context
+	}
+	if r.decision == "" {
+		r.decision = decision
+	}
+	return r
+}
+
+func (r DecisionRecord) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(DecisionRecord); ok {
+		return r == o
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package valueobjects
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecisionRecord_TrimsSections(t *testing.T) {
+	record, err := NewDecisionRecord(" why ", " what ", " so what ")
+	require.NoError(t, err)
+	assert.Equal(t, "why", record.Context())
+	assert.Equal(t, "what", record.Decision())
+	assert.Equal(t, "so what", record.Consequences())
+}
+
+func TestNewDecisionRecord_SectionTooLong(t *testing.T) {
+	_, err := NewDecisionRecord("", "", strings.Repeat("x", MaxDecisionRecordSectionLength+1))
+	assert.ErrorIs(t, err, ErrDecisionRecordSectionTooLong)
+}
+
+func TestDecisionRecord_WithDefaultsOnlyFillsEmptySections(t *testing.T) {
+	record, err := NewDecisionRecord("", "Keep both", "")
+	require.NoError(t, err)
+	filled := record.WithDefaults("narrative", "default decision")
+	assert.Equal(t, "narrative", filled.Context())
+	assert.Equal(t, "Keep both", filled.Decision())
+	assert.Empty(t, filled.Consequences())
+}
@@ -0,0 +1,37 @@
+package valueobjects
+
+import (
+	"errors"
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var ErrExceptionExpiryRequired = errors.New("an exception needs an expiry date")
+
+// ExceptionExpiry is the last day, in UTC, on which an exception still holds.
+type ExceptionExpiry struct {
+	date time.Time
+}
+
+func NewExceptionExpiry(date time.Time) (ExceptionExpiry, error) {
+	if date.IsZero() {
+		return ExceptionExpiry{}, ErrExceptionExpiryRequired
+	}
+	utc := date.UTC()
+	return ExceptionExpiry{date: time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)}, nil
+}
+
+func (e ExceptionExpiry) Date() time.Time { return e.date }
+
+// HasPassed reports whether the exception no longer holds at the given moment.
+func (e ExceptionExpiry) HasPassed(now time.Time) bool {
+	return !now.UTC().Before(e.date.AddDate(0, 0, 1))
+}
+
+func (e ExceptionExpiry) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(ExceptionExpiry); ok {
+		return e.date.Equal(o.date)
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package valueobjects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExceptionExpiry_TruncatesToDate(t *testing.T) {
+	e, err := NewExceptionExpiry(time.Date(2027, time.March, 31, 17, 45, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2027, time.March, 31, 0, 0, 0, 0, time.UTC), e.Date())
+}
+
+func TestNewExceptionExpiry_Zero_Rejected(t *testing.T) {
+	_, err := NewExceptionExpiry(time.Time{})
+	assert.ErrorIs(t, err, ErrExceptionExpiryRequired)
+}
+
+func TestExceptionExpiry_HoldsThroughItsLastDay(t *testing.T) {
+	e, err := NewExceptionExpiry(time.Date(2027, time.March, 31, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	assert.False(t, e.HasPassed(time.Date(2027, time.March, 31, 23, 59, 0, 0, time.UTC)))
+	assert.True(t, e.HasPassed(time.Date(2027, time.April, 1, 0, 0, 0, 0, time.UTC)))
+}
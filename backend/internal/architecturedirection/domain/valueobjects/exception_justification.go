@@ -0,0 +1,41 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const MaxExceptionJustificationLength = 2000
+
+var (
+	ErrExceptionJustificationRequired = errors.New("an exception needs a justification")
+	ErrExceptionJustificationTooLong  = errors.New("exception justification exceeds maximum length of 2000 characters")
+)
+
+// ExceptionJustification explains why a non-standard application may keep
+// realising a capability for the time being.
+type ExceptionJustification struct {
+	value string
+}
+
+func NewExceptionJustification(value string) (ExceptionJustification, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return ExceptionJustification{}, ErrExceptionJustificationRequired
+	}
+	if len(trimmed) > MaxExceptionJustificationLength {
+		return ExceptionJustification{}, ErrExceptionJustificationTooLong
+	}
+	return ExceptionJustification{value: trimmed}, nil
+}
+
+func (j ExceptionJustification) Value() string { return j.value }
+
+func (j ExceptionJustification) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(ExceptionJustification); ok {
+		return j.value == o.value
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package valueobjects
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExceptionJustification_TrimsWhitespace(t *testing.T) {
+	j, err := NewExceptionJustification("  Vendor contract runs until 2027  ")
+	require.NoError(t, err)
+	assert.Equal(t, "Vendor contract runs until 2027", j.Value())
+}
+
+func TestNewExceptionJustification_Empty_Rejected(t *testing.T) {
+	_, err := NewExceptionJustification("   ")
+	assert.ErrorIs(t, err, ErrExceptionJustificationRequired)
+}
+
+func TestNewExceptionJustification_TooLong_Rejected(t *testing.T) {
+	_, err := NewExceptionJustification(strings.Repeat("a", 2001))
+	assert.ErrorIs(t, err, ErrExceptionJustificationTooLong)
+}
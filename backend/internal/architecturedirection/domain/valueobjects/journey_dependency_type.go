@@ -0,0 +1,51 @@
+package valueobjects
+
+import (
+	"errors"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var ErrInvalidJourneyDependencyType = errors.New("journey dependency type must be one of finish-to-start, start-to-start")
+
+const (
+	JourneyDependencyFinishToStart = "finish-to-start"
+	JourneyDependencyStartToStart  = "start-to-start"
+)
+
+// JourneyDependencyType says which transition of the predecessor unblocks the
+// dependent journey: finish-to-start waits for done, start-to-start only for in-flight.
+type JourneyDependencyType struct {
+	value string
+}
+
+func NewJourneyDependencyType(value string) (JourneyDependencyType, error) {
+	switch value {
+	case JourneyDependencyFinishToStart, JourneyDependencyStartToStart:
+		return JourneyDependencyType{value: value}, nil
+	default:
+		return JourneyDependencyType{}, ErrInvalidJourneyDependencyType
+	}
+}
+
+func (t JourneyDependencyType) Value() string { return t.value }
+
+// SatisfiedBy reports whether a predecessor in the given status no longer blocks.
+// An abandoned predecessor never satisfies a dependency.
+func (t JourneyDependencyType) SatisfiedBy(predecessor JourneyStatus) bool {
+	switch predecessor.Value() {
+	case JourneyStatusDone:
+		return true
+	case JourneyStatusInFlight:
+		return t.value == JourneyDependencyStartToStart
+	default:
+		return false
+	}
+}
+
+func (t JourneyDependencyType) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(JourneyDependencyType); ok {
+		return t.value == o.value
+	}
+	return false
+}
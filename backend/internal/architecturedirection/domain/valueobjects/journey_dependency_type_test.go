@@ -0,0 +1,55 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJourneyDependencyType_AllValid(t *testing.T) {
+	for _, v := range []string{"finish-to-start", "start-to-start"} {
+		d, err := NewJourneyDependencyType(v)
+		require.NoError(t, err)
+		assert.Equal(t, v, d.Value())
+	}
+}
+
+func TestNewJourneyDependencyType_Invalid(t *testing.T) {
+	_, err := NewJourneyDependencyType("finish-to-finish")
+	assert.ErrorIs(t, err, ErrInvalidJourneyDependencyType)
+}
+
+func TestJourneyDependencyType_SatisfiedBy(t *testing.T) {
+	cases := []struct {
+		depType   string
+		status    string
+		satisfied bool
+	}{
+		{JourneyDependencyFinishToStart, JourneyStatusPlanned, false},
+		{JourneyDependencyFinishToStart, JourneyStatusInFlight, false},
+		{JourneyDependencyFinishToStart, JourneyStatusDone, true},
+		{JourneyDependencyFinishToStart, JourneyStatusAbandoned, false},
+		{JourneyDependencyStartToStart, JourneyStatusPlanned, false},
+		{JourneyDependencyStartToStart, JourneyStatusInFlight, true},
+		{JourneyDependencyStartToStart, JourneyStatusDone, true},
+		{JourneyDependencyStartToStart, JourneyStatusAbandoned, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.depType+"/"+tc.status, func(t *testing.T) {
+			depType, err := NewJourneyDependencyType(tc.depType)
+			require.NoError(t, err)
+			status, err := NewJourneyStatus(tc.status)
+			require.NoError(t, err)
+			assert.Equal(t, tc.satisfied, depType.SatisfiedBy(status))
+		})
+	}
+}
+
+func TestJourneyDependencyType_Equals(t *testing.T) {
+	a, _ := NewJourneyDependencyType("finish-to-start")
+	b, _ := NewJourneyDependencyType("finish-to-start")
+	c, _ := NewJourneyDependencyType("start-to-start")
+	assert.True(t, a.Equals(b))
+	assert.False(t, a.Equals(c))
+}
@@ -0,0 +1,29 @@
+package valueobjects
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+type JourneyProgrammeID struct {
+	sharedvo.UUIDValue
+}
+
+func NewJourneyProgrammeID() JourneyProgrammeID {
+	return JourneyProgrammeID{UUIDValue: sharedvo.NewUUIDValue()}
+}
+
+func NewJourneyProgrammeIDFromString(value string) (JourneyProgrammeID, error) {
+	uuidValue, err := sharedvo.NewUUIDValueFromString(value)
+	if err != nil {
+		return JourneyProgrammeID{}, err
+	}
+	return JourneyProgrammeID{UUIDValue: uuidValue}, nil
+}
+
+func (i JourneyProgrammeID) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(JourneyProgrammeID); ok {
+		return i.EqualsValue(o.UUIDValue)
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJourneyProgrammeID_GeneratesUniqueValue(t *testing.T) {
+	a := NewJourneyProgrammeID()
+	b := NewJourneyProgrammeID()
+	assert.NotEmpty(t, a.Value())
+	assert.NotEqual(t, a.Value(), b.Value())
+}
+
+func TestNewJourneyProgrammeIDFromString_Valid(t *testing.T) {
+	id := uuid.New().String()
+	programmeID, err := NewJourneyProgrammeIDFromString(id)
+	require.NoError(t, err)
+	assert.Equal(t, id, programmeID.Value())
+}
+
+func TestNewJourneyProgrammeIDFromString_Invalid(t *testing.T) {
+	_, err := NewJourneyProgrammeIDFromString("not-a-uuid")
+	assert.Error(t, err)
+}
+
+func TestJourneyProgrammeID_Equals(t *testing.T) {
+	id := uuid.New().String()
+	a, _ := NewJourneyProgrammeIDFromString(id)
+	b, _ := NewJourneyProgrammeIDFromString(id)
+	c := NewJourneyProgrammeID()
+	assert.True(t, a.Equals(b))
+	assert.False(t, a.Equals(c))
+}
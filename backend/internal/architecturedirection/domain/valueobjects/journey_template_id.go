@@ -0,0 +1,29 @@
+package valueobjects
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+type JourneyTemplateID struct {
+	sharedvo.UUIDValue
+}
+
+func NewJourneyTemplateID() JourneyTemplateID {
+	return JourneyTemplateID{UUIDValue: sharedvo.NewUUIDValue()}
+}
+
+func NewJourneyTemplateIDFromString(value string) (JourneyTemplateID, error) {
+	uuidValue, err := sharedvo.NewUUIDValueFromString(value)
+	if err != nil {
+		return JourneyTemplateID{}, err
+	}
+	return JourneyTemplateID{UUIDValue: uuidValue}, nil
+}
+
+func (i JourneyTemplateID) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(JourneyTemplateID); ok {
+		return i.EqualsValue(o.UUIDValue)
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJourneyTemplateID_GeneratesUniqueValue(t *testing.T) {
+	a := NewJourneyTemplateID()
+	b := NewJourneyTemplateID()
+	assert.NotEmpty(t, a.Value())
+	assert.NotEqual(t, a.Value(), b.Value())
+}
+
+func TestNewJourneyTemplateIDFromString_Valid(t *testing.T) {
+	id := uuid.New().String()
+	templateID, err := NewJourneyTemplateIDFromString(id)
+	require.NoError(t, err)
+	assert.Equal(t, id, templateID.Value())
+}
+
+func TestNewJourneyTemplateIDFromString_Invalid(t *testing.T) {
+	_, err := NewJourneyTemplateIDFromString("not-a-uuid")
+	assert.Error(t, err)
+}
+
+func TestJourneyTemplateID_Equals(t *testing.T) {
+	id := uuid.New().String()
+	a, _ := NewJourneyTemplateIDFromString(id)
+	b, _ := NewJourneyTemplateIDFromString(id)
+	c := NewJourneyTemplateID()
+	assert.True(t, a.Equals(b))
+	assert.False(t, a.Equals(c))
+}
@@ -0,0 +1,39 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const MaxJourneyTemplateNameLength = 200
+
+var (
+	ErrJourneyTemplateNameRequired = errors.New("journey template name is required")
+	ErrJourneyTemplateNameTooLong  = errors.New("journey template name exceeds maximum length of 200 characters")
+)
+
+type JourneyTemplateName struct {
+	value string
+}
+
+func NewJourneyTemplateName(value string) (JourneyTemplateName, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return JourneyTemplateName{}, ErrJourneyTemplateNameRequired
+	}
+	if len(trimmed) > MaxJourneyTemplateNameLength {
+		return JourneyTemplateName{}, ErrJourneyTemplateNameTooLong
+	}
+	return JourneyTemplateName{value: trimmed}, nil
+}
+
+func (n JourneyTemplateName) Value() string { return n.value }
+
+func (n JourneyTemplateName) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(JourneyTemplateName); ok {
+		return n.value == o.value
+	}
+	return false
+}
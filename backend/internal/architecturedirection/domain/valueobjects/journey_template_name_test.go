@@ -0,0 +1,30 @@
+package valueobjects
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJourneyTemplateName_TrimsWhitespace(t *testing.T) {
+	n, err := NewJourneyTemplateName("  SaaS migration  ")
+	require.NoError(t, err)
+	assert.Equal(t, "SaaS migration", n.Value())
+}
+
+func TestNewJourneyTemplateName_Empty_Rejected(t *testing.T) {
+	_, err := NewJourneyTemplateName("   ")
+	assert.ErrorIs(t, err, ErrJourneyTemplateNameRequired)
+}
+
+func TestNewJourneyTemplateName_TooLong_Rejected(t *testing.T) {
+	_, err := NewJourneyTemplateName(strings.Repeat("a", 201))
+	assert.ErrorIs(t, err, ErrJourneyTemplateNameTooLong)
+}
+
+func TestNewJourneyTemplateName_MaxLength_Accepted(t *testing.T) {
+	_, err := NewJourneyTemplateName(strings.Repeat("a", 200))
+	assert.NoError(t, err)
+}
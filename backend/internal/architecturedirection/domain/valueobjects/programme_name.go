@@ -0,0 +1,39 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const MaxProgrammeNameLength = 200
+
+var (
+	ErrProgrammeNameRequired = errors.New("programme name is required")
+	ErrProgrammeNameTooLong  = errors.New("programme name exceeds maximum length of 200 characters")
+)
+
+type ProgrammeName struct {
+	value string
+}
+
+func NewProgrammeName(value string) (ProgrammeName, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return ProgrammeName{}, ErrProgrammeNameRequired
+	}
+	if len(trimmed) > MaxProgrammeNameLength {
+		return ProgrammeName{}, ErrProgrammeNameTooLong
+	}
+	return ProgrammeName{value: trimmed}, nil
+}
+
+func (n ProgrammeName) Value() string { return n.value }
+
+func (n ProgrammeName) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(ProgrammeName); ok {
+		return n.value == o.value
+	}
+	return false
+}
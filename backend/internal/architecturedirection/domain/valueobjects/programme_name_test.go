@@ -0,0 +1,30 @@
+package valueobjects
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProgrammeName_TrimsWhitespace(t *testing.T) {
+	n, err := NewProgrammeName("  ERP consolidation  ")
+	require.NoError(t, err)
+	assert.Equal(t, "ERP consolidation", n.Value())
+}
+
+func TestNewProgrammeName_Empty_Rejected(t *testing.T) {
+	_, err := NewProgrammeName("   ")
+	assert.ErrorIs(t, err, ErrProgrammeNameRequired)
+}
+
+func TestNewProgrammeName_TooLong_Rejected(t *testing.T) {
+	_, err := NewProgrammeName(strings.Repeat("a", 201))
+	assert.ErrorIs(t, err, ErrProgrammeNameTooLong)
+}
+
+func TestNewProgrammeName_MaxLength_Accepted(t *testing.T) {
+	_, err := NewProgrammeName(strings.Repeat("a", 200))
+	assert.NoError(t, err)
+}
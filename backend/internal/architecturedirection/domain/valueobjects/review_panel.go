@@ -0,0 +1,70 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var (
+	ErrReviewersRequired   = errors.New("a proposal must name at least one reviewer")
+	ErrInvalidReviewQuorum = errors.New("review quorum must be between 1 and the number of reviewers")
+)
+
+// ReviewPanel is the set of users whose review a proposed direction needs and
+// the number of approvals that make a quorum. A zero quorum means every
+// reviewer must approve.
+type ReviewPanel struct {
+	reviewers []string
+	quorum    int
+}
+
+func NewReviewPanel(reviewers []string, quorum int) (ReviewPanel, error) {
+	seen := make(map[string]bool, len(reviewers))
+	unique := make([]string, 0, len(reviewers))
+	for _, id := range reviewers {
+		trimmed := strings.TrimSpace(id)
+		if trimmed == "" || seen[trimmed] {
+			continue
+		}
+		seen[trimmed] = true
+		unique = append(unique, trimmed)
+	}
+	if len(unique) == 0 {
+		return ReviewPanel{}, ErrReviewersRequired
+	}
+	if quorum == 0 {
+		quorum = len(unique)
+	}
+	if quorum < 1 || quorum > len(unique) {
+		return ReviewPanel{}, ErrInvalidReviewQuorum
+	}
+	return ReviewPanel{reviewers: unique, quorum: quorum}, nil
+}
+
+func (p ReviewPanel) Reviewers() []string { return append([]string(nil), p.reviewers...) }
+func (p ReviewPanel) Quorum() int         { return p.quorum }
+func (p ReviewPanel) IsEmpty() bool       { return len(p.reviewers) == 0 }
+
+func (p ReviewPanel) Includes(reviewerID string) bool {
+	for _, id := range p.reviewers {
+		if id == reviewerID {
+			return true
+		}
+	}
+	return false
+}
+
+func (p ReviewPanel) Equals(other domain.ValueObject) bool {
+	o, ok := other.(ReviewPanel)
+	if !ok || p.quorum != o.quorum || len(p.reviewers) != len(o.reviewers) {
+		return false
+	}
+	for i := range p.reviewers {
+		if p.reviewers[i] != o.reviewers[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,35 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReviewPanel_DeduplicatesReviewersAndDefaultsToUnanimous(t *testing.T) {
+	panel, err := NewReviewPanel([]string{"u1", " u2 ", "u1", ""}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u1", "u2"}, panel.Reviewers())
+	assert.Equal(t, 2, panel.Quorum())
+	assert.True(t, panel.Includes("u2"))
+	assert.False(t, panel.Includes("u3"))
+}
+
+func TestNewReviewPanel_ExplicitQuorum(t *testing.T) {
+	panel, err := NewReviewPanel([]string{"u1", "u2", "u3"}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, panel.Quorum())
+}
+
+func TestNewReviewPanel_RequiresReviewers(t *testing.T) {
+	_, err := NewReviewPanel([]string{" "}, 0)
+	assert.ErrorIs(t, err, ErrReviewersRequired)
+}
+
+func TestNewReviewPanel_QuorumOutOfRange(t *testing.T) {
+	for _, quorum := range []int{-1, 3} {
+		_, err := NewReviewPanel([]string{"u1", "u2"}, quorum)
+		assert.ErrorIs(t, err, ErrInvalidReviewQuorum, "quorum %d", quorum)
+	}
+}
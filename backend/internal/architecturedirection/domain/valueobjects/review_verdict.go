@@ -0,0 +1,38 @@
+package valueobjects
+
+import (
+	"errors"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var ErrInvalidReviewVerdict = errors.New("review verdict must be one of approve, object")
+
+const (
+	ReviewVerdictApprove = "approve"
+	ReviewVerdictObject  = "object"
+)
+
+type ReviewVerdict struct {
+	value string
+}
+
+func NewReviewVerdict(value string) (ReviewVerdict, error) {
+	switch value {
+	case ReviewVerdictApprove, ReviewVerdictObject:
+		return ReviewVerdict{value: value}, nil
+	default:
+		return ReviewVerdict{}, ErrInvalidReviewVerdict
+	}
+}
+
+func (v ReviewVerdict) Value() string     { return v.value }
+func (v ReviewVerdict) IsApproval() bool  { return v.value == ReviewVerdictApprove }
+func (v ReviewVerdict) IsObjection() bool { return v.value == ReviewVerdictObject }
+
+func (v ReviewVerdict) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(ReviewVerdict); ok {
+		return v.value == o.value
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReviewVerdict_AllValid(t *testing.T) {
+	approve, err := NewReviewVerdict("approve")
+	require.NoError(t, err)
+	assert.True(t, approve.IsApproval())
+	assert.False(t, approve.IsObjection())
+
+	object, err := NewReviewVerdict("object")
+	require.NoError(t, err)
+	assert.True(t, object.IsObjection())
+	assert.False(t, object.IsApproval())
+}
+
+func TestNewReviewVerdict_Invalid(t *testing.T) {
+	_, err := NewReviewVerdict("abstain")
+	assert.ErrorIs(t, err, ErrInvalidReviewVerdict)
+}
@@ -0,0 +1,29 @@
+package valueobjects
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+type StandardExceptionID struct {
+	sharedvo.UUIDValue
+}
+
+func NewStandardExceptionID() StandardExceptionID {
+	return StandardExceptionID{UUIDValue: sharedvo.NewUUIDValue()}
+}
+
+func NewStandardExceptionIDFromString(value string) (StandardExceptionID, error) {
+	uuidValue, err := sharedvo.NewUUIDValueFromString(value)
+	if err != nil {
+		return StandardExceptionID{}, err
+	}
+	return StandardExceptionID{UUIDValue: uuidValue}, nil
+}
+
+func (i StandardExceptionID) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(StandardExceptionID); ok {
+		return i.EqualsValue(o.UUIDValue)
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStandardExceptionID_GeneratesUniqueValue(t *testing.T) {
+	a := NewStandardExceptionID()
+	b := NewStandardExceptionID()
+	assert.NotEmpty(t, a.Value())
+	assert.NotEqual(t, a.Value(), b.Value())
+}
+
+func TestNewStandardExceptionIDFromString_Valid(t *testing.T) {
+	id := uuid.New().String()
+	exceptionID, err := NewStandardExceptionIDFromString(id)
+	require.NoError(t, err)
+	assert.Equal(t, id, exceptionID.Value())
+}
+
+func TestNewStandardExceptionIDFromString_Invalid(t *testing.T) {
+	_, err := NewStandardExceptionIDFromString("not-a-uuid")
+	assert.Error(t, err)
+}
+
+func TestStandardExceptionID_Equals(t *testing.T) {
+	id := uuid.New().String()
+	a, _ := NewStandardExceptionIDFromString(id)
+	b, _ := NewStandardExceptionIDFromString(id)
+	c := NewStandardExceptionID()
+	assert.True(t, a.Equals(b))
+	assert.False(t, a.Equals(c))
+}
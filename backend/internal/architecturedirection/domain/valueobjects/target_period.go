@@ -2,6 +2,7 @@ package valueobjects
 
 import (
 	"errors"
+	"time"
 
 	domain "easi/backend/internal/shared/eventsourcing"
 )
@@ -29,6 +30,28 @@ func NewTargetPeriod(year, quarter int) (TargetPeriod, error) {
 func (p TargetPeriod) Year() int    { return p.year }
 func (p TargetPeriod) Quarter() int { return p.quarter }
 
+// StartDate is the first day of the quarter, in UTC.
+func (p TargetPeriod) StartDate() time.Time {
+	return time.Date(p.year, time.Month((p.quarter-1)*3+1), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// EndDate is the last day of the quarter, in UTC.
+func (p TargetPeriod) EndDate() time.Time {
+	return p.StartDate().AddDate(0, 3, -1)
+}
+
+// TargetPeriodContaining is the quarter the given instant falls in, in UTC.
+func TargetPeriodContaining(t time.Time) (TargetPeriod, error) {
+	utc := t.UTC()
+	return NewTargetPeriod(utc.Year(), int(utc.Month()-1)/3+1)
+}
+
+// AddQuarters is the quarter n quarters after this one; negative n counts back.
+func (p TargetPeriod) AddQuarters(n int) (TargetPeriod, error) {
+	ordinal := p.year*4 + p.quarter - 1 + n
+	return NewTargetPeriod(ordinal/4, ordinal%4+1)
+}
+
 func (p TargetPeriod) Before(other TargetPeriod) bool {
 	if p.year != other.year {
 		return p.year < other.year
@@ -2,6 +2,7 @@ package valueobjects
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -50,3 +51,44 @@ func TestTargetPeriod_Equals(t *testing.T) {
 	assert.True(t, a.Equals(b))
 	assert.False(t, a.Equals(c))
 }
+
+func TestTargetPeriod_QuarterBounds(t *testing.T) {
+	q1, _ := NewTargetPeriod(2027, 1)
+	q4, _ := NewTargetPeriod(2026, 4)
+
+	assert.Equal(t, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), q1.StartDate())
+	assert.Equal(t, time.Date(2027, time.March, 31, 0, 0, 0, 0, time.UTC), q1.EndDate())
+	assert.Equal(t, time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), q4.StartDate())
+	assert.Equal(t, time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC), q4.EndDate())
+}
+
+func TestTargetPeriodContaining_UsesQuarterOfInstant(t *testing.T) {
+	p, err := TargetPeriodContaining(time.Date(2026, time.May, 15, 9, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, 2026, p.Year())
+	assert.Equal(t, 2, p.Quarter())
+}
+
+func TestTargetPeriod_AddQuarters_RollsOverYears(t *testing.T) {
+	q3, _ := NewTargetPeriod(2026, 3)
+
+	later, err := q3.AddQuarters(6)
+	require.NoError(t, err)
+	assert.Equal(t, 2028, later.Year())
+	assert.Equal(t, 1, later.Quarter())
+
+	same, err := q3.AddQuarters(0)
+	require.NoError(t, err)
+	assert.True(t, same.Equals(q3))
+
+	earlier, err := q3.AddQuarters(-3)
+	require.NoError(t, err)
+	assert.Equal(t, 2025, earlier.Year())
+	assert.Equal(t, 4, earlier.Quarter())
+}
+
+func TestTargetPeriod_AddQuarters_BeyondSupportedYears_Rejected(t *testing.T) {
+	last, _ := NewTargetPeriod(2100, 4)
+	_, err := last.AddQuarters(1)
+	assert.ErrorIs(t, err, ErrInvalidTargetPeriodYear)
+}
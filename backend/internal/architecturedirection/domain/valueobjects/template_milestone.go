@@ -0,0 +1,56 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const (
+	MaxTemplateMilestoneLabelLength    = 200
+	MaxTemplateMilestoneOffsetQuarters = 40
+)
+
+var (
+	ErrTemplateMilestoneLabelRequired = errors.New("template milestone label is required")
+	ErrTemplateMilestoneLabelTooLong  = errors.New("template milestone label exceeds maximum length of 200 characters")
+	ErrInvalidTemplateMilestoneOffset = errors.New("template milestone offset must be between 0 and 40 quarters")
+)
+
+// TemplateMilestone is a default milestone of a journey template. Its target is
+// relative: the number of quarters after the quarter the journey is planned in.
+type TemplateMilestone struct {
+	label          string
+	offsetQuarters int
+}
+
+func NewTemplateMilestone(label string, offsetQuarters int) (TemplateMilestone, error) {
+	trimmed := strings.TrimSpace(label)
+	if trimmed == "" {
+		return TemplateMilestone{}, ErrTemplateMilestoneLabelRequired
+	}
+	if len(trimmed) > MaxTemplateMilestoneLabelLength {
+		return TemplateMilestone{}, ErrTemplateMilestoneLabelTooLong
+	}
+	if offsetQuarters < 0 || offsetQuarters > MaxTemplateMilestoneOffsetQuarters {
+		return TemplateMilestone{}, ErrInvalidTemplateMilestoneOffset
+	}
+	return TemplateMilestone{label: trimmed, offsetQuarters: offsetQuarters}, nil
+}
+
+func (m TemplateMilestone) Label() string       { return m.label }
+func (m TemplateMilestone) OffsetQuarters() int { return m.offsetQuarters }
+
+// TargetFrom resolves the milestone's target period for a journey planned in
+// the given quarter.
+func (m TemplateMilestone) TargetFrom(plannedIn TargetPeriod) (TargetPeriod, error) {
+	return plannedIn.AddQuarters(m.offsetQuarters)
+}
+
+func (m TemplateMilestone) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(TemplateMilestone); ok {
+		return m.label == o.label && m.offsetQuarters == o.offsetQuarters
+	}
+	return false
+}
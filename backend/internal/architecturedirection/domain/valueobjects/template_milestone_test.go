@@ -0,0 +1,55 @@
+package valueobjects
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTemplateMilestone_TrimsLabel(t *testing.T) {
+	m, err := NewTemplateMilestone("  Vendor selection  ", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Vendor selection", m.Label())
+	assert.Equal(t, 1, m.OffsetQuarters())
+}
+
+func TestNewTemplateMilestone_EmptyLabel_Rejected(t *testing.T) {
+	_, err := NewTemplateMilestone("   ", 1)
+	assert.ErrorIs(t, err, ErrTemplateMilestoneLabelRequired)
+}
+
+func TestNewTemplateMilestone_LabelTooLong_Rejected(t *testing.T) {
+	_, err := NewTemplateMilestone(strings.Repeat("a", 201), 1)
+	assert.ErrorIs(t, err, ErrTemplateMilestoneLabelTooLong)
+}
+
+func TestNewTemplateMilestone_OffsetOutOfRange_Rejected(t *testing.T) {
+	for _, offset := range []int{-1, 41} {
+		_, err := NewTemplateMilestone("Parallel run", offset)
+		assert.ErrorIs(t, err, ErrInvalidTemplateMilestoneOffset, "offset %d", offset)
+	}
+	for _, offset := range []int{0, 40} {
+		_, err := NewTemplateMilestone("Parallel run", offset)
+		assert.NoError(t, err, "offset %d", offset)
+	}
+}
+
+func TestTemplateMilestone_TargetFrom_CountsQuartersFromPlanning(t *testing.T) {
+	m, _ := NewTemplateMilestone("Decommission", 5)
+	plannedIn, _ := NewTargetPeriod(2026, 4)
+
+	target, err := m.TargetFrom(plannedIn)
+	require.NoError(t, err)
+	assert.Equal(t, 2028, target.Year())
+	assert.Equal(t, 1, target.Quarter())
+}
+
+func TestTemplateMilestone_Equals(t *testing.T) {
+	a, _ := NewTemplateMilestone("Data migration", 2)
+	b, _ := NewTemplateMilestone("Data migration", 2)
+	c, _ := NewTemplateMilestone("Data migration", 3)
+	assert.True(t, a.Equals(b))
+	assert.False(t, a.Equals(c))
+}
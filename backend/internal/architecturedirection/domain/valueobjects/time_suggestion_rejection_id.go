@@ -0,0 +1,21 @@
+package valueobjects
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+type TimeSuggestionRejectionID struct {
+	sharedvo.UUIDValue
+}
+
+func NewTimeSuggestionRejectionID() TimeSuggestionRejectionID {
+	return TimeSuggestionRejectionID{UUIDValue: sharedvo.NewUUIDValue()}
+}
+
+func (i TimeSuggestionRejectionID) Equals(other domain.ValueObject) bool {
+	if otherID, ok := other.(TimeSuggestionRejectionID); ok {
+		return i.EqualsValue(otherID.UUIDValue)
+	}
+	return false
+}
@@ -53,6 +53,9 @@ type CaptureJourneyRequest struct {
 	TargetDomainID   string               `json:"targetDomainId,omitempty"`
 	TargetParentID   string               `json:"targetParentId,omitempty"`
 	ResultingName    string               `json:"resultingName,omitempty"`
+	// TemplateID seeds the journey's milestones from a journey template of the
+	// same kind, targeted relative to the current quarter.
+	TemplateID string `json:"templateId,omitempty"`
 }
 
 type UpdateJourneyProgressRequest struct {
@@ -87,6 +90,11 @@ type UpdateJourneyMilestoneRequest struct {
 
 func (req UpdateJourneyMilestoneRequest) targetPeriod() *TargetPeriodRequest { return req.TargetPeriod }
 
+type AddJourneyDependencyRequest struct {
+	PredecessorID  string `json:"predecessorId"`
+	DependencyType string `json:"dependencyType"`
+}
+
 // GetJourneyForCapability godoc
 // @Summary Get the active journey for a capability
 // @Description Returns the capability's active (planned or in-flight) journey, or null if none.
@@ -115,7 +123,7 @@ func (h *CapabilityJourneyHandlers) GetJourneyForCapability(w http.ResponseWrite
 
 // CaptureJourney godoc
 // @Summary Capture a journey on a capability
-// @Description Creates a new journey in status "planned"; rejected if an active journey already exists on the capability.
+// @Description Creates a new journey in status "planned"; rejected if an active journey already exists on the capability. Pass templateId to seed its milestones from a journey template.
 // @Tags capability-journeys
 // @Accept json
 // @Produce json
@@ -149,6 +157,7 @@ func (h *CapabilityJourneyHandlers) CaptureJourney(w http.ResponseWriter, r *htt
 		TargetDomainID:   req.TargetDomainID,
 		TargetParentID:   req.TargetParentID,
 		ResultingName:    req.ResultingName,
+		TemplateID:       req.TemplateID,
 		PlannedBy:        actor.Email,
 	}
 	result, err := h.commandBus.Dispatch(r.Context(), cmd)
@@ -520,6 +529,66 @@ func (h *CapabilityJourneyHandlers) DeleteJourneyMilestone(w http.ResponseWriter
 	sharedAPI.RespondNoContent(w)
 }
 
+// PostJourneyDependency godoc
+// @Summary Make an active journey depend on a predecessor journey
+// @Description finish-to-start waits for the predecessor to be done; start-to-start waits for it to be in flight. Rejected when the dependency would create a cycle.
+// @Tags capability-journeys
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param journeyId path string true "Journey ID"
+// @Param body body AddJourneyDependencyRequest true "Dependency data"
+// @Success 201 {object} readmodels.CapabilityJourneyDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capability-journeys/{journeyId}/dependencies [post]
+func (h *CapabilityJourneyHandlers) PostJourneyDependency(w http.ResponseWriter, r *http.Request) {
+	decodeJourneyMutation(h, w, r, http.StatusCreated, func(journeyID string, req AddJourneyDependencyRequest, actor sharedctx.Actor) cqrs.Command {
+		return &commands.AddJourneyDependency{
+			JourneyID:      journeyID,
+			PredecessorID:  req.PredecessorID,
+			DependencyType: defaultDependencyType(req.DependencyType),
+			Actor:          actor.Email,
+		}
+	})
+}
+
+func defaultDependencyType(dependencyType string) string {
+	if dependencyType == "" {
+		return valueobjects.JourneyDependencyFinishToStart
+	}
+	return dependencyType
+}
+
+// DeleteJourneyDependency godoc
+// @Summary Remove a dependency from an active journey
+// @Tags capability-journeys
+// @Security CookieAuth
+// @Param journeyId path string true "Journey ID"
+// @Param predecessorId path string true "Predecessor journey ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capability-journeys/{journeyId}/dependencies/{predecessorId} [delete]
+func (h *CapabilityJourneyHandlers) DeleteJourneyDependency(w http.ResponseWriter, r *http.Request) {
+	journeyID := sharedAPI.GetPathParam(r, "journeyId")
+	predecessorID := sharedAPI.GetPathParam(r, "predecessorId")
+	actor, _ := sharedctx.GetActor(r.Context())
+	cmd := &commands.RemoveJourneyDependency{JourneyID: journeyID, PredecessorID: predecessorID, Actor: actor.Email}
+	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	sharedAPI.RespondNoContent(w)
+}
+
 type respondJourneyParams struct {
 	journeyID  string
 	statusCode int
@@ -559,6 +628,9 @@ func (h *CapabilityJourneyHandlers) decorateJourney(journey *readmodels.Capabili
 	for i := range journey.Milestones {
 		journey.Milestones[i].Links = h.hateoas.MilestoneLinks(journey, journey.Milestones[i].ID, actor)
 	}
+	for i := range journey.Dependencies {
+		journey.Dependencies[i].Links = h.hateoas.DependencyLinks(journey, journey.Dependencies[i].PredecessorID, actor)
+	}
 }
 
 func requestTargetPeriodParts(period *TargetPeriodRequest) (*int, *int) {
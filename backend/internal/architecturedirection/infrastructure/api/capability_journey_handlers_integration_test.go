@@ -139,8 +139,8 @@ func registerCapabilityJourneyTestCommands(commandBus cqrs.CommandBus, repo *rep
 		DomainExists:                  services.DomainExists(func(context.Context, string) (bool, error) { return true, nil }),
 		CapabilityEffectivelyInDomain: services.CapabilityEffectivelyInDomain(func(context.Context, string, string) (bool, error) { return true, nil }),
 	}
-	commandBus.Register("PlanJourney", handlers.NewPlanJourneyHandler(repo, readModel, refs))
-	commandBus.Register("StartJourney", handlers.NewStartJourneyHandler(repo))
+	commandBus.Register("PlanJourney", handlers.NewPlanJourneyHandler(repo, readModel, refs, nil, time.Now))
+	commandBus.Register("StartJourney", handlers.NewStartJourneyHandler(repo, readModel))
 	commandBus.Register("CompleteJourney", handlers.NewCompleteJourneyHandler(repo))
 	commandBus.Register("AbandonJourney", handlers.NewAbandonJourneyHandler(repo))
 	commandBus.Register("UpdateJourneyProgress", handlers.NewUpdateJourneyProgressHandler(repo))
@@ -164,6 +164,7 @@ func cleanupCapabilityJourneysForCapability(db *sql.DB, capID string) {
 		_, _ = db.Exec("DELETE FROM infrastructure.events WHERE aggregate_id = $1", jid)
 		_, _ = db.Exec("DELETE FROM architecturedirection.capability_journey_sources WHERE tenant_id = $1 AND journey_id = $2", tenantID, jid)
 		_, _ = db.Exec("DELETE FROM architecturedirection.capability_journey_milestones WHERE tenant_id = $1 AND journey_id = $2", tenantID, jid)
+		_, _ = db.Exec("DELETE FROM architecturedirection.capability_journey_slips WHERE tenant_id = $1 AND journey_id = $2", tenantID, jid)
 	}
 	_, _ = db.Exec("DELETE FROM architecturedirection.capability_journeys WHERE tenant_id = $1 AND capability_id = $2", tenantID, capID)
 }
@@ -12,6 +12,7 @@ const (
 	journeyHistorySubPath  sharedAPI.ResourcePath = "/journey/history"
 	capabilityJourneysPath sharedAPI.ResourcePath = "/capability-journeys"
 	journeyMilestonesPath  sharedAPI.ResourcePath = "/milestones"
+	journeyDependencyPath  sharedAPI.ResourcePath = "/dependencies"
 )
 
 type CapabilityJourneyLinks struct {
@@ -29,6 +30,7 @@ func (h *CapabilityJourneyLinks) ForCapability(capabilityID string, journey *rea
 	}
 	if journey == nil && actor.CanWrite(ArchitectureDirectionResource) {
 		links["x-capture"] = h.Post(journeyResourcePath(capabilityID))
+		links["x-journey-templates"] = h.Get(string(journeyTemplatesPath))
 	}
 	return links
 }
@@ -38,6 +40,12 @@ func (h *CapabilityJourneyLinks) ItemLinks(journey *readmodels.CapabilityJourney
 		"self":      h.Get(journeyResourcePath(journey.CapabilityID)),
 		"x-history": h.Get(journeyHistoryResourcePath(journey.CapabilityID)),
 	}
+	if journey.BaselinedAt != nil {
+		links["x-slips"] = h.Get(journeySlipResourcePath(journey.ID))
+	}
+	if journey.Template != nil {
+		links["x-template"] = h.Get(journeyTemplateResourcePath(journey.Template.TemplateID))
+	}
 	if !actor.CanWrite(ArchitectureDirectionResource) {
 		return links
 	}
@@ -50,6 +58,7 @@ func (h *CapabilityJourneyLinks) ItemLinks(journey *readmodels.CapabilityJourney
 	links["x-progress"] = h.Put(itemBase + "/progress")
 	links["x-change-sources"] = h.Put(itemBase + "/source-applications")
 	links["x-add-milestone"] = h.Post(itemBase + string(journeyMilestonesPath))
+	links["x-add-dependency"] = h.Post(itemBase + string(journeyDependencyPath))
 	return links
 }
 
@@ -76,6 +85,15 @@ func (h *CapabilityJourneyLinks) MilestoneLinks(journey *readmodels.CapabilityJo
 	}
 }
 
+func (h *CapabilityJourneyLinks) DependencyLinks(journey *readmodels.CapabilityJourneyDTO, predecessorID string, actor sharedctx.Actor) sharedAPI.Links {
+	if !actor.CanWrite(ArchitectureDirectionResource) || !isActiveJourneyStatus(journey.Status) {
+		return sharedAPI.Links{}
+	}
+	return sharedAPI.Links{
+		"delete": h.Del(journeyItemResourcePath(journey.ID) + string(journeyDependencyPath) + "/" + predecessorID),
+	}
+}
+
 func (h *CapabilityJourneyLinks) BulkLinks(selfPath string, actor sharedctx.Actor) sharedAPI.Links {
 	links := sharedAPI.Links{"self": h.Get(selfPath)}
 	if actor.CanWrite(ArchitectureDirectionResource) {
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"fmt"
+
 	"easi/backend/internal/architecturedirection/application/handlers"
 	"easi/backend/internal/architecturedirection/application/readmodels"
 	"easi/backend/internal/architecturedirection/domain/aggregates"
@@ -17,6 +19,7 @@ func init() {
 
 	registry.RegisterNotFound(repositories.ErrTimeAssessmentNotFound, "Time assessment not found")
 	registry.RegisterNotFound(handlers.ErrTimeAssessmentNotFoundForPair, "No time assessment exists for this capability and component pair")
+	registry.RegisterNotFound(handlers.ErrTimeSuggestionNotPendingForPair, "No pending time suggestion exists for this capability and component pair")
 	registry.RegisterNotFound(repositories.ErrDirectionNotFound, "Direction not found")
 	registry.RegisterNotFound(repositories.ErrStandardApplicationNotFound, "Standard application not found")
 	registry.RegisterNotFound(services.ErrReferencedEntityNotFound, "A referenced entity does not exist or is not accessible")
@@ -28,18 +31,39 @@ func init() {
 	registry.RegisterNotFound(repositories.ErrCapabilityJourneyNotFound, "Capability journey not found")
 	registry.RegisterNotFound(aggregates.ErrInvalidJourneyTransition, "No journey in a status that allows this transition")
 	registry.RegisterNotFound(aggregates.ErrJourneyMilestoneNotFound, "Milestone not found on this journey")
+	registry.RegisterNotFound(aggregates.ErrJourneyDependencyNotFound, "This journey does not depend on that predecessor")
+	registry.RegisterNotFound(repositories.ErrJourneyProgrammeNotFound, "Journey programme not found")
+	registry.RegisterNotFound(aggregates.ErrJourneyProgrammeDeleted, "Journey programme not found")
+	registry.RegisterNotFound(aggregates.ErrJourneyNotInProgramme, "Journey is not a member of this programme")
+	registry.RegisterNotFound(repositories.ErrStandardExceptionNotFound, "Standard exception not found")
+	registry.RegisterNotFound(repositories.ErrJourneyTemplateNotFound, "Journey template not found")
 
 	registry.RegisterConflict(readmodels.ErrTimeAssessmentAlreadyExists, "A time assessment already exists for this capability and component pair")
 	registry.RegisterConflict(aggregates.ErrTimeAssessmentAlreadyRemoved, "This time assessment has already been removed")
 	registry.RegisterConflict(services.ErrActiveDirectionAlreadyExists, "An active direction already exists on this enterprise capability")
 	registry.RegisterConflict(services.ErrEnterpriseCapabilityInactive, "Directions can only be captured on active enterprise capabilities.")
 	registry.RegisterConflict(aggregates.ErrDirectionAgreedImmutable, "Agreed directions are immutable; reject and replace to change")
+	registry.RegisterConflict(aggregates.ErrReviewQuorumNotMet, "The review quorum must approve the proposal, with no objection outstanding, before it can be agreed")
+	registry.RegisterConflict(aggregates.ErrDirectionNotUnderReview, "Reviews can only be recorded while the direction is proposed")
+	registry.RegisterForbidden(aggregates.ErrNotARequiredReviewer, "Only a required reviewer of the proposal can review it")
 	registry.RegisterConflict(readmodels.ErrStandardApplicationAlreadyExists, "A standard application already exists for this enterprise capability")
 	registry.RegisterConflict(readmodels.ErrRealizationRolesAggregateConflict, "A different realization roles aggregate is already registered for this capability")
 	registry.RegisterConflict(aggregates.ErrJourneyFrozen, "This journey is terminal and can no longer be edited")
 	registry.RegisterConflict(readmodels.ErrActiveCapabilityJourneyExists, "An active journey already exists for this capability")
+	registry.RegisterConflict(aggregates.ErrJourneyDependencyExists, "This journey already depends on that predecessor")
+	registry.RegisterConflict(services.ErrJourneyDependencyCycle, "This dependency would create a cycle between journeys")
+	registry.RegisterConflict(aggregates.ErrJourneyAlreadyInProgramme, "Journey is already a member of this programme")
+	registry.RegisterConflict(handlers.ErrJourneyInAnotherProgramme, "Journey already belongs to another programme")
+	registry.RegisterConflict(handlers.ErrStandardExceptionAlreadyGranted, "An exception is already registered for this application on this capability; renew it instead")
+	registry.RegisterConflict(aggregates.ErrStandardExceptionRevoked, "This exception has been revoked")
+	registry.RegisterConflict(aggregates.ErrJourneyTemplateRetired, "This journey template has been retired")
 
 	registry.RegisterValidation(valueobjects.ErrInvalidTimeGrade, "Grade must be one of Invest, Tolerate, Migrate, Eliminate")
+	registry.RegisterValidation(handlers.ErrNoTimeSuggestionDecisions, "At least one review decision is required")
+	registry.RegisterValidation(handlers.ErrTooManyTimeSuggestionDecisions, fmt.Sprintf("At most %d review decisions can be applied in one request", handlers.MaxTimeSuggestionDecisions))
+	registry.RegisterValidation(handlers.ErrInvalidTimeSuggestionAction, "Review action must be one of accept, reject, override")
+	registry.RegisterValidation(handlers.ErrOverrideGradeRequired, "A grade is required to override a time suggestion")
+	registry.RegisterValidation(handlers.ErrDuplicateTimeSuggestionDecision, "Each capability and component pair can only be reviewed once per request")
 	registry.RegisterValidation(valueobjects.ErrInvalidRealizationRole, "Role must be one of standard, legacy")
 	registry.RegisterValidation(aggregates.ErrNarrativeRequiredForStandardApplication, "A narrative is required when setting or changing the standard application")
 	registry.RegisterValidation(aggregates.ErrInvalidSourceCardinality, "Source capability count does not match the direction type")
@@ -48,9 +72,15 @@ func init() {
 	registry.RegisterValidation(valueobjects.ErrInvalidDirectionType, "Direction type must be one of consolidate, decompose, stay")
 	registry.RegisterValidation(valueobjects.ErrInvalidDirectionStatus, "Direction status must be one of draft, proposed, agreed, rejected")
 	registry.RegisterValidation(valueobjects.ErrInvalidHorizon, "Horizon must be one of now, next, later")
+	registry.RegisterValidation(valueobjects.ErrReviewersRequired, "Name at least one reviewer other than the proposer")
+	registry.RegisterValidation(valueobjects.ErrInvalidReviewQuorum, "Quorum must be between 1 and the number of reviewers")
+	registry.RegisterValidation(valueobjects.ErrInvalidReviewVerdict, "Verdict must be one of approve, object")
+	registry.RegisterValidation(valueobjects.ErrDecisionRecordSectionTooLong, "Decision record sections cannot exceed 4000 characters")
 	registry.RegisterValidation(sharedvo.ErrDescriptionTooLong, "Narrative cannot exceed 1000 characters")
 	registry.RegisterValidation(valueobjects.ErrResultingNameTooLong, "Resulting name cannot exceed 200 characters")
 	registry.RegisterValidation(handlers.ErrUnknownAdvanceTarget, "Advance target must be 'proposed' or 'agreed'")
+	registry.RegisterValidation(handlers.ErrProposerCannotReview, "The proposer cannot be one of the reviewers")
+	registry.RegisterValidation(handlers.ErrUnknownReviewer, "Every reviewer must be an active user")
 
 	registry.RegisterValidation(aggregates.ErrJourneyTargetAmongSources, "The target application must not be among the from-applications")
 	registry.RegisterValidation(aggregates.ErrJourneyMoveRequiresTargetDomain, "A move journey requires a target business domain")
@@ -67,4 +97,29 @@ func init() {
 	registry.RegisterValidation(entities.ErrMilestoneLabelRequired, "Milestone label is required")
 	registry.RegisterValidation(entities.ErrMilestoneLabelTooLong, "Milestone label cannot exceed 200 characters")
 	registry.RegisterValidation(handlers.ErrTargetPeriodRequiresBoth, "Target period requires both year and quarter, or neither")
+	registry.RegisterValidation(valueobjects.ErrInvalidJourneyDependencyType, "Dependency type must be one of finish-to-start, start-to-start")
+	registry.RegisterValidation(aggregates.ErrJourneyDependsOnItself, "A journey cannot depend on itself")
+	registry.RegisterValidation(valueobjects.ErrProgrammeNameRequired, "Programme name is required")
+	registry.RegisterValidation(valueobjects.ErrProgrammeNameTooLong, "Programme name cannot exceed 200 characters")
+	registry.RegisterValidation(ErrUnknownRoadmapFormat, "Format must be one of json, ical, msproject, mermaid, plantuml")
+	registry.RegisterValidation(ErrInvalidQuarterParam, "Quarters must be written as YYYY-Qn, e.g. 2025-Q3")
+	registry.RegisterValidation(handlers.ErrTrendRangeReversed, "The from quarter must not be after the to quarter")
+	registry.RegisterValidation(handlers.ErrTrendRangeTooLong, fmt.Sprintf("A trend can span at most %d quarters", handlers.MaxTimeGradeTrendQuarters))
+	registry.RegisterValidation(handlers.ErrTrendQuarterInFuture, "A trend cannot extend past the current quarter")
+	registry.RegisterValidation(handlers.ErrTargetQuarterInPast, "The target quarter cannot be before the current quarter")
+	registry.RegisterValidation(handlers.ErrTargetHorizonAndQuarterSet, "Choose either a horizon or a target quarter, not both")
+	registry.RegisterValidation(valueobjects.ErrExceptionJustificationRequired, "An exception needs a justification")
+	registry.RegisterValidation(valueobjects.ErrExceptionJustificationTooLong, fmt.Sprintf("Exception justification cannot exceed %d characters", valueobjects.MaxExceptionJustificationLength))
+	registry.RegisterValidation(valueobjects.ErrExceptionExpiryRequired, "An exception needs an expiry date")
+	registry.RegisterValidation(aggregates.ErrExceptionApproverRequired, "An exception must name the person who approved it")
+	registry.RegisterValidation(aggregates.ErrExceptionExpiryInPast, "An exception cannot expire in the past")
+	registry.RegisterValidation(ErrInvalidExpiryDate, "Expiry dates must be written as YYYY-MM-DD")
+	registry.RegisterValidation(valueobjects.ErrJourneyTemplateNameRequired, "Journey template name is required")
+	registry.RegisterValidation(valueobjects.ErrJourneyTemplateNameTooLong, "Journey template name cannot exceed 200 characters")
+	registry.RegisterValidation(valueobjects.ErrTemplateMilestoneLabelRequired, "Template milestone label is required")
+	registry.RegisterValidation(valueobjects.ErrTemplateMilestoneLabelTooLong, "Template milestone label cannot exceed 200 characters")
+	registry.RegisterValidation(valueobjects.ErrInvalidTemplateMilestoneOffset, fmt.Sprintf("Template milestone offsets must be between 0 and %d quarters", valueobjects.MaxTemplateMilestoneOffsetQuarters))
+	registry.RegisterValidation(aggregates.ErrJourneyTemplateHasNoMilestones, "A journey template needs at least one milestone")
+	registry.RegisterValidation(aggregates.ErrJourneyTemplateTooManyMilestones, fmt.Sprintf("A journey template can have at most %d milestones", aggregates.MaxJourneyTemplateMilestones))
+	registry.RegisterValidation(aggregates.ErrJourneyTemplateKindMismatch, "The journey template is for a different journey kind")
 }
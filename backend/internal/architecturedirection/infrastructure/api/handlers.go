@@ -2,7 +2,9 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 
 	"easi/backend/internal/architecturedirection/application/commands"
@@ -45,6 +47,22 @@ type UpdateDirectionRequest struct {
 	Narrative *string `json:"narrative,omitempty"`
 }
 
+type ProposeDirectionRequest struct {
+	ReviewerIDs []string `json:"reviewerIds,omitempty"`
+	Quorum      int      `json:"quorum,omitempty"`
+}
+
+type AgreeDirectionRequest struct {
+	Context      string `json:"context,omitempty"`
+	Decision     string `json:"decision,omitempty"`
+	Consequences string `json:"consequences,omitempty"`
+}
+
+type ReviewDirectionRequest struct {
+	Verdict string `json:"verdict"`
+	Comment string `json:"comment,omitempty"`
+}
+
 type ECDirectionResponse struct {
 	Direction *readmodels.DirectionDTO `json:"direction"`
 	Links     sharedAPI.Links          `json:"_links,omitempty"`
@@ -76,7 +94,7 @@ func (h *DirectionHandlers) GetDirectionForEnterpriseCapability(w http.ResponseW
 		Links:     h.hateoas.EnterpriseCapabilityDirectionLinks(ecID, direction, actor),
 	}
 	if direction != nil {
-		direction.Links = h.hateoas.DirectionForActor(ecID, direction.Status, actor)
+		direction.Links = h.hateoas.DirectionForActor(ecID, direction, actor)
 	}
 	sharedAPI.RespondJSON(w, http.StatusOK, envelope)
 }
@@ -157,12 +175,16 @@ func (h *DirectionHandlers) UpdateDirection(w http.ResponseWriter, r *http.Reque
 }
 
 // ProposeDirection godoc
-// @Summary Advance the active direction to proposed
+// @Summary Propose the active direction for review
+// @Description Advances a draft direction to proposed, or re-proposes one that was proposed before reviews existed, and names the reviewers who must approve it. Reviewers must be active users other than the proposer. Without reviewerIds the architects of every source capability's business domain review, apart from the proposer; when there are none the tenant's active admins review instead, and the proposal is rejected when nobody but the proposer could review it. quorum is the number of approvals needed and defaults to all reviewers. The body is optional.
 // @Tags directions
+// @Accept json
 // @Produce json
 // @Security CookieAuth
 // @Param id path string true "Enterprise capability ID"
+// @Param body body ProposeDirectionRequest false "Review panel"
 // @Success 200 {object} easi_backend_internal_architecturedirection_application_readmodels.DirectionDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
 // @Failure 401 {object} sharedAPI.ErrorResponse
 // @Failure 403 {object} sharedAPI.ErrorResponse
 // @Failure 404 {object} sharedAPI.ErrorResponse
@@ -170,16 +192,30 @@ func (h *DirectionHandlers) UpdateDirection(w http.ResponseWriter, r *http.Reque
 // @Failure 500 {object} sharedAPI.ErrorResponse
 // @Router /enterprise-capabilities/{id}/direction/propose [post]
 func (h *DirectionHandlers) ProposeDirection(w http.ResponseWriter, r *http.Request) {
-	h.advance(w, r, valueobjects.DirectionStatusProposed)
+	req, ok := decodeOptionalRequest[ProposeDirectionRequest](w, r)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.advance(w, r, commands.AdvanceDirection{
+		TargetStatus: valueobjects.DirectionStatusProposed,
+		ReviewerIDs:  req.ReviewerIDs,
+		Quorum:       req.Quorum,
+		ProposedBy:   actor.ID,
+	})
 }
 
 // AgreeDirection godoc
-// @Summary Advance the active direction to agreed
+// @Summary Agree the active direction and record the decision
+// @Description Advances a proposed direction to agreed once its review quorum has approved and no objection is outstanding, and records the decision as an accepted architecture decision record linked to the direction, listed under /decision-records and offered by the x-decision-records link. Context defaults to the narrative and decision to a summary of the direction. The body is optional.
 // @Tags directions
+// @Accept json
 // @Produce json
 // @Security CookieAuth
 // @Param id path string true "Enterprise capability ID"
+// @Param body body AgreeDirectionRequest false "Decision record"
 // @Success 200 {object} easi_backend_internal_architecturedirection_application_readmodels.DirectionDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
 // @Failure 401 {object} sharedAPI.ErrorResponse
 // @Failure 403 {object} sharedAPI.ErrorResponse
 // @Failure 404 {object} sharedAPI.ErrorResponse
@@ -187,7 +223,59 @@ func (h *DirectionHandlers) ProposeDirection(w http.ResponseWriter, r *http.Requ
 // @Failure 500 {object} sharedAPI.ErrorResponse
 // @Router /enterprise-capabilities/{id}/direction/agree [post]
 func (h *DirectionHandlers) AgreeDirection(w http.ResponseWriter, r *http.Request) {
-	h.advance(w, r, valueobjects.DirectionStatusAgreed)
+	req, ok := decodeOptionalRequest[AgreeDirectionRequest](w, r)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.advance(w, r, commands.AdvanceDirection{
+		TargetStatus:         valueobjects.DirectionStatusAgreed,
+		DecisionContext:      req.Context,
+		Decision:             req.Decision,
+		DecisionConsequences: req.Consequences,
+		AgreedBy:             actor.Email,
+	})
+}
+
+// ReviewDirection godoc
+// @Summary Record the current user's review of the proposed direction
+// @Description A required reviewer approves or objects to the proposal, with an optional comment. Recording again replaces the reviewer's earlier verdict.
+// @Tags directions
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param id path string true "Enterprise capability ID"
+// @Param body body ReviewDirectionRequest true "Review"
+// @Success 200 {object} easi_backend_internal_architecturedirection_application_readmodels.DirectionDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /enterprise-capabilities/{id}/direction/reviews [post]
+func (h *DirectionHandlers) ReviewDirection(w http.ResponseWriter, r *http.Request) {
+	ecID := sharedAPI.GetPathParam(r, "id")
+	req, ok := sharedAPI.DecodeRequestOrFail[ReviewDirectionRequest](w, r)
+	if !ok {
+		return
+	}
+	direction, ok := h.resolveActiveDirection(w, r, ecID)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	cmd := &commands.ReviewDirection{
+		DirectionID: direction.ID,
+		ReviewerID:  actor.ID,
+		Verdict:     req.Verdict,
+		Comment:     req.Comment,
+	}
+	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	h.respondWithActiveDirection(w, r, ecID, http.StatusOK)
 }
 
 // RejectDirection godoc
@@ -222,14 +310,14 @@ func (h *DirectionHandlers) RejectDirection(w http.ResponseWriter, r *http.Reque
 	h.respondWithDirection(w, r, directionResponse{ecID: ecID, direction: rejected, statusCode: http.StatusOK})
 }
 
-func (h *DirectionHandlers) advance(w http.ResponseWriter, r *http.Request, target string) {
+func (h *DirectionHandlers) advance(w http.ResponseWriter, r *http.Request, cmd commands.AdvanceDirection) {
 	ecID := sharedAPI.GetPathParam(r, "id")
 	direction, ok := h.resolveActiveDirection(w, r, ecID)
 	if !ok {
 		return
 	}
-	cmd := &commands.AdvanceDirection{DirectionID: direction.ID, TargetStatus: target}
-	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+	cmd.DirectionID = direction.ID
+	if _, err := h.commandBus.Dispatch(r.Context(), &cmd); err != nil {
 		if errors.Is(err, aggregates.ErrInvalidSourceCardinality) {
 			sharedAPI.RespondError(w, http.StatusBadRequest, nil, proposeCardinalityMessage(direction.Type))
 			return
@@ -240,6 +328,17 @@ func (h *DirectionHandlers) advance(w http.ResponseWriter, r *http.Request, targ
 	h.respondWithActiveDirection(w, r, ecID, http.StatusOK)
 }
 
+// decodeOptionalRequest decodes the JSON body when one is sent; an empty body
+// yields the zero request.
+func decodeOptionalRequest[T any](w http.ResponseWriter, r *http.Request) (T, bool) {
+	var req T
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		sharedAPI.RespondError(w, http.StatusBadRequest, err, "Invalid request body")
+		return req, false
+	}
+	return req, true
+}
+
 func proposeCardinalityMessage(directionType string) string {
 	if directionType == valueobjects.DirectionTypeConsolidate {
 		return "A 'consolidate' direction requires at least 2 sources to be proposed."
@@ -281,7 +380,7 @@ func (h *DirectionHandlers) respondWithDirection(w http.ResponseWriter, r *http.
 		return
 	}
 	actor, _ := sharedctx.GetActor(r.Context())
-	resp.direction.Links = h.hateoas.DirectionForActor(resp.ecID, resp.direction.Status, actor)
+	resp.direction.Links = h.hateoas.DirectionForActor(resp.ecID, resp.direction, actor)
 	if resp.statusCode == http.StatusCreated {
 		location := sharedAPI.BuildSubResourceLink(enterpriseCapabilitiesPath, sharedAPI.ResourceID(resp.ecID), directionSubPath)
 		sharedAPI.RespondCreated(w, location, resp.direction)
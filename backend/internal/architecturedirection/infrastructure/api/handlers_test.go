@@ -127,9 +127,9 @@ func TestGetDirectionForEC_DirectionAffordancesByStatus(t *testing.T) {
 			forbidLinks: []string{"x-agree"},
 		},
 		{
-			name:         "agreed shows only reject; not edit/propose/agree",
+			name:         "agreed shows only reject and its decision records; not edit/propose/agree",
 			status:       "agreed",
-			expectLinks:  []string{"x-reject"},
+			expectLinks:  []string{"x-reject", "x-decision-records"},
 			forbidLinks:  []string{"edit", "x-propose", "x-agree"},
 			assertReason: "spec allows reject-and-replace from agreed",
 		},
@@ -317,3 +317,95 @@ func TestRejectDirection_NoActiveDirection_404(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, rec.Code)
 	assert.Empty(t, bus.dispatched)
 }
+
+func postDirectionAction(t *testing.T, h *DirectionHandlers, ecID, action string, body any, actor sharedctx.Actor) *httptest.ResponseRecorder {
+	t.Helper()
+	r := chi.NewRouter()
+	r.Post("/enterprise-capabilities/{id}/direction/propose", h.ProposeDirection)
+	r.Post("/enterprise-capabilities/{id}/direction/agree", h.AgreeDirection)
+	r.Post("/enterprise-capabilities/{id}/direction/reviews", h.ReviewDirection)
+	reqBody, err := json.Marshal(body)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/enterprise-capabilities/"+ecID+"/direction/"+action, bytes.NewReader(reqBody))
+	req = req.WithContext(sharedctx.WithActor(req.Context(), actor))
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestProposeDirection_PassesReviewPanel(t *testing.T) {
+	ecID, did := uuid.New().String(), uuid.New().String()
+	bus := &mockCommandBus{}
+	queries := &mockDirectionQueries{activeByEC: &readmodels.DirectionDTO{ID: did, EnterpriseCapabilityID: ecID, Status: "draft"}}
+
+	rec := postDirectionAction(t, setupHandlers(bus, queries), ecID, "propose",
+		ProposeDirectionRequest{ReviewerIDs: []string{"r1", "r2"}, Quorum: 1}, architectActor())
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	cmd := bus.dispatched[0].(*commands.AdvanceDirection)
+	assert.Equal(t, "proposed", cmd.TargetStatus)
+	assert.Equal(t, []string{"r1", "r2"}, cmd.ReviewerIDs)
+	assert.Equal(t, 1, cmd.Quorum)
+}
+
+func TestAgreeDirection_PassesDecisionRecord(t *testing.T) {
+	ecID, did := uuid.New().String(), uuid.New().String()
+	bus := &mockCommandBus{}
+	queries := &mockDirectionQueries{activeByEC: &readmodels.DirectionDTO{ID: did, EnterpriseCapabilityID: ecID, Status: "proposed"}}
+
+	rec := postDirectionAction(t, setupHandlers(bus, queries), ecID, "agree",
+		AgreeDirectionRequest{Context: "Two ledgers.", Decision: "Merge.", Consequences: "Migrate history."}, architectActor())
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	cmd := bus.dispatched[0].(*commands.AdvanceDirection)
+	assert.Equal(t, "agreed", cmd.TargetStatus)
+	assert.Equal(t, "Two ledgers.", cmd.DecisionContext)
+	assert.Equal(t, "Merge.", cmd.Decision)
+	assert.Equal(t, "Migrate history.", cmd.DecisionConsequences)
+	assert.Equal(t, architectActor().Email, cmd.AgreedBy)
+}
+
+func TestReviewDirection_RecordsVerdictForCurrentUser(t *testing.T) {
+	ecID, did := uuid.New().String(), uuid.New().String()
+	bus := &mockCommandBus{}
+	queries := &mockDirectionQueries{activeByEC: &readmodels.DirectionDTO{ID: did, EnterpriseCapabilityID: ecID, Status: "proposed"}}
+
+	rec := postDirectionAction(t, setupHandlers(bus, queries), ecID, "reviews",
+		ReviewDirectionRequest{Verdict: "object", Comment: "Billing is out of scope."}, stakeholderActor())
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	cmd := bus.dispatched[0].(*commands.ReviewDirection)
+	assert.Equal(t, did, cmd.DirectionID)
+	assert.Equal(t, "u2", cmd.ReviewerID)
+	assert.Equal(t, "object", cmd.Verdict)
+	assert.Equal(t, "Billing is out of scope.", cmd.Comment)
+}
+
+func TestGetDirectionForEC_ReviewAffordances(t *testing.T) {
+	ecID, did := uuid.New().String(), uuid.New().String()
+	pending := readmodels.SummarizeReview(1, []readmodels.DirectionReviewerDTO{{ReviewerID: "u1"}, {ReviewerID: "u3"}})
+	approved := readmodels.SummarizeReview(1, []readmodels.DirectionReviewerDTO{{ReviewerID: "u1", Verdict: "approve"}, {ReviewerID: "u3"}})
+
+	code, body := getDirection(t, &mockDirectionQueries{activeByEC: &readmodels.DirectionDTO{
+		ID: did, EnterpriseCapabilityID: ecID, Status: "proposed", Review: pending,
+	}}, ecID, architectActor())
+	require.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body.Direction.Links, "x-review", "a required reviewer can review")
+	assert.NotContains(t, body.Direction.Links, "x-agree", "agree waits for the quorum")
+
+	_, body = getDirection(t, &mockDirectionQueries{activeByEC: &readmodels.DirectionDTO{
+		ID: did, EnterpriseCapabilityID: ecID, Status: "proposed", Review: approved,
+	}}, ecID, architectActor())
+	assert.Contains(t, body.Direction.Links, "x-agree")
+
+	_, body = getDirection(t, &mockDirectionQueries{activeByEC: &readmodels.DirectionDTO{
+		ID: did, EnterpriseCapabilityID: ecID, Status: "proposed", Review: pending,
+	}}, ecID, stakeholderActor())
+	assert.NotContains(t, body.Direction.Links, "x-review", "only required reviewers can review")
+
+	_, body = getDirection(t, &mockDirectionQueries{activeByEC: &readmodels.DirectionDTO{
+		ID: did, EnterpriseCapabilityID: ecID, Status: "proposed",
+	}}, ecID, architectActor())
+	assert.NotContains(t, body.Direction.Links, "x-agree", "a proposal without a panel cannot be agreed")
+	assert.Contains(t, body.Direction.Links, "x-propose", "it is proposed again to name reviewers")
+}
@@ -0,0 +1,258 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/infrastructure/repositories"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+)
+
+var errJourneyProgrammeMissingAfterMutation = errors.New("journey programme not found after mutation")
+
+type JourneyProgrammeQueries interface {
+	GetAll(ctx context.Context) ([]readmodels.JourneyProgrammeDTO, error)
+	GetByID(ctx context.Context, id string) (*readmodels.JourneyProgrammeDTO, error)
+}
+
+type JourneyProgrammeHandlers struct {
+	commandBus cqrs.CommandBus
+	queries    JourneyProgrammeQueries
+	hateoas    *JourneyProgrammeLinks
+}
+
+func NewJourneyProgrammeHandlers(commandBus cqrs.CommandBus, queries JourneyProgrammeQueries, hateoas *JourneyProgrammeLinks) *JourneyProgrammeHandlers {
+	return &JourneyProgrammeHandlers{commandBus: commandBus, queries: queries, hateoas: hateoas}
+}
+
+type JourneyProgrammeRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type AddJourneyToProgrammeRequest struct {
+	JourneyID string `json:"journeyId"`
+}
+
+// GetJourneyProgrammes godoc
+// @Summary List journey programmes
+// @Description Returns every programme with its member journeys, roll-up (progress, target period span, status counts) and blocked-by reporting.
+// @Tags journey-programmes
+// @Produce json
+// @Security CookieAuth
+// @Success 200 {object} sharedAPI.CollectionResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-programmes [get]
+func (h *JourneyProgrammeHandlers) GetJourneyProgrammes(w http.ResponseWriter, r *http.Request) {
+	programmes, ok := fetchOrFail(w, r, h.queries.GetAll)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	for i := range programmes {
+		h.decorateProgramme(&programmes[i], actor)
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, programmes, h.hateoas.CollectionLinks(actor))
+}
+
+// GetJourneyProgramme godoc
+// @Summary Get a journey programme
+// @Tags journey-programmes
+// @Produce json
+// @Security CookieAuth
+// @Param programmeId path string true "Programme ID"
+// @Success 200 {object} readmodels.JourneyProgrammeDTO
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-programmes/{programmeId} [get]
+func (h *JourneyProgrammeHandlers) GetJourneyProgramme(w http.ResponseWriter, r *http.Request) {
+	programme, ok := fetchOrFail(w, r, func(ctx context.Context) (*readmodels.JourneyProgrammeDTO, error) {
+		return h.queries.GetByID(ctx, sharedAPI.GetPathParam(r, "programmeId"))
+	})
+	if !ok {
+		return
+	}
+	if programme == nil {
+		sharedAPI.HandleError(w, repositories.ErrJourneyProgrammeNotFound)
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.decorateProgramme(programme, actor)
+	sharedAPI.RespondJSON(w, http.StatusOK, programme)
+}
+
+// CreateJourneyProgramme godoc
+// @Summary Create a journey programme
+// @Tags journey-programmes
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param body body JourneyProgrammeRequest true "Programme data"
+// @Success 201 {object} readmodels.JourneyProgrammeDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-programmes [post]
+func (h *JourneyProgrammeHandlers) CreateJourneyProgramme(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[JourneyProgrammeRequest](w, r)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	result, err := h.commandBus.Dispatch(r.Context(), &commands.CreateJourneyProgramme{
+		Name: req.Name, Description: req.Description, Actor: actor.Email,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	h.respondWithProgramme(w, r, result.CreatedID, http.StatusCreated)
+}
+
+// UpdateJourneyProgramme godoc
+// @Summary Rename or re-describe a journey programme
+// @Tags journey-programmes
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param programmeId path string true "Programme ID"
+// @Param body body JourneyProgrammeRequest true "Programme data"
+// @Success 200 {object} readmodels.JourneyProgrammeDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-programmes/{programmeId} [put]
+func (h *JourneyProgrammeHandlers) UpdateJourneyProgramme(w http.ResponseWriter, r *http.Request) {
+	programmeID := sharedAPI.GetPathParam(r, "programmeId")
+	req, ok := sharedAPI.DecodeRequestOrFail[JourneyProgrammeRequest](w, r)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.dispatchAndRespond(w, r, programmeID, http.StatusOK, &commands.UpdateJourneyProgramme{
+		ProgrammeID: programmeID, Name: req.Name, Description: req.Description, Actor: actor.Email,
+	})
+}
+
+// DeleteJourneyProgramme godoc
+// @Summary Delete a journey programme
+// @Description Dissolves the programme; its journeys are kept and can join another programme.
+// @Tags journey-programmes
+// @Security CookieAuth
+// @Param programmeId path string true "Programme ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-programmes/{programmeId} [delete]
+func (h *JourneyProgrammeHandlers) DeleteJourneyProgramme(w http.ResponseWriter, r *http.Request) {
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.dispatchNoContent(w, r, &commands.DeleteJourneyProgramme{
+		ProgrammeID: sharedAPI.GetPathParam(r, "programmeId"), Actor: actor.Email,
+	})
+}
+
+// AddJourneyToProgramme godoc
+// @Summary Add a journey to a programme
+// @Description A journey belongs to at most one programme.
+// @Tags journey-programmes
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param programmeId path string true "Programme ID"
+// @Param body body AddJourneyToProgrammeRequest true "Journey reference"
+// @Success 201 {object} readmodels.JourneyProgrammeDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-programmes/{programmeId}/journeys [post]
+func (h *JourneyProgrammeHandlers) AddJourneyToProgramme(w http.ResponseWriter, r *http.Request) {
+	programmeID := sharedAPI.GetPathParam(r, "programmeId")
+	req, ok := sharedAPI.DecodeRequestOrFail[AddJourneyToProgrammeRequest](w, r)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.dispatchAndRespond(w, r, programmeID, http.StatusCreated, &commands.AddJourneyToProgramme{
+		ProgrammeID: programmeID, JourneyID: req.JourneyID, Actor: actor.Email,
+	})
+}
+
+// RemoveJourneyFromProgramme godoc
+// @Summary Remove a journey from a programme
+// @Tags journey-programmes
+// @Security CookieAuth
+// @Param programmeId path string true "Programme ID"
+// @Param journeyId path string true "Journey ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-programmes/{programmeId}/journeys/{journeyId} [delete]
+func (h *JourneyProgrammeHandlers) RemoveJourneyFromProgramme(w http.ResponseWriter, r *http.Request) {
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.dispatchNoContent(w, r, &commands.RemoveJourneyFromProgramme{
+		ProgrammeID: sharedAPI.GetPathParam(r, "programmeId"),
+		JourneyID:   sharedAPI.GetPathParam(r, "journeyId"),
+		Actor:       actor.Email,
+	})
+}
+
+func (h *JourneyProgrammeHandlers) dispatchAndRespond(w http.ResponseWriter, r *http.Request, programmeID string, statusCode int, cmd cqrs.Command) {
+	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	h.respondWithProgramme(w, r, programmeID, statusCode)
+}
+
+func (h *JourneyProgrammeHandlers) dispatchNoContent(w http.ResponseWriter, r *http.Request, cmd cqrs.Command) {
+	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	sharedAPI.RespondNoContent(w)
+}
+
+func (h *JourneyProgrammeHandlers) respondWithProgramme(w http.ResponseWriter, r *http.Request, programmeID string, statusCode int) {
+	programme, err := h.queries.GetByID(r.Context(), programmeID)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	if programme == nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, errJourneyProgrammeMissingAfterMutation, "failed to load journey programme after mutation")
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.decorateProgramme(programme, actor)
+	if statusCode == http.StatusCreated {
+		sharedAPI.RespondCreated(w, sharedAPI.BuildResourceLink(journeyProgrammesPath, sharedAPI.ResourceID(programmeID)), programme)
+		return
+	}
+	sharedAPI.RespondJSON(w, statusCode, programme)
+}
+
+func (h *JourneyProgrammeHandlers) decorateProgramme(programme *readmodels.JourneyProgrammeDTO, actor sharedctx.Actor) {
+	programme.Links = h.hateoas.ItemLinks(programme.ID, actor)
+	for i := range programme.Journeys {
+		programme.Journeys[i].Links = h.hateoas.MemberLinks(programme.ID, programme.Journeys[i], actor)
+	}
+}
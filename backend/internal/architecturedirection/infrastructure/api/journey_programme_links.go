@@ -0,0 +1,51 @@
+package api
+
+import (
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+const (
+	journeyProgrammesPath        sharedAPI.ResourcePath = "/journey-programmes"
+	journeyProgrammeJourneysPath sharedAPI.ResourcePath = "/journeys"
+)
+
+type JourneyProgrammeLinks struct {
+	*sharedAPI.HATEOASLinks
+}
+
+func NewJourneyProgrammeLinks(h *sharedAPI.HATEOASLinks) *JourneyProgrammeLinks {
+	return &JourneyProgrammeLinks{HATEOASLinks: h}
+}
+
+func (h *JourneyProgrammeLinks) ItemLinks(programmeID string, actor sharedctx.Actor) sharedAPI.Links {
+	base := journeyProgrammeResourcePath(programmeID)
+	links := sharedAPI.Links{"self": h.Get(base)}
+	if actor.CanWrite(ArchitectureDirectionResource) {
+		links["edit"] = h.Put(base)
+		links["delete"] = h.Del(base)
+		links["x-add-journey"] = h.Post(base + string(journeyProgrammeJourneysPath))
+	}
+	return links
+}
+
+func (h *JourneyProgrammeLinks) MemberLinks(programmeID string, journey readmodels.ProgrammeJourneyDTO, actor sharedctx.Actor) sharedAPI.Links {
+	links := sharedAPI.Links{"x-journey": h.Get(journeyResourcePath(journey.CapabilityID))}
+	if actor.CanWrite(ArchitectureDirectionResource) {
+		links["x-remove"] = h.Del(journeyProgrammeResourcePath(programmeID) + string(journeyProgrammeJourneysPath) + "/" + journey.JourneyID)
+	}
+	return links
+}
+
+func (h *JourneyProgrammeLinks) CollectionLinks(actor sharedctx.Actor) sharedAPI.Links {
+	links := sharedAPI.Links{"self": h.Get(string(journeyProgrammesPath))}
+	if actor.CanWrite(ArchitectureDirectionResource) {
+		links["create"] = h.Post(string(journeyProgrammesPath))
+	}
+	return links
+}
+
+func journeyProgrammeResourcePath(programmeID string) string {
+	return string(journeyProgrammesPath) + "/" + programmeID
+}
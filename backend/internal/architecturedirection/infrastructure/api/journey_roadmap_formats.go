@@ -0,0 +1,292 @@
+package api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/handlers"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+)
+
+const (
+	roadmapTitle         = "Architecture roadmap"
+	icalDateFormat       = "20060102"
+	icalStampFormat      = "20060102T150405Z"
+	msProjectDateFormat  = "2006-01-02T15:04:05"
+	ganttDateFormat      = "2006-01-02"
+	icalMaxLineOctets    = 75
+	msProjectDayStarts   = 8 * time.Hour
+	msProjectDayFinishes = 17 * time.Hour
+)
+
+type roadmapRenderer func(w io.Writer, roadmap *handlers.JourneyRoadmap, generatedAt time.Time) error
+
+type roadmapFormat struct {
+	contentType string
+	filename    string
+	render      roadmapRenderer
+}
+
+var roadmapFormats = map[string]roadmapFormat{
+	"ical":      {contentType: "text/calendar; charset=utf-8", filename: "roadmap.ics", render: writeRoadmapICal},
+	"msproject": {contentType: "application/xml; charset=utf-8", filename: "roadmap.xml", render: writeRoadmapMSProject},
+	"mermaid":   {contentType: "text/plain; charset=utf-8", filename: "roadmap.mmd", render: writeRoadmapMermaid},
+	"plantuml":  {contentType: "text/plain; charset=utf-8", filename: "roadmap.puml", render: writeRoadmapPlantUML},
+}
+
+var roadmapFormatNames = []string{"ical", "msproject", "mermaid", "plantuml"}
+
+func journeyTitle(j handlers.RoadmapJourney) string {
+	return fmt.Sprintf("%s (%s)", j.CapabilityName, j.Kind)
+}
+
+// writeRoadmapICal emits one VTODO per journey, due at the end of its target
+// quarter, and one all-day VEVENT per milestone related to its journey.
+func writeRoadmapICal(w io.Writer, roadmap *handlers.JourneyRoadmap, generatedAt time.Time) error {
+	stamp := generatedAt.UTC().Format(icalStampFormat)
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//EASI//Architecture Roadmap//EN",
+		"CALSCALE:GREGORIAN",
+		"X-WR-CALNAME:" + icalText(roadmapTitle),
+	}
+	for _, j := range roadmap.Journeys {
+		journeyUID := "journey-" + j.ID + "@easi"
+		lines = append(lines,
+			"BEGIN:VTODO",
+			"UID:"+journeyUID,
+			"DTSTAMP:"+stamp,
+			"SUMMARY:"+icalText(journeyTitle(j)),
+			"DTSTART;VALUE=DATE:"+j.Start.Format(icalDateFormat),
+			"DUE;VALUE=DATE:"+j.Finish.Format(icalDateFormat),
+			"STATUS:"+icalTodoStatus(j.Status),
+			fmt.Sprintf("PERCENT-COMPLETE:%d", j.Progress),
+			"CATEGORIES:"+icalText(j.Kind),
+			"END:VTODO",
+		)
+		for _, m := range j.Milestones {
+			lines = append(lines,
+				"BEGIN:VEVENT",
+				"UID:milestone-"+m.ID+"@easi",
+				"DTSTAMP:"+stamp,
+				"SUMMARY:"+icalText(j.CapabilityName+": "+m.Label),
+				"DTSTART;VALUE=DATE:"+m.Due.Format(icalDateFormat),
+				"DTEND;VALUE=DATE:"+m.Due.AddDate(0, 0, 1).Format(icalDateFormat),
+				"RELATED-TO:"+journeyUID,
+				"DESCRIPTION:"+icalText("Milestone status: "+m.Status),
+				"TRANSP:TRANSPARENT",
+				"END:VEVENT",
+			)
+		}
+	}
+	lines = append(lines, "END:VCALENDAR")
+	for _, line := range lines {
+		if _, err := io.WriteString(w, foldICalLine(line)+"\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func icalTodoStatus(status string) string {
+	if status == valueobjects.JourneyStatusInFlight {
+		return "IN-PROCESS"
+	}
+	return "NEEDS-ACTION"
+}
+
+var icalTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func icalText(s string) string {
+	return icalTextEscaper.Replace(s)
+}
+
+// foldICalLine splits content lines longer than 75 octets as RFC 5545
+// requires, without breaking a multi-byte character.
+func foldICalLine(line string) string {
+	if len(line) <= icalMaxLineOctets {
+		return line
+	}
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > icalMaxLineOctets {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
+
+type msProjectDocument struct {
+	XMLName      xml.Name        `xml:"Project"`
+	Xmlns        string          `xml:"xmlns,attr"`
+	Name         string          `xml:"Name"`
+	Title        string          `xml:"Title"`
+	CreationDate string          `xml:"CreationDate"`
+	StartDate    string          `xml:"StartDate,omitempty"`
+	Tasks        []msProjectTask `xml:"Tasks>Task"`
+}
+
+type msProjectTask struct {
+	UID             int    `xml:"UID"`
+	ID              int    `xml:"ID"`
+	Name            string `xml:"Name"`
+	OutlineLevel    int    `xml:"OutlineLevel"`
+	Start           string `xml:"Start"`
+	Finish          string `xml:"Finish"`
+	Milestone       int    `xml:"Milestone"`
+	Summary         int    `xml:"Summary"`
+	PercentComplete int    `xml:"PercentComplete"`
+	Notes           string `xml:"Notes,omitempty"`
+}
+
+// writeRoadmapMSProject emits an MS Project XML (MSPDI) document: each journey
+// is a summary task with its milestones as zero-length child tasks.
+func writeRoadmapMSProject(w io.Writer, roadmap *handlers.JourneyRoadmap, generatedAt time.Time) error {
+	doc := msProjectDocument{
+		Xmlns:        "http://schemas.microsoft.com/project",
+		Name:         "roadmap.xml",
+		Title:        roadmapTitle,
+		CreationDate: generatedAt.UTC().Format(msProjectDateFormat),
+		Tasks:        []msProjectTask{},
+	}
+	if start, ok := roadmapStart(roadmap); ok {
+		doc.StartDate = start.Add(msProjectDayStarts).Format(msProjectDateFormat)
+	}
+	next := 1
+	for _, j := range roadmap.Journeys {
+		doc.Tasks = append(doc.Tasks, msProjectTask{
+			UID: next, ID: next, Name: journeyTitle(j), OutlineLevel: 1,
+			Start:           j.Start.Add(msProjectDayStarts).Format(msProjectDateFormat),
+			Finish:          j.Finish.Add(msProjectDayFinishes).Format(msProjectDateFormat),
+			Summary:         boolFlag(len(j.Milestones) > 0),
+			PercentComplete: j.Progress,
+			Notes:           "EASI journey " + j.ID + " (" + j.Status + ")",
+		})
+		next++
+		for _, m := range j.Milestones {
+			at := m.Due.Add(msProjectDayFinishes).Format(msProjectDateFormat)
+			doc.Tasks = append(doc.Tasks, msProjectTask{
+				UID: next, ID: next, Name: m.Label, OutlineLevel: 2,
+				Start: at, Finish: at, Milestone: 1,
+				PercentComplete: milestonePercent(m.Status),
+				Notes:           "EASI milestone " + m.ID,
+			})
+			next++
+		}
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder.Encode(doc)
+}
+
+func boolFlag(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func milestonePercent(status string) int {
+	if status == valueobjects.MilestoneStatusDone {
+		return 100
+	}
+	return 0
+}
+
+// writeRoadmapMermaid emits a Mermaid gantt chart with one section per journey.
+func writeRoadmapMermaid(w io.Writer, roadmap *handlers.JourneyRoadmap, _ time.Time) error {
+	var b strings.Builder
+	b.WriteString("gantt\n")
+	b.WriteString("    title " + roadmapTitle + "\n")
+	b.WriteString("    dateFormat YYYY-MM-DD\n")
+	for i, j := range roadmap.Journeys {
+		journeyID := fmt.Sprintf("j%d", i+1)
+		fmt.Fprintf(&b, "    section %s\n", mermaidText(journeyTitle(j)))
+		fmt.Fprintf(&b, "    %s :%s%s, %s, %s\n",
+			mermaidText(j.Kind), mermaidJourneyTag(j.Status), journeyID,
+			j.Start.Format(ganttDateFormat), j.Finish.Format(ganttDateFormat))
+		for k, m := range j.Milestones {
+			fmt.Fprintf(&b, "    %s :%smilestone, %sm%d, %s, 0d\n",
+				mermaidText(m.Label), mermaidMilestoneTag(m.Status), journeyID, k+1, m.Due.Format(ganttDateFormat))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func mermaidJourneyTag(status string) string {
+	if status == valueobjects.JourneyStatusInFlight {
+		return "active, "
+	}
+	return ""
+}
+
+func mermaidMilestoneTag(status string) string {
+	switch status {
+	case valueobjects.MilestoneStatusDone:
+		return "done, "
+	case valueobjects.MilestoneStatusInFlight:
+		return "active, "
+	}
+	return ""
+}
+
+// mermaidText strips the characters Mermaid uses as gantt syntax.
+var mermaidText = strings.NewReplacer(":", " -", "#", "", ";", ",", "\n", " ", "\r", "").Replace
+
+// writeRoadmapPlantUML emits a PlantUML gantt diagram with one separator per
+// journey. PlantUML identifies tasks by name, so milestone names carry their
+// capability to stay unique across journeys.
+func writeRoadmapPlantUML(w io.Writer, roadmap *handlers.JourneyRoadmap, _ time.Time) error {
+	var b strings.Builder
+	b.WriteString("@startgantt\n")
+	b.WriteString("title " + roadmapTitle + "\n")
+	if start, ok := roadmapStart(roadmap); ok {
+		b.WriteString("Project starts " + start.Format(ganttDateFormat) + "\n")
+	}
+	for _, j := range roadmap.Journeys {
+		name := plantUMLText(journeyTitle(j))
+		fmt.Fprintf(&b, "-- %s --\n", name)
+		fmt.Fprintf(&b, "[%s] starts %s and ends %s\n", name, j.Start.Format(ganttDateFormat), j.Finish.Format(ganttDateFormat))
+		if j.Progress > 0 {
+			fmt.Fprintf(&b, "[%s] is %d%% completed\n", name, j.Progress)
+		}
+		for _, m := range j.Milestones {
+			fmt.Fprintf(&b, "[%s] happens %s\n", plantUMLText(j.CapabilityName+": "+m.Label), m.Due.Format(ganttDateFormat))
+		}
+	}
+	b.WriteString("@endgantt\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var plantUMLText = strings.NewReplacer("[", "(", "]", ")", "\n", " ", "\r", "").Replace
+
+func roadmapStart(roadmap *handlers.JourneyRoadmap) (time.Time, bool) {
+	var earliest time.Time
+	found := false
+	consider := func(t time.Time) {
+		if !found || t.Before(earliest) {
+			earliest, found = t, true
+		}
+	}
+	for _, j := range roadmap.Journeys {
+		consider(j.Start)
+		for _, m := range j.Milestones {
+			consider(m.Due)
+		}
+	}
+	return earliest, found
+}
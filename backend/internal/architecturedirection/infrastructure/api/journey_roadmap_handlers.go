@@ -0,0 +1,195 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/handlers"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	sharedAPI "easi/backend/internal/shared/api"
+	"easi/backend/internal/shared/types"
+)
+
+const journeyRoadmapPath = "/journey-roadmap"
+
+var ErrUnknownRoadmapFormat = errors.New("roadmap format must be one of json, ical, msproject, mermaid, plantuml")
+
+type JourneyRoadmapHandlers struct {
+	query *handlers.JourneyRoadmapQuery
+	links *sharedAPI.HATEOASLinks
+	now   func() time.Time
+}
+
+func NewJourneyRoadmapHandlers(query *handlers.JourneyRoadmapQuery, links *sharedAPI.HATEOASLinks) *JourneyRoadmapHandlers {
+	return &JourneyRoadmapHandlers{query: query, links: links, now: time.Now}
+}
+
+type RoadmapMilestoneResponse struct {
+	ID     string `json:"id"`
+	Label  string `json:"label"`
+	Status string `json:"status"`
+	Due    string `json:"due"`
+}
+
+type RoadmapJourneyResponse struct {
+	ID             string                     `json:"id"`
+	CapabilityID   string                     `json:"capabilityId"`
+	CapabilityName string                     `json:"capabilityName"`
+	Kind           string                     `json:"kind"`
+	Status         string                     `json:"status"`
+	Progress       int                        `json:"progress"`
+	Start          string                     `json:"start"`
+	Finish         string                     `json:"finish"`
+	Milestones     []RoadmapMilestoneResponse `json:"milestones"`
+	Links          types.Links                `json:"_links"`
+}
+
+type JourneyRoadmapResponse struct {
+	BusinessDomainID        string                   `json:"businessDomainId,omitempty"`
+	ProgrammeID             string                   `json:"programmeId,omitempty"`
+	ProgrammeName           string                   `json:"programmeName,omitempty"`
+	Kinds                   []string                 `json:"kinds,omitempty"`
+	UnscheduledJourneyCount int                      `json:"unscheduledJourneyCount"`
+	Journeys                []RoadmapJourneyResponse `json:"journeys"`
+	Links                   types.Links              `json:"_links"`
+}
+
+// GetJourneyRoadmap godoc
+// @Summary Export the journey roadmap
+// @Description Schedules active journeys from their start (or target quarter start) to the end of their target quarter, with dated milestones. Journeys without a target period are counted but not scheduled. Use format to export as iCalendar, MS Project XML, or a Mermaid / PlantUML Gantt chart.
+// @Tags capability-journeys
+// @Produce json
+// @Produce text/calendar
+// @Produce application/xml
+// @Produce text/plain
+// @Security CookieAuth
+// @Param businessDomainId query string false "Restrict to journeys on capabilities in this business domain"
+// @Param programmeId query string false "Restrict to journeys in this programme"
+// @Param kind query string false "Comma-separated journey kinds" Enums(migration, consolidation, carve-out, move)
+// @Param format query string false "Response format" Enums(json, ical, msproject, mermaid, plantuml)
+// @Success 200 {object} JourneyRoadmapResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-roadmap [get]
+func (h *JourneyRoadmapHandlers) GetJourneyRoadmap(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	formatName := strings.ToLower(params.Get("format"))
+	format, known := roadmapFormats[formatName]
+	if !known && formatName != "" && formatName != "json" {
+		sharedAPI.HandleError(w, ErrUnknownRoadmapFormat)
+		return
+	}
+	req, err := parseRoadmapRequest(params)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	roadmap, err := h.query.Execute(r.Context(), req)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	if known {
+		h.writeExport(w, format, roadmap)
+		return
+	}
+	sharedAPI.RespondJSON(w, http.StatusOK, h.buildResponse(params, roadmap))
+}
+
+func parseRoadmapRequest(params url.Values) (handlers.JourneyRoadmapRequest, error) {
+	req := handlers.JourneyRoadmapRequest{
+		BusinessDomainID: params.Get("businessDomainId"),
+		ProgrammeID:      params.Get("programmeId"),
+	}
+	for _, raw := range strings.Split(params.Get("kind"), ",") {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		kind, err := valueobjects.NewJourneyKind(strings.TrimSpace(raw))
+		if err != nil {
+			return handlers.JourneyRoadmapRequest{}, err
+		}
+		req.Kinds = append(req.Kinds, kind)
+	}
+	return req, nil
+}
+
+// writeExport renders into a buffer first so a rendering failure can still
+// be reported as an error response rather than a truncated download.
+func (h *JourneyRoadmapHandlers) writeExport(w http.ResponseWriter, format roadmapFormat, roadmap *handlers.JourneyRoadmap) {
+	var body bytes.Buffer
+	if err := format.render(&body, roadmap, h.now()); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", format.contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+format.filename+`"`)
+	w.Header().Set("X-Unscheduled-Journeys", strconv.Itoa(roadmap.UnscheduledCount))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body.Bytes())
+}
+
+func (h *JourneyRoadmapHandlers) buildResponse(params url.Values, roadmap *handlers.JourneyRoadmap) JourneyRoadmapResponse {
+	response := JourneyRoadmapResponse{
+		BusinessDomainID:        roadmap.Request.BusinessDomainID,
+		ProgrammeID:             roadmap.Request.ProgrammeID,
+		ProgrammeName:           roadmap.ProgrammeName,
+		UnscheduledJourneyCount: roadmap.UnscheduledCount,
+		Journeys:                make([]RoadmapJourneyResponse, len(roadmap.Journeys)),
+		Links:                   h.roadmapLinks(params, roadmap.Request),
+	}
+	for _, kind := range roadmap.Request.Kinds {
+		response.Kinds = append(response.Kinds, kind.Value())
+	}
+	for i, j := range roadmap.Journeys {
+		milestones := make([]RoadmapMilestoneResponse, len(j.Milestones))
+		for k, m := range j.Milestones {
+			milestones[k] = RoadmapMilestoneResponse{ID: m.ID, Label: m.Label, Status: m.Status, Due: m.Due.Format(ganttDateFormat)}
+		}
+		response.Journeys[i] = RoadmapJourneyResponse{
+			ID:             j.ID,
+			CapabilityID:   j.CapabilityID,
+			CapabilityName: j.CapabilityName,
+			Kind:           j.Kind,
+			Status:         j.Status,
+			Progress:       j.Progress,
+			Start:          j.Start.Format(ganttDateFormat),
+			Finish:         j.Finish.Format(ganttDateFormat),
+			Milestones:     milestones,
+			Links:          types.Links{"x-journey": h.links.Get(journeyResourcePath(j.CapabilityID))},
+		}
+	}
+	return response
+}
+
+func (h *JourneyRoadmapHandlers) roadmapLinks(params url.Values, req handlers.JourneyRoadmapRequest) types.Links {
+	query := url.Values{}
+	for _, key := range []string{"businessDomainId", "programmeId", "kind"} {
+		if v := params.Get(key); v != "" {
+			query.Set(key, v)
+		}
+	}
+	self := journeyRoadmapPath
+	if encoded := query.Encode(); encoded != "" {
+		self += "?" + encoded
+	}
+	links := types.Links{"self": h.links.Get(self)}
+	for _, name := range roadmapFormatNames {
+		query.Set("format", name)
+		links["x-export-"+name] = h.links.Get(journeyRoadmapPath + "?" + query.Encode())
+	}
+	if req.ProgrammeID != "" {
+		links["x-programme"] = h.links.Get(journeyProgrammeResourcePath(req.ProgrammeID))
+	}
+	return links
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/handlers"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRoadmapProgrammes struct{}
+
+func (stubRoadmapProgrammes) GetByID(context.Context, string) (*readmodels.JourneyProgrammeDTO, error) {
+	return nil, nil
+}
+
+func sampleRoadmap() *handlers.JourneyRoadmap {
+	return &handlers.JourneyRoadmap{
+		Journeys: []handlers.RoadmapJourney{{
+			ID:             "j-1",
+			CapabilityID:   "cap-1",
+			CapabilityName: "Payments, Billing; Invoicing",
+			Kind:           "migration",
+			Status:         "in-flight",
+			Progress:       40,
+			Start:          time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Finish:         time.Date(2026, time.June, 30, 0, 0, 0, 0, time.UTC),
+			Milestones: []handlers.RoadmapMilestone{
+				{ID: "m-1", Label: "Cutover: wave 1", Status: "done", Due: time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC)},
+			},
+		}},
+	}
+}
+
+var roadmapGeneratedAt = time.Date(2026, time.October, 18, 9, 30, 0, 0, time.UTC)
+
+func TestWriteRoadmapICal_EmitsTodoPerJourneyAndEventPerMilestone(t *testing.T) {
+	var out strings.Builder
+	require.NoError(t, writeRoadmapICal(&out, sampleRoadmap(), roadmapGeneratedAt))
+
+	ical := out.String()
+	assert.True(t, strings.HasPrefix(ical, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.Contains(t, ical, "BEGIN:VTODO\r\nUID:journey-j-1@easi\r\nDTSTAMP:20261018T093000Z\r\n")
+	assert.Contains(t, ical, `SUMMARY:Payments\, Billing\; Invoicing (migration)`)
+	assert.Contains(t, ical, "DUE;VALUE=DATE:20260630\r\n")
+	assert.Contains(t, ical, "STATUS:IN-PROCESS\r\n")
+	assert.Contains(t, ical, "DTSTART;VALUE=DATE:20260331\r\nDTEND;VALUE=DATE:20260401\r\nRELATED-TO:journey-j-1@easi\r\n")
+	assert.True(t, strings.HasSuffix(ical, "END:VCALENDAR\r\n"))
+}
+
+func TestFoldICalLine_FoldsAt75OctetsWithoutSplittingRunes(t *testing.T) {
+	folded := foldICalLine("SUMMARY:" + strings.Repeat("é", 60))
+
+	for _, line := range strings.Split(folded, "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+	assert.Equal(t, "SUMMARY:"+strings.Repeat("é", 60), strings.ReplaceAll(folded, "\r\n ", ""))
+}
+
+func TestWriteRoadmapMSProject_NestsMilestonesUnderJourneySummaryTask(t *testing.T) {
+	var out strings.Builder
+	require.NoError(t, writeRoadmapMSProject(&out, sampleRoadmap(), roadmapGeneratedAt))
+
+	var doc msProjectDocument
+	require.NoError(t, xml.Unmarshal([]byte(out.String()), &doc))
+	require.Len(t, doc.Tasks, 2)
+	assert.Equal(t, "http://schemas.microsoft.com/project", doc.Xmlns)
+	assert.Equal(t, "2026-01-01T08:00:00", doc.StartDate)
+
+	journey, milestone := doc.Tasks[0], doc.Tasks[1]
+	assert.Equal(t, 1, journey.OutlineLevel)
+	assert.Equal(t, 1, journey.Summary)
+	assert.Equal(t, 40, journey.PercentComplete)
+	assert.Equal(t, "2026-06-30T17:00:00", journey.Finish)
+	assert.Equal(t, 2, milestone.OutlineLevel)
+	assert.Equal(t, 1, milestone.Milestone)
+	assert.Equal(t, milestone.Start, milestone.Finish)
+	assert.Equal(t, 100, milestone.PercentComplete)
+}
+
+func TestWriteRoadmapMermaid_SanitisesTaskNames(t *testing.T) {
+	var out strings.Builder
+	require.NoError(t, writeRoadmapMermaid(&out, sampleRoadmap(), roadmapGeneratedAt))
+
+	assert.Equal(t, "gantt\n"+
+		"    title Architecture roadmap\n"+
+		"    dateFormat YYYY-MM-DD\n"+
+		"    section Payments, Billing, Invoicing (migration)\n"+
+		"    migration :active, j1, 2026-01-01, 2026-06-30\n"+
+		"    Cutover - wave 1 :done, milestone, j1m1, 2026-03-31, 0d\n", out.String())
+}
+
+func TestWriteRoadmapPlantUML_UsesProjectStartAndCompletion(t *testing.T) {
+	var out strings.Builder
+	require.NoError(t, writeRoadmapPlantUML(&out, sampleRoadmap(), roadmapGeneratedAt))
+
+	puml := out.String()
+	assert.Contains(t, puml, "Project starts 2026-01-01\n")
+	assert.Contains(t, puml, "[Payments, Billing; Invoicing (migration)] starts 2026-01-01 and ends 2026-06-30\n")
+	assert.Contains(t, puml, "[Payments, Billing; Invoicing (migration)] is 40% completed\n")
+	assert.Contains(t, puml, "[Payments, Billing; Invoicing: Cutover: wave 1] happens 2026-03-31\n")
+	assert.True(t, strings.HasSuffix(puml, "@endgantt\n"))
+}
+
+func newRoadmapTestHandlers(journeys ...readmodels.CapabilityJourneyDTO) *JourneyRoadmapHandlers {
+	query := handlers.NewJourneyRoadmapQuery(&mockCapabilityJourneyQueries{all: journeys}, stubRoadmapProgrammes{}, nil, nil)
+	h := NewJourneyRoadmapHandlers(query, sharedAPI.NewHATEOASLinks(""))
+	h.now = func() time.Time { return roadmapGeneratedAt }
+	return h
+}
+
+func TestGetJourneyRoadmap_JSONListsScheduleAndExportLinks(t *testing.T) {
+	h := newRoadmapTestHandlers(
+		readmodels.CapabilityJourneyDTO{ID: "j-1", CapabilityID: "cap-1", Kind: "migration", Status: "planned", TargetPeriod: &readmodels.TargetPeriodDTO{Year: 2027, Quarter: 2}},
+		readmodels.CapabilityJourneyDTO{ID: "j-2", CapabilityID: "cap-2", Kind: "migration", Status: "planned"},
+	)
+	rec := httptest.NewRecorder()
+	h.GetJourneyRoadmap(rec, httptest.NewRequest(http.MethodGet, "/journey-roadmap?kind=migration", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body JourneyRoadmapResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Journeys, 1)
+	assert.Equal(t, "2027-04-01", body.Journeys[0].Start)
+	assert.Equal(t, "2027-06-30", body.Journeys[0].Finish)
+	assert.Equal(t, 1, body.UnscheduledJourneyCount)
+	assert.Equal(t, []string{"migration"}, body.Kinds)
+	assert.Contains(t, body.Links["x-export-ical"].Href, "/journey-roadmap?format=ical&kind=migration")
+}
+
+func TestGetJourneyRoadmap_ExportFormatSetsDownloadHeaders(t *testing.T) {
+	h := newRoadmapTestHandlers(
+		readmodels.CapabilityJourneyDTO{ID: "j-1", CapabilityID: "cap-1", Kind: "migration", Status: "planned", TargetPeriod: &readmodels.TargetPeriodDTO{Year: 2027, Quarter: 2}},
+	)
+	rec := httptest.NewRecorder()
+	h.GetJourneyRoadmap(rec, httptest.NewRequest(http.MethodGet, "/journey-roadmap?format=ical", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="roadmap.ics"`, rec.Header().Get("Content-Disposition"))
+	assert.Equal(t, "0", rec.Header().Get("X-Unscheduled-Journeys"))
+	assert.Contains(t, rec.Body.String(), "UID:journey-j-1@easi")
+}
+
+func TestGetJourneyRoadmap_RejectsUnknownFormatAndKind(t *testing.T) {
+	h := newRoadmapTestHandlers()
+
+	for _, query := range []string{"format=pdf", "kind=teleport"} {
+		rec := httptest.NewRecorder()
+		h.GetJourneyRoadmap(rec, httptest.NewRequest(http.MethodGet, "/journey-roadmap?"+query, nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
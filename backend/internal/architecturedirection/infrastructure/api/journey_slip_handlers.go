@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/infrastructure/repositories"
+	sharedAPI "easi/backend/internal/shared/api"
+)
+
+const journeySlipsPath sharedAPI.ResourcePath = "/journey-slips"
+
+type JourneySlipQueries interface {
+	GetByID(ctx context.Context, journeyID string) (*readmodels.CapabilityJourneyDTO, error)
+	GetSlipHistory(ctx context.Context, journeyID string) ([]readmodels.JourneySlipEntryDTO, error)
+	GetSlipReport(ctx context.Context) ([]readmodels.JourneySlipDTO, error)
+}
+
+type JourneySlipHandlers struct {
+	queries JourneySlipQueries
+	links   *sharedAPI.HATEOASLinks
+}
+
+func NewJourneySlipHandlers(queries JourneySlipQueries, links *sharedAPI.HATEOASLinks) *JourneySlipHandlers {
+	return &JourneySlipHandlers{queries: queries, links: links}
+}
+
+type MilestoneBaselineResponse struct {
+	ID                   string                      `json:"id"`
+	Label                string                      `json:"label"`
+	TargetPeriod         *readmodels.TargetPeriodDTO `json:"targetPeriod"`
+	BaselineTargetPeriod *readmodels.TargetPeriodDTO `json:"baselineTargetPeriod"`
+}
+
+type JourneySlipHistoryResponse struct {
+	JourneyID            string                           `json:"journeyId"`
+	CapabilityID         string                           `json:"capabilityId"`
+	CapabilityName       string                           `json:"capabilityName"`
+	Status               string                           `json:"status"`
+	TargetPeriod         *readmodels.TargetPeriodDTO      `json:"targetPeriod"`
+	BaselineTargetPeriod *readmodels.TargetPeriodDTO      `json:"baselineTargetPeriod"`
+	BaselinedAt          *time.Time                       `json:"baselinedAt"`
+	Milestones           []MilestoneBaselineResponse      `json:"milestones"`
+	Slips                []readmodels.JourneySlipEntryDTO `json:"slips"`
+	Links                sharedAPI.Links                  `json:"_links"`
+}
+
+// GetJourneySlipReport godoc
+// @Summary Report journeys that slipped past their baseline
+// @Description Lists in-flight journeys and milestones whose current target period is later than the baseline agreed when the journey was started, with the number of quarters slipped and the recorded target moves (who moved it and when). Most slipped first.
+// @Tags capability-journeys
+// @Produce json
+// @Security CookieAuth
+// @Success 200 {object} sharedAPI.CollectionResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-slips [get]
+func (h *JourneySlipHandlers) GetJourneySlipReport(w http.ResponseWriter, r *http.Request) {
+	report, ok := fetchOrFail(w, r, h.queries.GetSlipReport)
+	if !ok {
+		return
+	}
+	for i := range report {
+		report[i].Links = sharedAPI.Links{
+			"x-journey": h.links.Get(journeyResourcePath(report[i].CapabilityID)),
+			"x-slips":   h.links.Get(journeySlipResourcePath(report[i].JourneyID)),
+		}
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, report, sharedAPI.Links{"self": h.links.Get(string(journeySlipsPath))})
+}
+
+// GetJourneySlipHistory godoc
+// @Summary Get a journey's baseline and slip history
+// @Description Returns the baseline target periods fixed when the journey was started next to the current ones, and every target move made since, oldest first. A journey that has not been started has no baseline and no slips.
+// @Tags capability-journeys
+// @Produce json
+// @Security CookieAuth
+// @Param journeyId path string true "Journey ID"
+// @Success 200 {object} JourneySlipHistoryResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-slips/{journeyId} [get]
+func (h *JourneySlipHandlers) GetJourneySlipHistory(w http.ResponseWriter, r *http.Request) {
+	journeyID := sharedAPI.GetPathParam(r, "journeyId")
+	journey, ok := fetchOrFail(w, r, func(ctx context.Context) (*readmodels.CapabilityJourneyDTO, error) {
+		return h.queries.GetByID(ctx, journeyID)
+	})
+	if !ok {
+		return
+	}
+	if journey == nil {
+		sharedAPI.HandleError(w, repositories.ErrCapabilityJourneyNotFound)
+		return
+	}
+	slips, ok := fetchOrFail(w, r, func(ctx context.Context) ([]readmodels.JourneySlipEntryDTO, error) {
+		return h.queries.GetSlipHistory(ctx, journeyID)
+	})
+	if !ok {
+		return
+	}
+	sharedAPI.RespondJSON(w, http.StatusOK, h.buildHistory(journey, slips))
+}
+
+func (h *JourneySlipHandlers) buildHistory(journey *readmodels.CapabilityJourneyDTO, slips []readmodels.JourneySlipEntryDTO) JourneySlipHistoryResponse {
+	response := JourneySlipHistoryResponse{
+		JourneyID:            journey.ID,
+		CapabilityID:         journey.CapabilityID,
+		CapabilityName:       journey.CapabilityName,
+		Status:               journey.Status,
+		TargetPeriod:         journey.TargetPeriod,
+		BaselineTargetPeriod: journey.BaselineTargetPeriod,
+		BaselinedAt:          journey.BaselinedAt,
+		Milestones:           make([]MilestoneBaselineResponse, len(journey.Milestones)),
+		Slips:                slips,
+		Links: sharedAPI.Links{
+			"self":      h.links.Get(journeySlipResourcePath(journey.ID)),
+			"x-journey": h.links.Get(journeyResourcePath(journey.CapabilityID)),
+			"x-report":  h.links.Get(string(journeySlipsPath)),
+		},
+	}
+	for i, m := range journey.Milestones {
+		response.Milestones[i] = MilestoneBaselineResponse{
+			ID: m.ID, Label: m.Label, TargetPeriod: m.TargetPeriod, BaselineTargetPeriod: m.BaselineTargetPeriod,
+		}
+	}
+	return response
+}
+
+func journeySlipResourcePath(journeyID string) string {
+	return string(journeySlipsPath) + "/" + journeyID
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubJourneySlipQueries struct {
+	journey *readmodels.CapabilityJourneyDTO
+	history []readmodels.JourneySlipEntryDTO
+	report  []readmodels.JourneySlipDTO
+}
+
+func (s *stubJourneySlipQueries) GetByID(context.Context, string) (*readmodels.CapabilityJourneyDTO, error) {
+	return s.journey, nil
+}
+
+func (s *stubJourneySlipQueries) GetSlipHistory(context.Context, string) ([]readmodels.JourneySlipEntryDTO, error) {
+	return s.history, nil
+}
+
+func (s *stubJourneySlipQueries) GetSlipReport(context.Context) ([]readmodels.JourneySlipDTO, error) {
+	return s.report, nil
+}
+
+func journeySlipRouter(queries JourneySlipQueries) chi.Router {
+	h := NewJourneySlipHandlers(queries, sharedAPI.NewHATEOASLinks(""))
+	r := chi.NewRouter()
+	r.Get("/journey-slips", h.GetJourneySlipReport)
+	r.Get("/journey-slips/{journeyId}", h.GetJourneySlipHistory)
+	return r
+}
+
+func TestGetJourneySlipReport_LinksEachEntryToItsJourney(t *testing.T) {
+	r := journeySlipRouter(&stubJourneySlipQueries{report: []readmodels.JourneySlipDTO{
+		{JourneyID: "j-1", CapabilityID: "cap-1", QuartersSlipped: 2, History: []readmodels.JourneySlipEntryDTO{}},
+	}})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, withActor(httptest.NewRequest(http.MethodGet, "/journey-slips", nil), stakeholderActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body struct {
+		Data  []readmodels.JourneySlipDTO `json:"data"`
+		Links sharedAPI.Links             `json:"_links"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	require.Len(t, body.Data, 1)
+	assert.Equal(t, 2, body.Data[0].QuartersSlipped)
+	assert.True(t, strings.HasSuffix(body.Data[0].Links["x-journey"].Href, "/capabilities/cap-1/journey"))
+	assert.True(t, strings.HasSuffix(body.Data[0].Links["x-slips"].Href, "/journey-slips/j-1"))
+	assert.True(t, strings.HasSuffix(body.Links["self"].Href, "/journey-slips"))
+}
+
+func TestGetJourneySlipHistory_ReturnsBaselinesAndMoves(t *testing.T) {
+	baselinedAt := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	journey := plannedJourneyDTO()
+	journey.TargetPeriod = &readmodels.TargetPeriodDTO{Year: 2026, Quarter: 4}
+	journey.BaselineTargetPeriod = &readmodels.TargetPeriodDTO{Year: 2026, Quarter: 2}
+	journey.BaselinedAt = &baselinedAt
+	journey.Milestones = []readmodels.CapabilityJourneyMilestoneDTO{{ID: "m-1", Label: "Cutover"}}
+	moved := 2
+	r := journeySlipRouter(&stubJourneySlipQueries{journey: journey, history: []readmodels.JourneySlipEntryDTO{
+		{From: journey.BaselineTargetPeriod, To: journey.TargetPeriod, QuartersMoved: &moved, MovedBy: "a@example.com"},
+	}})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, withActor(httptest.NewRequest(http.MethodGet, "/journey-slips/"+journey.ID, nil), stakeholderActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body JourneySlipHistoryResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Equal(t, journey.ID, body.JourneyID)
+	assert.Equal(t, 2, body.BaselineTargetPeriod.Quarter)
+	assert.Equal(t, 4, body.TargetPeriod.Quarter)
+	require.Len(t, body.Milestones, 1)
+	require.Len(t, body.Slips, 1)
+	assert.Equal(t, "a@example.com", body.Slips[0].MovedBy)
+	assert.True(t, strings.HasSuffix(body.Links["self"].Href, "/journey-slips/"+journey.ID))
+}
+
+func TestGetJourneySlipHistory_UnknownJourney_Returns404(t *testing.T) {
+	r := journeySlipRouter(&stubJourneySlipQueries{})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, withActor(httptest.NewRequest(http.MethodGet, "/journey-slips/missing", nil), stakeholderActor()))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestJourneyItemLinks_BaselinedJourneyLinksToSlips(t *testing.T) {
+	links := NewCapabilityJourneyLinks(sharedAPI.NewHATEOASLinks(""))
+	journey := plannedJourneyDTO()
+
+	assert.NotContains(t, links.ItemLinks(journey, stakeholderActor()), "x-slips")
+
+	baselinedAt := time.Now()
+	journey.BaselinedAt = &baselinedAt
+	assert.True(t, strings.HasSuffix(links.ItemLinks(journey, stakeholderActor())["x-slips"].Href, "/journey-slips/"+journey.ID))
+}
@@ -0,0 +1,180 @@
+//go:build integration
+// +build integration
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	sharedcontext "easi/backend/internal/shared/context"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const journeyDetailsPattern = "/api/v1/capability-journeys/{journeyId}/details"
+const journeyMilestonePattern = "/api/v1/capability-journeys/{journeyId}/milestones/{milestoneId}"
+
+func slipTestContext() context.Context {
+	return sharedcontext.WithTenant(context.Background(), sharedvo.DefaultTenantID())
+}
+
+func capturePlannedJourney(t *testing.T, tc *capabilityJourneyTestContext, target *TargetPeriodRequest) string {
+	t.Helper()
+	capID := uuid.New().String()
+	tc.trackCapability(capID)
+	rec := captureJourneyReq(tc.handlers, capID, CaptureJourneyRequest{
+		Kind:             valueobjects.JourneyKindMigration,
+		FromComponentIDs: []string{uuid.New().String()},
+		ToComponentID:    uuid.New().String(),
+		TargetPeriod:     target,
+	}, architectActor())
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var captured readmodels.CapabilityJourneyDTO
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&captured))
+	return captured.ID
+}
+
+func startJourney(t *testing.T, tc *capabilityJourneyTestContext, journeyID string) readmodels.CapabilityJourneyDTO {
+	t.Helper()
+	rec := runCapabilityJourneyRequest(
+		httptest.NewRequest(http.MethodPost, "/api/v1/capability-journeys/"+journeyID+"/start", nil),
+		journeyTransitionPattern, tc.handlers.StartJourney, architectActor())
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var journey readmodels.CapabilityJourneyDTO
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&journey))
+	return journey
+}
+
+func putJourneyTarget(t *testing.T, tc *capabilityJourneyTestContext, journeyID string, target *TargetPeriodRequest) {
+	t.Helper()
+	body, _ := json.Marshal(UpdateJourneyDetailsRequest{TargetPeriod: target})
+	rec := runCapabilityJourneyRequest(
+		httptest.NewRequest(http.MethodPut, "/api/v1/capability-journeys/"+journeyID+"/details", bytes.NewReader(body)),
+		journeyDetailsPattern, tc.handlers.PutJourneyDetails, architectActor())
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func postMilestone(t *testing.T, tc *capabilityJourneyTestContext, journeyID, label string, target *TargetPeriodRequest) readmodels.CapabilityJourneyMilestoneDTO {
+	t.Helper()
+	body, _ := json.Marshal(AddJourneyMilestoneRequest{Label: label, TargetPeriod: target})
+	rec := runCapabilityJourneyRequest(
+		httptest.NewRequest(http.MethodPost, "/api/v1/capability-journeys/"+journeyID+"/milestones", bytes.NewReader(body)),
+		journeyMilestonesPattern, tc.handlers.PostJourneyMilestone, architectActor())
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var journey readmodels.CapabilityJourneyDTO
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&journey))
+	for _, m := range journey.Milestones {
+		if m.Label == label {
+			return m
+		}
+	}
+	t.Fatalf("milestone %q not in response", label)
+	return readmodels.CapabilityJourneyMilestoneDTO{}
+}
+
+func putMilestoneTarget(t *testing.T, tc *capabilityJourneyTestContext, journeyID string, milestone readmodels.CapabilityJourneyMilestoneDTO, target *TargetPeriodRequest) {
+	t.Helper()
+	body, _ := json.Marshal(UpdateJourneyMilestoneRequest{Label: milestone.Label, TargetPeriod: target, Status: milestone.Status})
+	rec := runCapabilityJourneyRequest(
+		httptest.NewRequest(http.MethodPut, "/api/v1/capability-journeys/"+journeyID+"/milestones/"+milestone.ID, bytes.NewReader(body)),
+		journeyMilestonePattern, tc.handlers.PutJourneyMilestone, architectActor())
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func period(year, quarter int) *TargetPeriodRequest {
+	return &TargetPeriodRequest{Year: year, Quarter: quarter}
+}
+
+func TestJourneySlipIntegration_TargetChangeRecordedOnlyWhenTargetMovesAfterStart(t *testing.T) {
+	tc, cleanup := setupCapabilityJourneyTestDB(t)
+	defer cleanup()
+
+	journeyID := capturePlannedJourney(t, tc, period(2027, 2))
+	putJourneyTarget(t, tc, journeyID, period(2027, 3))
+	startJourney(t, tc, journeyID)
+
+	putJourneyTarget(t, tc, journeyID, period(2027, 3))
+	putJourneyTarget(t, tc, journeyID, period(2028, 1))
+	putJourneyTarget(t, tc, journeyID, nil)
+
+	history, err := tc.readModel.GetSlipHistory(slipTestContext(), journeyID)
+	require.NoError(t, err)
+	require.Len(t, history, 2, "the pre-start move and the unchanged target must not be recorded")
+	assert.Equal(t, &readmodels.TargetPeriodDTO{Year: 2027, Quarter: 3}, history[0].From)
+	assert.Equal(t, &readmodels.TargetPeriodDTO{Year: 2028, Quarter: 1}, history[0].To)
+	require.NotNil(t, history[0].QuartersMoved)
+	assert.Equal(t, 2, *history[0].QuartersMoved)
+	assert.Equal(t, architectActor().Email, history[0].MovedBy)
+	assert.Equal(t, &readmodels.TargetPeriodDTO{Year: 2028, Quarter: 1}, history[1].From)
+	assert.Nil(t, history[1].To, "clearing the target is a move to no period")
+	assert.Nil(t, history[1].QuartersMoved)
+}
+
+func TestJourneySlipIntegration_MilestoneAddedAfterStartIsBaselinedAtItsTarget(t *testing.T) {
+	tc, cleanup := setupCapabilityJourneyTestDB(t)
+	defer cleanup()
+
+	journeyID := capturePlannedJourney(t, tc, period(2027, 4))
+	before := postMilestone(t, tc, journeyID, "Pilot", period(2027, 1))
+	assert.Nil(t, before.BaselineTargetPeriod, "a planned journey has no baseline yet")
+
+	started := startJourney(t, tc, journeyID)
+	require.Len(t, started.Milestones, 1)
+	assert.Equal(t, &readmodels.TargetPeriodDTO{Year: 2027, Quarter: 1}, started.Milestones[0].BaselineTargetPeriod)
+
+	after := postMilestone(t, tc, journeyID, "Decommission", period(2027, 3))
+	assert.Equal(t, &readmodels.TargetPeriodDTO{Year: 2027, Quarter: 3}, after.BaselineTargetPeriod)
+
+	putMilestoneTarget(t, tc, journeyID, after, period(2028, 2))
+
+	history, err := tc.readModel.GetSlipHistory(slipTestContext(), journeyID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, after.ID, history[0].MilestoneID)
+	assert.Equal(t, "Decommission", history[0].MilestoneLabel)
+	assert.Equal(t, &readmodels.TargetPeriodDTO{Year: 2027, Quarter: 3}, history[0].From)
+	assert.Equal(t, &readmodels.TargetPeriodDTO{Year: 2028, Quarter: 2}, history[0].To)
+}
+
+func TestJourneySlipIntegration_SlipReportListsInFlightJourneysAndMilestones(t *testing.T) {
+	tc, cleanup := setupCapabilityJourneyTestDB(t)
+	defer cleanup()
+
+	slipped := capturePlannedJourney(t, tc, period(2027, 2))
+	milestone := postMilestone(t, tc, slipped, "Cut-over", period(2027, 1))
+	startJourney(t, tc, slipped)
+	putJourneyTarget(t, tc, slipped, period(2027, 4))
+	putMilestoneTarget(t, tc, slipped, milestone, period(2027, 2))
+
+	planned := capturePlannedJourney(t, tc, period(2027, 2))
+	putJourneyTarget(t, tc, planned, period(2028, 4))
+
+	report, err := tc.readModel.GetSlipReport(slipTestContext())
+	require.NoError(t, err)
+
+	var entries []readmodels.JourneySlipDTO
+	for _, entry := range report {
+		assert.NotEqual(t, planned, entry.JourneyID, "journeys that were never started have no baseline")
+		if entry.JourneyID == slipped {
+			entries = append(entries, entry)
+		}
+	}
+	require.Len(t, entries, 2)
+	assert.Empty(t, entries[0].MilestoneID, "the journey slipped furthest and is listed first")
+	assert.Equal(t, 2, entries[0].QuartersSlipped)
+	assert.Equal(t, readmodels.TargetPeriodDTO{Year: 2027, Quarter: 2}, entries[0].Baseline)
+	assert.Equal(t, readmodels.TargetPeriodDTO{Year: 2027, Quarter: 4}, entries[0].Current)
+	assert.Equal(t, milestone.ID, entries[1].MilestoneID)
+	assert.Equal(t, "Cut-over", entries[1].MilestoneLabel)
+	assert.Equal(t, 1, entries[1].QuartersSlipped)
+}
@@ -0,0 +1,225 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	"easi/backend/internal/architecturedirection/infrastructure/repositories"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+)
+
+var errJourneyTemplateMissingAfterMutation = errors.New("journey template not found after mutation")
+
+type JourneyTemplateQueries interface {
+	GetAll(ctx context.Context, kind string) ([]readmodels.JourneyTemplateDTO, error)
+	GetByID(ctx context.Context, id string) (*readmodels.JourneyTemplateDTO, error)
+}
+
+type JourneyTemplateHandlers struct {
+	commandBus cqrs.CommandBus
+	queries    JourneyTemplateQueries
+	hateoas    *JourneyTemplateLinks
+}
+
+func NewJourneyTemplateHandlers(commandBus cqrs.CommandBus, queries JourneyTemplateQueries, hateoas *JourneyTemplateLinks) *JourneyTemplateHandlers {
+	return &JourneyTemplateHandlers{commandBus: commandBus, queries: queries, hateoas: hateoas}
+}
+
+type TemplateMilestoneRequest struct {
+	Label          string `json:"label"`
+	OffsetQuarters int    `json:"offsetQuarters"`
+}
+
+type CreateJourneyTemplateRequest struct {
+	Kind       string                     `json:"kind"`
+	Name       string                     `json:"name"`
+	Milestones []TemplateMilestoneRequest `json:"milestones"`
+}
+
+type ReviseJourneyTemplateRequest struct {
+	Name       string                     `json:"name"`
+	Milestones []TemplateMilestoneRequest `json:"milestones"`
+}
+
+// GetJourneyTemplates godoc
+// @Summary List journey templates
+// @Description Returns the current version of every journey template that has not been retired, optionally narrowed to one journey kind.
+// @Tags journey-templates
+// @Produce json
+// @Security CookieAuth
+// @Param kind query string false "Journey kind" Enums(migration, consolidation, carve-out, move)
+// @Success 200 {object} sharedAPI.CollectionResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-templates [get]
+func (h *JourneyTemplateHandlers) GetJourneyTemplates(w http.ResponseWriter, r *http.Request) {
+	kind := r.URL.Query().Get("kind")
+	if kind != "" {
+		if _, err := valueobjects.NewJourneyKind(kind); err != nil {
+			sharedAPI.HandleError(w, err)
+			return
+		}
+	}
+	templates, ok := fetchOrFail(w, r, func(ctx context.Context) ([]readmodels.JourneyTemplateDTO, error) {
+		return h.queries.GetAll(ctx, kind)
+	})
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	for i := range templates {
+		templates[i].Links = h.hateoas.ItemLinks(&templates[i], actor)
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, templates, h.hateoas.CollectionLinks(actor))
+}
+
+// GetJourneyTemplate godoc
+// @Summary Get a journey template
+// @Description Retired templates remain readable so journeys seeded from them can still show their origin.
+// @Tags journey-templates
+// @Produce json
+// @Security CookieAuth
+// @Param templateId path string true "Template ID"
+// @Success 200 {object} readmodels.JourneyTemplateDTO
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-templates/{templateId} [get]
+func (h *JourneyTemplateHandlers) GetJourneyTemplate(w http.ResponseWriter, r *http.Request) {
+	template, ok := fetchOrFail(w, r, func(ctx context.Context) (*readmodels.JourneyTemplateDTO, error) {
+		return h.queries.GetByID(ctx, sharedAPI.GetPathParam(r, "templateId"))
+	})
+	if !ok {
+		return
+	}
+	if template == nil {
+		sharedAPI.HandleError(w, repositories.ErrJourneyTemplateNotFound)
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	template.Links = h.hateoas.ItemLinks(template, actor)
+	sharedAPI.RespondJSON(w, http.StatusOK, template)
+}
+
+// CreateJourneyTemplate godoc
+// @Summary Create a journey template
+// @Description Milestone offsets are counted in quarters after the quarter a journey is planned in.
+// @Tags journey-templates
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param body body CreateJourneyTemplateRequest true "Template data"
+// @Success 201 {object} readmodels.JourneyTemplateDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-templates [post]
+func (h *JourneyTemplateHandlers) CreateJourneyTemplate(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[CreateJourneyTemplateRequest](w, r)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	result, err := h.commandBus.Dispatch(r.Context(), &commands.CreateJourneyTemplate{
+		Kind: req.Kind, Name: req.Name, Milestones: templateMilestoneInputs(req.Milestones), Actor: actor.Email,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	h.respondWithTemplate(w, r, result.CreatedID, http.StatusCreated)
+}
+
+// ReviseJourneyTemplate godoc
+// @Summary Revise a journey template
+// @Description Publishes a new version of the template. Journeys already seeded from an earlier version keep their milestones.
+// @Tags journey-templates
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param templateId path string true "Template ID"
+// @Param body body ReviseJourneyTemplateRequest true "Template data"
+// @Success 200 {object} readmodels.JourneyTemplateDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-templates/{templateId} [put]
+func (h *JourneyTemplateHandlers) ReviseJourneyTemplate(w http.ResponseWriter, r *http.Request) {
+	templateID := sharedAPI.GetPathParam(r, "templateId")
+	req, ok := sharedAPI.DecodeRequestOrFail[ReviseJourneyTemplateRequest](w, r)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	if _, err := h.commandBus.Dispatch(r.Context(), &commands.ReviseJourneyTemplate{
+		TemplateID: templateID, Name: req.Name, Milestones: templateMilestoneInputs(req.Milestones), Actor: actor.Email,
+	}); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	h.respondWithTemplate(w, r, templateID, http.StatusOK)
+}
+
+// RetireJourneyTemplate godoc
+// @Summary Retire a journey template
+// @Description The template can no longer seed new journeys; journeys already seeded from it are unchanged.
+// @Tags journey-templates
+// @Security CookieAuth
+// @Param templateId path string true "Template ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /journey-templates/{templateId} [delete]
+func (h *JourneyTemplateHandlers) RetireJourneyTemplate(w http.ResponseWriter, r *http.Request) {
+	actor, _ := sharedctx.GetActor(r.Context())
+	if _, err := h.commandBus.Dispatch(r.Context(), &commands.RetireJourneyTemplate{
+		TemplateID: sharedAPI.GetPathParam(r, "templateId"), Actor: actor.Email,
+	}); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	sharedAPI.RespondNoContent(w)
+}
+
+func (h *JourneyTemplateHandlers) respondWithTemplate(w http.ResponseWriter, r *http.Request, templateID string, statusCode int) {
+	template, err := h.queries.GetByID(r.Context(), templateID)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	if template == nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, errJourneyTemplateMissingAfterMutation, "failed to load journey template after mutation")
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	template.Links = h.hateoas.ItemLinks(template, actor)
+	if statusCode == http.StatusCreated {
+		sharedAPI.RespondCreated(w, sharedAPI.BuildResourceLink(journeyTemplatesPath, sharedAPI.ResourceID(templateID)), template)
+		return
+	}
+	sharedAPI.RespondJSON(w, statusCode, template)
+}
+
+func templateMilestoneInputs(milestones []TemplateMilestoneRequest) []commands.TemplateMilestoneInput {
+	inputs := make([]commands.TemplateMilestoneInput, len(milestones))
+	for i, m := range milestones {
+		inputs[i] = commands.TemplateMilestoneInput{Label: m.Label, OffsetQuarters: m.OffsetQuarters}
+	}
+	return inputs
+}
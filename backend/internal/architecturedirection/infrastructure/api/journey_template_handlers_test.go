@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubJourneyTemplateQueries struct {
+	templates     []readmodels.JourneyTemplateDTO
+	requestedKind string
+}
+
+func (s *stubJourneyTemplateQueries) GetAll(_ context.Context, kind string) ([]readmodels.JourneyTemplateDTO, error) {
+	s.requestedKind = kind
+	return s.templates, nil
+}
+
+func (s *stubJourneyTemplateQueries) GetByID(_ context.Context, id string) (*readmodels.JourneyTemplateDTO, error) {
+	for _, template := range s.templates {
+		if template.ID == id {
+			return &template, nil
+		}
+	}
+	return nil, nil
+}
+
+func journeyTemplateRouter(bus cqrs.CommandBus, queries JourneyTemplateQueries) chi.Router {
+	h := NewJourneyTemplateHandlers(bus, queries, NewJourneyTemplateLinks(sharedAPI.NewHATEOASLinks("")))
+	r := chi.NewRouter()
+	r.Get("/journey-templates", h.GetJourneyTemplates)
+	r.Get("/journey-templates/{templateId}", h.GetJourneyTemplate)
+	r.Post("/journey-templates", h.CreateJourneyTemplate)
+	r.Put("/journey-templates/{templateId}", h.ReviseJourneyTemplate)
+	r.Delete("/journey-templates/{templateId}", h.RetireJourneyTemplate)
+	return r
+}
+
+func TestGetJourneyTemplates_FiltersByKindAndOffersEditsToArchitects(t *testing.T) {
+	queries := &stubJourneyTemplateQueries{templates: []readmodels.JourneyTemplateDTO{
+		{ID: "tpl-1", Kind: "migration", Name: "Standard migration", Version: 2},
+	}}
+
+	for _, tc := range []struct {
+		actor   sharedctx.Actor
+		canEdit bool
+	}{{architectActor(), true}, {stakeholderActor(), false}} {
+		rec := httptest.NewRecorder()
+		journeyTemplateRouter(&mockCommandBus{}, queries).ServeHTTP(rec,
+			withActor(httptest.NewRequest(http.MethodGet, "/journey-templates?kind=migration", nil), tc.actor))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var body struct {
+			Data  []readmodels.JourneyTemplateDTO `json:"data"`
+			Links sharedAPI.Links                 `json:"_links"`
+		}
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		require.Len(t, body.Data, 1)
+		assert.Equal(t, "migration", queries.requestedKind)
+		assert.True(t, strings.HasSuffix(body.Data[0].Links["self"].Href, "/journey-templates/tpl-1"))
+		assert.Equal(t, tc.canEdit, body.Data[0].Links["edit"].Href != "")
+		assert.Equal(t, tc.canEdit, body.Links["create"].Href != "")
+	}
+}
+
+func TestGetJourneyTemplates_RejectsUnknownKind(t *testing.T) {
+	queries := &stubJourneyTemplateQueries{}
+
+	rec := httptest.NewRecorder()
+	journeyTemplateRouter(&mockCommandBus{}, queries).ServeHTTP(rec,
+		withActor(httptest.NewRequest(http.MethodGet, "/journey-templates?kind=rewrite", nil), architectActor()))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, queries.requestedKind)
+}
+
+func TestGetJourneyTemplate_RetiredTemplateOffersNoEdits(t *testing.T) {
+	queries := &stubJourneyTemplateQueries{templates: []readmodels.JourneyTemplateDTO{
+		{ID: "tpl-1", Kind: "migration", Name: "Standard migration", Version: 1, Retired: true},
+	}}
+
+	rec := httptest.NewRecorder()
+	journeyTemplateRouter(&mockCommandBus{}, queries).ServeHTTP(rec,
+		withActor(httptest.NewRequest(http.MethodGet, "/journey-templates/tpl-1", nil), architectActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body readmodels.JourneyTemplateDTO
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Contains(t, body.Links, "self")
+	assert.NotContains(t, body.Links, "edit")
+	assert.NotContains(t, body.Links, "delete")
+}
+
+func TestGetJourneyTemplate_NotFound(t *testing.T) {
+	rec := httptest.NewRecorder()
+	journeyTemplateRouter(&mockCommandBus{}, &stubJourneyTemplateQueries{}).ServeHTTP(rec,
+		withActor(httptest.NewRequest(http.MethodGet, "/journey-templates/missing", nil), architectActor()))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCreateJourneyTemplate_DispatchesMilestones(t *testing.T) {
+	queries := &stubJourneyTemplateQueries{templates: []readmodels.JourneyTemplateDTO{
+		{ID: "tpl-1", Kind: "migration", Name: "Standard migration", Version: 1},
+	}}
+	bus := &mockCommandBus{createdID: "tpl-1"}
+	body := `{"kind":"migration","name":"Standard migration","milestones":[{"label":"Pilot","offsetQuarters":1},{"label":"Decommission","offsetQuarters":4}]}`
+
+	rec := httptest.NewRecorder()
+	journeyTemplateRouter(bus, queries).ServeHTTP(rec,
+		withActor(httptest.NewRequest(http.MethodPost, "/journey-templates", strings.NewReader(body)), architectActor()))
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Len(t, bus.dispatched, 1)
+	assert.Equal(t, &commands.CreateJourneyTemplate{
+		Kind: "migration",
+		Name: "Standard migration",
+		Milestones: []commands.TemplateMilestoneInput{
+			{Label: "Pilot", OffsetQuarters: 1},
+			{Label: "Decommission", OffsetQuarters: 4},
+		},
+		Actor: architectActor().Email,
+	}, bus.dispatched[0])
+	assert.True(t, strings.HasSuffix(rec.Header().Get("Location"), "/journey-templates/tpl-1"))
+}
+
+func TestReviseJourneyTemplate_DispatchesRevision(t *testing.T) {
+	queries := &stubJourneyTemplateQueries{templates: []readmodels.JourneyTemplateDTO{
+		{ID: "tpl-1", Kind: "migration", Name: "Lean migration", Version: 2},
+	}}
+	bus := &mockCommandBus{}
+	body := `{"name":"Lean migration","milestones":[{"label":"Cut-over","offsetQuarters":2}]}`
+
+	rec := httptest.NewRecorder()
+	journeyTemplateRouter(bus, queries).ServeHTTP(rec,
+		withActor(httptest.NewRequest(http.MethodPut, "/journey-templates/tpl-1", strings.NewReader(body)), architectActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, bus.dispatched, 1)
+	cmd, ok := bus.dispatched[0].(*commands.ReviseJourneyTemplate)
+	require.True(t, ok)
+	assert.Equal(t, "tpl-1", cmd.TemplateID)
+	assert.Equal(t, []commands.TemplateMilestoneInput{{Label: "Cut-over", OffsetQuarters: 2}}, cmd.Milestones)
+}
+
+func TestRetireJourneyTemplate_DispatchesRetire(t *testing.T) {
+	bus := &mockCommandBus{}
+
+	rec := httptest.NewRecorder()
+	journeyTemplateRouter(bus, &stubJourneyTemplateQueries{}).ServeHTTP(rec,
+		withActor(httptest.NewRequest(http.MethodDelete, "/journey-templates/tpl-1", nil), architectActor()))
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Len(t, bus.dispatched, 1)
+	assert.Equal(t, &commands.RetireJourneyTemplate{TemplateID: "tpl-1", Actor: architectActor().Email}, bus.dispatched[0])
+}
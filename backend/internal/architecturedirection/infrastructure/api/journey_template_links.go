@@ -0,0 +1,39 @@
+package api
+
+import (
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+const journeyTemplatesPath sharedAPI.ResourcePath = "/journey-templates"
+
+type JourneyTemplateLinks struct {
+	*sharedAPI.HATEOASLinks
+}
+
+func NewJourneyTemplateLinks(h *sharedAPI.HATEOASLinks) *JourneyTemplateLinks {
+	return &JourneyTemplateLinks{HATEOASLinks: h}
+}
+
+func (h *JourneyTemplateLinks) ItemLinks(template *readmodels.JourneyTemplateDTO, actor sharedctx.Actor) sharedAPI.Links {
+	base := journeyTemplateResourcePath(template.ID)
+	links := sharedAPI.Links{"self": h.Get(base)}
+	if !template.Retired && actor.CanWrite(ArchitectureDirectionResource) {
+		links["edit"] = h.Put(base)
+		links["delete"] = h.Del(base)
+	}
+	return links
+}
+
+func (h *JourneyTemplateLinks) CollectionLinks(actor sharedctx.Actor) sharedAPI.Links {
+	links := sharedAPI.Links{"self": h.Get(string(journeyTemplatesPath))}
+	if actor.CanWrite(ArchitectureDirectionResource) {
+		links["create"] = h.Post(string(journeyTemplatesPath))
+	}
+	return links
+}
+
+func journeyTemplateResourcePath(templateID string) string {
+	return string(journeyTemplatesPath) + "/" + templateID
+}
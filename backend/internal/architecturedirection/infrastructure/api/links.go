@@ -14,6 +14,7 @@ const ArchitectureDirectionResource sharedctx.ResourceName = "architecture-direc
 const (
 	enterpriseCapabilitiesPath sharedAPI.ResourcePath = "/enterprise-capabilities"
 	directionSubPath           sharedAPI.ResourcePath = "/direction"
+	decisionRecordsPath        sharedAPI.ResourcePath = "/decision-records"
 )
 
 var ErrNoActiveDirection = errors.New("no active direction on this enterprise capability")
@@ -26,13 +27,19 @@ func NewDirectionLinks(h *sharedAPI.HATEOASLinks) *DirectionLinks {
 	return &DirectionLinks{HATEOASLinks: h}
 }
 
-func (h *DirectionLinks) DirectionForActor(enterpriseCapabilityID string, status string, actor sharedctx.Actor) sharedAPI.Links {
+func (h *DirectionLinks) DirectionForActor(enterpriseCapabilityID string, direction *readmodels.DirectionDTO, actor sharedctx.Actor) sharedAPI.Links {
 	base := directionResourcePath(enterpriseCapabilityID)
 	links := sharedAPI.Links{
 		"self": h.Get(base),
 		"up":   h.Get(enterpriseCapabilityResourcePath(enterpriseCapabilityID)),
 	}
-	h.addWriteAffordances(links, base, status, actor)
+	if direction.Status == valueobjects.DirectionStatusProposed && direction.Review.IsReviewer(actor.ID) {
+		links["x-review"] = h.Post(base + "/reviews")
+	}
+	if direction.Status == valueobjects.DirectionStatusAgreed {
+		links["x-decision-records"] = h.Get(string(decisionRecordsPath) + "?subjectId=" + direction.ID)
+	}
+	h.addWriteAffordances(links, base, direction, actor)
 	return links
 }
 
@@ -48,17 +55,18 @@ func (h *DirectionLinks) EnterpriseCapabilityDirectionLinks(enterpriseCapability
 	return links
 }
 
-func (h *DirectionLinks) addWriteAffordances(links sharedAPI.Links, base, status string, actor sharedctx.Actor) {
+func (h *DirectionLinks) addWriteAffordances(links sharedAPI.Links, base string, direction *readmodels.DirectionDTO, actor sharedctx.Actor) {
 	if !actor.CanWrite(ArchitectureDirectionResource) {
 		return
 	}
+	status := direction.Status
 	if canEdit(status) {
 		links["edit"] = h.Put(base)
 	}
 	if status == valueobjects.DirectionStatusDraft {
 		links["x-add-source"] = h.Post(base + "/sources")
 	}
-	if rel, target := nextAdvanceRel(status); rel != "" {
+	if rel, target := nextAdvanceRel(direction); rel != "" && awaitsNoReview(direction) {
 		links[rel] = h.Post(base + "/" + target)
 	}
 	if canReject(status) {
@@ -66,6 +74,11 @@ func (h *DirectionLinks) addWriteAffordances(links sharedAPI.Links, base, status
 	}
 }
 
+// awaitsNoReview holds back the agree affordance until the review quorum is met.
+func awaitsNoReview(direction *readmodels.DirectionDTO) bool {
+	return direction.Status != valueobjects.DirectionStatusProposed || direction.Review == nil || direction.Review.QuorumMet
+}
+
 func canEdit(status string) bool {
 	return status != valueobjects.DirectionStatusRejected && status != valueobjects.DirectionStatusAgreed
 }
@@ -74,11 +87,16 @@ func canReject(status string) bool {
 	return status != valueobjects.DirectionStatusRejected
 }
 
-func nextAdvanceRel(status string) (rel, target string) {
-	switch status {
+// nextAdvanceRel offers proposing again for a direction proposed before
+// reviews were introduced, since it cannot be agreed until it names a panel.
+func nextAdvanceRel(direction *readmodels.DirectionDTO) (rel, target string) {
+	switch direction.Status {
 	case valueobjects.DirectionStatusDraft:
 		return "x-propose", "propose"
 	case valueobjects.DirectionStatusProposed:
+		if direction.Review == nil {
+			return "x-propose", "propose"
+		}
 		return "x-agree", "agree"
 	default:
 		return "", ""
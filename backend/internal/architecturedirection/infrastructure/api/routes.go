@@ -1,7 +1,9 @@
 package api
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"easi/backend/internal/architecturedirection/application/handlers"
 	"easi/backend/internal/architecturedirection/application/projectors"
@@ -38,11 +40,21 @@ type RoutesDeps struct {
 	SourceEligibility  services.SourceEligibility
 	CompositionPreview CompositionPreviewProvider
 	DirectRealization  services.DirectRealizationLookup
+	TimeSuggestions    services.TimeSuggestionSource
+	CurrentLandscape   services.CurrentLandscapeSource
 
 	CapabilityExists              services.CapabilityExists
 	ComponentExists               services.ComponentExists
 	DomainExists                  services.DomainExists
 	CapabilityEffectivelyInDomain services.CapabilityEffectivelyInDomain
+	CapabilityDomainArchitects    services.CapabilityDomainArchitects
+	ActiveUser                    services.ActiveUser
+	TenantAdmins                  services.TenantAdmins
+
+	// ActiveTenants and ExecutionContext drive the standard exception expiry
+	// sweep; without ActiveTenants no sweep runs.
+	ActiveTenants    services.ActiveTenants
+	ExecutionContext context.Context
 }
 
 func SetupRoutes(deps RoutesDeps) error {
@@ -52,12 +64,19 @@ func SetupRoutes(deps RoutesDeps) error {
 	subscribeEvents(deps.EventBus, readModel)
 	deps.EventBus.Subscribe(eaPL.EnterpriseCapabilityDeleted,
 		projectors.NewEnterpriseCapabilityDeletedReactor(readModel, deps.CommandBus))
+	subscribeMany(deps.EventBus, projectors.NewCapabilityReorganisedDirectionReactor(readModel, deps.CommandBus),
+		cmPL.CapabilityMergedInto, cmPL.CapabilitySplit)
 	registerCommandHandlers(commandHandlerDeps{
 		commandBus:  deps.CommandBus,
 		repo:        repo,
 		readModel:   readModel,
 		refs:        deps.ReferenceChecker,
 		eligibility: deps.SourceEligibility,
+		reviewerSources: handlers.ReviewerSources{
+			Architects:   deps.CapabilityDomainArchitects,
+			ActiveUser:   deps.ActiveUser,
+			TenantAdmins: deps.TenantAdmins,
+		},
 	})
 
 	links := NewDirectionLinks(deps.HATEOAS)
@@ -70,6 +89,8 @@ func SetupRoutes(deps RoutesDeps) error {
 	setupTimeAssessmentRoutes(deps)
 	setupRealizationRoleRoutes(deps)
 	setupCapabilityJourneyRoutes(deps)
+	setupTargetStateRoutes(deps, readModel)
+	setupStandardExceptionRoutes(deps, readModel)
 	return nil
 }
 
@@ -119,12 +140,42 @@ func setupTimeAssessmentRoutes(deps RoutesDeps) {
 	subscribeTimeAssessmentEvents(deps.EventBus, readModel)
 	deps.CommandBus.Register("AssessRealization", handlers.NewAssessRealizationHandler(repo, readModel, deps.DirectRealization))
 	deps.CommandBus.Register("RemoveTimeAssessment", handlers.NewRemoveTimeAssessmentHandler(repo, readModel))
+	deps.CommandBus.Register("TransferTimeAssessment", handlers.NewTransferTimeAssessmentHandler(repo, readModel))
 	deps.EventBus.Subscribe(cmPL.SystemRealizationDeleted, projectors.NewTimeAssessmentDeletionReactor(readModel, deps.CommandBus))
+	reassignmentReactor := projectors.NewTimeAssessmentReassignmentReactor(deps.CommandBus)
+	deps.EventBus.Subscribe(cmPL.SystemRealizationReassigned, reassignmentReactor)
+	deps.EventBus.Subscribe(cmPL.SystemRealizationMoved, reassignmentReactor)
 
 	links := NewTimeAssessmentLinks(deps.HATEOAS)
 	httpHandlers := NewTimeAssessmentHandlers(deps.CommandBus, readModel, links)
+	trendQuery := handlers.NewTimeGradeTrendQuery(readModel, deps.DomainExists, deps.CapabilityEffectivelyInDomain, time.Now)
+	trendHandlers := NewTimeGradeTrendHandlers(trendQuery, links)
 
-	registerTimeAssessmentRoutes(deps.Router, httpHandlers, deps.AuthMiddleware)
+	registerTimeAssessmentRoutes(deps.Router, httpHandlers, trendHandlers, deps.AuthMiddleware)
+	setupTimeSuggestionReviewRoutes(deps, readModel)
+}
+
+func setupTimeSuggestionReviewRoutes(deps RoutesDeps, assessments *readmodels.TimeAssessmentReadModel) {
+	readModel := readmodels.NewTimeSuggestionRejectionReadModel(deps.DB)
+	repo := repositories.NewTimeSuggestionRejectionRepository(deps.EventStore)
+
+	deps.EventBus.Subscribe(pl.TimeSuggestionRejected, projectors.NewTimeSuggestionRejectionProjector(readModel))
+	deps.CommandBus.Register("RejectTimeSuggestion", handlers.NewRejectTimeSuggestionHandler(repo, readModel))
+
+	queue := handlers.NewTimeSuggestionReviewQueue(deps.TimeSuggestions, assessments, readModel)
+	reviewer := handlers.NewTimeSuggestionReviewer(queue, deps.CommandBus)
+	httpHandlers := NewTimeSuggestionReviewHandlers(queue, reviewer, NewTimeSuggestionReviewLinks(deps.HATEOAS))
+
+	deps.Router.Route(string(timeSuggestionReviewsPath), func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(deps.AuthMiddleware.RequirePermission(authPL.PermArchitectureDirectionRead))
+			r.Get("/", httpHandlers.GetTimeSuggestionReviews)
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(deps.AuthMiddleware.RequirePermission(authPL.PermArchitectureDirectionWrite))
+			r.Post("/", httpHandlers.ReviewTimeSuggestions)
+		})
+	})
 }
 
 func subscribeTimeAssessmentEvents(eventBus events.EventBus, rm *readmodels.TimeAssessmentReadModel) {
@@ -136,13 +187,17 @@ func subscribeTimeAssessmentEvents(eventBus events.EventBus, rm *readmodels.Time
 		authPL.UserCreated)
 }
 
-func registerTimeAssessmentRoutes(r chi.Router, h *TimeAssessmentHandlers, authMiddleware AuthMiddleware) {
+func registerTimeAssessmentRoutes(r chi.Router, h *TimeAssessmentHandlers, trend *TimeGradeTrendHandlers, authMiddleware AuthMiddleware) {
 	registerDomainReadCollection(r, "/time-assessments", authMiddleware, func(r chi.Router) {
 		r.Get("/", h.GetTimeAssessments)
 		r.Get("/rollups", h.GetTimeAssessmentRollups)
+		r.Get("/trend", trend.GetTimeGradeTrend)
 	})
 	registerPairResourceRoutes(r, "/capabilities/{id}/components/{componentId}/time-assessment", authMiddleware,
 		pairResourceHandlers{get: h.GetTimeAssessment, put: h.PutTimeAssessment, delete: h.DeleteTimeAssessment})
+	registerDomainReadCollection(r, "/capabilities/{id}/components/{componentId}/time-assessment/history", authMiddleware, func(r chi.Router) {
+		r.Get("/", h.GetTimeAssessmentHistory)
+	})
 }
 
 func registerDomainReadCollection(r chi.Router, pattern string, authMiddleware AuthMiddleware, register func(chi.Router)) {
@@ -209,8 +264,9 @@ func setupCapabilityJourneyRoutes(deps RoutesDeps) {
 		DomainExists:                  deps.DomainExists,
 		CapabilityEffectivelyInDomain: deps.CapabilityEffectivelyInDomain,
 	}
-	deps.CommandBus.Register("PlanJourney", handlers.NewPlanJourneyHandler(repo, readModel, refs))
-	deps.CommandBus.Register("StartJourney", handlers.NewStartJourneyHandler(repo))
+	templates := setupJourneyTemplateRoutes(deps)
+	deps.CommandBus.Register("PlanJourney", handlers.NewPlanJourneyHandler(repo, readModel, refs, templates, time.Now))
+	deps.CommandBus.Register("StartJourney", handlers.NewStartJourneyHandler(repo, readModel))
 	deps.CommandBus.Register("CompleteJourney", handlers.NewCompleteJourneyHandler(repo))
 	deps.CommandBus.Register("AbandonJourney", handlers.NewAbandonJourneyHandler(repo))
 	deps.CommandBus.Register("UpdateJourneyProgress", handlers.NewUpdateJourneyProgressHandler(repo))
@@ -219,18 +275,188 @@ func setupCapabilityJourneyRoutes(deps RoutesDeps) {
 	deps.CommandBus.Register("AddJourneyMilestone", handlers.NewAddJourneyMilestoneHandler(repo))
 	deps.CommandBus.Register("UpdateJourneyMilestone", handlers.NewUpdateJourneyMilestoneHandler(repo))
 	deps.CommandBus.Register("RemoveJourneyMilestone", handlers.NewRemoveJourneyMilestoneHandler(repo))
+	deps.CommandBus.Register("RehomeCapabilityJourney", handlers.NewRehomeCapabilityJourneyHandler(repo, readModel))
+	deps.CommandBus.Register("AddJourneyDependency", handlers.NewAddJourneyDependencyHandler(repo, readModel))
+	deps.CommandBus.Register("RemoveJourneyDependency", handlers.NewRemoveJourneyDependencyHandler(repo))
+	subscribeMany(deps.EventBus, projectors.NewCapabilityReorganisedJourneyReactor(deps.CommandBus),
+		cmPL.CapabilityMergedInto, cmPL.CapabilitySplit)
 
 	links := NewCapabilityJourneyLinks(deps.HATEOAS)
 	httpHandlers := NewCapabilityJourneyHandlers(deps.CommandBus, readModel, links)
 
 	registerCapabilityJourneyRoutes(deps.Router, httpHandlers, deps.AuthMiddleware)
+	programmes := setupJourneyProgrammeRoutes(deps, readModel)
+	setupJourneyRoadmapRoutes(deps, readModel, programmes)
+	setupJourneySlipRoutes(deps, readModel)
+}
+
+func setupJourneyProgrammeRoutes(deps RoutesDeps, journeys *readmodels.CapabilityJourneyReadModel) *readmodels.JourneyProgrammeReadModel {
+	readModel := readmodels.NewJourneyProgrammeReadModel(deps.DB)
+	repo := repositories.NewJourneyProgrammeRepository(deps.EventStore)
+
+	subscribeMany(deps.EventBus, projectors.NewJourneyProgrammeProjector(readModel),
+		pl.JourneyProgrammeCreated, pl.JourneyProgrammeUpdated, pl.JourneyProgrammeDeleted,
+		pl.JourneyProgrammeJourneyAdded, pl.JourneyProgrammeJourneyRemoved)
+	deps.CommandBus.Register("CreateJourneyProgramme", handlers.NewCreateJourneyProgrammeHandler(repo))
+	deps.CommandBus.Register("UpdateJourneyProgramme", handlers.NewUpdateJourneyProgrammeHandler(repo))
+	deps.CommandBus.Register("DeleteJourneyProgramme", handlers.NewDeleteJourneyProgrammeHandler(repo))
+	deps.CommandBus.Register("AddJourneyToProgramme", handlers.NewAddJourneyToProgrammeHandler(repo, readModel, journeys))
+	deps.CommandBus.Register("RemoveJourneyFromProgramme", handlers.NewRemoveJourneyFromProgrammeHandler(repo))
+
+	links := NewJourneyProgrammeLinks(deps.HATEOAS)
+	httpHandlers := NewJourneyProgrammeHandlers(deps.CommandBus, readModel, links)
+
+	registerJourneyProgrammeRoutes(deps.Router, httpHandlers, deps.AuthMiddleware)
+	return readModel
+}
+
+func setupJourneyTemplateRoutes(deps RoutesDeps) *repositories.JourneyTemplateRepository {
+	readModel := readmodels.NewJourneyTemplateReadModel(deps.DB)
+	repo := repositories.NewJourneyTemplateRepository(deps.EventStore)
+
+	subscribeMany(deps.EventBus, projectors.NewJourneyTemplateProjector(readModel),
+		pl.JourneyTemplateCreated, pl.JourneyTemplateRevised, pl.JourneyTemplateRetired)
+	deps.CommandBus.Register("CreateJourneyTemplate", handlers.NewCreateJourneyTemplateHandler(repo))
+	deps.CommandBus.Register("ReviseJourneyTemplate", handlers.NewReviseJourneyTemplateHandler(repo))
+	deps.CommandBus.Register("RetireJourneyTemplate", handlers.NewRetireJourneyTemplateHandler(repo))
+
+	links := NewJourneyTemplateLinks(deps.HATEOAS)
+	httpHandlers := NewJourneyTemplateHandlers(deps.CommandBus, readModel, links)
+
+	registerJourneyTemplateRoutes(deps.Router, httpHandlers, deps.AuthMiddleware)
+	return repo
+}
+
+func setupJourneyRoadmapRoutes(deps RoutesDeps, journeys *readmodels.CapabilityJourneyReadModel, programmes *readmodels.JourneyProgrammeReadModel) {
+	query := handlers.NewJourneyRoadmapQuery(journeys, programmes, deps.DomainExists, deps.CapabilityEffectivelyInDomain)
+	httpHandlers := NewJourneyRoadmapHandlers(query, deps.HATEOAS)
+
+	registerDomainReadCollection(deps.Router, journeyRoadmapPath, deps.AuthMiddleware, func(r chi.Router) {
+		r.Get("/", httpHandlers.GetJourneyRoadmap)
+	})
+}
+
+func setupJourneySlipRoutes(deps RoutesDeps, journeys *readmodels.CapabilityJourneyReadModel) {
+	httpHandlers := NewJourneySlipHandlers(journeys, deps.HATEOAS)
+
+	registerDomainReadCollection(deps.Router, string(journeySlipsPath), deps.AuthMiddleware, func(r chi.Router) {
+		r.Get("/", httpHandlers.GetJourneySlipReport)
+		r.Get("/{journeyId}", httpHandlers.GetJourneySlipHistory)
+	})
+}
+
+func setupTargetStateRoutes(deps RoutesDeps, directions *readmodels.DirectionReadModel) {
+	query := handlers.NewTargetStateQuery(handlers.TargetStateSources{
+		Landscape:  deps.CurrentLandscape,
+		Journeys:   readmodels.NewCapabilityJourneyReadModel(deps.DB),
+		Roles:      readmodels.NewRealizationRoleReadModel(deps.DB),
+		Directions: directions,
+	}, deps.DomainExists, time.Now)
+	httpHandlers := NewTargetStateHandlers(query, deps.HATEOAS)
+
+	registerDomainReadCollection(deps.Router, targetStatePath, deps.AuthMiddleware, func(r chi.Router) {
+		r.Get("/", httpHandlers.GetTargetState)
+	})
+}
+
+func setupStandardExceptionRoutes(deps RoutesDeps, directions *readmodels.DirectionReadModel) {
+	readModel := readmodels.NewStandardExceptionReadModel(deps.DB)
+	repo := repositories.NewStandardExceptionRepository(deps.EventStore)
+
+	subscribeMany(deps.EventBus, projectors.NewStandardExceptionProjector(readModel),
+		pl.StandardExceptionGranted, pl.StandardExceptionRenewed, pl.StandardExceptionRevoked, pl.StandardExceptionExpired)
+	deps.CommandBus.Register("GrantStandardException", handlers.NewGrantStandardExceptionHandler(repo, readModel, deps.DirectRealization))
+	deps.CommandBus.Register("RenewStandardException", handlers.NewRenewStandardExceptionHandler(repo))
+	deps.CommandBus.Register("RevokeStandardException", handlers.NewRevokeStandardExceptionHandler(repo))
+	deps.CommandBus.Register("ExpireStandardException", handlers.NewExpireStandardExceptionHandler(repo))
+	startStandardExceptionExpirySweep(deps, readModel)
+
+	links := NewStandardExceptionLinks(deps.HATEOAS)
+	registerStandardExceptionRoutes(deps.Router, NewStandardExceptionHandlers(deps.CommandBus, readModel, links, time.Now), deps.AuthMiddleware)
+
+	query := handlers.NewStandardComplianceQuery(handlers.StandardComplianceSources{
+		Landscape:  deps.CurrentLandscape,
+		Journeys:   readmodels.NewCapabilityJourneyReadModel(deps.DB),
+		Roles:      readmodels.NewRealizationRoleReadModel(deps.DB),
+		Directions: directions,
+		Exceptions: readModel,
+	}, deps.DomainExists, time.Now)
+	complianceHandlers := NewStandardComplianceHandlers(query, links)
+	registerDomainReadCollection(deps.Router, string(standardCompliancePath), deps.AuthMiddleware, func(r chi.Router) {
+		r.Get("/", complianceHandlers.GetStandardCompliance)
+	})
+}
+
+const standardExceptionExpirySweepInterval = time.Hour
+
+func startStandardExceptionExpirySweep(deps RoutesDeps, readModel *readmodels.StandardExceptionReadModel) {
+	if deps.ActiveTenants == nil {
+		return
+	}
+	ctx := deps.ExecutionContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	sweeper := handlers.NewStandardExceptionExpirySweeper(deps.ActiveTenants, readModel, deps.CommandBus, time.Now)
+	go sweeper.Run(ctx, standardExceptionExpirySweepInterval)
+}
+
+func registerStandardExceptionRoutes(r chi.Router, h *StandardExceptionHandlers, authMiddleware AuthMiddleware) {
+	r.Route(string(standardExceptionsPath), func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermDomainsRead))
+			r.Get("/", h.GetStandardExceptions)
+			r.Get("/{exceptionId}", h.GetStandardException)
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermArchitectureDirectionWrite))
+			r.Post("/", h.GrantStandardException)
+			r.Put("/{exceptionId}", h.RenewStandardException)
+			r.Delete("/{exceptionId}", h.RevokeStandardException)
+		})
+	})
+}
+
+func registerJourneyProgrammeRoutes(r chi.Router, h *JourneyProgrammeHandlers, authMiddleware AuthMiddleware) {
+	r.Route("/journey-programmes", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermDomainsRead))
+			r.Get("/", h.GetJourneyProgrammes)
+			r.Get("/{programmeId}", h.GetJourneyProgramme)
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermArchitectureDirectionWrite))
+			r.Post("/", h.CreateJourneyProgramme)
+			r.Put("/{programmeId}", h.UpdateJourneyProgramme)
+			r.Delete("/{programmeId}", h.DeleteJourneyProgramme)
+			r.Post("/{programmeId}/journeys", h.AddJourneyToProgramme)
+			r.Delete("/{programmeId}/journeys/{journeyId}", h.RemoveJourneyFromProgramme)
+		})
+	})
+}
+
+func registerJourneyTemplateRoutes(r chi.Router, h *JourneyTemplateHandlers, authMiddleware AuthMiddleware) {
+	r.Route("/journey-templates", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermDomainsRead))
+			r.Get("/", h.GetJourneyTemplates)
+			r.Get("/{templateId}", h.GetJourneyTemplate)
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermArchitectureDirectionWrite))
+			r.Post("/", h.CreateJourneyTemplate)
+			r.Put("/{templateId}", h.ReviseJourneyTemplate)
+			r.Delete("/{templateId}", h.RetireJourneyTemplate)
+		})
+	})
 }
 
 func subscribeCapabilityJourneyEvents(eventBus events.EventBus, rm *readmodels.CapabilityJourneyReadModel) {
 	subscribeMany(eventBus, projectors.NewCapabilityJourneyProjector(rm),
 		pl.JourneyPlanned, pl.JourneyStarted, pl.JourneyCompleted, pl.JourneyAbandoned,
-		pl.JourneyProgressUpdated, pl.JourneyDetailsUpdated, pl.JourneySourceApplicationsChanged,
-		pl.JourneyMilestoneAdded, pl.JourneyMilestoneUpdated, pl.JourneyMilestoneRemoved)
+		pl.JourneyProgressUpdated, pl.JourneyDetailsUpdated, pl.JourneySourceApplicationsChanged, pl.JourneyCapabilityChanged,
+		pl.JourneyMilestoneAdded, pl.JourneyMilestoneUpdated, pl.JourneyMilestoneRemoved,
+		pl.JourneyDependencyAdded, pl.JourneyDependencyRemoved)
 	subscribeMany(eventBus, projectors.NewCapabilityJourneyReferenceProjector(rm),
 		cmPL.CapabilityCreated, cmPL.CapabilityUpdated, cmPL.CapabilityDeleted,
 		cmPL.BusinessDomainCreated, cmPL.BusinessDomainUpdated, cmPL.BusinessDomainDeleted,
@@ -266,6 +492,8 @@ func registerCapabilityJourneyRoutes(r chi.Router, h *CapabilityJourneyHandlers,
 		r.Post("/milestones", h.PostJourneyMilestone)
 		r.Put("/milestones/{milestoneId}", h.PutJourneyMilestone)
 		r.Delete("/milestones/{milestoneId}", h.DeleteJourneyMilestone)
+		r.Post("/dependencies", h.PostJourneyDependency)
+		r.Delete("/dependencies/{predecessorId}", h.DeleteJourneyDependency)
 	})
 }
 
@@ -281,7 +509,7 @@ func subscribeEvents(eventBus events.EventBus, rm *readmodels.DirectionReadModel
 	subscribeMany(eventBus, projectors.NewDirectionProjector(rm),
 		pl.DirectionDrafted, pl.DirectionProposed, pl.DirectionAgreed, pl.DirectionRejected,
 		pl.DirectionNarrativeUpdated, pl.DirectionHorizonChanged, pl.DirectionPlacementsChanged,
-		pl.DirectionSourceCapabilitiesChanged)
+		pl.DirectionSourceCapabilitiesChanged, pl.DirectionReviewRecorded)
 	subscribeMany(eventBus, projectors.NewStaleReferenceProjector(rm),
 		cmPL.CapabilityDeleted, cmPL.CapabilityCreated, cmPL.CapabilityUpdated,
 		cmPL.BusinessDomainCreated, cmPL.BusinessDomainUpdated,
@@ -289,21 +517,24 @@ func subscribeEvents(eventBus events.EventBus, rm *readmodels.DirectionReadModel
 }
 
 type commandHandlerDeps struct {
-	commandBus  *cqrs.InMemoryCommandBus
-	repo        *repositories.DirectionRepository
-	readModel   *readmodels.DirectionReadModel
-	refs        *services.ReferenceChecker
-	eligibility services.SourceEligibility
+	commandBus      *cqrs.InMemoryCommandBus
+	repo            *repositories.DirectionRepository
+	readModel       *readmodels.DirectionReadModel
+	refs            *services.ReferenceChecker
+	eligibility     services.SourceEligibility
+	reviewerSources handlers.ReviewerSources
 }
 
 func registerCommandHandlers(deps commandHandlerDeps) {
 	policy := services.NewDirectionReferenceService(deps.refs, deps.readModel, deps.eligibility)
 	deps.commandBus.Register("CaptureDirection", handlers.NewCaptureDirectionHandler(deps.repo, policy))
-	deps.commandBus.Register("AdvanceDirection", handlers.NewAdvanceDirectionHandler(deps.repo))
+	deps.commandBus.Register("AdvanceDirection", handlers.NewAdvanceDirectionHandler(deps.repo, deps.reviewerSources))
+	deps.commandBus.Register("ReviewDirection", handlers.NewReviewDirectionHandler(deps.repo))
 	deps.commandBus.Register("RejectDirection", handlers.NewRejectDirectionHandler(deps.repo))
 	deps.commandBus.Register("UpdateDirection", handlers.NewUpdateDirectionHandler(deps.repo))
 	deps.commandBus.Register("AddDirectionSource", handlers.NewAddDirectionSourceHandler(deps.repo, policy))
 	deps.commandBus.Register("RemoveDirectionSource", handlers.NewRemoveDirectionSourceHandler(deps.repo))
+	deps.commandBus.Register("RehomeDirectionSource", handlers.NewRehomeDirectionSourceHandler(deps.repo))
 }
 
 func registerRoutes(r chi.Router, h *DirectionHandlers, preview *CompositionPreviewHandlers, authMiddleware AuthMiddleware) {
@@ -312,6 +543,7 @@ func registerRoutes(r chi.Router, h *DirectionHandlers, preview *CompositionPrev
 			r.Use(authMiddleware.RequirePermission(authPL.PermArchitectureDirectionRead))
 			r.Get("/", h.GetDirectionForEnterpriseCapability)
 			r.Post("/composition-preview", preview.PreviewComposition)
+			r.Post("/reviews", h.ReviewDirection)
 		})
 		r.Group(func(r chi.Router) {
 			r.Use(authMiddleware.RequirePermission(authPL.PermArchitectureDirectionWrite))
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/types"
+)
+
+type StandardComplianceExecutor interface {
+	Execute(ctx context.Context, businessDomainID string) (*readmodels.StandardComplianceReport, error)
+}
+
+type StandardComplianceHandlers struct {
+	query   StandardComplianceExecutor
+	hateoas *StandardExceptionLinks
+}
+
+func NewStandardComplianceHandlers(query StandardComplianceExecutor, hateoas *StandardExceptionLinks) *StandardComplianceHandlers {
+	return &StandardComplianceHandlers{query: query, hateoas: hateoas}
+}
+
+type StandardComplianceResponse struct {
+	BusinessDomainID string                               `json:"businessDomainId,omitempty"`
+	Summary          readmodels.ComplianceSummaryDTO      `json:"summary"`
+	Capabilities     []readmodels.CapabilityComplianceDTO `json:"capabilities"`
+	Signals          []readmodels.ComplianceSignalDTO     `json:"signals"`
+	Links            types.Links                          `json:"_links"`
+}
+
+// GetStandardCompliance godoc
+// @Summary Report realisations that do not follow the standard
+// @Description Lists capabilities with a standard application, from the standard realisation role or an agreed direction sourcing the capability, that are still directly realised by other applications with no active journey away from them. Each finding is non-compliant, excepted (an exception in the register still holds) or exception-expired. Every expired exception still excusing a finding raises an exception-expired signal; signals are computed on read and never stored.
+// @Tags standard-exceptions
+// @Produce json
+// @Security CookieAuth
+// @Param businessDomainId query string false "Restrict to capabilities in this business domain"
+// @Success 200 {object} StandardComplianceResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /standard-compliance [get]
+func (h *StandardComplianceHandlers) GetStandardCompliance(w http.ResponseWriter, r *http.Request) {
+	businessDomainID := r.URL.Query().Get("businessDomainId")
+	report, ok := fetchOrFail(w, r, func(ctx context.Context) (*readmodels.StandardComplianceReport, error) {
+		return h.query.Execute(ctx, businessDomainID)
+	})
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	for i := range report.Capabilities {
+		findings := report.Capabilities[i].Findings
+		for j := range findings {
+			findings[j].Links = h.hateoas.FindingLinks(findings[j], actor)
+		}
+	}
+	for i := range report.Signals {
+		report.Signals[i].Links = h.hateoas.SignalLinks(report.Signals[i], actor)
+	}
+	query := url.Values{}
+	if businessDomainID != "" {
+		query.Set("businessDomainId", businessDomainID)
+	}
+	sharedAPI.RespondJSON(w, http.StatusOK, StandardComplianceResponse{
+		BusinessDomainID: businessDomainID,
+		Summary:          report.Summary,
+		Capabilities:     report.Capabilities,
+		Signals:          report.Signals,
+		Links: types.Links{
+			"self":                  h.hateoas.Get(withQuery(string(standardCompliancePath), query)),
+			"x-standard-exceptions": h.hateoas.Get(string(standardExceptionsPath)),
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubStandardCompliance struct {
+	businessDomainID string
+	report           *readmodels.StandardComplianceReport
+}
+
+func (s *stubStandardCompliance) Execute(_ context.Context, businessDomainID string) (*readmodels.StandardComplianceReport, error) {
+	s.businessDomainID = businessDomainID
+	return s.report, nil
+}
+
+func standardComplianceRouter(query StandardComplianceExecutor) chi.Router {
+	h := NewStandardComplianceHandlers(query, NewStandardExceptionLinks(sharedAPI.NewHATEOASLinks("")))
+	r := chi.NewRouter()
+	r.Get("/standard-compliance", h.GetStandardCompliance)
+	return r
+}
+
+func TestGetStandardCompliance_LinksFindingsAndSignalsToTheRegister(t *testing.T) {
+	query := &stubStandardCompliance{report: &readmodels.StandardComplianceReport{
+		Summary: readmodels.ComplianceSummaryDTO{NonCompliant: 1, ExceptionExpired: 1},
+		Capabilities: []readmodels.CapabilityComplianceDTO{{
+			CapabilityID: "cap-1",
+			Findings: []readmodels.ComplianceFindingDTO{
+				{ComponentID: "app-old", Status: readmodels.ComplianceNonCompliant},
+				{ComponentID: "app-lapsed", Status: readmodels.ComplianceExceptionExpired, ExceptionID: "exc-1"},
+			},
+		}},
+		Signals: []readmodels.ComplianceSignalDTO{{Type: readmodels.SignalExceptionExpired, ExceptionID: "exc-1"}},
+	}}
+
+	rec := httptest.NewRecorder()
+	standardComplianceRouter(query).ServeHTTP(rec, withActor(
+		httptest.NewRequest(http.MethodGet, "/standard-compliance?businessDomainId=dom-1", nil), architectActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "dom-1", query.businessDomainID)
+	var body StandardComplianceResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Equal(t, readmodels.ComplianceSummaryDTO{NonCompliant: 1, ExceptionExpired: 1}, body.Summary)
+	findings := body.Capabilities[0].Findings
+	assert.True(t, strings.HasSuffix(findings[0].Links["x-grant-exception"].Href, "/standard-exceptions"))
+	assert.True(t, strings.HasSuffix(findings[1].Links["x-exception"].Href, "/standard-exceptions/exc-1"))
+	assert.Contains(t, findings[1].Links, "x-renew-exception")
+	require.Len(t, body.Signals, 1)
+	assert.True(t, strings.HasSuffix(body.Signals[0].Links["x-exception"].Href, "/standard-exceptions/exc-1"))
+	assert.Contains(t, body.Links["self"].Href, "businessDomainId=dom-1")
+}
+
+func TestGetStandardCompliance_ReadOnlyActorGetsNoGrantAffordance(t *testing.T) {
+	query := &stubStandardCompliance{report: &readmodels.StandardComplianceReport{
+		Capabilities: []readmodels.CapabilityComplianceDTO{{
+			CapabilityID: "cap-1",
+			Findings:     []readmodels.ComplianceFindingDTO{{ComponentID: "app-old", Status: readmodels.ComplianceNonCompliant}},
+		}},
+	}}
+
+	rec := httptest.NewRecorder()
+	standardComplianceRouter(query).ServeHTTP(rec, withActor(
+		httptest.NewRequest(http.MethodGet, "/standard-compliance", nil), stakeholderActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body StandardComplianceResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Empty(t, body.Capabilities[0].Findings[0].Links)
+}
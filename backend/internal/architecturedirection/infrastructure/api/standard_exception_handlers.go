@@ -0,0 +1,246 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/infrastructure/repositories"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+)
+
+const exceptionDateLayout = "2006-01-02"
+
+var (
+	ErrInvalidExpiryDate                     = errors.New("expiry dates must be written as YYYY-MM-DD")
+	errStandardExceptionMissingAfterMutation = errors.New("standard exception not found after mutation")
+)
+
+type StandardExceptionQueries interface {
+	GetAll(ctx context.Context) ([]readmodels.StandardExceptionDTO, error)
+	GetByID(ctx context.Context, id string) (*readmodels.StandardExceptionDTO, error)
+}
+
+type StandardExceptionHandlers struct {
+	commandBus cqrs.CommandBus
+	queries    StandardExceptionQueries
+	hateoas    *StandardExceptionLinks
+	now        func() time.Time
+}
+
+func NewStandardExceptionHandlers(commandBus cqrs.CommandBus, queries StandardExceptionQueries, hateoas *StandardExceptionLinks, now func() time.Time) *StandardExceptionHandlers {
+	return &StandardExceptionHandlers{commandBus: commandBus, queries: queries, hateoas: hateoas, now: now}
+}
+
+type GrantStandardExceptionRequest struct {
+	CapabilityID  string `json:"capabilityId"`
+	ComponentID   string `json:"componentId"`
+	Justification string `json:"justification"`
+	ApproverID    string `json:"approverId"`
+	ExpiresOn     string `json:"expiresOn" example:"2027-06-30"`
+}
+
+type RenewStandardExceptionRequest struct {
+	Justification string `json:"justification"`
+	ApproverID    string `json:"approverId"`
+	ExpiresOn     string `json:"expiresOn" example:"2027-12-31"`
+}
+
+// GetStandardExceptions godoc
+// @Summary List the standard exceptions register
+// @Description Returns every exception allowing a non-standard application to keep realising a capability, soonest expiry first. Status is active, expired (past its expiry date; it no longer excuses the realisation) or revoked.
+// @Tags standard-exceptions
+// @Produce json
+// @Security CookieAuth
+// @Success 200 {object} sharedAPI.CollectionResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /standard-exceptions [get]
+func (h *StandardExceptionHandlers) GetStandardExceptions(w http.ResponseWriter, r *http.Request) {
+	exceptions, ok := fetchOrFail(w, r, h.queries.GetAll)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	for i := range exceptions {
+		h.decorateException(&exceptions[i], actor)
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, exceptions, h.hateoas.CollectionLinks(actor))
+}
+
+// GetStandardException godoc
+// @Summary Get a standard exception
+// @Tags standard-exceptions
+// @Produce json
+// @Security CookieAuth
+// @Param exceptionId path string true "Exception ID"
+// @Success 200 {object} readmodels.StandardExceptionDTO
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /standard-exceptions/{exceptionId} [get]
+func (h *StandardExceptionHandlers) GetStandardException(w http.ResponseWriter, r *http.Request) {
+	exception, ok := fetchOrFail(w, r, func(ctx context.Context) (*readmodels.StandardExceptionDTO, error) {
+		return h.queries.GetByID(ctx, sharedAPI.GetPathParam(r, "exceptionId"))
+	})
+	if !ok {
+		return
+	}
+	if exception == nil {
+		sharedAPI.HandleError(w, repositories.ErrStandardExceptionNotFound)
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.decorateException(exception, actor)
+	sharedAPI.RespondJSON(w, http.StatusOK, exception)
+}
+
+// GrantStandardException godoc
+// @Summary Grant a standard exception
+// @Description Allows a non-standard application to keep realising a capability until the expiry date, inclusive. The application must directly realise the capability, and a pair can only hold one unrevoked exception; renew it to extend.
+// @Tags standard-exceptions
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param body body GrantStandardExceptionRequest true "Exception data"
+// @Success 201 {object} readmodels.StandardExceptionDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /standard-exceptions [post]
+func (h *StandardExceptionHandlers) GrantStandardException(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[GrantStandardExceptionRequest](w, r)
+	if !ok {
+		return
+	}
+	expiresOn, err := parseExpiryDate(req.ExpiresOn)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	result, err := h.commandBus.Dispatch(r.Context(), &commands.GrantStandardException{
+		CapabilityID:  req.CapabilityID,
+		ComponentID:   req.ComponentID,
+		Justification: req.Justification,
+		ApproverID:    req.ApproverID,
+		ExpiresOn:     expiresOn,
+		Actor:         actor.Email,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	h.respondWithException(w, r, result.CreatedID, http.StatusCreated)
+}
+
+// RenewStandardException godoc
+// @Summary Renew a standard exception
+// @Description Records a fresh approval and expiry date. Expired exceptions can be renewed; revoked ones cannot.
+// @Tags standard-exceptions
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param exceptionId path string true "Exception ID"
+// @Param body body RenewStandardExceptionRequest true "Renewal data"
+// @Success 200 {object} readmodels.StandardExceptionDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /standard-exceptions/{exceptionId} [put]
+func (h *StandardExceptionHandlers) RenewStandardException(w http.ResponseWriter, r *http.Request) {
+	exceptionID := sharedAPI.GetPathParam(r, "exceptionId")
+	req, ok := sharedAPI.DecodeRequestOrFail[RenewStandardExceptionRequest](w, r)
+	if !ok {
+		return
+	}
+	expiresOn, err := parseExpiryDate(req.ExpiresOn)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	if _, err := h.commandBus.Dispatch(r.Context(), &commands.RenewStandardException{
+		ExceptionID:   exceptionID,
+		Justification: req.Justification,
+		ApproverID:    req.ApproverID,
+		ExpiresOn:     expiresOn,
+		Actor:         actor.Email,
+	}); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	h.respondWithException(w, r, exceptionID, http.StatusOK)
+}
+
+// RevokeStandardException godoc
+// @Summary Revoke a standard exception
+// @Description Withdraws the exception; it stays in the register as revoked.
+// @Tags standard-exceptions
+// @Security CookieAuth
+// @Param exceptionId path string true "Exception ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /standard-exceptions/{exceptionId} [delete]
+func (h *StandardExceptionHandlers) RevokeStandardException(w http.ResponseWriter, r *http.Request) {
+	actor, _ := sharedctx.GetActor(r.Context())
+	if _, err := h.commandBus.Dispatch(r.Context(), &commands.RevokeStandardException{
+		ExceptionID: sharedAPI.GetPathParam(r, "exceptionId"), Actor: actor.Email,
+	}); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	sharedAPI.RespondNoContent(w)
+}
+
+func (h *StandardExceptionHandlers) respondWithException(w http.ResponseWriter, r *http.Request, exceptionID string, statusCode int) {
+	exception, err := h.queries.GetByID(r.Context(), exceptionID)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	if exception == nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, errStandardExceptionMissingAfterMutation, "failed to load standard exception after mutation")
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.decorateException(exception, actor)
+	if statusCode == http.StatusCreated {
+		sharedAPI.RespondCreated(w, sharedAPI.BuildResourceLink(standardExceptionsPath, sharedAPI.ResourceID(exceptionID)), exception)
+		return
+	}
+	sharedAPI.RespondJSON(w, statusCode, exception)
+}
+
+func (h *StandardExceptionHandlers) decorateException(exception *readmodels.StandardExceptionDTO, actor sharedctx.Actor) {
+	exception.Status = readmodels.ExceptionStatus(*exception, h.now())
+	exception.Links = h.hateoas.ItemLinks(*exception, actor)
+}
+
+func parseExpiryDate(raw string) (time.Time, error) {
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(exceptionDateLayout, raw)
+	if err != nil {
+		return time.Time{}, ErrInvalidExpiryDate
+	}
+	return parsed, nil
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/commands"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var exceptionsNow = time.Date(2026, time.May, 15, 9, 0, 0, 0, time.UTC)
+
+type stubStandardExceptionQueries struct {
+	exceptions []readmodels.StandardExceptionDTO
+}
+
+func (s *stubStandardExceptionQueries) GetAll(context.Context) ([]readmodels.StandardExceptionDTO, error) {
+	return s.exceptions, nil
+}
+
+func (s *stubStandardExceptionQueries) GetByID(_ context.Context, id string) (*readmodels.StandardExceptionDTO, error) {
+	for _, exception := range s.exceptions {
+		if exception.ID == id {
+			return &exception, nil
+		}
+	}
+	return nil, nil
+}
+
+func standardExceptionRouter(bus cqrs.CommandBus, queries StandardExceptionQueries) chi.Router {
+	h := NewStandardExceptionHandlers(bus, queries, NewStandardExceptionLinks(sharedAPI.NewHATEOASLinks("")),
+		func() time.Time { return exceptionsNow })
+	r := chi.NewRouter()
+	r.Get("/standard-exceptions", h.GetStandardExceptions)
+	r.Post("/standard-exceptions", h.GrantStandardException)
+	r.Put("/standard-exceptions/{exceptionId}", h.RenewStandardException)
+	r.Delete("/standard-exceptions/{exceptionId}", h.RevokeStandardException)
+	return r
+}
+
+func TestGetStandardExceptions_ComputesStatusAndAffordances(t *testing.T) {
+	revokedAt := exceptionsNow.AddDate(0, -1, 0)
+	queries := &stubStandardExceptionQueries{exceptions: []readmodels.StandardExceptionDTO{
+		{ID: "exc-expired", ExpiresOn: time.Date(2026, time.May, 14, 0, 0, 0, 0, time.UTC)},
+		{ID: "exc-active", ExpiresOn: time.Date(2026, time.May, 15, 0, 0, 0, 0, time.UTC)},
+		{ID: "exc-revoked", ExpiresOn: time.Date(2027, time.May, 15, 0, 0, 0, 0, time.UTC), RevokedAt: &revokedAt},
+	}}
+
+	for _, tc := range []struct {
+		actor     sharedctx.Actor
+		canChange bool
+	}{{architectActor(), true}, {stakeholderActor(), false}} {
+		rec := httptest.NewRecorder()
+		standardExceptionRouter(&mockCommandBus{}, queries).ServeHTTP(rec,
+			withActor(httptest.NewRequest(http.MethodGet, "/standard-exceptions", nil), tc.actor))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var body struct {
+			Data  []readmodels.StandardExceptionDTO `json:"data"`
+			Links sharedAPI.Links                   `json:"_links"`
+		}
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		require.Len(t, body.Data, 3)
+		assert.Equal(t, readmodels.ExceptionStatusExpired, body.Data[0].Status)
+		assert.Equal(t, readmodels.ExceptionStatusActive, body.Data[1].Status)
+		assert.Equal(t, readmodels.ExceptionStatusRevoked, body.Data[2].Status)
+		assert.Equal(t, tc.canChange, body.Data[0].Links["x-renew"].Href != "")
+		assert.NotContains(t, body.Data[2].Links, "x-renew")
+		assert.Equal(t, tc.canChange, body.Links["create"].Href != "")
+	}
+}
+
+func TestGrantStandardException_DispatchesParsedExpiry(t *testing.T) {
+	queries := &stubStandardExceptionQueries{exceptions: []readmodels.StandardExceptionDTO{
+		{ID: "exc-1", ExpiresOn: time.Date(2027, time.June, 30, 0, 0, 0, 0, time.UTC)},
+	}}
+	bus := &mockCommandBus{createdID: "exc-1"}
+	body := `{"capabilityId":"cap-1","componentId":"app-1","justification":"Contract","approverId":"cio@example.com","expiresOn":"2027-06-30"}`
+
+	rec := httptest.NewRecorder()
+	standardExceptionRouter(bus, queries).ServeHTTP(rec,
+		withActor(httptest.NewRequest(http.MethodPost, "/standard-exceptions", strings.NewReader(body)), architectActor()))
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Len(t, bus.dispatched, 1)
+	cmd, ok := bus.dispatched[0].(*commands.GrantStandardException)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2027, time.June, 30, 0, 0, 0, 0, time.UTC), cmd.ExpiresOn)
+	assert.Equal(t, "cio@example.com", cmd.ApproverID)
+	assert.Equal(t, architectActor().Email, cmd.Actor)
+	assert.True(t, strings.HasSuffix(rec.Header().Get("Location"), "/standard-exceptions/exc-1"))
+}
+
+func TestRenewStandardException_RejectsMalformedExpiry(t *testing.T) {
+	bus := &mockCommandBus{}
+	body := `{"justification":"Slipped","approverId":"cio@example.com","expiresOn":"30/06/2027"}`
+
+	rec := httptest.NewRecorder()
+	standardExceptionRouter(bus, &stubStandardExceptionQueries{}).ServeHTTP(rec,
+		withActor(httptest.NewRequest(http.MethodPut, "/standard-exceptions/exc-1", strings.NewReader(body)), architectActor()))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, bus.dispatched)
+}
+
+func TestRevokeStandardException_DispatchesRevoke(t *testing.T) {
+	bus := &mockCommandBus{}
+
+	rec := httptest.NewRecorder()
+	standardExceptionRouter(bus, &stubStandardExceptionQueries{}).ServeHTTP(rec,
+		withActor(httptest.NewRequest(http.MethodDelete, "/standard-exceptions/exc-1", nil), architectActor()))
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Len(t, bus.dispatched, 1)
+	assert.Equal(t, &commands.RevokeStandardException{ExceptionID: "exc-1", Actor: architectActor().Email}, bus.dispatched[0])
+}
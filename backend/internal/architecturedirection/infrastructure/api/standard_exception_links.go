@@ -0,0 +1,71 @@
+package api
+
+import (
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+const (
+	standardExceptionsPath sharedAPI.ResourcePath = "/standard-exceptions"
+	standardCompliancePath sharedAPI.ResourcePath = "/standard-compliance"
+)
+
+type StandardExceptionLinks struct {
+	*sharedAPI.HATEOASLinks
+}
+
+func NewStandardExceptionLinks(h *sharedAPI.HATEOASLinks) *StandardExceptionLinks {
+	return &StandardExceptionLinks{HATEOASLinks: h}
+}
+
+// ItemLinks offers renewal and revocation until the exception is revoked; an
+// expired exception can still be renewed.
+func (h *StandardExceptionLinks) ItemLinks(exception readmodels.StandardExceptionDTO, actor sharedctx.Actor) sharedAPI.Links {
+	base := standardExceptionResourcePath(exception.ID)
+	links := sharedAPI.Links{"self": h.Get(base)}
+	if exception.Status != readmodels.ExceptionStatusRevoked && actor.CanWrite(ArchitectureDirectionResource) {
+		links["x-renew"] = h.Put(base)
+		links["x-revoke"] = h.Del(base)
+	}
+	return links
+}
+
+func (h *StandardExceptionLinks) CollectionLinks(actor sharedctx.Actor) sharedAPI.Links {
+	links := sharedAPI.Links{
+		"self":                  h.Get(string(standardExceptionsPath)),
+		"x-standard-compliance": h.Get(string(standardCompliancePath)),
+	}
+	if actor.CanWrite(ArchitectureDirectionResource) {
+		links["create"] = h.Post(string(standardExceptionsPath))
+	}
+	return links
+}
+
+// FindingLinks points at the exception covering a finding, or offers to
+// grant one when there is none.
+func (h *StandardExceptionLinks) FindingLinks(finding readmodels.ComplianceFindingDTO, actor sharedctx.Actor) sharedAPI.Links {
+	links := sharedAPI.Links{}
+	switch {
+	case finding.ExceptionID != "":
+		links["x-exception"] = h.Get(standardExceptionResourcePath(finding.ExceptionID))
+		if finding.Status == readmodels.ComplianceExceptionExpired && actor.CanWrite(ArchitectureDirectionResource) {
+			links["x-renew-exception"] = h.Put(standardExceptionResourcePath(finding.ExceptionID))
+		}
+	case actor.CanWrite(ArchitectureDirectionResource):
+		links["x-grant-exception"] = h.Post(string(standardExceptionsPath))
+	}
+	return links
+}
+
+func (h *StandardExceptionLinks) SignalLinks(signal readmodels.ComplianceSignalDTO, actor sharedctx.Actor) sharedAPI.Links {
+	links := sharedAPI.Links{"x-exception": h.Get(standardExceptionResourcePath(signal.ExceptionID))}
+	if actor.CanWrite(ArchitectureDirectionResource) {
+		links["x-renew-exception"] = h.Put(standardExceptionResourcePath(signal.ExceptionID))
+	}
+	return links
+}
+
+func standardExceptionResourcePath(exceptionID string) string {
+	return string(standardExceptionsPath) + "/" + exceptionID
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"easi/backend/internal/architecturedirection/application/handlers"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	sharedAPI "easi/backend/internal/shared/api"
+	"easi/backend/internal/shared/types"
+)
+
+const targetStatePath = "/target-state"
+
+type TargetStateExecutor interface {
+	Execute(ctx context.Context, req handlers.TargetStateRequest) (*handlers.TargetState, error)
+}
+
+type TargetStateHandlers struct {
+	query TargetStateExecutor
+	links *sharedAPI.HATEOASLinks
+}
+
+func NewTargetStateHandlers(query TargetStateExecutor, links *sharedAPI.HATEOASLinks) *TargetStateHandlers {
+	return &TargetStateHandlers{query: query, links: links}
+}
+
+type TargetStateResponse struct {
+	Horizon          string                           `json:"horizon"`
+	Through          *readmodels.TargetPeriodDTO      `json:"through"`
+	BusinessDomainID string                           `json:"businessDomainId,omitempty"`
+	Capabilities     []readmodels.TargetCapabilityDTO `json:"capabilities"`
+	Links            types.Links                      `json:"_links"`
+}
+
+// GetTargetState godoc
+// @Summary Project the landscape to its target state
+// @Description Applies planned change to today's capabilities and realisations and returns the resulting capability to application map. Active journeys due by the horizon swap their from-applications for their target application (migration, consolidation, carve-out) or re-parent the capability (move); agreed directions whose horizon has been reached replace legacy applications in their source capabilities with the enterprise capability's standard application; standard realisation roles retire legacy ones. The now horizon ends with the current quarter, next reaches four quarters further and later (the default) applies every planned change, including journeys without a target period. Alternatively pass targetQuarter. With businessDomainId, only capabilities that end up in that domain are returned.
+// @Tags capability-journeys
+// @Produce json
+// @Security CookieAuth
+// @Param horizon query string false "How far ahead to look" Enums(now, next, later)
+// @Param targetQuarter query string false "Last quarter to apply journeys from, as YYYY-Qn; not combinable with horizon"
+// @Param businessDomainId query string false "Restrict to capabilities ending up in this business domain"
+// @Success 200 {object} TargetStateResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /target-state [get]
+func (h *TargetStateHandlers) GetTargetState(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	req, err := parseTargetStateRequest(params)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	state, ok := fetchOrFail(w, r, func(ctx context.Context) (*handlers.TargetState, error) {
+		return h.query.Execute(ctx, req)
+	})
+	if !ok {
+		return
+	}
+	for i := range state.Capabilities {
+		if capability := &state.Capabilities[i]; capability.JourneyID != "" {
+			capability.Links = types.Links{"x-journey": h.links.Get(journeyResourcePath(capability.CapabilityID))}
+		}
+	}
+	sharedAPI.RespondJSON(w, http.StatusOK, TargetStateResponse{
+		Horizon:          state.Horizon,
+		Through:          state.Through,
+		BusinessDomainID: req.BusinessDomainID,
+		Capabilities:     state.Capabilities,
+		Links:            h.targetStateLinks(params),
+	})
+}
+
+func parseTargetStateRequest(params url.Values) (handlers.TargetStateRequest, error) {
+	req := handlers.TargetStateRequest{BusinessDomainID: params.Get("businessDomainId")}
+	if raw := params.Get("horizon"); raw != "" {
+		horizon, err := valueobjects.NewHorizon(raw)
+		if err != nil {
+			return req, err
+		}
+		req.Horizon = &horizon
+	}
+	var err error
+	req.TargetQuarter, err = parseQuarterParam(params.Get("targetQuarter"))
+	return req, err
+}
+
+func (h *TargetStateHandlers) targetStateLinks(params url.Values) types.Links {
+	query := url.Values{}
+	if domainID := params.Get("businessDomainId"); domainID != "" {
+		query.Set("businessDomainId", domainID)
+	}
+	self := url.Values{}
+	for _, key := range []string{"horizon", "targetQuarter", "businessDomainId"} {
+		if v := params.Get(key); v != "" {
+			self.Set(key, v)
+		}
+	}
+	links := types.Links{"self": h.links.Get(withQuery(targetStatePath, self))}
+	for _, horizon := range []string{valueobjects.HorizonNow, valueobjects.HorizonNext, valueobjects.HorizonLater} {
+		query.Set("horizon", horizon)
+		links["x-horizon-"+horizon] = h.links.Get(withQuery(targetStatePath, query))
+	}
+	return links
+}
+
+func withQuery(path string, query url.Values) string {
+	if encoded := query.Encode(); encoded != "" {
+		return path + "?" + encoded
+	}
+	return path
+}
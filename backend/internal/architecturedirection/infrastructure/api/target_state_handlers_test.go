@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/handlers"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubTargetState struct {
+	received handlers.TargetStateRequest
+	state    *handlers.TargetState
+}
+
+func (s *stubTargetState) Execute(_ context.Context, req handlers.TargetStateRequest) (*handlers.TargetState, error) {
+	s.received = req
+	return s.state, nil
+}
+
+func targetStateRouter(query TargetStateExecutor) chi.Router {
+	h := NewTargetStateHandlers(query, sharedAPI.NewHATEOASLinks(""))
+	r := chi.NewRouter()
+	r.Get("/target-state", h.GetTargetState)
+	return r
+}
+
+func TestGetTargetState_ReturnsCapabilityApplicationMap(t *testing.T) {
+	query := &stubTargetState{state: &handlers.TargetState{
+		Horizon: "next",
+		Through: &readmodels.TargetPeriodDTO{Year: 2027, Quarter: 2},
+		Capabilities: []readmodels.TargetCapabilityDTO{
+			{CapabilityID: "cap-1", JourneyID: "j-1", Applications: []readmodels.TargetApplicationDTO{{ComponentID: "app-new", Change: "journey"}}},
+			{CapabilityID: "cap-2", Applications: []readmodels.TargetApplicationDTO{{ComponentID: "app-a"}}},
+		},
+	}}
+
+	rec := httptest.NewRecorder()
+	targetStateRouter(query).ServeHTTP(rec, withActor(
+		httptest.NewRequest(http.MethodGet, "/target-state?horizon=next&businessDomainId=dom-1", nil), stakeholderActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, query.received.Horizon)
+	assert.Equal(t, "next", query.received.Horizon.Value())
+	assert.Equal(t, "dom-1", query.received.BusinessDomainID)
+	var body TargetStateResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Equal(t, "next", body.Horizon)
+	require.Len(t, body.Capabilities, 2)
+	assert.True(t, strings.HasSuffix(body.Capabilities[0].Links["x-journey"].Href, "/capabilities/cap-1/journey"))
+	assert.Empty(t, body.Capabilities[1].Links)
+	assert.Contains(t, body.Links["x-horizon-later"].Href, "horizon=later")
+	assert.Contains(t, body.Links["x-horizon-later"].Href, "businessDomainId=dom-1")
+	assert.Contains(t, body.Links["self"].Href, "horizon=next")
+}
+
+func TestGetTargetState_ParsesTargetQuarter(t *testing.T) {
+	query := &stubTargetState{state: &handlers.TargetState{Horizon: "later"}}
+
+	rec := httptest.NewRecorder()
+	targetStateRouter(query).ServeHTTP(rec, withActor(
+		httptest.NewRequest(http.MethodGet, "/target-state?targetQuarter=2028-Q3", nil), stakeholderActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, query.received.TargetQuarter)
+	assert.Equal(t, 2028, query.received.TargetQuarter.Year())
+	assert.Equal(t, 3, query.received.TargetQuarter.Quarter())
+	assert.Nil(t, query.received.Horizon)
+}
+
+func TestGetTargetState_RejectsInvalidParameters(t *testing.T) {
+	for _, target := range []string{"/target-state?horizon=soon", "/target-state?targetQuarter=2028-5"} {
+		rec := httptest.NewRecorder()
+		targetStateRouter(&stubTargetState{}).ServeHTTP(rec, withActor(httptest.NewRequest(http.MethodGet, target, nil), stakeholderActor()))
+		assert.Equal(t, http.StatusBadRequest, rec.Code, target)
+	}
+}
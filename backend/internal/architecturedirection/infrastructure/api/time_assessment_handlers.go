@@ -18,6 +18,7 @@ type TimeAssessmentQueries interface {
 	GetByCapabilityIDs(ctx context.Context, capabilityIDs []string) ([]readmodels.TimeAssessmentDTO, error)
 	GetAll(ctx context.Context) ([]readmodels.TimeAssessmentDTO, error)
 	GetRollupsByComponentIDs(ctx context.Context, componentIDs []string) ([]readmodels.TimeAssessmentRollupDTO, error)
+	GetHistoryByPair(ctx context.Context, capabilityID, componentID string) ([]readmodels.TimeAssessmentHistoryEntryDTO, error)
 }
 
 type TimeAssessmentHandlers struct {
@@ -122,6 +123,31 @@ func (h *TimeAssessmentHandlers) GetTimeAssessment(w http.ResponseWriter, r *htt
 	sharedAPI.RespondJSON(w, http.StatusOK, dto)
 }
 
+// GetTimeAssessmentHistory godoc
+// @Summary Get the TIME grade history of a realisation
+// @Description Returns every grade recorded for the (capability, component) pair, newest first, with who changed it and why. An entry with a null grade marks the assessment being removed, transferred away with its realisation, or dropped with its capability or component. Empty when the pair has never been assessed.
+// @Tags time-assessments
+// @Produce json
+// @Security CookieAuth
+// @Param id path string true "Capability ID"
+// @Param componentId path string true "Application component ID"
+// @Success 200 {object} sharedAPI.CollectionResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/components/{componentId}/time-assessment/history [get]
+func (h *TimeAssessmentHandlers) GetTimeAssessmentHistory(w http.ResponseWriter, r *http.Request) {
+	capabilityID := sharedAPI.GetPathParam(r, "id")
+	componentID := sharedAPI.GetPathParam(r, "componentId")
+	history, ok := fetchOrFail(w, r, func(ctx context.Context) ([]readmodels.TimeAssessmentHistoryEntryDTO, error) {
+		return h.queries.GetHistoryByPair(ctx, capabilityID, componentID)
+	})
+	if !ok {
+		return
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, history, h.hateoas.HistoryLinks(capabilityID, componentID))
+}
+
 // PutTimeAssessment godoc
 // @Summary Assess or re-assess a realisation's TIME grade
 // @Description Records the architect's grade for the (capability, component) pair. Requires a direct realisation. 201 on first assessment, 200 on re-assessment.
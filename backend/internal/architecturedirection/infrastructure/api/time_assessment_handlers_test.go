@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"easi/backend/internal/architecturedirection/application/commands"
@@ -28,6 +29,8 @@ type mockTimeAssessmentQueries struct {
 	receivedCompIDs []string
 	all             []readmodels.TimeAssessmentDTO
 	allCalled       bool
+	history         []readmodels.TimeAssessmentHistoryEntryDTO
+	historyPair     timeAssessmentPairID
 }
 
 func (m *mockTimeAssessmentQueries) GetAll(_ context.Context) ([]readmodels.TimeAssessmentDTO, error) {
@@ -54,6 +57,11 @@ func (m *mockTimeAssessmentQueries) GetRollupsByComponentIDs(_ context.Context,
 	return m.rollups, nil
 }
 
+func (m *mockTimeAssessmentQueries) GetHistoryByPair(_ context.Context, capabilityID, componentID string) ([]readmodels.TimeAssessmentHistoryEntryDTO, error) {
+	m.historyPair = timeAssessmentPairID{CapabilityID: capabilityID, ComponentID: componentID}
+	return m.history, nil
+}
+
 func setupTimeAssessmentHandlers(bus *mockCommandBus, queries TimeAssessmentQueries) *TimeAssessmentHandlers {
 	links := NewTimeAssessmentLinks(sharedAPI.NewHATEOASLinks(""))
 	return NewTimeAssessmentHandlers(bus, queries, links)
@@ -66,6 +74,7 @@ func timeAssessmentRouter(h *TimeAssessmentHandlers) chi.Router {
 	r.Delete("/capabilities/{id}/components/{componentId}/time-assessment", h.DeleteTimeAssessment)
 	r.Get("/time-assessments", h.GetTimeAssessments)
 	r.Get("/time-assessments/rollups", h.GetTimeAssessmentRollups)
+	r.Get("/capabilities/{id}/components/{componentId}/time-assessment/history", h.GetTimeAssessmentHistory)
 	return r
 }
 
@@ -108,6 +117,7 @@ func TestGetTimeAssessment_Assessed_EditDeleteLinkVisibilityByActor(t *testing.T
 			require.Equal(t, http.StatusOK, rec.Code)
 			var body readmodels.TimeAssessmentDTO
 			require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+			assert.Contains(t, body.Links, "x-history")
 			if tc.shouldShow {
 				assert.Contains(t, body.Links, "edit")
 				assert.Contains(t, body.Links, "delete")
@@ -264,3 +274,31 @@ func TestGetTimeAssessmentRollups_ParsesCommaSeparatedComponentIDs(t *testing.T)
 	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
 	assert.Contains(t, body.Links, "self")
 }
+
+func TestGetTimeAssessmentHistory_ReturnsEntriesForPair(t *testing.T) {
+	capID, compID := uuid.New().String(), uuid.New().String()
+	grade := "Migrate"
+	queries := &mockTimeAssessmentQueries{history: []readmodels.TimeAssessmentHistoryEntryDTO{
+		{TimeAssessmentID: uuid.New().String(), CapabilityID: capID, ComponentID: compID},
+		{TimeAssessmentID: uuid.New().String(), CapabilityID: capID, ComponentID: compID, Grade: &grade},
+	}}
+	h := setupTimeAssessmentHandlers(&mockCommandBus{}, queries)
+	r := timeAssessmentRouter(h)
+
+	req := httptest.NewRequest(http.MethodGet, "/capabilities/"+capID+"/components/"+compID+"/time-assessment/history", nil)
+	req = req.WithContext(sharedctx.WithActor(req.Context(), stakeholderActor()))
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, timeAssessmentPairID{CapabilityID: capID, ComponentID: compID}, queries.historyPair)
+	var body struct {
+		Data  []readmodels.TimeAssessmentHistoryEntryDTO `json:"data"`
+		Links sharedAPI.Links                            `json:"_links"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	require.Len(t, body.Data, 2)
+	assert.Nil(t, body.Data[0].Grade)
+	assert.Equal(t, "Migrate", *body.Data[1].Grade)
+	assert.True(t, strings.HasSuffix(body.Links["x-time-assessment"].Href, timeAssessmentItemResourcePath(capID, compID)))
+}
@@ -9,6 +9,8 @@ const (
 	capabilitiesPath      sharedAPI.ResourcePath = "/capabilities"
 	timeAssessmentsPath   sharedAPI.ResourcePath = "/time-assessments"
 	timeAssessmentSubPath sharedAPI.ResourcePath = "/time-assessment"
+	historySubPath        sharedAPI.ResourcePath = "/history"
+	trendSubPath          sharedAPI.ResourcePath = "/trend"
 )
 
 type TimeAssessmentLinks struct {
@@ -21,7 +23,7 @@ func NewTimeAssessmentLinks(h *sharedAPI.HATEOASLinks) *TimeAssessmentLinks {
 
 func (h *TimeAssessmentLinks) ItemLinks(capabilityID, componentID string, actor sharedctx.Actor) sharedAPI.Links {
 	base := timeAssessmentItemResourcePath(capabilityID, componentID)
-	links := sharedAPI.Links{"self": h.Get(base)}
+	links := sharedAPI.Links{"self": h.Get(base), "x-history": h.Get(base + string(historySubPath))}
 	if actor.CanWrite(ArchitectureDirectionResource) {
 		links["edit"] = h.Put(base)
 		links["delete"] = h.Del(base)
@@ -30,13 +32,31 @@ func (h *TimeAssessmentLinks) ItemLinks(capabilityID, componentID string, actor
 }
 
 func (h *TimeAssessmentLinks) CollectionLinks(selfPath string, actor sharedctx.Actor) sharedAPI.Links {
-	links := sharedAPI.Links{"self": h.Get(selfPath)}
+	links := sharedAPI.Links{
+		"self":    h.Get(selfPath),
+		"x-trend": h.Get(string(timeAssessmentsPath) + string(trendSubPath)),
+	}
 	if actor.CanWrite(ArchitectureDirectionResource) {
 		links["x-assess"] = h.Put(templatedTimeAssessmentItemPath())
 	}
 	return links
 }
 
+func (h *TimeAssessmentLinks) HistoryLinks(capabilityID, componentID string) sharedAPI.Links {
+	base := timeAssessmentItemResourcePath(capabilityID, componentID)
+	return sharedAPI.Links{
+		"self":              h.Get(base + string(historySubPath)),
+		"x-time-assessment": h.Get(base),
+	}
+}
+
+func (h *TimeAssessmentLinks) TrendLinks(selfPath string) sharedAPI.Links {
+	return sharedAPI.Links{
+		"self":               h.Get(selfPath),
+		"x-time-assessments": h.Get(string(timeAssessmentsPath)),
+	}
+}
+
 func timeAssessmentItemResourcePath(capabilityID, componentID string) string {
 	return string(capabilitiesPath) + "/" + capabilityID + "/components/" + componentID + string(timeAssessmentSubPath)
 }
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"easi/backend/internal/architecturedirection/application/handlers"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	"easi/backend/internal/architecturedirection/domain/valueobjects"
+	sharedAPI "easi/backend/internal/shared/api"
+)
+
+var ErrInvalidQuarterParam = errors.New("quarters must be written as YYYY-Qn")
+
+var quarterParamPattern = regexp.MustCompile(`^(\d{4})-[Qq]([1-4])$`)
+
+type TimeGradeTrendExecutor interface {
+	Execute(ctx context.Context, req handlers.TimeGradeTrendRequest) ([]readmodels.TimeGradeTrendPointDTO, error)
+}
+
+type TimeGradeTrendHandlers struct {
+	query   TimeGradeTrendExecutor
+	hateoas *TimeAssessmentLinks
+}
+
+func NewTimeGradeTrendHandlers(query TimeGradeTrendExecutor, hateoas *TimeAssessmentLinks) *TimeGradeTrendHandlers {
+	return &TimeGradeTrendHandlers{query: query, hateoas: hateoas}
+}
+
+// GetTimeGradeTrend godoc
+// @Summary Count realisations per TIME grade at each quarter end
+// @Description Replays the TIME assessment history and returns, for each quarter in the range, how many realisations were graded Invest, Tolerate, Migrate and Eliminate at the end of that quarter. The current quarter is counted as of now. Defaults to the last 8 quarters. With businessDomainId, only capabilities placed in that domain today are counted.
+// @Tags time-assessments
+// @Produce json
+// @Security CookieAuth
+// @Param from query string false "First quarter, as YYYY-Qn (e.g. 2025-Q1)"
+// @Param to query string false "Last quarter, as YYYY-Qn; defaults to the current quarter"
+// @Param businessDomainId query string false "Restrict to capabilities in this business domain"
+// @Success 200 {object} sharedAPI.CollectionResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /time-assessments/trend [get]
+func (h *TimeGradeTrendHandlers) GetTimeGradeTrend(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	req, err := parseTimeGradeTrendRequest(params)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	trend, ok := fetchOrFail(w, r, func(ctx context.Context) ([]readmodels.TimeGradeTrendPointDTO, error) {
+		return h.query.Execute(ctx, req)
+	})
+	if !ok {
+		return
+	}
+	selfPath := string(timeAssessmentsPath) + string(trendSubPath)
+	if encoded := params.Encode(); encoded != "" {
+		selfPath += "?" + encoded
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, trend, h.hateoas.TrendLinks(selfPath))
+}
+
+func parseTimeGradeTrendRequest(params url.Values) (handlers.TimeGradeTrendRequest, error) {
+	req := handlers.TimeGradeTrendRequest{BusinessDomainID: params.Get("businessDomainId")}
+	var err error
+	if req.From, err = parseQuarterParam(params.Get("from")); err != nil {
+		return req, err
+	}
+	req.To, err = parseQuarterParam(params.Get("to"))
+	return req, err
+}
+
+func parseQuarterParam(raw string) (*valueobjects.TargetPeriod, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	match := quarterParamPattern.FindStringSubmatch(raw)
+	if match == nil {
+		return nil, ErrInvalidQuarterParam
+	}
+	year, _ := strconv.Atoi(match[1])
+	quarter, _ := strconv.Atoi(match[2])
+	period, err := valueobjects.NewTargetPeriod(year, quarter)
+	if err != nil {
+		return nil, err
+	}
+	return &period, nil
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturedirection/application/handlers"
+	"easi/backend/internal/architecturedirection/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubTimeGradeTrend struct {
+	received handlers.TimeGradeTrendRequest
+	points   []readmodels.TimeGradeTrendPointDTO
+	err      error
+}
+
+func (s *stubTimeGradeTrend) Execute(_ context.Context, req handlers.TimeGradeTrendRequest) ([]readmodels.TimeGradeTrendPointDTO, error) {
+	s.received = req
+	return s.points, s.err
+}
+
+func timeGradeTrendRouter(query TimeGradeTrendExecutor) chi.Router {
+	h := NewTimeGradeTrendHandlers(query, NewTimeAssessmentLinks(sharedAPI.NewHATEOASLinks("")))
+	r := chi.NewRouter()
+	r.Get("/time-assessments/trend", h.GetTimeGradeTrend)
+	return r
+}
+
+func TestGetTimeGradeTrend_ParsesQuartersAndDomain(t *testing.T) {
+	query := &stubTimeGradeTrend{points: []readmodels.TimeGradeTrendPointDTO{
+		{Year: 2025, Quarter: 1, AsOf: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), Counts: readmodels.TimeGradeCounts{Eliminate: 3}, Total: 3},
+	}}
+
+	rec := httptest.NewRecorder()
+	timeGradeTrendRouter(query).ServeHTTP(rec, withActor(
+		httptest.NewRequest(http.MethodGet, "/time-assessments/trend?from=2024-Q3&to=2025-q1&businessDomainId=dom-1", nil), stakeholderActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, query.received.From)
+	assert.Equal(t, 2024, query.received.From.Year())
+	assert.Equal(t, 3, query.received.From.Quarter())
+	require.NotNil(t, query.received.To)
+	assert.Equal(t, 1, query.received.To.Quarter())
+	assert.Equal(t, "dom-1", query.received.BusinessDomainID)
+	var body struct {
+		Data  []readmodels.TimeGradeTrendPointDTO `json:"data"`
+		Links sharedAPI.Links                     `json:"_links"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	require.Len(t, body.Data, 1)
+	assert.Equal(t, 3, body.Data[0].Counts.Eliminate)
+	assert.Contains(t, body.Links["self"].Href, "/time-assessments/trend?")
+}
+
+func TestGetTimeGradeTrend_DefaultsLeaveRangeToQuery(t *testing.T) {
+	query := &stubTimeGradeTrend{}
+
+	rec := httptest.NewRecorder()
+	timeGradeTrendRouter(query).ServeHTTP(rec, withActor(httptest.NewRequest(http.MethodGet, "/time-assessments/trend", nil), architectActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Nil(t, query.received.From)
+	assert.Nil(t, query.received.To)
+}
+
+func TestGetTimeGradeTrend_InvalidRange_Returns400(t *testing.T) {
+	cases := []struct {
+		name  string
+		query string
+		err   error
+	}{
+		{"malformed quarter", "?from=2025-3", nil},
+		{"quarter out of range", "?to=2025-Q5", nil},
+		{"year out of range", "?from=1999-Q1", nil},
+		{"reversed range", "?from=2025-Q3&to=2025-Q1", handlers.ErrTrendRangeReversed},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			timeGradeTrendRouter(&stubTimeGradeTrend{err: tc.err}).ServeHTTP(rec, withActor(
+				httptest.NewRequest(http.MethodGet, "/time-assessments/trend"+tc.query, nil), architectActor()))
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"easi/backend/internal/architecturedirection/application/handlers"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+const (
+	timeSuggestionReviewApplied = "applied"
+	timeSuggestionReviewFailed  = "failed"
+)
+
+type TimeSuggestionReviewQueue interface {
+	Pending(ctx context.Context) ([]handlers.PendingTimeSuggestion, error)
+}
+
+type TimeSuggestionReviewApplier interface {
+	Review(ctx context.Context, decisions []handlers.TimeSuggestionDecision, reviewedBy string) ([]handlers.TimeSuggestionOutcome, error)
+}
+
+type TimeSuggestionReviewHandlers struct {
+	queue    TimeSuggestionReviewQueue
+	reviewer TimeSuggestionReviewApplier
+	hateoas  *TimeSuggestionReviewLinks
+}
+
+func NewTimeSuggestionReviewHandlers(queue TimeSuggestionReviewQueue, reviewer TimeSuggestionReviewApplier, hateoas *TimeSuggestionReviewLinks) *TimeSuggestionReviewHandlers {
+	return &TimeSuggestionReviewHandlers{queue: queue, reviewer: reviewer, hateoas: hateoas}
+}
+
+type PillarEvidenceDTO struct {
+	PillarID   string  `json:"pillarId"`
+	PillarName string  `json:"pillarName"`
+	FitType    string  `json:"fitType"`
+	Importance int     `json:"importance"`
+	FitScore   int     `json:"fitScore"`
+	Gap        float64 `json:"gap"`
+}
+
+type PendingTimeSuggestionDTO struct {
+	CapabilityID   string              `json:"capabilityId"`
+	CapabilityName string              `json:"capabilityName"`
+	ComponentID    string              `json:"componentId"`
+	ComponentName  string              `json:"componentName"`
+	CurrentGrade   *string             `json:"currentGrade"`
+	SuggestedGrade string              `json:"suggestedGrade"`
+	Confidence     string              `json:"confidence"`
+	TechnicalGap   *float64            `json:"technicalGap"`
+	FunctionalGap  *float64            `json:"functionalGap"`
+	Evidence       []PillarEvidenceDTO `json:"evidence"`
+	Links          sharedAPI.Links     `json:"_links"`
+}
+
+type TimeSuggestionDecisionRequest struct {
+	CapabilityID string `json:"capabilityId"`
+	ComponentID  string `json:"componentId"`
+	Action       string `json:"action"`
+	Grade        string `json:"grade,omitempty"`
+	Rationale    string `json:"rationale,omitempty"`
+}
+
+type ReviewTimeSuggestionsRequest struct {
+	Decisions []TimeSuggestionDecisionRequest `json:"decisions"`
+}
+
+type TimeSuggestionOutcomeDTO struct {
+	CapabilityID string `json:"capabilityId"`
+	ComponentID  string `json:"componentId"`
+	Action       string `json:"action"`
+	Status       string `json:"status"`
+	AppliedGrade string `json:"appliedGrade,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// GetTimeSuggestionReviews godoc
+// @Summary List TIME suggestions awaiting review
+// @Description Lists the TIME grades suggested from fit gaps that differ from the realisation's current grade (or where it has none), with the per-pillar evidence behind each. Suggestions an architect rejected stay hidden until the underlying importance or fit scores change.
+// @Tags time-assessments
+// @Produce json
+// @Security CookieAuth
+// @Success 200 {object} sharedAPI.CollectionResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /time-suggestion-reviews [get]
+func (h *TimeSuggestionReviewHandlers) GetTimeSuggestionReviews(w http.ResponseWriter, r *http.Request) {
+	pending, ok := fetchOrFail(w, r, h.queue.Pending)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	items := make([]PendingTimeSuggestionDTO, len(pending))
+	for i, p := range pending {
+		items[i] = toPendingTimeSuggestionDTO(p)
+		items[i].Links = h.hateoas.ItemLinks(p.CapabilityID, p.ComponentID)
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, items, h.hateoas.CollectionLinks(actor))
+}
+
+// ReviewTimeSuggestions godoc
+// @Summary Accept, reject or override TIME suggestions in bulk
+// @Description Applies one decision per pending suggestion. accept records the suggested grade as the TIME assessment; override records the given grade instead and dismisses the suggestion; reject only dismisses it. Decisions are applied independently and reported per item; the request is refused as a whole only when it is malformed.
+// @Tags time-assessments
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param body body ReviewTimeSuggestionsRequest true "Review decisions"
+// @Success 200 {object} sharedAPI.CollectionResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /time-suggestion-reviews [post]
+func (h *TimeSuggestionReviewHandlers) ReviewTimeSuggestions(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[ReviewTimeSuggestionsRequest](w, r)
+	if !ok {
+		return
+	}
+	decisions := make([]handlers.TimeSuggestionDecision, len(req.Decisions))
+	for i, d := range req.Decisions {
+		decisions[i] = handlers.TimeSuggestionDecision{
+			CapabilityID: d.CapabilityID,
+			ComponentID:  d.ComponentID,
+			Action:       d.Action,
+			Grade:        d.Grade,
+			Rationale:    d.Rationale,
+		}
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	outcomes, err := h.reviewer.Review(r.Context(), decisions, actor.Email)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	results := make([]TimeSuggestionOutcomeDTO, len(outcomes))
+	for i, o := range outcomes {
+		results[i] = toTimeSuggestionOutcomeDTO(o)
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, results, h.hateoas.CollectionLinks(actor))
+}
+
+func toPendingTimeSuggestionDTO(p handlers.PendingTimeSuggestion) PendingTimeSuggestionDTO {
+	dto := PendingTimeSuggestionDTO{
+		CapabilityID:   p.CapabilityID,
+		CapabilityName: p.CapabilityName,
+		ComponentID:    p.ComponentID,
+		ComponentName:  p.ComponentName,
+		SuggestedGrade: p.SuggestedGrade,
+		Confidence:     p.Confidence,
+		TechnicalGap:   p.TechnicalGap,
+		FunctionalGap:  p.FunctionalGap,
+		Evidence:       make([]PillarEvidenceDTO, len(p.Evidence)),
+	}
+	if p.CurrentGrade != "" {
+		current := p.CurrentGrade
+		dto.CurrentGrade = &current
+	}
+	for i, e := range p.Evidence {
+		dto.Evidence[i] = PillarEvidenceDTO(e)
+	}
+	return dto
+}
+
+func toTimeSuggestionOutcomeDTO(o handlers.TimeSuggestionOutcome) TimeSuggestionOutcomeDTO {
+	dto := TimeSuggestionOutcomeDTO{
+		CapabilityID: o.Decision.CapabilityID,
+		ComponentID:  o.Decision.ComponentID,
+		Action:       o.Decision.Action,
+		Status:       timeSuggestionReviewApplied,
+		AppliedGrade: o.AppliedGrade,
+	}
+	if o.Err != nil {
+		dto.Status = timeSuggestionReviewFailed
+		dto.Error = outcomeErrorMessage(o.Err)
+	}
+	return dto
+}
+
+func outcomeErrorMessage(err error) string {
+	if _, message, known := sharedAPI.GetErrorRegistry().Lookup(err); known {
+		return message
+	}
+	return "The decision could not be applied"
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"easi/backend/internal/architecturedirection/application/handlers"
+	"easi/backend/internal/architecturedirection/domain/services"
+	sharedAPI "easi/backend/internal/shared/api"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubReviewQueue struct {
+	pending []handlers.PendingTimeSuggestion
+}
+
+func (s *stubReviewQueue) Pending(context.Context) ([]handlers.PendingTimeSuggestion, error) {
+	return s.pending, nil
+}
+
+type stubReviewApplier struct {
+	received   []handlers.TimeSuggestionDecision
+	reviewedBy string
+	outcomes   []handlers.TimeSuggestionOutcome
+	err        error
+}
+
+func (s *stubReviewApplier) Review(_ context.Context, decisions []handlers.TimeSuggestionDecision, reviewedBy string) ([]handlers.TimeSuggestionOutcome, error) {
+	s.received, s.reviewedBy = decisions, reviewedBy
+	return s.outcomes, s.err
+}
+
+func timeSuggestionReviewRouter(queue TimeSuggestionReviewQueue, applier TimeSuggestionReviewApplier) chi.Router {
+	h := NewTimeSuggestionReviewHandlers(queue, applier, NewTimeSuggestionReviewLinks(sharedAPI.NewHATEOASLinks("")))
+	r := chi.NewRouter()
+	r.Get("/time-suggestion-reviews", h.GetTimeSuggestionReviews)
+	r.Post("/time-suggestion-reviews", h.ReviewTimeSuggestions)
+	return r
+}
+
+func TestGetTimeSuggestionReviews_ReturnsEvidenceAndCurrentGrade(t *testing.T) {
+	gap := 2.5
+	queue := &stubReviewQueue{pending: []handlers.PendingTimeSuggestion{{
+		TimeSuggestion: services.TimeSuggestion{
+			CapabilityID: "cap-1", ComponentID: "app-1", SuggestedGrade: "Migrate", Confidence: "MEDIUM", TechnicalGap: &gap,
+			Evidence: []services.PillarEvidence{{PillarID: "p-1", PillarName: "Technology", FitType: "TECHNICAL", Importance: 4, FitScore: 1, Gap: 3}},
+		},
+		CurrentGrade: "Invest",
+	}}}
+	r := timeSuggestionReviewRouter(queue, &stubReviewApplier{})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, withActor(httptest.NewRequest(http.MethodGet, "/time-suggestion-reviews", nil), architectActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body struct {
+		Data  []PendingTimeSuggestionDTO `json:"data"`
+		Links sharedAPI.Links            `json:"_links"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	require.Len(t, body.Data, 1)
+	item := body.Data[0]
+	require.NotNil(t, item.CurrentGrade)
+	assert.Equal(t, "Invest", *item.CurrentGrade)
+	assert.Equal(t, "Migrate", item.SuggestedGrade)
+	assert.Equal(t, []PillarEvidenceDTO{{PillarID: "p-1", PillarName: "Technology", FitType: "TECHNICAL", Importance: 4, FitScore: 1, Gap: 3}}, item.Evidence)
+	assert.True(t, strings.HasSuffix(item.Links["x-time-assessment"].Href, "/capabilities/cap-1/components/app-1/time-assessment"))
+	assert.Contains(t, body.Links, "x-review")
+}
+
+func TestGetTimeSuggestionReviews_ReadOnlyActorHasNoReviewAffordance(t *testing.T) {
+	r := timeSuggestionReviewRouter(&stubReviewQueue{}, &stubReviewApplier{})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, withActor(httptest.NewRequest(http.MethodGet, "/time-suggestion-reviews", nil), stakeholderActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body struct {
+		Links sharedAPI.Links `json:"_links"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.NotContains(t, body.Links, "x-review")
+}
+
+func TestReviewTimeSuggestions_ReportsOutcomePerDecision(t *testing.T) {
+	applier := &stubReviewApplier{outcomes: []handlers.TimeSuggestionOutcome{
+		{Decision: handlers.TimeSuggestionDecision{CapabilityID: "cap-1", ComponentID: "app-1", Action: "accept"}, AppliedGrade: "Migrate"},
+		{Decision: handlers.TimeSuggestionDecision{CapabilityID: "cap-2", ComponentID: "app-2", Action: "reject"}, Err: handlers.ErrTimeSuggestionNotPendingForPair},
+	}}
+	r := timeSuggestionReviewRouter(&stubReviewQueue{}, applier)
+	payload := `{"decisions":[{"capabilityId":"cap-1","componentId":"app-1","action":"accept"},{"capabilityId":"cap-2","componentId":"app-2","action":"reject","rationale":"keep"}]}`
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, withActor(httptest.NewRequest(http.MethodPost, "/time-suggestion-reviews", strings.NewReader(payload)), architectActor()))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, applier.received, 2)
+	assert.Equal(t, "keep", applier.received[1].Rationale)
+	assert.Equal(t, architectActor().Email, applier.reviewedBy)
+	var body struct {
+		Data []TimeSuggestionOutcomeDTO `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	require.Len(t, body.Data, 2)
+	assert.Equal(t, TimeSuggestionOutcomeDTO{CapabilityID: "cap-1", ComponentID: "app-1", Action: "accept", Status: "applied", AppliedGrade: "Migrate"}, body.Data[0])
+	assert.Equal(t, "failed", body.Data[1].Status)
+	assert.Equal(t, "No pending time suggestion exists for this capability and component pair", body.Data[1].Error)
+}
+
+func TestReviewTimeSuggestions_InvalidBatch_Returns400(t *testing.T) {
+	r := timeSuggestionReviewRouter(&stubReviewQueue{}, &stubReviewApplier{err: handlers.ErrInvalidTimeSuggestionAction})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, withActor(httptest.NewRequest(http.MethodPost, "/time-suggestion-reviews",
+		strings.NewReader(`{"decisions":[{"capabilityId":"c","componentId":"a","action":"approve"}]}`)), architectActor()))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,30 @@
+package api
+
+import (
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+const timeSuggestionReviewsPath sharedAPI.ResourcePath = "/time-suggestion-reviews"
+
+type TimeSuggestionReviewLinks struct {
+	*sharedAPI.HATEOASLinks
+}
+
+func NewTimeSuggestionReviewLinks(h *sharedAPI.HATEOASLinks) *TimeSuggestionReviewLinks {
+	return &TimeSuggestionReviewLinks{HATEOASLinks: h}
+}
+
+func (h *TimeSuggestionReviewLinks) ItemLinks(capabilityID, componentID string) sharedAPI.Links {
+	return sharedAPI.Links{
+		"x-time-assessment": h.Get(timeAssessmentItemResourcePath(capabilityID, componentID)),
+	}
+}
+
+func (h *TimeSuggestionReviewLinks) CollectionLinks(actor sharedctx.Actor) sharedAPI.Links {
+	links := sharedAPI.Links{"self": h.Get(string(timeSuggestionReviewsPath))}
+	if actor.CanWrite(ArchitectureDirectionResource) {
+		links["x-review"] = h.Post(string(timeSuggestionReviewsPath))
+	}
+	return links
+}
@@ -39,5 +39,8 @@ var capabilityJourneyEventDeserializers = repository.NewEventDeserializers(
 		pl.JourneyMilestoneUpdated:          repository.JSONDeserializer[events.JourneyMilestoneUpdated],
 		pl.JourneyMilestoneRemoved:          repository.JSONDeserializer[events.JourneyMilestoneRemoved],
 		pl.JourneySourceApplicationsChanged: repository.JSONDeserializer[events.JourneySourceApplicationsChanged],
+		pl.JourneyCapabilityChanged:         repository.JSONDeserializer[events.JourneyCapabilityChanged],
+		pl.JourneyDependencyAdded:           repository.JSONDeserializer[events.JourneyDependencyAdded],
+		pl.JourneyDependencyRemoved:         repository.JSONDeserializer[events.JourneyDependencyRemoved],
 	},
 )
@@ -37,5 +37,6 @@ var directionEventDeserializers = repository.NewEventDeserializers(
 		pl.DirectionHorizonChanged:            repository.JSONDeserializer[events.DirectionHorizonChanged],
 		pl.DirectionPlacementsChanged:         repository.JSONDeserializer[events.DirectionPlacementsChanged],
 		pl.DirectionSourceCapabilitiesChanged: repository.JSONDeserializer[events.DirectionSourceCapabilitiesChanged],
+		pl.DirectionReviewRecorded:            repository.JSONDeserializer[events.DirectionReviewRecorded],
 	},
 )
@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"errors"
+
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/events"
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	"easi/backend/internal/infrastructure/eventstore"
+	"easi/backend/internal/shared/infrastructure/repository"
+)
+
+var ErrJourneyProgrammeNotFound = errors.New("journey programme not found")
+
+type JourneyProgrammeRepository struct {
+	*repository.EventSourcedRepository[*aggregates.JourneyProgramme]
+}
+
+func NewJourneyProgrammeRepository(eventStore eventstore.EventStore) *JourneyProgrammeRepository {
+	return &JourneyProgrammeRepository{
+		EventSourcedRepository: repository.NewEventSourcedRepository(
+			eventStore,
+			journeyProgrammeEventDeserializers,
+			aggregates.LoadJourneyProgrammeFromHistory,
+			ErrJourneyProgrammeNotFound,
+		),
+	}
+}
+
+var journeyProgrammeEventDeserializers = repository.NewEventDeserializers(
+	map[string]repository.EventDeserializerFunc{
+		pl.JourneyProgrammeCreated:        repository.JSONDeserializer[events.JourneyProgrammeCreated],
+		pl.JourneyProgrammeUpdated:        repository.JSONDeserializer[events.JourneyProgrammeUpdated],
+		pl.JourneyProgrammeJourneyAdded:   repository.JSONDeserializer[events.JourneyProgrammeJourneyAdded],
+		pl.JourneyProgrammeJourneyRemoved: repository.JSONDeserializer[events.JourneyProgrammeJourneyRemoved],
+		pl.JourneyProgrammeDeleted:        repository.JSONDeserializer[events.JourneyProgrammeDeleted],
+	},
+)
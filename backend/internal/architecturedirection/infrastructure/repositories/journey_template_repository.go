@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"errors"
+
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/events"
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	"easi/backend/internal/infrastructure/eventstore"
+	"easi/backend/internal/shared/infrastructure/repository"
+)
+
+var ErrJourneyTemplateNotFound = errors.New("journey template not found")
+
+type JourneyTemplateRepository struct {
+	*repository.EventSourcedRepository[*aggregates.JourneyTemplate]
+}
+
+func NewJourneyTemplateRepository(eventStore eventstore.EventStore) *JourneyTemplateRepository {
+	return &JourneyTemplateRepository{
+		EventSourcedRepository: repository.NewEventSourcedRepository(
+			eventStore,
+			journeyTemplateEventDeserializers,
+			aggregates.LoadJourneyTemplateFromHistory,
+			ErrJourneyTemplateNotFound,
+		),
+	}
+}
+
+var journeyTemplateEventDeserializers = repository.NewEventDeserializers(
+	map[string]repository.EventDeserializerFunc{
+		pl.JourneyTemplateCreated: repository.JSONDeserializer[events.JourneyTemplateCreated],
+		pl.JourneyTemplateRevised: repository.JSONDeserializer[events.JourneyTemplateRevised],
+		pl.JourneyTemplateRetired: repository.JSONDeserializer[events.JourneyTemplateRetired],
+	},
+)
@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"errors"
+
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/events"
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	"easi/backend/internal/infrastructure/eventstore"
+	"easi/backend/internal/shared/infrastructure/repository"
+)
+
+var ErrStandardExceptionNotFound = errors.New("standard exception not found")
+
+type StandardExceptionRepository struct {
+	*repository.EventSourcedRepository[*aggregates.StandardException]
+}
+
+func NewStandardExceptionRepository(eventStore eventstore.EventStore) *StandardExceptionRepository {
+	return &StandardExceptionRepository{
+		EventSourcedRepository: repository.NewEventSourcedRepository(
+			eventStore,
+			standardExceptionEventDeserializers,
+			aggregates.LoadStandardExceptionFromHistory,
+			ErrStandardExceptionNotFound,
+		),
+	}
+}
+
+var standardExceptionEventDeserializers = repository.NewEventDeserializers(
+	map[string]repository.EventDeserializerFunc{
+		pl.StandardExceptionGranted: repository.JSONDeserializer[events.StandardExceptionGranted],
+		pl.StandardExceptionRenewed: repository.JSONDeserializer[events.StandardExceptionRenewed],
+		pl.StandardExceptionRevoked: repository.JSONDeserializer[events.StandardExceptionRevoked],
+		pl.StandardExceptionExpired: repository.JSONDeserializer[events.StandardExceptionExpired],
+	},
+)
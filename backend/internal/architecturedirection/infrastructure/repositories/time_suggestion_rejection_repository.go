@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"errors"
+
+	"easi/backend/internal/architecturedirection/domain/aggregates"
+	"easi/backend/internal/architecturedirection/domain/events"
+	pl "easi/backend/internal/architecturedirection/publishedlanguage"
+	"easi/backend/internal/infrastructure/eventstore"
+	"easi/backend/internal/shared/infrastructure/repository"
+)
+
+var ErrTimeSuggestionRejectionNotFound = errors.New("time suggestion rejection not found")
+
+type TimeSuggestionRejectionRepository struct {
+	*repository.EventSourcedRepository[*aggregates.TimeSuggestionRejection]
+}
+
+func NewTimeSuggestionRejectionRepository(eventStore eventstore.EventStore) *TimeSuggestionRejectionRepository {
+	return &TimeSuggestionRejectionRepository{
+		EventSourcedRepository: repository.NewEventSourcedRepository(
+			eventStore,
+			timeSuggestionRejectionEventDeserializers,
+			aggregates.LoadTimeSuggestionRejectionFromHistory,
+			ErrTimeSuggestionRejectionNotFound,
+		),
+	}
+}
+
+var timeSuggestionRejectionEventDeserializers = repository.NewEventDeserializers(
+	map[string]repository.EventDeserializerFunc{
+		pl.TimeSuggestionRejected: repository.JSONDeserializer[events.TimeSuggestionRejected],
+	},
+)
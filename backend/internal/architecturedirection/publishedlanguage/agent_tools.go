@@ -71,6 +71,39 @@ func timeAssessmentTools() []pl.AgentToolSpec {
 				pl.StringParam("componentIds", "Comma-separated application component IDs (UUIDs)", true),
 			},
 		},
+		{
+			Name:        "get_time_assessment_history",
+			Description: "Get every TIME grade ever recorded for a realisation — a (domain capability, application component) pair — newest first, with who changed it, when and why. An entry with a null grade marks the assessment being removed or dropped. Empty when the pair has never been assessed.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/capabilities/{id}/components/{componentId}/time-assessment/history",
+			PathParams: []pl.ParamSpec{
+				pl.UUIDParam("id", "Domain capability ID (UUID)"),
+				pl.UUIDParam("componentId", "Application component ID (UUID)"),
+			},
+		},
+		{
+			Name:        "get_time_assessment_trend",
+			Description: "Get how many realisations were graded Invest / Tolerate / Migrate / Eliminate at the end of each quarter — use it to tell whether rationalisation is shrinking the Eliminate population over time. Defaults to the last 8 quarters; the current quarter is counted as of now. Narrow to one business domain with businessDomainId.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/time-assessments/trend",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("from", "First quarter as YYYY-Qn, e.g. 2025-Q1", false),
+				pl.StringParam("to", "Last quarter as YYYY-Qn; defaults to the current quarter", false),
+				pl.StringParam("businessDomainId", "Business domain ID (UUID) to restrict the count to", false),
+			},
+		},
+		{
+			Name:        "list_time_suggestion_reviews",
+			Description: "List the TIME grades suggested from strategy-pillar fit gaps that still await an architect's review — realisations that have no assessment yet or whose current grade disagrees with the suggestion. Each entry carries the current grade, the suggested grade, its confidence and the per-pillar importance and fit scores behind it. Suggestions an architect rejected are hidden until those scores change.",
+			Access:      pl.AccessRead,
+			Permission:  "architecture-direction:read",
+			Method:      "GET",
+			Path:        "/time-suggestion-reviews",
+		},
 	}
 }
 
@@ -133,5 +166,113 @@ func capabilityJourneyTools() []pl.AgentToolSpec {
 				pl.StringParam("capabilityIds", "Comma-separated domain capability IDs (UUIDs); omit to fetch the whole collection", false),
 			},
 		},
+		{
+			Name:        "list_journey_programmes",
+			Description: "List journey programmes — named groups of capability journeys delivered together. Each programme carries its member journeys, a roll-up (average progress, earliest and latest target period, status counts, number of blocked journeys) and, per journey, the predecessor journeys still blocking it.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/journey-programmes",
+		},
+		{
+			Name:        "get_journey_programme",
+			Description: "Get one journey programme with its member journeys, roll-up progress and target period span, and blocked-by reporting for journeys whose predecessors are not yet far enough along.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/journey-programmes/{programmeId}",
+			PathParams:  []pl.ParamSpec{pl.UUIDParam("programmeId", "Journey programme ID (UUID)")},
+		},
+		{
+			Name:        "get_journey_roadmap",
+			Description: "Get the roadmap of active journeys: each journey scheduled from its start (or target quarter start) to the end of its target quarter, with milestones dated at the end of their target quarter. Journeys without a target period are only counted. Filter by business domain, programme or journey kind.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/journey-roadmap",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("businessDomainId", "Only journeys on capabilities in this business domain (UUID)", false),
+				pl.StringParam("programmeId", "Only journeys in this journey programme (UUID)", false),
+				pl.StringParam("kind", "Comma-separated journey kinds: migration, consolidation, carve-out, move", false),
+			},
+		},
+		{
+			Name:        "list_journey_slips",
+			Description: "List in-flight journeys and milestones whose current target period is later than their baseline — the target agreed when the journey was started. Each entry carries the baseline and current quarter, quarters slipped, who last moved it, and the full list of target moves. Most slipped first.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/journey-slips",
+		},
+		{
+			Name:        "get_journey_slip_history",
+			Description: "Get one journey's baseline next to its current target period (for the journey and each milestone) and every target move made since it was started — from, to, quarters moved, who moved it and when.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/journey-slips/{journeyId}",
+			PathParams:  []pl.ParamSpec{pl.UUIDParam("journeyId", "Capability journey ID (UUID)")},
+		},
+		{
+			Name:        "get_target_state",
+			Description: "Get the projected target-state landscape: today's capabilities with active journeys, agreed directions and standard realisation roles applied, as a capability to application map. Each capability lists its target applications, the applications retired on the way and what changed them (journey, direction or realization-role); moved capabilities show their new parent and domain and where they came from. Horizon now covers the current quarter, next the following four quarters, later (default) every planned change; or pass targetQuarter instead.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/target-state",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("horizon", "One of now, next, later", false),
+				pl.StringParam("targetQuarter", "Last quarter to apply journeys from, as YYYY-Qn; not combinable with horizon", false),
+				pl.StringParam("businessDomainId", "Business domain ID (UUID) the capabilities end up in", false),
+			},
+		},
+		{
+			Name:        "list_standard_exceptions",
+			Description: "List the standard exceptions register: time-limited permissions for a non-standard application to keep realising a capability. Each entry carries the capability, application, justification, approver, expiry date and status (active, expired or revoked). Soonest expiry first.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/standard-exceptions",
+		},
+		{
+			Name:        "get_standard_exception",
+			Description: "Get one standard exception: the capability and application it covers, justification, approver, expiry date, status and when it was granted, renewed or revoked.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/standard-exceptions/{exceptionId}",
+			PathParams:  []pl.ParamSpec{pl.UUIDParam("exceptionId", "Standard exception ID (UUID)")},
+		},
+		{
+			Name:        "get_standard_compliance",
+			Description: "Get the standard application compliance report: per capability, the standard applications and every other directly realising application flagged non-compliant, excepted or exception-expired. Applications already being migrated off by an active journey are left out. Includes summary counts and a signal for each expired exception.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/standard-compliance",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("businessDomainId", "Only capabilities in this business domain (UUID)", false),
+			},
+		},
+		{
+			Name:        "list_journey_templates",
+			Description: "List journey templates — reusable milestone plans per journey kind. Each template carries its current version and default milestones, each offset a number of quarters after the quarter a journey is planned in. Retired templates are left out.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/journey-templates",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("kind", "Only templates for this journey kind: migration, consolidation, carve-out, move", false),
+			},
+		},
+		{
+			Name:        "get_journey_template",
+			Description: "Get one journey template with its kind, current version, default milestones and relative target offsets, and whether it has been retired.",
+			Access:      pl.AccessRead,
+			Permission:  "domains:read",
+			Method:      "GET",
+			Path:        "/journey-templates/{templateId}",
+			PathParams:  []pl.ParamSpec{pl.UUIDParam("templateId", "Journey template ID (UUID)")},
+		},
 	}
 }
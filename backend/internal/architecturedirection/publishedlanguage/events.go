@@ -9,11 +9,13 @@ const (
 	DirectionHorizonChanged            = "DirectionHorizonChanged"
 	DirectionPlacementsChanged         = "DirectionPlacementsChanged"
 	DirectionSourceCapabilitiesChanged = "DirectionSourceCapabilitiesChanged"
+	DirectionReviewRecorded            = "DirectionReviewRecorded"
 
 	StandardApplicationSet = "StandardApplicationSet"
 
 	TimeAssessmentRecorded = "TimeAssessmentRecorded"
 	TimeAssessmentRemoved  = "TimeAssessmentRemoved"
+	TimeSuggestionRejected = "TimeSuggestionRejected"
 
 	RealizationRoleAssigned = "RealizationRoleAssigned"
 	RealizationRoleCleared  = "RealizationRoleCleared"
@@ -28,4 +30,22 @@ const (
 	JourneyMilestoneUpdated          = "JourneyMilestoneUpdated"
 	JourneyMilestoneRemoved          = "JourneyMilestoneRemoved"
 	JourneySourceApplicationsChanged = "JourneySourceApplicationsChanged"
+	JourneyCapabilityChanged         = "JourneyCapabilityChanged"
+	JourneyDependencyAdded           = "JourneyDependencyAdded"
+	JourneyDependencyRemoved         = "JourneyDependencyRemoved"
+
+	JourneyProgrammeCreated        = "JourneyProgrammeCreated"
+	JourneyProgrammeUpdated        = "JourneyProgrammeUpdated"
+	JourneyProgrammeJourneyAdded   = "JourneyProgrammeJourneyAdded"
+	JourneyProgrammeJourneyRemoved = "JourneyProgrammeJourneyRemoved"
+	JourneyProgrammeDeleted        = "JourneyProgrammeDeleted"
+
+	JourneyTemplateCreated = "JourneyTemplateCreated"
+	JourneyTemplateRevised = "JourneyTemplateRevised"
+	JourneyTemplateRetired = "JourneyTemplateRetired"
+
+	StandardExceptionGranted = "StandardExceptionGranted"
+	StandardExceptionRenewed = "StandardExceptionRenewed"
+	StandardExceptionRevoked = "StandardExceptionRevoked"
+	StandardExceptionExpired = "StandardExceptionExpired"
 )
@@ -0,0 +1,19 @@
+package commands
+
+type AddApplicationComponentTag struct {
+	ComponentID string
+	Tag         string
+}
+
+func (c AddApplicationComponentTag) CommandName() string {
+	return "AddApplicationComponentTag"
+}
+
+type RemoveApplicationComponentTag struct {
+	ComponentID string
+	Tag         string
+}
+
+func (c RemoveApplicationComponentTag) CommandName() string {
+	return "RemoveApplicationComponentTag"
+}
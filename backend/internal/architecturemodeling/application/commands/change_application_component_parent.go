@@ -0,0 +1,10 @@
+package commands
+
+type ChangeApplicationComponentParent struct {
+	ID       string
+	ParentID string
+}
+
+func (c ChangeApplicationComponentParent) CommandName() string {
+	return "ChangeApplicationComponentParent"
+}
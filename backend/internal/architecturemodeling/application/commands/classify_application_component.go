@@ -0,0 +1,13 @@
+package commands
+
+// ClassifyApplicationComponent sets the component's value for a classification dimension.
+// An empty Value clears the classification.
+type ClassifyApplicationComponent struct {
+	ComponentID string
+	DimensionID string
+	Value       string
+}
+
+func (c ClassifyApplicationComponent) CommandName() string {
+	return "ClassifyApplicationComponent"
+}
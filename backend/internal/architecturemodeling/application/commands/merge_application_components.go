@@ -0,0 +1,13 @@
+package commands
+
+// MergeApplicationComponents folds a duplicate component into a surviving one
+// and deletes the duplicate afterwards.
+type MergeApplicationComponents struct {
+	SurvivorID  string
+	DuplicateID string
+	MergedBy    string
+}
+
+func (c MergeApplicationComponents) CommandName() string {
+	return "MergeApplicationComponents"
+}
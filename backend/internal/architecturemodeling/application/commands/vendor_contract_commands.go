@@ -0,0 +1,43 @@
+package commands
+
+import "time"
+
+type VendorContractFields struct {
+	Name                string
+	StartDate           time.Time
+	EndDate             time.Time
+	NoticePeriodDays    int
+	ValueAmount         float64
+	ValueCurrency       string
+	Owner               string
+	CoveredComponentIDs []string
+	Notes               string
+}
+
+type CreateVendorContract struct {
+	VendorID string
+	VendorContractFields
+}
+
+func (c CreateVendorContract) CommandName() string {
+	return "CreateVendorContract"
+}
+
+type UpdateVendorContract struct {
+	ID       string
+	VendorID string
+	VendorContractFields
+}
+
+func (c UpdateVendorContract) CommandName() string {
+	return "UpdateVendorContract"
+}
+
+type DeleteVendorContract struct {
+	ID       string
+	VendorID string
+}
+
+func (c DeleteVendorContract) CommandName() string {
+	return "DeleteVendorContract"
+}
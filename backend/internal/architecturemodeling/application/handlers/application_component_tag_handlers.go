@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/domain/aggregates"
+	"easi/backend/internal/architecturemodeling/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type ApplicationComponentTagRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.ApplicationComponent, error)
+	Save(ctx context.Context, component *aggregates.ApplicationComponent) error
+}
+
+type AddApplicationComponentTagHandler struct {
+	repository ApplicationComponentTagRepository
+}
+
+func NewAddApplicationComponentTagHandler(repository ApplicationComponentTagRepository) *AddApplicationComponentTagHandler {
+	return &AddApplicationComponentTagHandler{repository: repository}
+}
+
+func (h *AddApplicationComponentTagHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.AddApplicationComponentTag)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	return cqrs.EmptyResult(), modifyComponentTag(ctx, h.repository, command.ComponentID, command.Tag,
+		func(component *aggregates.ApplicationComponent, tag valueobjects.Tag) error {
+			return component.AddTag(tag)
+		})
+}
+
+type RemoveApplicationComponentTagHandler struct {
+	repository ApplicationComponentTagRepository
+}
+
+func NewRemoveApplicationComponentTagHandler(repository ApplicationComponentTagRepository) *RemoveApplicationComponentTagHandler {
+	return &RemoveApplicationComponentTagHandler{repository: repository}
+}
+
+func (h *RemoveApplicationComponentTagHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.RemoveApplicationComponentTag)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	return cqrs.EmptyResult(), modifyComponentTag(ctx, h.repository, command.ComponentID, command.Tag,
+		func(component *aggregates.ApplicationComponent, tag valueobjects.Tag) error {
+			return component.RemoveTag(tag)
+		})
+}
+
+func modifyComponentTag(
+	ctx context.Context,
+	repository ApplicationComponentTagRepository,
+	componentID, rawTag string,
+	modify func(*aggregates.ApplicationComponent, valueobjects.Tag) error,
+) error {
+	tag, err := valueobjects.NewTag(rawTag)
+	if err != nil {
+		return err
+	}
+
+	component, err := repository.GetByID(ctx, componentID)
+	if err != nil {
+		return err
+	}
+
+	if err := modify(component, tag); err != nil {
+		return err
+	}
+
+	return repository.Save(ctx, component)
+}
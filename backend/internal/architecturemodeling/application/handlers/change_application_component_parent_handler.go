@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	"easi/backend/internal/architecturemodeling/domain/aggregates"
+	"easi/backend/internal/architecturemodeling/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+var (
+	ErrParentComponentNotFound = errors.New("parent component not found")
+	ErrComponentHierarchyCycle = errors.New("a component cannot be placed under one of its own sub-components")
+)
+
+type ChangeApplicationComponentParentRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.ApplicationComponent, error)
+	Save(ctx context.Context, component *aggregates.ApplicationComponent) error
+}
+
+type ComponentParentLookup interface {
+	GetByID(ctx context.Context, id string) (*readmodels.ApplicationComponentDTO, error)
+}
+
+type ChangeApplicationComponentParentHandler struct {
+	repository ChangeApplicationComponentParentRepository
+	lookup     ComponentParentLookup
+}
+
+func NewChangeApplicationComponentParentHandler(repository ChangeApplicationComponentParentRepository, lookup ComponentParentLookup) *ChangeApplicationComponentParentHandler {
+	return &ChangeApplicationComponentParentHandler{
+		repository: repository,
+		lookup:     lookup,
+	}
+}
+
+func (h *ChangeApplicationComponentParentHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.ChangeApplicationComponentParent)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	component, err := h.repository.GetByID(ctx, command.ID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if command.ParentID != "" {
+		if err := h.validateNewParent(ctx, command.ID, command.ParentID); err != nil {
+			return cqrs.EmptyResult(), err
+		}
+	}
+
+	if err := component.ChangeParent(command.ParentID); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, component); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
+
+func (h *ChangeApplicationComponentParentHandler) validateNewParent(ctx context.Context, componentID, parentID string) error {
+	if _, err := valueobjects.NewComponentIDFromString(parentID); err != nil {
+		return err
+	}
+
+	parent, err := h.lookup.GetByID(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		return ErrParentComponentNotFound
+	}
+
+	visited := map[string]struct{}{}
+	for current := parent; current != nil; {
+		if current.ID == componentID {
+			return ErrComponentHierarchyCycle
+		}
+		if _, seen := visited[current.ID]; seen || current.ParentID == "" {
+			return nil
+		}
+		visited[current.ID] = struct{}{}
+
+		if current, err = h.lookup.GetByID(ctx, current.ParentID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	"easi/backend/internal/architecturemodeling/domain/aggregates"
+	"easi/backend/internal/shared/cqrs"
+)
+
+var (
+	ErrUnknownClassificationDimension = errors.New("classification dimension not found or no longer active")
+	ErrInvalidClassificationValue     = errors.New("value is not allowed for this classification dimension")
+)
+
+type ClassifyApplicationComponentRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.ApplicationComponent, error)
+	Save(ctx context.Context, component *aggregates.ApplicationComponent) error
+}
+
+type ClassificationDimensionLookup interface {
+	GetByID(ctx context.Context, id string) (*readmodels.ClassificationDimensionCacheDTO, error)
+}
+
+type ClassifyApplicationComponentHandler struct {
+	repository ClassifyApplicationComponentRepository
+	dimensions ClassificationDimensionLookup
+}
+
+func NewClassifyApplicationComponentHandler(repository ClassifyApplicationComponentRepository, dimensions ClassificationDimensionLookup) *ClassifyApplicationComponentHandler {
+	return &ClassifyApplicationComponentHandler{
+		repository: repository,
+		dimensions: dimensions,
+	}
+}
+
+func (h *ClassifyApplicationComponentHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.ClassifyApplicationComponent)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	component, err := h.repository.GetByID(ctx, command.ComponentID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if command.Value == "" {
+		if err := component.ClearClassification(command.DimensionID); err != nil {
+			return cqrs.EmptyResult(), err
+		}
+	} else {
+		if err := h.validate(ctx, command.DimensionID, command.Value); err != nil {
+			return cqrs.EmptyResult(), err
+		}
+		if err := component.Classify(command.DimensionID, command.Value); err != nil {
+			return cqrs.EmptyResult(), err
+		}
+	}
+
+	if err := h.repository.Save(ctx, component); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
+
+func (h *ClassifyApplicationComponentHandler) validate(ctx context.Context, dimensionID, value string) error {
+	dimension, err := h.dimensions.GetByID(ctx, dimensionID)
+	if err != nil {
+		return err
+	}
+	if dimension == nil || !dimension.Active {
+		return ErrUnknownClassificationDimension
+	}
+	if !dimension.AllowsValue(value) {
+		return ErrInvalidClassificationValue
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 
 	"easi/backend/internal/architecturemodeling/application/commands"
 	"easi/backend/internal/architecturemodeling/domain/aggregates"
@@ -13,13 +14,21 @@ type CreateComponentRelationRepository interface {
 	Save(ctx context.Context, relation *aggregates.ComponentRelation) error
 }
 
+var ErrUnknownCustomRelationType = errors.New("custom relation type does not exist or is no longer active")
+
+type CustomRelationTypeLookup interface {
+	IsActive(ctx context.Context, id string) (bool, error)
+}
+
 type CreateComponentRelationHandler struct {
-	repository CreateComponentRelationRepository
+	repository          CreateComponentRelationRepository
+	customRelationTypes CustomRelationTypeLookup
 }
 
-func NewCreateComponentRelationHandler(repository CreateComponentRelationRepository) *CreateComponentRelationHandler {
+func NewCreateComponentRelationHandler(repository CreateComponentRelationRepository, customRelationTypes CustomRelationTypeLookup) *CreateComponentRelationHandler {
 	return &CreateComponentRelationHandler{
-		repository: repository,
+		repository:          repository,
+		customRelationTypes: customRelationTypes,
 	}
 }
 
@@ -39,7 +48,7 @@ func (h *CreateComponentRelationHandler) Handle(ctx context.Context, cmd cqrs.Co
 		return cqrs.EmptyResult(), err
 	}
 
-	relationType, err := valueobjects.NewRelationType(command.RelationType)
+	relationType, err := h.resolveRelationType(ctx, command.RelationType)
 	if err != nil {
 		return cqrs.EmptyResult(), err
 	}
@@ -72,3 +81,22 @@ func (h *CreateComponentRelationHandler) Handle(ctx context.Context, cmd cqrs.Co
 
 	return cqrs.NewResult(relation.ID()), nil
 }
+
+func (h *CreateComponentRelationHandler) resolveRelationType(ctx context.Context, value string) (valueobjects.RelationType, error) {
+	relationType, err := valueobjects.NewRelationType(value)
+	if err != nil {
+		return "", err
+	}
+	if !relationType.IsCustom() {
+		return relationType, nil
+	}
+
+	active, err := h.customRelationTypes.IsActive(ctx, relationType.CustomTypeID())
+	if err != nil {
+		return "", err
+	}
+	if !active {
+		return "", ErrUnknownCustomRelationType
+	}
+	return relationType, nil
+}
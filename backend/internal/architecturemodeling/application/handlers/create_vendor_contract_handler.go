@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/domain/aggregates"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type CreateVendorContractRepository interface {
+	Save(ctx context.Context, contract *aggregates.VendorContract) error
+}
+
+type CreateVendorContractHandler struct {
+	repository       CreateVendorContractRepository
+	vendorRepository VendorContractVendorRepository
+	coverageReader   VendorContractCoverageReader
+}
+
+func NewCreateVendorContractHandler(
+	repository CreateVendorContractRepository,
+	vendorRepository VendorContractVendorRepository,
+	coverageReader VendorContractCoverageReader,
+) *CreateVendorContractHandler {
+	return &CreateVendorContractHandler{
+		repository:       repository,
+		vendorRepository: vendorRepository,
+		coverageReader:   coverageReader,
+	}
+}
+
+func (h *CreateVendorContractHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.CreateVendorContract)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	vendorID, err := requireActiveVendor(ctx, h.vendorRepository, command.VendorID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	details, err := buildVendorContractDetails(ctx, h.coverageReader, vendorID.Value(), command.VendorContractFields)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	contract, err := aggregates.NewVendorContract(vendorID, details)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, contract); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.NewResult(contract.ID()), nil
+}
@@ -21,20 +21,27 @@ type DeleteApplicationComponentRelationReader interface {
 	GetByTargetID(ctx context.Context, targetID string) ([]readmodels.ComponentRelationDTO, error)
 }
 
+type DeleteApplicationComponentChildReader interface {
+	GetChildren(ctx context.Context, parentID string) ([]readmodels.ApplicationComponentDTO, error)
+}
+
 type DeleteApplicationComponentHandler struct {
 	repository     DeleteApplicationComponentRepository
 	relationReader DeleteApplicationComponentRelationReader
+	childReader    DeleteApplicationComponentChildReader
 	commandBus     cqrs.CommandBus
 }
 
 func NewDeleteApplicationComponentHandler(
 	repository DeleteApplicationComponentRepository,
 	relationReader DeleteApplicationComponentRelationReader,
+	childReader DeleteApplicationComponentChildReader,
 	commandBus cqrs.CommandBus,
 ) *DeleteApplicationComponentHandler {
 	return &DeleteApplicationComponentHandler{
 		repository:     repository,
 		relationReader: relationReader,
+		childReader:    childReader,
 		commandBus:     commandBus,
 	}
 }
@@ -97,5 +104,24 @@ func (h *DeleteApplicationComponentHandler) Handle(ctx context.Context, cmd cqrs
 		log.Printf("Cascaded delete for relation %s", relation.ID)
 	}
 
+	h.detachChildren(ctx, componentID.Value())
+
 	return cqrs.EmptyResult(), nil
 }
+
+func (h *DeleteApplicationComponentHandler) detachChildren(ctx context.Context, componentID string) {
+	children, err := h.childReader.GetChildren(ctx, componentID)
+	if err != nil {
+		log.Printf("Error querying child components of component %s: %v", componentID, err)
+		return
+	}
+
+	for _, child := range children {
+		cmd := &commands.ChangeApplicationComponentParent{ID: child.ID}
+		if _, err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+			log.Printf("Error detaching child component %s from deleted parent %s: %v", child.ID, componentID, err)
+			continue
+		}
+		log.Printf("Detached child component %s from deleted parent %s", child.ID, componentID)
+	}
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/domain/aggregates"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type DeleteVendorContractRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.VendorContract, error)
+	Save(ctx context.Context, contract *aggregates.VendorContract) error
+}
+
+type DeleteVendorContractHandler struct {
+	repository DeleteVendorContractRepository
+}
+
+func NewDeleteVendorContractHandler(repository DeleteVendorContractRepository) *DeleteVendorContractHandler {
+	return &DeleteVendorContractHandler{
+		repository: repository,
+	}
+}
+
+func (h *DeleteVendorContractHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.DeleteVendorContract)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	contract, err := h.repository.GetByID(ctx, command.ID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if command.VendorID != "" && contract.VendorID().Value() != command.VendorID {
+		return cqrs.EmptyResult(), ErrVendorContractVendorMismatch
+	}
+
+	if contract.IsDeleted() {
+		return cqrs.EmptyResult(), nil
+	}
+
+	if err := contract.Delete(); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, contract); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
@@ -20,20 +20,27 @@ type DeleteVendorRelationReader interface {
 	GetByVendorID(ctx context.Context, vendorID string) ([]readmodels.PurchasedFromRelationshipDTO, error)
 }
 
+type DeleteVendorContractReader interface {
+	GetByVendorID(ctx context.Context, vendorID string) ([]readmodels.VendorContractDTO, error)
+}
+
 type DeleteVendorHandler struct {
 	repository        DeleteVendorRepository
 	relationReadModel DeleteVendorRelationReader
+	contractReadModel DeleteVendorContractReader
 	commandBus        cqrs.CommandBus
 }
 
 func NewDeleteVendorHandler(
 	repository DeleteVendorRepository,
 	relationReadModel DeleteVendorRelationReader,
+	contractReadModel DeleteVendorContractReader,
 	commandBus cqrs.CommandBus,
 ) *DeleteVendorHandler {
 	return &DeleteVendorHandler{
 		repository:        repository,
 		relationReadModel: relationReadModel,
+		contractReadModel: contractReadModel,
 		commandBus:        commandBus,
 	}
 }
@@ -61,7 +68,11 @@ func (h *DeleteVendorHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqr
 		return cqrs.EmptyResult(), err
 	}
 
-	return cqrs.EmptyResult(), h.cascadeClear(ctx, command.ID)
+	if err := h.cascadeClear(ctx, command.ID); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), h.cascadeDeleteContracts(ctx, command.ID)
 }
 
 func (h *DeleteVendorHandler) cascadeClear(ctx context.Context, vendorID string) error {
@@ -81,3 +92,21 @@ func (h *DeleteVendorHandler) cascadeClear(ctx context.Context, vendorID string)
 	}
 	return nil
 }
+
+func (h *DeleteVendorHandler) cascadeDeleteContracts(ctx context.Context, vendorID string) error {
+	contracts, err := h.contractReadModel.GetByVendorID(ctx, vendorID)
+	if err != nil {
+		log.Printf("Error querying contracts for vendor %s: %v", vendorID, err)
+		return err
+	}
+
+	for _, contract := range contracts {
+		deleteCmd := &commands.DeleteVendorContract{ID: contract.ID, VendorID: vendorID}
+		if _, err := h.commandBus.Dispatch(ctx, deleteCmd); err != nil {
+			log.Printf("Error cascading delete for contract %s of vendor %s: %v", contract.ID, vendorID, err)
+			continue
+		}
+		log.Printf("Cascaded delete for contract %s of vendor %s", contract.ID, vendorID)
+	}
+	return nil
+}
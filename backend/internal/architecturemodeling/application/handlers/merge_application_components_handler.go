@@ -0,0 +1,252 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	"easi/backend/internal/architecturemodeling/domain/aggregates"
+	"easi/backend/internal/architecturemodeling/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type MergeApplicationComponentsRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.ApplicationComponent, error)
+	Save(ctx context.Context, component *aggregates.ApplicationComponent) error
+}
+
+// MergeApplicationComponentsHandler moves relations and child components through their
+// own commands first, saving the record of each move on both components as soon as it
+// succeeds. A failed move stops the merge before anything is absorbed or deleted; the
+// moves already made stay recorded, and retrying the merge resumes with what is still
+// on the duplicate. Once every move has succeeded, the experts, tags and classifications
+// owned by the component aggregate are absorbed and the duplicate is deleted. Other
+// contexts move their data (realizations, fit scores, view memberships, one-pager facts)
+// by reacting to ApplicationComponentMergedInto, which is published before the duplicate
+// is deleted.
+type MergeApplicationComponentsHandler struct {
+	repository     MergeApplicationComponentsRepository
+	relationReader DeleteApplicationComponentRelationReader
+	childReader    DeleteApplicationComponentChildReader
+	commandBus     cqrs.CommandBus
+}
+
+func NewMergeApplicationComponentsHandler(
+	repository MergeApplicationComponentsRepository,
+	relationReader DeleteApplicationComponentRelationReader,
+	childReader DeleteApplicationComponentChildReader,
+	commandBus cqrs.CommandBus,
+) *MergeApplicationComponentsHandler {
+	return &MergeApplicationComponentsHandler{
+		repository:     repository,
+		relationReader: relationReader,
+		childReader:    childReader,
+		commandBus:     commandBus,
+	}
+}
+
+func (h *MergeApplicationComponentsHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.MergeApplicationComponents)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	survivor, duplicate, err := h.loadPair(ctx, command.SurvivorID, command.DuplicateID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := survivor.CanAbsorb(duplicate); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	progress := &mergeProgress{}
+	if err := h.moveRelations(ctx, survivor, duplicate, command.MergedBy, progress); err != nil {
+		return cqrs.EmptyResult(), progress.wrap(err)
+	}
+	if err := h.moveChildren(ctx, survivor, duplicate, command.MergedBy, progress); err != nil {
+		return cqrs.EmptyResult(), progress.wrap(err)
+	}
+
+	if err := survivor.AbsorbDuplicate(duplicate, command.MergedBy); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.savePair(ctx, survivor, duplicate); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if _, err := h.commandBus.Dispatch(ctx, &commands.DeleteApplicationComponent{ID: duplicate.ID()}); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.NewResult(survivor.ID()), nil
+}
+
+// mergeProgress lists the relations and children already moved, so a failed merge
+// can say what a retry will no longer find on the duplicate.
+type mergeProgress struct {
+	relations []string
+	children  []string
+}
+
+func (p *mergeProgress) wrap(err error) error {
+	if len(p.relations) == 0 && len(p.children) == 0 {
+		return err
+	}
+	return fmt.Errorf("%w (already moved and recorded: relations [%s], child components [%s])",
+		err, strings.Join(p.relations, ", "), strings.Join(p.children, ", "))
+}
+
+func (h *MergeApplicationComponentsHandler) savePair(ctx context.Context, survivor, duplicate *aggregates.ApplicationComponent) error {
+	if err := h.repository.Save(ctx, survivor); err != nil {
+		return err
+	}
+	return h.repository.Save(ctx, duplicate)
+}
+
+func (h *MergeApplicationComponentsHandler) loadPair(ctx context.Context, survivorID, duplicateID string) (*aggregates.ApplicationComponent, *aggregates.ApplicationComponent, error) {
+	if _, err := valueobjects.NewComponentIDFromString(survivorID); err != nil {
+		return nil, nil, err
+	}
+	if _, err := valueobjects.NewComponentIDFromString(duplicateID); err != nil {
+		return nil, nil, err
+	}
+	if survivorID == duplicateID {
+		return nil, nil, aggregates.ErrCannotMergeIntoItself
+	}
+
+	survivor, err := h.repository.GetByID(ctx, survivorID)
+	if err != nil {
+		return nil, nil, err
+	}
+	duplicate, err := h.repository.GetByID(ctx, duplicateID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return survivor, duplicate, nil
+}
+
+func (h *MergeApplicationComponentsHandler) relationsOf(ctx context.Context, componentID string) ([]readmodels.ComponentRelationDTO, error) {
+	asSource, err := h.relationReader.GetBySourceID(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+	asTarget, err := h.relationReader.GetByTargetID(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+	return append(asSource, asTarget...), nil
+}
+
+func relationKey(sourceID, targetID, relationType string) string {
+	return sourceID + "|" + targetID + "|" + relationType
+}
+
+// moveRelations re-points each of the duplicate's relations at the survivor. Relations
+// that would connect the survivor to itself, or that the survivor already has, are dropped.
+func (h *MergeApplicationComponentsHandler) moveRelations(ctx context.Context, survivor, duplicate *aggregates.ApplicationComponent, mergedBy string, progress *mergeProgress) error {
+	survivorRelations, err := h.relationsOf(ctx, survivor.ID())
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]struct{}, len(survivorRelations))
+	for _, rel := range survivorRelations {
+		existing[relationKey(rel.SourceComponentID, rel.TargetComponentID, rel.RelationType)] = struct{}{}
+	}
+
+	duplicateRelations, err := h.relationsOf(ctx, duplicate.ID())
+	if err != nil {
+		return err
+	}
+
+	moved := make(map[string]struct{}, len(duplicateRelations))
+	for _, rel := range duplicateRelations {
+		if _, done := moved[rel.ID]; done {
+			continue
+		}
+		moved[rel.ID] = struct{}{}
+		newRelationID, err := h.moveRelation(ctx, rel, survivor.ID(), duplicate.ID(), existing)
+		if err != nil {
+			return fmt.Errorf("move relation %s from component %s to %s: %w", rel.ID, duplicate.ID(), survivor.ID(), err)
+		}
+		survivor.RecordRelationMoved(duplicate, rel.ID, newRelationID, mergedBy)
+		if err := h.savePair(ctx, survivor, duplicate); err != nil {
+			return fmt.Errorf("record move of relation %s: %w", rel.ID, err)
+		}
+		progress.relations = append(progress.relations, rel.ID)
+	}
+	return nil
+}
+
+// moveRelation recreates the relation on the survivor and deletes the original. It
+// returns the recreated relation's ID, or "" when the relation was dropped. When the
+// original cannot be deleted the recreated relation is deleted again, so a failed move
+// does not leave the relation twice.
+func (h *MergeApplicationComponentsHandler) moveRelation(
+	ctx context.Context,
+	rel readmodels.ComponentRelationDTO,
+	survivorID, duplicateID string,
+	existing map[string]struct{},
+) (string, error) {
+	sourceID, targetID := rel.SourceComponentID, rel.TargetComponentID
+	if sourceID == duplicateID {
+		sourceID = survivorID
+	}
+	if targetID == duplicateID {
+		targetID = survivorID
+	}
+
+	var newRelationID string
+	key := relationKey(sourceID, targetID, rel.RelationType)
+	if _, exists := existing[key]; !exists && sourceID != targetID {
+		result, err := h.commandBus.Dispatch(ctx, &commands.CreateComponentRelation{
+			SourceComponentID: sourceID,
+			TargetComponentID: targetID,
+			RelationType:      rel.RelationType,
+			Name:              rel.Name,
+			Description:       rel.Description,
+		})
+		if err != nil {
+			return "", err
+		}
+		newRelationID = result.CreatedID
+		existing[key] = struct{}{}
+	}
+
+	if _, err := h.commandBus.Dispatch(ctx, &commands.DeleteComponentRelation{ID: rel.ID}); err != nil {
+		if newRelationID == "" {
+			return "", err
+		}
+		if _, undoErr := h.commandBus.Dispatch(ctx, &commands.DeleteComponentRelation{ID: newRelationID}); undoErr != nil {
+			return "", errors.Join(err, fmt.Errorf("remove recreated relation %s: %w", newRelationID, undoErr))
+		}
+		return "", err
+	}
+	return newRelationID, nil
+}
+
+func (h *MergeApplicationComponentsHandler) moveChildren(ctx context.Context, survivor, duplicate *aggregates.ApplicationComponent, mergedBy string, progress *mergeProgress) error {
+	children, err := h.childReader.GetChildren(ctx, duplicate.ID())
+	if err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		if child.ID == survivor.ID() {
+			continue
+		}
+		cmd := &commands.ChangeApplicationComponentParent{ID: child.ID, ParentID: survivor.ID()}
+		if _, err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+			return fmt.Errorf("move child component %s from %s to %s: %w", child.ID, duplicate.ID(), survivor.ID(), err)
+		}
+		survivor.RecordChildMoved(duplicate, child.ID, mergedBy)
+		if err := h.savePair(ctx, survivor, duplicate); err != nil {
+			return fmt.Errorf("record move of child component %s: %w", child.ID, err)
+		}
+		progress.children = append(progress.children, child.ID)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	"easi/backend/internal/architecturemodeling/domain/aggregates"
+	"easi/backend/internal/architecturemodeling/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mergeComponentRepository struct {
+	components map[string]*aggregates.ApplicationComponent
+	saved      map[string][]string
+}
+
+func (r *mergeComponentRepository) GetByID(_ context.Context, id string) (*aggregates.ApplicationComponent, error) {
+	return r.components[id], nil
+}
+
+func (r *mergeComponentRepository) Save(_ context.Context, component *aggregates.ApplicationComponent) error {
+	for _, change := range component.GetUncommittedChanges() {
+		r.saved[component.ID()] = append(r.saved[component.ID()], change.EventType())
+	}
+	component.MarkChangesAsCommitted()
+	return nil
+}
+
+type mergeRelationReader struct {
+	relations []readmodels.ComponentRelationDTO
+}
+
+func (r *mergeRelationReader) GetBySourceID(_ context.Context, id string) ([]readmodels.ComponentRelationDTO, error) {
+	var out []readmodels.ComponentRelationDTO
+	for _, rel := range r.relations {
+		if rel.SourceComponentID == id {
+			out = append(out, rel)
+		}
+	}
+	return out, nil
+}
+
+func (r *mergeRelationReader) GetByTargetID(_ context.Context, id string) ([]readmodels.ComponentRelationDTO, error) {
+	var out []readmodels.ComponentRelationDTO
+	for _, rel := range r.relations {
+		if rel.TargetComponentID == id {
+			out = append(out, rel)
+		}
+	}
+	return out, nil
+}
+
+type mergeChildReader struct {
+	children []readmodels.ApplicationComponentDTO
+}
+
+func (r *mergeChildReader) GetChildren(context.Context, string) ([]readmodels.ApplicationComponentDTO, error) {
+	return r.children, nil
+}
+
+type mergeCommandBus struct {
+	dispatched []cqrs.Command
+	failOn     string
+}
+
+func (b *mergeCommandBus) names() []string {
+	names := make([]string, len(b.dispatched))
+	for i, cmd := range b.dispatched {
+		names[i] = cmd.CommandName()
+	}
+	return names
+}
+
+func (b *mergeCommandBus) Dispatch(_ context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	b.dispatched = append(b.dispatched, cmd)
+	if cmd.CommandName() == b.failOn {
+		return cqrs.EmptyResult(), errors.New("dispatch failed")
+	}
+	return cqrs.NewResult("new-" + cmd.CommandName()), nil
+}
+
+func (b *mergeCommandBus) Register(string, cqrs.CommandHandler) {}
+
+func newMergeComponent(t *testing.T, componentName string) *aggregates.ApplicationComponent {
+	t.Helper()
+	name, err := valueobjects.NewComponentName(componentName)
+	require.NoError(t, err)
+	component, err := aggregates.NewApplicationComponent(name, valueobjects.MustNewDescription(""))
+	require.NoError(t, err)
+	component.MarkChangesAsCommitted()
+	return component
+}
+
+type mergeFixture struct {
+	survivor  *aggregates.ApplicationComponent
+	duplicate *aggregates.ApplicationComponent
+	repo      *mergeComponentRepository
+	bus       *mergeCommandBus
+	handler   *MergeApplicationComponentsHandler
+}
+
+func newMergeFixture(t *testing.T, failOn string) mergeFixture {
+	survivor := newMergeComponent(t, "Salesforce")
+	duplicate := newMergeComponent(t, "SFDC")
+	repo := &mergeComponentRepository{components: map[string]*aggregates.ApplicationComponent{
+		survivor.ID(): survivor, duplicate.ID(): duplicate,
+	}, saved: map[string][]string{}}
+	relations := &mergeRelationReader{relations: []readmodels.ComponentRelationDTO{
+		{ID: "rel-1", SourceComponentID: duplicate.ID(), TargetComponentID: "app-erp", RelationType: "Triggers"},
+	}}
+	children := &mergeChildReader{children: []readmodels.ApplicationComponentDTO{{ID: "child-1"}}}
+	bus := &mergeCommandBus{failOn: failOn}
+	return mergeFixture{
+		survivor: survivor, duplicate: duplicate, repo: repo, bus: bus,
+		handler: NewMergeApplicationComponentsHandler(repo, relations, children, bus),
+	}
+}
+
+func (f mergeFixture) merge() error {
+	_, err := f.handler.Handle(context.Background(), &commands.MergeApplicationComponents{
+		SurvivorID: f.survivor.ID(), DuplicateID: f.duplicate.ID(), MergedBy: "merger@example.com",
+	})
+	return err
+}
+
+func TestMergeApplicationComponents_RecordsMovesAndDeletesDuplicate(t *testing.T) {
+	f := newMergeFixture(t, "")
+
+	require.NoError(t, f.merge())
+
+	assert.Equal(t, []string{
+		"CreateComponentRelation", "DeleteComponentRelation", "ChangeApplicationComponentParent", "DeleteApplicationComponent",
+	}, f.bus.names())
+	assert.Equal(t, []string{
+		"ApplicationComponentRelationMoved", "ApplicationComponentChildMoved", "ApplicationComponentDuplicateAbsorbed",
+	}, f.repo.saved[f.survivor.ID()])
+	assert.Equal(t, []string{
+		"ApplicationComponentRelationMoved", "ApplicationComponentChildMoved", "ApplicationComponentMergedInto",
+	}, f.repo.saved[f.duplicate.ID()])
+}
+
+func TestMergeApplicationComponents_FailedMoveKeepsEarlierMovesRecorded(t *testing.T) {
+	f := newMergeFixture(t, "ChangeApplicationComponentParent")
+
+	err := f.merge()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rel-1", "the error names the relations already moved")
+	assert.NotContains(t, f.bus.names(), "DeleteApplicationComponent")
+	assert.Equal(t, []string{"ApplicationComponentRelationMoved"}, f.repo.saved[f.survivor.ID()])
+	assert.Equal(t, []string{"ApplicationComponentRelationMoved"}, f.repo.saved[f.duplicate.ID()])
+}
+
+func TestMergeApplicationComponents_FailedRelationMoveLeavesNoCopy(t *testing.T) {
+	for _, failOn := range []string{"CreateComponentRelation", "DeleteComponentRelation"} {
+		t.Run(failOn, func(t *testing.T) {
+			f := newMergeFixture(t, failOn)
+
+			err := f.merge()
+
+			require.Error(t, err)
+			assert.NotContains(t, f.bus.names(), "ChangeApplicationComponentParent")
+			assert.NotContains(t, f.bus.names(), "DeleteApplicationComponent")
+			assert.Empty(t, f.repo.saved)
+			if failOn == "DeleteComponentRelation" {
+				require.Len(t, f.bus.dispatched, 3)
+				undo, ok := f.bus.dispatched[2].(*commands.DeleteComponentRelation)
+				require.True(t, ok)
+				assert.Equal(t, "new-CreateComponentRelation", undo.ID, "the recreated relation is removed again")
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/domain/aggregates"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type UpdateVendorContractRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.VendorContract, error)
+	Save(ctx context.Context, contract *aggregates.VendorContract) error
+}
+
+type UpdateVendorContractHandler struct {
+	repository     UpdateVendorContractRepository
+	coverageReader VendorContractCoverageReader
+}
+
+func NewUpdateVendorContractHandler(
+	repository UpdateVendorContractRepository,
+	coverageReader VendorContractCoverageReader,
+) *UpdateVendorContractHandler {
+	return &UpdateVendorContractHandler{
+		repository:     repository,
+		coverageReader: coverageReader,
+	}
+}
+
+func (h *UpdateVendorContractHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.UpdateVendorContract)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	contract, err := h.repository.GetByID(ctx, command.ID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if contract.VendorID().Value() != command.VendorID {
+		return cqrs.EmptyResult(), ErrVendorContractVendorMismatch
+	}
+
+	details, err := buildVendorContractDetails(ctx, h.coverageReader, command.VendorID, command.VendorContractFields)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := contract.Update(details); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, contract); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
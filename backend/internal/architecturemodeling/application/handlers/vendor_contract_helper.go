@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	"easi/backend/internal/architecturemodeling/domain/aggregates"
+	"easi/backend/internal/architecturemodeling/domain/valueobjects"
+	"easi/backend/internal/architecturemodeling/infrastructure/repositories"
+)
+
+var (
+	ErrComponentNotPurchasedFromVendor = errors.New("covered application components must have a purchased-from link to the contract's vendor")
+	ErrVendorContractVendorMismatch    = errors.New("vendor contract does not belong to this vendor")
+)
+
+type VendorContractVendorRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.Vendor, error)
+}
+
+type VendorContractCoverageReader interface {
+	GetByVendorID(ctx context.Context, vendorID string) ([]readmodels.PurchasedFromRelationshipDTO, error)
+}
+
+func requireActiveVendor(ctx context.Context, vendors VendorContractVendorRepository, vendorID string) (valueobjects.VendorID, error) {
+	id, err := valueobjects.NewVendorIDFromString(vendorID)
+	if err != nil {
+		return valueobjects.VendorID{}, err
+	}
+
+	vendor, err := vendors.GetByID(ctx, id.Value())
+	if err != nil {
+		return valueobjects.VendorID{}, err
+	}
+	if vendor.IsDeleted() {
+		return valueobjects.VendorID{}, repositories.ErrVendorNotFound
+	}
+
+	return id, nil
+}
+
+func buildVendorContractDetails(
+	ctx context.Context,
+	coverage VendorContractCoverageReader,
+	vendorID string,
+	fields commands.VendorContractFields,
+) (aggregates.VendorContractDetails, error) {
+	name, err := valueobjects.NewEntityName(fields.Name)
+	if err != nil {
+		return aggregates.VendorContractDetails{}, err
+	}
+
+	term, err := valueobjects.NewContractTerm(fields.StartDate, fields.EndDate)
+	if err != nil {
+		return aggregates.VendorContractDetails{}, err
+	}
+
+	noticePeriod, err := valueobjects.NewNoticePeriod(fields.NoticePeriodDays)
+	if err != nil {
+		return aggregates.VendorContractDetails{}, err
+	}
+
+	value, err := valueobjects.NewContractValue(fields.ValueAmount, fields.ValueCurrency)
+	if err != nil {
+		return aggregates.VendorContractDetails{}, err
+	}
+
+	owner, err := valueobjects.NewContractOwner(fields.Owner)
+	if err != nil {
+		return aggregates.VendorContractDetails{}, err
+	}
+
+	notes, err := valueobjects.NewNotes(fields.Notes)
+	if err != nil {
+		return aggregates.VendorContractDetails{}, err
+	}
+
+	components, err := resolveCoveredComponents(ctx, coverage, vendorID, fields.CoveredComponentIDs)
+	if err != nil {
+		return aggregates.VendorContractDetails{}, err
+	}
+
+	return aggregates.VendorContractDetails{
+		Name:              name,
+		Term:              term,
+		NoticePeriod:      noticePeriod,
+		Value:             value,
+		Owner:             owner,
+		CoveredComponents: components,
+		Notes:             notes,
+	}, nil
+}
+
+func resolveCoveredComponents(
+	ctx context.Context,
+	coverage VendorContractCoverageReader,
+	vendorID string,
+	componentIDs []string,
+) ([]valueobjects.ComponentID, error) {
+	if len(componentIDs) == 0 {
+		return nil, nil
+	}
+
+	relations, err := coverage.GetByVendorID(ctx, vendorID)
+	if err != nil {
+		return nil, err
+	}
+
+	purchased := make(map[string]bool, len(relations))
+	for _, relation := range relations {
+		purchased[relation.ComponentID] = true
+	}
+
+	components := make([]valueobjects.ComponentID, 0, len(componentIDs))
+	for _, id := range componentIDs {
+		componentID, err := valueobjects.NewComponentIDFromString(id)
+		if err != nil {
+			return nil, err
+		}
+		if !purchased[componentID.Value()] {
+			return nil, ErrComponentNotPurchasedFromVendor
+		}
+		components = append(components, componentID)
+	}
+
+	return components, nil
+}
@@ -101,6 +101,24 @@ func (p *ApplicationComponentProjector) ProjectEvent(ctx context.Context, eventT
 		return p.projectExpertAdded(ctx, eventData)
 	case archPL.ApplicationComponentExpertRemoved:
 		return p.projectExpertRemoved(ctx, eventData)
+	case archPL.ApplicationComponentParentChanged:
+		return p.projectParentChanged(ctx, eventData)
+	case archPL.ApplicationComponentTagAdded:
+		return projectEvent(ctx, eventData, "ApplicationComponentTagAdded", func(ctx context.Context, event *events.ApplicationComponentTagAdded) error {
+			return p.readModel.AddTag(ctx, event.ComponentID, event.Tag, event.AddedAt)
+		})
+	case archPL.ApplicationComponentTagRemoved:
+		return projectEvent(ctx, eventData, "ApplicationComponentTagRemoved", func(ctx context.Context, event *events.ApplicationComponentTagRemoved) error {
+			return p.readModel.RemoveTag(ctx, event.ComponentID, event.Tag)
+		})
+	case archPL.ApplicationComponentClassified:
+		return projectEvent(ctx, eventData, "ApplicationComponentClassified", func(ctx context.Context, event *events.ApplicationComponentClassified) error {
+			return p.readModel.SetClassification(ctx, event.ComponentID, event.DimensionID, event.Value, event.ClassifiedAt)
+		})
+	case archPL.ApplicationComponentClassificationCleared:
+		return projectEvent(ctx, eventData, "ApplicationComponentClassificationCleared", func(ctx context.Context, event *events.ApplicationComponentClassificationCleared) error {
+			return p.readModel.ClearClassification(ctx, event.ComponentID, event.DimensionID)
+		})
 	}
 	return nil
 }
@@ -136,3 +154,9 @@ func (p *ApplicationComponentProjector) projectExpertRemoved(ctx context.Context
 		return p.readModel.RemoveExpert(ctx, toExpertInfo(expertRemovedAdapter{*event}))
 	})
 }
+
+func (p *ApplicationComponentProjector) projectParentChanged(ctx context.Context, eventData []byte) error {
+	return projectEvent(ctx, eventData, "ApplicationComponentParentChanged", func(ctx context.Context, event *events.ApplicationComponentParentChanged) error {
+		return p.readModel.SetParent(ctx, event.ID, event.NewParentID)
+	})
+}
@@ -0,0 +1,70 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	mmPL "easi/backend/internal/metamodel/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// ClassificationDimensionCacheProjector keeps a local copy of the tenant's classification dimensions
+type ClassificationDimensionCacheProjector struct {
+	readModel *readmodels.ClassificationDimensionCacheReadModel
+}
+
+// NewClassificationDimensionCacheProjector creates a new projector
+func NewClassificationDimensionCacheProjector(readModel *readmodels.ClassificationDimensionCacheReadModel) *ClassificationDimensionCacheProjector {
+	return &ClassificationDimensionCacheProjector{readModel: readModel}
+}
+
+// Handle implements the EventHandler interface for the event bus
+func (p *ClassificationDimensionCacheProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *ClassificationDimensionCacheProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	switch eventType {
+	case mmPL.ClassificationDimensionAdded:
+		return projectEvent(ctx, eventData, "ClassificationDimensionAdded", p.upsert)
+	case mmPL.ClassificationDimensionUpdated:
+		return projectEvent(ctx, eventData, "ClassificationDimensionUpdated", p.upsert)
+	case mmPL.ClassificationDimensionRemoved:
+		return projectEvent(ctx, eventData, "ClassificationDimensionRemoved", p.deactivate)
+	}
+	return nil
+}
+
+type classificationDimensionEvent struct {
+	DimensionID string   `json:"dimensionId"`
+	Name        string   `json:"name"`
+	Values      []string `json:"values"`
+}
+
+func (p *ClassificationDimensionCacheProjector) upsert(ctx context.Context, event *classificationDimensionEvent) error {
+	if err := p.readModel.Upsert(ctx, readmodels.ClassificationDimensionCacheDTO{
+		ID:     event.DimensionID,
+		Name:   event.Name,
+		Values: event.Values,
+		Active: true,
+	}); err != nil {
+		return fmt.Errorf("project classification dimension %s into cache: %w", event.DimensionID, err)
+	}
+	return nil
+}
+
+func (p *ClassificationDimensionCacheProjector) deactivate(ctx context.Context, event *classificationDimensionEvent) error {
+	if err := p.readModel.Deactivate(ctx, event.DimensionID); err != nil {
+		return fmt.Errorf("project ClassificationDimensionRemoved for dimension %s: %w", event.DimensionID, err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	mmPL "easi/backend/internal/metamodel/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// CustomRelationTypeCacheProjector keeps a local copy of the tenant's custom relation types
+type CustomRelationTypeCacheProjector struct {
+	readModel *readmodels.CustomRelationTypeCacheReadModel
+}
+
+// NewCustomRelationTypeCacheProjector creates a new projector
+func NewCustomRelationTypeCacheProjector(readModel *readmodels.CustomRelationTypeCacheReadModel) *CustomRelationTypeCacheProjector {
+	return &CustomRelationTypeCacheProjector{readModel: readModel}
+}
+
+// Handle implements the EventHandler interface for the event bus
+func (p *CustomRelationTypeCacheProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *CustomRelationTypeCacheProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	switch eventType {
+	case mmPL.CustomRelationTypeAdded:
+		return projectEvent(ctx, eventData, "CustomRelationTypeAdded", p.upsert)
+	case mmPL.CustomRelationTypeUpdated:
+		return projectEvent(ctx, eventData, "CustomRelationTypeUpdated", p.upsert)
+	case mmPL.CustomRelationTypeRemoved:
+		return projectEvent(ctx, eventData, "CustomRelationTypeRemoved", p.deactivate)
+	}
+	return nil
+}
+
+type customRelationTypeEvent struct {
+	RelationTypeID string `json:"relationTypeId"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	LineStyle      string `json:"lineStyle"`
+	Color          string `json:"color"`
+}
+
+func (p *CustomRelationTypeCacheProjector) upsert(ctx context.Context, event *customRelationTypeEvent) error {
+	if err := p.readModel.Upsert(ctx, readmodels.CustomRelationTypeCacheDTO{
+		ID:          event.RelationTypeID,
+		Name:        event.Name,
+		Description: event.Description,
+		LineStyle:   event.LineStyle,
+		Color:       event.Color,
+		Active:      true,
+	}); err != nil {
+		return fmt.Errorf("project custom relation type %s into cache: %w", event.RelationTypeID, err)
+	}
+	return nil
+}
+
+func (p *CustomRelationTypeCacheProjector) deactivate(ctx context.Context, event *customRelationTypeEvent) error {
+	if err := p.readModel.Deactivate(ctx, event.RelationTypeID); err != nil {
+		return fmt.Errorf("project CustomRelationTypeRemoved for relation type %s: %w", event.RelationTypeID, err)
+	}
+	return nil
+}
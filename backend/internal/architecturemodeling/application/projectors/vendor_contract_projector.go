@@ -0,0 +1,107 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	"easi/backend/internal/architecturemodeling/domain/events"
+	archPL "easi/backend/internal/architecturemodeling/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type VendorContractProjector struct {
+	readModel *readmodels.VendorContractReadModel
+}
+
+func NewVendorContractProjector(readModel *readmodels.VendorContractReadModel) *VendorContractProjector {
+	return &VendorContractProjector{
+		readModel: readModel,
+	}
+}
+
+func (p *VendorContractProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *VendorContractProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	switch eventType {
+	case archPL.VendorContractCreated:
+		return p.projectCreated(ctx, eventData)
+	case archPL.VendorContractUpdated:
+		return p.projectUpdated(ctx, eventData)
+	case archPL.VendorContractDeleted:
+		return p.projectDeleted(ctx, eventData)
+	case archPL.ApplicationComponentDeleted:
+		return p.projectComponentDeleted(ctx, eventData)
+	}
+	return nil
+}
+
+func (p *VendorContractProjector) projectCreated(ctx context.Context, eventData []byte) error {
+	event, err := unmarshalEvent[events.VendorContractCreated](eventData, "VendorContractCreated")
+	if err != nil {
+		return fmt.Errorf("decode VendorContractCreated event payload in projector: %w", err)
+	}
+	if err := p.readModel.Insert(ctx, event.VendorID, event.CreatedAt, toVendorContractTermsUpdate(event.ID, event.VendorContractTerms)); err != nil {
+		return fmt.Errorf("project VendorContractCreated for contract %s: %w", event.ID, err)
+	}
+	return nil
+}
+
+func (p *VendorContractProjector) projectUpdated(ctx context.Context, eventData []byte) error {
+	event, err := unmarshalEvent[events.VendorContractUpdated](eventData, "VendorContractUpdated")
+	if err != nil {
+		return fmt.Errorf("decode VendorContractUpdated event payload in projector: %w", err)
+	}
+	if err := p.readModel.Update(ctx, toVendorContractTermsUpdate(event.ID, event.VendorContractTerms)); err != nil {
+		return fmt.Errorf("project VendorContractUpdated for contract %s: %w", event.ID, err)
+	}
+	return nil
+}
+
+func (p *VendorContractProjector) projectDeleted(ctx context.Context, eventData []byte) error {
+	event, err := unmarshalEvent[events.VendorContractDeleted](eventData, "VendorContractDeleted")
+	if err != nil {
+		return fmt.Errorf("decode VendorContractDeleted event payload in projector: %w", err)
+	}
+	if err := p.readModel.MarkAsDeleted(ctx, event.ID, event.DeletedAt); err != nil {
+		return fmt.Errorf("project VendorContractDeleted for contract %s: %w", event.ID, err)
+	}
+	return nil
+}
+
+func (p *VendorContractProjector) projectComponentDeleted(ctx context.Context, eventData []byte) error {
+	event, err := unmarshalEvent[events.ApplicationComponentDeleted](eventData, "ApplicationComponentDeleted")
+	if err != nil {
+		return fmt.Errorf("decode ApplicationComponentDeleted event payload in vendor contract projector: %w", err)
+	}
+	if err := p.readModel.RemoveCoveredComponent(ctx, event.ID); err != nil {
+		return fmt.Errorf("remove deleted component %s from vendor contracts: %w", event.ID, err)
+	}
+	return nil
+}
+
+func toVendorContractTermsUpdate(id string, terms events.VendorContractTerms) readmodels.VendorContractTermsUpdate {
+	return readmodels.VendorContractTermsUpdate{
+		ID:                  id,
+		Name:                terms.Name,
+		StartDate:           terms.StartDate,
+		EndDate:             terms.EndDate,
+		NoticePeriodDays:    terms.NoticePeriodDays,
+		NoticeDeadline:      terms.NoticeDeadline,
+		ValueAmount:         terms.ValueAmount,
+		ValueCurrency:       terms.ValueCurrency,
+		Owner:               terms.Owner,
+		CoveredComponentIDs: terms.CoveredComponentIDs,
+		Notes:               terms.Notes,
+	}
+}
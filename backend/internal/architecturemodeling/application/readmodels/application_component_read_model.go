@@ -18,8 +18,11 @@ type ApplicationComponentDTO struct {
 	ID               string              `json:"id"`
 	Name             string              `json:"name"`
 	Description      string              `json:"description,omitempty"`
+	ParentID         string              `json:"parentId,omitempty"`
 	CreatedAt        time.Time           `json:"createdAt"`
 	Experts          []ExpertDTO         `json:"experts,omitempty"`
+	Tags             []string            `json:"tags,omitempty"`
+	Classifications  []ClassificationDTO `json:"classifications,omitempty"`
 	OnePagerComplete *bool               `json:"onePagerComplete,omitempty"`
 	Links            types.Links         `json:"_links,omitempty"`
 	XRelated         []types.RelatedLink `json:"-"`
@@ -108,6 +111,13 @@ func (rm *ApplicationComponentReadModel) Update(ctx context.Context, id, name, d
 	)
 }
 
+func (rm *ApplicationComponentReadModel) SetParent(ctx context.Context, id, parentID string) error {
+	return rm.execByID(ctx,
+		"UPDATE architecturemodeling.application_components SET parent_id = NULLIF($3, ''), updated_at = CURRENT_TIMESTAMP WHERE tenant_id = $1 AND id = $2",
+		id, parentID,
+	)
+}
+
 func (rm *ApplicationComponentReadModel) MarkAsDeleted(ctx context.Context, id string, deletedAt time.Time) error {
 	return rm.execByID(ctx,
 		"UPDATE architecturemodeling.application_components SET is_deleted = TRUE, deleted_at = $3 WHERE tenant_id = $1 AND id = $2",
@@ -127,10 +137,11 @@ func (rm *ApplicationComponentReadModel) GetByID(ctx context.Context, id string)
 	var notFound bool
 
 	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		var parentID sql.NullString
 		err := tx.QueryRowContext(ctx,
-			"SELECT id, name, description, created_at FROM architecturemodeling.application_components WHERE tenant_id = $1 AND id = $2 AND is_deleted = FALSE",
+			"SELECT id, name, description, created_at, parent_id FROM architecturemodeling.application_components WHERE tenant_id = $1 AND id = $2 AND is_deleted = FALSE",
 			tenantID.Value(), id,
-		).Scan(&dto.ID, &dto.Name, &dto.Description, &dto.CreatedAt)
+		).Scan(&dto.ID, &dto.Name, &dto.Description, &dto.CreatedAt, &parentID)
 
 		if err == sql.ErrNoRows {
 			notFound = true
@@ -139,9 +150,18 @@ func (rm *ApplicationComponentReadModel) GetByID(ctx context.Context, id string)
 		if err != nil {
 			return err
 		}
+		dto.ParentID = parentID.String
 
 		dto.Experts, err = rm.fetchExperts(ctx, tx, tenantID.Value(), id)
-		return err
+		if err != nil {
+			return err
+		}
+		single := []ApplicationComponentDTO{dto}
+		if err := rm.loadTagsAndClassifications(ctx, tx, tenantID.Value(), single); err != nil {
+			return err
+		}
+		dto = single[0]
+		return nil
 	})
 
 	if err != nil {
@@ -182,7 +202,7 @@ func (rm *ApplicationComponentReadModel) GetAll(ctx context.Context) ([]Applicat
 		return nil, err
 	}
 	return rm.queryComponents(ctx, tenantID.Value(),
-		"SELECT id, name, description, created_at FROM architecturemodeling.application_components WHERE tenant_id = $1 AND is_deleted = FALSE ORDER BY LOWER(name) ASC, id ASC",
+		"SELECT id, name, description, created_at, parent_id FROM architecturemodeling.application_components WHERE tenant_id = $1 AND is_deleted = FALSE ORDER BY LOWER(name) ASC, id ASC",
 		tenantID.Value(),
 	)
 }
@@ -196,11 +216,75 @@ func (rm *ApplicationComponentReadModel) GetByIDs(ctx context.Context, ids []str
 		return nil, err
 	}
 	return rm.queryComponents(ctx, tenantID.Value(),
-		"SELECT id, name, description, created_at FROM architecturemodeling.application_components WHERE tenant_id = $1 AND id = ANY($2) AND is_deleted = FALSE ORDER BY LOWER(name) ASC, id ASC",
+		"SELECT id, name, description, created_at, parent_id FROM architecturemodeling.application_components WHERE tenant_id = $1 AND id = ANY($2) AND is_deleted = FALSE ORDER BY LOWER(name) ASC, id ASC",
 		tenantID.Value(), pq.Array(ids),
 	)
 }
 
+// GetChildren retrieves the direct child components of a parent component
+func (rm *ApplicationComponentReadModel) GetChildren(ctx context.Context, parentID string) ([]ApplicationComponentDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rm.queryComponents(ctx, tenantID.Value(),
+		"SELECT id, name, description, created_at, parent_id FROM architecturemodeling.application_components WHERE tenant_id = $1 AND parent_id = $2 AND is_deleted = FALSE ORDER BY LOWER(name) ASC, id ASC",
+		tenantID.Value(), parentID,
+	)
+}
+
+// GetTree retrieves all components arranged as a parent/child forest
+func (rm *ApplicationComponentReadModel) GetTree(ctx context.Context) ([]*ComponentTreeNode, error) {
+	components, err := rm.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return BuildComponentTree(components), nil
+}
+
+// ComponentTreeNode is a component together with its nested child components
+type ComponentTreeNode struct {
+	ApplicationComponentDTO
+	Children []*ComponentTreeNode `json:"children"`
+}
+
+func (n ComponentTreeNode) MarshalJSON() ([]byte, error) {
+	base, err := json.Marshal(n.ApplicationComponentDTO)
+	if err != nil {
+		return nil, err
+	}
+	children := n.Children
+	if children == nil {
+		children = []*ComponentTreeNode{}
+	}
+	childJSON, err := json.Marshal(children)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(base[:len(base)-1], `,"children":`...), append(childJSON, '}')...), nil
+}
+
+// BuildComponentTree nests components under their parents, preserving input order among siblings.
+// Components whose parent is missing (e.g. deleted) are treated as roots.
+func BuildComponentTree(components []ApplicationComponentDTO) []*ComponentTreeNode {
+	nodes := make(map[string]*ComponentTreeNode, len(components))
+	for _, c := range components {
+		nodes[c.ID] = &ComponentTreeNode{ApplicationComponentDTO: c}
+	}
+
+	roots := make([]*ComponentTreeNode, 0)
+	for _, c := range components {
+		node := nodes[c.ID]
+		parent, ok := nodes[c.ParentID]
+		if !ok || c.ParentID == c.ID {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+	return roots
+}
+
 func (rm *ApplicationComponentReadModel) queryComponents(ctx context.Context, tenantID, query string, args ...any) ([]ApplicationComponentDTO, error) {
 	var components []ApplicationComponentDTO
 	err := rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
@@ -214,7 +298,7 @@ func (rm *ApplicationComponentReadModel) queryComponents(ctx context.Context, te
 		if err != nil {
 			return err
 		}
-		return rm.loadExpertsForComponents(ctx, tx, tenantID, components)
+		return rm.loadComponentDetails(ctx, tx, tenantID, components)
 	})
 
 	return components, err
@@ -262,7 +346,7 @@ func (rm *ApplicationComponentReadModel) GetAllPaginated(ctx context.Context, q
 			return err
 		}
 
-		return rm.loadExpertsForComponents(ctx, tx, tenantID.Value(), components)
+		return rm.loadComponentDetails(ctx, tx, tenantID.Value(), components)
 	})
 
 	if err != nil {
@@ -275,24 +359,24 @@ func (rm *ApplicationComponentReadModel) GetAllPaginated(ctx context.Context, q
 func (rm *ApplicationComponentReadModel) queryPaginatedComponents(ctx context.Context, tx *sql.Tx, query resolvedQuery) (*sql.Rows, error) {
 	if query.nameFilter != "" && query.afterCursor != "" {
 		return tx.QueryContext(ctx,
-			"SELECT id, name, description, created_at FROM architecturemodeling.application_components WHERE tenant_id = $1 AND is_deleted = FALSE AND LOWER(name) LIKE '%' || LOWER($2) || '%' AND (LOWER(name) > LOWER($3) OR (LOWER(name) = LOWER($3) AND id > $4)) ORDER BY LOWER(name) ASC, id ASC LIMIT $5",
+			"SELECT id, name, description, created_at, parent_id FROM architecturemodeling.application_components WHERE tenant_id = $1 AND is_deleted = FALSE AND LOWER(name) LIKE '%' || LOWER($2) || '%' AND (LOWER(name) > LOWER($3) OR (LOWER(name) = LOWER($3) AND id > $4)) ORDER BY LOWER(name) ASC, id ASC LIMIT $5",
 			query.tenantID, query.nameFilter, query.afterName, query.afterCursor, query.limit,
 		)
 	}
 	if query.nameFilter != "" {
 		return tx.QueryContext(ctx,
-			"SELECT id, name, description, created_at FROM architecturemodeling.application_components WHERE tenant_id = $1 AND is_deleted = FALSE AND LOWER(name) LIKE '%' || LOWER($2) || '%' ORDER BY LOWER(name) ASC, id ASC LIMIT $3",
+			"SELECT id, name, description, created_at, parent_id FROM architecturemodeling.application_components WHERE tenant_id = $1 AND is_deleted = FALSE AND LOWER(name) LIKE '%' || LOWER($2) || '%' ORDER BY LOWER(name) ASC, id ASC LIMIT $3",
 			query.tenantID, query.nameFilter, query.limit,
 		)
 	}
 	if query.afterCursor == "" {
 		return tx.QueryContext(ctx,
-			"SELECT id, name, description, created_at FROM architecturemodeling.application_components WHERE tenant_id = $1 AND is_deleted = FALSE ORDER BY LOWER(name) ASC, id ASC LIMIT $2",
+			"SELECT id, name, description, created_at, parent_id FROM architecturemodeling.application_components WHERE tenant_id = $1 AND is_deleted = FALSE ORDER BY LOWER(name) ASC, id ASC LIMIT $2",
 			query.tenantID, query.limit,
 		)
 	}
 	return tx.QueryContext(ctx,
-		"SELECT id, name, description, created_at FROM architecturemodeling.application_components WHERE tenant_id = $1 AND is_deleted = FALSE AND (LOWER(name) > LOWER($2) OR (LOWER(name) = LOWER($2) AND id > $3)) ORDER BY LOWER(name) ASC, id ASC LIMIT $4",
+		"SELECT id, name, description, created_at, parent_id FROM architecturemodeling.application_components WHERE tenant_id = $1 AND is_deleted = FALSE AND (LOWER(name) > LOWER($2) OR (LOWER(name) = LOWER($2) AND id > $3)) ORDER BY LOWER(name) ASC, id ASC LIMIT $4",
 		query.tenantID, query.afterName, query.afterCursor, query.limit,
 	)
 }
@@ -301,9 +385,11 @@ func (rm *ApplicationComponentReadModel) scanComponents(rows *sql.Rows) ([]Appli
 	var components []ApplicationComponentDTO
 	for rows.Next() {
 		var dto ApplicationComponentDTO
-		if err := rows.Scan(&dto.ID, &dto.Name, &dto.Description, &dto.CreatedAt); err != nil {
+		var parentID sql.NullString
+		if err := rows.Scan(&dto.ID, &dto.Name, &dto.Description, &dto.CreatedAt, &parentID); err != nil {
 			return nil, err
 		}
+		dto.ParentID = parentID.String
 		components = append(components, dto)
 	}
 	return components, rows.Err()
@@ -130,3 +130,55 @@ func TestOriginDTO_MarshalJSON_SplicesXRelated(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildComponentTree_NestsChildrenUnderParents(t *testing.T) {
+	components := []ApplicationComponentDTO{
+		{ID: "crm", Name: "CRM"},
+		{ID: "fi", Name: "FI", ParentID: "s4"},
+		{ID: "s4", Name: "SAP S/4"},
+		{ID: "gl", Name: "General Ledger", ParentID: "fi"},
+		{ID: "mm", Name: "MM", ParentID: "s4"},
+	}
+
+	roots := BuildComponentTree(components)
+
+	require.Len(t, roots, 2)
+	assert.Equal(t, "crm", roots[0].ID)
+	assert.Empty(t, roots[0].Children)
+	assert.Equal(t, "s4", roots[1].ID)
+	require.Len(t, roots[1].Children, 2)
+	assert.Equal(t, "fi", roots[1].Children[0].ID)
+	assert.Equal(t, "mm", roots[1].Children[1].ID)
+	require.Len(t, roots[1].Children[0].Children, 1)
+	assert.Equal(t, "gl", roots[1].Children[0].Children[0].ID)
+}
+
+func TestBuildComponentTree_OrphansBecomeRoots(t *testing.T) {
+	roots := BuildComponentTree([]ApplicationComponentDTO{
+		{ID: "fi", Name: "FI", ParentID: "deleted-suite"},
+	})
+
+	require.Len(t, roots, 1)
+	assert.Equal(t, "fi", roots[0].ID)
+}
+
+func TestComponentTreeNode_MarshalJSON_IncludesChildren(t *testing.T) {
+	roots := BuildComponentTree([]ApplicationComponentDTO{
+		{ID: "s4", Name: "SAP S/4"},
+		{ID: "fi", Name: "FI", ParentID: "s4"},
+	})
+
+	data, err := json.Marshal(roots)
+	require.NoError(t, err)
+
+	var out []map[string]any
+	require.NoError(t, json.Unmarshal(data, &out))
+	require.Len(t, out, 1)
+	assert.Equal(t, "SAP S/4", out[0]["name"])
+	children, ok := out[0]["children"].([]any)
+	require.True(t, ok)
+	require.Len(t, children, 1)
+	child := children[0].(map[string]any)
+	assert.Equal(t, "s4", child["parentId"])
+	assert.Equal(t, []any{}, child["children"])
+}
@@ -0,0 +1,215 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"unicode"
+
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+// ComponentSearchQuery filters components by full text, tags and classification values.
+// All filters are combined with AND; an empty query matches every component.
+type ComponentSearchQuery struct {
+	Text            string
+	Tags            []string
+	Classifications []ClassificationFilter
+	Limit           int
+}
+
+type ClassificationFilter struct {
+	DimensionID string
+	Value       string
+}
+
+type FacetValueCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type ClassificationFacet struct {
+	DimensionID   string            `json:"dimensionId"`
+	DimensionName string            `json:"dimensionName"`
+	Values        []FacetValueCount `json:"values"`
+}
+
+// ComponentSearchFacets counts tag and classification values across all matching components
+type ComponentSearchFacets struct {
+	Tags            []FacetValueCount     `json:"tags"`
+	Classifications []ClassificationFacet `json:"classifications"`
+}
+
+type ComponentSearchResult struct {
+	Components []ApplicationComponentDTO
+	Total      int
+	Facets     ComponentSearchFacets
+}
+
+// The expressions below must match the GIN indexes created in migration 136
+const componentTextMatch = `(to_tsvector('simple', c.name || ' ' || COALESCE(c.description, '')) @@ to_tsquery('simple', $%d)
+	OR EXISTS (SELECT 1 FROM architecturemodeling.application_component_experts e
+		WHERE e.tenant_id = c.tenant_id AND e.component_id = c.id AND to_tsvector('simple', e.expert_name) @@ to_tsquery('simple', $%d)))`
+
+const componentTagMatch = `EXISTS (SELECT 1 FROM architecturemodeling.application_component_tags t
+	WHERE t.tenant_id = c.tenant_id AND t.component_id = c.id AND t.tag = $%d)`
+
+const componentClassificationMatch = `EXISTS (SELECT 1 FROM architecturemodeling.application_component_classifications cl
+	WHERE cl.tenant_id = c.tenant_id AND cl.component_id = c.id AND cl.dimension_id = $%d AND cl.value = $%d)`
+
+const matchingComponentIDs = "SELECT c.id FROM architecturemodeling.application_components c WHERE "
+
+type componentSearchFilter struct {
+	where string
+	args  []any
+}
+
+func (f *componentSearchFilter) placeholder(value any) string {
+	f.args = append(f.args, value)
+	return strconv.Itoa(len(f.args))
+}
+
+func (f *componentSearchFilter) and(condition string) {
+	f.where += " AND " + condition
+}
+
+func newComponentSearchFilter(tenantID string, q ComponentSearchQuery) *componentSearchFilter {
+	f := &componentSearchFilter{where: "c.tenant_id = $1 AND c.is_deleted = FALSE", args: []any{tenantID}}
+
+	if tsQuery := BuildPrefixTSQuery(q.Text); tsQuery != "" {
+		n := f.placeholder(tsQuery)
+		f.and(strings.ReplaceAll(componentTextMatch, "%d", n))
+	}
+	for _, tag := range q.Tags {
+		n := f.placeholder(tag)
+		f.and(strings.ReplaceAll(componentTagMatch, "%d", n))
+	}
+	for _, c := range q.Classifications {
+		dim := f.placeholder(c.DimensionID)
+		val := f.placeholder(c.Value)
+		f.and(strings.Replace(strings.Replace(componentClassificationMatch, "%d", dim, 1), "%d", val, 1))
+	}
+	return f
+}
+
+// BuildPrefixTSQuery turns free text into a tsquery where every word must match as a prefix.
+// Anything other than letters and digits is treated as a separator, so user input can never
+// inject tsquery operators.
+func BuildPrefixTSQuery(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := make([]string, len(words))
+	for i, w := range words {
+		terms[i] = w + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
+
+// Search returns the first page of matching components together with facet counts over the full match set
+func (rm *ApplicationComponentReadModel) Search(ctx context.Context, q ComponentSearchQuery) (*ComponentSearchResult, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := newComponentSearchFilter(tenantID.Value(), q)
+	result := &ComponentSearchResult{}
+
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		if err := tx.QueryRowContext(ctx,
+			"SELECT COUNT(*) FROM architecturemodeling.application_components c WHERE "+filter.where,
+			filter.args...,
+		).Scan(&result.Total); err != nil {
+			return err
+		}
+
+		components, err := rm.searchComponents(ctx, tx, filter, q.Limit)
+		if err != nil {
+			return err
+		}
+		if err := rm.loadComponentDetails(ctx, tx, tenantID.Value(), components); err != nil {
+			return err
+		}
+		result.Components = components
+
+		if result.Facets.Tags, err = rm.tagFacets(ctx, tx, filter); err != nil {
+			return err
+		}
+		result.Facets.Classifications, err = rm.classificationFacets(ctx, tx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (rm *ApplicationComponentReadModel) searchComponents(ctx context.Context, tx *sql.Tx, filter *componentSearchFilter, limit int) ([]ApplicationComponentDTO, error) {
+	args := append(append([]any{}, filter.args...), limit)
+	rows, err := tx.QueryContext(ctx,
+		"SELECT c.id, c.name, c.description, c.created_at, c.parent_id FROM architecturemodeling.application_components c WHERE "+
+			filter.where+" ORDER BY LOWER(c.name) ASC, c.id ASC LIMIT $"+strconv.Itoa(len(args)),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	return rm.scanComponents(rows)
+}
+
+func (rm *ApplicationComponentReadModel) tagFacets(ctx context.Context, tx *sql.Tx, filter *componentSearchFilter) ([]FacetValueCount, error) {
+	rows, err := tx.QueryContext(ctx,
+		"SELECT t.tag, COUNT(*) FROM architecturemodeling.application_component_tags t WHERE t.tenant_id = $1 AND t.component_id IN ("+
+			matchingComponentIDs+filter.where+") GROUP BY t.tag ORDER BY COUNT(*) DESC, t.tag ASC",
+		filter.args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	facets := make([]FacetValueCount, 0)
+	for rows.Next() {
+		var f FacetValueCount
+		if err := rows.Scan(&f.Value, &f.Count); err != nil {
+			return nil, err
+		}
+		facets = append(facets, f)
+	}
+	return facets, rows.Err()
+}
+
+func (rm *ApplicationComponentReadModel) classificationFacets(ctx context.Context, tx *sql.Tx, filter *componentSearchFilter) ([]ClassificationFacet, error) {
+	rows, err := tx.QueryContext(ctx,
+		`SELECT cl.dimension_id, d.name, cl.value, COUNT(*)
+		FROM architecturemodeling.application_component_classifications cl
+		JOIN architecturemodeling.classification_dimension_cache d ON d.tenant_id = cl.tenant_id AND d.id = cl.dimension_id AND d.active = TRUE
+		WHERE cl.tenant_id = $1 AND cl.component_id IN (`+matchingComponentIDs+filter.where+`)
+		GROUP BY cl.dimension_id, d.name, cl.value
+		ORDER BY LOWER(d.name) ASC, cl.dimension_id ASC, COUNT(*) DESC, cl.value ASC`,
+		filter.args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	facets := make([]ClassificationFacet, 0)
+	for rows.Next() {
+		var dimensionID, dimensionName string
+		var value FacetValueCount
+		if err := rows.Scan(&dimensionID, &dimensionName, &value.Value, &value.Count); err != nil {
+			return nil, err
+		}
+		if n := len(facets); n == 0 || facets[n-1].DimensionID != dimensionID {
+			facets = append(facets, ClassificationFacet{DimensionID: dimensionID, DimensionName: dimensionName})
+		}
+		last := &facets[len(facets)-1]
+		last.Values = append(last.Values, value)
+	}
+	return facets, rows.Err()
+}
@@ -0,0 +1,41 @@
+package readmodels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildPrefixTSQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"empty", "", ""},
+		{"whitespace only", "   ", ""},
+		{"single word", "Sales", "sales:*"},
+		{"multiple words", "sales force", "sales:* & force:*"},
+		{"strips tsquery operators", "crm & !(erp | 'x'):*", "crm:* & erp:* & x:*"},
+		{"keeps non-ascii letters", "Lønsystem", "lønsystem:*"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, BuildPrefixTSQuery(tt.input))
+		})
+	}
+}
+
+func TestComponentSearchFilter_NumbersPlaceholdersInOrder(t *testing.T) {
+	f := newComponentSearchFilter("tenant", ComponentSearchQuery{
+		Text:            "crm",
+		Tags:            []string{"saas"},
+		Classifications: []ClassificationFilter{{DimensionID: "dim", Value: "High"}},
+	})
+
+	assert.Equal(t, []any{"tenant", "crm:*", "saas", "dim", "High"}, f.args)
+	assert.Contains(t, f.where, "to_tsquery('simple', $2)")
+	assert.Contains(t, f.where, "t.tag = $3")
+	assert.Contains(t, f.where, "cl.dimension_id = $4 AND cl.value = $5")
+	assert.NotContains(t, f.where, "%d")
+}
@@ -0,0 +1,166 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+// ClassificationDTO is a component's value for one tenant-configured classification dimension
+type ClassificationDTO struct {
+	DimensionID   string `json:"dimensionId"`
+	DimensionName string `json:"dimensionName"`
+	Value         string `json:"value"`
+}
+
+func (rm *ApplicationComponentReadModel) AddTag(ctx context.Context, componentID, tag string, addedAt time.Time) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return fmt.Errorf("resolve tenant for add tag to application component %s: %w", componentID, err)
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		`INSERT INTO architecturemodeling.application_component_tags (tenant_id, component_id, tag, added_at)
+		VALUES ($1, $2, $3, $4) ON CONFLICT (tenant_id, component_id, tag) DO NOTHING`,
+		tenantID.Value(), componentID, tag, addedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert tag %q for application component %s tenant %s: %w", tag, componentID, tenantID.Value(), err)
+	}
+	return nil
+}
+
+func (rm *ApplicationComponentReadModel) RemoveTag(ctx context.Context, componentID, tag string) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return fmt.Errorf("resolve tenant for remove tag from application component %s: %w", componentID, err)
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"DELETE FROM architecturemodeling.application_component_tags WHERE tenant_id = $1 AND component_id = $2 AND tag = $3",
+		tenantID.Value(), componentID, tag,
+	)
+	if err != nil {
+		return fmt.Errorf("remove tag %q from application component %s tenant %s: %w", tag, componentID, tenantID.Value(), err)
+	}
+	return nil
+}
+
+func (rm *ApplicationComponentReadModel) SetClassification(ctx context.Context, componentID, dimensionID, value string, classifiedAt time.Time) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return fmt.Errorf("resolve tenant for classify application component %s: %w", componentID, err)
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		`INSERT INTO architecturemodeling.application_component_classifications (tenant_id, component_id, dimension_id, value, classified_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (tenant_id, component_id, dimension_id) DO UPDATE SET value = EXCLUDED.value, classified_at = EXCLUDED.classified_at`,
+		tenantID.Value(), componentID, dimensionID, value, classifiedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("classify application component %s on dimension %s tenant %s: %w", componentID, dimensionID, tenantID.Value(), err)
+	}
+	return nil
+}
+
+func (rm *ApplicationComponentReadModel) ClearClassification(ctx context.Context, componentID, dimensionID string) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return fmt.Errorf("resolve tenant for clear classification of application component %s: %w", componentID, err)
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"DELETE FROM architecturemodeling.application_component_classifications WHERE tenant_id = $1 AND component_id = $2 AND dimension_id = $3",
+		tenantID.Value(), componentID, dimensionID,
+	)
+	if err != nil {
+		return fmt.Errorf("clear classification %s of application component %s tenant %s: %w", dimensionID, componentID, tenantID.Value(), err)
+	}
+	return nil
+}
+
+func (rm *ApplicationComponentReadModel) loadComponentDetails(ctx context.Context, tx *sql.Tx, tenantID string, components []ApplicationComponentDTO) error {
+	if err := rm.loadExpertsForComponents(ctx, tx, tenantID, components); err != nil {
+		return err
+	}
+	return rm.loadTagsAndClassifications(ctx, tx, tenantID, components)
+}
+
+func (rm *ApplicationComponentReadModel) loadTagsAndClassifications(ctx context.Context, tx *sql.Tx, tenantID string, components []ApplicationComponentDTO) error {
+	if len(components) == 0 {
+		return nil
+	}
+
+	componentIDs := make([]string, len(components))
+	componentIndex := make(map[string]int)
+	for i, c := range components {
+		componentIDs[i] = c.ID
+		componentIndex[c.ID] = i
+	}
+
+	if err := rm.loadTags(ctx, tx, tenantID, componentIDs, func(componentID, tag string) {
+		if idx, ok := componentIndex[componentID]; ok {
+			components[idx].Tags = append(components[idx].Tags, tag)
+		}
+	}); err != nil {
+		return err
+	}
+
+	return rm.loadClassifications(ctx, tx, tenantID, componentIDs, func(componentID string, c ClassificationDTO) {
+		if idx, ok := componentIndex[componentID]; ok {
+			components[idx].Classifications = append(components[idx].Classifications, c)
+		}
+	})
+}
+
+func (rm *ApplicationComponentReadModel) loadTags(ctx context.Context, tx *sql.Tx, tenantID string, componentIDs []string, add func(componentID, tag string)) error {
+	rows, err := tx.QueryContext(ctx,
+		"SELECT component_id, tag FROM architecturemodeling.application_component_tags WHERE tenant_id = $1 AND component_id = ANY($2) ORDER BY tag",
+		tenantID, pq.Array(componentIDs),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var componentID, tag string
+		if err := rows.Scan(&componentID, &tag); err != nil {
+			return err
+		}
+		add(componentID, tag)
+	}
+	return rows.Err()
+}
+
+// loadClassifications only returns values for dimensions that are still active in the metamodel
+func (rm *ApplicationComponentReadModel) loadClassifications(ctx context.Context, tx *sql.Tx, tenantID string, componentIDs []string, add func(string, ClassificationDTO)) error {
+	rows, err := tx.QueryContext(ctx,
+		`SELECT cl.component_id, cl.dimension_id, d.name, cl.value
+		FROM architecturemodeling.application_component_classifications cl
+		JOIN architecturemodeling.classification_dimension_cache d ON d.tenant_id = cl.tenant_id AND d.id = cl.dimension_id AND d.active = TRUE
+		WHERE cl.tenant_id = $1 AND cl.component_id = ANY($2)
+		ORDER BY LOWER(d.name)`,
+		tenantID, pq.Array(componentIDs),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var componentID string
+		var c ClassificationDTO
+		if err := rows.Scan(&componentID, &c.DimensionID, &c.DimensionName, &c.Value); err != nil {
+			return err
+		}
+		add(componentID, c)
+	}
+	return rows.Err()
+}
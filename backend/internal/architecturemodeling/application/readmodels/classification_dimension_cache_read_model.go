@@ -0,0 +1,132 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+// ClassificationDimensionCacheDTO is the local copy of a tenant-configured classification dimension owned by the metamodel
+type ClassificationDimensionCacheDTO struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+	Active bool     `json:"active"`
+}
+
+// AllowsValue reports whether value is one of the dimension's configured values
+func (d ClassificationDimensionCacheDTO) AllowsValue(value string) bool {
+	for _, v := range d.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ClassificationDimensionCacheReadModel stores classification dimensions projected from metamodel events
+type ClassificationDimensionCacheReadModel struct {
+	db *database.TenantAwareDB
+}
+
+// NewClassificationDimensionCacheReadModel creates a new cache read model
+func NewClassificationDimensionCacheReadModel(db *database.TenantAwareDB) *ClassificationDimensionCacheReadModel {
+	return &ClassificationDimensionCacheReadModel{db: db}
+}
+
+// Upsert inserts or replaces a cached classification dimension
+func (rm *ClassificationDimensionCacheReadModel) Upsert(ctx context.Context, dto ClassificationDimensionCacheDTO) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		`INSERT INTO architecturemodeling.classification_dimension_cache (id, tenant_id, name, allowed_values, active)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (tenant_id, id) DO UPDATE SET
+			name = EXCLUDED.name,
+			allowed_values = EXCLUDED.allowed_values,
+			active = EXCLUDED.active`,
+		dto.ID, tenantID.Value(), dto.Name, pq.Array(dto.Values), dto.Active,
+	)
+	return err
+}
+
+// Deactivate marks a cached dimension as no longer usable for classification
+func (rm *ClassificationDimensionCacheReadModel) Deactivate(ctx context.Context, id string) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"UPDATE architecturemodeling.classification_dimension_cache SET active = FALSE WHERE tenant_id = $1 AND id = $2",
+		tenantID.Value(), id,
+	)
+	return err
+}
+
+// GetActive returns all dimensions that can currently be used for classification
+func (rm *ClassificationDimensionCacheReadModel) GetActive(ctx context.Context) ([]ClassificationDimensionCacheDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dimensions := make([]ClassificationDimensionCacheDTO, 0)
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT id, name, allowed_values, active FROM architecturemodeling.classification_dimension_cache
+			WHERE tenant_id = $1 AND active = TRUE ORDER BY LOWER(name)`,
+			tenantID.Value(),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto ClassificationDimensionCacheDTO
+			if err := rows.Scan(&dto.ID, &dto.Name, pq.Array(&dto.Values), &dto.Active); err != nil {
+				return err
+			}
+			dimensions = append(dimensions, dto)
+		}
+		return rows.Err()
+	})
+	return dimensions, err
+}
+
+// GetByID returns a cached dimension, active or not, or nil when unknown
+func (rm *ClassificationDimensionCacheReadModel) GetByID(ctx context.Context, id string) (*ClassificationDimensionCacheDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto ClassificationDimensionCacheDTO
+	var notFound bool
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx,
+			"SELECT id, name, allowed_values, active FROM architecturemodeling.classification_dimension_cache WHERE tenant_id = $1 AND id = $2",
+			tenantID.Value(), id,
+		).Scan(&dto.ID, &dto.Name, pq.Array(&dto.Values), &dto.Active)
+		if err == sql.ErrNoRows {
+			notFound = true
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if notFound {
+		return nil, nil
+	}
+	return &dto, nil
+}
@@ -16,12 +16,28 @@ type ComponentRelationDTO struct {
 	SourceComponentID string      `json:"sourceComponentId"`
 	TargetComponentID string      `json:"targetComponentId"`
 	RelationType      string      `json:"relationType"`
+	RelationTypeName  string      `json:"relationTypeName"`
+	RelationTypeStyle *EdgeStyle  `json:"relationTypeStyle,omitempty"`
 	Name              string      `json:"name,omitempty"`
 	Description       string      `json:"description,omitempty"`
 	CreatedAt         time.Time   `json:"createdAt"`
 	Links             types.Links `json:"_links,omitempty"`
 }
 
+// EdgeStyle describes how a custom relation type is drawn on the canvas
+type EdgeStyle struct {
+	LineStyle string `json:"lineStyle"`
+	Color     string `json:"color,omitempty"`
+}
+
+// relationSelect resolves custom relation types against the local cache so
+// consumers get a display name and edge style without calling the metamodel
+const relationSelect = `SELECT r.id, r.source_component_id, r.target_component_id, r.relation_type, r.name, r.description, r.created_at,
+	t.name, t.line_style, t.color
+	FROM architecturemodeling.component_relations r
+	LEFT JOIN architecturemodeling.custom_relation_type_cache t
+		ON t.tenant_id = r.tenant_id AND r.relation_type = 'custom:' || t.id`
+
 // ComponentRelationReadModel handles queries for component relations
 type ComponentRelationReadModel struct {
 	db *database.TenantAwareDB
@@ -98,21 +114,17 @@ func (rm *ComponentRelationReadModel) GetByID(ctx context.Context, id string) (*
 	var notFound bool
 
 	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
-		var name, description sql.NullString
-		err := tx.QueryRowContext(ctx,
-			"SELECT id, source_component_id, target_component_id, relation_type, name, description, created_at FROM architecturemodeling.component_relations WHERE tenant_id = $1 AND id = $2 AND is_deleted = FALSE",
+		row := tx.QueryRowContext(ctx,
+			relationSelect+" WHERE r.tenant_id = $1 AND r.id = $2 AND r.is_deleted = FALSE",
 			tenantID.Value(), id,
-		).Scan(&dto.ID, &dto.SourceComponentID, &dto.TargetComponentID, &dto.RelationType, &name, &description, &dto.CreatedAt)
-
-		if err == sql.ErrNoRows {
+		)
+		var scanErr error
+		dto, scanErr = scanRelation(row)
+		if scanErr == sql.ErrNoRows {
 			notFound = true
 			return nil
 		}
-		if err == nil {
-			dto.Name = name.String
-			dto.Description = description.String
-		}
-		return err
+		return scanErr
 	})
 
 	if err != nil {
@@ -128,7 +140,7 @@ func (rm *ComponentRelationReadModel) GetByID(ctx context.Context, id string) (*
 // GetAll retrieves all relations for the current tenant
 // RLS policies automatically filter, but we add explicit filter for defense-in-depth
 func (rm *ComponentRelationReadModel) GetAll(ctx context.Context) ([]ComponentRelationDTO, error) {
-	return rm.queryRelations(ctx, "SELECT id, source_component_id, target_component_id, relation_type, name, description, created_at FROM architecturemodeling.component_relations WHERE tenant_id = $1 AND is_deleted = FALSE ORDER BY created_at DESC")
+	return rm.queryRelations(ctx, relationSelect+" WHERE r.tenant_id = $1 AND r.is_deleted = FALSE ORDER BY r.created_at DESC")
 }
 
 type paginationParams struct {
@@ -174,12 +186,12 @@ func (rm *ComponentRelationReadModel) GetAllPaginated(ctx context.Context, limit
 func (rm *ComponentRelationReadModel) selectPaginatedRows(ctx context.Context, tx *sql.Tx, params paginationParams) (*sql.Rows, error) {
 	if params.afterCursor == "" {
 		return tx.QueryContext(ctx,
-			"SELECT id, source_component_id, target_component_id, relation_type, name, description, created_at FROM architecturemodeling.component_relations WHERE tenant_id = $1 AND is_deleted = FALSE ORDER BY created_at DESC, id DESC LIMIT $2",
+			relationSelect+" WHERE r.tenant_id = $1 AND r.is_deleted = FALSE ORDER BY r.created_at DESC, r.id DESC LIMIT $2",
 			params.tenantID, params.limit,
 		)
 	}
 	return tx.QueryContext(ctx,
-		"SELECT id, source_component_id, target_component_id, relation_type, name, description, created_at FROM architecturemodeling.component_relations WHERE tenant_id = $1 AND is_deleted = FALSE AND (created_at < to_timestamp($2) OR (created_at = to_timestamp($2) AND id < $3)) ORDER BY created_at DESC, id DESC LIMIT $4",
+		relationSelect+" WHERE r.tenant_id = $1 AND r.is_deleted = FALSE AND (r.created_at < to_timestamp($2) OR (r.created_at = to_timestamp($2) AND r.id < $3)) ORDER BY r.created_at DESC, r.id DESC LIMIT $4",
 		params.tenantID, params.afterTimestamp, params.afterCursor, params.limit,
 	)
 }
@@ -194,12 +206,12 @@ func (rm *ComponentRelationReadModel) extractPageResults(relations []ComponentRe
 
 // GetBySourceID retrieves all relations where component is the source for the current tenant
 func (rm *ComponentRelationReadModel) GetBySourceID(ctx context.Context, componentID string) ([]ComponentRelationDTO, error) {
-	return rm.queryRelationsWithParam(ctx, "SELECT id, source_component_id, target_component_id, relation_type, name, description, created_at FROM architecturemodeling.component_relations WHERE tenant_id = $1 AND source_component_id = $2 AND is_deleted = FALSE ORDER BY created_at DESC", componentID)
+	return rm.queryRelationsWithParam(ctx, relationSelect+" WHERE r.tenant_id = $1 AND r.source_component_id = $2 AND r.is_deleted = FALSE ORDER BY r.created_at DESC", componentID)
 }
 
 // GetByTargetID retrieves all relations where component is the target for the current tenant
 func (rm *ComponentRelationReadModel) GetByTargetID(ctx context.Context, componentID string) ([]ComponentRelationDTO, error) {
-	return rm.queryRelationsWithParam(ctx, "SELECT id, source_component_id, target_component_id, relation_type, name, description, created_at FROM architecturemodeling.component_relations WHERE tenant_id = $1 AND target_component_id = $2 AND is_deleted = FALSE ORDER BY created_at DESC", componentID)
+	return rm.queryRelationsWithParam(ctx, relationSelect+" WHERE r.tenant_id = $1 AND r.target_component_id = $2 AND r.is_deleted = FALSE ORDER BY r.created_at DESC", componentID)
 }
 
 func (rm *ComponentRelationReadModel) queryRelations(ctx context.Context, query string) ([]ComponentRelationDTO, error) {
@@ -239,7 +251,7 @@ func (rm *ComponentRelationReadModel) executeRelationQuery(ctx context.Context,
 func (rm *ComponentRelationReadModel) collectRelations(rows *sql.Rows) ([]ComponentRelationDTO, error) {
 	var relations []ComponentRelationDTO
 	for rows.Next() {
-		dto, err := rm.scanRelationRow(rows)
+		dto, err := scanRelation(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -248,14 +260,24 @@ func (rm *ComponentRelationReadModel) collectRelations(rows *sql.Rows) ([]Compon
 	return relations, rows.Err()
 }
 
-func (rm *ComponentRelationReadModel) scanRelationRow(rows *sql.Rows) (ComponentRelationDTO, error) {
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRelation(row rowScanner) (ComponentRelationDTO, error) {
 	var dto ComponentRelationDTO
-	var name, description sql.NullString
-	err := rows.Scan(&dto.ID, &dto.SourceComponentID, &dto.TargetComponentID, &dto.RelationType, &name, &description, &dto.CreatedAt)
+	var name, description, typeName, lineStyle, color sql.NullString
+	err := row.Scan(&dto.ID, &dto.SourceComponentID, &dto.TargetComponentID, &dto.RelationType, &name, &description, &dto.CreatedAt,
+		&typeName, &lineStyle, &color)
 	if err != nil {
 		return ComponentRelationDTO{}, err
 	}
 	dto.Name = name.String
 	dto.Description = description.String
+	dto.RelationTypeName = dto.RelationType
+	if typeName.Valid {
+		dto.RelationTypeName = typeName.String
+		dto.RelationTypeStyle = &EdgeStyle{LineStyle: lineStyle.String, Color: color.String}
+	}
 	return dto, nil
 }
@@ -0,0 +1,117 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+// CustomRelationTypeCacheDTO is the local copy of a tenant-defined relation type owned by the metamodel
+type CustomRelationTypeCacheDTO struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	LineStyle   string `json:"lineStyle"`
+	Color       string `json:"color,omitempty"`
+	Active      bool   `json:"active"`
+}
+
+// CustomRelationTypeCacheReadModel stores custom relation types projected from metamodel events
+type CustomRelationTypeCacheReadModel struct {
+	db *database.TenantAwareDB
+}
+
+// NewCustomRelationTypeCacheReadModel creates a new cache read model
+func NewCustomRelationTypeCacheReadModel(db *database.TenantAwareDB) *CustomRelationTypeCacheReadModel {
+	return &CustomRelationTypeCacheReadModel{db: db}
+}
+
+// Upsert inserts or replaces a cached custom relation type
+func (rm *CustomRelationTypeCacheReadModel) Upsert(ctx context.Context, dto CustomRelationTypeCacheDTO) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		`INSERT INTO architecturemodeling.custom_relation_type_cache (id, tenant_id, name, description, line_style, color, active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (tenant_id, id) DO UPDATE SET
+			name = EXCLUDED.name,
+			description = EXCLUDED.description,
+			line_style = EXCLUDED.line_style,
+			color = EXCLUDED.color,
+			active = EXCLUDED.active`,
+		dto.ID, tenantID.Value(), dto.Name, dto.Description, dto.LineStyle, dto.Color, dto.Active,
+	)
+	return err
+}
+
+// Deactivate marks a cached custom relation type as no longer selectable.
+// The row is kept so existing relations still resolve their name and style.
+func (rm *CustomRelationTypeCacheReadModel) Deactivate(ctx context.Context, id string) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"UPDATE architecturemodeling.custom_relation_type_cache SET active = FALSE WHERE tenant_id = $1 AND id = $2",
+		tenantID.Value(), id,
+	)
+	return err
+}
+
+// GetActive returns the active custom relation types for the current tenant
+func (rm *CustomRelationTypeCacheReadModel) GetActive(ctx context.Context) ([]CustomRelationTypeCacheDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var types []CustomRelationTypeCacheDTO
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			"SELECT id, name, description, line_style, color, active FROM architecturemodeling.custom_relation_type_cache WHERE tenant_id = $1 AND active = TRUE ORDER BY LOWER(name)",
+			tenantID.Value(),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto CustomRelationTypeCacheDTO
+			var description, color sql.NullString
+			if err := rows.Scan(&dto.ID, &dto.Name, &description, &dto.LineStyle, &color, &dto.Active); err != nil {
+				return err
+			}
+			dto.Description = description.String
+			dto.Color = color.String
+			types = append(types, dto)
+		}
+		return rows.Err()
+	})
+
+	return types, err
+}
+
+// IsActive reports whether the custom relation type exists and can be used for new relations
+func (rm *CustomRelationTypeCacheReadModel) IsActive(ctx context.Context, id string) (bool, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var active bool
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx,
+			"SELECT EXISTS(SELECT 1 FROM architecturemodeling.custom_relation_type_cache WHERE tenant_id = $1 AND id = $2 AND active = TRUE)",
+			tenantID.Value(), id,
+		).Scan(&active)
+	})
+
+	return active, err
+}
@@ -0,0 +1,276 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/types"
+)
+
+type CoveredComponentDTO struct {
+	ComponentID   string `json:"componentId"`
+	ComponentName string `json:"componentName,omitempty"`
+}
+
+type VendorContractDTO struct {
+	ID                string                `json:"id"`
+	VendorID          string                `json:"vendorId"`
+	VendorName        string                `json:"vendorName,omitempty"`
+	Name              string                `json:"name"`
+	StartDate         time.Time             `json:"startDate"`
+	EndDate           time.Time             `json:"endDate"`
+	NoticePeriodDays  int                   `json:"noticePeriodDays"`
+	NoticeDeadline    time.Time             `json:"noticeDeadline"`
+	ValueAmount       float64               `json:"valueAmount,omitempty"`
+	ValueCurrency     string                `json:"valueCurrency,omitempty"`
+	Owner             string                `json:"owner"`
+	CoveredComponents []CoveredComponentDTO `json:"coveredComponents"`
+	Notes             string                `json:"notes,omitempty"`
+	CreatedAt         time.Time             `json:"createdAt"`
+	UpdatedAt         *time.Time            `json:"updatedAt,omitempty"`
+	Links             types.Links           `json:"_links,omitempty"`
+}
+
+type VendorContractTermsUpdate struct {
+	ID                  string
+	Name                string
+	StartDate           time.Time
+	EndDate             time.Time
+	NoticePeriodDays    int
+	NoticeDeadline      time.Time
+	ValueAmount         float64
+	ValueCurrency       string
+	Owner               string
+	CoveredComponentIDs []string
+	Notes               string
+}
+
+type VendorContractReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewVendorContractReadModel(db *database.TenantAwareDB) *VendorContractReadModel {
+	return &VendorContractReadModel{db: db}
+}
+
+func (rm *VendorContractReadModel) Insert(ctx context.Context, vendorID string, createdAt time.Time, terms VendorContractTermsUpdate) error {
+	return rm.withTx(ctx, func(tx *sql.Tx, tenantID string) error {
+		if _, err := tx.ExecContext(ctx,
+			"DELETE FROM architecturemodeling.vendor_contracts WHERE tenant_id = $1 AND id = $2",
+			tenantID, terms.ID,
+		); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO architecturemodeling.vendor_contracts
+			(id, tenant_id, vendor_id, name, start_date, end_date, notice_period_days, notice_deadline,
+			 value_amount, value_currency, owner, notes, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+			terms.ID, tenantID, vendorID, terms.Name, terms.StartDate, terms.EndDate, terms.NoticePeriodDays,
+			terms.NoticeDeadline, terms.ValueAmount, terms.ValueCurrency, terms.Owner, terms.Notes, createdAt,
+		); err != nil {
+			return err
+		}
+		return replaceCoveredComponents(ctx, tx, tenantID, terms.ID, terms.CoveredComponentIDs)
+	})
+}
+
+func (rm *VendorContractReadModel) Update(ctx context.Context, terms VendorContractTermsUpdate) error {
+	return rm.withTx(ctx, func(tx *sql.Tx, tenantID string) error {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE architecturemodeling.vendor_contracts
+			SET name = $1, start_date = $2, end_date = $3, notice_period_days = $4, notice_deadline = $5,
+			    value_amount = $6, value_currency = $7, owner = $8, notes = $9, updated_at = CURRENT_TIMESTAMP
+			WHERE tenant_id = $10 AND id = $11`,
+			terms.Name, terms.StartDate, terms.EndDate, terms.NoticePeriodDays, terms.NoticeDeadline,
+			terms.ValueAmount, terms.ValueCurrency, terms.Owner, terms.Notes, tenantID, terms.ID,
+		); err != nil {
+			return err
+		}
+		return replaceCoveredComponents(ctx, tx, tenantID, terms.ID, terms.CoveredComponentIDs)
+	})
+}
+
+func replaceCoveredComponents(ctx context.Context, tx *sql.Tx, tenantID, contractID string, componentIDs []string) error {
+	if _, err := tx.ExecContext(ctx,
+		"DELETE FROM architecturemodeling.vendor_contract_components WHERE tenant_id = $1 AND contract_id = $2",
+		tenantID, contractID,
+	); err != nil {
+		return err
+	}
+	for _, componentID := range componentIDs {
+		if _, err := tx.ExecContext(ctx,
+			"INSERT INTO architecturemodeling.vendor_contract_components (tenant_id, contract_id, component_id) VALUES ($1, $2, $3)",
+			tenantID, contractID, componentID,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rm *VendorContractReadModel) MarkAsDeleted(ctx context.Context, id string, deletedAt time.Time) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"UPDATE architecturemodeling.vendor_contracts SET is_deleted = TRUE, deleted_at = $1 WHERE tenant_id = $2 AND id = $3",
+		deletedAt, tenantID.Value(), id,
+	)
+	return err
+}
+
+func (rm *VendorContractReadModel) RemoveCoveredComponent(ctx context.Context, componentID string) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"DELETE FROM architecturemodeling.vendor_contract_components WHERE tenant_id = $1 AND component_id = $2",
+		tenantID.Value(), componentID,
+	)
+	return err
+}
+
+const vendorContractSelect = `SELECT c.id, c.vendor_id, COALESCE(v.name, ''), c.name, c.start_date, c.end_date,
+	c.notice_period_days, c.notice_deadline, c.value_amount, c.value_currency, c.owner, c.notes,
+	c.created_at, c.updated_at
+	FROM architecturemodeling.vendor_contracts c
+	LEFT JOIN architecturemodeling.vendors v ON v.tenant_id = c.tenant_id AND v.id = c.vendor_id
+	WHERE c.tenant_id = $1 AND c.is_deleted = FALSE`
+
+func (rm *VendorContractReadModel) GetByID(ctx context.Context, id string) (*VendorContractDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts, err := rm.queryContracts(ctx, tenantID.Value(), " AND c.id = $2", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(contracts) == 0 {
+		return nil, nil
+	}
+	return &contracts[0], nil
+}
+
+func (rm *VendorContractReadModel) GetByVendorID(ctx context.Context, vendorID string) ([]VendorContractDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return rm.queryContracts(ctx, tenantID.Value(),
+		" AND c.vendor_id = $2 ORDER BY c.end_date ASC, LOWER(c.name) ASC",
+		vendorID,
+	)
+}
+
+// GetByNoticeDeadlineWindow lists the contracts whose notice deadline falls
+// between from and to. Contracts raise no event as their deadline nears, so
+// notice alerts are built by polling this window.
+func (rm *VendorContractReadModel) GetByNoticeDeadlineWindow(ctx context.Context, from, to time.Time) ([]VendorContractDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return rm.queryContracts(ctx, tenantID.Value(),
+		" AND c.notice_deadline >= $2 AND c.notice_deadline <= $3 ORDER BY c.notice_deadline ASC, LOWER(c.name) ASC",
+		from, to,
+	)
+}
+
+func (rm *VendorContractReadModel) queryContracts(ctx context.Context, tenantID, clause string, args ...any) ([]VendorContractDTO, error) {
+	contracts := make([]VendorContractDTO, 0)
+	err := rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, vendorContractSelect+clause, append([]any{tenantID}, args...)...)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto VendorContractDTO
+			if err := rows.Scan(
+				&dto.ID, &dto.VendorID, &dto.VendorName, &dto.Name, &dto.StartDate, &dto.EndDate,
+				&dto.NoticePeriodDays, &dto.NoticeDeadline, &dto.ValueAmount, &dto.ValueCurrency, &dto.Owner, &dto.Notes,
+				&dto.CreatedAt, &dto.UpdatedAt,
+			); err != nil {
+				return err
+			}
+			dto.CoveredComponents = []CoveredComponentDTO{}
+			contracts = append(contracts, dto)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		return loadCoveredComponents(ctx, tx, tenantID, contracts)
+	})
+
+	return contracts, err
+}
+
+func loadCoveredComponents(ctx context.Context, tx *sql.Tx, tenantID string, contracts []VendorContractDTO) error {
+	if len(contracts) == 0 {
+		return nil
+	}
+
+	index := make(map[string]int, len(contracts))
+	ids := make([]string, len(contracts))
+	for i, contract := range contracts {
+		index[contract.ID] = i
+		ids[i] = contract.ID
+	}
+
+	rows, err := tx.QueryContext(ctx,
+		`SELECT cc.contract_id, cc.component_id, COALESCE(ac.name, '')
+		FROM architecturemodeling.vendor_contract_components cc
+		LEFT JOIN architecturemodeling.application_components ac
+			ON ac.tenant_id = cc.tenant_id AND ac.id = cc.component_id AND ac.is_deleted = FALSE
+		WHERE cc.tenant_id = $1 AND cc.contract_id = ANY($2)
+		ORDER BY LOWER(ac.name) ASC`,
+		tenantID, pq.Array(ids),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var contractID string
+		var covered CoveredComponentDTO
+		if err := rows.Scan(&contractID, &covered.ComponentID, &covered.ComponentName); err != nil {
+			return err
+		}
+		i := index[contractID]
+		contracts[i].CoveredComponents = append(contracts[i].CoveredComponents, covered)
+	}
+	return rows.Err()
+}
+
+func (rm *VendorContractReadModel) withTx(ctx context.Context, fn func(tx *sql.Tx, tenantID string) error) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := rm.db.BeginTxWithTenant(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx, tenantID.Value()); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
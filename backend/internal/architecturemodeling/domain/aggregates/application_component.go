@@ -3,6 +3,7 @@ package aggregates
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"easi/backend/internal/architecturemodeling/domain/events"
@@ -11,17 +12,23 @@ import (
 )
 
 var (
-	ErrDuplicateExpert = errors.New("this exact expert entry already exists on this component")
+	ErrDuplicateExpert            = errors.New("this exact expert entry already exists on this component")
+	ErrComponentCannotBeOwnParent = errors.New("a component cannot be its own parent")
+	ErrCannotMergeIntoItself      = errors.New("a component cannot be merged into itself")
+	ErrMergeWithDeletedComponent  = errors.New("deleted components cannot take part in a merge")
 )
 
 // ApplicationComponent represents an application component aggregate
 type ApplicationComponent struct {
 	domain.AggregateRoot
-	name        valueobjects.ComponentName
-	description valueobjects.Description
-	createdAt   time.Time
-	isDeleted   bool
-	experts     []valueobjects.Expert
+	name            valueobjects.ComponentName
+	description     valueobjects.Description
+	createdAt       time.Time
+	isDeleted       bool
+	experts         []valueobjects.Expert
+	parentID        string
+	tags            []valueobjects.Tag
+	classifications map[string]string
 }
 
 func NewApplicationComponent(name valueobjects.ComponentName, description valueobjects.Description) (*ApplicationComponent, error) {
@@ -129,6 +136,196 @@ func (a *ApplicationComponent) RemoveExpert(expert valueobjects.Expert) error {
 	return nil
 }
 
+// ChangeParent places the component under another component, e.g. a module inside a suite.
+// An empty parent ID makes the component a top-level component again.
+// Cycle detection across the hierarchy is the caller's responsibility.
+func (a *ApplicationComponent) ChangeParent(newParentID string) error {
+	if newParentID == a.ID() {
+		return ErrComponentCannotBeOwnParent
+	}
+	if newParentID == a.parentID {
+		return nil
+	}
+
+	event := events.NewApplicationComponentParentChanged(a.ID(), a.parentID, newParentID)
+
+	if err := a.apply(event); err != nil {
+		return err
+	}
+	a.RaiseEvent(event)
+
+	return nil
+}
+
+func (a *ApplicationComponent) AddTag(tag valueobjects.Tag) error {
+	if a.HasTag(tag) {
+		return nil
+	}
+
+	event := events.NewApplicationComponentTagAdded(a.ID(), tag.Value())
+
+	if err := a.apply(event); err != nil {
+		return err
+	}
+	a.RaiseEvent(event)
+
+	return nil
+}
+
+func (a *ApplicationComponent) RemoveTag(tag valueobjects.Tag) error {
+	if !a.HasTag(tag) {
+		return nil
+	}
+
+	event := events.NewApplicationComponentTagRemoved(a.ID(), tag.Value())
+
+	if err := a.apply(event); err != nil {
+		return err
+	}
+	a.RaiseEvent(event)
+
+	return nil
+}
+
+func (a *ApplicationComponent) HasTag(tag valueobjects.Tag) bool {
+	for _, existing := range a.tags {
+		if existing.Equals(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Classify sets the component's value for a classification dimension.
+// Validating the dimension and its allowed values is the caller's responsibility,
+// since dimensions are configured in the metamodel.
+func (a *ApplicationComponent) Classify(dimensionID, value string) error {
+	if current, ok := a.classifications[dimensionID]; ok && current == value {
+		return nil
+	}
+
+	event := events.NewApplicationComponentClassified(a.ID(), dimensionID, value)
+
+	if err := a.apply(event); err != nil {
+		return err
+	}
+	a.RaiseEvent(event)
+
+	return nil
+}
+
+func (a *ApplicationComponent) ClearClassification(dimensionID string) error {
+	if _, ok := a.classifications[dimensionID]; !ok {
+		return nil
+	}
+
+	event := events.NewApplicationComponentClassificationCleared(a.ID(), dimensionID)
+
+	if err := a.apply(event); err != nil {
+		return err
+	}
+	a.RaiseEvent(event)
+
+	return nil
+}
+
+// CanAbsorb reports whether the duplicate can be merged into this component.
+func (a *ApplicationComponent) CanAbsorb(duplicate *ApplicationComponent) error {
+	if duplicate.ID() == a.ID() {
+		return ErrCannotMergeIntoItself
+	}
+	if a.isDeleted || duplicate.isDeleted {
+		return ErrMergeWithDeletedComponent
+	}
+	return nil
+}
+
+// AbsorbDuplicate moves the duplicate's experts, tags and classifications onto this
+// component and records the merge on both. Where both components classify the same
+// dimension, this component's value wins. Deleting the duplicate is left to the caller.
+func (a *ApplicationComponent) AbsorbDuplicate(duplicate *ApplicationComponent, mergedBy string) error {
+	if err := a.CanAbsorb(duplicate); err != nil {
+		return err
+	}
+
+	if err := a.absorbExperts(duplicate); err != nil {
+		return err
+	}
+	if err := a.absorbTags(duplicate); err != nil {
+		return err
+	}
+	if err := a.absorbClassifications(duplicate); err != nil {
+		return err
+	}
+
+	absorbed := events.NewApplicationComponentDuplicateAbsorbed(a.ID(), duplicate.ID(), duplicate.name.Value(), mergedBy)
+	a.RaiseEvent(absorbed)
+
+	mergedInto := events.NewApplicationComponentMergedInto(duplicate.ID(), a.ID(), a.name.Value(), mergedBy)
+	duplicate.RaiseEvent(mergedInto)
+
+	return nil
+}
+
+// RecordRelationMoved records on both components of a merge that one of the
+// duplicate's relations was moved onto this component. newRelationID is empty
+// when the relation was dropped rather than recreated.
+func (a *ApplicationComponent) RecordRelationMoved(duplicate *ApplicationComponent, relationID, newRelationID, mergedBy string) {
+	a.RaiseEvent(events.NewApplicationComponentRelationMoved(a.ID(), relationID, newRelationID, duplicate.ID(), a.ID(), mergedBy))
+	duplicate.RaiseEvent(events.NewApplicationComponentRelationMoved(duplicate.ID(), relationID, newRelationID, duplicate.ID(), a.ID(), mergedBy))
+}
+
+// RecordChildMoved records on both components of a merge that a child component
+// of the duplicate was re-parented onto this component.
+func (a *ApplicationComponent) RecordChildMoved(duplicate *ApplicationComponent, childID, mergedBy string) {
+	a.RaiseEvent(events.NewApplicationComponentChildMoved(a.ID(), childID, duplicate.ID(), a.ID(), mergedBy))
+	duplicate.RaiseEvent(events.NewApplicationComponentChildMoved(duplicate.ID(), childID, duplicate.ID(), a.ID(), mergedBy))
+}
+
+func (a *ApplicationComponent) absorbExperts(duplicate *ApplicationComponent) error {
+	for _, expert := range append([]valueobjects.Expert(nil), duplicate.experts...) {
+		if err := a.AddExpert(expert); err != nil && !errors.Is(err, ErrDuplicateExpert) {
+			return err
+		}
+		if err := duplicate.RemoveExpert(expert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *ApplicationComponent) absorbTags(duplicate *ApplicationComponent) error {
+	for _, tag := range append([]valueobjects.Tag(nil), duplicate.tags...) {
+		if err := a.AddTag(tag); err != nil {
+			return err
+		}
+		if err := duplicate.RemoveTag(tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *ApplicationComponent) absorbClassifications(duplicate *ApplicationComponent) error {
+	dimensionIDs := make([]string, 0, len(duplicate.classifications))
+	for dimensionID := range duplicate.classifications {
+		dimensionIDs = append(dimensionIDs, dimensionID)
+	}
+	sort.Strings(dimensionIDs)
+
+	for _, dimensionID := range dimensionIDs {
+		if _, ok := a.classifications[dimensionID]; !ok {
+			if err := a.Classify(dimensionID, duplicate.classifications[dimensionID]); err != nil {
+				return err
+			}
+		}
+		if err := duplicate.ClearClassification(dimensionID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *ApplicationComponent) Experts() []valueobjects.Expert {
 	return a.experts
 }
@@ -145,6 +342,16 @@ func (a *ApplicationComponent) apply(event domain.DomainEvent) error {
 		return a.applyExpertAdded(e)
 	case events.ApplicationComponentExpertRemoved:
 		a.experts = removeExpert(a.experts, e.ExpertName, e.ExpertRole, e.ContactInfo)
+	case events.ApplicationComponentParentChanged:
+		a.parentID = e.NewParentID
+	case events.ApplicationComponentTagAdded:
+		return a.applyTagAdded(e)
+	case events.ApplicationComponentTagRemoved:
+		a.tags = removeTag(a.tags, e.Tag)
+	case events.ApplicationComponentClassified:
+		a.applyClassified(e)
+	case events.ApplicationComponentClassificationCleared:
+		delete(a.classifications, e.DimensionID)
 	}
 	return nil
 }
@@ -195,6 +402,32 @@ func removeExpert(experts []valueobjects.Expert, name, role, contact string) []v
 	return result
 }
 
+func (a *ApplicationComponent) applyTagAdded(e events.ApplicationComponentTagAdded) error {
+	tag, err := valueobjects.NewTag(e.Tag)
+	if err != nil {
+		return fmt.Errorf("%w: tag %q: %v", domain.ErrCorruptedEvent, e.Tag, err)
+	}
+	a.tags = append(a.tags, tag)
+	return nil
+}
+
+func removeTag(tags []valueobjects.Tag, value string) []valueobjects.Tag {
+	result := make([]valueobjects.Tag, 0, len(tags))
+	for _, tag := range tags {
+		if tag.Value() != value {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+func (a *ApplicationComponent) applyClassified(e events.ApplicationComponentClassified) {
+	if a.classifications == nil {
+		a.classifications = make(map[string]string)
+	}
+	a.classifications[e.DimensionID] = e.Value
+}
+
 func (a *ApplicationComponent) Name() valueobjects.ComponentName {
 	return a.name
 }
@@ -207,6 +440,20 @@ func (a *ApplicationComponent) CreatedAt() time.Time {
 	return a.createdAt
 }
 
+func (a *ApplicationComponent) ParentID() string {
+	return a.parentID
+}
+
+func (a *ApplicationComponent) Tags() []valueobjects.Tag {
+	return a.tags
+}
+
+// Classification returns the component's value for a dimension and whether one is set
+func (a *ApplicationComponent) Classification(dimensionID string) (string, bool) {
+	value, ok := a.classifications[dimensionID]
+	return value, ok
+}
+
 func (a *ApplicationComponent) IsDeleted() bool {
 	return a.isDeleted
 }
@@ -4,7 +4,9 @@ import (
 	"testing"
 	"time"
 
+	"easi/backend/internal/architecturemodeling/domain/events"
 	"easi/backend/internal/architecturemodeling/domain/valueobjects"
+	domain "easi/backend/internal/shared/eventsourcing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -260,3 +262,247 @@ func TestLoadApplicationComponentFromHistory_WithExpertEvents(t *testing.T) {
 	assert.Len(t, reconstructed.Experts(), 1)
 	assert.Equal(t, "Alice Smith", reconstructed.Experts()[0].Name().Value())
 }
+
+func newTestComponent(t *testing.T, componentName string) *ApplicationComponent {
+	t.Helper()
+	name, err := valueobjects.NewComponentName(componentName)
+	require.NoError(t, err)
+	component, err := NewApplicationComponent(name, valueobjects.MustNewDescription(""))
+	require.NoError(t, err)
+	component.MarkChangesAsCommitted()
+	return component
+}
+
+func TestApplicationComponent_ChangeParent(t *testing.T) {
+	suite := newTestComponent(t, "SAP S/4")
+	module := newTestComponent(t, "SAP FI")
+
+	require.NoError(t, module.ChangeParent(suite.ID()))
+
+	assert.Equal(t, suite.ID(), module.ParentID())
+	changes := module.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	assert.Equal(t, "ApplicationComponentParentChanged", changes[0].EventType())
+	assert.Equal(t, "", changes[0].EventData()["oldParentId"])
+	assert.Equal(t, suite.ID(), changes[0].EventData()["newParentId"])
+}
+
+func TestApplicationComponent_ChangeParent_RejectsSelf(t *testing.T) {
+	component := newTestComponent(t, "SAP S/4")
+
+	err := component.ChangeParent(component.ID())
+
+	assert.ErrorIs(t, err, ErrComponentCannotBeOwnParent)
+	assert.Empty(t, component.GetUncommittedChanges())
+}
+
+func TestApplicationComponent_ChangeParent_UnchangedIsNoOp(t *testing.T) {
+	suite := newTestComponent(t, "SAP S/4")
+	module := newTestComponent(t, "SAP CO")
+	require.NoError(t, module.ChangeParent(suite.ID()))
+	module.MarkChangesAsCommitted()
+
+	require.NoError(t, module.ChangeParent(suite.ID()))
+
+	assert.Empty(t, module.GetUncommittedChanges())
+}
+
+func TestApplicationComponent_ChangeParent_ClearToTopLevel(t *testing.T) {
+	suite := newTestComponent(t, "SAP S/4")
+	module := newTestComponent(t, "SAP MM")
+	require.NoError(t, module.ChangeParent(suite.ID()))
+
+	require.NoError(t, module.ChangeParent(""))
+
+	assert.Empty(t, module.ParentID())
+}
+
+func TestLoadApplicationComponentFromHistory_WithParentChange(t *testing.T) {
+	name, _ := valueobjects.NewComponentName("SAP FI")
+	component, err := NewApplicationComponent(name, valueobjects.MustNewDescription(""))
+	require.NoError(t, err)
+	require.NoError(t, component.ChangeParent("4b0f8a1e-6a4c-4e3e-9a53-2f7d6c1b9e01"))
+
+	reconstructed, err := LoadApplicationComponentFromHistory(component.GetUncommittedChanges())
+	require.NoError(t, err)
+
+	assert.Equal(t, "4b0f8a1e-6a4c-4e3e-9a53-2f7d6c1b9e01", reconstructed.ParentID())
+}
+
+func mustTag(t *testing.T, value string) valueobjects.Tag {
+	t.Helper()
+	tag, err := valueobjects.NewTag(value)
+	require.NoError(t, err)
+	return tag
+}
+
+func TestApplicationComponent_AddTag(t *testing.T) {
+	component := newTestComponent(t, "Salesforce")
+
+	require.NoError(t, component.AddTag(mustTag(t, "crm")))
+
+	require.Len(t, component.Tags(), 1)
+	assert.Equal(t, "crm", component.Tags()[0].Value())
+	changes := component.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	assert.Equal(t, "ApplicationComponentTagAdded", changes[0].EventType())
+}
+
+func TestApplicationComponent_AddTag_DuplicateIsNoOp(t *testing.T) {
+	component := newTestComponent(t, "Salesforce")
+	require.NoError(t, component.AddTag(mustTag(t, "crm")))
+	component.MarkChangesAsCommitted()
+
+	require.NoError(t, component.AddTag(mustTag(t, "crm")))
+
+	assert.Len(t, component.Tags(), 1)
+	assert.Empty(t, component.GetUncommittedChanges())
+}
+
+func TestApplicationComponent_RemoveTag(t *testing.T) {
+	component := newTestComponent(t, "Salesforce")
+	require.NoError(t, component.AddTag(mustTag(t, "crm")))
+	require.NoError(t, component.AddTag(mustTag(t, "saas")))
+	component.MarkChangesAsCommitted()
+
+	require.NoError(t, component.RemoveTag(mustTag(t, "crm")))
+	require.NoError(t, component.RemoveTag(mustTag(t, "unknown")))
+
+	require.Len(t, component.Tags(), 1)
+	assert.Equal(t, "saas", component.Tags()[0].Value())
+	assert.Len(t, component.GetUncommittedChanges(), 1)
+}
+
+func TestApplicationComponent_Classify(t *testing.T) {
+	component := newTestComponent(t, "Salesforce")
+
+	require.NoError(t, component.Classify("dim-hosting", "SaaS"))
+	require.NoError(t, component.Classify("dim-hosting", "SaaS"))
+	require.NoError(t, component.Classify("dim-hosting", "On-premise"))
+
+	value, ok := component.Classification("dim-hosting")
+	assert.True(t, ok)
+	assert.Equal(t, "On-premise", value)
+	assert.Len(t, component.GetUncommittedChanges(), 2, "repeating the current value is a no-op")
+}
+
+func TestApplicationComponent_ClearClassification(t *testing.T) {
+	component := newTestComponent(t, "Salesforce")
+	require.NoError(t, component.Classify("dim-hosting", "SaaS"))
+	component.MarkChangesAsCommitted()
+
+	require.NoError(t, component.ClearClassification("dim-hosting"))
+	require.NoError(t, component.ClearClassification("dim-unset"))
+
+	_, ok := component.Classification("dim-hosting")
+	assert.False(t, ok)
+	assert.Len(t, component.GetUncommittedChanges(), 1)
+}
+
+func TestLoadApplicationComponentFromHistory_WithTagsAndClassifications(t *testing.T) {
+	name, _ := valueobjects.NewComponentName("Salesforce")
+	component, err := NewApplicationComponent(name, valueobjects.MustNewDescription(""))
+	require.NoError(t, err)
+	require.NoError(t, component.AddTag(mustTag(t, "crm")))
+	require.NoError(t, component.Classify("dim-criticality", "High"))
+
+	reconstructed, err := LoadApplicationComponentFromHistory(component.GetUncommittedChanges())
+	require.NoError(t, err)
+
+	require.Len(t, reconstructed.Tags(), 1)
+	value, ok := reconstructed.Classification("dim-criticality")
+	assert.True(t, ok)
+	assert.Equal(t, "High", value)
+}
+
+func eventTypes(changes []domain.DomainEvent) []string {
+	types := make([]string, len(changes))
+	for i, change := range changes {
+		types[i] = change.EventType()
+	}
+	return types
+}
+
+func TestApplicationComponent_AbsorbDuplicate(t *testing.T) {
+	survivor := newTestComponent(t, "Salesforce")
+	require.NoError(t, survivor.AddTag(mustTag(t, "crm")))
+	require.NoError(t, survivor.Classify("dim-hosting", "SaaS"))
+	survivor.MarkChangesAsCommitted()
+
+	duplicate := newTestComponent(t, "SFDC")
+	expert, err := valueobjects.NewExpert("Alice Smith", "Product Owner", "alice@example.com", time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, duplicate.AddExpert(expert))
+	require.NoError(t, duplicate.AddTag(mustTag(t, "crm")))
+	require.NoError(t, duplicate.Classify("dim-hosting", "On-premise"))
+	require.NoError(t, duplicate.Classify("dim-criticality", "High"))
+	duplicate.MarkChangesAsCommitted()
+
+	require.NoError(t, survivor.AbsorbDuplicate(duplicate, "merger@example.com"))
+
+	require.Len(t, survivor.Experts(), 1)
+	assert.Len(t, survivor.Tags(), 1)
+	hosting, _ := survivor.Classification("dim-hosting")
+	assert.Equal(t, "SaaS", hosting, "the survivor's own classification wins")
+	criticality, ok := survivor.Classification("dim-criticality")
+	assert.True(t, ok)
+	assert.Equal(t, "High", criticality)
+
+	assert.Empty(t, duplicate.Experts())
+	assert.Empty(t, duplicate.Tags())
+	_, ok = duplicate.Classification("dim-hosting")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{
+		"ApplicationComponentExpertAdded",
+		"ApplicationComponentClassified",
+		"ApplicationComponentDuplicateAbsorbed",
+	}, eventTypes(survivor.GetUncommittedChanges()))
+	assert.Equal(t, []string{
+		"ApplicationComponentExpertRemoved",
+		"ApplicationComponentTagRemoved",
+		"ApplicationComponentClassificationCleared",
+		"ApplicationComponentClassificationCleared",
+		"ApplicationComponentMergedInto",
+	}, eventTypes(duplicate.GetUncommittedChanges()))
+}
+
+func TestApplicationComponent_AbsorbDuplicate_RejectsSelf(t *testing.T) {
+	component := newTestComponent(t, "Salesforce")
+
+	err := component.AbsorbDuplicate(component, "merger@example.com")
+
+	assert.ErrorIs(t, err, ErrCannotMergeIntoItself)
+}
+
+func TestApplicationComponent_AbsorbDuplicate_RejectsDeleted(t *testing.T) {
+	survivor := newTestComponent(t, "Salesforce")
+	duplicate := newTestComponent(t, "SFDC")
+	require.NoError(t, duplicate.Delete())
+
+	err := survivor.AbsorbDuplicate(duplicate, "merger@example.com")
+
+	assert.ErrorIs(t, err, ErrMergeWithDeletedComponent)
+}
+
+func TestApplicationComponent_RecordMergeMoves_RecordsOnBothComponents(t *testing.T) {
+	survivor := newTestComponent(t, "Salesforce")
+	duplicate := newTestComponent(t, "SFDC")
+
+	survivor.RecordRelationMoved(duplicate, "rel-1", "rel-2", "merger@example.com")
+	survivor.RecordChildMoved(duplicate, "child-1", "merger@example.com")
+
+	for _, component := range []*ApplicationComponent{survivor, duplicate} {
+		changes := component.GetUncommittedChanges()
+		require.Len(t, changes, 2)
+		relationMoved, ok := changes[0].(events.ApplicationComponentRelationMoved)
+		require.True(t, ok)
+		assert.Equal(t, component.ID(), relationMoved.AggregateID())
+		assert.Equal(t, duplicate.ID(), relationMoved.FromComponentID)
+		assert.Equal(t, survivor.ID(), relationMoved.ToComponentID)
+		assert.Equal(t, "rel-2", relationMoved.NewRelationID)
+		childMoved, ok := changes[1].(events.ApplicationComponentChildMoved)
+		require.True(t, ok)
+		assert.Equal(t, "child-1", childMoved.ChildID)
+	}
+}
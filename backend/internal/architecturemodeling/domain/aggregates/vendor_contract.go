@@ -0,0 +1,213 @@
+package aggregates
+
+import (
+	"errors"
+	"time"
+
+	"easi/backend/internal/architecturemodeling/domain/events"
+	"easi/backend/internal/architecturemodeling/domain/valueobjects"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var ErrVendorContractDeleted = errors.New("vendor contract has been deleted")
+
+type VendorContractDetails struct {
+	Name              valueobjects.EntityName
+	Term              valueobjects.ContractTerm
+	NoticePeriod      valueobjects.NoticePeriod
+	Value             valueobjects.ContractValue
+	Owner             valueobjects.ContractOwner
+	CoveredComponents []valueobjects.ComponentID
+	Notes             valueobjects.Notes
+}
+
+// VendorContract records a contract with a vendor and the notice deadline
+// derived from its term. No event is raised as the deadline approaches;
+// alerts come from polling the notice-deadline window of the read model.
+type VendorContract struct {
+	domain.AggregateRoot
+	vendorID          valueobjects.VendorID
+	name              valueobjects.EntityName
+	term              valueobjects.ContractTerm
+	noticePeriod      valueobjects.NoticePeriod
+	noticeDeadline    time.Time
+	value             valueobjects.ContractValue
+	owner             valueobjects.ContractOwner
+	coveredComponents []valueobjects.ComponentID
+	notes             valueobjects.Notes
+	createdAt         time.Time
+	isDeleted         bool
+}
+
+func NewVendorContract(vendorID valueobjects.VendorID, details VendorContractDetails) (*VendorContract, error) {
+	terms, err := buildVendorContractTerms(details)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregate := &VendorContract{
+		AggregateRoot: domain.NewAggregateRoot(),
+	}
+
+	event := events.NewVendorContractCreated(aggregate.ID(), vendorID.Value(), terms)
+
+	aggregate.apply(event)
+	aggregate.RaiseEvent(event)
+
+	return aggregate, nil
+}
+
+func LoadVendorContractFromHistory(events []domain.DomainEvent) (*VendorContract, error) {
+	aggregate := &VendorContract{
+		AggregateRoot: domain.NewAggregateRoot(),
+	}
+
+	aggregate.LoadFromHistory(events, func(event domain.DomainEvent) {
+		aggregate.apply(event)
+	})
+
+	return aggregate, nil
+}
+
+func (c *VendorContract) Update(details VendorContractDetails) error {
+	if c.isDeleted {
+		return ErrVendorContractDeleted
+	}
+
+	terms, err := buildVendorContractTerms(details)
+	if err != nil {
+		return err
+	}
+
+	event := events.NewVendorContractUpdated(c.ID(), c.vendorID.Value(), terms)
+
+	c.apply(event)
+	c.RaiseEvent(event)
+
+	return nil
+}
+
+func (c *VendorContract) Delete() error {
+	if c.isDeleted {
+		return ErrVendorContractDeleted
+	}
+
+	event := events.NewVendorContractDeleted(c.ID(), c.vendorID.Value(), c.name.Value())
+
+	c.apply(event)
+	c.RaiseEvent(event)
+
+	return nil
+}
+
+func buildVendorContractTerms(details VendorContractDetails) (events.VendorContractTerms, error) {
+	deadline, err := details.Term.NoticeDeadline(details.NoticePeriod)
+	if err != nil {
+		return events.VendorContractTerms{}, err
+	}
+
+	return events.VendorContractTerms{
+		Name:                details.Name.Value(),
+		StartDate:           details.Term.StartDate(),
+		EndDate:             details.Term.EndDate(),
+		NoticePeriodDays:    details.NoticePeriod.Days(),
+		NoticeDeadline:      deadline,
+		ValueAmount:         details.Value.Amount(),
+		ValueCurrency:       details.Value.Currency(),
+		Owner:               details.Owner.Value(),
+		CoveredComponentIDs: uniqueComponentIDs(details.CoveredComponents),
+		Notes:               details.Notes.Value(),
+	}, nil
+}
+
+func uniqueComponentIDs(ids []valueobjects.ComponentID) []string {
+	seen := make(map[string]bool, len(ids))
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if seen[id.Value()] {
+			continue
+		}
+		seen[id.Value()] = true
+		result = append(result, id.Value())
+	}
+	return result
+}
+
+func (c *VendorContract) apply(event domain.DomainEvent) {
+	switch e := event.(type) {
+	case events.VendorContractCreated:
+		c.AggregateRoot = domain.NewAggregateRootWithID(e.ID)
+		c.vendorID, _ = valueobjects.NewVendorIDFromString(e.VendorID)
+		c.applyTerms(e.VendorContractTerms)
+		c.createdAt = e.CreatedAt
+	case events.VendorContractUpdated:
+		c.applyTerms(e.VendorContractTerms)
+	case events.VendorContractDeleted:
+		c.isDeleted = true
+	}
+}
+
+func (c *VendorContract) applyTerms(terms events.VendorContractTerms) {
+	c.name = valueobjects.MustNewEntityName(terms.Name)
+	c.term = valueobjects.MustNewContractTerm(terms.StartDate, terms.EndDate)
+	c.noticePeriod = valueobjects.MustNewNoticePeriod(terms.NoticePeriodDays)
+	c.noticeDeadline = terms.NoticeDeadline
+	c.value = valueobjects.MustNewContractValue(terms.ValueAmount, terms.ValueCurrency)
+	c.owner = valueobjects.MustNewContractOwner(terms.Owner)
+	c.notes = valueobjects.MustNewNotes(terms.Notes)
+
+	c.coveredComponents = make([]valueobjects.ComponentID, 0, len(terms.CoveredComponentIDs))
+	for _, id := range terms.CoveredComponentIDs {
+		componentID, err := valueobjects.NewComponentIDFromString(id)
+		if err != nil {
+			continue
+		}
+		c.coveredComponents = append(c.coveredComponents, componentID)
+	}
+}
+
+func (c *VendorContract) VendorID() valueobjects.VendorID {
+	return c.vendorID
+}
+
+func (c *VendorContract) Name() valueobjects.EntityName {
+	return c.name
+}
+
+func (c *VendorContract) Term() valueobjects.ContractTerm {
+	return c.term
+}
+
+func (c *VendorContract) NoticePeriod() valueobjects.NoticePeriod {
+	return c.noticePeriod
+}
+
+func (c *VendorContract) NoticeDeadline() time.Time {
+	return c.noticeDeadline
+}
+
+func (c *VendorContract) Value() valueobjects.ContractValue {
+	return c.value
+}
+
+func (c *VendorContract) Owner() valueobjects.ContractOwner {
+	return c.owner
+}
+
+func (c *VendorContract) CoveredComponents() []valueobjects.ComponentID {
+	out := make([]valueobjects.ComponentID, len(c.coveredComponents))
+	copy(out, c.coveredComponents)
+	return out
+}
+
+func (c *VendorContract) Notes() valueobjects.Notes {
+	return c.notes
+}
+
+func (c *VendorContract) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *VendorContract) IsDeleted() bool {
+	return c.isDeleted
+}
@@ -0,0 +1,119 @@
+package aggregates
+
+import (
+	"testing"
+	"time"
+
+	"easi/backend/internal/architecturemodeling/domain/events"
+	"easi/backend/internal/architecturemodeling/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func contractDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func newContractDetails(t *testing.T, noticeDays int, components ...valueobjects.ComponentID) VendorContractDetails {
+	t.Helper()
+	return VendorContractDetails{
+		Name:              valueobjects.MustNewEntityName("Enterprise Agreement"),
+		Term:              valueobjects.MustNewContractTerm(contractDate(2025, 1, 1), contractDate(2027, 12, 31)),
+		NoticePeriod:      valueobjects.MustNewNoticePeriod(noticeDays),
+		Value:             valueobjects.MustNewContractValue(250000, "EUR"),
+		Owner:             valueobjects.MustNewContractOwner("Jane Procurement"),
+		CoveredComponents: components,
+		Notes:             valueobjects.MustNewNotes("Auto-renews for 12 months"),
+	}
+}
+
+func TestNewVendorContract(t *testing.T) {
+	vendorID := valueobjects.NewVendorID()
+	componentID := newComponentID(t)
+
+	contract, err := NewVendorContract(vendorID, newContractDetails(t, 90, componentID))
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, contract.ID())
+	assert.Equal(t, vendorID.Value(), contract.VendorID().Value())
+	assert.Equal(t, "Enterprise Agreement", contract.Name().Value())
+	assert.Equal(t, 90, contract.NoticePeriod().Days())
+	assert.Equal(t, contractDate(2027, 10, 2), contract.NoticeDeadline())
+	assert.Equal(t, "EUR", contract.Value().Currency())
+	assert.Equal(t, "Jane Procurement", contract.Owner().Value())
+	require.Len(t, contract.CoveredComponents(), 1)
+	assert.Equal(t, componentID.Value(), contract.CoveredComponents()[0].Value())
+
+	uncommitted := contract.GetUncommittedChanges()
+	require.Len(t, uncommitted, 1)
+	assert.Equal(t, "VendorContractCreated", uncommitted[0].EventType())
+}
+
+func TestNewVendorContract_RejectsNoticePeriodLongerThanTerm(t *testing.T) {
+	details := newContractDetails(t, 90)
+	details.Term = valueobjects.MustNewContractTerm(contractDate(2025, 1, 1), contractDate(2025, 2, 1))
+
+	_, err := NewVendorContract(valueobjects.NewVendorID(), details)
+	assert.ErrorIs(t, err, valueobjects.ErrNoticeDeadlineBeforeStart)
+}
+
+func TestNewVendorContract_DeduplicatesCoveredComponents(t *testing.T) {
+	componentID := newComponentID(t)
+
+	contract, err := NewVendorContract(valueobjects.NewVendorID(), newContractDetails(t, 30, componentID, componentID))
+	require.NoError(t, err)
+
+	assert.Len(t, contract.CoveredComponents(), 1)
+}
+
+func TestVendorContract_Update(t *testing.T) {
+	contract, err := NewVendorContract(valueobjects.NewVendorID(), newContractDetails(t, 90))
+	require.NoError(t, err)
+	contract.MarkChangesAsCommitted()
+
+	err = contract.Update(newContractDetails(t, 180))
+	require.NoError(t, err)
+
+	assert.Equal(t, 180, contract.NoticePeriod().Days())
+	assert.Equal(t, contractDate(2027, 7, 4), contract.NoticeDeadline())
+
+	uncommitted := contract.GetUncommittedChanges()
+	require.Len(t, uncommitted, 1)
+	updated, ok := uncommitted[0].(events.VendorContractUpdated)
+	require.True(t, ok)
+	assert.Equal(t, contract.VendorID().Value(), updated.VendorID)
+}
+
+func TestVendorContract_UpdateAfterDeleteFails(t *testing.T) {
+	contract, err := NewVendorContract(valueobjects.NewVendorID(), newContractDetails(t, 90))
+	require.NoError(t, err)
+	require.NoError(t, contract.Delete())
+
+	err = contract.Update(newContractDetails(t, 30))
+	assert.ErrorIs(t, err, ErrVendorContractDeleted)
+}
+
+func TestVendorContract_DeleteTwiceFails(t *testing.T) {
+	contract, err := NewVendorContract(valueobjects.NewVendorID(), newContractDetails(t, 90))
+	require.NoError(t, err)
+	require.NoError(t, contract.Delete())
+
+	assert.ErrorIs(t, contract.Delete(), ErrVendorContractDeleted)
+	assert.Len(t, contract.GetUncommittedChanges(), 2, "no second deletion is recorded")
+}
+
+func TestVendorContract_LoadFromHistory(t *testing.T) {
+	original, err := NewVendorContract(valueobjects.NewVendorID(), newContractDetails(t, 90, newComponentID(t)))
+	require.NoError(t, err)
+	require.NoError(t, original.Delete())
+
+	loaded, err := LoadVendorContractFromHistory(original.GetUncommittedChanges())
+	require.NoError(t, err)
+
+	assert.Equal(t, original.ID(), loaded.ID())
+	assert.Equal(t, original.VendorID().Value(), loaded.VendorID().Value())
+	assert.Equal(t, original.NoticeDeadline(), loaded.NoticeDeadline())
+	assert.Len(t, loaded.CoveredComponents(), 1)
+	assert.True(t, loaded.IsDeleted())
+}
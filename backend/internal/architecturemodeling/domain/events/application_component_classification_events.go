@@ -0,0 +1,81 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// ApplicationComponentClassified records the component's value for a tenant-configured classification dimension
+type ApplicationComponentClassified struct {
+	domain.BaseEvent
+	ComponentID  string    `json:"componentId"`
+	DimensionID  string    `json:"dimensionId"`
+	Value        string    `json:"value"`
+	ClassifiedAt time.Time `json:"classifiedAt"`
+}
+
+func NewApplicationComponentClassified(componentID, dimensionID, value string) ApplicationComponentClassified {
+	return ApplicationComponentClassified{
+		BaseEvent:    domain.NewBaseEvent(componentID),
+		ComponentID:  componentID,
+		DimensionID:  dimensionID,
+		Value:        value,
+		ClassifiedAt: time.Now().UTC(),
+	}
+}
+
+func (e ApplicationComponentClassified) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ComponentID
+}
+
+func (e ApplicationComponentClassified) EventType() string {
+	return "ApplicationComponentClassified"
+}
+
+func (e ApplicationComponentClassified) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"componentId":  e.ComponentID,
+		"dimensionId":  e.DimensionID,
+		"value":        e.Value,
+		"classifiedAt": e.ClassifiedAt,
+	}
+}
+
+type ApplicationComponentClassificationCleared struct {
+	domain.BaseEvent
+	ComponentID string    `json:"componentId"`
+	DimensionID string    `json:"dimensionId"`
+	ClearedAt   time.Time `json:"clearedAt"`
+}
+
+func NewApplicationComponentClassificationCleared(componentID, dimensionID string) ApplicationComponentClassificationCleared {
+	return ApplicationComponentClassificationCleared{
+		BaseEvent:   domain.NewBaseEvent(componentID),
+		ComponentID: componentID,
+		DimensionID: dimensionID,
+		ClearedAt:   time.Now().UTC(),
+	}
+}
+
+func (e ApplicationComponentClassificationCleared) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ComponentID
+}
+
+func (e ApplicationComponentClassificationCleared) EventType() string {
+	return "ApplicationComponentClassificationCleared"
+}
+
+func (e ApplicationComponentClassificationCleared) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"componentId": e.ComponentID,
+		"dimensionId": e.DimensionID,
+		"clearedAt":   e.ClearedAt,
+	}
+}
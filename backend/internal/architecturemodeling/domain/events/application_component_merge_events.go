@@ -0,0 +1,188 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// ApplicationComponentMergedInto is raised on a duplicate component when its
+// content is moved onto a surviving component, just before it is deleted.
+type ApplicationComponentMergedInto struct {
+	domain.BaseEvent
+	ID           string    `json:"id"`
+	SurvivorID   string    `json:"survivorId"`
+	SurvivorName string    `json:"survivorName"`
+	MergedBy     string    `json:"mergedBy"`
+	MergedAt     time.Time `json:"mergedAt"`
+}
+
+func NewApplicationComponentMergedInto(id, survivorID, survivorName, mergedBy string) ApplicationComponentMergedInto {
+	return ApplicationComponentMergedInto{
+		BaseEvent:    domain.NewBaseEvent(id),
+		ID:           id,
+		SurvivorID:   survivorID,
+		SurvivorName: survivorName,
+		MergedBy:     mergedBy,
+		MergedAt:     time.Now().UTC(),
+	}
+}
+
+func (e ApplicationComponentMergedInto) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e ApplicationComponentMergedInto) EventType() string {
+	return "ApplicationComponentMergedInto"
+}
+
+func (e ApplicationComponentMergedInto) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":           e.ID,
+		"survivorId":   e.SurvivorID,
+		"survivorName": e.SurvivorName,
+		"mergedBy":     e.MergedBy,
+		"mergedAt":     e.MergedAt,
+	}
+}
+
+// ApplicationComponentDuplicateAbsorbed is raised on the surviving component of a merge.
+type ApplicationComponentDuplicateAbsorbed struct {
+	domain.BaseEvent
+	ID            string    `json:"id"`
+	DuplicateID   string    `json:"duplicateId"`
+	DuplicateName string    `json:"duplicateName"`
+	MergedBy      string    `json:"mergedBy"`
+	MergedAt      time.Time `json:"mergedAt"`
+}
+
+func NewApplicationComponentDuplicateAbsorbed(id, duplicateID, duplicateName, mergedBy string) ApplicationComponentDuplicateAbsorbed {
+	return ApplicationComponentDuplicateAbsorbed{
+		BaseEvent:     domain.NewBaseEvent(id),
+		ID:            id,
+		DuplicateID:   duplicateID,
+		DuplicateName: duplicateName,
+		MergedBy:      mergedBy,
+		MergedAt:      time.Now().UTC(),
+	}
+}
+
+func (e ApplicationComponentDuplicateAbsorbed) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e ApplicationComponentDuplicateAbsorbed) EventType() string {
+	return "ApplicationComponentDuplicateAbsorbed"
+}
+
+func (e ApplicationComponentDuplicateAbsorbed) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":            e.ID,
+		"duplicateId":   e.DuplicateID,
+		"duplicateName": e.DuplicateName,
+		"mergedBy":      e.MergedBy,
+		"mergedAt":      e.MergedAt,
+	}
+}
+
+// ApplicationComponentRelationMoved is raised on both components of a merge for
+// each of the duplicate's relations. NewRelationID is empty when the relation was
+// dropped because the survivor already had it or it would have pointed at itself.
+type ApplicationComponentRelationMoved struct {
+	domain.BaseEvent
+	ID              string    `json:"id"`
+	RelationID      string    `json:"relationId"`
+	NewRelationID   string    `json:"newRelationId,omitempty"`
+	FromComponentID string    `json:"fromComponentId"`
+	ToComponentID   string    `json:"toComponentId"`
+	MergedBy        string    `json:"mergedBy"`
+	MovedAt         time.Time `json:"movedAt"`
+}
+
+func NewApplicationComponentRelationMoved(id, relationID, newRelationID, fromComponentID, toComponentID, mergedBy string) ApplicationComponentRelationMoved {
+	return ApplicationComponentRelationMoved{
+		BaseEvent:       domain.NewBaseEvent(id),
+		ID:              id,
+		RelationID:      relationID,
+		NewRelationID:   newRelationID,
+		FromComponentID: fromComponentID,
+		ToComponentID:   toComponentID,
+		MergedBy:        mergedBy,
+		MovedAt:         time.Now().UTC(),
+	}
+}
+
+func (e ApplicationComponentRelationMoved) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e ApplicationComponentRelationMoved) EventType() string {
+	return "ApplicationComponentRelationMoved"
+}
+
+func (e ApplicationComponentRelationMoved) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":              e.ID,
+		"relationId":      e.RelationID,
+		"newRelationId":   e.NewRelationID,
+		"fromComponentId": e.FromComponentID,
+		"toComponentId":   e.ToComponentID,
+		"mergedBy":        e.MergedBy,
+		"movedAt":         e.MovedAt,
+	}
+}
+
+// ApplicationComponentChildMoved is raised on both components of a merge for each
+// child component re-parented from the duplicate onto the survivor.
+type ApplicationComponentChildMoved struct {
+	domain.BaseEvent
+	ID              string    `json:"id"`
+	ChildID         string    `json:"childId"`
+	FromComponentID string    `json:"fromComponentId"`
+	ToComponentID   string    `json:"toComponentId"`
+	MergedBy        string    `json:"mergedBy"`
+	MovedAt         time.Time `json:"movedAt"`
+}
+
+func NewApplicationComponentChildMoved(id, childID, fromComponentID, toComponentID, mergedBy string) ApplicationComponentChildMoved {
+	return ApplicationComponentChildMoved{
+		BaseEvent:       domain.NewBaseEvent(id),
+		ID:              id,
+		ChildID:         childID,
+		FromComponentID: fromComponentID,
+		ToComponentID:   toComponentID,
+		MergedBy:        mergedBy,
+		MovedAt:         time.Now().UTC(),
+	}
+}
+
+func (e ApplicationComponentChildMoved) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e ApplicationComponentChildMoved) EventType() string {
+	return "ApplicationComponentChildMoved"
+}
+
+func (e ApplicationComponentChildMoved) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":              e.ID,
+		"childId":         e.ChildID,
+		"fromComponentId": e.FromComponentID,
+		"toComponentId":   e.ToComponentID,
+		"mergedBy":        e.MergedBy,
+		"movedAt":         e.MovedAt,
+	}
+}
@@ -0,0 +1,45 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type ApplicationComponentParentChanged struct {
+	domain.BaseEvent
+	ID          string    `json:"id"`
+	OldParentID string    `json:"oldParentId"`
+	NewParentID string    `json:"newParentId"`
+	ChangedAt   time.Time `json:"changedAt"`
+}
+
+func (e ApplicationComponentParentChanged) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func NewApplicationComponentParentChanged(id, oldParentID, newParentID string) ApplicationComponentParentChanged {
+	return ApplicationComponentParentChanged{
+		BaseEvent:   domain.NewBaseEvent(id),
+		ID:          id,
+		OldParentID: oldParentID,
+		NewParentID: newParentID,
+		ChangedAt:   time.Now().UTC(),
+	}
+}
+
+func (e ApplicationComponentParentChanged) EventType() string {
+	return "ApplicationComponentParentChanged"
+}
+
+func (e ApplicationComponentParentChanged) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          e.ID,
+		"oldParentId": e.OldParentID,
+		"newParentId": e.NewParentID,
+		"changedAt":   e.ChangedAt,
+	}
+}
@@ -0,0 +1,77 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type ApplicationComponentTagAdded struct {
+	domain.BaseEvent
+	ComponentID string    `json:"componentId"`
+	Tag         string    `json:"tag"`
+	AddedAt     time.Time `json:"addedAt"`
+}
+
+func NewApplicationComponentTagAdded(componentID, tag string) ApplicationComponentTagAdded {
+	return ApplicationComponentTagAdded{
+		BaseEvent:   domain.NewBaseEvent(componentID),
+		ComponentID: componentID,
+		Tag:         tag,
+		AddedAt:     time.Now().UTC(),
+	}
+}
+
+func (e ApplicationComponentTagAdded) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ComponentID
+}
+
+func (e ApplicationComponentTagAdded) EventType() string {
+	return "ApplicationComponentTagAdded"
+}
+
+func (e ApplicationComponentTagAdded) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"componentId": e.ComponentID,
+		"tag":         e.Tag,
+		"addedAt":     e.AddedAt,
+	}
+}
+
+type ApplicationComponentTagRemoved struct {
+	domain.BaseEvent
+	ComponentID string    `json:"componentId"`
+	Tag         string    `json:"tag"`
+	RemovedAt   time.Time `json:"removedAt"`
+}
+
+func NewApplicationComponentTagRemoved(componentID, tag string) ApplicationComponentTagRemoved {
+	return ApplicationComponentTagRemoved{
+		BaseEvent:   domain.NewBaseEvent(componentID),
+		ComponentID: componentID,
+		Tag:         tag,
+		RemovedAt:   time.Now().UTC(),
+	}
+}
+
+func (e ApplicationComponentTagRemoved) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ComponentID
+}
+
+func (e ApplicationComponentTagRemoved) EventType() string {
+	return "ApplicationComponentTagRemoved"
+}
+
+func (e ApplicationComponentTagRemoved) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"componentId": e.ComponentID,
+		"tag":         e.Tag,
+		"removedAt":   e.RemovedAt,
+	}
+}
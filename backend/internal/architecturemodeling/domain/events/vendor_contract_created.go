@@ -0,0 +1,44 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type VendorContractCreated struct {
+	domain.BaseEvent
+	VendorContractTerms
+	ID        string    `json:"id"`
+	VendorID  string    `json:"vendorId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (e VendorContractCreated) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func NewVendorContractCreated(id, vendorID string, terms VendorContractTerms) VendorContractCreated {
+	return VendorContractCreated{
+		BaseEvent:           domain.NewBaseEvent(id),
+		VendorContractTerms: terms,
+		ID:                  id,
+		VendorID:            vendorID,
+		CreatedAt:           time.Now().UTC(),
+	}
+}
+
+func (e VendorContractCreated) EventType() string {
+	return "VendorContractCreated"
+}
+
+func (e VendorContractCreated) EventData() map[string]interface{} {
+	data := e.VendorContractTerms.eventData()
+	data["id"] = e.ID
+	data["vendorId"] = e.VendorID
+	data["createdAt"] = e.CreatedAt
+	return data
+}
@@ -0,0 +1,45 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type VendorContractDeleted struct {
+	domain.BaseEvent
+	ID        string    `json:"id"`
+	VendorID  string    `json:"vendorId"`
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+func (e VendorContractDeleted) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func NewVendorContractDeleted(id, vendorID, name string) VendorContractDeleted {
+	return VendorContractDeleted{
+		BaseEvent: domain.NewBaseEvent(id),
+		ID:        id,
+		VendorID:  vendorID,
+		Name:      name,
+		DeletedAt: time.Now().UTC(),
+	}
+}
+
+func (e VendorContractDeleted) EventType() string {
+	return "VendorContractDeleted"
+}
+
+func (e VendorContractDeleted) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        e.ID,
+		"vendorId":  e.VendorID,
+		"name":      e.Name,
+		"deletedAt": e.DeletedAt,
+	}
+}
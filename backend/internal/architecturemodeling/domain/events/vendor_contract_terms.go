@@ -0,0 +1,31 @@
+package events
+
+import "time"
+
+type VendorContractTerms struct {
+	Name                string    `json:"name"`
+	StartDate           time.Time `json:"startDate"`
+	EndDate             time.Time `json:"endDate"`
+	NoticePeriodDays    int       `json:"noticePeriodDays"`
+	NoticeDeadline      time.Time `json:"noticeDeadline"`
+	ValueAmount         float64   `json:"valueAmount"`
+	ValueCurrency       string    `json:"valueCurrency"`
+	Owner               string    `json:"owner"`
+	CoveredComponentIDs []string  `json:"coveredComponentIds"`
+	Notes               string    `json:"notes"`
+}
+
+func (t VendorContractTerms) eventData() map[string]interface{} {
+	return map[string]interface{}{
+		"name":                t.Name,
+		"startDate":           t.StartDate,
+		"endDate":             t.EndDate,
+		"noticePeriodDays":    t.NoticePeriodDays,
+		"noticeDeadline":      t.NoticeDeadline,
+		"valueAmount":         t.ValueAmount,
+		"valueCurrency":       t.ValueCurrency,
+		"owner":               t.Owner,
+		"coveredComponentIds": t.CoveredComponentIDs,
+		"notes":               t.Notes,
+	}
+}
@@ -0,0 +1,44 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type VendorContractUpdated struct {
+	domain.BaseEvent
+	VendorContractTerms
+	ID        string    `json:"id"`
+	VendorID  string    `json:"vendorId"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (e VendorContractUpdated) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func NewVendorContractUpdated(id, vendorID string, terms VendorContractTerms) VendorContractUpdated {
+	return VendorContractUpdated{
+		BaseEvent:           domain.NewBaseEvent(id),
+		VendorContractTerms: terms,
+		ID:                  id,
+		VendorID:            vendorID,
+		UpdatedAt:           time.Now().UTC(),
+	}
+}
+
+func (e VendorContractUpdated) EventType() string {
+	return "VendorContractUpdated"
+}
+
+func (e VendorContractUpdated) EventData() map[string]interface{} {
+	data := e.VendorContractTerms.eventData()
+	data["id"] = e.ID
+	data["vendorId"] = e.VendorID
+	data["updatedAt"] = e.UpdatedAt
+	return data
+}
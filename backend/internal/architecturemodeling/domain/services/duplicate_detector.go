@@ -0,0 +1,194 @@
+package services
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	DefaultDuplicateMinScore = 0.5
+
+	DuplicateReasonSimilarName = "similar-name"
+	DuplicateReasonSameVendor  = "same-vendor"
+	DuplicateReasonSameTeam    = "same-team"
+
+	sharedOriginWeight    = 0.25
+	prefixMatchSimilarity = 0.8
+	minPrefixLength       = 4
+	similarNameThreshold  = 0.3
+)
+
+// ComponentProfile is what the detector knows about a component: its name and
+// the vendors and internal teams it originates from.
+type ComponentProfile struct {
+	ID        string
+	Name      string
+	VendorIDs []string
+	TeamIDs   []string
+}
+
+type DuplicateCandidate struct {
+	First          ComponentProfile
+	Second         ComponentProfile
+	NameSimilarity float64
+	Score          float64
+	Reasons        []string
+}
+
+type DuplicateDetector struct {
+	minScore float64
+}
+
+func NewDuplicateDetector(minScore float64) *DuplicateDetector {
+	return &DuplicateDetector{minScore: minScore}
+}
+
+type profileFingerprint struct {
+	profile  ComponentProfile
+	compact  string
+	trigrams map[string]struct{}
+	vendors  map[string]struct{}
+	teams    map[string]struct{}
+}
+
+// FindCandidates compares every pair of components and returns the pairs scoring
+// at least the detector's minimum, highest score first.
+func (d *DuplicateDetector) FindCandidates(profiles []ComponentProfile) []DuplicateCandidate {
+	fingerprints := make([]profileFingerprint, len(profiles))
+	for i, p := range profiles {
+		compact := normalizeName(p.Name)
+		fingerprints[i] = profileFingerprint{
+			profile:  p,
+			compact:  compact,
+			trigrams: trigrams(compact),
+			vendors:  toSet(p.VendorIDs),
+			teams:    toSet(p.TeamIDs),
+		}
+	}
+
+	var candidates []DuplicateCandidate
+	for i := 0; i < len(fingerprints); i++ {
+		for j := i + 1; j < len(fingerprints); j++ {
+			candidate := d.compare(fingerprints[i], fingerprints[j])
+			if candidate.Score >= d.minScore {
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].First.Name < candidates[j].First.Name
+	})
+	return candidates
+}
+
+func (d *DuplicateDetector) compare(a, b profileFingerprint) DuplicateCandidate {
+	similarity := compactNameSimilarity(a, b)
+	candidate := DuplicateCandidate{
+		First:          a.profile,
+		Second:         b.profile,
+		NameSimilarity: similarity,
+		Score:          similarity,
+	}
+	if similarity >= similarNameThreshold {
+		candidate.Reasons = append(candidate.Reasons, DuplicateReasonSimilarName)
+	}
+	if intersects(a.vendors, b.vendors) {
+		candidate.Score += sharedOriginWeight
+		candidate.Reasons = append(candidate.Reasons, DuplicateReasonSameVendor)
+	}
+	if intersects(a.teams, b.teams) {
+		candidate.Score += sharedOriginWeight
+		candidate.Reasons = append(candidate.Reasons, DuplicateReasonSameTeam)
+	}
+	if candidate.Score > 1 {
+		candidate.Score = 1
+	}
+	return candidate
+}
+
+// NameSimilarity scores two component names between 0 and 1 using trigram overlap
+// on the lowercased alphanumeric characters, so "SalesForce CRM" and "Salesforce"
+// are close while unrelated names score near zero.
+func NameSimilarity(a, b string) float64 {
+	ca, cb := normalizeName(a), normalizeName(b)
+	return compactNameSimilarity(
+		profileFingerprint{compact: ca, trigrams: trigrams(ca)},
+		profileFingerprint{compact: cb, trigrams: trigrams(cb)},
+	)
+}
+
+func compactNameSimilarity(a, b profileFingerprint) float64 {
+	if a.compact == "" || b.compact == "" {
+		return 0
+	}
+	if a.compact == b.compact {
+		return 1
+	}
+	similarity := jaccard(a.trigrams, b.trigrams)
+	if isPrefixMatch(a.compact, b.compact) && similarity < prefixMatchSimilarity {
+		return prefixMatchSimilarity
+	}
+	return similarity
+}
+
+func isPrefixMatch(a, b string) bool {
+	shorter, longer := a, b
+	if len(shorter) > len(longer) {
+		shorter, longer = longer, shorter
+	}
+	return len(shorter) >= minPrefixLength && strings.HasPrefix(longer, shorter)
+}
+
+func normalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func trigrams(compact string) map[string]struct{} {
+	padded := []rune("  " + compact + " ")
+	result := make(map[string]struct{}, len(padded))
+	for i := 0; i+3 <= len(padded); i++ {
+		result[string(padded[i:i+3])] = struct{}{}
+	}
+	return result
+}
+
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for key := range a {
+		if _, ok := b[key]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+func intersects(a, b map[string]struct{}) bool {
+	for key := range a {
+		if _, ok := b[key]; ok {
+			return true
+		}
+	}
+	return false
+}
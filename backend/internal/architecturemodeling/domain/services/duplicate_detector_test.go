@@ -0,0 +1,86 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameSimilarity(t *testing.T) {
+	testCases := []struct {
+		name    string
+		a, b    string
+		atLeast float64
+		below   float64
+	}{
+		{name: "case and spacing differences are identical", a: "SalesForce", b: "sales force", atLeast: 1, below: 1.01},
+		{name: "suffix on a shared prefix", a: "Salesforce", b: "SalesForce CRM", atLeast: 0.8, below: 1},
+		{name: "typo", a: "Workday", b: "Workdya", atLeast: 0.3, below: 1},
+		{name: "unrelated names", a: "SAP ERP", b: "Confluence", atLeast: 0, below: 0.2},
+		{name: "empty name", a: "", b: "Jira", atLeast: 0, below: 0.01},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			similarity := NameSimilarity(tc.a, tc.b)
+			assert.GreaterOrEqual(t, similarity, tc.atLeast)
+			assert.Less(t, similarity, tc.below)
+		})
+	}
+}
+
+func TestFindCandidates_NameMatch(t *testing.T) {
+	detector := NewDuplicateDetector(DefaultDuplicateMinScore)
+
+	candidates := detector.FindCandidates([]ComponentProfile{
+		{ID: "1", Name: "Salesforce"},
+		{ID: "2", Name: "SalesForce CRM"},
+		{ID: "3", Name: "Confluence"},
+	})
+
+	require.Len(t, candidates, 1)
+	assert.Equal(t, "1", candidates[0].First.ID)
+	assert.Equal(t, "2", candidates[0].Second.ID)
+	assert.Equal(t, []string{DuplicateReasonSimilarName}, candidates[0].Reasons)
+}
+
+func TestFindCandidates_SharedOriginsLiftDissimilarNames(t *testing.T) {
+	detector := NewDuplicateDetector(DefaultDuplicateMinScore)
+
+	candidates := detector.FindCandidates([]ComponentProfile{
+		{ID: "1", Name: "Salesforce", VendorIDs: []string{"v-sf"}, TeamIDs: []string{"t-crm"}},
+		{ID: "2", Name: "SFDC", VendorIDs: []string{"v-sf"}, TeamIDs: []string{"t-crm"}},
+	})
+
+	require.Len(t, candidates, 1)
+	assert.Equal(t, []string{DuplicateReasonSameVendor, DuplicateReasonSameTeam}, candidates[0].Reasons)
+	assert.GreaterOrEqual(t, candidates[0].Score, 0.5)
+}
+
+func TestFindCandidates_SharedVendorAloneIsNotEnough(t *testing.T) {
+	detector := NewDuplicateDetector(DefaultDuplicateMinScore)
+
+	candidates := detector.FindCandidates([]ComponentProfile{
+		{ID: "1", Name: "Outlook", VendorIDs: []string{"v-ms"}},
+		{ID: "2", Name: "Teams", VendorIDs: []string{"v-ms"}},
+	})
+
+	assert.Empty(t, candidates)
+}
+
+func TestFindCandidates_SortedByScoreAndCapped(t *testing.T) {
+	detector := NewDuplicateDetector(0.1)
+
+	candidates := detector.FindCandidates([]ComponentProfile{
+		{ID: "1", Name: "Jira", VendorIDs: []string{"v"}, TeamIDs: []string{"t"}},
+		{ID: "2", Name: "JIRA", VendorIDs: []string{"v"}, TeamIDs: []string{"t"}},
+		{ID: "3", Name: "Jira Service Desk"},
+	})
+
+	require.NotEmpty(t, candidates)
+	assert.Equal(t, 1.0, candidates[0].Score)
+	for i := 1; i < len(candidates); i++ {
+		assert.GreaterOrEqual(t, candidates[i-1].Score, candidates[i].Score)
+	}
+}
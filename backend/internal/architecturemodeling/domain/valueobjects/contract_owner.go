@@ -0,0 +1,53 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const MaxContractOwnerLength = 100
+
+var (
+	ErrContractOwnerRequired = errors.New("contract owner is required")
+	ErrContractOwnerTooLong  = errors.New("contract owner exceeds maximum length of 100 characters")
+)
+
+type ContractOwner struct {
+	value string
+}
+
+func NewContractOwner(value string) (ContractOwner, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return ContractOwner{}, ErrContractOwnerRequired
+	}
+	if len(trimmed) > MaxContractOwnerLength {
+		return ContractOwner{}, ErrContractOwnerTooLong
+	}
+	return ContractOwner{value: trimmed}, nil
+}
+
+func MustNewContractOwner(value string) ContractOwner {
+	owner, err := NewContractOwner(value)
+	if err != nil {
+		panic(err)
+	}
+	return owner
+}
+
+func (o ContractOwner) Value() string {
+	return o.value
+}
+
+func (o ContractOwner) Equals(other domain.ValueObject) bool {
+	if otherOwner, ok := other.(ContractOwner); ok {
+		return o.value == otherOwner.value
+	}
+	return false
+}
+
+func (o ContractOwner) String() string {
+	return o.value
+}
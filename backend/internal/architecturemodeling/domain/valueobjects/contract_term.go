@@ -0,0 +1,71 @@
+package valueobjects
+
+import (
+	"errors"
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var (
+	ErrContractStartDateRequired = errors.New("contract start date is required")
+	ErrContractEndDateRequired   = errors.New("contract end date is required")
+	ErrContractEndBeforeStart    = errors.New("contract end date must be after its start date")
+	ErrNoticeDeadlineBeforeStart = errors.New("notice period is longer than the contract term: the notice deadline falls before the contract start date")
+)
+
+type ContractTerm struct {
+	startDate time.Time
+	endDate   time.Time
+}
+
+func NewContractTerm(startDate, endDate time.Time) (ContractTerm, error) {
+	if startDate.IsZero() {
+		return ContractTerm{}, ErrContractStartDateRequired
+	}
+	if endDate.IsZero() {
+		return ContractTerm{}, ErrContractEndDateRequired
+	}
+	start := truncateToDate(startDate)
+	end := truncateToDate(endDate)
+	if !end.After(start) {
+		return ContractTerm{}, ErrContractEndBeforeStart
+	}
+	return ContractTerm{startDate: start, endDate: end}, nil
+}
+
+func MustNewContractTerm(startDate, endDate time.Time) ContractTerm {
+	term, err := NewContractTerm(startDate, endDate)
+	if err != nil {
+		panic(err)
+	}
+	return term
+}
+
+func (t ContractTerm) StartDate() time.Time {
+	return t.startDate
+}
+
+func (t ContractTerm) EndDate() time.Time {
+	return t.endDate
+}
+
+func (t ContractTerm) NoticeDeadline(period NoticePeriod) (time.Time, error) {
+	deadline := period.DeadlineBefore(t.endDate)
+	if deadline.Before(t.startDate) {
+		return time.Time{}, ErrNoticeDeadlineBeforeStart
+	}
+	return deadline, nil
+}
+
+func (t ContractTerm) Equals(other domain.ValueObject) bool {
+	if otherTerm, ok := other.(ContractTerm); ok {
+		return t.startDate.Equal(otherTerm.startDate) && t.endDate.Equal(otherTerm.endDate)
+	}
+	return false
+}
+
+func truncateToDate(t time.Time) time.Time {
+	utc := t.UTC()
+	return time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+}
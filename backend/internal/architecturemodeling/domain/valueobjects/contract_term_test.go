@@ -0,0 +1,122 @@
+package valueobjects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNewContractTerm(t *testing.T) {
+	cases := []struct {
+		name    string
+		start   time.Time
+		end     time.Time
+		wantErr error
+	}{
+		{name: "valid term", start: date(2025, 1, 1), end: date(2027, 12, 31)},
+		{name: "missing start", end: date(2027, 12, 31), wantErr: ErrContractStartDateRequired},
+		{name: "missing end", start: date(2025, 1, 1), wantErr: ErrContractEndDateRequired},
+		{name: "end equals start", start: date(2025, 1, 1), end: date(2025, 1, 1), wantErr: ErrContractEndBeforeStart},
+		{name: "end before start", start: date(2025, 6, 1), end: date(2025, 1, 1), wantErr: ErrContractEndBeforeStart},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewContractTerm(tc.start, tc.end)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestContractTerm_TruncatesToDate(t *testing.T) {
+	term, err := NewContractTerm(
+		time.Date(2025, 1, 1, 15, 30, 0, 0, time.UTC),
+		time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, date(2025, 1, 1), term.StartDate())
+	assert.Equal(t, date(2026, 1, 1), term.EndDate())
+}
+
+func TestContractTerm_NoticeDeadline(t *testing.T) {
+	term := MustNewContractTerm(date(2025, 1, 1), date(2026, 12, 31))
+
+	deadline, err := term.NoticeDeadline(MustNewNoticePeriod(90))
+	require.NoError(t, err)
+	assert.Equal(t, date(2026, 10, 2), deadline)
+}
+
+func TestContractTerm_NoticeDeadlineBeforeStart(t *testing.T) {
+	term := MustNewContractTerm(date(2025, 1, 1), date(2025, 3, 1))
+
+	_, err := term.NoticeDeadline(MustNewNoticePeriod(180))
+	assert.ErrorIs(t, err, ErrNoticeDeadlineBeforeStart)
+}
+
+func TestNewNoticePeriod(t *testing.T) {
+	cases := []struct {
+		name    string
+		days    int
+		wantErr error
+	}{
+		{name: "valid", days: 90},
+		{name: "maximum", days: MaxNoticePeriodDays},
+		{name: "zero is treated as missing", days: 0, wantErr: ErrNoticePeriodRequired},
+		{name: "negative", days: -30, wantErr: ErrNoticePeriodRequired},
+		{name: "too long", days: MaxNoticePeriodDays + 1, wantErr: ErrNoticePeriodTooLong},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			period, err := NewNoticePeriod(tc.days)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.days, period.Days())
+		})
+	}
+}
+
+func TestNewContractValue(t *testing.T) {
+	cases := []struct {
+		name         string
+		amount       float64
+		currency     string
+		wantCurrency string
+		wantEmpty    bool
+		wantErr      error
+	}{
+		{name: "amount with currency", amount: 120000, currency: "EUR", wantCurrency: "EUR"},
+		{name: "lowercase currency is normalised", amount: 500, currency: " dkk ", wantCurrency: "DKK"},
+		{name: "no value", amount: 0, currency: "", wantEmpty: true},
+		{name: "amount without currency", amount: 10, currency: "", wantErr: ErrContractCurrencyRequired},
+		{name: "negative amount", amount: -1, currency: "EUR", wantErr: ErrContractValueNegative},
+		{name: "invalid currency", amount: 10, currency: "EURO", wantErr: ErrInvalidContractCurrency},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := NewContractValue(tc.amount, tc.currency)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCurrency, value.Currency())
+			assert.Equal(t, tc.wantEmpty, value.IsEmpty())
+		})
+	}
+}
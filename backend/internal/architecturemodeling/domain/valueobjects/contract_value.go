@@ -0,0 +1,66 @@
+package valueobjects
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var (
+	ErrContractValueNegative    = errors.New("contract value cannot be negative")
+	ErrContractCurrencyRequired = errors.New("contract value requires a currency")
+	ErrInvalidContractCurrency  = errors.New("currency must be a three-letter ISO 4217 code")
+)
+
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+type ContractValue struct {
+	amount   float64
+	currency string
+}
+
+func NewContractValue(amount float64, currency string) (ContractValue, error) {
+	if amount < 0 {
+		return ContractValue{}, ErrContractValueNegative
+	}
+	code := strings.ToUpper(strings.TrimSpace(currency))
+	if code == "" {
+		if amount > 0 {
+			return ContractValue{}, ErrContractCurrencyRequired
+		}
+		return ContractValue{}, nil
+	}
+	if !currencyCodePattern.MatchString(code) {
+		return ContractValue{}, ErrInvalidContractCurrency
+	}
+	return ContractValue{amount: amount, currency: code}, nil
+}
+
+func MustNewContractValue(amount float64, currency string) ContractValue {
+	value, err := NewContractValue(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func (v ContractValue) Amount() float64 {
+	return v.amount
+}
+
+func (v ContractValue) Currency() string {
+	return v.currency
+}
+
+func (v ContractValue) IsEmpty() bool {
+	return v.currency == ""
+}
+
+func (v ContractValue) Equals(other domain.ValueObject) bool {
+	if otherValue, ok := other.(ContractValue); ok {
+		return v.amount == otherValue.amount && v.currency == otherValue.currency
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package valueobjects
+
+import (
+	"errors"
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const MaxNoticePeriodDays = 3650
+
+var (
+	ErrNoticePeriodRequired = errors.New("notice period is required and must be at least one day")
+	ErrNoticePeriodTooLong  = errors.New("notice period exceeds maximum of 3650 days")
+)
+
+type NoticePeriod struct {
+	days int
+}
+
+func NewNoticePeriod(days int) (NoticePeriod, error) {
+	if days < 1 {
+		return NoticePeriod{}, ErrNoticePeriodRequired
+	}
+	if days > MaxNoticePeriodDays {
+		return NoticePeriod{}, ErrNoticePeriodTooLong
+	}
+	return NoticePeriod{days: days}, nil
+}
+
+func MustNewNoticePeriod(days int) NoticePeriod {
+	period, err := NewNoticePeriod(days)
+	if err != nil {
+		panic(err)
+	}
+	return period
+}
+
+func (n NoticePeriod) Days() int {
+	return n.days
+}
+
+func (n NoticePeriod) DeadlineBefore(date time.Time) time.Time {
+	return date.AddDate(0, 0, -n.days)
+}
+
+func (n NoticePeriod) Equals(other domain.ValueObject) bool {
+	if otherPeriod, ok := other.(NoticePeriod); ok {
+		return n.days == otherPeriod.days
+	}
+	return false
+}
@@ -2,12 +2,14 @@ package valueobjects
 
 import (
 	domain "easi/backend/internal/shared/eventsourcing"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
 	"errors"
+	"strings"
 )
 
 var (
 	// ErrInvalidRelationType is returned when relation type is not valid
-	ErrInvalidRelationType = errors.New("relation type must be either 'Triggers' or 'Serves'")
+	ErrInvalidRelationType = errors.New("relation type must be a built-in type (Triggers, Serves, Flow, AccessRead, AccessWrite, AccessReadWrite, Composition, Aggregation) or a custom type reference")
 )
 
 // RelationType represents the type of relation between components
@@ -19,18 +21,91 @@ const (
 
 	// RelationTypeServes indicates source provides services to target
 	RelationTypeServes RelationType = "Serves"
+
+	// RelationTypeFlow indicates information or value flows from source to target
+	RelationTypeFlow RelationType = "Flow"
+
+	// RelationTypeAccessRead indicates source reads data held by target
+	RelationTypeAccessRead RelationType = "AccessRead"
+
+	// RelationTypeAccessWrite indicates source writes data held by target
+	RelationTypeAccessWrite RelationType = "AccessWrite"
+
+	// RelationTypeAccessReadWrite indicates source both reads and writes data held by target
+	RelationTypeAccessReadWrite RelationType = "AccessReadWrite"
+
+	// RelationTypeComposition indicates target is an inseparable part of source
+	RelationTypeComposition RelationType = "Composition"
+
+	// RelationTypeAggregation indicates target is grouped under source but can exist on its own
+	RelationTypeAggregation RelationType = "Aggregation"
 )
 
-// NewRelationType creates a new relation type with validation
+// CustomRelationTypePrefix marks a relation type defined in the tenant's meta-model
+const CustomRelationTypePrefix = "custom:"
+
+var builtInRelationTypes = []RelationType{
+	RelationTypeTriggers,
+	RelationTypeServes,
+	RelationTypeFlow,
+	RelationTypeAccessRead,
+	RelationTypeAccessWrite,
+	RelationTypeAccessReadWrite,
+	RelationTypeComposition,
+	RelationTypeAggregation,
+}
+
+// BuiltInRelationTypes returns the ArchiMate-aligned relation types available to every tenant
+func BuiltInRelationTypes() []RelationType {
+	result := make([]RelationType, len(builtInRelationTypes))
+	copy(result, builtInRelationTypes)
+	return result
+}
+
+// NewRelationType creates a new relation type with validation.
+// Custom types are accepted in the form "custom:<uuid>"; whether the referenced
+// type exists for the tenant is checked by the application layer.
 func NewRelationType(value string) (RelationType, error) {
 	rt := RelationType(value)
-
-	switch rt {
-	case RelationTypeTriggers, RelationTypeServes:
+	if rt.IsBuiltIn() {
 		return rt, nil
-	default:
+	}
+	if strings.HasPrefix(value, CustomRelationTypePrefix) {
+		return NewCustomRelationType(strings.TrimPrefix(value, CustomRelationTypePrefix))
+	}
+	return "", ErrInvalidRelationType
+}
+
+// NewCustomRelationType creates a relation type referencing a tenant-defined type
+func NewCustomRelationType(customTypeID string) (RelationType, error) {
+	id, err := sharedvo.NewUUIDValueFromString(customTypeID)
+	if err != nil {
 		return "", ErrInvalidRelationType
 	}
+	return RelationType(CustomRelationTypePrefix + id.Value()), nil
+}
+
+// IsBuiltIn reports whether the relation type is one of the built-in types
+func (r RelationType) IsBuiltIn() bool {
+	for _, builtIn := range builtInRelationTypes {
+		if r == builtIn {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCustom reports whether the relation type references a tenant-defined type
+func (r RelationType) IsCustom() bool {
+	return strings.HasPrefix(string(r), CustomRelationTypePrefix)
+}
+
+// CustomTypeID returns the referenced custom type ID, or an empty string for built-in types
+func (r RelationType) CustomTypeID() string {
+	if !r.IsCustom() {
+		return ""
+	}
+	return strings.TrimPrefix(string(r), CustomRelationTypePrefix)
 }
 
 // Value returns the string value of the relation type
@@ -32,3 +32,39 @@ func TestRelationType_Equals(t *testing.T) {
 	assert.True(t, triggers1.Equals(triggers2))
 	assert.False(t, triggers1.Equals(serves))
 }
+
+func TestNewRelationType_BuiltInTypes(t *testing.T) {
+	for _, value := range []string{"Flow", "AccessRead", "AccessWrite", "AccessReadWrite", "Composition", "Aggregation"} {
+		t.Run(value, func(t *testing.T) {
+			rt, err := NewRelationType(value)
+			assert.NoError(t, err)
+			assert.True(t, rt.IsBuiltIn())
+			assert.False(t, rt.IsCustom())
+			assert.Empty(t, rt.CustomTypeID())
+		})
+	}
+}
+
+func TestNewRelationType_IsCaseSensitive(t *testing.T) {
+	_, err := NewRelationType("flow")
+	assert.Equal(t, ErrInvalidRelationType, err)
+}
+
+func TestNewRelationType_CustomReference(t *testing.T) {
+	rt, err := NewRelationType("custom:3f1c2a9e-8d4b-4c6a-9f0e-1b2c3d4e5f60")
+	assert.NoError(t, err)
+	assert.True(t, rt.IsCustom())
+	assert.False(t, rt.IsBuiltIn())
+	assert.Equal(t, "3f1c2a9e-8d4b-4c6a-9f0e-1b2c3d4e5f60", rt.CustomTypeID())
+}
+
+func TestNewRelationType_CustomReferenceRequiresUUID(t *testing.T) {
+	_, err := NewRelationType("custom:replicates")
+	assert.Equal(t, ErrInvalidRelationType, err)
+}
+
+func TestBuiltInRelationTypes_ReturnsCopy(t *testing.T) {
+	types := BuiltInRelationTypes()
+	types[0] = "Mutated"
+	assert.Equal(t, RelationTypeTriggers, BuiltInRelationTypes()[0])
+}
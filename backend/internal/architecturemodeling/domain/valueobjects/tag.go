@@ -0,0 +1,46 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const maxTagLength = 50
+
+var (
+	ErrTagEmpty   = errors.New("tag cannot be empty")
+	ErrTagTooLong = errors.New("tag cannot exceed 50 characters")
+)
+
+type Tag struct {
+	value string
+}
+
+func NewTag(value string) (Tag, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return Tag{}, ErrTagEmpty
+	}
+	if len(trimmed) > maxTagLength {
+		return Tag{}, ErrTagTooLong
+	}
+
+	return Tag{value: trimmed}, nil
+}
+
+func (t Tag) Value() string {
+	return t.value
+}
+
+func (t Tag) Equals(other domain.ValueObject) bool {
+	if otherTag, ok := other.(Tag); ok {
+		return t.value == otherTag.value
+	}
+	return false
+}
+
+func (t Tag) String() string {
+	return t.value
+}
@@ -0,0 +1,29 @@
+package valueobjects
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+type VendorContractID struct {
+	sharedvo.UUIDValue
+}
+
+func NewVendorContractID() VendorContractID {
+	return VendorContractID{UUIDValue: sharedvo.NewUUIDValue()}
+}
+
+func NewVendorContractIDFromString(value string) (VendorContractID, error) {
+	uuidValue, err := sharedvo.NewUUIDValueFromString(value)
+	if err != nil {
+		return VendorContractID{}, err
+	}
+	return VendorContractID{UUIDValue: uuidValue}, nil
+}
+
+func (v VendorContractID) Equals(other domain.ValueObject) bool {
+	if otherID, ok := other.(VendorContractID); ok {
+		return v.EqualsValue(otherID.UUIDValue)
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"net/http"
+
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+)
+
+// ClassificationDimensionHandlers exposes the classification dimensions components can be classified by
+type ClassificationDimensionHandlers struct {
+	dimensions *readmodels.ClassificationDimensionCacheReadModel
+	hateoas    *ArchitectureModelingLinks
+}
+
+// NewClassificationDimensionHandlers creates a new classification dimension handlers instance
+func NewClassificationDimensionHandlers(dimensions *readmodels.ClassificationDimensionCacheReadModel, hateoas *ArchitectureModelingLinks) *ClassificationDimensionHandlers {
+	return &ClassificationDimensionHandlers{
+		dimensions: dimensions,
+		hateoas:    hateoas,
+	}
+}
+
+// GetClassificationDimensions godoc
+// @Summary Get classification dimensions for components
+// @Description Lists the tenant's active classification dimensions (configured in the metamodel) with their allowed values
+// @Tags components
+// @Produce json
+// @Success 200 {object} easi_backend_internal_shared_api.CollectionResponse{data=[]readmodels.ClassificationDimensionCacheDTO}
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /classification-dimensions [get]
+func (h *ClassificationDimensionHandlers) GetClassificationDimensions(w http.ResponseWriter, r *http.Request) {
+	dimensions, err := h.dimensions.GetActive(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve classification dimensions")
+		return
+	}
+
+	links := sharedAPI.NewResourceLinks().Self(sharedAPI.ResourcePath("/classification-dimensions")).Build()
+	links["x-search"] = h.hateoas.Get("/components/search")
+	sharedAPI.RespondCollection(w, http.StatusOK, dimensions, links)
+}
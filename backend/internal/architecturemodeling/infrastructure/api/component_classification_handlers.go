@@ -0,0 +1,218 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	"easi/backend/internal/shared/cqrs"
+)
+
+var errInvalidClassificationFilter = errors.New("classification filter must have the form dimensionId:value")
+
+type AddComponentTagRequest struct {
+	Tag string `json:"tag"`
+}
+
+type ClassifyComponentRequest struct {
+	Value string `json:"value"`
+}
+
+// ComponentSearchResponse is a collection of matching components with facet counts over the whole match set
+type ComponentSearchResponse struct {
+	Data   []readmodels.ApplicationComponentDTO `json:"data"`
+	Facets readmodels.ComponentSearchFacets     `json:"facets"`
+	Meta   sharedAPI.CollectionMeta             `json:"meta"`
+	Links  sharedAPI.Links                      `json:"_links,omitempty"`
+}
+
+// SearchComponents godoc
+// @Summary Faceted search over application components
+// @Description Full-text search over component name, description and expert names, filtered by tags and classification values. Returns counts per tag and classification value across all matches.
+// @Tags components
+// @Produce json
+// @Param q query string false "Free text; every word is matched as a prefix"
+// @Param tag query []string false "Tag the component must have (repeatable)"
+// @Param classification query []string false "Classification filter as dimensionId:value (repeatable)"
+// @Param limit query int false "Maximum number of components to return (default 50, max 100)"
+// @Success 200 {object} ComponentSearchResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /components/search [get]
+func (h *ComponentHandlers) SearchComponents(w http.ResponseWriter, r *http.Request) {
+	query, err := parseComponentSearchQuery(r.URL.Query())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusBadRequest, err, "")
+		return
+	}
+	query.Limit = sharedAPI.ParsePaginationParams(r).Limit
+
+	result, err := h.readModel.Search(r.Context(), query)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to search components")
+		return
+	}
+
+	components := result.Components
+	if components == nil {
+		components = []readmodels.ApplicationComponentDTO{}
+	}
+	for i := range components {
+		h.enrichWithLinks(r, &components[i])
+	}
+
+	selfPath := "/components/search"
+	if r.URL.RawQuery != "" {
+		selfPath += "?" + r.URL.RawQuery
+	}
+
+	total := result.Total
+	sharedAPI.RespondJSON(w, http.StatusOK, ComponentSearchResponse{
+		Data:   components,
+		Facets: result.Facets,
+		Meta:   sharedAPI.CollectionMeta{Total: &total},
+		Links:  sharedAPI.Links{"self": h.hateoas.Get(selfPath), "collection": h.hateoas.Get("/components")},
+	})
+}
+
+func parseComponentSearchQuery(values url.Values) (readmodels.ComponentSearchQuery, error) {
+	query := readmodels.ComponentSearchQuery{
+		Text: values.Get("q"),
+		Tags: values["tag"],
+	}
+	for _, raw := range values["classification"] {
+		dimensionID, value, ok := strings.Cut(raw, ":")
+		if !ok || dimensionID == "" || value == "" {
+			return readmodels.ComponentSearchQuery{}, errInvalidClassificationFilter
+		}
+		query.Classifications = append(query.Classifications, readmodels.ClassificationFilter{
+			DimensionID: dimensionID,
+			Value:       value,
+		})
+	}
+	return query, nil
+}
+
+// AddComponentTag godoc
+// @Summary Add a tag to an application component
+// @Description Associates a free-form tag with a component. Adding an existing tag has no effect.
+// @Tags components
+// @Accept json
+// @Produce json
+// @Param id path string true "Component ID"
+// @Param tag body AddComponentTagRequest true "Tag data"
+// @Success 200 {object} readmodels.ApplicationComponentDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /components/{id}/tags [post]
+func (h *ComponentHandlers) AddComponentTag(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	req, ok := sharedAPI.DecodeRequestOrFail[AddComponentTagRequest](w, r)
+	if !ok {
+		return
+	}
+
+	h.dispatchAndRespondWithComponent(w, r, id, &commands.AddApplicationComponentTag{
+		ComponentID: id,
+		Tag:         req.Tag,
+	}, "Failed to add tag")
+}
+
+// RemoveComponentTag godoc
+// @Summary Remove a tag from an application component
+// @Tags components
+// @Produce json
+// @Param id path string true "Component ID"
+// @Param tag path string true "Tag"
+// @Success 200 {object} readmodels.ApplicationComponentDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /components/{id}/tags/{tag} [delete]
+func (h *ComponentHandlers) RemoveComponentTag(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	h.dispatchAndRespondWithComponent(w, r, id, &commands.RemoveApplicationComponentTag{
+		ComponentID: id,
+		Tag:         sharedAPI.GetPathParam(r, "tag"),
+	}, "Failed to remove tag")
+}
+
+// ClassifyComponent godoc
+// @Summary Set a component's classification value
+// @Description Sets the component's value for a tenant-configured classification dimension such as hosting model or business criticality. The value must be one of the dimension's allowed values.
+// @Tags components
+// @Accept json
+// @Produce json
+// @Param id path string true "Component ID"
+// @Param dimensionId path string true "Classification dimension ID"
+// @Param classification body ClassifyComponentRequest true "Classification value"
+// @Success 200 {object} readmodels.ApplicationComponentDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /components/{id}/classifications/{dimensionId} [put]
+func (h *ComponentHandlers) ClassifyComponent(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	req, ok := sharedAPI.DecodeRequestOrFail[ClassifyComponentRequest](w, r)
+	if !ok {
+		return
+	}
+	if strings.TrimSpace(req.Value) == "" {
+		sharedAPI.RespondError(w, http.StatusBadRequest, nil, "Classification value is required")
+		return
+	}
+
+	h.dispatchAndRespondWithComponent(w, r, id, &commands.ClassifyApplicationComponent{
+		ComponentID: id,
+		DimensionID: sharedAPI.GetPathParam(r, "dimensionId"),
+		Value:       req.Value,
+	}, "Failed to classify component")
+}
+
+// ClearComponentClassification godoc
+// @Summary Clear a component's classification value
+// @Tags components
+// @Produce json
+// @Param id path string true "Component ID"
+// @Param dimensionId path string true "Classification dimension ID"
+// @Success 200 {object} readmodels.ApplicationComponentDTO
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /components/{id}/classifications/{dimensionId} [delete]
+func (h *ComponentHandlers) ClearComponentClassification(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	h.dispatchAndRespondWithComponent(w, r, id, &commands.ClassifyApplicationComponent{
+		ComponentID: id,
+		DimensionID: sharedAPI.GetPathParam(r, "dimensionId"),
+	}, "Failed to clear classification")
+}
+
+func (h *ComponentHandlers) dispatchAndRespondWithComponent(w http.ResponseWriter, r *http.Request, id string, cmd cqrs.Command, failureMsg string) {
+	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		sharedAPI.HandleErrorWithDefault(w, err, failureMsg)
+		return
+	}
+
+	component, err := h.readModel.GetByID(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve updated component")
+		return
+	}
+
+	if component == nil {
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "Component not found")
+		return
+	}
+
+	h.enrichWithLinks(r, component)
+	sharedAPI.RespondJSON(w, http.StatusOK, component)
+}
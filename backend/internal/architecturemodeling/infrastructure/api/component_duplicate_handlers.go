@@ -0,0 +1,183 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	"easi/backend/internal/architecturemodeling/domain/services"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+// ComponentDuplicateHandlers finds components that are likely the same application
+type ComponentDuplicateHandlers struct {
+	components    *readmodels.ApplicationComponentReadModel
+	purchasedFrom *readmodels.PurchasedFromRelationshipReadModel
+	builtBy       *readmodels.BuiltByRelationshipReadModel
+	hateoas       *ArchitectureModelingLinks
+}
+
+// NewComponentDuplicateHandlers creates a new component duplicate handlers instance
+func NewComponentDuplicateHandlers(
+	components *readmodels.ApplicationComponentReadModel,
+	purchasedFrom *readmodels.PurchasedFromRelationshipReadModel,
+	builtBy *readmodels.BuiltByRelationshipReadModel,
+	hateoas *ArchitectureModelingLinks,
+) *ComponentDuplicateHandlers {
+	return &ComponentDuplicateHandlers{
+		components:    components,
+		purchasedFrom: purchasedFrom,
+		builtBy:       builtBy,
+		hateoas:       hateoas,
+	}
+}
+
+type DuplicateCandidateComponent struct {
+	ID    string          `json:"id"`
+	Name  string          `json:"name"`
+	Links sharedAPI.Links `json:"_links,omitempty"`
+}
+
+// DuplicateCandidateResponse is a pair of components that are likely the same application
+type DuplicateCandidateResponse struct {
+	Components     []DuplicateCandidateComponent `json:"components"`
+	Score          float64                       `json:"score"`
+	NameSimilarity float64                       `json:"nameSimilarity"`
+	Reasons        []string                      `json:"reasons"`
+	Links          sharedAPI.Links               `json:"_links,omitempty"`
+}
+
+type MergeComponentsRequest struct {
+	DuplicateID string `json:"duplicateId"`
+}
+
+// GetDuplicateCandidates godoc
+// @Summary Find likely duplicate application components
+// @Description Compares every pair of components by fuzzy name similarity and shared vendor (purchased-from) or internal team (built-by) origins. Pairs are returned highest score first.
+// @Tags components
+// @Produce json
+// @Param minScore query number false "Minimum score between 0 and 1 (default 0.5)"
+// @Success 200 {object} easi_backend_internal_shared_api.CollectionResponse{data=[]DuplicateCandidateResponse}
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /components/duplicates [get]
+func (h *ComponentDuplicateHandlers) GetDuplicateCandidates(w http.ResponseWriter, r *http.Request) {
+	minScore := services.DefaultDuplicateMinScore
+	if raw := r.URL.Query().Get("minScore"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil || parsed < 0 || parsed > 1 {
+			sharedAPI.RespondError(w, http.StatusBadRequest, nil, "minScore must be a number between 0 and 1")
+			return
+		}
+		minScore = parsed
+	}
+
+	profiles, err := h.loadProfiles(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to load components")
+		return
+	}
+
+	actor, _ := sharedctx.GetActor(r.Context())
+	candidates := services.NewDuplicateDetector(minScore).FindCandidates(profiles)
+	data := make([]DuplicateCandidateResponse, 0, len(candidates))
+	for _, c := range candidates {
+		data = append(data, h.toCandidateResponse(c, actor))
+	}
+
+	links := sharedAPI.NewResourceLinks().Self(sharedAPI.ResourcePath("/components/duplicates")).Build()
+	links["collection"] = h.hateoas.Get("/components")
+	sharedAPI.RespondCollection(w, http.StatusOK, data, links)
+}
+
+func (h *ComponentDuplicateHandlers) loadProfiles(ctx context.Context) ([]services.ComponentProfile, error) {
+	components, err := h.components.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vendorLinks, err := h.purchasedFrom.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	teamLinks, err := h.builtBy.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vendorsByComponent := make(map[string][]string)
+	for _, link := range vendorLinks {
+		vendorsByComponent[link.ComponentID] = append(vendorsByComponent[link.ComponentID], link.VendorID)
+	}
+	teamsByComponent := make(map[string][]string)
+	for _, link := range teamLinks {
+		teamsByComponent[link.ComponentID] = append(teamsByComponent[link.ComponentID], link.InternalTeamID)
+	}
+
+	profiles := make([]services.ComponentProfile, 0, len(components))
+	for _, c := range components {
+		profiles = append(profiles, services.ComponentProfile{
+			ID:        c.ID,
+			Name:      c.Name,
+			VendorIDs: vendorsByComponent[c.ID],
+			TeamIDs:   teamsByComponent[c.ID],
+		})
+	}
+	return profiles, nil
+}
+
+func (h *ComponentDuplicateHandlers) toCandidateResponse(c services.DuplicateCandidate, actor sharedctx.Actor) DuplicateCandidateResponse {
+	response := DuplicateCandidateResponse{
+		Components: []DuplicateCandidateComponent{
+			{ID: c.First.ID, Name: c.First.Name, Links: sharedAPI.Links{"self": h.hateoas.Get("/components/" + c.First.ID)}},
+			{ID: c.Second.ID, Name: c.Second.Name, Links: sharedAPI.Links{"self": h.hateoas.Get("/components/" + c.Second.ID)}},
+		},
+		Score:          c.Score,
+		NameSimilarity: c.NameSimilarity,
+		Reasons:        c.Reasons,
+		Links:          sharedAPI.Links{},
+	}
+	if response.Reasons == nil {
+		response.Reasons = []string{}
+	}
+	if actor.CanDelete("components") {
+		response.Links["x-merge"] = h.hateoas.Post("/components/" + c.First.ID + "/merge")
+	}
+	return response
+}
+
+// MergeComponents godoc
+// @Summary Merge a duplicate component into this one
+// @Description Moves the duplicate's experts, tags, classifications, relations, sub-components, capability realizations, fit scores, TIME assessments, view memberships and one-pager facts onto this component, then deletes the duplicate. Where both components hold a value, this component's value is kept.
+// @Tags components
+// @Accept json
+// @Produce json
+// @Param id path string true "Surviving component ID"
+// @Param merge body MergeComponentsRequest true "Duplicate to merge"
+// @Success 200 {object} readmodels.ApplicationComponentDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /components/{id}/merge [post]
+func (h *ComponentHandlers) MergeComponents(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	req, ok := sharedAPI.DecodeRequestOrFail[MergeComponentsRequest](w, r)
+	if !ok {
+		return
+	}
+	if req.DuplicateID == "" {
+		sharedAPI.RespondError(w, http.StatusBadRequest, nil, "duplicateId is required")
+		return
+	}
+
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.dispatchAndRespondWithComponent(w, r, id, &commands.MergeApplicationComponents{
+		SurvivorID:  id,
+		DuplicateID: req.DuplicateID,
+		MergedBy:    actor.Email,
+	}, "Failed to merge components")
+}
@@ -44,6 +44,10 @@ type UpdateApplicationComponentRequest struct {
 	Description string `json:"description,omitempty"`
 }
 
+type ChangeComponentParentRequest struct {
+	ParentID string `json:"parentId"`
+}
+
 // CreateApplicationComponent godoc
 // @Summary Create a new application component
 // @Description Creates a new application component in the system
@@ -230,6 +234,76 @@ func (h *ComponentHandlers) UpdateApplicationComponent(w http.ResponseWriter, r
 	sharedAPI.RespondJSON(w, http.StatusOK, component)
 }
 
+// GetComponentTree godoc
+// @Summary Get the application component hierarchy
+// @Description Retrieves all application components arranged as a parent/child tree. Top-level components are returned as roots.
+// @Tags components
+// @Produce json
+// @Success 200 {object} sharedAPI.CollectionResponse{data=[]readmodels.ComponentTreeNode}
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /components/tree [get]
+func (h *ComponentHandlers) GetComponentTree(w http.ResponseWriter, r *http.Request) {
+	roots, err := h.readModel.GetTree(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve component hierarchy")
+		return
+	}
+
+	for _, root := range roots {
+		h.enrichTreeWithLinks(r, root)
+	}
+
+	links := sharedAPI.NewResourceLinks().Self(sharedAPI.ResourcePath("/components/tree")).Build()
+	sharedAPI.RespondCollection(w, http.StatusOK, roots, links)
+}
+
+// ChangeApplicationComponentParent godoc
+// @Summary Change the parent of an application component
+// @Description Places a component under a parent component (e.g. a module under its ERP suite). An empty parentId makes the component top-level.
+// @Tags components
+// @Accept json
+// @Produce json
+// @Param id path string true "Component ID"
+// @Param parent body ChangeComponentParentRequest true "New parent"
+// @Success 200 {object} readmodels.ApplicationComponentDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /components/{id}/parent [patch]
+func (h *ComponentHandlers) ChangeApplicationComponentParent(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	req, ok := sharedAPI.DecodeRequestOrFail[ChangeComponentParentRequest](w, r)
+	if !ok {
+		return
+	}
+
+	cmd := &commands.ChangeApplicationComponentParent{
+		ID:       id,
+		ParentID: req.ParentID,
+	}
+
+	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		sharedAPI.HandleErrorWithDefault(w, err, "Failed to change component parent")
+		return
+	}
+
+	component, err := h.readModel.GetByID(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve updated component")
+		return
+	}
+
+	if component == nil {
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "Component not found")
+		return
+	}
+
+	h.enrichWithLinks(r, component)
+	sharedAPI.RespondJSON(w, http.StatusOK, component)
+}
+
 // DeleteApplicationComponent godoc
 // @Summary Delete an application component
 // @Description Permanently deletes an application component from the model
@@ -276,3 +350,10 @@ func (h *ComponentHandlers) enrichWithLinks(r *http.Request, component *readmode
 		}, actor)
 	}
 }
+
+func (h *ComponentHandlers) enrichTreeWithLinks(r *http.Request, node *readmodels.ComponentTreeNode) {
+	h.enrichWithLinks(r, &node.ApplicationComponentDTO)
+	for _, child := range node.Children {
+		h.enrichTreeWithLinks(r, child)
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"easi/backend/internal/architecturemodeling/application/handlers"
 	"easi/backend/internal/architecturemodeling/domain/aggregates"
 	"easi/backend/internal/architecturemodeling/domain/valueobjects"
 	"easi/backend/internal/architecturemodeling/infrastructure/repositories"
@@ -14,14 +15,40 @@ func init() {
 	registry.RegisterNotFound(repositories.ErrRelationNotFound, "Relation not found")
 	registry.RegisterNotFound(repositories.ErrAcquiredEntityNotFound, "Acquired entity not found")
 	registry.RegisterNotFound(repositories.ErrVendorNotFound, "Vendor not found")
+	registry.RegisterNotFound(repositories.ErrVendorContractNotFound, "Vendor contract not found")
 	registry.RegisterNotFound(repositories.ErrInternalTeamNotFound, "Internal team not found")
 	registry.RegisterNotFound(repositories.ErrComponentOriginLinkNotFound, "Component origin link not found")
 
 	registry.RegisterConflict(aggregates.ErrSelfReference, "Component cannot have a relation to itself")
 	registry.RegisterNotFound(aggregates.ErrNoOriginLink, "No origin link exists")
+	registry.RegisterNotFound(handlers.ErrVendorContractVendorMismatch, "Vendor contract not found")
+	registry.RegisterConflict(aggregates.ErrVendorContractDeleted, "Vendor contract has been deleted")
+	registry.RegisterConflict(aggregates.ErrComponentCannotBeOwnParent, "Component cannot be its own parent")
+	registry.RegisterConflict(handlers.ErrComponentHierarchyCycle, "Parent would create a cycle in the component hierarchy")
+	registry.RegisterValidation(handlers.ErrParentComponentNotFound, "Parent component does not exist")
+	registry.RegisterValidation(aggregates.ErrCannotMergeIntoItself, "A component cannot be merged into itself")
+	registry.RegisterConflict(aggregates.ErrMergeWithDeletedComponent, "Deleted components cannot be merged")
 
 	registry.RegisterValidation(valueobjects.ErrEntityNameEmpty, "Name cannot be empty")
 	registry.RegisterValidation(valueobjects.ErrEntityNameTooLong, "Name exceeds maximum length of 100 characters")
 	registry.RegisterValidation(valueobjects.ErrNotesTooLong, "Notes exceeds maximum length of 500 characters")
 	registry.RegisterValidation(valueobjects.ErrInvalidIntegrationStatus, "Invalid integration status")
+	registry.RegisterValidation(valueobjects.ErrNoticePeriodRequired, "A notice period of at least one day is required")
+	registry.RegisterValidation(valueobjects.ErrNoticePeriodTooLong, "Notice period exceeds maximum of 3650 days")
+	registry.RegisterValidation(valueobjects.ErrContractStartDateRequired, "Contract start date is required")
+	registry.RegisterValidation(valueobjects.ErrContractEndDateRequired, "Contract end date is required")
+	registry.RegisterValidation(valueobjects.ErrContractEndBeforeStart, "Contract end date must be after its start date")
+	registry.RegisterValidation(valueobjects.ErrNoticeDeadlineBeforeStart, "Notice period is longer than the contract term")
+	registry.RegisterValidation(valueobjects.ErrContractValueNegative, "Contract value cannot be negative")
+	registry.RegisterValidation(valueobjects.ErrContractCurrencyRequired, "Contract value requires a currency")
+	registry.RegisterValidation(valueobjects.ErrInvalidContractCurrency, "Currency must be a three-letter ISO 4217 code")
+	registry.RegisterValidation(valueobjects.ErrContractOwnerRequired, "Contract owner is required")
+	registry.RegisterValidation(valueobjects.ErrContractOwnerTooLong, "Contract owner exceeds maximum length of 100 characters")
+	registry.RegisterValidation(valueobjects.ErrInvalidRelationType, "Invalid relation type")
+	registry.RegisterValidation(handlers.ErrUnknownCustomRelationType, "Custom relation type does not exist or is no longer active")
+	registry.RegisterValidation(valueobjects.ErrTagEmpty, "Tag cannot be empty")
+	registry.RegisterValidation(valueobjects.ErrTagTooLong, "Tag exceeds maximum length of 50 characters")
+	registry.RegisterValidation(handlers.ErrUnknownClassificationDimension, "Classification dimension does not exist or is no longer active")
+	registry.RegisterValidation(handlers.ErrInvalidClassificationValue, "Value is not one of the classification dimension's allowed values")
+	registry.RegisterValidation(handlers.ErrComponentNotPurchasedFromVendor, "Covered components must be purchased from this vendor")
 }
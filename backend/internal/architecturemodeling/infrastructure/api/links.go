@@ -35,6 +35,8 @@ func (h *ArchitectureModelingLinks) ComponentLinksForActor(id string, actor shar
 		"collection":     h.Get("/components"),
 		"x-expert-roles": h.Get("/components/expert-roles"),
 		"x-one-pager":    h.Get("/one-pagers/application/" + id),
+		"x-hierarchy":    h.Get("/components/tree"),
+		"x-search":       h.Get("/components/search"),
 	}
 	h.AddEditOrGrantLink(links, actor, sharedAPI.EditGrantParams{
 		Permission:   "components",
@@ -42,11 +44,14 @@ func (h *ArchitectureModelingLinks) ComponentLinksForActor(id string, actor shar
 		ArtifactID:   id,
 		EditLink:     h.Put(p),
 		ExtraWrite: map[string]types.Link{
-			"x-add-expert": h.Post(p + "/experts"),
+			"x-add-expert":    h.Post(p + "/experts"),
+			"x-change-parent": h.Patch(p + "/parent"),
+			"x-add-tag":       h.Post(p + "/tags"),
 		},
 	})
 	if actor.CanDelete("components") {
 		links["delete"] = h.Del(p)
+		links["x-merge"] = h.Post(p + "/merge")
 	}
 	h.AddEditGrantsLink(links, actor, "components")
 	return links
@@ -162,7 +167,25 @@ func (h *ArchitectureModelingLinks) AcquiredEntityLinksForActor(id string, actor
 }
 
 func (h *ArchitectureModelingLinks) VendorLinksForActor(id string, actor sharedctx.Actor) sharedAPI.Links {
-	return h.originEntityLinksForActor(vendorConfig, id, actor)
+	links := h.originEntityLinksForActor(vendorConfig, id, actor)
+	links["x-contracts"] = h.Get("/vendors/" + id + "/contracts")
+	return links
+}
+
+func (h *ArchitectureModelingLinks) VendorContractLinksForActor(vendorID, id string, actor sharedctx.Actor) sharedAPI.Links {
+	p := "/vendors/" + vendorID + "/contracts/" + id
+	links := sharedAPI.Links{
+		"self":       h.Get(p),
+		"collection": h.Get("/vendors/" + vendorID + "/contracts"),
+		"x-vendor":   h.Get("/vendors/" + vendorID),
+	}
+	if actor.CanWrite("components") {
+		links["edit"] = h.Put(p)
+	}
+	if actor.CanDelete("components") {
+		links["delete"] = h.Del(p)
+	}
+	return links
 }
 
 func (h *ArchitectureModelingLinks) InternalTeamLinksForActor(id string, actor sharedctx.Actor) sharedAPI.Links {
@@ -117,7 +117,7 @@ func setupRelationHandlers(db *sql.DB) (*RelationHandlers, *readmodels.Component
 
 	// Setup repository and handlers
 	relationRepo := repositories.NewComponentRelationRepository(eventStore)
-	createHandler := handlers.NewCreateComponentRelationHandler(relationRepo)
+	createHandler := handlers.NewCreateComponentRelationHandler(relationRepo, readmodels.NewCustomRelationTypeCacheReadModel(tenantDB))
 	deleteHandler := handlers.NewDeleteComponentRelationHandler(relationRepo)
 	commandBus.Register("CreateComponentRelation", createHandler)
 	commandBus.Register("DeleteComponentRelation", deleteHandler)
@@ -568,8 +568,8 @@ func setupCascadeTestDependencies(testCtx *testContext) *cascadeTestDependencies
 	relationRepo := repositories.NewComponentRelationRepository(eventStore)
 
 	createComponentHandler := handlers.NewCreateApplicationComponentHandler(componentRepo)
-	deleteComponentHandler := handlers.NewDeleteApplicationComponentHandler(componentRepo, relationReadModel, commandBus)
-	createRelationHandler := handlers.NewCreateComponentRelationHandler(relationRepo)
+	deleteComponentHandler := handlers.NewDeleteApplicationComponentHandler(componentRepo, relationReadModel, componentReadModel, commandBus)
+	createRelationHandler := handlers.NewCreateComponentRelationHandler(relationRepo, readmodels.NewCustomRelationTypeCacheReadModel(tenantDB))
 	deleteRelationHandler := handlers.NewDeleteComponentRelationHandler(relationRepo)
 
 	commandBus.Register("CreateApplicationComponent", createComponentHandler)
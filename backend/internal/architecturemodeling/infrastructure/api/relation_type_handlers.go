@@ -0,0 +1,95 @@
+package api
+
+import (
+	"net/http"
+
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	"easi/backend/internal/architecturemodeling/domain/valueobjects"
+	sharedAPI "easi/backend/internal/shared/api"
+)
+
+// RelationTypeHandlers handles HTTP requests for the relation types available to the tenant
+type RelationTypeHandlers struct {
+	customTypes *readmodels.CustomRelationTypeCacheReadModel
+	hateoas     *ArchitectureModelingLinks
+}
+
+// NewRelationTypeHandlers creates a new relation type handlers instance
+func NewRelationTypeHandlers(customTypes *readmodels.CustomRelationTypeCacheReadModel, hateoas *ArchitectureModelingLinks) *RelationTypeHandlers {
+	return &RelationTypeHandlers{
+		customTypes: customTypes,
+		hateoas:     hateoas,
+	}
+}
+
+// RelationTypeDTO describes a relation type that can be used when creating a component relation
+type RelationTypeDTO struct {
+	Value       string                `json:"value"`
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	BuiltIn     bool                  `json:"builtIn"`
+	Style       *readmodels.EdgeStyle `json:"style,omitempty"`
+	Links       sharedAPI.Links       `json:"_links,omitempty"`
+}
+
+type builtInRelationTypeInfo struct {
+	name        string
+	description string
+}
+
+var builtInRelationTypeInfos = map[valueobjects.RelationType]builtInRelationTypeInfo{
+	valueobjects.RelationTypeTriggers:        {"Triggers", "Source initiates behaviour in the target"},
+	valueobjects.RelationTypeServes:          {"Serves", "Source provides functionality to the target"},
+	valueobjects.RelationTypeFlow:            {"Flow", "Information or value is transferred from source to target"},
+	valueobjects.RelationTypeAccessRead:      {"Access (read)", "Source reads data managed by the target"},
+	valueobjects.RelationTypeAccessWrite:     {"Access (write)", "Source writes data managed by the target"},
+	valueobjects.RelationTypeAccessReadWrite: {"Access (read/write)", "Source reads and writes data managed by the target"},
+	valueobjects.RelationTypeComposition:     {"Composition", "Target is an integral part of the source and cannot exist without it"},
+	valueobjects.RelationTypeAggregation:     {"Aggregation", "Target is grouped under the source but can exist on its own"},
+}
+
+// GetRelationTypes godoc
+// @Summary Get available relation types
+// @Description Lists the built-in ArchiMate-aligned relation types followed by the tenant's active custom relation types
+// @Tags relations
+// @Produce json
+// @Success 200 {object} easi_backend_internal_shared_api.CollectionResponse{data=[]RelationTypeDTO}
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /relation-types [get]
+func (h *RelationTypeHandlers) GetRelationTypes(w http.ResponseWriter, r *http.Request) {
+	customTypes, err := h.customTypes.GetActive(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve relation types")
+		return
+	}
+
+	builtIns := valueobjects.BuiltInRelationTypes()
+	relationTypes := make([]RelationTypeDTO, 0, len(builtIns)+len(customTypes))
+	for _, rt := range builtIns {
+		info := builtInRelationTypeInfos[rt]
+		relationTypes = append(relationTypes, RelationTypeDTO{
+			Value:       rt.Value(),
+			Name:        info.name,
+			Description: info.description,
+			BuiltIn:     true,
+			Links:       h.hateoas.RelationTypeLinks(rt),
+		})
+	}
+
+	for _, custom := range customTypes {
+		rt, err := valueobjects.NewCustomRelationType(custom.ID)
+		if err != nil {
+			continue
+		}
+		relationTypes = append(relationTypes, RelationTypeDTO{
+			Value:       rt.Value(),
+			Name:        custom.Name,
+			Description: custom.Description,
+			Style:       &readmodels.EdgeStyle{LineStyle: custom.LineStyle, Color: custom.Color},
+			Links:       h.hateoas.RelationTypeLinks(rt),
+		})
+	}
+
+	links := sharedAPI.NewResourceLinks().Self(sharedAPI.ResourcePath("/relation-types")).Build()
+	sharedAPI.RespondCollection(w, http.StatusOK, relationTypes, links)
+}
@@ -11,6 +11,7 @@ import (
 	authPL "easi/backend/internal/auth/publishedlanguage"
 	"easi/backend/internal/infrastructure/database"
 	"easi/backend/internal/infrastructure/eventstore"
+	mmPL "easi/backend/internal/metamodel/publishedlanguage"
 	sharedAPI "easi/backend/internal/shared/api"
 	"easi/backend/internal/shared/cqrs"
 	"easi/backend/internal/shared/events"
@@ -38,6 +39,7 @@ type repositorySet struct {
 	relation            *repositories.ComponentRelationRepository
 	acquiredEntity      *repositories.AcquiredEntityRepository
 	vendor              *repositories.VendorRepository
+	vendorContract      *repositories.VendorContractRepository
 	internalTeam        *repositories.InternalTeamRepository
 	componentOriginLink *repositories.ComponentOriginLinkRepository
 }
@@ -47,18 +49,25 @@ type readModelSet struct {
 	relation       *readmodels.ComponentRelationReadModel
 	acquiredEntity *readmodels.AcquiredEntityReadModel
 	vendor         *readmodels.VendorReadModel
+	vendorContract *readmodels.VendorContractReadModel
 	internalTeam   *readmodels.InternalTeamReadModel
 	acquiredVia    *readmodels.AcquiredViaRelationshipReadModel
 	purchasedFrom  *readmodels.PurchasedFromRelationshipReadModel
 	builtBy        *readmodels.BuiltByRelationshipReadModel
+	customRelTypes *readmodels.CustomRelationTypeCacheReadModel
+	classDims      *readmodels.ClassificationDimensionCacheReadModel
 }
 
 type httpHandlerSet struct {
 	component          *ComponentHandlers
+	duplicate          *ComponentDuplicateHandlers
 	expert             *ComponentExpertHandlers
 	relation           *RelationHandlers
+	relationType       *RelationTypeHandlers
+	classificationDim  *ClassificationDimensionHandlers
 	acquiredEntity     *AcquiredEntityHandlers
 	vendor             *VendorHandlers
+	vendorContract     *VendorContractHandlers
 	internalTeam       *InternalTeamHandlers
 	originRelationship *OriginRelationshipHandlers
 }
@@ -69,6 +78,7 @@ func newRepositorySet(eventStore eventstore.EventStore) *repositorySet {
 		relation:            repositories.NewComponentRelationRepository(eventStore),
 		acquiredEntity:      repositories.NewAcquiredEntityRepository(eventStore),
 		vendor:              repositories.NewVendorRepository(eventStore),
+		vendorContract:      repositories.NewVendorContractRepository(eventStore),
 		internalTeam:        repositories.NewInternalTeamRepository(eventStore),
 		componentOriginLink: repositories.NewComponentOriginLinkRepository(eventStore),
 	}
@@ -80,10 +90,13 @@ func newReadModelSet(db *database.TenantAwareDB) *readModelSet {
 		relation:       readmodels.NewComponentRelationReadModel(db),
 		acquiredEntity: readmodels.NewAcquiredEntityReadModel(db),
 		vendor:         readmodels.NewVendorReadModel(db),
+		vendorContract: readmodels.NewVendorContractReadModel(db),
 		internalTeam:   readmodels.NewInternalTeamReadModel(db),
 		acquiredVia:    readmodels.NewAcquiredViaRelationshipReadModel(db),
 		purchasedFrom:  readmodels.NewPurchasedFromRelationshipReadModel(db),
 		builtBy:        readmodels.NewBuiltByRelationshipReadModel(db),
+		customRelTypes: readmodels.NewCustomRelationTypeCacheReadModel(db),
+		classDims:      readmodels.NewClassificationDimensionCacheReadModel(db),
 	}
 }
 
@@ -92,20 +105,31 @@ func subscribeProjectors(eventBus events.EventBus, rm *readModelSet) {
 	relationProjector := projectors.NewComponentRelationProjector(rm.relation)
 	acquiredEntityProjector := projectors.NewAcquiredEntityProjector(rm.acquiredEntity)
 	vendorProjector := projectors.NewVendorProjector(rm.vendor)
+	vendorContractProjector := projectors.NewVendorContractProjector(rm.vendorContract)
 	internalTeamProjector := projectors.NewInternalTeamProjector(rm.internalTeam)
 	originRelationshipProjector := projectors.NewOriginRelationshipProjector(rm.acquiredVia, rm.purchasedFrom, rm.builtBy)
+	customRelationTypeProjector := projectors.NewCustomRelationTypeCacheProjector(rm.customRelTypes)
+	classificationDimensionProjector := projectors.NewClassificationDimensionCacheProjector(rm.classDims)
 
 	subscribeComponentProjectors(eventBus, componentProjector, relationProjector)
 	subscribeOriginEntityProjectors(eventBus, acquiredEntityProjector, vendorProjector, internalTeamProjector)
 	subscribeOriginRelationshipProjectors(eventBus, originRelationshipProjector)
+	subscribeVendorContractProjectors(eventBus, vendorContractProjector)
+	subscribeCustomRelationTypeProjectors(eventBus, customRelationTypeProjector)
+	subscribeClassificationDimensionProjectors(eventBus, classificationDimensionProjector)
 }
 
 func subscribeComponentProjectors(eventBus events.EventBus, component, relation events.EventHandler) {
 	eventBus.Subscribe(archPL.ApplicationComponentCreated, component)
 	eventBus.Subscribe(archPL.ApplicationComponentUpdated, component)
 	eventBus.Subscribe(archPL.ApplicationComponentDeleted, component)
+	eventBus.Subscribe(archPL.ApplicationComponentParentChanged, component)
 	eventBus.Subscribe(archPL.ApplicationComponentExpertAdded, component)
 	eventBus.Subscribe(archPL.ApplicationComponentExpertRemoved, component)
+	eventBus.Subscribe(archPL.ApplicationComponentTagAdded, component)
+	eventBus.Subscribe(archPL.ApplicationComponentTagRemoved, component)
+	eventBus.Subscribe(archPL.ApplicationComponentClassified, component)
+	eventBus.Subscribe(archPL.ApplicationComponentClassificationCleared, component)
 	eventBus.Subscribe(archPL.ComponentRelationCreated, relation)
 	eventBus.Subscribe(archPL.ComponentRelationUpdated, relation)
 	eventBus.Subscribe(archPL.ComponentRelationDeleted, relation)
@@ -131,6 +155,25 @@ func subscribeOriginRelationshipProjectors(eventBus events.EventBus, projector e
 	eventBus.Subscribe(archPL.OriginLinkDeleted, projector)
 }
 
+func subscribeVendorContractProjectors(eventBus events.EventBus, projector events.EventHandler) {
+	eventBus.Subscribe(archPL.VendorContractCreated, projector)
+	eventBus.Subscribe(archPL.VendorContractUpdated, projector)
+	eventBus.Subscribe(archPL.VendorContractDeleted, projector)
+	eventBus.Subscribe(archPL.ApplicationComponentDeleted, projector)
+}
+
+func subscribeCustomRelationTypeProjectors(eventBus events.EventBus, projector events.EventHandler) {
+	eventBus.Subscribe(mmPL.CustomRelationTypeAdded, projector)
+	eventBus.Subscribe(mmPL.CustomRelationTypeUpdated, projector)
+	eventBus.Subscribe(mmPL.CustomRelationTypeRemoved, projector)
+}
+
+func subscribeClassificationDimensionProjectors(eventBus events.EventBus, projector events.EventHandler) {
+	eventBus.Subscribe(mmPL.ClassificationDimensionAdded, projector)
+	eventBus.Subscribe(mmPL.ClassificationDimensionUpdated, projector)
+	eventBus.Subscribe(mmPL.ClassificationDimensionRemoved, projector)
+}
+
 func registerCommandHandlers(bus *cqrs.InMemoryCommandBus, repos *repositorySet, rm *readModelSet) {
 	registerComponentCommandHandlers(bus, repos, rm)
 	registerOriginEntityCommandHandlers(bus, repos, rm)
@@ -140,10 +183,15 @@ func registerCommandHandlers(bus *cqrs.InMemoryCommandBus, repos *repositorySet,
 func registerComponentCommandHandlers(bus *cqrs.InMemoryCommandBus, repos *repositorySet, rm *readModelSet) {
 	bus.Register("CreateApplicationComponent", handlers.NewCreateApplicationComponentHandler(repos.component))
 	bus.Register("UpdateApplicationComponent", handlers.NewUpdateApplicationComponentHandler(repos.component))
-	bus.Register("DeleteApplicationComponent", handlers.NewDeleteApplicationComponentHandler(repos.component, rm.relation, bus))
+	bus.Register("DeleteApplicationComponent", handlers.NewDeleteApplicationComponentHandler(repos.component, rm.relation, rm.component, bus))
+	bus.Register("MergeApplicationComponents", handlers.NewMergeApplicationComponentsHandler(repos.component, rm.relation, rm.component, bus))
+	bus.Register("ChangeApplicationComponentParent", handlers.NewChangeApplicationComponentParentHandler(repos.component, rm.component))
 	bus.Register("AddApplicationComponentExpert", handlers.NewAddApplicationComponentExpertHandler(repos.component))
 	bus.Register("RemoveApplicationComponentExpert", handlers.NewRemoveApplicationComponentExpertHandler(repos.component))
-	bus.Register("CreateComponentRelation", handlers.NewCreateComponentRelationHandler(repos.relation))
+	bus.Register("AddApplicationComponentTag", handlers.NewAddApplicationComponentTagHandler(repos.component))
+	bus.Register("RemoveApplicationComponentTag", handlers.NewRemoveApplicationComponentTagHandler(repos.component))
+	bus.Register("ClassifyApplicationComponent", handlers.NewClassifyApplicationComponentHandler(repos.component, rm.classDims))
+	bus.Register("CreateComponentRelation", handlers.NewCreateComponentRelationHandler(repos.relation, rm.customRelTypes))
 	bus.Register("UpdateComponentRelation", handlers.NewUpdateComponentRelationHandler(repos.relation))
 	bus.Register("DeleteComponentRelation", handlers.NewDeleteComponentRelationHandler(repos.relation))
 }
@@ -154,7 +202,10 @@ func registerOriginEntityCommandHandlers(bus *cqrs.InMemoryCommandBus, repos *re
 	bus.Register("DeleteAcquiredEntity", handlers.NewDeleteAcquiredEntityHandler(repos.acquiredEntity, rm.acquiredVia, bus))
 	bus.Register("CreateVendor", handlers.NewCreateVendorHandler(repos.vendor))
 	bus.Register("UpdateVendor", handlers.NewUpdateVendorHandler(repos.vendor))
-	bus.Register("DeleteVendor", handlers.NewDeleteVendorHandler(repos.vendor, rm.purchasedFrom, bus))
+	bus.Register("DeleteVendor", handlers.NewDeleteVendorHandler(repos.vendor, rm.purchasedFrom, rm.vendorContract, bus))
+	bus.Register("CreateVendorContract", handlers.NewCreateVendorContractHandler(repos.vendorContract, repos.vendor, rm.purchasedFrom))
+	bus.Register("UpdateVendorContract", handlers.NewUpdateVendorContractHandler(repos.vendorContract, rm.purchasedFrom))
+	bus.Register("DeleteVendorContract", handlers.NewDeleteVendorContractHandler(repos.vendorContract))
 	bus.Register("CreateInternalTeam", handlers.NewCreateInternalTeamHandler(repos.internalTeam))
 	bus.Register("UpdateInternalTeam", handlers.NewUpdateInternalTeamHandler(repos.internalTeam))
 	bus.Register("DeleteInternalTeam", handlers.NewDeleteInternalTeamHandler(repos.internalTeam, rm.builtBy, bus))
@@ -168,12 +219,16 @@ func registerOriginRelationshipCommandHandlers(bus *cqrs.InMemoryCommandBus, rep
 func newHTTPHandlerSet(bus *cqrs.InMemoryCommandBus, rm *readModelSet, hateoas *sharedAPI.HATEOASLinks, completeness OnePagerCompletenessSources) *httpHandlerSet {
 	links := NewArchitectureModelingLinks(hateoas)
 	return &httpHandlerSet{
-		component:      NewComponentHandlers(bus, rm.component, links, completeness.Components),
-		expert:         NewComponentExpertHandlers(bus, rm.component),
-		relation:       NewRelationHandlers(bus, rm.relation, links),
-		acquiredEntity: NewAcquiredEntityHandlers(bus, rm.acquiredEntity, links, completeness.AcquiredEntities),
-		vendor:         NewVendorHandlers(bus, rm.vendor, links, completeness.Vendors),
-		internalTeam:   NewInternalTeamHandlers(bus, rm.internalTeam, links, completeness.InternalTeams),
+		component:         NewComponentHandlers(bus, rm.component, links, completeness.Components),
+		duplicate:         NewComponentDuplicateHandlers(rm.component, rm.purchasedFrom, rm.builtBy, links),
+		expert:            NewComponentExpertHandlers(bus, rm.component),
+		relation:          NewRelationHandlers(bus, rm.relation, links),
+		relationType:      NewRelationTypeHandlers(rm.customRelTypes, links),
+		classificationDim: NewClassificationDimensionHandlers(rm.classDims, links),
+		acquiredEntity:    NewAcquiredEntityHandlers(bus, rm.acquiredEntity, links, completeness.AcquiredEntities),
+		vendor:            NewVendorHandlers(bus, rm.vendor, links, completeness.Vendors),
+		vendorContract:    NewVendorContractHandlers(bus, rm.vendorContract, links),
+		internalTeam:      NewInternalTeamHandlers(bus, rm.internalTeam, links, completeness.InternalTeams),
 		originRelationship: NewOriginRelationshipHandlersFromConfig(OriginRelationshipHandlersConfig{
 			CommandBus: bus,
 			ReadModels: OriginReadModels{
@@ -199,6 +254,9 @@ func registerComponentRoutes(r chi.Router, h *httpHandlerSet, auth AuthMiddlewar
 			r.Use(auth.RequirePermission(authPL.PermComponentsRead))
 			r.Get("/", h.component.GetAllComponents)
 			r.Get("/expert-roles", h.expert.GetExpertRoles)
+			r.Get("/tree", h.component.GetComponentTree)
+			r.Get("/search", h.component.SearchComponents)
+			r.Get("/duplicates", h.duplicate.GetDuplicateCandidates)
 			r.Get("/{id}", h.component.GetComponentByID)
 			r.Get("/{componentId}/origins", h.originRelationship.GetAllOriginsByComponent)
 			r.Get("/{componentId}/origin/acquired-via", h.originRelationship.GetAcquiredViaByComponent)
@@ -216,10 +274,16 @@ func registerComponentRoutes(r chi.Router, h *httpHandlerSet, auth AuthMiddlewar
 		r.Group(func(r chi.Router) {
 			r.Use(sharedAPI.RequireWriteOrEditGrant("components", "id"))
 			r.Put("/{id}", h.component.UpdateApplicationComponent)
+			r.Patch("/{id}/parent", h.component.ChangeApplicationComponentParent)
+			r.Post("/{id}/tags", h.component.AddComponentTag)
+			r.Delete("/{id}/tags/{tag}", h.component.RemoveComponentTag)
+			r.Put("/{id}/classifications/{dimensionId}", h.component.ClassifyComponent)
+			r.Delete("/{id}/classifications/{dimensionId}", h.component.ClearComponentClassification)
 		})
 		r.Group(func(r chi.Router) {
 			r.Use(auth.RequirePermission(authPL.PermComponentsDelete))
 			r.Delete("/{id}", h.component.DeleteApplicationComponent)
+			r.Post("/{id}/merge", h.component.MergeComponents)
 			r.Delete("/{id}/experts", h.expert.RemoveComponentExpert)
 			r.Delete("/{componentId}/origin/acquired-via", h.originRelationship.DeleteAcquiredViaRelationship)
 			r.Delete("/{componentId}/origin/purchased-from", h.originRelationship.DeletePurchasedFromRelationship)
@@ -247,6 +311,16 @@ func registerRelationRoutes(r chi.Router, h *httpHandlerSet, auth AuthMiddleware
 			r.Delete("/{id}", h.relation.DeleteComponentRelation)
 		})
 	})
+
+	r.Route("/relation-types", func(r chi.Router) {
+		r.Use(auth.RequirePermission(authPL.PermComponentsRead))
+		r.Get("/", h.relationType.GetRelationTypes)
+	})
+
+	r.Route("/classification-dimensions", func(r chi.Router) {
+		r.Use(auth.RequirePermission(authPL.PermComponentsRead))
+		r.Get("/", h.classificationDim.GetClassificationDimensions)
+	})
 }
 
 func registerOriginEntityRoutes(r chi.Router, h *httpHandlerSet, auth AuthMiddleware) {
@@ -275,10 +349,14 @@ func registerOriginEntityRoutes(r chi.Router, h *httpHandlerSet, auth AuthMiddle
 			r.Use(auth.RequirePermission(authPL.PermComponentsRead))
 			r.Get("/", h.vendor.GetAllVendors)
 			r.Get("/{id}", h.vendor.GetVendorByID)
+			r.Get("/{id}/contracts", h.vendorContract.GetVendorContracts)
+			r.Get("/{id}/contracts/{contractId}", h.vendorContract.GetVendorContractByID)
 		})
 		r.Group(func(r chi.Router) {
 			r.Use(auth.RequirePermission(authPL.PermComponentsWrite))
 			r.Post("/", h.vendor.CreateVendor)
+			r.Post("/{id}/contracts", h.vendorContract.CreateVendorContract)
+			r.Put("/{id}/contracts/{contractId}", h.vendorContract.UpdateVendorContract)
 		})
 		r.Group(func(r chi.Router) {
 			r.Use(sharedAPI.RequireWriteOrEditGrantFor("components", "vendors", "id"))
@@ -287,6 +365,14 @@ func registerOriginEntityRoutes(r chi.Router, h *httpHandlerSet, auth AuthMiddle
 		r.Group(func(r chi.Router) {
 			r.Use(auth.RequirePermission(authPL.PermComponentsDelete))
 			r.Delete("/{id}", h.vendor.DeleteVendor)
+			r.Delete("/{id}/contracts/{contractId}", h.vendorContract.DeleteVendorContract)
+		})
+	})
+
+	r.Route("/vendor-contracts", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(auth.RequirePermission(authPL.PermComponentsRead))
+			r.Get("/notice-deadlines", h.vendorContract.GetUpcomingNoticeDeadlines)
 		})
 	})
 
@@ -0,0 +1,327 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"easi/backend/internal/architecturemodeling/application/commands"
+	"easi/backend/internal/architecturemodeling/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+)
+
+const (
+	contractDateLayout              = "2006-01-02"
+	defaultNoticeDeadlineWindowDays = 90
+)
+
+var errNoticeWindowReversed = errors.New("'to' must not be before 'from'")
+
+type VendorContractHandlers struct {
+	commandBus cqrs.CommandBus
+	readModel  *readmodels.VendorContractReadModel
+	hateoas    *ArchitectureModelingLinks
+}
+
+func NewVendorContractHandlers(
+	commandBus cqrs.CommandBus,
+	readModel *readmodels.VendorContractReadModel,
+	hateoas *ArchitectureModelingLinks,
+) *VendorContractHandlers {
+	return &VendorContractHandlers{
+		commandBus: commandBus,
+		readModel:  readModel,
+		hateoas:    hateoas,
+	}
+}
+
+type VendorContractRequest struct {
+	Name                string   `json:"name"`
+	StartDate           string   `json:"startDate"`
+	EndDate             string   `json:"endDate"`
+	NoticePeriodDays    int      `json:"noticePeriodDays"`
+	ValueAmount         float64  `json:"valueAmount,omitempty"`
+	ValueCurrency       string   `json:"valueCurrency,omitempty"`
+	Owner               string   `json:"owner"`
+	CoveredComponentIDs []string `json:"coveredComponentIds,omitempty"`
+	Notes               string   `json:"notes,omitempty"`
+}
+
+func (req VendorContractRequest) toFields() (commands.VendorContractFields, error) {
+	startDate, err := parseContractDate(req.StartDate)
+	if err != nil {
+		return commands.VendorContractFields{}, err
+	}
+	endDate, err := parseContractDate(req.EndDate)
+	if err != nil {
+		return commands.VendorContractFields{}, err
+	}
+	return commands.VendorContractFields{
+		Name:                req.Name,
+		StartDate:           startDate,
+		EndDate:             endDate,
+		NoticePeriodDays:    req.NoticePeriodDays,
+		ValueAmount:         req.ValueAmount,
+		ValueCurrency:       req.ValueCurrency,
+		Owner:               req.Owner,
+		CoveredComponentIDs: req.CoveredComponentIDs,
+		Notes:               req.Notes,
+	}, nil
+}
+
+// CreateVendorContract godoc
+// @Summary Create a vendor contract
+// @Description Registers a contract with a vendor. A notice period is mandatory; covered components must be purchased from the vendor.
+// @Tags vendors
+// @Accept json
+// @Produce json
+// @Param id path string true "Vendor ID"
+// @Param contract body VendorContractRequest true "Contract data"
+// @Success 201 {object} readmodels.VendorContractDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /vendors/{id}/contracts [post]
+func (h *VendorContractHandlers) CreateVendorContract(w http.ResponseWriter, r *http.Request) {
+	vendorID := sharedAPI.GetPathParam(r, "id")
+
+	req, ok := sharedAPI.DecodeRequestOrFail[VendorContractRequest](w, r)
+	if !ok {
+		return
+	}
+
+	fields, err := req.toFields()
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusBadRequest, err, "Invalid contract date format (expected YYYY-MM-DD)")
+		return
+	}
+
+	result, err := h.commandBus.Dispatch(r.Context(), &commands.CreateVendorContract{
+		VendorID:             vendorID,
+		VendorContractFields: fields,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	location := sharedAPI.BuildSubResourceLink(sharedAPI.ResourcePath("/vendors"), sharedAPI.ResourceID(vendorID), sharedAPI.ResourcePath("/contracts/"+result.CreatedID))
+	contract, err := h.readModel.GetByID(r.Context(), result.CreatedID)
+	if err != nil {
+		sharedAPI.HandleErrorWithDefault(w, err, "Failed to retrieve created vendor contract")
+		return
+	}
+
+	if contract == nil {
+		sharedAPI.RespondCreated(w, location, map[string]string{
+			"id":      result.CreatedID,
+			"message": "Vendor contract created, processing",
+		})
+		return
+	}
+
+	h.enrichWithLinks(r, contract)
+	sharedAPI.RespondCreated(w, location, contract)
+}
+
+// GetVendorContracts godoc
+// @Summary Get contracts for a vendor
+// @Description Retrieves all contracts held with a vendor, ordered by end date
+// @Tags vendors
+// @Produce json
+// @Param id path string true "Vendor ID"
+// @Success 200 {object} sharedAPI.CollectionResponse{data=[]readmodels.VendorContractDTO}
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /vendors/{id}/contracts [get]
+func (h *VendorContractHandlers) GetVendorContracts(w http.ResponseWriter, r *http.Request) {
+	vendorID := sharedAPI.GetPathParam(r, "id")
+
+	contracts, err := h.readModel.GetByVendorID(r.Context(), vendorID)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve vendor contracts")
+		return
+	}
+
+	h.respondContracts(w, r, contracts, "/vendors/"+vendorID+"/contracts")
+}
+
+// GetVendorContractByID godoc
+// @Summary Get a vendor contract
+// @Description Retrieves a specific contract held with a vendor
+// @Tags vendors
+// @Produce json
+// @Param id path string true "Vendor ID"
+// @Param contractId path string true "Contract ID"
+// @Success 200 {object} readmodels.VendorContractDTO
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /vendors/{id}/contracts/{contractId} [get]
+func (h *VendorContractHandlers) GetVendorContractByID(w http.ResponseWriter, r *http.Request) {
+	vendorID := sharedAPI.GetPathParam(r, "id")
+	contractID := sharedAPI.GetPathParam(r, "contractId")
+
+	contract, err := h.readModel.GetByID(r.Context(), contractID)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve vendor contract")
+		return
+	}
+
+	if contract == nil || contract.VendorID != vendorID {
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "Vendor contract not found")
+		return
+	}
+
+	h.enrichWithLinks(r, contract)
+	sharedAPI.RespondJSON(w, http.StatusOK, contract)
+}
+
+// UpdateVendorContract godoc
+// @Summary Update a vendor contract
+// @Description Replaces the terms of an existing vendor contract
+// @Tags vendors
+// @Accept json
+// @Produce json
+// @Param id path string true "Vendor ID"
+// @Param contractId path string true "Contract ID"
+// @Param contract body VendorContractRequest true "Updated contract data"
+// @Success 200 {object} readmodels.VendorContractDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /vendors/{id}/contracts/{contractId} [put]
+func (h *VendorContractHandlers) UpdateVendorContract(w http.ResponseWriter, r *http.Request) {
+	vendorID := sharedAPI.GetPathParam(r, "id")
+	contractID := sharedAPI.GetPathParam(r, "contractId")
+
+	req, ok := sharedAPI.DecodeRequestOrFail[VendorContractRequest](w, r)
+	if !ok {
+		return
+	}
+
+	fields, err := req.toFields()
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusBadRequest, err, "Invalid contract date format (expected YYYY-MM-DD)")
+		return
+	}
+
+	if _, err := h.commandBus.Dispatch(r.Context(), &commands.UpdateVendorContract{
+		ID:                   contractID,
+		VendorID:             vendorID,
+		VendorContractFields: fields,
+	}); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	contract, err := h.readModel.GetByID(r.Context(), contractID)
+	if err != nil {
+		sharedAPI.HandleErrorWithDefault(w, err, "Failed to retrieve updated vendor contract")
+		return
+	}
+
+	if contract == nil {
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "Vendor contract not found")
+		return
+	}
+
+	h.enrichWithLinks(r, contract)
+	sharedAPI.RespondJSON(w, http.StatusOK, contract)
+}
+
+// DeleteVendorContract godoc
+// @Summary Delete a vendor contract
+// @Description Removes a contract from a vendor
+// @Tags vendors
+// @Produce json
+// @Param id path string true "Vendor ID"
+// @Param contractId path string true "Contract ID"
+// @Success 204
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /vendors/{id}/contracts/{contractId} [delete]
+func (h *VendorContractHandlers) DeleteVendorContract(w http.ResponseWriter, r *http.Request) {
+	cmd := &commands.DeleteVendorContract{
+		ID:       sharedAPI.GetPathParam(r, "contractId"),
+		VendorID: sharedAPI.GetPathParam(r, "id"),
+	}
+
+	result, err := h.commandBus.Dispatch(r.Context(), cmd)
+	sharedAPI.HandleCommandResult(w, result, err, func(_ string) {
+		sharedAPI.RespondDeleted(w)
+	})
+}
+
+// GetUpcomingNoticeDeadlines godoc
+// @Summary Get vendor contracts by notice deadline
+// @Description Retrieves contracts across all vendors whose notice deadline falls within a date window. Defaults to the next 90 days. No event is published as a deadline approaches; poll this endpoint to raise notice alerts.
+// @Tags vendors
+// @Produce json
+// @Param from query string false "Window start (YYYY-MM-DD), defaults to today"
+// @Param to query string false "Window end (YYYY-MM-DD), defaults to 90 days after from"
+// @Success 200 {object} sharedAPI.CollectionResponse{data=[]readmodels.VendorContractDTO}
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /vendor-contracts/notice-deadlines [get]
+func (h *VendorContractHandlers) GetUpcomingNoticeDeadlines(w http.ResponseWriter, r *http.Request) {
+	from, to, err := parseNoticeWindow(r, time.Now().UTC())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusBadRequest, err, "Invalid notice deadline window (expected YYYY-MM-DD)")
+		return
+	}
+
+	contracts, err := h.readModel.GetByNoticeDeadlineWindow(r.Context(), from, to)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve vendor contracts")
+		return
+	}
+
+	h.respondContracts(w, r, contracts, "/vendor-contracts/notice-deadlines")
+}
+
+func (h *VendorContractHandlers) respondContracts(w http.ResponseWriter, r *http.Request, contracts []readmodels.VendorContractDTO, selfPath string) {
+	for i := range contracts {
+		h.enrichWithLinks(r, &contracts[i])
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, contracts, sharedAPI.Links{
+		"self": h.hateoas.Get(selfPath),
+	})
+}
+
+func (h *VendorContractHandlers) enrichWithLinks(r *http.Request, contract *readmodels.VendorContractDTO) {
+	actor, _ := sharedctx.GetActor(r.Context())
+	contract.Links = h.hateoas.VendorContractLinksForActor(contract.VendorID, contract.ID, actor)
+}
+
+func parseContractDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(contractDateLayout, value)
+}
+
+func parseNoticeWindow(r *http.Request, now time.Time) (time.Time, time.Time, error) {
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if raw := r.URL.Query().Get("from"); raw != "" {
+		parsed, err := time.Parse(contractDateLayout, raw)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		from = parsed
+	}
+
+	to := from.AddDate(0, 0, defaultNoticeDeadlineWindowDays)
+	if raw := r.URL.Query().Get("to"); raw != "" {
+		parsed, err := time.Parse(contractDateLayout, raw)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		to = parsed
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, errNoticeWindowReversed
+	}
+	return from, to, nil
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNoticeWindow_DefaultsToNext90Days(t *testing.T) {
+	now := time.Date(2026, 3, 15, 14, 30, 0, 0, time.UTC)
+	req := httptest.NewRequest("GET", "/vendor-contracts/notice-deadlines", nil)
+
+	from, to, err := parseNoticeWindow(req, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2026, 6, 13, 0, 0, 0, 0, time.UTC), to)
+}
+
+func TestParseNoticeWindow_ExplicitBounds(t *testing.T) {
+	req := httptest.NewRequest("GET", "/vendor-contracts/notice-deadlines?from=2026-01-01&to=2026-12-31", nil)
+
+	from, to, err := parseNoticeWindow(req, time.Now())
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), to)
+}
+
+func TestParseNoticeWindow_Rejects(t *testing.T) {
+	cases := []struct {
+		name  string
+		query string
+	}{
+		{name: "malformed from", query: "?from=01-01-2026"},
+		{name: "malformed to", query: "?to=tomorrow"},
+		{name: "reversed window", query: "?from=2026-06-01&to=2026-01-01"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/vendor-contracts/notice-deadlines"+tc.query, nil)
+			_, _, err := parseNoticeWindow(req, time.Now())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestVendorContractLinksForActor(t *testing.T) {
+	links := NewArchitectureModelingLinks(sharedAPI.NewHATEOASLinks("/api/v1"))
+
+	t.Run("architect can edit and delete", func(t *testing.T) {
+		actor := sharedctx.NewActor("u1", "u@example.com", sharedctx.RoleArchitect)
+		result := links.VendorContractLinksForActor("v1", "c1", actor)
+
+		assert.Equal(t, "/api/v1/vendors/v1/contracts/c1", result["self"].Href)
+		assert.Equal(t, "/api/v1/vendors/v1", result["x-vendor"].Href)
+		assert.Contains(t, result, "edit")
+		assert.Contains(t, result, "delete")
+	})
+
+	t.Run("stakeholder is read only", func(t *testing.T) {
+		actor := sharedctx.NewActor("u2", "s@example.com", sharedctx.RoleStakeholder)
+		result := links.VendorContractLinksForActor("v1", "c1", actor)
+
+		assert.NotContains(t, result, "edit")
+		assert.NotContains(t, result, "delete")
+	})
+}
@@ -61,8 +61,8 @@ func newXRelatedFixture(db *sql.DB) *xRelatedFixture {
 	relationRepo := repositories.NewComponentRelationRepository(eventStore)
 	createComp := handlers.NewCreateApplicationComponentHandler(componentRepo)
 	updateComp := handlers.NewUpdateApplicationComponentHandler(componentRepo)
-	deleteComp := handlers.NewDeleteApplicationComponentHandler(componentRepo, relationReadM, commandBus)
-	createRel := handlers.NewCreateComponentRelationHandler(relationRepo)
+	deleteComp := handlers.NewDeleteApplicationComponentHandler(componentRepo, relationReadM, componentReadM, commandBus)
+	createRel := handlers.NewCreateComponentRelationHandler(relationRepo, readmodels.NewCustomRelationTypeCacheReadModel(tenantDB))
 	deleteRel := handlers.NewDeleteComponentRelationHandler(relationRepo)
 	commandBus.Register("CreateApplicationComponent", createComp)
 	commandBus.Register("UpdateApplicationComponent", updateComp)
@@ -28,10 +28,19 @@ func NewApplicationComponentRepository(eventStore eventstore.EventStore) *Applic
 
 var componentEventDeserializers = repository.NewEventDeserializers(
 	map[string]repository.EventDeserializerFunc{
-		"ApplicationComponentCreated":       repository.JSONDeserializer[events.ApplicationComponentCreated],
-		"ApplicationComponentUpdated":       repository.JSONDeserializer[events.ApplicationComponentUpdated],
-		"ApplicationComponentDeleted":       repository.JSONDeserializer[events.ApplicationComponentDeleted],
-		"ApplicationComponentExpertAdded":   repository.JSONDeserializer[events.ApplicationComponentExpertAdded],
-		"ApplicationComponentExpertRemoved": repository.JSONDeserializer[events.ApplicationComponentExpertRemoved],
+		"ApplicationComponentCreated":               repository.JSONDeserializer[events.ApplicationComponentCreated],
+		"ApplicationComponentUpdated":               repository.JSONDeserializer[events.ApplicationComponentUpdated],
+		"ApplicationComponentDeleted":               repository.JSONDeserializer[events.ApplicationComponentDeleted],
+		"ApplicationComponentExpertAdded":           repository.JSONDeserializer[events.ApplicationComponentExpertAdded],
+		"ApplicationComponentExpertRemoved":         repository.JSONDeserializer[events.ApplicationComponentExpertRemoved],
+		"ApplicationComponentParentChanged":         repository.JSONDeserializer[events.ApplicationComponentParentChanged],
+		"ApplicationComponentTagAdded":              repository.JSONDeserializer[events.ApplicationComponentTagAdded],
+		"ApplicationComponentTagRemoved":            repository.JSONDeserializer[events.ApplicationComponentTagRemoved],
+		"ApplicationComponentClassified":            repository.JSONDeserializer[events.ApplicationComponentClassified],
+		"ApplicationComponentClassificationCleared": repository.JSONDeserializer[events.ApplicationComponentClassificationCleared],
+		"ApplicationComponentMergedInto":            repository.JSONDeserializer[events.ApplicationComponentMergedInto],
+		"ApplicationComponentDuplicateAbsorbed":     repository.JSONDeserializer[events.ApplicationComponentDuplicateAbsorbed],
+		"ApplicationComponentRelationMoved":         repository.JSONDeserializer[events.ApplicationComponentRelationMoved],
+		"ApplicationComponentChildMoved":            repository.JSONDeserializer[events.ApplicationComponentChildMoved],
 	},
 )
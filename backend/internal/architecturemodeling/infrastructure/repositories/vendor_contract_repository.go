@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"errors"
+
+	"easi/backend/internal/architecturemodeling/domain/aggregates"
+	"easi/backend/internal/architecturemodeling/domain/events"
+	"easi/backend/internal/infrastructure/eventstore"
+	"easi/backend/internal/shared/infrastructure/repository"
+)
+
+var ErrVendorContractNotFound = errors.New("vendor contract not found")
+
+type VendorContractRepository struct {
+	*repository.EventSourcedRepository[*aggregates.VendorContract]
+}
+
+func NewVendorContractRepository(eventStore eventstore.EventStore) *VendorContractRepository {
+	return &VendorContractRepository{
+		EventSourcedRepository: repository.NewEventSourcedRepository(
+			eventStore,
+			vendorContractEventDeserializers,
+			aggregates.LoadVendorContractFromHistory,
+			ErrVendorContractNotFound,
+		),
+	}
+}
+
+var vendorContractEventDeserializers = repository.NewEventDeserializers(
+	map[string]repository.EventDeserializerFunc{
+		"VendorContractCreated": repository.JSONDeserializer[events.VendorContractCreated],
+		"VendorContractUpdated": repository.JSONDeserializer[events.VendorContractUpdated],
+		"VendorContractDeleted": repository.JSONDeserializer[events.VendorContractDeleted],
+	},
+)
@@ -10,6 +10,7 @@ func AgentTools() []pl.AgentToolSpec {
 	specs = append(specs, originEntityTools()...)
 	specs = append(specs, originLinkTools()...)
 	specs = append(specs, originEntityCRUDTools()...)
+	specs = append(specs, vendorContractTools()...)
 	return specs
 }
 
@@ -30,6 +31,35 @@ func applicationTools() []pl.AgentToolSpec {
 			Method: "GET", Path: "/components/{id}",
 			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Application ID (UUID)")},
 		},
+		{
+			Name: "get_application_hierarchy", Description: "Get all application components arranged as a parent/child tree, e.g. an ERP suite such as SAP S/4 with its modules (FI, CO, MM) as children. Top-level applications are the roots; each node lists its children.",
+			Access: pl.AccessRead, Permission: "components:read",
+			Method: "GET", Path: "/components/tree",
+		},
+		{
+			Name: "search_applications", Description: "Search application components by free text over name, description and expert names, optionally narrowed by tag and classification value (e.g. hosting model, business criticality). Returns matching applications plus facet counts per tag and classification value across all matches, useful for portfolio breakdowns. Call list_classification_dimensions to discover dimension IDs and allowed values.",
+			Access: pl.AccessRead, Permission: "components:read",
+			Method: "GET", Path: "/components/search",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("q", "Free-text search; every word is matched as a prefix", false),
+				pl.StringParam("tag", "Only applications carrying this tag", false),
+				pl.StringParam("classification", "Only applications with this classification, as dimensionId:value", false),
+				pl.IntParam("limit", "Max results (1-50, default 20)"),
+			},
+		},
+		{
+			Name: "find_duplicate_applications", Description: "Find pairs of application components that are probably the same system (e.g. 'Salesforce', 'SalesForce CRM', 'SFDC'), scored by fuzzy name similarity plus shared vendor or building team. Useful for portfolio clean-up before merging duplicates.",
+			Access: pl.AccessRead, Permission: "components:read",
+			Method: "GET", Path: "/components/duplicates",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("minScore", "Minimum score between 0 and 1 (default 0.5); lower finds more, weaker candidates", false),
+			},
+		},
+		{
+			Name: "list_classification_dimensions", Description: "List the tenant-configured classification dimensions for applications (e.g. hosting model, business criticality, user base) with their allowed values.",
+			Access: pl.AccessRead, Permission: "components:read",
+			Method: "GET", Path: "/classification-dimensions",
+		},
 		{
 			Name: "create_application", Description: "Register a new application component (IT system) in the architecture portfolio. The application can then be linked to capabilities via realizations, related to other applications, and scored against strategy pillars.",
 			Access: pl.AccessCreate, Permission: "components:write",
@@ -56,13 +86,18 @@ func applicationTools() []pl.AgentToolSpec {
 			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Application ID (UUID)")},
 		},
 		{
-			Name: "create_application_relation", Description: "Create a directed relation between two application components (e.g. depends_on, uses, sends_data_to). Relations model integration dependencies and data flows between systems.",
+			Name: "list_relation_types", Description: "List the relation types that can be used between application components: the built-in ArchiMate-aligned types (Triggers, Serves, Flow, AccessRead, AccessWrite, AccessReadWrite, Composition, Aggregation) and the tenant's active custom types. Use the returned value as relationType when creating a relation.",
+			Access: pl.AccessRead, Permission: "components:read",
+			Method: "GET", Path: "/relation-types",
+		},
+		{
+			Name: "create_application_relation", Description: "Create a directed relation between two application components (e.g. Serves, Flow, AccessRead). Relations model integration dependencies and data flows between systems. Call list_relation_types to discover custom types defined by the tenant.",
 			Access: pl.AccessCreate, Permission: "components:write",
 			Method: "POST", Path: "/relations",
 			BodyParams: []pl.ParamSpec{
 				{Name: "sourceComponentId", Type: "uuid", Description: "Source application ID (UUID)", Required: true},
 				{Name: "targetComponentId", Type: "uuid", Description: "Target application ID (UUID)", Required: true},
-				pl.StringParam("relationType", "Relation type (e.g. depends_on, uses, sends_data_to)", true),
+				pl.StringParam("relationType", "Relation type value from list_relation_types (e.g. Serves, Flow, custom:<uuid>)", true),
 				pl.StringParam("description", "Relation description", false),
 			},
 		},
@@ -243,3 +278,32 @@ func originEntityCRUDTools() []pl.AgentToolSpec {
 		},
 	}
 }
+
+func vendorContractTools() []pl.AgentToolSpec {
+	return []pl.AgentToolSpec{
+		{
+			Name: "list_vendor_contracts", Description: "List the contracts held with a vendor, including term dates, notice period, computed notice deadline, value, owner, and the application components each contract covers.",
+			Access: pl.AccessRead, Permission: "components:read",
+			Method: "GET", Path: "/vendors/{id}/contracts",
+			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Vendor ID (UUID)")},
+		},
+		{
+			Name: "get_vendor_contract_details", Description: "Get a single vendor contract by ID, including its notice deadline and covered application components.",
+			Access: pl.AccessRead, Permission: "components:read",
+			Method: "GET", Path: "/vendors/{id}/contracts/{contractId}",
+			PathParams: []pl.ParamSpec{
+				pl.UUIDParam("id", "Vendor ID (UUID)"),
+				pl.UUIDParam("contractId", "Vendor contract ID (UUID)"),
+			},
+		},
+		{
+			Name: "list_upcoming_notice_deadlines", Description: "List vendor contracts whose notice deadline falls within a date window, ordered by deadline. Use to find contracts that must be renegotiated or terminated soon. Defaults to the next 90 days.",
+			Access: pl.AccessRead, Permission: "components:read",
+			Method: "GET", Path: "/vendor-contracts/notice-deadlines",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("from", "Window start (YYYY-MM-DD, default today)", false),
+				pl.StringParam("to", "Window end (YYYY-MM-DD, default today + 90 days)", false),
+			},
+		},
+	}
+}
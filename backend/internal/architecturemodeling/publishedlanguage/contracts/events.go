@@ -21,3 +21,109 @@ type ApplicationComponentDeletedPayload struct {
 	Name      string    `json:"name"`
 	DeletedAt time.Time `json:"deletedAt"`
 }
+
+type ApplicationComponentParentChangedPayload struct {
+	ID          string    `json:"id"`
+	OldParentID string    `json:"oldParentId"`
+	NewParentID string    `json:"newParentId"`
+	ChangedAt   time.Time `json:"changedAt"`
+}
+
+type ApplicationComponentMergedIntoPayload struct {
+	ID           string    `json:"id"`
+	SurvivorID   string    `json:"survivorId"`
+	SurvivorName string    `json:"survivorName"`
+	MergedBy     string    `json:"mergedBy"`
+	MergedAt     time.Time `json:"mergedAt"`
+}
+
+type ApplicationComponentDuplicateAbsorbedPayload struct {
+	ID            string    `json:"id"`
+	DuplicateID   string    `json:"duplicateId"`
+	DuplicateName string    `json:"duplicateName"`
+	MergedBy      string    `json:"mergedBy"`
+	MergedAt      time.Time `json:"mergedAt"`
+}
+
+type ApplicationComponentRelationMovedPayload struct {
+	ID              string    `json:"id"`
+	RelationID      string    `json:"relationId"`
+	NewRelationID   string    `json:"newRelationId,omitempty"`
+	FromComponentID string    `json:"fromComponentId"`
+	ToComponentID   string    `json:"toComponentId"`
+	MergedBy        string    `json:"mergedBy"`
+	MovedAt         time.Time `json:"movedAt"`
+}
+
+type ApplicationComponentChildMovedPayload struct {
+	ID              string    `json:"id"`
+	ChildID         string    `json:"childId"`
+	FromComponentID string    `json:"fromComponentId"`
+	ToComponentID   string    `json:"toComponentId"`
+	MergedBy        string    `json:"mergedBy"`
+	MovedAt         time.Time `json:"movedAt"`
+}
+
+type ApplicationComponentTagAddedPayload struct {
+	ComponentID string    `json:"componentId"`
+	Tag         string    `json:"tag"`
+	AddedAt     time.Time `json:"addedAt"`
+}
+
+type ApplicationComponentTagRemovedPayload struct {
+	ComponentID string    `json:"componentId"`
+	Tag         string    `json:"tag"`
+	RemovedAt   time.Time `json:"removedAt"`
+}
+
+type ApplicationComponentClassifiedPayload struct {
+	ComponentID  string    `json:"componentId"`
+	DimensionID  string    `json:"dimensionId"`
+	Value        string    `json:"value"`
+	ClassifiedAt time.Time `json:"classifiedAt"`
+}
+
+type ApplicationComponentClassificationClearedPayload struct {
+	ComponentID string    `json:"componentId"`
+	DimensionID string    `json:"dimensionId"`
+	ClearedAt   time.Time `json:"clearedAt"`
+}
+
+type VendorContractCreatedPayload struct {
+	ID                  string    `json:"id"`
+	VendorID            string    `json:"vendorId"`
+	Name                string    `json:"name"`
+	StartDate           time.Time `json:"startDate"`
+	EndDate             time.Time `json:"endDate"`
+	NoticePeriodDays    int       `json:"noticePeriodDays"`
+	NoticeDeadline      time.Time `json:"noticeDeadline"`
+	ValueAmount         float64   `json:"valueAmount"`
+	ValueCurrency       string    `json:"valueCurrency"`
+	Owner               string    `json:"owner"`
+	CoveredComponentIDs []string  `json:"coveredComponentIds"`
+	Notes               string    `json:"notes"`
+	CreatedAt           time.Time `json:"createdAt"`
+}
+
+type VendorContractUpdatedPayload struct {
+	ID                  string    `json:"id"`
+	VendorID            string    `json:"vendorId"`
+	Name                string    `json:"name"`
+	StartDate           time.Time `json:"startDate"`
+	EndDate             time.Time `json:"endDate"`
+	NoticePeriodDays    int       `json:"noticePeriodDays"`
+	NoticeDeadline      time.Time `json:"noticeDeadline"`
+	ValueAmount         float64   `json:"valueAmount"`
+	ValueCurrency       string    `json:"valueCurrency"`
+	Owner               string    `json:"owner"`
+	CoveredComponentIDs []string  `json:"coveredComponentIds"`
+	Notes               string    `json:"notes"`
+	UpdatedAt           time.Time `json:"updatedAt"`
+}
+
+type VendorContractDeletedPayload struct {
+	ID        string    `json:"id"`
+	VendorID  string    `json:"vendorId"`
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deletedAt"`
+}
@@ -6,6 +6,17 @@ const (
 	ApplicationComponentDeleted       = "ApplicationComponentDeleted"
 	ApplicationComponentExpertAdded   = "ApplicationComponentExpertAdded"
 	ApplicationComponentExpertRemoved = "ApplicationComponentExpertRemoved"
+	ApplicationComponentParentChanged = "ApplicationComponentParentChanged"
+	ApplicationComponentTagAdded      = "ApplicationComponentTagAdded"
+	ApplicationComponentTagRemoved    = "ApplicationComponentTagRemoved"
+
+	ApplicationComponentClassified            = "ApplicationComponentClassified"
+	ApplicationComponentClassificationCleared = "ApplicationComponentClassificationCleared"
+
+	ApplicationComponentMergedInto        = "ApplicationComponentMergedInto"
+	ApplicationComponentDuplicateAbsorbed = "ApplicationComponentDuplicateAbsorbed"
+	ApplicationComponentRelationMoved     = "ApplicationComponentRelationMoved"
+	ApplicationComponentChildMoved        = "ApplicationComponentChildMoved"
 
 	ComponentRelationCreated = "ComponentRelationCreated"
 	ComponentRelationUpdated = "ComponentRelationUpdated"
@@ -19,6 +30,10 @@ const (
 	VendorUpdated = "VendorUpdated"
 	VendorDeleted = "VendorDeleted"
 
+	VendorContractCreated = "VendorContractCreated"
+	VendorContractUpdated = "VendorContractUpdated"
+	VendorContractDeleted = "VendorContractDeleted"
+
 	InternalTeamCreated = "InternalTeamCreated"
 	InternalTeamUpdated = "InternalTeamUpdated"
 	InternalTeamDeleted = "InternalTeamDeleted"
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"easi/backend/internal/architectureviews/application/commands"
+	"easi/backend/internal/architectureviews/application/readmodels"
+	"easi/backend/internal/shared/cqrs"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// ApplicationComponentMergedHandler places the surviving component of a merge on
+// every view the duplicate appeared on, at the duplicate's position. The
+// duplicate itself is removed from views when its deletion follows.
+type ApplicationComponentMergedHandler struct {
+	commandBus cqrs.CommandBus
+	readModel  *readmodels.ArchitectureViewReadModel
+}
+
+func NewApplicationComponentMergedHandler(
+	commandBus cqrs.CommandBus,
+	readModel *readmodels.ArchitectureViewReadModel,
+) *ApplicationComponentMergedHandler {
+	return &ApplicationComponentMergedHandler{
+		commandBus: commandBus,
+		readModel:  readModel,
+	}
+}
+
+func (h *ApplicationComponentMergedHandler) Handle(ctx context.Context, event domain.DomainEvent) error {
+	duplicateID := event.AggregateID()
+	survivorID := survivorIDFrom(event)
+	if survivorID == "" {
+		log.Printf("ApplicationComponentMergedInto for component %s carries no survivor", duplicateID)
+		return nil
+	}
+
+	viewIDs, err := h.readModel.GetViewsContainingComponent(ctx, duplicateID)
+	if err != nil {
+		log.Printf("Error querying views containing component %s: %v", duplicateID, err)
+		return err
+	}
+
+	for _, viewID := range viewIDs {
+		h.placeSurvivor(ctx, viewID, duplicateID, survivorID)
+	}
+
+	return nil
+}
+
+func (h *ApplicationComponentMergedHandler) placeSurvivor(ctx context.Context, viewID, duplicateID, survivorID string) {
+	view, err := h.readModel.GetByID(ctx, viewID)
+	if err != nil || view == nil {
+		log.Printf("Error loading view %s: %v", viewID, err)
+		return
+	}
+
+	var duplicatePosition *readmodels.ComponentPositionDTO
+	for i := range view.Components {
+		switch view.Components[i].ComponentID {
+		case survivorID:
+			return
+		case duplicateID:
+			duplicatePosition = &view.Components[i]
+		}
+	}
+	if duplicatePosition == nil {
+		return
+	}
+
+	cmd := commands.AddComponentToView{
+		ViewID:      viewID,
+		ComponentID: survivorID,
+		X:           duplicatePosition.X,
+		Y:           duplicatePosition.Y,
+	}
+	if _, err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		log.Printf("Error adding component %s to view %s: %v", survivorID, viewID, err)
+		return
+	}
+
+	log.Printf("Replaced merged component %s with %s on view %s", duplicateID, survivorID, viewID)
+}
+
+func survivorIDFrom(event domain.DomainEvent) string {
+	data, err := json.Marshal(event.EventData())
+	if err != nil {
+		return ""
+	}
+	var payload struct {
+		SurvivorID string `json:"survivorId"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return ""
+	}
+	return payload.SurvivorID
+}
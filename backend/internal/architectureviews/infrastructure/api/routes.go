@@ -37,9 +37,11 @@ func SubscribeEvents(eventBus events.EventBus, commandBus *cqrs.InMemoryCommandB
 	eventBus.Subscribe(viewsPL.ViewVisibilityChanged, viewProjector)
 
 	componentDeletedHandler := handlers.NewApplicationComponentDeletedHandler(commandBus, viewReadModel)
+	componentMergedHandler := handlers.NewApplicationComponentMergedHandler(commandBus, viewReadModel)
 	relationDeletedHandler := handlers.NewComponentRelationDeletedHandler()
 
 	eventBus.Subscribe(archPL.ApplicationComponentDeleted, componentDeletedHandler)
+	eventBus.Subscribe(archPL.ApplicationComponentMergedInto, componentMergedHandler)
 	eventBus.Subscribe(archPL.ComponentRelationDeleted, relationDeletedHandler)
 }
 
@@ -223,6 +223,36 @@ func (rm *UserReadModel) CountActiveAdmins(ctx context.Context) (int, error) {
 	return count, err
 }
 
+func (rm *UserReadModel) GetActiveAdminIDs(ctx context.Context) ([]string, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT id FROM auth.users WHERE tenant_id = $1 AND role = 'admin' AND status = 'active' ORDER BY id`,
+			tenantID.Value(),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		return rows.Err()
+	})
+
+	return ids, err
+}
+
 func (rm *UserReadModel) IsLastActiveAdmin(ctx context.Context, userID string) (bool, error) {
 	user, err := rm.GetByIDString(ctx, userID)
 	if err != nil {
@@ -0,0 +1,12 @@
+package commands
+
+type AssignCapabilityOwner struct {
+	CapabilityID string
+	PartyType    string
+	PartyID      string
+	Role         string
+}
+
+func (c AssignCapabilityOwner) CommandName() string {
+	return "AssignCapabilityOwner"
+}
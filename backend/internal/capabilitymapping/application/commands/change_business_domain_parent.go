@@ -0,0 +1,10 @@
+package commands
+
+type ChangeBusinessDomainParent struct {
+	ID       string
+	ParentID string
+}
+
+func (c ChangeBusinessDomainParent) CommandName() string {
+	return "ChangeBusinessDomainParent"
+}
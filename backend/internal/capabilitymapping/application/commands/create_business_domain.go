@@ -1,9 +1,11 @@
 package commands
 
 type CreateBusinessDomain struct {
-	Name              string
-	Description       string
-	DomainArchitectID string
+	Name               string
+	Description        string
+	ParentID           string
+	DomainArchitectID  string
+	DomainArchitectIDs []string
 }
 
 func (c CreateBusinessDomain) CommandName() string {
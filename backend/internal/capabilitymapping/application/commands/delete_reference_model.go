@@ -0,0 +1,9 @@
+package commands
+
+type DeleteReferenceModel struct {
+	ID string
+}
+
+func (c DeleteReferenceModel) CommandName() string {
+	return "DeleteReferenceModel"
+}
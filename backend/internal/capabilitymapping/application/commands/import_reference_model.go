@@ -0,0 +1,21 @@
+package commands
+
+type ReferenceEntryInput struct {
+	Code        string
+	Name        string
+	Description string
+	ParentCode  string
+}
+
+type ImportReferenceModel struct {
+	Name        string
+	Framework   string
+	Version     string
+	Description string
+	Entries     []ReferenceEntryInput
+	ImportedBy  string
+}
+
+func (c ImportReferenceModel) CommandName() string {
+	return "ImportReferenceModel"
+}
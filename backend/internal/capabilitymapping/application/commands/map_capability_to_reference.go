@@ -0,0 +1,12 @@
+package commands
+
+type MapCapabilityToReference struct {
+	CapabilityID     string
+	ReferenceModelID string
+	EntryCode        string
+	MappedBy         string
+}
+
+func (c MapCapabilityToReference) CommandName() string {
+	return "MapCapabilityToReference"
+}
@@ -0,0 +1,15 @@
+package commands
+
+// MergeCapabilities folds the merged capabilities into the survivor. Realizations,
+// dependencies, domain assignments and strategy importance ratings whose IDs are
+// listed in Discard are removed instead of being carried over.
+type MergeCapabilities struct {
+	SurvivorID string
+	MergedIDs  []string
+	Discard    []string
+	MergedBy   string
+}
+
+func (c MergeCapabilities) CommandName() string {
+	return "MergeCapabilities"
+}
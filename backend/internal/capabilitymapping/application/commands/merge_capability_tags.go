@@ -0,0 +1,12 @@
+package commands
+
+// MergeCapabilityTags folds every source tag into the target tag across all
+// capabilities.
+type MergeCapabilityTags struct {
+	Sources []string
+	Target  string
+}
+
+func (c MergeCapabilityTags) CommandName() string {
+	return "MergeCapabilityTags"
+}
@@ -0,0 +1,10 @@
+package commands
+
+type MoveSystemRealization struct {
+	ID           string
+	CapabilityID string
+}
+
+func (c MoveSystemRealization) CommandName() string {
+	return "MoveSystemRealization"
+}
@@ -0,0 +1,17 @@
+package commands
+
+// ReassignCapabilityOwners moves every role held by one party over to another.
+// An empty successor drops the roles; AccountableOnly hands over accountability
+// and drops the other roles.
+type ReassignCapabilityOwners struct {
+	FromType        string
+	FromID          string
+	ToType          string
+	ToID            string
+	AccountableOnly bool
+	Reason          string
+}
+
+func (c ReassignCapabilityOwners) CommandName() string {
+	return "ReassignCapabilityOwners"
+}
@@ -0,0 +1,11 @@
+package commands
+
+type ReassignSystemRealization struct {
+	ID            string
+	ComponentID   string
+	ComponentName string
+}
+
+func (c ReassignSystemRealization) CommandName() string {
+	return "ReassignSystemRealization"
+}
@@ -0,0 +1,10 @@
+package commands
+
+type RemoveCapabilityTag struct {
+	CapabilityID string
+	Tag          string
+}
+
+func (c RemoveCapabilityTag) CommandName() string {
+	return "RemoveCapabilityTag"
+}
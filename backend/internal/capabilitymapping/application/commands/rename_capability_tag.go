@@ -0,0 +1,12 @@
+package commands
+
+// RenameCapabilityTag replaces a tag with another on every capability that
+// carries it.
+type RenameCapabilityTag struct {
+	From string
+	To   string
+}
+
+func (c RenameCapabilityTag) CommandName() string {
+	return "RenameCapabilityTag"
+}
@@ -0,0 +1,21 @@
+package commands
+
+type SplitCapabilityPart struct {
+	Name        string
+	Description string
+}
+
+// SplitCapability divides a capability into new sibling capabilities. Distribution
+// maps the ID of a child capability, realization, dependency, domain assignment or
+// strategy importance rating to the index of the part that receives it; anything
+// not listed goes to the first part.
+type SplitCapability struct {
+	ID           string
+	Parts        []SplitCapabilityPart
+	Distribution map[string]int
+	SplitBy      string
+}
+
+func (c SplitCapability) CommandName() string {
+	return "SplitCapability"
+}
@@ -0,0 +1,11 @@
+package commands
+
+type UnassignCapabilityOwner struct {
+	CapabilityID string
+	PartyType    string
+	PartyID      string
+}
+
+func (c UnassignCapabilityOwner) CommandName() string {
+	return "UnassignCapabilityOwner"
+}
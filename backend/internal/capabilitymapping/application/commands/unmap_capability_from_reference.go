@@ -0,0 +1,12 @@
+package commands
+
+// UnmapCapabilityFromReference removes a mapping. When CapabilityID is set the
+// mapping must belong to that capability.
+type UnmapCapabilityFromReference struct {
+	MappingID    string
+	CapabilityID string
+}
+
+func (c UnmapCapabilityFromReference) CommandName() string {
+	return "UnmapCapabilityFromReference"
+}
@@ -1,10 +1,11 @@
 package commands
 
 type UpdateBusinessDomain struct {
-	ID                string
-	Name              string
-	Description       string
-	DomainArchitectID string
+	ID                 string
+	Name               string
+	Description        string
+	DomainArchitectID  string
+	DomainArchitectIDs []string
 }
 
 func (c UpdateBusinessDomain) CommandName() string {
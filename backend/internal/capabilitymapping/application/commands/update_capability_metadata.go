@@ -5,8 +5,6 @@ type UpdateCapabilityMetadata struct {
 	MaturityValue  int
 	MaturityLevel  string
 	OwnershipModel string
-	PrimaryOwner   string
-	EAOwner        string
 	Status         string
 }
 
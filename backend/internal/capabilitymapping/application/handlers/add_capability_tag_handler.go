@@ -5,6 +5,7 @@ import (
 
 	"easi/backend/internal/capabilitymapping/application/commands"
 	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/domain/services"
 	"easi/backend/internal/capabilitymapping/domain/valueobjects"
 	"easi/backend/internal/shared/cqrs"
 )
@@ -16,11 +17,13 @@ type AddCapabilityTagRepository interface {
 
 type AddCapabilityTagHandler struct {
 	repository AddCapabilityTagRepository
+	vocabulary services.CapabilityTagVocabularyProvider
 }
 
-func NewAddCapabilityTagHandler(repository AddCapabilityTagRepository) *AddCapabilityTagHandler {
+func NewAddCapabilityTagHandler(repository AddCapabilityTagRepository, vocabulary services.CapabilityTagVocabularyProvider) *AddCapabilityTagHandler {
 	return &AddCapabilityTagHandler{
 		repository: repository,
+		vocabulary: vocabulary,
 	}
 }
 
@@ -30,12 +33,16 @@ func (h *AddCapabilityTagHandler) Handle(ctx context.Context, cmd cqrs.Command)
 		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
 	}
 
-	capability, err := h.repository.GetByID(ctx, command.CapabilityID)
+	tag, err := valueobjects.NewTag(command.Tag)
 	if err != nil {
 		return cqrs.EmptyResult(), err
 	}
 
-	tag, err := valueobjects.NewTag(command.Tag)
+	if err := ensureTagPermitted(ctx, h.vocabulary, tag); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	capability, err := h.repository.GetByID(ctx, command.CapabilityID)
 	if err != nil {
 		return cqrs.EmptyResult(), err
 	}
@@ -50,3 +57,14 @@ func (h *AddCapabilityTagHandler) Handle(ctx context.Context, cmd cqrs.Command)
 
 	return cqrs.EmptyResult(), nil
 }
+
+func ensureTagPermitted(ctx context.Context, provider services.CapabilityTagVocabularyProvider, tag valueobjects.Tag) error {
+	vocabulary, err := provider.GetCapabilityTagVocabulary(ctx)
+	if err != nil {
+		return err
+	}
+	if !vocabulary.Permits(tag) {
+		return valueobjects.ErrTagNotInVocabulary
+	}
+	return nil
+}
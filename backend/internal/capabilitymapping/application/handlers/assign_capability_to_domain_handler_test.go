@@ -80,7 +80,7 @@ func createCapability(t *testing.T, level string) *aggregates.Capability {
 		parentID = valueobjects.NewCapabilityID()
 	}
 
-	capability, err := aggregates.NewCapability(name, description, parentID, capLevel)
+	capability, err := aggregates.NewCapability(name, description, parentID, capLevel, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 	capability.MarkChangesAsCommitted()
 
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/google/uuid"
+)
+
+const MaxBulkTargets = 200
+
+var (
+	ErrBulkTargetsRequired = errors.New("at least one target ID is required")
+	ErrBulkTooManyTargets  = errors.New("too many bulk edit targets")
+	ErrBulkPatchEmpty      = errors.New("bulk edit patch must change at least one field")
+)
+
+type BulkCapabilityReader interface {
+	GetByID(ctx context.Context, id string) (*readmodels.CapabilityDTO, error)
+}
+
+type BulkRealizationReader interface {
+	GetByID(ctx context.Context, id string) (*readmodels.RealizationDTO, error)
+}
+
+// BulkCapabilityPatch holds the changes applied to every targeted capability.
+// Nil fields keep each capability's current value; tags are added, never replaced.
+type BulkCapabilityPatch struct {
+	MaturityValue    *int
+	OwnershipModel   *string
+	Status           *string
+	AddTags          []string
+	BusinessDomainID string
+}
+
+func (p BulkCapabilityPatch) changesMetadata() bool {
+	return p.MaturityValue != nil || p.OwnershipModel != nil || p.Status != nil
+}
+
+func (p BulkCapabilityPatch) isEmpty() bool {
+	return !p.changesMetadata() && len(p.AddTags) == 0 && p.BusinessDomainID == ""
+}
+
+type BulkRealizationPatch struct {
+	RealizationLevel *string
+	Notes            *string
+}
+
+func (p BulkRealizationPatch) isEmpty() bool {
+	return p.RealizationLevel == nil && p.Notes == nil
+}
+
+type BulkItemResult struct {
+	ID  string
+	Err error
+}
+
+func (r BulkItemResult) Succeeded() bool {
+	return r.Err == nil
+}
+
+type BulkEditResult struct {
+	CorrelationID string
+	Items         []BulkItemResult
+}
+
+func (r BulkEditResult) FailedCount() int {
+	failed := 0
+	for _, item := range r.Items {
+		if !item.Succeeded() {
+			failed++
+		}
+	}
+	return failed
+}
+
+// BulkEditService applies one patch to many capabilities or realizations by
+// dispatching the regular per-item commands. A failing item is reported and the
+// batch continues; every event written by the batch shares one correlation ID.
+type BulkEditService struct {
+	commandBus   cqrs.CommandBus
+	capabilities BulkCapabilityReader
+	realizations BulkRealizationReader
+}
+
+func NewBulkEditService(commandBus cqrs.CommandBus, capabilities BulkCapabilityReader, realizations BulkRealizationReader) *BulkEditService {
+	return &BulkEditService{
+		commandBus:   commandBus,
+		capabilities: capabilities,
+		realizations: realizations,
+	}
+}
+
+func (s *BulkEditService) EditCapabilities(ctx context.Context, ids []string, patch BulkCapabilityPatch) (*BulkEditResult, error) {
+	if patch.isEmpty() {
+		return nil, ErrBulkPatchEmpty
+	}
+	return s.run(ctx, ids, func(ctx context.Context, id string) error {
+		return s.editCapability(ctx, id, patch)
+	})
+}
+
+func (s *BulkEditService) EditRealizations(ctx context.Context, ids []string, patch BulkRealizationPatch) (*BulkEditResult, error) {
+	if patch.isEmpty() {
+		return nil, ErrBulkPatchEmpty
+	}
+	return s.run(ctx, ids, func(ctx context.Context, id string) error {
+		return s.editRealization(ctx, id, patch)
+	})
+}
+
+func (s *BulkEditService) run(ctx context.Context, ids []string, apply func(context.Context, string) error) (*BulkEditResult, error) {
+	targets := uniqueTargets(ids)
+	if len(targets) == 0 {
+		return nil, ErrBulkTargetsRequired
+	}
+	if len(targets) > MaxBulkTargets {
+		return nil, ErrBulkTooManyTargets
+	}
+
+	correlationID := uuid.New().String()
+	ctx = sharedctx.WithCorrelationID(ctx, correlationID)
+
+	result := &BulkEditResult{CorrelationID: correlationID, Items: make([]BulkItemResult, len(targets))}
+	for i, id := range targets {
+		result.Items[i] = BulkItemResult{ID: id, Err: apply(ctx, id)}
+	}
+	return result, nil
+}
+
+func (s *BulkEditService) editCapability(ctx context.Context, id string, patch BulkCapabilityPatch) error {
+	if patch.changesMetadata() {
+		if err := s.updateMetadata(ctx, id, patch); err != nil {
+			return err
+		}
+	}
+
+	for _, tag := range patch.AddTags {
+		if _, err := s.commandBus.Dispatch(ctx, &commands.AddCapabilityTag{CapabilityID: id, Tag: tag}); err != nil {
+			return err
+		}
+	}
+
+	if patch.BusinessDomainID != "" {
+		_, err := s.commandBus.Dispatch(ctx, &commands.AssignCapabilityToDomain{BusinessDomainID: patch.BusinessDomainID, CapabilityID: id})
+		if err != nil && !errors.Is(err, ErrAssignmentAlreadyExists) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *BulkEditService) updateMetadata(ctx context.Context, id string, patch BulkCapabilityPatch) error {
+	current, err := s.capabilities.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return ErrCapabilityNotFound
+	}
+
+	cmd := &commands.UpdateCapabilityMetadata{
+		ID:             id,
+		MaturityValue:  valueOr(patch.MaturityValue, current.MaturityValue),
+		OwnershipModel: valueOr(patch.OwnershipModel, current.OwnershipModel),
+		Status:         valueOr(patch.Status, current.Status),
+	}
+	_, err = s.commandBus.Dispatch(ctx, cmd)
+	return err
+}
+
+func (s *BulkEditService) editRealization(ctx context.Context, id string, patch BulkRealizationPatch) error {
+	current, err := s.realizations.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return repositories.ErrRealizationNotFound
+	}
+
+	_, err = s.commandBus.Dispatch(ctx, &commands.UpdateSystemRealization{
+		ID:               id,
+		RealizationLevel: valueOr(patch.RealizationLevel, current.RealizationLevel),
+		Notes:            valueOr(patch.Notes, current.Notes),
+	})
+	return err
+}
+
+func uniqueTargets(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	targets := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		targets = append(targets, id)
+	}
+	return targets
+}
+
+func valueOr[T any](patched *T, current T) T {
+	if patched != nil {
+		return *patched
+	}
+	return current
+}
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type bulkRecordingBus struct {
+	dispatched     []cqrs.Command
+	correlationIDs map[string]bool
+	failFor        map[string]error
+}
+
+func newBulkRecordingBus() *bulkRecordingBus {
+	return &bulkRecordingBus{correlationIDs: map[string]bool{}, failFor: map[string]error{}}
+}
+
+func (b *bulkRecordingBus) Register(string, cqrs.CommandHandler) {}
+
+func (b *bulkRecordingBus) Dispatch(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	if id, ok := sharedctx.GetCorrelationID(ctx); ok {
+		b.correlationIDs[id] = true
+	}
+	if err := b.failFor[bulkTargetOf(cmd)]; err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	b.dispatched = append(b.dispatched, cmd)
+	return cqrs.EmptyResult(), nil
+}
+
+func bulkTargetOf(cmd cqrs.Command) string {
+	switch c := cmd.(type) {
+	case *commands.UpdateCapabilityMetadata:
+		return c.ID
+	case *commands.AddCapabilityTag:
+		return c.CapabilityID
+	case *commands.AssignCapabilityToDomain:
+		return c.CapabilityID
+	case *commands.UpdateSystemRealization:
+		return c.ID
+	}
+	return ""
+}
+
+type stubBulkCapabilityReader map[string]readmodels.CapabilityDTO
+
+func (s stubBulkCapabilityReader) GetByID(_ context.Context, id string) (*readmodels.CapabilityDTO, error) {
+	if c, ok := s[id]; ok {
+		return &c, nil
+	}
+	return nil, nil
+}
+
+type stubBulkRealizationReader map[string]readmodels.RealizationDTO
+
+func (s stubBulkRealizationReader) GetByID(_ context.Context, id string) (*readmodels.RealizationDTO, error) {
+	if r, ok := s[id]; ok {
+		return &r, nil
+	}
+	return nil, nil
+}
+
+func testBulkCapabilities() stubBulkCapabilityReader {
+	return stubBulkCapabilityReader{
+		"cap-1": {ID: "cap-1", MaturityValue: 40, OwnershipModel: "Shared", Status: "Active"},
+		"cap-2": {ID: "cap-2", MaturityValue: 70, OwnershipModel: "TribeOwned", Status: "Planned"},
+	}
+}
+
+func TestBulkEditService_MergesPatchWithCurrentMetadata(t *testing.T) {
+	bus := newBulkRecordingBus()
+	service := NewBulkEditService(bus, testBulkCapabilities(), nil)
+	status := "Active"
+
+	result, err := service.EditCapabilities(context.Background(), []string{"cap-1", "cap-2"}, BulkCapabilityPatch{Status: &status})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, result.FailedCount())
+	require.Len(t, bus.dispatched, 2)
+	second := bus.dispatched[1].(*commands.UpdateCapabilityMetadata)
+	assert.Equal(t, "Active", second.Status)
+	assert.Equal(t, 70, second.MaturityValue)
+	assert.Equal(t, "TribeOwned", second.OwnershipModel)
+}
+
+func TestBulkEditService_PartialFailureDoesNotAbortBatch(t *testing.T) {
+	bus := newBulkRecordingBus()
+	bus.failFor["cap-1"] = repositories.ErrCapabilityNotFound
+	service := NewBulkEditService(bus, testBulkCapabilities(), nil)
+
+	result, err := service.EditCapabilities(context.Background(), []string{"cap-1", "cap-2"}, BulkCapabilityPatch{AddTags: []string{"core", "regulated"}})
+	require.NoError(t, err)
+
+	require.Len(t, result.Items, 2)
+	assert.ErrorIs(t, result.Items[0].Err, repositories.ErrCapabilityNotFound)
+	assert.True(t, result.Items[1].Succeeded())
+	assert.Equal(t, 1, result.FailedCount())
+	assert.Len(t, bus.dispatched, 2)
+}
+
+func TestBulkEditService_UnknownCapabilityIsReportedPerItem(t *testing.T) {
+	bus := newBulkRecordingBus()
+	service := NewBulkEditService(bus, testBulkCapabilities(), nil)
+	status := "Deprecated"
+
+	result, err := service.EditCapabilities(context.Background(), []string{"missing", "cap-1"}, BulkCapabilityPatch{Status: &status})
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, result.Items[0].Err, ErrCapabilityNotFound)
+	assert.True(t, result.Items[1].Succeeded())
+}
+
+func TestBulkEditService_ExistingDomainAssignmentIsNotAFailure(t *testing.T) {
+	bus := newBulkRecordingBus()
+	bus.failFor["cap-1"] = ErrAssignmentAlreadyExists
+	service := NewBulkEditService(bus, testBulkCapabilities(), nil)
+
+	result, err := service.EditCapabilities(context.Background(), []string{"cap-1"}, BulkCapabilityPatch{BusinessDomainID: "domain-1"})
+	require.NoError(t, err)
+
+	assert.True(t, result.Items[0].Succeeded())
+}
+
+func TestBulkEditService_BatchSharesOneCorrelationID(t *testing.T) {
+	bus := newBulkRecordingBus()
+	service := NewBulkEditService(bus, testBulkCapabilities(), nil)
+
+	result, err := service.EditCapabilities(context.Background(), []string{"cap-1", "cap-2", "cap-1"}, BulkCapabilityPatch{AddTags: []string{"core"}})
+	require.NoError(t, err)
+
+	assert.Len(t, result.Items, 2)
+	assert.NotEmpty(t, result.CorrelationID)
+	assert.Equal(t, map[string]bool{result.CorrelationID: true}, bus.correlationIDs)
+}
+
+func TestBulkEditService_RealizationsKeepUnpatchedNotes(t *testing.T) {
+	bus := newBulkRecordingBus()
+	realizations := stubBulkRealizationReader{"real-1": {ID: "real-1", RealizationLevel: "Partial", Notes: "legacy batch"}}
+	service := NewBulkEditService(bus, nil, realizations)
+	level := "Full"
+
+	result, err := service.EditRealizations(context.Background(), []string{"real-1", "real-2"}, BulkRealizationPatch{RealizationLevel: &level})
+	require.NoError(t, err)
+
+	require.Len(t, bus.dispatched, 1)
+	cmd := bus.dispatched[0].(*commands.UpdateSystemRealization)
+	assert.Equal(t, "Full", cmd.RealizationLevel)
+	assert.Equal(t, "legacy batch", cmd.Notes)
+	assert.ErrorIs(t, result.Items[1].Err, repositories.ErrRealizationNotFound)
+}
+
+func TestBulkEditService_RejectsInvalidBatches(t *testing.T) {
+	service := NewBulkEditService(newBulkRecordingBus(), testBulkCapabilities(), nil)
+	tag := BulkCapabilityPatch{AddTags: []string{"core"}}
+	tooMany := make([]string, MaxBulkTargets+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("cap-%d", i)
+	}
+
+	_, err := service.EditCapabilities(context.Background(), []string{"cap-1"}, BulkCapabilityPatch{})
+	assert.ErrorIs(t, err, ErrBulkPatchEmpty)
+
+	_, err = service.EditCapabilities(context.Background(), []string{"", ""}, tag)
+	assert.ErrorIs(t, err, ErrBulkTargetsRequired)
+
+	_, err = service.EditCapabilities(context.Background(), tooMany, tag)
+	assert.ErrorIs(t, err, ErrBulkTooManyTargets)
+}
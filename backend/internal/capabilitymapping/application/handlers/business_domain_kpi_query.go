@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+)
+
+type DomainKPILocalMetricsReader interface {
+	AverageFitByCapability(ctx context.Context, pillarID string) (map[string]float64, error)
+	DirectComponentsByCapability(ctx context.Context) (map[string][]string, error)
+}
+
+// CapabilityTimeDistributionSource counts TIME assessments per grade across the given capabilities.
+type CapabilityTimeDistributionSource interface {
+	DistributionFor(ctx context.Context, capabilityIDs []string) (map[string]int, error)
+}
+
+// JourneyProgressCounts tallies the current journeys of a set of capabilities by status.
+type JourneyProgressCounts struct {
+	Planned   int
+	InFlight  int
+	Done      int
+	Abandoned int
+}
+
+// CapabilityJourneyProgressSource supplies journey progress owned by the architecture direction context.
+type CapabilityJourneyProgressSource interface {
+	ProgressFor(ctx context.Context, capabilityIDs []string) (JourneyProgressCounts, error)
+}
+
+// DomainKPIExternalSources are optional; a missing source leaves its KPI empty.
+type DomainKPIExternalSources struct {
+	TimeDistribution CapabilityTimeDistributionSource
+	JourneyProgress  CapabilityJourneyProgressSource
+	Completeness     CapabilityMetricSource
+}
+
+type DomainJourneyProgress struct {
+	JourneyProgressCounts
+	CompletionPercent *float64
+}
+
+// BusinessDomainKPIs rolls up a domain together with all of its sub-domains.
+type BusinessDomainKPIs struct {
+	Domain                  readmodels.BusinessDomainDTO
+	IncludedDomainIDs       []string
+	CapabilityCount         int
+	RealizedCapabilityCount int
+	RealizationCoverage     float64
+	AverageFit              *float64
+	TimeDistribution        map[string]int
+	JourneyProgress         *DomainJourneyProgress
+	OnePagerCompleteness    *float64
+}
+
+type BusinessDomainKPIQuery struct {
+	domains      BusinessDomainTreeReader
+	capabilities HeatmapCapabilityReader
+	assignments  HeatmapDomainAssignmentReader
+	local        DomainKPILocalMetricsReader
+	external     DomainKPIExternalSources
+}
+
+func NewBusinessDomainKPIQuery(
+	domains BusinessDomainTreeReader,
+	capabilities HeatmapCapabilityReader,
+	assignments HeatmapDomainAssignmentReader,
+	local DomainKPILocalMetricsReader,
+	external DomainKPIExternalSources,
+) *BusinessDomainKPIQuery {
+	return &BusinessDomainKPIQuery{
+		domains:      domains,
+		capabilities: capabilities,
+		assignments:  assignments,
+		local:        local,
+		external:     external,
+	}
+}
+
+func (q *BusinessDomainKPIQuery) Execute(ctx context.Context, domainID string) (*BusinessDomainKPIs, error) {
+	all, err := q.capabilities.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := resolveBusinessDomainTreeScope(ctx, q.domains, q.assignments, all, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilityIDs := make([]string, len(scope.Capabilities))
+	for i, c := range scope.Capabilities {
+		capabilityIDs[i] = c.ID
+	}
+
+	kpis := &BusinessDomainKPIs{
+		Domain:            scope.Domain,
+		IncludedDomainIDs: scope.DomainIDs,
+		CapabilityCount:   len(capabilityIDs),
+		TimeDistribution:  map[string]int{},
+	}
+	if len(capabilityIDs) == 0 {
+		return kpis, nil
+	}
+
+	if err := q.addLocalKPIs(ctx, kpis, capabilityIDs); err != nil {
+		return nil, err
+	}
+	if err := q.addExternalKPIs(ctx, kpis, capabilityIDs); err != nil {
+		return nil, err
+	}
+	return kpis, nil
+}
+
+func (q *BusinessDomainKPIQuery) addLocalKPIs(ctx context.Context, kpis *BusinessDomainKPIs, capabilityIDs []string) error {
+	components, err := q.local.DirectComponentsByCapability(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range capabilityIDs {
+		if len(components[id]) > 0 {
+			kpis.RealizedCapabilityCount++
+		}
+	}
+	kpis.RealizationCoverage = float64(kpis.RealizedCapabilityCount) / float64(len(capabilityIDs)) * 100
+
+	fit, err := q.local.AverageFitByCapability(ctx, "")
+	if err != nil {
+		return err
+	}
+	kpis.AverageFit = meanOf(fit, capabilityIDs)
+	return nil
+}
+
+func (q *BusinessDomainKPIQuery) addExternalKPIs(ctx context.Context, kpis *BusinessDomainKPIs, capabilityIDs []string) error {
+	if q.external.TimeDistribution != nil {
+		distribution, err := q.external.TimeDistribution.DistributionFor(ctx, capabilityIDs)
+		if err != nil {
+			return err
+		}
+		for grade, count := range distribution {
+			kpis.TimeDistribution[grade] = count
+		}
+	}
+
+	if q.external.JourneyProgress != nil {
+		counts, err := q.external.JourneyProgress.ProgressFor(ctx, capabilityIDs)
+		if err != nil {
+			return err
+		}
+		kpis.JourneyProgress = &DomainJourneyProgress{JourneyProgressCounts: counts}
+		if active := counts.Planned + counts.InFlight + counts.Done; active > 0 {
+			percent := float64(counts.Done) / float64(active) * 100
+			kpis.JourneyProgress.CompletionPercent = &percent
+		}
+	}
+
+	if q.external.Completeness != nil {
+		completeness, err := q.external.Completeness.ValuesFor(ctx, capabilityIDs)
+		if err != nil {
+			return err
+		}
+		kpis.OnePagerCompleteness = meanOf(completeness, capabilityIDs)
+	}
+	return nil
+}
+
+// meanOf averages the values present for the given IDs, or returns nil when none are.
+func meanOf(values map[string]float64, ids []string) *float64 {
+	var sum float64
+	var n int
+	for _, id := range ids {
+		if v, ok := values[id]; ok {
+			sum += v
+			n++
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	mean := sum / float64(n)
+	return &mean
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubKPIDomains struct {
+	domains     map[string]*readmodels.BusinessDomainDTO
+	descendants map[string][]string
+}
+
+func (s *stubKPIDomains) GetByID(_ context.Context, id string) (*readmodels.BusinessDomainDTO, error) {
+	return s.domains[id], nil
+}
+
+func (s *stubKPIDomains) GetDescendantIDs(_ context.Context, rootID string) ([]string, error) {
+	return s.descendants[rootID], nil
+}
+
+type stubTimeDistributionSource map[string]int
+
+func (s stubTimeDistributionSource) DistributionFor(context.Context, []string) (map[string]int, error) {
+	return s, nil
+}
+
+type stubJourneyProgressSource JourneyProgressCounts
+
+func (s stubJourneyProgressSource) ProgressFor(context.Context, []string) (JourneyProgressCounts, error) {
+	return JourneyProgressCounts(s), nil
+}
+
+func newTestKPIQuery(external DomainKPIExternalSources) *BusinessDomainKPIQuery {
+	return NewBusinessDomainKPIQuery(
+		&stubKPIDomains{
+			domains: map[string]*readmodels.BusinessDomainDTO{
+				"division": {ID: "division", Name: "Division"},
+				"empty":    {ID: "empty", Name: "Empty"},
+			},
+			descendants: map[string][]string{"division": {"commercial", "back-office"}},
+		},
+		&stubHeatmapCapabilities{capabilities: heatmapCapabilities()},
+		&stubHeatmapAssignments{byDomain: map[string][]readmodels.AssignmentDTO{
+			"commercial":  {{CapabilityID: "sales"}},
+			"back-office": {{CapabilityID: "finance"}},
+		}},
+		&stubHeatmapLocalMetrics{
+			fit:        map[string]float64{"leads": 2, "orders": 4},
+			components: map[string][]string{"leads": {"crm"}, "finance": {"erp"}},
+		},
+		external,
+	)
+}
+
+func TestBusinessDomainKPIQuery_RollsUpSubDomains(t *testing.T) {
+	kpis, err := newTestKPIQuery(DomainKPIExternalSources{}).Execute(context.Background(), "division")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"division", "commercial", "back-office"}, kpis.IncludedDomainIDs)
+	assert.Equal(t, 4, kpis.CapabilityCount)
+	assert.Equal(t, 2, kpis.RealizedCapabilityCount)
+	assert.InDelta(t, 50.0, kpis.RealizationCoverage, 0.001)
+	require.NotNil(t, kpis.AverageFit)
+	assert.InDelta(t, 3.0, *kpis.AverageFit, 0.001)
+	assert.Nil(t, kpis.JourneyProgress, "journey progress is left empty without a source")
+	assert.Nil(t, kpis.OnePagerCompleteness)
+}
+
+func TestBusinessDomainKPIQuery_UsesExternalSources(t *testing.T) {
+	kpis, err := newTestKPIQuery(DomainKPIExternalSources{
+		TimeDistribution: stubTimeDistributionSource{"INVEST": 3, "ELIMINATE": 1},
+		JourneyProgress:  stubJourneyProgressSource{Planned: 1, InFlight: 1, Done: 2, Abandoned: 5},
+		Completeness:     stubCapabilityMetricSource{"sales": 100, "finance": 50},
+	}).Execute(context.Background(), "division")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]int{"INVEST": 3, "ELIMINATE": 1}, kpis.TimeDistribution)
+	require.NotNil(t, kpis.JourneyProgress)
+	require.NotNil(t, kpis.JourneyProgress.CompletionPercent)
+	assert.InDelta(t, 50.0, *kpis.JourneyProgress.CompletionPercent, 0.001, "abandoned journeys do not count towards progress")
+	require.NotNil(t, kpis.OnePagerCompleteness)
+	assert.InDelta(t, 75.0, *kpis.OnePagerCompleteness, 0.001)
+}
+
+func TestBusinessDomainKPIQuery_EmptyDomain(t *testing.T) {
+	kpis, err := newTestKPIQuery(DomainKPIExternalSources{
+		TimeDistribution: stubTimeDistributionSource{"INVEST": 3},
+	}).Execute(context.Background(), "empty")
+	require.NoError(t, err)
+
+	assert.Zero(t, kpis.CapabilityCount)
+	assert.Zero(t, kpis.RealizationCoverage)
+	assert.Nil(t, kpis.AverageFit)
+	assert.Empty(t, kpis.TimeDistribution)
+}
+
+func TestBusinessDomainKPIQuery_UnknownDomain(t *testing.T) {
+	_, err := newTestKPIQuery(DomainKPIExternalSources{}).Execute(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrBusinessDomainNotFound)
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+)
+
+type BusinessDomainTreeReader interface {
+	GetByID(ctx context.Context, id string) (*readmodels.BusinessDomainDTO, error)
+	GetDescendantIDs(ctx context.Context, rootID string) ([]string, error)
+}
+
+type businessDomainTreeScope struct {
+	Domain       readmodels.BusinessDomainDTO
+	DomainIDs    []string
+	Capabilities []readmodels.CapabilityDTO
+}
+
+// resolveBusinessDomainTreeScope collects the capabilities assigned to a domain or
+// any of its sub-domains, together with their descendant capabilities.
+func resolveBusinessDomainTreeScope(
+	ctx context.Context,
+	domains BusinessDomainTreeReader,
+	assignments HeatmapDomainAssignmentReader,
+	all []readmodels.CapabilityDTO,
+	domainID string,
+) (*businessDomainTreeScope, error) {
+	domain, err := domains.GetByID(ctx, domainID)
+	if err != nil {
+		return nil, err
+	}
+	if domain == nil {
+		return nil, ErrBusinessDomainNotFound
+	}
+
+	descendantIDs, err := domains.GetDescendantIDs(ctx, domainID)
+	if err != nil {
+		return nil, err
+	}
+	domainIDs := append([]string{domainID}, descendantIDs...)
+
+	inScope := make(map[string]bool)
+	for _, id := range domainIDs {
+		assigned, err := assignments.GetByDomainID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range assigned {
+			inScope[a.CapabilityID] = true
+		}
+	}
+
+	return &businessDomainTreeScope{
+		Domain:       *domain,
+		DomainIDs:    domainIDs,
+		Capabilities: withDescendants(all, inScope),
+	}, nil
+}
@@ -0,0 +1,276 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type ContentChildrenReader interface {
+	GetChildren(ctx context.Context, parentID string) ([]readmodels.CapabilityDTO, error)
+}
+
+type ContentRealizationReader interface {
+	GetByCapabilityID(ctx context.Context, capabilityID string) ([]readmodels.RealizationDTO, error)
+	GetDirectByCapabilityAndComponent(ctx context.Context, capabilityID, componentID string) (string, bool, error)
+}
+
+type ContentDependencyReader interface {
+	GetOutgoing(ctx context.Context, capabilityID string) ([]readmodels.DependencyDTO, error)
+	GetIncoming(ctx context.Context, capabilityID string) ([]readmodels.DependencyDTO, error)
+}
+
+type ContentAssignmentReader interface {
+	GetByCapabilityID(ctx context.Context, capabilityID string) ([]readmodels.AssignmentDTO, error)
+	AssignmentExists(ctx context.Context, domainID, capabilityID string) (bool, error)
+}
+
+type ContentImportanceReader interface {
+	GetByCapability(ctx context.Context, capabilityID string) ([]readmodels.StrategyImportanceDTO, error)
+	Exists(ctx context.Context, domainID, capabilityID, pillarID string) (bool, error)
+}
+
+// CapabilityContentReaders are the read models a merge or split consults to find
+// what hangs off a capability.
+type CapabilityContentReaders struct {
+	Children     ContentChildrenReader
+	Realizations ContentRealizationReader
+	Dependencies ContentDependencyReader
+	Assignments  ContentAssignmentReader
+	Importance   ContentImportanceReader
+}
+
+// CapabilityContent is everything a merge or split redistributes. Only direct
+// realizations are listed; inherited ones follow their source.
+type CapabilityContent struct {
+	Children     []readmodels.CapabilityDTO
+	Realizations []readmodels.RealizationDTO
+	Outgoing     []readmodels.DependencyDTO
+	Incoming     []readmodels.DependencyDTO
+	Assignments  []readmodels.AssignmentDTO
+	Importance   []readmodels.StrategyImportanceDTO
+}
+
+// capabilityContentMover moves children, realizations, dependencies, domain
+// assignments and strategy importance from one capability to another through the
+// regular commands, so every step leaves its own events behind. Where the target
+// already holds an equivalent item, the target's own item wins and the moved one
+// is removed. The first failed step stops the move and is returned, so the caller
+// can leave the source capability in place rather than delete it half-emptied.
+type capabilityContentMover struct {
+	commandBus cqrs.CommandBus
+	readers    CapabilityContentReaders
+}
+
+func (m *capabilityContentMover) load(ctx context.Context, capabilityID string) (CapabilityContent, error) {
+	var content CapabilityContent
+	var err error
+
+	if content.Children, err = m.readers.Children.GetChildren(ctx, capabilityID); err != nil {
+		return content, err
+	}
+	realizations, err := m.readers.Realizations.GetByCapabilityID(ctx, capabilityID)
+	if err != nil {
+		return content, err
+	}
+	for _, realization := range realizations {
+		if realization.Origin == "Direct" {
+			content.Realizations = append(content.Realizations, realization)
+		}
+	}
+	if content.Outgoing, err = m.readers.Dependencies.GetOutgoing(ctx, capabilityID); err != nil {
+		return content, err
+	}
+	if content.Incoming, err = m.readers.Dependencies.GetIncoming(ctx, capabilityID); err != nil {
+		return content, err
+	}
+	if content.Assignments, err = m.readers.Assignments.GetByCapabilityID(ctx, capabilityID); err != nil {
+		return content, err
+	}
+	content.Importance, err = m.readers.Importance.GetByCapability(ctx, capabilityID)
+	return content, err
+}
+
+// contentTarget picks the capability an item moves to, or keep=false to discard it.
+// Child capabilities are never discarded.
+type contentTarget func(itemID string) (targetID string, keep bool)
+
+func (m *capabilityContentMover) redistribute(ctx context.Context, fromID string, content CapabilityContent, target contentTarget) error {
+	for _, child := range content.Children {
+		targetID, _ := target(child.ID)
+		if err := m.moveChild(ctx, child, targetID); err != nil {
+			return err
+		}
+	}
+	for _, realization := range content.Realizations {
+		if err := m.redistributeRealization(ctx, realization, target); err != nil {
+			return err
+		}
+	}
+	for _, dependency := range append(content.Outgoing, content.Incoming...) {
+		if err := m.redistributeDependency(ctx, dependency, fromID, target); err != nil {
+			return err
+		}
+	}
+	for _, importance := range content.Importance {
+		if err := m.redistributeImportance(ctx, importance, target); err != nil {
+			return err
+		}
+	}
+	for _, assignment := range content.Assignments {
+		if err := m.redistributeAssignment(ctx, assignment, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *capabilityContentMover) redistributeRealization(ctx context.Context, realization readmodels.RealizationDTO, target contentTarget) error {
+	if targetID, keep := target(realization.ID); keep {
+		return m.moveRealization(ctx, realization, targetID)
+	}
+	return m.discardRealization(ctx, realization.ID)
+}
+
+func (m *capabilityContentMover) redistributeDependency(ctx context.Context, dependency readmodels.DependencyDTO, fromID string, target contentTarget) error {
+	if targetID, keep := target(dependency.ID); keep {
+		return m.moveDependency(ctx, dependency, fromID, targetID)
+	}
+	return m.discardDependency(ctx, dependency.ID)
+}
+
+func (m *capabilityContentMover) redistributeImportance(ctx context.Context, importance readmodels.StrategyImportanceDTO, target contentTarget) error {
+	if targetID, keep := target(importance.ID); keep {
+		return m.moveImportance(ctx, importance, targetID)
+	}
+	return m.discardImportance(ctx, importance.ID)
+}
+
+func (m *capabilityContentMover) redistributeAssignment(ctx context.Context, assignment readmodels.AssignmentDTO, target contentTarget) error {
+	if targetID, keep := target(assignment.AssignmentID); keep {
+		return m.moveAssignment(ctx, assignment, targetID)
+	}
+	return m.discardAssignment(ctx, assignment.AssignmentID)
+}
+
+func (m *capabilityContentMover) dispatch(ctx context.Context, cmd cqrs.Command, what string) error {
+	if _, err := m.commandBus.Dispatch(ctx, cmd); err != nil {
+		return fmt.Errorf("%s: %w", what, err)
+	}
+	return nil
+}
+
+func (m *capabilityContentMover) moveChild(ctx context.Context, child readmodels.CapabilityDTO, targetID string) error {
+	return m.dispatch(ctx, &commands.ChangeCapabilityParent{CapabilityID: child.ID, NewParentID: targetID},
+		"move child capability "+child.ID+" to "+targetID)
+}
+
+func (m *capabilityContentMover) moveRealization(ctx context.Context, realization readmodels.RealizationDTO, targetID string) error {
+	_, targetRealizes, err := m.readers.Realizations.GetDirectByCapabilityAndComponent(ctx, targetID, realization.ComponentID)
+	if err != nil {
+		return fmt.Errorf("check capability %s realization by component %s: %w", targetID, realization.ComponentID, err)
+	}
+
+	var cmd cqrs.Command = &commands.MoveSystemRealization{ID: realization.ID, CapabilityID: targetID}
+	if targetRealizes {
+		cmd = &commands.DeleteSystemRealization{ID: realization.ID}
+	}
+	return m.dispatch(ctx, cmd, "move realization "+realization.ID+" to "+targetID)
+}
+
+// moveDependency re-points the fromID end of a dependency at targetID. A dependency
+// that would point at itself, or that the target already has, is dropped.
+func (m *capabilityContentMover) moveDependency(ctx context.Context, dependency readmodels.DependencyDTO, fromID, targetID string) error {
+	sourceID, dependsOnID := dependency.SourceCapabilityID, dependency.TargetCapabilityID
+	if sourceID == fromID {
+		sourceID = targetID
+	}
+	if dependsOnID == fromID {
+		dependsOnID = targetID
+	}
+
+	if sourceID != dependsOnID {
+		exists, err := m.dependencyExists(ctx, sourceID, dependsOnID, dependency.DependencyType)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			if err := m.dispatch(ctx, &commands.CreateCapabilityDependency{
+				SourceCapabilityID: sourceID,
+				TargetCapabilityID: dependsOnID,
+				DependencyType:     dependency.DependencyType,
+				Description:        dependency.Description,
+			}, "recreate dependency "+dependency.ID+" on "+targetID); err != nil {
+				return err
+			}
+		}
+	}
+	return m.discardDependency(ctx, dependency.ID)
+}
+
+func (m *capabilityContentMover) dependencyExists(ctx context.Context, sourceID, targetID, dependencyType string) (bool, error) {
+	existing, err := m.readers.Dependencies.GetOutgoing(ctx, sourceID)
+	if err != nil {
+		return false, fmt.Errorf("query dependencies of capability %s: %w", sourceID, err)
+	}
+	for _, dependency := range existing {
+		if dependency.TargetCapabilityID == targetID && dependency.DependencyType == dependencyType {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *capabilityContentMover) moveAssignment(ctx context.Context, assignment readmodels.AssignmentDTO, targetID string) error {
+	assigned, err := m.readers.Assignments.AssignmentExists(ctx, assignment.BusinessDomainID, targetID)
+	if err != nil {
+		return fmt.Errorf("check capability %s assignment to domain %s: %w", targetID, assignment.BusinessDomainID, err)
+	}
+	if !assigned {
+		if err := m.dispatch(ctx, &commands.AssignCapabilityToDomain{
+			BusinessDomainID: assignment.BusinessDomainID,
+			CapabilityID:     targetID,
+		}, "assign capability "+targetID+" to domain "+assignment.BusinessDomainID); err != nil {
+			return err
+		}
+	}
+	return m.discardAssignment(ctx, assignment.AssignmentID)
+}
+
+func (m *capabilityContentMover) moveImportance(ctx context.Context, importance readmodels.StrategyImportanceDTO, targetID string) error {
+	rated, err := m.readers.Importance.Exists(ctx, importance.BusinessDomainID, targetID, importance.PillarID)
+	if err != nil {
+		return fmt.Errorf("check capability %s importance for pillar %s: %w", targetID, importance.PillarID, err)
+	}
+	if !rated {
+		if err := m.dispatch(ctx, &commands.SetStrategyImportance{
+			BusinessDomainID: importance.BusinessDomainID,
+			CapabilityID:     targetID,
+			PillarID:         importance.PillarID,
+			Importance:       importance.Importance,
+			Rationale:        importance.Rationale,
+		}, "move strategy importance "+importance.ID+" to "+targetID); err != nil {
+			return err
+		}
+	}
+	return m.discardImportance(ctx, importance.ID)
+}
+
+func (m *capabilityContentMover) discardRealization(ctx context.Context, id string) error {
+	return m.dispatch(ctx, &commands.DeleteSystemRealization{ID: id}, "delete realization "+id)
+}
+
+func (m *capabilityContentMover) discardDependency(ctx context.Context, id string) error {
+	return m.dispatch(ctx, &commands.DeleteCapabilityDependency{ID: id}, "delete dependency "+id)
+}
+
+func (m *capabilityContentMover) discardAssignment(ctx context.Context, id string) error {
+	return m.dispatch(ctx, &commands.UnassignCapabilityFromDomain{AssignmentID: id}, "unassign domain assignment "+id)
+}
+
+func (m *capabilityContentMover) discardImportance(ctx context.Context, id string) error {
+	return m.dispatch(ctx, &commands.RemoveStrategyImportance{ImportanceID: id}, "remove strategy importance "+id)
+}
@@ -0,0 +1,297 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+var (
+	ErrHeatmapPillarRequired    = errors.New("the importance heatmap requires a strategy pillar")
+	ErrHeatmapCostFieldRequired = errors.New("the cost heatmap requires the one-pager field holding application cost")
+	ErrHeatmapMetricUnavailable = errors.New("heatmap metric is not available in this deployment")
+)
+
+type HeatmapCapabilityReader interface {
+	GetAll(ctx context.Context) ([]readmodels.CapabilityDTO, error)
+}
+
+type HeatmapDomainAssignmentReader interface {
+	GetByDomainID(ctx context.Context, domainID string) ([]readmodels.AssignmentDTO, error)
+}
+
+type HeatmapLocalMetricsReader interface {
+	ImportanceByCapability(ctx context.Context, pillarID, businessDomainID string) (map[string]float64, error)
+	AverageFitByCapability(ctx context.Context, pillarID string) (map[string]float64, error)
+	DirectComponentsByCapability(ctx context.Context) (map[string][]string, error)
+}
+
+// CapabilityMetricSource supplies a per-capability metric owned by another bounded context.
+type CapabilityMetricSource interface {
+	ValuesFor(ctx context.Context, capabilityIDs []string) (map[string]float64, error)
+}
+
+// ComponentCostSource supplies application cost from a tenant-chosen numeric one-pager field.
+type ComponentCostSource interface {
+	CostsFor(ctx context.Context, fieldID string, componentIDs []string) (map[string]float64, error)
+}
+
+type HeatmapExternalSources struct {
+	EliminateCounts CapabilityMetricSource
+	ActiveJourneys  CapabilityMetricSource
+	Completeness    CapabilityMetricSource
+	ComponentCosts  ComponentCostSource
+}
+
+type CapabilityHeatmapRequest struct {
+	Metric           valueobjects.HeatmapMetric
+	Aggregation      valueobjects.HeatmapAggregation
+	PillarID         string
+	BusinessDomainID string
+	CostFieldID      string
+}
+
+type CapabilityHeatmapNode struct {
+	ID       string
+	Name     string
+	Level    string
+	OwnValue *float64
+	Value    *float64
+	Band     int
+	Colour   string
+	Children []*CapabilityHeatmapNode
+}
+
+type CapabilityHeatmapResult struct {
+	Metric      valueobjects.HeatmapMetric
+	Aggregation valueobjects.HeatmapAggregation
+	Polarity    valueobjects.HeatmapPolarity
+	ScaleMin    float64
+	ScaleMax    float64
+	Roots       []*CapabilityHeatmapNode
+}
+
+type CapabilityHeatmapQuery struct {
+	capabilities HeatmapCapabilityReader
+	assignments  HeatmapDomainAssignmentReader
+	local        HeatmapLocalMetricsReader
+	external     HeatmapExternalSources
+}
+
+func NewCapabilityHeatmapQuery(
+	capabilities HeatmapCapabilityReader,
+	assignments HeatmapDomainAssignmentReader,
+	local HeatmapLocalMetricsReader,
+	external HeatmapExternalSources,
+) *CapabilityHeatmapQuery {
+	return &CapabilityHeatmapQuery{
+		capabilities: capabilities,
+		assignments:  assignments,
+		local:        local,
+		external:     external,
+	}
+}
+
+func (q *CapabilityHeatmapQuery) Execute(ctx context.Context, req CapabilityHeatmapRequest) (*CapabilityHeatmapResult, error) {
+	if req.Metric.RequiresPillar() && req.PillarID == "" {
+		return nil, ErrHeatmapPillarRequired
+	}
+	if req.Aggregation == "" {
+		req.Aggregation = req.Metric.DefaultAggregation()
+	}
+
+	scope, err := q.scopedCapabilities(ctx, req.BusinessDomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := q.metricValues(ctx, req, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]services.HeatmapNode, len(scope))
+	for i, c := range scope {
+		node := services.HeatmapNode{ID: c.ID, ParentID: c.ParentID}
+		if v, ok := values[c.ID]; ok {
+			node.Value = &v
+		}
+		nodes[i] = node
+	}
+	rollup := services.RollUpHeatmap(nodes, req.Metric, req.Aggregation)
+
+	return &CapabilityHeatmapResult{
+		Metric:      req.Metric,
+		Aggregation: req.Aggregation,
+		Polarity:    req.Metric.Polarity(),
+		ScaleMin:    rollup.ScaleMin,
+		ScaleMax:    rollup.ScaleMax,
+		Roots:       buildHeatmapTree(scope, rollup),
+	}, nil
+}
+
+func (q *CapabilityHeatmapQuery) scopedCapabilities(ctx context.Context, businessDomainID string) ([]readmodels.CapabilityDTO, error) {
+	all, err := q.capabilities.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if businessDomainID == "" {
+		return all, nil
+	}
+
+	assignments, err := q.assignments.GetByDomainID(ctx, businessDomainID)
+	if err != nil {
+		return nil, err
+	}
+	inScope := make(map[string]bool, len(assignments))
+	for _, a := range assignments {
+		inScope[a.CapabilityID] = true
+	}
+	return withDescendants(all, inScope), nil
+}
+
+func withDescendants(all []readmodels.CapabilityDTO, inScope map[string]bool) []readmodels.CapabilityDTO {
+	childrenOf := make(map[string][]string)
+	for _, c := range all {
+		childrenOf[c.ParentID] = append(childrenOf[c.ParentID], c.ID)
+	}
+	pending := make([]string, 0, len(inScope))
+	for id := range inScope {
+		pending = append(pending, id)
+	}
+	for len(pending) > 0 {
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		for _, childID := range childrenOf[id] {
+			if !inScope[childID] {
+				inScope[childID] = true
+				pending = append(pending, childID)
+			}
+		}
+	}
+
+	scoped := make([]readmodels.CapabilityDTO, 0, len(inScope))
+	for _, c := range all {
+		if inScope[c.ID] {
+			scoped = append(scoped, c)
+		}
+	}
+	return scoped
+}
+
+func (q *CapabilityHeatmapQuery) metricValues(ctx context.Context, req CapabilityHeatmapRequest, scope []readmodels.CapabilityDTO) (map[string]float64, error) {
+	ids := make([]string, len(scope))
+	for i, c := range scope {
+		ids[i] = c.ID
+	}
+
+	switch req.Metric {
+	case valueobjects.HeatmapMetricMaturity:
+		values := make(map[string]float64, len(scope))
+		for _, c := range scope {
+			values[c.ID] = float64(c.MaturityValue)
+		}
+		return values, nil
+	case valueobjects.HeatmapMetricImportance:
+		return q.local.ImportanceByCapability(ctx, req.PillarID, req.BusinessDomainID)
+	case valueobjects.HeatmapMetricFit:
+		return q.local.AverageFitByCapability(ctx, req.PillarID)
+	case valueobjects.HeatmapMetricCost:
+		return q.costValues(ctx, req.CostFieldID)
+	case valueobjects.HeatmapMetricEliminateCount:
+		return externalValues(ctx, q.external.EliminateCounts, ids)
+	case valueobjects.HeatmapMetricActiveJourneys:
+		return externalValues(ctx, q.external.ActiveJourneys, ids)
+	case valueobjects.HeatmapMetricCompleteness:
+		return externalValues(ctx, q.external.Completeness, ids)
+	default:
+		return nil, valueobjects.ErrInvalidHeatmapMetric
+	}
+}
+
+func externalValues(ctx context.Context, source CapabilityMetricSource, capabilityIDs []string) (map[string]float64, error) {
+	if source == nil {
+		return nil, ErrHeatmapMetricUnavailable
+	}
+	return source.ValuesFor(ctx, capabilityIDs)
+}
+
+// costValues totals the cost of the applications directly realizing each capability.
+func (q *CapabilityHeatmapQuery) costValues(ctx context.Context, costFieldID string) (map[string]float64, error) {
+	if costFieldID == "" {
+		return nil, ErrHeatmapCostFieldRequired
+	}
+	if q.external.ComponentCosts == nil {
+		return nil, ErrHeatmapMetricUnavailable
+	}
+
+	componentsByCapability, err := q.local.DirectComponentsByCapability(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var componentIDs []string
+	for _, components := range componentsByCapability {
+		for _, id := range components {
+			if !seen[id] {
+				seen[id] = true
+				componentIDs = append(componentIDs, id)
+			}
+		}
+	}
+
+	costs, err := q.external.ComponentCosts.CostsFor(ctx, costFieldID, componentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]float64)
+	for capabilityID, components := range componentsByCapability {
+		for _, id := range components {
+			if cost, ok := costs[id]; ok {
+				values[capabilityID] += cost
+			}
+		}
+	}
+	return values, nil
+}
+
+func buildHeatmapTree(scope []readmodels.CapabilityDTO, rollup services.HeatmapRollup) []*CapabilityHeatmapNode {
+	byID := make(map[string]*CapabilityHeatmapNode, len(scope))
+	for _, c := range scope {
+		cell := rollup.Cells[c.ID]
+		byID[c.ID] = &CapabilityHeatmapNode{
+			ID:       c.ID,
+			Name:     c.Name,
+			Level:    c.Level,
+			OwnValue: cell.OwnValue,
+			Value:    cell.RolledValue,
+			Band:     cell.Band,
+			Colour:   cell.Colour,
+			Children: []*CapabilityHeatmapNode{},
+		}
+	}
+
+	roots := []*CapabilityHeatmapNode{}
+	for _, c := range scope {
+		node := byID[c.ID]
+		if parent, ok := byID[c.ParentID]; ok && c.ParentID != c.ID {
+			parent.Children = append(parent.Children, node)
+			continue
+		}
+		roots = append(roots, node)
+	}
+	sortHeatmapNodes(roots)
+	return roots
+}
+
+func sortHeatmapNodes(nodes []*CapabilityHeatmapNode) {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	for _, n := range nodes {
+		sortHeatmapNodes(n.Children)
+	}
+}
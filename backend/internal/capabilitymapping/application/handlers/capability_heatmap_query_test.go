@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubHeatmapCapabilities struct {
+	capabilities []readmodels.CapabilityDTO
+}
+
+func (s *stubHeatmapCapabilities) GetAll(context.Context) ([]readmodels.CapabilityDTO, error) {
+	return s.capabilities, nil
+}
+
+type stubHeatmapAssignments struct {
+	byDomain map[string][]readmodels.AssignmentDTO
+}
+
+func (s *stubHeatmapAssignments) GetByDomainID(_ context.Context, domainID string) ([]readmodels.AssignmentDTO, error) {
+	return s.byDomain[domainID], nil
+}
+
+type stubHeatmapLocalMetrics struct {
+	importance  map[string]float64
+	fit         map[string]float64
+	components  map[string][]string
+	pillarAsked string
+	domainAsked string
+}
+
+func (s *stubHeatmapLocalMetrics) ImportanceByCapability(_ context.Context, pillarID, businessDomainID string) (map[string]float64, error) {
+	s.pillarAsked, s.domainAsked = pillarID, businessDomainID
+	return s.importance, nil
+}
+
+func (s *stubHeatmapLocalMetrics) AverageFitByCapability(_ context.Context, pillarID string) (map[string]float64, error) {
+	s.pillarAsked = pillarID
+	return s.fit, nil
+}
+
+func (s *stubHeatmapLocalMetrics) DirectComponentsByCapability(context.Context) (map[string][]string, error) {
+	return s.components, nil
+}
+
+type stubCapabilityMetricSource map[string]float64
+
+func (s stubCapabilityMetricSource) ValuesFor(context.Context, []string) (map[string]float64, error) {
+	return s, nil
+}
+
+type stubComponentCostSource map[string]float64
+
+func (s stubComponentCostSource) CostsFor(context.Context, string, []string) (map[string]float64, error) {
+	return s, nil
+}
+
+func heatmapCapabilities() []readmodels.CapabilityDTO {
+	return []readmodels.CapabilityDTO{
+		{ID: "sales", Name: "Sales", Level: "L1", MaturityValue: 10},
+		{ID: "leads", Name: "Leads", Level: "L2", ParentID: "sales", MaturityValue: 30},
+		{ID: "orders", Name: "Orders", Level: "L2", ParentID: "sales", MaturityValue: 50},
+		{ID: "finance", Name: "Finance", Level: "L1", MaturityValue: 90},
+	}
+}
+
+func newTestHeatmapQuery(local *stubHeatmapLocalMetrics, external HeatmapExternalSources) *CapabilityHeatmapQuery {
+	return NewCapabilityHeatmapQuery(
+		&stubHeatmapCapabilities{capabilities: heatmapCapabilities()},
+		&stubHeatmapAssignments{byDomain: map[string][]readmodels.AssignmentDTO{
+			"commercial": {{CapabilityID: "sales"}},
+		}},
+		local,
+		external,
+	)
+}
+
+func TestCapabilityHeatmapQuery_MaturityRollsUpTree(t *testing.T) {
+	query := newTestHeatmapQuery(&stubHeatmapLocalMetrics{}, HeatmapExternalSources{})
+
+	result, err := query.Execute(context.Background(), CapabilityHeatmapRequest{
+		Metric:      valueobjects.HeatmapMetricMaturity,
+		Aggregation: valueobjects.HeatmapAggregationMax,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, result.Roots, 2)
+	sales := result.Roots[1]
+	assert.Equal(t, "Sales", sales.Name)
+	assert.Equal(t, 10.0, *sales.OwnValue)
+	assert.Equal(t, 50.0, *sales.Value)
+	require.Len(t, sales.Children, 2)
+	assert.Equal(t, "Leads", sales.Children[0].Name)
+	assert.NotEmpty(t, sales.Colour)
+	assert.Equal(t, valueobjects.HeatmapPolarityHigherIsBetter, result.Polarity)
+}
+
+func TestCapabilityHeatmapQuery_ScopesToBusinessDomainWithDescendants(t *testing.T) {
+	query := newTestHeatmapQuery(&stubHeatmapLocalMetrics{}, HeatmapExternalSources{})
+
+	result, err := query.Execute(context.Background(), CapabilityHeatmapRequest{
+		Metric:           valueobjects.HeatmapMetricMaturity,
+		BusinessDomainID: "commercial",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, result.Roots, 1)
+	assert.Equal(t, "sales", result.Roots[0].ID)
+	assert.Len(t, result.Roots[0].Children, 2)
+	assert.Equal(t, valueobjects.HeatmapAggregationAvg, result.Aggregation)
+}
+
+func TestCapabilityHeatmapQuery_ImportanceRequiresPillar(t *testing.T) {
+	query := newTestHeatmapQuery(&stubHeatmapLocalMetrics{}, HeatmapExternalSources{})
+
+	_, err := query.Execute(context.Background(), CapabilityHeatmapRequest{Metric: valueobjects.HeatmapMetricImportance})
+
+	assert.ErrorIs(t, err, ErrHeatmapPillarRequired)
+}
+
+func TestCapabilityHeatmapQuery_ImportancePassesPillarAndDomain(t *testing.T) {
+	local := &stubHeatmapLocalMetrics{importance: map[string]float64{"leads": 4}}
+	query := newTestHeatmapQuery(local, HeatmapExternalSources{})
+
+	result, err := query.Execute(context.Background(), CapabilityHeatmapRequest{
+		Metric:           valueobjects.HeatmapMetricImportance,
+		PillarID:         "pillar-1",
+		BusinessDomainID: "commercial",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "pillar-1", local.pillarAsked)
+	assert.Equal(t, "commercial", local.domainAsked)
+	assert.Equal(t, 4.0, *result.Roots[0].Value)
+}
+
+func TestCapabilityHeatmapQuery_CostSumsDirectComponents(t *testing.T) {
+	local := &stubHeatmapLocalMetrics{components: map[string][]string{
+		"leads":  {"crm"},
+		"orders": {"crm", "erp"},
+	}}
+	query := newTestHeatmapQuery(local, HeatmapExternalSources{
+		ComponentCosts: stubComponentCostSource{"crm": 100, "erp": 250},
+	})
+
+	result, err := query.Execute(context.Background(), CapabilityHeatmapRequest{
+		Metric:      valueobjects.HeatmapMetricCost,
+		CostFieldID: "annual-cost",
+	})
+	require.NoError(t, err)
+
+	sales := result.Roots[1]
+	assert.Equal(t, 450.0, *sales.Value)
+	assert.Equal(t, 350.0, *sales.Children[1].Value)
+	assert.Nil(t, result.Roots[0].Value)
+}
+
+func TestCapabilityHeatmapQuery_CostRequiresField(t *testing.T) {
+	query := newTestHeatmapQuery(&stubHeatmapLocalMetrics{}, HeatmapExternalSources{ComponentCosts: stubComponentCostSource{}})
+
+	_, err := query.Execute(context.Background(), CapabilityHeatmapRequest{Metric: valueobjects.HeatmapMetricCost})
+
+	assert.ErrorIs(t, err, ErrHeatmapCostFieldRequired)
+}
+
+func TestCapabilityHeatmapQuery_ExternalMetricUsesSource(t *testing.T) {
+	query := newTestHeatmapQuery(&stubHeatmapLocalMetrics{}, HeatmapExternalSources{
+		EliminateCounts: stubCapabilityMetricSource{"leads": 2, "orders": 1},
+	})
+
+	result, err := query.Execute(context.Background(), CapabilityHeatmapRequest{Metric: valueobjects.HeatmapMetricEliminateCount})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3.0, *result.Roots[1].Value)
+	assert.Equal(t, valueobjects.HeatmapPolarityLowerIsBetter, result.Polarity)
+}
+
+func TestCapabilityHeatmapQuery_MissingExternalSource(t *testing.T) {
+	query := newTestHeatmapQuery(&stubHeatmapLocalMetrics{}, HeatmapExternalSources{})
+
+	_, err := query.Execute(context.Background(), CapabilityHeatmapRequest{Metric: valueobjects.HeatmapMetricActiveJourneys})
+
+	assert.ErrorIs(t, err, ErrHeatmapMetricUnavailable)
+}
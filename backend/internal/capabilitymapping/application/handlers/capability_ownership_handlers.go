@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/domain/events"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/google/uuid"
+)
+
+var ErrOwnershipPartyNotFound = errors.New("owner must be an active user or an existing internal team")
+
+type CapabilityOwnershipRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.Capability, error)
+	Save(ctx context.Context, capability *aggregates.Capability) error
+}
+
+type OwnershipPartyChecker interface {
+	IsActiveParty(ctx context.Context, partyType, partyID string) (bool, error)
+}
+
+type OwnedCapabilityLister interface {
+	GetCapabilityIDsForParty(ctx context.Context, partyType, partyID string) ([]string, error)
+}
+
+type CapabilityOwnershipDeps struct {
+	Repository CapabilityOwnershipRepository
+	Parties    OwnershipPartyChecker
+	Owned      OwnedCapabilityLister
+}
+
+type AssignCapabilityOwnerHandler struct {
+	deps CapabilityOwnershipDeps
+}
+
+func NewAssignCapabilityOwnerHandler(deps CapabilityOwnershipDeps) *AssignCapabilityOwnerHandler {
+	return &AssignCapabilityOwnerHandler{deps: deps}
+}
+
+func (h *AssignCapabilityOwnerHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.AssignCapabilityOwner)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	party, err := h.deps.activeParty(ctx, command.PartyType, command.PartyID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	role, err := valueobjects.NewRACIRole(command.Role)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	capability, err := h.deps.Repository.GetByID(ctx, command.CapabilityID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := capability.AssignOwner(valueobjects.NewRACIAssignment(party, role)); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), h.deps.Repository.Save(ctx, capability)
+}
+
+type UnassignCapabilityOwnerHandler struct {
+	repository CapabilityOwnershipRepository
+}
+
+func NewUnassignCapabilityOwnerHandler(repository CapabilityOwnershipRepository) *UnassignCapabilityOwnerHandler {
+	return &UnassignCapabilityOwnerHandler{repository: repository}
+}
+
+func (h *UnassignCapabilityOwnerHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.UnassignCapabilityOwner)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	party, err := valueobjects.NewOwnershipParty(command.PartyType, command.PartyID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	capability, err := h.repository.GetByID(ctx, command.CapabilityID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := capability.UnassignOwner(party); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), h.repository.Save(ctx, capability)
+}
+
+type ReassignCapabilityOwnersHandler struct {
+	deps CapabilityOwnershipDeps
+}
+
+func NewReassignCapabilityOwnersHandler(deps CapabilityOwnershipDeps) *ReassignCapabilityOwnersHandler {
+	return &ReassignCapabilityOwnersHandler{deps: deps}
+}
+
+func (h *ReassignCapabilityOwnersHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.ReassignCapabilityOwners)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	from, err := valueobjects.NewOwnershipParty(command.FromType, command.FromID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	var successor valueobjects.OwnershipParty
+	if command.ToID != "" {
+		if successor, err = h.deps.activeParty(ctx, command.ToType, command.ToID); err != nil {
+			return cqrs.EmptyResult(), err
+		}
+	}
+	reason := command.Reason
+	if reason == "" {
+		reason = events.OwnerUnassignedReassigned
+	}
+
+	ids, err := h.deps.Owned.GetCapabilityIDsForParty(ctx, from.Type().Value(), from.ID())
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if _, ok := sharedctx.GetCorrelationID(ctx); !ok {
+		ctx = sharedctx.WithCorrelationID(ctx, uuid.New().String())
+	}
+
+	for _, id := range ids {
+		capability, err := h.deps.Repository.GetByID(ctx, id)
+		if err != nil {
+			return cqrs.EmptyResult(), err
+		}
+		if err := capability.ReassignOwner(from, successor, command.AccountableOnly, reason); err != nil {
+			if errors.Is(err, aggregates.ErrCapabilityOwnerNotFound) {
+				continue
+			}
+			return cqrs.EmptyResult(), err
+		}
+		if err := h.deps.Repository.Save(ctx, capability); err != nil {
+			return cqrs.EmptyResult(), err
+		}
+	}
+
+	return cqrs.EmptyResult(), nil
+}
+
+func (d CapabilityOwnershipDeps) activeParty(ctx context.Context, partyType, partyID string) (valueobjects.OwnershipParty, error) {
+	party, err := valueobjects.NewOwnershipParty(partyType, partyID)
+	if err != nil {
+		return valueobjects.OwnershipParty{}, err
+	}
+	active, err := d.Parties.IsActiveParty(ctx, party.Type().Value(), party.ID())
+	if err != nil {
+		return valueobjects.OwnershipParty{}, err
+	}
+	if !active {
+		return valueobjects.OwnershipParty{}, ErrOwnershipPartyNotFound
+	}
+	return party, nil
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/domain/events"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ownedCapabilityStore struct {
+	*taggedCapabilityStore
+	activeParties map[string]bool
+}
+
+func newOwnedCapabilityStore(t *testing.T, names ...string) *ownedCapabilityStore {
+	t.Helper()
+	tagsByName := make(map[string][]string, len(names))
+	for _, name := range names {
+		tagsByName[name] = nil
+	}
+	return &ownedCapabilityStore{
+		taggedCapabilityStore: newTaggedCapabilityStore(t, tagsByName),
+		activeParties:         map[string]bool{"user/alice": true, "user/bob": true, "team/crm-team": true},
+	}
+}
+
+func (s *ownedCapabilityStore) IsActiveParty(_ context.Context, partyType, partyID string) (bool, error) {
+	return s.activeParties[partyType+"/"+partyID], nil
+}
+
+func (s *ownedCapabilityStore) GetCapabilityIDsForParty(_ context.Context, partyType, partyID string) ([]string, error) {
+	var ids []string
+	for _, capability := range s.capabilities {
+		for _, owner := range capability.Owners() {
+			if owner.Party().Type().Value() == partyType && owner.Party().ID() == partyID {
+				ids = append(ids, capability.ID())
+			}
+		}
+	}
+	return ids, nil
+}
+
+func (s *ownedCapabilityStore) deps() CapabilityOwnershipDeps {
+	return CapabilityOwnershipDeps{Repository: s, Parties: s, Owned: s}
+}
+
+func (s *ownedCapabilityStore) assign(t *testing.T, name, partyType, partyID string, role valueobjects.RACIRole) {
+	t.Helper()
+	party, err := valueobjects.NewOwnershipParty(partyType, partyID)
+	require.NoError(t, err)
+	require.NoError(t, s.capabilities[name].AssignOwner(valueobjects.NewRACIAssignment(party, role)))
+	s.capabilities[name].MarkChangesAsCommitted()
+}
+
+func ownerRolesOf(capability *aggregates.Capability) map[string]valueobjects.RACIRole {
+	roles := make(map[string]valueobjects.RACIRole)
+	for _, owner := range capability.Owners() {
+		roles[owner.Party().Type().Value()+"/"+owner.Party().ID()] = owner.Role()
+	}
+	return roles
+}
+
+func TestAssignCapabilityOwnerHandler_AssignsActiveParty(t *testing.T) {
+	store := newOwnedCapabilityStore(t, "crm")
+	handler := NewAssignCapabilityOwnerHandler(store.deps())
+
+	_, err := handler.Handle(context.Background(), &commands.AssignCapabilityOwner{
+		CapabilityID: store.capabilities["crm"].ID(),
+		PartyType:    "team",
+		PartyID:      "crm-team",
+		Role:         "Accountable",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]valueobjects.RACIRole{"team/crm-team": valueobjects.RACIAccountable}, ownerRolesOf(store.capabilities["crm"]))
+	assert.Len(t, store.saved, 1)
+}
+
+func TestAssignCapabilityOwnerHandler_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmd      commands.AssignCapabilityOwner
+		expected error
+	}{
+		{"unknown party", commands.AssignCapabilityOwner{PartyType: "user", PartyID: "mallory", Role: "Responsible"}, ErrOwnershipPartyNotFound},
+		{"invalid party type", commands.AssignCapabilityOwner{PartyType: "department", PartyID: "alice", Role: "Responsible"}, valueobjects.ErrInvalidPartyType},
+		{"invalid role", commands.AssignCapabilityOwner{PartyType: "user", PartyID: "alice", Role: "Owner"}, valueobjects.ErrInvalidRACIRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newOwnedCapabilityStore(t, "crm")
+			tt.cmd.CapabilityID = store.capabilities["crm"].ID()
+
+			_, err := NewAssignCapabilityOwnerHandler(store.deps()).Handle(context.Background(), &tt.cmd)
+
+			assert.ErrorIs(t, err, tt.expected)
+			assert.Empty(t, store.saved)
+		})
+	}
+}
+
+func TestUnassignCapabilityOwnerHandler_UnknownOwner(t *testing.T) {
+	store := newOwnedCapabilityStore(t, "crm")
+
+	_, err := NewUnassignCapabilityOwnerHandler(store).Handle(context.Background(), &commands.UnassignCapabilityOwner{
+		CapabilityID: store.capabilities["crm"].ID(),
+		PartyType:    "user",
+		PartyID:      "alice",
+	})
+
+	assert.ErrorIs(t, err, aggregates.ErrCapabilityOwnerNotFound)
+}
+
+func TestReassignCapabilityOwnersHandler_MovesAccountabilityOnly(t *testing.T) {
+	store := newOwnedCapabilityStore(t, "crm", "billing", "sales")
+	store.assign(t, "crm", "user", "alice", valueobjects.RACIAccountable)
+	store.assign(t, "billing", "user", "alice", valueobjects.RACIConsulted)
+	handler := NewReassignCapabilityOwnersHandler(store.deps())
+
+	_, err := handler.Handle(context.Background(), &commands.ReassignCapabilityOwners{
+		FromType:        "user",
+		FromID:          "alice",
+		ToType:          "user",
+		ToID:            "bob",
+		AccountableOnly: true,
+		Reason:          events.OwnerUnassignedUserDisabled,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]valueobjects.RACIRole{"user/bob": valueobjects.RACIAccountable}, ownerRolesOf(store.capabilities["crm"]))
+	assert.Empty(t, store.capabilities["billing"].Owners())
+	assert.ElementsMatch(t, []string{store.capabilities["crm"].ID(), store.capabilities["billing"].ID()}, store.saved)
+}
+
+func TestReassignCapabilityOwnersHandler_RejectsInactiveSuccessor(t *testing.T) {
+	store := newOwnedCapabilityStore(t, "crm")
+	store.assign(t, "crm", "user", "alice", valueobjects.RACIAccountable)
+
+	_, err := NewReassignCapabilityOwnersHandler(store.deps()).Handle(context.Background(), &commands.ReassignCapabilityOwners{
+		FromType: "user",
+		FromID:   "alice",
+		ToType:   "user",
+		ToID:     "mallory",
+	})
+
+	assert.ErrorIs(t, err, ErrOwnershipPartyNotFound)
+	assert.Empty(t, store.saved)
+}
@@ -115,7 +115,7 @@ func cascadeCapabilityWithParent(t *testing.T, level, parentID string) *aggregat
 	desc := valueobjects.MustNewDescription("Test")
 	lvl, _ := valueobjects.NewCapabilityLevel(level)
 	pid, _ := valueobjects.NewCapabilityIDFromString(parentID)
-	cap, err := aggregates.NewCapability(name, desc, pid, lvl)
+	cap, err := aggregates.NewCapability(name, desc, pid, lvl, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 	cap.MarkChangesAsCommitted()
 	return cap
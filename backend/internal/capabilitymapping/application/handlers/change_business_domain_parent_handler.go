@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type ChangeBusinessDomainParentRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.BusinessDomain, error)
+	Save(ctx context.Context, domain *aggregates.BusinessDomain) error
+}
+
+type ChangeBusinessDomainParentReadModel interface {
+	GetByID(ctx context.Context, id string) (*readmodels.BusinessDomainDTO, error)
+}
+
+type ChangeBusinessDomainParentHandler struct {
+	repository ChangeBusinessDomainParentRepository
+	readModel  ChangeBusinessDomainParentReadModel
+}
+
+func NewChangeBusinessDomainParentHandler(
+	repository ChangeBusinessDomainParentRepository,
+	readModel ChangeBusinessDomainParentReadModel,
+) *ChangeBusinessDomainParentHandler {
+	return &ChangeBusinessDomainParentHandler{
+		repository: repository,
+		readModel:  readModel,
+	}
+}
+
+func (h *ChangeBusinessDomainParentHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.ChangeBusinessDomainParent)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	domain, err := h.repository.GetByID(ctx, command.ID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrBusinessDomainNotFound) {
+			return cqrs.EmptyResult(), ErrBusinessDomainNotFound
+		}
+		return cqrs.EmptyResult(), err
+	}
+
+	ancestorIDs, err := h.parentAncestorIDs(ctx, command.ParentID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := domain.ChangeParent(command.ParentID, ancestorIDs); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, domain); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
+
+func (h *ChangeBusinessDomainParentHandler) parentAncestorIDs(ctx context.Context, parentID string) ([]string, error) {
+	if parentID == "" {
+		return nil, nil
+	}
+
+	parent, err := h.readModel.GetByID(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		return nil, ErrParentBusinessDomainNotFound
+	}
+
+	ids := []string{}
+	visited := map[string]struct{}{parent.ID: {}}
+	currentID := parent.ParentID
+
+	for currentID != "" {
+		if _, seen := visited[currentID]; seen {
+			break
+		}
+		visited[currentID] = struct{}{}
+		ids = append(ids, currentID)
+
+		ancestor, err := h.readModel.GetByID(ctx, currentID)
+		if err != nil {
+			return nil, err
+		}
+		if ancestor == nil {
+			break
+		}
+		currentID = ancestor.ParentID
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/domain/events"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockChangeDomainParentRepository struct {
+	domain     *aggregates.BusinessDomain
+	savedCount int
+}
+
+func (m *mockChangeDomainParentRepository) GetByID(ctx context.Context, id string) (*aggregates.BusinessDomain, error) {
+	if m.domain == nil || m.domain.ID() != id {
+		return nil, repositories.ErrBusinessDomainNotFound
+	}
+	return m.domain, nil
+}
+
+func (m *mockChangeDomainParentRepository) Save(ctx context.Context, domain *aggregates.BusinessDomain) error {
+	m.savedCount++
+	return nil
+}
+
+type mockChangeDomainParentReadModel struct {
+	domains map[string]*readmodels.BusinessDomainDTO
+}
+
+func (m *mockChangeDomainParentReadModel) GetByID(ctx context.Context, id string) (*readmodels.BusinessDomainDTO, error) {
+	return m.domains[id], nil
+}
+
+func newDomainForParentChange(t *testing.T) *aggregates.BusinessDomain {
+	t.Helper()
+	name, err := valueobjects.NewDomainName("Payments")
+	require.NoError(t, err)
+	domain, err := aggregates.NewBusinessDomain(name, valueobjects.MustNewDescription(""), valueobjects.DomainArchitects{}, "")
+	require.NoError(t, err)
+	domain.MarkChangesAsCommitted()
+	return domain
+}
+
+func TestChangeBusinessDomainParentHandler_MovesDomainUnderParent(t *testing.T) {
+	domain := newDomainForParentChange(t)
+	repo := &mockChangeDomainParentRepository{domain: domain}
+	readModel := &mockChangeDomainParentReadModel{domains: map[string]*readmodels.BusinessDomainDTO{
+		"segment-1":  {ID: "segment-1", ParentID: "division-1"},
+		"division-1": {ID: "division-1"},
+	}}
+
+	handler := NewChangeBusinessDomainParentHandler(repo, readModel)
+
+	_, err := handler.Handle(context.Background(), &commands.ChangeBusinessDomainParent{ID: domain.ID(), ParentID: "segment-1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, repo.savedCount)
+	assert.Equal(t, "segment-1", domain.ParentID())
+	changes := domain.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	assert.IsType(t, events.BusinessDomainParentChanged{}, changes[0])
+}
+
+func TestChangeBusinessDomainParentHandler_RejectsCycleThroughAncestors(t *testing.T) {
+	domain := newDomainForParentChange(t)
+	repo := &mockChangeDomainParentRepository{domain: domain}
+	readModel := &mockChangeDomainParentReadModel{domains: map[string]*readmodels.BusinessDomainDTO{
+		"child-1":   {ID: "child-1", ParentID: domain.ID()},
+		domain.ID(): {ID: domain.ID()},
+	}}
+
+	handler := NewChangeBusinessDomainParentHandler(repo, readModel)
+
+	_, err := handler.Handle(context.Background(), &commands.ChangeBusinessDomainParent{ID: domain.ID(), ParentID: "child-1"})
+	assert.ErrorIs(t, err, aggregates.ErrBusinessDomainCircularHierarchy)
+	assert.Zero(t, repo.savedCount)
+}
+
+func TestChangeBusinessDomainParentHandler_ErrorPaths(t *testing.T) {
+	tests := []struct {
+		name       string
+		cmd        func(domainID string) *commands.ChangeBusinessDomainParent
+		expectedIs error
+	}{
+		{
+			name: "unknown domain",
+			cmd: func(string) *commands.ChangeBusinessDomainParent {
+				return &commands.ChangeBusinessDomainParent{ID: "missing"}
+			},
+			expectedIs: ErrBusinessDomainNotFound,
+		},
+		{
+			name: "unknown parent",
+			cmd: func(id string) *commands.ChangeBusinessDomainParent {
+				return &commands.ChangeBusinessDomainParent{ID: id, ParentID: "missing"}
+			},
+			expectedIs: ErrParentBusinessDomainNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domain := newDomainForParentChange(t)
+			repo := &mockChangeDomainParentRepository{domain: domain}
+			handler := NewChangeBusinessDomainParentHandler(repo, &mockChangeDomainParentReadModel{})
+
+			_, err := handler.Handle(context.Background(), tt.cmd(domain.ID()))
+			assert.ErrorIs(t, err, tt.expectedIs)
+			assert.Zero(t, repo.savedCount)
+		})
+	}
+}
+
+func TestChangeBusinessDomainParentHandler_InvalidCommand_ReturnsError(t *testing.T) {
+	handler := NewChangeBusinessDomainParentHandler(&mockChangeDomainParentRepository{}, &mockChangeDomainParentReadModel{})
+
+	_, err := handler.Handle(context.Background(), &commands.DeleteBusinessDomain{})
+	assert.ErrorIs(t, err, cqrs.ErrInvalidCommand)
+}
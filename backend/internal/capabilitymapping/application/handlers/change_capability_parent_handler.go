@@ -37,6 +37,7 @@ type ChangeCapabilityParentHandler struct {
 	capabilityReadModel  ChangeCapabilityParentReadModel
 	realizationReadModel ChangeCapabilityParentRealizationReadModel
 	reparentingService   services.CapabilityReparentingService
+	hierarchies          services.CapabilityHierarchyProvider
 }
 
 func NewChangeCapabilityParentHandler(
@@ -44,12 +45,14 @@ func NewChangeCapabilityParentHandler(
 	capabilityReadModel ChangeCapabilityParentReadModel,
 	realizationReadModel ChangeCapabilityParentRealizationReadModel,
 	reparentingService services.CapabilityReparentingService,
+	hierarchies services.CapabilityHierarchyProvider,
 ) *ChangeCapabilityParentHandler {
 	return &ChangeCapabilityParentHandler{
 		repository:           repository,
 		capabilityReadModel:  capabilityReadModel,
 		realizationReadModel: realizationReadModel,
 		reparentingService:   reparentingService,
+		hierarchies:          hierarchies,
 	}
 }
 
@@ -69,18 +72,23 @@ func (h *ChangeCapabilityParentHandler) Handle(ctx context.Context, cmd cqrs.Com
 		return cqrs.EmptyResult(), err
 	}
 
-	if err := h.applyParentChange(ctx, capability, newParentID, newLevel); err != nil {
+	hierarchy, err := h.hierarchies.GetCapabilityHierarchy(ctx)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.applyParentChange(ctx, capability, newParentID, newLevel, hierarchy); err != nil {
 		return cqrs.EmptyResult(), err
 	}
 
-	if err := h.updateDescendantLevels(ctx, command.CapabilityID, newLevel); err != nil {
+	if err := h.updateDescendantLevels(ctx, command.CapabilityID, newLevel, hierarchy); err != nil {
 		return cqrs.EmptyResult(), err
 	}
 
 	return cqrs.EmptyResult(), nil
 }
 
-func (h *ChangeCapabilityParentHandler) applyParentChange(ctx context.Context, capability *aggregates.Capability, newParentID valueobjects.CapabilityID, newLevel valueobjects.CapabilityLevel) error {
+func (h *ChangeCapabilityParentHandler) applyParentChange(ctx context.Context, capability *aggregates.Capability, newParentID valueobjects.CapabilityID, newLevel valueobjects.CapabilityLevel, hierarchy valueobjects.CapabilityHierarchy) error {
 	oldParentID := capability.ParentID().Value()
 
 	additions, removals, err := h.buildInheritanceChanges(ctx, capability.ID(), oldParentID, newParentID.Value())
@@ -88,7 +96,7 @@ func (h *ChangeCapabilityParentHandler) applyParentChange(ctx context.Context, c
 		return err
 	}
 
-	if err := capability.ChangeParent(newParentID, newLevel); err != nil {
+	if err := capability.ChangeParent(newParentID, newLevel, hierarchy); err != nil {
 		return err
 	}
 
@@ -242,7 +250,7 @@ func buildInheritanceSource(realization readmodels.RealizationDTO, capability *r
 	return realization.ID, capability.ID, capability.Name
 }
 
-func (h *ChangeCapabilityParentHandler) updateDescendantLevels(ctx context.Context, parentID string, parentLevel valueobjects.CapabilityLevel) error {
+func (h *ChangeCapabilityParentHandler) updateDescendantLevels(ctx context.Context, parentID string, parentLevel valueobjects.CapabilityLevel, hierarchy valueobjects.CapabilityHierarchy) error {
 	children, err := h.capabilityReadModel.GetChildren(ctx, parentID)
 	if err != nil {
 		return err
@@ -252,13 +260,13 @@ func (h *ChangeCapabilityParentHandler) updateDescendantLevels(ctx context.Conte
 		return nil
 	}
 
-	childLevel, err := h.reparentingService.CalculateChildLevel(parentLevel)
+	childLevel, err := h.reparentingService.CalculateChildLevel(ctx, parentLevel)
 	if err != nil {
 		return err
 	}
 
 	for _, child := range children {
-		if err := h.updateChildLevel(ctx, child.ID, childLevel); err != nil {
+		if err := h.updateChildLevel(ctx, child.ID, childLevel, hierarchy); err != nil {
 			return err
 		}
 	}
@@ -266,13 +274,13 @@ func (h *ChangeCapabilityParentHandler) updateDescendantLevels(ctx context.Conte
 	return nil
 }
 
-func (h *ChangeCapabilityParentHandler) updateChildLevel(ctx context.Context, childID string, childLevel valueobjects.CapabilityLevel) error {
+func (h *ChangeCapabilityParentHandler) updateChildLevel(ctx context.Context, childID string, childLevel valueobjects.CapabilityLevel, hierarchy valueobjects.CapabilityHierarchy) error {
 	childCapability, err := h.repository.GetByID(ctx, childID)
 	if err != nil {
 		return err
 	}
 
-	if err := childCapability.ChangeLevel(childLevel); err != nil {
+	if err := childCapability.ChangeLevel(childLevel, hierarchy); err != nil {
 		return err
 	}
 
@@ -280,5 +288,5 @@ func (h *ChangeCapabilityParentHandler) updateChildLevel(ctx context.Context, ch
 		return err
 	}
 
-	return h.updateDescendantLevels(ctx, childID, childLevel)
+	return h.updateDescendantLevels(ctx, childID, childLevel, hierarchy)
 }
@@ -76,17 +76,18 @@ func (m *mockReparentingService) DetermineNewLevel(ctx context.Context, capabili
 	return m.level, nil
 }
 
-func (m *mockReparentingService) CalculateChildLevel(parentLevel valueobjects.CapabilityLevel) (valueobjects.CapabilityLevel, error) {
-	switch parentLevel {
-	case valueobjects.LevelL1:
-		return valueobjects.LevelL2, nil
-	case valueobjects.LevelL2:
-		return valueobjects.LevelL3, nil
-	case valueobjects.LevelL3:
-		return valueobjects.LevelL4, nil
-	default:
+func (m *mockReparentingService) CalculateChildLevel(ctx context.Context, parentLevel valueobjects.CapabilityLevel) (valueobjects.CapabilityLevel, error) {
+	childLevel, ok := valueobjects.DefaultCapabilityHierarchy().ChildLevel(parentLevel)
+	if !ok {
 		return "", aggregates.ErrWouldExceedMaximumDepth
 	}
+	return childLevel, nil
+}
+
+type defaultHierarchyProvider struct{}
+
+func (defaultHierarchyProvider) GetCapabilityHierarchy(context.Context) (valueobjects.CapabilityHierarchy, error) {
+	return valueobjects.DefaultCapabilityHierarchy(), nil
 }
 
 func TestChangeCapabilityParentHandler_EmitsInheritanceEvents(t *testing.T) {
@@ -143,7 +144,7 @@ func TestChangeCapabilityParentHandler_EmitsInheritanceEvents(t *testing.T) {
 		},
 	}
 
-	handler := NewChangeCapabilityParentHandler(repo, capRM, realRM, &mockReparentingService{level: valueobjects.LevelL2})
+	handler := NewChangeCapabilityParentHandler(repo, capRM, realRM, &mockReparentingService{level: valueobjects.LevelL2}, defaultHierarchyProvider{})
 
 	cmd := &commands.ChangeCapabilityParent{CapabilityID: capability.ID(), NewParentID: newParentID.Value()}
 	_, err = handler.Handle(context.Background(), cmd)
@@ -199,7 +200,7 @@ func newTestCapability(t *testing.T, parentID valueobjects.CapabilityID, level v
 	require.NoError(t, err)
 	description := valueobjects.MustNewDescription("Test")
 
-	capability, err := aggregates.NewCapability(name, description, parentID, level)
+	capability, err := aggregates.NewCapability(name, description, parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 	capability.MarkChangesAsCommitted()
 	return capability
@@ -5,12 +5,16 @@ import (
 	"errors"
 
 	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
 	"easi/backend/internal/capabilitymapping/domain/aggregates"
 	"easi/backend/internal/capabilitymapping/domain/valueobjects"
 	"easi/backend/internal/shared/cqrs"
 )
 
-var ErrBusinessDomainNameExists = errors.New("business domain with this name already exists")
+var (
+	ErrBusinessDomainNameExists     = errors.New("business domain with this name already exists")
+	ErrParentBusinessDomainNotFound = errors.New("parent business domain not found")
+)
 
 type CreateBusinessDomainRepository interface {
 	Save(ctx context.Context, domain *aggregates.BusinessDomain) error
@@ -18,6 +22,7 @@ type CreateBusinessDomainRepository interface {
 
 type CreateBusinessDomainReadModel interface {
 	NameExists(ctx context.Context, name, excludeID string) (bool, error)
+	GetByID(ctx context.Context, id string) (*readmodels.BusinessDomainDTO, error)
 }
 
 type CreateBusinessDomainHandler struct {
@@ -59,7 +64,19 @@ func (h *CreateBusinessDomainHandler) Handle(ctx context.Context, cmd cqrs.Comma
 		return cqrs.EmptyResult(), err
 	}
 
-	domain, err := aggregates.NewBusinessDomain(name, description, command.DomainArchitectID)
+	if command.ParentID != "" {
+		parent, err := h.readModel.GetByID(ctx, command.ParentID)
+		if err != nil {
+			return cqrs.EmptyResult(), err
+		}
+		if parent == nil {
+			return cqrs.EmptyResult(), ErrParentBusinessDomainNotFound
+		}
+	}
+
+	architects := domainArchitectsFrom(command.DomainArchitectIDs, command.DomainArchitectID)
+
+	domain, err := aggregates.NewBusinessDomain(name, description, architects, command.ParentID)
 	if err != nil {
 		return cqrs.EmptyResult(), err
 	}
@@ -70,3 +87,12 @@ func (h *CreateBusinessDomainHandler) Handle(ctx context.Context, cmd cqrs.Comma
 
 	return cqrs.NewResult(domain.ID()), nil
 }
+
+// domainArchitectsFrom accepts the architect list and falls back to the single
+// architect field still sent by older clients.
+func domainArchitectsFrom(ids []string, legacyID string) valueobjects.DomainArchitects {
+	if len(ids) == 0 && legacyID != "" {
+		return valueobjects.NewDomainArchitects([]string{legacyID})
+	}
+	return valueobjects.NewDomainArchitects(ids)
+}
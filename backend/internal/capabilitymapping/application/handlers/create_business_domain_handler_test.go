@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
 	"easi/backend/internal/capabilitymapping/domain/aggregates"
 	"easi/backend/internal/shared/cqrs"
 
@@ -29,6 +30,11 @@ func (m *mockCreateBusinessDomainRepository) Save(ctx context.Context, domain *a
 type mockCreateBusinessDomainReadModel struct {
 	nameExists bool
 	checkErr   error
+	domains    map[string]*readmodels.BusinessDomainDTO
+}
+
+func (m *mockCreateBusinessDomainReadModel) GetByID(ctx context.Context, id string) (*readmodels.BusinessDomainDTO, error) {
+	return m.domains[id], nil
 }
 
 func (m *mockCreateBusinessDomainReadModel) NameExists(ctx context.Context, name, excludeID string) (bool, error) {
@@ -59,6 +65,40 @@ func TestCreateBusinessDomainHandler_CreatesBusinessDomain(t *testing.T) {
 	assert.Equal(t, "Manages customer relationships", domain.Description().Value())
 }
 
+func TestCreateBusinessDomainHandler_NestsUnderParentWithArchitects(t *testing.T) {
+	mockRepo := &mockCreateBusinessDomainRepository{}
+	mockReadModel := &mockCreateBusinessDomainReadModel{
+		domains: map[string]*readmodels.BusinessDomainDTO{"division-1": {ID: "division-1", Name: "Retail"}},
+	}
+
+	handler := NewCreateBusinessDomainHandler(mockRepo, mockReadModel)
+
+	cmd := &commands.CreateBusinessDomain{
+		Name:               "Payments",
+		ParentID:           "division-1",
+		DomainArchitectIDs: []string{"architect-1", "architect-2", "architect-1"},
+	}
+
+	_, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	require.Len(t, mockRepo.savedDomains, 1)
+	domain := mockRepo.savedDomains[0]
+	assert.Equal(t, "division-1", domain.ParentID())
+	assert.Equal(t, []string{"architect-1", "architect-2"}, domain.Architects().IDs())
+}
+
+func TestCreateBusinessDomainHandler_LegacySingleArchitect(t *testing.T) {
+	mockRepo := &mockCreateBusinessDomainRepository{}
+	handler := NewCreateBusinessDomainHandler(mockRepo, &mockCreateBusinessDomainReadModel{})
+
+	_, err := handler.Handle(context.Background(), &commands.CreateBusinessDomain{Name: "Sales", DomainArchitectID: "architect-1"})
+	require.NoError(t, err)
+
+	require.Len(t, mockRepo.savedDomains, 1)
+	assert.Equal(t, []string{"architect-1"}, mockRepo.savedDomains[0].Architects().IDs())
+}
+
 func TestCreateBusinessDomainHandler_ReturnsCreatedID(t *testing.T) {
 	mockRepo := &mockCreateBusinessDomainRepository{}
 	mockReadModel := &mockCreateBusinessDomainReadModel{nameExists: false}
@@ -92,6 +132,13 @@ func TestCreateBusinessDomainHandler_ErrorPaths(t *testing.T) {
 			expectedIs: ErrBusinessDomainNameExists,
 			msg:        "Should not save domain when name exists",
 		},
+		{
+			name:       "unknown parent",
+			readModel:  &mockCreateBusinessDomainReadModel{},
+			cmd:        &commands.CreateBusinessDomain{Name: "Payments", ParentID: "missing"},
+			expectedIs: ErrParentBusinessDomainNotFound,
+			msg:        "Should not save domain under a missing parent",
+		},
 		{
 			name:      "invalid name",
 			readModel: &mockCreateBusinessDomainReadModel{nameExists: false},
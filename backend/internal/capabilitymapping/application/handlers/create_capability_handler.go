@@ -5,6 +5,7 @@ import (
 
 	"easi/backend/internal/capabilitymapping/application/commands"
 	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/domain/services"
 	"easi/backend/internal/capabilitymapping/domain/valueobjects"
 	"easi/backend/internal/shared/cqrs"
 )
@@ -14,12 +15,14 @@ type CreateCapabilityRepository interface {
 }
 
 type CreateCapabilityHandler struct {
-	repository CreateCapabilityRepository
+	repository  CreateCapabilityRepository
+	hierarchies services.CapabilityHierarchyProvider
 }
 
-func NewCreateCapabilityHandler(repository CreateCapabilityRepository) *CreateCapabilityHandler {
+func NewCreateCapabilityHandler(repository CreateCapabilityRepository, hierarchies services.CapabilityHierarchyProvider) *CreateCapabilityHandler {
 	return &CreateCapabilityHandler{
-		repository: repository,
+		repository:  repository,
+		hierarchies: hierarchies,
 	}
 }
 
@@ -52,7 +55,12 @@ func (h *CreateCapabilityHandler) Handle(ctx context.Context, cmd cqrs.Command)
 		}
 	}
 
-	capability, err := aggregates.NewCapability(name, description, parentID, level)
+	hierarchy, err := h.hierarchies.GetCapabilityHierarchy(ctx)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	capability, err := aggregates.NewCapability(name, description, parentID, level, hierarchy)
 	if err != nil {
 		return cqrs.EmptyResult(), err
 	}
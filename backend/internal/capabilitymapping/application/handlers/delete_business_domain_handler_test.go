@@ -68,6 +68,14 @@ func TestDeleteBusinessDomainHandler_WithExistingDomain(t *testing.T) {
 			expectedSaveCount: 0,
 			msg:               "Should not save when domain has assignments",
 		},
+		{
+			name:              "domain has child domains",
+			canDeleteErr:      services.ErrBusinessDomainHasChildren,
+			expectErr:         true,
+			expectedIs:        services.ErrBusinessDomainHasChildren,
+			expectedSaveCount: 0,
+			msg:               "Should not save when domain has child domains",
+		},
 		{
 			name:              "deletion service error",
 			canDeleteErr:      errors.New("database error"),
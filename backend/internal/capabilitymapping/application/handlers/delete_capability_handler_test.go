@@ -81,7 +81,7 @@ func newCommittedCapability(t *testing.T, spec capabilitySpec) *aggregates.Capab
 	level, err := valueobjects.NewCapabilityLevel(spec.level)
 	require.NoError(t, err)
 
-	capability, err := aggregates.NewCapability(capName, valueobjects.MustNewDescription(spec.description), spec.parentID, level)
+	capability, err := aggregates.NewCapability(capName, valueobjects.MustNewDescription(spec.description), spec.parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 	capability.MarkChangesAsCommitted()
 
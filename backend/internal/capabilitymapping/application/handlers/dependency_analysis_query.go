@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+const (
+	FragilityUnrealized        = "unrealized"
+	FragilitySingleApplication = "single-application"
+	FragilityAllEliminate      = "all-eliminate"
+)
+
+type DependencyGraphReader interface {
+	GetAll(ctx context.Context) ([]readmodels.DependencyDTO, error)
+}
+
+type DependencyCapabilityReader interface {
+	GetAll(ctx context.Context) ([]readmodels.CapabilityDTO, error)
+}
+
+type DependencyRealizationReader interface {
+	GetAll(ctx context.Context) ([]readmodels.RealizationDTO, error)
+}
+
+// EliminateGradeSource reports, per capability, which realizing components are graded Eliminate.
+type EliminateGradeSource interface {
+	EliminateGraded(ctx context.Context, capabilityIDs []string) (map[string]map[string]bool, error)
+}
+
+type ImpactedCapabilityView struct {
+	CapabilityID   string
+	CapabilityName string
+	Level          string
+	Depth          int
+	ViaID          string
+	DependencyType string
+}
+
+type DependencyImpactResult struct {
+	CapabilityID string
+	Direction    services.ImpactDirection
+	MaxDepth     int
+	Impacted     []ImpactedCapabilityView
+}
+
+type CapabilityCriticalityView struct {
+	services.DependencyCriticality
+	CapabilityName string
+}
+
+type FragileDependencyView struct {
+	CapabilityCriticalityView
+	ComponentIDs []string
+	Reasons      []string
+}
+
+type DependencyAnalysisQuery struct {
+	dependencies DependencyGraphReader
+	capabilities DependencyCapabilityReader
+	realizations DependencyRealizationReader
+	grades       EliminateGradeSource
+}
+
+func NewDependencyAnalysisQuery(
+	dependencies DependencyGraphReader,
+	capabilities DependencyCapabilityReader,
+	realizations DependencyRealizationReader,
+	grades EliminateGradeSource,
+) *DependencyAnalysisQuery {
+	return &DependencyAnalysisQuery{
+		dependencies: dependencies,
+		capabilities: capabilities,
+		realizations: realizations,
+		grades:       grades,
+	}
+}
+
+func (q *DependencyAnalysisQuery) Impact(ctx context.Context, capabilityID string, direction services.ImpactDirection, maxDepth int, types []valueobjects.DependencyType) (*DependencyImpactResult, error) {
+	capabilities, err := q.capabilityIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := capabilities[capabilityID]; !ok {
+		return nil, ErrCapabilityNotFound
+	}
+
+	graph, err := q.graph(ctx, types)
+	if err != nil {
+		return nil, err
+	}
+
+	impacted := graph.Impact(capabilityID, direction, maxDepth)
+	views := make([]ImpactedCapabilityView, len(impacted))
+	for i, item := range impacted {
+		c := capabilities[item.CapabilityID]
+		views[i] = ImpactedCapabilityView{
+			CapabilityID:   item.CapabilityID,
+			CapabilityName: c.Name,
+			Level:          c.Level,
+			Depth:          item.Depth,
+			ViaID:          item.ViaID,
+			DependencyType: item.DependencyType.Value(),
+		}
+	}
+	return &DependencyImpactResult{CapabilityID: capabilityID, Direction: direction, MaxDepth: maxDepth, Impacted: views}, nil
+}
+
+func (q *DependencyAnalysisQuery) Criticality(ctx context.Context, types []valueobjects.DependencyType) ([]CapabilityCriticalityView, error) {
+	capabilities, err := q.capabilityIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	graph, err := q.graph(ctx, types)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := graph.Criticality()
+	views := make([]CapabilityCriticalityView, len(scores))
+	for i, s := range scores {
+		views[i] = CapabilityCriticalityView{DependencyCriticality: s, CapabilityName: capabilities[s.CapabilityID].Name}
+	}
+	return views, nil
+}
+
+// FragileDependencies lists capabilities required by at least minRequiredBy others
+// whose realization is a single point of failure: none at all, a single application,
+// or only applications graded Eliminate.
+func (q *DependencyAnalysisQuery) FragileDependencies(ctx context.Context, minRequiredBy int) ([]FragileDependencyView, error) {
+	critical, err := q.Criticality(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []CapabilityCriticalityView
+	var ids []string
+	for _, c := range critical {
+		if c.RequiredBy >= minRequiredBy {
+			candidates = append(candidates, c)
+			ids = append(ids, c.CapabilityID)
+		}
+	}
+	if len(candidates) == 0 {
+		return []FragileDependencyView{}, nil
+	}
+
+	components, err := q.componentsByCapability(ctx)
+	if err != nil {
+		return nil, err
+	}
+	eliminate, err := q.eliminateGraded(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	fragile := []FragileDependencyView{}
+	for _, c := range candidates {
+		componentIDs := components[c.CapabilityID]
+		reasons := fragilityReasons(componentIDs, eliminate[c.CapabilityID])
+		if len(reasons) > 0 {
+			fragile = append(fragile, FragileDependencyView{CapabilityCriticalityView: c, ComponentIDs: componentIDs, Reasons: reasons})
+		}
+	}
+	return fragile, nil
+}
+
+func fragilityReasons(componentIDs []string, eliminate map[string]bool) []string {
+	if len(componentIDs) == 0 {
+		return []string{FragilityUnrealized}
+	}
+	var reasons []string
+	if len(componentIDs) == 1 {
+		reasons = append(reasons, FragilitySingleApplication)
+	}
+	allEliminate := true
+	for _, id := range componentIDs {
+		if !eliminate[id] {
+			allEliminate = false
+			break
+		}
+	}
+	if allEliminate {
+		reasons = append(reasons, FragilityAllEliminate)
+	}
+	return reasons
+}
+
+func (q *DependencyAnalysisQuery) eliminateGraded(ctx context.Context, capabilityIDs []string) (map[string]map[string]bool, error) {
+	if q.grades == nil {
+		return map[string]map[string]bool{}, nil
+	}
+	return q.grades.EliminateGraded(ctx, capabilityIDs)
+}
+
+func (q *DependencyAnalysisQuery) componentsByCapability(ctx context.Context) (map[string][]string, error) {
+	realizations, err := q.realizations.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	components := make(map[string][]string)
+	for _, r := range realizations {
+		key := r.CapabilityID + "/" + r.ComponentID
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		components[r.CapabilityID] = append(components[r.CapabilityID], r.ComponentID)
+	}
+	for _, ids := range components {
+		sort.Strings(ids)
+	}
+	return components, nil
+}
+
+func (q *DependencyAnalysisQuery) capabilityIndex(ctx context.Context) (map[string]readmodels.CapabilityDTO, error) {
+	all, err := q.capabilities.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]readmodels.CapabilityDTO, len(all))
+	for _, c := range all {
+		index[c.ID] = c
+	}
+	return index, nil
+}
+
+func (q *DependencyAnalysisQuery) graph(ctx context.Context, types []valueobjects.DependencyType) (*services.DependencyGraph, error) {
+	dependencies, err := q.dependencies.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]services.DependencyEdge, len(dependencies))
+	for i, d := range dependencies {
+		edges[i] = services.DependencyEdge{
+			SourceID: d.SourceCapabilityID,
+			TargetID: d.TargetCapabilityID,
+			Type:     valueobjects.DependencyType(d.DependencyType),
+		}
+	}
+	return services.NewDependencyGraph(edges, types), nil
+}
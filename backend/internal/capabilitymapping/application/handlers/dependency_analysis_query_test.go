@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubDependencyGraphReader []readmodels.DependencyDTO
+
+func (s stubDependencyGraphReader) GetAll(context.Context) ([]readmodels.DependencyDTO, error) {
+	return s, nil
+}
+
+type stubDependencyCapabilityReader []readmodels.CapabilityDTO
+
+func (s stubDependencyCapabilityReader) GetAll(context.Context) ([]readmodels.CapabilityDTO, error) {
+	return s, nil
+}
+
+type stubDependencyRealizationReader []readmodels.RealizationDTO
+
+func (s stubDependencyRealizationReader) GetAll(context.Context) ([]readmodels.RealizationDTO, error) {
+	return s, nil
+}
+
+type stubEliminateGradeSource map[string]map[string]bool
+
+func (s stubEliminateGradeSource) EliminateGraded(context.Context, []string) (map[string]map[string]bool, error) {
+	return s, nil
+}
+
+func newTestDependencyAnalysisQuery(realizations stubDependencyRealizationReader, grades EliminateGradeSource) *DependencyAnalysisQuery {
+	return NewDependencyAnalysisQuery(
+		stubDependencyGraphReader{
+			{SourceCapabilityID: "billing", TargetCapabilityID: "payments", DependencyType: "Requires"},
+			{SourceCapabilityID: "shipping", TargetCapabilityID: "payments", DependencyType: "Requires"},
+			{SourceCapabilityID: "returns", TargetCapabilityID: "payments", DependencyType: "Enables"},
+			{SourceCapabilityID: "billing", TargetCapabilityID: "ledger", DependencyType: "Requires"},
+			{SourceCapabilityID: "shipping", TargetCapabilityID: "ledger", DependencyType: "Requires"},
+		},
+		stubDependencyCapabilityReader{
+			{ID: "billing", Name: "Billing", Level: "L2"},
+			{ID: "shipping", Name: "Shipping", Level: "L2"},
+			{ID: "returns", Name: "Returns", Level: "L2"},
+			{ID: "payments", Name: "Payments", Level: "L2"},
+			{ID: "ledger", Name: "Ledger", Level: "L2"},
+		},
+		realizations,
+		grades,
+	)
+}
+
+func TestDependencyAnalysisQuery_DownstreamImpactWithTypeFilter(t *testing.T) {
+	query := newTestDependencyAnalysisQuery(nil, nil)
+
+	result, err := query.Impact(context.Background(), "payments", services.ImpactDownstream, 0, []valueobjects.DependencyType{valueobjects.DependencyRequires})
+	require.NoError(t, err)
+
+	require.Len(t, result.Impacted, 2)
+	assert.Equal(t, "Billing", result.Impacted[0].CapabilityName)
+	assert.Equal(t, "Requires", result.Impacted[0].DependencyType)
+}
+
+func TestDependencyAnalysisQuery_ImpactUnknownCapability(t *testing.T) {
+	query := newTestDependencyAnalysisQuery(nil, nil)
+
+	_, err := query.Impact(context.Background(), "unknown", services.ImpactUpstream, 0, nil)
+
+	assert.ErrorIs(t, err, ErrCapabilityNotFound)
+}
+
+func TestDependencyAnalysisQuery_CriticalityIncludesNames(t *testing.T) {
+	query := newTestDependencyAnalysisQuery(nil, nil)
+
+	result, err := query.Criticality(context.Background(), nil)
+	require.NoError(t, err)
+
+	require.Len(t, result, 2)
+	assert.Equal(t, "Payments", result[0].CapabilityName)
+	assert.Equal(t, 3, result[0].DirectDependents)
+	assert.Equal(t, 2, result[0].RequiredBy)
+}
+
+func TestDependencyAnalysisQuery_FragileDependencies(t *testing.T) {
+	realizations := stubDependencyRealizationReader{
+		{CapabilityID: "payments", ComponentID: "legacy-pay"},
+		{CapabilityID: "payments", ComponentID: "old-pay"},
+		{CapabilityID: "ledger", ComponentID: "gl"},
+	}
+	grades := stubEliminateGradeSource{"payments": {"legacy-pay": true, "old-pay": true}}
+	query := newTestDependencyAnalysisQuery(realizations, grades)
+
+	result, err := query.FragileDependencies(context.Background(), 2)
+	require.NoError(t, err)
+
+	require.Len(t, result, 2)
+	byID := map[string]FragileDependencyView{}
+	for _, f := range result {
+		byID[f.CapabilityID] = f
+	}
+	assert.Equal(t, []string{FragilityAllEliminate}, byID["payments"].Reasons)
+	assert.Equal(t, []string{FragilitySingleApplication}, byID["ledger"].Reasons)
+}
+
+func TestDependencyAnalysisQuery_FragileDependenciesSkipsHealthy(t *testing.T) {
+	realizations := stubDependencyRealizationReader{
+		{CapabilityID: "payments", ComponentID: "pay-a"},
+		{CapabilityID: "payments", ComponentID: "pay-b"},
+	}
+	query := newTestDependencyAnalysisQuery(realizations, nil)
+
+	result, err := query.FragileDependencies(context.Background(), 2)
+	require.NoError(t, err)
+
+	require.Len(t, result, 1)
+	assert.Equal(t, "ledger", result[0].CapabilityID)
+	assert.Equal(t, []string{FragilityUnrealized}, result[0].Reasons)
+}
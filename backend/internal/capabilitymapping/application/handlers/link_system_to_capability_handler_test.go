@@ -97,7 +97,7 @@ func createTestCapabilityForLink(t *testing.T, level string, parentID string) *a
 		require.NoError(t, err)
 	}
 
-	capability, err := aggregates.NewCapability(name, description, parent, capLevel)
+	capability, err := aggregates.NewCapability(name, description, parent, capLevel, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 	capability.MarkChangesAsCommitted()
 
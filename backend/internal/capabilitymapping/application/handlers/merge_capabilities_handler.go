@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/shared/cqrs"
+)
+
+var ErrNothingToMerge = errors.New("at least one capability to merge is required")
+
+type MergeCapabilitiesRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.Capability, error)
+	Save(ctx context.Context, capability *aggregates.Capability) error
+}
+
+// MergeCapabilitiesHandler folds one or more capabilities into a survivor. The
+// survivor takes over their experts and tags, children, realizations, dependencies,
+// domain assignments and strategy importance. The merge is only recorded and the
+// merged capabilities deleted once all of their content has moved; a failed move
+// leaves them in place. Realizations move rather than being recreated, so their
+// TIME assessments and other history follow them.
+type MergeCapabilitiesHandler struct {
+	repository MergeCapabilitiesRepository
+	commandBus cqrs.CommandBus
+	mover      *capabilityContentMover
+}
+
+func NewMergeCapabilitiesHandler(repository MergeCapabilitiesRepository, commandBus cqrs.CommandBus, readers CapabilityContentReaders) *MergeCapabilitiesHandler {
+	return &MergeCapabilitiesHandler{
+		repository: repository,
+		commandBus: commandBus,
+		mover:      &capabilityContentMover{commandBus: commandBus, readers: readers},
+	}
+}
+
+func (h *MergeCapabilitiesHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.MergeCapabilities)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	mergedIDs := uniqueIDs(command.MergedIDs)
+	if len(mergedIDs) == 0 {
+		return cqrs.EmptyResult(), ErrNothingToMerge
+	}
+
+	survivor, merged, err := h.absorb(ctx, command, mergedIDs)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	discard := make(map[string]bool, len(command.Discard))
+	for _, id := range command.Discard {
+		discard[id] = true
+	}
+	toSurvivor := func(itemID string) (string, bool) {
+		return survivor.ID(), !discard[itemID]
+	}
+
+	for _, capability := range merged {
+		content, err := h.mover.load(ctx, capability.ID())
+		if err != nil {
+			return cqrs.EmptyResult(), err
+		}
+		if err := h.mover.redistribute(ctx, capability.ID(), content, toSurvivor); err != nil {
+			return cqrs.EmptyResult(), err
+		}
+	}
+
+	if err := h.save(ctx, survivor, merged); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	for _, capability := range merged {
+		if _, err := h.commandBus.Dispatch(ctx, &commands.DeleteCapability{ID: capability.ID()}); err != nil {
+			return cqrs.EmptyResult(), err
+		}
+	}
+
+	return cqrs.NewResult(survivor.ID()), nil
+}
+
+func (h *MergeCapabilitiesHandler) absorb(ctx context.Context, command *commands.MergeCapabilities, mergedIDs []string) (*aggregates.Capability, []*aggregates.Capability, error) {
+	survivor, err := h.repository.GetByID(ctx, command.SurvivorID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	merged := make([]*aggregates.Capability, 0, len(mergedIDs))
+	for _, id := range mergedIDs {
+		capability, err := h.repository.GetByID(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := survivor.AbsorbMerged(capability, command.MergedBy); err != nil {
+			return nil, nil, err
+		}
+		merged = append(merged, capability)
+	}
+
+	return survivor, merged, nil
+}
+
+func (h *MergeCapabilitiesHandler) save(ctx context.Context, survivor *aggregates.Capability, merged []*aggregates.Capability) error {
+	if err := h.repository.Save(ctx, survivor); err != nil {
+		return err
+	}
+	for _, capability := range merged {
+		if err := h.repository.Save(ctx, capability); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockContentChildren struct {
+	children map[string][]readmodels.CapabilityDTO
+}
+
+func (m *mockContentChildren) GetChildren(ctx context.Context, parentID string) ([]readmodels.CapabilityDTO, error) {
+	return m.children[parentID], nil
+}
+
+type mockContentRealizations struct {
+	byCapability map[string][]readmodels.RealizationDTO
+	realizes     map[string]bool
+}
+
+func (m *mockContentRealizations) GetByCapabilityID(ctx context.Context, capabilityID string) ([]readmodels.RealizationDTO, error) {
+	return m.byCapability[capabilityID], nil
+}
+
+func (m *mockContentRealizations) GetDirectByCapabilityAndComponent(ctx context.Context, capabilityID, componentID string) (string, bool, error) {
+	return "", m.realizes[capabilityID+"/"+componentID], nil
+}
+
+type mockContentAssignments struct {
+	byCapability map[string][]readmodels.AssignmentDTO
+	assigned     map[string]bool
+}
+
+func (m *mockContentAssignments) GetByCapabilityID(ctx context.Context, capabilityID string) ([]readmodels.AssignmentDTO, error) {
+	return m.byCapability[capabilityID], nil
+}
+
+func (m *mockContentAssignments) AssignmentExists(ctx context.Context, domainID, capabilityID string) (bool, error) {
+	return m.assigned[domainID+"/"+capabilityID], nil
+}
+
+type mockContentImportance struct {
+	byCapability map[string][]readmodels.StrategyImportanceDTO
+	rated        map[string]bool
+}
+
+func (m *mockContentImportance) GetByCapability(ctx context.Context, capabilityID string) ([]readmodels.StrategyImportanceDTO, error) {
+	return m.byCapability[capabilityID], nil
+}
+
+func (m *mockContentImportance) Exists(ctx context.Context, domainID, capabilityID, pillarID string) (bool, error) {
+	return m.rated[domainID+"/"+capabilityID+"/"+pillarID], nil
+}
+
+type contentFixture struct {
+	children     *mockContentChildren
+	realizations *mockContentRealizations
+	dependencies *mockCascadeDependencyRM
+	assignments  *mockContentAssignments
+	importance   *mockContentImportance
+}
+
+func newContentFixture() *contentFixture {
+	return &contentFixture{
+		children:     &mockContentChildren{children: map[string][]readmodels.CapabilityDTO{}},
+		realizations: &mockContentRealizations{byCapability: map[string][]readmodels.RealizationDTO{}, realizes: map[string]bool{}},
+		dependencies: &mockCascadeDependencyRM{outgoing: map[string][]readmodels.DependencyDTO{}, incoming: map[string][]readmodels.DependencyDTO{}},
+		assignments:  &mockContentAssignments{byCapability: map[string][]readmodels.AssignmentDTO{}, assigned: map[string]bool{}},
+		importance:   &mockContentImportance{byCapability: map[string][]readmodels.StrategyImportanceDTO{}, rated: map[string]bool{}},
+	}
+}
+
+func (f *contentFixture) readers() CapabilityContentReaders {
+	return CapabilityContentReaders{
+		Children:     f.children,
+		Realizations: f.realizations,
+		Dependencies: f.dependencies,
+		Assignments:  f.assignments,
+		Importance:   f.importance,
+	}
+}
+
+func TestMergeCapabilitiesHandler_MovesContentOntoSurvivor(t *testing.T) {
+	repo := newMockCascadeRepo()
+	survivor := cascadeCapabilityWithParent(t, "L1", "")
+	merged := cascadeCapabilityWithParent(t, "L1", "")
+	repo.capabilities[survivor.ID()] = survivor
+	repo.capabilities[merged.ID()] = merged
+	s, m := survivor.ID(), merged.ID()
+
+	content := newContentFixture()
+	content.children.children[m] = []readmodels.CapabilityDTO{{ID: "child-1"}}
+	content.realizations.byCapability[m] = []readmodels.RealizationDTO{
+		{ID: "real-1", ComponentID: "comp-1", Origin: "Direct"},
+		{ID: "real-2", ComponentID: "comp-2", Origin: "Direct"},
+		{ID: "real-3", ComponentID: "comp-3", Origin: "Inherited"},
+	}
+	content.realizations.realizes[s+"/comp-2"] = true
+	content.dependencies.outgoing[m] = []readmodels.DependencyDTO{{ID: "dep-1", SourceCapabilityID: m, TargetCapabilityID: "cap-x", DependencyType: "Requires"}}
+	content.dependencies.incoming[m] = []readmodels.DependencyDTO{{ID: "dep-2", SourceCapabilityID: s, TargetCapabilityID: m, DependencyType: "Requires"}}
+	content.importance.byCapability[m] = []readmodels.StrategyImportanceDTO{{ID: "imp-1", BusinessDomainID: "dom-1", PillarID: "pillar-1", Importance: 4}}
+	content.importance.rated["dom-1/"+s+"/pillar-1"] = true
+	content.assignments.byCapability[m] = []readmodels.AssignmentDTO{{AssignmentID: "asg-1", BusinessDomainID: "dom-1"}}
+
+	commandBus := &mockCommandBus{}
+	handler := NewMergeCapabilitiesHandler(repo, commandBus, content.readers())
+
+	result, err := handler.Handle(context.Background(), &commands.MergeCapabilities{SurvivorID: s, MergedIDs: []string{m}, MergedBy: "jane@example.com"})
+
+	require.NoError(t, err)
+	assert.Equal(t, s, result.CreatedID)
+	assert.Equal(t, []cqrs.Command{
+		&commands.ChangeCapabilityParent{CapabilityID: "child-1", NewParentID: s},
+		&commands.MoveSystemRealization{ID: "real-1", CapabilityID: s},
+		&commands.DeleteSystemRealization{ID: "real-2"},
+		&commands.CreateCapabilityDependency{SourceCapabilityID: s, TargetCapabilityID: "cap-x", DependencyType: "Requires"},
+		&commands.DeleteCapabilityDependency{ID: "dep-1"},
+		&commands.DeleteCapabilityDependency{ID: "dep-2"},
+		&commands.RemoveStrategyImportance{ImportanceID: "imp-1"},
+		&commands.AssignCapabilityToDomain{BusinessDomainID: "dom-1", CapabilityID: s},
+		&commands.UnassignCapabilityFromDomain{AssignmentID: "asg-1"},
+		&commands.DeleteCapability{ID: m},
+	}, commandBus.dispatchedCommands)
+	assert.ElementsMatch(t, []*aggregates.Capability{survivor, merged}, repo.saved)
+}
+
+func TestMergeCapabilitiesHandler_DiscardedItemsAreRemoved(t *testing.T) {
+	repo := newMockCascadeRepo()
+	survivor := cascadeCapabilityWithParent(t, "L1", "")
+	merged := cascadeCapabilityWithParent(t, "L1", "")
+	repo.capabilities[survivor.ID()] = survivor
+	repo.capabilities[merged.ID()] = merged
+
+	content := newContentFixture()
+	content.realizations.byCapability[merged.ID()] = []readmodels.RealizationDTO{{ID: "real-1", ComponentID: "comp-1", Origin: "Direct"}}
+	commandBus := &mockCommandBus{}
+	handler := NewMergeCapabilitiesHandler(repo, commandBus, content.readers())
+
+	_, err := handler.Handle(context.Background(), &commands.MergeCapabilities{
+		SurvivorID: survivor.ID(),
+		MergedIDs:  []string{merged.ID()},
+		Discard:    []string{"real-1"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []cqrs.Command{
+		&commands.DeleteSystemRealization{ID: "real-1"},
+		&commands.DeleteCapability{ID: merged.ID()},
+	}, commandBus.dispatchedCommands)
+}
+
+func TestMergeCapabilitiesHandler_RejectedMergeChangesNothing(t *testing.T) {
+	repo := newMockCascadeRepo()
+	survivor := cascadeCapabilityWithParent(t, "L1", "")
+	other := cascadeCapabilityWithParent(t, "L2", survivor.ID())
+	repo.capabilities[survivor.ID()] = survivor
+	repo.capabilities[other.ID()] = other
+	commandBus := &mockCommandBus{}
+	handler := NewMergeCapabilitiesHandler(repo, commandBus, newContentFixture().readers())
+
+	_, err := handler.Handle(context.Background(), &commands.MergeCapabilities{SurvivorID: survivor.ID(), MergedIDs: []string{other.ID()}})
+	assert.ErrorIs(t, err, aggregates.ErrMergeRequiresSameLevel)
+
+	_, err = handler.Handle(context.Background(), &commands.MergeCapabilities{SurvivorID: survivor.ID()})
+	assert.ErrorIs(t, err, ErrNothingToMerge)
+
+	assert.Empty(t, repo.saved)
+	assert.Empty(t, commandBus.dispatchedCommands)
+}
+
+// failingOnCommandBus records dispatched commands and fails the first one named failOn.
+type failingOnCommandBus struct {
+	mockCommandBus
+	failOn string
+}
+
+func (m *failingOnCommandBus) Dispatch(ctx context.Context, command cqrs.Command) (cqrs.CommandResult, error) {
+	if command.CommandName() == m.failOn {
+		return cqrs.EmptyResult(), errors.New("dispatch failed")
+	}
+	return m.mockCommandBus.Dispatch(ctx, command)
+}
+
+func TestMergeCapabilitiesHandler_FailedMoveKeepsMergedCapability(t *testing.T) {
+	repo := newMockCascadeRepo()
+	survivor := cascadeCapabilityWithParent(t, "L1", "")
+	merged := cascadeCapabilityWithParent(t, "L1", "")
+	repo.capabilities[survivor.ID()] = survivor
+	repo.capabilities[merged.ID()] = merged
+
+	content := newContentFixture()
+	content.realizations.byCapability[merged.ID()] = []readmodels.RealizationDTO{{ID: "real-1", ComponentID: "comp-1", Origin: "Direct"}}
+	content.assignments.byCapability[merged.ID()] = []readmodels.AssignmentDTO{{AssignmentID: "asg-1", BusinessDomainID: "dom-1"}}
+	commandBus := &failingOnCommandBus{failOn: "MoveSystemRealization"}
+	handler := NewMergeCapabilitiesHandler(repo, commandBus, content.readers())
+
+	_, err := handler.Handle(context.Background(), &commands.MergeCapabilities{
+		SurvivorID: survivor.ID(), MergedIDs: []string{merged.ID()}, MergedBy: "jane@example.com",
+	})
+
+	require.Error(t, err)
+	assert.Empty(t, commandBus.dispatchedCommands, "nothing after the failed move is dispatched")
+	assert.Empty(t, repo.saved, "the merge is not recorded")
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/domain/events"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type MoveSystemRealizationReadModel interface {
+	GetByID(ctx context.Context, id string) (*readmodels.RealizationDTO, error)
+}
+
+// MoveSystemRealizationHandler moves a realization to another capability and
+// propagates it to the new capability's ancestors. The realization keeps its identity,
+// so anything keyed by it (e.g. TIME assessments) follows the move.
+type MoveSystemRealizationHandler struct {
+	realizationRepository UpdateSystemRealizationRepository
+	realizationReadModel  MoveSystemRealizationReadModel
+	capabilityRepository  LinkSystemCapabilityRepository
+	capabilityReadModel   LinkSystemCapabilityReadModel
+}
+
+func NewMoveSystemRealizationHandler(
+	realizationRepository UpdateSystemRealizationRepository,
+	realizationReadModel MoveSystemRealizationReadModel,
+	capabilityRepository LinkSystemCapabilityRepository,
+	capabilityReadModel LinkSystemCapabilityReadModel,
+) *MoveSystemRealizationHandler {
+	return &MoveSystemRealizationHandler{
+		realizationRepository: realizationRepository,
+		realizationReadModel:  realizationReadModel,
+		capabilityRepository:  capabilityRepository,
+		capabilityReadModel:   capabilityReadModel,
+	}
+}
+
+func (h *MoveSystemRealizationHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.MoveSystemRealization)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	capabilityID, err := valueobjects.NewCapabilityIDFromString(command.CapabilityID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	capability, err := h.capabilityRepository.GetByID(ctx, command.CapabilityID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrCapabilityNotFound) {
+			return cqrs.EmptyResult(), ErrCapabilityNotFoundForRealization
+		}
+		return cqrs.EmptyResult(), err
+	}
+
+	realization, err := h.realizationRepository.GetByID(ctx, command.ID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if realization.CapabilityID().Value() == command.CapabilityID {
+		return cqrs.EmptyResult(), nil
+	}
+
+	componentName := ""
+	if dto, err := h.realizationReadModel.GetByID(ctx, command.ID); err == nil && dto != nil {
+		componentName = dto.ComponentName
+	}
+
+	if err := realization.MoveToCapability(capabilityID); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.realizationRepository.Save(ctx, realization); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), h.propagateInheritance(ctx, capability, realization, componentName)
+}
+
+func (h *MoveSystemRealizationHandler) propagateInheritance(ctx context.Context, capability *aggregates.Capability, realization *aggregates.CapabilityRealization, componentName string) error {
+	ancestorIDs, err := CollectAncestorIDs(ctx, h.capabilityReadModel, capability.ParentID().Value())
+	if err != nil || len(ancestorIDs) == 0 {
+		return err
+	}
+
+	additions := make([]events.InheritedRealization, 0, len(ancestorIDs))
+	for _, ancestorID := range ancestorIDs {
+		additions = append(additions, events.InheritedRealization{
+			CapabilityID:         ancestorID,
+			ComponentID:          realization.ComponentID().Value(),
+			ComponentName:        componentName,
+			RealizationLevel:     "Full",
+			Origin:               "Inherited",
+			SourceRealizationID:  realization.ID(),
+			SourceCapabilityID:   capability.ID(),
+			SourceCapabilityName: capability.Name().Value(),
+			LinkedAt:             realization.LinkedAt(),
+		})
+	}
+
+	capability.RaiseEvent(events.NewCapabilityRealizationsInherited(capability.ID(), additions))
+	return h.capabilityRepository.Save(ctx, capability)
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/shared/cqrs"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type MergedComponentRealizationReader interface {
+	GetByComponentID(ctx context.Context, componentID string) ([]readmodels.RealizationDTO, error)
+	GetDirectByCapabilityAndComponent(ctx context.Context, capabilityID, componentID string) (string, bool, error)
+}
+
+type MergedComponentFitScoreReader interface {
+	GetByComponentID(ctx context.Context, componentID string) ([]readmodels.ApplicationFitScoreDTO, error)
+	Exists(ctx context.Context, componentID, pillarID string) (bool, error)
+}
+
+type applicationComponentMergedEvent struct {
+	ID           string `json:"id"`
+	SurvivorID   string `json:"survivorId"`
+	SurvivorName string `json:"survivorName"`
+	MergedBy     string `json:"mergedBy"`
+}
+
+// OnApplicationComponentMergedHandler moves a merged duplicate's realizations and fit
+// scores onto the surviving component. Realizations are reassigned rather than
+// recreated so that anything keyed by the realization (e.g. TIME assessments) follows.
+// Where the survivor already realizes a capability or scores a pillar, its own entry wins.
+type OnApplicationComponentMergedHandler struct {
+	commandBus   cqrs.CommandBus
+	realizations MergedComponentRealizationReader
+	fitScores    MergedComponentFitScoreReader
+}
+
+func NewOnApplicationComponentMergedHandler(
+	commandBus cqrs.CommandBus,
+	realizations MergedComponentRealizationReader,
+	fitScores MergedComponentFitScoreReader,
+) *OnApplicationComponentMergedHandler {
+	return &OnApplicationComponentMergedHandler{
+		commandBus:   commandBus,
+		realizations: realizations,
+		fitScores:    fitScores,
+	}
+}
+
+func (h *OnApplicationComponentMergedHandler) Handle(ctx context.Context, event domain.DomainEvent) error {
+	data, err := json.Marshal(event.EventData())
+	if err != nil {
+		return fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+	}
+	var merged applicationComponentMergedEvent
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return fmt.Errorf("unmarshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+	}
+
+	log.Printf("Handling ApplicationComponentMergedInto: moving component %s onto %s", merged.ID, merged.SurvivorID)
+
+	if err := h.moveRealizations(ctx, merged); err != nil {
+		return err
+	}
+	return h.moveFitScores(ctx, merged)
+}
+
+func (h *OnApplicationComponentMergedHandler) moveRealizations(ctx context.Context, merged applicationComponentMergedEvent) error {
+	realizations, err := h.realizations.GetByComponentID(ctx, merged.ID)
+	if err != nil {
+		log.Printf("Error querying realizations for merged component %s: %v", merged.ID, err)
+		return err
+	}
+
+	for _, realization := range realizations {
+		if realization.Origin != "Direct" {
+			continue
+		}
+		_, survivorRealizes, err := h.realizations.GetDirectByCapabilityAndComponent(ctx, realization.CapabilityID, merged.SurvivorID)
+		if err != nil {
+			log.Printf("Error checking survivor %s realization of capability %s: %v", merged.SurvivorID, realization.CapabilityID, err)
+			continue
+		}
+
+		var cmd cqrs.Command = &commands.ReassignSystemRealization{
+			ID:            realization.ID,
+			ComponentID:   merged.SurvivorID,
+			ComponentName: merged.SurvivorName,
+		}
+		if survivorRealizes {
+			cmd = &commands.DeleteSystemRealization{ID: realization.ID}
+		}
+		if _, err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+			log.Printf("Error moving realization %s of merged component %s: %v", realization.ID, merged.ID, err)
+		}
+	}
+	return nil
+}
+
+func (h *OnApplicationComponentMergedHandler) moveFitScores(ctx context.Context, merged applicationComponentMergedEvent) error {
+	scores, err := h.fitScores.GetByComponentID(ctx, merged.ID)
+	if err != nil {
+		log.Printf("Error querying fit scores for merged component %s: %v", merged.ID, err)
+		return err
+	}
+
+	for _, score := range scores {
+		h.moveFitScore(ctx, merged, score)
+	}
+	return nil
+}
+
+func (h *OnApplicationComponentMergedHandler) moveFitScore(ctx context.Context, merged applicationComponentMergedEvent, score readmodels.ApplicationFitScoreDTO) {
+	survivorScored, err := h.fitScores.Exists(ctx, merged.SurvivorID, score.PillarID)
+	if err != nil {
+		log.Printf("Error checking survivor %s fit score for pillar %s: %v", merged.SurvivorID, score.PillarID, err)
+		return
+	}
+
+	if !survivorScored {
+		if _, err := h.commandBus.Dispatch(ctx, &commands.SetApplicationFitScore{
+			ComponentID: merged.SurvivorID,
+			PillarID:    score.PillarID,
+			Score:       score.Score,
+			Rationale:   score.Rationale,
+			ScoredBy:    score.ScoredBy,
+		}); err != nil {
+			log.Printf("Error moving fit score %s of merged component %s: %v", score.ID, merged.ID, err)
+			return
+		}
+	}
+
+	if _, err := h.commandBus.Dispatch(ctx, &commands.RemoveApplicationFitScore{
+		FitScoreID: score.ID,
+		RemovedBy:  merged.MergedBy,
+	}); err != nil {
+		log.Printf("Error removing fit score %s of merged component %s: %v", score.ID, merged.ID, err)
+	}
+}
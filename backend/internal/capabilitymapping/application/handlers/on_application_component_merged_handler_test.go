@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMergedRealizationReader struct {
+	realizations      []readmodels.RealizationDTO
+	survivorRealizing map[string]bool
+}
+
+func (m *mockMergedRealizationReader) GetByComponentID(ctx context.Context, componentID string) ([]readmodels.RealizationDTO, error) {
+	return m.realizations, nil
+}
+
+func (m *mockMergedRealizationReader) GetDirectByCapabilityAndComponent(ctx context.Context, capabilityID, componentID string) (string, bool, error) {
+	return "", m.survivorRealizing[capabilityID], nil
+}
+
+type mockMergedFitScoreReader struct {
+	scores         []readmodels.ApplicationFitScoreDTO
+	survivorScored map[string]bool
+}
+
+func (m *mockMergedFitScoreReader) GetByComponentID(ctx context.Context, componentID string) ([]readmodels.ApplicationFitScoreDTO, error) {
+	return m.scores, nil
+}
+
+func (m *mockMergedFitScoreReader) Exists(ctx context.Context, componentID, pillarID string) (bool, error) {
+	return m.survivorScored[pillarID], nil
+}
+
+func mergedEvent() mockEvent {
+	return mockEvent{
+		aggregateID: "comp-dup",
+		eventType:   "ApplicationComponentMergedInto",
+		eventData: map[string]interface{}{
+			"id":           "comp-dup",
+			"survivorId":   "comp-survivor",
+			"survivorName": "Salesforce",
+			"mergedBy":     "merger@example.com",
+		},
+	}
+}
+
+func TestOnApplicationComponentMergedHandler_MovesRealizations(t *testing.T) {
+	commandBus := &mockCommandBus{}
+	realizations := &mockMergedRealizationReader{
+		realizations: []readmodels.RealizationDTO{
+			{ID: "real-1", CapabilityID: "cap-1", Origin: "Direct"},
+			{ID: "real-2", CapabilityID: "cap-2", Origin: "Direct"},
+			{ID: "real-3", CapabilityID: "cap-parent", Origin: "Inherited"},
+		},
+		survivorRealizing: map[string]bool{"cap-2": true},
+	}
+	handler := NewOnApplicationComponentMergedHandler(commandBus, realizations, &mockMergedFitScoreReader{})
+
+	require.NoError(t, handler.Handle(context.Background(), mergedEvent()))
+
+	assert.Equal(t, []cqrs.Command{
+		&commands.ReassignSystemRealization{ID: "real-1", ComponentID: "comp-survivor", ComponentName: "Salesforce"},
+		&commands.DeleteSystemRealization{ID: "real-2"},
+	}, commandBus.dispatchedCommands)
+}
+
+func TestOnApplicationComponentMergedHandler_MovesFitScoresTheSurvivorLacks(t *testing.T) {
+	commandBus := &mockCommandBus{}
+	fitScores := &mockMergedFitScoreReader{
+		scores: []readmodels.ApplicationFitScoreDTO{
+			{ID: "fit-1", PillarID: "pillar-a", Score: 4, Rationale: "Solid", ScoredBy: "alice@example.com"},
+			{ID: "fit-2", PillarID: "pillar-b", Score: 2},
+		},
+		survivorScored: map[string]bool{"pillar-b": true},
+	}
+	handler := NewOnApplicationComponentMergedHandler(commandBus, &mockMergedRealizationReader{}, fitScores)
+
+	require.NoError(t, handler.Handle(context.Background(), mergedEvent()))
+
+	assert.Equal(t, []cqrs.Command{
+		&commands.SetApplicationFitScore{ComponentID: "comp-survivor", PillarID: "pillar-a", Score: 4, Rationale: "Solid", ScoredBy: "alice@example.com"},
+		&commands.RemoveApplicationFitScore{FitScoreID: "fit-1", RemovedBy: "merger@example.com"},
+		&commands.RemoveApplicationFitScore{FitScoreID: "fit-2", RemovedBy: "merger@example.com"},
+	}, commandBus.dispatchedCommands)
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/shared/cqrs"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type ReferenceMappingsByModelReader interface {
+	GetByModelID(ctx context.Context, modelID string) ([]readmodels.ReferenceMappingDTO, error)
+}
+
+type ReferenceMappingsByCapabilityReader interface {
+	GetByCapabilityID(ctx context.Context, capabilityID string) ([]readmodels.ReferenceMappingDTO, error)
+}
+
+// OnReferenceModelDeletedHandler removes every mapping onto a deleted reference model.
+type OnReferenceModelDeletedHandler struct {
+	commandBus cqrs.CommandBus
+	readModel  ReferenceMappingsByModelReader
+}
+
+func NewOnReferenceModelDeletedHandler(commandBus cqrs.CommandBus, readModel ReferenceMappingsByModelReader) *OnReferenceModelDeletedHandler {
+	return &OnReferenceModelDeletedHandler{commandBus: commandBus, readModel: readModel}
+}
+
+func (h *OnReferenceModelDeletedHandler) Handle(ctx context.Context, event domain.DomainEvent) error {
+	modelID := event.AggregateID()
+
+	mappings, err := h.readModel.GetByModelID(ctx, modelID)
+	if err != nil {
+		log.Printf("Error querying reference mappings for model %s: %v", modelID, err)
+		return err
+	}
+
+	unmapAll(ctx, h.commandBus, mappings)
+	return nil
+}
+
+// OnCapabilityDeletedReferenceMappingHandler removes the reference mappings of a deleted capability.
+type OnCapabilityDeletedReferenceMappingHandler struct {
+	commandBus cqrs.CommandBus
+	readModel  ReferenceMappingsByCapabilityReader
+}
+
+func NewOnCapabilityDeletedReferenceMappingHandler(commandBus cqrs.CommandBus, readModel ReferenceMappingsByCapabilityReader) *OnCapabilityDeletedReferenceMappingHandler {
+	return &OnCapabilityDeletedReferenceMappingHandler{commandBus: commandBus, readModel: readModel}
+}
+
+func (h *OnCapabilityDeletedReferenceMappingHandler) Handle(ctx context.Context, event domain.DomainEvent) error {
+	capabilityID := event.AggregateID()
+
+	mappings, err := h.readModel.GetByCapabilityID(ctx, capabilityID)
+	if err != nil {
+		log.Printf("Error querying reference mappings for capability %s: %v", capabilityID, err)
+		return err
+	}
+
+	unmapAll(ctx, h.commandBus, mappings)
+	return nil
+}
+
+func unmapAll(ctx context.Context, commandBus cqrs.CommandBus, mappings []readmodels.ReferenceMappingDTO) {
+	for _, mapping := range mappings {
+		if _, err := commandBus.Dispatch(ctx, &commands.UnmapCapabilityFromReference{MappingID: mapping.ID}); err != nil {
+			log.Printf("Error unmapping capability %s from reference entry %s of model %s: %v",
+				mapping.CapabilityID, mapping.EntryCode, mapping.ReferenceModelID, err)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/domain/events"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type userDisabledEvent struct {
+	ID         string `json:"id"`
+	DisabledBy string `json:"disabledBy"`
+}
+
+// OnUserDisabledHandler keeps capabilities from being owned by someone who can no
+// longer sign in. Accountability passes to the admin who disabled the user, so no
+// capability silently loses its accountable owner; the user's other roles are dropped.
+type OnUserDisabledHandler struct {
+	commandBus cqrs.CommandBus
+	parties    OwnershipPartyChecker
+}
+
+func NewOnUserDisabledHandler(commandBus cqrs.CommandBus, parties OwnershipPartyChecker) *OnUserDisabledHandler {
+	return &OnUserDisabledHandler{
+		commandBus: commandBus,
+		parties:    parties,
+	}
+}
+
+func (h *OnUserDisabledHandler) Handle(ctx context.Context, event domain.DomainEvent) error {
+	data, err := json.Marshal(event.EventData())
+	if err != nil {
+		return fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+	}
+	var disabled userDisabledEvent
+	if err := json.Unmarshal(data, &disabled); err != nil {
+		return fmt.Errorf("unmarshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+	}
+
+	log.Printf("Handling UserDisabled: reassigning capability ownership of user %s", disabled.ID)
+
+	cmd := &commands.ReassignCapabilityOwners{
+		FromType:        valueobjects.PartyTypeUser.Value(),
+		FromID:          disabled.ID,
+		AccountableOnly: true,
+		Reason:          events.OwnerUnassignedUserDisabled,
+	}
+	if h.canSucceed(ctx, disabled) {
+		cmd.ToType = valueobjects.PartyTypeUser.Value()
+		cmd.ToID = disabled.DisabledBy
+	}
+
+	if _, err := h.commandBus.Dispatch(ctx, cmd); err != nil {
+		log.Printf("Error reassigning capability ownership of disabled user %s: %v", disabled.ID, err)
+		return err
+	}
+	return nil
+}
+
+func (h *OnUserDisabledHandler) canSucceed(ctx context.Context, disabled userDisabledEvent) bool {
+	if disabled.DisabledBy == "" || disabled.DisabledBy == disabled.ID {
+		return false
+	}
+	active, err := h.parties.IsActiveParty(ctx, valueobjects.PartyTypeUser.Value(), disabled.DisabledBy)
+	if err != nil {
+		log.Printf("Error checking successor %s for disabled user %s: %v", disabled.DisabledBy, disabled.ID, err)
+		return false
+	}
+	return active
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/domain/events"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubPartyChecker map[string]bool
+
+func (s stubPartyChecker) IsActiveParty(_ context.Context, partyType, partyID string) (bool, error) {
+	return s[partyType+"/"+partyID], nil
+}
+
+func userDisabledMockEvent(disabledBy string) mockEvent {
+	return mockEvent{
+		aggregateID: "user-1",
+		eventType:   "UserDisabled",
+		eventData: map[string]interface{}{
+			"id":         "user-1",
+			"disabledBy": disabledBy,
+		},
+	}
+}
+
+func TestOnUserDisabledHandler_HandsAccountabilityToDisabler(t *testing.T) {
+	commandBus := &mockCommandBus{}
+	handler := NewOnUserDisabledHandler(commandBus, stubPartyChecker{"user/admin-1": true})
+
+	require.NoError(t, handler.Handle(context.Background(), userDisabledMockEvent("admin-1")))
+
+	assert.Equal(t, []cqrs.Command{&commands.ReassignCapabilityOwners{
+		FromType:        "user",
+		FromID:          "user-1",
+		ToType:          "user",
+		ToID:            "admin-1",
+		AccountableOnly: true,
+		Reason:          events.OwnerUnassignedUserDisabled,
+	}}, commandBus.dispatchedCommands)
+}
+
+func TestOnUserDisabledHandler_DropsRolesWithoutActiveDisabler(t *testing.T) {
+	commandBus := &mockCommandBus{}
+	handler := NewOnUserDisabledHandler(commandBus, stubPartyChecker{})
+
+	require.NoError(t, handler.Handle(context.Background(), userDisabledMockEvent("admin-1")))
+
+	require.Len(t, commandBus.dispatchedCommands, 1)
+	cmd := commandBus.dispatchedCommands[0].(*commands.ReassignCapabilityOwners)
+	assert.Empty(t, cmd.ToID)
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+type CoverageGapRealizationReader interface {
+	GetAll(ctx context.Context) ([]readmodels.RealizationDTO, error)
+}
+
+type CoverageGapFitScoreReader interface {
+	ScoredComponentIDs(ctx context.Context) (map[string]bool, error)
+}
+
+type CoverageGapsRequest struct {
+	BusinessDomainID string
+	Types            []valueobjects.CoverageGapType
+}
+
+type CoverageGapEntry struct {
+	Type                 valueobjects.CoverageGapType
+	CapabilityID         string
+	CapabilityName       string
+	CapabilityLevel      string
+	ParentCapabilityID   string
+	ParentCapabilityName string
+	RealizationID        string
+	ComponentID          string
+	ComponentName        string
+}
+
+type CoverageGapsReport struct {
+	BusinessDomainID    string
+	CapabilitiesScanned int
+	Counts              map[valueobjects.CoverageGapType]int
+	Gaps                []CoverageGapEntry
+}
+
+type RealizationCoverageGapsQuery struct {
+	capabilities HeatmapCapabilityReader
+	realizations CoverageGapRealizationReader
+	fitScores    CoverageGapFitScoreReader
+	domains      BusinessDomainTreeReader
+	assignments  HeatmapDomainAssignmentReader
+}
+
+func NewRealizationCoverageGapsQuery(
+	capabilities HeatmapCapabilityReader,
+	realizations CoverageGapRealizationReader,
+	fitScores CoverageGapFitScoreReader,
+	domains BusinessDomainTreeReader,
+	assignments HeatmapDomainAssignmentReader,
+) *RealizationCoverageGapsQuery {
+	return &RealizationCoverageGapsQuery{
+		capabilities: capabilities,
+		realizations: realizations,
+		fitScores:    fitScores,
+		domains:      domains,
+		assignments:  assignments,
+	}
+}
+
+func (q *RealizationCoverageGapsQuery) Execute(ctx context.Context, req CoverageGapsRequest) (*CoverageGapsReport, error) {
+	all, err := q.capabilities.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scope := all
+	if req.BusinessDomainID != "" {
+		domainScope, err := resolveBusinessDomainTreeScope(ctx, q.domains, q.assignments, all, req.BusinessDomainID)
+		if err != nil {
+			return nil, err
+		}
+		scope = domainScope.Capabilities
+	}
+
+	realizations, err := q.realizations.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scored, err := q.fitScores.ScoredComponentIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]readmodels.CapabilityDTO, len(all))
+	for _, c := range all {
+		byID[c.ID] = c
+	}
+	byRealizationID := make(map[string]readmodels.RealizationDTO, len(realizations))
+	coverageRealizations := make([]services.CoverageRealization, len(realizations))
+	for i, r := range realizations {
+		byRealizationID[r.ID] = r
+		coverageRealizations[i] = services.CoverageRealization{
+			ID:           r.ID,
+			CapabilityID: r.CapabilityID,
+			ComponentID:  r.ComponentID,
+			Level:        valueobjects.RealizationLevel(r.RealizationLevel),
+			Direct:       r.Origin == "Direct",
+		}
+	}
+
+	coverageCapabilities := make([]services.CoverageCapability, len(scope))
+	for i, c := range scope {
+		coverageCapabilities[i] = services.CoverageCapability{ID: c.ID, ParentID: c.ParentID}
+	}
+
+	report := &CoverageGapsReport{
+		BusinessDomainID:    req.BusinessDomainID,
+		CapabilitiesScanned: len(scope),
+		Counts:              make(map[valueobjects.CoverageGapType]int),
+		Gaps:                []CoverageGapEntry{},
+	}
+	for _, gap := range services.DetectCoverageGaps(coverageCapabilities, coverageRealizations, scored) {
+		if !wantsGapType(req.Types, gap.Type) {
+			continue
+		}
+		report.Counts[gap.Type]++
+		report.Gaps = append(report.Gaps, toCoverageGapEntry(gap, byID, byRealizationID))
+	}
+
+	sort.SliceStable(report.Gaps, func(i, j int) bool {
+		if report.Gaps[i].CapabilityName != report.Gaps[j].CapabilityName {
+			return report.Gaps[i].CapabilityName < report.Gaps[j].CapabilityName
+		}
+		return report.Gaps[i].CapabilityID < report.Gaps[j].CapabilityID
+	})
+	return report, nil
+}
+
+func wantsGapType(types []valueobjects.CoverageGapType, gapType valueobjects.CoverageGapType) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if t == gapType {
+			return true
+		}
+	}
+	return false
+}
+
+func toCoverageGapEntry(gap services.CoverageGap, capabilities map[string]readmodels.CapabilityDTO, realizations map[string]readmodels.RealizationDTO) CoverageGapEntry {
+	capability := capabilities[gap.CapabilityID]
+	entry := CoverageGapEntry{
+		Type:               gap.Type,
+		CapabilityID:       gap.CapabilityID,
+		CapabilityName:     capability.Name,
+		CapabilityLevel:    capability.Level,
+		ParentCapabilityID: gap.ParentCapabilityID,
+		RealizationID:      gap.RealizationID,
+		ComponentID:        gap.ComponentID,
+	}
+	if gap.ParentCapabilityID != "" {
+		entry.ParentCapabilityName = capabilities[gap.ParentCapabilityID].Name
+	}
+	if gap.RealizationID != "" {
+		entry.ComponentName = realizations[gap.RealizationID].ComponentName
+	}
+	return entry
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubCoverageRealizations []readmodels.RealizationDTO
+
+func (s stubCoverageRealizations) GetAll(context.Context) ([]readmodels.RealizationDTO, error) {
+	return s, nil
+}
+
+type stubScoredComponents map[string]bool
+
+func (s stubScoredComponents) ScoredComponentIDs(context.Context) (map[string]bool, error) {
+	return s, nil
+}
+
+func newTestCoverageGapsQuery() *RealizationCoverageGapsQuery {
+	return NewRealizationCoverageGapsQuery(
+		&stubHeatmapCapabilities{capabilities: heatmapCapabilities()},
+		stubCoverageRealizations{
+			{ID: "r1", CapabilityID: "sales", ComponentID: "crm", ComponentName: "CRM", RealizationLevel: "Full", Origin: "Direct"},
+			{ID: "r2", CapabilityID: "orders", ComponentID: "erp", ComponentName: "ERP", RealizationLevel: "Planned", Origin: "Direct"},
+		},
+		stubScoredComponents{"crm": true},
+		&stubKPIDomains{
+			domains: map[string]*readmodels.BusinessDomainDTO{"commercial": {ID: "commercial"}},
+		},
+		&stubHeatmapAssignments{byDomain: map[string][]readmodels.AssignmentDTO{
+			"commercial": {{CapabilityID: "sales"}},
+		}},
+	)
+}
+
+func TestRealizationCoverageGapsQuery_ScopesToBusinessDomain(t *testing.T) {
+	report, err := newTestCoverageGapsQuery().Execute(context.Background(), CoverageGapsRequest{BusinessDomainID: "commercial"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, report.CapabilitiesScanned)
+	assert.Equal(t, map[valueobjects.CoverageGapType]int{
+		valueobjects.CoverageGapUnrealized:        1,
+		valueobjects.CoverageGapPlannedOnly:       1,
+		valueobjects.CoverageGapParentOnlyFull:    2,
+		valueobjects.CoverageGapUnscoredComponent: 1,
+	}, report.Counts)
+	for _, gap := range report.Gaps {
+		assert.NotEqual(t, "finance", gap.CapabilityID, "capabilities outside the domain are not reported")
+	}
+}
+
+func TestRealizationCoverageGapsQuery_FiltersByTypeAndEnrichesNames(t *testing.T) {
+	report, err := newTestCoverageGapsQuery().Execute(context.Background(), CoverageGapsRequest{
+		Types: []valueobjects.CoverageGapType{valueobjects.CoverageGapUnscoredComponent, valueobjects.CoverageGapParentOnlyFull},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, report.Gaps, 3)
+	assert.Equal(t, "Leads", report.Gaps[0].CapabilityName)
+	assert.Equal(t, "Sales", report.Gaps[0].ParentCapabilityName)
+	assert.Equal(t, valueobjects.CoverageGapUnscoredComponent, report.Gaps[2].Type)
+	assert.Equal(t, "ERP", report.Gaps[2].ComponentName)
+}
+
+func TestRealizationCoverageGapsQuery_UnknownDomain(t *testing.T) {
+	_, err := newTestCoverageGapsQuery().Execute(context.Background(), CoverageGapsRequest{BusinessDomainID: "missing"})
+	assert.ErrorIs(t, err, ErrBusinessDomainNotFound)
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type ReassignSystemRealizationHandler struct {
+	repository UpdateSystemRealizationRepository
+}
+
+func NewReassignSystemRealizationHandler(repository UpdateSystemRealizationRepository) *ReassignSystemRealizationHandler {
+	return &ReassignSystemRealizationHandler{
+		repository: repository,
+	}
+}
+
+func (h *ReassignSystemRealizationHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.ReassignSystemRealization)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	componentID, err := valueobjects.NewComponentIDFromString(command.ComponentID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	realization, err := h.repository.GetByID(ctx, command.ID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := realization.ReassignComponent(componentID, command.ComponentName); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, realization); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
+)
+
+type ReferenceModelReader interface {
+	GetByID(ctx context.Context, id string) (*readmodels.ReferenceModelDTO, error)
+	GetEntries(ctx context.Context, modelID string) ([]readmodels.ReferenceEntryDTO, error)
+}
+
+type ReferenceCoverageEntry struct {
+	readmodels.ReferenceEntryDTO
+	Status   valueobjects.ReferenceCoverageStatus
+	Mappings []readmodels.ReferenceMappingDTO
+}
+
+// ReferenceCoverageReport benchmarks our capability map against a reference
+// model. CoveragePercent counts entries mapped directly; partial entries are
+// reported separately.
+type ReferenceCoverageReport struct {
+	Model           readmodels.ReferenceModelDTO
+	TotalEntries    int
+	Counts          map[valueobjects.ReferenceCoverageStatus]int
+	CoveragePercent float64
+	Entries         []ReferenceCoverageEntry
+}
+
+type ReferenceCoverageQuery struct {
+	models   ReferenceModelReader
+	mappings ReferenceMappingsByModelReader
+}
+
+func NewReferenceCoverageQuery(models ReferenceModelReader, mappings ReferenceMappingsByModelReader) *ReferenceCoverageQuery {
+	return &ReferenceCoverageQuery{models: models, mappings: mappings}
+}
+
+// Execute assesses every entry of the model; statuses narrows the returned
+// entries without affecting the totals.
+func (q *ReferenceCoverageQuery) Execute(ctx context.Context, modelID string, statuses []valueobjects.ReferenceCoverageStatus) (*ReferenceCoverageReport, error) {
+	model, err := q.models.GetByID(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	if model == nil {
+		return nil, repositories.ErrReferenceModelNotFound
+	}
+
+	entries, err := q.models.GetEntries(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := q.mappings.GetByModelID(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+
+	mappingsByCode := make(map[string][]readmodels.ReferenceMappingDTO)
+	mappedCounts := make(map[string]int)
+	for _, m := range mappings {
+		mappingsByCode[m.EntryCode] = append(mappingsByCode[m.EntryCode], m)
+		mappedCounts[m.EntryCode]++
+	}
+
+	coverageEntries := make([]services.ReferenceCoverageEntry, len(entries))
+	for i, e := range entries {
+		coverageEntries[i] = services.ReferenceCoverageEntry{Code: e.Code, ParentCode: e.ParentCode}
+	}
+	assessed := services.AssessReferenceCoverage(coverageEntries, mappedCounts)
+
+	report := &ReferenceCoverageReport{
+		Model:        *model,
+		TotalEntries: len(entries),
+		Counts:       make(map[valueobjects.ReferenceCoverageStatus]int, len(valueobjects.ReferenceCoverageStatuses)),
+		Entries:      []ReferenceCoverageEntry{},
+	}
+	for _, e := range entries {
+		status := assessed[e.Code]
+		report.Counts[status]++
+		if !wantsCoverageStatus(statuses, status) {
+			continue
+		}
+		entryMappings := mappingsByCode[e.Code]
+		if entryMappings == nil {
+			entryMappings = []readmodels.ReferenceMappingDTO{}
+		}
+		report.Entries = append(report.Entries, ReferenceCoverageEntry{
+			ReferenceEntryDTO: e,
+			Status:            status,
+			Mappings:          entryMappings,
+		})
+	}
+	if report.TotalEntries > 0 {
+		report.CoveragePercent = float64(report.Counts[valueobjects.ReferenceCoverageMapped]) / float64(report.TotalEntries) * 100
+	}
+	return report, nil
+}
+
+func wantsCoverageStatus(statuses []valueobjects.ReferenceCoverageStatus, status valueobjects.ReferenceCoverageStatus) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
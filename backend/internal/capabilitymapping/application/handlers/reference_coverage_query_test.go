@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubReferenceModelReader struct {
+	models  map[string]readmodels.ReferenceModelDTO
+	entries map[string][]readmodels.ReferenceEntryDTO
+}
+
+func (s stubReferenceModelReader) GetByID(_ context.Context, id string) (*readmodels.ReferenceModelDTO, error) {
+	if m, ok := s.models[id]; ok {
+		return &m, nil
+	}
+	return nil, nil
+}
+
+func (s stubReferenceModelReader) GetEntries(_ context.Context, modelID string) ([]readmodels.ReferenceEntryDTO, error) {
+	return s.entries[modelID], nil
+}
+
+type stubReferenceMappingsByModel map[string][]readmodels.ReferenceMappingDTO
+
+func (s stubReferenceMappingsByModel) GetByModelID(_ context.Context, modelID string) ([]readmodels.ReferenceMappingDTO, error) {
+	return s[modelID], nil
+}
+
+func testReferenceModels() stubReferenceModelReader {
+	return stubReferenceModelReader{
+		models: map[string]readmodels.ReferenceModelDTO{
+			"bian": {ID: "bian", Name: "BIAN", Framework: "BIAN", EntryCount: 4},
+		},
+		entries: map[string][]readmodels.ReferenceEntryDTO{
+			"bian": {
+				{Code: "SALES", Name: "Sales and Service", Level: 1},
+				{Code: "SALES.PAY", Name: "Payments", ParentCode: "SALES", Level: 2},
+				{Code: "RISK", Name: "Risk and Compliance", Level: 1},
+				{Code: "OPS", Name: "Operations", Level: 1},
+			},
+		},
+	}
+}
+
+func TestReferenceCoverageQuery_ClassifiesEntries(t *testing.T) {
+	mappings := stubReferenceMappingsByModel{
+		"bian": {
+			{ID: "m1", CapabilityID: "cap-1", ReferenceModelID: "bian", EntryCode: "SALES.PAY"},
+			{ID: "m2", CapabilityID: "cap-2", ReferenceModelID: "bian", EntryCode: "RISK"},
+		},
+	}
+	query := NewReferenceCoverageQuery(testReferenceModels(), mappings)
+
+	report, err := query.Execute(context.Background(), "bian", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, report.TotalEntries)
+	assert.Equal(t, 2, report.Counts[valueobjects.ReferenceCoverageMapped])
+	assert.Equal(t, 1, report.Counts[valueobjects.ReferenceCoveragePartial])
+	assert.Equal(t, 1, report.Counts[valueobjects.ReferenceCoverageUnmapped])
+	assert.InDelta(t, 50.0, report.CoveragePercent, 0.001)
+	require.Len(t, report.Entries, 4)
+	assert.Equal(t, valueobjects.ReferenceCoveragePartial, report.Entries[0].Status)
+	assert.Len(t, report.Entries[1].Mappings, 1)
+}
+
+func TestReferenceCoverageQuery_StatusFilterKeepsTotals(t *testing.T) {
+	query := NewReferenceCoverageQuery(testReferenceModels(), stubReferenceMappingsByModel{})
+
+	report, err := query.Execute(context.Background(), "bian", []valueobjects.ReferenceCoverageStatus{valueobjects.ReferenceCoverageUnmapped})
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, report.Counts[valueobjects.ReferenceCoverageUnmapped])
+	assert.Len(t, report.Entries, 4)
+	assert.Zero(t, report.CoveragePercent)
+	assert.NotNil(t, report.Entries[0].Mappings)
+}
+
+func TestReferenceCoverageQuery_UnknownModel(t *testing.T) {
+	query := NewReferenceCoverageQuery(testReferenceModels(), stubReferenceMappingsByModel{})
+
+	_, err := query.Execute(context.Background(), "missing", nil)
+	assert.ErrorIs(t, err, repositories.ErrReferenceModelNotFound)
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/google/uuid"
+)
+
+const MaxSeedDepth = 3
+
+var (
+	ErrSeedInvalidDepth   = fmt.Errorf("seed depth must be between 0 and %d", MaxSeedDepth)
+	ErrSeedTooManyDomains = fmt.Errorf("seeding would create more than %d business domains", MaxBulkTargets)
+)
+
+const (
+	SeedStatusCreated  = "created"
+	SeedStatusExisting = "existing"
+	SeedStatusFailed   = "failed"
+	SeedStatusSkipped  = "skipped"
+)
+
+type SeedDomainLookup interface {
+	GetByID(ctx context.Context, id string) (*readmodels.BusinessDomainDTO, error)
+	GetByName(ctx context.Context, name string) (*readmodels.BusinessDomainDTO, error)
+}
+
+// SeedBusinessDomainsRequest selects the reference entries to turn into
+// business domains. Without entry codes the model's top-level entries are used;
+// Depth adds that many levels of descendants as nested sub-domains.
+type SeedBusinessDomainsRequest struct {
+	ReferenceModelID       string
+	EntryCodes             []string
+	Depth                  int
+	ParentBusinessDomainID string
+}
+
+type SeededDomain struct {
+	EntryCode        string
+	EntryName        string
+	BusinessDomainID string
+	ParentDomainID   string
+	Status           string
+	Err              error
+}
+
+type SeedBusinessDomainsResult struct {
+	CorrelationID string
+	Domains       []SeededDomain
+}
+
+func (r SeedBusinessDomainsResult) CountByStatus(status string) int {
+	count := 0
+	for _, d := range r.Domains {
+		if d.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// ReferenceDomainSeeder builds a business domain tree from a reference model by
+// dispatching regular CreateBusinessDomain commands. A domain whose name already
+// exists is reused as the parent of its seeded children rather than duplicated;
+// the descendants of an entry that fails are skipped. All events written by one
+// seeding share a correlation ID.
+type ReferenceDomainSeeder struct {
+	commandBus cqrs.CommandBus
+	models     ReferenceModelReader
+	domains    SeedDomainLookup
+}
+
+func NewReferenceDomainSeeder(commandBus cqrs.CommandBus, models ReferenceModelReader, domains SeedDomainLookup) *ReferenceDomainSeeder {
+	return &ReferenceDomainSeeder{commandBus: commandBus, models: models, domains: domains}
+}
+
+func (s *ReferenceDomainSeeder) Seed(ctx context.Context, req SeedBusinessDomainsRequest) (*SeedBusinessDomainsResult, error) {
+	if req.Depth < 0 || req.Depth > MaxSeedDepth {
+		return nil, ErrSeedInvalidDepth
+	}
+	plan, err := s.plan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	correlationID := uuid.New().String()
+	ctx = sharedctx.WithCorrelationID(ctx, correlationID)
+
+	result := &SeedBusinessDomainsResult{CorrelationID: correlationID, Domains: make([]SeededDomain, 0, len(plan))}
+	domainByCode := make(map[string]string)
+	failed := make(map[string]bool)
+	for _, step := range plan {
+		seeded := SeededDomain{EntryCode: step.entry.Code, EntryName: step.entry.Name, ParentDomainID: req.ParentBusinessDomainID}
+		if step.parentCode != "" {
+			if failed[step.parentCode] {
+				failed[step.entry.Code] = true
+				seeded.Status = SeedStatusSkipped
+				result.Domains = append(result.Domains, seeded)
+				continue
+			}
+			seeded.ParentDomainID = domainByCode[step.parentCode]
+		}
+
+		seeded.BusinessDomainID, seeded.Status, seeded.Err = s.seedOne(ctx, step.entry, seeded.ParentDomainID)
+		if seeded.Status == SeedStatusFailed {
+			failed[step.entry.Code] = true
+		} else {
+			domainByCode[step.entry.Code] = seeded.BusinessDomainID
+		}
+		result.Domains = append(result.Domains, seeded)
+	}
+	return result, nil
+}
+
+func (s *ReferenceDomainSeeder) seedOne(ctx context.Context, entry readmodels.ReferenceEntryDTO, parentID string) (string, string, error) {
+	existing, err := s.domains.GetByName(ctx, entry.Name)
+	if err != nil {
+		return "", SeedStatusFailed, err
+	}
+	if existing != nil {
+		return existing.ID, SeedStatusExisting, nil
+	}
+
+	created, err := s.commandBus.Dispatch(ctx, &commands.CreateBusinessDomain{
+		Name:        entry.Name,
+		Description: entry.Description,
+		ParentID:    parentID,
+	})
+	if err != nil {
+		return "", SeedStatusFailed, err
+	}
+	return created.CreatedID, SeedStatusCreated, nil
+}
+
+type seedStep struct {
+	entry      readmodels.ReferenceEntryDTO
+	parentCode string
+}
+
+// plan lists the entries to seed parents-first, each with the code of the
+// seeded entry it nests under (empty for the selected roots).
+func (s *ReferenceDomainSeeder) plan(ctx context.Context, req SeedBusinessDomainsRequest) ([]seedStep, error) {
+	model, err := s.models.GetByID(ctx, req.ReferenceModelID)
+	if err != nil {
+		return nil, err
+	}
+	if model == nil {
+		return nil, repositories.ErrReferenceModelNotFound
+	}
+	if req.ParentBusinessDomainID != "" {
+		parent, err := s.domains.GetByID(ctx, req.ParentBusinessDomainID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return nil, ErrParentBusinessDomainNotFound
+		}
+	}
+
+	entries, err := s.models.GetEntries(ctx, req.ReferenceModelID)
+	if err != nil {
+		return nil, err
+	}
+	byCode := make(map[string]readmodels.ReferenceEntryDTO, len(entries))
+	children := make(map[string][]readmodels.ReferenceEntryDTO)
+	var topLevel []readmodels.ReferenceEntryDTO
+	for _, e := range entries {
+		byCode[e.Code] = e
+		if e.ParentCode == "" {
+			topLevel = append(topLevel, e)
+		} else {
+			children[e.ParentCode] = append(children[e.ParentCode], e)
+		}
+	}
+
+	roots := topLevel
+	if len(req.EntryCodes) > 0 {
+		roots, err = selectSeedRoots(req.EntryCodes, byCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var plan []seedStep
+	var visit func(entry readmodels.ReferenceEntryDTO, parentCode string, remaining int) error
+	visit = func(entry readmodels.ReferenceEntryDTO, parentCode string, remaining int) error {
+		if len(plan) >= MaxBulkTargets {
+			return ErrSeedTooManyDomains
+		}
+		plan = append(plan, seedStep{entry: entry, parentCode: parentCode})
+		if remaining == 0 {
+			return nil
+		}
+		for _, child := range children[entry.Code] {
+			if err := visit(child, entry.Code, remaining-1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, root := range roots {
+		if err := visit(root, "", req.Depth); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+func selectSeedRoots(codes []string, byCode map[string]readmodels.ReferenceEntryDTO) ([]readmodels.ReferenceEntryDTO, error) {
+	seen := make(map[string]bool, len(codes))
+	roots := make([]readmodels.ReferenceEntryDTO, 0, len(codes))
+	for _, code := range codes {
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+		entry, ok := byCode[code]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrReferenceEntryNotFound, code)
+		}
+		roots = append(roots, entry)
+	}
+	return roots, nil
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type seedRecordingBus struct {
+	created        []*commands.CreateBusinessDomain
+	correlationIDs map[string]bool
+	failFor        map[string]error
+}
+
+func newSeedRecordingBus() *seedRecordingBus {
+	return &seedRecordingBus{correlationIDs: map[string]bool{}, failFor: map[string]error{}}
+}
+
+func (b *seedRecordingBus) Register(string, cqrs.CommandHandler) {}
+
+func (b *seedRecordingBus) Dispatch(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	if id, ok := sharedctx.GetCorrelationID(ctx); ok {
+		b.correlationIDs[id] = true
+	}
+	create := cmd.(*commands.CreateBusinessDomain)
+	if err := b.failFor[create.Name]; err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	b.created = append(b.created, create)
+	return cqrs.NewResult("bd-" + create.Name), nil
+}
+
+type stubSeedDomainLookup map[string]readmodels.BusinessDomainDTO
+
+func (s stubSeedDomainLookup) GetByID(_ context.Context, id string) (*readmodels.BusinessDomainDTO, error) {
+	for _, d := range s {
+		if d.ID == id {
+			return &d, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s stubSeedDomainLookup) GetByName(_ context.Context, name string) (*readmodels.BusinessDomainDTO, error) {
+	if d, ok := s[name]; ok {
+		return &d, nil
+	}
+	return nil, nil
+}
+
+func TestReferenceDomainSeeder_SeedsTopLevelEntriesByDefault(t *testing.T) {
+	bus := newSeedRecordingBus()
+	seeder := NewReferenceDomainSeeder(bus, testReferenceModels(), stubSeedDomainLookup{})
+
+	result, err := seeder.Seed(context.Background(), SeedBusinessDomainsRequest{ReferenceModelID: "bian"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, result.CountByStatus(SeedStatusCreated))
+	require.Len(t, bus.created, 3)
+	assert.Equal(t, "Sales and Service", bus.created[0].Name)
+	assert.Empty(t, bus.created[0].ParentID)
+	assert.Len(t, bus.correlationIDs, 1)
+	assert.True(t, bus.correlationIDs[result.CorrelationID])
+}
+
+func TestReferenceDomainSeeder_NestsDescendantsUnderSeededParent(t *testing.T) {
+	bus := newSeedRecordingBus()
+	seeder := NewReferenceDomainSeeder(bus, testReferenceModels(), stubSeedDomainLookup{})
+
+	result, err := seeder.Seed(context.Background(), SeedBusinessDomainsRequest{ReferenceModelID: "bian", EntryCodes: []string{"SALES"}, Depth: 1})
+	require.NoError(t, err)
+
+	require.Len(t, bus.created, 2)
+	assert.Equal(t, "Payments", bus.created[1].Name)
+	assert.Equal(t, "bd-Sales and Service", bus.created[1].ParentID)
+	assert.Equal(t, "bd-Sales and Service", result.Domains[1].ParentDomainID)
+}
+
+func TestReferenceDomainSeeder_ReusesExistingDomainByName(t *testing.T) {
+	bus := newSeedRecordingBus()
+	existing := stubSeedDomainLookup{"Sales and Service": {ID: "bd-existing", Name: "Sales and Service"}}
+	seeder := NewReferenceDomainSeeder(bus, testReferenceModels(), existing)
+
+	result, err := seeder.Seed(context.Background(), SeedBusinessDomainsRequest{ReferenceModelID: "bian", EntryCodes: []string{"SALES"}, Depth: 1})
+	require.NoError(t, err)
+
+	assert.Equal(t, SeedStatusExisting, result.Domains[0].Status)
+	assert.Equal(t, "bd-existing", result.Domains[0].BusinessDomainID)
+	require.Len(t, bus.created, 1)
+	assert.Equal(t, "bd-existing", bus.created[0].ParentID)
+}
+
+func TestReferenceDomainSeeder_SkipsDescendantsOfFailedEntry(t *testing.T) {
+	bus := newSeedRecordingBus()
+	bus.failFor["Sales and Service"] = errors.New("boom")
+	seeder := NewReferenceDomainSeeder(bus, testReferenceModels(), stubSeedDomainLookup{})
+
+	result, err := seeder.Seed(context.Background(), SeedBusinessDomainsRequest{ReferenceModelID: "bian", Depth: 1})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.CountByStatus(SeedStatusFailed))
+	assert.Equal(t, 1, result.CountByStatus(SeedStatusSkipped))
+	assert.Equal(t, 2, result.CountByStatus(SeedStatusCreated))
+}
+
+func TestReferenceDomainSeeder_RejectsInvalidRequests(t *testing.T) {
+	seeder := NewReferenceDomainSeeder(newSeedRecordingBus(), testReferenceModels(), stubSeedDomainLookup{})
+
+	_, err := seeder.Seed(context.Background(), SeedBusinessDomainsRequest{ReferenceModelID: "bian", Depth: MaxSeedDepth + 1})
+	assert.ErrorIs(t, err, ErrSeedInvalidDepth)
+
+	_, err = seeder.Seed(context.Background(), SeedBusinessDomainsRequest{ReferenceModelID: "bian", EntryCodes: []string{"NOPE"}})
+	assert.ErrorIs(t, err, ErrReferenceEntryNotFound)
+
+	_, err = seeder.Seed(context.Background(), SeedBusinessDomainsRequest{ReferenceModelID: "bian", ParentBusinessDomainID: "missing"})
+	assert.ErrorIs(t, err, ErrParentBusinessDomainNotFound)
+}
@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+var (
+	ErrReferenceEntryNotFound        = errors.New("reference entry not found in this reference model")
+	ErrReferenceMappingAlreadyExists = errors.New("this capability is already mapped to this reference entry")
+)
+
+type ReferenceModelRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.ReferenceModel, error)
+	Save(ctx context.Context, model *aggregates.ReferenceModel) error
+}
+
+type ImportReferenceModelHandler struct {
+	repository ReferenceModelRepository
+}
+
+func NewImportReferenceModelHandler(repository ReferenceModelRepository) *ImportReferenceModelHandler {
+	return &ImportReferenceModelHandler{repository: repository}
+}
+
+func (h *ImportReferenceModelHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.ImportReferenceModel)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	framework, err := valueobjects.NewReferenceFramework(command.Framework)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	specs := make([]valueobjects.ReferenceEntrySpec, len(command.Entries))
+	for i, entry := range command.Entries {
+		specs[i] = valueobjects.ReferenceEntrySpec{
+			Code:        entry.Code,
+			Name:        entry.Name,
+			Description: entry.Description,
+			ParentCode:  entry.ParentCode,
+		}
+	}
+	catalogue, err := valueobjects.NewReferenceCatalogue(specs)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	model, err := aggregates.ImportReferenceModel(aggregates.ReferenceModelSpec{
+		Name:        command.Name,
+		Framework:   framework,
+		Version:     command.Version,
+		Description: command.Description,
+		Catalogue:   catalogue,
+		ImportedBy:  command.ImportedBy,
+	})
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, model); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.NewResult(model.ID()), nil
+}
+
+type DeleteReferenceModelHandler struct {
+	repository ReferenceModelRepository
+}
+
+func NewDeleteReferenceModelHandler(repository ReferenceModelRepository) *DeleteReferenceModelHandler {
+	return &DeleteReferenceModelHandler{repository: repository}
+}
+
+func (h *DeleteReferenceModelHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.DeleteReferenceModel)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	model, err := h.repository.GetByID(ctx, command.ID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := model.Delete(); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), h.repository.Save(ctx, model)
+}
+
+type ReferenceMappingRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.ReferenceMapping, error)
+	Save(ctx context.Context, mapping *aggregates.ReferenceMapping) error
+}
+
+type ReferenceCatalogueReader interface {
+	GetByID(ctx context.Context, id string) (*readmodels.ReferenceModelDTO, error)
+	GetEntry(ctx context.Context, modelID, code string) (*readmodels.ReferenceEntryDTO, error)
+}
+
+type ReferenceMappingExistenceChecker interface {
+	MappingExists(ctx context.Context, capabilityID, modelID, entryCode string) (bool, error)
+}
+
+type ReferenceMappingDeps struct {
+	Mappings     ReferenceMappingRepository
+	Capabilities AssignCapabilityCapabilityRepository
+	Catalogues   ReferenceCatalogueReader
+	Existing     ReferenceMappingExistenceChecker
+}
+
+type MapCapabilityToReferenceHandler struct {
+	deps ReferenceMappingDeps
+}
+
+func NewMapCapabilityToReferenceHandler(deps ReferenceMappingDeps) *MapCapabilityToReferenceHandler {
+	return &MapCapabilityToReferenceHandler{deps: deps}
+}
+
+func (h *MapCapabilityToReferenceHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.MapCapabilityToReference)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	capabilityID, err := valueobjects.NewCapabilityIDFromString(command.CapabilityID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if _, err := h.deps.Capabilities.GetByID(ctx, command.CapabilityID); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	entryCode := strings.TrimSpace(command.EntryCode)
+	if err := h.validateEntry(ctx, command.ReferenceModelID, entryCode); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	exists, err := h.deps.Existing.MappingExists(ctx, command.CapabilityID, command.ReferenceModelID, entryCode)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if exists {
+		return cqrs.EmptyResult(), ErrReferenceMappingAlreadyExists
+	}
+
+	mapping, err := aggregates.MapCapabilityToReference(capabilityID, command.ReferenceModelID, entryCode, command.MappedBy)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.deps.Mappings.Save(ctx, mapping); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.NewResult(mapping.ID()), nil
+}
+
+func (h *MapCapabilityToReferenceHandler) validateEntry(ctx context.Context, modelID, entryCode string) error {
+	model, err := h.deps.Catalogues.GetByID(ctx, modelID)
+	if err != nil {
+		return err
+	}
+	if model == nil {
+		return repositories.ErrReferenceModelNotFound
+	}
+
+	entry, err := h.deps.Catalogues.GetEntry(ctx, modelID, entryCode)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return ErrReferenceEntryNotFound
+	}
+	return nil
+}
+
+type UnmapCapabilityFromReferenceHandler struct {
+	repository ReferenceMappingRepository
+}
+
+func NewUnmapCapabilityFromReferenceHandler(repository ReferenceMappingRepository) *UnmapCapabilityFromReferenceHandler {
+	return &UnmapCapabilityFromReferenceHandler{repository: repository}
+}
+
+func (h *UnmapCapabilityFromReferenceHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.UnmapCapabilityFromReference)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	mapping, err := h.repository.GetByID(ctx, command.MappingID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if command.CapabilityID != "" && mapping.CapabilityID().Value() != command.CapabilityID {
+		return cqrs.EmptyResult(), repositories.ErrReferenceMappingNotFound
+	}
+
+	if err := mapping.Unmap(); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), h.repository.Save(ctx, mapping)
+}
@@ -47,7 +47,7 @@ func createCapabilityWithExpert(t *testing.T) *aggregates.Capability {
 
 	var parentID valueobjects.CapabilityID
 
-	capability, err := aggregates.NewCapability(name, description, parentID, level)
+	capability, err := aggregates.NewCapability(name, description, parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	expert := valueobjects.MustNewExpert("Alice Smith", "Product Owner", "alice@example.com", time.Now().UTC())
@@ -166,11 +166,9 @@ func TestRemoveCapabilityExpertHandler_InvalidCommand_ReturnsError(t *testing.T)
 
 	handler := NewRemoveCapabilityExpertHandler(mockRepo)
 
-	invalidCmd := &commands.AddCapabilityExpert{
+	invalidCmd := &commands.AddCapabilityTag{
 		CapabilityID: "test-id",
-		ExpertName:   "Alice Smith",
-		ExpertRole:   "Product Owner",
-		ContactInfo:  "alice@example.com",
+		Tag:          "core",
 	}
 
 	_, err := handler.Handle(context.Background(), invalidCmd)
@@ -189,7 +187,7 @@ func TestRemoveCapabilityExpertHandler_RoleRemainsAvailable_WhenOtherExpertUsesI
 
 	var parentID valueobjects.CapabilityID
 
-	capability, err := aggregates.NewCapability(name, description, parentID, level)
+	capability, err := aggregates.NewCapability(name, description, parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	expert1 := valueobjects.MustNewExpert("Alice Smith", "Product Owner", "alice@example.com", time.Now().UTC())
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type RemoveCapabilityTagHandler struct {
+	repository AddCapabilityTagRepository
+}
+
+func NewRemoveCapabilityTagHandler(repository AddCapabilityTagRepository) *RemoveCapabilityTagHandler {
+	return &RemoveCapabilityTagHandler{
+		repository: repository,
+	}
+}
+
+func (h *RemoveCapabilityTagHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.RemoveCapabilityTag)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	tag, err := valueobjects.NewTag(command.Tag)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	capability, err := h.repository.GetByID(ctx, command.CapabilityID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := capability.RemoveTag(tag); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, capability); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
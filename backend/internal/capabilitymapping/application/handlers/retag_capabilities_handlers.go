@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTagRenameUnchanged     = errors.New("new tag must differ from the current tag")
+	ErrTagMergeSourceRequired = errors.New("at least one source tag other than the target is required")
+)
+
+type TaggedCapabilityLister interface {
+	GetCapabilityIDsWithTag(ctx context.Context, tag string) ([]string, error)
+}
+
+// RetagCapabilitiesDeps is shared by the rename and merge handlers, which both
+// rewrite a tag on every capability carrying it through CapabilityTagRenamed events.
+type RetagCapabilitiesDeps struct {
+	Repository AddCapabilityTagRepository
+	Tagged     TaggedCapabilityLister
+	Vocabulary services.CapabilityTagVocabularyProvider
+}
+
+type RenameCapabilityTagHandler struct {
+	deps RetagCapabilitiesDeps
+}
+
+func NewRenameCapabilityTagHandler(deps RetagCapabilitiesDeps) *RenameCapabilityTagHandler {
+	return &RenameCapabilityTagHandler{deps: deps}
+}
+
+func (h *RenameCapabilityTagHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.RenameCapabilityTag)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	from, err := valueobjects.NewTag(command.From)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	to, err := valueobjects.NewTag(command.To)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if from.Equals(to) {
+		return cqrs.EmptyResult(), ErrTagRenameUnchanged
+	}
+
+	return cqrs.EmptyResult(), h.deps.retag(ctx, []valueobjects.Tag{from}, to)
+}
+
+type MergeCapabilityTagsHandler struct {
+	deps RetagCapabilitiesDeps
+}
+
+func NewMergeCapabilityTagsHandler(deps RetagCapabilitiesDeps) *MergeCapabilityTagsHandler {
+	return &MergeCapabilityTagsHandler{deps: deps}
+}
+
+func (h *MergeCapabilityTagsHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.MergeCapabilityTags)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	target, err := valueobjects.NewTag(command.Target)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	sources, err := mergeSources(command.Sources, target)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), h.deps.retag(ctx, sources, target)
+}
+
+func mergeSources(raw []string, target valueobjects.Tag) ([]valueobjects.Tag, error) {
+	seen := make(map[string]bool, len(raw))
+	sources := make([]valueobjects.Tag, 0, len(raw))
+	for _, value := range raw {
+		tag, err := valueobjects.NewTag(value)
+		if err != nil {
+			return nil, err
+		}
+		if tag.Equals(target) || seen[tag.Value()] {
+			continue
+		}
+		seen[tag.Value()] = true
+		sources = append(sources, tag)
+	}
+	if len(sources) == 0 {
+		return nil, ErrTagMergeSourceRequired
+	}
+	return sources, nil
+}
+
+func (d RetagCapabilitiesDeps) retag(ctx context.Context, sources []valueobjects.Tag, target valueobjects.Tag) error {
+	if err := ensureTagPermitted(ctx, d.Vocabulary, target); err != nil {
+		return err
+	}
+
+	if _, ok := sharedctx.GetCorrelationID(ctx); !ok {
+		ctx = sharedctx.WithCorrelationID(ctx, uuid.New().String())
+	}
+
+	for _, source := range sources {
+		ids, err := d.Tagged.GetCapabilityIDsWithTag(ctx, source.Value())
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if err := d.retagCapability(ctx, id, source, target); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d RetagCapabilitiesDeps) retagCapability(ctx context.Context, id string, from, to valueobjects.Tag) error {
+	capability, err := d.Repository.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := capability.RenameTag(from, to); err != nil {
+		return err
+	}
+	return d.Repository.Save(ctx, capability)
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type taggedCapabilityStore struct {
+	capabilities map[string]*aggregates.Capability
+	saved        []string
+}
+
+func newTaggedCapabilityStore(t *testing.T, tagsByName map[string][]string) *taggedCapabilityStore {
+	t.Helper()
+	store := &taggedCapabilityStore{capabilities: map[string]*aggregates.Capability{}}
+	for name, tags := range tagsByName {
+		capability := createCapabilityWithExpert(t)
+		for _, value := range tags {
+			tag, err := valueobjects.NewTag(value)
+			require.NoError(t, err)
+			require.NoError(t, capability.AddTag(tag))
+		}
+		capability.MarkChangesAsCommitted()
+		store.capabilities[name] = capability
+	}
+	return store
+}
+
+func (s *taggedCapabilityStore) GetByID(_ context.Context, id string) (*aggregates.Capability, error) {
+	for _, capability := range s.capabilities {
+		if capability.ID() == id {
+			return capability, nil
+		}
+	}
+	return nil, ErrCapabilityNotFound
+}
+
+func (s *taggedCapabilityStore) Save(_ context.Context, capability *aggregates.Capability) error {
+	s.saved = append(s.saved, capability.ID())
+	return nil
+}
+
+func (s *taggedCapabilityStore) GetCapabilityIDsWithTag(_ context.Context, tag string) ([]string, error) {
+	var ids []string
+	for _, capability := range s.capabilities {
+		for _, existing := range capability.Tags() {
+			if existing.Value() == tag {
+				ids = append(ids, capability.ID())
+			}
+		}
+	}
+	return ids, nil
+}
+
+func (s *taggedCapabilityStore) tagsOf(name string) []string {
+	var values []string
+	for _, tag := range s.capabilities[name].Tags() {
+		values = append(values, tag.Value())
+	}
+	return values
+}
+
+type stubTagVocabulary struct {
+	vocabulary valueobjects.TagVocabulary
+}
+
+func (s stubTagVocabulary) GetCapabilityTagVocabulary(context.Context) (valueobjects.TagVocabulary, error) {
+	return s.vocabulary, nil
+}
+
+func retagDeps(store *taggedCapabilityStore, vocabulary valueobjects.TagVocabulary) RetagCapabilitiesDeps {
+	return RetagCapabilitiesDeps{Repository: store, Tagged: store, Vocabulary: stubTagVocabulary{vocabulary}}
+}
+
+func TestRenameCapabilityTagHandler_RewritesEveryTaggedCapability(t *testing.T) {
+	store := newTaggedCapabilityStore(t, map[string][]string{
+		"crm":      {"crm", "core"},
+		"billing":  {"crm"},
+		"untagged": {"core"},
+	})
+	handler := NewRenameCapabilityTagHandler(retagDeps(store, valueobjects.UnrestrictedTagVocabulary()))
+
+	_, err := handler.Handle(context.Background(), &commands.RenameCapabilityTag{From: "crm", To: "customer"})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"core", "customer"}, store.tagsOf("crm"))
+	assert.Equal(t, []string{"customer"}, store.tagsOf("billing"))
+	assert.Equal(t, []string{"core"}, store.tagsOf("untagged"))
+	assert.Len(t, store.saved, 2)
+}
+
+func TestRenameCapabilityTagHandler_RejectsUnchangedTag(t *testing.T) {
+	store := newTaggedCapabilityStore(t, nil)
+	handler := NewRenameCapabilityTagHandler(retagDeps(store, valueobjects.UnrestrictedTagVocabulary()))
+
+	_, err := handler.Handle(context.Background(), &commands.RenameCapabilityTag{From: "crm", To: " crm "})
+
+	assert.ErrorIs(t, err, ErrTagRenameUnchanged)
+}
+
+func TestRenameCapabilityTagHandler_TargetMustBeInRestrictedVocabulary(t *testing.T) {
+	store := newTaggedCapabilityStore(t, map[string][]string{"crm": {"crm"}})
+	handler := NewRenameCapabilityTagHandler(retagDeps(store, valueobjects.NewTagVocabulary([]string{"customer"}, true)))
+
+	_, err := handler.Handle(context.Background(), &commands.RenameCapabilityTag{From: "crm", To: "client"})
+
+	assert.ErrorIs(t, err, valueobjects.ErrTagNotInVocabulary)
+	assert.Empty(t, store.saved)
+}
+
+func TestMergeCapabilityTagsHandler_FoldsSourcesIntoTarget(t *testing.T) {
+	store := newTaggedCapabilityStore(t, map[string][]string{
+		"both":   {"crm", "customer-mgmt", "customer"},
+		"source": {"customer-mgmt"},
+	})
+	handler := NewMergeCapabilityTagsHandler(retagDeps(store, valueobjects.UnrestrictedTagVocabulary()))
+
+	_, err := handler.Handle(context.Background(), &commands.MergeCapabilityTags{
+		Sources: []string{"crm", "customer-mgmt", "customer"},
+		Target:  "customer",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"customer"}, store.tagsOf("both"))
+	assert.Equal(t, []string{"customer"}, store.tagsOf("source"))
+}
+
+func TestMergeCapabilityTagsHandler_RequiresASourceOtherThanTarget(t *testing.T) {
+	handler := NewMergeCapabilityTagsHandler(retagDeps(newTaggedCapabilityStore(t, nil), valueobjects.UnrestrictedTagVocabulary()))
+
+	_, err := handler.Handle(context.Background(), &commands.MergeCapabilityTags{Sources: []string{"customer"}, Target: "customer"})
+
+	assert.ErrorIs(t, err, ErrTagMergeSourceRequired)
+}
+
+func TestAddCapabilityTagHandler_RestrictedVocabularyRejectsUnknownTag(t *testing.T) {
+	store := newTaggedCapabilityStore(t, map[string][]string{"crm": nil})
+	handler := NewAddCapabilityTagHandler(store, stubTagVocabulary{valueobjects.NewTagVocabulary([]string{"core"}, true)})
+	id := store.capabilities["crm"].ID()
+
+	_, err := handler.Handle(context.Background(), &commands.AddCapabilityTag{CapabilityID: id, Tag: "legacy"})
+	assert.ErrorIs(t, err, valueobjects.ErrTagNotInVocabulary)
+
+	_, err = handler.Handle(context.Background(), &commands.AddCapabilityTag{CapabilityID: id, Tag: "core"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"core"}, store.tagsOf("crm"))
+}
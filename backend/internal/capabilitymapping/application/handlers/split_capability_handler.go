@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+var ErrInvalidSplitDistribution = errors.New("split distribution refers to a part that does not exist")
+
+type SplitCapabilityRepository interface {
+	GetByID(ctx context.Context, id string) (*aggregates.Capability, error)
+	Save(ctx context.Context, capability *aggregates.Capability) error
+}
+
+// SplitCapabilityHandler divides a capability into new siblings that each take
+// over its experts and tags. Children, realizations, dependencies, domain
+// assignments and strategy importance go to the part the architect chose, or to
+// the first part. The split capability is only marked split and deleted once all
+// of its content has moved; a failed move leaves it in place and discards the
+// parts.
+type SplitCapabilityHandler struct {
+	repository  SplitCapabilityRepository
+	hierarchies services.CapabilityHierarchyProvider
+	commandBus  cqrs.CommandBus
+	mover       *capabilityContentMover
+}
+
+func NewSplitCapabilityHandler(
+	repository SplitCapabilityRepository,
+	hierarchies services.CapabilityHierarchyProvider,
+	commandBus cqrs.CommandBus,
+	readers CapabilityContentReaders,
+) *SplitCapabilityHandler {
+	return &SplitCapabilityHandler{
+		repository:  repository,
+		hierarchies: hierarchies,
+		commandBus:  commandBus,
+		mover:       &capabilityContentMover{commandBus: commandBus, readers: readers},
+	}
+}
+
+func (h *SplitCapabilityHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.SplitCapability)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	for _, partIndex := range command.Distribution {
+		if partIndex < 0 || partIndex >= len(command.Parts) {
+			return cqrs.EmptyResult(), ErrInvalidSplitDistribution
+		}
+	}
+
+	source, err := h.repository.GetByID(ctx, command.ID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	content, err := h.mover.load(ctx, source.ID())
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	parts, err := h.createParts(ctx, source, command)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	partIDs := make([]string, len(parts))
+	for i, part := range parts {
+		partIDs[i] = part.ID()
+	}
+	toPart := func(itemID string) (string, bool) {
+		return partIDs[command.Distribution[itemID]], true
+	}
+
+	if err := h.mover.redistribute(ctx, source.ID(), content, toPart); err != nil {
+		return cqrs.EmptyResult(), errors.Join(err, h.discardParts(ctx, source.ID(), partIDs))
+	}
+	if err := h.repository.Save(ctx, source); err != nil {
+		return cqrs.EmptyResult(), errors.Join(err, h.discardParts(ctx, source.ID(), partIDs))
+	}
+
+	if _, err := h.commandBus.Dispatch(ctx, &commands.DeleteCapability{ID: source.ID()}); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.NewMultiResult(partIDs), nil
+}
+
+// createParts saves the new parts straight away, as they are the targets the
+// content moves to; the source is only saved once the move has succeeded. A
+// part that cannot be saved discards the ones saved before it.
+func (h *SplitCapabilityHandler) createParts(ctx context.Context, source *aggregates.Capability, command *commands.SplitCapability) ([]*aggregates.Capability, error) {
+	hierarchy, err := h.hierarchies.GetCapabilityHierarchy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]*aggregates.Capability, 0, len(command.Parts))
+	for _, spec := range command.Parts {
+		name, err := valueobjects.NewCapabilityName(spec.Name)
+		if err != nil {
+			return nil, err
+		}
+		description, err := valueobjects.NewDescription(spec.Description)
+		if err != nil {
+			return nil, err
+		}
+		part, err := aggregates.NewCapability(name, description, source.ParentID(), source.Level(), hierarchy)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+	}
+
+	if err := source.SplitInto(parts, command.SplitBy); err != nil {
+		return nil, err
+	}
+
+	savedIDs := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if err := h.repository.Save(ctx, part); err != nil {
+			return nil, errors.Join(err, h.discardParts(ctx, source.ID(), savedIDs))
+		}
+		savedIDs = append(savedIDs, part.ID())
+	}
+	return parts, nil
+}
+
+// discardParts undoes a split that could not finish: whatever already moved
+// to a part goes back to the source, and the part is deleted, so no orphan
+// parts are left next to the source.
+func (h *SplitCapabilityHandler) discardParts(ctx context.Context, sourceID string, partIDs []string) error {
+	toSource := func(string) (string, bool) { return sourceID, true }
+	var errs []error
+	for _, partID := range partIDs {
+		if err := h.discardPart(ctx, partID, toSource); err != nil {
+			errs = append(errs, fmt.Errorf("discard split part %s: %w", partID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h *SplitCapabilityHandler) discardPart(ctx context.Context, partID string, toSource contentTarget) error {
+	content, err := h.mover.load(ctx, partID)
+	if err != nil {
+		return err
+	}
+	if err := h.mover.redistribute(ctx, partID, content, toSource); err != nil {
+		return err
+	}
+	_, err = h.commandBus.Dispatch(ctx, &commands.DeleteCapability{ID: partID})
+	return err
+}
@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCapabilityHandler_DistributesContentAcrossParts(t *testing.T) {
+	repo := newMockCascadeRepo()
+	source := cascadeCapabilityWithParent(t, "L1", "")
+	repo.capabilities[source.ID()] = source
+	id := source.ID()
+
+	content := newContentFixture()
+	content.children.children[id] = []readmodels.CapabilityDTO{{ID: "child-1"}, {ID: "child-2"}}
+	content.realizations.byCapability[id] = []readmodels.RealizationDTO{{ID: "real-1", ComponentID: "comp-1", Origin: "Direct"}}
+	content.assignments.byCapability[id] = []readmodels.AssignmentDTO{{AssignmentID: "asg-1", BusinessDomainID: "dom-1"}}
+	commandBus := &mockCommandBus{}
+	handler := NewSplitCapabilityHandler(repo, defaultHierarchyProvider{}, commandBus, content.readers())
+
+	result, err := handler.Handle(context.Background(), &commands.SplitCapability{
+		ID:           id,
+		Parts:        []commands.SplitCapabilityPart{{Name: "Customer Onboarding"}, {Name: "Customer Service"}},
+		Distribution: map[string]int{"child-2": 1, "real-1": 1},
+		SplitBy:      "jane@example.com",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.CreatedIDs, 2)
+	first, second := result.CreatedIDs[0], result.CreatedIDs[1]
+	assert.Equal(t, []cqrs.Command{
+		&commands.ChangeCapabilityParent{CapabilityID: "child-1", NewParentID: first},
+		&commands.ChangeCapabilityParent{CapabilityID: "child-2", NewParentID: second},
+		&commands.MoveSystemRealization{ID: "real-1", CapabilityID: second},
+		&commands.AssignCapabilityToDomain{BusinessDomainID: "dom-1", CapabilityID: first},
+		&commands.UnassignCapabilityFromDomain{AssignmentID: "asg-1"},
+		&commands.DeleteCapability{ID: id},
+	}, commandBus.dispatchedCommands)
+	require.Len(t, repo.saved, 3)
+	assert.Equal(t, source, repo.saved[2])
+}
+
+func TestSplitCapabilityHandler_RejectsUnknownPart(t *testing.T) {
+	repo := newMockCascadeRepo()
+	source := cascadeCapabilityWithParent(t, "L1", "")
+	repo.capabilities[source.ID()] = source
+	commandBus := &mockCommandBus{}
+	handler := NewSplitCapabilityHandler(repo, defaultHierarchyProvider{}, commandBus, newContentFixture().readers())
+
+	_, err := handler.Handle(context.Background(), &commands.SplitCapability{
+		ID:           source.ID(),
+		Parts:        []commands.SplitCapabilityPart{{Name: "Customer Onboarding"}, {Name: "Customer Service"}},
+		Distribution: map[string]int{"child-1": 2},
+	})
+
+	assert.ErrorIs(t, err, ErrInvalidSplitDistribution)
+	assert.Empty(t, repo.saved)
+	assert.Empty(t, commandBus.dispatchedCommands)
+}
+
+func TestSplitCapabilityHandler_FailedMoveKeepsSourceAndDiscardsParts(t *testing.T) {
+	repo := newMockCascadeRepo()
+	source := cascadeCapabilityWithParent(t, "L1", "")
+	repo.capabilities[source.ID()] = source
+
+	content := newContentFixture()
+	content.children.children[source.ID()] = []readmodels.CapabilityDTO{{ID: "child-1"}}
+	commandBus := &failingOnCommandBus{failOn: "ChangeCapabilityParent"}
+	handler := NewSplitCapabilityHandler(repo, defaultHierarchyProvider{}, commandBus, content.readers())
+
+	_, err := handler.Handle(context.Background(), &commands.SplitCapability{
+		ID:      source.ID(),
+		Parts:   []commands.SplitCapabilityPart{{Name: "Customer Onboarding"}, {Name: "Customer Service"}},
+		SplitBy: "jane@example.com",
+	})
+
+	require.Error(t, err)
+	assert.NotContains(t, repo.saved, source, "the split is not recorded on the source")
+	require.Len(t, repo.saved, 2)
+	assert.Equal(t, []cqrs.Command{
+		&commands.DeleteCapability{ID: repo.saved[0].ID()},
+		&commands.DeleteCapability{ID: repo.saved[1].ID()},
+	}, commandBus.dispatchedCommands, "the parts are deleted rather than left orphaned")
+}
+
+// movedChildren answers GetChildren from the parent changes dispatched so far,
+// so content moved to a part during a split is found there when it is undone.
+type movedChildren struct {
+	parents map[string]string
+	bus     *failingOnCommandBus
+}
+
+func (m movedChildren) GetChildren(_ context.Context, parentID string) ([]readmodels.CapabilityDTO, error) {
+	current := map[string]string{}
+	for child, parent := range m.parents {
+		current[child] = parent
+	}
+	for _, cmd := range m.bus.dispatchedCommands {
+		if move, ok := cmd.(*commands.ChangeCapabilityParent); ok {
+			current[move.CapabilityID] = move.NewParentID
+		}
+	}
+	var children []readmodels.CapabilityDTO
+	for _, id := range []string{"child-1", "child-2"} {
+		if current[id] == parentID {
+			children = append(children, readmodels.CapabilityDTO{ID: id})
+		}
+	}
+	return children, nil
+}
+
+func TestSplitCapabilityHandler_FailureMidMoveReturnsMovedContentToSource(t *testing.T) {
+	repo := newMockCascadeRepo()
+	source := cascadeCapabilityWithParent(t, "L1", "")
+	repo.capabilities[source.ID()] = source
+	id := source.ID()
+
+	content := newContentFixture()
+	content.realizations.byCapability[id] = []readmodels.RealizationDTO{{ID: "real-1", ComponentID: "comp-1", Origin: "Direct"}}
+	commandBus := &failingOnCommandBus{failOn: "MoveSystemRealization"}
+	readers := content.readers()
+	readers.Children = movedChildren{parents: map[string]string{"child-1": id, "child-2": id}, bus: commandBus}
+	handler := NewSplitCapabilityHandler(repo, defaultHierarchyProvider{}, commandBus, readers)
+
+	_, err := handler.Handle(context.Background(), &commands.SplitCapability{
+		ID:           id,
+		Parts:        []commands.SplitCapabilityPart{{Name: "Customer Onboarding"}, {Name: "Customer Service"}},
+		Distribution: map[string]int{"child-2": 1},
+		SplitBy:      "jane@example.com",
+	})
+
+	require.Error(t, err)
+	require.Len(t, repo.saved, 2)
+	first, second := repo.saved[0].ID(), repo.saved[1].ID()
+	assert.Equal(t, []cqrs.Command{
+		&commands.ChangeCapabilityParent{CapabilityID: "child-1", NewParentID: first},
+		&commands.ChangeCapabilityParent{CapabilityID: "child-2", NewParentID: second},
+		&commands.ChangeCapabilityParent{CapabilityID: "child-1", NewParentID: id},
+		&commands.DeleteCapability{ID: first},
+		&commands.ChangeCapabilityParent{CapabilityID: "child-2", NewParentID: id},
+		&commands.DeleteCapability{ID: second},
+	}, commandBus.dispatchedCommands)
+}
+
+// failingOnSecondSaveRepo fails to save the second capability it is given.
+type failingOnSecondSaveRepo struct {
+	*mockCascadeRepository
+}
+
+func (r failingOnSecondSaveRepo) Save(ctx context.Context, capability *aggregates.Capability) error {
+	if len(r.saved) == 1 {
+		return errors.New("event store unavailable")
+	}
+	return r.mockCascadeRepository.Save(ctx, capability)
+}
+
+func TestSplitCapabilityHandler_FailedPartSaveDiscardsSavedParts(t *testing.T) {
+	repo := failingOnSecondSaveRepo{newMockCascadeRepo()}
+	source := cascadeCapabilityWithParent(t, "L1", "")
+	repo.capabilities[source.ID()] = source
+	commandBus := &failingOnCommandBus{}
+	handler := NewSplitCapabilityHandler(repo, defaultHierarchyProvider{}, commandBus, newContentFixture().readers())
+
+	_, err := handler.Handle(context.Background(), &commands.SplitCapability{
+		ID:      source.ID(),
+		Parts:   []commands.SplitCapabilityPart{{Name: "Customer Onboarding"}, {Name: "Customer Service"}},
+		SplitBy: "jane@example.com",
+	})
+
+	require.Error(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, []cqrs.Command{&commands.DeleteCapability{ID: repo.saved[0].ID()}}, commandBus.dispatchedCommands)
+}
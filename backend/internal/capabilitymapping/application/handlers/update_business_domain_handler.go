@@ -67,7 +67,9 @@ func (h *UpdateBusinessDomainHandler) Handle(ctx context.Context, cmd cqrs.Comma
 		return cqrs.EmptyResult(), err
 	}
 
-	if err := domain.Update(name, description, command.DomainArchitectID); err != nil {
+	architects := domainArchitectsFrom(command.DomainArchitectIDs, command.DomainArchitectID)
+
+	if err := domain.Update(name, description, architects); err != nil {
 		return cqrs.EmptyResult(), err
 	}
 
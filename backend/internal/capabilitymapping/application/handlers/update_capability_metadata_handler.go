@@ -53,8 +53,6 @@ func buildMetadata(cmd *commands.UpdateCapabilityMetadata) (valueobjects.Capabil
 	return valueobjects.NewCapabilityMetadata(
 		maturityLevel,
 		ownershipModel,
-		valueobjects.NewOwner(cmd.PrimaryOwner),
-		valueobjects.NewOwner(cmd.EAOwner),
 		status,
 	), nil
 }
@@ -14,6 +14,7 @@ import (
 type BusinessDomainStore interface {
 	Insert(ctx context.Context, dto readmodels.BusinessDomainDTO) error
 	Update(ctx context.Context, id string, update readmodels.BusinessDomainUpdate) error
+	UpdateParent(ctx context.Context, id, parentID string) error
 	Delete(ctx context.Context, id string) error
 	IncrementCapabilityCount(ctx context.Context, id string) error
 	DecrementCapabilityCount(ctx context.Context, id string) error
@@ -43,6 +44,7 @@ func (p *BusinessDomainProjector) ProjectEvent(ctx context.Context, eventType st
 	handlers := map[string]func(context.Context, []byte) error{
 		"BusinessDomainCreated":          p.handleBusinessDomainCreated,
 		"BusinessDomainUpdated":          p.handleBusinessDomainUpdated,
+		"BusinessDomainParentChanged":    p.handleBusinessDomainParentChanged,
 		"BusinessDomainDeleted":          p.handleBusinessDomainDeleted,
 		"CapabilityAssignedToDomain":     p.handleCapabilityAssignedToDomain,
 		"CapabilityUnassignedFromDomain": p.handleCapabilityUnassignedFromDomain,
@@ -60,11 +62,12 @@ func (p *BusinessDomainProjector) handleBusinessDomainCreated(ctx context.Contex
 
 func (p *BusinessDomainProjector) projectBusinessDomainCreated(ctx context.Context, event events.BusinessDomainCreated) error {
 	return p.readModel.Insert(ctx, readmodels.BusinessDomainDTO{
-		ID:                event.ID,
-		Name:              event.Name,
-		Description:       event.Description,
-		DomainArchitectID: event.DomainArchitectID,
-		CreatedAt:         event.CreatedAt,
+		ID:                 event.ID,
+		Name:               event.Name,
+		Description:        event.Description,
+		ParentID:           event.ParentID,
+		DomainArchitectIDs: event.ArchitectIDs(),
+		CreatedAt:          event.CreatedAt,
 	})
 }
 
@@ -74,9 +77,15 @@ func (p *BusinessDomainProjector) handleBusinessDomainUpdated(ctx context.Contex
 
 func (p *BusinessDomainProjector) projectBusinessDomainUpdated(ctx context.Context, event events.BusinessDomainUpdated) error {
 	return p.readModel.Update(ctx, event.ID, readmodels.BusinessDomainUpdate{
-		Name:              event.Name,
-		Description:       event.Description,
-		DomainArchitectID: event.DomainArchitectID,
+		Name:               event.Name,
+		Description:        event.Description,
+		DomainArchitectIDs: event.ArchitectIDs(),
+	})
+}
+
+func (p *BusinessDomainProjector) handleBusinessDomainParentChanged(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, event events.BusinessDomainParentChanged) error {
+		return p.readModel.UpdateParent(ctx, event.ID, event.NewParentID)
 	})
 }
 
@@ -19,6 +19,7 @@ type mockBusinessDomainReadModel struct {
 	deletedIDs             []string
 	incrementCapCountCalls []string
 	decrementCapCountCalls []string
+	parentUpdates          map[string]string
 	insertErr              error
 	updateErr              error
 	deleteErr              error
@@ -27,10 +28,10 @@ type mockBusinessDomainReadModel struct {
 }
 
 type updateBusinessDomainCall struct {
-	ID                string
-	Name              string
-	Description       string
-	DomainArchitectID string
+	ID                 string
+	Name               string
+	Description        string
+	DomainArchitectIDs []string
 }
 
 func (m *mockBusinessDomainReadModel) Insert(ctx context.Context, dto readmodels.BusinessDomainDTO) error {
@@ -46,14 +47,22 @@ func (m *mockBusinessDomainReadModel) Update(ctx context.Context, id string, upd
 		return m.updateErr
 	}
 	m.updatedDomains = append(m.updatedDomains, updateBusinessDomainCall{
-		ID:                id,
-		Name:              update.Name,
-		Description:       update.Description,
-		DomainArchitectID: update.DomainArchitectID,
+		ID:                 id,
+		Name:               update.Name,
+		Description:        update.Description,
+		DomainArchitectIDs: update.DomainArchitectIDs,
 	})
 	return nil
 }
 
+func (m *mockBusinessDomainReadModel) UpdateParent(ctx context.Context, id, parentID string) error {
+	if m.parentUpdates == nil {
+		m.parentUpdates = map[string]string{}
+	}
+	m.parentUpdates[id] = parentID
+	return nil
+}
+
 func (m *mockBusinessDomainReadModel) Delete(ctx context.Context, id string) error {
 	if m.deleteErr != nil {
 		return m.deleteErr
@@ -82,12 +91,13 @@ func TestBusinessDomainProjector_HandleBusinessDomainCreated(t *testing.T) {
 	mock := &mockBusinessDomainReadModel{}
 	projector := NewBusinessDomainProjector(mock)
 
-	event := events.NewBusinessDomainCreated(
-		"bd-123",
-		"Finance",
-		"Financial operations and planning",
-		"architect-1",
-	)
+	event := events.NewBusinessDomainCreated(events.BusinessDomainCreatedParams{
+		ID:           "bd-123",
+		Name:         "Finance",
+		Description:  "Financial operations and planning",
+		ParentID:     "bd-division",
+		ArchitectIDs: []string{"architect-1", "architect-3"},
+	})
 
 	eventData, err := json.Marshal(event.EventData())
 	require.NoError(t, err)
@@ -99,7 +109,8 @@ func TestBusinessDomainProjector_HandleBusinessDomainCreated(t *testing.T) {
 	assert.Equal(t, "bd-123", mock.insertedDomains[0].ID)
 	assert.Equal(t, "Finance", mock.insertedDomains[0].Name)
 	assert.Equal(t, "Financial operations and planning", mock.insertedDomains[0].Description)
-	assert.Equal(t, "architect-1", mock.insertedDomains[0].DomainArchitectID)
+	assert.Equal(t, "bd-division", mock.insertedDomains[0].ParentID)
+	assert.Equal(t, []string{"architect-1", "architect-3"}, mock.insertedDomains[0].DomainArchitectIDs)
 	assert.WithinDuration(t, time.Now().UTC(), mock.insertedDomains[0].CreatedAt, time.Second)
 }
 
@@ -111,7 +122,7 @@ func TestBusinessDomainProjector_HandleBusinessDomainUpdated(t *testing.T) {
 		"bd-123",
 		"Finance & Accounting",
 		"Updated description",
-		"architect-2",
+		[]string{"architect-2"},
 	)
 
 	eventData, err := json.Marshal(event.EventData())
@@ -122,13 +133,38 @@ func TestBusinessDomainProjector_HandleBusinessDomainUpdated(t *testing.T) {
 
 	require.Len(t, mock.updatedDomains, 1)
 	assert.Equal(t, updateBusinessDomainCall{
-		ID:                "bd-123",
-		Name:              "Finance & Accounting",
-		Description:       "Updated description",
-		DomainArchitectID: "architect-2",
+		ID:                 "bd-123",
+		Name:               "Finance & Accounting",
+		Description:        "Updated description",
+		DomainArchitectIDs: []string{"architect-2"},
 	}, mock.updatedDomains[0])
 }
 
+func TestBusinessDomainProjector_HandleBusinessDomainCreated_LegacySingleArchitect(t *testing.T) {
+	mock := &mockBusinessDomainReadModel{}
+	projector := NewBusinessDomainProjector(mock)
+
+	legacyPayload := []byte(`{"id":"bd-1","name":"Sales","description":"","domainArchitectId":"architect-1","createdAt":"2024-01-01T00:00:00Z"}`)
+
+	require.NoError(t, projector.ProjectEvent(context.Background(), "BusinessDomainCreated", legacyPayload))
+
+	require.Len(t, mock.insertedDomains, 1)
+	assert.Equal(t, []string{"architect-1"}, mock.insertedDomains[0].DomainArchitectIDs)
+	assert.Empty(t, mock.insertedDomains[0].ParentID)
+}
+
+func TestBusinessDomainProjector_HandleBusinessDomainParentChanged(t *testing.T) {
+	mock := &mockBusinessDomainReadModel{}
+	projector := NewBusinessDomainProjector(mock)
+
+	event := events.NewBusinessDomainParentChanged("bd-123", "", "bd-division")
+	eventData, err := json.Marshal(event.EventData())
+	require.NoError(t, err)
+
+	require.NoError(t, projector.ProjectEvent(context.Background(), "BusinessDomainParentChanged", eventData))
+	assert.Equal(t, map[string]string{"bd-123": "bd-division"}, mock.parentUpdates)
+}
+
 type bdProjectorEvent interface {
 	EventType() string
 	EventData() map[string]interface{}
@@ -0,0 +1,53 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	mmPL "easi/backend/internal/metamodel/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type CapabilityHierarchyCacheStore interface {
+	Upsert(ctx context.Context, tenantID string, labels []string) error
+}
+
+type CapabilityHierarchyCacheProjector struct {
+	readModel CapabilityHierarchyCacheStore
+}
+
+func NewCapabilityHierarchyCacheProjector(readModel CapabilityHierarchyCacheStore) *CapabilityHierarchyCacheProjector {
+	return &CapabilityHierarchyCacheProjector{readModel: readModel}
+}
+
+func (p *CapabilityHierarchyCacheProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+type capabilityHierarchyConfigUpdatedEvent struct {
+	TenantID string   `json:"tenantId"`
+	Labels   []string `json:"labels"`
+}
+
+func (p *CapabilityHierarchyCacheProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	if eventType != mmPL.CapabilityHierarchyConfigUpdated {
+		return nil
+	}
+
+	var event capabilityHierarchyConfigUpdatedEvent
+	if err := json.Unmarshal(eventData, &event); err != nil {
+		wrappedErr := fmt.Errorf("unmarshal CapabilityHierarchyConfigUpdated event data in capability hierarchy cache projector: %w", err)
+		log.Printf("failed to unmarshal CapabilityHierarchyConfigUpdated event: %v", wrappedErr)
+		return wrappedErr
+	}
+
+	return p.readModel.Upsert(ctx, event.TenantID, event.Labels)
+}
@@ -0,0 +1,46 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCapabilityHierarchyCache struct {
+	tenantID string
+	labels   []string
+}
+
+func (m *mockCapabilityHierarchyCache) Upsert(ctx context.Context, tenantID string, labels []string) error {
+	m.tenantID = tenantID
+	m.labels = labels
+	return nil
+}
+
+func TestCapabilityHierarchyCacheProjector_StoresLabels(t *testing.T) {
+	cache := &mockCapabilityHierarchyCache{}
+	projector := NewCapabilityHierarchyCacheProjector(cache)
+
+	eventData, err := json.Marshal(capabilityHierarchyConfigUpdatedEvent{
+		TenantID: "tenant-1",
+		Labels:   []string{"Domain", "Area"},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, projector.ProjectEvent(context.Background(), "CapabilityHierarchyConfigUpdated", eventData))
+
+	assert.Equal(t, "tenant-1", cache.tenantID)
+	assert.Equal(t, []string{"Domain", "Area"}, cache.labels)
+}
+
+func TestCapabilityHierarchyCacheProjector_IgnoresOtherEvents(t *testing.T) {
+	cache := &mockCapabilityHierarchyCache{}
+	projector := NewCapabilityHierarchyCacheProjector(cache)
+
+	require.NoError(t, projector.ProjectEvent(context.Background(), "StrategyPillarAdded", []byte(`{}`)))
+
+	assert.Empty(t, cache.tenantID)
+}
@@ -0,0 +1,67 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/events"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type CapabilityOwnerStore interface {
+	Insert(ctx context.Context, assignment readmodels.CapabilityOwnerAssignment) error
+	Delete(ctx context.Context, capabilityID, partyType, partyID string) error
+	DeleteForCapability(ctx context.Context, capabilityID string) error
+}
+
+type CapabilityOwnerProjector struct {
+	readModel CapabilityOwnerStore
+}
+
+func NewCapabilityOwnerProjector(readModel CapabilityOwnerStore) *CapabilityOwnerProjector {
+	return &CapabilityOwnerProjector{readModel: readModel}
+}
+
+func (p *CapabilityOwnerProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *CapabilityOwnerProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	handlers := map[string]func(context.Context, []byte) error{
+		"CapabilityOwnerAssigned":   projectionHandler(p.projectOwnerAssigned),
+		"CapabilityOwnerUnassigned": projectionHandler(p.projectOwnerUnassigned),
+		"CapabilityDeleted":         projectionHandler(p.projectCapabilityDeleted),
+	}
+
+	if handler, exists := handlers[eventType]; exists {
+		return handler(ctx, eventData)
+	}
+	return nil
+}
+
+func (p *CapabilityOwnerProjector) projectOwnerAssigned(ctx context.Context, event events.CapabilityOwnerAssigned) error {
+	return p.readModel.Insert(ctx, readmodels.CapabilityOwnerAssignment{
+		CapabilityID: event.CapabilityID,
+		PartyType:    event.PartyType,
+		PartyID:      event.PartyID,
+		Role:         event.Role,
+		AssignedAt:   event.AssignedAt,
+	})
+}
+
+func (p *CapabilityOwnerProjector) projectOwnerUnassigned(ctx context.Context, event events.CapabilityOwnerUnassigned) error {
+	return p.readModel.Delete(ctx, event.CapabilityID, event.PartyType, event.PartyID)
+}
+
+func (p *CapabilityOwnerProjector) projectCapabilityDeleted(ctx context.Context, event events.CapabilityDeleted) error {
+	return p.readModel.DeleteForCapability(ctx, event.ID)
+}
@@ -47,6 +47,8 @@ func (p *CapabilityProjector) ProjectEvent(ctx context.Context, eventType string
 		"CapabilityExpertAdded":     projectionHandler(p.projectExpertAdded),
 		"CapabilityExpertRemoved":   projectionHandler(p.projectExpertRemoved),
 		"CapabilityTagAdded":        projectionHandler(p.projectTagAdded),
+		"CapabilityTagRemoved":      projectionHandler(p.projectTagRemoved),
+		"CapabilityTagRenamed":      projectionHandler(p.projectTagRenamed),
 		"CapabilityParentChanged":   projectionHandler(p.projectParentChanged),
 		"CapabilityLevelChanged":    projectionHandler(p.projectLevelChanged),
 		"CapabilityDeleted":         projectionHandler(p.projectDeleted),
@@ -156,6 +158,19 @@ func (p *CapabilityProjector) projectTagAdded(ctx context.Context, event events.
 	})
 }
 
+func (p *CapabilityProjector) projectTagRemoved(ctx context.Context, event events.CapabilityTagRemoved) error {
+	return p.readModel.RemoveTag(ctx, event.CapabilityID, event.Tag)
+}
+
+func (p *CapabilityProjector) projectTagRenamed(ctx context.Context, event events.CapabilityTagRenamed) error {
+	return p.readModel.RenameTag(ctx, readmodels.TagRename{
+		CapabilityID: event.CapabilityID,
+		From:         event.From,
+		To:           event.To,
+		RenamedAt:    event.RenamedAt,
+	})
+}
+
 func (p *CapabilityProjector) projectParentChanged(ctx context.Context, event events.CapabilityParentChanged) error {
 	return p.readModel.UpdateParent(ctx, readmodels.ParentUpdate{
 		ID:       event.CapabilityID,
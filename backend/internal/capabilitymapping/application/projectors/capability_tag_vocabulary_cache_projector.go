@@ -0,0 +1,58 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	mmPL "easi/backend/internal/metamodel/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type CapabilityTagVocabularyCacheStore interface {
+	Upsert(ctx context.Context, tenantID string, vocabulary readmodels.CapabilityTagVocabularyCache) error
+}
+
+type CapabilityTagVocabularyCacheProjector struct {
+	readModel CapabilityTagVocabularyCacheStore
+}
+
+func NewCapabilityTagVocabularyCacheProjector(readModel CapabilityTagVocabularyCacheStore) *CapabilityTagVocabularyCacheProjector {
+	return &CapabilityTagVocabularyCacheProjector{readModel: readModel}
+}
+
+func (p *CapabilityTagVocabularyCacheProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+type capabilityTagVocabularyUpdatedEvent struct {
+	TenantID   string                         `json:"tenantId"`
+	Terms      []readmodels.TagVocabularyTerm `json:"terms"`
+	Restricted bool                           `json:"restricted"`
+}
+
+func (p *CapabilityTagVocabularyCacheProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	if eventType != mmPL.CapabilityTagVocabularyUpdated {
+		return nil
+	}
+
+	var event capabilityTagVocabularyUpdatedEvent
+	if err := json.Unmarshal(eventData, &event); err != nil {
+		wrappedErr := fmt.Errorf("unmarshal CapabilityTagVocabularyUpdated event data in capability tag vocabulary cache projector: %w", err)
+		log.Printf("failed to unmarshal CapabilityTagVocabularyUpdated event: %v", wrappedErr)
+		return wrappedErr
+	}
+
+	return p.readModel.Upsert(ctx, event.TenantID, readmodels.CapabilityTagVocabularyCache{
+		Terms:      event.Terms,
+		Restricted: event.Restricted,
+	})
+}
@@ -0,0 +1,51 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCapabilityTagVocabularyCache struct {
+	tenantID   string
+	vocabulary readmodels.CapabilityTagVocabularyCache
+}
+
+func (m *mockCapabilityTagVocabularyCache) Upsert(ctx context.Context, tenantID string, vocabulary readmodels.CapabilityTagVocabularyCache) error {
+	m.tenantID = tenantID
+	m.vocabulary = vocabulary
+	return nil
+}
+
+func TestCapabilityTagVocabularyCacheProjector_StoresTerms(t *testing.T) {
+	cache := &mockCapabilityTagVocabularyCache{}
+	projector := NewCapabilityTagVocabularyCacheProjector(cache)
+
+	eventData, err := json.Marshal(map[string]interface{}{
+		"id":         "config-1",
+		"tenantId":   "tenant-1",
+		"terms":      []map[string]string{{"tag": "regulated", "category": "Compliance"}, {"tag": "core"}},
+		"restricted": true,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, projector.ProjectEvent(context.Background(), "CapabilityTagVocabularyUpdated", eventData))
+
+	assert.Equal(t, "tenant-1", cache.tenantID)
+	assert.True(t, cache.vocabulary.Restricted)
+	assert.Equal(t, []readmodels.TagVocabularyTerm{{Tag: "regulated", Category: "Compliance"}, {Tag: "core"}}, cache.vocabulary.Terms)
+}
+
+func TestCapabilityTagVocabularyCacheProjector_IgnoresOtherEvents(t *testing.T) {
+	cache := &mockCapabilityTagVocabularyCache{}
+	projector := NewCapabilityTagVocabularyCacheProjector(cache)
+
+	require.NoError(t, projector.ProjectEvent(context.Background(), "CapabilityHierarchyConfigUpdated", []byte(`{}`)))
+
+	assert.Empty(t, cache.tenantID)
+}
@@ -13,6 +13,7 @@ import (
 type ComponentCacheWriter interface {
 	Upsert(ctx context.Context, id, name string) error
 	Delete(ctx context.Context, id string) error
+	SetParent(ctx context.Context, id, parentID string) error
 }
 
 type ComponentCacheProjector struct {
@@ -41,6 +42,8 @@ func (p *ComponentCacheProjector) ProjectEvent(ctx context.Context, eventType st
 		return p.handleComponentUpdated(ctx, eventData)
 	case archPL.ApplicationComponentDeleted:
 		return p.handleComponentDeleted(ctx, eventData)
+	case archPL.ApplicationComponentParentChanged:
+		return p.handleComponentParentChanged(ctx, eventData)
 	}
 	return nil
 }
@@ -59,6 +62,11 @@ type componentDeletedEvent struct {
 	ID string `json:"id"`
 }
 
+type componentParentChangedEvent struct {
+	ID          string `json:"id"`
+	NewParentID string `json:"newParentId"`
+}
+
 func (p *ComponentCacheProjector) handleComponentCreated(ctx context.Context, eventData []byte) error {
 	var event componentCreatedEvent
 	if err := json.Unmarshal(eventData, &event); err != nil {
@@ -97,3 +105,16 @@ func (p *ComponentCacheProjector) handleComponentDeleted(ctx context.Context, ev
 	}
 	return nil
 }
+
+func (p *ComponentCacheProjector) handleComponentParentChanged(ctx context.Context, eventData []byte) error {
+	var event componentParentChangedEvent
+	if err := json.Unmarshal(eventData, &event); err != nil {
+		wrappedErr := fmt.Errorf("unmarshal ApplicationComponentParentChanged event data: %w", err)
+		log.Printf("failed to unmarshal ApplicationComponentParentChanged event: %v", wrappedErr)
+		return wrappedErr
+	}
+	if err := p.cache.SetParent(ctx, event.ID, event.NewParentID); err != nil {
+		return fmt.Errorf("project ApplicationComponentParentChanged cache parent for component %s: %w", event.ID, err)
+	}
+	return nil
+}
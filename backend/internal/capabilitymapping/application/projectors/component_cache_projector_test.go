@@ -10,8 +10,9 @@ import (
 )
 
 type mockComponentCacheWriter struct {
-	upsertCalls []struct{ id, name string }
-	deleteCalls []string
+	upsertCalls    []struct{ id, name string }
+	deleteCalls    []string
+	setParentCalls []struct{ id, parentID string }
 }
 
 func (m *mockComponentCacheWriter) Upsert(ctx context.Context, id, name string) error {
@@ -24,6 +25,11 @@ func (m *mockComponentCacheWriter) Delete(ctx context.Context, id string) error
 	return nil
 }
 
+func (m *mockComponentCacheWriter) SetParent(ctx context.Context, id, parentID string) error {
+	m.setParentCalls = append(m.setParentCalls, struct{ id, parentID string }{id, parentID})
+	return nil
+}
+
 func TestComponentCacheProjector_HandlesApplicationComponentCreated(t *testing.T) {
 	mock := &mockComponentCacheWriter{}
 	projector := NewComponentCacheProjector(mock)
@@ -76,6 +82,25 @@ func TestComponentCacheProjector_HandlesApplicationComponentDeleted(t *testing.T
 	assert.Equal(t, "comp-123", mock.deleteCalls[0])
 }
 
+func TestComponentCacheProjector_HandlesApplicationComponentParentChanged(t *testing.T) {
+	mock := &mockComponentCacheWriter{}
+	projector := NewComponentCacheProjector(mock)
+
+	eventData, err := json.Marshal(struct {
+		ID          string `json:"id"`
+		OldParentID string `json:"oldParentId"`
+		NewParentID string `json:"newParentId"`
+	}{"comp-fi", "", "comp-s4"})
+	require.NoError(t, err)
+
+	err = projector.ProjectEvent(context.Background(), "ApplicationComponentParentChanged", eventData)
+	require.NoError(t, err)
+
+	require.Len(t, mock.setParentCalls, 1)
+	assert.Equal(t, "comp-fi", mock.setParentCalls[0].id)
+	assert.Equal(t, "comp-s4", mock.setParentCalls[0].parentID)
+}
+
 func TestComponentCacheProjector_IgnoresUnknownEvents(t *testing.T) {
 	mock := &mockComponentCacheWriter{}
 	projector := NewComponentCacheProjector(mock)
@@ -0,0 +1,90 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	archPL "easi/backend/internal/architecturemodeling/publishedlanguage"
+	authPL "easi/backend/internal/auth/publishedlanguage"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const (
+	partyTypeUser = "user"
+	partyTypeTeam = "team"
+)
+
+type OwnershipPartyCacheWriter interface {
+	Upsert(ctx context.Context, party readmodels.OwnershipParty) error
+	SetActive(ctx context.Context, partyType, partyID string, active bool) error
+}
+
+// OwnershipPartyCacheProjector caches the users and internal teams that can own
+// capabilities, tracking whether each can still be assigned.
+type OwnershipPartyCacheProjector struct {
+	cache OwnershipPartyCacheWriter
+}
+
+func NewOwnershipPartyCacheProjector(cache OwnershipPartyCacheWriter) *OwnershipPartyCacheProjector {
+	return &OwnershipPartyCacheProjector{cache: cache}
+}
+
+func (p *OwnershipPartyCacheProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+type ownershipPartyEvent struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+	Status string `json:"status"`
+}
+
+func (p *OwnershipPartyCacheProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	var event ownershipPartyEvent
+	if err := json.Unmarshal(eventData, &event); err != nil {
+		wrappedErr := fmt.Errorf("unmarshal %s event data in ownership party cache projector: %w", eventType, err)
+		log.Printf("failed to unmarshal %s event: %v", eventType, wrappedErr)
+		return wrappedErr
+	}
+
+	var err error
+	switch eventType {
+	case authPL.UserCreated:
+		err = p.cache.Upsert(ctx, readmodels.OwnershipParty{
+			PartyType: partyTypeUser,
+			PartyID:   event.ID,
+			Name:      event.Name,
+			Email:     event.Email,
+			Active:    event.Status == "" || event.Status == "active",
+		})
+	case authPL.UserDisabled:
+		err = p.cache.SetActive(ctx, partyTypeUser, event.ID, false)
+	case authPL.UserEnabled:
+		err = p.cache.SetActive(ctx, partyTypeUser, event.ID, true)
+	case archPL.InternalTeamCreated, archPL.InternalTeamUpdated:
+		err = p.cache.Upsert(ctx, readmodels.OwnershipParty{
+			PartyType: partyTypeTeam,
+			PartyID:   event.ID,
+			Name:      event.Name,
+			Active:    true,
+		})
+	case archPL.InternalTeamDeleted:
+		err = p.cache.SetActive(ctx, partyTypeTeam, event.ID, false)
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("project %s into ownership party cache for %s: %w", eventType, event.ID, err)
+	}
+	return nil
+}
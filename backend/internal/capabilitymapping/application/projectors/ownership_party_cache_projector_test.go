@@ -0,0 +1,59 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockOwnershipPartyCache struct {
+	upserts   []readmodels.OwnershipParty
+	activeSet map[string]bool
+}
+
+func (m *mockOwnershipPartyCache) Upsert(_ context.Context, party readmodels.OwnershipParty) error {
+	m.upserts = append(m.upserts, party)
+	return nil
+}
+
+func (m *mockOwnershipPartyCache) SetActive(_ context.Context, partyType, partyID string, active bool) error {
+	if m.activeSet == nil {
+		m.activeSet = map[string]bool{}
+	}
+	m.activeSet[partyType+"/"+partyID] = active
+	return nil
+}
+
+func projectOwnershipPartyEvent(t *testing.T, cache *mockOwnershipPartyCache, eventType string, payload map[string]string) {
+	t.Helper()
+	eventData, err := json.Marshal(payload)
+	require.NoError(t, err)
+	require.NoError(t, NewOwnershipPartyCacheProjector(cache).ProjectEvent(context.Background(), eventType, eventData))
+}
+
+func TestOwnershipPartyCacheProjector_CachesUsersAndTeams(t *testing.T) {
+	cache := &mockOwnershipPartyCache{}
+
+	projectOwnershipPartyEvent(t, cache, "UserCreated", map[string]string{"id": "user-1", "name": "Alice", "email": "alice@example.com", "status": "active"})
+	projectOwnershipPartyEvent(t, cache, "InternalTeamCreated", map[string]string{"id": "team-1", "name": "CRM Team"})
+
+	assert.Equal(t, []readmodels.OwnershipParty{
+		{PartyType: "user", PartyID: "user-1", Name: "Alice", Email: "alice@example.com", Active: true},
+		{PartyType: "team", PartyID: "team-1", Name: "CRM Team", Active: true},
+	}, cache.upserts)
+}
+
+func TestOwnershipPartyCacheProjector_TracksActiveState(t *testing.T) {
+	cache := &mockOwnershipPartyCache{}
+
+	projectOwnershipPartyEvent(t, cache, "UserDisabled", map[string]string{"id": "user-1", "disabledBy": "admin-1"})
+	projectOwnershipPartyEvent(t, cache, "UserEnabled", map[string]string{"id": "user-2"})
+	projectOwnershipPartyEvent(t, cache, "InternalTeamDeleted", map[string]string{"id": "team-1"})
+
+	assert.Equal(t, map[string]bool{"user/user-1": false, "user/user-2": true, "team/team-1": false}, cache.activeSet)
+}
@@ -21,6 +21,8 @@ type RealizationProjectorReadModel interface {
 	DeleteBySourceRealizationID(ctx context.Context, sourceRealizationID string) error
 	DeleteInheritedBySourceRealizationIDAndCapabilities(ctx context.Context, deletion readmodels.InheritedRealizationDeletion) error
 	DeleteByComponentID(ctx context.Context, componentID string) error
+	ReassignComponent(ctx context.Context, reassignment readmodels.RealizationReassignment) error
+	MoveToCapability(ctx context.Context, move readmodels.RealizationMove) error
 	UpdateSourceCapabilityName(ctx context.Context, update readmodels.NameUpdate) error
 	UpdateComponentName(ctx context.Context, update readmodels.NameUpdate) error
 }
@@ -45,6 +47,8 @@ func NewRealizationProjector(
 		"SystemLinkedToCapability":          p.handleSystemLinked,
 		"SystemRealizationUpdated":          p.handleRealizationUpdated,
 		"SystemRealizationDeleted":          p.handleRealizationDeleted,
+		"SystemRealizationReassigned":       p.handleRealizationReassigned,
+		"SystemRealizationMoved":            p.handleRealizationMoved,
 		"CapabilityRealizationsInherited":   p.handleCapabilityRealizationsInherited,
 		"CapabilityRealizationsUninherited": p.handleCapabilityRealizationsUninherited,
 		"CapabilityUpdated":                 p.handleCapabilityUpdated,
@@ -140,6 +144,35 @@ func (p *RealizationProjector) handleRealizationDeleted(ctx context.Context, eve
 	return nil
 }
 
+func (p *RealizationProjector) handleRealizationReassigned(ctx context.Context, eventData []byte) error {
+	event, err := unmarshalEvent[events.SystemRealizationReassigned](eventData)
+	if err != nil {
+		return fmt.Errorf("unmarshal SystemRealizationReassigned event data: %w", err)
+	}
+	if err := p.readModel.ReassignComponent(ctx, readmodels.RealizationReassignment{
+		ID:            event.ID,
+		ComponentID:   event.ToComponentID,
+		ComponentName: event.ToComponentName,
+	}); err != nil {
+		return fmt.Errorf("project SystemRealizationReassigned for realization %s: %w", event.ID, err)
+	}
+	return nil
+}
+
+func (p *RealizationProjector) handleRealizationMoved(ctx context.Context, eventData []byte) error {
+	event, err := unmarshalEvent[events.SystemRealizationMoved](eventData)
+	if err != nil {
+		return fmt.Errorf("unmarshal SystemRealizationMoved event data: %w", err)
+	}
+	if err := p.readModel.MoveToCapability(ctx, readmodels.RealizationMove{
+		ID:           event.ID,
+		CapabilityID: event.ToCapabilityID,
+	}); err != nil {
+		return fmt.Errorf("project SystemRealizationMoved for realization %s: %w", event.ID, err)
+	}
+	return nil
+}
+
 func (p *RealizationProjector) handleCapabilityRealizationsInherited(ctx context.Context, eventData []byte) error {
 	event, err := unmarshalEvent[events.CapabilityRealizationsInherited](eventData)
 	if err != nil {
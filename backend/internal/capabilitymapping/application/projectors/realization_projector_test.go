@@ -24,6 +24,8 @@ type mockRealizationReadModel struct {
 	deletedByComponentIDs          []string
 	updatedSourceCapabilityNames   []updateSourceCapabilityNameCall
 	updatedComponentNames          []updateComponentNameCall
+	reassignments                  []readmodels.RealizationReassignment
+	moves                          []readmodels.RealizationMove
 	insertErr                      error
 	insertInheritedErr             error
 	updateErr                      error
@@ -104,6 +106,16 @@ func (m *mockRealizationReadModel) DeleteByComponentID(ctx context.Context, comp
 	return nil
 }
 
+func (m *mockRealizationReadModel) ReassignComponent(ctx context.Context, reassignment readmodels.RealizationReassignment) error {
+	m.reassignments = append(m.reassignments, reassignment)
+	return nil
+}
+
+func (m *mockRealizationReadModel) MoveToCapability(ctx context.Context, move readmodels.RealizationMove) error {
+	m.moves = append(m.moves, move)
+	return nil
+}
+
 func (m *mockRealizationReadModel) DeleteInheritedBySourceRealizationIDAndCapabilities(ctx context.Context, deletion readmodels.InheritedRealizationDeletion) error {
 	if m.deleteInheritedBySourceCapsErr != nil {
 		return m.deleteInheritedBySourceCapsErr
@@ -292,6 +304,50 @@ func TestRealizationProjector_HandleRealizationDeleted_CascadesInheritedDeletion
 	assert.Equal(t, "real-1", mockRealRM.deletedIDs[0])
 }
 
+func TestRealizationProjector_HandleRealizationReassigned_MovesToNewComponent(t *testing.T) {
+	mockRealRM := &mockRealizationReadModel{}
+	projector := newProjector(mockRealRM)
+
+	event := events.NewSystemRealizationReassigned(events.SystemRealizationReassignment{
+		ID:              "real-1",
+		CapabilityID:    "cap-1",
+		FromComponentID: "comp-dup",
+		ToComponentID:   "comp-survivor",
+		ToComponentName: "Salesforce",
+	})
+	eventData, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	err = projector.ProjectEvent(context.Background(), "SystemRealizationReassigned", eventData)
+	require.NoError(t, err)
+
+	require.Len(t, mockRealRM.reassignments, 1)
+	assert.Equal(t, readmodels.RealizationReassignment{
+		ID:            "real-1",
+		ComponentID:   "comp-survivor",
+		ComponentName: "Salesforce",
+	}, mockRealRM.reassignments[0])
+}
+
+func TestRealizationProjector_HandleRealizationMoved_MovesToNewCapability(t *testing.T) {
+	mockRealRM := &mockRealizationReadModel{}
+	projector := newProjector(mockRealRM)
+
+	event := events.NewSystemRealizationMoved(events.SystemRealizationMove{
+		ID:               "real-1",
+		ComponentID:      "comp-1",
+		FromCapabilityID: "cap-merged",
+		ToCapabilityID:   "cap-survivor",
+	})
+	eventData, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	err = projector.ProjectEvent(context.Background(), "SystemRealizationMoved", eventData)
+	require.NoError(t, err)
+
+	assert.Equal(t, []readmodels.RealizationMove{{ID: "real-1", CapabilityID: "cap-survivor"}}, mockRealRM.moves)
+}
+
 func TestRealizationProjector_HandleCapabilityUpdated_UpdatesSourceCapabilityName(t *testing.T) {
 	mockRealRM := &mockRealizationReadModel{}
 	projector := newProjector(mockRealRM)
@@ -0,0 +1,107 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/events"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type ReferenceModelStore interface {
+	Insert(ctx context.Context, dto readmodels.ReferenceModelDTO, entries []readmodels.ReferenceEntryDTO) error
+	Delete(ctx context.Context, id string) error
+}
+
+type ReferenceMappingStore interface {
+	Insert(ctx context.Context, dto readmodels.ReferenceMappingDTO) error
+	Delete(ctx context.Context, id string) error
+}
+
+type ReferenceModelProjector struct {
+	models   ReferenceModelStore
+	mappings ReferenceMappingStore
+}
+
+func NewReferenceModelProjector(models ReferenceModelStore, mappings ReferenceMappingStore) *ReferenceModelProjector {
+	return &ReferenceModelProjector{
+		models:   models,
+		mappings: mappings,
+	}
+}
+
+func (p *ReferenceModelProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *ReferenceModelProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	handlers := map[string]func(context.Context, []byte) error{
+		"ReferenceModelImported":          p.handleReferenceModelImported,
+		"ReferenceModelDeleted":           p.handleReferenceModelDeleted,
+		"CapabilityMappedToReference":     p.handleCapabilityMappedToReference,
+		"CapabilityUnmappedFromReference": p.handleCapabilityUnmappedFromReference,
+	}
+
+	if handler, exists := handlers[eventType]; exists {
+		return handler(ctx, eventData)
+	}
+	return nil
+}
+
+func (p *ReferenceModelProjector) handleReferenceModelImported(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, event events.ReferenceModelImported) error {
+		entries := make([]readmodels.ReferenceEntryDTO, len(event.Entries))
+		for i, entry := range event.Entries {
+			entries[i] = readmodels.ReferenceEntryDTO{
+				Code:        entry.Code,
+				Name:        entry.Name,
+				Description: entry.Description,
+				ParentCode:  entry.ParentCode,
+				Level:       entry.Level,
+			}
+		}
+		return p.models.Insert(ctx, readmodels.ReferenceModelDTO{
+			ID:          event.ID,
+			Name:        event.Name,
+			Framework:   event.Framework,
+			Version:     event.Version,
+			Description: event.Description,
+			ImportedBy:  event.ImportedBy,
+			ImportedAt:  event.ImportedAt,
+		}, entries)
+	})
+}
+
+func (p *ReferenceModelProjector) handleReferenceModelDeleted(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, event events.ReferenceModelDeleted) error {
+		return p.models.Delete(ctx, event.ID)
+	})
+}
+
+func (p *ReferenceModelProjector) handleCapabilityMappedToReference(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, event events.CapabilityMappedToReference) error {
+		return p.mappings.Insert(ctx, readmodels.ReferenceMappingDTO{
+			ID:               event.ID,
+			CapabilityID:     event.CapabilityID,
+			ReferenceModelID: event.ReferenceModelID,
+			EntryCode:        event.EntryCode,
+			MappedBy:         event.MappedBy,
+			MappedAt:         event.MappedAt,
+		})
+	})
+}
+
+func (p *ReferenceModelProjector) handleCapabilityUnmappedFromReference(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, event events.CapabilityUnmappedFromReference) error {
+		return p.mappings.Delete(ctx, event.ID)
+	})
+}
@@ -3,6 +3,7 @@ package readmodels
 import (
 	"context"
 	"database/sql"
+	"sort"
 	"time"
 
 	"easi/backend/internal/infrastructure/database"
@@ -23,6 +24,9 @@ type ApplicationFitScoreDTO struct {
 	ScoredBy      string      `json:"scoredBy"`
 	UpdatedAt     *time.Time  `json:"updatedAt,omitempty"`
 	Links         types.Links `json:"_links,omitempty"`
+
+	InheritedFromComponentID   string `json:"inheritedFromComponentId,omitempty"`
+	InheritedFromComponentName string `json:"inheritedFromComponentName,omitempty"`
 }
 
 type ApplicationFitScoreReadModel struct {
@@ -109,6 +113,73 @@ func (rm *ApplicationFitScoreReadModel) GetByComponentID(ctx context.Context, co
 	return rm.queryFitScoreList(ctx, query, componentID)
 }
 
+// GetByComponentIDWithInherited rolls fit scores down the component hierarchy:
+// pillars the component has not been scored on take the score of its nearest
+// scored ancestor, marked with the ancestor it was inherited from.
+func (rm *ApplicationFitScoreReadModel) GetByComponentIDWithInherited(ctx context.Context, componentID string) ([]ApplicationFitScoreDTO, error) {
+	args, err := rm.buildTenantArgs(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []ApplicationFitScoreDTO
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, inheritedFitScoresQuery, args...)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			scanner := &inheritedFitScoreScanner{row: rows}
+			dto, scanErr := rm.scanFitScoreRow(scanner)
+			if scanErr != nil {
+				return scanErr
+			}
+			if scanner.sourceID != componentID {
+				dto.InheritedFromComponentID = scanner.sourceID
+				dto.InheritedFromComponentName = scanner.sourceName
+			}
+			results = append(results, dto)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(results, func(i, j int) bool { return results[i].PillarName < results[j].PillarName })
+	return results, nil
+}
+
+const inheritedFitScoresQuery = `
+	WITH RECURSIVE lineage AS (
+		SELECT $2::text AS id, 0 AS depth
+		UNION
+		SELECT c.parent_id, l.depth + 1 FROM capabilitymapping.capability_component_cache c
+		JOIN lineage l ON c.id = l.id
+		WHERE c.tenant_id = $1 AND c.parent_id IS NOT NULL AND l.depth < 50
+	)
+	SELECT DISTINCT ON (f.pillar_id)
+		f.id, $2::text, COALESCE(self.name, f.component_name), f.pillar_id, f.pillar_name,
+		f.score, f.score_label, f.rationale, f.scored_at, f.scored_by, f.updated_at,
+		f.component_id, f.component_name
+	FROM capabilitymapping.application_fit_scores f
+	JOIN lineage l ON f.component_id = l.id
+	LEFT JOIN capabilitymapping.capability_component_cache self ON self.tenant_id = $1 AND self.id = $2
+	WHERE f.tenant_id = $1
+	ORDER BY f.pillar_id, l.depth`
+
+type inheritedFitScoreScanner struct {
+	row        fitScoreRowScanner
+	sourceID   string
+	sourceName string
+}
+
+func (s *inheritedFitScoreScanner) Scan(dest ...any) error {
+	return s.row.Scan(append(dest, &s.sourceID, &s.sourceName)...)
+}
+
 func (rm *ApplicationFitScoreReadModel) GetByComponentAndPillar(ctx context.Context, componentID, pillarID string) (*ApplicationFitScoreDTO, error) {
 	return rm.querySingleFitScore(ctx,
 		"SELECT "+fitScoreSelectColumns+" FROM capabilitymapping.application_fit_scores WHERE tenant_id = $1 AND component_id = $2 AND pillar_id = $3",
@@ -227,3 +298,41 @@ func (rm *ApplicationFitScoreReadModel) scanFitScoreRow(row fitScoreRowScanner)
 	}
 	return dto, nil
 }
+
+// ScoredComponentIDs returns the components that have at least one fit score,
+// either of their own or inherited from a parent component.
+func (rm *ApplicationFitScoreReadModel) ScoredComponentIDs(ctx context.Context) (map[string]bool, error) {
+	args, err := rm.buildTenantArgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scored := make(map[string]bool)
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `
+			WITH RECURSIVE scored AS (
+				SELECT DISTINCT component_id AS id, 0 AS depth
+				FROM capabilitymapping.application_fit_scores
+				WHERE tenant_id = $1
+				UNION
+				SELECT c.id, s.depth + 1 FROM capabilitymapping.capability_component_cache c
+				JOIN scored s ON c.parent_id = s.id
+				WHERE c.tenant_id = $1 AND s.depth < 50
+			)
+			SELECT DISTINCT id FROM scored`, args...)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			scored[id] = true
+		}
+		return rows.Err()
+	})
+	return scored, err
+}
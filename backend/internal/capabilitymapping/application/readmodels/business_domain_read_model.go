@@ -9,25 +9,31 @@ import (
 	"easi/backend/internal/infrastructure/database"
 	sharedctx "easi/backend/internal/shared/context"
 	"easi/backend/internal/shared/types"
+
+	"github.com/lib/pq"
 )
 
 type BusinessDomainDTO struct {
-	ID                string      `json:"id"`
-	Name              string      `json:"name"`
-	Description       string      `json:"description,omitempty"`
-	DomainArchitectID string      `json:"domainArchitectId,omitempty"`
-	CapabilityCount   int         `json:"capabilityCount"`
-	CreatedAt         time.Time   `json:"createdAt"`
-	UpdatedAt         *time.Time  `json:"updatedAt,omitempty"`
-	Links             types.Links `json:"_links,omitempty"`
+	ID                 string      `json:"id"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description,omitempty"`
+	ParentID           string      `json:"parentId,omitempty"`
+	DomainArchitectID  string      `json:"domainArchitectId,omitempty"`
+	DomainArchitectIDs []string    `json:"domainArchitectIds,omitempty"`
+	CapabilityCount    int         `json:"capabilityCount"`
+	CreatedAt          time.Time   `json:"createdAt"`
+	UpdatedAt          *time.Time  `json:"updatedAt,omitempty"`
+	Links              types.Links `json:"_links,omitempty"`
 }
 
 type BusinessDomainUpdate struct {
-	Name              string
-	Description       string
-	DomainArchitectID string
+	Name               string
+	Description        string
+	DomainArchitectIDs []string
 }
 
+const businessDomainColumns = "id, name, description, parent_id, domain_architect_ids, capability_count, created_at, updated_at"
+
 type BusinessDomainReadModel struct {
 	db *database.TenantAwareDB
 }
@@ -54,11 +60,6 @@ func (rm *BusinessDomainReadModel) Insert(ctx context.Context, dto BusinessDomai
 		return fmt.Errorf("resolve tenant for insert business domain %s: %w", dto.ID, err)
 	}
 
-	var domainArchitectID interface{} = nil
-	if dto.DomainArchitectID != "" {
-		domainArchitectID = dto.DomainArchitectID
-	}
-
 	_, err = rm.db.ExecContext(ctx,
 		"DELETE FROM capabilitymapping.business_domains WHERE tenant_id = $1 AND id = $2",
 		tenantID.Value(), dto.ID,
@@ -67,11 +68,17 @@ func (rm *BusinessDomainReadModel) Insert(ctx context.Context, dto BusinessDomai
 		return fmt.Errorf("delete existing business domain %s before insert: %w", dto.ID, err)
 	}
 
+	architectIDs := dto.DomainArchitectIDs
+	if len(architectIDs) == 0 && dto.DomainArchitectID != "" {
+		architectIDs = []string{dto.DomainArchitectID}
+	}
+
 	_, err = rm.db.ExecContext(ctx,
 		`INSERT INTO capabilitymapping.business_domains
-		(id, tenant_id, name, description, domain_architect_id, capability_count, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
-		dto.ID, tenantID.Value(), dto.Name, dto.Description, domainArchitectID, 0, dto.CreatedAt,
+		(id, tenant_id, name, description, parent_id, domain_architect_id, domain_architect_ids, capability_count, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		dto.ID, tenantID.Value(), dto.Name, dto.Description, toNullableString(dto.ParentID),
+		toNullableString(leadArchitect(architectIDs)), pq.Array(architectIDsOrEmpty(architectIDs)), 0, dto.CreatedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("insert business domain %s for tenant %s: %w", dto.ID, tenantID.Value(), err)
@@ -85,14 +92,9 @@ func (rm *BusinessDomainReadModel) Update(ctx context.Context, id string, update
 		return fmt.Errorf("resolve tenant for update business domain %s: %w", id, err)
 	}
 
-	var archID interface{} = nil
-	if update.DomainArchitectID != "" {
-		archID = update.DomainArchitectID
-	}
-
 	_, err = rm.db.ExecContext(ctx,
-		"UPDATE capabilitymapping.business_domains SET name = $1, description = $2, domain_architect_id = $3, updated_at = CURRENT_TIMESTAMP WHERE tenant_id = $4 AND id = $5",
-		update.Name, update.Description, archID, tenantID.Value(), id,
+		"UPDATE capabilitymapping.business_domains SET name = $1, description = $2, domain_architect_id = $3, domain_architect_ids = $4, updated_at = CURRENT_TIMESTAMP WHERE tenant_id = $5 AND id = $6",
+		update.Name, update.Description, toNullableString(leadArchitect(update.DomainArchitectIDs)), pq.Array(architectIDsOrEmpty(update.DomainArchitectIDs)), tenantID.Value(), id,
 	)
 	if err != nil {
 		return fmt.Errorf("update business domain %s for tenant %s: %w", id, tenantID.Value(), err)
@@ -100,6 +102,27 @@ func (rm *BusinessDomainReadModel) Update(ctx context.Context, id string, update
 	return nil
 }
 
+func (rm *BusinessDomainReadModel) UpdateParent(ctx context.Context, id, parentID string) error {
+	return rm.execTenantQuery(ctx,
+		"UPDATE capabilitymapping.business_domains SET parent_id = $2, updated_at = CURRENT_TIMESTAMP WHERE tenant_id = $1 AND id = $3",
+		toNullableString(parentID), id,
+	)
+}
+
+func leadArchitect(ids []string) string {
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
+func architectIDsOrEmpty(ids []string) []string {
+	if ids == nil {
+		return []string{}
+	}
+	return ids
+}
+
 func (rm *BusinessDomainReadModel) Delete(ctx context.Context, id string) error {
 	return rm.execTenantQuery(ctx, "DELETE FROM capabilitymapping.business_domains WHERE tenant_id = $1 AND id = $2", id)
 }
@@ -121,7 +144,7 @@ func (rm *BusinessDomainReadModel) GetAll(ctx context.Context) ([]BusinessDomain
 	var domains []BusinessDomainDTO
 	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
 		rows, err := tx.QueryContext(ctx,
-			"SELECT id, name, description, domain_architect_id, capability_count, created_at, updated_at FROM capabilitymapping.business_domains WHERE tenant_id = $1 ORDER BY name",
+			"SELECT "+businessDomainColumns+" FROM capabilitymapping.business_domains WHERE tenant_id = $1 ORDER BY name",
 			tenantID.Value(),
 		)
 		if err != nil {
@@ -129,15 +152,36 @@ func (rm *BusinessDomainReadModel) GetAll(ctx context.Context) ([]BusinessDomain
 		}
 		defer func() { _ = rows.Close() }()
 
-		for rows.Next() {
-			dto, err := scanBusinessDomain(rows)
-			if err != nil {
-				return fmt.Errorf("scan business domain row for tenant %s: %w", tenantID.Value(), err)
-			}
-			domains = append(domains, *dto)
+		domains, err = scanBusinessDomainRows(rows)
+		if err != nil {
+			return fmt.Errorf("read business domain rows for tenant %s: %w", tenantID.Value(), err)
 		}
-		if err := rows.Err(); err != nil {
-			return fmt.Errorf("iterate business domain rows for tenant %s: %w", tenantID.Value(), err)
+		return nil
+	})
+
+	return domains, err
+}
+
+func (rm *BusinessDomainReadModel) GetChildren(ctx context.Context, parentID string) ([]BusinessDomainDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("resolve tenant for business domain children of %s: %w", parentID, err)
+	}
+
+	var domains []BusinessDomainDTO
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			"SELECT "+businessDomainColumns+" FROM capabilitymapping.business_domains WHERE tenant_id = $1 AND parent_id = $2 ORDER BY name",
+			tenantID.Value(), parentID,
+		)
+		if err != nil {
+			return fmt.Errorf("query children of business domain %s for tenant %s: %w", parentID, tenantID.Value(), err)
+		}
+		defer func() { _ = rows.Close() }()
+
+		domains, err = scanBusinessDomainRows(rows)
+		if err != nil {
+			return fmt.Errorf("read children of business domain %s for tenant %s: %w", parentID, tenantID.Value(), err)
 		}
 		return nil
 	})
@@ -145,6 +189,59 @@ func (rm *BusinessDomainReadModel) GetAll(ctx context.Context) ([]BusinessDomain
 	return domains, err
 }
 
+// GetDescendantIDs returns the IDs of every domain nested below rootID, at any depth.
+func (rm *BusinessDomainReadModel) GetDescendantIDs(ctx context.Context, rootID string) ([]string, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("resolve tenant for business domain descendants of %s: %w", rootID, err)
+	}
+
+	var ids []string
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `
+			WITH RECURSIVE descendants AS (
+				SELECT id, ARRAY[id] AS path
+				FROM capabilitymapping.business_domains
+				WHERE tenant_id = $1 AND parent_id = $2
+				UNION ALL
+				SELECT d.id, ds.path || d.id
+				FROM capabilitymapping.business_domains d
+				JOIN descendants ds ON d.parent_id = ds.id
+				WHERE d.tenant_id = $1 AND NOT d.id = ANY(ds.path)
+			)
+			SELECT DISTINCT id FROM descendants`,
+			tenantID.Value(), rootID,
+		)
+		if err != nil {
+			return fmt.Errorf("query descendants of business domain %s for tenant %s: %w", rootID, tenantID.Value(), err)
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return fmt.Errorf("scan descendant of business domain %s: %w", rootID, err)
+			}
+			ids = append(ids, id)
+		}
+		return rows.Err()
+	})
+
+	return ids, err
+}
+
+func scanBusinessDomainRows(rows *sql.Rows) ([]BusinessDomainDTO, error) {
+	var domains []BusinessDomainDTO
+	for rows.Next() {
+		dto, err := scanBusinessDomain(rows)
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, *dto)
+	}
+	return domains, rows.Err()
+}
+
 type scanner interface {
 	Scan(dest ...interface{}) error
 }
@@ -152,17 +249,22 @@ type scanner interface {
 func scanBusinessDomain(s scanner) (*BusinessDomainDTO, error) {
 	var dto BusinessDomainDTO
 	var updatedAt sql.NullTime
-	var domainArchitectID sql.NullString
+	var parentID sql.NullString
+	var architectIDs pq.StringArray
 
-	if err := s.Scan(&dto.ID, &dto.Name, &dto.Description, &domainArchitectID, &dto.CapabilityCount, &dto.CreatedAt, &updatedAt); err != nil {
+	if err := s.Scan(&dto.ID, &dto.Name, &dto.Description, &parentID, &architectIDs, &dto.CapabilityCount, &dto.CreatedAt, &updatedAt); err != nil {
 		return nil, err
 	}
 
 	if updatedAt.Valid {
 		dto.UpdatedAt = &updatedAt.Time
 	}
-	if domainArchitectID.Valid {
-		dto.DomainArchitectID = domainArchitectID.String
+	if parentID.Valid {
+		dto.ParentID = parentID.String
+	}
+	if len(architectIDs) > 0 {
+		dto.DomainArchitectIDs = []string(architectIDs)
+		dto.DomainArchitectID = architectIDs[0]
 	}
 
 	return &dto, nil
@@ -177,7 +279,7 @@ func (rm *BusinessDomainReadModel) getByCondition(ctx context.Context, whereClau
 	var dto *BusinessDomainDTO
 	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
 		row := tx.QueryRowContext(ctx,
-			"SELECT id, name, description, domain_architect_id, capability_count, created_at, updated_at FROM capabilitymapping.business_domains WHERE tenant_id = $1 AND "+whereClause,
+			"SELECT "+businessDomainColumns+" FROM capabilitymapping.business_domains WHERE tenant_id = $1 AND "+whereClause,
 			tenantID.Value(), arg,
 		)
 
@@ -0,0 +1,106 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+type CapabilityHeatmapReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewCapabilityHeatmapReadModel(db *database.TenantAwareDB) *CapabilityHeatmapReadModel {
+	return &CapabilityHeatmapReadModel{db: db}
+}
+
+// ImportanceByCapability returns the effective importance for a pillar per capability.
+// Without a business domain the highest importance across all domains is used.
+func (rm *CapabilityHeatmapReadModel) ImportanceByCapability(ctx context.Context, pillarID, businessDomainID string) (map[string]float64, error) {
+	return rm.queryCapabilityValues(ctx,
+		`SELECT capability_id, MAX(effective_importance)::float8
+		FROM capabilitymapping.effective_capability_importance
+		WHERE tenant_id = $1 AND pillar_id = $2 AND ($3 = '' OR business_domain_id = $3)
+		GROUP BY capability_id`,
+		pillarID, businessDomainID,
+	)
+}
+
+// AverageFitByCapability returns the average fit score of the applications that
+// directly realize each capability. Without a pillar all pillars are averaged.
+func (rm *CapabilityHeatmapReadModel) AverageFitByCapability(ctx context.Context, pillarID string) (map[string]float64, error) {
+	return rm.queryCapabilityValues(ctx,
+		`SELECT r.capability_id, AVG(afs.score)::float8
+		FROM capabilitymapping.capability_realizations r
+		JOIN capabilitymapping.application_fit_scores afs
+			ON afs.tenant_id = r.tenant_id AND afs.component_id = r.component_id
+		WHERE r.tenant_id = $1 AND r.origin = 'Direct' AND ($2 = '' OR afs.pillar_id = $2)
+		GROUP BY r.capability_id`,
+		pillarID,
+	)
+}
+
+// DirectComponentsByCapability returns the application components directly realizing each capability.
+func (rm *CapabilityHeatmapReadModel) DirectComponentsByCapability(ctx context.Context) (map[string][]string, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]string)
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT capability_id, component_id FROM capabilitymapping.capability_realizations
+			WHERE tenant_id = $1 AND origin = 'Direct'`,
+			tenantID.Value(),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var capabilityID, componentID string
+			if err := rows.Scan(&capabilityID, &componentID); err != nil {
+				return err
+			}
+			result[capabilityID] = append(result[capabilityID], componentID)
+		}
+		return rows.Err()
+	})
+	return result, err
+}
+
+func (rm *CapabilityHeatmapReadModel) queryCapabilityValues(ctx context.Context, query string, params ...string) (map[string]float64, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []any{tenantID.Value()}
+	for _, p := range params {
+		args = append(args, p)
+	}
+
+	result := make(map[string]float64)
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var capabilityID string
+			var value float64
+			if err := rows.Scan(&capabilityID, &value); err != nil {
+				return err
+			}
+			result[capabilityID] = value
+		}
+		return rows.Err()
+	})
+	return result, err
+}
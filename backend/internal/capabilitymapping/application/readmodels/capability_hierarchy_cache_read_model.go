@@ -0,0 +1,50 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	"easi/backend/internal/infrastructure/database"
+)
+
+type CapabilityHierarchyCacheReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewCapabilityHierarchyCacheReadModel(db *database.TenantAwareDB) *CapabilityHierarchyCacheReadModel {
+	return &CapabilityHierarchyCacheReadModel{db: db}
+}
+
+func (rm *CapabilityHierarchyCacheReadModel) Upsert(ctx context.Context, tenantID string, labels []string) error {
+	query := `
+		INSERT INTO capabilitymapping.cm_capability_hierarchy_cache (tenant_id, level_labels)
+		VALUES ($1, $2)
+		ON CONFLICT (tenant_id)
+		DO UPDATE SET level_labels = EXCLUDED.level_labels
+	`
+
+	_, err := rm.db.ExecContext(ctx, query, tenantID, pq.Array(labels))
+	return err
+}
+
+// GetLabels returns the tenant's capability level labels, or nil when the
+// tenant still uses the default hierarchy.
+func (rm *CapabilityHierarchyCacheReadModel) GetLabels(ctx context.Context) ([]string, error) {
+	query := `SELECT level_labels FROM capabilitymapping.cm_capability_hierarchy_cache`
+
+	var labels []string
+	err := rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, query).Scan(pq.Array(&labels))
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
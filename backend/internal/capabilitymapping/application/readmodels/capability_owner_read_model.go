@@ -0,0 +1,160 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/types"
+)
+
+type CapabilityOwnerDTO struct {
+	PartyType  string      `json:"partyType"`
+	PartyID    string      `json:"partyId"`
+	Name       string      `json:"name,omitempty"`
+	Email      string      `json:"email,omitempty"`
+	Active     bool        `json:"active"`
+	Role       string      `json:"role"`
+	AssignedAt time.Time   `json:"assignedAt"`
+	Links      types.Links `json:"_links,omitempty"`
+}
+
+type OwnedCapabilityDTO struct {
+	CapabilityID   string      `json:"capabilityId"`
+	CapabilityName string      `json:"capabilityName"`
+	Level          string      `json:"level"`
+	Role           string      `json:"role"`
+	AssignedAt     time.Time   `json:"assignedAt"`
+	Links          types.Links `json:"_links,omitempty"`
+}
+
+type CapabilityOwnerAssignment struct {
+	CapabilityID string
+	PartyType    string
+	PartyID      string
+	Role         string
+	AssignedAt   time.Time
+}
+
+type CapabilityOwnerReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewCapabilityOwnerReadModel(db *database.TenantAwareDB) *CapabilityOwnerReadModel {
+	return &CapabilityOwnerReadModel{db: db}
+}
+
+func (rm *CapabilityOwnerReadModel) execWithTenant(ctx context.Context, query string, buildArgs func(tid string) []any) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = rm.db.ExecContext(ctx, query, buildArgs(tenantID.Value())...)
+	return err
+}
+
+func (rm *CapabilityOwnerReadModel) Insert(ctx context.Context, assignment CapabilityOwnerAssignment) error {
+	return rm.execWithTenant(ctx, `
+		INSERT INTO capabilitymapping.capability_owners (tenant_id, capability_id, party_type, party_id, role, assigned_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (tenant_id, capability_id, party_type, party_id)
+		DO UPDATE SET role = EXCLUDED.role, assigned_at = EXCLUDED.assigned_at
+	`, func(tid string) []any {
+		return []any{tid, assignment.CapabilityID, assignment.PartyType, assignment.PartyID, assignment.Role, assignment.AssignedAt}
+	})
+}
+
+func (rm *CapabilityOwnerReadModel) Delete(ctx context.Context, capabilityID, partyType, partyID string) error {
+	return rm.execWithTenant(ctx,
+		"DELETE FROM capabilitymapping.capability_owners WHERE tenant_id = $1 AND capability_id = $2 AND party_type = $3 AND party_id = $4",
+		func(tid string) []any { return []any{tid, capabilityID, partyType, partyID} },
+	)
+}
+
+func (rm *CapabilityOwnerReadModel) DeleteForCapability(ctx context.Context, capabilityID string) error {
+	return rm.execWithTenant(ctx,
+		"DELETE FROM capabilitymapping.capability_owners WHERE tenant_id = $1 AND capability_id = $2",
+		func(tid string) []any { return []any{tid, capabilityID} },
+	)
+}
+
+func (rm *CapabilityOwnerReadModel) GetForCapability(ctx context.Context, capabilityID string) ([]CapabilityOwnerDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make([]CapabilityOwnerDTO, 0)
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `
+			SELECT o.party_type, o.party_id, COALESCE(p.name, ''), COALESCE(p.email, ''), COALESCE(p.active, false), o.role, o.assigned_at
+			FROM capabilitymapping.capability_owners o
+			LEFT JOIN capabilitymapping.cm_ownership_party_cache p
+				ON p.tenant_id = o.tenant_id AND p.party_type = o.party_type AND p.party_id = o.party_id
+			WHERE o.tenant_id = $1 AND o.capability_id = $2
+			ORDER BY CASE o.role WHEN 'Accountable' THEN 1 WHEN 'Responsible' THEN 2 WHEN 'Consulted' THEN 3 ELSE 4 END, p.name
+		`, tenantID.Value(), capabilityID)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto CapabilityOwnerDTO
+			if err := rows.Scan(&dto.PartyType, &dto.PartyID, &dto.Name, &dto.Email, &dto.Active, &dto.Role, &dto.AssignedAt); err != nil {
+				return err
+			}
+			owners = append(owners, dto)
+		}
+		return rows.Err()
+	})
+	return owners, err
+}
+
+// GetCapabilitiesForParty lists the capabilities a party holds a role on,
+// optionally narrowed to a single role.
+func (rm *CapabilityOwnerReadModel) GetCapabilitiesForParty(ctx context.Context, partyType, partyID, role string) ([]OwnedCapabilityDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owned := make([]OwnedCapabilityDTO, 0)
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `
+			SELECT o.capability_id, c.name, c.level, o.role, o.assigned_at
+			FROM capabilitymapping.capability_owners o
+			JOIN capabilitymapping.capabilities c ON c.tenant_id = o.tenant_id AND c.id = o.capability_id
+			WHERE o.tenant_id = $1 AND o.party_type = $2 AND o.party_id = $3 AND ($4 = '' OR o.role = $4)
+			ORDER BY c.level, c.name
+		`, tenantID.Value(), partyType, partyID, role)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto OwnedCapabilityDTO
+			if err := rows.Scan(&dto.CapabilityID, &dto.CapabilityName, &dto.Level, &dto.Role, &dto.AssignedAt); err != nil {
+				return err
+			}
+			owned = append(owned, dto)
+		}
+		return rows.Err()
+	})
+	return owned, err
+}
+
+func (rm *CapabilityOwnerReadModel) GetCapabilityIDsForParty(ctx context.Context, partyType, partyID string) ([]string, error) {
+	owned, err := rm.GetCapabilitiesForParty(ctx, partyType, partyID, "")
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(owned))
+	for i, capability := range owned {
+		ids[i] = capability.CapabilityID
+	}
+	return ids, nil
+}
@@ -25,18 +25,21 @@ type MaturityRange struct {
 }
 
 type CapabilityDTO struct {
-	ID               string              `json:"id"`
-	Name             string              `json:"name"`
-	Description      string              `json:"description,omitempty"`
-	ParentID         string              `json:"parentId,omitempty"`
-	Level            string              `json:"level"`
-	MaturityValue    int                 `json:"maturityValue"`
-	MaturitySection  *MaturitySectionDTO `json:"maturitySection,omitempty"`
-	OwnershipModel   string              `json:"ownershipModel,omitempty"`
-	PrimaryOwner     string              `json:"primaryOwner,omitempty"`
-	EAOwner          string              `json:"eaOwner,omitempty"`
-	Status           string              `json:"status,omitempty"`
-	Experts          []ExpertDTO         `json:"experts,omitempty"`
+	ID              string              `json:"id"`
+	Name            string              `json:"name"`
+	Description     string              `json:"description,omitempty"`
+	ParentID        string              `json:"parentId,omitempty"`
+	Level           string              `json:"level"`
+	MaturityValue   int                 `json:"maturityValue"`
+	MaturitySection *MaturitySectionDTO `json:"maturitySection,omitempty"`
+	OwnershipModel  string              `json:"ownershipModel,omitempty"`
+	// Legacy free text, no longer written. Owners are listed under x-owners.
+	PrimaryOwner string `json:"primaryOwner,omitempty" extensions:"x-deprecated=true"`
+	// Legacy free text, no longer written. Owners are listed under x-owners.
+	EAOwner string `json:"eaOwner,omitempty" extensions:"x-deprecated=true"`
+	Status  string `json:"status,omitempty"`
+	// Legacy free text, no longer written. Experts are Consulted owners under x-owners.
+	Experts          []ExpertDTO         `json:"experts,omitempty" extensions:"x-deprecated=true"`
 	Tags             []string            `json:"tags,omitempty"`
 	CreatedAt        time.Time           `json:"createdAt"`
 	OnePagerComplete *bool               `json:"onePagerComplete,omitempty"`
@@ -198,6 +201,98 @@ func (rm *CapabilityReadModel) AddTag(ctx context.Context, info TagInfo) error {
 	)
 }
 
+func (rm *CapabilityReadModel) RemoveTag(ctx context.Context, capabilityID, tag string) error {
+	return rm.execWithTenant(ctx,
+		"DELETE FROM capabilitymapping.capability_tags WHERE tenant_id = $1 AND capability_id = $2 AND tag = $3",
+		func(tid string) []any { return []any{tid, capabilityID, tag} },
+	)
+}
+
+type TagRename struct {
+	CapabilityID string
+	From         string
+	To           string
+	RenamedAt    time.Time
+}
+
+func (rm *CapabilityReadModel) RenameTag(ctx context.Context, rename TagRename) error {
+	return rm.execWithTenant(ctx,
+		`WITH removed AS (
+			DELETE FROM capabilitymapping.capability_tags WHERE tenant_id = $1 AND capability_id = $2 AND tag = $3
+		)
+		INSERT INTO capabilitymapping.capability_tags (capability_id, tenant_id, tag, added_at)
+		VALUES ($2, $1, $4, $5)
+		ON CONFLICT (tenant_id, capability_id, tag) DO NOTHING`,
+		func(tid string) []any {
+			return []any{tid, rename.CapabilityID, rename.From, rename.To, rename.RenamedAt}
+		},
+	)
+}
+
+type TagUsage struct {
+	Tag             string
+	CapabilityCount int
+}
+
+// GetTagUsage returns every tag in use with the number of capabilities carrying it.
+func (rm *CapabilityReadModel) GetTagUsage(ctx context.Context) ([]TagUsage, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var usage []TagUsage
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			"SELECT tag, COUNT(*) FROM capabilitymapping.capability_tags WHERE tenant_id = $1 GROUP BY tag ORDER BY tag",
+			tenantID.Value(),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var u TagUsage
+			if err := rows.Scan(&u.Tag, &u.CapabilityCount); err != nil {
+				return err
+			}
+			usage = append(usage, u)
+		}
+		return rows.Err()
+	})
+	return usage, err
+}
+
+func (rm *CapabilityReadModel) GetCapabilityIDsWithTag(ctx context.Context, tag string) ([]string, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			"SELECT capability_id FROM capabilitymapping.capability_tags WHERE tenant_id = $1 AND tag = $2 ORDER BY capability_id",
+			tenantID.Value(), tag,
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		return rows.Err()
+	})
+	return ids, err
+}
+
 type CapabilityUpdate struct {
 	ID          string
 	Name        string
@@ -423,6 +518,24 @@ func (rm *CapabilityReadModel) Count(ctx context.Context) (int, error) {
 	return count, err
 }
 
+// DeepestLevel returns the numeric level of the deepest capability, or 0 when
+// the tenant has no capabilities. Levels are stored as "L1" to "L6".
+func (rm *CapabilityReadModel) DeepestLevel(ctx context.Context) (int, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var level int
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx,
+			"SELECT COALESCE(MAX(CAST(SUBSTRING(level FROM 2) AS INTEGER)), 0) FROM capabilitymapping.capabilities WHERE tenant_id = $1",
+			tenantID.Value(),
+		).Scan(&level)
+	})
+	return level, err
+}
+
 func (rm *CapabilityReadModel) queryForTenant(ctx context.Context, query string, extraArgs ...any) ([]CapabilityDTO, error) {
 	tenantID, err := sharedctx.GetTenant(ctx)
 	if err != nil {
@@ -0,0 +1,70 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"easi/backend/internal/infrastructure/database"
+)
+
+type TagVocabularyTerm struct {
+	Tag      string `json:"tag"`
+	Category string `json:"category,omitempty"`
+}
+
+type CapabilityTagVocabularyCache struct {
+	Terms      []TagVocabularyTerm
+	Restricted bool
+}
+
+type CapabilityTagVocabularyCacheReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewCapabilityTagVocabularyCacheReadModel(db *database.TenantAwareDB) *CapabilityTagVocabularyCacheReadModel {
+	return &CapabilityTagVocabularyCacheReadModel{db: db}
+}
+
+func (rm *CapabilityTagVocabularyCacheReadModel) Upsert(ctx context.Context, tenantID string, vocabulary CapabilityTagVocabularyCache) error {
+	terms, err := json.Marshal(vocabulary.Terms)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO capabilitymapping.cm_capability_tag_vocabulary_cache (tenant_id, terms, restricted)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (tenant_id)
+		DO UPDATE SET terms = EXCLUDED.terms, restricted = EXCLUDED.restricted
+	`
+
+	_, err = rm.db.ExecContext(ctx, query, tenantID, terms, vocabulary.Restricted)
+	return err
+}
+
+// Get returns the tenant's capability tag vocabulary, or nil when the tenant
+// has not defined one.
+func (rm *CapabilityTagVocabularyCacheReadModel) Get(ctx context.Context) (*CapabilityTagVocabularyCache, error) {
+	query := `SELECT terms, restricted FROM capabilitymapping.cm_capability_tag_vocabulary_cache`
+
+	var terms []byte
+	var vocabulary CapabilityTagVocabularyCache
+	found := false
+	err := rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, query).Scan(&terms, &vocabulary.Restricted)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		found = err == nil
+		return err
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(terms, &vocabulary.Terms); err != nil {
+		return nil, err
+	}
+	return &vocabulary, nil
+}
@@ -98,6 +98,39 @@ func (rm *CMEffectiveBusinessDomainReadModel) GetByCapabilityID(ctx context.Cont
 	return &dto, nil
 }
 
+func (rm *CMEffectiveBusinessDomainReadModel) GetAll(ctx context.Context) ([]CMEffectiveBusinessDomainDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dtos []CMEffectiveBusinessDomainDTO
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT capability_id, business_domain_id, business_domain_name, l1_capability_id
+			 FROM capabilitymapping.cm_effective_business_domain WHERE tenant_id = $1`,
+			tenantID.Value(),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto CMEffectiveBusinessDomainDTO
+			var businessDomainID, businessDomainName sql.NullString
+			if err := rows.Scan(&dto.CapabilityID, &businessDomainID, &businessDomainName, &dto.L1CapabilityID); err != nil {
+				return err
+			}
+			dto.BusinessDomainID = businessDomainID.String
+			dto.BusinessDomainName = businessDomainName.String
+			dtos = append(dtos, dto)
+		}
+		return rows.Err()
+	})
+	return dtos, err
+}
+
 func (rm *CMEffectiveBusinessDomainReadModel) UpdateBusinessDomainForL1Subtree(ctx context.Context, l1CapabilityID string, bdID string, bdName string) error {
 	tenantID, err := sharedctx.GetTenant(ctx)
 	if err != nil {
@@ -75,3 +75,55 @@ func (rm *ComponentCacheReadModel) Delete(ctx context.Context, id string) error
 	)
 	return err
 }
+
+func (rm *ComponentCacheReadModel) SetParent(ctx context.Context, id, parentID string) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"UPDATE capabilitymapping.capability_component_cache SET parent_id = NULLIF($3, '') WHERE tenant_id = $1 AND id = $2",
+		tenantID.Value(), id, parentID,
+	)
+	return err
+}
+
+// GetAncestorIDs returns the component's ancestors ordered from the direct
+// parent up to the top-level component.
+func (rm *ComponentCacheReadModel) GetAncestorIDs(ctx context.Context, id string) ([]string, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ancestors []string
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `
+			WITH RECURSIVE ancestors AS (
+				SELECT parent_id AS id, 1 AS depth FROM capabilitymapping.capability_component_cache
+				WHERE tenant_id = $1 AND id = $2 AND parent_id IS NOT NULL
+				UNION
+				SELECT c.parent_id, a.depth + 1 FROM capabilitymapping.capability_component_cache c
+				JOIN ancestors a ON c.id = a.id
+				WHERE c.tenant_id = $1 AND c.parent_id IS NOT NULL AND a.depth < 50
+			)
+			SELECT id FROM ancestors ORDER BY depth
+		`, tenantID.Value(), id)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var ancestorID string
+			if err := rows.Scan(&ancestorID); err != nil {
+				return err
+			}
+			ancestors = append(ancestors, ancestorID)
+		}
+		return rows.Err()
+	})
+
+	return ancestors, err
+}
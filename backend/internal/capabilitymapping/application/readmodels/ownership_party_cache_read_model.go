@@ -0,0 +1,74 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+// OwnershipParty is a cached user or internal team that can own a capability.
+type OwnershipParty struct {
+	PartyType string
+	PartyID   string
+	Name      string
+	Email     string
+	Active    bool
+}
+
+type OwnershipPartyCacheReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewOwnershipPartyCacheReadModel(db *database.TenantAwareDB) *OwnershipPartyCacheReadModel {
+	return &OwnershipPartyCacheReadModel{db: db}
+}
+
+func (rm *OwnershipPartyCacheReadModel) Upsert(ctx context.Context, party OwnershipParty) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx, `
+		INSERT INTO capabilitymapping.cm_ownership_party_cache (tenant_id, party_type, party_id, name, email, active)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (tenant_id, party_type, party_id)
+		DO UPDATE SET name = EXCLUDED.name, email = EXCLUDED.email, active = EXCLUDED.active
+	`, tenantID.Value(), party.PartyType, party.PartyID, party.Name, party.Email, party.Active)
+	return err
+}
+
+func (rm *OwnershipPartyCacheReadModel) SetActive(ctx context.Context, partyType, partyID string, active bool) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"UPDATE capabilitymapping.cm_ownership_party_cache SET active = $4 WHERE tenant_id = $1 AND party_type = $2 AND party_id = $3",
+		tenantID.Value(), partyType, partyID, active,
+	)
+	return err
+}
+
+func (rm *OwnershipPartyCacheReadModel) IsActiveParty(ctx context.Context, partyType, partyID string) (bool, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var active bool
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx,
+			"SELECT active FROM capabilitymapping.cm_ownership_party_cache WHERE tenant_id = $1 AND party_type = $2 AND party_id = $3",
+			tenantID.Value(), partyType, partyID,
+		).Scan(&active)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	})
+	return active, err
+}
@@ -136,6 +136,24 @@ func (rm *RealizationReadModel) GetByComponentID(ctx context.Context, componentI
 	return rm.queryRealizations(ctx, query, componentID)
 }
 
+const componentSubtreeIDsQuery = `
+	WITH RECURSIVE component_tree AS (
+		SELECT $2::text AS id
+		UNION
+		SELECT c.id FROM capabilitymapping.capability_component_cache c
+		JOIN component_tree t ON c.parent_id = t.id
+		WHERE c.tenant_id = $1
+	)
+	SELECT id FROM component_tree`
+
+// GetByComponentIDIncludingSubComponents rolls realizations up the component
+// hierarchy: the component's own realizations plus those of all its descendants.
+func (rm *RealizationReadModel) GetByComponentIDIncludingSubComponents(ctx context.Context, componentID string) ([]RealizationDTO, error) {
+	query := `SELECT ` + realizationSelectColumns + ` FROM capabilitymapping.capability_realizations
+		WHERE tenant_id = $1 AND component_id IN (` + componentSubtreeIDsQuery + `) ORDER BY linked_at DESC`
+	return rm.queryRealizations(ctx, query, componentID)
+}
+
 func (rm *RealizationReadModel) GetAll(ctx context.Context) ([]RealizationDTO, error) {
 	query := `SELECT ` + realizationSelectColumns + ` FROM capabilitymapping.capability_realizations WHERE tenant_id = $1 ORDER BY linked_at DESC`
 	return rm.queryRealizations(ctx, query)
@@ -239,6 +257,89 @@ func (rm *RealizationReadModel) DeleteByComponentID(ctx context.Context, compone
 	return err
 }
 
+type RealizationReassignment struct {
+	ID            string
+	ComponentID   string
+	ComponentName string
+}
+
+// ReassignComponent moves a direct realization and the rows it propagated up the
+// capability hierarchy to another component. The direct row takes precedence over a
+// row the component had inherited on the same capability; inherited rows the component
+// already has are kept and the duplicates dropped.
+func (rm *RealizationReadModel) ReassignComponent(ctx context.Context, r RealizationReassignment) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := rm.db.ExecContext(ctx,
+		`DELETE FROM capabilitymapping.capability_realizations
+		 WHERE tenant_id = $1 AND component_id = $2 AND origin <> 'Direct'
+		   AND capability_id IN (SELECT capability_id FROM capabilitymapping.capability_realizations WHERE tenant_id = $1 AND id = $3)`,
+		tenantID.Value(), r.ComponentID, r.ID,
+	); err != nil {
+		return err
+	}
+
+	if _, err := rm.db.ExecContext(ctx,
+		`UPDATE capabilitymapping.capability_realizations cr
+		 SET component_id = $1, component_name = $2
+		 WHERE cr.tenant_id = $3 AND (cr.id = $4 OR cr.source_realization_id = $4)
+		   AND NOT EXISTS (
+		     SELECT 1 FROM capabilitymapping.capability_realizations other
+		     WHERE other.tenant_id = cr.tenant_id AND other.capability_id = cr.capability_id AND other.component_id = $1
+		   )`,
+		r.ComponentID, r.ComponentName, tenantID.Value(), r.ID,
+	); err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"DELETE FROM capabilitymapping.capability_realizations WHERE tenant_id = $1 AND source_realization_id = $2 AND component_id <> $3",
+		tenantID.Value(), r.ID, r.ComponentID,
+	)
+	return err
+}
+
+type RealizationMove struct {
+	ID           string
+	CapabilityID string
+}
+
+// MoveToCapability moves a direct realization to another capability. The rows it
+// propagated up the old hierarchy are dropped and, on the target, the direct row
+// replaces any row the component had inherited there; the new ancestors are
+// populated by the inheritance event that follows the move.
+func (rm *RealizationReadModel) MoveToCapability(ctx context.Context, move RealizationMove) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := rm.db.ExecContext(ctx,
+		"DELETE FROM capabilitymapping.capability_realizations WHERE tenant_id = $1 AND source_realization_id = $2",
+		tenantID.Value(), move.ID,
+	); err != nil {
+		return err
+	}
+
+	if _, err := rm.db.ExecContext(ctx,
+		`DELETE FROM capabilitymapping.capability_realizations
+		 WHERE tenant_id = $1 AND capability_id = $2 AND origin <> 'Direct'
+		   AND component_id IN (SELECT component_id FROM capabilitymapping.capability_realizations WHERE tenant_id = $1 AND id = $3)`,
+		tenantID.Value(), move.CapabilityID, move.ID,
+	); err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"UPDATE capabilitymapping.capability_realizations SET capability_id = $1, updated_at = CURRENT_TIMESTAMP WHERE tenant_id = $2 AND id = $3",
+		move.CapabilityID, tenantID.Value(), move.ID,
+	)
+	return err
+}
+
 type InheritedRealizationDeletion struct {
 	SourceRealizationID string
 	CapabilityIDs       []string
@@ -0,0 +1,131 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/types"
+)
+
+type ReferenceMappingDTO struct {
+	ID                 string      `json:"id"`
+	CapabilityID       string      `json:"capabilityId"`
+	CapabilityName     string      `json:"capabilityName,omitempty"`
+	ReferenceModelID   string      `json:"referenceModelId"`
+	ReferenceModelName string      `json:"referenceModelName,omitempty"`
+	Framework          string      `json:"framework,omitempty"`
+	EntryCode          string      `json:"entryCode"`
+	EntryName          string      `json:"entryName,omitempty"`
+	MappedBy           string      `json:"mappedBy,omitempty"`
+	MappedAt           time.Time   `json:"mappedAt"`
+	Links              types.Links `json:"_links,omitempty"`
+}
+
+// Names are joined at read time rather than copied into the mapping rows, so
+// capability renames need no projection. Left joins keep a mapping listed while
+// its capability or model is being deleted and the cascade has not yet run.
+const referenceMappingSelect = `
+	SELECT m.id, m.capability_id, COALESCE(c.name, ''), m.model_id, COALESCE(rmod.name, ''), COALESCE(rmod.framework, ''),
+		m.entry_code, COALESCE(e.name, ''), m.mapped_by, m.mapped_at
+	FROM capabilitymapping.capability_reference_mappings m
+	LEFT JOIN capabilitymapping.capabilities c ON c.tenant_id = m.tenant_id AND c.id = m.capability_id
+	LEFT JOIN capabilitymapping.reference_models rmod ON rmod.tenant_id = m.tenant_id AND rmod.id = m.model_id
+	LEFT JOIN capabilitymapping.reference_model_entries e
+		ON e.tenant_id = m.tenant_id AND e.model_id = m.model_id AND e.code = m.entry_code`
+
+type ReferenceMappingReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewReferenceMappingReadModel(db *database.TenantAwareDB) *ReferenceMappingReadModel {
+	return &ReferenceMappingReadModel{db: db}
+}
+
+func (rm *ReferenceMappingReadModel) execTenantQuery(ctx context.Context, query string, args ...interface{}) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return fmt.Errorf("resolve tenant for reference mapping mutation: %w", err)
+	}
+	_, err = rm.db.ExecContext(ctx, query, append([]interface{}{tenantID.Value()}, args...)...)
+	if err != nil {
+		return fmt.Errorf("execute reference mapping mutation for tenant %s: %w", tenantID.Value(), err)
+	}
+	return nil
+}
+
+func (rm *ReferenceMappingReadModel) Insert(ctx context.Context, dto ReferenceMappingDTO) error {
+	return rm.execTenantQuery(ctx, `
+		INSERT INTO capabilitymapping.capability_reference_mappings (tenant_id, id, capability_id, model_id, entry_code, mapped_by, mapped_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (tenant_id, id) DO NOTHING
+	`, dto.ID, dto.CapabilityID, dto.ReferenceModelID, dto.EntryCode, dto.MappedBy, dto.MappedAt)
+}
+
+func (rm *ReferenceMappingReadModel) Delete(ctx context.Context, id string) error {
+	return rm.execTenantQuery(ctx, "DELETE FROM capabilitymapping.capability_reference_mappings WHERE tenant_id = $1 AND id = $2", id)
+}
+
+func (rm *ReferenceMappingReadModel) GetByID(ctx context.Context, id string) (*ReferenceMappingDTO, error) {
+	mappings, err := rm.query(ctx, referenceMappingSelect+" WHERE m.tenant_id = $1 AND m.id = $2", id)
+	if err != nil || len(mappings) == 0 {
+		return nil, err
+	}
+	return &mappings[0], nil
+}
+
+func (rm *ReferenceMappingReadModel) GetByCapabilityID(ctx context.Context, capabilityID string) ([]ReferenceMappingDTO, error) {
+	return rm.query(ctx, referenceMappingSelect+" WHERE m.tenant_id = $1 AND m.capability_id = $2 ORDER BY rmod.framework, rmod.name, m.entry_code", capabilityID)
+}
+
+func (rm *ReferenceMappingReadModel) GetByModelID(ctx context.Context, modelID string) ([]ReferenceMappingDTO, error) {
+	return rm.query(ctx, referenceMappingSelect+" WHERE m.tenant_id = $1 AND m.model_id = $2 ORDER BY m.entry_code, c.name", modelID)
+}
+
+func (rm *ReferenceMappingReadModel) MappingExists(ctx context.Context, capabilityID, modelID, entryCode string) (bool, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var exists bool
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM capabilitymapping.capability_reference_mappings
+				WHERE tenant_id = $1 AND capability_id = $2 AND model_id = $3 AND entry_code = $4
+			)
+		`, tenantID.Value(), capabilityID, modelID, entryCode).Scan(&exists)
+	})
+	return exists, err
+}
+
+func (rm *ReferenceMappingReadModel) query(ctx context.Context, query string, arg string) ([]ReferenceMappingDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings := make([]ReferenceMappingDTO, 0)
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, tenantID.Value(), arg)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto ReferenceMappingDTO
+			if err := rows.Scan(&dto.ID, &dto.CapabilityID, &dto.CapabilityName, &dto.ReferenceModelID, &dto.ReferenceModelName,
+				&dto.Framework, &dto.EntryCode, &dto.EntryName, &dto.MappedBy, &dto.MappedAt); err != nil {
+				return err
+			}
+			mappings = append(mappings, dto)
+		}
+		return rows.Err()
+	})
+	return mappings, err
+}
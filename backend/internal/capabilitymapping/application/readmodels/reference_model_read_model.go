@@ -0,0 +1,226 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/types"
+)
+
+type ReferenceModelDTO struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Framework   string      `json:"framework"`
+	Version     string      `json:"version,omitempty"`
+	Description string      `json:"description,omitempty"`
+	EntryCount  int         `json:"entryCount"`
+	ImportedBy  string      `json:"importedBy,omitempty"`
+	ImportedAt  time.Time   `json:"importedAt"`
+	Links       types.Links `json:"_links,omitempty"`
+}
+
+type ReferenceEntryDTO struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	ParentCode  string `json:"parentCode,omitempty"`
+	Level       int    `json:"level"`
+}
+
+const referenceModelColumns = "id, name, framework, version, description, entry_count, imported_by, imported_at"
+
+type ReferenceModelReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewReferenceModelReadModel(db *database.TenantAwareDB) *ReferenceModelReadModel {
+	return &ReferenceModelReadModel{db: db}
+}
+
+// Insert stores the model together with its catalogue in one transaction, so a
+// model is never visible with only part of its entries.
+func (rm *ReferenceModelReadModel) Insert(ctx context.Context, dto ReferenceModelDTO, entries []ReferenceEntryDTO) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return fmt.Errorf("resolve tenant for insert reference model %s: %w", dto.ID, err)
+	}
+
+	tx, err := rm.db.BeginTxWithTenant(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO capabilitymapping.reference_models (tenant_id, id, name, framework, version, description, entry_count, imported_by, imported_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (tenant_id, id) DO NOTHING
+	`, tenantID.Value(), dto.ID, dto.Name, dto.Framework, dto.Version, dto.Description, len(entries), dto.ImportedBy, dto.ImportedAt)
+	if err != nil {
+		return fmt.Errorf("insert reference model %s: %w", dto.ID, err)
+	}
+
+	for position, entry := range entries {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO capabilitymapping.reference_model_entries (tenant_id, model_id, code, name, description, parent_code, level, position)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (tenant_id, model_id, code) DO NOTHING
+		`, tenantID.Value(), dto.ID, entry.Code, entry.Name, entry.Description, toNullableString(entry.ParentCode), entry.Level, position)
+		if err != nil {
+			return fmt.Errorf("insert entry %s of reference model %s: %w", entry.Code, dto.ID, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (rm *ReferenceModelReadModel) Delete(ctx context.Context, id string) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return fmt.Errorf("resolve tenant for delete reference model %s: %w", id, err)
+	}
+
+	tx, err := rm.db.BeginTxWithTenant(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx,
+		"DELETE FROM capabilitymapping.reference_model_entries WHERE tenant_id = $1 AND model_id = $2",
+		tenantID.Value(), id,
+	); err != nil {
+		return fmt.Errorf("delete entries of reference model %s: %w", id, err)
+	}
+	if _, err := tx.ExecContext(ctx,
+		"DELETE FROM capabilitymapping.reference_models WHERE tenant_id = $1 AND id = $2",
+		tenantID.Value(), id,
+	); err != nil {
+		return fmt.Errorf("delete reference model %s: %w", id, err)
+	}
+
+	return tx.Commit()
+}
+
+func (rm *ReferenceModelReadModel) GetAll(ctx context.Context) ([]ReferenceModelDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]ReferenceModelDTO, 0)
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			"SELECT "+referenceModelColumns+" FROM capabilitymapping.reference_models WHERE tenant_id = $1 ORDER BY framework, name, version",
+			tenantID.Value(),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto ReferenceModelDTO
+			if err := rows.Scan(&dto.ID, &dto.Name, &dto.Framework, &dto.Version, &dto.Description, &dto.EntryCount, &dto.ImportedBy, &dto.ImportedAt); err != nil {
+				return err
+			}
+			models = append(models, dto)
+		}
+		return rows.Err()
+	})
+	return models, err
+}
+
+func (rm *ReferenceModelReadModel) GetByID(ctx context.Context, id string) (*ReferenceModelDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto ReferenceModelDTO
+	var found bool
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx,
+			"SELECT "+referenceModelColumns+" FROM capabilitymapping.reference_models WHERE tenant_id = $1 AND id = $2",
+			tenantID.Value(), id,
+		).Scan(&dto.ID, &dto.Name, &dto.Framework, &dto.Version, &dto.Description, &dto.EntryCount, &dto.ImportedBy, &dto.ImportedAt)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		found = true
+		return nil
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+	return &dto, nil
+}
+
+// GetEntries returns the catalogue in import order, which keeps each parent
+// ahead of its children for frameworks that publish their trees that way.
+func (rm *ReferenceModelReadModel) GetEntries(ctx context.Context, modelID string) ([]ReferenceEntryDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]ReferenceEntryDTO, 0)
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `
+			SELECT code, name, description, COALESCE(parent_code, ''), level
+			FROM capabilitymapping.reference_model_entries
+			WHERE tenant_id = $1 AND model_id = $2
+			ORDER BY position
+		`, tenantID.Value(), modelID)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto ReferenceEntryDTO
+			if err := rows.Scan(&dto.Code, &dto.Name, &dto.Description, &dto.ParentCode, &dto.Level); err != nil {
+				return err
+			}
+			entries = append(entries, dto)
+		}
+		return rows.Err()
+	})
+	return entries, err
+}
+
+func (rm *ReferenceModelReadModel) GetEntry(ctx context.Context, modelID, code string) (*ReferenceEntryDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto ReferenceEntryDTO
+	var found bool
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, `
+			SELECT code, name, description, COALESCE(parent_code, ''), level
+			FROM capabilitymapping.reference_model_entries
+			WHERE tenant_id = $1 AND model_id = $2 AND code = $3
+		`, tenantID.Value(), modelID, code).Scan(&dto.Code, &dto.Name, &dto.Description, &dto.ParentCode, &dto.Level)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		found = true
+		return nil
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+	return &dto, nil
+}
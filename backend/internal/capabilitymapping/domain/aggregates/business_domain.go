@@ -1,7 +1,9 @@
 package aggregates
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"easi/backend/internal/capabilitymapping/domain/events"
@@ -9,30 +11,40 @@ import (
 	domain "easi/backend/internal/shared/eventsourcing"
 )
 
+var (
+	ErrBusinessDomainCannotBeOwnParent = errors.New("business domain cannot be its own parent")
+	ErrBusinessDomainCircularHierarchy = errors.New("business domain cannot be nested under one of its own sub-domains")
+)
+
 type BusinessDomain struct {
 	domain.AggregateRoot
-	name              valueobjects.DomainName
-	description       valueobjects.Description
-	domainArchitectID string
-	createdAt         time.Time
+	name        valueobjects.DomainName
+	description valueobjects.Description
+	parentID    string
+	architects  valueobjects.DomainArchitects
+	createdAt   time.Time
 }
 
+// NewBusinessDomain creates a domain, optionally nested under a parent domain
+// such as a division or segment. An empty parentID makes it a top-level domain.
 func NewBusinessDomain(
 	name valueobjects.DomainName,
 	description valueobjects.Description,
-	domainArchitectID string,
+	architects valueobjects.DomainArchitects,
+	parentID string,
 ) (*BusinessDomain, error) {
 	id := valueobjects.NewBusinessDomainID()
 	aggregate := &BusinessDomain{
 		AggregateRoot: domain.NewAggregateRootWithID(id.Value()),
 	}
 
-	event := events.NewBusinessDomainCreated(
-		aggregate.ID(),
-		name.Value(),
-		description.Value(),
-		domainArchitectID,
-	)
+	event := events.NewBusinessDomainCreated(events.BusinessDomainCreatedParams{
+		ID:           aggregate.ID(),
+		Name:         name.Value(),
+		Description:  description.Value(),
+		ParentID:     parentID,
+		ArchitectIDs: architects.IDs(),
+	})
 
 	aggregate.raise(event)
 
@@ -58,12 +70,12 @@ func LoadBusinessDomainFromHistory(events []domain.DomainEvent) (*BusinessDomain
 	return aggregate, nil
 }
 
-func (b *BusinessDomain) Update(name valueobjects.DomainName, description valueobjects.Description, domainArchitectID string) error {
+func (b *BusinessDomain) Update(name valueobjects.DomainName, description valueobjects.Description, architects valueobjects.DomainArchitects) error {
 	event := events.NewBusinessDomainUpdated(
 		b.ID(),
 		name.Value(),
 		description.Value(),
-		domainArchitectID,
+		architects.IDs(),
 	)
 
 	b.raise(event)
@@ -71,6 +83,25 @@ func (b *BusinessDomain) Update(name valueobjects.DomainName, description valueo
 	return nil
 }
 
+// ChangeParent moves the domain under another domain, or to the top level when
+// newParentID is empty. newParentAncestorIDs are the ancestors of the new parent
+// and guard against nesting a domain inside its own sub-tree.
+func (b *BusinessDomain) ChangeParent(newParentID string, newParentAncestorIDs []string) error {
+	if newParentID == b.parentID {
+		return nil
+	}
+	if newParentID == b.ID() {
+		return ErrBusinessDomainCannotBeOwnParent
+	}
+	if slices.Contains(newParentAncestorIDs, b.ID()) {
+		return ErrBusinessDomainCircularHierarchy
+	}
+
+	b.raise(events.NewBusinessDomainParentChanged(b.ID(), b.parentID, newParentID))
+
+	return nil
+}
+
 func (b *BusinessDomain) Delete() error {
 	event := events.NewBusinessDomainDeleted(b.ID())
 
@@ -96,7 +127,8 @@ func (b *BusinessDomain) apply(event domain.DomainEvent) error {
 		}
 		b.name = name
 		b.description = valueobjects.MustNewDescription(e.Description)
-		b.domainArchitectID = e.DomainArchitectID
+		b.parentID = e.ParentID
+		b.architects = valueobjects.NewDomainArchitects(e.ArchitectIDs())
 		b.createdAt = e.CreatedAt
 	case events.BusinessDomainUpdated:
 		name, err := valueobjects.NewDomainName(e.Name)
@@ -105,7 +137,9 @@ func (b *BusinessDomain) apply(event domain.DomainEvent) error {
 		}
 		b.name = name
 		b.description = valueobjects.MustNewDescription(e.Description)
-		b.domainArchitectID = e.DomainArchitectID
+		b.architects = valueobjects.NewDomainArchitects(e.ArchitectIDs())
+	case events.BusinessDomainParentChanged:
+		b.parentID = e.NewParentID
 	case events.BusinessDomainDeleted:
 	}
 	return nil
@@ -119,8 +153,16 @@ func (b *BusinessDomain) Description() valueobjects.Description {
 	return b.description
 }
 
+func (b *BusinessDomain) ParentID() string {
+	return b.parentID
+}
+
+func (b *BusinessDomain) Architects() valueobjects.DomainArchitects {
+	return b.architects
+}
+
 func (b *BusinessDomain) DomainArchitectID() string {
-	return b.domainArchitectID
+	return b.architects.Lead()
 }
 
 func (b *BusinessDomain) CreatedAt() time.Time {
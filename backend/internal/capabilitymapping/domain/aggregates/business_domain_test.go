@@ -3,7 +3,9 @@ package aggregates
 import (
 	"testing"
 
+	"easi/backend/internal/capabilitymapping/domain/events"
 	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	domainevents "easi/backend/internal/shared/eventsourcing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,7 +17,7 @@ func TestNewBusinessDomain(t *testing.T) {
 
 	description := valueobjects.MustNewDescription("Financial business domain")
 
-	domain, err := NewBusinessDomain(name, description, "")
+	domain, err := NewBusinessDomain(name, description, valueobjects.DomainArchitects{}, "")
 	require.NoError(t, err)
 	assert.NotNil(t, domain)
 	assert.NotEmpty(t, domain.ID())
@@ -31,7 +33,7 @@ func TestBusinessDomain_RaisesCreatedEvent(t *testing.T) {
 
 	description := valueobjects.MustNewDescription("Customer-facing business domain")
 
-	domain, err := NewBusinessDomain(name, description, "")
+	domain, err := NewBusinessDomain(name, description, valueobjects.DomainArchitects{}, "")
 	require.NoError(t, err)
 
 	uncommittedEvents := domain.GetUncommittedChanges()
@@ -51,7 +53,7 @@ func TestBusinessDomain_Update(t *testing.T) {
 
 	description := valueobjects.MustNewDescription("Financial capabilities")
 
-	domain, err := NewBusinessDomain(name, description, "")
+	domain, err := NewBusinessDomain(name, description, valueobjects.DomainArchitects{}, "")
 	require.NoError(t, err)
 
 	domain.MarkChangesAsCommitted()
@@ -61,7 +63,7 @@ func TestBusinessDomain_Update(t *testing.T) {
 
 	newDescription := valueobjects.MustNewDescription("Financial and accounting capabilities")
 
-	err = domain.Update(newName, newDescription, "")
+	err = domain.Update(newName, newDescription, valueobjects.DomainArchitects{})
 	require.NoError(t, err)
 
 	assert.Equal(t, newName, domain.Name())
@@ -78,7 +80,7 @@ func TestBusinessDomain_UpdateRaisesEvent(t *testing.T) {
 
 	description := valueobjects.MustNewDescription("Operational domain")
 
-	domain, err := NewBusinessDomain(name, description, "")
+	domain, err := NewBusinessDomain(name, description, valueobjects.DomainArchitects{}, "")
 	require.NoError(t, err)
 
 	domain.MarkChangesAsCommitted()
@@ -88,7 +90,7 @@ func TestBusinessDomain_UpdateRaisesEvent(t *testing.T) {
 
 	newDescription := valueobjects.MustNewDescription("Operations and support domain")
 
-	err = domain.Update(newName, newDescription, "")
+	err = domain.Update(newName, newDescription, valueobjects.DomainArchitects{})
 	require.NoError(t, err)
 
 	uncommittedEvents := domain.GetUncommittedChanges()
@@ -126,7 +128,7 @@ func TestBusinessDomain_DeletePreservesState(t *testing.T) {
 
 	description := valueobjects.MustNewDescription("Financial capabilities")
 
-	domain, err := NewBusinessDomain(name, description, "")
+	domain, err := NewBusinessDomain(name, description, valueobjects.DomainArchitects{}, "")
 	require.NoError(t, err)
 	domain.MarkChangesAsCommitted()
 
@@ -146,7 +148,7 @@ func TestBusinessDomain_LoadFromHistory(t *testing.T) {
 
 	description := valueobjects.MustNewDescription("Operational capabilities")
 
-	domain, err := NewBusinessDomain(name, description, "")
+	domain, err := NewBusinessDomain(name, description, valueobjects.DomainArchitects{}, "")
 	require.NoError(t, err)
 
 	events := domain.GetUncommittedChanges()
@@ -165,7 +167,7 @@ func TestBusinessDomain_LoadFromHistoryWithMultipleEvents(t *testing.T) {
 
 	description := valueobjects.MustNewDescription("Financial domain")
 
-	domain, err := NewBusinessDomain(name, description, "")
+	domain, err := NewBusinessDomain(name, description, valueobjects.DomainArchitects{}, "")
 	require.NoError(t, err)
 
 	newName, err := valueobjects.NewDomainName("Finance & Accounting")
@@ -173,7 +175,7 @@ func TestBusinessDomain_LoadFromHistoryWithMultipleEvents(t *testing.T) {
 
 	newDescription := valueobjects.MustNewDescription("Financial and accounting domain")
 
-	err = domain.Update(newName, newDescription, "")
+	err = domain.Update(newName, newDescription, valueobjects.DomainArchitects{})
 	require.NoError(t, err)
 
 	allEvents := domain.GetUncommittedChanges()
@@ -209,8 +211,56 @@ func createBusinessDomain(t *testing.T, domainName string) *BusinessDomain {
 
 	description := valueobjects.MustNewDescription("Test business domain")
 
-	domain, err := NewBusinessDomain(name, description, "")
+	domain, err := NewBusinessDomain(name, description, valueobjects.DomainArchitects{}, "")
 	require.NoError(t, err)
 
 	return domain
 }
+
+func TestBusinessDomain_CreatedUnderParentWithArchitects(t *testing.T) {
+	name, err := valueobjects.NewDomainName("Retail Banking")
+	require.NoError(t, err)
+	architects := valueobjects.NewDomainArchitects([]string{"arch-1", "arch-2"})
+
+	domain, err := NewBusinessDomain(name, valueobjects.MustNewDescription(""), architects, "division-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "division-1", domain.ParentID())
+	assert.Equal(t, []string{"arch-1", "arch-2"}, domain.Architects().IDs())
+	assert.Equal(t, "arch-1", domain.DomainArchitectID())
+
+	loaded, err := LoadBusinessDomainFromHistory(domain.GetUncommittedChanges())
+	require.NoError(t, err)
+	assert.Equal(t, "division-1", loaded.ParentID())
+	assert.Equal(t, []string{"arch-1", "arch-2"}, loaded.Architects().IDs())
+}
+
+func TestBusinessDomain_LegacySingleArchitectEventIsUpcast(t *testing.T) {
+	created := events.BusinessDomainCreated{ID: "11111111-1111-1111-1111-111111111111", Name: "Finance", DomainArchitectID: "arch-1"}
+
+	loaded, err := LoadBusinessDomainFromHistory([]domainevents.DomainEvent{created})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"arch-1"}, loaded.Architects().IDs())
+}
+
+func TestBusinessDomain_ChangeParent(t *testing.T) {
+	domain := createBusinessDomain(t, "Payments")
+	domain.MarkChangesAsCommitted()
+
+	require.NoError(t, domain.ChangeParent("division-1", nil))
+	assert.Equal(t, "division-1", domain.ParentID())
+
+	require.NoError(t, domain.ChangeParent("division-1", nil))
+	assert.Len(t, domain.GetUncommittedChanges(), 1, "moving under the current parent raises nothing")
+
+	require.NoError(t, domain.ChangeParent("", nil))
+	assert.Empty(t, domain.ParentID())
+}
+
+func TestBusinessDomain_ChangeParentRejectsCycles(t *testing.T) {
+	domain := createBusinessDomain(t, "Payments")
+
+	assert.ErrorIs(t, domain.ChangeParent(domain.ID(), nil), ErrBusinessDomainCannotBeOwnParent)
+	assert.ErrorIs(t, domain.ChangeParent("sub-domain", []string{"segment", domain.ID()}), ErrBusinessDomainCircularHierarchy)
+}
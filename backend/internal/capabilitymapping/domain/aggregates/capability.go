@@ -12,12 +12,17 @@ import (
 
 var (
 	ErrL1CannotHaveParent           = errors.New("L1 capabilities cannot have a parent")
-	ErrNonL1MustHaveParent          = errors.New("L2-L4 capabilities must have a parent")
+	ErrNonL1MustHaveParent          = errors.New("capabilities below L1 must have a parent")
 	ErrParentMustBeOneLevelAbove    = errors.New("parent must be exactly one level above")
 	ErrCapabilityCannotBeOwnParent  = errors.New("capability cannot be its own parent")
 	ErrWouldCreateCircularReference = errors.New("operation would create circular reference")
-	ErrWouldExceedMaximumDepth      = errors.New("operation would create L5+ hierarchy")
+	ErrWouldExceedMaximumDepth      = errors.New("operation would exceed the configured capability hierarchy depth")
 	ErrOnlyL1CanBeAssignedToDomain  = errors.New("only L1 capabilities can be assigned to business domains")
+	ErrCannotMergeIntoItself        = errors.New("capability cannot be merged into itself")
+	ErrMergeRequiresSameLevel       = errors.New("only capabilities on the same level can be merged")
+	ErrSplitRequiresTwoParts        = errors.New("a capability must be split into at least two parts")
+	ErrSplitPartMustBeSibling       = errors.New("split parts must share the parent and level of the split capability")
+	ErrCapabilityOwnerNotFound      = errors.New("party holds no role on this capability")
 )
 
 type Capability struct {
@@ -29,11 +34,16 @@ type Capability struct {
 	createdAt      time.Time
 	maturityLevel  valueobjects.MaturityLevel
 	ownershipModel valueobjects.OwnershipModel
-	primaryOwner   valueobjects.Owner
-	eaOwner        valueobjects.Owner
 	status         valueobjects.CapabilityStatus
-	experts        []valueobjects.Expert
 	tags           []valueobjects.Tag
+	owners         []valueobjects.RACIAssignment
+
+	// Free-text owners and experts from before RACI ownership. Nothing writes
+	// them any more; existing capabilities keep showing them until they are
+	// replaced by owner assignments.
+	primaryOwner valueobjects.Owner
+	eaOwner      valueobjects.Owner
+	experts      []valueobjects.Expert
 }
 
 func NewCapability(
@@ -41,8 +51,9 @@ func NewCapability(
 	description valueobjects.Description,
 	parentID valueobjects.CapabilityID,
 	level valueobjects.CapabilityLevel,
+	hierarchy valueobjects.CapabilityHierarchy,
 ) (*Capability, error) {
-	if err := validateHierarchy(parentID, level); err != nil {
+	if err := validateHierarchy(parentID, level, hierarchy); err != nil {
 		return nil, err
 	}
 
@@ -94,6 +105,8 @@ func (c *Capability) Update(name valueobjects.CapabilityName, description valueo
 	return nil
 }
 
+// UpdateMetadata carries the legacy free-text owners over unchanged; owners
+// are assigned with AssignOwner.
 func (c *Capability) UpdateMetadata(metadata valueobjects.CapabilityMetadata) error {
 	event := events.NewCapabilityMetadataUpdated(
 		c.ID(),
@@ -101,8 +114,8 @@ func (c *Capability) UpdateMetadata(metadata valueobjects.CapabilityMetadata) er
 		0,
 		metadata.MaturityLevel().Value(),
 		metadata.OwnershipModel().Value(),
-		metadata.PrimaryOwner().Value(),
-		metadata.EAOwner().Value(),
+		c.primaryOwner.Value(),
+		c.eaOwner.Value(),
 		metadata.Status().Value(),
 	)
 
@@ -111,6 +124,8 @@ func (c *Capability) UpdateMetadata(metadata valueobjects.CapabilityMetadata) er
 	return nil
 }
 
+// AddExpert records a legacy free-text expert. No command calls it any more;
+// experts are assigned as Consulted owners with AssignOwner.
 func (c *Capability) AddExpert(expert valueobjects.Expert) error {
 	event := events.NewCapabilityExpertAdded(
 		c.ID(),
@@ -151,6 +166,110 @@ func (c *Capability) AddTag(tag valueobjects.Tag) error {
 	return nil
 }
 
+func (c *Capability) RemoveTag(tag valueobjects.Tag) error {
+	if !c.hasTag(tag) {
+		return nil
+	}
+
+	c.raise(events.NewCapabilityTagRemoved(c.ID(), tag.Value()))
+
+	return nil
+}
+
+// RenameTag replaces from with to. Capabilities without from are left
+// untouched; when to is already present, from is dropped (a merge).
+func (c *Capability) RenameTag(from, to valueobjects.Tag) error {
+	if from.Equals(to) || !c.hasTag(from) {
+		return nil
+	}
+
+	c.raise(events.NewCapabilityTagRenamed(c.ID(), from.Value(), to.Value()))
+
+	return nil
+}
+
+func (c *Capability) hasTag(tag valueobjects.Tag) bool {
+	for _, existingTag := range c.tags {
+		if existingTag.Equals(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// AssignOwner gives a party a RACI role. A party holds one role at a time, and
+// a capability has at most one Accountable party, so both are replaced.
+func (c *Capability) AssignOwner(assignment valueobjects.RACIAssignment) error {
+	party := assignment.Party()
+	if current, ok := c.ownerRole(party); ok {
+		if current == assignment.Role() {
+			return nil
+		}
+		c.raiseOwnerUnassigned(party, current, events.OwnerUnassignedReplaced)
+	}
+	if assignment.Role() == valueobjects.RACIAccountable {
+		if accountable, ok := c.accountableParty(); ok {
+			c.raiseOwnerUnassigned(accountable, valueobjects.RACIAccountable, events.OwnerUnassignedReplaced)
+		}
+	}
+
+	c.raise(events.NewCapabilityOwnerAssigned(c.ID(), party.Type().Value(), party.ID(), assignment.Role().Value()))
+	return nil
+}
+
+func (c *Capability) UnassignOwner(party valueobjects.OwnershipParty) error {
+	role, ok := c.ownerRole(party)
+	if !ok {
+		return ErrCapabilityOwnerNotFound
+	}
+	c.raiseOwnerUnassigned(party, role, events.OwnerUnassignedRemoved)
+	return nil
+}
+
+// ReassignOwner removes the role held by from and hands it to successor. With
+// accountableOnly set, only accountability is handed over and any other role is
+// dropped; a zero successor drops the role outright.
+func (c *Capability) ReassignOwner(from, successor valueobjects.OwnershipParty, accountableOnly bool, reason string) error {
+	role, ok := c.ownerRole(from)
+	if !ok {
+		return ErrCapabilityOwnerNotFound
+	}
+	c.raiseOwnerUnassigned(from, role, reason)
+
+	if successor.IsZero() || successor == from {
+		return nil
+	}
+	if accountableOnly && role != valueobjects.RACIAccountable {
+		return nil
+	}
+	if _, held := c.ownerRole(successor); held && role != valueobjects.RACIAccountable {
+		return nil
+	}
+	return c.AssignOwner(valueobjects.NewRACIAssignment(successor, role))
+}
+
+func (c *Capability) ownerRole(party valueobjects.OwnershipParty) (valueobjects.RACIRole, bool) {
+	for _, owner := range c.owners {
+		if owner.Party() == party {
+			return owner.Role(), true
+		}
+	}
+	return "", false
+}
+
+func (c *Capability) accountableParty() (valueobjects.OwnershipParty, bool) {
+	for _, owner := range c.owners {
+		if owner.Role() == valueobjects.RACIAccountable {
+			return owner.Party(), true
+		}
+	}
+	return valueobjects.OwnershipParty{}, false
+}
+
+func (c *Capability) raiseOwnerUnassigned(party valueobjects.OwnershipParty, role valueobjects.RACIRole, reason string) {
+	c.raise(events.NewCapabilityOwnerUnassigned(c.ID(), party.Type().Value(), party.ID(), role.Value(), reason))
+}
+
 func (c *Capability) apply(event domain.DomainEvent) error {
 	switch e := event.(type) {
 	case events.CapabilityCreated:
@@ -165,6 +284,14 @@ func (c *Capability) apply(event domain.DomainEvent) error {
 		c.applyExpertRemoved(e)
 	case events.CapabilityTagAdded:
 		return c.applyTagAdded(e)
+	case events.CapabilityTagRemoved:
+		c.tags = withoutTag(c.tags, e.Tag)
+	case events.CapabilityTagRenamed:
+		return c.applyTagRenamed(e)
+	case events.CapabilityOwnerAssigned:
+		return c.applyOwnerAssigned(e)
+	case events.CapabilityOwnerUnassigned:
+		c.owners = withoutOwner(c.owners, e.PartyType, e.PartyID)
 	case events.CapabilityParentChanged:
 		return c.applyParentChanged(e)
 	case events.CapabilityLevelChanged:
@@ -259,6 +386,51 @@ func (c *Capability) applyTagAdded(e events.CapabilityTagAdded) error {
 	return nil
 }
 
+func (c *Capability) applyTagRenamed(e events.CapabilityTagRenamed) error {
+	to, err := valueobjects.NewTag(e.To)
+	if err != nil {
+		return fmt.Errorf("%w: tag %q: %v", domain.ErrCorruptedEvent, e.To, err)
+	}
+	c.tags = withoutTag(c.tags, e.From)
+	if !c.hasTag(to) {
+		c.tags = append(c.tags, to)
+	}
+	return nil
+}
+
+func withoutTag(tags []valueobjects.Tag, value string) []valueobjects.Tag {
+	result := make([]valueobjects.Tag, 0, len(tags))
+	for _, tag := range tags {
+		if tag.Value() != value {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+func (c *Capability) applyOwnerAssigned(e events.CapabilityOwnerAssigned) error {
+	party, err := valueobjects.NewOwnershipParty(e.PartyType, e.PartyID)
+	if err != nil {
+		return fmt.Errorf("%w: owner party %s/%q: %v", domain.ErrCorruptedEvent, e.PartyType, e.PartyID, err)
+	}
+	role, err := valueobjects.NewRACIRole(e.Role)
+	if err != nil {
+		return fmt.Errorf("%w: owner role %q: %v", domain.ErrCorruptedEvent, e.Role, err)
+	}
+	c.owners = append(withoutOwner(c.owners, e.PartyType, e.PartyID), valueobjects.NewRACIAssignment(party, role))
+	return nil
+}
+
+func withoutOwner(owners []valueobjects.RACIAssignment, partyType, partyID string) []valueobjects.RACIAssignment {
+	result := make([]valueobjects.RACIAssignment, 0, len(owners))
+	for _, owner := range owners {
+		if owner.Party().Type().Value() != partyType || owner.Party().ID() != partyID {
+			result = append(result, owner)
+		}
+	}
+	return result
+}
+
 func (c *Capability) applyParentChanged(e events.CapabilityParentChanged) error {
 	if e.NewParentID != "" {
 		parentID, err := valueobjects.NewCapabilityIDFromString(e.NewParentID)
@@ -334,12 +506,16 @@ func (c *Capability) Tags() []valueobjects.Tag {
 	return c.tags
 }
 
-func (c *Capability) ChangeParent(newParentID valueobjects.CapabilityID, newLevel valueobjects.CapabilityLevel) error {
+func (c *Capability) Owners() []valueobjects.RACIAssignment {
+	return c.owners
+}
+
+func (c *Capability) ChangeParent(newParentID valueobjects.CapabilityID, newLevel valueobjects.CapabilityLevel, hierarchy valueobjects.CapabilityHierarchy) error {
 	if newParentID.Value() == c.ID() {
 		return ErrCapabilityCannotBeOwnParent
 	}
 
-	if newLevel.NumericValue() > 4 {
+	if !hierarchy.Allows(newLevel) {
 		return ErrWouldExceedMaximumDepth
 	}
 
@@ -356,8 +532,8 @@ func (c *Capability) ChangeParent(newParentID valueobjects.CapabilityID, newLeve
 	return nil
 }
 
-func (c *Capability) ChangeLevel(newLevel valueobjects.CapabilityLevel) error {
-	if newLevel.NumericValue() > 4 {
+func (c *Capability) ChangeLevel(newLevel valueobjects.CapabilityLevel, hierarchy valueobjects.CapabilityHierarchy) error {
+	if !hierarchy.Allows(newLevel) {
 		return ErrWouldExceedMaximumDepth
 	}
 
@@ -379,6 +555,68 @@ func (c *Capability) CanBeAssignedToDomain() error {
 	return nil
 }
 
+// AbsorbMerged takes over the experts and tags of a capability being merged into
+// this one and records the merge on both. The caller deletes the merged capability.
+func (c *Capability) AbsorbMerged(merged *Capability, mergedBy string) error {
+	if merged.ID() == c.ID() {
+		return ErrCannotMergeIntoItself
+	}
+	if merged.level != c.level {
+		return ErrMergeRequiresSameLevel
+	}
+
+	c.takeOverExpertsAndTags(merged)
+
+	c.raise(events.NewCapabilityAbsorbed(c.ID(), merged.ID(), merged.name.Value(), mergedBy))
+	merged.raise(events.NewCapabilityMergedInto(merged.ID(), merged.name.Value(), c.ID(), c.name.Value(), mergedBy))
+
+	return nil
+}
+
+// SplitInto records that this capability is divided into the given sibling parts,
+// each of which takes over its experts and tags. The caller deletes this capability.
+func (c *Capability) SplitInto(parts []*Capability, splitBy string) error {
+	if len(parts) < 2 {
+		return ErrSplitRequiresTwoParts
+	}
+
+	splitParts := make([]events.CapabilitySplitPart, 0, len(parts))
+	for _, part := range parts {
+		if part.ID() == c.ID() || part.parentID.Value() != c.parentID.Value() || part.level != c.level {
+			return ErrSplitPartMustBeSibling
+		}
+		splitParts = append(splitParts, events.CapabilitySplitPart{ID: part.ID(), Name: part.name.Value()})
+	}
+
+	for _, part := range parts {
+		part.takeOverExpertsAndTags(c)
+		part.raise(events.NewCapabilitySplitOff(part.ID(), c.ID(), c.name.Value(), splitBy))
+	}
+	c.raise(events.NewCapabilitySplit(c.ID(), c.name.Value(), splitParts, splitBy))
+
+	return nil
+}
+
+func (c *Capability) takeOverExpertsAndTags(from *Capability) {
+	for _, expert := range from.experts {
+		if !c.hasExpert(expert) {
+			c.raise(events.NewCapabilityExpertAdded(c.ID(), expert.Name(), expert.Role(), expert.Contact()))
+		}
+	}
+	for _, tag := range from.tags {
+		_ = c.AddTag(tag)
+	}
+}
+
+func (c *Capability) hasExpert(expert valueobjects.Expert) bool {
+	for _, existing := range c.experts {
+		if existing.MatchesValues(expert.Name(), expert.Role(), expert.Contact()) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Capability) Delete() error {
 	event := events.NewCapabilityDeleted(c.ID())
 
@@ -394,9 +632,13 @@ func (c *Capability) raise(event domain.DomainEvent) {
 	c.RaiseEvent(event)
 }
 
-func validateHierarchy(parentID valueobjects.CapabilityID, level valueobjects.CapabilityLevel) error {
+func validateHierarchy(parentID valueobjects.CapabilityID, level valueobjects.CapabilityLevel, hierarchy valueobjects.CapabilityHierarchy) error {
 	hasParent := parentID.Value() != ""
 
+	if !hierarchy.Allows(level) {
+		return ErrWouldExceedMaximumDepth
+	}
+
 	if level == valueobjects.LevelL1 && hasParent {
 		return ErrL1CannotHaveParent
 	}
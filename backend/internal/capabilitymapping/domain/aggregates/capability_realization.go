@@ -77,6 +77,47 @@ func (cr *CapabilityRealization) Update(
 	return nil
 }
 
+// ReassignComponent moves the realization to another component, e.g. when duplicate
+// components are merged. Reassigning to the current component is a no-op.
+func (cr *CapabilityRealization) ReassignComponent(componentID valueobjects.ComponentID, componentName string) error {
+	if componentID.Value() == cr.componentID.Value() {
+		return nil
+	}
+
+	event := events.NewSystemRealizationReassigned(events.SystemRealizationReassignment{
+		ID:               cr.ID(),
+		CapabilityID:     cr.capabilityID.Value(),
+		FromComponentID:  cr.componentID.Value(),
+		ToComponentID:    componentID.Value(),
+		ToComponentName:  componentName,
+		RealizationLevel: cr.realizationLevel.Value(),
+	})
+
+	cr.raise(event)
+
+	return nil
+}
+
+// MoveToCapability moves the realization to another capability, e.g. when capabilities
+// are merged or split. Moving to the current capability is a no-op.
+func (cr *CapabilityRealization) MoveToCapability(capabilityID valueobjects.CapabilityID) error {
+	if capabilityID.Value() == cr.capabilityID.Value() {
+		return nil
+	}
+
+	event := events.NewSystemRealizationMoved(events.SystemRealizationMove{
+		ID:               cr.ID(),
+		ComponentID:      cr.componentID.Value(),
+		FromCapabilityID: cr.capabilityID.Value(),
+		ToCapabilityID:   capabilityID.Value(),
+		RealizationLevel: cr.realizationLevel.Value(),
+	})
+
+	cr.raise(event)
+
+	return nil
+}
+
 func (cr *CapabilityRealization) Delete() error {
 	event := events.NewSystemRealizationDeleted(cr.ID())
 
@@ -120,6 +161,18 @@ func (cr *CapabilityRealization) apply(event domain.DomainEvent) error {
 		}
 		cr.realizationLevel = realizationLevel
 		cr.notes = valueobjects.MustNewDescription(e.Notes)
+	case events.SystemRealizationReassigned:
+		componentID, err := valueobjects.NewComponentIDFromString(e.ToComponentID)
+		if err != nil {
+			return fmt.Errorf("%w: component ID %q: %v", domain.ErrCorruptedEvent, e.ToComponentID, err)
+		}
+		cr.componentID = componentID
+	case events.SystemRealizationMoved:
+		capabilityID, err := valueobjects.NewCapabilityIDFromString(e.ToCapabilityID)
+		if err != nil {
+			return fmt.Errorf("%w: capability ID %q: %v", domain.ErrCorruptedEvent, e.ToCapabilityID, err)
+		}
+		cr.capabilityID = capabilityID
 	case events.SystemRealizationDeleted:
 	}
 	return nil
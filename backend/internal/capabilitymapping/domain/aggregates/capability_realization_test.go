@@ -156,3 +156,59 @@ func TestCapabilityRealization_AllRealizationLevels(t *testing.T) {
 		})
 	}
 }
+
+func TestCapabilityRealization_ReassignComponent(t *testing.T) {
+	capabilityID := valueobjects.NewCapabilityID()
+	fromComponent, err := valueobjects.NewComponentIDFromString(valueobjects.NewCapabilityID().Value())
+	require.NoError(t, err)
+	toComponent, err := valueobjects.NewComponentIDFromString(valueobjects.NewCapabilityID().Value())
+	require.NoError(t, err)
+	realizationLevel, err := valueobjects.NewRealizationLevel("Full")
+	require.NoError(t, err)
+
+	realization, err := NewCapabilityRealization(capabilityID, fromComponent, "SFDC", realizationLevel, valueobjects.MustNewDescription(""))
+	require.NoError(t, err)
+	history := realization.GetUncommittedChanges()
+	realization.MarkChangesAsCommitted()
+
+	require.NoError(t, realization.ReassignComponent(toComponent, "Salesforce"))
+	require.NoError(t, realization.ReassignComponent(toComponent, "Salesforce"))
+
+	assert.Equal(t, toComponent, realization.ComponentID())
+	assert.Equal(t, realizationLevel, realization.RealizationLevel())
+	changes := realization.GetUncommittedChanges()
+	require.Len(t, changes, 1, "reassigning to the current component is a no-op")
+	assert.Equal(t, "SystemRealizationReassigned", changes[0].EventType())
+
+	reloaded, err := LoadCapabilityRealizationFromHistory(append(history, changes...))
+	require.NoError(t, err)
+	assert.Equal(t, toComponent, reloaded.ComponentID())
+}
+
+func TestCapabilityRealization_MoveToCapability(t *testing.T) {
+	fromCapability := valueobjects.NewCapabilityID()
+	toCapability := valueobjects.NewCapabilityID()
+	component, err := valueobjects.NewComponentIDFromString(valueobjects.NewCapabilityID().Value())
+	require.NoError(t, err)
+	realizationLevel, err := valueobjects.NewRealizationLevel("Partial")
+	require.NoError(t, err)
+
+	realization, err := NewCapabilityRealization(fromCapability, component, "SAP", realizationLevel, valueobjects.MustNewDescription("Invoicing"))
+	require.NoError(t, err)
+	history := realization.GetUncommittedChanges()
+	realization.MarkChangesAsCommitted()
+
+	require.NoError(t, realization.MoveToCapability(toCapability))
+	require.NoError(t, realization.MoveToCapability(toCapability))
+
+	assert.Equal(t, toCapability, realization.CapabilityID())
+	assert.Equal(t, component, realization.ComponentID())
+	changes := realization.GetUncommittedChanges()
+	require.Len(t, changes, 1, "moving to the current capability is a no-op")
+	assert.Equal(t, "SystemRealizationMoved", changes[0].EventType())
+
+	reloaded, err := LoadCapabilityRealizationFromHistory(append(history, changes...))
+	require.NoError(t, err)
+	assert.Equal(t, toCapability, reloaded.CapabilityID())
+	assert.Equal(t, "Invoicing", reloaded.Notes().Value())
+}
@@ -3,6 +3,7 @@ package aggregates
 import (
 	"testing"
 
+	"easi/backend/internal/capabilitymapping/domain/events"
 	"easi/backend/internal/capabilitymapping/domain/valueobjects"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,7 @@ func TestNewCapability_L1(t *testing.T) {
 
 	var parentID valueobjects.CapabilityID
 
-	capability, err := NewCapability(name, description, parentID, level)
+	capability, err := NewCapability(name, description, parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 	assert.NotNil(t, capability)
 	assert.NotEmpty(t, capability.ID())
@@ -37,7 +38,7 @@ func TestNewCapability_L1WithParent_ShouldFail(t *testing.T) {
 	description := valueobjects.MustNewDescription("Test")
 	parentID := valueobjects.NewCapabilityID()
 
-	capability, err := NewCapability(name, description, parentID, level)
+	capability, err := NewCapability(name, description, parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	assert.Error(t, err)
 	assert.Nil(t, capability)
 	assert.Equal(t, ErrL1CannotHaveParent, err)
@@ -48,7 +49,7 @@ func TestNewCapability_L2WithoutParent_OrphanAllowed(t *testing.T) {
 	description := valueobjects.MustNewDescription("Test")
 	var parentID valueobjects.CapabilityID
 
-	capability, err := NewCapability(name, description, parentID, level)
+	capability, err := NewCapability(name, description, parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 	assert.NotNil(t, capability)
 	assert.Equal(t, "L2", capability.Level().Value())
@@ -60,7 +61,7 @@ func TestNewCapability_L2WithParent(t *testing.T) {
 	description := valueobjects.MustNewDescription("Customer digital touchpoints")
 	parentID := valueobjects.NewCapabilityID()
 
-	capability, err := NewCapability(name, description, parentID, level)
+	capability, err := NewCapability(name, description, parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 	assert.NotNil(t, capability)
 	assert.Equal(t, parentID.Value(), capability.ParentID().Value())
@@ -89,7 +90,7 @@ func TestCapability_RaisesCreatedEvent(t *testing.T) {
 
 	var parentID valueobjects.CapabilityID
 
-	capability, err := NewCapability(name, description, parentID, level)
+	capability, err := NewCapability(name, description, parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	uncommittedEvents := capability.GetUncommittedChanges()
@@ -108,7 +109,7 @@ func TestCapability_Update(t *testing.T) {
 
 	var parentID valueobjects.CapabilityID
 
-	capability, err := NewCapability(name, description, parentID, level)
+	capability, err := NewCapability(name, description, parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	capability.MarkChangesAsCommitted()
@@ -140,7 +141,7 @@ func TestCapability_LoadFromHistory(t *testing.T) {
 
 	var parentID valueobjects.CapabilityID
 
-	capability, err := NewCapability(name, description, parentID, level)
+	capability, err := NewCapability(name, description, parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	events := capability.GetUncommittedChanges()
@@ -154,6 +155,28 @@ func TestCapability_LoadFromHistory(t *testing.T) {
 	assert.Equal(t, capability.Level().Value(), loadedCapability.Level().Value())
 }
 
+func TestCapability_UpdateMetadata_KeepsLegacyFreeTextOwners(t *testing.T) {
+	created := createCapability(t, "Billing", "L1")
+	history := append(created.GetUncommittedChanges(),
+		events.NewCapabilityMetadataUpdated(created.ID(), "", 0, 25, "Shared", "Alex (Finance)", "ea-user-1", "Active"))
+	capability, err := LoadCapabilityFromHistory(history)
+	require.NoError(t, err)
+
+	maturity, err := valueobjects.NewMaturityLevelFromValue(60)
+	require.NoError(t, err)
+	ownership, err := valueobjects.NewOwnershipModel("TribeOwned")
+	require.NoError(t, err)
+	require.NoError(t, capability.UpdateMetadata(valueobjects.NewCapabilityMetadata(maturity, ownership, valueobjects.StatusPlanned)))
+
+	changes := capability.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	updated, ok := changes[0].(events.CapabilityMetadataUpdated)
+	require.True(t, ok)
+	assert.Equal(t, 60, updated.MaturityValue)
+	assert.Equal(t, "Alex (Finance)", updated.PrimaryOwner, "legacy owners are carried over, not edited")
+	assert.Equal(t, "ea-user-1", updated.EAOwner)
+}
+
 func TestChangeParent_L1ToL2_WhenAssignedParent(t *testing.T) {
 	capability := createCapability(t, "Customer Engagement", "L1")
 	capability.MarkChangesAsCommitted()
@@ -162,7 +185,7 @@ func TestChangeParent_L1ToL2_WhenAssignedParent(t *testing.T) {
 	newLevel, err := valueobjects.NewCapabilityLevel("L2")
 	require.NoError(t, err)
 
-	err = capability.ChangeParent(newParentID, newLevel)
+	err = capability.ChangeParent(newParentID, newLevel, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	assert.Equal(t, newParentID.Value(), capability.ParentID().Value())
@@ -199,7 +222,7 @@ func TestChangeParent_LevelTransitions(t *testing.T) {
 			newLevel, err := valueobjects.NewCapabilityLevel(tt.toLevel)
 			require.NoError(t, err)
 
-			err = capability.ChangeParent(newParentID, newLevel)
+			err = capability.ChangeParent(newParentID, newLevel, valueobjects.DefaultCapabilityHierarchy())
 			require.NoError(t, err)
 
 			if tt.orphan {
@@ -222,17 +245,33 @@ func TestChangeParent_SelfReference_ShouldFail(t *testing.T) {
 	newLevel, err := valueobjects.NewCapabilityLevel("L2")
 	require.NoError(t, err)
 
-	err = capability.ChangeParent(selfParentID, newLevel)
+	err = capability.ChangeParent(selfParentID, newLevel, valueobjects.DefaultCapabilityHierarchy())
 	assert.Error(t, err)
 	assert.Equal(t, ErrCapabilityCannotBeOwnParent, err)
 }
 
-func TestChangeParent_L5CannotBeCreated_ValueObjectEnforcesMaxDepth(t *testing.T) {
-	_, err := valueobjects.NewCapabilityLevel("L5")
+func TestChangeParent_L7CannotBeCreated_ValueObjectEnforcesMaxDepth(t *testing.T) {
+	_, err := valueobjects.NewCapabilityLevel("L7")
 	assert.Error(t, err)
 	assert.Equal(t, valueobjects.ErrInvalidCapabilityLevel, err)
 }
 
+func TestChangeParent_BeyondConfiguredDepthIsRejected(t *testing.T) {
+	capability := createCapability(t, "Order Capture", "L2")
+	capability.MarkChangesAsCommitted()
+	parentID := valueobjects.NewCapabilityID()
+
+	newLevel, err := valueobjects.NewCapabilityLevel("L5")
+	require.NoError(t, err)
+
+	err = capability.ChangeParent(parentID, newLevel, valueobjects.DefaultCapabilityHierarchy())
+	assert.Equal(t, ErrWouldExceedMaximumDepth, err)
+
+	deeper, err := valueobjects.NewCapabilityHierarchy([]string{"L1", "L2", "L3", "L4", "L5"})
+	require.NoError(t, err)
+	assert.NoError(t, capability.ChangeParent(parentID, newLevel, deeper))
+}
+
 func TestChangeParent_ChangingParentWithinSameLevel(t *testing.T) {
 	capability := createCapability(t, "Digital Experience", "L2")
 	originalParentID := capability.ParentID()
@@ -242,7 +281,7 @@ func TestChangeParent_ChangingParentWithinSameLevel(t *testing.T) {
 	sameLevel, err := valueobjects.NewCapabilityLevel("L2")
 	require.NoError(t, err)
 
-	err = capability.ChangeParent(newParentID, sameLevel)
+	err = capability.ChangeParent(newParentID, sameLevel, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	assert.NotEqual(t, originalParentID.Value(), capability.ParentID().Value())
@@ -259,7 +298,7 @@ func TestChangeParent_RaisesCapabilityParentChangedEvent(t *testing.T) {
 	newLevel, err := valueobjects.NewCapabilityLevel("L2")
 	require.NoError(t, err)
 
-	err = capability.ChangeParent(newParentID, newLevel)
+	err = capability.ChangeParent(newParentID, newLevel, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	uncommittedEvents := capability.GetUncommittedChanges()
@@ -283,7 +322,7 @@ func TestChangeParent_LoadFromHistory_PreservesParentChange(t *testing.T) {
 	newLevel, err := valueobjects.NewCapabilityLevel("L2")
 	require.NoError(t, err)
 
-	err = capability.ChangeParent(newParentID, newLevel)
+	err = capability.ChangeParent(newParentID, newLevel, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	allEvents := capability.GetUncommittedChanges()
@@ -304,7 +343,7 @@ func TestChangeParent_MultipleParentChanges(t *testing.T) {
 	levelL2, err := valueobjects.NewCapabilityLevel("L2")
 	require.NoError(t, err)
 
-	err = capability.ChangeParent(firstParentID, levelL2)
+	err = capability.ChangeParent(firstParentID, levelL2, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 	capability.MarkChangesAsCommitted()
 
@@ -312,7 +351,7 @@ func TestChangeParent_MultipleParentChanges(t *testing.T) {
 	levelL3, err := valueobjects.NewCapabilityLevel("L3")
 	require.NoError(t, err)
 
-	err = capability.ChangeParent(secondParentID, levelL3)
+	err = capability.ChangeParent(secondParentID, levelL3, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	assert.Equal(t, secondParentID.Value(), capability.ParentID().Value())
@@ -329,7 +368,7 @@ func TestChangeParent_PreservesOtherAggregateState(t *testing.T) {
 	newLevel, err := valueobjects.NewCapabilityLevel("L2")
 	require.NoError(t, err)
 
-	err = capability.ChangeParent(newParentID, newLevel)
+	err = capability.ChangeParent(newParentID, newLevel, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	assert.Equal(t, originalName, capability.Name().Value())
@@ -352,7 +391,7 @@ func createCapability(t *testing.T, capabilityName string, levelStr string) *Cap
 		parentID = valueobjects.NewCapabilityID()
 	}
 
-	capability, err := NewCapability(name, description, parentID, level)
+	capability, err := NewCapability(name, description, parentID, level, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	return capability
@@ -523,3 +562,227 @@ func TestCapability_MultipleExperts_AllPersist(t *testing.T) {
 	assert.Contains(t, names, "Alice Smith")
 	assert.Contains(t, names, "Bob Jones")
 }
+
+func TestCapability_AbsorbMerged_TakesOverExpertsAndTags(t *testing.T) {
+	survivor := createCapability(t, "Customer Management", "L1")
+	merged := createCapability(t, "Client Management", "L1")
+	shared := valueobjects.MustNewExpert("Alice Smith", "Product Owner", "alice@example.com", survivor.CreatedAt())
+	require.NoError(t, survivor.AddExpert(shared))
+	require.NoError(t, merged.AddExpert(shared))
+	require.NoError(t, merged.AddExpert(valueobjects.MustNewExpert("Bob Jones", "Architect", "bob@example.com", merged.CreatedAt())))
+	tag, err := valueobjects.NewTag("crm")
+	require.NoError(t, err)
+	require.NoError(t, merged.AddTag(tag))
+	survivor.MarkChangesAsCommitted()
+	merged.MarkChangesAsCommitted()
+
+	require.NoError(t, survivor.AbsorbMerged(merged, "jane@example.com"))
+
+	assert.Len(t, survivor.Experts(), 2, "shared experts are not duplicated")
+	assert.Equal(t, []valueobjects.Tag{tag}, survivor.Tags())
+	survivorChanges := survivor.GetUncommittedChanges()
+	assert.Equal(t, "CapabilityAbsorbed", survivorChanges[len(survivorChanges)-1].EventType())
+	mergedChanges := merged.GetUncommittedChanges()
+	require.Len(t, mergedChanges, 1)
+	assert.Equal(t, "CapabilityMergedInto", mergedChanges[0].EventType())
+	assert.Equal(t, survivor.ID(), mergedChanges[0].EventData()["survivorId"])
+}
+
+func TestCapability_AbsorbMerged_Rejected(t *testing.T) {
+	survivor := createCapability(t, "Customer Management", "L1")
+
+	assert.ErrorIs(t, survivor.AbsorbMerged(survivor, "jane@example.com"), ErrCannotMergeIntoItself)
+	assert.ErrorIs(t, survivor.AbsorbMerged(createCapability(t, "Onboarding", "L2"), "jane@example.com"), ErrMergeRequiresSameLevel)
+}
+
+func TestCapability_SplitInto_RecordsPartsOnBothSides(t *testing.T) {
+	source := createCapability(t, "Customer Management", "L1")
+	require.NoError(t, source.AddExpert(valueobjects.MustNewExpert("Alice Smith", "Product Owner", "alice@example.com", source.CreatedAt())))
+	source.MarkChangesAsCommitted()
+	first := createCapability(t, "Customer Onboarding", "L1")
+	second := createCapability(t, "Customer Service", "L1")
+
+	require.NoError(t, source.SplitInto([]*Capability{first, second}, "jane@example.com"))
+
+	assert.Len(t, first.Experts(), 1)
+	assert.Len(t, second.Experts(), 1)
+	sourceChanges := source.GetUncommittedChanges()
+	require.Len(t, sourceChanges, 1)
+	split, ok := sourceChanges[0].(events.CapabilitySplit)
+	require.True(t, ok)
+	assert.Equal(t, []events.CapabilitySplitPart{
+		{ID: first.ID(), Name: "Customer Onboarding"},
+		{ID: second.ID(), Name: "Customer Service"},
+	}, split.Parts)
+	firstChanges := first.GetUncommittedChanges()
+	assert.Equal(t, "CapabilitySplitOff", firstChanges[len(firstChanges)-1].EventType())
+}
+
+func TestCapability_SplitInto_Rejected(t *testing.T) {
+	source := createCapability(t, "Customer Management", "L1")
+	part := createCapability(t, "Customer Onboarding", "L1")
+
+	assert.ErrorIs(t, source.SplitInto([]*Capability{part}, "jane@example.com"), ErrSplitRequiresTwoParts)
+	assert.ErrorIs(t, source.SplitInto([]*Capability{part, createCapability(t, "Customer Service", "L2")}, "jane@example.com"), ErrSplitPartMustBeSibling)
+	assert.Empty(t, source.GetUncommittedChanges()[1:], "a rejected split raises nothing")
+}
+
+func TestCapability_RemoveTag(t *testing.T) {
+	capability := createCapability(t, "Customer Management", "L1")
+	crm, _ := valueobjects.NewTag("crm")
+	legacy, _ := valueobjects.NewTag("legacy")
+	require.NoError(t, capability.AddTag(crm))
+	capability.MarkChangesAsCommitted()
+
+	require.NoError(t, capability.RemoveTag(legacy))
+	assert.Empty(t, capability.GetUncommittedChanges(), "removing an absent tag raises nothing")
+
+	require.NoError(t, capability.RemoveTag(crm))
+	changes := capability.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	assert.Equal(t, "CapabilityTagRemoved", changes[0].EventType())
+	assert.Empty(t, capability.Tags())
+}
+
+func TestCapability_RenameTag(t *testing.T) {
+	capability := createCapability(t, "Customer Management", "L1")
+	crm, _ := valueobjects.NewTag("crm")
+	customer, _ := valueobjects.NewTag("customer")
+	require.NoError(t, capability.AddTag(crm))
+
+	require.NoError(t, capability.RenameTag(crm, customer))
+
+	require.Len(t, capability.Tags(), 1)
+	assert.Equal(t, "customer", capability.Tags()[0].Value())
+
+	loaded, err := LoadCapabilityFromHistory(capability.GetUncommittedChanges())
+	require.NoError(t, err)
+	require.Len(t, loaded.Tags(), 1)
+	assert.Equal(t, "customer", loaded.Tags()[0].Value())
+}
+
+func TestCapability_RenameTagOntoExistingTagMerges(t *testing.T) {
+	capability := createCapability(t, "Customer Management", "L1")
+	crm, _ := valueobjects.NewTag("crm")
+	customer, _ := valueobjects.NewTag("customer")
+	require.NoError(t, capability.AddTag(crm))
+	require.NoError(t, capability.AddTag(customer))
+	capability.MarkChangesAsCommitted()
+
+	require.NoError(t, capability.RenameTag(crm, customer))
+
+	require.Len(t, capability.Tags(), 1)
+	assert.Equal(t, "customer", capability.Tags()[0].Value())
+}
+
+func TestCapability_RenameTagWithoutSourceIsNoOp(t *testing.T) {
+	capability := createCapability(t, "Customer Management", "L1")
+	crm, _ := valueobjects.NewTag("crm")
+	customer, _ := valueobjects.NewTag("customer")
+	capability.MarkChangesAsCommitted()
+
+	require.NoError(t, capability.RenameTag(crm, customer))
+
+	assert.Empty(t, capability.GetUncommittedChanges())
+	assert.Empty(t, capability.Tags())
+}
+
+func ownerParty(t *testing.T, partyType, id string) valueobjects.OwnershipParty {
+	t.Helper()
+	party, err := valueobjects.NewOwnershipParty(partyType, id)
+	require.NoError(t, err)
+	return party
+}
+
+func ownerRoles(capability *Capability) map[string]valueobjects.RACIRole {
+	roles := make(map[string]valueobjects.RACIRole)
+	for _, owner := range capability.Owners() {
+		roles[owner.Party().ID()] = owner.Role()
+	}
+	return roles
+}
+
+func TestCapability_AssignOwner_ReplacesPreviousAccountableParty(t *testing.T) {
+	capability := createCapability(t, "Customer Management", "L1")
+	alice := ownerParty(t, "user", "alice")
+	crmTeam := ownerParty(t, "team", "crm-team")
+
+	require.NoError(t, capability.AssignOwner(valueobjects.NewRACIAssignment(alice, valueobjects.RACIAccountable)))
+	capability.MarkChangesAsCommitted()
+	require.NoError(t, capability.AssignOwner(valueobjects.NewRACIAssignment(crmTeam, valueobjects.RACIAccountable)))
+
+	changes := capability.GetUncommittedChanges()
+	require.Len(t, changes, 2)
+	unassigned := changes[0].(events.CapabilityOwnerUnassigned)
+	assert.Equal(t, "alice", unassigned.PartyID)
+	assert.Equal(t, events.OwnerUnassignedReplaced, unassigned.Reason)
+	assert.Equal(t, map[string]valueobjects.RACIRole{"crm-team": valueobjects.RACIAccountable}, ownerRoles(capability))
+}
+
+func TestCapability_AssignOwner_ChangesRoleOfExistingParty(t *testing.T) {
+	capability := createCapability(t, "Customer Management", "L1")
+	alice := ownerParty(t, "user", "alice")
+
+	require.NoError(t, capability.AssignOwner(valueobjects.NewRACIAssignment(alice, valueobjects.RACIConsulted)))
+	require.NoError(t, capability.AssignOwner(valueobjects.NewRACIAssignment(alice, valueobjects.RACIResponsible)))
+	capability.MarkChangesAsCommitted()
+	require.NoError(t, capability.AssignOwner(valueobjects.NewRACIAssignment(alice, valueobjects.RACIResponsible)))
+
+	assert.Empty(t, capability.GetUncommittedChanges(), "re-assigning the same role raises nothing")
+	assert.Equal(t, map[string]valueobjects.RACIRole{"alice": valueobjects.RACIResponsible}, ownerRoles(capability))
+}
+
+func TestCapability_UnassignOwner(t *testing.T) {
+	capability := createCapability(t, "Customer Management", "L1")
+	alice := ownerParty(t, "user", "alice")
+	require.NoError(t, capability.AssignOwner(valueobjects.NewRACIAssignment(alice, valueobjects.RACIInformed)))
+
+	require.NoError(t, capability.UnassignOwner(alice))
+	assert.Empty(t, capability.Owners())
+	assert.ErrorIs(t, capability.UnassignOwner(alice), ErrCapabilityOwnerNotFound)
+}
+
+func TestCapability_ReassignOwner(t *testing.T) {
+	tests := []struct {
+		name            string
+		fromRole        valueobjects.RACIRole
+		successorRole   valueobjects.RACIRole
+		accountableOnly bool
+		expected        map[string]valueobjects.RACIRole
+	}{
+		{"role moves to successor", valueobjects.RACIResponsible, "", false, map[string]valueobjects.RACIRole{"bob": valueobjects.RACIResponsible}},
+		{"non-accountable role is dropped when only accountability moves", valueobjects.RACIResponsible, "", true, map[string]valueobjects.RACIRole{}},
+		{"accountability moves", valueobjects.RACIAccountable, "", true, map[string]valueobjects.RACIRole{"bob": valueobjects.RACIAccountable}},
+		{"successor keeps own role", valueobjects.RACIConsulted, valueobjects.RACIInformed, false, map[string]valueobjects.RACIRole{"bob": valueobjects.RACIInformed}},
+		{"accountability overrides successor role", valueobjects.RACIAccountable, valueobjects.RACIInformed, false, map[string]valueobjects.RACIRole{"bob": valueobjects.RACIAccountable}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			capability := createCapability(t, "Customer Management", "L1")
+			alice := ownerParty(t, "user", "alice")
+			bob := ownerParty(t, "user", "bob")
+			require.NoError(t, capability.AssignOwner(valueobjects.NewRACIAssignment(alice, tt.fromRole)))
+			if tt.successorRole != "" {
+				require.NoError(t, capability.AssignOwner(valueobjects.NewRACIAssignment(bob, tt.successorRole)))
+			}
+
+			require.NoError(t, capability.ReassignOwner(alice, bob, tt.accountableOnly, events.OwnerUnassignedReassigned))
+
+			assert.Equal(t, tt.expected, ownerRoles(capability))
+		})
+	}
+}
+
+func TestCapability_ReassignOwner_WithoutSuccessorDropsRole(t *testing.T) {
+	capability := createCapability(t, "Customer Management", "L1")
+	alice := ownerParty(t, "user", "alice")
+	require.NoError(t, capability.AssignOwner(valueobjects.NewRACIAssignment(alice, valueobjects.RACIAccountable)))
+
+	require.NoError(t, capability.ReassignOwner(alice, valueobjects.OwnershipParty{}, true, events.OwnerUnassignedUserDisabled))
+
+	assert.Empty(t, capability.Owners())
+	loaded, err := LoadCapabilityFromHistory(capability.GetUncommittedChanges())
+	require.NoError(t, err)
+	assert.Empty(t, loaded.Owners())
+}
@@ -0,0 +1,110 @@
+package aggregates
+
+import (
+	"fmt"
+	"time"
+
+	"easi/backend/internal/capabilitymapping/domain/events"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// ReferenceMapping links a capability to one entry of a reference model. A
+// capability may map to several entries, in the same or in different models.
+type ReferenceMapping struct {
+	domain.AggregateRoot
+	capabilityID     valueobjects.CapabilityID
+	referenceModelID string
+	entryCode        string
+	mappedAt         time.Time
+}
+
+func MapCapabilityToReference(
+	capabilityID valueobjects.CapabilityID,
+	referenceModelID string,
+	entryCode string,
+	mappedBy string,
+) (*ReferenceMapping, error) {
+	aggregate := &ReferenceMapping{
+		AggregateRoot: domain.NewAggregateRoot(),
+	}
+
+	aggregate.raise(events.NewCapabilityMappedToReference(
+		aggregate.ID(),
+		capabilityID.Value(),
+		referenceModelID,
+		entryCode,
+		mappedBy,
+	))
+
+	return aggregate, nil
+}
+
+func LoadReferenceMappingFromHistory(events []domain.DomainEvent) (*ReferenceMapping, error) {
+	aggregate := &ReferenceMapping{
+		AggregateRoot: domain.NewAggregateRoot(),
+	}
+
+	var applyErr error
+	aggregate.LoadFromHistory(events, func(event domain.DomainEvent) {
+		if applyErr != nil {
+			return
+		}
+		applyErr = aggregate.apply(event)
+	})
+	if applyErr != nil {
+		return nil, applyErr
+	}
+
+	return aggregate, nil
+}
+
+func (m *ReferenceMapping) Unmap() error {
+	m.raise(events.NewCapabilityUnmappedFromReference(
+		m.ID(),
+		m.capabilityID.Value(),
+		m.referenceModelID,
+		m.entryCode,
+	))
+	return nil
+}
+
+func (m *ReferenceMapping) raise(event domain.DomainEvent) {
+	if err := m.apply(event); err != nil {
+		panic(fmt.Sprintf("capabilitymapping: in-process apply failed: %v", err))
+	}
+	m.RaiseEvent(event)
+}
+
+func (m *ReferenceMapping) apply(event domain.DomainEvent) error {
+	switch e := event.(type) {
+	case events.CapabilityMappedToReference:
+		m.AggregateRoot = domain.NewAggregateRootWithID(e.ID)
+		capabilityID, err := valueobjects.NewCapabilityIDFromString(e.CapabilityID)
+		if err != nil {
+			return fmt.Errorf("%w: capability ID %q: %v", domain.ErrCorruptedEvent, e.CapabilityID, err)
+		}
+		m.capabilityID = capabilityID
+		m.referenceModelID = e.ReferenceModelID
+		m.entryCode = e.EntryCode
+		m.mappedAt = e.MappedAt
+	case events.CapabilityUnmappedFromReference:
+	}
+	return nil
+}
+
+func (m *ReferenceMapping) CapabilityID() valueobjects.CapabilityID {
+	return m.capabilityID
+}
+
+func (m *ReferenceMapping) ReferenceModelID() string {
+	return m.referenceModelID
+}
+
+func (m *ReferenceMapping) EntryCode() string {
+	return m.entryCode
+}
+
+func (m *ReferenceMapping) MappedAt() time.Time {
+	return m.mappedAt
+}
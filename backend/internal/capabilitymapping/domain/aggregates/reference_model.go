@@ -0,0 +1,165 @@
+package aggregates
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"easi/backend/internal/capabilitymapping/domain/events"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const maxReferenceModelNameLength = 200
+
+var (
+	ErrReferenceModelNameEmpty   = errors.New("reference model name cannot be empty")
+	ErrReferenceModelNameTooLong = fmt.Errorf("reference model name cannot exceed %d characters", maxReferenceModelNameLength)
+)
+
+// ReferenceModel is an industry reference capability catalogue (BIAN, APQC PCF,
+// TOGAF...). It is read-only once imported: the only change it accepts is deletion.
+type ReferenceModel struct {
+	domain.AggregateRoot
+	name        string
+	framework   valueobjects.ReferenceFramework
+	version     string
+	description string
+	catalogue   valueobjects.ReferenceCatalogue
+	importedAt  time.Time
+}
+
+type ReferenceModelSpec struct {
+	Name        string
+	Framework   valueobjects.ReferenceFramework
+	Version     string
+	Description string
+	Catalogue   valueobjects.ReferenceCatalogue
+	ImportedBy  string
+}
+
+func ImportReferenceModel(spec ReferenceModelSpec) (*ReferenceModel, error) {
+	name := strings.TrimSpace(spec.Name)
+	if name == "" {
+		return nil, ErrReferenceModelNameEmpty
+	}
+	if len(name) > maxReferenceModelNameLength {
+		return nil, ErrReferenceModelNameTooLong
+	}
+
+	aggregate := &ReferenceModel{
+		AggregateRoot: domain.NewAggregateRoot(),
+	}
+
+	entries := spec.Catalogue.Entries()
+	eventEntries := make([]events.ReferenceModelEntry, len(entries))
+	for i, entry := range entries {
+		eventEntries[i] = events.ReferenceModelEntry{
+			Code:        entry.Code(),
+			Name:        entry.Name(),
+			Description: entry.Description(),
+			ParentCode:  entry.ParentCode(),
+			Level:       entry.Level(),
+		}
+	}
+
+	aggregate.raise(events.NewReferenceModelImported(events.ReferenceModelImportedParams{
+		ID:          aggregate.ID(),
+		Name:        name,
+		Framework:   spec.Framework.String(),
+		Version:     strings.TrimSpace(spec.Version),
+		Description: strings.TrimSpace(spec.Description),
+		Entries:     eventEntries,
+		ImportedBy:  spec.ImportedBy,
+	}))
+
+	return aggregate, nil
+}
+
+func LoadReferenceModelFromHistory(events []domain.DomainEvent) (*ReferenceModel, error) {
+	aggregate := &ReferenceModel{
+		AggregateRoot: domain.NewAggregateRoot(),
+	}
+
+	var applyErr error
+	aggregate.LoadFromHistory(events, func(event domain.DomainEvent) {
+		if applyErr != nil {
+			return
+		}
+		applyErr = aggregate.apply(event)
+	})
+	if applyErr != nil {
+		return nil, applyErr
+	}
+
+	return aggregate, nil
+}
+
+func (m *ReferenceModel) Delete() error {
+	m.raise(events.NewReferenceModelDeleted(m.ID()))
+	return nil
+}
+
+func (m *ReferenceModel) raise(event domain.DomainEvent) {
+	if err := m.apply(event); err != nil {
+		panic(fmt.Sprintf("capabilitymapping: in-process apply failed: %v", err))
+	}
+	m.RaiseEvent(event)
+}
+
+func (m *ReferenceModel) apply(event domain.DomainEvent) error {
+	switch e := event.(type) {
+	case events.ReferenceModelImported:
+		m.AggregateRoot = domain.NewAggregateRootWithID(e.ID)
+		framework, err := valueobjects.NewReferenceFramework(e.Framework)
+		if err != nil {
+			return fmt.Errorf("%w: reference framework %q: %v", domain.ErrCorruptedEvent, e.Framework, err)
+		}
+		specs := make([]valueobjects.ReferenceEntrySpec, len(e.Entries))
+		for i, entry := range e.Entries {
+			specs[i] = valueobjects.ReferenceEntrySpec{
+				Code:        entry.Code,
+				Name:        entry.Name,
+				Description: entry.Description,
+				ParentCode:  entry.ParentCode,
+			}
+		}
+		catalogue, err := valueobjects.NewReferenceCatalogue(specs)
+		if err != nil {
+			return fmt.Errorf("%w: reference catalogue of %s: %v", domain.ErrCorruptedEvent, e.ID, err)
+		}
+		m.name = e.Name
+		m.framework = framework
+		m.version = e.Version
+		m.description = e.Description
+		m.catalogue = catalogue
+		m.importedAt = e.ImportedAt
+	case events.ReferenceModelDeleted:
+	}
+	return nil
+}
+
+func (m *ReferenceModel) Name() string {
+	return m.name
+}
+
+func (m *ReferenceModel) Framework() valueobjects.ReferenceFramework {
+	return m.framework
+}
+
+func (m *ReferenceModel) FrameworkVersion() string {
+	return m.version
+}
+
+func (m *ReferenceModel) Description() string {
+	return m.description
+}
+
+func (m *ReferenceModel) Catalogue() valueobjects.ReferenceCatalogue {
+	return m.catalogue
+}
+
+func (m *ReferenceModel) ImportedAt() time.Time {
+	return m.importedAt
+}
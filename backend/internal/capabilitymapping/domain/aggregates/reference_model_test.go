@@ -0,0 +1,90 @@
+package aggregates
+
+import (
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/domain/events"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReferenceCatalogue(t *testing.T) valueobjects.ReferenceCatalogue {
+	t.Helper()
+	catalogue, err := valueobjects.NewReferenceCatalogue([]valueobjects.ReferenceEntrySpec{
+		{Code: "SD-SALES", Name: "Sales"},
+		{Code: "SD-LEADS", Name: "Lead Management", ParentCode: "SD-SALES"},
+	})
+	require.NoError(t, err)
+	return catalogue
+}
+
+func TestImportReferenceModel(t *testing.T) {
+	model, err := ImportReferenceModel(ReferenceModelSpec{
+		Name:       "  BIAN Service Landscape ",
+		Framework:  valueobjects.ReferenceFrameworkBIAN,
+		Version:    "12.0",
+		Catalogue:  newTestReferenceCatalogue(t),
+		ImportedBy: "architect@example.com",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "BIAN Service Landscape", model.Name())
+	assert.Equal(t, valueobjects.ReferenceFrameworkBIAN, model.Framework())
+	assert.Equal(t, 2, model.Catalogue().Len())
+
+	changes := model.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	imported, ok := changes[0].(events.ReferenceModelImported)
+	require.True(t, ok)
+	assert.Equal(t, []events.ReferenceModelEntry{
+		{Code: "SD-SALES", Name: "Sales", Level: 1},
+		{Code: "SD-LEADS", Name: "Lead Management", ParentCode: "SD-SALES", Level: 2},
+	}, imported.Entries)
+}
+
+func TestImportReferenceModel_RequiresName(t *testing.T) {
+	_, err := ImportReferenceModel(ReferenceModelSpec{
+		Name:      " ",
+		Framework: valueobjects.ReferenceFrameworkAPQC,
+		Catalogue: newTestReferenceCatalogue(t),
+	})
+	assert.ErrorIs(t, err, ErrReferenceModelNameEmpty)
+}
+
+func TestLoadReferenceModelFromHistory(t *testing.T) {
+	model, err := ImportReferenceModel(ReferenceModelSpec{
+		Name:      "APQC PCF",
+		Framework: valueobjects.ReferenceFrameworkAPQC,
+		Catalogue: newTestReferenceCatalogue(t),
+	})
+	require.NoError(t, err)
+
+	loaded, err := LoadReferenceModelFromHistory(model.GetUncommittedChanges())
+	require.NoError(t, err)
+
+	assert.Equal(t, model.ID(), loaded.ID())
+	entry, ok := loaded.Catalogue().Entry("SD-LEADS")
+	require.True(t, ok)
+	assert.Equal(t, 2, entry.Level())
+}
+
+func TestReferenceMapping_MapAndUnmap(t *testing.T) {
+	capabilityID := valueobjects.NewCapabilityID()
+
+	mapping, err := MapCapabilityToReference(capabilityID, "model-1", "SD-LEADS", "architect@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "SD-LEADS", mapping.EntryCode())
+	assert.Equal(t, "model-1", mapping.ReferenceModelID())
+
+	mapping.MarkChangesAsCommitted()
+	require.NoError(t, mapping.Unmap())
+
+	changes := mapping.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	unmapped, ok := changes[0].(events.CapabilityUnmappedFromReference)
+	require.True(t, ok)
+	assert.Equal(t, capabilityID.Value(), unmapped.CapabilityID)
+	assert.Equal(t, "SD-LEADS", unmapped.EntryCode)
+}
@@ -8,21 +8,33 @@ import (
 
 type BusinessDomainCreated struct {
 	domain.BaseEvent
-	ID                string    `json:"id"`
-	Name              string    `json:"name"`
-	Description       string    `json:"description"`
-	DomainArchitectID string    `json:"domainArchitectId"`
-	CreatedAt         time.Time `json:"createdAt"`
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	Description        string    `json:"description"`
+	ParentID           string    `json:"parentId,omitempty"`
+	DomainArchitectID  string    `json:"domainArchitectId"`
+	DomainArchitectIDs []string  `json:"domainArchitectIds,omitempty"`
+	CreatedAt          time.Time `json:"createdAt"`
 }
 
-func NewBusinessDomainCreated(id, name, description, domainArchitectID string) BusinessDomainCreated {
+type BusinessDomainCreatedParams struct {
+	ID           string
+	Name         string
+	Description  string
+	ParentID     string
+	ArchitectIDs []string
+}
+
+func NewBusinessDomainCreated(params BusinessDomainCreatedParams) BusinessDomainCreated {
 	return BusinessDomainCreated{
-		BaseEvent:         domain.NewBaseEvent(id),
-		ID:                id,
-		Name:              name,
-		Description:       description,
-		DomainArchitectID: domainArchitectID,
-		CreatedAt:         time.Now().UTC(),
+		BaseEvent:          domain.NewBaseEvent(params.ID),
+		ID:                 params.ID,
+		Name:               params.Name,
+		Description:        params.Description,
+		ParentID:           params.ParentID,
+		DomainArchitectID:  firstArchitect(params.ArchitectIDs),
+		DomainArchitectIDs: params.ArchitectIDs,
+		CreatedAt:          time.Now().UTC(),
 	}
 }
 
@@ -32,11 +44,13 @@ func (e BusinessDomainCreated) EventType() string {
 
 func (e BusinessDomainCreated) EventData() map[string]interface{} {
 	return map[string]interface{}{
-		"id":                e.ID,
-		"name":              e.Name,
-		"description":       e.Description,
-		"domainArchitectId": e.DomainArchitectID,
-		"createdAt":         e.CreatedAt,
+		"id":                 e.ID,
+		"name":               e.Name,
+		"description":        e.Description,
+		"parentId":           e.ParentID,
+		"domainArchitectId":  e.DomainArchitectID,
+		"domainArchitectIds": e.DomainArchitectIDs,
+		"createdAt":          e.CreatedAt,
 	}
 }
 
@@ -46,3 +60,23 @@ func (e BusinessDomainCreated) AggregateID() string {
 	}
 	return e.ID
 }
+
+// ArchitectIDs returns the domain architects, falling back to the single
+// architect recorded by events from before domains had several.
+func (e BusinessDomainCreated) ArchitectIDs() []string {
+	return architectsOrLegacy(e.DomainArchitectIDs, e.DomainArchitectID)
+}
+
+func firstArchitect(ids []string) string {
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
+func architectsOrLegacy(ids []string, legacy string) []string {
+	if len(ids) > 0 || legacy == "" {
+		return ids
+	}
+	return []string{legacy}
+}
@@ -0,0 +1,45 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type BusinessDomainParentChanged struct {
+	domain.BaseEvent
+	ID          string    `json:"id"`
+	OldParentID string    `json:"oldParentId"`
+	NewParentID string    `json:"newParentId"`
+	ChangedAt   time.Time `json:"changedAt"`
+}
+
+func NewBusinessDomainParentChanged(id, oldParentID, newParentID string) BusinessDomainParentChanged {
+	return BusinessDomainParentChanged{
+		BaseEvent:   domain.NewBaseEvent(id),
+		ID:          id,
+		OldParentID: oldParentID,
+		NewParentID: newParentID,
+		ChangedAt:   time.Now().UTC(),
+	}
+}
+
+func (e BusinessDomainParentChanged) EventType() string {
+	return "BusinessDomainParentChanged"
+}
+
+func (e BusinessDomainParentChanged) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          e.ID,
+		"oldParentId": e.OldParentID,
+		"newParentId": e.NewParentID,
+		"changedAt":   e.ChangedAt,
+	}
+}
+
+func (e BusinessDomainParentChanged) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
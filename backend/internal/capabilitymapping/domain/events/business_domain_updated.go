@@ -7,21 +7,23 @@ import (
 
 type BusinessDomainUpdated struct {
 	domain.BaseEvent
-	ID                string    `json:"id"`
-	Name              string    `json:"name"`
-	Description       string    `json:"description"`
-	DomainArchitectID string    `json:"domainArchitectId"`
-	UpdatedAt         time.Time `json:"updatedAt"`
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	Description        string    `json:"description"`
+	DomainArchitectID  string    `json:"domainArchitectId"`
+	DomainArchitectIDs []string  `json:"domainArchitectIds,omitempty"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
 
-func NewBusinessDomainUpdated(id, name, description, domainArchitectID string) BusinessDomainUpdated {
+func NewBusinessDomainUpdated(id, name, description string, architectIDs []string) BusinessDomainUpdated {
 	return BusinessDomainUpdated{
-		BaseEvent:         domain.NewBaseEvent(id),
-		ID:                id,
-		Name:              name,
-		Description:       description,
-		DomainArchitectID: domainArchitectID,
-		UpdatedAt:         time.Now().UTC(),
+		BaseEvent:          domain.NewBaseEvent(id),
+		ID:                 id,
+		Name:               name,
+		Description:        description,
+		DomainArchitectID:  firstArchitect(architectIDs),
+		DomainArchitectIDs: architectIDs,
+		UpdatedAt:          time.Now().UTC(),
 	}
 }
 
@@ -31,11 +33,12 @@ func (e BusinessDomainUpdated) EventType() string {
 
 func (e BusinessDomainUpdated) EventData() map[string]interface{} {
 	return map[string]interface{}{
-		"id":                e.ID,
-		"name":              e.Name,
-		"description":       e.Description,
-		"domainArchitectId": e.DomainArchitectID,
-		"updatedAt":         e.UpdatedAt,
+		"id":                 e.ID,
+		"name":               e.Name,
+		"description":        e.Description,
+		"domainArchitectId":  e.DomainArchitectID,
+		"domainArchitectIds": e.DomainArchitectIDs,
+		"updatedAt":          e.UpdatedAt,
 	}
 }
 
@@ -45,3 +48,7 @@ func (e BusinessDomainUpdated) AggregateID() string {
 	}
 	return e.ID
 }
+
+func (e BusinessDomainUpdated) ArchitectIDs() []string {
+	return architectsOrLegacy(e.DomainArchitectIDs, e.DomainArchitectID)
+}
@@ -0,0 +1,96 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// CapabilityMergedInto is raised on a capability whose content is moved onto a
+// surviving capability, just before it is deleted.
+type CapabilityMergedInto struct {
+	domain.BaseEvent
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	SurvivorID   string    `json:"survivorId"`
+	SurvivorName string    `json:"survivorName"`
+	MergedBy     string    `json:"mergedBy"`
+	MergedAt     time.Time `json:"mergedAt"`
+}
+
+func NewCapabilityMergedInto(id, name, survivorID, survivorName, mergedBy string) CapabilityMergedInto {
+	return CapabilityMergedInto{
+		BaseEvent:    domain.NewBaseEvent(id),
+		ID:           id,
+		Name:         name,
+		SurvivorID:   survivorID,
+		SurvivorName: survivorName,
+		MergedBy:     mergedBy,
+		MergedAt:     time.Now().UTC(),
+	}
+}
+
+func (e CapabilityMergedInto) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e CapabilityMergedInto) EventType() string {
+	return "CapabilityMergedInto"
+}
+
+func (e CapabilityMergedInto) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":           e.ID,
+		"name":         e.Name,
+		"survivorId":   e.SurvivorID,
+		"survivorName": e.SurvivorName,
+		"mergedBy":     e.MergedBy,
+		"mergedAt":     e.MergedAt,
+	}
+}
+
+// CapabilityAbsorbed is raised on the surviving capability of a merge, once per
+// merged capability.
+type CapabilityAbsorbed struct {
+	domain.BaseEvent
+	ID         string    `json:"id"`
+	MergedID   string    `json:"mergedId"`
+	MergedName string    `json:"mergedName"`
+	MergedBy   string    `json:"mergedBy"`
+	MergedAt   time.Time `json:"mergedAt"`
+}
+
+func NewCapabilityAbsorbed(id, mergedID, mergedName, mergedBy string) CapabilityAbsorbed {
+	return CapabilityAbsorbed{
+		BaseEvent:  domain.NewBaseEvent(id),
+		ID:         id,
+		MergedID:   mergedID,
+		MergedName: mergedName,
+		MergedBy:   mergedBy,
+		MergedAt:   time.Now().UTC(),
+	}
+}
+
+func (e CapabilityAbsorbed) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e CapabilityAbsorbed) EventType() string {
+	return "CapabilityAbsorbed"
+}
+
+func (e CapabilityAbsorbed) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"mergedId":   e.MergedID,
+		"mergedName": e.MergedName,
+		"mergedBy":   e.MergedBy,
+		"mergedAt":   e.MergedAt,
+	}
+}
@@ -0,0 +1,47 @@
+package events
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	"time"
+)
+
+type CapabilityOwnerAssigned struct {
+	domain.BaseEvent
+	CapabilityID string    `json:"capabilityId"`
+	PartyType    string    `json:"partyType"`
+	PartyID      string    `json:"partyId"`
+	Role         string    `json:"role"`
+	AssignedAt   time.Time `json:"assignedAt"`
+}
+
+func NewCapabilityOwnerAssigned(capabilityID, partyType, partyID, role string) CapabilityOwnerAssigned {
+	return CapabilityOwnerAssigned{
+		BaseEvent:    domain.NewBaseEvent(capabilityID),
+		CapabilityID: capabilityID,
+		PartyType:    partyType,
+		PartyID:      partyID,
+		Role:         role,
+		AssignedAt:   time.Now().UTC(),
+	}
+}
+
+func (e CapabilityOwnerAssigned) EventType() string {
+	return "CapabilityOwnerAssigned"
+}
+
+func (e CapabilityOwnerAssigned) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"capabilityId": e.CapabilityID,
+		"partyType":    e.PartyType,
+		"partyId":      e.PartyID,
+		"role":         e.Role,
+		"assignedAt":   e.AssignedAt,
+	}
+}
+
+func (e CapabilityOwnerAssigned) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.CapabilityID
+}
@@ -0,0 +1,57 @@
+package events
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	"time"
+)
+
+const (
+	OwnerUnassignedRemoved      = "removed"
+	OwnerUnassignedReplaced     = "replaced"
+	OwnerUnassignedReassigned   = "reassigned"
+	OwnerUnassignedUserDisabled = "user-disabled"
+)
+
+type CapabilityOwnerUnassigned struct {
+	domain.BaseEvent
+	CapabilityID string    `json:"capabilityId"`
+	PartyType    string    `json:"partyType"`
+	PartyID      string    `json:"partyId"`
+	Role         string    `json:"role"`
+	Reason       string    `json:"reason"`
+	UnassignedAt time.Time `json:"unassignedAt"`
+}
+
+func NewCapabilityOwnerUnassigned(capabilityID, partyType, partyID, role, reason string) CapabilityOwnerUnassigned {
+	return CapabilityOwnerUnassigned{
+		BaseEvent:    domain.NewBaseEvent(capabilityID),
+		CapabilityID: capabilityID,
+		PartyType:    partyType,
+		PartyID:      partyID,
+		Role:         role,
+		Reason:       reason,
+		UnassignedAt: time.Now().UTC(),
+	}
+}
+
+func (e CapabilityOwnerUnassigned) EventType() string {
+	return "CapabilityOwnerUnassigned"
+}
+
+func (e CapabilityOwnerUnassigned) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"capabilityId": e.CapabilityID,
+		"partyType":    e.PartyType,
+		"partyId":      e.PartyID,
+		"role":         e.Role,
+		"reason":       e.Reason,
+		"unassignedAt": e.UnassignedAt,
+	}
+}
+
+func (e CapabilityOwnerUnassigned) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.CapabilityID
+}
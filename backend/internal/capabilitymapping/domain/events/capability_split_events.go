@@ -0,0 +1,106 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type CapabilitySplitPart struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// CapabilitySplit is raised on a capability that is divided into new sibling
+// capabilities, just before it is deleted. The first part receives anything the
+// architect did not distribute explicitly.
+type CapabilitySplit struct {
+	domain.BaseEvent
+	ID      string                `json:"id"`
+	Name    string                `json:"name"`
+	Parts   []CapabilitySplitPart `json:"parts"`
+	SplitBy string                `json:"splitBy"`
+	SplitAt time.Time             `json:"splitAt"`
+}
+
+func NewCapabilitySplit(id, name string, parts []CapabilitySplitPart, splitBy string) CapabilitySplit {
+	return CapabilitySplit{
+		BaseEvent: domain.NewBaseEvent(id),
+		ID:        id,
+		Name:      name,
+		Parts:     parts,
+		SplitBy:   splitBy,
+		SplitAt:   time.Now().UTC(),
+	}
+}
+
+func (e CapabilitySplit) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e CapabilitySplit) EventType() string {
+	return "CapabilitySplit"
+}
+
+func (e CapabilitySplit) EventData() map[string]interface{} {
+	parts := make([]map[string]interface{}, len(e.Parts))
+	for i, part := range e.Parts {
+		parts[i] = map[string]interface{}{
+			"id":   part.ID,
+			"name": part.Name,
+		}
+	}
+	return map[string]interface{}{
+		"id":      e.ID,
+		"name":    e.Name,
+		"parts":   parts,
+		"splitBy": e.SplitBy,
+		"splitAt": e.SplitAt,
+	}
+}
+
+// CapabilitySplitOff is raised on each capability created by a split, linking it
+// back to the capability it came from.
+type CapabilitySplitOff struct {
+	domain.BaseEvent
+	ID         string    `json:"id"`
+	SourceID   string    `json:"sourceId"`
+	SourceName string    `json:"sourceName"`
+	SplitBy    string    `json:"splitBy"`
+	SplitAt    time.Time `json:"splitAt"`
+}
+
+func NewCapabilitySplitOff(id, sourceID, sourceName, splitBy string) CapabilitySplitOff {
+	return CapabilitySplitOff{
+		BaseEvent:  domain.NewBaseEvent(id),
+		ID:         id,
+		SourceID:   sourceID,
+		SourceName: sourceName,
+		SplitBy:    splitBy,
+		SplitAt:    time.Now().UTC(),
+	}
+}
+
+func (e CapabilitySplitOff) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e CapabilitySplitOff) EventType() string {
+	return "CapabilitySplitOff"
+}
+
+func (e CapabilitySplitOff) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"sourceId":   e.SourceID,
+		"sourceName": e.SourceName,
+		"splitBy":    e.SplitBy,
+		"splitAt":    e.SplitAt,
+	}
+}
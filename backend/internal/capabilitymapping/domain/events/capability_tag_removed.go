@@ -0,0 +1,41 @@
+package events
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	"time"
+)
+
+type CapabilityTagRemoved struct {
+	domain.BaseEvent
+	CapabilityID string    `json:"capabilityId"`
+	Tag          string    `json:"tag"`
+	RemovedAt    time.Time `json:"removedAt"`
+}
+
+func NewCapabilityTagRemoved(capabilityID, tag string) CapabilityTagRemoved {
+	return CapabilityTagRemoved{
+		BaseEvent:    domain.NewBaseEvent(capabilityID),
+		CapabilityID: capabilityID,
+		Tag:          tag,
+		RemovedAt:    time.Now().UTC(),
+	}
+}
+
+func (e CapabilityTagRemoved) EventType() string {
+	return "CapabilityTagRemoved"
+}
+
+func (e CapabilityTagRemoved) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"capabilityId": e.CapabilityID,
+		"tag":          e.Tag,
+		"removedAt":    e.RemovedAt,
+	}
+}
+
+func (e CapabilityTagRemoved) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.CapabilityID
+}
@@ -0,0 +1,47 @@
+package events
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	"time"
+)
+
+// CapabilityTagRenamed replaces one tag with another on a capability. When the
+// capability already carries the new tag the old one is simply dropped, which
+// is how tag merges are recorded.
+type CapabilityTagRenamed struct {
+	domain.BaseEvent
+	CapabilityID string    `json:"capabilityId"`
+	From         string    `json:"from"`
+	To           string    `json:"to"`
+	RenamedAt    time.Time `json:"renamedAt"`
+}
+
+func NewCapabilityTagRenamed(capabilityID, from, to string) CapabilityTagRenamed {
+	return CapabilityTagRenamed{
+		BaseEvent:    domain.NewBaseEvent(capabilityID),
+		CapabilityID: capabilityID,
+		From:         from,
+		To:           to,
+		RenamedAt:    time.Now().UTC(),
+	}
+}
+
+func (e CapabilityTagRenamed) EventType() string {
+	return "CapabilityTagRenamed"
+}
+
+func (e CapabilityTagRenamed) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"capabilityId": e.CapabilityID,
+		"from":         e.From,
+		"to":           e.To,
+		"renamedAt":    e.RenamedAt,
+	}
+}
+
+func (e CapabilityTagRenamed) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.CapabilityID
+}
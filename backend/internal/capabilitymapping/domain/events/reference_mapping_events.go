@@ -0,0 +1,94 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// CapabilityMappedToReference links one of our capabilities to an entry of a
+// reference model, so the map can be benchmarked against the framework.
+type CapabilityMappedToReference struct {
+	domain.BaseEvent
+	ID               string    `json:"id"`
+	CapabilityID     string    `json:"capabilityId"`
+	ReferenceModelID string    `json:"referenceModelId"`
+	EntryCode        string    `json:"entryCode"`
+	MappedBy         string    `json:"mappedBy"`
+	MappedAt         time.Time `json:"mappedAt"`
+}
+
+func NewCapabilityMappedToReference(id, capabilityID, referenceModelID, entryCode, mappedBy string) CapabilityMappedToReference {
+	return CapabilityMappedToReference{
+		BaseEvent:        domain.NewBaseEvent(id),
+		ID:               id,
+		CapabilityID:     capabilityID,
+		ReferenceModelID: referenceModelID,
+		EntryCode:        entryCode,
+		MappedBy:         mappedBy,
+		MappedAt:         time.Now().UTC(),
+	}
+}
+
+func (e CapabilityMappedToReference) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e CapabilityMappedToReference) EventType() string {
+	return "CapabilityMappedToReference"
+}
+
+func (e CapabilityMappedToReference) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":               e.ID,
+		"capabilityId":     e.CapabilityID,
+		"referenceModelId": e.ReferenceModelID,
+		"entryCode":        e.EntryCode,
+		"mappedBy":         e.MappedBy,
+		"mappedAt":         e.MappedAt,
+	}
+}
+
+type CapabilityUnmappedFromReference struct {
+	domain.BaseEvent
+	ID               string    `json:"id"`
+	CapabilityID     string    `json:"capabilityId"`
+	ReferenceModelID string    `json:"referenceModelId"`
+	EntryCode        string    `json:"entryCode"`
+	UnmappedAt       time.Time `json:"unmappedAt"`
+}
+
+func NewCapabilityUnmappedFromReference(id, capabilityID, referenceModelID, entryCode string) CapabilityUnmappedFromReference {
+	return CapabilityUnmappedFromReference{
+		BaseEvent:        domain.NewBaseEvent(id),
+		ID:               id,
+		CapabilityID:     capabilityID,
+		ReferenceModelID: referenceModelID,
+		EntryCode:        entryCode,
+		UnmappedAt:       time.Now().UTC(),
+	}
+}
+
+func (e CapabilityUnmappedFromReference) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e CapabilityUnmappedFromReference) EventType() string {
+	return "CapabilityUnmappedFromReference"
+}
+
+func (e CapabilityUnmappedFromReference) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":               e.ID,
+		"capabilityId":     e.CapabilityID,
+		"referenceModelId": e.ReferenceModelID,
+		"entryCode":        e.EntryCode,
+		"unmappedAt":       e.UnmappedAt,
+	}
+}
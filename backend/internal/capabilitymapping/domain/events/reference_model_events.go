@@ -0,0 +1,119 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type ReferenceModelEntry struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	ParentCode  string `json:"parentCode,omitempty"`
+	Level       int    `json:"level"`
+}
+
+// ReferenceModelImported carries the whole catalogue: reference models are
+// read-only once imported, so a new framework version is a new import.
+type ReferenceModelImported struct {
+	domain.BaseEvent
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Framework   string                `json:"framework"`
+	Version     string                `json:"version"`
+	Description string                `json:"description"`
+	Entries     []ReferenceModelEntry `json:"entries"`
+	ImportedBy  string                `json:"importedBy"`
+	ImportedAt  time.Time             `json:"importedAt"`
+}
+
+type ReferenceModelImportedParams struct {
+	ID          string
+	Name        string
+	Framework   string
+	Version     string
+	Description string
+	Entries     []ReferenceModelEntry
+	ImportedBy  string
+}
+
+func NewReferenceModelImported(params ReferenceModelImportedParams) ReferenceModelImported {
+	return ReferenceModelImported{
+		BaseEvent:   domain.NewBaseEvent(params.ID),
+		ID:          params.ID,
+		Name:        params.Name,
+		Framework:   params.Framework,
+		Version:     params.Version,
+		Description: params.Description,
+		Entries:     params.Entries,
+		ImportedBy:  params.ImportedBy,
+		ImportedAt:  time.Now().UTC(),
+	}
+}
+
+func (e ReferenceModelImported) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e ReferenceModelImported) EventType() string {
+	return "ReferenceModelImported"
+}
+
+func (e ReferenceModelImported) EventData() map[string]interface{} {
+	entries := make([]map[string]interface{}, len(e.Entries))
+	for i, entry := range e.Entries {
+		entries[i] = map[string]interface{}{
+			"code":        entry.Code,
+			"name":        entry.Name,
+			"description": entry.Description,
+			"parentCode":  entry.ParentCode,
+			"level":       entry.Level,
+		}
+	}
+	return map[string]interface{}{
+		"id":          e.ID,
+		"name":        e.Name,
+		"framework":   e.Framework,
+		"version":     e.Version,
+		"description": e.Description,
+		"entries":     entries,
+		"importedBy":  e.ImportedBy,
+		"importedAt":  e.ImportedAt,
+	}
+}
+
+type ReferenceModelDeleted struct {
+	domain.BaseEvent
+	ID        string    `json:"id"`
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+func NewReferenceModelDeleted(id string) ReferenceModelDeleted {
+	return ReferenceModelDeleted{
+		BaseEvent: domain.NewBaseEvent(id),
+		ID:        id,
+		DeletedAt: time.Now().UTC(),
+	}
+}
+
+func (e ReferenceModelDeleted) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e ReferenceModelDeleted) EventType() string {
+	return "ReferenceModelDeleted"
+}
+
+func (e ReferenceModelDeleted) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        e.ID,
+		"deletedAt": e.DeletedAt,
+	}
+}
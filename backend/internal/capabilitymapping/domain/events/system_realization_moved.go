@@ -0,0 +1,61 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// SystemRealizationMoved moves a realization to another capability, keeping its
+// identity, component, level and notes. It is raised when capabilities are merged or split.
+type SystemRealizationMoved struct {
+	domain.BaseEvent
+	ID               string    `json:"id"`
+	ComponentID      string    `json:"componentId"`
+	FromCapabilityID string    `json:"fromCapabilityId"`
+	ToCapabilityID   string    `json:"toCapabilityId"`
+	RealizationLevel string    `json:"realizationLevel"`
+	MovedAt          time.Time `json:"movedAt"`
+}
+
+type SystemRealizationMove struct {
+	ID               string
+	ComponentID      string
+	FromCapabilityID string
+	ToCapabilityID   string
+	RealizationLevel string
+}
+
+func NewSystemRealizationMoved(m SystemRealizationMove) SystemRealizationMoved {
+	return SystemRealizationMoved{
+		BaseEvent:        domain.NewBaseEvent(m.ID),
+		ID:               m.ID,
+		ComponentID:      m.ComponentID,
+		FromCapabilityID: m.FromCapabilityID,
+		ToCapabilityID:   m.ToCapabilityID,
+		RealizationLevel: m.RealizationLevel,
+		MovedAt:          time.Now().UTC(),
+	}
+}
+
+func (e SystemRealizationMoved) EventType() string {
+	return "SystemRealizationMoved"
+}
+
+func (e SystemRealizationMoved) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":               e.ID,
+		"componentId":      e.ComponentID,
+		"fromCapabilityId": e.FromCapabilityID,
+		"toCapabilityId":   e.ToCapabilityID,
+		"realizationLevel": e.RealizationLevel,
+		"movedAt":          e.MovedAt,
+	}
+}
+
+func (e SystemRealizationMoved) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
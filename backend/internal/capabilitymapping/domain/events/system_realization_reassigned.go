@@ -0,0 +1,65 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// SystemRealizationReassigned moves a realization to another component, keeping its
+// identity, level and notes. It is raised when duplicate components are merged.
+type SystemRealizationReassigned struct {
+	domain.BaseEvent
+	ID               string    `json:"id"`
+	CapabilityID     string    `json:"capabilityId"`
+	FromComponentID  string    `json:"fromComponentId"`
+	ToComponentID    string    `json:"toComponentId"`
+	ToComponentName  string    `json:"toComponentName"`
+	RealizationLevel string    `json:"realizationLevel"`
+	ReassignedAt     time.Time `json:"reassignedAt"`
+}
+
+type SystemRealizationReassignment struct {
+	ID               string
+	CapabilityID     string
+	FromComponentID  string
+	ToComponentID    string
+	ToComponentName  string
+	RealizationLevel string
+}
+
+func NewSystemRealizationReassigned(r SystemRealizationReassignment) SystemRealizationReassigned {
+	return SystemRealizationReassigned{
+		BaseEvent:        domain.NewBaseEvent(r.ID),
+		ID:               r.ID,
+		CapabilityID:     r.CapabilityID,
+		FromComponentID:  r.FromComponentID,
+		ToComponentID:    r.ToComponentID,
+		ToComponentName:  r.ToComponentName,
+		RealizationLevel: r.RealizationLevel,
+		ReassignedAt:     time.Now().UTC(),
+	}
+}
+
+func (e SystemRealizationReassigned) EventType() string {
+	return "SystemRealizationReassigned"
+}
+
+func (e SystemRealizationReassigned) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":               e.ID,
+		"capabilityId":     e.CapabilityID,
+		"fromComponentId":  e.FromComponentID,
+		"toComponentId":    e.ToComponentID,
+		"toComponentName":  e.ToComponentName,
+		"realizationLevel": e.RealizationLevel,
+		"reassignedAt":     e.ReassignedAt,
+	}
+}
+
+func (e SystemRealizationReassigned) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
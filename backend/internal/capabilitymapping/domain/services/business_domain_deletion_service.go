@@ -9,23 +9,30 @@ import (
 
 var (
 	ErrBusinessDomainHasAssignments = errors.New("cannot delete business domain with active capability assignments")
+	ErrBusinessDomainHasChildren    = errors.New("cannot delete business domain with child domains")
 )
 
 type BusinessDomainAssignmentChecker interface {
 	HasAssignments(ctx context.Context, domainID valueobjects.BusinessDomainID) (bool, error)
 }
 
+type BusinessDomainChildrenChecker interface {
+	HasChildren(ctx context.Context, domainID valueobjects.BusinessDomainID) (bool, error)
+}
+
 type BusinessDomainDeletionService interface {
 	CanDelete(ctx context.Context, domainID valueobjects.BusinessDomainID) error
 }
 
 type businessDomainDeletionService struct {
 	assignmentChecker BusinessDomainAssignmentChecker
+	childrenChecker   BusinessDomainChildrenChecker
 }
 
-func NewBusinessDomainDeletionService(assignmentChecker BusinessDomainAssignmentChecker) BusinessDomainDeletionService {
+func NewBusinessDomainDeletionService(assignmentChecker BusinessDomainAssignmentChecker, childrenChecker BusinessDomainChildrenChecker) BusinessDomainDeletionService {
 	return &businessDomainDeletionService{
 		assignmentChecker: assignmentChecker,
+		childrenChecker:   childrenChecker,
 	}
 }
 
@@ -39,5 +46,14 @@ func (s *businessDomainDeletionService) CanDelete(ctx context.Context, domainID
 		return ErrBusinessDomainHasAssignments
 	}
 
+	hasChildren, err := s.childrenChecker.HasChildren(ctx, domainID)
+	if err != nil {
+		return err
+	}
+
+	if hasChildren {
+		return ErrBusinessDomainHasChildren
+	}
+
 	return nil
 }
@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+// CapabilityHierarchyProvider supplies the tenant's configured capability depth
+// and level labels. Implementations fall back to the default L1–L4 hierarchy
+// when the tenant has not configured one.
+type CapabilityHierarchyProvider interface {
+	GetCapabilityHierarchy(ctx context.Context) (valueobjects.CapabilityHierarchy, error)
+}
@@ -10,17 +10,19 @@ import (
 
 type CapabilityReparentingService interface {
 	DetermineNewLevel(ctx context.Context, capabilityID valueobjects.CapabilityID, newParentID valueobjects.CapabilityID, parentLevel valueobjects.CapabilityLevel) (valueobjects.CapabilityLevel, error)
-	CalculateChildLevel(parentLevel valueobjects.CapabilityLevel) (valueobjects.CapabilityLevel, error)
+	CalculateChildLevel(ctx context.Context, parentLevel valueobjects.CapabilityLevel) (valueobjects.CapabilityLevel, error)
 }
 
 type capabilityReparentingService struct {
 	lookup           CapabilityLookup
+	hierarchies      CapabilityHierarchyProvider
 	hierarchyService CapabilityHierarchyService
 }
 
-func NewCapabilityReparentingService(lookup CapabilityLookup) CapabilityReparentingService {
+func NewCapabilityReparentingService(lookup CapabilityLookup, hierarchies CapabilityHierarchyProvider) CapabilityReparentingService {
 	return &capabilityReparentingService{
 		lookup:           lookup,
+		hierarchies:      hierarchies,
 		hierarchyService: NewCapabilityHierarchyService(lookup),
 	}
 }
@@ -37,7 +39,7 @@ func (s *capabilityReparentingService) DetermineNewLevel(ctx context.Context, ca
 		return "", err
 	}
 
-	newLevel, err := s.CalculateChildLevel(parentLevel)
+	newLevel, err := s.CalculateChildLevel(ctx, parentLevel)
 	if err != nil {
 		return "", err
 	}
@@ -49,17 +51,17 @@ func (s *capabilityReparentingService) DetermineNewLevel(ctx context.Context, ca
 	return newLevel, nil
 }
 
-func (s *capabilityReparentingService) CalculateChildLevel(parentLevel valueobjects.CapabilityLevel) (valueobjects.CapabilityLevel, error) {
-	switch parentLevel {
-	case valueobjects.LevelL1:
-		return valueobjects.LevelL2, nil
-	case valueobjects.LevelL2:
-		return valueobjects.LevelL3, nil
-	case valueobjects.LevelL3:
-		return valueobjects.LevelL4, nil
-	default:
+func (s *capabilityReparentingService) CalculateChildLevel(ctx context.Context, parentLevel valueobjects.CapabilityLevel) (valueobjects.CapabilityLevel, error) {
+	hierarchy, err := s.hierarchies.GetCapabilityHierarchy(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	childLevel, ok := hierarchy.ChildLevel(parentLevel)
+	if !ok {
 		return "", aggregates.ErrWouldExceedMaximumDepth
 	}
+	return childLevel, nil
 }
 
 func (s *capabilityReparentingService) validateHierarchyChange(ctx context.Context, capabilityID valueobjects.CapabilityID, newParentID valueobjects.CapabilityID) error {
@@ -73,12 +75,17 @@ func (s *capabilityReparentingService) validateHierarchyChange(ctx context.Conte
 }
 
 func (s *capabilityReparentingService) validateDepthConstraints(ctx context.Context, capabilityID valueobjects.CapabilityID, newLevel valueobjects.CapabilityLevel) error {
+	hierarchy, err := s.hierarchies.GetCapabilityHierarchy(ctx)
+	if err != nil {
+		return err
+	}
+
 	subtreeDepth, err := s.calculateSubtreeDepth(ctx, capabilityID)
 	if err != nil {
 		return err
 	}
 
-	if newLevel.NumericValue()+subtreeDepth > 4 {
+	if newLevel.NumericValue()+subtreeDepth > hierarchy.MaxDepth() {
 		return aggregates.ErrWouldExceedMaximumDepth
 	}
 
@@ -13,7 +13,7 @@ import (
 
 func TestCapabilityReparentingService_DetermineNewLevel_Root(t *testing.T) {
 	lookup := newMockCapabilityLookup()
-	service := NewCapabilityReparentingService(lookup)
+	service := NewCapabilityReparentingService(lookup, fixedHierarchy{valueobjects.DefaultCapabilityHierarchy()})
 
 	capID := valueobjects.NewCapabilityID()
 	lookup.addCapability(capID, valueobjects.LevelL2, valueobjects.NewCapabilityID())
@@ -25,7 +25,7 @@ func TestCapabilityReparentingService_DetermineNewLevel_Root(t *testing.T) {
 
 func TestCapabilityReparentingService_DetermineNewLevel_CircularReference(t *testing.T) {
 	lookup := newMockCapabilityLookup()
-	service := NewCapabilityReparentingService(lookup)
+	service := NewCapabilityReparentingService(lookup, fixedHierarchy{valueobjects.DefaultCapabilityHierarchy()})
 
 	parentID := valueobjects.NewCapabilityID()
 	childID := valueobjects.NewCapabilityID()
@@ -41,7 +41,7 @@ func TestCapabilityReparentingService_DetermineNewLevel_CircularReference(t *tes
 
 func TestCapabilityReparentingService_DetermineNewLevel_MaxDepthExceeded(t *testing.T) {
 	lookup := newMockCapabilityLookup()
-	service := NewCapabilityReparentingService(lookup)
+	service := NewCapabilityReparentingService(lookup, fixedHierarchy{valueobjects.DefaultCapabilityHierarchy()})
 
 	rootID := valueobjects.NewCapabilityID()
 	childID := valueobjects.NewCapabilityID()
@@ -61,9 +61,34 @@ func TestCapabilityReparentingService_DetermineNewLevel_MaxDepthExceeded(t *test
 
 func TestCapabilityReparentingService_CalculateChildLevel(t *testing.T) {
 	lookup := newMockCapabilityLookup()
-	service := NewCapabilityReparentingService(lookup)
+	service := NewCapabilityReparentingService(lookup, fixedHierarchy{valueobjects.DefaultCapabilityHierarchy()})
 
-	level, err := service.CalculateChildLevel(valueobjects.LevelL2)
+	level, err := service.CalculateChildLevel(context.Background(), valueobjects.LevelL2)
 	require.NoError(t, err)
 	assert.Equal(t, valueobjects.LevelL3, level)
 }
+
+type fixedHierarchy struct {
+	hierarchy valueobjects.CapabilityHierarchy
+}
+
+func (f fixedHierarchy) GetCapabilityHierarchy(context.Context) (valueobjects.CapabilityHierarchy, error) {
+	return f.hierarchy, nil
+}
+
+func TestCapabilityReparentingService_FollowsConfiguredDepth(t *testing.T) {
+	sixLevels, err := valueobjects.NewCapabilityHierarchy([]string{"Domain", "Area", "Capability", "Sub-capability", "Function", "Activity"})
+	require.NoError(t, err)
+	service := NewCapabilityReparentingService(newMockCapabilityLookup(), fixedHierarchy{sixLevels})
+
+	level, err := service.CalculateChildLevel(context.Background(), valueobjects.LevelL4)
+	require.NoError(t, err)
+	assert.Equal(t, valueobjects.LevelL5, level)
+
+	twoLevels, err := valueobjects.NewCapabilityHierarchy([]string{"Domain", "Capability"})
+	require.NoError(t, err)
+	service = NewCapabilityReparentingService(newMockCapabilityLookup(), fixedHierarchy{twoLevels})
+
+	_, err = service.CalculateChildLevel(context.Background(), valueobjects.LevelL2)
+	assert.Equal(t, aggregates.ErrWouldExceedMaximumDepth, err)
+}
@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+// CapabilityTagVocabularyProvider supplies the tenant's capability tag
+// vocabulary. Implementations return an unrestricted vocabulary when the
+// tenant has not defined one.
+type CapabilityTagVocabularyProvider interface {
+	GetCapabilityTagVocabulary(ctx context.Context) (valueobjects.TagVocabulary, error)
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+type CoverageCapability struct {
+	ID       string
+	ParentID string
+}
+
+type CoverageRealization struct {
+	ID           string
+	CapabilityID string
+	ComponentID  string
+	Level        valueobjects.RealizationLevel
+	Direct       bool
+}
+
+type CoverageGap struct {
+	Type               valueobjects.CoverageGapType
+	CapabilityID       string
+	ParentCapabilityID string
+	RealizationID      string
+	ComponentID        string
+}
+
+// DetectCoverageGaps scans the capabilities against their realizations. Inherited
+// realizations count as coverage for an ancestor; the parent/leaf and fit score
+// checks only look at direct realizations, since those are the ones an architect
+// can act on. Gaps are returned in capability order.
+func DetectCoverageGaps(capabilities []CoverageCapability, realizations []CoverageRealization, scoredComponents map[string]bool) []CoverageGap {
+	byCapability := make(map[string][]CoverageRealization)
+	for _, r := range realizations {
+		byCapability[r.CapabilityID] = append(byCapability[r.CapabilityID], r)
+	}
+	hasChildren := make(map[string]bool)
+	for _, c := range capabilities {
+		if c.ParentID != "" {
+			hasChildren[c.ParentID] = true
+		}
+	}
+
+	var gaps []CoverageGap
+	for _, c := range capabilities {
+		own := byCapability[c.ID]
+		switch {
+		case len(own) == 0:
+			gaps = append(gaps, CoverageGap{Type: valueobjects.CoverageGapUnrealized, CapabilityID: c.ID})
+		case allPlanned(own):
+			gaps = append(gaps, CoverageGap{Type: valueobjects.CoverageGapPlannedOnly, CapabilityID: c.ID})
+		}
+
+		if c.ParentID != "" && !hasChildren[c.ID] &&
+			hasDirectFull(byCapability[c.ParentID]) && !hasDirectFull(own) {
+			gaps = append(gaps, CoverageGap{
+				Type:               valueobjects.CoverageGapParentOnlyFull,
+				CapabilityID:       c.ID,
+				ParentCapabilityID: c.ParentID,
+			})
+		}
+
+		for _, r := range own {
+			if r.Direct && !scoredComponents[r.ComponentID] {
+				gaps = append(gaps, CoverageGap{
+					Type:          valueobjects.CoverageGapUnscoredComponent,
+					CapabilityID:  c.ID,
+					RealizationID: r.ID,
+					ComponentID:   r.ComponentID,
+				})
+			}
+		}
+	}
+	return gaps
+}
+
+func allPlanned(realizations []CoverageRealization) bool {
+	for _, r := range realizations {
+		if r.Level != valueobjects.RealizationPlanned {
+			return false
+		}
+	}
+	return true
+}
+
+func hasDirectFull(realizations []CoverageRealization) bool {
+	for _, r := range realizations {
+		if r.Direct && r.Level == valueobjects.RealizationFull {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func gapTypesFor(gaps []CoverageGap, capabilityID string) []valueobjects.CoverageGapType {
+	var types []valueobjects.CoverageGapType
+	for _, g := range gaps {
+		if g.CapabilityID == capabilityID {
+			types = append(types, g.Type)
+		}
+	}
+	return types
+}
+
+func TestDetectCoverageGaps(t *testing.T) {
+	capabilities := []CoverageCapability{
+		{ID: "sales"},
+		{ID: "leads", ParentID: "sales"},
+		{ID: "orders", ParentID: "sales"},
+		{ID: "billing", ParentID: "sales"},
+		{ID: "finance"},
+	}
+	realizations := []CoverageRealization{
+		{ID: "r1", CapabilityID: "sales", ComponentID: "crm", Level: valueobjects.RealizationFull, Direct: true},
+		{ID: "r2", CapabilityID: "orders", ComponentID: "erp", Level: valueobjects.RealizationFull, Direct: true},
+		{ID: "r3", CapabilityID: "billing", ComponentID: "crm", Level: valueobjects.RealizationPlanned, Direct: true},
+		{ID: "r4", CapabilityID: "sales", ComponentID: "erp", Level: valueobjects.RealizationFull, Direct: false},
+	}
+	scored := map[string]bool{"crm": true}
+
+	gaps := DetectCoverageGaps(capabilities, realizations, scored)
+
+	assert.Empty(t, gapTypesFor(gaps, "sales"), "inherited realizations are not checked for fit scores")
+	assert.Equal(t, []valueobjects.CoverageGapType{valueobjects.CoverageGapUnrealized, valueobjects.CoverageGapParentOnlyFull}, gapTypesFor(gaps, "leads"))
+	assert.Equal(t, []valueobjects.CoverageGapType{valueobjects.CoverageGapUnscoredComponent}, gapTypesFor(gaps, "orders"))
+	assert.Equal(t, []valueobjects.CoverageGapType{valueobjects.CoverageGapPlannedOnly, valueobjects.CoverageGapParentOnlyFull}, gapTypesFor(gaps, "billing"))
+	assert.Equal(t, []valueobjects.CoverageGapType{valueobjects.CoverageGapUnrealized}, gapTypesFor(gaps, "finance"))
+}
+
+func TestDetectCoverageGaps_ParentFullDoesNotFlagNonLeaf(t *testing.T) {
+	capabilities := []CoverageCapability{
+		{ID: "l1"},
+		{ID: "l2", ParentID: "l1"},
+		{ID: "l3", ParentID: "l2"},
+	}
+	realizations := []CoverageRealization{
+		{ID: "r1", CapabilityID: "l1", ComponentID: "c", Level: valueobjects.RealizationFull, Direct: true},
+		{ID: "r2", CapabilityID: "l2", ComponentID: "c", Level: valueobjects.RealizationPartial, Direct: true},
+		{ID: "r3", CapabilityID: "l3", ComponentID: "c", Level: valueobjects.RealizationFull, Direct: true},
+	}
+
+	gaps := DetectCoverageGaps(capabilities, realizations, map[string]bool{"c": true})
+
+	assert.Empty(t, gaps)
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"sort"
+
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+// Dependency edges point from the dependent capability (source) to the capability
+// it depends on (target). Upstream of X is what X depends on; downstream of X is
+// everything that depends on X and is therefore impacted when X is disrupted.
+
+type DependencyEdge struct {
+	SourceID string
+	TargetID string
+	Type     valueobjects.DependencyType
+}
+
+type ImpactDirection string
+
+const (
+	ImpactUpstream   ImpactDirection = "upstream"
+	ImpactDownstream ImpactDirection = "downstream"
+)
+
+type ImpactedCapability struct {
+	CapabilityID   string
+	Depth          int
+	ViaID          string
+	DependencyType valueobjects.DependencyType
+}
+
+type DependencyCriticality struct {
+	CapabilityID         string
+	DirectDependents     int
+	RequiredBy           int
+	TransitiveDependents int
+	Score                float64
+}
+
+var criticalityWeights = map[valueobjects.DependencyType]float64{
+	valueobjects.DependencyRequires: 1.0,
+	valueobjects.DependencyEnables:  0.6,
+	valueobjects.DependencySupports: 0.3,
+}
+
+const indirectDependentWeight = 0.25
+
+type DependencyGraph struct {
+	outgoing map[string][]DependencyEdge
+	incoming map[string][]DependencyEdge
+}
+
+func NewDependencyGraph(edges []DependencyEdge, types []valueobjects.DependencyType) *DependencyGraph {
+	allowed := make(map[valueobjects.DependencyType]bool, len(types))
+	for _, t := range types {
+		allowed[t] = true
+	}
+
+	g := &DependencyGraph{
+		outgoing: make(map[string][]DependencyEdge),
+		incoming: make(map[string][]DependencyEdge),
+	}
+	for _, e := range edges {
+		if len(allowed) > 0 && !allowed[e.Type] {
+			continue
+		}
+		g.outgoing[e.SourceID] = append(g.outgoing[e.SourceID], e)
+		g.incoming[e.TargetID] = append(g.incoming[e.TargetID], e)
+	}
+	return g
+}
+
+// Impact walks the graph breadth-first from the capability and returns every
+// capability reached within maxDepth hops (0 means unlimited), at its shortest distance.
+func (g *DependencyGraph) Impact(capabilityID string, direction ImpactDirection, maxDepth int) []ImpactedCapability {
+	visited := map[string]bool{capabilityID: true}
+	frontier := []string{capabilityID}
+	var impacted []ImpactedCapability
+
+	for depth := 1; len(frontier) > 0 && (maxDepth <= 0 || depth <= maxDepth); depth++ {
+		var next []string
+		for _, id := range frontier {
+			for _, e := range g.neighbours(id, direction) {
+				reached := e.TargetID
+				if direction == ImpactDownstream {
+					reached = e.SourceID
+				}
+				if visited[reached] {
+					continue
+				}
+				visited[reached] = true
+				next = append(next, reached)
+				impacted = append(impacted, ImpactedCapability{
+					CapabilityID:   reached,
+					Depth:          depth,
+					ViaID:          id,
+					DependencyType: e.Type,
+				})
+			}
+		}
+		frontier = next
+	}
+	return impacted
+}
+
+func (g *DependencyGraph) neighbours(id string, direction ImpactDirection) []DependencyEdge {
+	if direction == ImpactDownstream {
+		return g.incoming[id]
+	}
+	return g.outgoing[id]
+}
+
+// Criticality scores every capability with dependents. Direct dependents are
+// weighted by dependency type; indirect dependents add a smaller fixed weight.
+// A dependent linked by several dependency types counts once.
+func (g *DependencyGraph) Criticality() []DependencyCriticality {
+	var result []DependencyCriticality
+	for id, edges := range g.incoming {
+		c := DependencyCriticality{CapabilityID: id}
+		dependents := make(map[string]bool, len(edges))
+		requiredBy := make(map[string]bool, len(edges))
+		for _, e := range edges {
+			c.Score += criticalityWeights[e.Type]
+			dependents[e.SourceID] = true
+			if e.Type == valueobjects.DependencyRequires {
+				requiredBy[e.SourceID] = true
+			}
+		}
+		c.DirectDependents = len(dependents)
+		c.RequiredBy = len(requiredBy)
+		c.TransitiveDependents = len(g.Impact(id, ImpactDownstream, 0))
+		c.Score += float64(c.TransitiveDependents-c.DirectDependents) * indirectDependentWeight
+		result = append(result, c)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		return result[i].CapabilityID < result[j].CapabilityID
+	})
+	return result
+}
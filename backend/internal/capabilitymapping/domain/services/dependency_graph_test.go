@@ -0,0 +1,95 @@
+package services
+
+import (
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// billing and shipping require payments; payments requires identity; reporting supports billing.
+func testDependencyEdges() []DependencyEdge {
+	return []DependencyEdge{
+		{SourceID: "billing", TargetID: "payments", Type: valueobjects.DependencyRequires},
+		{SourceID: "shipping", TargetID: "payments", Type: valueobjects.DependencyRequires},
+		{SourceID: "payments", TargetID: "identity", Type: valueobjects.DependencyRequires},
+		{SourceID: "reporting", TargetID: "billing", Type: valueobjects.DependencySupports},
+	}
+}
+
+func impactedIDs(impacted []ImpactedCapability) map[string]int {
+	ids := make(map[string]int, len(impacted))
+	for _, i := range impacted {
+		ids[i.CapabilityID] = i.Depth
+	}
+	return ids
+}
+
+func TestDependencyGraph_DownstreamIsTransitive(t *testing.T) {
+	graph := NewDependencyGraph(testDependencyEdges(), nil)
+
+	impacted := impactedIDs(graph.Impact("identity", ImpactDownstream, 0))
+
+	assert.Equal(t, map[string]int{"payments": 1, "billing": 2, "shipping": 2, "reporting": 3}, impacted)
+}
+
+func TestDependencyGraph_DepthLimit(t *testing.T) {
+	graph := NewDependencyGraph(testDependencyEdges(), nil)
+
+	impacted := impactedIDs(graph.Impact("identity", ImpactDownstream, 2))
+
+	assert.NotContains(t, impacted, "reporting")
+	assert.Len(t, impacted, 3)
+}
+
+func TestDependencyGraph_Upstream(t *testing.T) {
+	graph := NewDependencyGraph(testDependencyEdges(), nil)
+
+	impacted := graph.Impact("reporting", ImpactUpstream, 0)
+
+	require.Len(t, impacted, 3)
+	assert.Equal(t, "billing", impacted[0].CapabilityID)
+	assert.Equal(t, valueobjects.DependencySupports, impacted[0].DependencyType)
+	assert.Equal(t, "payments", impacted[1].CapabilityID)
+	assert.Equal(t, "billing", impacted[1].ViaID)
+}
+
+func TestDependencyGraph_TypeFilter(t *testing.T) {
+	graph := NewDependencyGraph(testDependencyEdges(), []valueobjects.DependencyType{valueobjects.DependencyRequires})
+
+	impacted := impactedIDs(graph.Impact("identity", ImpactDownstream, 0))
+
+	assert.NotContains(t, impacted, "reporting")
+}
+
+func TestDependencyGraph_Criticality(t *testing.T) {
+	graph := NewDependencyGraph(testDependencyEdges(), nil)
+
+	criticality := graph.Criticality()
+
+	require.Len(t, criticality, 3)
+	assert.Equal(t, "payments", criticality[0].CapabilityID)
+	assert.Equal(t, 2, criticality[0].RequiredBy)
+	assert.Equal(t, 3, criticality[0].TransitiveDependents)
+	assert.InDelta(t, 2.25, criticality[0].Score, 0.001)
+	assert.Equal(t, "identity", criticality[1].CapabilityID)
+	assert.InDelta(t, 1.75, criticality[1].Score, 0.001)
+}
+
+func TestDependencyGraph_CriticalityCountsParallelEdgesOnce(t *testing.T) {
+	graph := NewDependencyGraph([]DependencyEdge{
+		{SourceID: "billing", TargetID: "payments", Type: valueobjects.DependencyRequires},
+		{SourceID: "billing", TargetID: "payments", Type: valueobjects.DependencySupports},
+	}, nil)
+
+	criticality := graph.Criticality()
+
+	require.Len(t, criticality, 1)
+	assert.Equal(t, 1, criticality[0].DirectDependents)
+	assert.Equal(t, 1, criticality[0].TransitiveDependents)
+	assert.Equal(t, 1, criticality[0].RequiredBy)
+	assert.InDelta(t, criticalityWeights[valueobjects.DependencyRequires]+criticalityWeights[valueobjects.DependencySupports],
+		criticality[0].Score, 0.001, "parallel edges must not subtract an indirect share")
+}
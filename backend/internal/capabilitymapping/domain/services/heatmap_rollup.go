@@ -0,0 +1,159 @@
+package services
+
+import (
+	"math"
+
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+const HeatmapBandCount = 5
+
+var (
+	heatmapDivergingColours = [HeatmapBandCount]string{"#d7191c", "#fdae61", "#ffffbf", "#a6d96a", "#1a9641"}
+	heatmapIntensityColours = [HeatmapBandCount]string{"#eff3ff", "#bdd7e7", "#6baed6", "#3182bd", "#08519c"}
+)
+
+type HeatmapNode struct {
+	ID       string
+	ParentID string
+	Value    *float64
+}
+
+type HeatmapCell struct {
+	OwnValue    *float64
+	RolledValue *float64
+	Band        int
+	Colour      string
+}
+
+type HeatmapRollup struct {
+	Cells    map[string]HeatmapCell
+	ScaleMin float64
+	ScaleMax float64
+}
+
+type rolledNode struct {
+	value    *float64
+	sum      float64
+	count    int
+	children []string
+}
+
+// RollUpHeatmap aggregates each node's own metric value with the rolled-up values
+// of its children and assigns a colour band to the result. Nodes whose parent is
+// not part of the input are treated as roots.
+func RollUpHeatmap(nodes []HeatmapNode, metric valueobjects.HeatmapMetric, aggregation valueobjects.HeatmapAggregation) HeatmapRollup {
+	byID := make(map[string]HeatmapNode, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	children := make(map[string][]string)
+	var roots []string
+	for _, n := range nodes {
+		if _, ok := byID[n.ParentID]; ok && n.ParentID != n.ID {
+			children[n.ParentID] = append(children[n.ParentID], n.ID)
+			continue
+		}
+		roots = append(roots, n.ID)
+	}
+
+	rolled := make(map[string]rolledNode, len(nodes))
+	for _, root := range roots {
+		rollUpNode(root, byID, children, aggregation, rolled)
+	}
+
+	scaleMin, scaleMax := heatmapScale(metric, aggregation, rolled)
+	cells := make(map[string]HeatmapCell, len(rolled))
+	for id, r := range rolled {
+		cell := HeatmapCell{OwnValue: byID[id].Value, RolledValue: r.value}
+		if r.value != nil {
+			cell.Band = heatmapBand(*r.value, scaleMin, scaleMax)
+			cell.Colour = heatmapColour(metric.Polarity(), cell.Band)
+		}
+		cells[id] = cell
+	}
+	return HeatmapRollup{Cells: cells, ScaleMin: scaleMin, ScaleMax: scaleMax}
+}
+
+func rollUpNode(id string, byID map[string]HeatmapNode, children map[string][]string, aggregation valueobjects.HeatmapAggregation, rolled map[string]rolledNode) rolledNode {
+	if r, ok := rolled[id]; ok {
+		return r
+	}
+	rolled[id] = rolledNode{}
+
+	var values []float64
+	var result rolledNode
+	if own := byID[id].Value; own != nil {
+		values = append(values, *own)
+		result.sum = *own
+		result.count = 1
+	}
+	for _, childID := range children[id] {
+		child := rollUpNode(childID, byID, children, aggregation, rolled)
+		result.sum += child.sum
+		result.count += child.count
+		if child.value != nil {
+			values = append(values, *child.value)
+		}
+	}
+
+	result.value = aggregateHeatmapValues(values, result, aggregation)
+	rolled[id] = result
+	return result
+}
+
+func aggregateHeatmapValues(values []float64, subtree rolledNode, aggregation valueobjects.HeatmapAggregation) *float64 {
+	if len(values) == 0 {
+		return nil
+	}
+	var v float64
+	switch aggregation {
+	case valueobjects.HeatmapAggregationMax:
+		v = values[0]
+		for _, x := range values[1:] {
+			v = math.Max(v, x)
+		}
+	case valueobjects.HeatmapAggregationSum:
+		v = subtree.sum
+	case valueobjects.HeatmapAggregationWeighted:
+		v = subtree.sum / float64(subtree.count)
+	default:
+		for _, x := range values {
+			v += x
+		}
+		v /= float64(len(values))
+	}
+	return &v
+}
+
+func heatmapScale(metric valueobjects.HeatmapMetric, aggregation valueobjects.HeatmapAggregation, rolled map[string]rolledNode) (float64, float64) {
+	if lo, hi, ok := metric.Scale(); ok && aggregation != valueobjects.HeatmapAggregationSum {
+		return lo, hi
+	}
+	var hi float64
+	for _, r := range rolled {
+		if r.value != nil && *r.value > hi {
+			hi = *r.value
+		}
+	}
+	return 0, hi
+}
+
+func heatmapBand(value, scaleMin, scaleMax float64) int {
+	if scaleMax <= scaleMin {
+		return 1
+	}
+	band := 1 + int(math.Floor((value-scaleMin)/(scaleMax-scaleMin)*HeatmapBandCount))
+	return max(1, min(HeatmapBandCount, band))
+}
+
+func heatmapColour(polarity valueobjects.HeatmapPolarity, band int) string {
+	switch polarity {
+	case valueobjects.HeatmapPolarityHigherIsBetter:
+		return heatmapDivergingColours[band-1]
+	case valueobjects.HeatmapPolarityLowerIsBetter:
+		return heatmapDivergingColours[HeatmapBandCount-band]
+	default:
+		return heatmapIntensityColours[band-1]
+	}
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func heatmapValue(v float64) *float64 {
+	return &v
+}
+
+func heatmapTestTree() []HeatmapNode {
+	return []HeatmapNode{
+		{ID: "l1", Value: heatmapValue(1)},
+		{ID: "l2a", ParentID: "l1", Value: heatmapValue(5)},
+		{ID: "l2b", ParentID: "l1"},
+		{ID: "l3a", ParentID: "l2b", Value: heatmapValue(2)},
+		{ID: "l3b", ParentID: "l2b", Value: heatmapValue(4)},
+		{ID: "l3c", ParentID: "l2b", Value: heatmapValue(3)},
+	}
+}
+
+func TestRollUpHeatmap_Max(t *testing.T) {
+	result := RollUpHeatmap(heatmapTestTree(), valueobjects.HeatmapMetricFit, valueobjects.HeatmapAggregationMax)
+
+	assert.Equal(t, 4.0, *result.Cells["l2b"].RolledValue)
+	assert.Equal(t, 5.0, *result.Cells["l1"].RolledValue)
+	assert.Equal(t, 1.0, *result.Cells["l1"].OwnValue)
+}
+
+func TestRollUpHeatmap_AvgAveragesOwnValueAndChildren(t *testing.T) {
+	result := RollUpHeatmap(heatmapTestTree(), valueobjects.HeatmapMetricFit, valueobjects.HeatmapAggregationAvg)
+
+	assert.Equal(t, 3.0, *result.Cells["l2b"].RolledValue)
+	assert.Equal(t, 3.0, *result.Cells["l1"].RolledValue)
+}
+
+func TestRollUpHeatmap_WeightedAveragesWholeSubtree(t *testing.T) {
+	result := RollUpHeatmap(heatmapTestTree(), valueobjects.HeatmapMetricFit, valueobjects.HeatmapAggregationWeighted)
+
+	assert.Equal(t, 3.0, *result.Cells["l2b"].RolledValue)
+	assert.Equal(t, 15.0/5.0, *result.Cells["l1"].RolledValue)
+}
+
+func TestRollUpHeatmap_SumUsesObservedScale(t *testing.T) {
+	result := RollUpHeatmap(heatmapTestTree(), valueobjects.HeatmapMetricCost, valueobjects.HeatmapAggregationSum)
+
+	assert.Equal(t, 15.0, *result.Cells["l1"].RolledValue)
+	assert.Equal(t, 0.0, result.ScaleMin)
+	assert.Equal(t, 15.0, result.ScaleMax)
+	assert.Equal(t, HeatmapBandCount, result.Cells["l1"].Band)
+	assert.Equal(t, 1, result.Cells["l3a"].Band)
+}
+
+func TestRollUpHeatmap_NodesWithoutValuesHaveNoBand(t *testing.T) {
+	nodes := []HeatmapNode{{ID: "root"}, {ID: "child", ParentID: "root"}}
+
+	result := RollUpHeatmap(nodes, valueobjects.HeatmapMetricFit, valueobjects.HeatmapAggregationAvg)
+
+	require.Len(t, result.Cells, 2)
+	assert.Nil(t, result.Cells["root"].RolledValue)
+	assert.Zero(t, result.Cells["root"].Band)
+	assert.Empty(t, result.Cells["root"].Colour)
+}
+
+func TestRollUpHeatmap_OrphansAreTreatedAsRoots(t *testing.T) {
+	nodes := []HeatmapNode{{ID: "orphan", ParentID: "outside-scope", Value: heatmapValue(3)}}
+
+	result := RollUpHeatmap(nodes, valueobjects.HeatmapMetricFit, valueobjects.HeatmapAggregationAvg)
+
+	assert.Equal(t, 3.0, *result.Cells["orphan"].RolledValue)
+}
+
+func TestRollUpHeatmap_ColourFollowsPolarity(t *testing.T) {
+	nodes := []HeatmapNode{{ID: "a", Value: heatmapValue(99)}}
+
+	better := RollUpHeatmap(nodes, valueobjects.HeatmapMetricMaturity, valueobjects.HeatmapAggregationAvg)
+	worse := RollUpHeatmap(nodes, valueobjects.HeatmapMetricEliminateCount, valueobjects.HeatmapAggregationSum)
+
+	assert.Equal(t, HeatmapBandCount, better.Cells["a"].Band)
+	assert.Equal(t, HeatmapBandCount, worse.Cells["a"].Band)
+	assert.Equal(t, heatmapDivergingColours[HeatmapBandCount-1], better.Cells["a"].Colour)
+	assert.Equal(t, heatmapDivergingColours[0], worse.Cells["a"].Colour)
+}
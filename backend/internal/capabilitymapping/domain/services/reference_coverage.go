@@ -0,0 +1,44 @@
+package services
+
+import (
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+type ReferenceCoverageEntry struct {
+	Code       string
+	ParentCode string
+}
+
+// AssessReferenceCoverage classifies every entry of a reference model against
+// the number of capabilities mapped to it. An unmapped entry whose subtree holds
+// a mapped entry counts as partial, so broad framework areas we only cover in
+// part are not reported as missing altogether.
+func AssessReferenceCoverage(entries []ReferenceCoverageEntry, mappedCapabilities map[string]int) map[string]valueobjects.ReferenceCoverageStatus {
+	parentOf := make(map[string]string, len(entries))
+	for _, e := range entries {
+		parentOf[e.Code] = e.ParentCode
+	}
+
+	hasMappedDescendant := make(map[string]bool)
+	for _, e := range entries {
+		if mappedCapabilities[e.Code] == 0 {
+			continue
+		}
+		for parent := parentOf[e.Code]; parent != "" && !hasMappedDescendant[parent]; parent = parentOf[parent] {
+			hasMappedDescendant[parent] = true
+		}
+	}
+
+	statuses := make(map[string]valueobjects.ReferenceCoverageStatus, len(entries))
+	for _, e := range entries {
+		switch {
+		case mappedCapabilities[e.Code] > 0:
+			statuses[e.Code] = valueobjects.ReferenceCoverageMapped
+		case hasMappedDescendant[e.Code]:
+			statuses[e.Code] = valueobjects.ReferenceCoveragePartial
+		default:
+			statuses[e.Code] = valueobjects.ReferenceCoverageUnmapped
+		}
+	}
+	return statuses
+}
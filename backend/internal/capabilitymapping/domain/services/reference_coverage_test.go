@@ -0,0 +1,31 @@
+package services
+
+import (
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssessReferenceCoverage(t *testing.T) {
+	entries := []ReferenceCoverageEntry{
+		{Code: "1.0"},
+		{Code: "1.1", ParentCode: "1.0"},
+		{Code: "1.1.1", ParentCode: "1.1"},
+		{Code: "1.2", ParentCode: "1.0"},
+		{Code: "2.0"},
+		{Code: "3.0"},
+	}
+
+	statuses := AssessReferenceCoverage(entries, map[string]int{"1.1.1": 2, "3.0": 1})
+
+	assert.Equal(t, map[string]valueobjects.ReferenceCoverageStatus{
+		"1.0":   valueobjects.ReferenceCoveragePartial,
+		"1.1":   valueobjects.ReferenceCoveragePartial,
+		"1.1.1": valueobjects.ReferenceCoverageMapped,
+		"1.2":   valueobjects.ReferenceCoverageUnmapped,
+		"2.0":   valueobjects.ReferenceCoverageUnmapped,
+		"3.0":   valueobjects.ReferenceCoverageMapped,
+	}, statuses)
+}
@@ -0,0 +1,86 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var ErrInvalidCapabilityHierarchy = errors.New("capability hierarchy must have between 1 and 6 labelled levels")
+
+// CapabilityHierarchy is a tenant's configured capability depth together with
+// the label of each level, e.g. "Domain / Area / Capability / Sub-capability".
+type CapabilityHierarchy struct {
+	labels []string
+}
+
+func NewCapabilityHierarchy(labels []string) (CapabilityHierarchy, error) {
+	if len(labels) < 1 || len(labels) > MaxCapabilityLevelDepth {
+		return CapabilityHierarchy{}, ErrInvalidCapabilityHierarchy
+	}
+	trimmed := make([]string, len(labels))
+	for i, label := range labels {
+		trimmed[i] = strings.TrimSpace(label)
+		if trimmed[i] == "" {
+			return CapabilityHierarchy{}, ErrInvalidCapabilityHierarchy
+		}
+	}
+	return CapabilityHierarchy{labels: trimmed}, nil
+}
+
+func DefaultCapabilityHierarchy() CapabilityHierarchy {
+	return CapabilityHierarchy{labels: []string{"L1", "L2", "L3", "L4"}}
+}
+
+func (h CapabilityHierarchy) MaxDepth() int {
+	return len(h.labels)
+}
+
+func (h CapabilityHierarchy) Labels() []string {
+	return append([]string(nil), h.labels...)
+}
+
+func (h CapabilityHierarchy) Allows(level CapabilityLevel) bool {
+	depth := level.NumericValue()
+	return depth >= 1 && depth <= h.MaxDepth()
+}
+
+// ChildLevel returns the level directly below parent, or false when parent is
+// already the deepest level the hierarchy allows.
+func (h CapabilityHierarchy) ChildLevel(parent CapabilityLevel) (CapabilityLevel, bool) {
+	depth := parent.NumericValue()
+	if depth < 1 || depth >= h.MaxDepth() {
+		return "", false
+	}
+	child, err := CapabilityLevelFromDepth(depth + 1)
+	if err != nil {
+		return "", false
+	}
+	return child, true
+}
+
+func (h CapabilityHierarchy) CanHaveChildren(level CapabilityLevel) bool {
+	_, ok := h.ChildLevel(level)
+	return ok
+}
+
+func (h CapabilityHierarchy) LabelFor(level CapabilityLevel) string {
+	if !h.Allows(level) {
+		return level.Value()
+	}
+	return h.labels[level.NumericValue()-1]
+}
+
+func (h CapabilityHierarchy) Equals(other domain.ValueObject) bool {
+	otherHierarchy, ok := other.(CapabilityHierarchy)
+	if !ok || len(otherHierarchy.labels) != len(h.labels) {
+		return false
+	}
+	for i := range h.labels {
+		if h.labels[i] != otherHierarchy.labels[i] {
+			return false
+		}
+	}
+	return true
+}
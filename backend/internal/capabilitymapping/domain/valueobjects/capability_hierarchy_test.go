@@ -0,0 +1,53 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCapabilityHierarchy_Valid(t *testing.T) {
+	hierarchy, err := NewCapabilityHierarchy([]string{"Domain", " Area ", "Capability", "Sub-capability", "Activity"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, hierarchy.MaxDepth())
+	assert.Equal(t, "Area", hierarchy.LabelFor(LevelL2))
+	assert.True(t, hierarchy.Allows(LevelL5))
+	assert.False(t, hierarchy.Allows(LevelL6))
+}
+
+func TestNewCapabilityHierarchy_Invalid(t *testing.T) {
+	testCases := map[string][]string{
+		"no levels":    {},
+		"too deep":     {"1", "2", "3", "4", "5", "6", "7"},
+		"blank labels": {"Domain", "  "},
+	}
+	for name, labels := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewCapabilityHierarchy(labels)
+			assert.Equal(t, ErrInvalidCapabilityHierarchy, err)
+		})
+	}
+}
+
+func TestCapabilityHierarchy_ChildLevel(t *testing.T) {
+	hierarchy, err := NewCapabilityHierarchy([]string{"Domain", "Capability"})
+	require.NoError(t, err)
+
+	child, ok := hierarchy.ChildLevel(LevelL1)
+	assert.True(t, ok)
+	assert.Equal(t, LevelL2, child)
+
+	_, ok = hierarchy.ChildLevel(LevelL2)
+	assert.False(t, ok)
+	assert.False(t, hierarchy.CanHaveChildren(LevelL2))
+}
+
+func TestDefaultCapabilityHierarchy_IsFourLevels(t *testing.T) {
+	hierarchy := DefaultCapabilityHierarchy()
+
+	assert.Equal(t, 4, hierarchy.MaxDepth())
+	assert.True(t, hierarchy.CanHaveChildren(LevelL3))
+	assert.False(t, hierarchy.CanHaveChildren(LevelL4))
+}
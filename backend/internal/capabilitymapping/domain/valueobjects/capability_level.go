@@ -3,11 +3,17 @@ package valueobjects
 import (
 	domain "easi/backend/internal/shared/eventsourcing"
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 )
 
+// MaxCapabilityLevelDepth is the deepest level any tenant hierarchy can be
+// configured to use.
+const MaxCapabilityLevelDepth = 6
+
 var (
-	ErrInvalidCapabilityLevel = errors.New("invalid capability level: must be L1, L2, L3, or L4")
+	ErrInvalidCapabilityLevel = errors.New("invalid capability level: must be L1 through L6")
 )
 
 type CapabilityLevel string
@@ -17,17 +23,23 @@ const (
 	LevelL2 CapabilityLevel = "L2"
 	LevelL3 CapabilityLevel = "L3"
 	LevelL4 CapabilityLevel = "L4"
+	LevelL5 CapabilityLevel = "L5"
+	LevelL6 CapabilityLevel = "L6"
 )
 
 func NewCapabilityLevel(value string) (CapabilityLevel, error) {
-	normalized := strings.ToUpper(strings.TrimSpace(value))
+	level := CapabilityLevel(strings.ToUpper(strings.TrimSpace(value)))
+	if !level.IsValid() {
+		return "", ErrInvalidCapabilityLevel
+	}
+	return level, nil
+}
 
-	switch CapabilityLevel(normalized) {
-	case LevelL1, LevelL2, LevelL3, LevelL4:
-		return CapabilityLevel(normalized), nil
-	default:
+func CapabilityLevelFromDepth(depth int) (CapabilityLevel, error) {
+	if depth < 1 || depth > MaxCapabilityLevelDepth {
 		return "", ErrInvalidCapabilityLevel
 	}
+	return CapabilityLevel(fmt.Sprintf("L%d", depth)), nil
 }
 
 func (c CapabilityLevel) Value() string {
@@ -46,20 +58,16 @@ func (c CapabilityLevel) String() string {
 }
 
 func (c CapabilityLevel) IsValid() bool {
-	return c == LevelL1 || c == LevelL2 || c == LevelL3 || c == LevelL4
+	return c.NumericValue() != 0
 }
 
 func (c CapabilityLevel) NumericValue() int {
-	switch c {
-	case LevelL1:
-		return 1
-	case LevelL2:
-		return 2
-	case LevelL3:
-		return 3
-	case LevelL4:
-		return 4
-	default:
+	if len(c) != 2 || c[0] != 'L' {
+		return 0
+	}
+	depth, err := strconv.Atoi(string(c[1:]))
+	if err != nil || depth < 1 || depth > MaxCapabilityLevelDepth {
 		return 0
 	}
+	return depth
 }
@@ -19,8 +19,15 @@ func TestNewCapabilityLevel_CaseInsensitive(t *testing.T) {
 	assert.Equal(t, LevelL2, level)
 }
 
+func TestNewCapabilityLevel_DeeperLevels(t *testing.T) {
+	level, err := NewCapabilityLevel("L6")
+	assert.NoError(t, err)
+	assert.Equal(t, LevelL6, level)
+	assert.Equal(t, 6, level.NumericValue())
+}
+
 func TestNewCapabilityLevel_Invalid(t *testing.T) {
-	_, err := NewCapabilityLevel("L5")
+	_, err := NewCapabilityLevel("L7")
 	assert.Error(t, err)
 	assert.Equal(t, ErrInvalidCapabilityLevel, err)
 }
@@ -35,6 +42,15 @@ func TestCapabilityLevel_IsValid(t *testing.T) {
 	level, _ := NewCapabilityLevel("L1")
 	assert.True(t, level.IsValid())
 
-	invalidLevel := CapabilityLevel("L5")
+	invalidLevel := CapabilityLevel("L7")
 	assert.False(t, invalidLevel.IsValid())
 }
+
+func TestCapabilityLevelFromDepth(t *testing.T) {
+	level, err := CapabilityLevelFromDepth(5)
+	assert.NoError(t, err)
+	assert.Equal(t, LevelL5, level)
+
+	_, err = CapabilityLevelFromDepth(0)
+	assert.Equal(t, ErrInvalidCapabilityLevel, err)
+}
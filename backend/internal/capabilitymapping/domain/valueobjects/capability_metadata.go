@@ -1,25 +1,22 @@
 package valueobjects
 
+// CapabilityMetadata holds the metadata a capability is edited with. Ownership
+// is recorded as RACI assignments; the legacy free-text owners are not part
+// of it and keep whatever value they last had.
 type CapabilityMetadata struct {
 	maturityLevel  MaturityLevel
 	ownershipModel OwnershipModel
-	primaryOwner   Owner
-	eaOwner        Owner
 	status         CapabilityStatus
 }
 
 func NewCapabilityMetadata(
 	maturityLevel MaturityLevel,
 	ownershipModel OwnershipModel,
-	primaryOwner Owner,
-	eaOwner Owner,
 	status CapabilityStatus,
 ) CapabilityMetadata {
 	return CapabilityMetadata{
 		maturityLevel:  maturityLevel,
 		ownershipModel: ownershipModel,
-		primaryOwner:   primaryOwner,
-		eaOwner:        eaOwner,
 		status:         status,
 	}
 }
@@ -32,14 +29,6 @@ func (m CapabilityMetadata) OwnershipModel() OwnershipModel {
 	return m.ownershipModel
 }
 
-func (m CapabilityMetadata) PrimaryOwner() Owner {
-	return m.primaryOwner
-}
-
-func (m CapabilityMetadata) EAOwner() Owner {
-	return m.eaOwner
-}
-
 func (m CapabilityMetadata) Status() CapabilityStatus {
 	return m.status
 }
@@ -0,0 +1,43 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidCoverageGapType = errors.New("invalid coverage gap type: must be unrealized, planned-only, parent-only-full or unscored-component")
+
+// CoverageGapType classifies why a capability counts as a realization coverage hole.
+type CoverageGapType string
+
+const (
+	// CoverageGapUnrealized: the capability has no realization at all.
+	CoverageGapUnrealized CoverageGapType = "unrealized"
+	// CoverageGapPlannedOnly: every realization of the capability is still Planned.
+	CoverageGapPlannedOnly CoverageGapType = "planned-only"
+	// CoverageGapParentOnlyFull: a leaf capability lacks a Full realization its parent has.
+	CoverageGapParentOnlyFull CoverageGapType = "parent-only-full"
+	// CoverageGapUnscoredComponent: the capability is realized by a component without any fit score.
+	CoverageGapUnscoredComponent CoverageGapType = "unscored-component"
+)
+
+var CoverageGapTypes = []CoverageGapType{
+	CoverageGapUnrealized,
+	CoverageGapPlannedOnly,
+	CoverageGapParentOnlyFull,
+	CoverageGapUnscoredComponent,
+}
+
+func NewCoverageGapType(value string) (CoverageGapType, error) {
+	gapType := CoverageGapType(strings.ToLower(strings.TrimSpace(value)))
+	for _, known := range CoverageGapTypes {
+		if gapType == known {
+			return gapType, nil
+		}
+	}
+	return "", ErrInvalidCoverageGapType
+}
+
+func (t CoverageGapType) String() string {
+	return string(t)
+}
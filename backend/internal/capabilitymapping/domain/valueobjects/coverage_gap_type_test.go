@@ -0,0 +1,17 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCoverageGapType(t *testing.T) {
+	gapType, err := NewCoverageGapType(" Planned-Only ")
+	require.NoError(t, err)
+	assert.Equal(t, CoverageGapPlannedOnly, gapType)
+
+	_, err = NewCoverageGapType("orphaned")
+	assert.ErrorIs(t, err, ErrInvalidCoverageGapType)
+}
@@ -0,0 +1,52 @@
+package valueobjects
+
+import (
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// DomainArchitects is the ordered set of users acting as architects for a
+// business domain. The first architect is the lead, kept for clients that still
+// read a single domain architect.
+type DomainArchitects struct {
+	ids []string
+}
+
+func NewDomainArchitects(ids []string) DomainArchitects {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		trimmed := strings.TrimSpace(id)
+		if trimmed == "" || seen[trimmed] {
+			continue
+		}
+		seen[trimmed] = true
+		unique = append(unique, trimmed)
+	}
+	return DomainArchitects{ids: unique}
+}
+
+func (a DomainArchitects) IDs() []string {
+	return append([]string(nil), a.ids...)
+}
+
+func (a DomainArchitects) Lead() string {
+	if len(a.ids) == 0 {
+		return ""
+	}
+	return a.ids[0]
+}
+
+func (a DomainArchitects) Equals(other domain.ValueObject) bool {
+	otherArchitects, ok := other.(DomainArchitects)
+	if !ok || len(a.ids) != len(otherArchitects.ids) {
+		return false
+	}
+	for i := range a.ids {
+		if a.ids[i] != otherArchitects.ids[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,22 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDomainArchitects_TrimsAndDeduplicates(t *testing.T) {
+	architects := NewDomainArchitects([]string{" arch-1 ", "arch-2", "", "arch-1"})
+
+	assert.Equal(t, []string{"arch-1", "arch-2"}, architects.IDs())
+	assert.Equal(t, "arch-1", architects.Lead())
+}
+
+func TestDomainArchitects_Empty(t *testing.T) {
+	architects := NewDomainArchitects(nil)
+
+	assert.Empty(t, architects.IDs())
+	assert.Empty(t, architects.Lead())
+	assert.True(t, architects.Equals(DomainArchitects{}))
+}
@@ -0,0 +1,109 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidHeatmapMetric      = errors.New("invalid heatmap metric: must be maturity, importance, fit, eliminate-count, cost, completeness or active-journeys")
+	ErrInvalidHeatmapAggregation = errors.New("invalid heatmap aggregation: must be max, avg, weighted or sum")
+)
+
+type HeatmapMetric string
+
+const (
+	HeatmapMetricMaturity       HeatmapMetric = "maturity"
+	HeatmapMetricImportance     HeatmapMetric = "importance"
+	HeatmapMetricFit            HeatmapMetric = "fit"
+	HeatmapMetricEliminateCount HeatmapMetric = "eliminate-count"
+	HeatmapMetricCost           HeatmapMetric = "cost"
+	HeatmapMetricCompleteness   HeatmapMetric = "completeness"
+	HeatmapMetricActiveJourneys HeatmapMetric = "active-journeys"
+)
+
+type HeatmapPolarity string
+
+const (
+	HeatmapPolarityHigherIsBetter HeatmapPolarity = "higher-is-better"
+	HeatmapPolarityLowerIsBetter  HeatmapPolarity = "lower-is-better"
+	HeatmapPolarityIntensity      HeatmapPolarity = "intensity"
+)
+
+type heatmapMetricSpec struct {
+	polarity HeatmapPolarity
+	min      float64
+	max      float64
+	bounded  bool
+}
+
+var heatmapMetricSpecs = map[HeatmapMetric]heatmapMetricSpec{
+	HeatmapMetricMaturity:       {polarity: HeatmapPolarityHigherIsBetter, min: 0, max: 99, bounded: true},
+	HeatmapMetricImportance:     {polarity: HeatmapPolarityIntensity, min: 1, max: 5, bounded: true},
+	HeatmapMetricFit:            {polarity: HeatmapPolarityHigherIsBetter, min: 1, max: 5, bounded: true},
+	HeatmapMetricEliminateCount: {polarity: HeatmapPolarityLowerIsBetter},
+	HeatmapMetricCost:           {polarity: HeatmapPolarityIntensity},
+	HeatmapMetricCompleteness:   {polarity: HeatmapPolarityHigherIsBetter, min: 0, max: 100, bounded: true},
+	HeatmapMetricActiveJourneys: {polarity: HeatmapPolarityIntensity},
+}
+
+func NewHeatmapMetric(value string) (HeatmapMetric, error) {
+	metric := HeatmapMetric(strings.ToLower(strings.TrimSpace(value)))
+	if _, ok := heatmapMetricSpecs[metric]; !ok {
+		return "", ErrInvalidHeatmapMetric
+	}
+	return metric, nil
+}
+
+func (m HeatmapMetric) String() string {
+	return string(m)
+}
+
+func (m HeatmapMetric) Polarity() HeatmapPolarity {
+	return heatmapMetricSpecs[m].polarity
+}
+
+// Scale returns the fixed value range of bounded metrics. Unbounded metrics
+// (counts and cost) report ok=false and are banded against the observed maximum.
+func (m HeatmapMetric) Scale() (min, max float64, ok bool) {
+	spec := heatmapMetricSpecs[m]
+	return spec.min, spec.max, spec.bounded
+}
+
+func (m HeatmapMetric) RequiresPillar() bool {
+	return m == HeatmapMetricImportance
+}
+
+func (m HeatmapMetric) DefaultAggregation() HeatmapAggregation {
+	switch m {
+	case HeatmapMetricEliminateCount, HeatmapMetricCost, HeatmapMetricActiveJourneys:
+		return HeatmapAggregationSum
+	case HeatmapMetricImportance:
+		return HeatmapAggregationMax
+	default:
+		return HeatmapAggregationAvg
+	}
+}
+
+type HeatmapAggregation string
+
+const (
+	HeatmapAggregationMax      HeatmapAggregation = "max"
+	HeatmapAggregationAvg      HeatmapAggregation = "avg"
+	HeatmapAggregationWeighted HeatmapAggregation = "weighted"
+	HeatmapAggregationSum      HeatmapAggregation = "sum"
+)
+
+func NewHeatmapAggregation(value string) (HeatmapAggregation, error) {
+	aggregation := HeatmapAggregation(strings.ToLower(strings.TrimSpace(value)))
+	switch aggregation {
+	case HeatmapAggregationMax, HeatmapAggregationAvg, HeatmapAggregationWeighted, HeatmapAggregationSum:
+		return aggregation, nil
+	default:
+		return "", ErrInvalidHeatmapAggregation
+	}
+}
+
+func (a HeatmapAggregation) String() string {
+	return string(a)
+}
@@ -0,0 +1,44 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHeatmapMetric_NormalisesInput(t *testing.T) {
+	metric, err := NewHeatmapMetric(" Eliminate-Count ")
+	require.NoError(t, err)
+	assert.Equal(t, HeatmapMetricEliminateCount, metric)
+}
+
+func TestNewHeatmapMetric_RejectsUnknown(t *testing.T) {
+	_, err := NewHeatmapMetric("velocity")
+	assert.ErrorIs(t, err, ErrInvalidHeatmapMetric)
+}
+
+func TestHeatmapMetric_Scale(t *testing.T) {
+	lo, hi, ok := HeatmapMetricMaturity.Scale()
+	assert.True(t, ok)
+	assert.Equal(t, 0.0, lo)
+	assert.Equal(t, 99.0, hi)
+
+	_, _, ok = HeatmapMetricCost.Scale()
+	assert.False(t, ok)
+}
+
+func TestHeatmapMetric_DefaultAggregation(t *testing.T) {
+	assert.Equal(t, HeatmapAggregationSum, HeatmapMetricActiveJourneys.DefaultAggregation())
+	assert.Equal(t, HeatmapAggregationMax, HeatmapMetricImportance.DefaultAggregation())
+	assert.Equal(t, HeatmapAggregationAvg, HeatmapMetricFit.DefaultAggregation())
+}
+
+func TestNewHeatmapAggregation(t *testing.T) {
+	aggregation, err := NewHeatmapAggregation("WEIGHTED")
+	require.NoError(t, err)
+	assert.Equal(t, HeatmapAggregationWeighted, aggregation)
+
+	_, err = NewHeatmapAggregation("median")
+	assert.ErrorIs(t, err, ErrInvalidHeatmapAggregation)
+}
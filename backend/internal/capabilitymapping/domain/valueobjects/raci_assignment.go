@@ -0,0 +1,108 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var (
+	ErrInvalidPartyType = errors.New("invalid party type: must be user or team")
+	ErrPartyIDEmpty     = errors.New("party ID cannot be empty")
+	ErrInvalidRACIRole  = errors.New("invalid RACI role: must be Responsible, Accountable, Consulted, or Informed")
+)
+
+type PartyType string
+
+const (
+	PartyTypeUser PartyType = "user"
+	PartyTypeTeam PartyType = "team"
+)
+
+func NewPartyType(value string) (PartyType, error) {
+	switch PartyType(strings.ToLower(strings.TrimSpace(value))) {
+	case PartyTypeUser:
+		return PartyTypeUser, nil
+	case PartyTypeTeam:
+		return PartyTypeTeam, nil
+	default:
+		return "", ErrInvalidPartyType
+	}
+}
+
+func (p PartyType) Value() string {
+	return string(p)
+}
+
+type RACIRole string
+
+const (
+	RACIResponsible RACIRole = "Responsible"
+	RACIAccountable RACIRole = "Accountable"
+	RACIConsulted   RACIRole = "Consulted"
+	RACIInformed    RACIRole = "Informed"
+)
+
+func NewRACIRole(value string) (RACIRole, error) {
+	switch RACIRole(strings.TrimSpace(value)) {
+	case RACIResponsible, RACIAccountable, RACIConsulted, RACIInformed:
+		return RACIRole(strings.TrimSpace(value)), nil
+	default:
+		return "", ErrInvalidRACIRole
+	}
+}
+
+func (r RACIRole) Value() string {
+	return string(r)
+}
+
+// OwnershipParty is a user from the auth context or an internal team from
+// architecture modeling, referenced by ID so names never go stale.
+type OwnershipParty struct {
+	partyType PartyType
+	id        string
+}
+
+func NewOwnershipParty(partyType, id string) (OwnershipParty, error) {
+	pt, err := NewPartyType(partyType)
+	if err != nil {
+		return OwnershipParty{}, err
+	}
+	trimmed := strings.TrimSpace(id)
+	if trimmed == "" {
+		return OwnershipParty{}, ErrPartyIDEmpty
+	}
+	return OwnershipParty{partyType: pt, id: trimmed}, nil
+}
+
+func (p OwnershipParty) Type() PartyType { return p.partyType }
+func (p OwnershipParty) ID() string      { return p.id }
+func (p OwnershipParty) IsZero() bool    { return p.id == "" }
+
+func (p OwnershipParty) Equals(other domain.ValueObject) bool {
+	if otherParty, ok := other.(OwnershipParty); ok {
+		return p == otherParty
+	}
+	return false
+}
+
+// RACIAssignment gives one party one RACI role on a capability.
+type RACIAssignment struct {
+	party OwnershipParty
+	role  RACIRole
+}
+
+func NewRACIAssignment(party OwnershipParty, role RACIRole) RACIAssignment {
+	return RACIAssignment{party: party, role: role}
+}
+
+func (a RACIAssignment) Party() OwnershipParty { return a.party }
+func (a RACIAssignment) Role() RACIRole        { return a.role }
+
+func (a RACIAssignment) Equals(other domain.ValueObject) bool {
+	if otherAssignment, ok := other.(RACIAssignment); ok {
+		return a == otherAssignment
+	}
+	return false
+}
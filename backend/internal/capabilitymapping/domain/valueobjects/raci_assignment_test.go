@@ -0,0 +1,32 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOwnershipParty(t *testing.T) {
+	party, err := NewOwnershipParty(" Team ", " team-1 ")
+	require.NoError(t, err)
+	assert.Equal(t, PartyTypeTeam, party.Type())
+	assert.Equal(t, "team-1", party.ID())
+
+	_, err = NewOwnershipParty("department", "d-1")
+	assert.ErrorIs(t, err, ErrInvalidPartyType)
+
+	_, err = NewOwnershipParty("user", " ")
+	assert.ErrorIs(t, err, ErrPartyIDEmpty)
+}
+
+func TestNewRACIRole(t *testing.T) {
+	for _, valid := range []string{"Responsible", "Accountable", "Consulted", "Informed"} {
+		role, err := NewRACIRole(valid)
+		require.NoError(t, err)
+		assert.Equal(t, valid, role.Value())
+	}
+
+	_, err := NewRACIRole("Owner")
+	assert.ErrorIs(t, err, ErrInvalidRACIRole)
+}
@@ -0,0 +1,132 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const MaxReferenceCatalogueEntries = 5000
+
+var (
+	ErrReferenceCatalogueEmpty     = errors.New("reference model must contain at least one entry")
+	ErrReferenceCatalogueTooLarge  = fmt.Errorf("reference model cannot contain more than %d entries", MaxReferenceCatalogueEntries)
+	ErrReferenceEntryCodeEmpty     = errors.New("reference entry code cannot be empty")
+	ErrReferenceEntryNameEmpty     = errors.New("reference entry name cannot be empty")
+	ErrReferenceEntryDuplicateCode = errors.New("reference entry codes must be unique within a model")
+	ErrReferenceEntryUnknownParent = errors.New("reference entry parent code does not exist in the model")
+	ErrReferenceEntryCycle         = errors.New("reference entry parent codes must not form a cycle")
+)
+
+// ReferenceEntry is one capability of an industry reference model, identified
+// by the code the framework publishes for it (e.g. an APQC PCF element ID).
+type ReferenceEntry struct {
+	code        string
+	name        string
+	description string
+	parentCode  string
+	level       int
+}
+
+func (e ReferenceEntry) Code() string        { return e.code }
+func (e ReferenceEntry) Name() string        { return e.name }
+func (e ReferenceEntry) Description() string { return e.description }
+func (e ReferenceEntry) ParentCode() string  { return e.parentCode }
+func (e ReferenceEntry) Level() int          { return e.level }
+
+// ReferenceEntrySpec is the raw shape of an entry before it is validated into a catalogue.
+type ReferenceEntrySpec struct {
+	Code        string
+	Name        string
+	Description string
+	ParentCode  string
+}
+
+// ReferenceCatalogue is the validated, ordered tree of entries of a reference
+// model. Levels are derived from the parent chain, roots being level 1.
+type ReferenceCatalogue struct {
+	entries []ReferenceEntry
+	byCode  map[string]int
+}
+
+func NewReferenceCatalogue(specs []ReferenceEntrySpec) (ReferenceCatalogue, error) {
+	if len(specs) == 0 {
+		return ReferenceCatalogue{}, ErrReferenceCatalogueEmpty
+	}
+	if len(specs) > MaxReferenceCatalogueEntries {
+		return ReferenceCatalogue{}, ErrReferenceCatalogueTooLarge
+	}
+
+	catalogue := ReferenceCatalogue{
+		entries: make([]ReferenceEntry, len(specs)),
+		byCode:  make(map[string]int, len(specs)),
+	}
+	for i, spec := range specs {
+		entry, err := newReferenceEntry(spec)
+		if err != nil {
+			return ReferenceCatalogue{}, err
+		}
+		if _, exists := catalogue.byCode[entry.code]; exists {
+			return ReferenceCatalogue{}, fmt.Errorf("%w: %q", ErrReferenceEntryDuplicateCode, entry.code)
+		}
+		catalogue.byCode[entry.code] = i
+		catalogue.entries[i] = entry
+	}
+
+	for i := range catalogue.entries {
+		level, err := catalogue.depthOf(catalogue.entries[i])
+		if err != nil {
+			return ReferenceCatalogue{}, err
+		}
+		catalogue.entries[i].level = level
+	}
+	return catalogue, nil
+}
+
+func newReferenceEntry(spec ReferenceEntrySpec) (ReferenceEntry, error) {
+	code := strings.TrimSpace(spec.Code)
+	if code == "" {
+		return ReferenceEntry{}, ErrReferenceEntryCodeEmpty
+	}
+	name := strings.TrimSpace(spec.Name)
+	if name == "" {
+		return ReferenceEntry{}, fmt.Errorf("%w: entry %q", ErrReferenceEntryNameEmpty, code)
+	}
+	return ReferenceEntry{
+		code:        code,
+		name:        name,
+		description: strings.TrimSpace(spec.Description),
+		parentCode:  strings.TrimSpace(spec.ParentCode),
+	}, nil
+}
+
+func (c ReferenceCatalogue) depthOf(entry ReferenceEntry) (int, error) {
+	depth := 1
+	for current := entry; current.parentCode != ""; depth++ {
+		index, ok := c.byCode[current.parentCode]
+		if !ok {
+			return 0, fmt.Errorf("%w: %q (parent of %q)", ErrReferenceEntryUnknownParent, current.parentCode, current.code)
+		}
+		if depth > len(c.entries) {
+			return 0, fmt.Errorf("%w: at %q", ErrReferenceEntryCycle, entry.code)
+		}
+		current = c.entries[index]
+	}
+	return depth, nil
+}
+
+func (c ReferenceCatalogue) Entries() []ReferenceEntry {
+	return append([]ReferenceEntry(nil), c.entries...)
+}
+
+func (c ReferenceCatalogue) Len() int {
+	return len(c.entries)
+}
+
+func (c ReferenceCatalogue) Entry(code string) (ReferenceEntry, bool) {
+	index, ok := c.byCode[code]
+	if !ok {
+		return ReferenceEntry{}, false
+	}
+	return c.entries[index], true
+}
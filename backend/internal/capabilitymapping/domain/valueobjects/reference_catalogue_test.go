@@ -0,0 +1,70 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReferenceFramework(t *testing.T) {
+	framework, err := NewReferenceFramework(" apqc ")
+	require.NoError(t, err)
+	assert.Equal(t, ReferenceFrameworkAPQC, framework)
+
+	_, err = NewReferenceFramework("ITIL")
+	assert.ErrorIs(t, err, ErrInvalidReferenceFramework)
+}
+
+func TestNewReferenceCatalogue_DerivesLevels(t *testing.T) {
+	catalogue, err := NewReferenceCatalogue([]ReferenceEntrySpec{
+		{Code: "1.2.1", Name: "Develop pricing", ParentCode: "1.2"},
+		{Code: "1.0", Name: "Develop vision and strategy"},
+		{Code: "1.2", Name: "Develop business strategy", ParentCode: "1.0"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, catalogue.Len())
+	entry, ok := catalogue.Entry("1.2.1")
+	require.True(t, ok)
+	assert.Equal(t, 3, entry.Level())
+	assert.Equal(t, "1.2", entry.ParentCode())
+
+	root, ok := catalogue.Entry("1.0")
+	require.True(t, ok)
+	assert.Equal(t, 1, root.Level())
+}
+
+func TestNewReferenceCatalogue_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		specs   []ReferenceEntrySpec
+		wantErr error
+	}{
+		{name: "empty", specs: nil, wantErr: ErrReferenceCatalogueEmpty},
+		{name: "missing code", specs: []ReferenceEntrySpec{{Name: "Sales"}}, wantErr: ErrReferenceEntryCodeEmpty},
+		{name: "missing name", specs: []ReferenceEntrySpec{{Code: "SD-1"}}, wantErr: ErrReferenceEntryNameEmpty},
+		{
+			name:    "duplicate code",
+			specs:   []ReferenceEntrySpec{{Code: "SD-1", Name: "Sales"}, {Code: "SD-1", Name: "Marketing"}},
+			wantErr: ErrReferenceEntryDuplicateCode,
+		},
+		{
+			name:    "unknown parent",
+			specs:   []ReferenceEntrySpec{{Code: "SD-1", Name: "Sales", ParentCode: "SD-0"}},
+			wantErr: ErrReferenceEntryUnknownParent,
+		},
+		{
+			name:    "cycle",
+			specs:   []ReferenceEntrySpec{{Code: "A", Name: "A", ParentCode: "B"}, {Code: "B", Name: "B", ParentCode: "A"}},
+			wantErr: ErrReferenceEntryCycle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewReferenceCatalogue(tt.specs)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidReferenceCoverageStatus = errors.New("invalid reference coverage status: must be mapped, partial or unmapped")
+
+// ReferenceCoverageStatus tells whether our capability map has a counterpart
+// for an entry of a reference model.
+type ReferenceCoverageStatus string
+
+const (
+	// ReferenceCoverageMapped: at least one capability is mapped to the entry itself.
+	ReferenceCoverageMapped ReferenceCoverageStatus = "mapped"
+	// ReferenceCoveragePartial: the entry is not mapped, but some of its descendants are.
+	ReferenceCoveragePartial ReferenceCoverageStatus = "partial"
+	// ReferenceCoverageUnmapped: neither the entry nor any descendant has a counterpart.
+	ReferenceCoverageUnmapped ReferenceCoverageStatus = "unmapped"
+)
+
+var ReferenceCoverageStatuses = []ReferenceCoverageStatus{
+	ReferenceCoverageMapped,
+	ReferenceCoveragePartial,
+	ReferenceCoverageUnmapped,
+}
+
+func NewReferenceCoverageStatus(value string) (ReferenceCoverageStatus, error) {
+	status := ReferenceCoverageStatus(strings.ToLower(strings.TrimSpace(value)))
+	for _, known := range ReferenceCoverageStatuses {
+		if status == known {
+			return status, nil
+		}
+	}
+	return "", ErrInvalidReferenceCoverageStatus
+}
+
+func (s ReferenceCoverageStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,39 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidReferenceFramework = errors.New("invalid reference framework: must be BIAN, APQC, TOGAF or Custom")
+
+// ReferenceFramework names the industry framework a reference capability model comes from.
+type ReferenceFramework string
+
+const (
+	ReferenceFrameworkBIAN   ReferenceFramework = "BIAN"
+	ReferenceFrameworkAPQC   ReferenceFramework = "APQC"
+	ReferenceFrameworkTOGAF  ReferenceFramework = "TOGAF"
+	ReferenceFrameworkCustom ReferenceFramework = "Custom"
+)
+
+var ReferenceFrameworks = []ReferenceFramework{
+	ReferenceFrameworkBIAN,
+	ReferenceFrameworkAPQC,
+	ReferenceFrameworkTOGAF,
+	ReferenceFrameworkCustom,
+}
+
+func NewReferenceFramework(value string) (ReferenceFramework, error) {
+	trimmed := strings.TrimSpace(value)
+	for _, known := range ReferenceFrameworks {
+		if strings.EqualFold(trimmed, string(known)) {
+			return known, nil
+		}
+	}
+	return "", ErrInvalidReferenceFramework
+}
+
+func (f ReferenceFramework) String() string {
+	return string(f)
+}
@@ -0,0 +1,54 @@
+package valueobjects
+
+import (
+	"errors"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var ErrTagNotInVocabulary = errors.New("tag is not in the tenant's capability tag vocabulary")
+
+// TagVocabulary is the tenant's sanctioned list of capability tags. An
+// unrestricted vocabulary permits any tag.
+type TagVocabulary struct {
+	tags       map[string]struct{}
+	restricted bool
+}
+
+func NewTagVocabulary(tags []string, restricted bool) TagVocabulary {
+	set := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		set[tag] = struct{}{}
+	}
+	return TagVocabulary{tags: set, restricted: restricted}
+}
+
+func UnrestrictedTagVocabulary() TagVocabulary {
+	return NewTagVocabulary(nil, false)
+}
+
+func (v TagVocabulary) Restricted() bool {
+	return v.restricted
+}
+
+func (v TagVocabulary) Contains(tag Tag) bool {
+	_, ok := v.tags[tag.Value()]
+	return ok
+}
+
+func (v TagVocabulary) Permits(tag Tag) bool {
+	return !v.restricted || v.Contains(tag)
+}
+
+func (v TagVocabulary) Equals(other domain.ValueObject) bool {
+	otherVocabulary, ok := other.(TagVocabulary)
+	if !ok || v.restricted != otherVocabulary.restricted || len(v.tags) != len(otherVocabulary.tags) {
+		return false
+	}
+	for tag := range v.tags {
+		if _, ok := otherVocabulary.tags[tag]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,24 @@
+package adapters
+
+import (
+	"context"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+type BusinessDomainChildrenCheckerAdapter struct {
+	readModel *readmodels.BusinessDomainReadModel
+}
+
+func NewBusinessDomainChildrenCheckerAdapter(readModel *readmodels.BusinessDomainReadModel) *BusinessDomainChildrenCheckerAdapter {
+	return &BusinessDomainChildrenCheckerAdapter{readModel: readModel}
+}
+
+func (a *BusinessDomainChildrenCheckerAdapter) HasChildren(ctx context.Context, domainID valueobjects.BusinessDomainID) (bool, error) {
+	children, err := a.readModel.GetChildren(ctx, domainID.Value())
+	if err != nil {
+		return false, err
+	}
+	return len(children) > 0, nil
+}
@@ -5,16 +5,19 @@ import (
 	"fmt"
 
 	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
 	"easi/backend/internal/importing/publishedlanguage"
 	"easi/backend/internal/shared/cqrs"
 )
 
 type ImportCapabilityGateway struct {
-	commandBus cqrs.CommandBus
+	commandBus  cqrs.CommandBus
+	hierarchies services.CapabilityHierarchyProvider
 }
 
-func NewImportCapabilityGateway(bus cqrs.CommandBus) *ImportCapabilityGateway {
-	return &ImportCapabilityGateway{commandBus: bus}
+func NewImportCapabilityGateway(bus cqrs.CommandBus, hierarchies services.CapabilityHierarchyProvider) *ImportCapabilityGateway {
+	return &ImportCapabilityGateway{commandBus: bus, hierarchies: hierarchies}
 }
 
 func (g *ImportCapabilityGateway) CreateCapability(ctx context.Context, input publishedlanguage.CreateCapabilityInput) (string, error) {
@@ -30,15 +33,28 @@ func (g *ImportCapabilityGateway) CreateCapability(ctx context.Context, input pu
 	return result.CreatedID, nil
 }
 
+// UpdateMetadata sets the status and makes the EA owner, a user chosen for
+// the import, accountable for the capability.
 func (g *ImportCapabilityGateway) UpdateMetadata(ctx context.Context, id, eaOwner, status string) error {
 	_, err := g.commandBus.Dispatch(ctx, &commands.UpdateCapabilityMetadata{
-		ID:      id,
-		EAOwner: eaOwner,
-		Status:  status,
+		ID:     id,
+		Status: status,
 	})
 	if err != nil {
 		return fmt.Errorf("dispatch update capability metadata command for capability %s: %w", id, err)
 	}
+	if eaOwner == "" {
+		return nil
+	}
+	_, err = g.commandBus.Dispatch(ctx, &commands.AssignCapabilityOwner{
+		CapabilityID: id,
+		PartyType:    valueobjects.PartyTypeUser.Value(),
+		PartyID:      eaOwner,
+		Role:         valueobjects.RACIAccountable.Value(),
+	})
+	if err != nil {
+		return fmt.Errorf("dispatch assign capability owner command for capability %s: %w", id, err)
+	}
 	return nil
 }
 
@@ -65,3 +81,11 @@ func (g *ImportCapabilityGateway) AssignToDomain(ctx context.Context, capability
 	}
 	return nil
 }
+
+func (g *ImportCapabilityGateway) MaxHierarchyDepth(ctx context.Context) (int, error) {
+	hierarchy, err := g.hierarchies.GetCapabilityHierarchy(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("load capability hierarchy: %w", err)
+	}
+	return hierarchy.MaxDepth(), nil
+}
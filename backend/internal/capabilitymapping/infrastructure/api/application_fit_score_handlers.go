@@ -54,15 +54,19 @@ type ApplicationFitScoreResponse struct {
 	ScoredAt      string      `json:"scoredAt"`
 	ScoredBy      string      `json:"scoredBy"`
 	Links         types.Links `json:"_links,omitempty"`
+
+	InheritedFromComponentID   string `json:"inheritedFromComponentId,omitempty"`
+	InheritedFromComponentName string `json:"inheritedFromComponentName,omitempty"`
 }
 
 // GetFitScoresByComponent godoc
 // @Summary Get strategic fit scores for a component
-// @Description Retrieves all strategic fit scores for a specific application component
+// @Description Retrieves all strategic fit scores for a specific application component. With includeInherited=true, pillars the component has not been scored on inherit the score of its nearest scored parent component.
 // @Tags application-fit-scores
 // @Accept json
 // @Produce json
 // @Param id path string true "Component ID"
+// @Param includeInherited query bool false "Fill unscored pillars from parent components"
 // @Success 200 {object} sharedAPI.CollectionResponse{data=[]ApplicationFitScoreResponse}
 // @Failure 500 {object} sharedAPI.ErrorResponse
 // @Router /components/{id}/fit-scores [get]
@@ -71,6 +75,9 @@ func (h *ApplicationFitScoreHandlers) GetFitScoresByComponent(w http.ResponseWri
 	actor, _ := sharedctx.GetActor(r.Context())
 	links := h.hateoas.FitScoresCollectionLinksForActor(componentID, actor)
 	h.fetchAndRespondFitScores(w, r, actor, links, func() ([]readmodels.ApplicationFitScoreDTO, error) {
+		if r.URL.Query().Get("includeInherited") == "true" {
+			return h.fitScoreRM.GetByComponentIDWithInherited(r.Context(), componentID)
+		}
 		return h.fitScoreRM.GetByComponentID(r.Context(), componentID)
 	})
 }
@@ -267,6 +274,9 @@ func (h *ApplicationFitScoreHandlers) buildFitScoreResponseForActor(dto readmode
 		ScoredAt:      dto.ScoredAt.Format("2006-01-02T15:04:05Z"),
 		ScoredBy:      dto.ScoredBy,
 		Links:         h.hateoas.FitScoreLinksForActor(dto.ComponentID, dto.PillarID, actor),
+
+		InheritedFromComponentID:   dto.InheritedFromComponentID,
+		InheritedFromComponentName: dto.InheritedFromComponentName,
 	}
 }
 
@@ -15,6 +15,7 @@ import (
 	"easi/backend/internal/capabilitymapping/application/readmodels"
 	"easi/backend/internal/capabilitymapping/domain/services"
 	"easi/backend/internal/capabilitymapping/infrastructure/adapters"
+	"easi/backend/internal/capabilitymapping/infrastructure/metamodel"
 	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
 	"easi/backend/internal/infrastructure/database"
 	"easi/backend/internal/infrastructure/eventstore"
@@ -86,9 +87,10 @@ func setupAssignmentConsistencyTestDB(t *testing.T) (*assignmentConsistencyTestC
 	domainRepo := repositories.NewBusinessDomainRepository(eventStore)
 	assignmentRepo := repositories.NewBusinessDomainAssignmentRepository(eventStore)
 
-	commandBus.Register("CreateCapability", handlers.NewCreateCapabilityHandler(capabilityRepo))
-	reparentingService := services.NewCapabilityReparentingService(adapters.NewCapabilityLookupAdapter(capabilityRM))
-	commandBus.Register("ChangeCapabilityParent", handlers.NewChangeCapabilityParentHandler(capabilityRepo, capabilityRM, realizationRM, reparentingService))
+	hierarchies := metamodel.NewLocalCapabilityHierarchyGateway(readmodels.NewCapabilityHierarchyCacheReadModel(tenantDB))
+	commandBus.Register("CreateCapability", handlers.NewCreateCapabilityHandler(capabilityRepo, hierarchies))
+	reparentingService := services.NewCapabilityReparentingService(adapters.NewCapabilityLookupAdapter(capabilityRM), hierarchies)
+	commandBus.Register("ChangeCapabilityParent", handlers.NewChangeCapabilityParentHandler(capabilityRepo, capabilityRM, realizationRM, reparentingService, hierarchies))
 	commandBus.Register("CreateBusinessDomain", handlers.NewCreateBusinessDomainHandler(domainRepo, domainRM))
 	commandBus.Register("AssignCapabilityToDomain", handlers.NewAssignCapabilityToDomainHandler(assignmentRepo, capabilityRepo, domainRM, assignmentRM))
 	commandBus.Register("UnassignCapabilityFromDomain", handlers.NewUnassignCapabilityFromDomainHandler(assignmentRepo))
@@ -166,8 +168,9 @@ func (ctx *assignmentConsistencyTestContext) setupParentChangeHandlers() cqrs.Co
 
 	capabilityRepo := repositories.NewCapabilityRepository(eventStore)
 	realizationRM := readmodels.NewRealizationReadModel(tenantDB)
-	reparentingService := services.NewCapabilityReparentingService(adapters.NewCapabilityLookupAdapter(ctx.capabilityRM))
-	changeParentHandler := handlers.NewChangeCapabilityParentHandler(capabilityRepo, ctx.capabilityRM, realizationRM, reparentingService)
+	hierarchies := metamodel.NewLocalCapabilityHierarchyGateway(readmodels.NewCapabilityHierarchyCacheReadModel(tenantDB))
+	reparentingService := services.NewCapabilityReparentingService(adapters.NewCapabilityLookupAdapter(ctx.capabilityRM), hierarchies)
+	changeParentHandler := handlers.NewChangeCapabilityParentHandler(capabilityRepo, ctx.capabilityRM, realizationRM, reparentingService, hierarchies)
 	commandBus.Register("ChangeCapabilityParent", changeParentHandler)
 
 	return commandBus
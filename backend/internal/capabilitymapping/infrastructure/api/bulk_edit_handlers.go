@@ -0,0 +1,161 @@
+package api
+
+import (
+	"net/http"
+
+	"easi/backend/internal/capabilitymapping/application/handlers"
+	sharedAPI "easi/backend/internal/shared/api"
+)
+
+const (
+	bulkItemUpdated = "updated"
+	bulkItemFailed  = "failed"
+)
+
+type BulkEditHandlers struct {
+	service *handlers.BulkEditService
+}
+
+func NewBulkEditHandlers(service *handlers.BulkEditService) *BulkEditHandlers {
+	return &BulkEditHandlers{service: service}
+}
+
+type BulkCapabilityPatchRequest struct {
+	MaturityValue  *int    `json:"maturityValue,omitempty"`
+	OwnershipModel *string `json:"ownershipModel,omitempty"`
+	// Deprecated: ignored. Assign owners through /capabilities/{id}/owners.
+	PrimaryOwner *string `json:"primaryOwner,omitempty" extensions:"x-deprecated=true"`
+	// Deprecated: ignored. Assign owners through /capabilities/{id}/owners.
+	EAOwner          *string  `json:"eaOwner,omitempty" extensions:"x-deprecated=true"`
+	Status           *string  `json:"status,omitempty"`
+	AddTags          []string `json:"addTags,omitempty"`
+	BusinessDomainID string   `json:"businessDomainId,omitempty"`
+}
+
+type BulkEditCapabilitiesRequest struct {
+	IDs   []string                   `json:"ids"`
+	Patch BulkCapabilityPatchRequest `json:"patch"`
+}
+
+type BulkRealizationPatchRequest struct {
+	RealizationLevel *string `json:"realizationLevel,omitempty"`
+	Notes            *string `json:"notes,omitempty"`
+}
+
+type BulkEditRealizationsRequest struct {
+	IDs   []string                    `json:"ids"`
+	Patch BulkRealizationPatchRequest `json:"patch"`
+}
+
+type BulkItemResultResponse struct {
+	ID          string `json:"id"`
+	Status      string `json:"status"`
+	ErrorStatus int    `json:"errorStatus,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+type BulkEditResponse struct {
+	CorrelationID string                   `json:"correlationId"`
+	Succeeded     int                      `json:"succeeded"`
+	Failed        int                      `json:"failed"`
+	Items         []BulkItemResultResponse `json:"items"`
+	Links         sharedAPI.Links          `json:"_links"`
+}
+
+// BulkEditCapabilities godoc
+// @Summary Apply one patch to many capabilities
+// @Description Applies metadata changes, added tags and a business domain assignment to every listed capability (at most 200) by running the regular per-capability commands. Fields left out keep each capability's current value. Items that fail are reported individually without aborting the batch, and all resulting changes share one correlation ID in the audit log.
+// @Tags capabilities
+// @Accept json
+// @Produce json
+// @Param request body BulkEditCapabilitiesRequest true "Target capability IDs and patch"
+// @Success 200 {object} BulkEditResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/bulk [post]
+func (h *BulkEditHandlers) BulkEditCapabilities(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[BulkEditCapabilitiesRequest](w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.EditCapabilities(r.Context(), req.IDs, handlers.BulkCapabilityPatch{
+		MaturityValue:    req.Patch.MaturityValue,
+		OwnershipModel:   req.Patch.OwnershipModel,
+		Status:           req.Patch.Status,
+		AddTags:          req.Patch.AddTags,
+		BusinessDomainID: req.Patch.BusinessDomainID,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	sharedAPI.RespondJSON(w, http.StatusOK, toBulkEditResponse(result))
+}
+
+// BulkEditRealizations godoc
+// @Summary Apply one patch to many capability realizations
+// @Description Sets the realization level and/or notes on every listed realization (at most 200) by running the regular per-realization update. Items that fail are reported individually without aborting the batch, and all resulting changes share one correlation ID in the audit log.
+// @Tags capabilities
+// @Accept json
+// @Produce json
+// @Param request body BulkEditRealizationsRequest true "Target realization IDs and patch"
+// @Success 200 {object} BulkEditResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capability-realizations/bulk [post]
+func (h *BulkEditHandlers) BulkEditRealizations(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[BulkEditRealizationsRequest](w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.EditRealizations(r.Context(), req.IDs, handlers.BulkRealizationPatch{
+		RealizationLevel: req.Patch.RealizationLevel,
+		Notes:            req.Patch.Notes,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	sharedAPI.RespondJSON(w, http.StatusOK, toBulkEditResponse(result))
+}
+
+func toBulkEditResponse(result *handlers.BulkEditResult) BulkEditResponse {
+	items := make([]BulkItemResultResponse, len(result.Items))
+	for i, item := range result.Items {
+		items[i] = BulkItemResultResponse{ID: item.ID, Status: bulkItemUpdated}
+		if !item.Succeeded() {
+			items[i].Status = bulkItemFailed
+			items[i].ErrorStatus, items[i].Error = bulkItemError(item.Err)
+		}
+	}
+
+	failed := result.FailedCount()
+	return BulkEditResponse{
+		CorrelationID: result.CorrelationID,
+		Succeeded:     len(items) - failed,
+		Failed:        failed,
+		Items:         items,
+		Links: sharedAPI.Links{
+			"audit": sharedAPI.NewLink("/api/v1/audit/changes/"+result.CorrelationID, "GET"),
+		},
+	}
+}
+
+func bulkItemError(err error) (int, string) {
+	status, message, found := sharedAPI.GetErrorRegistry().Lookup(err)
+	if found {
+		return status, message
+	}
+	if isValidationError(err) {
+		return http.StatusBadRequest, err.Error()
+	}
+	status = sharedAPI.MapErrorToStatusCode(err, http.StatusInternalServerError)
+	if status < http.StatusInternalServerError {
+		return status, err.Error()
+	}
+	return status, "Failed to apply change"
+}
@@ -39,15 +39,22 @@ func NewBusinessDomainHandlers(
 }
 
 type CreateBusinessDomainRequest struct {
-	Name              string `json:"name"`
-	Description       string `json:"description,omitempty"`
-	DomainArchitectID string `json:"domainArchitectId,omitempty"`
+	Name               string   `json:"name"`
+	Description        string   `json:"description,omitempty"`
+	ParentID           string   `json:"parentId,omitempty"`
+	DomainArchitectID  string   `json:"domainArchitectId,omitempty"`
+	DomainArchitectIDs []string `json:"domainArchitectIds,omitempty"`
 }
 
 type UpdateBusinessDomainRequest struct {
-	Name              string `json:"name"`
-	Description       string `json:"description,omitempty"`
-	DomainArchitectID string `json:"domainArchitectId,omitempty"`
+	Name               string   `json:"name"`
+	Description        string   `json:"description,omitempty"`
+	DomainArchitectID  string   `json:"domainArchitectId,omitempty"`
+	DomainArchitectIDs []string `json:"domainArchitectIds,omitempty"`
+}
+
+type ChangeBusinessDomainParentRequest struct {
+	ParentID string `json:"parentId"`
 }
 
 type AssignCapabilityRequest struct {
@@ -96,14 +103,16 @@ func (h *BusinessDomainHandlers) CreateBusinessDomain(w http.ResponseWriter, r *
 	}
 
 	cmd := &commands.CreateBusinessDomain{
-		Name:              req.Name,
-		Description:       req.Description,
-		DomainArchitectID: req.DomainArchitectID,
+		Name:               req.Name,
+		Description:        req.Description,
+		ParentID:           req.ParentID,
+		DomainArchitectID:  req.DomainArchitectID,
+		DomainArchitectIDs: req.DomainArchitectIDs,
 	}
 
 	result, err := h.commandBus.Dispatch(r.Context(), cmd)
 	if err != nil {
-		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "")
+		sharedAPI.HandleError(w, err)
 		return
 	}
 
@@ -127,7 +136,7 @@ func (h *BusinessDomainHandlers) GetAllBusinessDomains(w http.ResponseWriter, r
 
 	actor, _ := sharedctx.GetActor(r.Context())
 	for i := range domains {
-		domains[i].Links = h.hateoas.BusinessDomainLinksForActor(domains[i].ID, domains[i].CapabilityCount > 0, actor)
+		domains[i].Links = h.hateoas.BusinessDomainLinksForActor(domains[i].ID, domains[i].ParentID, domains[i].CapabilityCount > 0, actor)
 	}
 
 	sharedAPI.RespondCollection(w, http.StatusOK, domains, h.hateoas.BusinessDomainCollectionLinksForActor(actor))
@@ -162,7 +171,7 @@ func (h *BusinessDomainHandlers) GetBusinessDomainByID(w http.ResponseWriter, r
 		return
 	}
 	actor, _ := sharedctx.GetActor(r.Context())
-	domain.Links = h.hateoas.BusinessDomainLinksForActor(domain.ID, domain.CapabilityCount > 0, actor)
+	domain.Links = h.hateoas.BusinessDomainLinksForActor(domain.ID, domain.ParentID, domain.CapabilityCount > 0, actor)
 	sharedAPI.RespondJSON(w, http.StatusOK, domain)
 }
 
@@ -189,10 +198,11 @@ func (h *BusinessDomainHandlers) UpdateBusinessDomain(w http.ResponseWriter, r *
 	}
 
 	cmd := &commands.UpdateBusinessDomain{
-		ID:                id,
-		Name:              req.Name,
-		Description:       req.Description,
-		DomainArchitectID: req.DomainArchitectID,
+		ID:                 id,
+		Name:               req.Name,
+		Description:        req.Description,
+		DomainArchitectID:  req.DomainArchitectID,
+		DomainArchitectIDs: req.DomainArchitectIDs,
 	}
 
 	result, err := h.commandBus.Dispatch(r.Context(), cmd)
@@ -201,6 +211,71 @@ func (h *BusinessDomainHandlers) UpdateBusinessDomain(w http.ResponseWriter, r *
 	})
 }
 
+// ChangeBusinessDomainParent godoc
+// @Summary Move a business domain in the hierarchy
+// @Description Nests a business domain under a division or segment, or makes it top-level when parentId is empty
+// @Tags business-domains
+// @Accept json
+// @Produce json
+// @Param id path string true "Business Domain ID"
+// @Param parent body ChangeBusinessDomainParentRequest true "New parent domain"
+// @Success 200 {object} easi_backend_internal_capabilitymapping_application_readmodels.BusinessDomainDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse "Move would create a cycle"
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /business-domains/{id}/parent [patch]
+func (h *BusinessDomainHandlers) ChangeBusinessDomainParent(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	req, ok := sharedAPI.DecodeRequestOrFail[ChangeBusinessDomainParentRequest](w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.commandBus.Dispatch(r.Context(), &commands.ChangeBusinessDomainParent{ID: id, ParentID: req.ParentID})
+	sharedAPI.HandleCommandResult(w, result, err, func(_ string) {
+		h.respondWithDomain(w, r, id, http.StatusOK)
+	})
+}
+
+// GetBusinessDomainChildren godoc
+// @Summary List the direct sub-domains of a business domain
+// @Description Returns the business domains nested directly under a division or segment
+// @Tags business-domains
+// @Produce json
+// @Param id path string true "Business Domain ID"
+// @Success 200 {object} easi_backend_internal_shared_api.CollectionResponse{data=[]easi_backend_internal_capabilitymapping_application_readmodels.BusinessDomainDTO}
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /business-domains/{id}/children [get]
+func (h *BusinessDomainHandlers) GetBusinessDomainChildren(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+	if h.getDomainOrNotFound(w, r, id) == nil {
+		return
+	}
+
+	children, err := h.readModels.Domain.GetChildren(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve sub-domains")
+		return
+	}
+	if children == nil {
+		children = []readmodels.BusinessDomainDTO{}
+	}
+
+	actor, _ := sharedctx.GetActor(r.Context())
+	for i := range children {
+		children[i].Links = h.hateoas.BusinessDomainLinksForActor(children[i].ID, children[i].ParentID, children[i].CapabilityCount > 0, actor)
+	}
+
+	p := "/business-domains/" + id
+	sharedAPI.RespondCollection(w, http.StatusOK, children, sharedAPI.Links{
+		"self": h.hateoas.Get(p + "/children"),
+		"up":   h.hateoas.Get(p),
+	})
+}
+
 func (h *BusinessDomainHandlers) respondWithDomain(w http.ResponseWriter, r *http.Request, domainID string, statusCode int) {
 	domain, err := h.readModels.Domain.GetByID(r.Context(), domainID)
 	if err != nil {
@@ -223,7 +298,7 @@ func (h *BusinessDomainHandlers) respondWithDomain(w http.ResponseWriter, r *htt
 	}
 
 	actor, _ := sharedctx.GetActor(r.Context())
-	domain.Links = h.hateoas.BusinessDomainLinksForActor(domain.ID, domain.CapabilityCount > 0, actor)
+	domain.Links = h.hateoas.BusinessDomainLinksForActor(domain.ID, domain.ParentID, domain.CapabilityCount > 0, actor)
 	if statusCode == http.StatusCreated {
 		sharedAPI.RespondCreated(w, location, domain)
 	} else {
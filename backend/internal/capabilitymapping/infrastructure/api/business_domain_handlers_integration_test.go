@@ -269,7 +269,8 @@ func setupBusinessDomainHandlers(db *sql.DB) *BusinessDomainHandlers {
 	capabilityRepo := repositories.NewCapabilityRepository(eventStore)
 
 	assignmentChecker := adapters.NewBusinessDomainAssignmentCheckerAdapter(assignmentRM)
-	deletionService := services.NewBusinessDomainDeletionService(assignmentChecker)
+	childrenChecker := adapters.NewBusinessDomainChildrenCheckerAdapter(domainRM)
+	deletionService := services.NewBusinessDomainDeletionService(assignmentChecker, childrenChecker)
 
 	commandBus.Register("CreateBusinessDomain", handlers.NewCreateBusinessDomainHandler(domainRepo, domainRM))
 	commandBus.Register("UpdateBusinessDomain", handlers.NewUpdateBusinessDomainHandler(domainRepo, domainRM))
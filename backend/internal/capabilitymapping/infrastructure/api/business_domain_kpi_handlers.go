@@ -0,0 +1,84 @@
+package api
+
+import (
+	"net/http"
+
+	"easi/backend/internal/capabilitymapping/application/handlers"
+	sharedAPI "easi/backend/internal/shared/api"
+	"easi/backend/internal/shared/types"
+)
+
+type BusinessDomainKPIHandlers struct {
+	query *handlers.BusinessDomainKPIQuery
+	links *CapabilityMappingLinks
+}
+
+func NewBusinessDomainKPIHandlers(query *handlers.BusinessDomainKPIQuery, links *CapabilityMappingLinks) *BusinessDomainKPIHandlers {
+	return &BusinessDomainKPIHandlers{query: query, links: links}
+}
+
+type JourneyProgressResponse struct {
+	Planned           int      `json:"planned"`
+	InFlight          int      `json:"inFlight"`
+	Done              int      `json:"done"`
+	Abandoned         int      `json:"abandoned"`
+	CompletionPercent *float64 `json:"completionPercent"`
+}
+
+type BusinessDomainKPIResponse struct {
+	BusinessDomainID        string                   `json:"businessDomainId"`
+	Name                    string                   `json:"name"`
+	ParentID                string                   `json:"parentId,omitempty"`
+	IncludedDomainIDs       []string                 `json:"includedDomainIds"`
+	CapabilityCount         int                      `json:"capabilityCount"`
+	RealizedCapabilityCount int                      `json:"realizedCapabilityCount"`
+	RealizationCoverage     float64                  `json:"realizationCoveragePercent"`
+	AverageFit              *float64                 `json:"averageFit"`
+	TimeDistribution        map[string]int           `json:"timeDistribution"`
+	JourneyProgress         *JourneyProgressResponse `json:"journeyProgress"`
+	OnePagerCompleteness    *float64                 `json:"onePagerCompletenessPercent"`
+	Links                   types.Links              `json:"_links"`
+}
+
+// GetBusinessDomainKPIs godoc
+// @Summary Get domain-level KPIs
+// @Description Rolls up capability count, realization coverage, average fit, TIME distribution, journey progress and one-pager completeness over a business domain and all of its sub-domains. KPIs whose source is unavailable are null.
+// @Tags business-domains
+// @Produce json
+// @Param id path string true "Business Domain ID"
+// @Success 200 {object} BusinessDomainKPIResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /business-domains/{id}/kpis [get]
+func (h *BusinessDomainKPIHandlers) GetBusinessDomainKPIs(w http.ResponseWriter, r *http.Request) {
+	kpis, err := h.query.Execute(r.Context(), sharedAPI.GetPathParam(r, "id"))
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	response := BusinessDomainKPIResponse{
+		BusinessDomainID:        kpis.Domain.ID,
+		Name:                    kpis.Domain.Name,
+		ParentID:                kpis.Domain.ParentID,
+		IncludedDomainIDs:       kpis.IncludedDomainIDs,
+		CapabilityCount:         kpis.CapabilityCount,
+		RealizedCapabilityCount: kpis.RealizedCapabilityCount,
+		RealizationCoverage:     kpis.RealizationCoverage,
+		AverageFit:              kpis.AverageFit,
+		TimeDistribution:        kpis.TimeDistribution,
+		OnePagerCompleteness:    kpis.OnePagerCompleteness,
+		Links:                   h.links.BusinessDomainKPILinks(kpis.Domain.ID, kpis.Domain.ParentID),
+	}
+	if progress := kpis.JourneyProgress; progress != nil {
+		response.JourneyProgress = &JourneyProgressResponse{
+			Planned:           progress.Planned,
+			InFlight:          progress.InFlight,
+			Done:              progress.Done,
+			Abandoned:         progress.Abandoned,
+			CompletionPercent: progress.CompletionPercent,
+		}
+	}
+
+	sharedAPI.RespondJSON(w, http.StatusOK, response)
+}
@@ -1,12 +1,14 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 
 	"encoding/json"
 	"io"
+	"log"
 
 	"easi/backend/internal/capabilitymapping/application/commands"
 	"easi/backend/internal/capabilitymapping/application/handlers"
@@ -24,6 +26,7 @@ type CapabilityHandlers struct {
 	hateoas      *CapabilityMappingLinks
 	impactQuery  *handlers.DeleteImpactQuery
 	completeness OnePagerCompletenessSource
+	hierarchies  services.CapabilityHierarchyProvider
 }
 
 type CapabilityHandlersDeps struct {
@@ -32,6 +35,7 @@ type CapabilityHandlersDeps struct {
 	Links        *CapabilityMappingLinks
 	ImpactQuery  *handlers.DeleteImpactQuery
 	Completeness OnePagerCompletenessSource
+	Hierarchies  services.CapabilityHierarchyProvider
 }
 
 func NewCapabilityHandlers(deps CapabilityHandlersDeps) *CapabilityHandlers {
@@ -41,12 +45,27 @@ func NewCapabilityHandlers(deps CapabilityHandlersDeps) *CapabilityHandlers {
 		hateoas:      deps.Links,
 		impactQuery:  deps.ImpactQuery,
 		completeness: deps.Completeness,
+		hierarchies:  deps.Hierarchies,
 	}
 }
 
-func (h *CapabilityHandlers) addLinksToCapability(cap *readmodels.CapabilityDTO, actor sharedctx.Actor) {
+// capabilityHierarchy only drives link affordances, so it falls back to the
+// default hierarchy rather than failing the request.
+func (h *CapabilityHandlers) capabilityHierarchy(ctx context.Context) valueobjects.CapabilityHierarchy {
+	if h.hierarchies == nil {
+		return valueobjects.DefaultCapabilityHierarchy()
+	}
+	hierarchy, err := h.hierarchies.GetCapabilityHierarchy(ctx)
+	if err != nil {
+		log.Printf("failed to load capability hierarchy, using default: %v", err)
+		return valueobjects.DefaultCapabilityHierarchy()
+	}
+	return hierarchy
+}
+
+func (h *CapabilityHandlers) addLinksToCapability(cap *readmodels.CapabilityDTO, actor sharedctx.Actor, hierarchy valueobjects.CapabilityHierarchy) {
 	cap.Links = h.hateoas.CapabilityLinksForActor(cap.ID, cap.ParentID, actor)
-	cap.XRelated = h.hateoas.CapabilityXRelatedForActor(cap.Level, actor)
+	cap.XRelated = h.hateoas.CapabilityXRelatedForActor(cap.Level, hierarchy, actor)
 	for i := range cap.Experts {
 		cap.Experts[i].Links = h.hateoas.CapabilityExpertLinksForActor(sharedAPI.ExpertParams{
 			ResourcePath: "/capabilities/" + cap.ID,
@@ -143,7 +162,7 @@ func (h *CapabilityHandlers) handleCreateCapabilityResponse(w http.ResponseWrite
 	}
 
 	actor, _ := sharedctx.GetActor(r.Context())
-	h.addLinksToCapability(capability, actor)
+	h.addLinksToCapability(capability, actor, h.capabilityHierarchy(r.Context()))
 	sharedAPI.RespondCreated(w, location, capability)
 }
 
@@ -168,8 +187,9 @@ func (h *CapabilityHandlers) GetAllCapabilities(w http.ResponseWriter, r *http.R
 	}
 
 	actor, _ := sharedctx.GetActor(r.Context())
+	hierarchy := h.capabilityHierarchy(r.Context())
 	for i := range capabilities {
-		h.addLinksToCapability(&capabilities[i], actor)
+		h.addLinksToCapability(&capabilities[i], actor, hierarchy)
 	}
 
 	links := sharedAPI.Links{
@@ -204,7 +224,7 @@ func (h *CapabilityHandlers) GetCapabilityByID(w http.ResponseWriter, r *http.Re
 	}
 
 	actor, _ := sharedctx.GetActor(r.Context())
-	h.addLinksToCapability(capability, actor)
+	h.addLinksToCapability(capability, actor, h.capabilityHierarchy(r.Context()))
 	sharedAPI.RespondJSON(w, http.StatusOK, capability)
 }
 
@@ -239,8 +259,9 @@ func (h *CapabilityHandlers) GetCapabilityChildren(w http.ResponseWriter, r *htt
 	}
 
 	actor, _ := sharedctx.GetActor(r.Context())
+	hierarchy := h.capabilityHierarchy(r.Context())
 	for i := range children {
-		h.addLinksToCapability(&children[i], actor)
+		h.addLinksToCapability(&children[i], actor, hierarchy)
 	}
 
 	links := sharedAPI.NewResourceLinks().
@@ -300,7 +321,7 @@ func (h *CapabilityHandlers) UpdateCapability(w http.ResponseWriter, r *http.Req
 	}
 
 	actor, _ := sharedctx.GetActor(r.Context())
-	h.addLinksToCapability(capability, actor)
+	h.addLinksToCapability(capability, actor, h.capabilityHierarchy(r.Context()))
 	sharedAPI.RespondJSON(w, http.StatusOK, capability)
 }
 
@@ -374,6 +395,130 @@ func (h *CapabilityHandlers) parseDeleteBody(r *http.Request) DeleteCapabilityRe
 	return req
 }
 
+type MergeCapabilitiesRequest struct {
+	MergedIDs []string `json:"mergedIds"`
+	Discard   []string `json:"discard,omitempty"`
+}
+
+// MergeCapabilities godoc
+// @Summary Merge capabilities into a surviving capability
+// @Description Folds the given capabilities into this one. Experts, tags, children, realizations, dependencies, domain assignments and strategy importance move to the survivor unless their IDs are listed in discard. The merged capabilities are deleted afterwards.
+// @Tags capabilities
+// @Accept json
+// @Produce json
+// @Param id path string true "Surviving capability ID"
+// @Param body body MergeCapabilitiesRequest true "Capabilities to merge"
+// @Success 200 {object} easi_backend_internal_capabilitymapping_application_readmodels.CapabilityDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/merge [post]
+func (h *CapabilityHandlers) MergeCapabilities(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	req, ok := sharedAPI.DecodeRequestOrFail[MergeCapabilitiesRequest](w, r)
+	if !ok {
+		return
+	}
+
+	actor, _ := sharedctx.GetActor(r.Context())
+	cmd := &commands.MergeCapabilities{
+		SurvivorID: id,
+		MergedIDs:  req.MergedIDs,
+		Discard:    req.Discard,
+		MergedBy:   actor.Email,
+	}
+
+	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	capability, err := h.readModel.GetByID(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve merged capability")
+		return
+	}
+	if capability == nil {
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "Capability not found")
+		return
+	}
+
+	h.addLinksToCapability(capability, actor, h.capabilityHierarchy(r.Context()))
+	sharedAPI.RespondJSON(w, http.StatusOK, capability)
+}
+
+type SplitCapabilityPartRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type SplitCapabilityRequest struct {
+	Parts        []SplitCapabilityPartRequest `json:"parts"`
+	Distribution map[string]int               `json:"distribution,omitempty"`
+}
+
+// SplitCapability godoc
+// @Summary Split a capability into sibling capabilities
+// @Description Creates the given parts as siblings of this capability and distributes its children, realizations, dependencies, domain assignments and strategy importance between them. Distribution maps an item ID to the index of the receiving part; unlisted items go to the first part. Experts and tags are copied to every part. The split capability is deleted afterwards; if any step fails it is kept, content already moved goes back to it and the parts are deleted.
+// @Tags capabilities
+// @Accept json
+// @Produce json
+// @Param id path string true "Capability ID"
+// @Param body body SplitCapabilityRequest true "Parts and distribution"
+// @Success 201 {object} sharedAPI.CollectionResponse{data=[]easi_backend_internal_capabilitymapping_application_readmodels.CapabilityDTO}
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/split [post]
+func (h *CapabilityHandlers) SplitCapability(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	req, ok := sharedAPI.DecodeRequestOrFail[SplitCapabilityRequest](w, r)
+	if !ok {
+		return
+	}
+
+	parts := make([]commands.SplitCapabilityPart, len(req.Parts))
+	for i, part := range req.Parts {
+		if _, err := valueobjects.NewCapabilityName(part.Name); err != nil {
+			sharedAPI.RespondError(w, http.StatusBadRequest, err, "")
+			return
+		}
+		parts[i] = commands.SplitCapabilityPart{Name: part.Name, Description: part.Description}
+	}
+
+	actor, _ := sharedctx.GetActor(r.Context())
+	result, err := h.commandBus.Dispatch(r.Context(), &commands.SplitCapability{
+		ID:           id,
+		Parts:        parts,
+		Distribution: req.Distribution,
+		SplitBy:      actor.Email,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	created, err := h.readModel.GetByIDs(r.Context(), result.CreatedIDs)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve split capabilities")
+		return
+	}
+
+	hierarchy := h.capabilityHierarchy(r.Context())
+	for i := range created {
+		h.addLinksToCapability(&created[i], actor, hierarchy)
+	}
+
+	links := sharedAPI.NewResourceLinks().
+		Self(sharedAPI.ResourcePath("/capabilities/" + id + "/split")).
+		Build()
+	sharedAPI.RespondCollection(w, http.StatusCreated, created, links)
+}
+
 // GetDeleteImpact godoc
 // @Summary Get delete impact analysis for a capability
 // @Description Returns all capabilities and realizations that would be affected by deleting this capability and all descendants.
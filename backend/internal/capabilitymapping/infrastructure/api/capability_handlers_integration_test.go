@@ -19,6 +19,7 @@ import (
 	"easi/backend/internal/capabilitymapping/application/readmodels"
 	"easi/backend/internal/capabilitymapping/domain/services"
 	"easi/backend/internal/capabilitymapping/infrastructure/adapters"
+	"easi/backend/internal/capabilitymapping/infrastructure/metamodel"
 	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
 	cmPL "easi/backend/internal/capabilitymapping/publishedlanguage"
 	"easi/backend/internal/infrastructure/database"
@@ -138,17 +139,15 @@ func setupHandlers(db *sql.DB) *CapabilityHandlers {
 	childrenChecker := adapters.NewCapabilityChildrenCheckerAdapter(readModel)
 	deletionService := services.NewCapabilityDeletionService(childrenChecker)
 
-	createHandler := handlers.NewCreateCapabilityHandler(capabilityRepo)
+	createHandler := handlers.NewCreateCapabilityHandler(capabilityRepo, metamodel.NewLocalCapabilityHierarchyGateway(readmodels.NewCapabilityHierarchyCacheReadModel(tenantDB)))
 	updateHandler := handlers.NewUpdateCapabilityHandler(capabilityRepo)
 	updateMetadataHandler := handlers.NewUpdateCapabilityMetadataHandler(capabilityRepo)
-	addExpertHandler := handlers.NewAddCapabilityExpertHandler(capabilityRepo)
-	addTagHandler := handlers.NewAddCapabilityTagHandler(capabilityRepo)
+	addTagHandler := handlers.NewAddCapabilityTagHandler(capabilityRepo, metamodel.NewLocalCapabilityTagVocabularyGateway(readmodels.NewCapabilityTagVocabularyCacheReadModel(tenantDB)))
 	deleteHandler := handlers.NewDeleteCapabilityHandler(capabilityRepo, deletionService, realizationReadModel, readModel)
 
 	commandBus.Register("CreateCapability", createHandler)
 	commandBus.Register("UpdateCapability", updateHandler)
 	commandBus.Register("UpdateCapabilityMetadata", updateMetadataHandler)
-	commandBus.Register("AddCapabilityExpert", addExpertHandler)
 	commandBus.Register("AddCapabilityTag", addTagHandler)
 	commandBus.Register("DeleteCapability", deleteHandler)
 	commandBus.Register("DeleteSystemRealization", handlers.NewDeleteSystemRealizationHandler(realizationRepo))
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"net/http"
+
+	"easi/backend/internal/capabilitymapping/application/handlers"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	sharedAPI "easi/backend/internal/shared/api"
+)
+
+type CapabilityHeatmapHandlers struct {
+	query *handlers.CapabilityHeatmapQuery
+}
+
+func NewCapabilityHeatmapHandlers(query *handlers.CapabilityHeatmapQuery) *CapabilityHeatmapHandlers {
+	return &CapabilityHeatmapHandlers{query: query}
+}
+
+type CapabilityHeatmapNodeResponse struct {
+	ID       string                          `json:"id"`
+	Name     string                          `json:"name"`
+	Level    string                          `json:"level"`
+	OwnValue *float64                        `json:"ownValue"`
+	Value    *float64                        `json:"value"`
+	Band     int                             `json:"band,omitempty"`
+	Colour   string                          `json:"colour,omitempty"`
+	Children []CapabilityHeatmapNodeResponse `json:"children"`
+}
+
+type CapabilityHeatmapResponse struct {
+	Metric           string                          `json:"metric"`
+	Aggregation      string                          `json:"aggregation"`
+	Polarity         string                          `json:"polarity"`
+	PillarID         string                          `json:"pillarId,omitempty"`
+	BusinessDomainID string                          `json:"businessDomainId,omitempty"`
+	ScaleMin         float64                         `json:"scaleMin"`
+	ScaleMax         float64                         `json:"scaleMax"`
+	Bands            int                             `json:"bands"`
+	Nodes            []CapabilityHeatmapNodeResponse `json:"nodes"`
+}
+
+// GetCapabilityHeatmap godoc
+// @Summary Get a capability heatmap for a metric
+// @Description Returns the capability tree for the tenant or a business domain with a value and colour band per node. Values roll up the hierarchy using the chosen aggregation; nodes without data have a null value.
+// @Tags capabilities
+// @Produce json
+// @Param metric query string true "Metric" Enums(maturity, importance, fit, eliminate-count, cost, completeness, active-journeys)
+// @Param aggregation query string false "Roll-up aggregation (defaults per metric)" Enums(max, avg, weighted, sum)
+// @Param pillarId query string false "Strategy pillar; required for importance, optional for fit"
+// @Param businessDomainId query string false "Restrict the tree to capabilities of a business domain"
+// @Param costFieldId query string false "Numeric application one-pager field holding cost; required for cost"
+// @Success 200 {object} CapabilityHeatmapResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/heatmap [get]
+func (h *CapabilityHeatmapHandlers) GetCapabilityHeatmap(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	metric, err := valueobjects.NewHeatmapMetric(params.Get("metric"))
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	req := handlers.CapabilityHeatmapRequest{
+		Metric:           metric,
+		PillarID:         params.Get("pillarId"),
+		BusinessDomainID: params.Get("businessDomainId"),
+		CostFieldID:      params.Get("costFieldId"),
+	}
+	if raw := params.Get("aggregation"); raw != "" {
+		if req.Aggregation, err = valueobjects.NewHeatmapAggregation(raw); err != nil {
+			sharedAPI.HandleError(w, err)
+			return
+		}
+	}
+
+	result, err := h.query.Execute(r.Context(), req)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	sharedAPI.RespondJSON(w, http.StatusOK, CapabilityHeatmapResponse{
+		Metric:           result.Metric.String(),
+		Aggregation:      result.Aggregation.String(),
+		Polarity:         string(result.Polarity),
+		PillarID:         req.PillarID,
+		BusinessDomainID: req.BusinessDomainID,
+		ScaleMin:         result.ScaleMin,
+		ScaleMax:         result.ScaleMax,
+		Bands:            services.HeatmapBandCount,
+		Nodes:            toHeatmapNodeResponses(result.Roots),
+	})
+}
+
+func toHeatmapNodeResponses(nodes []*handlers.CapabilityHeatmapNode) []CapabilityHeatmapNodeResponse {
+	responses := make([]CapabilityHeatmapNodeResponse, len(nodes))
+	for i, n := range nodes {
+		responses[i] = CapabilityHeatmapNodeResponse{
+			ID:       n.ID,
+			Name:     n.Name,
+			Level:    n.Level,
+			OwnValue: n.OwnValue,
+			Value:    n.Value,
+			Band:     n.Band,
+			Colour:   n.Colour,
+			Children: toHeatmapNodeResponses(n.Children),
+		}
+	}
+	return responses
+}
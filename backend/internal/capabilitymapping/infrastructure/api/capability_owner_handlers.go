@@ -0,0 +1,211 @@
+package api
+
+import (
+	"net/http"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type CapabilityOwnerHandlers struct {
+	commandBus cqrs.CommandBus
+	owners     *readmodels.CapabilityOwnerReadModel
+	capability *readmodels.CapabilityReadModel
+	links      *CapabilityMappingLinks
+}
+
+func NewCapabilityOwnerHandlers(
+	commandBus cqrs.CommandBus,
+	owners *readmodels.CapabilityOwnerReadModel,
+	capability *readmodels.CapabilityReadModel,
+	links *CapabilityMappingLinks,
+) *CapabilityOwnerHandlers {
+	return &CapabilityOwnerHandlers{
+		commandBus: commandBus,
+		owners:     owners,
+		capability: capability,
+		links:      links,
+	}
+}
+
+type AssignCapabilityOwnerRequest struct {
+	Role string `json:"role"`
+}
+
+type ReassignCapabilityOwnersRequest struct {
+	FromType        string `json:"fromType"`
+	FromID          string `json:"fromId"`
+	ToType          string `json:"toType,omitempty"`
+	ToID            string `json:"toId,omitempty"`
+	AccountableOnly bool   `json:"accountableOnly"`
+}
+
+// GetCapabilityOwners godoc
+// @Summary Get the owners of a capability
+// @Description Lists the users and internal teams holding a RACI role (Responsible, Accountable, Consulted, Informed) on the capability, with their current name and whether they are still active.
+// @Tags capabilities
+// @Produce json
+// @Param id path string true "Capability ID"
+// @Success 200 {object} sharedAPI.CollectionResponse{data=[]readmodels.CapabilityOwnerDTO}
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/owners [get]
+func (h *CapabilityOwnerHandlers) GetCapabilityOwners(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	capability, err := h.capability.GetByID(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve capability")
+		return
+	}
+	if capability == nil {
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "Capability not found")
+		return
+	}
+
+	owners, err := h.owners.GetForCapability(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve capability owners")
+		return
+	}
+
+	actor, _ := sharedctx.GetActor(r.Context())
+	for i := range owners {
+		owners[i].Links = h.links.CapabilityOwnerLinksForActor(id, owners[i].PartyType, owners[i].PartyID, actor)
+	}
+
+	sharedAPI.RespondCollection(w, http.StatusOK, owners, sharedAPI.Links{
+		"self": h.links.Get("/capabilities/" + id + "/owners"),
+		"up":   h.links.Get("/capabilities/" + id),
+	})
+}
+
+// AssignCapabilityOwner godoc
+// @Summary Assign a RACI role on a capability
+// @Description Gives an active user or an internal team a RACI role on the capability. A party holds one role at a time, and assigning Accountable replaces the current accountable party.
+// @Tags capabilities
+// @Accept json
+// @Param id path string true "Capability ID"
+// @Param partyType path string true "Party type (user or team)"
+// @Param partyId path string true "User ID or internal team ID"
+// @Param request body AssignCapabilityOwnerRequest true "RACI role"
+// @Success 204 "No Content"
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/owners/{partyType}/{partyId} [put]
+func (h *CapabilityOwnerHandlers) AssignCapabilityOwner(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[AssignCapabilityOwnerRequest](w, r)
+	if !ok {
+		return
+	}
+
+	h.dispatch(w, r, &commands.AssignCapabilityOwner{
+		CapabilityID: sharedAPI.GetPathParam(r, "id"),
+		PartyType:    sharedAPI.GetPathParam(r, "partyType"),
+		PartyID:      sharedAPI.GetPathParam(r, "partyId"),
+		Role:         req.Role,
+	})
+}
+
+// UnassignCapabilityOwner godoc
+// @Summary Remove an owner from a capability
+// @Description Removes the RACI role a user or internal team holds on the capability.
+// @Tags capabilities
+// @Param id path string true "Capability ID"
+// @Param partyType path string true "Party type (user or team)"
+// @Param partyId path string true "User ID or internal team ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/owners/{partyType}/{partyId} [delete]
+func (h *CapabilityOwnerHandlers) UnassignCapabilityOwner(w http.ResponseWriter, r *http.Request) {
+	h.dispatch(w, r, &commands.UnassignCapabilityOwner{
+		CapabilityID: sharedAPI.GetPathParam(r, "id"),
+		PartyType:    sharedAPI.GetPathParam(r, "partyType"),
+		PartyID:      sharedAPI.GetPathParam(r, "partyId"),
+	})
+}
+
+// GetMyCapabilities godoc
+// @Summary Get the capabilities I own
+// @Description Lists the capabilities on which the signed-in user holds a RACI role, optionally narrowed to one role.
+// @Tags capabilities
+// @Produce json
+// @Param role query string false "Only this RACI role (Responsible, Accountable, Consulted, Informed)"
+// @Success 200 {object} sharedAPI.CollectionResponse{data=[]readmodels.OwnedCapabilityDTO}
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/mine [get]
+func (h *CapabilityOwnerHandlers) GetMyCapabilities(w http.ResponseWriter, r *http.Request) {
+	actor, ok := sharedctx.GetActor(r.Context())
+	if !ok {
+		sharedAPI.RespondError(w, http.StatusUnauthorized, nil, "Unauthorized")
+		return
+	}
+
+	role := r.URL.Query().Get("role")
+	if role != "" {
+		if _, err := valueobjects.NewRACIRole(role); err != nil {
+			sharedAPI.HandleError(w, err)
+			return
+		}
+	}
+
+	owned, err := h.owners.GetCapabilitiesForParty(r.Context(), valueobjects.PartyTypeUser.Value(), actor.ID, role)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve owned capabilities")
+		return
+	}
+
+	for i := range owned {
+		owned[i].Links = sharedAPI.Links{
+			"x-capability": h.links.Get("/capabilities/" + owned[i].CapabilityID),
+			"x-owners":     h.links.Get("/capabilities/" + owned[i].CapabilityID + "/owners"),
+		}
+	}
+
+	sharedAPI.RespondCollection(w, http.StatusOK, owned, sharedAPI.Links{
+		"self":       h.links.Get("/capabilities/mine"),
+		"collection": h.links.Get("/capabilities"),
+	})
+}
+
+// ReassignCapabilityOwners godoc
+// @Summary Reassign every capability role of a party
+// @Description Moves every RACI role a user or internal team holds over to another party, e.g. when someone changes jobs. Without a successor the roles are dropped. With accountableOnly, only accountability is handed over and the other roles are dropped.
+// @Tags capabilities
+// @Accept json
+// @Param request body ReassignCapabilityOwnersRequest true "Current and new party"
+// @Success 204 "No Content"
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capability-owners/reassign [post]
+func (h *CapabilityOwnerHandlers) ReassignCapabilityOwners(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[ReassignCapabilityOwnersRequest](w, r)
+	if !ok {
+		return
+	}
+
+	h.dispatch(w, r, &commands.ReassignCapabilityOwners{
+		FromType:        req.FromType,
+		FromID:          req.FromID,
+		ToType:          req.ToType,
+		ToID:            req.ToID,
+		AccountableOnly: req.AccountableOnly,
+	})
+}
+
+func (h *CapabilityOwnerHandlers) dispatch(w http.ResponseWriter, r *http.Request, cmd cqrs.Command) {
+	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type CapabilityTagHandlers struct {
+	commandBus cqrs.CommandBus
+	capability *readmodels.CapabilityReadModel
+	vocabulary *readmodels.CapabilityTagVocabularyCacheReadModel
+	links      *CapabilityMappingLinks
+}
+
+func NewCapabilityTagHandlers(
+	commandBus cqrs.CommandBus,
+	capability *readmodels.CapabilityReadModel,
+	vocabulary *readmodels.CapabilityTagVocabularyCacheReadModel,
+	links *CapabilityMappingLinks,
+) *CapabilityTagHandlers {
+	return &CapabilityTagHandlers{
+		commandBus: commandBus,
+		capability: capability,
+		vocabulary: vocabulary,
+		links:      links,
+	}
+}
+
+type CapabilityTagUsageResponse struct {
+	Tag             string `json:"tag"`
+	Category        string `json:"category,omitempty"`
+	CapabilityCount int    `json:"capabilityCount"`
+	InVocabulary    bool   `json:"inVocabulary"`
+}
+
+type RenameCapabilityTagRequest struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type MergeCapabilityTagsRequest struct {
+	Sources []string `json:"sources"`
+	Target  string   `json:"target"`
+}
+
+// GetCapabilityTags godoc
+// @Summary List capability tags with usage counts
+// @Description Lists every tag in use on capabilities and every term of the tenant's tag vocabulary, with the number of capabilities carrying it, its vocabulary category and whether it is in the vocabulary. Unused vocabulary terms have a count of zero; tags outside the vocabulary are candidates for cleanup.
+// @Tags capabilities
+// @Produce json
+// @Success 200 {object} sharedAPI.CollectionResponse{data=[]CapabilityTagUsageResponse}
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capability-tags [get]
+func (h *CapabilityTagHandlers) GetCapabilityTags(w http.ResponseWriter, r *http.Request) {
+	usage, err := h.capability.GetTagUsage(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve capability tags")
+		return
+	}
+
+	vocabulary, err := h.vocabulary.Get(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve capability tag vocabulary")
+		return
+	}
+
+	sharedAPI.RespondCollection(w, http.StatusOK, mergeTagUsage(usage, vocabulary), sharedAPI.Links{
+		"self":         h.links.Get("/capability-tags"),
+		"x-vocabulary": h.links.Get("/meta-model/capability-tag-vocabulary"),
+	})
+}
+
+func mergeTagUsage(usage []readmodels.TagUsage, vocabulary *readmodels.CapabilityTagVocabularyCache) []CapabilityTagUsageResponse {
+	byTag := make(map[string]*CapabilityTagUsageResponse, len(usage))
+	for _, u := range usage {
+		byTag[u.Tag] = &CapabilityTagUsageResponse{Tag: u.Tag, CapabilityCount: u.CapabilityCount}
+	}
+	if vocabulary != nil {
+		for _, term := range vocabulary.Terms {
+			entry, ok := byTag[term.Tag]
+			if !ok {
+				entry = &CapabilityTagUsageResponse{Tag: term.Tag}
+				byTag[term.Tag] = entry
+			}
+			entry.Category = term.Category
+			entry.InVocabulary = true
+		}
+	}
+
+	result := make([]CapabilityTagUsageResponse, 0, len(byTag))
+	for _, entry := range byTag {
+		result = append(result, *entry)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Tag < result[j].Tag })
+	return result
+}
+
+// RenameCapabilityTag godoc
+// @Summary Rename a capability tag
+// @Description Replaces the tag on every capability that carries it. Capabilities that already carry the new tag simply lose the old one. When the tag vocabulary is restricted, the new tag must be a vocabulary term.
+// @Tags capabilities
+// @Accept json
+// @Param request body RenameCapabilityTagRequest true "Current and new tag"
+// @Success 204 "No Content"
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capability-tags/rename [post]
+func (h *CapabilityTagHandlers) RenameCapabilityTag(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[RenameCapabilityTagRequest](w, r)
+	if !ok {
+		return
+	}
+
+	h.dispatch(w, r, &commands.RenameCapabilityTag{From: req.From, To: req.To})
+}
+
+// MergeCapabilityTags godoc
+// @Summary Merge capability tags
+// @Description Folds every source tag into the target tag on all capabilities, so each affected capability carries the target tag once. When the tag vocabulary is restricted, the target must be a vocabulary term.
+// @Tags capabilities
+// @Accept json
+// @Param request body MergeCapabilityTagsRequest true "Source tags and target tag"
+// @Success 204 "No Content"
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capability-tags/merge [post]
+func (h *CapabilityTagHandlers) MergeCapabilityTags(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[MergeCapabilityTagsRequest](w, r)
+	if !ok {
+		return
+	}
+
+	h.dispatch(w, r, &commands.MergeCapabilityTags{Sources: req.Sources, Target: req.Target})
+}
+
+func (h *CapabilityTagHandlers) dispatch(w http.ResponseWriter, r *http.Request, cmd cqrs.Command) {
+	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,254 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"easi/backend/internal/capabilitymapping/application/handlers"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	sharedAPI "easi/backend/internal/shared/api"
+)
+
+const (
+	maxImpactDepth        = 10
+	defaultMinRequiredBy  = 3
+	dependencyAnalysisURL = "/api/v1/capability-dependencies"
+)
+
+var errInvalidImpactDepth = fmt.Errorf("maxDepth must be between 0 and %d", maxImpactDepth)
+
+type DependencyAnalysisHandlers struct {
+	query *handlers.DependencyAnalysisQuery
+}
+
+func NewDependencyAnalysisHandlers(query *handlers.DependencyAnalysisQuery) *DependencyAnalysisHandlers {
+	return &DependencyAnalysisHandlers{query: query}
+}
+
+type ImpactedCapabilityResponse struct {
+	CapabilityID   string          `json:"capabilityId"`
+	CapabilityName string          `json:"capabilityName"`
+	Level          string          `json:"level"`
+	Depth          int             `json:"depth"`
+	ViaID          string          `json:"viaCapabilityId"`
+	DependencyType string          `json:"dependencyType"`
+	Links          sharedAPI.Links `json:"_links,omitempty"`
+}
+
+type CapabilityCriticalityResponse struct {
+	CapabilityID         string          `json:"capabilityId"`
+	CapabilityName       string          `json:"capabilityName"`
+	DirectDependents     int             `json:"directDependents"`
+	RequiredBy           int             `json:"requiredBy"`
+	TransitiveDependents int             `json:"transitiveDependents"`
+	Score                float64         `json:"score"`
+	Links                sharedAPI.Links `json:"_links,omitempty"`
+}
+
+type FragileDependencyResponse struct {
+	CapabilityCriticalityResponse
+	ComponentIDs []string `json:"componentIds"`
+	Reasons      []string `json:"reasons"`
+}
+
+// GetUpstreamImpact godoc
+// @Summary Get transitive upstream dependencies of a capability
+// @Description Returns every capability the given capability depends on, directly or transitively, with the hop distance and the capability it was reached through
+// @Tags capabilities
+// @Produce json
+// @Param id path string true "Capability ID"
+// @Param maxDepth query int false "Maximum number of hops (0 = unlimited, max 10)" default(0)
+// @Param type query string false "Comma-separated dependency types to follow (Requires, Enables, Supports)"
+// @Success 200 {object} easi_backend_internal_shared_api.CollectionResponse{data=[]api.ImpactedCapabilityResponse}
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/dependencies/upstream [get]
+func (h *DependencyAnalysisHandlers) GetUpstreamImpact(w http.ResponseWriter, r *http.Request) {
+	h.respondImpact(w, r, services.ImpactUpstream)
+}
+
+// GetDownstreamImpact godoc
+// @Summary Get transitive downstream impact of a capability
+// @Description Returns every capability that depends on the given capability, directly or transitively, and is therefore impacted when it is disrupted
+// @Tags capabilities
+// @Produce json
+// @Param id path string true "Capability ID"
+// @Param maxDepth query int false "Maximum number of hops (0 = unlimited, max 10)" default(0)
+// @Param type query string false "Comma-separated dependency types to follow (Requires, Enables, Supports)"
+// @Success 200 {object} easi_backend_internal_shared_api.CollectionResponse{data=[]api.ImpactedCapabilityResponse}
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/dependencies/downstream [get]
+func (h *DependencyAnalysisHandlers) GetDownstreamImpact(w http.ResponseWriter, r *http.Request) {
+	h.respondImpact(w, r, services.ImpactDownstream)
+}
+
+func (h *DependencyAnalysisHandlers) respondImpact(w http.ResponseWriter, r *http.Request, direction services.ImpactDirection) {
+	capabilityID := sharedAPI.GetPathParam(r, "id")
+
+	maxDepth, err := parseImpactDepth(r)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusBadRequest, nil, err.Error())
+		return
+	}
+	types, err := parseDependencyTypes(r)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	result, err := h.query.Impact(r.Context(), capabilityID, direction, maxDepth, types)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	impacted := make([]ImpactedCapabilityResponse, len(result.Impacted))
+	for i, item := range result.Impacted {
+		impacted[i] = ImpactedCapabilityResponse{
+			CapabilityID:   item.CapabilityID,
+			CapabilityName: item.CapabilityName,
+			Level:          item.Level,
+			Depth:          item.Depth,
+			ViaID:          item.ViaID,
+			DependencyType: item.DependencyType,
+			Links:          h.capabilityLink(item.CapabilityID),
+		}
+	}
+
+	links := sharedAPI.Links{
+		"self": sharedAPI.NewLink(fmt.Sprintf("/api/v1/capabilities/%s/dependencies/%s", capabilityID, direction), "GET"),
+		"up":   sharedAPI.NewLink("/api/v1/capabilities/"+capabilityID, "GET"),
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, impacted, links)
+}
+
+// GetDependencyCriticality godoc
+// @Summary Rank capabilities by dependency criticality
+// @Description Scores every capability with dependents from its fan-in. Direct dependents weigh by type (Requires 1.0, Enables 0.6, Supports 0.3); each further transitive dependent adds 0.25.
+// @Tags capability-dependencies
+// @Produce json
+// @Param type query string false "Comma-separated dependency types to consider (Requires, Enables, Supports)"
+// @Success 200 {object} easi_backend_internal_shared_api.CollectionResponse{data=[]api.CapabilityCriticalityResponse}
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capability-dependencies/criticality [get]
+func (h *DependencyAnalysisHandlers) GetDependencyCriticality(w http.ResponseWriter, r *http.Request) {
+	types, err := parseDependencyTypes(r)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	scores, err := h.query.Criticality(r.Context(), types)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to compute dependency criticality")
+		return
+	}
+
+	responses := make([]CapabilityCriticalityResponse, len(scores))
+	for i, s := range scores {
+		responses[i] = h.toCriticalityResponse(s)
+	}
+
+	links := sharedAPI.Links{
+		"self": sharedAPI.NewLink(dependencyAnalysisURL+"/criticality", "GET"),
+		"up":   sharedAPI.NewLink(dependencyAnalysisURL, "GET"),
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, responses, links)
+}
+
+// GetFragileDependencies godoc
+// @Summary Find heavily required capabilities with fragile realization
+// @Description Lists capabilities required by at least minRequiredBy others that are unrealized, realized by a single application, or realized only by applications graded Eliminate
+// @Tags capability-dependencies
+// @Produce json
+// @Param minRequiredBy query int false "Minimum number of capabilities that require it" default(3)
+// @Success 200 {object} easi_backend_internal_shared_api.CollectionResponse{data=[]api.FragileDependencyResponse}
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capability-dependencies/fragile [get]
+func (h *DependencyAnalysisHandlers) GetFragileDependencies(w http.ResponseWriter, r *http.Request) {
+	minRequiredBy := defaultMinRequiredBy
+	if raw := r.URL.Query().Get("minRequiredBy"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			sharedAPI.RespondError(w, http.StatusBadRequest, nil, "minRequiredBy must be a positive integer")
+			return
+		}
+		minRequiredBy = parsed
+	}
+
+	fragile, err := h.query.FragileDependencies(r.Context(), minRequiredBy)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to detect fragile dependencies")
+		return
+	}
+
+	responses := make([]FragileDependencyResponse, len(fragile))
+	for i, f := range fragile {
+		responses[i] = FragileDependencyResponse{
+			CapabilityCriticalityResponse: h.toCriticalityResponse(f.CapabilityCriticalityView),
+			ComponentIDs:                  f.ComponentIDs,
+			Reasons:                       f.Reasons,
+		}
+	}
+
+	links := sharedAPI.Links{
+		"self": sharedAPI.NewLink(fmt.Sprintf("%s/fragile?minRequiredBy=%d", dependencyAnalysisURL, minRequiredBy), "GET"),
+		"up":   sharedAPI.NewLink(dependencyAnalysisURL, "GET"),
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, responses, links)
+}
+
+func (h *DependencyAnalysisHandlers) toCriticalityResponse(view handlers.CapabilityCriticalityView) CapabilityCriticalityResponse {
+	return CapabilityCriticalityResponse{
+		CapabilityID:         view.CapabilityID,
+		CapabilityName:       view.CapabilityName,
+		DirectDependents:     view.DirectDependents,
+		RequiredBy:           view.RequiredBy,
+		TransitiveDependents: view.TransitiveDependents,
+		Score:                view.Score,
+		Links:                h.capabilityLink(view.CapabilityID),
+	}
+}
+
+func (h *DependencyAnalysisHandlers) capabilityLink(capabilityID string) sharedAPI.Links {
+	return sharedAPI.Links{
+		"capability": sharedAPI.NewLink("/api/v1/capabilities/"+capabilityID, "GET"),
+		"downstream": sharedAPI.NewLink("/api/v1/capabilities/"+capabilityID+"/dependencies/downstream", "GET"),
+	}
+}
+
+func parseImpactDepth(r *http.Request) (int, error) {
+	raw := r.URL.Query().Get("maxDepth")
+	if raw == "" {
+		return 0, nil
+	}
+	depth, err := strconv.Atoi(raw)
+	if err != nil || depth < 0 || depth > maxImpactDepth {
+		return 0, errInvalidImpactDepth
+	}
+	return depth, nil
+}
+
+func parseDependencyTypes(r *http.Request) ([]valueobjects.DependencyType, error) {
+	raw := r.URL.Query().Get("type")
+	if raw == "" {
+		return nil, nil
+	}
+	var types []valueobjects.DependencyType
+	for _, part := range strings.Split(raw, ",") {
+		t, err := valueobjects.NewDependencyType(part)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
@@ -21,6 +21,7 @@ func init() {
 	registry.RegisterNotFound(handlers.ErrCapabilityNotFound, "Capability not found")
 	registry.RegisterNotFound(handlers.ErrBusinessDomainNotFound, "Business domain not found")
 	registry.RegisterNotFound(handlers.ErrParentCapabilityNotFound, "Parent capability not found")
+	registry.RegisterNotFound(handlers.ErrParentBusinessDomainNotFound, "Parent business domain not found")
 	registry.RegisterNotFound(handlers.ErrComponentNotFound, "Component not found")
 	registry.RegisterNotFound(handlers.ErrCapabilityNotFoundForRealization, "Capability not found")
 	registry.RegisterNotFound(handlers.ErrSourceCapabilityNotFound, "Source capability not found")
@@ -29,21 +30,33 @@ func init() {
 	registry.RegisterValidation(valueobjects.ErrDomainNameEmpty, "Domain name cannot be empty")
 	registry.RegisterValidation(valueobjects.ErrDomainNameTooLong, "Domain name cannot exceed 100 characters")
 	registry.RegisterValidation(valueobjects.ErrCapabilityNameEmpty, "Capability name cannot be empty")
+	registry.RegisterValidation(valueobjects.ErrInvalidRealizationLevel, "Invalid realization level: must be Full, Partial, or Planned")
+	registry.RegisterValidation(valueobjects.ErrDescriptionTooLong, "Description cannot exceed 1000 characters")
 
 	registry.RegisterConflict(aggregates.ErrOnlyL1CanBeAssignedToDomain, "Only L1 capabilities can be assigned to business domains")
 	registry.RegisterConflict(handlers.ErrAssignmentAlreadyExists, "Capability is already assigned to this domain")
 	registry.RegisterConflict(handlers.ErrBusinessDomainNameExists, "Business domain with this name already exists")
 	registry.RegisterConflict(services.ErrBusinessDomainHasAssignments, "Cannot delete domain with assigned capabilities")
+	registry.RegisterConflict(services.ErrBusinessDomainHasChildren, "Cannot delete domain with child domains")
 	registry.RegisterConflict(services.ErrCapabilityHasChildren, "Cannot delete capability with children")
 	registry.RegisterConflict(services.ErrCascadeRequiredForChildCapabilities, "Capability has descendants. Set cascade:true to confirm cascade deletion.")
 
 	registry.RegisterConflict(aggregates.ErrL1CannotHaveParent, "L1 capabilities cannot have a parent")
-	registry.RegisterConflict(aggregates.ErrNonL1MustHaveParent, "L2-L4 capabilities must have a parent")
+	registry.RegisterConflict(aggregates.ErrNonL1MustHaveParent, "Capabilities below L1 must have a parent")
 	registry.RegisterConflict(aggregates.ErrParentMustBeOneLevelAbove, "Parent must be exactly one level above")
 	registry.RegisterConflict(aggregates.ErrCapabilityCannotBeOwnParent, "Capability cannot be its own parent")
 	registry.RegisterConflict(aggregates.ErrWouldCreateCircularReference, "Operation would create circular reference")
-	registry.RegisterConflict(aggregates.ErrWouldExceedMaximumDepth, "Operation would create L5+ hierarchy")
+	registry.RegisterConflict(aggregates.ErrWouldExceedMaximumDepth, "Operation would exceed the configured capability hierarchy depth")
 	registry.RegisterConflict(aggregates.ErrCannotCreateSelfDependency, "Cannot create self-dependency")
+	registry.RegisterConflict(aggregates.ErrBusinessDomainCannotBeOwnParent, "Business domain cannot be its own parent")
+	registry.RegisterConflict(aggregates.ErrBusinessDomainCircularHierarchy, "Operation would create a circular business domain hierarchy")
+
+	registry.RegisterValidation(handlers.ErrNothingToMerge, "At least one other capability must be merged")
+	registry.RegisterValidation(handlers.ErrInvalidSplitDistribution, "Split distribution refers to a part that does not exist")
+	registry.RegisterValidation(aggregates.ErrCannotMergeIntoItself, "A capability cannot be merged into itself")
+	registry.RegisterValidation(aggregates.ErrSplitRequiresTwoParts, "A split requires at least two parts")
+	registry.RegisterConflict(aggregates.ErrMergeRequiresSameLevel, "Only capabilities on the same level can be merged")
+	registry.RegisterConflict(aggregates.ErrSplitPartMustBeSibling, "Split parts must be siblings of the split capability")
 
 	registry.RegisterNotFound(repositories.ErrApplicationFitScoreNotFound, "Application fit score not found")
 	registry.RegisterNotFound(handlers.ErrFitScoreNotFound, "Application fit score not found")
@@ -64,4 +77,46 @@ func init() {
 	registry.RegisterValidation(valueobjects.ErrRationaleTooLong, "Rationale cannot exceed 2000 characters")
 
 	registry.RegisterConflict(handlers.ErrImportanceAlreadyExists, "Importance rating already exists for this combination")
+
+	registry.RegisterValidation(handlers.ErrBulkTargetsRequired, "At least one target ID is required")
+	registry.RegisterValidation(handlers.ErrBulkTooManyTargets, "A bulk edit can target at most 200 items")
+	registry.RegisterValidation(handlers.ErrBulkPatchEmpty, "The bulk edit patch must change at least one field")
+
+	registry.RegisterValidation(valueobjects.ErrTagEmpty, "Tag cannot be empty")
+	registry.RegisterValidation(valueobjects.ErrTagNotInVocabulary, "Tag is not in the capability tag vocabulary")
+	registry.RegisterValidation(handlers.ErrTagRenameUnchanged, "The new tag must differ from the current tag")
+	registry.RegisterValidation(handlers.ErrTagMergeSourceRequired, "At least one source tag other than the target is required")
+
+	registry.RegisterValidation(valueobjects.ErrInvalidCoverageGapType, "Invalid coverage gap type: must be unrealized, planned-only, parent-only-full or unscored-component")
+	registry.RegisterValidation(valueobjects.ErrInvalidHeatmapMetric, "Invalid heatmap metric: must be maturity, importance, fit, eliminate-count, cost, completeness or active-journeys")
+	registry.RegisterValidation(valueobjects.ErrInvalidHeatmapAggregation, "Invalid heatmap aggregation: must be max, avg, weighted or sum")
+	registry.RegisterValidation(handlers.ErrHeatmapPillarRequired, "The importance heatmap requires a pillarId")
+	registry.RegisterValidation(handlers.ErrHeatmapCostFieldRequired, "The cost heatmap requires a costFieldId")
+	registry.RegisterValidation(handlers.ErrHeatmapMetricUnavailable, "Heatmap metric is not available")
+
+	registry.RegisterValidation(valueobjects.ErrInvalidPartyType, "Invalid party type: must be user or team")
+	registry.RegisterValidation(valueobjects.ErrPartyIDEmpty, "Party ID cannot be empty")
+	registry.RegisterValidation(valueobjects.ErrInvalidRACIRole, "Invalid RACI role: must be Responsible, Accountable, Consulted, or Informed")
+	registry.RegisterValidation(handlers.ErrOwnershipPartyNotFound, "Owner must be an active user or an existing internal team")
+	registry.RegisterNotFound(aggregates.ErrCapabilityOwnerNotFound, "Party holds no role on this capability")
+
+	registry.RegisterValidation(valueobjects.ErrInvalidDependencyType, "Invalid dependency type: must be Requires, Enables, or Supports")
+
+	registry.RegisterNotFound(repositories.ErrReferenceModelNotFound, "Reference model not found")
+	registry.RegisterNotFound(repositories.ErrReferenceMappingNotFound, "Reference mapping not found")
+	registry.RegisterNotFound(handlers.ErrReferenceEntryNotFound, "Reference entry not found in this reference model")
+	registry.RegisterValidation(aggregates.ErrReferenceModelNameEmpty, "Reference model name cannot be empty")
+	registry.RegisterValidation(aggregates.ErrReferenceModelNameTooLong, "Reference model name cannot exceed 200 characters")
+	registry.RegisterValidation(valueobjects.ErrInvalidReferenceFramework, "Invalid reference framework: must be BIAN, APQC, TOGAF or Custom")
+	registry.RegisterValidation(valueobjects.ErrReferenceCatalogueEmpty, "Reference model must contain at least one entry")
+	registry.RegisterValidation(valueobjects.ErrReferenceCatalogueTooLarge, "Reference model cannot contain more than 5000 entries")
+	registry.RegisterValidation(valueobjects.ErrReferenceEntryCodeEmpty, "Reference entry code cannot be empty")
+	registry.RegisterValidation(valueobjects.ErrReferenceEntryNameEmpty, "Reference entry name cannot be empty")
+	registry.RegisterValidation(valueobjects.ErrReferenceEntryDuplicateCode, "Reference entry codes must be unique within a model")
+	registry.RegisterValidation(valueobjects.ErrReferenceEntryUnknownParent, "Reference entry parent code does not exist in the model")
+	registry.RegisterValidation(valueobjects.ErrReferenceEntryCycle, "Reference entry parent codes must not form a cycle")
+	registry.RegisterValidation(valueobjects.ErrInvalidReferenceCoverageStatus, "Invalid reference coverage status: must be mapped, partial or unmapped")
+	registry.RegisterValidation(handlers.ErrSeedInvalidDepth, "Seed depth must be between 0 and 3")
+	registry.RegisterValidation(handlers.ErrSeedTooManyDomains, "Seeding would create more than 200 business domains")
+	registry.RegisterConflict(handlers.ErrReferenceMappingAlreadyExists, "Capability is already mapped to this reference entry")
 }
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"easi/backend/internal/capabilitymapping/application/readmodels"
 	"easi/backend/internal/capabilitymapping/domain/valueobjects"
 	sharedAPI "easi/backend/internal/shared/api"
 	sharedctx "easi/backend/internal/shared/context"
@@ -34,15 +35,13 @@ func (h *CapabilityMappingLinks) capabilityBaseForActor(id string, actor sharedc
 		"collection":              h.Get("/capabilities"),
 		"x-expert-roles":          h.Get("/capabilities/expert-roles"),
 		"x-one-pager":             h.Get("/one-pagers/capability/" + id),
+		"x-owners":                h.Get(p + "/owners"),
 	}
 	h.AddEditOrGrantLink(links, actor, sharedAPI.EditGrantParams{
 		Permission:   "capabilities",
 		ArtifactType: "capabilities",
 		ArtifactID:   id,
 		EditLink:     h.Put(p),
-		ExtraWrite: map[string]types.Link{
-			"x-add-expert": h.Post(p + "/experts"),
-		},
 	})
 	if actor.CanDelete("capabilities") {
 		links["delete"] = h.Del(p)
@@ -56,9 +55,19 @@ func (h *CapabilityMappingLinks) CapabilityExpertLinksForActor(p sharedAPI.Exper
 	return h.ExpertRemoveLink(p, actor, "capabilities")
 }
 
-func (h *CapabilityMappingLinks) CapabilityXRelatedForActor(level string, actor sharedctx.Actor) []types.RelatedLink {
+func (h *CapabilityMappingLinks) CapabilityOwnerLinksForActor(capabilityID, partyType, partyID string, actor sharedctx.Actor) sharedAPI.Links {
+	p := "/capabilities/" + capabilityID + "/owners/" + partyType + "/" + partyID
+	links := sharedAPI.Links{"up": h.Get("/capabilities/" + capabilityID)}
+	if actor.CanWrite("capabilities") || actor.HasEditGrant("capabilities", capabilityID) {
+		links["edit"] = h.Put(p)
+		links["delete"] = h.Del(p)
+	}
+	return links
+}
+
+func (h *CapabilityMappingLinks) CapabilityXRelatedForActor(level string, hierarchy valueobjects.CapabilityHierarchy, actor sharedctx.Actor) []types.RelatedLink {
 	related := []types.RelatedLink{}
-	if actor.CanWrite("capabilities") && canHaveChildCapability(level) {
+	if actor.CanWrite("capabilities") && hierarchy.CanHaveChildren(valueobjects.CapabilityLevel(level)) {
 		related = append(related, types.RelatedLink{
 			Href:         h.Base() + "/capabilities",
 			Methods:      []string{"POST"},
@@ -79,15 +88,6 @@ func (h *CapabilityMappingLinks) CapabilityXRelatedForActor(level string, actor
 	return related
 }
 
-func canHaveChildCapability(level string) bool {
-	switch valueobjects.CapabilityLevel(level) {
-	case valueobjects.LevelL1, valueobjects.LevelL2, valueobjects.LevelL3:
-		return true
-	default:
-		return false
-	}
-}
-
 func (h *CapabilityMappingLinks) DependencyLinks(id, srcCapID, tgtCapID string) sharedAPI.Links {
 	p := "/capability-dependencies/" + id
 	return sharedAPI.Links{
@@ -107,15 +107,21 @@ func (h *CapabilityMappingLinks) RealizationLinks(id, capID, compID string) shar
 	}
 }
 
-func (h *CapabilityMappingLinks) BusinessDomainLinksForActor(id string, hasCaps bool, actor sharedctx.Actor) sharedAPI.Links {
+func (h *CapabilityMappingLinks) BusinessDomainLinksForActor(id, parentID string, hasCaps bool, actor sharedctx.Actor) sharedAPI.Links {
 	p := "/business-domains/" + id
 	links := sharedAPI.Links{
 		"self":           h.Get(p),
 		"x-capabilities": h.Get(p + "/capabilities"),
+		"x-children":     h.Get(p + "/children"),
+		"x-kpis":         h.Get(p + "/kpis"),
 		"collection":     h.Get("/business-domains"),
 	}
+	if parentID != "" {
+		links["up"] = h.Get("/business-domains/" + parentID)
+	}
 	if actor.CanWrite("domains") {
 		links["edit"] = h.Put(p)
+		links["x-move"] = h.Patch(p + "/parent")
 	}
 	if actor.CanDelete("domains") && !hasCaps {
 		links["delete"] = h.Del(p)
@@ -124,6 +130,24 @@ func (h *CapabilityMappingLinks) BusinessDomainLinksForActor(id string, hasCaps
 	return links
 }
 
+// BusinessDomainKPILinks points from a domain's KPI rollup to the views that explain each figure.
+func (h *CapabilityMappingLinks) BusinessDomainKPILinks(id, parentID string) sharedAPI.Links {
+	p := "/business-domains/" + id
+	links := sharedAPI.Links{
+		"self":                      h.Get(p + "/kpis"),
+		"x-business-domain":         h.Get(p),
+		"x-children":                h.Get(p + "/children"),
+		"x-capabilities":            h.Get(p + "/capabilities"),
+		"x-capability-realizations": h.Get(p + "/capability-realizations"),
+		"x-importance":              h.Get(p + "/importance"),
+		"x-heatmap":                 h.Get("/capabilities/heatmap?metric=fit&businessDomainId=" + id),
+	}
+	if parentID != "" {
+		links["up"] = h.Get("/business-domains/" + parentID + "/kpis")
+	}
+	return links
+}
+
 func (h *CapabilityMappingLinks) BusinessDomainCollectionLinksForActor(actor sharedctx.Actor) sharedAPI.Links {
 	links := sharedAPI.Links{"self": h.Get("/business-domains")}
 	if actor.CanWrite("domains") {
@@ -236,3 +260,38 @@ func (h *CapabilityMappingLinks) FitScoresCollectionLinksForActor(componentID st
 	}
 	return links
 }
+
+func (h *CapabilityMappingLinks) ReferenceModelLinksForActor(id string, actor sharedctx.Actor) sharedAPI.Links {
+	p := "/reference-models/" + id
+	links := sharedAPI.Links{
+		"self":       h.Get(p),
+		"x-coverage": h.Get(p + "/coverage"),
+		"collection": h.Get("/reference-models"),
+	}
+	if actor.CanWrite("domains") {
+		links["x-seed-business-domains"] = h.Post(p + "/seed-business-domains")
+	}
+	if actor.CanDelete("capabilities") {
+		links["delete"] = h.Del(p)
+	}
+	return links
+}
+
+func (h *CapabilityMappingLinks) ReferenceModelCollectionLinksForActor(actor sharedctx.Actor) sharedAPI.Links {
+	links := sharedAPI.Links{"self": h.Get("/reference-models")}
+	if actor.CanWrite("capabilities") {
+		links["create"] = h.Post("/reference-models")
+	}
+	return links
+}
+
+func (h *CapabilityMappingLinks) ReferenceMappingLinksForActor(mapping readmodels.ReferenceMappingDTO, actor sharedctx.Actor) sharedAPI.Links {
+	links := sharedAPI.Links{
+		"up":                h.Get("/capabilities/" + mapping.CapabilityID),
+		"x-reference-model": h.Get("/reference-models/" + mapping.ReferenceModelID),
+	}
+	if actor.CanWrite("capabilities") || actor.HasEditGrant("capabilities", mapping.CapabilityID) {
+		links["delete"] = h.Del("/capabilities/" + mapping.CapabilityID + "/reference-mappings/" + mapping.ID)
+	}
+	return links
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
 	sharedAPI "easi/backend/internal/shared/api"
 	sharedctx "easi/backend/internal/shared/context"
 	"easi/backend/internal/shared/types"
@@ -18,7 +19,7 @@ func capabilityRelatedFor(t *testing.T, role sharedctx.Role, level string) []typ
 	h := sharedAPI.NewHATEOASLinks("/api/v1")
 	links := NewCapabilityMappingLinks(h)
 	actor := sharedctx.NewActor("u1", "u@example.com", role)
-	return links.CapabilityXRelatedForActor(level, actor)
+	return links.CapabilityXRelatedForActor(level, valueobjects.DefaultCapabilityHierarchy(), actor)
 }
 
 func capabilityRelatedForPerms(t *testing.T, perms map[string]bool, level string) []types.RelatedLink {
@@ -26,7 +27,7 @@ func capabilityRelatedForPerms(t *testing.T, perms map[string]bool, level string
 	h := sharedAPI.NewHATEOASLinks("/api/v1")
 	links := NewCapabilityMappingLinks(h)
 	actor := sharedctx.Actor{ID: "u1", Email: "u@example.com", Permissions: perms}
-	return links.CapabilityXRelatedForActor(level, actor)
+	return links.CapabilityXRelatedForActor(level, valueobjects.DefaultCapabilityHierarchy(), actor)
 }
 
 func findRelatedLink(items []types.RelatedLink, relationType string) *types.RelatedLink {
@@ -56,6 +57,19 @@ func TestCapabilityXRelatedForActor_AtL4HasNoParentEntry(t *testing.T) {
 	assert.Nil(t, entry, "L4 capability must omit the capability-parent entry entirely")
 }
 
+func TestCapabilityXRelatedForActor_FollowsConfiguredDepth(t *testing.T) {
+	h := sharedAPI.NewHATEOASLinks("/api/v1")
+	links := NewCapabilityMappingLinks(h)
+	actor := sharedctx.NewActor("u1", "u@example.com", sharedctx.RoleArchitect)
+	deep, err := valueobjects.NewCapabilityHierarchy([]string{"Domain", "Area", "Capability", "Sub-capability", "Function", "Activity"})
+	require.NoError(t, err)
+	shallow, err := valueobjects.NewCapabilityHierarchy([]string{"Domain", "Capability"})
+	require.NoError(t, err)
+
+	assert.NotNil(t, findRelatedLink(links.CapabilityXRelatedForActor("L4", deep, actor), "capability-parent"))
+	assert.Nil(t, findRelatedLink(links.CapabilityXRelatedForActor("L2", shallow, actor), "capability-parent"))
+}
+
 func TestCapabilityXRelatedForActor_ArchitectGetsRealizationPOST(t *testing.T) {
 	related := capabilityRelatedFor(t, sharedctx.RoleArchitect, "L3")
 
@@ -110,7 +124,7 @@ func TestAddLinksToCapability_EnrichToMarshaledJSON_AdvertisesXRelated(t *testin
 	architect := sharedctx.NewActor("u1", "u@example.com", sharedctx.RoleArchitect)
 	dto := &readmodels.CapabilityDTO{ID: "cap1", Name: "Cap", Level: "L2"}
 
-	h.addLinksToCapability(dto, architect)
+	h.addLinksToCapability(dto, architect, valueobjects.DefaultCapabilityHierarchy())
 
 	data, err := json.Marshal(dto)
 	require.NoError(t, err)
@@ -21,6 +21,7 @@ func isValidationError(err error) bool {
 		errors.Is(err, valueobjects.ErrInvalidOwnershipModel) ||
 		errors.Is(err, valueobjects.ErrInvalidCapabilityStatus) ||
 		errors.Is(err, valueobjects.ErrTagEmpty) ||
+		errors.Is(err, valueobjects.ErrTagNotInVocabulary) ||
 		errors.Is(err, valueobjects.ErrExpertNameEmpty) ||
 		errors.Is(err, valueobjects.ErrExpertRoleEmpty) ||
 		errors.Is(err, valueobjects.ErrExpertContactEmpty)
@@ -34,15 +35,11 @@ type UpdateCapabilityMetadataRequest struct {
 	MaturityValue  *int   `json:"maturityValue,omitempty"`
 	MaturityLevel  string `json:"maturityLevel,omitempty"`
 	OwnershipModel string `json:"ownershipModel,omitempty"`
-	PrimaryOwner   string `json:"primaryOwner,omitempty"`
-	EAOwner        string `json:"eaOwner,omitempty"`
-	Status         string `json:"status"`
-}
-
-type AddCapabilityExpertRequest struct {
-	ExpertName  string `json:"expertName"`
-	ExpertRole  string `json:"expertRole"`
-	ContactInfo string `json:"contactInfo"`
+	// Deprecated: ignored. Assign owners through /capabilities/{id}/owners.
+	PrimaryOwner string `json:"primaryOwner,omitempty" extensions:"x-deprecated=true"`
+	// Deprecated: ignored. Assign owners through /capabilities/{id}/owners.
+	EAOwner string `json:"eaOwner,omitempty" extensions:"x-deprecated=true"`
+	Status  string `json:"status"`
 }
 
 type AddCapabilityTagRequest struct {
@@ -51,7 +48,7 @@ type AddCapabilityTagRequest struct {
 
 // UpdateCapabilityMetadata godoc
 // @Summary Update capability metadata
-// @Description Updates metadata fields like maturity level, ownership, and strategy alignment
+// @Description Updates maturity, ownership model and status. The free-text primaryOwner and eaOwner are deprecated and ignored; owners are assigned through /capabilities/{id}/owners.
 // @Tags capabilities
 // @Accept json
 // @Produce json
@@ -81,8 +78,6 @@ func (h *CapabilityHandlers) UpdateCapabilityMetadata(w http.ResponseWriter, r *
 		MaturityValue:  maturityValue,
 		MaturityLevel:  req.MaturityLevel,
 		OwnershipModel: req.OwnershipModel,
-		PrimaryOwner:   req.PrimaryOwner,
-		EAOwner:        req.EAOwner,
 		Status:         req.Status,
 	}
 
@@ -111,38 +106,14 @@ func (h *CapabilityHandlers) UpdateCapabilityMetadata(w http.ResponseWriter, r *
 	}
 
 	actor, _ := sharedctx.GetActor(r.Context())
-	h.addLinksToCapability(capability, actor)
+	h.addLinksToCapability(capability, actor, h.capabilityHierarchy(r.Context()))
 
 	sharedAPI.RespondJSON(w, http.StatusOK, capability)
 }
 
-// AddCapabilityExpert godoc
-// @Summary Add an expert to a capability
-// @Description Associates a subject matter expert with a capability
-// @Tags capabilities
-// @Accept json
-// @Param id path string true "Capability ID"
-// @Param expert body AddCapabilityExpertRequest true "Expert data"
-// @Success 204 "No Content"
-// @Failure 400 {object} sharedAPI.ErrorResponse
-// @Failure 404 {object} sharedAPI.ErrorResponse
-// @Failure 500 {object} sharedAPI.ErrorResponse
-// @Router /capabilities/{id}/experts [post]
-func (h *CapabilityHandlers) AddCapabilityExpert(w http.ResponseWriter, r *http.Request) {
-	decodeAndDispatchCapabilityCommand(h, w, r, "Failed to add expert",
-		func(id string, req AddCapabilityExpertRequest) cqrs.Command {
-			return &commands.AddCapabilityExpert{
-				CapabilityID: id,
-				ExpertName:   req.ExpertName,
-				ExpertRole:   req.ExpertRole,
-				ContactInfo:  req.ContactInfo,
-			}
-		})
-}
-
 // RemoveCapabilityExpert godoc
 // @Summary Remove an expert from a capability
-// @Description Removes a subject matter expert from a capability
+// @Description Removes a legacy free-text expert from a capability. New experts are assigned as Consulted owners through /capabilities/{id}/owners.
 // @Tags capabilities
 // @Param id path string true "Capability ID"
 // @Param name query string true "Expert name"
@@ -238,6 +209,24 @@ func (h *CapabilityHandlers) AddCapabilityTag(w http.ResponseWriter, r *http.Req
 		})
 }
 
+// RemoveCapabilityTag godoc
+// @Summary Remove a tag from a capability
+// @Description Removes a tag from a capability. Removing a tag the capability does not carry is a no-op.
+// @Tags capabilities
+// @Param id path string true "Capability ID"
+// @Param tag path string true "Tag"
+// @Success 204 "No Content"
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/tags/{tag} [delete]
+func (h *CapabilityHandlers) RemoveCapabilityTag(w http.ResponseWriter, r *http.Request) {
+	h.dispatchCapabilityCommand(w, r, &commands.RemoveCapabilityTag{
+		CapabilityID: chi.URLParam(r, "id"),
+		Tag:          sharedAPI.GetPathParam(r, "tag"),
+	}, "Failed to remove tag")
+}
+
 func decodeAndDispatchCapabilityCommand[T any](h *CapabilityHandlers, w http.ResponseWriter, r *http.Request, failureMsg string, build func(id string, req T) cqrs.Command) {
 	id := chi.URLParam(r, "id")
 
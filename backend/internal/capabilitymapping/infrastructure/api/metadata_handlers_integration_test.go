@@ -6,6 +6,7 @@ package api
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -122,14 +123,17 @@ func TestUpdateCapabilityMetadata_Integration(t *testing.T) {
 	testCtx.setTenantContext(t)
 	var ownershipModel, status string
 	var maturityValue int
+	var primaryOwner, eaOwner sql.NullString
 	err := testCtx.db.QueryRow(
-		"SELECT maturity_value, ownership_model, status FROM capabilitymapping.capabilities WHERE id = $1",
+		"SELECT maturity_value, ownership_model, status, primary_owner, ea_owner FROM capabilitymapping.capabilities WHERE id = $1",
 		capabilityID,
-	).Scan(&maturityValue, &ownershipModel, &status)
+	).Scan(&maturityValue, &ownershipModel, &status, &primaryOwner, &eaOwner)
 	require.NoError(t, err)
 	assert.Equal(t, 37, maturityValue)
 	assert.Equal(t, "TribeOwned", ownershipModel)
 	assert.Equal(t, "Active", status)
+	assert.Empty(t, primaryOwner.String, "the deprecated free-text owners are no longer written")
+	assert.Empty(t, eaOwner.String)
 }
 
 func TestUpdateCapabilityMetadata_ValidationErrors_Integration(t *testing.T) {
@@ -173,35 +177,6 @@ func TestUpdateCapabilityMetadata_ValidationErrors_Integration(t *testing.T) {
 	}
 }
 
-func TestAddCapabilityExpert_Integration(t *testing.T) {
-	testCtx, cleanup := setupTestDB(t)
-	defer cleanup()
-
-	handlers := setupHandlers(testCtx.db)
-
-	capabilityID := createCapabilityForTest(t, testCtx, handlers, CreateCapabilityRequest{
-		Name:  "Data Management",
-		Level: "L1",
-	})
-
-	expertReq := newJSONRequest(t, jsonRequest{
-		method: http.MethodPost,
-		target: "/api/v1/capabilities/" + capabilityID + "/experts",
-		id:     capabilityID,
-		body: AddCapabilityExpertRequest{
-			ExpertName:  "Dr. Alice Johnson",
-			ExpertRole:  "Data Architect",
-			ContactInfo: "alice.johnson@example.com",
-		},
-	})
-	expertW := invokeHandler(handlers.AddCapabilityExpert, expertReq)
-
-	assert.Equal(t, http.StatusNoContent, expertW.Code)
-
-	testCtx.requireEventDataContains(t, capabilityID, "CapabilityExpertAdded",
-		"Dr. Alice Johnson", "Data Architect", "alice.johnson@example.com")
-}
-
 func TestAddCapabilityTag_Integration(t *testing.T) {
 	cases := []struct {
 		name             string
@@ -18,6 +18,7 @@ import (
 	"easi/backend/internal/capabilitymapping/application/readmodels"
 	"easi/backend/internal/capabilitymapping/domain/services"
 	"easi/backend/internal/capabilitymapping/infrastructure/adapters"
+	"easi/backend/internal/capabilitymapping/infrastructure/metamodel"
 	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
 	cmPL "easi/backend/internal/capabilitymapping/publishedlanguage"
 	"easi/backend/internal/infrastructure/database"
@@ -68,16 +69,16 @@ func setupParentHandlers(db *sql.DB) *CapabilityHandlers {
 	}
 
 	capabilityRepo := repositories.NewCapabilityRepository(eventStore)
-	reparentingService := services.NewCapabilityReparentingService(adapters.NewCapabilityLookupAdapter(readModel))
+	hierarchies := metamodel.NewLocalCapabilityHierarchyGateway(readmodels.NewCapabilityHierarchyCacheReadModel(tenantDB))
+	reparentingService := services.NewCapabilityReparentingService(adapters.NewCapabilityLookupAdapter(readModel), hierarchies)
 
-	commandBus.Register("CreateCapability", handlers.NewCreateCapabilityHandler(capabilityRepo))
+	commandBus.Register("CreateCapability", handlers.NewCreateCapabilityHandler(capabilityRepo, hierarchies))
 	commandBus.Register("UpdateCapability", handlers.NewUpdateCapabilityHandler(capabilityRepo))
 	commandBus.Register("UpdateCapabilityMetadata", handlers.NewUpdateCapabilityMetadataHandler(capabilityRepo))
-	commandBus.Register("AddCapabilityExpert", handlers.NewAddCapabilityExpertHandler(capabilityRepo))
-	commandBus.Register("AddCapabilityTag", handlers.NewAddCapabilityTagHandler(capabilityRepo))
-	commandBus.Register("ChangeCapabilityParent", handlers.NewChangeCapabilityParentHandler(capabilityRepo, readModel, realizationReadModel, reparentingService))
+	commandBus.Register("AddCapabilityTag", handlers.NewAddCapabilityTagHandler(capabilityRepo, metamodel.NewLocalCapabilityTagVocabularyGateway(readmodels.NewCapabilityTagVocabularyCacheReadModel(tenantDB))))
+	commandBus.Register("ChangeCapabilityParent", handlers.NewChangeCapabilityParentHandler(capabilityRepo, readModel, realizationReadModel, reparentingService, hierarchies))
 
-	return NewCapabilityHandlers(CapabilityHandlersDeps{CommandBus: commandBus, ReadModel: readModel, Links: links})
+	return NewCapabilityHandlers(CapabilityHandlersDeps{CommandBus: commandBus, ReadModel: readModel, Links: links, Hierarchies: hierarchies})
 }
 
 func (f *parentTestFixture) createCapability(capReq CreateCapabilityRequest) string {
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"encoding/csv"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"easi/backend/internal/capabilitymapping/application/handlers"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	sharedAPI "easi/backend/internal/shared/api"
+	"easi/backend/internal/shared/types"
+)
+
+const coverageGapsPath = "/capabilities/coverage-gaps"
+
+type RealizationCoverageGapsHandlers struct {
+	query *handlers.RealizationCoverageGapsQuery
+	links *CapabilityMappingLinks
+}
+
+func NewRealizationCoverageGapsHandlers(query *handlers.RealizationCoverageGapsQuery, links *CapabilityMappingLinks) *RealizationCoverageGapsHandlers {
+	return &RealizationCoverageGapsHandlers{query: query, links: links}
+}
+
+type CoverageGapResponse struct {
+	Type                 string      `json:"type"`
+	CapabilityID         string      `json:"capabilityId"`
+	CapabilityName       string      `json:"capabilityName"`
+	CapabilityLevel      string      `json:"capabilityLevel"`
+	ParentCapabilityID   string      `json:"parentCapabilityId,omitempty"`
+	ParentCapabilityName string      `json:"parentCapabilityName,omitempty"`
+	RealizationID        string      `json:"realizationId,omitempty"`
+	ComponentID          string      `json:"componentId,omitempty"`
+	ComponentName        string      `json:"componentName,omitempty"`
+	Links                types.Links `json:"_links"`
+}
+
+type CoverageGapsResponse struct {
+	BusinessDomainID    string                `json:"businessDomainId,omitempty"`
+	CapabilitiesScanned int                   `json:"capabilitiesScanned"`
+	Counts              map[string]int        `json:"counts"`
+	Gaps                []CoverageGapResponse `json:"gaps"`
+	Links               types.Links           `json:"_links"`
+}
+
+// GetCoverageGaps godoc
+// @Summary Get the realization coverage gaps report
+// @Description Scans capabilities against their realizations and lists unrealized capabilities, capabilities covered only by Planned realizations, leaf capabilities lacking a Full realization their parent has, and realizations by components without any fit score. Use format=csv to export.
+// @Tags capabilities
+// @Produce json
+// @Produce text/csv
+// @Param businessDomainId query string false "Restrict the report to a business domain and its sub-domains"
+// @Param type query string false "Comma-separated gap types" Enums(unrealized, planned-only, parent-only-full, unscored-component)
+// @Param format query string false "Response format" Enums(json, csv)
+// @Success 200 {object} CoverageGapsResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/coverage-gaps [get]
+func (h *RealizationCoverageGapsHandlers) GetCoverageGaps(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	req := handlers.CoverageGapsRequest{BusinessDomainID: params.Get("businessDomainId")}
+	for _, raw := range strings.Split(params.Get("type"), ",") {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		gapType, err := valueobjects.NewCoverageGapType(raw)
+		if err != nil {
+			sharedAPI.HandleError(w, err)
+			return
+		}
+		req.Types = append(req.Types, gapType)
+	}
+
+	report, err := h.query.Execute(r.Context(), req)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	if strings.EqualFold(params.Get("format"), "csv") {
+		writeCoverageGapsCSV(w, report)
+		return
+	}
+
+	response := CoverageGapsResponse{
+		BusinessDomainID:    report.BusinessDomainID,
+		CapabilitiesScanned: report.CapabilitiesScanned,
+		Counts:              make(map[string]int, len(valueobjects.CoverageGapTypes)),
+		Gaps:                make([]CoverageGapResponse, len(report.Gaps)),
+		Links:               h.reportLinks(params, report.BusinessDomainID),
+	}
+	for _, gapType := range valueobjects.CoverageGapTypes {
+		response.Counts[gapType.String()] = report.Counts[gapType]
+	}
+	for i, gap := range report.Gaps {
+		response.Gaps[i] = CoverageGapResponse{
+			Type:                 gap.Type.String(),
+			CapabilityID:         gap.CapabilityID,
+			CapabilityName:       gap.CapabilityName,
+			CapabilityLevel:      gap.CapabilityLevel,
+			ParentCapabilityID:   gap.ParentCapabilityID,
+			ParentCapabilityName: gap.ParentCapabilityName,
+			RealizationID:        gap.RealizationID,
+			ComponentID:          gap.ComponentID,
+			ComponentName:        gap.ComponentName,
+			Links: types.Links{
+				"x-capability":   h.links.Get("/capabilities/" + gap.CapabilityID),
+				"x-realizations": h.links.Get("/capabilities/" + gap.CapabilityID + "/systems"),
+			},
+		}
+	}
+
+	sharedAPI.RespondJSON(w, http.StatusOK, response)
+}
+
+func (h *RealizationCoverageGapsHandlers) reportLinks(params url.Values, businessDomainID string) types.Links {
+	query := url.Values{}
+	if businessDomainID != "" {
+		query.Set("businessDomainId", businessDomainID)
+	}
+	if t := params.Get("type"); t != "" {
+		query.Set("type", t)
+	}
+	self := coverageGapsPath
+	if encoded := query.Encode(); encoded != "" {
+		self += "?" + encoded
+	}
+	query.Set("format", "csv")
+
+	links := types.Links{
+		"self":         h.links.Get(self),
+		"x-export-csv": h.links.Get(coverageGapsPath + "?" + query.Encode()),
+	}
+	if businessDomainID != "" {
+		links["x-business-domain"] = h.links.Get("/business-domains/" + businessDomainID)
+	}
+	return links
+}
+
+var coverageGapsCSVHeader = []string{
+	"gapType", "capabilityId", "capabilityName", "capabilityLevel",
+	"parentCapabilityId", "parentCapabilityName", "realizationId", "componentId", "componentName",
+}
+
+func writeCoverageGapsCSV(w http.ResponseWriter, report *handlers.CoverageGapsReport) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="coverage-gaps.csv"`)
+	w.Header().Set("X-Capabilities-Scanned", strconv.Itoa(report.CapabilitiesScanned))
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	_ = writer.Write(coverageGapsCSVHeader)
+	for _, gap := range report.Gaps {
+		_ = writer.Write(csvCells(
+			gap.Type.String(), gap.CapabilityID, gap.CapabilityName, gap.CapabilityLevel,
+			gap.ParentCapabilityID, gap.ParentCapabilityName, gap.RealizationID, gap.ComponentID, gap.ComponentName,
+		))
+	}
+	writer.Flush()
+}
+
+// csvCells neutralises values a spreadsheet would evaluate as a formula by
+// prefixing them with an apostrophe, since names are user-entered.
+func csvCells(values ...string) []string {
+	cells := make([]string, len(values))
+	for i, v := range values {
+		if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+			v = "'" + v
+		}
+		cells[i] = v
+	}
+	return cells
+}
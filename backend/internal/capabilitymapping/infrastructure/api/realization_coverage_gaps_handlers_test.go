@@ -0,0 +1,35 @@
+package api
+
+import (
+	"encoding/csv"
+	"net/http/httptest"
+	"testing"
+
+	"easi/backend/internal/capabilitymapping/application/handlers"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCoverageGapsCSV_NeutralisesFormulaCells(t *testing.T) {
+	rec := httptest.NewRecorder()
+	writeCoverageGapsCSV(rec, &handlers.CoverageGapsReport{Gaps: []handlers.CoverageGapEntry{{
+		Type:                 valueobjects.CoverageGapPlannedOnly,
+		CapabilityID:         "cap-1",
+		CapabilityName:       `=HYPERLINK("http://evil.example","x")`,
+		CapabilityLevel:      "L2",
+		ParentCapabilityName: "+Payments",
+		ComponentName:        "@SUM(A1)",
+		RealizationID:        "-1",
+		ComponentID:          "comp-1",
+	}}})
+
+	rows, err := csv.NewReader(rec.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []string{
+		"planned-only", "cap-1", `'=HYPERLINK("http://evil.example","x")`, "L2",
+		"", "'+Payments", "'-1", "comp-1", "'@SUM(A1)",
+	}, rows[1])
+}
@@ -134,16 +134,21 @@ func (h *RealizationHandlers) GetSystemsByCapability(w http.ResponseWriter, r *h
 
 // GetCapabilitiesByComponent godoc
 // @Summary Get capabilities realized by a component
-// @Description Retrieves all capabilities that are realized by a specific application component
+// @Description Retrieves all capabilities that are realized by a specific application component. With includeSubComponents=true, realizations of its child components (e.g. the modules of an ERP suite) are rolled up as well.
 // @Tags capability-realizations
 // @Produce json
 // @Param componentId path string true "Component ID"
+// @Param includeSubComponents query bool false "Include realizations of all descendant components"
 // @Success 200 {object} easi_backend_internal_shared_api.CollectionResponse{data=[]easi_backend_internal_capabilitymapping_application_readmodels.RealizationDTO}
 // @Failure 500 {object} sharedAPI.ErrorResponse
 // @Router /capability-realizations/by-component/{componentId} [get]
 func (h *RealizationHandlers) GetCapabilitiesByComponent(w http.ResponseWriter, r *http.Request) {
 	componentID := chi.URLParam(r, "componentId")
-	h.respondRealizations(w, r, h.readModel.GetByComponentID, componentID, sharedAPI.Links{
+	fetch := h.readModel.GetByComponentID
+	if r.URL.Query().Get("includeSubComponents") == "true" {
+		fetch = h.readModel.GetByComponentIDIncludingSubComponents
+	}
+	h.respondRealizations(w, r, fetch, componentID, sharedAPI.Links{
 		"self": sharedAPI.NewLink("/api/v1/capability-realizations/by-component/"+componentID, "GET"),
 		"up":   sharedAPI.NewLink("/api/v1/components/"+componentID, "GET"),
 	})
@@ -77,7 +77,7 @@ func newCapabilityAggregate(t *testing.T, name, level string, parentID string) *
 		require.NoError(t, err)
 	}
 
-	capability, err := aggregates.NewCapability(capabilityName, valueobjects.MustNewDescription(""), parent, capabilityLevel)
+	capability, err := aggregates.NewCapability(capabilityName, valueobjects.MustNewDescription(""), parent, capabilityLevel, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 	return capability
 }
@@ -0,0 +1,480 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"easi/backend/internal/capabilitymapping/application/commands"
+	"easi/backend/internal/capabilitymapping/application/handlers"
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+	"easi/backend/internal/shared/types"
+)
+
+type ReferenceModelHandlersDeps struct {
+	CommandBus cqrs.CommandBus
+	Models     *readmodels.ReferenceModelReadModel
+	Mappings   *readmodels.ReferenceMappingReadModel
+	Capability *readmodels.CapabilityReadModel
+	Coverage   *handlers.ReferenceCoverageQuery
+	Seeder     *handlers.ReferenceDomainSeeder
+	Links      *CapabilityMappingLinks
+}
+
+type ReferenceModelHandlers struct {
+	commandBus cqrs.CommandBus
+	models     *readmodels.ReferenceModelReadModel
+	mappings   *readmodels.ReferenceMappingReadModel
+	capability *readmodels.CapabilityReadModel
+	coverage   *handlers.ReferenceCoverageQuery
+	seeder     *handlers.ReferenceDomainSeeder
+	links      *CapabilityMappingLinks
+}
+
+func NewReferenceModelHandlers(deps ReferenceModelHandlersDeps) *ReferenceModelHandlers {
+	return &ReferenceModelHandlers{
+		commandBus: deps.CommandBus,
+		models:     deps.Models,
+		mappings:   deps.Mappings,
+		capability: deps.Capability,
+		coverage:   deps.Coverage,
+		seeder:     deps.Seeder,
+		links:      deps.Links,
+	}
+}
+
+type ReferenceEntryRequest struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	ParentCode  string `json:"parentCode,omitempty"`
+}
+
+type ImportReferenceModelRequest struct {
+	Name        string                  `json:"name"`
+	Framework   string                  `json:"framework"`
+	Version     string                  `json:"version,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Entries     []ReferenceEntryRequest `json:"entries"`
+}
+
+type ReferenceModelDetailResponse struct {
+	readmodels.ReferenceModelDTO
+	Entries []readmodels.ReferenceEntryDTO `json:"entries"`
+}
+
+type ReferenceCoverageEntryResponse struct {
+	readmodels.ReferenceEntryDTO
+	Status   string                           `json:"status"`
+	Mappings []readmodels.ReferenceMappingDTO `json:"mappings"`
+}
+
+type ReferenceCoverageResponse struct {
+	ReferenceModel  readmodels.ReferenceModelDTO     `json:"referenceModel"`
+	TotalEntries    int                              `json:"totalEntries"`
+	Counts          map[string]int                   `json:"counts"`
+	CoveragePercent float64                          `json:"coveragePercent"`
+	Entries         []ReferenceCoverageEntryResponse `json:"entries"`
+	Links           types.Links                      `json:"_links"`
+}
+
+type MapCapabilityToReferenceRequest struct {
+	ReferenceModelID string `json:"referenceModelId"`
+	EntryCode        string `json:"entryCode"`
+}
+
+type SeedBusinessDomainsRequest struct {
+	EntryCodes             []string `json:"entryCodes,omitempty"`
+	Depth                  int      `json:"depth"`
+	ParentBusinessDomainID string   `json:"parentBusinessDomainId,omitempty"`
+}
+
+type SeededDomainResponse struct {
+	EntryCode              string `json:"entryCode"`
+	EntryName              string `json:"entryName"`
+	BusinessDomainID       string `json:"businessDomainId,omitempty"`
+	ParentBusinessDomainID string `json:"parentBusinessDomainId,omitempty"`
+	Status                 string `json:"status"`
+	ErrorStatus            int    `json:"errorStatus,omitempty"`
+	Error                  string `json:"error,omitempty"`
+}
+
+type SeedBusinessDomainsResponse struct {
+	CorrelationID string                 `json:"correlationId"`
+	Created       int                    `json:"created"`
+	Existing      int                    `json:"existing"`
+	Failed        int                    `json:"failed"`
+	Skipped       int                    `json:"skipped"`
+	Domains       []SeededDomainResponse `json:"domains"`
+	Links         sharedAPI.Links        `json:"_links"`
+}
+
+// GetAllReferenceModels godoc
+// @Summary List reference capability models
+// @Description Lists the imported industry reference models (BIAN, APQC PCF, TOGAF or custom catalogues) with their entry counts.
+// @Tags reference-models
+// @Produce json
+// @Success 200 {object} sharedAPI.CollectionResponse{data=[]readmodels.ReferenceModelDTO}
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /reference-models [get]
+func (h *ReferenceModelHandlers) GetAllReferenceModels(w http.ResponseWriter, r *http.Request) {
+	models, err := h.models.GetAll(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve reference models")
+		return
+	}
+
+	actor, _ := sharedctx.GetActor(r.Context())
+	for i := range models {
+		models[i].Links = h.links.ReferenceModelLinksForActor(models[i].ID, actor)
+	}
+
+	sharedAPI.RespondCollection(w, http.StatusOK, models, h.links.ReferenceModelCollectionLinksForActor(actor))
+}
+
+// GetReferenceModelByID godoc
+// @Summary Get a reference capability model
+// @Description Returns a reference model with its full catalogue of entries, parents before children.
+// @Tags reference-models
+// @Produce json
+// @Param id path string true "Reference model ID"
+// @Success 200 {object} ReferenceModelDetailResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /reference-models/{id} [get]
+func (h *ReferenceModelHandlers) GetReferenceModelByID(w http.ResponseWriter, r *http.Request) {
+	h.respondWithModel(w, r, sharedAPI.GetPathParam(r, "id"), http.StatusOK)
+}
+
+// ImportReferenceModel godoc
+// @Summary Import a reference capability model
+// @Description Loads an industry reference model as a read-only catalogue. Entries form a tree through parentCode; codes must be unique and every parent must be part of the import.
+// @Tags reference-models
+// @Accept json
+// @Produce json
+// @Param request body ImportReferenceModelRequest true "Reference model and its entries"
+// @Success 201 {object} ReferenceModelDetailResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /reference-models [post]
+func (h *ReferenceModelHandlers) ImportReferenceModel(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[ImportReferenceModelRequest](w, r)
+	if !ok {
+		return
+	}
+
+	entries := make([]commands.ReferenceEntryInput, len(req.Entries))
+	for i, entry := range req.Entries {
+		entries[i] = commands.ReferenceEntryInput{
+			Code:        entry.Code,
+			Name:        entry.Name,
+			Description: entry.Description,
+			ParentCode:  entry.ParentCode,
+		}
+	}
+
+	actor, _ := sharedctx.GetActor(r.Context())
+	result, err := h.commandBus.Dispatch(r.Context(), &commands.ImportReferenceModel{
+		Name:        req.Name,
+		Framework:   req.Framework,
+		Version:     req.Version,
+		Description: req.Description,
+		Entries:     entries,
+		ImportedBy:  actor.Email,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	h.respondWithModel(w, r, result.CreatedID, http.StatusCreated)
+}
+
+// DeleteReferenceModel godoc
+// @Summary Delete a reference capability model
+// @Description Deletes a reference model together with every capability mapping onto it.
+// @Tags reference-models
+// @Param id path string true "Reference model ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /reference-models/{id} [delete]
+func (h *ReferenceModelHandlers) DeleteReferenceModel(w http.ResponseWriter, r *http.Request) {
+	h.dispatch(w, r, &commands.DeleteReferenceModel{ID: sharedAPI.GetPathParam(r, "id")})
+}
+
+// GetReferenceModelCoverage godoc
+// @Summary Get the coverage of a reference model
+// @Description Benchmarks the capability map against a reference model. Each entry is mapped (at least one capability maps to it), partial (only descendants are mapped) or unmapped (we have no counterpart). Totals always cover the whole model; status narrows the listed entries.
+// @Tags reference-models
+// @Produce json
+// @Param id path string true "Reference model ID"
+// @Param status query string false "Comma-separated coverage statuses" Enums(mapped, partial, unmapped)
+// @Success 200 {object} ReferenceCoverageResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /reference-models/{id}/coverage [get]
+func (h *ReferenceModelHandlers) GetReferenceModelCoverage(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	var statuses []valueobjects.ReferenceCoverageStatus
+	for _, raw := range strings.Split(r.URL.Query().Get("status"), ",") {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		status, err := valueobjects.NewReferenceCoverageStatus(raw)
+		if err != nil {
+			sharedAPI.HandleError(w, err)
+			return
+		}
+		statuses = append(statuses, status)
+	}
+
+	report, err := h.coverage.Execute(r.Context(), id, statuses)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	response := ReferenceCoverageResponse{
+		ReferenceModel:  report.Model,
+		TotalEntries:    report.TotalEntries,
+		Counts:          make(map[string]int, len(valueobjects.ReferenceCoverageStatuses)),
+		CoveragePercent: report.CoveragePercent,
+		Entries:         make([]ReferenceCoverageEntryResponse, len(report.Entries)),
+		Links: types.Links{
+			"self":              h.links.Get("/reference-models/" + id + "/coverage"),
+			"x-reference-model": h.links.Get("/reference-models/" + id),
+		},
+	}
+	for _, status := range valueobjects.ReferenceCoverageStatuses {
+		response.Counts[status.String()] = report.Counts[status]
+	}
+	for i, entry := range report.Entries {
+		response.Entries[i] = ReferenceCoverageEntryResponse{
+			ReferenceEntryDTO: entry.ReferenceEntryDTO,
+			Status:            entry.Status.String(),
+			Mappings:          entry.Mappings,
+		}
+	}
+
+	sharedAPI.RespondJSON(w, http.StatusOK, response)
+}
+
+// SeedBusinessDomains godoc
+// @Summary Seed business domains from a reference model
+// @Description Creates a business domain for each selected reference entry (the model's top-level entries when none are given) and, up to depth levels below, nested sub-domains for their descendants. Domains whose name already exists are reused instead of duplicated. Entries that fail are reported individually and their descendants skipped; all created domains share one correlation ID in the audit log.
+// @Tags reference-models
+// @Accept json
+// @Produce json
+// @Param id path string true "Reference model ID"
+// @Param request body SeedBusinessDomainsRequest true "Entries to seed and how deep"
+// @Success 200 {object} SeedBusinessDomainsResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /reference-models/{id}/seed-business-domains [post]
+func (h *ReferenceModelHandlers) SeedBusinessDomains(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[SeedBusinessDomainsRequest](w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.seeder.Seed(r.Context(), handlers.SeedBusinessDomainsRequest{
+		ReferenceModelID:       sharedAPI.GetPathParam(r, "id"),
+		EntryCodes:             req.EntryCodes,
+		Depth:                  req.Depth,
+		ParentBusinessDomainID: req.ParentBusinessDomainID,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	domains := make([]SeededDomainResponse, len(result.Domains))
+	for i, d := range result.Domains {
+		domains[i] = SeededDomainResponse{
+			EntryCode:              d.EntryCode,
+			EntryName:              d.EntryName,
+			BusinessDomainID:       d.BusinessDomainID,
+			ParentBusinessDomainID: d.ParentDomainID,
+			Status:                 d.Status,
+		}
+		if d.Err != nil {
+			domains[i].ErrorStatus, domains[i].Error = bulkItemError(d.Err)
+		}
+	}
+
+	sharedAPI.RespondJSON(w, http.StatusOK, SeedBusinessDomainsResponse{
+		CorrelationID: result.CorrelationID,
+		Created:       result.CountByStatus(handlers.SeedStatusCreated),
+		Existing:      result.CountByStatus(handlers.SeedStatusExisting),
+		Failed:        result.CountByStatus(handlers.SeedStatusFailed),
+		Skipped:       result.CountByStatus(handlers.SeedStatusSkipped),
+		Domains:       domains,
+		Links: sharedAPI.Links{
+			"audit":              sharedAPI.NewLink("/api/v1/audit/changes/"+result.CorrelationID, "GET"),
+			"x-business-domains": h.links.Get("/business-domains"),
+		},
+	})
+}
+
+// GetCapabilityReferenceMappings godoc
+// @Summary Get the reference mappings of a capability
+// @Description Lists the reference model entries the capability is mapped to.
+// @Tags capabilities
+// @Produce json
+// @Param id path string true "Capability ID"
+// @Success 200 {object} sharedAPI.CollectionResponse{data=[]readmodels.ReferenceMappingDTO}
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/reference-mappings [get]
+func (h *ReferenceModelHandlers) GetCapabilityReferenceMappings(w http.ResponseWriter, r *http.Request) {
+	id := sharedAPI.GetPathParam(r, "id")
+
+	capability, err := h.capability.GetByID(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve capability")
+		return
+	}
+	if capability == nil {
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "Capability not found")
+		return
+	}
+
+	mappings, err := h.mappings.GetByCapabilityID(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve reference mappings")
+		return
+	}
+
+	actor, _ := sharedctx.GetActor(r.Context())
+	for i := range mappings {
+		mappings[i].Links = h.links.ReferenceMappingLinksForActor(mappings[i], actor)
+	}
+
+	links := sharedAPI.Links{
+		"self": h.links.Get("/capabilities/" + id + "/reference-mappings"),
+		"up":   h.links.Get("/capabilities/" + id),
+	}
+	if actor.CanWrite("capabilities") || actor.HasEditGrant("capabilities", id) {
+		links["create"] = h.links.Post("/capabilities/" + id + "/reference-mappings")
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, mappings, links)
+}
+
+// MapCapabilityToReference godoc
+// @Summary Map a capability to a reference entry
+// @Description Records that the capability is our counterpart of an entry in a reference model. A capability may map to several entries and an entry may have several capabilities.
+// @Tags capabilities
+// @Accept json
+// @Produce json
+// @Param id path string true "Capability ID"
+// @Param request body MapCapabilityToReferenceRequest true "Reference model and entry code"
+// @Success 201 {object} readmodels.ReferenceMappingDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/reference-mappings [post]
+func (h *ReferenceModelHandlers) MapCapabilityToReference(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[MapCapabilityToReferenceRequest](w, r)
+	if !ok {
+		return
+	}
+
+	capabilityID := sharedAPI.GetPathParam(r, "id")
+	actor, _ := sharedctx.GetActor(r.Context())
+	result, err := h.commandBus.Dispatch(r.Context(), &commands.MapCapabilityToReference{
+		CapabilityID:     capabilityID,
+		ReferenceModelID: req.ReferenceModelID,
+		EntryCode:        req.EntryCode,
+		MappedBy:         actor.Email,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	location := "/api/v1/capabilities/" + capabilityID + "/reference-mappings/" + result.CreatedID
+	mapping, err := h.mappings.GetByID(r.Context(), result.CreatedID)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve reference mapping")
+		return
+	}
+	if mapping == nil {
+		sharedAPI.RespondCreated(w, location, map[string]string{
+			"id":      result.CreatedID,
+			"message": "Reference mapping created, processing",
+		})
+		return
+	}
+
+	mapping.Links = h.links.ReferenceMappingLinksForActor(*mapping, actor)
+	sharedAPI.RespondCreated(w, location, mapping)
+}
+
+// UnmapCapabilityFromReference godoc
+// @Summary Remove a reference mapping from a capability
+// @Tags capabilities
+// @Param id path string true "Capability ID"
+// @Param mappingId path string true "Reference mapping ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /capabilities/{id}/reference-mappings/{mappingId} [delete]
+func (h *ReferenceModelHandlers) UnmapCapabilityFromReference(w http.ResponseWriter, r *http.Request) {
+	h.dispatch(w, r, &commands.UnmapCapabilityFromReference{
+		MappingID:    sharedAPI.GetPathParam(r, "mappingId"),
+		CapabilityID: sharedAPI.GetPathParam(r, "id"),
+	})
+}
+
+func (h *ReferenceModelHandlers) respondWithModel(w http.ResponseWriter, r *http.Request, id string, statusCode int) {
+	model, err := h.models.GetByID(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve reference model")
+		return
+	}
+
+	location := sharedAPI.BuildResourceLink(sharedAPI.ResourcePath("/reference-models"), sharedAPI.ResourceID(id))
+	if model == nil {
+		if statusCode == http.StatusCreated {
+			sharedAPI.RespondCreated(w, location, map[string]string{
+				"id":      id,
+				"message": "Reference model imported, processing",
+			})
+			return
+		}
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "Reference model not found")
+		return
+	}
+
+	entries, err := h.models.GetEntries(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve reference model entries")
+		return
+	}
+
+	actor, _ := sharedctx.GetActor(r.Context())
+	model.Links = h.links.ReferenceModelLinksForActor(id, actor)
+	response := ReferenceModelDetailResponse{ReferenceModelDTO: *model, Entries: entries}
+	if statusCode == http.StatusCreated {
+		sharedAPI.RespondCreated(w, location, response)
+		return
+	}
+	sharedAPI.RespondJSON(w, statusCode, response)
+}
+
+func (h *ReferenceModelHandlers) dispatch(w http.ResponseWriter, r *http.Request, cmd cqrs.Command) {
+	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
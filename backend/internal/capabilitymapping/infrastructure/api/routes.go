@@ -40,6 +40,9 @@ type RouteConfig struct {
 	SessionProvider        authPL.SessionProvider
 	AuthMiddleware         AuthMiddleware
 	OnePagerCompleteness   OnePagerCompletenessSource
+	HeatmapSources         handlers.HeatmapExternalSources
+	DomainKPISources       handlers.DomainKPIExternalSources
+	EliminateGrades        handlers.EliminateGradeSource
 }
 
 func SetupCapabilityMappingRoutes(config *RouteConfig) error {
@@ -50,9 +53,15 @@ func SetupCapabilityMappingRoutes(config *RouteConfig) error {
 		config.StrategyPillarsGateway = metamodel.NewLocalStrategyPillarsGateway(rm.strategyPillarCache)
 	}
 
+	hierarchies := metamodel.NewLocalCapabilityHierarchyGateway(rm.capabilityHierarchyCache)
+	tagVocabulary := metamodel.NewLocalCapabilityTagVocabularyGateway(rm.capabilityTagVocabularyCache)
+
 	setupEventSubscriptions(config.EventBus, rm, config.StrategyPillarsGateway)
 	setupCascadingDeleteHandlers(config.EventBus, config.CommandBus, rm)
-	setupCommandHandlers(config.CommandBus, repos, rm, config.StrategyPillarsGateway)
+	setupCommandHandlers(config.CommandBus, repos, rm, config.StrategyPillarsGateway, hierarchies)
+	registerCapabilityTagCommands(config.CommandBus, repos.capability, rm.capability, tagVocabulary)
+	registerCapabilityOwnershipCommands(config.CommandBus, repos.capability, rm)
+	registerReferenceModelCommands(config.CommandBus, repos, rm)
 	setupMetaModelEventHandlers(config.EventBus, config.MaturityScaleGateway)
 
 	businessDomainReadModels := &BusinessDomainReadModels{
@@ -73,7 +82,10 @@ func SetupCapabilityMappingRoutes(config *RouteConfig) error {
 			Links:        links,
 			ImpactQuery:  impactQuery,
 			Completeness: config.OnePagerCompleteness,
+			Hierarchies:  hierarchies,
 		}),
+		capabilityTag:        NewCapabilityTagHandlers(config.CommandBus, rm.capability, rm.capabilityTagVocabularyCache, links),
+		capabilityOwner:      NewCapabilityOwnerHandlers(config.CommandBus, rm.capabilityOwner, rm.capability, links),
 		dependency:           NewDependencyHandlers(config.CommandBus, rm.dependency, links),
 		realization:          NewRealizationHandlers(config.CommandBus, rm.realization, links),
 		maturityLevel:        NewMaturityLevelHandlers(config.MaturityScaleGateway),
@@ -82,14 +94,43 @@ func SetupCapabilityMappingRoutes(config *RouteConfig) error {
 		applicationFitScore:  NewApplicationFitScoreHandlers(config.CommandBus, rm.applicationFitScore, links, config.SessionProvider),
 		fitComparison:        NewFitComparisonHandlers(rm.componentFitComparison),
 		strategicFitAnalysis: NewStrategicFitAnalysisHandlers(rm.strategicFitAnalysis, config.StrategyPillarsGateway, config.SessionProvider),
+		dependencyAnalysis: NewDependencyAnalysisHandlers(
+			handlers.NewDependencyAnalysisQuery(rm.dependency, rm.capability, rm.realization, config.EliminateGrades),
+		),
+		bulkEdit: NewBulkEditHandlers(
+			handlers.NewBulkEditService(config.CommandBus, rm.capability, rm.realization),
+		),
+		heatmap: NewCapabilityHeatmapHandlers(
+			handlers.NewCapabilityHeatmapQuery(rm.capability, rm.domainAssignment, rm.capabilityHeatmap, config.HeatmapSources),
+		),
+		coverageGaps: NewRealizationCoverageGapsHandlers(
+			handlers.NewRealizationCoverageGapsQuery(rm.capability, rm.realization, rm.applicationFitScore, rm.businessDomain, rm.domainAssignment),
+			links,
+		),
+		businessDomainKPI: NewBusinessDomainKPIHandlers(
+			handlers.NewBusinessDomainKPIQuery(rm.businessDomain, rm.capability, rm.domainAssignment, rm.capabilityHeatmap, config.DomainKPISources),
+			links,
+		),
+		referenceModel: NewReferenceModelHandlers(ReferenceModelHandlersDeps{
+			CommandBus: config.CommandBus,
+			Models:     rm.referenceModel,
+			Mappings:   rm.referenceMapping,
+			Capability: rm.capability,
+			Coverage:   handlers.NewReferenceCoverageQuery(rm.referenceModel, rm.referenceMapping),
+			Seeder:     handlers.NewReferenceDomainSeeder(config.CommandBus, rm.referenceModel, rm.businessDomain),
+			Links:      links,
+		}),
 	}
 
 	rateLimiter := middleware.NewRateLimiter(100, 60)
 
 	registerCapabilityRoutes(config.Router, httpHandlers, config.AuthMiddleware)
+	registerCapabilityTagRoutes(config.Router, httpHandlers, config.AuthMiddleware)
+	registerCapabilityOwnerRoutes(config.Router, httpHandlers, config.AuthMiddleware)
 	registerDependencyRoutes(config.Router, httpHandlers, config.AuthMiddleware)
 	registerRealizationRoutes(config.Router, httpHandlers, config.AuthMiddleware)
 	registerBusinessDomainRoutes(config.Router, httpHandlers, config.AuthMiddleware)
+	registerReferenceModelRoutes(config.Router, httpHandlers, config.AuthMiddleware)
 	registerStrategyImportanceRoutes(config.Router, httpHandlers)
 	registerApplicationFitScoreRoutes(config.Router, httpHandlers, config.AuthMiddleware, rateLimiter)
 	registerStrategicFitAnalysisRoutes(config.Router, httpHandlers, config.AuthMiddleware)
@@ -115,6 +156,8 @@ type routeRepositories struct {
 	domainAssignment    *repositories.BusinessDomainAssignmentRepository
 	strategyImportance  *repositories.StrategyImportanceRepository
 	applicationFitScore *repositories.ApplicationFitScoreRepository
+	referenceModel      *repositories.ReferenceModelRepository
+	referenceMapping    *repositories.ReferenceMappingRepository
 }
 
 type routeReadModels struct {
@@ -130,11 +173,20 @@ type routeReadModels struct {
 	componentCache                *readmodels.ComponentCacheReadModel
 	effectiveCapabilityImportance *readmodels.EffectiveCapabilityImportanceReadModel
 	strategyPillarCache           *readmodels.StrategyPillarCacheReadModel
+	capabilityHierarchyCache      *readmodels.CapabilityHierarchyCacheReadModel
+	capabilityTagVocabularyCache  *readmodels.CapabilityTagVocabularyCacheReadModel
 	effectiveBusinessDomain       *readmodels.CMEffectiveBusinessDomainReadModel
+	capabilityHeatmap             *readmodels.CapabilityHeatmapReadModel
+	capabilityOwner               *readmodels.CapabilityOwnerReadModel
+	ownershipPartyCache           *readmodels.OwnershipPartyCacheReadModel
+	referenceModel                *readmodels.ReferenceModelReadModel
+	referenceMapping              *readmodels.ReferenceMappingReadModel
 }
 
 type routeHTTPHandlers struct {
 	capability           *CapabilityHandlers
+	capabilityTag        *CapabilityTagHandlers
+	capabilityOwner      *CapabilityOwnerHandlers
 	dependency           *DependencyHandlers
 	realization          *RealizationHandlers
 	maturityLevel        *MaturityLevelHandlers
@@ -143,6 +195,12 @@ type routeHTTPHandlers struct {
 	applicationFitScore  *ApplicationFitScoreHandlers
 	fitComparison        *FitComparisonHandlers
 	strategicFitAnalysis *StrategicFitAnalysisHandlers
+	heatmap              *CapabilityHeatmapHandlers
+	businessDomainKPI    *BusinessDomainKPIHandlers
+	coverageGaps         *RealizationCoverageGapsHandlers
+	dependencyAnalysis   *DependencyAnalysisHandlers
+	bulkEdit             *BulkEditHandlers
+	referenceModel       *ReferenceModelHandlers
 }
 
 func initializeRepositories(eventStore eventstore.EventStore) *routeRepositories {
@@ -154,6 +212,8 @@ func initializeRepositories(eventStore eventstore.EventStore) *routeRepositories
 		domainAssignment:    repositories.NewBusinessDomainAssignmentRepository(eventStore),
 		strategyImportance:  repositories.NewStrategyImportanceRepository(eventStore),
 		applicationFitScore: repositories.NewApplicationFitScoreRepository(eventStore),
+		referenceModel:      repositories.NewReferenceModelRepository(eventStore),
+		referenceMapping:    repositories.NewReferenceMappingRepository(eventStore),
 	}
 }
 
@@ -171,7 +231,14 @@ func initializeReadModels(db *database.TenantAwareDB) *routeReadModels {
 		componentCache:                readmodels.NewComponentCacheReadModel(db),
 		effectiveCapabilityImportance: readmodels.NewEffectiveCapabilityImportanceReadModel(db),
 		strategyPillarCache:           readmodels.NewStrategyPillarCacheReadModel(db),
+		capabilityHierarchyCache:      readmodels.NewCapabilityHierarchyCacheReadModel(db),
+		capabilityTagVocabularyCache:  readmodels.NewCapabilityTagVocabularyCacheReadModel(db),
 		effectiveBusinessDomain:       readmodels.NewCMEffectiveBusinessDomainReadModel(db),
+		capabilityHeatmap:             readmodels.NewCapabilityHeatmapReadModel(db),
+		capabilityOwner:               readmodels.NewCapabilityOwnerReadModel(db),
+		ownershipPartyCache:           readmodels.NewOwnershipPartyCacheReadModel(db),
+		referenceModel:                readmodels.NewReferenceModelReadModel(db),
+		referenceMapping:              readmodels.NewReferenceMappingReadModel(db),
 	}
 }
 
@@ -185,6 +252,11 @@ func setupEventSubscriptions(eventBus events.EventBus, rm *routeReadModels, pill
 	applicationFitScoreProjector := projectors.NewApplicationFitScoreProjector(rm.applicationFitScore, rm.componentCache, pillarsGateway)
 	componentCacheProjector := projectors.NewComponentCacheProjector(rm.componentCache)
 	pillarCacheProjector := projectors.NewStrategyPillarCacheProjector(rm.strategyPillarCache)
+	hierarchyCacheProjector := projectors.NewCapabilityHierarchyCacheProjector(rm.capabilityHierarchyCache)
+	tagVocabularyCacheProjector := projectors.NewCapabilityTagVocabularyCacheProjector(rm.capabilityTagVocabularyCache)
+	capabilityOwnerProjector := projectors.NewCapabilityOwnerProjector(rm.capabilityOwner)
+	ownershipPartyCacheProjector := projectors.NewOwnershipPartyCacheProjector(rm.ownershipPartyCache)
+	referenceModelProjector := projectors.NewReferenceModelProjector(rm.referenceModel, rm.referenceMapping)
 
 	capabilityLookupAdapter := adapters.NewCapabilityLookupAdapter(rm.capability)
 	ratingLookupAdapter := adapters.NewRatingLookupAdapter(rm.strategyImportance)
@@ -211,11 +283,42 @@ func setupEventSubscriptions(eventBus events.EventBus, rm *routeReadModels, pill
 	subscribeHierarchyChangeEffectiveEvents(eventBus, hierarchyChangeProjector)
 	subscribeDomainAssignmentEffectiveEvents(eventBus, domainAssignmentEffectiveProjector)
 	subscribeMetaModelEvents(eventBus, pillarCacheProjector)
+	eventBus.Subscribe(mmPL.CapabilityHierarchyConfigUpdated, hierarchyCacheProjector)
+	eventBus.Subscribe(mmPL.CapabilityTagVocabularyUpdated, tagVocabularyCacheProjector)
+	subscribeCapabilityOwnershipEvents(eventBus, capabilityOwnerProjector, ownershipPartyCacheProjector)
+	subscribeReferenceModelEvents(eventBus, referenceModelProjector)
+}
+
+func subscribeReferenceModelEvents(eventBus events.EventBus, projector *projectors.ReferenceModelProjector) {
+	for _, event := range []string{
+		cmPL.ReferenceModelImported,
+		cmPL.ReferenceModelDeleted,
+		cmPL.CapabilityMappedToReference,
+		cmPL.CapabilityUnmappedFromReference,
+	} {
+		eventBus.Subscribe(event, projector)
+	}
+}
+
+func subscribeCapabilityOwnershipEvents(eventBus events.EventBus, owners *projectors.CapabilityOwnerProjector, parties *projectors.OwnershipPartyCacheProjector) {
+	for _, event := range []string{cmPL.CapabilityOwnerAssigned, cmPL.CapabilityOwnerUnassigned, cmPL.CapabilityDeleted} {
+		eventBus.Subscribe(event, owners)
+	}
+	for _, event := range []string{
+		authPL.UserCreated,
+		authPL.UserDisabled,
+		authPL.UserEnabled,
+		archPL.InternalTeamCreated,
+		archPL.InternalTeamUpdated,
+		archPL.InternalTeamDeleted,
+	} {
+		eventBus.Subscribe(event, parties)
+	}
 }
 
 func subscribeCapabilityEvents(eventBus events.EventBus, projector *projectors.CapabilityProjector) {
 	events := []string{cmPL.CapabilityCreated, cmPL.CapabilityUpdated, cmPL.CapabilityMetadataUpdated,
-		cmPL.CapabilityExpertAdded, cmPL.CapabilityExpertRemoved, cmPL.CapabilityTagAdded, cmPL.CapabilityParentChanged, cmPL.CapabilityLevelChanged, cmPL.CapabilityDeleted}
+		cmPL.CapabilityExpertAdded, cmPL.CapabilityExpertRemoved, cmPL.CapabilityTagAdded, cmPL.CapabilityTagRemoved, cmPL.CapabilityTagRenamed, cmPL.CapabilityParentChanged, cmPL.CapabilityLevelChanged, cmPL.CapabilityDeleted}
 	for _, event := range events {
 		eventBus.Subscribe(event, projector)
 	}
@@ -244,18 +347,21 @@ func subscribeRealizationEvents(eventBus events.EventBus, projector *projectors.
 		cmPL.SystemLinkedToCapability,
 		cmPL.SystemRealizationUpdated,
 		cmPL.SystemRealizationDeleted,
+		cmPL.SystemRealizationReassigned,
+		cmPL.SystemRealizationMoved,
 		cmPL.CapabilityRealizationsInherited,
 		cmPL.CapabilityRealizationsUninherited,
 		cmPL.CapabilityUpdated,
 		archPL.ApplicationComponentUpdated,
 		archPL.ApplicationComponentDeleted,
+		archPL.ApplicationComponentParentChanged,
 	} {
 		eventBus.Subscribe(event, projector)
 	}
 }
 
 func subscribeBusinessDomainEvents(eventBus events.EventBus, projector *projectors.BusinessDomainProjector) {
-	events := []string{cmPL.BusinessDomainCreated, cmPL.BusinessDomainUpdated, cmPL.BusinessDomainDeleted,
+	events := []string{cmPL.BusinessDomainCreated, cmPL.BusinessDomainUpdated, cmPL.BusinessDomainParentChanged, cmPL.BusinessDomainDeleted,
 		cmPL.CapabilityAssignedToDomain, cmPL.CapabilityUnassignedFromDomain}
 	for _, event := range events {
 		eventBus.Subscribe(event, projector)
@@ -329,20 +435,25 @@ func setupCascadingDeleteHandlers(eventBus events.EventBus, commandBus *cqrs.InM
 	onCapabilityParentChangedHandler := handlers.NewOnCapabilityParentChangedHandler(commandBus, rm.domainAssignment, rm.capability)
 	onCapabilityDeletedImportanceHandler := handlers.NewOnCapabilityDeletedImportanceHandler(rm.strategyImportance)
 	onBusinessDomainDeletedImportanceHandler := handlers.NewOnBusinessDomainDeletedImportanceHandler(rm.strategyImportance)
+	onApplicationComponentMergedHandler := handlers.NewOnApplicationComponentMergedHandler(commandBus, rm.realization, rm.applicationFitScore)
 
 	eventBus.Subscribe(cmPL.CapabilityDeleted, onCapabilityDeletedHandler)
 	eventBus.Subscribe(cmPL.CapabilityDeleted, onCapabilityDeletedImportanceHandler)
 	eventBus.Subscribe(cmPL.BusinessDomainDeleted, onBusinessDomainDeletedHandler)
 	eventBus.Subscribe(cmPL.BusinessDomainDeleted, onBusinessDomainDeletedImportanceHandler)
 	eventBus.Subscribe(cmPL.CapabilityParentChanged, onCapabilityParentChangedHandler)
+	eventBus.Subscribe(archPL.ApplicationComponentMergedInto, onApplicationComponentMergedHandler)
+	eventBus.Subscribe(authPL.UserDisabled, handlers.NewOnUserDisabledHandler(commandBus, rm.ownershipPartyCache))
+	eventBus.Subscribe(cmPL.CapabilityDeleted, handlers.NewOnCapabilityDeletedReferenceMappingHandler(commandBus, rm.referenceMapping))
+	eventBus.Subscribe(cmPL.ReferenceModelDeleted, handlers.NewOnReferenceModelDeletedHandler(commandBus, rm.referenceMapping))
 }
 
-func setupCommandHandlers(commandBus *cqrs.InMemoryCommandBus, repos *routeRepositories, rm *routeReadModels, pillarsGateway metamodel.StrategyPillarsGateway) {
+func setupCommandHandlers(commandBus *cqrs.InMemoryCommandBus, repos *routeRepositories, rm *routeReadModels, pillarsGateway metamodel.StrategyPillarsGateway, hierarchies services.CapabilityHierarchyProvider) {
 	registerCapabilityCommands(commandBus, repos.capability, capabilityCommandReadModels{
 		capability:  rm.capability,
 		realization: rm.realization,
 		dependency:  rm.dependency,
-	})
+	}, hierarchies)
 	registerDependencyCommands(commandBus, repos.dependency, repos.capability)
 	registerRealizationCommands(commandBus, repos, rm)
 	registerBusinessDomainCommands(commandBus, repos.businessDomain, rm.businessDomain, rm.domainAssignment)
@@ -360,6 +471,54 @@ func setupCommandHandlers(commandBus *cqrs.InMemoryCommandBus, repos *routeRepos
 		FitScoreReader: rm.applicationFitScore,
 		PillarsGateway: pillarsGateway,
 	})
+	registerReorganisationCommands(commandBus, repos.capability, rm, hierarchies)
+}
+
+func registerReorganisationCommands(commandBus *cqrs.InMemoryCommandBus, repo *repositories.CapabilityRepository, rm *routeReadModels, hierarchies services.CapabilityHierarchyProvider) {
+	readers := handlers.CapabilityContentReaders{
+		Children:     rm.capability,
+		Realizations: rm.realization,
+		Dependencies: rm.dependency,
+		Assignments:  rm.domainAssignment,
+		Importance:   rm.strategyImportance,
+	}
+	commandBus.Register("MergeCapabilities", handlers.NewMergeCapabilitiesHandler(repo, commandBus, readers))
+	commandBus.Register("SplitCapability", handlers.NewSplitCapabilityHandler(repo, hierarchies, commandBus, readers))
+}
+
+func registerCapabilityTagCommands(commandBus *cqrs.InMemoryCommandBus, repo *repositories.CapabilityRepository, capabilityRM *readmodels.CapabilityReadModel, vocabulary services.CapabilityTagVocabularyProvider) {
+	retagDeps := handlers.RetagCapabilitiesDeps{
+		Repository: repo,
+		Tagged:     capabilityRM,
+		Vocabulary: vocabulary,
+	}
+	commandBus.Register("AddCapabilityTag", handlers.NewAddCapabilityTagHandler(repo, vocabulary))
+	commandBus.Register("RemoveCapabilityTag", handlers.NewRemoveCapabilityTagHandler(repo))
+	commandBus.Register("RenameCapabilityTag", handlers.NewRenameCapabilityTagHandler(retagDeps))
+	commandBus.Register("MergeCapabilityTags", handlers.NewMergeCapabilityTagsHandler(retagDeps))
+}
+
+func registerCapabilityOwnershipCommands(commandBus *cqrs.InMemoryCommandBus, repo *repositories.CapabilityRepository, rm *routeReadModels) {
+	deps := handlers.CapabilityOwnershipDeps{
+		Repository: repo,
+		Parties:    rm.ownershipPartyCache,
+		Owned:      rm.capabilityOwner,
+	}
+	commandBus.Register("AssignCapabilityOwner", handlers.NewAssignCapabilityOwnerHandler(deps))
+	commandBus.Register("UnassignCapabilityOwner", handlers.NewUnassignCapabilityOwnerHandler(repo))
+	commandBus.Register("ReassignCapabilityOwners", handlers.NewReassignCapabilityOwnersHandler(deps))
+}
+
+func registerReferenceModelCommands(commandBus *cqrs.InMemoryCommandBus, repos *routeRepositories, rm *routeReadModels) {
+	commandBus.Register("ImportReferenceModel", handlers.NewImportReferenceModelHandler(repos.referenceModel))
+	commandBus.Register("DeleteReferenceModel", handlers.NewDeleteReferenceModelHandler(repos.referenceModel))
+	commandBus.Register("MapCapabilityToReference", handlers.NewMapCapabilityToReferenceHandler(handlers.ReferenceMappingDeps{
+		Mappings:     repos.referenceMapping,
+		Capabilities: repos.capability,
+		Catalogues:   rm.referenceModel,
+		Existing:     rm.referenceMapping,
+	}))
+	commandBus.Register("UnmapCapabilityFromReference", handlers.NewUnmapCapabilityFromReferenceHandler(repos.referenceMapping))
 }
 
 type capabilityCommandReadModels struct {
@@ -368,23 +527,21 @@ type capabilityCommandReadModels struct {
 	dependency  *readmodels.DependencyReadModel
 }
 
-func registerCapabilityCommands(commandBus *cqrs.InMemoryCommandBus, repo *repositories.CapabilityRepository, rm capabilityCommandReadModels) {
+func registerCapabilityCommands(commandBus *cqrs.InMemoryCommandBus, repo *repositories.CapabilityRepository, rm capabilityCommandReadModels, hierarchies services.CapabilityHierarchyProvider) {
 	capabilityRM := rm.capability
 	realizationRM := rm.realization
 	dependencyRM := rm.dependency
 	childrenChecker := adapters.NewCapabilityChildrenCheckerAdapter(capabilityRM)
 	deletionService := services.NewCapabilityDeletionService(childrenChecker)
-	reparentingService := services.NewCapabilityReparentingService(adapters.NewCapabilityLookupAdapter(capabilityRM))
+	reparentingService := services.NewCapabilityReparentingService(adapters.NewCapabilityLookupAdapter(capabilityRM), hierarchies)
 	capabilityLookupAdapter := adapters.NewCapabilityLookupAdapter(capabilityRM)
 	hierarchyService := services.NewCapabilityHierarchyService(capabilityLookupAdapter)
 
-	commandBus.Register("CreateCapability", handlers.NewCreateCapabilityHandler(repo))
+	commandBus.Register("CreateCapability", handlers.NewCreateCapabilityHandler(repo, hierarchies))
 	commandBus.Register("UpdateCapability", handlers.NewUpdateCapabilityHandler(repo))
 	commandBus.Register("UpdateCapabilityMetadata", handlers.NewUpdateCapabilityMetadataHandler(repo))
-	commandBus.Register("AddCapabilityExpert", handlers.NewAddCapabilityExpertHandler(repo))
 	commandBus.Register("RemoveCapabilityExpert", handlers.NewRemoveCapabilityExpertHandler(repo))
-	commandBus.Register("AddCapabilityTag", handlers.NewAddCapabilityTagHandler(repo))
-	commandBus.Register("ChangeCapabilityParent", handlers.NewChangeCapabilityParentHandler(repo, capabilityRM, realizationRM, reparentingService))
+	commandBus.Register("ChangeCapabilityParent", handlers.NewChangeCapabilityParentHandler(repo, capabilityRM, realizationRM, reparentingService, hierarchies))
 	commandBus.Register("DeleteCapability", handlers.NewDeleteCapabilityHandler(repo, deletionService, realizationRM, capabilityRM))
 	commandBus.Register("CascadeDeleteCapability", handlers.NewCascadeDeleteCapabilityHandler(handlers.CascadeDeleteDeps{
 		Repository:       repo,
@@ -405,14 +562,18 @@ func registerRealizationCommands(commandBus *cqrs.InMemoryCommandBus, repos *rou
 	commandBus.Register("LinkSystemToCapability", handlers.NewLinkSystemToCapabilityHandler(repos.realization, repos.capability, rm.capability, rm.componentCache))
 	commandBus.Register("UpdateSystemRealization", handlers.NewUpdateSystemRealizationHandler(repos.realization))
 	commandBus.Register("DeleteSystemRealization", handlers.NewDeleteSystemRealizationHandler(repos.realization))
+	commandBus.Register("ReassignSystemRealization", handlers.NewReassignSystemRealizationHandler(repos.realization))
+	commandBus.Register("MoveSystemRealization", handlers.NewMoveSystemRealizationHandler(repos.realization, rm.realization, repos.capability, rm.capability))
 }
 
 func registerBusinessDomainCommands(commandBus *cqrs.InMemoryCommandBus, domainRepo *repositories.BusinessDomainRepository, domainRM *readmodels.BusinessDomainReadModel, assignmentRM *readmodels.DomainCapabilityAssignmentReadModel) {
 	assignmentChecker := adapters.NewBusinessDomainAssignmentCheckerAdapter(assignmentRM)
-	deletionService := services.NewBusinessDomainDeletionService(assignmentChecker)
+	childrenChecker := adapters.NewBusinessDomainChildrenCheckerAdapter(domainRM)
+	deletionService := services.NewBusinessDomainDeletionService(assignmentChecker, childrenChecker)
 
 	commandBus.Register("CreateBusinessDomain", handlers.NewCreateBusinessDomainHandler(domainRepo, domainRM))
 	commandBus.Register("UpdateBusinessDomain", handlers.NewUpdateBusinessDomainHandler(domainRepo, domainRM))
+	commandBus.Register("ChangeBusinessDomainParent", handlers.NewChangeBusinessDomainParentHandler(domainRepo, domainRM))
 	commandBus.Register("DeleteBusinessDomain", handlers.NewDeleteBusinessDomainHandler(domainRepo, deletionService))
 }
 
@@ -437,34 +598,69 @@ func registerCapabilityRoutes(r chi.Router, h *routeHTTPHandlers, authMiddleware
 			r.Get("/metadata/statuses", h.maturityLevel.GetStatuses)
 			r.Get("/metadata/ownership-models", h.maturityLevel.GetOwnershipModels)
 			r.Get("/expert-roles", h.capability.GetExpertRoles)
+			r.Get("/heatmap", h.heatmap.GetCapabilityHeatmap)
+			r.Get("/coverage-gaps", h.coverageGaps.GetCoverageGaps)
+			r.Get("/mine", h.capabilityOwner.GetMyCapabilities)
 			r.Get("/", h.capability.GetAllCapabilities)
 			r.Get("/{id}", h.capability.GetCapabilityByID)
 			r.Get("/{id}/children", h.capability.GetCapabilityChildren)
 			r.Get("/{id}/systems", h.realization.GetSystemsByCapability)
 			r.Get("/{id}/dependencies/outgoing", h.dependency.GetOutgoingDependencies)
 			r.Get("/{id}/dependencies/incoming", h.dependency.GetIncomingDependencies)
+			r.Get("/{id}/dependencies/upstream", h.dependencyAnalysis.GetUpstreamImpact)
+			r.Get("/{id}/dependencies/downstream", h.dependencyAnalysis.GetDownstreamImpact)
 			r.Get("/{id}/business-domains", h.businessDomain.GetDomainsForCapability)
 			r.Get("/{id}/importance", h.strategyImportance.GetImportanceByCapability)
 			r.Get("/{id}/delete-impact", h.capability.GetDeleteImpact)
+			r.Get("/{id}/owners", h.capabilityOwner.GetCapabilityOwners)
+			r.Get("/{id}/reference-mappings", h.referenceModel.GetCapabilityReferenceMappings)
 		})
 		r.Group(func(r chi.Router) {
 			r.Use(authMiddleware.RequirePermission(authPL.PermCapabilitiesWrite))
 			r.Post("/", h.capability.CreateCapability)
+			r.Post("/bulk", h.bulkEdit.BulkEditCapabilities)
 			r.Post("/{id}/systems", h.realization.LinkSystemToCapability)
-			r.Post("/{id}/experts", h.capability.AddCapabilityExpert)
 			r.Delete("/{id}/experts", h.capability.RemoveCapabilityExpert)
 			r.Post("/{id}/tags", h.capability.AddCapabilityTag)
+			r.Delete("/{id}/tags/{tag}", h.capability.RemoveCapabilityTag)
 		})
 		r.Group(func(r chi.Router) {
 			r.Use(sharedAPI.RequireWriteOrEditGrant("capabilities", "id"))
 			r.Put("/{id}", h.capability.UpdateCapability)
 			r.Put("/{id}/metadata", h.capability.UpdateCapabilityMetadata)
 			r.Patch("/{id}/parent", h.capability.ChangeCapabilityParent)
+			r.Put("/{id}/owners/{partyType}/{partyId}", h.capabilityOwner.AssignCapabilityOwner)
+			r.Delete("/{id}/owners/{partyType}/{partyId}", h.capabilityOwner.UnassignCapabilityOwner)
+			r.Post("/{id}/reference-mappings", h.referenceModel.MapCapabilityToReference)
+			r.Delete("/{id}/reference-mappings/{mappingId}", h.referenceModel.UnmapCapabilityFromReference)
 		})
 		r.Group(func(r chi.Router) {
 			r.Use(authMiddleware.RequirePermission(authPL.PermCapabilitiesDelete))
 			r.Delete("/{id}", h.capability.DeleteCapability)
+			r.Post("/{id}/merge", h.capability.MergeCapabilities)
+			r.Post("/{id}/split", h.capability.SplitCapability)
+		})
+	})
+}
+
+func registerCapabilityTagRoutes(r chi.Router, h *routeHTTPHandlers, authMiddleware AuthMiddleware) {
+	r.Route("/capability-tags", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermCapabilitiesRead))
+			r.Get("/", h.capabilityTag.GetCapabilityTags)
 		})
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermCapabilitiesWrite))
+			r.Post("/rename", h.capabilityTag.RenameCapabilityTag)
+			r.Post("/merge", h.capabilityTag.MergeCapabilityTags)
+		})
+	})
+}
+
+func registerCapabilityOwnerRoutes(r chi.Router, h *routeHTTPHandlers, authMiddleware AuthMiddleware) {
+	r.Route("/capability-owners", func(r chi.Router) {
+		r.Use(authMiddleware.RequirePermission(authPL.PermCapabilitiesWrite))
+		r.Post("/reassign", h.capabilityOwner.ReassignCapabilityOwners)
 	})
 }
 
@@ -473,6 +669,8 @@ func registerDependencyRoutes(r chi.Router, h *routeHTTPHandlers, authMiddleware
 		r.Group(func(r chi.Router) {
 			r.Use(authMiddleware.RequirePermission(authPL.PermCapabilitiesRead))
 			r.Get("/", h.dependency.GetAllDependencies)
+			r.Get("/criticality", h.dependencyAnalysis.GetDependencyCriticality)
+			r.Get("/fragile", h.dependencyAnalysis.GetFragileDependencies)
 		})
 		r.Group(func(r chi.Router) {
 			r.Use(authMiddleware.RequirePermission(authPL.PermCapabilitiesWrite))
@@ -494,6 +692,7 @@ func registerRealizationRoutes(r chi.Router, h *routeHTTPHandlers, authMiddlewar
 		})
 		r.Group(func(r chi.Router) {
 			r.Use(authMiddleware.RequirePermission(authPL.PermCapabilitiesWrite))
+			r.Post("/bulk", h.bulkEdit.BulkEditRealizations)
 			r.Put("/{id}", h.realization.UpdateRealization)
 		})
 		r.Group(func(r chi.Router) {
@@ -509,6 +708,8 @@ func registerBusinessDomainRoutes(r chi.Router, h *routeHTTPHandlers, authMiddle
 			r.Use(authMiddleware.RequirePermission(authPL.PermDomainsRead))
 			r.Get("/", h.businessDomain.GetAllBusinessDomains)
 			r.Get("/{id}", h.businessDomain.GetBusinessDomainByID)
+			r.Get("/{id}/children", h.businessDomain.GetBusinessDomainChildren)
+			r.Get("/{id}/kpis", h.businessDomainKPI.GetBusinessDomainKPIs)
 			r.Get("/{id}/capabilities", h.businessDomain.GetCapabilitiesInDomain)
 			r.Get("/{id}/capability-realizations", h.businessDomain.GetCapabilityRealizationsByDomain)
 			r.Get("/{id}/importance", h.strategyImportance.GetImportanceByDomain)
@@ -522,6 +723,7 @@ func registerBusinessDomainRoutes(r chi.Router, h *routeHTTPHandlers, authMiddle
 		r.Group(func(r chi.Router) {
 			r.Use(sharedAPI.RequireWriteOrEditGrant("domains", "id"))
 			r.Put("/{id}", h.businessDomain.UpdateBusinessDomain)
+			r.Patch("/{id}/parent", h.businessDomain.ChangeBusinessDomainParent)
 		})
 		r.Group(func(r chi.Router) {
 			r.Use(authMiddleware.RequirePermission(authPL.PermDomainsDelete))
@@ -545,6 +747,29 @@ func registerBusinessDomainRoutes(r chi.Router, h *routeHTTPHandlers, authMiddle
 	})
 }
 
+func registerReferenceModelRoutes(r chi.Router, h *routeHTTPHandlers, authMiddleware AuthMiddleware) {
+	r.Route("/reference-models", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermCapabilitiesRead))
+			r.Get("/", h.referenceModel.GetAllReferenceModels)
+			r.Get("/{id}", h.referenceModel.GetReferenceModelByID)
+			r.Get("/{id}/coverage", h.referenceModel.GetReferenceModelCoverage)
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermCapabilitiesWrite))
+			r.Post("/", h.referenceModel.ImportReferenceModel)
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermDomainsWrite))
+			r.Post("/{id}/seed-business-domains", h.referenceModel.SeedBusinessDomains)
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermCapabilitiesDelete))
+			r.Delete("/{id}", h.referenceModel.DeleteReferenceModel)
+		})
+	})
+}
+
 func registerStrategyImportanceRoutes(r chi.Router, h *routeHTTPHandlers) {
 }
 
@@ -0,0 +1,32 @@
+package metamodel
+
+import (
+	"context"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+type localCapabilityHierarchyGateway struct {
+	cacheReadModel *readmodels.CapabilityHierarchyCacheReadModel
+}
+
+// NewLocalCapabilityHierarchyGateway serves the tenant's capability hierarchy
+// from the local cache of meta-model events, falling back to L1-L4.
+func NewLocalCapabilityHierarchyGateway(cacheReadModel *readmodels.CapabilityHierarchyCacheReadModel) services.CapabilityHierarchyProvider {
+	return &localCapabilityHierarchyGateway{cacheReadModel: cacheReadModel}
+}
+
+func (g *localCapabilityHierarchyGateway) GetCapabilityHierarchy(ctx context.Context) (valueobjects.CapabilityHierarchy, error) {
+	labels, err := g.cacheReadModel.GetLabels(ctx)
+	if err != nil {
+		return valueobjects.CapabilityHierarchy{}, err
+	}
+
+	if len(labels) == 0 {
+		return valueobjects.DefaultCapabilityHierarchy(), nil
+	}
+
+	return valueobjects.NewCapabilityHierarchy(labels)
+}
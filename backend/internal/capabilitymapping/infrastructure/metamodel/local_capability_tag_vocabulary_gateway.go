@@ -0,0 +1,36 @@
+package metamodel
+
+import (
+	"context"
+
+	"easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/capabilitymapping/domain/services"
+	"easi/backend/internal/capabilitymapping/domain/valueobjects"
+)
+
+type localCapabilityTagVocabularyGateway struct {
+	cacheReadModel *readmodels.CapabilityTagVocabularyCacheReadModel
+}
+
+// NewLocalCapabilityTagVocabularyGateway serves the tenant's capability tag
+// vocabulary from the local cache of meta-model events, falling back to an
+// unrestricted vocabulary.
+func NewLocalCapabilityTagVocabularyGateway(cacheReadModel *readmodels.CapabilityTagVocabularyCacheReadModel) services.CapabilityTagVocabularyProvider {
+	return &localCapabilityTagVocabularyGateway{cacheReadModel: cacheReadModel}
+}
+
+func (g *localCapabilityTagVocabularyGateway) GetCapabilityTagVocabulary(ctx context.Context) (valueobjects.TagVocabulary, error) {
+	cached, err := g.cacheReadModel.Get(ctx)
+	if err != nil {
+		return valueobjects.TagVocabulary{}, err
+	}
+	if cached == nil {
+		return valueobjects.UnrestrictedTagVocabulary(), nil
+	}
+
+	tags := make([]string, len(cached.Terms))
+	for i, term := range cached.Terms {
+		tags[i] = term.Tag
+	}
+	return valueobjects.NewTagVocabulary(tags, cached.Restricted), nil
+}
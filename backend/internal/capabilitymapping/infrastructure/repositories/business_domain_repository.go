@@ -28,8 +28,9 @@ func NewBusinessDomainRepository(eventStore eventstore.EventStore) *BusinessDoma
 
 var businessDomainEventDeserializers = repository.NewEventDeserializers(
 	map[string]repository.EventDeserializerFunc{
-		"BusinessDomainCreated": repository.JSONDeserializer[events.BusinessDomainCreated],
-		"BusinessDomainUpdated": repository.JSONDeserializer[events.BusinessDomainUpdated],
-		"BusinessDomainDeleted": repository.JSONDeserializer[events.BusinessDomainDeleted],
+		"BusinessDomainCreated":       repository.JSONDeserializer[events.BusinessDomainCreated],
+		"BusinessDomainUpdated":       repository.JSONDeserializer[events.BusinessDomainUpdated],
+		"BusinessDomainParentChanged": repository.JSONDeserializer[events.BusinessDomainParentChanged],
+		"BusinessDomainDeleted":       repository.JSONDeserializer[events.BusinessDomainDeleted],
 	},
 )
@@ -35,10 +35,18 @@ var capabilityEventDeserializers = repository.NewEventDeserializers(
 		"CapabilityExpertAdded":             repository.JSONDeserializer[events.CapabilityExpertAdded],
 		"CapabilityExpertRemoved":           repository.JSONDeserializer[events.CapabilityExpertRemoved],
 		"CapabilityTagAdded":                repository.JSONDeserializer[events.CapabilityTagAdded],
+		"CapabilityTagRemoved":              repository.JSONDeserializer[events.CapabilityTagRemoved],
+		"CapabilityTagRenamed":              repository.JSONDeserializer[events.CapabilityTagRenamed],
+		"CapabilityOwnerAssigned":           repository.JSONDeserializer[events.CapabilityOwnerAssigned],
+		"CapabilityOwnerUnassigned":         repository.JSONDeserializer[events.CapabilityOwnerUnassigned],
 		"CapabilityParentChanged":           repository.JSONDeserializer[events.CapabilityParentChanged],
 		"CapabilityLevelChanged":            repository.JSONDeserializer[events.CapabilityLevelChanged],
 		"CapabilityRealizationsInherited":   repository.JSONDeserializer[events.CapabilityRealizationsInherited],
 		"CapabilityRealizationsUninherited": repository.JSONDeserializer[events.CapabilityRealizationsUninherited],
+		"CapabilityMergedInto":              repository.JSONDeserializer[events.CapabilityMergedInto],
+		"CapabilityAbsorbed":                repository.JSONDeserializer[events.CapabilityAbsorbed],
+		"CapabilitySplit":                   repository.JSONDeserializer[events.CapabilitySplit],
+		"CapabilitySplitOff":                repository.JSONDeserializer[events.CapabilitySplitOff],
 	},
 	CapabilityMetadataUpdatedV1ToV2Upcaster{},
 )
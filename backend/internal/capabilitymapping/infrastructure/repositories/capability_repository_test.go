@@ -17,7 +17,7 @@ func TestCapabilityDeserializers_RoundTrip(t *testing.T) {
 	name, _ := valueobjects.NewCapabilityName("Order Management")
 	description := valueobjects.MustNewDescription("Manages customer orders")
 
-	original, err := aggregates.NewCapability(name, description, valueobjects.CapabilityID{}, valueobjects.LevelL1)
+	original, err := aggregates.NewCapability(name, description, valueobjects.CapabilityID{}, valueobjects.LevelL1, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	events := original.GetUncommittedChanges()
@@ -55,16 +55,14 @@ func TestCapabilityDeserializers_RoundTripWithMetadata(t *testing.T) {
 	name, _ := valueobjects.NewCapabilityName("Inventory Management")
 	description := valueobjects.MustNewDescription("Manages inventory")
 
-	original, err := aggregates.NewCapability(name, description, valueobjects.CapabilityID{}, valueobjects.LevelL1)
+	original, err := aggregates.NewCapability(name, description, valueobjects.CapabilityID{}, valueobjects.LevelL1, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	maturityLevel, _ := valueobjects.NewMaturityLevelFromValue(50)
 	ownershipModel, _ := valueobjects.NewOwnershipModel("IT")
-	primaryOwner := valueobjects.NewOwner("john@example.com")
-	eaOwner := valueobjects.NewOwner("ea@example.com")
 	status, _ := valueobjects.NewCapabilityStatus("active")
 
-	metadata := valueobjects.NewCapabilityMetadata(maturityLevel, ownershipModel, primaryOwner, eaOwner, status)
+	metadata := valueobjects.NewCapabilityMetadata(maturityLevel, ownershipModel, status)
 	_ = original.UpdateMetadata(metadata)
 
 	events := original.GetUncommittedChanges()
@@ -87,7 +85,7 @@ func TestCapabilityDeserializers_RoundTripWithExpertAndTag(t *testing.T) {
 	name, _ := valueobjects.NewCapabilityName("Customer Service")
 	description := valueobjects.MustNewDescription("Handles customer inquiries")
 
-	original, err := aggregates.NewCapability(name, description, valueobjects.CapabilityID{}, valueobjects.LevelL1)
+	original, err := aggregates.NewCapability(name, description, valueobjects.CapabilityID{}, valueobjects.LevelL1, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	expert, _ := valueobjects.NewExpert("Jane Doe", "Domain Expert", "jane@example.com", time.Now().UTC())
@@ -117,7 +115,7 @@ func TestCapabilityDeserializers_RoundTripWithParentChange(t *testing.T) {
 
 	parentCapability := createTestCapability(t, "Parent Capability", "Parent description")
 	newParentID, _ := valueobjects.NewCapabilityIDFromString(parentCapability.ID())
-	_ = original.ChangeParent(newParentID, valueobjects.LevelL2)
+	_ = original.ChangeParent(newParentID, valueobjects.LevelL2, valueobjects.DefaultCapabilityHierarchy())
 
 	loaded := roundTripAndLoad(t, original, 2)
 
@@ -128,7 +126,7 @@ func TestCapabilityDeserializers_AllEventsCanBeDeserialized(t *testing.T) {
 	name, _ := valueobjects.NewCapabilityName("Test Capability")
 	description := valueobjects.MustNewDescription("Test description")
 
-	capability, err := aggregates.NewCapability(name, description, valueobjects.CapabilityID{}, valueobjects.LevelL1)
+	capability, err := aggregates.NewCapability(name, description, valueobjects.CapabilityID{}, valueobjects.LevelL1, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 
 	newName, _ := valueobjects.NewCapabilityName("Updated Name")
@@ -137,10 +135,8 @@ func TestCapabilityDeserializers_AllEventsCanBeDeserialized(t *testing.T) {
 
 	maturityLevel, _ := valueobjects.NewMaturityLevelFromValue(30)
 	ownershipModel, _ := valueobjects.NewOwnershipModel("Business")
-	primaryOwner := valueobjects.NewOwner("owner@example.com")
-	eaOwner := valueobjects.NewOwner("ea@example.com")
 	status, _ := valueobjects.NewCapabilityStatus("active")
-	metadata := valueobjects.NewCapabilityMetadata(maturityLevel, ownershipModel, primaryOwner, eaOwner, status)
+	metadata := valueobjects.NewCapabilityMetadata(maturityLevel, ownershipModel, status)
 	_ = capability.UpdateMetadata(metadata)
 
 	expert, _ := valueobjects.NewExpert("Expert", "Role", "contact", time.Now().UTC())
@@ -149,9 +145,9 @@ func TestCapabilityDeserializers_AllEventsCanBeDeserialized(t *testing.T) {
 	tag, _ := valueobjects.NewTag("test-tag")
 	_ = capability.AddTag(tag)
 
-	parentCapability, _ := aggregates.NewCapability(name, description, valueobjects.CapabilityID{}, valueobjects.LevelL1)
+	parentCapability, _ := aggregates.NewCapability(name, description, valueobjects.CapabilityID{}, valueobjects.LevelL1, valueobjects.DefaultCapabilityHierarchy())
 	newParentID, _ := valueobjects.NewCapabilityIDFromString(parentCapability.ID())
-	_ = capability.ChangeParent(newParentID, valueobjects.LevelL2)
+	_ = capability.ChangeParent(newParentID, valueobjects.LevelL2, valueobjects.DefaultCapabilityHierarchy())
 
 	events := capability.GetUncommittedChanges()
 	require.Len(t, events, 6, "Expected 6 events")
@@ -173,7 +169,7 @@ func createTestCapability(t *testing.T, name, description string) *aggregates.Ca
 	t.Helper()
 	capName, _ := valueobjects.NewCapabilityName(name)
 	capDesc := valueobjects.MustNewDescription(description)
-	capability, err := aggregates.NewCapability(capName, capDesc, valueobjects.CapabilityID{}, valueobjects.LevelL1)
+	capability, err := aggregates.NewCapability(capName, capDesc, valueobjects.CapabilityID{}, valueobjects.LevelL1, valueobjects.DefaultCapabilityHierarchy())
 	require.NoError(t, err)
 	return capability
 }
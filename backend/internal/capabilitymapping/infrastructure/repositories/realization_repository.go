@@ -28,8 +28,10 @@ func NewRealizationRepository(eventStore eventstore.EventStore) *RealizationRepo
 
 var realizationEventDeserializers = repository.NewEventDeserializers(
 	map[string]repository.EventDeserializerFunc{
-		"SystemLinkedToCapability": repository.JSONDeserializer[events.SystemLinkedToCapability],
-		"SystemRealizationUpdated": repository.JSONDeserializer[events.SystemRealizationUpdated],
-		"SystemRealizationDeleted": repository.JSONDeserializer[events.SystemRealizationDeleted],
+		"SystemLinkedToCapability":    repository.JSONDeserializer[events.SystemLinkedToCapability],
+		"SystemRealizationUpdated":    repository.JSONDeserializer[events.SystemRealizationUpdated],
+		"SystemRealizationDeleted":    repository.JSONDeserializer[events.SystemRealizationDeleted],
+		"SystemRealizationReassigned": repository.JSONDeserializer[events.SystemRealizationReassigned],
+		"SystemRealizationMoved":      repository.JSONDeserializer[events.SystemRealizationMoved],
 	},
 )
@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"errors"
+
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/domain/events"
+	"easi/backend/internal/infrastructure/eventstore"
+	"easi/backend/internal/shared/infrastructure/repository"
+)
+
+var ErrReferenceMappingNotFound = errors.New("reference mapping not found")
+
+type ReferenceMappingRepository struct {
+	*repository.EventSourcedRepository[*aggregates.ReferenceMapping]
+}
+
+func NewReferenceMappingRepository(eventStore eventstore.EventStore) *ReferenceMappingRepository {
+	return &ReferenceMappingRepository{
+		EventSourcedRepository: repository.NewEventSourcedRepository(
+			eventStore,
+			referenceMappingEventDeserializers,
+			aggregates.LoadReferenceMappingFromHistory,
+			ErrReferenceMappingNotFound,
+		),
+	}
+}
+
+var referenceMappingEventDeserializers = repository.NewEventDeserializers(
+	map[string]repository.EventDeserializerFunc{
+		"CapabilityMappedToReference":     repository.JSONDeserializer[events.CapabilityMappedToReference],
+		"CapabilityUnmappedFromReference": repository.JSONDeserializer[events.CapabilityUnmappedFromReference],
+	},
+)
@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"errors"
+
+	"easi/backend/internal/capabilitymapping/domain/aggregates"
+	"easi/backend/internal/capabilitymapping/domain/events"
+	"easi/backend/internal/infrastructure/eventstore"
+	"easi/backend/internal/shared/infrastructure/repository"
+)
+
+var ErrReferenceModelNotFound = errors.New("reference model not found")
+
+type ReferenceModelRepository struct {
+	*repository.EventSourcedRepository[*aggregates.ReferenceModel]
+}
+
+func NewReferenceModelRepository(eventStore eventstore.EventStore) *ReferenceModelRepository {
+	return &ReferenceModelRepository{
+		EventSourcedRepository: repository.NewEventSourcedRepository(
+			eventStore,
+			referenceModelEventDeserializers,
+			aggregates.LoadReferenceModelFromHistory,
+			ErrReferenceModelNotFound,
+		),
+	}
+}
+
+var referenceModelEventDeserializers = repository.NewEventDeserializers(
+	map[string]repository.EventDeserializerFunc{
+		"ReferenceModelImported": repository.JSONDeserializer[events.ReferenceModelImported],
+		"ReferenceModelDeleted":  repository.JSONDeserializer[events.ReferenceModelDeleted],
+	},
+)
@@ -12,6 +12,7 @@ func AgentTools() []pl.AgentToolSpec {
 	specs = append(specs, dependencyTools()...)
 	specs = append(specs, domainCapabilityRealizationTools()...)
 	specs = append(specs, strategyTools()...)
+	specs = append(specs, referenceModelTools()...)
 	return specs
 }
 
@@ -101,12 +102,29 @@ func capabilityMetadataTools() []pl.AgentToolSpec {
 			Method: "GET", Path: "/capabilities/metadata/ownership-models",
 		},
 		{
-			Name: "get_capability_expert_roles", Description: "Get available expert role values for capabilities. Expert roles define the types of subject matter experts that can be assigned to capabilities (e.g. Business Owner, Technical Lead).",
+			Name: "get_capability_expert_roles", Description: "Get the expert role values used by the legacy free-text experts on capabilities (e.g. Business Owner, Technical Lead). New experts are Consulted owners, listed by get_capability_owners.",
 			Access: pl.AccessRead, Permission: "capabilities:read",
 			Method: "GET", Path: "/capabilities/expert-roles",
 		},
 		{
-			Name: "update_capability_metadata", Description: "Update operational metadata of a capability: maturity level, status, ownership model, and owners. Does not change the capability's name, description, hierarchy position, or realizations.",
+			Name: "list_capability_tags", Description: "List the tags used on capabilities with how many capabilities carry each, plus the tenant's tag vocabulary terms with their categories. Tags not in the vocabulary or used only once are cleanup candidates.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/capability-tags",
+		},
+		{
+			Name: "get_capability_owners", Description: "Get the users and internal teams holding a RACI role (Responsible, Accountable, Consulted, Informed) on a capability, with their names and whether they are still active.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/capabilities/{id}/owners",
+			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Capability ID (UUID)")},
+		},
+		{
+			Name: "get_my_capabilities", Description: "List the capabilities on which the current user holds a RACI role, optionally only one role (e.g. the capabilities they are Accountable for).",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/capabilities/mine",
+			QueryParams: []pl.ParamSpec{pl.StringParam("role", "Only this RACI role: Responsible, Accountable, Consulted or Informed", false)},
+		},
+		{
+			Name: "update_capability_metadata", Description: "Update operational metadata of a capability: maturity level, status and ownership model. Owners are RACI assignments, listed by get_capability_owners. Does not change the capability's name, description, hierarchy position, or realizations.",
 			Access: pl.AccessUpdate, Permission: "capabilities:write",
 			Method: "PUT", Path: "/capabilities/{id}/metadata",
 			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Capability ID (UUID)")},
@@ -114,8 +132,6 @@ func capabilityMetadataTools() []pl.AgentToolSpec {
 				pl.StringParam("status", "Capability status (e.g. Active, Planned, Retiring)", true),
 				pl.StringParam("maturityLevel", "Maturity level name", false),
 				pl.StringParam("ownershipModel", "Ownership model (e.g. Centralized, Federated)", false),
-				pl.StringParam("primaryOwner", "Primary owner name", false),
-				pl.StringParam("eaOwner", "EA owner name", false),
 			},
 		},
 		{
@@ -125,10 +141,34 @@ func capabilityMetadataTools() []pl.AgentToolSpec {
 			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Capability ID (UUID)")},
 		},
 		{
-			Name: "get_capabilities_by_application", Description: "Get all capabilities realized by a specific application component (IT system). Returns all realization links for the given component, each including the capability ID, realization level (Full, Partial, Planned), and optional notes. Use this as the primary lookup when the user asks which capabilities a given application realises.",
+			Name: "get_capability_heatmap", Description: "Get the capability tree for the tenant or one business domain with a value and colour band (1-5) per node for a metric: maturity, importance (per pillar), fit (average application fit), eliminate-count (Eliminate-graded realizations), cost (sum of a numeric application one-pager field), completeness (one-pager %) or active-journeys. Values roll up the hierarchy using max, avg, weighted (mean over the whole subtree) or sum. Use for board-pack heatmaps and hotspot questions.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/capabilities/heatmap",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("metric", "One of maturity, importance, fit, eliminate-count, cost, completeness, active-journeys", true),
+				pl.StringParam("aggregation", "Roll-up: max, avg, weighted or sum (defaults per metric)", false),
+				pl.StringParam("pillarId", "Strategy pillar ID; required for importance, optional for fit", false),
+				pl.StringParam("businessDomainId", "Restrict to the capabilities of a business domain", false),
+				pl.StringParam("costFieldId", "Numeric application one-pager field ID holding cost; required for cost", false),
+			},
+		},
+		{
+			Name: "get_capability_coverage_gaps", Description: "List realization coverage gaps: capabilities with no realization (unrealized), only Planned realizations (planned-only), leaf capabilities lacking a Full realization their parent has (parent-only-full) and realizations by components without any fit score (unscored-component). Use to find coverage holes to fill, optionally per business domain.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/capabilities/coverage-gaps",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("businessDomainId", "Restrict to a business domain and its sub-domains", false),
+				pl.StringParam("type", "Comma-separated gap types: unrealized, planned-only, parent-only-full, unscored-component", false),
+			},
+		},
+		{
+			Name: "get_capabilities_by_application", Description: "Get all capabilities realized by a specific application component (IT system). Returns all realization links for the given component, each including the capability ID, realization level (Full, Partial, Planned), and optional notes. Use this as the primary lookup when the user asks which capabilities a given application realises. Set includeSubComponents to roll up the realizations of its child components (e.g. the modules of an ERP suite).",
 			Access: pl.AccessRead, Permission: "capabilities:read",
 			Method: "GET", Path: "/capability-realizations/by-component/{componentId}",
 			PathParams: []pl.ParamSpec{pl.UUIDParam("componentId", "Application component ID (UUID)")},
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("includeSubComponents", "Set to 'true' to include realizations of all child components", false),
+			},
 		},
 		{
 			Name: "get_capability_business_domains", Description: "Get the business domains that a capability belongs to. For L1 capabilities this shows direct assignments; for L2-L4 it shows inherited domain membership through the parent hierarchy.",
@@ -164,6 +204,18 @@ func businessDomainTools() []pl.AgentToolSpec {
 			Method: "GET", Path: "/business-domains/{id}",
 			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Business domain ID (UUID)")},
 		},
+		{
+			Name: "get_business_domain_children", Description: "List the business domains nested directly under a division or segment domain.",
+			Access: pl.AccessRead, Permission: "domains:read",
+			Method: "GET", Path: "/business-domains/{id}/children",
+			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Business domain ID (UUID)")},
+		},
+		{
+			Name: "get_business_domain_kpis", Description: "Get KPIs for a business domain rolled up over all of its sub-domains: capability count, realization coverage, average application fit, TIME distribution, journey progress and one-pager completeness.",
+			Access: pl.AccessRead, Permission: "domains:read",
+			Method: "GET", Path: "/business-domains/{id}/kpis",
+			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Business domain ID (UUID)")},
+		},
 		{
 			Name: "create_business_domain", Description: "Create a new business domain. Domains group L1 capabilities into organizational areas. After creation, assign L1 capabilities using assign_capability_to_domain.",
 			Access: pl.AccessCreate, Permission: "domains:write",
@@ -171,6 +223,7 @@ func businessDomainTools() []pl.AgentToolSpec {
 			BodyParams: []pl.ParamSpec{
 				pl.StringParam("name", "Business domain name", true),
 				pl.StringParam("description", "Business domain description", false),
+				{Name: "parentId", Type: "uuid", Description: "Parent business domain ID (UUID) when nesting the domain under a division or segment"},
 			},
 		},
 		{
@@ -227,6 +280,42 @@ func dependencyTools() []pl.AgentToolSpec {
 			Method: "DELETE", Path: "/capability-dependencies/{id}",
 			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Dependency ID (UUID)")},
 		},
+		{
+			Name: "get_capability_upstream_dependencies", Description: "Get every capability the given capability depends on, directly or transitively, with the hop distance and the capability it was reached through. Use to explain what a capability needs in order to function.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/capabilities/{id}/dependencies/upstream",
+			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Capability ID (UUID)")},
+			QueryParams: []pl.ParamSpec{
+				pl.IntParam("maxDepth", "Maximum number of hops (0 = unlimited, max 10)"),
+				pl.StringParam("type", "Comma-separated dependency types to follow: Requires, Enables, Supports", false),
+			},
+		},
+		{
+			Name: "get_capability_downstream_impact", Description: "Get every capability that depends on the given capability, directly or transitively, i.e. what is impacted if it is disrupted or changed. Use for impact analysis before retiring or reorganising a capability.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/capabilities/{id}/dependencies/downstream",
+			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Capability ID (UUID)")},
+			QueryParams: []pl.ParamSpec{
+				pl.IntParam("maxDepth", "Maximum number of hops (0 = unlimited, max 10)"),
+				pl.StringParam("type", "Comma-separated dependency types to follow: Requires, Enables, Supports", false),
+			},
+		},
+		{
+			Name: "get_capability_dependency_criticality", Description: "Rank capabilities by dependency criticality derived from fan-in: direct dependents weighted by type (Requires 1.0, Enables 0.6, Supports 0.3) plus 0.25 per further transitive dependent.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/capability-dependencies/criticality",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("type", "Comma-separated dependency types to consider: Requires, Enables, Supports", false),
+			},
+		},
+		{
+			Name: "get_fragile_capability_dependencies", Description: "Find capabilities that many others require but that are unrealized, realized by a single application, or realized only by Eliminate-graded applications. Use to spot single points of failure in the capability landscape.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/capability-dependencies/fragile",
+			QueryParams: []pl.ParamSpec{
+				pl.IntParam("minRequiredBy", "Minimum number of capabilities that require it (default 3)"),
+			},
+		},
 		{
 			Name: "get_capability_children", Description: "Get the direct children of a capability in the hierarchy. L1 capabilities have L2 children, L2 have L3, L3 have L4. L4 capabilities have no children. Use to navigate the capability tree.",
 			Access: pl.AccessRead, Permission: "capabilities:read",
@@ -276,10 +365,13 @@ func strategyTools() []pl.AgentToolSpec {
 			},
 		},
 		{
-			Name: "get_application_fit_scores", Description: "Get fit scores for an application component across all strategy pillars. Fit scores rate how well an application supports each strategic dimension (e.g. excellent, adequate, poor). Use to assess an application's strategic alignment.",
+			Name: "get_application_fit_scores", Description: "Get fit scores for an application component across all strategy pillars. Fit scores rate how well an application supports each strategic dimension (e.g. excellent, adequate, poor). Use to assess an application's strategic alignment. Set includeInherited to fill unscored pillars from the nearest scored parent component.",
 			Access: pl.AccessRead, Permission: "components:read",
 			Method: "GET", Path: "/components/{id}/fit-scores",
 			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Application component ID (UUID)")},
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("includeInherited", "Set to 'true' to inherit scores from parent components for unscored pillars", false),
+			},
 		},
 		{
 			Name: "set_application_fit_score", Description: "Set or update the fit score of an application for a specific strategy pillar. Fit scores rate how well the application supports that strategic dimension. The score is a numeric value where higher means better fit.",
@@ -301,3 +393,44 @@ func strategyTools() []pl.AgentToolSpec {
 		},
 	}
 }
+
+func referenceModelTools() []pl.AgentToolSpec {
+	return []pl.AgentToolSpec{
+		{
+			Name: "list_reference_models", Description: "List the imported industry reference capability models (BIAN, APQC PCF, TOGAF or custom catalogues) with their framework, version and number of entries. Use to find the reference model to benchmark the capability map against.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/reference-models",
+		},
+		{
+			Name: "get_reference_model", Description: "Get a reference capability model with its full catalogue of entries. Each entry has a code, name, level and the code of its parent entry.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/reference-models/{id}",
+			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Reference model ID (UUID)")},
+		},
+		{
+			Name: "get_reference_model_coverage", Description: "Benchmark the capability map against a reference model. Each reference entry is mapped (one of our capabilities maps to it), partial (only its descendants are mapped) or unmapped (we have no counterpart). Use to find reference capabilities missing from our map.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/reference-models/{id}/coverage",
+			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Reference model ID (UUID)")},
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("status", "Comma-separated coverage statuses to list: mapped, partial, unmapped", false),
+			},
+		},
+		{
+			Name: "get_capability_reference_mappings", Description: "Get the reference model entries a capability is mapped to, e.g. its BIAN service domain or APQC process counterpart.",
+			Access: pl.AccessRead, Permission: "capabilities:read",
+			Method: "GET", Path: "/capabilities/{id}/reference-mappings",
+			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Capability ID (UUID)")},
+		},
+		{
+			Name: "map_capability_to_reference", Description: "Map a capability to an entry of a reference model, recording it as our counterpart of that reference capability. A capability may map to several entries.",
+			Access: pl.AccessCreate, Permission: "capabilities:write",
+			Method: "POST", Path: "/capabilities/{id}/reference-mappings",
+			PathParams: []pl.ParamSpec{pl.UUIDParam("id", "Capability ID (UUID)")},
+			BodyParams: []pl.ParamSpec{
+				{Name: "referenceModelId", Type: "uuid", Description: "Reference model ID (UUID)", Required: true},
+				pl.StringParam("entryCode", "Code of the reference entry", true),
+			},
+		},
+	}
+}
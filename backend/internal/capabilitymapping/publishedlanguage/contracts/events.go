@@ -78,6 +78,47 @@ type SystemRealizationDeletedPayload struct {
 	DeletedAt time.Time `json:"deletedAt"`
 }
 
+type SystemRealizationReassignedPayload struct {
+	ID               string    `json:"id"`
+	CapabilityID     string    `json:"capabilityId"`
+	FromComponentID  string    `json:"fromComponentId"`
+	ToComponentID    string    `json:"toComponentId"`
+	ToComponentName  string    `json:"toComponentName"`
+	RealizationLevel string    `json:"realizationLevel"`
+	ReassignedAt     time.Time `json:"reassignedAt"`
+}
+
+type SystemRealizationMovedPayload struct {
+	ID               string    `json:"id"`
+	ComponentID      string    `json:"componentId"`
+	FromCapabilityID string    `json:"fromCapabilityId"`
+	ToCapabilityID   string    `json:"toCapabilityId"`
+	RealizationLevel string    `json:"realizationLevel"`
+	MovedAt          time.Time `json:"movedAt"`
+}
+
+type CapabilityMergedIntoPayload struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	SurvivorID   string    `json:"survivorId"`
+	SurvivorName string    `json:"survivorName"`
+	MergedBy     string    `json:"mergedBy"`
+	MergedAt     time.Time `json:"mergedAt"`
+}
+
+type CapabilitySplitPartPayload struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type CapabilitySplitPayload struct {
+	ID      string                       `json:"id"`
+	Name    string                       `json:"name"`
+	Parts   []CapabilitySplitPartPayload `json:"parts"`
+	SplitBy string                       `json:"splitBy"`
+	SplitAt time.Time                    `json:"splitAt"`
+}
+
 type ApplicationFitScoreSetPayload struct {
 	ID          string    `json:"id"`
 	ComponentID string    `json:"componentId"`
@@ -13,9 +13,18 @@ const (
 	SystemLinkedToCapability = "SystemLinkedToCapability"
 	SystemRealizationDeleted = "SystemRealizationDeleted"
 
-	BusinessDomainCreated = "BusinessDomainCreated"
-	BusinessDomainUpdated = "BusinessDomainUpdated"
-	BusinessDomainDeleted = "BusinessDomainDeleted"
+	SystemRealizationReassigned = "SystemRealizationReassigned"
+	SystemRealizationMoved      = "SystemRealizationMoved"
+
+	CapabilityMergedInto = "CapabilityMergedInto"
+	CapabilityAbsorbed   = "CapabilityAbsorbed"
+	CapabilitySplit      = "CapabilitySplit"
+	CapabilitySplitOff   = "CapabilitySplitOff"
+
+	BusinessDomainCreated       = "BusinessDomainCreated"
+	BusinessDomainUpdated       = "BusinessDomainUpdated"
+	BusinessDomainParentChanged = "BusinessDomainParentChanged"
+	BusinessDomainDeleted       = "BusinessDomainDeleted"
 
 	EffectiveImportanceRecalculated = "EffectiveImportanceRecalculated"
 	ApplicationFitScoreSet          = "ApplicationFitScoreSet"
@@ -4,6 +4,10 @@ const (
 	CapabilityExpertAdded             = "CapabilityExpertAdded"
 	CapabilityExpertRemoved           = "CapabilityExpertRemoved"
 	CapabilityTagAdded                = "CapabilityTagAdded"
+	CapabilityTagRemoved              = "CapabilityTagRemoved"
+	CapabilityTagRenamed              = "CapabilityTagRenamed"
+	CapabilityOwnerAssigned           = "CapabilityOwnerAssigned"
+	CapabilityOwnerUnassigned         = "CapabilityOwnerUnassigned"
 	CapabilityDependencyCreated       = "CapabilityDependencyCreated"
 	CapabilityDependencyDeleted       = "CapabilityDependencyDeleted"
 	SystemRealizationUpdated          = "SystemRealizationUpdated"
@@ -13,4 +17,8 @@ const (
 	StrategyImportanceUpdated         = "StrategyImportanceUpdated"
 	StrategyImportanceRemoved         = "StrategyImportanceRemoved"
 	ApplicationFitScoreUpdated        = "ApplicationFitScoreUpdated"
+	ReferenceModelImported            = "ReferenceModelImported"
+	ReferenceModelDeleted             = "ReferenceModelDeleted"
+	CapabilityMappedToReference       = "CapabilityMappedToReference"
+	CapabilityUnmappedFromReference   = "CapabilityUnmappedFromReference"
 )
@@ -0,0 +1,83 @@
+package commands
+
+type ConsideredOption struct {
+	Name        string
+	Description string
+}
+
+type SubjectRef struct {
+	Type string
+	ID   string
+}
+
+// ProposeDecisionRecord opens a new architecture decision record. When
+// SupersedesID is set, accepting the new record supersedes that one.
+type ProposeDecisionRecord struct {
+	Title        string
+	Context      string
+	Options      []ConsideredOption
+	Decision     string
+	Consequences string
+	SupersedesID string
+	Subjects     []SubjectRef
+	Actor        string
+}
+
+func (c ProposeDecisionRecord) CommandName() string { return "ProposeDecisionRecord" }
+
+type ReviseDecisionRecord struct {
+	RecordID     string
+	Title        string
+	Context      string
+	Options      []ConsideredOption
+	Decision     string
+	Consequences string
+	Actor        string
+}
+
+func (c ReviseDecisionRecord) CommandName() string { return "ReviseDecisionRecord" }
+
+type AcceptDecisionRecord struct {
+	RecordID string
+	Actor    string
+}
+
+func (c AcceptDecisionRecord) CommandName() string { return "AcceptDecisionRecord" }
+
+type DeprecateDecisionRecord struct {
+	RecordID string
+	Reason   string
+	Actor    string
+}
+
+func (c DeprecateDecisionRecord) CommandName() string { return "DeprecateDecisionRecord" }
+
+type LinkDecisionRecordSubject struct {
+	RecordID    string
+	SubjectType string
+	SubjectID   string
+	Actor       string
+}
+
+func (c LinkDecisionRecordSubject) CommandName() string { return "LinkDecisionRecordSubject" }
+
+type UnlinkDecisionRecordSubject struct {
+	RecordID    string
+	SubjectType string
+	SubjectID   string
+	Actor       string
+}
+
+func (c UnlinkDecisionRecordSubject) CommandName() string { return "UnlinkDecisionRecordSubject" }
+
+// RecordAgreedDirection records the decision an architecture direction was
+// agreed with as an accepted decision record linked to the direction.
+type RecordAgreedDirection struct {
+	DirectionID  string
+	Context      string
+	Decision     string
+	Consequences string
+	Actor        string
+}
+
+func (c RecordAgreedDirection) CommandName() string { return "RecordAgreedDirection" }
@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"easi/backend/internal/decisionrecords/application/commands"
+	"easi/backend/internal/decisionrecords/application/ports"
+	"easi/backend/internal/decisionrecords/domain/aggregates"
+	"easi/backend/internal/decisionrecords/domain/valueobjects"
+	"easi/backend/internal/shared/cqrs"
+)
+
+var ErrSubjectNotFound = errors.New("decision subject does not exist")
+
+type DecisionRecordRepository interface {
+	Save(ctx context.Context, r *aggregates.DecisionRecord) error
+	GetByID(ctx context.Context, id string) (*aggregates.DecisionRecord, error)
+}
+
+type ProposeDecisionRecordHandler struct {
+	repo     DecisionRecordRepository
+	subjects ports.SubjectDirectory
+}
+
+func NewProposeDecisionRecordHandler(repo DecisionRecordRepository, subjects ports.SubjectDirectory) *ProposeDecisionRecordHandler {
+	return &ProposeDecisionRecordHandler{repo: repo, subjects: subjects}
+}
+
+func (h *ProposeDecisionRecordHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.ProposeDecisionRecord)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	content, err := parseContent(command.Title, command.Context, command.Options, command.Decision, command.Consequences)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.ensureSupersedable(ctx, command.SupersedesID); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	subjects := make([]aggregates.NamedSubject, 0, len(command.Subjects))
+	for _, ref := range command.Subjects {
+		named, err := resolveSubject(ctx, h.subjects, ref.Type, ref.ID)
+		if err != nil {
+			return cqrs.EmptyResult(), err
+		}
+		subjects = append(subjects, named)
+	}
+	record, err := aggregates.ProposeDecisionRecord(aggregates.DecisionRecordFacts{
+		ID:           valueobjects.NewDecisionRecordID(),
+		Content:      content,
+		SupersedesID: command.SupersedesID,
+		Subjects:     subjects,
+		ProposedBy:   command.Actor,
+	})
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, record); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.NewResult(record.ID()), nil
+}
+
+func (h *ProposeDecisionRecordHandler) ensureSupersedable(ctx context.Context, recordID string) error {
+	if recordID == "" {
+		return nil
+	}
+	if _, err := valueobjects.NewDecisionRecordIDFromString(recordID); err != nil {
+		return err
+	}
+	superseded, err := h.repo.GetByID(ctx, recordID)
+	if err != nil {
+		return err
+	}
+	if !superseded.Status().IsAccepted() {
+		return aggregates.ErrDecisionRecordNotAccepted
+	}
+	return nil
+}
+
+type mutationHandler[T cqrs.Command] struct {
+	repo       DecisionRecordRepository
+	recordIDOf func(T) string
+	apply      func(context.Context, T, *aggregates.DecisionRecord) error
+}
+
+func (h *mutationHandler[T]) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(T)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	record, err := h.repo.GetByID(ctx, h.recordIDOf(command))
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.apply(ctx, command, record); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, record); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.EmptyResult(), nil
+}
+
+func NewReviseDecisionRecordHandler(repo DecisionRecordRepository) cqrs.CommandHandler {
+	return &mutationHandler[*commands.ReviseDecisionRecord]{
+		repo:       repo,
+		recordIDOf: func(c *commands.ReviseDecisionRecord) string { return c.RecordID },
+		apply: func(_ context.Context, c *commands.ReviseDecisionRecord, r *aggregates.DecisionRecord) error {
+			content, err := parseContent(c.Title, c.Context, c.Options, c.Decision, c.Consequences)
+			if err != nil {
+				return err
+			}
+			return r.Revise(content, c.Actor)
+		},
+	}
+}
+
+func NewDeprecateDecisionRecordHandler(repo DecisionRecordRepository) cqrs.CommandHandler {
+	return &mutationHandler[*commands.DeprecateDecisionRecord]{
+		repo:       repo,
+		recordIDOf: func(c *commands.DeprecateDecisionRecord) string { return c.RecordID },
+		apply: func(_ context.Context, c *commands.DeprecateDecisionRecord, r *aggregates.DecisionRecord) error {
+			return r.Deprecate(c.Reason, c.Actor)
+		},
+	}
+}
+
+func NewLinkDecisionRecordSubjectHandler(repo DecisionRecordRepository, subjects ports.SubjectDirectory) cqrs.CommandHandler {
+	return &mutationHandler[*commands.LinkDecisionRecordSubject]{
+		repo:       repo,
+		recordIDOf: func(c *commands.LinkDecisionRecordSubject) string { return c.RecordID },
+		apply: func(ctx context.Context, c *commands.LinkDecisionRecordSubject, r *aggregates.DecisionRecord) error {
+			named, err := resolveSubject(ctx, subjects, c.SubjectType, c.SubjectID)
+			if err != nil {
+				return err
+			}
+			return r.LinkSubject(named.Subject, named.Name, c.Actor)
+		},
+	}
+}
+
+func NewUnlinkDecisionRecordSubjectHandler(repo DecisionRecordRepository) cqrs.CommandHandler {
+	return &mutationHandler[*commands.UnlinkDecisionRecordSubject]{
+		repo:       repo,
+		recordIDOf: func(c *commands.UnlinkDecisionRecordSubject) string { return c.RecordID },
+		apply: func(_ context.Context, c *commands.UnlinkDecisionRecordSubject, r *aggregates.DecisionRecord) error {
+			subject, err := valueobjects.NewDecisionSubject(c.SubjectType, c.SubjectID)
+			if err != nil {
+				return err
+			}
+			return r.UnlinkSubject(subject, c.Actor)
+		},
+	}
+}
+
+// AcceptDecisionRecordHandler accepts a record and, when it was proposed as
+// the successor of an earlier decision, supersedes that decision as well.
+type AcceptDecisionRecordHandler struct {
+	repo DecisionRecordRepository
+}
+
+func NewAcceptDecisionRecordHandler(repo DecisionRecordRepository) *AcceptDecisionRecordHandler {
+	return &AcceptDecisionRecordHandler{repo: repo}
+}
+
+func (h *AcceptDecisionRecordHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.AcceptDecisionRecord)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	record, err := h.repo.GetByID(ctx, command.RecordID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := record.Accept(command.Actor); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	superseded, err := h.supersede(ctx, record, command.Actor)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, record); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if superseded != nil {
+		if err := h.repo.Save(ctx, superseded); err != nil {
+			return cqrs.EmptyResult(), err
+		}
+	}
+	return cqrs.EmptyResult(), nil
+}
+
+func (h *AcceptDecisionRecordHandler) supersede(ctx context.Context, record *aggregates.DecisionRecord, actor string) (*aggregates.DecisionRecord, error) {
+	if record.SupersedesID() == "" {
+		return nil, nil
+	}
+	superseded, err := h.repo.GetByID(ctx, record.SupersedesID())
+	if err != nil {
+		return nil, err
+	}
+	if err := superseded.SupersedeBy(record.ID(), actor); err != nil {
+		return nil, err
+	}
+	return superseded, nil
+}
+
+func resolveSubject(ctx context.Context, subjects ports.SubjectDirectory, subjectType, subjectID string) (aggregates.NamedSubject, error) {
+	subject, err := valueobjects.NewDecisionSubject(subjectType, subjectID)
+	if err != nil {
+		return aggregates.NamedSubject{}, err
+	}
+	name, found, err := subjects.SubjectName(ctx, subject.Type(), subject.ID())
+	if err != nil {
+		return aggregates.NamedSubject{}, err
+	}
+	if !found {
+		return aggregates.NamedSubject{}, ErrSubjectNotFound
+	}
+	return aggregates.NamedSubject{Subject: subject, Name: name}, nil
+}
+
+func parseContent(title, context string, options []commands.ConsideredOption, decision, consequences string) (valueobjects.DecisionRecordContent, error) {
+	params := valueobjects.DecisionRecordContentParams{
+		Title:        title,
+		Context:      context,
+		Decision:     decision,
+		Consequences: consequences,
+		Options:      make([]valueobjects.ConsideredOptionParams, len(options)),
+	}
+	for i, o := range options {
+		params.Options[i] = valueobjects.ConsideredOptionParams{Name: o.Name, Description: o.Description}
+	}
+	return valueobjects.NewDecisionRecordContent(params)
+}
@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/decisionrecords/application/commands"
+	"easi/backend/internal/decisionrecords/domain/aggregates"
+	"easi/backend/internal/decisionrecords/domain/events"
+	"easi/backend/internal/decisionrecords/domain/valueobjects"
+	"easi/backend/internal/decisionrecords/infrastructure/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errRecordNotFound = repositories.ErrDecisionRecordNotFound
+
+type mockDecisionRecordRepository struct {
+	records map[string]*aggregates.DecisionRecord
+	saved   []*aggregates.DecisionRecord
+}
+
+func newMockRepository(records ...*aggregates.DecisionRecord) *mockDecisionRecordRepository {
+	repo := &mockDecisionRecordRepository{records: map[string]*aggregates.DecisionRecord{}}
+	for _, r := range records {
+		repo.records[r.ID()] = r
+	}
+	return repo
+}
+
+func (m *mockDecisionRecordRepository) Save(_ context.Context, r *aggregates.DecisionRecord) error {
+	m.saved = append(m.saved, r)
+	m.records[r.ID()] = r
+	return nil
+}
+
+func (m *mockDecisionRecordRepository) GetByID(_ context.Context, id string) (*aggregates.DecisionRecord, error) {
+	if r, ok := m.records[id]; ok {
+		return r, nil
+	}
+	return nil, errRecordNotFound
+}
+
+type stubSubjectDirectory map[string]string
+
+func (s stubSubjectDirectory) SubjectName(_ context.Context, subjectType, subjectID string) (string, bool, error) {
+	name, found := s[subjectType+"/"+subjectID]
+	return name, found, nil
+}
+
+func recordFixture(t *testing.T, accept bool) *aggregates.DecisionRecord {
+	t.Helper()
+	content, err := valueobjects.NewDecisionRecordContent(valueobjects.DecisionRecordContentParams{
+		Title: "Adopt event streaming", Decision: "Adopt Kafka",
+	})
+	require.NoError(t, err)
+	record, err := aggregates.ProposeDecisionRecord(aggregates.DecisionRecordFacts{
+		ID: valueobjects.NewDecisionRecordID(), Content: content, ProposedBy: "a@example.com",
+	})
+	require.NoError(t, err)
+	if accept {
+		require.NoError(t, record.Accept("a@example.com"))
+	}
+	record.MarkChangesAsCommitted()
+	return record
+}
+
+func TestProposeDecisionRecordHandler_ResolvesSubjectNames(t *testing.T) {
+	repo := newMockRepository()
+	subjects := stubSubjectDirectory{"capability/cap-1": "Payments"}
+
+	result, err := NewProposeDecisionRecordHandler(repo, subjects).Handle(context.Background(), &commands.ProposeDecisionRecord{
+		Title:    "Adopt event streaming",
+		Options:  []commands.ConsideredOption{{Name: "Kafka"}},
+		Subjects: []commands.SubjectRef{{Type: "capability", ID: "cap-1"}},
+		Actor:    "a@example.com",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, repo.saved[0].ID(), result.CreatedID)
+	linked := repo.saved[0].GetUncommittedChanges()[1].(events.DecisionRecordSubjectLinked)
+	assert.Equal(t, "Payments", linked.SubjectName)
+}
+
+func TestProposeDecisionRecordHandler_UnknownSubject_Fails(t *testing.T) {
+	repo := newMockRepository()
+
+	_, err := NewProposeDecisionRecordHandler(repo, stubSubjectDirectory{}).Handle(context.Background(), &commands.ProposeDecisionRecord{
+		Title:    "Adopt event streaming",
+		Subjects: []commands.SubjectRef{{Type: "journey", ID: "journey-1"}},
+	})
+
+	assert.ErrorIs(t, err, ErrSubjectNotFound)
+	assert.Empty(t, repo.saved)
+}
+
+func TestProposeDecisionRecordHandler_SupersedesOnlyAcceptedRecords(t *testing.T) {
+	accepted := recordFixture(t, true)
+	proposed := recordFixture(t, false)
+	repo := newMockRepository(accepted, proposed)
+	handler := NewProposeDecisionRecordHandler(repo, stubSubjectDirectory{})
+
+	_, err := handler.Handle(context.Background(), &commands.ProposeDecisionRecord{Title: "Replace Kafka", SupersedesID: proposed.ID()})
+	assert.ErrorIs(t, err, aggregates.ErrDecisionRecordNotAccepted)
+
+	_, err = handler.Handle(context.Background(), &commands.ProposeDecisionRecord{Title: "Replace Kafka", SupersedesID: accepted.ID()})
+	require.NoError(t, err)
+	assert.Equal(t, accepted.ID(), repo.saved[0].SupersedesID())
+}
+
+func TestAcceptDecisionRecordHandler_SupersedesPredecessor(t *testing.T) {
+	predecessor := recordFixture(t, true)
+	repo := newMockRepository(predecessor)
+	result, err := NewProposeDecisionRecordHandler(repo, stubSubjectDirectory{}).Handle(context.Background(), &commands.ProposeDecisionRecord{
+		Title: "Replace Kafka", Decision: "Adopt Pulsar", SupersedesID: predecessor.ID(),
+	})
+	require.NoError(t, err)
+
+	_, err = NewAcceptDecisionRecordHandler(repo).Handle(context.Background(), &commands.AcceptDecisionRecord{RecordID: result.CreatedID, Actor: "a@example.com"})
+
+	require.NoError(t, err)
+	assert.True(t, repo.records[result.CreatedID].Status().IsAccepted())
+	assert.True(t, predecessor.Status().IsSuperseded())
+	assert.Equal(t, result.CreatedID, predecessor.SupersededByID())
+}
+
+func TestAcceptDecisionRecordHandler_PredecessorNoLongerAccepted_Fails(t *testing.T) {
+	predecessor := recordFixture(t, true)
+	repo := newMockRepository(predecessor)
+	result, err := NewProposeDecisionRecordHandler(repo, stubSubjectDirectory{}).Handle(context.Background(), &commands.ProposeDecisionRecord{
+		Title: "Replace Kafka", Decision: "Adopt Pulsar", SupersedesID: predecessor.ID(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, predecessor.Deprecate("Streaming dropped", "a@example.com"))
+	repo.saved = nil
+
+	_, err = NewAcceptDecisionRecordHandler(repo).Handle(context.Background(), &commands.AcceptDecisionRecord{RecordID: result.CreatedID})
+
+	assert.ErrorIs(t, err, aggregates.ErrDecisionRecordNotAccepted)
+	assert.Empty(t, repo.saved)
+}
+
+func TestLinkDecisionRecordSubjectHandler(t *testing.T) {
+	record := recordFixture(t, false)
+	repo := newMockRepository(record)
+	handler := NewLinkDecisionRecordSubjectHandler(repo, stubSubjectDirectory{"component/comp-1": "Billing Engine"})
+
+	_, err := handler.Handle(context.Background(), &commands.LinkDecisionRecordSubject{RecordID: record.ID(), SubjectType: "component", SubjectID: "comp-1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"comp-1"}, record.SubjectIDs())
+
+	_, err = handler.Handle(context.Background(), &commands.LinkDecisionRecordSubject{RecordID: record.ID(), SubjectType: "component", SubjectID: "comp-2"})
+	assert.ErrorIs(t, err, ErrSubjectNotFound)
+}
+
+func TestUnlinkDecisionRecordSubjectHandler_NotLinked_Fails(t *testing.T) {
+	record := recordFixture(t, false)
+	repo := newMockRepository(record)
+
+	_, err := NewUnlinkDecisionRecordSubjectHandler(repo).Handle(context.Background(),
+		&commands.UnlinkDecisionRecordSubject{RecordID: record.ID(), SubjectType: "direction", SubjectID: "dir-1"})
+
+	assert.ErrorIs(t, err, aggregates.ErrSubjectNotLinked)
+	assert.Empty(t, repo.saved)
+}
+
+func TestReviseDecisionRecordHandler_InvalidContent_Fails(t *testing.T) {
+	record := recordFixture(t, false)
+	repo := newMockRepository(record)
+
+	_, err := NewReviseDecisionRecordHandler(repo).Handle(context.Background(), &commands.ReviseDecisionRecord{RecordID: record.ID(), Title: " "})
+
+	assert.ErrorIs(t, err, valueobjects.ErrDecisionTitleRequired)
+	assert.Empty(t, repo.saved)
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"easi/backend/internal/decisionrecords/application/commands"
+	"easi/backend/internal/decisionrecords/application/ports"
+	"easi/backend/internal/decisionrecords/domain/aggregates"
+	"easi/backend/internal/decisionrecords/domain/valueobjects"
+	"easi/backend/internal/decisionrecords/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+// RecordAgreedDirectionHandler turns the decision an architecture direction
+// was agreed with into an accepted decision record titled after the direction
+// and linked to it. The record's ID is derived from the direction, so a
+// redelivered event finds the record already there and changes nothing.
+type RecordAgreedDirectionHandler struct {
+	repo     DecisionRecordRepository
+	subjects ports.SubjectDirectory
+}
+
+func NewRecordAgreedDirectionHandler(repo DecisionRecordRepository, subjects ports.SubjectDirectory) *RecordAgreedDirectionHandler {
+	return &RecordAgreedDirectionHandler{repo: repo, subjects: subjects}
+}
+
+func (h *RecordAgreedDirectionHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.RecordAgreedDirection)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+	recordID := valueobjects.NewDecisionRecordIDForDirection(command.DirectionID)
+	_, err := h.repo.GetByID(ctx, recordID.Value())
+	if err == nil {
+		return cqrs.NewResult(recordID.Value()), nil
+	}
+	if !errors.Is(err, repositories.ErrDecisionRecordNotFound) {
+		return cqrs.EmptyResult(), err
+	}
+
+	direction, err := resolveSubject(ctx, h.subjects, valueobjects.SubjectTypeDirection, command.DirectionID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	content, err := parseContent(truncateTitle(direction.Name), command.Context, nil, command.Decision, command.Consequences)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	record, err := aggregates.ProposeDecisionRecord(aggregates.DecisionRecordFacts{
+		ID:         recordID,
+		Content:    content,
+		Subjects:   []aggregates.NamedSubject{direction},
+		ProposedBy: command.Actor,
+	})
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := record.Accept(command.Actor); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if err := h.repo.Save(ctx, record); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	return cqrs.NewResult(record.ID()), nil
+}
+
+// truncateTitle keeps a long direction name within the title limit without
+// splitting a character.
+func truncateTitle(title string) string {
+	if len(title) <= valueobjects.MaxDecisionTitleLength {
+		return title
+	}
+	return strings.ToValidUTF8(title[:valueobjects.MaxDecisionTitleLength], "")
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/decisionrecords/application/commands"
+	"easi/backend/internal/decisionrecords/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func agreedDirection() *commands.RecordAgreedDirection {
+	return &commands.RecordAgreedDirection{
+		DirectionID:  "dir-1",
+		Context:      "Two ledgers.",
+		Decision:     "Merge.",
+		Consequences: "Migrate history.",
+		Actor:        "architect@example.com",
+	}
+}
+
+func TestRecordAgreedDirectionHandler_RecordsAnAcceptedDecisionLinkedToTheDirection(t *testing.T) {
+	repo := newMockRepository()
+	subjects := stubSubjectDirectory{"direction/dir-1": "Payments (consolidate direction)"}
+
+	result, err := NewRecordAgreedDirectionHandler(repo, subjects).Handle(context.Background(), agreedDirection())
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	record := repo.saved[0]
+	assert.Equal(t, valueobjects.NewDecisionRecordIDForDirection("dir-1").Value(), result.CreatedID)
+	assert.Equal(t, result.CreatedID, record.ID())
+	assert.True(t, record.Status().IsAccepted())
+	assert.Equal(t, "Payments (consolidate direction)", record.Content().Title())
+	assert.Equal(t, "Two ledgers.", record.Content().Context())
+	assert.Equal(t, "Merge.", record.Content().Decision())
+	assert.Equal(t, "Migrate history.", record.Content().Consequences())
+	assert.Equal(t, []string{"dir-1"}, record.SubjectIDs())
+}
+
+func TestRecordAgreedDirectionHandler_RedeliveryRecordsOnce(t *testing.T) {
+	repo := newMockRepository()
+	handler := NewRecordAgreedDirectionHandler(repo, stubSubjectDirectory{"direction/dir-1": "Payments"})
+
+	_, err := handler.Handle(context.Background(), agreedDirection())
+	require.NoError(t, err)
+	_, err = handler.Handle(context.Background(), agreedDirection())
+	require.NoError(t, err)
+
+	assert.Len(t, repo.saved, 1)
+}
+
+func TestRecordAgreedDirectionHandler_UnknownDirection_Fails(t *testing.T) {
+	repo := newMockRepository()
+
+	_, err := NewRecordAgreedDirectionHandler(repo, stubSubjectDirectory{}).Handle(context.Background(), agreedDirection())
+
+	assert.ErrorIs(t, err, ErrSubjectNotFound)
+	assert.Empty(t, repo.saved)
+}
@@ -0,0 +1,9 @@
+package ports
+
+import "context"
+
+// SubjectDirectory resolves the architecture elements a decision record can
+// link to. Found is false when the subject does not exist.
+type SubjectDirectory interface {
+	SubjectName(ctx context.Context, subjectType, subjectID string) (name string, found bool, err error)
+}
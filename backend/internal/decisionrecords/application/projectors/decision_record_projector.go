@@ -0,0 +1,156 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"easi/backend/internal/decisionrecords/application/readmodels"
+	"easi/backend/internal/decisionrecords/domain/events"
+	"easi/backend/internal/decisionrecords/domain/valueobjects"
+	pl "easi/backend/internal/decisionrecords/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+func handleProjection[T any](ctx context.Context, eventData []byte, fn func(context.Context, T) error) error {
+	var event T
+	if err := json.Unmarshal(eventData, &event); err != nil {
+		return fmt.Errorf("unmarshal %T event data: %w", event, err)
+	}
+	return fn(ctx, event)
+}
+
+type DecisionRecordStore interface {
+	Insert(ctx context.Context, p readmodels.InsertDecisionRecordParams) error
+	Revise(ctx context.Context, p readmodels.ReviseDecisionRecordParams) error
+	SetStatus(ctx context.Context, c readmodels.StatusChange) error
+	LinkSubject(ctx context.Context, l readmodels.SubjectLink) error
+	UnlinkSubject(ctx context.Context, l readmodels.SubjectLink) error
+}
+
+type DecisionRecordProjector struct {
+	readModel DecisionRecordStore
+}
+
+func NewDecisionRecordProjector(readModel DecisionRecordStore) *DecisionRecordProjector {
+	return &DecisionRecordProjector{readModel: readModel}
+}
+
+func (p *DecisionRecordProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		wrappedErr := fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+		log.Printf("failed to marshal event data: %v", wrappedErr)
+		return wrappedErr
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *DecisionRecordProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	handlers := map[string]func(context.Context, []byte) error{
+		pl.DecisionRecordProposed:        p.handleProposed,
+		pl.DecisionRecordRevised:         p.handleRevised,
+		pl.DecisionRecordAccepted:        p.handleAccepted,
+		pl.DecisionRecordSuperseded:      p.handleSuperseded,
+		pl.DecisionRecordDeprecated:      p.handleDeprecated,
+		pl.DecisionRecordSubjectLinked:   p.handleSubjectLinked,
+		pl.DecisionRecordSubjectUnlinked: p.handleSubjectUnlinked,
+	}
+	if handler, exists := handlers[eventType]; exists {
+		return handler(ctx, eventData)
+	}
+	return nil
+}
+
+func (p *DecisionRecordProjector) handleProposed(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, e events.DecisionRecordProposed) error {
+		return p.readModel.Insert(ctx, readmodels.InsertDecisionRecordParams{
+			ID:           e.ID,
+			Title:        e.Title,
+			Context:      e.Context,
+			Options:      toOptionDTOs(e.Options),
+			Decision:     e.Decision,
+			Consequences: e.Consequences,
+			SupersedesID: e.SupersedesID,
+			ProposedBy:   e.ProposedBy,
+			CreatedAt:    e.OccurredOn,
+		})
+	})
+}
+
+func (p *DecisionRecordProjector) handleRevised(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, e events.DecisionRecordRevised) error {
+		return p.readModel.Revise(ctx, readmodels.ReviseDecisionRecordParams{
+			ID:           e.ID,
+			Title:        e.Title,
+			Context:      e.Context,
+			Options:      toOptionDTOs(e.Options),
+			Decision:     e.Decision,
+			Consequences: e.Consequences,
+			RevisedAt:    e.OccurredOn,
+		})
+	})
+}
+
+func (p *DecisionRecordProjector) handleAccepted(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, e events.DecisionRecordAccepted) error {
+		return p.readModel.SetStatus(ctx, readmodels.StatusChange{
+			ID:        e.ID,
+			Status:    valueobjects.DecisionRecordStatusAccepted,
+			ChangedAt: e.OccurredOn,
+		})
+	})
+}
+
+func (p *DecisionRecordProjector) handleSuperseded(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, e events.DecisionRecordSuperseded) error {
+		return p.readModel.SetStatus(ctx, readmodels.StatusChange{
+			ID:             e.ID,
+			Status:         valueobjects.DecisionRecordStatusSuperseded,
+			SupersededByID: e.SupersededByID,
+			ChangedAt:      e.OccurredOn,
+		})
+	})
+}
+
+func (p *DecisionRecordProjector) handleDeprecated(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, e events.DecisionRecordDeprecated) error {
+		return p.readModel.SetStatus(ctx, readmodels.StatusChange{
+			ID:        e.ID,
+			Status:    valueobjects.DecisionRecordStatusDeprecated,
+			Reason:    e.Reason,
+			ChangedAt: e.OccurredOn,
+		})
+	})
+}
+
+func (p *DecisionRecordProjector) handleSubjectLinked(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, e events.DecisionRecordSubjectLinked) error {
+		return p.readModel.LinkSubject(ctx, readmodels.SubjectLink{
+			RecordID:    e.ID,
+			SubjectType: e.SubjectType,
+			SubjectID:   e.SubjectID,
+			SubjectName: e.SubjectName,
+			LinkedAt:    e.OccurredOn,
+		})
+	})
+}
+
+func (p *DecisionRecordProjector) handleSubjectUnlinked(ctx context.Context, eventData []byte) error {
+	return handleProjection(ctx, eventData, func(ctx context.Context, e events.DecisionRecordSubjectUnlinked) error {
+		return p.readModel.UnlinkSubject(ctx, readmodels.SubjectLink{
+			RecordID:    e.ID,
+			SubjectType: e.SubjectType,
+			SubjectID:   e.SubjectID,
+		})
+	})
+}
+
+func toOptionDTOs(in []events.ConsideredOptionData) []readmodels.ConsideredOptionDTO {
+	out := make([]readmodels.ConsideredOptionDTO, len(in))
+	for i, o := range in {
+		out[i] = readmodels.ConsideredOptionDTO{Name: o.Name, Description: o.Description}
+	}
+	return out
+}
@@ -0,0 +1,132 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"easi/backend/internal/decisionrecords/application/readmodels"
+	"easi/backend/internal/decisionrecords/domain/events"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDecisionRecordStore struct {
+	inserts   []readmodels.InsertDecisionRecordParams
+	revisions []readmodels.ReviseDecisionRecordParams
+	statuses  []readmodels.StatusChange
+	linked    []readmodels.SubjectLink
+	unlinked  []readmodels.SubjectLink
+}
+
+func (m *mockDecisionRecordStore) Insert(_ context.Context, p readmodels.InsertDecisionRecordParams) error {
+	m.inserts = append(m.inserts, p)
+	return nil
+}
+func (m *mockDecisionRecordStore) Revise(_ context.Context, p readmodels.ReviseDecisionRecordParams) error {
+	m.revisions = append(m.revisions, p)
+	return nil
+}
+func (m *mockDecisionRecordStore) SetStatus(_ context.Context, c readmodels.StatusChange) error {
+	m.statuses = append(m.statuses, c)
+	return nil
+}
+func (m *mockDecisionRecordStore) LinkSubject(_ context.Context, l readmodels.SubjectLink) error {
+	m.linked = append(m.linked, l)
+	return nil
+}
+func (m *mockDecisionRecordStore) UnlinkSubject(_ context.Context, l readmodels.SubjectLink) error {
+	m.unlinked = append(m.unlinked, l)
+	return nil
+}
+
+func projectViaJSON(t *testing.T, projector *DecisionRecordProjector, eventType string, payload map[string]interface{}) error {
+	t.Helper()
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return projector.ProjectEvent(context.Background(), eventType, data)
+}
+
+func TestDecisionRecordProjector_Proposed_InsertsRecord(t *testing.T) {
+	store := &mockDecisionRecordStore{}
+	projector := NewDecisionRecordProjector(store)
+	id, predecessor := uuid.New().String(), uuid.New().String()
+
+	evt := events.NewDecisionRecordProposed(events.DecisionRecordProposedFields{
+		ID:           id,
+		Title:        "Use event sourcing for portfolio data",
+		Context:      "Audit needs full history.",
+		Options:      []events.ConsideredOptionData{{Name: "CRUD"}, {Name: "Event sourcing", Description: "Append-only."}},
+		Decision:     "Event sourcing.",
+		SupersedesID: predecessor,
+		ProposedBy:   "architect@example.com",
+	})
+	require.NoError(t, projectViaJSON(t, projector, evt.EventType(), evt.EventData()))
+
+	require.Len(t, store.inserts, 1)
+	got := store.inserts[0]
+	assert.Equal(t, id, got.ID)
+	assert.Equal(t, "Use event sourcing for portfolio data", got.Title)
+	assert.Equal(t, []readmodels.ConsideredOptionDTO{{Name: "CRUD"}, {Name: "Event sourcing", Description: "Append-only."}}, got.Options)
+	assert.Equal(t, predecessor, got.SupersedesID)
+	assert.Equal(t, "architect@example.com", got.ProposedBy)
+	assert.False(t, got.CreatedAt.IsZero())
+}
+
+func TestDecisionRecordProjector_Revised_UpdatesContent(t *testing.T) {
+	store := &mockDecisionRecordStore{}
+	projector := NewDecisionRecordProjector(store)
+	id := uuid.New().String()
+
+	evt := events.NewDecisionRecordRevised(events.DecisionRecordRevisedFields{ID: id, Title: "Refined", Decision: "Go."})
+	require.NoError(t, projectViaJSON(t, projector, evt.EventType(), evt.EventData()))
+
+	require.Len(t, store.revisions, 1)
+	assert.Equal(t, "Refined", store.revisions[0].Title)
+	assert.Equal(t, "Go.", store.revisions[0].Decision)
+}
+
+func TestDecisionRecordProjector_LifecycleEvents_SetStatus(t *testing.T) {
+	store := &mockDecisionRecordStore{}
+	projector := NewDecisionRecordProjector(store)
+	id, successor := uuid.New().String(), uuid.New().String()
+
+	accepted := events.NewDecisionRecordAccepted(id, nil, "a@example.com")
+	superseded := events.NewDecisionRecordSuperseded(id, successor, nil, "a@example.com")
+	deprecated := events.NewDecisionRecordDeprecated(id, "No longer relevant.", nil, "a@example.com")
+	require.NoError(t, projectViaJSON(t, projector, accepted.EventType(), accepted.EventData()))
+	require.NoError(t, projectViaJSON(t, projector, superseded.EventType(), superseded.EventData()))
+	require.NoError(t, projectViaJSON(t, projector, deprecated.EventType(), deprecated.EventData()))
+
+	require.Len(t, store.statuses, 3)
+	assert.Equal(t, "accepted", store.statuses[0].Status)
+	assert.Equal(t, "superseded", store.statuses[1].Status)
+	assert.Equal(t, successor, store.statuses[1].SupersededByID)
+	assert.Equal(t, "deprecated", store.statuses[2].Status)
+	assert.Equal(t, "No longer relevant.", store.statuses[2].Reason)
+}
+
+func TestDecisionRecordProjector_SubjectLinks(t *testing.T) {
+	store := &mockDecisionRecordStore{}
+	projector := NewDecisionRecordProjector(store)
+	id, capabilityID := uuid.New().String(), uuid.New().String()
+
+	linked := events.NewDecisionRecordSubjectLinked(id, "capability", capabilityID, "Payments", "a@example.com")
+	unlinked := events.NewDecisionRecordSubjectUnlinked(id, "capability", capabilityID, "a@example.com")
+	require.NoError(t, projectViaJSON(t, projector, linked.EventType(), linked.EventData()))
+	require.NoError(t, projectViaJSON(t, projector, unlinked.EventType(), unlinked.EventData()))
+
+	require.Len(t, store.linked, 1)
+	assert.Equal(t, readmodels.SubjectLink{
+		RecordID: id, SubjectType: "capability", SubjectID: capabilityID, SubjectName: "Payments", LinkedAt: store.linked[0].LinkedAt,
+	}, store.linked[0])
+	require.Len(t, store.unlinked, 1)
+	assert.Equal(t, capabilityID, store.unlinked[0].SubjectID)
+}
+
+func TestDecisionRecordProjector_UnknownEventIgnored(t *testing.T) {
+	projector := NewDecisionRecordProjector(&mockDecisionRecordStore{})
+	assert.NoError(t, projector.ProjectEvent(context.Background(), "SomethingElse", []byte(`{}`)))
+}
@@ -0,0 +1,70 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	adPL "easi/backend/internal/architecturedirection/publishedlanguage"
+	"easi/backend/internal/decisionrecords/application/commands"
+	"easi/backend/internal/shared/cqrs"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type CommandDispatcher interface {
+	Dispatch(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error)
+}
+
+// DirectionAgreedReactor records every agreed architecture direction as an
+// accepted decision record, so the decision lives in one place alongside the
+// rest of the tenant's decisions.
+type DirectionAgreedReactor struct {
+	commands CommandDispatcher
+}
+
+func NewDirectionAgreedReactor(commandDispatcher CommandDispatcher) *DirectionAgreedReactor {
+	return &DirectionAgreedReactor{commands: commandDispatcher}
+}
+
+func (r *DirectionAgreedReactor) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+	}
+	return r.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+type directionAgreedEvent struct {
+	ID             string `json:"id"`
+	AgreedBy       string `json:"agreedBy"`
+	DecisionRecord *struct {
+		Context      string `json:"context"`
+		Decision     string `json:"decision"`
+		Consequences string `json:"consequences"`
+	} `json:"decisionRecord"`
+}
+
+// ProjectEvent skips directions agreed before they carried a decision, as
+// there is nothing to record for them.
+func (r *DirectionAgreedReactor) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	if eventType != adPL.DirectionAgreed {
+		return nil
+	}
+	var event directionAgreedEvent
+	if err := json.Unmarshal(eventData, &event); err != nil {
+		return fmt.Errorf("unmarshal %s event: %w", eventType, err)
+	}
+	if event.DecisionRecord == nil {
+		return nil
+	}
+	if _, err := r.commands.Dispatch(ctx, &commands.RecordAgreedDirection{
+		DirectionID:  event.ID,
+		Context:      event.DecisionRecord.Context,
+		Decision:     event.DecisionRecord.Decision,
+		Consequences: event.DecisionRecord.Consequences,
+		Actor:        event.AgreedBy,
+	}); err != nil {
+		return fmt.Errorf("record decision of agreed direction %s: %w", event.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package projectors
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/decisionrecords/application/commands"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDispatcher struct {
+	dispatched []cqrs.Command
+}
+
+func (d *fakeDispatcher) Dispatch(_ context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	d.dispatched = append(d.dispatched, cmd)
+	return cqrs.EmptyResult(), nil
+}
+
+func TestDirectionAgreedReactor_RecordsTheDecision(t *testing.T) {
+	dispatcher := &fakeDispatcher{}
+
+	err := NewDirectionAgreedReactor(dispatcher).ProjectEvent(context.Background(), "DirectionAgreed", []byte(`{
+		"id": "dir-1", "agreedBy": "architect@example.com",
+		"decisionRecord": {"context": "Two ledgers.", "decision": "Merge.", "consequences": "Migrate history."}
+	}`))
+
+	require.NoError(t, err)
+	require.Len(t, dispatcher.dispatched, 1)
+	assert.Equal(t, &commands.RecordAgreedDirection{
+		DirectionID:  "dir-1",
+		Context:      "Two ledgers.",
+		Decision:     "Merge.",
+		Consequences: "Migrate history.",
+		Actor:        "architect@example.com",
+	}, dispatcher.dispatched[0])
+}
+
+func TestDirectionAgreedReactor_SkipsDirectionsAgreedWithoutADecision(t *testing.T) {
+	dispatcher := &fakeDispatcher{}
+
+	err := NewDirectionAgreedReactor(dispatcher).ProjectEvent(context.Background(), "DirectionAgreed", []byte(`{"id": "dir-1"}`))
+
+	require.NoError(t, err)
+	assert.Empty(t, dispatcher.dispatched)
+}
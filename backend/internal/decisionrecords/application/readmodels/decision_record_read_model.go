@@ -0,0 +1,399 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"easi/backend/internal/decisionrecords/domain/valueobjects"
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/types"
+)
+
+type ConsideredOptionDTO struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type DecisionRecordRefDTO struct {
+	ID    string `json:"id"`
+	Key   string `json:"key"`
+	Title string `json:"title"`
+}
+
+type DecisionSubjectDTO struct {
+	Type  string      `json:"type"`
+	ID    string      `json:"id"`
+	Name  string      `json:"name"`
+	Links types.Links `json:"_links,omitempty"`
+}
+
+type DecisionRecordDTO struct {
+	ID           string                `json:"id"`
+	Number       int                   `json:"number"`
+	Key          string                `json:"key"`
+	Title        string                `json:"title"`
+	Status       string                `json:"status"`
+	StatusReason string                `json:"statusReason,omitempty"`
+	Context      string                `json:"context"`
+	Options      []ConsideredOptionDTO `json:"options"`
+	Decision     string                `json:"decision"`
+	Consequences string                `json:"consequences"`
+	Supersedes   *DecisionRecordRefDTO `json:"supersedes,omitempty"`
+	SupersededBy *DecisionRecordRefDTO `json:"supersededBy,omitempty"`
+	Subjects     []DecisionSubjectDTO  `json:"subjects"`
+	ProposedBy   string                `json:"proposedBy"`
+	CreatedAt    time.Time             `json:"createdAt"`
+	UpdatedAt    *time.Time            `json:"updatedAt,omitempty"`
+	DecidedAt    *time.Time            `json:"decidedAt,omitempty"`
+	Links        types.Links           `json:"_links,omitempty"`
+}
+
+// DecisionRecordKey formats the human-facing ADR number, e.g. ADR-007.
+func DecisionRecordKey(number int) string {
+	return fmt.Sprintf("ADR-%03d", number)
+}
+
+type DecisionRecordFilter struct {
+	Status    string
+	SubjectID string
+}
+
+type DecisionRecordReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewDecisionRecordReadModel(db *database.TenantAwareDB) *DecisionRecordReadModel {
+	return &DecisionRecordReadModel{db: db}
+}
+
+type InsertDecisionRecordParams struct {
+	ID           string
+	Title        string
+	Context      string
+	Options      []ConsideredOptionDTO
+	Decision     string
+	Consequences string
+	SupersedesID string
+	ProposedBy   string
+	CreatedAt    time.Time
+}
+
+// Insert numbers records in the order they are proposed; the number is
+// assigned here rather than in the aggregate so it stays gapless per tenant.
+func (rm *DecisionRecordReadModel) Insert(ctx context.Context, p InsertDecisionRecordParams) error {
+	options, err := json.Marshal(nonNilOptions(p.Options))
+	if err != nil {
+		return err
+	}
+	return rm.withTx(ctx, func(tx *sql.Tx, tenantID string) error {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO decisionrecords.decision_records
+			   (tenant_id, id, number, title, status, context, options, decision, consequences, supersedes_id, proposed_by, created_at)
+			 SELECT $1, $2, COALESCE(MAX(number), 0) + 1, $3, 'proposed', $4, $5, $6, $7, NULLIF($8, ''), $9, $10
+			 FROM decisionrecords.decision_records WHERE tenant_id = $1
+			 ON CONFLICT (tenant_id, id) DO NOTHING`,
+			tenantID, p.ID, p.Title, p.Context, options, p.Decision, p.Consequences, p.SupersedesID, p.ProposedBy, p.CreatedAt,
+		)
+		return err
+	})
+}
+
+type ReviseDecisionRecordParams struct {
+	ID           string
+	Title        string
+	Context      string
+	Options      []ConsideredOptionDTO
+	Decision     string
+	Consequences string
+	RevisedAt    time.Time
+}
+
+func (rm *DecisionRecordReadModel) Revise(ctx context.Context, p ReviseDecisionRecordParams) error {
+	options, err := json.Marshal(nonNilOptions(p.Options))
+	if err != nil {
+		return err
+	}
+	return rm.tenantExec(ctx,
+		`UPDATE decisionrecords.decision_records
+		 SET title = $3, context = $4, options = $5, decision = $6, consequences = $7, updated_at = $8
+		 WHERE tenant_id = $1 AND id = $2`,
+		func(t string) []any {
+			return []any{t, p.ID, p.Title, p.Context, options, p.Decision, p.Consequences, p.RevisedAt}
+		},
+	)
+}
+
+type StatusChange struct {
+	ID             string
+	Status         string
+	Reason         string
+	SupersededByID string
+	ChangedAt      time.Time
+}
+
+// SetStatus records a lifecycle transition. Acceptance stamps decided_at;
+// later transitions keep it so the log still shows when the decision was made.
+func (rm *DecisionRecordReadModel) SetStatus(ctx context.Context, c StatusChange) error {
+	return rm.tenantExec(ctx,
+		`UPDATE decisionrecords.decision_records
+		 SET status = $3,
+		     status_reason = $4,
+		     superseded_by_id = COALESCE(NULLIF($5, ''), superseded_by_id),
+		     decided_at = CASE WHEN $3 = 'accepted' THEN $6 ELSE decided_at END,
+		     updated_at = $6
+		 WHERE tenant_id = $1 AND id = $2`,
+		func(t string) []any { return []any{t, c.ID, c.Status, c.Reason, c.SupersededByID, c.ChangedAt} },
+	)
+}
+
+type SubjectLink struct {
+	RecordID    string
+	SubjectType string
+	SubjectID   string
+	SubjectName string
+	LinkedAt    time.Time
+}
+
+func (rm *DecisionRecordReadModel) LinkSubject(ctx context.Context, l SubjectLink) error {
+	return rm.tenantExec(ctx,
+		`INSERT INTO decisionrecords.decision_record_subjects
+		   (tenant_id, decision_record_id, subject_type, subject_id, subject_name, linked_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 ON CONFLICT (tenant_id, decision_record_id, subject_type, subject_id)
+		 DO UPDATE SET subject_name = EXCLUDED.subject_name`,
+		func(t string) []any {
+			return []any{t, l.RecordID, l.SubjectType, l.SubjectID, l.SubjectName, l.LinkedAt}
+		},
+	)
+}
+
+func (rm *DecisionRecordReadModel) UnlinkSubject(ctx context.Context, l SubjectLink) error {
+	return rm.tenantExec(ctx,
+		`DELETE FROM decisionrecords.decision_record_subjects
+		 WHERE tenant_id = $1 AND decision_record_id = $2 AND subject_type = $3 AND subject_id = $4`,
+		func(t string) []any { return []any{t, l.RecordID, l.SubjectType, l.SubjectID} },
+	)
+}
+
+const selectDecisionRecords = `SELECT r.id, r.number, r.title, r.status, r.status_reason, r.context, r.options,
+	   r.decision, r.consequences, r.proposed_by, r.created_at, r.updated_at, r.decided_at,
+	   s.id, s.number, s.title, sb.id, sb.number, sb.title
+	 FROM decisionrecords.decision_records r
+	 LEFT JOIN decisionrecords.decision_records s ON s.tenant_id = r.tenant_id AND s.id = r.supersedes_id
+	 LEFT JOIN decisionrecords.decision_records sb ON sb.tenant_id = r.tenant_id AND sb.id = r.superseded_by_id`
+
+// GetAll returns the decision log in number order, optionally narrowed to one
+// status or to the records that apply to one subject.
+func (rm *DecisionRecordReadModel) GetAll(ctx context.Context, filter DecisionRecordFilter) ([]DecisionRecordDTO, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := selectDecisionRecords + ` WHERE r.tenant_id = $1`
+	args := []any{tenantID}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		query += fmt.Sprintf(` AND r.status = $%d`, len(args))
+	}
+	if filter.SubjectID != "" {
+		args = append(args, filter.SubjectID)
+		query += fmt.Sprintf(` AND EXISTS (SELECT 1 FROM decisionrecords.decision_record_subjects x
+			WHERE x.tenant_id = r.tenant_id AND x.decision_record_id = r.id AND x.subject_id = $%d)`, len(args))
+	}
+	query += ` ORDER BY r.number`
+
+	var records []DecisionRecordDTO
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			record, err := scanDecisionRecord(rows)
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return attachSubjects(ctx, tx, tenantID, records)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if records == nil {
+		records = []DecisionRecordDTO{}
+	}
+	return records, nil
+}
+
+func (rm *DecisionRecordReadModel) GetByID(ctx context.Context, id string) (*DecisionRecordDTO, error) {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var record *DecisionRecordDTO
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx, selectDecisionRecords+` WHERE r.tenant_id = $1 AND r.id = $2`, tenantID, id)
+		found, err := scanDecisionRecord(row)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		records := []DecisionRecordDTO{found}
+		if err := attachSubjects(ctx, tx, tenantID, records); err != nil {
+			return err
+		}
+		record = &records[0]
+		return nil
+	})
+	return record, err
+}
+
+// GetBySubject lists the records that apply to an architecture element,
+// retired ones last, for one-pagers and other subject-centric views.
+func (rm *DecisionRecordReadModel) GetBySubject(ctx context.Context, subjectID string) ([]DecisionRecordDTO, error) {
+	records, err := rm.GetAll(ctx, DecisionRecordFilter{SubjectID: subjectID})
+	if err != nil {
+		return nil, err
+	}
+	active := make([]DecisionRecordDTO, 0, len(records))
+	var retired []DecisionRecordDTO
+	for _, r := range records {
+		if r.Status == valueobjects.DecisionRecordStatusSuperseded || r.Status == valueobjects.DecisionRecordStatusDeprecated {
+			retired = append(retired, r)
+			continue
+		}
+		active = append(active, r)
+	}
+	return append(active, retired...), nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDecisionRecord(row rowScanner) (DecisionRecordDTO, error) {
+	var (
+		r                                    DecisionRecordDTO
+		options                              []byte
+		updatedAt, decidedAt                 sql.NullTime
+		supersedesID, supersedesTitle        sql.NullString
+		supersededByID, supersededByTitle    sql.NullString
+		supersedesNumber, supersededByNumber sql.NullInt64
+	)
+	err := row.Scan(&r.ID, &r.Number, &r.Title, &r.Status, &r.StatusReason, &r.Context, &options,
+		&r.Decision, &r.Consequences, &r.ProposedBy, &r.CreatedAt, &updatedAt, &decidedAt,
+		&supersedesID, &supersedesNumber, &supersedesTitle,
+		&supersededByID, &supersededByNumber, &supersededByTitle)
+	if err != nil {
+		return r, err
+	}
+	if err := json.Unmarshal(options, &r.Options); err != nil {
+		return r, fmt.Errorf("decode options of decision record %s: %w", r.ID, err)
+	}
+	r.Options = nonNilOptions(r.Options)
+	r.Key = DecisionRecordKey(r.Number)
+	r.Subjects = []DecisionSubjectDTO{}
+	if updatedAt.Valid {
+		r.UpdatedAt = &updatedAt.Time
+	}
+	if decidedAt.Valid {
+		r.DecidedAt = &decidedAt.Time
+	}
+	r.Supersedes = toRef(supersedesID, supersedesNumber, supersedesTitle)
+	r.SupersededBy = toRef(supersededByID, supersededByNumber, supersededByTitle)
+	return r, nil
+}
+
+func toRef(id sql.NullString, number sql.NullInt64, title sql.NullString) *DecisionRecordRefDTO {
+	if !id.Valid {
+		return nil
+	}
+	return &DecisionRecordRefDTO{ID: id.String, Key: DecisionRecordKey(int(number.Int64)), Title: title.String}
+}
+
+func attachSubjects(ctx context.Context, tx *sql.Tx, tenantID string, records []DecisionRecordDTO) error {
+	if len(records) == 0 {
+		return nil
+	}
+	index := make(map[string]int, len(records))
+	placeholders := make([]string, len(records))
+	args := []any{tenantID}
+	for i, r := range records {
+		index[r.ID] = i
+		args = append(args, r.ID)
+		placeholders[i] = fmt.Sprintf("$%d", len(args))
+	}
+	rows, err := tx.QueryContext(ctx,
+		`SELECT decision_record_id, subject_type, subject_id, subject_name
+		 FROM decisionrecords.decision_record_subjects
+		 WHERE tenant_id = $1 AND decision_record_id IN (`+strings.Join(placeholders, ", ")+`)
+		 ORDER BY subject_type, subject_name, subject_id`,
+		args...,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var recordID string
+		var s DecisionSubjectDTO
+		if err := rows.Scan(&recordID, &s.Type, &s.ID, &s.Name); err != nil {
+			return err
+		}
+		i := index[recordID]
+		records[i].Subjects = append(records[i].Subjects, s)
+	}
+	return rows.Err()
+}
+
+func nonNilOptions(options []ConsideredOptionDTO) []ConsideredOptionDTO {
+	if options == nil {
+		return []ConsideredOptionDTO{}
+	}
+	return options
+}
+
+func tenantOf(ctx context.Context) (string, error) {
+	t, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return "", err
+	}
+	return t.Value(), nil
+}
+
+func (rm *DecisionRecordReadModel) tenantExec(ctx context.Context, query string, argsFn func(tenantID string) []any) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = rm.db.ExecContext(ctx, query, argsFn(tenantID)...)
+	return err
+}
+
+func (rm *DecisionRecordReadModel) withTx(ctx context.Context, fn func(tx *sql.Tx, tenantID string) error) error {
+	tenantID, err := tenantOf(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := rm.db.BeginTxWithTenant(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx, tenantID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
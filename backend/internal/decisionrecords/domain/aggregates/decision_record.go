@@ -0,0 +1,271 @@
+package aggregates
+
+import (
+	"errors"
+	"fmt"
+
+	"easi/backend/internal/decisionrecords/domain/events"
+	"easi/backend/internal/decisionrecords/domain/valueobjects"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var (
+	ErrDecisionRecordNotProposed    = errors.New("only a proposed decision record can be revised or accepted")
+	ErrDecisionRecordNotAccepted    = errors.New("only an accepted decision record can be superseded")
+	ErrDecisionRecordRetired        = errors.New("decision record is already superseded or deprecated")
+	ErrDecisionRequiredToAccept     = errors.New("a decision record needs a decision before it can be accepted")
+	ErrCannotSupersedeItself        = errors.New("a decision record cannot supersede itself")
+	ErrSubjectAlreadyLinked         = errors.New("subject is already linked to this decision record")
+	ErrSubjectNotLinked             = errors.New("subject is not linked to this decision record")
+	ErrCorruptedDecisionRecordEvent = errors.New("corrupted event store: cannot rehydrate decision record")
+	ErrUnknownDecisionRecordEvent   = errors.New("unknown event type for decision record aggregate")
+)
+
+// DecisionRecord is an architecture decision record (ADR). It is proposed,
+// then accepted; an accepted record is retired either by deprecation or by
+// a later record that supersedes it, which happens when that record is
+// accepted. Whether the superseded record exists is checked by the command
+// handler, not here.
+type DecisionRecord struct {
+	domain.AggregateRoot
+	content        valueobjects.DecisionRecordContent
+	status         valueobjects.DecisionRecordStatus
+	supersedesID   string
+	supersededByID string
+	subjects       []valueobjects.DecisionSubject
+}
+
+type NamedSubject struct {
+	Subject valueobjects.DecisionSubject
+	Name    string
+}
+
+type DecisionRecordFacts struct {
+	ID           valueobjects.DecisionRecordID
+	Content      valueobjects.DecisionRecordContent
+	SupersedesID string
+	Subjects     []NamedSubject
+	ProposedBy   string
+}
+
+func ProposeDecisionRecord(facts DecisionRecordFacts) (*DecisionRecord, error) {
+	if facts.SupersedesID == facts.ID.Value() {
+		return nil, ErrCannotSupersedeItself
+	}
+	record := &DecisionRecord{
+		AggregateRoot: domain.NewAggregateRootWithID(facts.ID.Value()),
+	}
+	record.raise(events.NewDecisionRecordProposed(events.DecisionRecordProposedFields{
+		ID:           facts.ID.Value(),
+		Title:        facts.Content.Title(),
+		Context:      facts.Content.Context(),
+		Options:      toOptionData(facts.Content.Options()),
+		Decision:     facts.Content.Decision(),
+		Consequences: facts.Content.Consequences(),
+		SupersedesID: facts.SupersedesID,
+		ProposedBy:   facts.ProposedBy,
+	}))
+	for _, s := range facts.Subjects {
+		if err := record.LinkSubject(s.Subject, s.Name, facts.ProposedBy); err != nil {
+			return nil, err
+		}
+	}
+	return record, nil
+}
+
+func LoadDecisionRecordFromHistory(eventHistory []domain.DomainEvent) (*DecisionRecord, error) {
+	record := &DecisionRecord{
+		AggregateRoot: domain.NewAggregateRoot(),
+	}
+	var applyErr error
+	record.LoadFromHistory(eventHistory, func(event domain.DomainEvent) {
+		if applyErr != nil {
+			return
+		}
+		applyErr = record.apply(event)
+	})
+	if applyErr != nil {
+		return nil, applyErr
+	}
+	return record, nil
+}
+
+func (r *DecisionRecord) Revise(content valueobjects.DecisionRecordContent, actor string) error {
+	if !r.status.IsProposed() {
+		return ErrDecisionRecordNotProposed
+	}
+	r.raise(events.NewDecisionRecordRevised(events.DecisionRecordRevisedFields{
+		ID:           r.ID(),
+		Title:        content.Title(),
+		Context:      content.Context(),
+		Options:      toOptionData(content.Options()),
+		Decision:     content.Decision(),
+		Consequences: content.Consequences(),
+		SubjectIDs:   r.SubjectIDs(),
+		RevisedBy:    actor,
+	}))
+	return nil
+}
+
+func (r *DecisionRecord) Accept(actor string) error {
+	if !r.status.IsProposed() {
+		return ErrDecisionRecordNotProposed
+	}
+	if !r.content.HasDecision() {
+		return ErrDecisionRequiredToAccept
+	}
+	r.raise(events.NewDecisionRecordAccepted(r.ID(), r.SubjectIDs(), actor))
+	return nil
+}
+
+// SupersedeBy retires an accepted record in favour of the record that has
+// just been accepted in its place.
+func (r *DecisionRecord) SupersedeBy(recordID, actor string) error {
+	if recordID == r.ID() {
+		return ErrCannotSupersedeItself
+	}
+	if !r.status.IsAccepted() {
+		return ErrDecisionRecordNotAccepted
+	}
+	r.raise(events.NewDecisionRecordSuperseded(r.ID(), recordID, r.SubjectIDs(), actor))
+	return nil
+}
+
+// Deprecate withdraws a proposal or an accepted decision that no longer
+// applies and has no successor.
+func (r *DecisionRecord) Deprecate(reason, actor string) error {
+	if r.status.IsRetired() {
+		return ErrDecisionRecordRetired
+	}
+	r.raise(events.NewDecisionRecordDeprecated(r.ID(), reason, r.SubjectIDs(), actor))
+	return nil
+}
+
+func (r *DecisionRecord) LinkSubject(subject valueobjects.DecisionSubject, name, actor string) error {
+	if r.HasSubject(subject) {
+		return ErrSubjectAlreadyLinked
+	}
+	r.raise(events.NewDecisionRecordSubjectLinked(r.ID(), subject.Type(), subject.ID(), name, actor))
+	return nil
+}
+
+func (r *DecisionRecord) UnlinkSubject(subject valueobjects.DecisionSubject, actor string) error {
+	if !r.HasSubject(subject) {
+		return ErrSubjectNotLinked
+	}
+	r.raise(events.NewDecisionRecordSubjectUnlinked(r.ID(), subject.Type(), subject.ID(), actor))
+	return nil
+}
+
+func (r *DecisionRecord) Content() valueobjects.DecisionRecordContent { return r.content }
+func (r *DecisionRecord) Status() valueobjects.DecisionRecordStatus   { return r.status }
+func (r *DecisionRecord) SupersedesID() string                        { return r.supersedesID }
+func (r *DecisionRecord) SupersededByID() string                      { return r.supersededByID }
+
+func (r *DecisionRecord) Subjects() []valueobjects.DecisionSubject {
+	out := make([]valueobjects.DecisionSubject, len(r.subjects))
+	copy(out, r.subjects)
+	return out
+}
+
+func (r *DecisionRecord) SubjectIDs() []string {
+	ids := make([]string, len(r.subjects))
+	for i, s := range r.subjects {
+		ids[i] = s.ID()
+	}
+	return ids
+}
+
+func (r *DecisionRecord) HasSubject(subject valueobjects.DecisionSubject) bool {
+	for _, s := range r.subjects {
+		if s.Equals(subject) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *DecisionRecord) raise(event domain.DomainEvent) {
+	if err := r.apply(event); err != nil {
+		panic(fmt.Sprintf("decisionrecords: in-process apply failed: %v", err))
+	}
+	r.RaiseEvent(event)
+}
+
+func (r *DecisionRecord) apply(event domain.DomainEvent) error {
+	switch evt := event.(type) {
+	case events.DecisionRecordProposed:
+		r.AggregateRoot = domain.NewAggregateRootWithID(evt.ID)
+		r.supersedesID = evt.SupersedesID
+		r.subjects = []valueobjects.DecisionSubject{}
+		return r.applyContent(valueobjects.DecisionRecordStatusProposed, evt.Title, evt.Context, evt.Options, evt.Decision, evt.Consequences)
+	case events.DecisionRecordRevised:
+		return r.applyContent(r.status.Value(), evt.Title, evt.Context, evt.Options, evt.Decision, evt.Consequences)
+	case events.DecisionRecordAccepted:
+		return r.applyStatus(valueobjects.DecisionRecordStatusAccepted)
+	case events.DecisionRecordSuperseded:
+		r.supersededByID = evt.SupersededByID
+		return r.applyStatus(valueobjects.DecisionRecordStatusSuperseded)
+	case events.DecisionRecordDeprecated:
+		return r.applyStatus(valueobjects.DecisionRecordStatusDeprecated)
+	case events.DecisionRecordSubjectLinked:
+		return r.applySubjectLinked(evt.SubjectType, evt.SubjectID)
+	case events.DecisionRecordSubjectUnlinked:
+		r.applySubjectUnlinked(evt.SubjectType, evt.SubjectID)
+		return nil
+	default:
+		return fmt.Errorf("%w: %T", ErrUnknownDecisionRecordEvent, event)
+	}
+}
+
+func (r *DecisionRecord) applyContent(rawStatus, title, context string, options []events.ConsideredOptionData, decision, consequences string) error {
+	params := valueobjects.DecisionRecordContentParams{
+		Title: title, Context: context, Decision: decision, Consequences: consequences,
+		Options: make([]valueobjects.ConsideredOptionParams, len(options)),
+	}
+	for i, o := range options {
+		params.Options[i] = valueobjects.ConsideredOptionParams{Name: o.Name, Description: o.Description}
+	}
+	content, err := valueobjects.NewDecisionRecordContent(params)
+	if err != nil {
+		return fmt.Errorf("%w: content: %v", ErrCorruptedDecisionRecordEvent, err)
+	}
+	r.content = content
+	return r.applyStatus(rawStatus)
+}
+
+func (r *DecisionRecord) applyStatus(raw string) error {
+	status, err := valueobjects.NewDecisionRecordStatus(raw)
+	if err != nil {
+		return fmt.Errorf("%w: status %q: %v", ErrCorruptedDecisionRecordEvent, raw, err)
+	}
+	r.status = status
+	return nil
+}
+
+func (r *DecisionRecord) applySubjectLinked(subjectType, subjectID string) error {
+	subject, err := valueobjects.NewDecisionSubject(subjectType, subjectID)
+	if err != nil {
+		return fmt.Errorf("%w: subject %s/%s: %v", ErrCorruptedDecisionRecordEvent, subjectType, subjectID, err)
+	}
+	r.subjects = append(r.subjects, subject)
+	return nil
+}
+
+func (r *DecisionRecord) applySubjectUnlinked(subjectType, subjectID string) {
+	remaining := make([]valueobjects.DecisionSubject, 0, len(r.subjects))
+	for _, s := range r.subjects {
+		if s.Type() != subjectType || s.ID() != subjectID {
+			remaining = append(remaining, s)
+		}
+	}
+	r.subjects = remaining
+}
+
+func toOptionData(options []valueobjects.ConsideredOption) []events.ConsideredOptionData {
+	out := make([]events.ConsideredOptionData, len(options))
+	for i, o := range options {
+		out[i] = events.ConsideredOptionData{Name: o.Name(), Description: o.Description()}
+	}
+	return out
+}
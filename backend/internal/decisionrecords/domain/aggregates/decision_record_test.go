@@ -0,0 +1,182 @@
+package aggregates
+
+import (
+	"testing"
+
+	"easi/backend/internal/decisionrecords/domain/events"
+	"easi/backend/internal/decisionrecords/domain/valueobjects"
+	domain "easi/backend/internal/shared/eventsourcing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustContent(t *testing.T, title, decision string) valueobjects.DecisionRecordContent {
+	t.Helper()
+	content, err := valueobjects.NewDecisionRecordContent(valueobjects.DecisionRecordContentParams{
+		Title:    title,
+		Context:  "Order updates arrive a day late",
+		Options:  []valueobjects.ConsideredOptionParams{{Name: "Kafka"}, {Name: "Nightly batch"}},
+		Decision: decision,
+	})
+	require.NoError(t, err)
+	return content
+}
+
+func mustSubject(t *testing.T, subjectType, id string) valueobjects.DecisionSubject {
+	t.Helper()
+	subject, err := valueobjects.NewDecisionSubject(subjectType, id)
+	require.NoError(t, err)
+	return subject
+}
+
+func proposedRecord(t *testing.T, decision string, subjects ...NamedSubject) *DecisionRecord {
+	t.Helper()
+	record, err := ProposeDecisionRecord(DecisionRecordFacts{
+		ID:         valueobjects.NewDecisionRecordID(),
+		Content:    mustContent(t, "Adopt event streaming", decision),
+		Subjects:   subjects,
+		ProposedBy: "architect@example.com",
+	})
+	require.NoError(t, err)
+	return record
+}
+
+func acceptedRecord(t *testing.T) *DecisionRecord {
+	t.Helper()
+	record := proposedRecord(t, "Adopt Kafka")
+	require.NoError(t, record.Accept("architect@example.com"))
+	return record
+}
+
+func TestProposeDecisionRecord_RaisesProposedThenSubjectLinks(t *testing.T) {
+	capability := mustSubject(t, "capability", "cap-1")
+	journey := mustSubject(t, "journey", "journey-1")
+
+	record := proposedRecord(t, "", NamedSubject{Subject: capability, Name: "Payments"}, NamedSubject{Subject: journey})
+
+	changes := record.GetUncommittedChanges()
+	require.Len(t, changes, 3)
+	proposed, ok := changes[0].(events.DecisionRecordProposed)
+	require.True(t, ok)
+	assert.Equal(t, "Adopt event streaming", proposed.Title)
+	assert.Len(t, proposed.Options, 2)
+	linked, ok := changes[1].(events.DecisionRecordSubjectLinked)
+	require.True(t, ok)
+	assert.Equal(t, "Payments", linked.SubjectName)
+	assert.True(t, record.Status().IsProposed())
+	assert.Equal(t, []string{"cap-1", "journey-1"}, record.SubjectIDs())
+}
+
+func TestProposeDecisionRecord_CannotSupersedeItself(t *testing.T) {
+	id := valueobjects.NewDecisionRecordID()
+	_, err := ProposeDecisionRecord(DecisionRecordFacts{
+		ID: id, Content: mustContent(t, "Adopt event streaming", ""), SupersedesID: id.Value(),
+	})
+	assert.ErrorIs(t, err, ErrCannotSupersedeItself)
+}
+
+func TestDecisionRecord_Revise(t *testing.T) {
+	record := proposedRecord(t, "")
+
+	require.NoError(t, record.Revise(mustContent(t, "Adopt Kafka for order events", "Adopt Kafka"), "architect@example.com"))
+
+	assert.Equal(t, "Adopt Kafka for order events", record.Content().Title())
+	assert.True(t, record.Status().IsProposed())
+}
+
+func TestDecisionRecord_ReviseAfterAcceptance_Fails(t *testing.T) {
+	record := acceptedRecord(t)
+
+	err := record.Revise(mustContent(t, "Something else", "Adopt Kafka"), "architect@example.com")
+
+	assert.ErrorIs(t, err, ErrDecisionRecordNotProposed)
+}
+
+func TestDecisionRecord_Accept(t *testing.T) {
+	subject := mustSubject(t, "component", "comp-1")
+	record := proposedRecord(t, "Adopt Kafka", NamedSubject{Subject: subject})
+	record.MarkChangesAsCommitted()
+
+	require.NoError(t, record.Accept("architect@example.com"))
+
+	assert.True(t, record.Status().IsAccepted())
+	accepted := record.GetUncommittedChanges()[0].(events.DecisionRecordAccepted)
+	assert.Equal(t, []string{"comp-1"}, accepted.SubjectIDs)
+}
+
+func TestDecisionRecord_AcceptWithoutDecision_Fails(t *testing.T) {
+	record := proposedRecord(t, "")
+
+	assert.ErrorIs(t, record.Accept("architect@example.com"), ErrDecisionRequiredToAccept)
+}
+
+func TestDecisionRecord_AcceptTwice_Fails(t *testing.T) {
+	record := acceptedRecord(t)
+
+	assert.ErrorIs(t, record.Accept("architect@example.com"), ErrDecisionRecordNotProposed)
+}
+
+func TestDecisionRecord_SupersedeBy(t *testing.T) {
+	record := acceptedRecord(t)
+
+	require.NoError(t, record.SupersedeBy("adr-2", "architect@example.com"))
+
+	assert.True(t, record.Status().IsSuperseded())
+	assert.Equal(t, "adr-2", record.SupersededByID())
+}
+
+func TestDecisionRecord_SupersedeProposal_Fails(t *testing.T) {
+	record := proposedRecord(t, "Adopt Kafka")
+
+	assert.ErrorIs(t, record.SupersedeBy("adr-2", "architect@example.com"), ErrDecisionRecordNotAccepted)
+}
+
+func TestDecisionRecord_Deprecate(t *testing.T) {
+	for name, record := range map[string]*DecisionRecord{
+		"proposed": proposedRecord(t, ""),
+		"accepted": acceptedRecord(t),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, record.Deprecate("Payments moved to a SaaS platform", "architect@example.com"))
+			assert.True(t, record.Status().IsDeprecated())
+			assert.ErrorIs(t, record.Deprecate("again", "architect@example.com"), ErrDecisionRecordRetired)
+		})
+	}
+}
+
+func TestDecisionRecord_LinkAndUnlinkSubjects(t *testing.T) {
+	record := proposedRecord(t, "")
+	direction := mustSubject(t, "direction", "dir-1")
+
+	require.NoError(t, record.LinkSubject(direction, "Payments direction", "architect@example.com"))
+	assert.ErrorIs(t, record.LinkSubject(direction, "", "architect@example.com"), ErrSubjectAlreadyLinked)
+	assert.True(t, record.HasSubject(direction))
+
+	require.NoError(t, record.UnlinkSubject(direction, "architect@example.com"))
+	assert.ErrorIs(t, record.UnlinkSubject(direction, "architect@example.com"), ErrSubjectNotLinked)
+	assert.Empty(t, record.Subjects())
+}
+
+func TestLoadDecisionRecordFromHistory_RestoresState(t *testing.T) {
+	original := acceptedRecord(t)
+	require.NoError(t, original.LinkSubject(mustSubject(t, "enterprise-capability", "ec-1"), "Payments", "architect@example.com"))
+	require.NoError(t, original.SupersedeBy("adr-2", "architect@example.com"))
+
+	loaded, err := LoadDecisionRecordFromHistory(original.GetUncommittedChanges())
+
+	require.NoError(t, err)
+	assert.Equal(t, original.ID(), loaded.ID())
+	assert.True(t, loaded.Status().IsSuperseded())
+	assert.Equal(t, "adr-2", loaded.SupersededByID())
+	assert.Equal(t, []string{"ec-1"}, loaded.SubjectIDs())
+	assert.True(t, original.Content().Equals(loaded.Content()))
+}
+
+func TestLoadDecisionRecordFromHistory_CorruptedContentFails(t *testing.T) {
+	proposed := events.NewDecisionRecordProposed(events.DecisionRecordProposedFields{ID: "adr-1", Title: ""})
+
+	_, err := LoadDecisionRecordFromHistory([]domain.DomainEvent{proposed})
+
+	assert.ErrorIs(t, err, ErrCorruptedDecisionRecordEvent)
+}
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/decisionrecords/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+// Lifecycle events carry the IDs of the subjects linked at the time, so the
+// change shows up in each subject's audit history.
+
+type DecisionRecordAccepted struct {
+	domain.BaseEvent
+	ID         string    `json:"id"`
+	SubjectIDs []string  `json:"subjectIds"`
+	AcceptedBy string    `json:"acceptedBy"`
+	OccurredOn time.Time `json:"occurredOn"`
+}
+
+func NewDecisionRecordAccepted(id string, subjectIDs []string, acceptedBy string) DecisionRecordAccepted {
+	return DecisionRecordAccepted{
+		BaseEvent:  domain.NewBaseEvent(id),
+		ID:         id,
+		SubjectIDs: subjectIDs,
+		AcceptedBy: acceptedBy,
+		OccurredOn: time.Now().UTC(),
+	}
+}
+func (e DecisionRecordAccepted) EventType() string { return pl.DecisionRecordAccepted }
+func (e DecisionRecordAccepted) EventData() map[string]interface{} {
+	return map[string]interface{}{"id": e.ID, "subjectIds": e.SubjectIDs, "acceptedBy": e.AcceptedBy, "occurredOn": e.OccurredOn}
+}
+
+type DecisionRecordSuperseded struct {
+	domain.BaseEvent
+	ID             string    `json:"id"`
+	SupersededByID string    `json:"supersededById"`
+	SubjectIDs     []string  `json:"subjectIds"`
+	SupersededBy   string    `json:"supersededBy"`
+	OccurredOn     time.Time `json:"occurredOn"`
+}
+
+func NewDecisionRecordSuperseded(id, supersededByID string, subjectIDs []string, supersededBy string) DecisionRecordSuperseded {
+	return DecisionRecordSuperseded{
+		BaseEvent:      domain.NewBaseEvent(id),
+		ID:             id,
+		SupersededByID: supersededByID,
+		SubjectIDs:     subjectIDs,
+		SupersededBy:   supersededBy,
+		OccurredOn:     time.Now().UTC(),
+	}
+}
+func (e DecisionRecordSuperseded) EventType() string { return pl.DecisionRecordSuperseded }
+func (e DecisionRecordSuperseded) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":             e.ID,
+		"supersededById": e.SupersededByID,
+		"subjectIds":     e.SubjectIDs,
+		"supersededBy":   e.SupersededBy,
+		"occurredOn":     e.OccurredOn,
+	}
+}
+
+type DecisionRecordDeprecated struct {
+	domain.BaseEvent
+	ID           string    `json:"id"`
+	Reason       string    `json:"reason"`
+	SubjectIDs   []string  `json:"subjectIds"`
+	DeprecatedBy string    `json:"deprecatedBy"`
+	OccurredOn   time.Time `json:"occurredOn"`
+}
+
+func NewDecisionRecordDeprecated(id, reason string, subjectIDs []string, deprecatedBy string) DecisionRecordDeprecated {
+	return DecisionRecordDeprecated{
+		BaseEvent:    domain.NewBaseEvent(id),
+		ID:           id,
+		Reason:       reason,
+		SubjectIDs:   subjectIDs,
+		DeprecatedBy: deprecatedBy,
+		OccurredOn:   time.Now().UTC(),
+	}
+}
+func (e DecisionRecordDeprecated) EventType() string { return pl.DecisionRecordDeprecated }
+func (e DecisionRecordDeprecated) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":           e.ID,
+		"reason":       e.Reason,
+		"subjectIds":   e.SubjectIDs,
+		"deprecatedBy": e.DeprecatedBy,
+		"occurredOn":   e.OccurredOn,
+	}
+}
@@ -0,0 +1,75 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/decisionrecords/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type ConsideredOptionData struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type DecisionRecordProposed struct {
+	domain.BaseEvent
+	ID           string                 `json:"id"`
+	Title        string                 `json:"title"`
+	Context      string                 `json:"context"`
+	Options      []ConsideredOptionData `json:"options"`
+	Decision     string                 `json:"decision"`
+	Consequences string                 `json:"consequences"`
+	SupersedesID string                 `json:"supersedesId,omitempty"`
+	ProposedBy   string                 `json:"proposedBy"`
+	OccurredOn   time.Time              `json:"occurredOn"`
+}
+
+type DecisionRecordProposedFields struct {
+	ID           string
+	Title        string
+	Context      string
+	Options      []ConsideredOptionData
+	Decision     string
+	Consequences string
+	SupersedesID string
+	ProposedBy   string
+}
+
+func NewDecisionRecordProposed(f DecisionRecordProposedFields) DecisionRecordProposed {
+	return DecisionRecordProposed{
+		BaseEvent:    domain.NewBaseEvent(f.ID),
+		ID:           f.ID,
+		Title:        f.Title,
+		Context:      f.Context,
+		Options:      f.Options,
+		Decision:     f.Decision,
+		Consequences: f.Consequences,
+		SupersedesID: f.SupersedesID,
+		ProposedBy:   f.ProposedBy,
+		OccurredOn:   time.Now().UTC(),
+	}
+}
+
+func (e DecisionRecordProposed) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func (e DecisionRecordProposed) EventType() string { return pl.DecisionRecordProposed }
+
+func (e DecisionRecordProposed) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":           e.ID,
+		"title":        e.Title,
+		"context":      e.Context,
+		"options":      e.Options,
+		"decision":     e.Decision,
+		"consequences": e.Consequences,
+		"supersedesId": e.SupersedesID,
+		"proposedBy":   e.ProposedBy,
+		"occurredOn":   e.OccurredOn,
+	}
+}
@@ -0,0 +1,32 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/decisionrecords/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDecisionRecordProposed_CarriesContentAndActor(t *testing.T) {
+	evt := NewDecisionRecordProposed(DecisionRecordProposedFields{
+		ID:           "adr-1",
+		Title:        "Adopt event streaming",
+		Context:      "Batch files arrive late",
+		Options:      []ConsideredOptionData{{Name: "Kafka", Description: "Managed cluster"}},
+		Decision:     "Adopt Kafka",
+		Consequences: "Teams run consumers",
+		SupersedesID: "adr-0",
+		ProposedBy:   "architect@example.com",
+	})
+
+	assert.Equal(t, "adr-1", evt.AggregateID())
+	assert.Equal(t, pl.DecisionRecordProposed, evt.EventType())
+	assert.False(t, evt.OccurredOn.IsZero())
+
+	data := evt.EventData()
+	assert.Equal(t, "Adopt event streaming", data["title"])
+	assert.Equal(t, []ConsideredOptionData{{Name: "Kafka", Description: "Managed cluster"}}, data["options"])
+	assert.Equal(t, "adr-0", data["supersedesId"])
+	assert.Equal(t, "architect@example.com", data["proposedBy"])
+}
@@ -0,0 +1,63 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/decisionrecords/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type DecisionRecordRevised struct {
+	domain.BaseEvent
+	ID           string                 `json:"id"`
+	Title        string                 `json:"title"`
+	Context      string                 `json:"context"`
+	Options      []ConsideredOptionData `json:"options"`
+	Decision     string                 `json:"decision"`
+	Consequences string                 `json:"consequences"`
+	SubjectIDs   []string               `json:"subjectIds"`
+	RevisedBy    string                 `json:"revisedBy"`
+	OccurredOn   time.Time              `json:"occurredOn"`
+}
+
+type DecisionRecordRevisedFields struct {
+	ID           string
+	Title        string
+	Context      string
+	Options      []ConsideredOptionData
+	Decision     string
+	Consequences string
+	SubjectIDs   []string
+	RevisedBy    string
+}
+
+func NewDecisionRecordRevised(f DecisionRecordRevisedFields) DecisionRecordRevised {
+	return DecisionRecordRevised{
+		BaseEvent:    domain.NewBaseEvent(f.ID),
+		ID:           f.ID,
+		Title:        f.Title,
+		Context:      f.Context,
+		Options:      f.Options,
+		Decision:     f.Decision,
+		Consequences: f.Consequences,
+		SubjectIDs:   f.SubjectIDs,
+		RevisedBy:    f.RevisedBy,
+		OccurredOn:   time.Now().UTC(),
+	}
+}
+
+func (e DecisionRecordRevised) EventType() string { return pl.DecisionRecordRevised }
+
+func (e DecisionRecordRevised) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":           e.ID,
+		"title":        e.Title,
+		"context":      e.Context,
+		"options":      e.Options,
+		"decision":     e.Decision,
+		"consequences": e.Consequences,
+		"subjectIds":   e.SubjectIDs,
+		"revisedBy":    e.RevisedBy,
+		"occurredOn":   e.OccurredOn,
+	}
+}
@@ -0,0 +1,77 @@
+package events
+
+import (
+	"time"
+
+	pl "easi/backend/internal/decisionrecords/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type DecisionRecordSubjectLinked struct {
+	domain.BaseEvent
+	ID          string    `json:"id"`
+	SubjectType string    `json:"subjectType"`
+	SubjectID   string    `json:"subjectId"`
+	SubjectName string    `json:"subjectName"`
+	SubjectIDs  []string  `json:"subjectIds"`
+	LinkedBy    string    `json:"linkedBy"`
+	OccurredOn  time.Time `json:"occurredOn"`
+}
+
+func NewDecisionRecordSubjectLinked(id, subjectType, subjectID, subjectName, linkedBy string) DecisionRecordSubjectLinked {
+	return DecisionRecordSubjectLinked{
+		BaseEvent:   domain.NewBaseEvent(id),
+		ID:          id,
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+		SubjectName: subjectName,
+		SubjectIDs:  []string{subjectID},
+		LinkedBy:    linkedBy,
+		OccurredOn:  time.Now().UTC(),
+	}
+}
+func (e DecisionRecordSubjectLinked) EventType() string { return pl.DecisionRecordSubjectLinked }
+func (e DecisionRecordSubjectLinked) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          e.ID,
+		"subjectType": e.SubjectType,
+		"subjectId":   e.SubjectID,
+		"subjectName": e.SubjectName,
+		"subjectIds":  e.SubjectIDs,
+		"linkedBy":    e.LinkedBy,
+		"occurredOn":  e.OccurredOn,
+	}
+}
+
+type DecisionRecordSubjectUnlinked struct {
+	domain.BaseEvent
+	ID          string    `json:"id"`
+	SubjectType string    `json:"subjectType"`
+	SubjectID   string    `json:"subjectId"`
+	SubjectIDs  []string  `json:"subjectIds"`
+	UnlinkedBy  string    `json:"unlinkedBy"`
+	OccurredOn  time.Time `json:"occurredOn"`
+}
+
+func NewDecisionRecordSubjectUnlinked(id, subjectType, subjectID, unlinkedBy string) DecisionRecordSubjectUnlinked {
+	return DecisionRecordSubjectUnlinked{
+		BaseEvent:   domain.NewBaseEvent(id),
+		ID:          id,
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+		SubjectIDs:  []string{subjectID},
+		UnlinkedBy:  unlinkedBy,
+		OccurredOn:  time.Now().UTC(),
+	}
+}
+func (e DecisionRecordSubjectUnlinked) EventType() string { return pl.DecisionRecordSubjectUnlinked }
+func (e DecisionRecordSubjectUnlinked) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          e.ID,
+		"subjectType": e.SubjectType,
+		"subjectId":   e.SubjectID,
+		"subjectIds":  e.SubjectIDs,
+		"unlinkedBy":  e.UnlinkedBy,
+		"occurredOn":  e.OccurredOn,
+	}
+}
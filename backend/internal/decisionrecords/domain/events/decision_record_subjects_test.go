@@ -0,0 +1,26 @@
+package events
+
+import (
+	"testing"
+
+	pl "easi/backend/internal/decisionrecords/publishedlanguage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDecisionRecordSubjectLinked_ListsSubjectForAuditHistory(t *testing.T) {
+	evt := NewDecisionRecordSubjectLinked("adr-1", "capability", "cap-1", "Payments", "architect@example.com")
+
+	assert.Equal(t, pl.DecisionRecordSubjectLinked, evt.EventType())
+	data := evt.EventData()
+	assert.Equal(t, "cap-1", data["subjectId"])
+	assert.Equal(t, "Payments", data["subjectName"])
+	assert.Equal(t, []string{"cap-1"}, data["subjectIds"])
+}
+
+func TestNewDecisionRecordSubjectUnlinked_ListsSubjectForAuditHistory(t *testing.T) {
+	evt := NewDecisionRecordSubjectUnlinked("adr-1", "journey", "journey-1", "architect@example.com")
+
+	assert.Equal(t, pl.DecisionRecordSubjectUnlinked, evt.EventType())
+	assert.Equal(t, []string{"journey-1"}, evt.EventData()["subjectIds"])
+}
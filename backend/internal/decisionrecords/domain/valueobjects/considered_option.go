@@ -0,0 +1,53 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const (
+	MaxConsideredOptionNameLength        = 200
+	MaxConsideredOptionDescriptionLength = 2000
+	MaxConsideredOptions                 = 20
+)
+
+var (
+	ErrConsideredOptionNameRequired       = errors.New("considered option name is required")
+	ErrConsideredOptionNameTooLong        = errors.New("considered option name exceeds maximum length of 200 characters")
+	ErrConsideredOptionDescriptionTooLong = errors.New("considered option description exceeds maximum length of 2000 characters")
+	ErrTooManyConsideredOptions           = errors.New("a decision record can list at most 20 considered options")
+	ErrDuplicateConsideredOption          = errors.New("considered option names must be unique")
+)
+
+// ConsideredOption is one alternative weighed before the decision was taken.
+type ConsideredOption struct {
+	name        string
+	description string
+}
+
+func NewConsideredOption(name, description string) (ConsideredOption, error) {
+	trimmedName := strings.TrimSpace(name)
+	trimmedDescription := strings.TrimSpace(description)
+	if trimmedName == "" {
+		return ConsideredOption{}, ErrConsideredOptionNameRequired
+	}
+	if len(trimmedName) > MaxConsideredOptionNameLength {
+		return ConsideredOption{}, ErrConsideredOptionNameTooLong
+	}
+	if len(trimmedDescription) > MaxConsideredOptionDescriptionLength {
+		return ConsideredOption{}, ErrConsideredOptionDescriptionTooLong
+	}
+	return ConsideredOption{name: trimmedName, description: trimmedDescription}, nil
+}
+
+func (o ConsideredOption) Name() string        { return o.name }
+func (o ConsideredOption) Description() string { return o.description }
+
+func (o ConsideredOption) Equals(other domain.ValueObject) bool {
+	if other, ok := other.(ConsideredOption); ok {
+		return o == other
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const (
+	MaxDecisionTitleLength   = 200
+	MaxDecisionSectionLength = 8000
+)
+
+var (
+	ErrDecisionTitleRequired  = errors.New("decision record title is required")
+	ErrDecisionTitleTooLong   = errors.New("decision record title exceeds maximum length of 200 characters")
+	ErrDecisionSectionTooLong = errors.New("decision record sections cannot exceed 8000 characters")
+)
+
+type ConsideredOptionParams struct {
+	Name        string
+	Description string
+}
+
+type DecisionRecordContentParams struct {
+	Title        string
+	Context      string
+	Options      []ConsideredOptionParams
+	Decision     string
+	Consequences string
+}
+
+// DecisionRecordContent is the body of an architecture decision record: the
+// forces at play, the options weighed, the option chosen and what follows
+// from it. Only the title is required while the decision is being proposed.
+type DecisionRecordContent struct {
+	title        string
+	context      string
+	options      []ConsideredOption
+	decision     string
+	consequences string
+}
+
+func NewDecisionRecordContent(p DecisionRecordContentParams) (DecisionRecordContent, error) {
+	title := strings.TrimSpace(p.Title)
+	if title == "" {
+		return DecisionRecordContent{}, ErrDecisionTitleRequired
+	}
+	if len(title) > MaxDecisionTitleLength {
+		return DecisionRecordContent{}, ErrDecisionTitleTooLong
+	}
+	content := DecisionRecordContent{
+		title:        title,
+		context:      strings.TrimSpace(p.Context),
+		decision:     strings.TrimSpace(p.Decision),
+		consequences: strings.TrimSpace(p.Consequences),
+	}
+	for _, section := range []string{content.context, content.decision, content.consequences} {
+		if len(section) > MaxDecisionSectionLength {
+			return DecisionRecordContent{}, ErrDecisionSectionTooLong
+		}
+	}
+	options, err := newConsideredOptions(p.Options)
+	if err != nil {
+		return DecisionRecordContent{}, err
+	}
+	content.options = options
+	return content, nil
+}
+
+func newConsideredOptions(params []ConsideredOptionParams) ([]ConsideredOption, error) {
+	if len(params) > MaxConsideredOptions {
+		return nil, ErrTooManyConsideredOptions
+	}
+	options := make([]ConsideredOption, 0, len(params))
+	seen := make(map[string]bool, len(params))
+	for _, p := range params {
+		option, err := NewConsideredOption(p.Name, p.Description)
+		if err != nil {
+			return nil, err
+		}
+		key := strings.ToLower(option.Name())
+		if seen[key] {
+			return nil, ErrDuplicateConsideredOption
+		}
+		seen[key] = true
+		options = append(options, option)
+	}
+	return options, nil
+}
+
+func (c DecisionRecordContent) Title() string        { return c.title }
+func (c DecisionRecordContent) Context() string      { return c.context }
+func (c DecisionRecordContent) Decision() string     { return c.decision }
+func (c DecisionRecordContent) Consequences() string { return c.consequences }
+func (c DecisionRecordContent) HasDecision() bool    { return c.decision != "" }
+
+func (c DecisionRecordContent) Options() []ConsideredOption {
+	out := make([]ConsideredOption, len(c.options))
+	copy(out, c.options)
+	return out
+}
+
+func (c DecisionRecordContent) Equals(other domain.ValueObject) bool {
+	o, ok := other.(DecisionRecordContent)
+	if !ok || c.title != o.title || c.context != o.context || c.decision != o.decision || c.consequences != o.consequences {
+		return false
+	}
+	if len(c.options) != len(o.options) {
+		return false
+	}
+	for i := range c.options {
+		if c.options[i] != o.options[i] {
+			return false
+		}
+	}
+	return true
+}
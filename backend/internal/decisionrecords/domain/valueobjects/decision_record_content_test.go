@@ -0,0 +1,68 @@
+package valueobjects
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecisionRecordContent_TrimsSections(t *testing.T) {
+	content, err := NewDecisionRecordContent(DecisionRecordContentParams{
+		Title:        "  Use event streaming for order updates ",
+		Context:      " Batch files arrive a day late. ",
+		Options:      []ConsideredOptionParams{{Name: " Kafka ", Description: " Managed cluster "}, {Name: "Nightly batch"}},
+		Decision:     " Adopt Kafka. ",
+		Consequences: " Teams must run consumers. ",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Use event streaming for order updates", content.Title())
+	assert.Equal(t, "Batch files arrive a day late.", content.Context())
+	assert.Equal(t, "Adopt Kafka.", content.Decision())
+	assert.Equal(t, "Teams must run consumers.", content.Consequences())
+	require.Len(t, content.Options(), 2)
+	assert.Equal(t, "Kafka", content.Options()[0].Name())
+	assert.Equal(t, "Managed cluster", content.Options()[0].Description())
+	assert.True(t, content.HasDecision())
+}
+
+func TestNewDecisionRecordContent_OnlyTitleRequired(t *testing.T) {
+	content, err := NewDecisionRecordContent(DecisionRecordContentParams{Title: "Pick a CRM"})
+
+	require.NoError(t, err)
+	assert.False(t, content.HasDecision())
+	assert.Empty(t, content.Options())
+}
+
+func TestNewDecisionRecordContent_Invalid(t *testing.T) {
+	cases := []struct {
+		name   string
+		params DecisionRecordContentParams
+		want   error
+	}{
+		{"blank title", DecisionRecordContentParams{Title: "  "}, ErrDecisionTitleRequired},
+		{"long title", DecisionRecordContentParams{Title: strings.Repeat("t", MaxDecisionTitleLength+1)}, ErrDecisionTitleTooLong},
+		{"long context", DecisionRecordContentParams{Title: "t", Context: strings.Repeat("c", MaxDecisionSectionLength+1)}, ErrDecisionSectionTooLong},
+		{"unnamed option", DecisionRecordContentParams{Title: "t", Options: []ConsideredOptionParams{{Description: "x"}}}, ErrConsideredOptionNameRequired},
+		{"duplicate option", DecisionRecordContentParams{Title: "t", Options: []ConsideredOptionParams{{Name: "Kafka"}, {Name: "kafka"}}}, ErrDuplicateConsideredOption},
+		{"too many options", DecisionRecordContentParams{Title: "t", Options: make([]ConsideredOptionParams, MaxConsideredOptions+1)}, ErrTooManyConsideredOptions},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewDecisionRecordContent(tc.params)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestDecisionRecordContent_Equals(t *testing.T) {
+	params := DecisionRecordContentParams{Title: "Pick a CRM", Options: []ConsideredOptionParams{{Name: "Build"}}}
+	a, _ := NewDecisionRecordContent(params)
+	b, _ := NewDecisionRecordContent(params)
+	params.Options[0].Name = "Buy"
+	c, _ := NewDecisionRecordContent(params)
+	assert.True(t, a.Equals(b))
+	assert.False(t, a.Equals(c))
+}
@@ -0,0 +1,41 @@
+package valueobjects
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+
+	"github.com/google/uuid"
+)
+
+var agreedDirectionNamespace = uuid.MustParse("6f1c2a4e-8b7d-4e3a-9c5f-2d8e1b7a4c60")
+
+type DecisionRecordID struct {
+	sharedvo.UUIDValue
+}
+
+func NewDecisionRecordID() DecisionRecordID {
+	return DecisionRecordID{UUIDValue: sharedvo.NewUUIDValue()}
+}
+
+// NewDecisionRecordIDForDirection derives the ID of the record an agreed
+// direction produces, so that the direction is only ever recorded once.
+func NewDecisionRecordIDForDirection(directionID string) DecisionRecordID {
+	deterministicID := uuid.NewSHA1(agreedDirectionNamespace, []byte(directionID))
+	uuidValue, _ := sharedvo.NewUUIDValueFromString(deterministicID.String())
+	return DecisionRecordID{UUIDValue: uuidValue}
+}
+
+func NewDecisionRecordIDFromString(value string) (DecisionRecordID, error) {
+	uuidValue, err := sharedvo.NewUUIDValueFromString(value)
+	if err != nil {
+		return DecisionRecordID{}, err
+	}
+	return DecisionRecordID{UUIDValue: uuidValue}, nil
+}
+
+func (i DecisionRecordID) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(DecisionRecordID); ok {
+		return i.EqualsValue(o.UUIDValue)
+	}
+	return false
+}
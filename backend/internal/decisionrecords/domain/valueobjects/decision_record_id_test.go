@@ -0,0 +1,48 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecisionRecordID_GeneratesUniqueValue(t *testing.T) {
+	a := NewDecisionRecordID()
+	b := NewDecisionRecordID()
+	assert.NotEmpty(t, a.Value())
+	assert.NotEqual(t, a.Value(), b.Value())
+}
+
+func TestNewDecisionRecordIDFromString_Valid(t *testing.T) {
+	id := uuid.New().String()
+	recordID, err := NewDecisionRecordIDFromString(id)
+	require.NoError(t, err)
+	assert.Equal(t, id, recordID.Value())
+}
+
+func TestNewDecisionRecordIDFromString_Invalid(t *testing.T) {
+	_, err := NewDecisionRecordIDFromString("not-a-uuid")
+	assert.Error(t, err)
+}
+
+func TestDecisionRecordID_Equals(t *testing.T) {
+	id := uuid.New().String()
+	a, _ := NewDecisionRecordIDFromString(id)
+	b, _ := NewDecisionRecordIDFromString(id)
+	c := NewDecisionRecordID()
+	assert.True(t, a.Equals(b))
+	assert.False(t, a.Equals(c))
+}
+
+func TestNewDecisionRecordIDForDirection_IsStablePerDirection(t *testing.T) {
+	directionID := uuid.New().String()
+	first := NewDecisionRecordIDForDirection(directionID)
+	again := NewDecisionRecordIDForDirection(directionID)
+	other := NewDecisionRecordIDForDirection(uuid.New().String())
+	assert.True(t, first.Equals(again))
+	assert.False(t, first.Equals(other))
+	_, err := NewDecisionRecordIDFromString(first.Value())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,49 @@
+package valueobjects
+
+import (
+	"errors"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var ErrInvalidDecisionRecordStatus = errors.New("decision record status must be one of proposed, accepted, superseded, deprecated")
+
+const (
+	DecisionRecordStatusProposed   = "proposed"
+	DecisionRecordStatusAccepted   = "accepted"
+	DecisionRecordStatusSuperseded = "superseded"
+	DecisionRecordStatusDeprecated = "deprecated"
+)
+
+type DecisionRecordStatus struct {
+	value string
+}
+
+func NewDecisionRecordStatus(value string) (DecisionRecordStatus, error) {
+	switch value {
+	case DecisionRecordStatusProposed, DecisionRecordStatusAccepted, DecisionRecordStatusSuperseded, DecisionRecordStatusDeprecated:
+		return DecisionRecordStatus{value: value}, nil
+	default:
+		return DecisionRecordStatus{}, ErrInvalidDecisionRecordStatus
+	}
+}
+
+func (s DecisionRecordStatus) Value() string { return s.value }
+
+func (s DecisionRecordStatus) IsProposed() bool   { return s.value == DecisionRecordStatusProposed }
+func (s DecisionRecordStatus) IsAccepted() bool   { return s.value == DecisionRecordStatusAccepted }
+func (s DecisionRecordStatus) IsSuperseded() bool { return s.value == DecisionRecordStatusSuperseded }
+func (s DecisionRecordStatus) IsDeprecated() bool { return s.value == DecisionRecordStatusDeprecated }
+
+// IsRetired reports whether the decision no longer applies: it was either
+// replaced by a later record or withdrawn.
+func (s DecisionRecordStatus) IsRetired() bool {
+	return s.IsSuperseded() || s.IsDeprecated()
+}
+
+func (s DecisionRecordStatus) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(DecisionRecordStatus); ok {
+		return s.value == o.value
+	}
+	return false
+}
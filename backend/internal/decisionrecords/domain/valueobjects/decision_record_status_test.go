@@ -0,0 +1,34 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecisionRecordStatus_AllValid(t *testing.T) {
+	for _, v := range []string{"proposed", "accepted", "superseded", "deprecated"} {
+		s, err := NewDecisionRecordStatus(v)
+		require.NoError(t, err)
+		assert.Equal(t, v, s.Value())
+	}
+}
+
+func TestNewDecisionRecordStatus_Invalid(t *testing.T) {
+	_, err := NewDecisionRecordStatus("rejected")
+	assert.ErrorIs(t, err, ErrInvalidDecisionRecordStatus)
+}
+
+func TestDecisionRecordStatus_IsRetired(t *testing.T) {
+	cases := map[string]bool{
+		"proposed":   false,
+		"accepted":   false,
+		"superseded": true,
+		"deprecated": true,
+	}
+	for v, retired := range cases {
+		s, _ := NewDecisionRecordStatus(v)
+		assert.Equal(t, retired, s.IsRetired(), v)
+	}
+}
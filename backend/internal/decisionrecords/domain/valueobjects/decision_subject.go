@@ -0,0 +1,50 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var (
+	ErrInvalidDecisionSubjectType = errors.New("decision subject type must be one of capability, enterprise-capability, component, direction, journey")
+	ErrDecisionSubjectIDRequired  = errors.New("decision subject id is required")
+)
+
+const (
+	SubjectTypeCapability           = "capability"
+	SubjectTypeEnterpriseCapability = "enterprise-capability"
+	SubjectTypeComponent            = "component"
+	SubjectTypeDirection            = "direction"
+	SubjectTypeJourney              = "journey"
+)
+
+// DecisionSubject is an architecture element a decision record applies to.
+type DecisionSubject struct {
+	subjectType string
+	id          string
+}
+
+func NewDecisionSubject(subjectType, id string) (DecisionSubject, error) {
+	switch subjectType {
+	case SubjectTypeCapability, SubjectTypeEnterpriseCapability, SubjectTypeComponent, SubjectTypeDirection, SubjectTypeJourney:
+	default:
+		return DecisionSubject{}, ErrInvalidDecisionSubjectType
+	}
+	trimmedID := strings.TrimSpace(id)
+	if trimmedID == "" {
+		return DecisionSubject{}, ErrDecisionSubjectIDRequired
+	}
+	return DecisionSubject{subjectType: subjectType, id: trimmedID}, nil
+}
+
+func (s DecisionSubject) Type() string { return s.subjectType }
+func (s DecisionSubject) ID() string   { return s.id }
+
+func (s DecisionSubject) Equals(other domain.ValueObject) bool {
+	if o, ok := other.(DecisionSubject); ok {
+		return s == o
+	}
+	return false
+}
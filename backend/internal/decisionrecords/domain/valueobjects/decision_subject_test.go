@@ -0,0 +1,35 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecisionSubject_AllTypesValid(t *testing.T) {
+	for _, v := range []string{"capability", "enterprise-capability", "component", "direction", "journey"} {
+		s, err := NewDecisionSubject(v, " subject-1 ")
+		require.NoError(t, err)
+		assert.Equal(t, v, s.Type())
+		assert.Equal(t, "subject-1", s.ID())
+	}
+}
+
+func TestNewDecisionSubject_InvalidType(t *testing.T) {
+	_, err := NewDecisionSubject("vendor", "subject-1")
+	assert.ErrorIs(t, err, ErrInvalidDecisionSubjectType)
+}
+
+func TestNewDecisionSubject_BlankID(t *testing.T) {
+	_, err := NewDecisionSubject("capability", "  ")
+	assert.ErrorIs(t, err, ErrDecisionSubjectIDRequired)
+}
+
+func TestDecisionSubject_Equals(t *testing.T) {
+	a, _ := NewDecisionSubject("capability", "cap-1")
+	b, _ := NewDecisionSubject("capability", "cap-1")
+	c, _ := NewDecisionSubject("component", "cap-1")
+	assert.True(t, a.Equals(b))
+	assert.False(t, a.Equals(c))
+}
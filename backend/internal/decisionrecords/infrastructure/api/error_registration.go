@@ -0,0 +1,36 @@
+package api
+
+import (
+	"easi/backend/internal/decisionrecords/application/handlers"
+	"easi/backend/internal/decisionrecords/domain/aggregates"
+	"easi/backend/internal/decisionrecords/domain/valueobjects"
+	"easi/backend/internal/decisionrecords/infrastructure/repositories"
+	sharedAPI "easi/backend/internal/shared/api"
+)
+
+func init() {
+	registry := sharedAPI.GetErrorRegistry()
+
+	registry.RegisterNotFound(repositories.ErrDecisionRecordNotFound, "Decision record not found")
+	registry.RegisterNotFound(handlers.ErrSubjectNotFound, "The architecture element does not exist or is not accessible")
+	registry.RegisterNotFound(aggregates.ErrSubjectNotLinked, "This decision record is not linked to that architecture element")
+
+	registry.RegisterConflict(aggregates.ErrDecisionRecordNotProposed, "Only proposed decision records can be revised or accepted; supersede an accepted record to change it")
+	registry.RegisterConflict(aggregates.ErrDecisionRecordNotAccepted, "Only accepted decision records can be superseded")
+	registry.RegisterConflict(aggregates.ErrDecisionRecordRetired, "This decision record is already superseded or deprecated")
+	registry.RegisterConflict(aggregates.ErrSubjectAlreadyLinked, "This decision record is already linked to that architecture element")
+
+	registry.RegisterValidation(aggregates.ErrDecisionRequiredToAccept, "A decision record needs a decision before it can be accepted")
+	registry.RegisterValidation(aggregates.ErrCannotSupersedeItself, "A decision record cannot supersede itself")
+	registry.RegisterValidation(valueobjects.ErrDecisionTitleRequired, "Title is required")
+	registry.RegisterValidation(valueobjects.ErrDecisionTitleTooLong, "Title cannot exceed 200 characters")
+	registry.RegisterValidation(valueobjects.ErrDecisionSectionTooLong, "Context, decision and consequences cannot exceed 8000 characters each")
+	registry.RegisterValidation(valueobjects.ErrConsideredOptionNameRequired, "Every considered option needs a name")
+	registry.RegisterValidation(valueobjects.ErrConsideredOptionNameTooLong, "Considered option names cannot exceed 200 characters")
+	registry.RegisterValidation(valueobjects.ErrConsideredOptionDescriptionTooLong, "Considered option descriptions cannot exceed 2000 characters")
+	registry.RegisterValidation(valueobjects.ErrTooManyConsideredOptions, "A decision record can list at most 20 considered options")
+	registry.RegisterValidation(valueobjects.ErrDuplicateConsideredOption, "Considered option names must be unique")
+	registry.RegisterValidation(valueobjects.ErrInvalidDecisionSubjectType, "Subject type must be one of capability, enterprise-capability, component, direction, journey")
+	registry.RegisterValidation(valueobjects.ErrDecisionSubjectIDRequired, "Subject id is required")
+	registry.RegisterValidation(ErrUnknownDecisionRecordFormat, "Format must be one of json, markdown")
+}
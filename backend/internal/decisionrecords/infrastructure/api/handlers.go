@@ -0,0 +1,411 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"easi/backend/internal/decisionrecords/application/commands"
+	"easi/backend/internal/decisionrecords/application/readmodels"
+	"easi/backend/internal/decisionrecords/infrastructure/repositories"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+)
+
+var (
+	ErrUnknownDecisionRecordFormat      = errors.New("decision record format must be one of json, markdown")
+	errDecisionRecordMissingAfterChange = errors.New("decision record not found after mutation")
+)
+
+type DecisionRecordQueries interface {
+	GetAll(ctx context.Context, filter readmodels.DecisionRecordFilter) ([]readmodels.DecisionRecordDTO, error)
+	GetByID(ctx context.Context, id string) (*readmodels.DecisionRecordDTO, error)
+}
+
+type DecisionRecordHandlers struct {
+	commandBus cqrs.CommandBus
+	queries    DecisionRecordQueries
+	hateoas    *DecisionRecordLinks
+	now        func() time.Time
+}
+
+func NewDecisionRecordHandlers(commandBus cqrs.CommandBus, queries DecisionRecordQueries, hateoas *DecisionRecordLinks) *DecisionRecordHandlers {
+	return &DecisionRecordHandlers{commandBus: commandBus, queries: queries, hateoas: hateoas, now: time.Now}
+}
+
+type ConsideredOptionRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type SubjectRequest struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type DecisionRecordContentRequest struct {
+	Title        string                    `json:"title"`
+	Context      string                    `json:"context,omitempty"`
+	Options      []ConsideredOptionRequest `json:"options,omitempty"`
+	Decision     string                    `json:"decision,omitempty"`
+	Consequences string                    `json:"consequences,omitempty"`
+}
+
+type ProposeDecisionRecordRequest struct {
+	DecisionRecordContentRequest
+	SupersedesID string           `json:"supersedesId,omitempty"`
+	Subjects     []SubjectRequest `json:"subjects,omitempty"`
+}
+
+type DeprecateDecisionRecordRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// GetDecisionRecords godoc
+// @Summary List architecture decision records
+// @Description Returns the decision log in ADR number order. Filter by status or by the architecture element a record applies to. Use format=markdown to download the log as a single Markdown document.
+// @Tags decision-records
+// @Produce json
+// @Produce text/markdown
+// @Security CookieAuth
+// @Param status query string false "Only records in this status" Enums(proposed, accepted, superseded, deprecated)
+// @Param subjectId query string false "Only records linked to this capability, enterprise capability, component, direction or journey"
+// @Param format query string false "Response format" Enums(json, markdown)
+// @Success 200 {object} sharedAPI.CollectionResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /decision-records [get]
+func (h *DecisionRecordHandlers) GetDecisionRecords(w http.ResponseWriter, r *http.Request) {
+	markdown, ok := wantsMarkdown(w, r)
+	if !ok {
+		return
+	}
+	params := r.URL.Query()
+	records, ok := fetchOrFail(w, r, func(ctx context.Context) ([]readmodels.DecisionRecordDTO, error) {
+		return h.queries.GetAll(ctx, readmodels.DecisionRecordFilter{Status: params.Get("status"), SubjectID: params.Get("subjectId")})
+	})
+	if !ok {
+		return
+	}
+	if markdown {
+		h.writeMarkdown(w, "decision-log.md", func(buf *bytes.Buffer) error {
+			return writeDecisionLogMarkdown(buf, records, h.now())
+		})
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	for i := range records {
+		h.decorate(&records[i], actor)
+	}
+	sharedAPI.RespondCollection(w, http.StatusOK, records, h.hateoas.CollectionLinks(actor))
+}
+
+// GetDecisionRecord godoc
+// @Summary Get an architecture decision record
+// @Tags decision-records
+// @Produce json
+// @Produce text/markdown
+// @Security CookieAuth
+// @Param recordId path string true "Decision record ID"
+// @Param format query string false "Response format" Enums(json, markdown)
+// @Success 200 {object} readmodels.DecisionRecordDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /decision-records/{recordId} [get]
+func (h *DecisionRecordHandlers) GetDecisionRecord(w http.ResponseWriter, r *http.Request) {
+	markdown, ok := wantsMarkdown(w, r)
+	if !ok {
+		return
+	}
+	record, ok := fetchOrFail(w, r, func(ctx context.Context) (*readmodels.DecisionRecordDTO, error) {
+		return h.queries.GetByID(ctx, sharedAPI.GetPathParam(r, "recordId"))
+	})
+	if !ok {
+		return
+	}
+	if record == nil {
+		sharedAPI.HandleError(w, repositories.ErrDecisionRecordNotFound)
+		return
+	}
+	if markdown {
+		h.writeMarkdown(w, strings.ToLower(record.Key)+".md", func(buf *bytes.Buffer) error {
+			return writeDecisionRecordMarkdown(buf, *record)
+		})
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.decorate(record, actor)
+	sharedAPI.RespondJSON(w, http.StatusOK, record)
+}
+
+// ProposeDecisionRecord godoc
+// @Summary Propose an architecture decision record
+// @Description Opens a record in proposed status and assigns the next ADR number. Set supersedesId to replace an accepted record; it is marked superseded when this one is accepted.
+// @Tags decision-records
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param body body ProposeDecisionRecordRequest true "Decision record"
+// @Success 201 {object} readmodels.DecisionRecordDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /decision-records [post]
+func (h *DecisionRecordHandlers) ProposeDecisionRecord(w http.ResponseWriter, r *http.Request) {
+	req, ok := sharedAPI.DecodeRequestOrFail[ProposeDecisionRecordRequest](w, r)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	subjects := make([]commands.SubjectRef, len(req.Subjects))
+	for i, s := range req.Subjects {
+		subjects[i] = commands.SubjectRef{Type: s.Type, ID: s.ID}
+	}
+	result, err := h.commandBus.Dispatch(r.Context(), &commands.ProposeDecisionRecord{
+		Title:        req.Title,
+		Context:      req.Context,
+		Options:      toCommandOptions(req.Options),
+		Decision:     req.Decision,
+		Consequences: req.Consequences,
+		SupersedesID: req.SupersedesID,
+		Subjects:     subjects,
+		Actor:        actor.Email,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	h.respondWithRecord(w, r, result.CreatedID, http.StatusCreated)
+}
+
+// ReviseDecisionRecord godoc
+// @Summary Revise a proposed decision record
+// @Description Replaces the title, context, options, decision and consequences. Only proposed records can be revised; accepted decisions are changed by superseding them.
+// @Tags decision-records
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param recordId path string true "Decision record ID"
+// @Param body body DecisionRecordContentRequest true "Decision record content"
+// @Success 200 {object} readmodels.DecisionRecordDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /decision-records/{recordId} [put]
+func (h *DecisionRecordHandlers) ReviseDecisionRecord(w http.ResponseWriter, r *http.Request) {
+	recordID := sharedAPI.GetPathParam(r, "recordId")
+	req, ok := sharedAPI.DecodeRequestOrFail[DecisionRecordContentRequest](w, r)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.dispatchAndRespond(w, r, recordID, http.StatusOK, &commands.ReviseDecisionRecord{
+		RecordID:     recordID,
+		Title:        req.Title,
+		Context:      req.Context,
+		Options:      toCommandOptions(req.Options),
+		Decision:     req.Decision,
+		Consequences: req.Consequences,
+		Actor:        actor.Email,
+	})
+}
+
+// AcceptDecisionRecord godoc
+// @Summary Accept a proposed decision record
+// @Description Accepts the record; the record it supersedes, if any, becomes superseded.
+// @Tags decision-records
+// @Produce json
+// @Security CookieAuth
+// @Param recordId path string true "Decision record ID"
+// @Success 200 {object} readmodels.DecisionRecordDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /decision-records/{recordId}/accept [post]
+func (h *DecisionRecordHandlers) AcceptDecisionRecord(w http.ResponseWriter, r *http.Request) {
+	recordID := sharedAPI.GetPathParam(r, "recordId")
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.dispatchAndRespond(w, r, recordID, http.StatusOK, &commands.AcceptDecisionRecord{RecordID: recordID, Actor: actor.Email})
+}
+
+// DeprecateDecisionRecord godoc
+// @Summary Deprecate a decision record
+// @Description Withdraws a proposal or retires an accepted decision that no longer applies without replacing it.
+// @Tags decision-records
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param recordId path string true "Decision record ID"
+// @Param body body DeprecateDecisionRecordRequest true "Reason"
+// @Success 200 {object} readmodels.DecisionRecordDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /decision-records/{recordId}/deprecate [post]
+func (h *DecisionRecordHandlers) DeprecateDecisionRecord(w http.ResponseWriter, r *http.Request) {
+	recordID := sharedAPI.GetPathParam(r, "recordId")
+	req, ok := sharedAPI.DecodeRequestOrFail[DeprecateDecisionRecordRequest](w, r)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.dispatchAndRespond(w, r, recordID, http.StatusOK, &commands.DeprecateDecisionRecord{
+		RecordID: recordID, Reason: req.Reason, Actor: actor.Email,
+	})
+}
+
+// LinkDecisionRecordSubject godoc
+// @Summary Link a decision record to an architecture element
+// @Description The record then shows on the element's one-pager and in its audit history.
+// @Tags decision-records
+// @Accept json
+// @Produce json
+// @Security CookieAuth
+// @Param recordId path string true "Decision record ID"
+// @Param body body SubjectRequest true "Subject (type is one of capability, enterprise-capability, component, direction, journey)"
+// @Success 201 {object} readmodels.DecisionRecordDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /decision-records/{recordId}/subjects [post]
+func (h *DecisionRecordHandlers) LinkDecisionRecordSubject(w http.ResponseWriter, r *http.Request) {
+	recordID := sharedAPI.GetPathParam(r, "recordId")
+	req, ok := sharedAPI.DecodeRequestOrFail[SubjectRequest](w, r)
+	if !ok {
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.dispatchAndRespond(w, r, recordID, http.StatusCreated, &commands.LinkDecisionRecordSubject{
+		RecordID: recordID, SubjectType: req.Type, SubjectID: req.ID, Actor: actor.Email,
+	})
+}
+
+// UnlinkDecisionRecordSubject godoc
+// @Summary Unlink a decision record from an architecture element
+// @Tags decision-records
+// @Security CookieAuth
+// @Param recordId path string true "Decision record ID"
+// @Param subjectType path string true "Subject type"
+// @Param subjectId path string true "Subject ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 403 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /decision-records/{recordId}/subjects/{subjectType}/{subjectId} [delete]
+func (h *DecisionRecordHandlers) UnlinkDecisionRecordSubject(w http.ResponseWriter, r *http.Request) {
+	actor, _ := sharedctx.GetActor(r.Context())
+	_, err := h.commandBus.Dispatch(r.Context(), &commands.UnlinkDecisionRecordSubject{
+		RecordID:    sharedAPI.GetPathParam(r, "recordId"),
+		SubjectType: sharedAPI.GetPathParam(r, "subjectType"),
+		SubjectID:   sharedAPI.GetPathParam(r, "subjectId"),
+		Actor:       actor.Email,
+	})
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	sharedAPI.RespondNoContent(w)
+}
+
+func (h *DecisionRecordHandlers) dispatchAndRespond(w http.ResponseWriter, r *http.Request, recordID string, statusCode int, cmd cqrs.Command) {
+	if _, err := h.commandBus.Dispatch(r.Context(), cmd); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	h.respondWithRecord(w, r, recordID, statusCode)
+}
+
+func (h *DecisionRecordHandlers) respondWithRecord(w http.ResponseWriter, r *http.Request, recordID string, statusCode int) {
+	record, err := h.queries.GetByID(r.Context(), recordID)
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	if record == nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, errDecisionRecordMissingAfterChange, "failed to load decision record after mutation")
+		return
+	}
+	actor, _ := sharedctx.GetActor(r.Context())
+	h.decorate(record, actor)
+	if statusCode == http.StatusCreated {
+		sharedAPI.RespondCreated(w, sharedAPI.BuildResourceLink(decisionRecordsPath, sharedAPI.ResourceID(recordID)), record)
+		return
+	}
+	sharedAPI.RespondJSON(w, statusCode, record)
+}
+
+func (h *DecisionRecordHandlers) decorate(record *readmodels.DecisionRecordDTO, actor sharedctx.Actor) {
+	record.Links = h.hateoas.ItemLinks(*record, actor)
+	for i := range record.Subjects {
+		record.Subjects[i].Links = h.hateoas.SubjectLinks(record.ID, record.Subjects[i], actor)
+	}
+}
+
+// writeMarkdown renders into a buffer first so a rendering failure can still
+// be reported as an error response rather than a truncated download.
+func (h *DecisionRecordHandlers) writeMarkdown(w http.ResponseWriter, filename string, render func(*bytes.Buffer) error) {
+	var body bytes.Buffer
+	if err := render(&body); err != nil {
+		sharedAPI.HandleError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", markdownContentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body.Bytes())
+}
+
+func wantsMarkdown(w http.ResponseWriter, r *http.Request) (bool, bool) {
+	switch strings.ToLower(r.URL.Query().Get("format")) {
+	case "", "json":
+		return false, true
+	case "markdown":
+		return true, true
+	}
+	sharedAPI.HandleError(w, ErrUnknownDecisionRecordFormat)
+	return false, false
+}
+
+func toCommandOptions(in []ConsideredOptionRequest) []commands.ConsideredOption {
+	out := make([]commands.ConsideredOption, len(in))
+	for i, o := range in {
+		out[i] = commands.ConsideredOption{Name: o.Name, Description: o.Description}
+	}
+	return out
+}
+
+func fetchOrFail[T any](w http.ResponseWriter, r *http.Request, fetch func(ctx context.Context) (T, error)) (T, bool) {
+	result, err := fetch(r.Context())
+	if err != nil {
+		sharedAPI.HandleError(w, err)
+		var zero T
+		return zero, false
+	}
+	return result, true
+}
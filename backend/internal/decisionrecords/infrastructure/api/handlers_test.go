@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"easi/backend/internal/decisionrecords/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubDecisionRecordQueries struct {
+	records []readmodels.DecisionRecordDTO
+	filter  readmodels.DecisionRecordFilter
+}
+
+func (s *stubDecisionRecordQueries) GetAll(_ context.Context, filter readmodels.DecisionRecordFilter) ([]readmodels.DecisionRecordDTO, error) {
+	s.filter = filter
+	return s.records, nil
+}
+
+func (s *stubDecisionRecordQueries) GetByID(_ context.Context, id string) (*readmodels.DecisionRecordDTO, error) {
+	for i := range s.records {
+		if s.records[i].ID == id {
+			return &s.records[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func decisionRecordRouter(queries DecisionRecordQueries) chi.Router {
+	h := NewDecisionRecordHandlers(nil, queries, NewDecisionRecordLinks(sharedAPI.NewHATEOASLinks("")))
+	r := chi.NewRouter()
+	r.Get("/decision-records", h.GetDecisionRecords)
+	r.Get("/decision-records/{recordId}", h.GetDecisionRecord)
+	return r
+}
+
+func serve(r chi.Router, target string, actor sharedctx.Actor) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req.WithContext(sharedctx.WithActor(req.Context(), actor)))
+	return rec
+}
+
+func architectActor() sharedctx.Actor {
+	return sharedctx.NewActor("u1", "user@example.com", sharedctx.RoleArchitect)
+}
+
+func stakeholderActor() sharedctx.Actor {
+	return sharedctx.NewActor("u2", "stake@example.com", sharedctx.RoleStakeholder)
+}
+
+func TestGetDecisionRecords_PassesFiltersAndDecoratesLinks(t *testing.T) {
+	queries := &stubDecisionRecordQueries{records: supersessionPair()}
+
+	rec := serve(decisionRecordRouter(queries), "/decision-records?status=proposed&subjectId=cap-1", architectActor())
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, readmodels.DecisionRecordFilter{Status: "proposed", SubjectID: "cap-1"}, queries.filter)
+	var body struct {
+		Data  []readmodels.DecisionRecordDTO `json:"data"`
+		Links sharedAPI.Links                `json:"_links"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	require.Len(t, body.Data, 2)
+	assert.True(t, strings.HasSuffix(body.Data[0].Links["x-superseded-by"].Href, "/decision-records/r-2"))
+	assert.NotContains(t, body.Data[0].Links, "x-accept", "a superseded record has no lifecycle actions")
+	assert.True(t, strings.HasSuffix(body.Data[0].Subjects[0].Links["x-unlink"].Href, "/decision-records/r-1/subjects/capability/cap-1"))
+	assert.True(t, strings.HasSuffix(body.Data[1].Links["x-accept"].Href, "/decision-records/r-2/accept"))
+	assert.Contains(t, body.Links, "create")
+}
+
+func TestGetDecisionRecords_ReadOnlyActorSeesNoActions(t *testing.T) {
+	rec := serve(decisionRecordRouter(&stubDecisionRecordQueries{records: supersessionPair()}), "/decision-records", stakeholderActor())
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body struct {
+		Data  []readmodels.DecisionRecordDTO `json:"data"`
+		Links sharedAPI.Links                `json:"_links"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.NotContains(t, body.Data[1].Links, "edit")
+	assert.NotContains(t, body.Data[1].Links, "x-accept")
+	assert.NotContains(t, body.Links, "create")
+	assert.Contains(t, body.Links, "x-markdown")
+}
+
+func TestGetDecisionRecords_MarkdownDownload(t *testing.T) {
+	rec := serve(decisionRecordRouter(&stubDecisionRecordQueries{records: supersessionPair()}), "/decision-records?format=markdown", stakeholderActor())
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/markdown; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="decision-log.md"`, rec.Header().Get("Content-Disposition"))
+	assert.Contains(t, rec.Body.String(), "## ADR-002: Split ledger | per region")
+}
+
+func TestGetDecisionRecord_MarkdownUsesRecordKeyAsFilename(t *testing.T) {
+	rec := serve(decisionRecordRouter(&stubDecisionRecordQueries{records: supersessionPair()}), "/decision-records/r-1?format=markdown", stakeholderActor())
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `attachment; filename="adr-001.md"`, rec.Header().Get("Content-Disposition"))
+	assert.True(t, strings.HasPrefix(rec.Body.String(), "<a id=\"adr-001\"></a>\n\n# ADR-001: Use one ledger"))
+}
+
+func TestGetDecisionRecord_UnknownFormatIsRejected(t *testing.T) {
+	rec := serve(decisionRecordRouter(&stubDecisionRecordQueries{records: supersessionPair()}), "/decision-records/r-1?format=pdf", stakeholderActor())
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetDecisionRecord_NotFound(t *testing.T) {
+	rec := serve(decisionRecordRouter(&stubDecisionRecordQueries{}), "/decision-records/missing", stakeholderActor())
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"easi/backend/internal/decisionrecords/application/readmodels"
+	"easi/backend/internal/decisionrecords/domain/valueobjects"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+)
+
+// DecisionRecordResource shares the architecture direction permission: the
+// people who shape target architecture are the ones who record its decisions.
+const DecisionRecordResource sharedctx.ResourceName = "architecture-direction"
+
+const (
+	decisionRecordsPath sharedAPI.ResourcePath = "/decision-records"
+	markdownFormatQuery                        = "?format=markdown"
+)
+
+type DecisionRecordLinks struct {
+	*sharedAPI.HATEOASLinks
+}
+
+func NewDecisionRecordLinks(h *sharedAPI.HATEOASLinks) *DecisionRecordLinks {
+	return &DecisionRecordLinks{HATEOASLinks: h}
+}
+
+func (h *DecisionRecordLinks) ItemLinks(record readmodels.DecisionRecordDTO, actor sharedctx.Actor) sharedAPI.Links {
+	base := decisionRecordResourcePath(record.ID)
+	links := sharedAPI.Links{
+		"self":       h.Get(base),
+		"x-markdown": h.Get(base + markdownFormatQuery),
+	}
+	if record.Supersedes != nil {
+		links["x-supersedes"] = h.Get(decisionRecordResourcePath(record.Supersedes.ID))
+	}
+	if record.SupersededBy != nil {
+		links["x-superseded-by"] = h.Get(decisionRecordResourcePath(record.SupersededBy.ID))
+	}
+	if !actor.CanWrite(DecisionRecordResource) {
+		return links
+	}
+	switch record.Status {
+	case valueobjects.DecisionRecordStatusProposed:
+		links["edit"] = h.Put(base)
+		links["x-accept"] = h.Post(base + "/accept")
+		links["x-deprecate"] = h.Post(base + "/deprecate")
+	case valueobjects.DecisionRecordStatusAccepted:
+		links["x-deprecate"] = h.Post(base + "/deprecate")
+		links["x-supersede"] = h.Post(string(decisionRecordsPath))
+	}
+	links["x-link-subject"] = h.Post(base + "/subjects")
+	return links
+}
+
+func (h *DecisionRecordLinks) SubjectLinks(recordID string, subject readmodels.DecisionSubjectDTO, actor sharedctx.Actor) sharedAPI.Links {
+	if !actor.CanWrite(DecisionRecordResource) {
+		return nil
+	}
+	return sharedAPI.Links{
+		"x-unlink": h.Del(decisionRecordResourcePath(recordID) + "/subjects/" + subject.Type + "/" + subject.ID),
+	}
+}
+
+func (h *DecisionRecordLinks) CollectionLinks(actor sharedctx.Actor) sharedAPI.Links {
+	links := sharedAPI.Links{
+		"self":       h.Get(string(decisionRecordsPath)),
+		"x-markdown": h.Get(string(decisionRecordsPath) + markdownFormatQuery),
+	}
+	if actor.CanWrite(DecisionRecordResource) {
+		links["create"] = h.Post(string(decisionRecordsPath))
+	}
+	return links
+}
+
+func decisionRecordResourcePath(recordID string) string {
+	return string(decisionRecordsPath) + "/" + recordID
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"easi/backend/internal/decisionrecords/application/readmodels"
+	"easi/backend/internal/decisionrecords/domain/valueobjects"
+)
+
+const (
+	decisionLogTitle       = "Architecture decision log"
+	markdownTimestampStyle = "2006-01-02 15:04 UTC"
+	markdownDateStyle      = "2006-01-02"
+	markdownContentType    = "text/markdown; charset=utf-8"
+)
+
+var subjectTypeLabels = map[string]string{
+	valueobjects.SubjectTypeCapability:           "Capability",
+	valueobjects.SubjectTypeEnterpriseCapability: "Enterprise capability",
+	valueobjects.SubjectTypeComponent:            "Application",
+	valueobjects.SubjectTypeDirection:            "Direction",
+	valueobjects.SubjectTypeJourney:              "Journey",
+}
+
+// writeDecisionLogMarkdown renders the log as a single document: an index
+// table followed by one section per record, each with an anchor so the index
+// and the supersession lines can link within the file.
+func writeDecisionLogMarkdown(w io.Writer, records []readmodels.DecisionRecordDTO, generatedAt time.Time) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", decisionLogTitle)
+	fmt.Fprintf(&b, "_Generated %s. %d decision records._\n\n", generatedAt.UTC().Format(markdownTimestampStyle), len(records))
+	if len(records) > 0 {
+		b.WriteString("| ADR | Title | Status | Decided |\n")
+		b.WriteString("| --- | --- | --- | --- |\n")
+		for _, r := range records {
+			fmt.Fprintf(&b, "| [%s](#%s) | %s | %s | %s |\n",
+				r.Key, recordAnchor(r.Key), tableCell(r.Title), r.Status, decidedOn(r))
+		}
+	}
+	for _, r := range records {
+		b.WriteString("\n")
+		writeRecordSection(&b, r, "##")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeDecisionRecordMarkdown renders one record as a standalone document.
+func writeDecisionRecordMarkdown(w io.Writer, record readmodels.DecisionRecordDTO) error {
+	var b strings.Builder
+	writeRecordSection(&b, record, "#")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeRecordSection(b *strings.Builder, r readmodels.DecisionRecordDTO, heading string) {
+	fmt.Fprintf(b, "<a id=\"%s\"></a>\n\n", recordAnchor(r.Key))
+	fmt.Fprintf(b, "%s %s: %s\n\n", heading, r.Key, r.Title)
+	fmt.Fprintf(b, "- **Status:** %s\n", statusLine(r))
+	if r.DecidedAt != nil {
+		fmt.Fprintf(b, "- **Decided:** %s\n", r.DecidedAt.Format(markdownDateStyle))
+	}
+	if r.Supersedes != nil {
+		fmt.Fprintf(b, "- **Supersedes:** %s\n", refLink(r.Supersedes))
+	}
+	if len(r.Subjects) > 0 {
+		fmt.Fprintf(b, "- **Applies to:** %s\n", subjectList(r.Subjects))
+	}
+	if r.ProposedBy != "" {
+		fmt.Fprintf(b, "- **Proposed by:** %s\n", r.ProposedBy)
+	}
+
+	sub := heading + "#"
+	writeSection(b, sub, "Context", r.Context)
+	if len(r.Options) > 0 {
+		fmt.Fprintf(b, "\n%s Options considered\n\n", sub)
+		for _, o := range r.Options {
+			if o.Description == "" {
+				fmt.Fprintf(b, "- **%s**\n", o.Name)
+				continue
+			}
+			fmt.Fprintf(b, "- **%s**: %s\n", o.Name, singleLine(o.Description))
+		}
+	}
+	writeSection(b, sub, "Decision", r.Decision)
+	writeSection(b, sub, "Consequences", r.Consequences)
+}
+
+func writeSection(b *strings.Builder, heading, title, body string) {
+	if strings.TrimSpace(body) == "" {
+		return
+	}
+	fmt.Fprintf(b, "\n%s %s\n\n%s\n", heading, title, strings.TrimSpace(body))
+}
+
+func statusLine(r readmodels.DecisionRecordDTO) string {
+	switch {
+	case r.Status == valueobjects.DecisionRecordStatusSuperseded && r.SupersededBy != nil:
+		return "Superseded by " + refLink(r.SupersededBy)
+	case r.Status == valueobjects.DecisionRecordStatusDeprecated && r.StatusReason != "":
+		return "Deprecated: " + singleLine(r.StatusReason)
+	}
+	return strings.ToUpper(r.Status[:1]) + r.Status[1:]
+}
+
+func subjectList(subjects []readmodels.DecisionSubjectDTO) string {
+	parts := make([]string, len(subjects))
+	for i, s := range subjects {
+		label, ok := subjectTypeLabels[s.Type]
+		if !ok {
+			label = s.Type
+		}
+		name := s.Name
+		if name == "" {
+			name = s.ID
+		}
+		parts[i] = label + " " + name
+	}
+	return strings.Join(parts, ", ")
+}
+
+func refLink(ref *readmodels.DecisionRecordRefDTO) string {
+	return fmt.Sprintf("[%s](#%s) %s", ref.Key, recordAnchor(ref.Key), ref.Title)
+}
+
+func decidedOn(r readmodels.DecisionRecordDTO) string {
+	if r.DecidedAt == nil {
+		return ""
+	}
+	return r.DecidedAt.Format(markdownDateStyle)
+}
+
+func recordAnchor(key string) string {
+	return strings.ToLower(key)
+}
+
+func tableCell(s string) string {
+	return strings.ReplaceAll(singleLine(s), "|", `\|`)
+}
+
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
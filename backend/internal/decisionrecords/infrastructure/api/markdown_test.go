@@ -0,0 +1,73 @@
+package api
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"easi/backend/internal/decisionrecords/application/readmodels"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func supersessionPair() []readmodels.DecisionRecordDTO {
+	decided := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	first := &readmodels.DecisionRecordRefDTO{ID: "r-1", Key: "ADR-001", Title: "Use one ledger"}
+	second := &readmodels.DecisionRecordRefDTO{ID: "r-2", Key: "ADR-002", Title: "Split ledger | per region"}
+	return []readmodels.DecisionRecordDTO{
+		{
+			ID: "r-1", Key: "ADR-001", Title: "Use one ledger", Status: "superseded",
+			Context: "Two ledgers drift.", Decision: "Merge them.", DecidedAt: &decided, SupersededBy: second,
+			Options:  []readmodels.ConsideredOptionDTO{{Name: "Keep both"}, {Name: "Merge", Description: "One\nledger."}},
+			Subjects: []readmodels.DecisionSubjectDTO{{Type: "capability", ID: "cap-1", Name: "Payments"}},
+		},
+		{
+			ID: "r-2", Key: "ADR-002", Title: "Split ledger | per region", Status: "proposed",
+			Decision: "Split per region.", Supersedes: first, ProposedBy: "a@example.com",
+		},
+	}
+}
+
+func TestWriteDecisionLogMarkdown_IndexAndSections(t *testing.T) {
+	var buf bytes.Buffer
+	generatedAt := time.Date(2026, time.April, 1, 9, 30, 0, 0, time.UTC)
+
+	require.NoError(t, writeDecisionLogMarkdown(&buf, supersessionPair(), generatedAt))
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, "# Architecture decision log\n\n_Generated 2026-04-01 09:30 UTC. 2 decision records._"))
+	assert.Contains(t, out, "| [ADR-001](#adr-001) | Use one ledger | superseded | 2026-03-02 |")
+	assert.Contains(t, out, `| [ADR-002](#adr-002) | Split ledger \| per region | proposed |  |`)
+	assert.Contains(t, out, "<a id=\"adr-001\"></a>\n\n## ADR-001: Use one ledger")
+	assert.Contains(t, out, "- **Status:** Superseded by [ADR-002](#adr-002) Split ledger | per region")
+	assert.Contains(t, out, "- **Supersedes:** [ADR-001](#adr-001) Use one ledger")
+	assert.Contains(t, out, "- **Applies to:** Capability Payments")
+	assert.Contains(t, out, "### Options considered\n\n- **Keep both**\n- **Merge**: One ledger.\n")
+	assert.Contains(t, out, "### Decision\n\nMerge them.\n")
+	assert.NotContains(t, out, "### Consequences", "empty sections are omitted")
+}
+
+func TestWriteDecisionLogMarkdown_EmptyLogHasNoIndex(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeDecisionLogMarkdown(&buf, nil, time.Now()))
+	assert.Contains(t, buf.String(), "0 decision records")
+	assert.NotContains(t, buf.String(), "| ADR |")
+}
+
+func TestWriteDecisionRecordMarkdown_StandaloneDocument(t *testing.T) {
+	var buf bytes.Buffer
+	record := supersessionPair()[1]
+
+	require.NoError(t, writeDecisionRecordMarkdown(&buf, record))
+
+	assert.Contains(t, buf.String(), "# ADR-002: Split ledger | per region\n")
+	assert.Contains(t, buf.String(), "- **Status:** Proposed\n")
+	assert.Contains(t, buf.String(), "## Decision\n\nSplit per region.\n")
+}
+
+func TestStatusLine_DeprecatedShowsReason(t *testing.T) {
+	assert.Equal(t, "Deprecated: Vendor exited.", statusLine(readmodels.DecisionRecordDTO{Status: "deprecated", StatusReason: "Vendor exited."}))
+	assert.Equal(t, "Accepted", statusLine(readmodels.DecisionRecordDTO{Status: "accepted"}))
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"net/http"
+
+	adPL "easi/backend/internal/architecturedirection/publishedlanguage"
+	authPL "easi/backend/internal/auth/publishedlanguage"
+	"easi/backend/internal/decisionrecords/application/handlers"
+	"easi/backend/internal/decisionrecords/application/ports"
+	"easi/backend/internal/decisionrecords/application/projectors"
+	"easi/backend/internal/decisionrecords/application/readmodels"
+	"easi/backend/internal/decisionrecords/infrastructure/repositories"
+	pl "easi/backend/internal/decisionrecords/publishedlanguage"
+	"easi/backend/internal/infrastructure/database"
+	"easi/backend/internal/infrastructure/eventstore"
+	sharedAPI "easi/backend/internal/shared/api"
+	"easi/backend/internal/shared/cqrs"
+	"easi/backend/internal/shared/events"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type AuthMiddleware interface {
+	RequirePermission(permission authPL.Permission) func(http.Handler) http.Handler
+}
+
+type RoutesDeps struct {
+	Router         chi.Router
+	CommandBus     *cqrs.InMemoryCommandBus
+	EventStore     eventstore.EventStore
+	EventBus       events.EventBus
+	DB             *database.TenantAwareDB
+	HATEOAS        *sharedAPI.HATEOASLinks
+	AuthMiddleware AuthMiddleware
+	Subjects       ports.SubjectDirectory
+}
+
+func SetupDecisionRecordRoutes(deps RoutesDeps) error {
+	readModel := readmodels.NewDecisionRecordReadModel(deps.DB)
+	repo := repositories.NewDecisionRecordRepository(deps.EventStore)
+
+	projector := projectors.NewDecisionRecordProjector(readModel)
+	for _, eventType := range []string{
+		pl.DecisionRecordProposed,
+		pl.DecisionRecordRevised,
+		pl.DecisionRecordAccepted,
+		pl.DecisionRecordSuperseded,
+		pl.DecisionRecordDeprecated,
+		pl.DecisionRecordSubjectLinked,
+		pl.DecisionRecordSubjectUnlinked,
+	} {
+		deps.EventBus.Subscribe(eventType, projector)
+	}
+	deps.EventBus.Subscribe(adPL.DirectionAgreed, projectors.NewDirectionAgreedReactor(deps.CommandBus))
+
+	deps.CommandBus.Register("ProposeDecisionRecord", handlers.NewProposeDecisionRecordHandler(repo, deps.Subjects))
+	deps.CommandBus.Register("ReviseDecisionRecord", handlers.NewReviseDecisionRecordHandler(repo))
+	deps.CommandBus.Register("AcceptDecisionRecord", handlers.NewAcceptDecisionRecordHandler(repo))
+	deps.CommandBus.Register("DeprecateDecisionRecord", handlers.NewDeprecateDecisionRecordHandler(repo))
+	deps.CommandBus.Register("LinkDecisionRecordSubject", handlers.NewLinkDecisionRecordSubjectHandler(repo, deps.Subjects))
+	deps.CommandBus.Register("UnlinkDecisionRecordSubject", handlers.NewUnlinkDecisionRecordSubjectHandler(repo))
+	deps.CommandBus.Register("RecordAgreedDirection", handlers.NewRecordAgreedDirectionHandler(repo, deps.Subjects))
+
+	httpHandlers := NewDecisionRecordHandlers(deps.CommandBus, readModel, NewDecisionRecordLinks(deps.HATEOAS))
+	registerRoutes(deps.Router, httpHandlers, deps.AuthMiddleware)
+	return nil
+}
+
+func registerRoutes(r chi.Router, h *DecisionRecordHandlers, authMiddleware AuthMiddleware) {
+	r.Route("/decision-records", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermArchitectureDirectionRead))
+			r.Get("/", h.GetDecisionRecords)
+			r.Get("/{recordId}", h.GetDecisionRecord)
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.RequirePermission(authPL.PermArchitectureDirectionWrite))
+			r.Post("/", h.ProposeDecisionRecord)
+			r.Put("/{recordId}", h.ReviseDecisionRecord)
+			r.Post("/{recordId}/accept", h.AcceptDecisionRecord)
+			r.Post("/{recordId}/deprecate", h.DeprecateDecisionRecord)
+			r.Post("/{recordId}/subjects", h.LinkDecisionRecordSubject)
+			r.Delete("/{recordId}/subjects/{subjectType}/{subjectId}", h.UnlinkDecisionRecordSubject)
+		})
+	})
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"errors"
+
+	"easi/backend/internal/decisionrecords/domain/aggregates"
+	"easi/backend/internal/decisionrecords/domain/events"
+	pl "easi/backend/internal/decisionrecords/publishedlanguage"
+	"easi/backend/internal/infrastructure/eventstore"
+	"easi/backend/internal/shared/infrastructure/repository"
+)
+
+var ErrDecisionRecordNotFound = errors.New("decision record not found")
+
+type DecisionRecordRepository struct {
+	*repository.EventSourcedRepository[*aggregates.DecisionRecord]
+}
+
+func NewDecisionRecordRepository(eventStore eventstore.EventStore) *DecisionRecordRepository {
+	return &DecisionRecordRepository{
+		EventSourcedRepository: repository.NewEventSourcedRepository(
+			eventStore,
+			decisionRecordEventDeserializers,
+			aggregates.LoadDecisionRecordFromHistory,
+			ErrDecisionRecordNotFound,
+		),
+	}
+}
+
+var decisionRecordEventDeserializers = repository.NewEventDeserializers(
+	map[string]repository.EventDeserializerFunc{
+		pl.DecisionRecordProposed:        repository.JSONDeserializer[events.DecisionRecordProposed],
+		pl.DecisionRecordRevised:         repository.JSONDeserializer[events.DecisionRecordRevised],
+		pl.DecisionRecordAccepted:        repository.JSONDeserializer[events.DecisionRecordAccepted],
+		pl.DecisionRecordSuperseded:      repository.JSONDeserializer[events.DecisionRecordSuperseded],
+		pl.DecisionRecordDeprecated:      repository.JSONDeserializer[events.DecisionRecordDeprecated],
+		pl.DecisionRecordSubjectLinked:   repository.JSONDeserializer[events.DecisionRecordSubjectLinked],
+		pl.DecisionRecordSubjectUnlinked: repository.JSONDeserializer[events.DecisionRecordSubjectUnlinked],
+	},
+)
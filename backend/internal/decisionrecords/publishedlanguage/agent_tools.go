@@ -0,0 +1,31 @@
+package publishedlanguage
+
+import (
+	pl "easi/backend/internal/archassistant/publishedlanguage"
+)
+
+func AgentTools() []pl.AgentToolSpec {
+	return []pl.AgentToolSpec{
+		{
+			Name:        "list_decision_records",
+			Description: "List the architecture decision records (ADRs) in number order — title, status (proposed / accepted / superseded / deprecated), context, options considered, decision, consequences, supersession links and the capabilities, enterprise capabilities, components, directions and journeys each one applies to. Narrow by status or to the records that apply to one architecture element.",
+			Access:      pl.AccessRead,
+			Permission:  "architecture-direction:read",
+			Method:      "GET",
+			Path:        "/decision-records",
+			QueryParams: []pl.ParamSpec{
+				pl.StringParam("status", "Only records in this status: proposed, accepted, superseded or deprecated", false),
+				pl.StringParam("subjectId", "Only records linked to this architecture element (UUID)", false),
+			},
+		},
+		{
+			Name:        "get_decision_record",
+			Description: "Get one architecture decision record with its full context, options considered, decision and consequences, which record it supersedes or is superseded by, and the architecture elements it applies to.",
+			Access:      pl.AccessRead,
+			Permission:  "architecture-direction:read",
+			Method:      "GET",
+			Path:        "/decision-records/{recordId}",
+			PathParams:  []pl.ParamSpec{pl.UUIDParam("recordId", "Decision record ID (UUID)")},
+		},
+	}
+}
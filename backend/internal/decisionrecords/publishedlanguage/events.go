@@ -0,0 +1,11 @@
+package publishedlanguage
+
+const (
+	DecisionRecordProposed        = "DecisionRecordProposed"
+	DecisionRecordRevised         = "DecisionRecordRevised"
+	DecisionRecordAccepted        = "DecisionRecordAccepted"
+	DecisionRecordSuperseded      = "DecisionRecordSuperseded"
+	DecisionRecordDeprecated      = "DecisionRecordDeprecated"
+	DecisionRecordSubjectLinked   = "DecisionRecordSubjectLinked"
+	DecisionRecordSubjectUnlinked = "DecisionRecordSubjectUnlinked"
+)
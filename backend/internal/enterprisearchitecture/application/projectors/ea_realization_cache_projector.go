@@ -15,6 +15,7 @@ import (
 type RealizationCacheWriter interface {
 	Upsert(ctx context.Context, entry readmodels.RealizationEntry) error
 	Delete(ctx context.Context, realizationID string) error
+	MoveToCapability(ctx context.Context, realizationID, capabilityID string) error
 	DeleteByCapabilityID(ctx context.Context, capabilityID string) error
 	UpdateComponentName(ctx context.Context, componentID, componentName string) error
 }
@@ -41,6 +42,8 @@ func (p *EARealizationCacheProjector) ProjectEvent(ctx context.Context, eventTyp
 	handlers := map[string]func(context.Context, []byte) error{
 		cmPL.SystemLinkedToCapability:    p.handleSystemLinkedToCapability,
 		cmPL.SystemRealizationDeleted:    p.handleSystemRealizationDeleted,
+		cmPL.SystemRealizationReassigned: p.handleSystemRealizationReassigned,
+		cmPL.SystemRealizationMoved:      p.handleSystemRealizationMoved,
 		cmPL.CapabilityDeleted:           p.handleCapabilityDeleted,
 		amPL.ApplicationComponentUpdated: p.handleApplicationComponentUpdated,
 	}
@@ -86,6 +89,47 @@ func (p *EARealizationCacheProjector) handleSystemLinkedToCapability(ctx context
 	return nil
 }
 
+type systemRealizationReassignedEvent struct {
+	ID               string `json:"id"`
+	CapabilityID     string `json:"capabilityId"`
+	ToComponentID    string `json:"toComponentId"`
+	ToComponentName  string `json:"toComponentName"`
+	RealizationLevel string `json:"realizationLevel"`
+}
+
+func (p *EARealizationCacheProjector) handleSystemRealizationReassigned(ctx context.Context, eventData []byte) error {
+	event, err := decodeRealizationEvent[systemRealizationReassignedEvent]("SystemRealizationReassigned", eventData)
+	if err != nil {
+		return err
+	}
+	if err := p.readModel.Upsert(ctx, readmodels.RealizationEntry{
+		RealizationID: event.ID,
+		CapabilityID:  event.CapabilityID,
+		ComponentID:   event.ToComponentID,
+		ComponentName: event.ToComponentName,
+		Origin:        event.RealizationLevel,
+	}); err != nil {
+		return fmt.Errorf("project SystemRealizationReassigned EA realization cache upsert for realization %s: %w", event.ID, err)
+	}
+	return nil
+}
+
+type systemRealizationMovedEvent struct {
+	ID             string `json:"id"`
+	ToCapabilityID string `json:"toCapabilityId"`
+}
+
+func (p *EARealizationCacheProjector) handleSystemRealizationMoved(ctx context.Context, eventData []byte) error {
+	event, err := decodeRealizationEvent[systemRealizationMovedEvent]("SystemRealizationMoved", eventData)
+	if err != nil {
+		return err
+	}
+	if err := p.readModel.MoveToCapability(ctx, event.ID, event.ToCapabilityID); err != nil {
+		return fmt.Errorf("project SystemRealizationMoved EA realization cache move for realization %s: %w", event.ID, err)
+	}
+	return nil
+}
+
 type identifiedEvent struct {
 	ID string `json:"id"`
 }
@@ -19,6 +19,7 @@ type mockRealizationCacheReadModel struct {
 	upsertedEntries      []readmodels.RealizationEntry
 	deletedIDs           []string
 	deletedCapabilityIDs []string
+	moves                map[string]string
 	updatedNames         []componentNameUpdate
 	upsertErr            error
 	deleteErr            error
@@ -47,6 +48,14 @@ func (m *mockRealizationCacheReadModel) Delete(ctx context.Context, realizationI
 	return nil
 }
 
+func (m *mockRealizationCacheReadModel) MoveToCapability(ctx context.Context, realizationID, capabilityID string) error {
+	if m.moves == nil {
+		m.moves = map[string]string{}
+	}
+	m.moves[realizationID] = capabilityID
+	return nil
+}
+
 func (m *mockRealizationCacheReadModel) DeleteByCapabilityID(ctx context.Context, capabilityID string) error {
 	if m.deleteByCapErr != nil {
 		return m.deleteByCapErr
@@ -91,6 +100,54 @@ func TestRealizationCache_SystemLinked_UpsertsEntry(t *testing.T) {
 	assert.Equal(t, "Direct", entry.Origin)
 }
 
+func TestRealizationCache_SystemRealizationReassigned_UpsertsNewComponent(t *testing.T) {
+	mock := &mockRealizationCacheReadModel{}
+	projector := NewEARealizationCacheProjector(mock)
+
+	realizationID := uuid.New().String()
+	survivorID := uuid.New().String()
+	eventData, err := json.Marshal(map[string]string{
+		"id":               realizationID,
+		"capabilityId":     "cap-1",
+		"fromComponentId":  uuid.New().String(),
+		"toComponentId":    survivorID,
+		"toComponentName":  "Salesforce",
+		"realizationLevel": "Full",
+	})
+	require.NoError(t, err)
+
+	err = projector.ProjectEvent(context.Background(), cmPL.SystemRealizationReassigned, eventData)
+	require.NoError(t, err)
+
+	require.Len(t, mock.upsertedEntries, 1)
+	entry := mock.upsertedEntries[0]
+	assert.Equal(t, realizationID, entry.RealizationID)
+	assert.Equal(t, survivorID, entry.ComponentID)
+	assert.Equal(t, "Salesforce", entry.ComponentName)
+}
+
+func TestRealizationCache_SystemRealizationMoved_MovesEntry(t *testing.T) {
+	mock := &mockRealizationCacheReadModel{}
+	projector := NewEARealizationCacheProjector(mock)
+
+	realizationID := uuid.New().String()
+	targetID := uuid.New().String()
+	eventData, err := json.Marshal(map[string]string{
+		"id":               realizationID,
+		"componentId":      uuid.New().String(),
+		"fromCapabilityId": uuid.New().String(),
+		"toCapabilityId":   targetID,
+		"realizationLevel": "Full",
+	})
+	require.NoError(t, err)
+
+	err = projector.ProjectEvent(context.Background(), cmPL.SystemRealizationMoved, eventData)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{realizationID: targetID}, mock.moves)
+	assert.Empty(t, mock.upsertedEntries)
+}
+
 func TestRealizationCache_DeleteEvents(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -43,6 +43,13 @@ func (rm *EARealizationCacheReadModel) Delete(ctx context.Context, realizationID
 	)
 }
 
+func (rm *EARealizationCacheReadModel) MoveToCapability(ctx context.Context, realizationID, capabilityID string) error {
+	return rm.execForTenant(ctx,
+		"UPDATE enterprisearchitecture.ea_realization_cache SET capability_id = $2 WHERE tenant_id = $1 AND realization_id = $3",
+		capabilityID, realizationID,
+	)
+}
+
 func (rm *EARealizationCacheReadModel) DeleteByCapabilityID(ctx context.Context, capabilityID string) error {
 	return rm.execForTenant(ctx,
 		"DELETE FROM enterprisearchitecture.ea_realization_cache WHERE tenant_id = $1 AND capability_id = $2",
@@ -11,14 +11,26 @@ import (
 )
 
 type TimeSuggestionDTO struct {
-	CapabilityID   string   `json:"capabilityId"`
-	CapabilityName string   `json:"capabilityName"`
-	ComponentID    string   `json:"componentId"`
-	ComponentName  string   `json:"componentName"`
-	SuggestedTime  *string  `json:"suggestedTime"`
-	TechnicalGap   *float64 `json:"technicalGap"`
-	FunctionalGap  *float64 `json:"functionalGap"`
-	Confidence     string   `json:"confidence"`
+	CapabilityID   string                      `json:"capabilityId"`
+	CapabilityName string                      `json:"capabilityName"`
+	ComponentID    string                      `json:"componentId"`
+	ComponentName  string                      `json:"componentName"`
+	SuggestedTime  *string                     `json:"suggestedTime"`
+	TechnicalGap   *float64                    `json:"technicalGap"`
+	FunctionalGap  *float64                    `json:"functionalGap"`
+	Confidence     string                      `json:"confidence"`
+	Evidence       []TimeSuggestionEvidenceDTO `json:"evidence"`
+}
+
+// TimeSuggestionEvidenceDTO is one pillar's contribution to a suggestion: the
+// capability's importance on the pillar against the application's fit score.
+type TimeSuggestionEvidenceDTO struct {
+	PillarID   string  `json:"pillarId"`
+	PillarName string  `json:"pillarName"`
+	FitType    string  `json:"fitType"`
+	Importance int     `json:"importance"`
+	FitScore   int     `json:"fitScore"`
+	Gap        float64 `json:"gap"`
 }
 
 type TimeSuggestionReadModel struct {
@@ -61,21 +73,26 @@ func (rm *TimeSuggestionReadModel) getSuggestions(ctx context.Context, filter ti
 		return nil, err
 	}
 
-	pillarFitTypes := rm.buildPillarFitTypeMap(pillars)
+	scoredPillars := rm.buildScoredPillarMap(pillars)
 
 	realizationGaps, err := rm.queryRealizationGaps(ctx, filter.capabilityID, filter.componentID)
 	if err != nil {
 		return nil, err
 	}
 
-	return rm.calculateSuggestions(realizationGaps, pillarFitTypes), nil
+	return rm.calculateSuggestions(realizationGaps, scoredPillars), nil
 }
 
-func (rm *TimeSuggestionReadModel) buildPillarFitTypeMap(pillars *mmPL.StrategyPillarsConfigDTO) map[string]string {
-	result := make(map[string]string)
+type scoredPillar struct {
+	name    string
+	fitType string
+}
+
+func (rm *TimeSuggestionReadModel) buildScoredPillarMap(pillars *mmPL.StrategyPillarsConfigDTO) map[string]scoredPillar {
+	result := make(map[string]scoredPillar)
 	for _, pillar := range pillars.Pillars {
 		if pillar.FitType != "" && pillar.FitScoringEnabled {
-			result[pillar.ID] = pillar.FitType
+			result[pillar.ID] = scoredPillar{name: pillar.Name, fitType: pillar.FitType}
 		}
 	}
 	return result
@@ -89,8 +106,10 @@ type realizationKey struct {
 }
 
 type pillarGap struct {
-	pillarID string
-	gap      float64
+	pillarID   string
+	importance int
+	fitScore   int
+	gap        float64
 }
 
 type realizationGaps struct {
@@ -135,8 +154,12 @@ func (rm *TimeSuggestionReadModel) queryRealizationGaps(ctx context.Context, cap
 				realizationsMap[key] = &realizationGaps{key: key, gaps: []pillarGap{}}
 			}
 
-			gap := float64(importance - fitScore)
-			realizationsMap[key].gaps = append(realizationsMap[key].gaps, pillarGap{pillarID: pillarID, gap: gap})
+			realizationsMap[key].gaps = append(realizationsMap[key].gaps, pillarGap{
+				pillarID:   pillarID,
+				importance: importance,
+				fitScore:   fitScore,
+				gap:        float64(importance - fitScore),
+			})
 		}
 		return rows.Err()
 	})
@@ -199,24 +222,45 @@ func (rm *TimeSuggestionReadModel) buildQueryArgs(tenantID, capabilityID, compon
 	return args
 }
 
-func (rm *TimeSuggestionReadModel) calculateSuggestions(realizations []realizationGaps, pillarFitTypes map[string]string) []TimeSuggestionDTO {
+func (rm *TimeSuggestionReadModel) calculateSuggestions(realizations []realizationGaps, pillars map[string]scoredPillar) []TimeSuggestionDTO {
 	result := make([]TimeSuggestionDTO, 0, len(realizations))
 	for _, rg := range realizations {
-		result = append(result, rm.calculateSingleSuggestion(rg, pillarFitTypes))
+		result = append(result, rm.calculateSingleSuggestion(rg, pillars))
 	}
 	return result
 }
 
-func (rm *TimeSuggestionReadModel) calculateSingleSuggestion(rg realizationGaps, pillarFitTypes map[string]string) TimeSuggestionDTO {
-	technicalGaps, functionalGaps := rm.separateGapsByFitType(rg.gaps, pillarFitTypes)
+func (rm *TimeSuggestionReadModel) calculateSingleSuggestion(rg realizationGaps, pillars map[string]scoredPillar) TimeSuggestionDTO {
+	technicalGaps, functionalGaps := rm.separateGapsByFitType(rg.gaps, pillars)
 	calcResult := rm.calculator.Calculate(technicalGaps, functionalGaps)
-	return rm.buildSuggestionDTO(rg.key, calcResult, technicalGaps, functionalGaps)
+	dto := rm.buildSuggestionDTO(rg.key, calcResult, technicalGaps, functionalGaps)
+	dto.Evidence = buildEvidence(rg.gaps, pillars)
+	return dto
+}
+
+func buildEvidence(gaps []pillarGap, pillars map[string]scoredPillar) []TimeSuggestionEvidenceDTO {
+	evidence := make([]TimeSuggestionEvidenceDTO, 0, len(gaps))
+	for _, pg := range gaps {
+		pillar, scored := pillars[pg.pillarID]
+		if !scored {
+			continue
+		}
+		evidence = append(evidence, TimeSuggestionEvidenceDTO{
+			PillarID:   pg.pillarID,
+			PillarName: pillar.name,
+			FitType:    pillar.fitType,
+			Importance: pg.importance,
+			FitScore:   pg.fitScore,
+			Gap:        pg.gap,
+		})
+	}
+	return evidence
 }
 
-func (rm *TimeSuggestionReadModel) separateGapsByFitType(gaps []pillarGap, pillarFitTypes map[string]string) ([]float64, []float64) {
+func (rm *TimeSuggestionReadModel) separateGapsByFitType(gaps []pillarGap, pillars map[string]scoredPillar) ([]float64, []float64) {
 	var technicalGaps, functionalGaps []float64
 	for _, pg := range gaps {
-		fitType := pillarFitTypes[pg.pillarID]
+		fitType := pillars[pg.pillarID].fitType
 		switch fitType {
 		case "TECHNICAL":
 			technicalGaps = append(technicalGaps, pg.gap)
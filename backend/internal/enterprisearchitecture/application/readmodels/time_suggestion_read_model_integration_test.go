@@ -192,6 +192,10 @@ func TestTimeSuggestionReadModel_GetAllSuggestions_WithData(t *testing.T) {
 	assert.NotNil(t, suggestion.SuggestedTime)
 	assert.Equal(t, "Eliminate", *suggestion.SuggestedTime)
 	assert.Equal(t, "MEDIUM", suggestion.Confidence)
+	assert.ElementsMatch(t, []TimeSuggestionEvidenceDTO{
+		{PillarID: "pillar-tech", PillarName: "Technical Quality", FitType: "TECHNICAL", Importance: 80, FitScore: 60, Gap: 20},
+		{PillarID: "pillar-func", PillarName: "Functional Fit", FitType: "FUNCTIONAL", Importance: 70, FitScore: 50, Gap: 20},
+	}, suggestion.Evidence)
 }
 
 func TestTimeSuggestionReadModel_FilterMethods(t *testing.T) {
@@ -181,6 +181,8 @@ func subscribeRealizationCacheEvents(eventBus events.EventBus, projector *projec
 	eventTypes := []string{
 		cmPL.SystemLinkedToCapability,
 		cmPL.SystemRealizationDeleted,
+		cmPL.SystemRealizationReassigned,
+		cmPL.SystemRealizationMoved,
 		cmPL.CapabilityDeleted,
 		amPL.ApplicationComponentUpdated,
 	}
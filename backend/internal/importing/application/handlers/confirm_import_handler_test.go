@@ -48,6 +48,10 @@ func (s stubCapabilityGateway) AssignToDomain(_ context.Context, _, _ string) er
 	return nil
 }
 
+func (s stubCapabilityGateway) MaxHierarchyDepth(_ context.Context) (int, error) {
+	return 4, nil
+}
+
 type stubValueStreamGateway struct{}
 
 func (s stubValueStreamGateway) CreateValueStream(_ context.Context, _, _ string) (string, error) {
@@ -16,6 +16,7 @@ type CapabilityGateway interface {
 	UpdateMetadata(ctx context.Context, id, eaOwner, status string) error
 	LinkSystem(ctx context.Context, input publishedlanguage.LinkSystemInput) (string, error)
 	AssignToDomain(ctx context.Context, capabilityID, businessDomainID string) error
+	MaxHierarchyDepth(ctx context.Context) (int, error)
 }
 
 type ValueStreamGateway interface {
@@ -67,12 +67,14 @@ type fakeCapabilityGateway struct {
 	metadataCalls   []metadataUpdateCall
 	linkSystemCalls []publishedlanguage.LinkSystemInput
 	linkErrByKey    map[string]error
+	maxDepth        int
 }
 
 func newFakeCapabilityGateway() *fakeCapabilityGateway {
 	return &fakeCapabilityGateway{
 		fakeEntityStore: newFakeEntityStore("cap-"),
 		linkErrByKey:    make(map[string]error),
+		maxDepth:        4,
 	}
 }
 
@@ -102,6 +104,10 @@ func (f *fakeCapabilityGateway) AssignToDomain(_ context.Context, _, _ string) e
 	return f.err
 }
 
+func (f *fakeCapabilityGateway) MaxHierarchyDepth(_ context.Context) (int, error) {
+	return f.maxDepth, nil
+}
+
 type fakeValueStreamGateway struct {
 	fakeEntityStore
 	stageIDs map[string]string
@@ -2,6 +2,7 @@ package saga
 
 import (
 	"context"
+	"fmt"
 
 	"easi/backend/internal/importing/application/ports"
 	"easi/backend/internal/importing/domain/aggregates"
@@ -78,6 +79,7 @@ func (s *ImportSaga) createCapabilities(ctx context.Context, data aggregates.Par
 	parentMap := buildParentMap(data.Relationships)
 	capabilityBySourceID := indexBySourceID(data.Capabilities)
 	levels := buildHierarchyLevels(data.Capabilities, parentMap)
+	maxDepth := s.capabilityHierarchyDepth(ctx, result)
 
 	for level, sourceIDs := range levels {
 		for _, sourceID := range sourceIDs {
@@ -90,7 +92,7 @@ func (s *ImportSaga) createCapabilities(ctx context.Context, data aggregates.Par
 				Name:        cap.Name,
 				Description: cap.Description,
 				ParentID:    parentID,
-				Level:       getLevelString(level),
+				Level:       getLevelString(level, maxDepth),
 			})
 			if err != nil {
 				result.Errors = append(result.Errors, valueobjects.NewImportError(cap.SourceID, cap.Name, err.Error(), "skipped"))
@@ -103,6 +105,19 @@ func (s *ImportSaga) createCapabilities(ctx context.Context, data aggregates.Par
 	}
 }
 
+func (s *ImportSaga) capabilityHierarchyDepth(ctx context.Context, result *aggregates.ImportResult) int {
+	maxDepth, err := s.capabilities.MaxHierarchyDepth(ctx)
+	if err != nil || maxDepth < 1 {
+		reason := "invalid depth"
+		if err != nil {
+			reason = err.Error()
+		}
+		result.Errors = append(result.Errors, valueobjects.NewImportError("", "", "failed to read capability hierarchy depth, using default: "+reason, "warning"))
+		return defaultCapabilityHierarchyDepth
+	}
+	return maxDepth
+}
+
 func (s *ImportSaga) assignCapabilityMetadata(ctx context.Context, eaOwner string, state *sagaState, result *aggregates.ImportResult) {
 	if eaOwner == "" || len(state.createdCapabilityIDs) == 0 {
 		return
@@ -329,17 +344,16 @@ func addToSet(s map[string]bool, ids []string) {
 	}
 }
 
-func getLevelString(level int) string {
-	switch level {
-	case 0:
-		return "L1"
-	case 1:
-		return "L2"
-	case 2:
-		return "L3"
-	default:
-		return "L4"
+const defaultCapabilityHierarchyDepth = 4
+
+// getLevelString maps a zero-based depth in the imported tree to a capability
+// level, flattening anything deeper than the tenant allows onto the last level.
+func getLevelString(level, maxDepth int) string {
+	depth := level + 1
+	if depth > maxDepth {
+		depth = maxDepth
 	}
+	return fmt.Sprintf("L%d", depth)
 }
 
 func buildNotes(name, documentation string) string {
@@ -58,6 +58,33 @@ func TestImportSaga_CreatesCapabilitiesWithHierarchy(t *testing.T) {
 	}
 }
 
+func TestImportSaga_CapabilityLevelsFollowConfiguredDepth(t *testing.T) {
+	f := newFixture()
+	f.capGw.maxDepth = 2
+	data := aggregates.ParsedData{
+		Capabilities: []aggregates.ParsedElement{
+			{SourceID: "cap-1", Name: "Domain"},
+			{SourceID: "cap-2", Name: "Area"},
+			{SourceID: "cap-3", Name: "Capability"},
+		},
+		Relationships: []aggregates.ParsedRelationship{
+			{SourceID: "rel-1", Type: "Aggregation", SourceRef: "cap-1", TargetRef: "cap-2"},
+			{SourceID: "rel-2", Type: "Aggregation", SourceRef: "cap-2", TargetRef: "cap-3"},
+		},
+	}
+
+	f.execute(t, data, "", "")
+
+	if len(f.capGw.createCalls) != 3 {
+		t.Fatalf("expected 3 CreateCapability calls, got %d", len(f.capGw.createCalls))
+	}
+	for i, want := range []string{"L1", "L2", "L2"} {
+		if got := f.capGw.createCalls[i].Level; got != want {
+			t.Errorf("call %d: expected level %q, got %q", i, want, got)
+		}
+	}
+}
+
 func TestImportSaga_CompositionRelationshipBuildsHierarchy(t *testing.T) {
 	f := newFixture()
 	data := aggregates.ParsedData{
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+
+	adReadModels "easi/backend/internal/architecturedirection/application/readmodels"
+	adValueObjects "easi/backend/internal/architecturedirection/domain/valueobjects"
+	capHandlers "easi/backend/internal/capabilitymapping/application/handlers"
+	"easi/backend/internal/infrastructure/database"
+	"easi/backend/internal/onepagers/application/queries"
+)
+
+type timeDistributionAdapter struct {
+	assessments *adReadModels.TimeAssessmentReadModel
+}
+
+func (a timeDistributionAdapter) DistributionFor(ctx context.Context, capabilityIDs []string) (map[string]int, error) {
+	assessments, err := a.assessments.GetByCapabilityIDs(ctx, capabilityIDs)
+	if err != nil {
+		return nil, err
+	}
+	distribution := make(map[string]int)
+	for _, assessment := range assessments {
+		distribution[assessment.Grade]++
+	}
+	return distribution, nil
+}
+
+type journeyProgressAdapter struct {
+	journeys *adReadModels.CapabilityJourneyReadModel
+}
+
+func (a journeyProgressAdapter) ProgressFor(ctx context.Context, capabilityIDs []string) (capHandlers.JourneyProgressCounts, error) {
+	journeys, err := a.journeys.GetCurrentByCapabilityIDs(ctx, capabilityIDs)
+	if err != nil {
+		return capHandlers.JourneyProgressCounts{}, err
+	}
+	var counts capHandlers.JourneyProgressCounts
+	for _, journey := range journeys {
+		switch journey.Status {
+		case adValueObjects.JourneyStatusPlanned:
+			counts.Planned++
+		case adValueObjects.JourneyStatusInFlight:
+			counts.InFlight++
+		case adValueObjects.JourneyStatusDone:
+			counts.Done++
+		case adValueObjects.JourneyStatusAbandoned:
+			counts.Abandoned++
+		}
+	}
+	return counts, nil
+}
+
+func newBusinessDomainKPISources(db *database.TenantAwareDB, completeness *queries.CompletenessIndicators) capHandlers.DomainKPIExternalSources {
+	return capHandlers.DomainKPIExternalSources{
+		TimeDistribution: timeDistributionAdapter{assessments: adReadModels.NewTimeAssessmentReadModel(db)},
+		JourneyProgress:  journeyProgressAdapter{journeys: adReadModels.NewCapabilityJourneyReadModel(db)},
+		Completeness:     onePagerCompletenessPercentAdapter{indicators: completeness, subjectType: "capability"},
+	}
+}
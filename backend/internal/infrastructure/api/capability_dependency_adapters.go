@@ -0,0 +1,30 @@
+package api
+
+import (
+	"context"
+
+	adReadModels "easi/backend/internal/architecturedirection/application/readmodels"
+	adValueObjects "easi/backend/internal/architecturedirection/domain/valueobjects"
+)
+
+type eliminateGradesAdapter struct {
+	assessments *adReadModels.TimeAssessmentReadModel
+}
+
+func (a eliminateGradesAdapter) EliminateGraded(ctx context.Context, capabilityIDs []string) (map[string]map[string]bool, error) {
+	assessments, err := a.assessments.GetByCapabilityIDs(ctx, capabilityIDs)
+	if err != nil {
+		return nil, err
+	}
+	graded := make(map[string]map[string]bool)
+	for _, assessment := range assessments {
+		if assessment.Grade != adValueObjects.TimeGradeEliminate {
+			continue
+		}
+		if graded[assessment.CapabilityID] == nil {
+			graded[assessment.CapabilityID] = make(map[string]bool)
+		}
+		graded[assessment.CapabilityID][assessment.ComponentID] = true
+	}
+	return graded, nil
+}
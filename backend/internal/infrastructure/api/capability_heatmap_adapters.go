@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+
+	adReadModels "easi/backend/internal/architecturedirection/application/readmodels"
+	adValueObjects "easi/backend/internal/architecturedirection/domain/valueobjects"
+	capHandlers "easi/backend/internal/capabilitymapping/application/handlers"
+	"easi/backend/internal/infrastructure/database"
+	"easi/backend/internal/onepagers/application/queries"
+	opReadModels "easi/backend/internal/onepagers/application/readmodels"
+)
+
+type eliminateCountsAdapter struct {
+	assessments *adReadModels.TimeAssessmentReadModel
+}
+
+func (a eliminateCountsAdapter) ValuesFor(ctx context.Context, capabilityIDs []string) (map[string]float64, error) {
+	assessments, err := a.assessments.GetByCapabilityIDs(ctx, capabilityIDs)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]float64)
+	for _, assessment := range assessments {
+		if assessment.Grade == adValueObjects.TimeGradeEliminate {
+			counts[assessment.CapabilityID]++
+		}
+	}
+	return counts, nil
+}
+
+type activeJourneyCountsAdapter struct {
+	journeys *adReadModels.CapabilityJourneyReadModel
+}
+
+func (a activeJourneyCountsAdapter) ValuesFor(ctx context.Context, capabilityIDs []string) (map[string]float64, error) {
+	journeys, err := a.journeys.GetCurrentByCapabilityIDs(ctx, capabilityIDs)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]float64)
+	for _, journey := range journeys {
+		if journey.Status == adValueObjects.JourneyStatusPlanned || journey.Status == adValueObjects.JourneyStatusInFlight {
+			counts[journey.CapabilityID]++
+		}
+	}
+	return counts, nil
+}
+
+type onePagerCompletenessPercentAdapter struct {
+	indicators  *queries.CompletenessIndicators
+	subjectType string
+}
+
+func (a onePagerCompletenessPercentAdapter) ValuesFor(ctx context.Context, subjectIDs []string) (map[string]float64, error) {
+	required, filled, err := a.indicators.CountsForSubjects(ctx, a.subjectType, subjectIDs)
+	if err != nil {
+		return nil, err
+	}
+	percentages := make(map[string]float64)
+	if required == 0 {
+		return percentages, nil
+	}
+	for _, id := range subjectIDs {
+		percentages[id] = float64(filled[id]) / float64(required) * 100
+	}
+	return percentages, nil
+}
+
+type componentCostAdapter struct {
+	facts *opReadModels.OnePagerFactsReadModel
+}
+
+func (a componentCostAdapter) CostsFor(ctx context.Context, fieldID string, componentIDs []string) (map[string]float64, error) {
+	return a.facts.NumericFieldValues(ctx, "application", fieldID, componentIDs)
+}
+
+func newCapabilityHeatmapSources(db *database.TenantAwareDB, completeness *queries.CompletenessIndicators) capHandlers.HeatmapExternalSources {
+	return capHandlers.HeatmapExternalSources{
+		EliminateCounts: eliminateCountsAdapter{assessments: adReadModels.NewTimeAssessmentReadModel(db)},
+		ActiveJourneys:  activeJourneyCountsAdapter{journeys: adReadModels.NewCapabilityJourneyReadModel(db)},
+		Completeness:    onePagerCompletenessPercentAdapter{indicators: completeness, subjectType: "capability"},
+		ComponentCosts:  componentCostAdapter{facts: opReadModels.NewOnePagerFactsReadModel(db)},
+	}
+}
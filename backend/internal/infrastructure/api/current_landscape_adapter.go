@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+
+	directionServices "easi/backend/internal/architecturedirection/domain/services"
+	capReadModels "easi/backend/internal/capabilitymapping/application/readmodels"
+	"easi/backend/internal/infrastructure/database"
+)
+
+// currentLandscapeAdapter feeds today's capabilities, their effective business
+// domain and their direct realisations from capability mapping into the
+// architecture direction target-state projection.
+type currentLandscapeAdapter struct {
+	capabilities *capReadModels.CapabilityReadModel
+	domains      *capReadModels.CMEffectiveBusinessDomainReadModel
+	realizations *capReadModels.RealizationReadModel
+}
+
+func newCurrentLandscapeAdapter(db *database.TenantAwareDB) currentLandscapeAdapter {
+	return currentLandscapeAdapter{
+		capabilities: capReadModels.NewCapabilityReadModel(db),
+		domains:      capReadModels.NewCMEffectiveBusinessDomainReadModel(db),
+		realizations: capReadModels.NewRealizationReadModel(db),
+	}
+}
+
+func (a currentLandscapeAdapter) GetCurrentLandscape(ctx context.Context) ([]directionServices.LandscapeCapability, error) {
+	capabilities, err := a.capabilities.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	placements, err := a.domains.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	realizations, err := a.realizations.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	placementByCapability := make(map[string]capReadModels.CMEffectiveBusinessDomainDTO, len(placements))
+	for _, placement := range placements {
+		placementByCapability[placement.CapabilityID] = placement
+	}
+	applications := map[string][]directionServices.LandscapeApplication{}
+	// Realisations arrive newest first; list each capability's oldest first.
+	for i := len(realizations) - 1; i >= 0; i-- {
+		r := realizations[i]
+		if r.Origin != "Direct" {
+			continue
+		}
+		applications[r.CapabilityID] = append(applications[r.CapabilityID],
+			directionServices.LandscapeApplication{ID: r.ComponentID, Name: r.ComponentName})
+	}
+
+	out := make([]directionServices.LandscapeCapability, len(capabilities))
+	for i, capability := range capabilities {
+		placement := placementByCapability[capability.ID]
+		out[i] = directionServices.LandscapeCapability{
+			ID:                 capability.ID,
+			Name:               capability.Name,
+			ParentID:           capability.ParentID,
+			BusinessDomainID:   placement.BusinessDomainID,
+			BusinessDomainName: placement.BusinessDomainName,
+			Applications:       applications[capability.ID],
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	adReadModels "easi/backend/internal/architecturedirection/application/readmodels"
+	archReadModels "easi/backend/internal/architecturemodeling/application/readmodels"
+	capReadModels "easi/backend/internal/capabilitymapping/application/readmodels"
+	eaReadModels "easi/backend/internal/enterprisearchitecture/application/readmodels"
+	"easi/backend/internal/infrastructure/database"
+)
+
+type subjectNameLookup func(ctx context.Context, id string) (string, bool, error)
+
+// decisionRecordSubjectDirectory resolves the display name of every kind of
+// architecture element a decision record can apply to. Directions and
+// journeys have no name of their own, so they borrow their capability's.
+type decisionRecordSubjectDirectory struct {
+	lookups map[string]subjectNameLookup
+}
+
+func newDecisionRecordSubjectDirectory(db *database.TenantAwareDB) decisionRecordSubjectDirectory {
+	ecReadModel := eaReadModels.NewEnterpriseCapabilityReadModel(db)
+	directionReadModel := adReadModels.NewDirectionReadModel(db)
+	journeyReadModel := adReadModels.NewCapabilityJourneyReadModel(db)
+	return decisionRecordSubjectDirectory{lookups: map[string]subjectNameLookup{
+		"capability": nameByID(capReadModels.NewCapabilityReadModel(db).GetByID,
+			func(c *capReadModels.CapabilityDTO) string { return c.Name }),
+		"enterprise-capability": nameByID(ecReadModel.GetByID,
+			func(ec *eaReadModels.EnterpriseCapabilityDTO) string { return ec.Name }),
+		"component": nameByID(archReadModels.NewApplicationComponentReadModel(db).GetByID,
+			func(c *archReadModels.ApplicationComponentDTO) string { return c.Name }),
+		"direction": directionName(directionReadModel, ecReadModel),
+		"journey": nameByID(journeyReadModel.GetByID,
+			func(j *adReadModels.CapabilityJourneyDTO) string {
+				return fmt.Sprintf("%s (%s journey)", j.CapabilityName, j.Kind)
+			}),
+	}}
+}
+
+func (d decisionRecordSubjectDirectory) SubjectName(ctx context.Context, subjectType, subjectID string) (string, bool, error) {
+	lookup, found := d.lookups[subjectType]
+	if !found {
+		return "", false, fmt.Errorf("unknown decision record subject type %q", subjectType)
+	}
+	return lookup(ctx, subjectID)
+}
+
+func nameByID[T any](getByID func(context.Context, string) (*T, error), name func(*T) string) subjectNameLookup {
+	return func(ctx context.Context, id string) (string, bool, error) {
+		dto, err := getByID(ctx, id)
+		if err != nil || dto == nil {
+			return "", false, err
+		}
+		return name(dto), true, nil
+	}
+}
+
+func directionName(directions *adReadModels.DirectionReadModel, ecs *eaReadModels.EnterpriseCapabilityReadModel) subjectNameLookup {
+	return func(ctx context.Context, id string) (string, bool, error) {
+		direction, err := directions.GetByID(ctx, adReadModels.DirectionID(id))
+		if err != nil || direction == nil {
+			return "", false, err
+		}
+		ec, err := ecs.GetByID(ctx, direction.EnterpriseCapabilityID)
+		if err != nil {
+			return "", false, err
+		}
+		if ec == nil {
+			return fmt.Sprintf("%s direction", direction.Type), true, nil
+		}
+		return fmt.Sprintf("%s (%s direction)", ec.Name, direction.Type), true, nil
+	}
+}
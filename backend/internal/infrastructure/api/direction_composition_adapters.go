@@ -6,11 +6,13 @@ import (
 	adReadModels "easi/backend/internal/architecturedirection/application/readmodels"
 	directionServices "easi/backend/internal/architecturedirection/domain/services"
 	directionAPI "easi/backend/internal/architecturedirection/infrastructure/api"
+	authReadModels "easi/backend/internal/auth/application/readmodels"
 	capReadModels "easi/backend/internal/capabilitymapping/application/readmodels"
 	eaProjectors "easi/backend/internal/enterprisearchitecture/application/projectors"
 	eaReadModels "easi/backend/internal/enterprisearchitecture/application/readmodels"
 	eaServices "easi/backend/internal/enterprisearchitecture/application/services"
 	eaDomainServices "easi/backend/internal/enterprisearchitecture/domain/services"
+	platformRepos "easi/backend/internal/platform/infrastructure/repositories"
 )
 
 type directionSourcesAdapter struct {
@@ -156,9 +158,47 @@ func capabilityEffectivelyInDomain(readModel *capReadModels.CMEffectiveBusinessD
 	}
 }
 
+func capabilityDomainArchitects(effective *capReadModels.CMEffectiveBusinessDomainReadModel, domains *capReadModels.BusinessDomainReadModel) directionServices.CapabilityDomainArchitects {
+	return func(ctx context.Context, capabilityID string) ([]string, error) {
+		placement, err := effective.GetByCapabilityID(ctx, capabilityID)
+		if err != nil || placement == nil || placement.BusinessDomainID == "" {
+			return nil, err
+		}
+		domain, err := domains.GetByID(ctx, placement.BusinessDomainID)
+		if err != nil || domain == nil {
+			return nil, err
+		}
+		return domain.DomainArchitectIDs, nil
+	}
+}
+
+func activeUser(users *authReadModels.UserReadModel) directionServices.ActiveUser {
+	return func(ctx context.Context, userID string) (bool, error) {
+		user, err := users.GetByIDString(ctx, userID)
+		if err != nil {
+			return false, err
+		}
+		return user != nil && user.Status == "active", nil
+	}
+}
+
 func optionalString(value string) *string {
 	if value == "" {
 		return nil
 	}
 	return &value
 }
+
+func activeTenants(tenants *platformRepos.TenantRepository) directionServices.ActiveTenants {
+	return func(ctx context.Context) ([]string, error) {
+		records, err := tenants.List(ctx, "active", "")
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, len(records))
+		for i, record := range records {
+			ids[i] = record.ID
+		}
+		return ids, nil
+	}
+}
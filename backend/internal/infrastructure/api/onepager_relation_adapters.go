@@ -6,6 +6,7 @@ import (
 	adReadModels "easi/backend/internal/architecturedirection/application/readmodels"
 	archReadModels "easi/backend/internal/architecturemodeling/application/readmodels"
 	capReadModels "easi/backend/internal/capabilitymapping/application/readmodels"
+	drReadModels "easi/backend/internal/decisionrecords/application/readmodels"
 	eaReadModels "easi/backend/internal/enterprisearchitecture/application/readmodels"
 	eaServices "easi/backend/internal/enterprisearchitecture/application/services"
 	eaDomainServices "easi/backend/internal/enterprisearchitecture/domain/services"
@@ -24,6 +25,7 @@ type onePagerRelationModels struct {
 	acquiredVia   *archReadModels.AcquiredViaRelationshipReadModel
 	componentRels *archReadModels.ComponentRelationReadModel
 	composition   *eaServices.CompositionService
+	decisions     *drReadModels.DecisionRecordReadModel
 }
 
 func newOnePagerRelationModels(db *database.TenantAwareDB) onePagerRelationModels {
@@ -41,6 +43,7 @@ func newOnePagerRelationModels(db *database.TenantAwareDB) onePagerRelationModel
 		acquiredVia:   archReadModels.NewAcquiredViaRelationshipReadModel(db),
 		componentRels: archReadModels.NewComponentRelationReadModel(db),
 		composition:   eaServices.NewCompositionService(directions, metadata, enterpriseCapabilities),
+		decisions:     drReadModels.NewDecisionRecordReadModel(db),
 	}
 }
 
@@ -109,6 +112,20 @@ func (m onePagerRelationModels) applicationNames(ctx context.Context, ids []stri
 		func(a archReadModels.ApplicationComponentDTO) string { return a.Name })
 }
 
+// decisionRecordsOf lists the decision records linked to a subject, labelled
+// with their ADR key; superseded and deprecated records come last.
+func decisionRecordsOf[T any](m onePagerRelationModels, idOf func(*T) string) func(context.Context, *T) (ports.ReferenceListValue, error) {
+	return func(ctx context.Context, dto *T) (ports.ReferenceListValue, error) {
+		records, err := m.decisions.GetBySubject(ctx, idOf(dto))
+		if err != nil {
+			return ports.ReferenceListValue{}, err
+		}
+		return mapReferences(records, func(r drReadModels.DecisionRecordDTO) ports.Reference {
+			return ports.Reference{ID: r.ID, Label: r.Key + ": " + r.Title + " (" + r.Status + ")"}
+		}), nil
+	}
+}
+
 func (m onePagerRelationModels) capabilityRelations() []relationBinding[capReadModels.CapabilityDTO] {
 	return []relationBinding[capReadModels.CapabilityDTO]{
 		{entryID: "realizing-applications", resolve: m.realizingApplications},
@@ -116,6 +133,7 @@ func (m onePagerRelationModels) capabilityRelations() []relationBinding[capReadM
 		{entryID: "parent-capability", resolve: m.parentCapability},
 		{entryID: "child-capabilities", resolve: m.childCapabilities},
 		{entryID: "depends-on", resolve: m.dependsOn},
+		{entryID: "decision-records", resolve: decisionRecordsOf(m, func(c *capReadModels.CapabilityDTO) string { return c.ID })},
 	}
 }
 
@@ -164,6 +182,7 @@ func (m onePagerRelationModels) dependsOn(ctx context.Context, dto *capReadModel
 func (m onePagerRelationModels) enterpriseCapabilityRelations() []relationBinding[eaReadModels.EnterpriseCapabilityDTO] {
 	return []relationBinding[eaReadModels.EnterpriseCapabilityDTO]{
 		{entryID: "included-capabilities", resolve: m.includedCapabilities},
+		{entryID: "decision-records", resolve: decisionRecordsOf(m, func(ec *eaReadModels.EnterpriseCapabilityDTO) string { return ec.ID })},
 	}
 }
 
@@ -189,6 +208,7 @@ func (m onePagerRelationModels) applicationRelations() []relationBinding[archRea
 		{entryID: "purchased-from", resolve: m.purchasedFromVendor},
 		{entryID: "acquired-via", resolve: m.acquiredViaEntity},
 		{entryID: "component-relations", resolve: m.componentRelations},
+		{entryID: "decision-records", resolve: decisionRecordsOf(m, func(a *archReadModels.ApplicationComponentDTO) string { return a.ID })},
 	}
 }
 
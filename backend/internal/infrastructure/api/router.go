@@ -31,6 +31,8 @@ import (
 	capReadModels "easi/backend/internal/capabilitymapping/application/readmodels"
 	capAdapters "easi/backend/internal/capabilitymapping/infrastructure/adapters"
 	capabilityAPI "easi/backend/internal/capabilitymapping/infrastructure/api"
+	capMetamodel "easi/backend/internal/capabilitymapping/infrastructure/metamodel"
+	decisionRecordsAPI "easi/backend/internal/decisionrecords/infrastructure/api"
 	eaReadModels "easi/backend/internal/enterprisearchitecture/application/readmodels"
 	enterpriseArchAPI "easi/backend/internal/enterprisearchitecture/infrastructure/api"
 	importingAPI "easi/backend/internal/importing/infrastructure/api"
@@ -40,6 +42,7 @@ import (
 	metamodelAPI "easi/backend/internal/metamodel/infrastructure/api"
 	onepagersAPI "easi/backend/internal/onepagers/infrastructure/api"
 	platformAPI "easi/backend/internal/platform/infrastructure/api"
+	platformRepos "easi/backend/internal/platform/infrastructure/repositories"
 	platformPL "easi/backend/internal/platform/publishedlanguage"
 	releasesAPI "easi/backend/internal/releases/infrastructure/api"
 	sharedAPI "easi/backend/internal/shared/api"
@@ -258,6 +261,9 @@ func setupModelingRoutes(r chi.Router, deps routerDependencies) {
 		SessionProvider:      deps.authDeps.SessionManager,
 		AuthMiddleware:       deps.authDeps.AuthMiddleware,
 		OnePagerCompleteness: onePagerCompletenessFor(onePagerCompleteness, "capability"),
+		HeatmapSources:       newCapabilityHeatmapSources(deps.db, onePagerCompleteness),
+		DomainKPISources:     newBusinessDomainKPISources(deps.db, onePagerCompleteness),
+		EliminateGrades:      eliminateGradesAdapter{assessments: adReadModels.NewTimeAssessmentReadModel(deps.db)},
 	}), "capability mapping routes")
 }
 
@@ -312,17 +318,37 @@ func setupDomainRoutes(r chi.Router, deps routerDependencies) {
 		ComponentExists:               directionServices.ComponentExists(existsByID(archReadModels.NewApplicationComponentReadModel(deps.db).GetByID)),
 		DomainExists:                  directionServices.DomainExists(existsByID(capReadModels.NewBusinessDomainReadModel(deps.db).GetByID)),
 		CapabilityEffectivelyInDomain: capabilityEffectivelyInDomain(capReadModels.NewCMEffectiveBusinessDomainReadModel(deps.db)),
+		CapabilityDomainArchitects: capabilityDomainArchitects(
+			capReadModels.NewCMEffectiveBusinessDomainReadModel(deps.db), capReadModels.NewBusinessDomainReadModel(deps.db)),
+		ActiveUser:       activeUser(deps.userReadModel),
+		TenantAdmins:     directionServices.TenantAdmins(deps.userReadModel.GetActiveAdminIDs),
+		TimeSuggestions:  newTimeSuggestionSourceAdapter(deps.db),
+		CurrentLandscape: newCurrentLandscapeAdapter(deps.db),
+		ActiveTenants:    activeTenants(platformRepos.NewTenantRepository(deps.db.DB())),
+		ExecutionContext: deps.appContext,
 	}), "architecture direction routes")
 
+	mustSetup(decisionRecordsAPI.SetupDecisionRecordRoutes(decisionRecordsAPI.RoutesDeps{
+		Router:         r,
+		CommandBus:     deps.commandBus,
+		EventStore:     deps.eventStore,
+		EventBus:       deps.eventBus,
+		DB:             deps.db,
+		HATEOAS:        deps.hateoas,
+		AuthMiddleware: deps.authDeps.AuthMiddleware,
+		Subjects:       newDecisionRecordSubjectDirectory(deps.db),
+	}), "decision record routes")
+
 	mustSetup(metamodelAPI.SetupMetaModelRoutes(metamodelAPI.MetaModelRoutesDeps{
-		Router:          r,
-		CommandBus:      deps.commandBus,
-		EventStore:      deps.eventStore,
-		EventBus:        deps.eventBus,
-		DB:              deps.db,
-		Hateoas:         deps.hateoas,
-		AuthMiddleware:  deps.authDeps.AuthMiddleware,
-		SessionProvider: deps.authDeps.SessionManager,
+		Router:           r,
+		CommandBus:       deps.commandBus,
+		EventStore:       deps.eventStore,
+		EventBus:         deps.eventBus,
+		DB:               deps.db,
+		Hateoas:          deps.hateoas,
+		AuthMiddleware:   deps.authDeps.AuthMiddleware,
+		SessionProvider:  deps.authDeps.SessionManager,
+		CapabilityLevels: capReadModels.NewCapabilityReadModel(deps.db),
 	}), "metamodel routes")
 
 	mustSetup(onepagersAPI.SetupOnePagersRoutes(onepagersAPI.OnePagersRoutesDeps{
@@ -349,7 +375,7 @@ func setupSupportRoutes(r chi.Router, deps routerDependencies) {
 		EventBus:           deps.eventBus,
 		DB:                 deps.db,
 		ComponentGateway:   archAdapters.NewImportComponentGateway(deps.commandBus),
-		CapabilityGateway:  capAdapters.NewImportCapabilityGateway(deps.commandBus),
+		CapabilityGateway:  capAdapters.NewImportCapabilityGateway(deps.commandBus, capMetamodel.NewLocalCapabilityHierarchyGateway(capReadModels.NewCapabilityHierarchyCacheReadModel(deps.db))),
 		ValueStreamGateway: vsAdapters.NewImportValueStreamGateway(deps.commandBus),
 		ExecutionContext:   deps.appContext,
 	}), "importing routes")
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+
+	directionServices "easi/backend/internal/architecturedirection/domain/services"
+	eaReadModels "easi/backend/internal/enterprisearchitecture/application/readmodels"
+	eaMetamodel "easi/backend/internal/enterprisearchitecture/infrastructure/metamodel"
+	"easi/backend/internal/infrastructure/database"
+)
+
+// timeSuggestionSourceAdapter feeds the fit-gap TIME suggestions computed by
+// enterprise architecture into the architecture direction review queue.
+type timeSuggestionSourceAdapter struct {
+	readModel *eaReadModels.TimeSuggestionReadModel
+}
+
+func newTimeSuggestionSourceAdapter(db *database.TenantAwareDB) timeSuggestionSourceAdapter {
+	pillars := eaMetamodel.NewLocalStrategyPillarsGateway(eaReadModels.NewStrategyPillarCacheReadModel(db))
+	return timeSuggestionSourceAdapter{readModel: eaReadModels.NewTimeSuggestionReadModel(db, pillars)}
+}
+
+func (a timeSuggestionSourceAdapter) GetAllSuggestions(ctx context.Context) ([]directionServices.TimeSuggestion, error) {
+	items, err := a.readModel.GetAllSuggestions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]directionServices.TimeSuggestion, len(items))
+	for i, item := range items {
+		out[i] = directionServices.TimeSuggestion{
+			CapabilityID:   item.CapabilityID,
+			CapabilityName: item.CapabilityName,
+			ComponentID:    item.ComponentID,
+			ComponentName:  item.ComponentName,
+			Confidence:     item.Confidence,
+			TechnicalGap:   item.TechnicalGap,
+			FunctionalGap:  item.FunctionalGap,
+			Evidence:       make([]directionServices.PillarEvidence, len(item.Evidence)),
+		}
+		if item.SuggestedTime != nil {
+			out[i].SuggestedGrade = *item.SuggestedTime
+		}
+		for j, e := range item.Evidence {
+			out[i].Evidence[j] = directionServices.PillarEvidence(e)
+		}
+	}
+	return out, nil
+}
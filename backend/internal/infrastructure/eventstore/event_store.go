@@ -124,8 +124,13 @@ func (s *PostgresEventStore) checkVersionConflict(ctx context.Context, batch sav
 func (s *PostgresEventStore) insertEvents(ctx context.Context, batch saveBatch) error {
 	actor, hasActor := sharedctx.GetActor(ctx)
 
+	var correlationID sql.NullString
+	if id, ok := sharedctx.GetCorrelationID(ctx); ok {
+		correlationID = sql.NullString{String: id, Valid: true}
+	}
+
 	stmt, err := batch.tx.PrepareContext(ctx,
-		"INSERT INTO infrastructure.events (tenant_id, aggregate_id, event_type, event_data, version, occurred_at, actor_id, actor_email) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		"INSERT INTO infrastructure.events (tenant_id, aggregate_id, event_type, event_data, version, occurred_at, actor_id, actor_email, correlation_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
 	)
 	if err != nil {
 		return fmt.Errorf("failed to prepare statement: %w", err)
@@ -159,6 +164,7 @@ func (s *PostgresEventStore) insertEvents(ctx context.Context, batch saveBatch)
 			event.OccurredAt(),
 			actorID,
 			actorEmail,
+			correlationID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to insert event: %w", err)
@@ -0,0 +1,12 @@
+package commands
+
+type AddClassificationDimension struct {
+	ConfigID   string
+	Name       string
+	Values     []string
+	ModifiedBy string
+}
+
+func (c AddClassificationDimension) CommandName() string {
+	return "AddClassificationDimension"
+}
@@ -0,0 +1,14 @@
+package commands
+
+type AddCustomRelationType struct {
+	ConfigID    string
+	Name        string
+	Description string
+	LineStyle   string
+	Color       string
+	ModifiedBy  string
+}
+
+func (c AddCustomRelationType) CommandName() string {
+	return "AddCustomRelationType"
+}
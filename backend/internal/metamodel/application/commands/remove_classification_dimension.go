@@ -0,0 +1,11 @@
+package commands
+
+type RemoveClassificationDimension struct {
+	ConfigID    string
+	DimensionID string
+	ModifiedBy  string
+}
+
+func (c RemoveClassificationDimension) CommandName() string {
+	return "RemoveClassificationDimension"
+}
@@ -0,0 +1,11 @@
+package commands
+
+type RemoveCustomRelationType struct {
+	ConfigID       string
+	RelationTypeID string
+	ModifiedBy     string
+}
+
+func (c RemoveCustomRelationType) CommandName() string {
+	return "RemoveCustomRelationType"
+}
@@ -0,0 +1,11 @@
+package commands
+
+type UpdateCapabilityHierarchy struct {
+	ID         string
+	Labels     []string
+	ModifiedBy string
+}
+
+func (c UpdateCapabilityHierarchy) CommandName() string {
+	return "UpdateCapabilityHierarchy"
+}
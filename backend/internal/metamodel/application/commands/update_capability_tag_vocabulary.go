@@ -0,0 +1,17 @@
+package commands
+
+type CapabilityTagTerm struct {
+	Tag      string
+	Category string
+}
+
+type UpdateCapabilityTagVocabulary struct {
+	ID         string
+	Terms      []CapabilityTagTerm
+	Restricted bool
+	ModifiedBy string
+}
+
+func (c UpdateCapabilityTagVocabulary) CommandName() string {
+	return "UpdateCapabilityTagVocabulary"
+}
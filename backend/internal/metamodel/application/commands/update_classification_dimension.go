@@ -0,0 +1,13 @@
+package commands
+
+type UpdateClassificationDimension struct {
+	ConfigID    string
+	DimensionID string
+	Name        string
+	Values      []string
+	ModifiedBy  string
+}
+
+func (c UpdateClassificationDimension) CommandName() string {
+	return "UpdateClassificationDimension"
+}
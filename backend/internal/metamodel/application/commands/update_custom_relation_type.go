@@ -0,0 +1,15 @@
+package commands
+
+type UpdateCustomRelationType struct {
+	ConfigID       string
+	RelationTypeID string
+	Name           string
+	Description    string
+	LineStyle      string
+	Color          string
+	ModifiedBy     string
+}
+
+func (c UpdateCustomRelationType) CommandName() string {
+	return "UpdateCustomRelationType"
+}
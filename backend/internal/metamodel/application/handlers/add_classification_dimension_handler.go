@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/domain/valueobjects"
+	"easi/backend/internal/metamodel/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type AddClassificationDimensionHandler struct {
+	repository *repositories.MetaModelConfigurationRepository
+}
+
+func NewAddClassificationDimensionHandler(repository *repositories.MetaModelConfigurationRepository) *AddClassificationDimensionHandler {
+	return &AddClassificationDimensionHandler{
+		repository: repository,
+	}
+}
+
+func (h *AddClassificationDimensionHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.AddClassificationDimension)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	config, err := h.repository.GetByID(ctx, command.ConfigID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	modifiedBy, err := valueobjects.NewUserEmail(command.ModifiedBy)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	details, err := newClassificationDimensionDetails(command.Name, command.Values)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	id, err := config.AddClassificationDimension(details.name, details.values, modifiedBy)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, config); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.NewResult(id.Value()), nil
+}
+
+type classificationDimensionDetails struct {
+	name   valueobjects.ClassificationDimensionName
+	values valueobjects.ClassificationValues
+}
+
+func newClassificationDimensionDetails(name string, values []string) (classificationDimensionDetails, error) {
+	dimensionName, err := valueobjects.NewClassificationDimensionName(name)
+	if err != nil {
+		return classificationDimensionDetails{}, err
+	}
+
+	dimensionValues, err := valueobjects.NewClassificationValues(values)
+	if err != nil {
+		return classificationDimensionDetails{}, err
+	}
+
+	return classificationDimensionDetails{name: dimensionName, values: dimensionValues}, nil
+}
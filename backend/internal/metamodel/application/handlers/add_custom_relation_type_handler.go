@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/domain/valueobjects"
+	"easi/backend/internal/metamodel/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type AddCustomRelationTypeHandler struct {
+	repository *repositories.MetaModelConfigurationRepository
+}
+
+func NewAddCustomRelationTypeHandler(repository *repositories.MetaModelConfigurationRepository) *AddCustomRelationTypeHandler {
+	return &AddCustomRelationTypeHandler{
+		repository: repository,
+	}
+}
+
+func (h *AddCustomRelationTypeHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.AddCustomRelationType)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	config, err := h.repository.GetByID(ctx, command.ConfigID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	modifiedBy, err := valueobjects.NewUserEmail(command.ModifiedBy)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	details, err := newCustomRelationTypeDetails(command.Name, command.Description, command.LineStyle, command.Color)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	id, err := config.AddCustomRelationType(details.name, details.description, details.style, modifiedBy)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, config); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.NewResult(id.Value()), nil
+}
+
+type customRelationTypeDetails struct {
+	name        valueobjects.RelationTypeName
+	description valueobjects.RelationTypeDescription
+	style       valueobjects.EdgeStyle
+}
+
+func newCustomRelationTypeDetails(name, description, lineStyle, color string) (customRelationTypeDetails, error) {
+	relationTypeName, err := valueobjects.NewRelationTypeName(name)
+	if err != nil {
+		return customRelationTypeDetails{}, err
+	}
+
+	relationTypeDescription, err := valueobjects.NewRelationTypeDescription(description)
+	if err != nil {
+		return customRelationTypeDetails{}, err
+	}
+
+	style, err := valueobjects.NewEdgeStyle(lineStyle, color)
+	if err != nil {
+		return customRelationTypeDetails{}, err
+	}
+
+	return customRelationTypeDetails{name: relationTypeName, description: relationTypeDescription, style: style}, nil
+}
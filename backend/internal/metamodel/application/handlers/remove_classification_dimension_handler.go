@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/domain/valueobjects"
+	"easi/backend/internal/metamodel/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type RemoveClassificationDimensionHandler struct {
+	repository *repositories.MetaModelConfigurationRepository
+}
+
+func NewRemoveClassificationDimensionHandler(repository *repositories.MetaModelConfigurationRepository) *RemoveClassificationDimensionHandler {
+	return &RemoveClassificationDimensionHandler{
+		repository: repository,
+	}
+}
+
+func (h *RemoveClassificationDimensionHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.RemoveClassificationDimension)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	config, err := h.repository.GetByID(ctx, command.ConfigID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	modifiedBy, err := valueobjects.NewUserEmail(command.ModifiedBy)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	dimensionID, err := valueobjects.NewClassificationDimensionIDFromString(command.DimensionID)
+	if err != nil {
+		return cqrs.EmptyResult(), valueobjects.ErrClassificationDimensionNotFound
+	}
+
+	if err := config.RemoveClassificationDimension(dimensionID, modifiedBy); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, config); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/domain/valueobjects"
+	"easi/backend/internal/metamodel/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type RemoveCustomRelationTypeHandler struct {
+	repository *repositories.MetaModelConfigurationRepository
+}
+
+func NewRemoveCustomRelationTypeHandler(repository *repositories.MetaModelConfigurationRepository) *RemoveCustomRelationTypeHandler {
+	return &RemoveCustomRelationTypeHandler{
+		repository: repository,
+	}
+}
+
+func (h *RemoveCustomRelationTypeHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.RemoveCustomRelationType)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	config, err := h.repository.GetByID(ctx, command.ConfigID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	modifiedBy, err := valueobjects.NewUserEmail(command.ModifiedBy)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	relationTypeID, err := valueobjects.NewCustomRelationTypeIDFromString(command.RelationTypeID)
+	if err != nil {
+		return cqrs.EmptyResult(), valueobjects.ErrCustomRelationTypeNotFound
+	}
+
+	if err := config.RemoveCustomRelationType(relationTypeID, modifiedBy); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, config); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/domain/valueobjects"
+	"easi/backend/internal/metamodel/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+// CapabilityLevelReader reports the numeric level of the deepest existing
+// capability, or 0 when there are none.
+type CapabilityLevelReader interface {
+	DeepestLevel(ctx context.Context) (int, error)
+}
+
+type UpdateCapabilityHierarchyHandler struct {
+	repository *repositories.MetaModelConfigurationRepository
+	levels     CapabilityLevelReader
+}
+
+func NewUpdateCapabilityHierarchyHandler(repository *repositories.MetaModelConfigurationRepository, levels CapabilityLevelReader) *UpdateCapabilityHierarchyHandler {
+	return &UpdateCapabilityHierarchyHandler{
+		repository: repository,
+		levels:     levels,
+	}
+}
+
+func (h *UpdateCapabilityHierarchyHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.UpdateCapabilityHierarchy)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	config, err := h.repository.GetByID(ctx, command.ID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	modifiedBy, err := valueobjects.NewUserEmail(command.ModifiedBy)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	hierarchy, err := valueobjects.NewCapabilityHierarchyConfig(command.Labels)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	deepestLevel, err := h.levels.DeepestLevel(ctx)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := config.UpdateCapabilityHierarchy(hierarchy, deepestLevel, modifiedBy); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, config); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
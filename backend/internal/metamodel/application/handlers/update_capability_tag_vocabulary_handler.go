@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/domain/valueobjects"
+	"easi/backend/internal/metamodel/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type UpdateCapabilityTagVocabularyHandler struct {
+	repository *repositories.MetaModelConfigurationRepository
+}
+
+func NewUpdateCapabilityTagVocabularyHandler(repository *repositories.MetaModelConfigurationRepository) *UpdateCapabilityTagVocabularyHandler {
+	return &UpdateCapabilityTagVocabularyHandler{
+		repository: repository,
+	}
+}
+
+func (h *UpdateCapabilityTagVocabularyHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.UpdateCapabilityTagVocabulary)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	config, err := h.repository.GetByID(ctx, command.ID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	modifiedBy, err := valueobjects.NewUserEmail(command.ModifiedBy)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	inputs := make([]valueobjects.CapabilityTagTermInput, len(command.Terms))
+	for i, t := range command.Terms {
+		inputs[i] = valueobjects.CapabilityTagTermInput{Tag: t.Tag, Category: t.Category}
+	}
+	vocabulary, err := valueobjects.NewCapabilityTagVocabulary(inputs, command.Restricted)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := config.UpdateCapabilityTagVocabulary(vocabulary, modifiedBy); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, config); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
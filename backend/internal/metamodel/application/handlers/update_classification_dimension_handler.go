@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/domain/valueobjects"
+	"easi/backend/internal/metamodel/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type UpdateClassificationDimensionHandler struct {
+	repository *repositories.MetaModelConfigurationRepository
+}
+
+func NewUpdateClassificationDimensionHandler(repository *repositories.MetaModelConfigurationRepository) *UpdateClassificationDimensionHandler {
+	return &UpdateClassificationDimensionHandler{
+		repository: repository,
+	}
+}
+
+func (h *UpdateClassificationDimensionHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.UpdateClassificationDimension)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	config, err := h.repository.GetByID(ctx, command.ConfigID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	modifiedBy, err := valueobjects.NewUserEmail(command.ModifiedBy)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	dimensionID, err := valueobjects.NewClassificationDimensionIDFromString(command.DimensionID)
+	if err != nil {
+		return cqrs.EmptyResult(), valueobjects.ErrClassificationDimensionNotFound
+	}
+
+	details, err := newClassificationDimensionDetails(command.Name, command.Values)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := config.UpdateClassificationDimension(dimensionID, details.name, details.values, modifiedBy); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, config); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/domain/valueobjects"
+	"easi/backend/internal/metamodel/infrastructure/repositories"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type UpdateCustomRelationTypeHandler struct {
+	repository *repositories.MetaModelConfigurationRepository
+}
+
+func NewUpdateCustomRelationTypeHandler(repository *repositories.MetaModelConfigurationRepository) *UpdateCustomRelationTypeHandler {
+	return &UpdateCustomRelationTypeHandler{
+		repository: repository,
+	}
+}
+
+func (h *UpdateCustomRelationTypeHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.UpdateCustomRelationType)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	config, err := h.repository.GetByID(ctx, command.ConfigID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	modifiedBy, err := valueobjects.NewUserEmail(command.ModifiedBy)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	relationTypeID, err := valueobjects.NewCustomRelationTypeIDFromString(command.RelationTypeID)
+	if err != nil {
+		return cqrs.EmptyResult(), valueobjects.ErrCustomRelationTypeNotFound
+	}
+
+	details, err := newCustomRelationTypeDetails(command.Name, command.Description, command.LineStyle, command.Color)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := config.UpdateCustomRelationType(relationTypeID, details.name, details.description, details.style, modifiedBy); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	if err := h.repository.Save(ctx, config); err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	return cqrs.EmptyResult(), nil
+}
@@ -0,0 +1,48 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"easi/backend/internal/metamodel/application/readmodels"
+	"easi/backend/internal/metamodel/domain/events"
+	mmPL "easi/backend/internal/metamodel/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type CapabilityHierarchyProjector struct {
+	readModel       *readmodels.CapabilityHierarchyReadModel
+	configReadModel *readmodels.MetaModelConfigurationReadModel
+}
+
+func NewCapabilityHierarchyProjector(
+	readModel *readmodels.CapabilityHierarchyReadModel,
+	configReadModel *readmodels.MetaModelConfigurationReadModel,
+) *CapabilityHierarchyProjector {
+	return &CapabilityHierarchyProjector{
+		readModel:       readModel,
+		configReadModel: configReadModel,
+	}
+}
+
+func (p *CapabilityHierarchyProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		log.Printf("Failed to marshal event data: %v", err)
+		return err
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *CapabilityHierarchyProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	if eventType != mmPL.CapabilityHierarchyConfigUpdated {
+		return nil
+	}
+	return unmarshalAndProject(eventData, "CapabilityHierarchyConfigUpdated", func(event *events.CapabilityHierarchyConfigUpdated) error {
+		if err := p.readModel.Upsert(ctx, event.Labels, event.ModifiedAt, event.ModifiedBy); err != nil {
+			return err
+		}
+		return p.configReadModel.UpdateVersion(ctx, event.ID, event.Version, event.ModifiedAt, event.ModifiedBy)
+	})
+}
@@ -0,0 +1,57 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"easi/backend/internal/metamodel/application/readmodels"
+	"easi/backend/internal/metamodel/domain/events"
+	mmPL "easi/backend/internal/metamodel/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type CapabilityTagVocabularyProjector struct {
+	readModel       *readmodels.CapabilityTagVocabularyReadModel
+	configReadModel *readmodels.MetaModelConfigurationReadModel
+}
+
+func NewCapabilityTagVocabularyProjector(
+	readModel *readmodels.CapabilityTagVocabularyReadModel,
+	configReadModel *readmodels.MetaModelConfigurationReadModel,
+) *CapabilityTagVocabularyProjector {
+	return &CapabilityTagVocabularyProjector{
+		readModel:       readModel,
+		configReadModel: configReadModel,
+	}
+}
+
+func (p *CapabilityTagVocabularyProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		log.Printf("Failed to marshal event data: %v", err)
+		return err
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *CapabilityTagVocabularyProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	if eventType != mmPL.CapabilityTagVocabularyUpdated {
+		return nil
+	}
+	return unmarshalAndProject(eventData, "CapabilityTagVocabularyUpdated", func(event *events.CapabilityTagVocabularyUpdated) error {
+		terms := make([]readmodels.CapabilityTagTermDTO, len(event.Terms))
+		for i, t := range event.Terms {
+			terms[i] = readmodels.CapabilityTagTermDTO{Tag: t.Tag, Category: t.Category}
+		}
+		if err := p.readModel.Upsert(ctx, readmodels.CapabilityTagVocabularyUpdate{
+			Terms:      terms,
+			Restricted: event.Restricted,
+			ModifiedAt: event.ModifiedAt,
+			ModifiedBy: event.ModifiedBy,
+		}); err != nil {
+			return err
+		}
+		return p.configReadModel.UpdateVersion(ctx, event.ID, event.Version, event.ModifiedAt, event.ModifiedBy)
+	})
+}
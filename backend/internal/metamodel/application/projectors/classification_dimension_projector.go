@@ -0,0 +1,68 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"easi/backend/internal/metamodel/application/readmodels"
+	"easi/backend/internal/metamodel/domain/events"
+	mmPL "easi/backend/internal/metamodel/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type ClassificationDimensionProjector struct {
+	readModel       *readmodels.ClassificationDimensionReadModel
+	configReadModel *readmodels.MetaModelConfigurationReadModel
+}
+
+func NewClassificationDimensionProjector(
+	readModel *readmodels.ClassificationDimensionReadModel,
+	configReadModel *readmodels.MetaModelConfigurationReadModel,
+) *ClassificationDimensionProjector {
+	return &ClassificationDimensionProjector{
+		readModel:       readModel,
+		configReadModel: configReadModel,
+	}
+}
+
+func (p *ClassificationDimensionProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		log.Printf("Failed to marshal event data: %v", err)
+		return err
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *ClassificationDimensionProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	switch eventType {
+	case mmPL.ClassificationDimensionAdded:
+		return unmarshalAndProject(eventData, "ClassificationDimensionAdded", func(event *events.ClassificationDimensionAdded) error {
+			return p.upsert(ctx, event.ID, event.Version, event.ModifiedBy, readmodels.ClassificationDimensionDTO{
+				ID: event.DimensionID, Name: event.Name, Values: event.Values, Active: true, ModifiedAt: event.ModifiedAt,
+			})
+		})
+	case mmPL.ClassificationDimensionUpdated:
+		return unmarshalAndProject(eventData, "ClassificationDimensionUpdated", func(event *events.ClassificationDimensionUpdated) error {
+			return p.upsert(ctx, event.ID, event.Version, event.ModifiedBy, readmodels.ClassificationDimensionDTO{
+				ID: event.DimensionID, Name: event.Name, Values: event.Values, Active: true, ModifiedAt: event.ModifiedAt,
+			})
+		})
+	case mmPL.ClassificationDimensionRemoved:
+		return unmarshalAndProject(eventData, "ClassificationDimensionRemoved", func(event *events.ClassificationDimensionRemoved) error {
+			if err := p.readModel.Deactivate(ctx, event.DimensionID, event.ModifiedAt); err != nil {
+				return err
+			}
+			return p.configReadModel.UpdateVersion(ctx, event.ID, event.Version, event.ModifiedAt, event.ModifiedBy)
+		})
+	}
+	return nil
+}
+
+func (p *ClassificationDimensionProjector) upsert(ctx context.Context, configID string, version int, modifiedBy string, dto readmodels.ClassificationDimensionDTO) error {
+	if err := p.readModel.Upsert(ctx, dto); err != nil {
+		return err
+	}
+	return p.configReadModel.UpdateVersion(ctx, configID, version, dto.ModifiedAt, modifiedBy)
+}
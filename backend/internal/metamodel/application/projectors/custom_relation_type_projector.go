@@ -0,0 +1,70 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"easi/backend/internal/metamodel/application/readmodels"
+	"easi/backend/internal/metamodel/domain/events"
+	mmPL "easi/backend/internal/metamodel/publishedlanguage"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type CustomRelationTypeProjector struct {
+	readModel       *readmodels.CustomRelationTypeReadModel
+	configReadModel *readmodels.MetaModelConfigurationReadModel
+}
+
+func NewCustomRelationTypeProjector(
+	readModel *readmodels.CustomRelationTypeReadModel,
+	configReadModel *readmodels.MetaModelConfigurationReadModel,
+) *CustomRelationTypeProjector {
+	return &CustomRelationTypeProjector{
+		readModel:       readModel,
+		configReadModel: configReadModel,
+	}
+}
+
+func (p *CustomRelationTypeProjector) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		log.Printf("Failed to marshal event data: %v", err)
+		return err
+	}
+	return p.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+func (p *CustomRelationTypeProjector) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	switch eventType {
+	case mmPL.CustomRelationTypeAdded:
+		return unmarshalAndProject(eventData, "CustomRelationTypeAdded", func(event *events.CustomRelationTypeAdded) error {
+			return p.upsert(ctx, event.ID, event.Version, event.ModifiedBy, readmodels.CustomRelationTypeDTO{
+				ID: event.RelationTypeID, Name: event.Name, Description: event.Description,
+				LineStyle: event.LineStyle, Color: event.Color, Active: true, ModifiedAt: event.ModifiedAt,
+			})
+		})
+	case mmPL.CustomRelationTypeUpdated:
+		return unmarshalAndProject(eventData, "CustomRelationTypeUpdated", func(event *events.CustomRelationTypeUpdated) error {
+			return p.upsert(ctx, event.ID, event.Version, event.ModifiedBy, readmodels.CustomRelationTypeDTO{
+				ID: event.RelationTypeID, Name: event.Name, Description: event.Description,
+				LineStyle: event.LineStyle, Color: event.Color, Active: true, ModifiedAt: event.ModifiedAt,
+			})
+		})
+	case mmPL.CustomRelationTypeRemoved:
+		return unmarshalAndProject(eventData, "CustomRelationTypeRemoved", func(event *events.CustomRelationTypeRemoved) error {
+			if err := p.readModel.Deactivate(ctx, event.RelationTypeID, event.ModifiedAt); err != nil {
+				return err
+			}
+			return p.configReadModel.UpdateVersion(ctx, event.ID, event.Version, event.ModifiedAt, event.ModifiedBy)
+		})
+	}
+	return nil
+}
+
+func (p *CustomRelationTypeProjector) upsert(ctx context.Context, configID string, version int, modifiedBy string, dto readmodels.CustomRelationTypeDTO) error {
+	if err := p.readModel.Upsert(ctx, dto); err != nil {
+		return err
+	}
+	return p.configReadModel.UpdateVersion(ctx, configID, version, dto.ModifiedAt, modifiedBy)
+}
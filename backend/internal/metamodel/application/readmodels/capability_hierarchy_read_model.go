@@ -0,0 +1,106 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/types"
+)
+
+type CapabilityLevelLabelDTO struct {
+	Level string `json:"level"`
+	Label string `json:"label"`
+}
+
+type CapabilityHierarchyDTO struct {
+	MaxDepth   int                       `json:"maxDepth"`
+	Levels     []CapabilityLevelLabelDTO `json:"levels"`
+	IsDefault  bool                      `json:"isDefault"`
+	ModifiedAt *time.Time                `json:"modifiedAt,omitempty"`
+	ModifiedBy string                    `json:"modifiedBy,omitempty"`
+	Links      types.Links               `json:"_links,omitempty"`
+}
+
+// NewCapabilityHierarchyDTO numbers the labels as L1, L2, ... in order.
+func NewCapabilityHierarchyDTO(labels []string, isDefault bool) CapabilityHierarchyDTO {
+	levels := make([]CapabilityLevelLabelDTO, len(labels))
+	for i, label := range labels {
+		levels[i] = CapabilityLevelLabelDTO{Level: fmt.Sprintf("L%d", i+1), Label: label}
+	}
+	return CapabilityHierarchyDTO{MaxDepth: len(labels), Levels: levels, IsDefault: isDefault}
+}
+
+type CapabilityHierarchyReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewCapabilityHierarchyReadModel(db *database.TenantAwareDB) *CapabilityHierarchyReadModel {
+	return &CapabilityHierarchyReadModel{db: db}
+}
+
+func (rm *CapabilityHierarchyReadModel) Upsert(ctx context.Context, labels []string, modifiedAt time.Time, modifiedBy string) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		`INSERT INTO metamodel.capability_hierarchies
+		(tenant_id, level_labels, modified_at, modified_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (tenant_id)
+		DO UPDATE SET
+			level_labels = EXCLUDED.level_labels,
+			modified_at = EXCLUDED.modified_at,
+			modified_by = EXCLUDED.modified_by`,
+		tenantID.Value(), pq.Array(labels), modifiedAt, modifiedBy,
+	)
+	return err
+}
+
+// Get returns the tenant's configured hierarchy, or nil when the tenant has
+// never changed it from the default.
+func (rm *CapabilityHierarchyReadModel) Get(ctx context.Context) (*CapabilityHierarchyDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var labels []string
+	var modifiedAt time.Time
+	var modifiedBy string
+	var notFound bool
+
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx,
+			`SELECT level_labels, modified_at, modified_by
+			FROM metamodel.capability_hierarchies
+			WHERE tenant_id = $1`,
+			tenantID.Value(),
+		).Scan(pq.Array(&labels), &modifiedAt, &modifiedBy)
+
+		if err == sql.ErrNoRows {
+			notFound = true
+			return nil
+		}
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if notFound {
+		return nil, nil
+	}
+
+	dto := NewCapabilityHierarchyDTO(labels, false)
+	dto.ModifiedAt = &modifiedAt
+	dto.ModifiedBy = modifiedBy
+	return &dto, nil
+}
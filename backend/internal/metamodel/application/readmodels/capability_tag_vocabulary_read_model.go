@@ -0,0 +1,107 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/types"
+)
+
+type CapabilityTagTermDTO struct {
+	Tag      string `json:"tag"`
+	Category string `json:"category,omitempty"`
+}
+
+type CapabilityTagVocabularyDTO struct {
+	Terms      []CapabilityTagTermDTO `json:"terms"`
+	Restricted bool                   `json:"restricted"`
+	ModifiedAt *time.Time             `json:"modifiedAt,omitempty"`
+	ModifiedBy string                 `json:"modifiedBy,omitempty"`
+	Links      types.Links            `json:"_links,omitempty"`
+}
+
+type CapabilityTagVocabularyReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewCapabilityTagVocabularyReadModel(db *database.TenantAwareDB) *CapabilityTagVocabularyReadModel {
+	return &CapabilityTagVocabularyReadModel{db: db}
+}
+
+type CapabilityTagVocabularyUpdate struct {
+	Terms      []CapabilityTagTermDTO
+	Restricted bool
+	ModifiedAt time.Time
+	ModifiedBy string
+}
+
+func (rm *CapabilityTagVocabularyReadModel) Upsert(ctx context.Context, update CapabilityTagVocabularyUpdate) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	terms, err := json.Marshal(update.Terms)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		`INSERT INTO metamodel.capability_tag_vocabularies
+		(tenant_id, terms, restricted, modified_at, modified_by)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (tenant_id)
+		DO UPDATE SET
+			terms = EXCLUDED.terms,
+			restricted = EXCLUDED.restricted,
+			modified_at = EXCLUDED.modified_at,
+			modified_by = EXCLUDED.modified_by`,
+		tenantID.Value(), terms, update.Restricted, update.ModifiedAt, update.ModifiedBy,
+	)
+	return err
+}
+
+// Get returns the tenant's tag vocabulary, or nil when none has been defined.
+func (rm *CapabilityTagVocabularyReadModel) Get(ctx context.Context) (*CapabilityTagVocabularyDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto CapabilityTagVocabularyDTO
+	var terms []byte
+	var modifiedAt time.Time
+	var notFound bool
+
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx,
+			`SELECT terms, restricted, modified_at, modified_by
+			FROM metamodel.capability_tag_vocabularies
+			WHERE tenant_id = $1`,
+			tenantID.Value(),
+		).Scan(&terms, &dto.Restricted, &modifiedAt, &dto.ModifiedBy)
+
+		if err == sql.ErrNoRows {
+			notFound = true
+			return nil
+		}
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if notFound {
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(terms, &dto.Terms); err != nil {
+		return nil, err
+	}
+	dto.ModifiedAt = &modifiedAt
+	return &dto, nil
+}
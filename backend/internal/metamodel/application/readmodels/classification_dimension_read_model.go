@@ -0,0 +1,133 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/types"
+)
+
+type ClassificationDimensionDTO struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Values     []string    `json:"values"`
+	Active     bool        `json:"active"`
+	ModifiedAt time.Time   `json:"modifiedAt"`
+	Links      types.Links `json:"_links,omitempty"`
+}
+
+type ClassificationDimensionReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewClassificationDimensionReadModel(db *database.TenantAwareDB) *ClassificationDimensionReadModel {
+	return &ClassificationDimensionReadModel{db: db}
+}
+
+func (rm *ClassificationDimensionReadModel) Upsert(ctx context.Context, dto ClassificationDimensionDTO) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		`INSERT INTO metamodel.classification_dimensions
+		(id, tenant_id, name, allowed_values, active, modified_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (tenant_id, id)
+		DO UPDATE SET
+			name = EXCLUDED.name,
+			allowed_values = EXCLUDED.allowed_values,
+			active = EXCLUDED.active,
+			modified_at = EXCLUDED.modified_at`,
+		dto.ID, tenantID.Value(), dto.Name, pq.Array(dto.Values), dto.Active, dto.ModifiedAt,
+	)
+	return err
+}
+
+func (rm *ClassificationDimensionReadModel) Deactivate(ctx context.Context, id string, modifiedAt time.Time) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"UPDATE metamodel.classification_dimensions SET active = FALSE, modified_at = $1 WHERE tenant_id = $2 AND id = $3",
+		modifiedAt, tenantID.Value(), id,
+	)
+	return err
+}
+
+func (rm *ClassificationDimensionReadModel) GetAll(ctx context.Context, includeInactive bool) ([]ClassificationDimensionDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT id, name, allowed_values, active, modified_at
+		FROM metamodel.classification_dimensions
+		WHERE tenant_id = $1`
+	if !includeInactive {
+		query += " AND active = TRUE"
+	}
+	query += " ORDER BY LOWER(name)"
+
+	dimensions := make([]ClassificationDimensionDTO, 0)
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, tenantID.Value())
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto ClassificationDimensionDTO
+			if err := rows.Scan(&dto.ID, &dto.Name, pq.Array(&dto.Values), &dto.Active, &dto.ModifiedAt); err != nil {
+				return err
+			}
+			dimensions = append(dimensions, dto)
+		}
+		return rows.Err()
+	})
+
+	return dimensions, err
+}
+
+func (rm *ClassificationDimensionReadModel) GetByID(ctx context.Context, id string) (*ClassificationDimensionDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto ClassificationDimensionDTO
+	var notFound bool
+
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx,
+			`SELECT id, name, allowed_values, active, modified_at
+			FROM metamodel.classification_dimensions
+			WHERE tenant_id = $1 AND id = $2`,
+			tenantID.Value(), id,
+		).Scan(&dto.ID, &dto.Name, pq.Array(&dto.Values), &dto.Active, &dto.ModifiedAt)
+
+		if err == sql.ErrNoRows {
+			notFound = true
+			return nil
+		}
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if notFound {
+		return nil, nil
+	}
+
+	return &dto, nil
+}
@@ -0,0 +1,135 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"easi/backend/internal/infrastructure/database"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/types"
+)
+
+type CustomRelationTypeDTO struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	LineStyle   string      `json:"lineStyle"`
+	Color       string      `json:"color,omitempty"`
+	Active      bool        `json:"active"`
+	ModifiedAt  time.Time   `json:"modifiedAt"`
+	Links       types.Links `json:"_links,omitempty"`
+}
+
+type CustomRelationTypeReadModel struct {
+	db *database.TenantAwareDB
+}
+
+func NewCustomRelationTypeReadModel(db *database.TenantAwareDB) *CustomRelationTypeReadModel {
+	return &CustomRelationTypeReadModel{db: db}
+}
+
+func (rm *CustomRelationTypeReadModel) Upsert(ctx context.Context, dto CustomRelationTypeDTO) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		`INSERT INTO metamodel.custom_relation_types
+		(id, tenant_id, name, description, line_style, color, active, modified_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (tenant_id, id)
+		DO UPDATE SET
+			name = EXCLUDED.name,
+			description = EXCLUDED.description,
+			line_style = EXCLUDED.line_style,
+			color = EXCLUDED.color,
+			active = EXCLUDED.active,
+			modified_at = EXCLUDED.modified_at`,
+		dto.ID, tenantID.Value(), dto.Name, dto.Description, dto.LineStyle, dto.Color, dto.Active, dto.ModifiedAt,
+	)
+	return err
+}
+
+func (rm *CustomRelationTypeReadModel) Deactivate(ctx context.Context, id string, modifiedAt time.Time) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		"UPDATE metamodel.custom_relation_types SET active = FALSE, modified_at = $1 WHERE tenant_id = $2 AND id = $3",
+		modifiedAt, tenantID.Value(), id,
+	)
+	return err
+}
+
+func (rm *CustomRelationTypeReadModel) GetAll(ctx context.Context, includeInactive bool) ([]CustomRelationTypeDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT id, name, description, line_style, color, active, modified_at
+		FROM metamodel.custom_relation_types
+		WHERE tenant_id = $1`
+	if !includeInactive {
+		query += " AND active = TRUE"
+	}
+	query += " ORDER BY LOWER(name)"
+
+	relationTypes := make([]CustomRelationTypeDTO, 0)
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, tenantID.Value())
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dto CustomRelationTypeDTO
+			if err := rows.Scan(&dto.ID, &dto.Name, &dto.Description, &dto.LineStyle, &dto.Color, &dto.Active, &dto.ModifiedAt); err != nil {
+				return err
+			}
+			relationTypes = append(relationTypes, dto)
+		}
+		return rows.Err()
+	})
+
+	return relationTypes, err
+}
+
+func (rm *CustomRelationTypeReadModel) GetByID(ctx context.Context, id string) (*CustomRelationTypeDTO, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto CustomRelationTypeDTO
+	var notFound bool
+
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx,
+			`SELECT id, name, description, line_style, color, active, modified_at
+			FROM metamodel.custom_relation_types
+			WHERE tenant_id = $1 AND id = $2`,
+			tenantID.Value(), id,
+		).Scan(&dto.ID, &dto.Name, &dto.Description, &dto.LineStyle, &dto.Color, &dto.Active, &dto.ModifiedAt)
+
+		if err == sql.ErrNoRows {
+			notFound = true
+			return nil
+		}
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if notFound {
+		return nil, nil
+	}
+
+	return &dto, nil
+}
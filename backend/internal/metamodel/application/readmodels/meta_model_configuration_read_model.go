@@ -109,6 +109,21 @@ func (rm *MetaModelConfigurationReadModel) Update(ctx context.Context, params Up
 	return err
 }
 
+func (rm *MetaModelConfigurationReadModel) UpdateVersion(ctx context.Context, id string, version int, modifiedAt time.Time, modifiedBy string) error {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rm.db.ExecContext(ctx,
+		`UPDATE metamodel.meta_model_configurations
+		SET version = $1, modified_at = $2, modified_by = $3
+		WHERE tenant_id = $4 AND id = $5`,
+		version, modifiedAt, modifiedBy, tenantID.Value(), id,
+	)
+	return err
+}
+
 func (rm *MetaModelConfigurationReadModel) GetByID(ctx context.Context, id string) (*MetaModelConfigurationDTO, error) {
 	tenantID, err := sharedctx.GetTenant(ctx)
 	if err != nil {
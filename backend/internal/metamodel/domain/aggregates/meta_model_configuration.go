@@ -15,6 +15,10 @@ type MetaModelConfiguration struct {
 	tenantID              sharedvo.TenantID
 	maturityScaleConfig   valueobjects.MaturityScaleConfig
 	strategyPillarsConfig valueobjects.StrategyPillarsConfig
+	customRelationTypes   valueobjects.CustomRelationTypesConfig
+	classificationDims    valueobjects.ClassificationDimensionsConfig
+	capabilityHierarchy   valueobjects.CapabilityHierarchyConfig
+	capabilityTags        valueobjects.CapabilityTagVocabulary
 	createdAt             valueobjects.Timestamp
 	modifiedAt            valueobjects.Timestamp
 	modifiedBy            valueobjects.UserEmail
@@ -113,6 +117,22 @@ func (m *MetaModelConfiguration) apply(event domain.DomainEvent) error {
 		return m.applyPillarRemoved(e)
 	case events.PillarFitConfigurationUpdated:
 		return m.applyPillarFitConfigurationUpdated(e)
+	case events.CustomRelationTypeAdded:
+		return m.applyCustomRelationTypeAdded(e)
+	case events.CustomRelationTypeUpdated:
+		return m.applyCustomRelationTypeUpdated(e)
+	case events.CustomRelationTypeRemoved:
+		return m.applyCustomRelationTypeRemoved(e)
+	case events.ClassificationDimensionAdded:
+		return m.applyClassificationDimensionAdded(e)
+	case events.ClassificationDimensionUpdated:
+		return m.applyClassificationDimensionUpdated(e)
+	case events.ClassificationDimensionRemoved:
+		return m.applyClassificationDimensionRemoved(e)
+	case events.CapabilityHierarchyConfigUpdated:
+		return m.applyCapabilityHierarchyUpdated(e)
+	case events.CapabilityTagVocabularyUpdated:
+		return m.applyCapabilityTagVocabularyUpdated(e)
 	}
 	return nil
 }
@@ -146,6 +166,7 @@ func (m *MetaModelConfiguration) applyCreated(e events.MetaModelConfigurationCre
 	m.tenantID = tenantID
 	m.maturityScaleConfig = maturityConfig
 	m.strategyPillarsConfig = pillarsConfig
+	m.capabilityHierarchy = valueobjects.DefaultCapabilityHierarchyConfig()
 	m.createdAt = createdAt
 	m.modifiedAt = modifiedAt
 	m.modifiedBy = modifiedBy
@@ -250,6 +271,150 @@ func (m *MetaModelConfiguration) applyPillarFitConfigurationUpdated(e events.Pil
 	return m.applyModificationMetadata(e.ModifiedAt, e.ModifiedBy)
 }
 
+func (m *MetaModelConfiguration) applyCustomRelationTypeAdded(e events.CustomRelationTypeAdded) error {
+	id, err := valueobjects.NewCustomRelationTypeIDFromString(e.RelationTypeID)
+	if err != nil {
+		return fmt.Errorf("%w: relation type ID %q: %v", domain.ErrCorruptedEvent, e.RelationTypeID, err)
+	}
+	name, description, style, err := customRelationTypeDetailsFromEvent(e.Name, e.Description, e.LineStyle, e.Color)
+	if err != nil {
+		return fmt.Errorf("%w: custom relation type: %v", domain.ErrCorruptedEvent, err)
+	}
+	config, err := m.customRelationTypes.WithAdded(valueobjects.NewCustomRelationType(id, name, description, style))
+	if err != nil {
+		return fmt.Errorf("%w: adding custom relation type: %v", domain.ErrCorruptedEvent, err)
+	}
+	m.customRelationTypes = config
+	return m.applyModificationMetadata(e.ModifiedAt, e.ModifiedBy)
+}
+
+func (m *MetaModelConfiguration) applyCustomRelationTypeUpdated(e events.CustomRelationTypeUpdated) error {
+	id, err := valueobjects.NewCustomRelationTypeIDFromString(e.RelationTypeID)
+	if err != nil {
+		return fmt.Errorf("%w: relation type ID %q: %v", domain.ErrCorruptedEvent, e.RelationTypeID, err)
+	}
+	name, description, style, err := customRelationTypeDetailsFromEvent(e.Name, e.Description, e.LineStyle, e.Color)
+	if err != nil {
+		return fmt.Errorf("%w: custom relation type: %v", domain.ErrCorruptedEvent, err)
+	}
+	config, err := m.customRelationTypes.WithUpdated(id, name, description, style)
+	if err != nil {
+		return fmt.Errorf("%w: updating custom relation type: %v", domain.ErrCorruptedEvent, err)
+	}
+	m.customRelationTypes = config
+	return m.applyModificationMetadata(e.ModifiedAt, e.ModifiedBy)
+}
+
+func (m *MetaModelConfiguration) applyCustomRelationTypeRemoved(e events.CustomRelationTypeRemoved) error {
+	id, err := valueobjects.NewCustomRelationTypeIDFromString(e.RelationTypeID)
+	if err != nil {
+		return fmt.Errorf("%w: relation type ID %q: %v", domain.ErrCorruptedEvent, e.RelationTypeID, err)
+	}
+	config, err := m.customRelationTypes.WithRemoved(id)
+	if err != nil {
+		return fmt.Errorf("%w: removing custom relation type: %v", domain.ErrCorruptedEvent, err)
+	}
+	m.customRelationTypes = config
+	return m.applyModificationMetadata(e.ModifiedAt, e.ModifiedBy)
+}
+
+func customRelationTypeDetailsFromEvent(rawName, rawDescription, lineStyle, color string) (valueobjects.RelationTypeName, valueobjects.RelationTypeDescription, valueobjects.EdgeStyle, error) {
+	name, err := valueobjects.NewRelationTypeName(rawName)
+	if err != nil {
+		return valueobjects.RelationTypeName{}, valueobjects.RelationTypeDescription{}, valueobjects.EdgeStyle{}, fmt.Errorf("name %q: %v", rawName, err)
+	}
+	description, err := valueobjects.NewRelationTypeDescription(rawDescription)
+	if err != nil {
+		return valueobjects.RelationTypeName{}, valueobjects.RelationTypeDescription{}, valueobjects.EdgeStyle{}, fmt.Errorf("description: %v", err)
+	}
+	style, err := valueobjects.NewEdgeStyle(lineStyle, color)
+	if err != nil {
+		return valueobjects.RelationTypeName{}, valueobjects.RelationTypeDescription{}, valueobjects.EdgeStyle{}, fmt.Errorf("style: %v", err)
+	}
+	return name, description, style, nil
+}
+
+func (m *MetaModelConfiguration) applyClassificationDimensionAdded(e events.ClassificationDimensionAdded) error {
+	id, err := valueobjects.NewClassificationDimensionIDFromString(e.DimensionID)
+	if err != nil {
+		return fmt.Errorf("%w: classification dimension ID %q: %v", domain.ErrCorruptedEvent, e.DimensionID, err)
+	}
+	name, values, err := classificationDimensionDetailsFromEvent(e.Name, e.Values)
+	if err != nil {
+		return fmt.Errorf("%w: classification dimension: %v", domain.ErrCorruptedEvent, err)
+	}
+	config, err := m.classificationDims.WithAdded(valueobjects.NewClassificationDimension(id, name, values))
+	if err != nil {
+		return fmt.Errorf("%w: adding classification dimension: %v", domain.ErrCorruptedEvent, err)
+	}
+	m.classificationDims = config
+	return m.applyModificationMetadata(e.ModifiedAt, e.ModifiedBy)
+}
+
+func (m *MetaModelConfiguration) applyClassificationDimensionUpdated(e events.ClassificationDimensionUpdated) error {
+	id, err := valueobjects.NewClassificationDimensionIDFromString(e.DimensionID)
+	if err != nil {
+		return fmt.Errorf("%w: classification dimension ID %q: %v", domain.ErrCorruptedEvent, e.DimensionID, err)
+	}
+	name, values, err := classificationDimensionDetailsFromEvent(e.Name, e.Values)
+	if err != nil {
+		return fmt.Errorf("%w: classification dimension: %v", domain.ErrCorruptedEvent, err)
+	}
+	config, err := m.classificationDims.WithUpdated(id, name, values)
+	if err != nil {
+		return fmt.Errorf("%w: updating classification dimension: %v", domain.ErrCorruptedEvent, err)
+	}
+	m.classificationDims = config
+	return m.applyModificationMetadata(e.ModifiedAt, e.ModifiedBy)
+}
+
+func (m *MetaModelConfiguration) applyClassificationDimensionRemoved(e events.ClassificationDimensionRemoved) error {
+	id, err := valueobjects.NewClassificationDimensionIDFromString(e.DimensionID)
+	if err != nil {
+		return fmt.Errorf("%w: classification dimension ID %q: %v", domain.ErrCorruptedEvent, e.DimensionID, err)
+	}
+	config, err := m.classificationDims.WithRemoved(id)
+	if err != nil {
+		return fmt.Errorf("%w: removing classification dimension: %v", domain.ErrCorruptedEvent, err)
+	}
+	m.classificationDims = config
+	return m.applyModificationMetadata(e.ModifiedAt, e.ModifiedBy)
+}
+
+func classificationDimensionDetailsFromEvent(rawName string, rawValues []string) (valueobjects.ClassificationDimensionName, valueobjects.ClassificationValues, error) {
+	name, err := valueobjects.NewClassificationDimensionName(rawName)
+	if err != nil {
+		return valueobjects.ClassificationDimensionName{}, valueobjects.ClassificationValues{}, fmt.Errorf("name %q: %v", rawName, err)
+	}
+	values, err := valueobjects.NewClassificationValues(rawValues)
+	if err != nil {
+		return valueobjects.ClassificationDimensionName{}, valueobjects.ClassificationValues{}, fmt.Errorf("values: %v", err)
+	}
+	return name, values, nil
+}
+
+func (m *MetaModelConfiguration) applyCapabilityHierarchyUpdated(e events.CapabilityHierarchyConfigUpdated) error {
+	config, err := valueobjects.NewCapabilityHierarchyConfig(e.Labels)
+	if err != nil {
+		return fmt.Errorf("%w: capability hierarchy config: %v", domain.ErrCorruptedEvent, err)
+	}
+	m.capabilityHierarchy = config
+	return m.applyModificationMetadata(e.ModifiedAt, e.ModifiedBy)
+}
+
+func (m *MetaModelConfiguration) applyCapabilityTagVocabularyUpdated(e events.CapabilityTagVocabularyUpdated) error {
+	inputs := make([]valueobjects.CapabilityTagTermInput, len(e.Terms))
+	for i, t := range e.Terms {
+		inputs[i] = valueobjects.CapabilityTagTermInput{Tag: t.Tag, Category: t.Category}
+	}
+	vocabulary, err := valueobjects.NewCapabilityTagVocabulary(inputs, e.Restricted)
+	if err != nil {
+		return fmt.Errorf("%w: capability tag vocabulary: %v", domain.ErrCorruptedEvent, err)
+	}
+	m.capabilityTags = vocabulary
+	return m.applyModificationMetadata(e.ModifiedAt, e.ModifiedBy)
+}
+
 func (m *MetaModelConfiguration) applyModificationMetadata(modifiedAtRaw time.Time, modifiedByRaw string) error {
 	modifiedAt, err := valueobjects.NewTimestamp(modifiedAtRaw)
 	if err != nil {
@@ -367,6 +532,155 @@ func (m *MetaModelConfiguration) UpdatePillarFitConfiguration(id valueobjects.St
 	return m.applyAndRaise(event)
 }
 
+func (m *MetaModelConfiguration) CustomRelationTypes() valueobjects.CustomRelationTypesConfig {
+	return m.customRelationTypes
+}
+
+func (m *MetaModelConfiguration) AddCustomRelationType(name valueobjects.RelationTypeName, description valueobjects.RelationTypeDescription, style valueobjects.EdgeStyle, modifiedBy valueobjects.UserEmail) (valueobjects.CustomRelationTypeID, error) {
+	id := valueobjects.NewCustomRelationTypeID()
+	if _, err := m.customRelationTypes.WithAdded(valueobjects.NewCustomRelationType(id, name, description, style)); err != nil {
+		return valueobjects.CustomRelationTypeID{}, err
+	}
+
+	event := events.NewCustomRelationTypeAdded(m.customRelationTypeDetailsParams(id, name, description, style, modifiedBy))
+	if err := m.applyAndRaise(event); err != nil {
+		return valueobjects.CustomRelationTypeID{}, err
+	}
+	return id, nil
+}
+
+func (m *MetaModelConfiguration) UpdateCustomRelationType(id valueobjects.CustomRelationTypeID, name valueobjects.RelationTypeName, description valueobjects.RelationTypeDescription, style valueobjects.EdgeStyle, modifiedBy valueobjects.UserEmail) error {
+	if _, err := m.customRelationTypes.WithUpdated(id, name, description, style); err != nil {
+		return err
+	}
+
+	event := events.NewCustomRelationTypeUpdated(m.customRelationTypeDetailsParams(id, name, description, style, modifiedBy))
+	return m.applyAndRaise(event)
+}
+
+func (m *MetaModelConfiguration) RemoveCustomRelationType(id valueobjects.CustomRelationTypeID, modifiedBy valueobjects.UserEmail) error {
+	if _, err := m.customRelationTypes.WithRemoved(id); err != nil {
+		return err
+	}
+
+	event := events.NewCustomRelationTypeRemoved(m.customRelationTypeEventParams(id, modifiedBy))
+	return m.applyAndRaise(event)
+}
+
+func (m *MetaModelConfiguration) customRelationTypeEventParams(id valueobjects.CustomRelationTypeID, modifiedBy valueobjects.UserEmail) events.CustomRelationTypeEventParams {
+	return events.CustomRelationTypeEventParams{
+		ConfigID:       m.ID(),
+		TenantID:       m.tenantID.Value(),
+		Version:        m.Version() + 1,
+		RelationTypeID: id.Value(),
+		ModifiedBy:     modifiedBy.Value(),
+	}
+}
+
+func (m *MetaModelConfiguration) customRelationTypeDetailsParams(id valueobjects.CustomRelationTypeID, name valueobjects.RelationTypeName, description valueobjects.RelationTypeDescription, style valueobjects.EdgeStyle, modifiedBy valueobjects.UserEmail) events.CustomRelationTypeDetailsParams {
+	return events.CustomRelationTypeDetailsParams{
+		CustomRelationTypeEventParams: m.customRelationTypeEventParams(id, modifiedBy),
+		Name:                          name.Value(),
+		Description:                   description.Value(),
+		LineStyle:                     style.LineStyle(),
+		Color:                         style.Color(),
+	}
+}
+
+func (m *MetaModelConfiguration) ClassificationDimensions() valueobjects.ClassificationDimensionsConfig {
+	return m.classificationDims
+}
+
+func (m *MetaModelConfiguration) AddClassificationDimension(name valueobjects.ClassificationDimensionName, values valueobjects.ClassificationValues, modifiedBy valueobjects.UserEmail) (valueobjects.ClassificationDimensionID, error) {
+	id := valueobjects.NewClassificationDimensionID()
+	if _, err := m.classificationDims.WithAdded(valueobjects.NewClassificationDimension(id, name, values)); err != nil {
+		return valueobjects.ClassificationDimensionID{}, err
+	}
+
+	event := events.NewClassificationDimensionAdded(m.classificationDimensionDetailsParams(id, name, values, modifiedBy))
+	if err := m.applyAndRaise(event); err != nil {
+		return valueobjects.ClassificationDimensionID{}, err
+	}
+	return id, nil
+}
+
+func (m *MetaModelConfiguration) UpdateClassificationDimension(id valueobjects.ClassificationDimensionID, name valueobjects.ClassificationDimensionName, values valueobjects.ClassificationValues, modifiedBy valueobjects.UserEmail) error {
+	if _, err := m.classificationDims.WithUpdated(id, name, values); err != nil {
+		return err
+	}
+
+	event := events.NewClassificationDimensionUpdated(m.classificationDimensionDetailsParams(id, name, values, modifiedBy))
+	return m.applyAndRaise(event)
+}
+
+func (m *MetaModelConfiguration) RemoveClassificationDimension(id valueobjects.ClassificationDimensionID, modifiedBy valueobjects.UserEmail) error {
+	if _, err := m.classificationDims.WithRemoved(id); err != nil {
+		return err
+	}
+
+	event := events.NewClassificationDimensionRemoved(m.classificationDimensionEventParams(id, modifiedBy))
+	return m.applyAndRaise(event)
+}
+
+func (m *MetaModelConfiguration) classificationDimensionEventParams(id valueobjects.ClassificationDimensionID, modifiedBy valueobjects.UserEmail) events.ClassificationDimensionEventParams {
+	return events.ClassificationDimensionEventParams{
+		ConfigID:    m.ID(),
+		TenantID:    m.tenantID.Value(),
+		Version:     m.Version() + 1,
+		DimensionID: id.Value(),
+		ModifiedBy:  modifiedBy.Value(),
+	}
+}
+
+func (m *MetaModelConfiguration) classificationDimensionDetailsParams(id valueobjects.ClassificationDimensionID, name valueobjects.ClassificationDimensionName, values valueobjects.ClassificationValues, modifiedBy valueobjects.UserEmail) events.ClassificationDimensionDetailsParams {
+	return events.ClassificationDimensionDetailsParams{
+		ClassificationDimensionEventParams: m.classificationDimensionEventParams(id, modifiedBy),
+		Name:                               name.Value(),
+		Values:                             values.Values(),
+	}
+}
+
+func (m *MetaModelConfiguration) CapabilityHierarchy() valueobjects.CapabilityHierarchyConfig {
+	return m.capabilityHierarchy
+}
+
+// UpdateCapabilityHierarchy replaces the level labels. The hierarchy cannot be
+// made shallower than the deepest level a capability already sits at, since
+// those capabilities could then no longer be moved or re-levelled.
+func (m *MetaModelConfiguration) UpdateCapabilityHierarchy(config valueobjects.CapabilityHierarchyConfig, deepestLevelInUse int, modifiedBy valueobjects.UserEmail) error {
+	if config.MaxDepth() < deepestLevelInUse {
+		return valueobjects.ErrCapabilityHierarchyBelowExistingLevels
+	}
+	event := events.NewCapabilityHierarchyConfigUpdated(
+		m.ID(),
+		m.tenantID.Value(),
+		m.Version()+1,
+		config.Labels(),
+		modifiedBy.Value(),
+	)
+	return m.applyAndRaise(event)
+}
+
+func (m *MetaModelConfiguration) CapabilityTagVocabulary() valueobjects.CapabilityTagVocabulary {
+	return m.capabilityTags
+}
+
+func (m *MetaModelConfiguration) UpdateCapabilityTagVocabulary(vocabulary valueobjects.CapabilityTagVocabulary, modifiedBy valueobjects.UserEmail) error {
+	terms := make([]events.CapabilityTagTermData, 0, len(vocabulary.Terms()))
+	for _, t := range vocabulary.Terms() {
+		terms = append(terms, events.CapabilityTagTermData{Tag: t.Tag(), Category: t.Category()})
+	}
+	event := events.NewCapabilityTagVocabularyUpdated(events.CapabilityTagVocabularyParams{
+		ConfigID:   m.ID(),
+		TenantID:   m.tenantID.Value(),
+		Version:    m.Version() + 1,
+		Terms:      terms,
+		Restricted: vocabulary.Restricted(),
+		ModifiedBy: modifiedBy.Value(),
+	})
+	return m.applyAndRaise(event)
+}
+
 func maturityScaleConfigToEventData(config valueobjects.MaturityScaleConfig) []events.MaturitySectionData {
 	sections := config.Sections()
 	data := make([]events.MaturitySectionData, 4)
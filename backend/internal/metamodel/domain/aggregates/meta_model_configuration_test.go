@@ -270,3 +270,233 @@ func createCustomMaturityScaleConfig() valueobjects.MaturityScaleConfig {
 	config, _ := valueobjects.NewMaturityScaleConfig([4]valueobjects.MaturitySection{section1, section2, section3, section4})
 	return config
 }
+
+func addCustomRelationType(t *testing.T, config *MetaModelConfiguration, name string) valueobjects.CustomRelationTypeID {
+	t.Helper()
+	relationName, _ := valueobjects.NewRelationTypeName(name)
+	description, _ := valueobjects.NewRelationTypeDescription("Replicates data nightly")
+	style, _ := valueobjects.NewEdgeStyle("dashed", "#1a2b3c")
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+	id, err := config.AddCustomRelationType(relationName, description, style, modifiedBy)
+	require.NoError(t, err)
+	return id
+}
+
+func TestAddCustomRelationType_RaisesEvent(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+
+	id := addCustomRelationType(t, config, "Replicates")
+
+	changes := config.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	event, ok := changes[0].(events.CustomRelationTypeAdded)
+	require.True(t, ok)
+	assert.Equal(t, id.Value(), event.RelationTypeID)
+	assert.Equal(t, "Replicates", event.Name)
+	assert.Equal(t, "dashed", event.LineStyle)
+	assert.Equal(t, "#1A2B3C", event.Color)
+
+	added, found := config.CustomRelationTypes().FindByID(id)
+	require.True(t, found)
+	assert.True(t, added.IsActive())
+}
+
+func TestAddCustomRelationType_RejectsDuplicateName(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+	addCustomRelationType(t, config, "Replicates")
+
+	name, _ := valueobjects.NewRelationTypeName("replicates")
+	style, _ := valueobjects.NewEdgeStyle("", "")
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+	_, err := config.AddCustomRelationType(name, valueobjects.RelationTypeDescription{}, style, modifiedBy)
+
+	assert.ErrorIs(t, err, valueobjects.ErrCustomRelationTypeNameDuplicate)
+}
+
+func TestRemoveCustomRelationType_DeactivatesAndFreesName(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+	id := addCustomRelationType(t, config, "Replicates")
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+
+	require.NoError(t, config.RemoveCustomRelationType(id, modifiedBy))
+
+	removed, found := config.CustomRelationTypes().FindByID(id)
+	require.True(t, found)
+	assert.False(t, removed.IsActive())
+	assert.ErrorIs(t, config.RemoveCustomRelationType(id, modifiedBy), valueobjects.ErrCustomRelationTypeAlreadyInactive)
+
+	addCustomRelationType(t, config, "Replicates")
+}
+
+func TestUpdateCustomRelationType_UnknownID(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+	name, _ := valueobjects.NewRelationTypeName("Replicates")
+	style, _ := valueobjects.NewEdgeStyle("", "")
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+
+	err := config.UpdateCustomRelationType(valueobjects.NewCustomRelationTypeID(), name, valueobjects.RelationTypeDescription{}, style, modifiedBy)
+
+	assert.ErrorIs(t, err, valueobjects.ErrCustomRelationTypeNotFound)
+}
+
+func TestCustomRelationTypes_RebuiltFromHistory(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+	history := []domain.DomainEvent{newDefaultConfigCreatedEvent()}
+
+	id := addCustomRelationType(t, config, "Replicates")
+	history = append(history, config.GetUncommittedChanges()...)
+	config.MarkChangesAsCommitted()
+
+	name, _ := valueobjects.NewRelationTypeName("Mirrors")
+	description, _ := valueobjects.NewRelationTypeDescription("")
+	style, _ := valueobjects.NewEdgeStyle("dotted", "")
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+	require.NoError(t, config.UpdateCustomRelationType(id, name, description, style, modifiedBy))
+	history = append(history, config.GetUncommittedChanges()...)
+
+	loaded, err := LoadMetaModelConfigurationFromHistory(history)
+	require.NoError(t, err)
+
+	rebuilt, found := loaded.CustomRelationTypes().FindByID(id)
+	require.True(t, found)
+	assert.Equal(t, "Mirrors", rebuilt.Name().Value())
+	assert.Equal(t, "dotted", rebuilt.Style().LineStyle())
+}
+
+func addClassificationDimension(t *testing.T, config *MetaModelConfiguration, name string, values ...string) valueobjects.ClassificationDimensionID {
+	t.Helper()
+	dimensionName, _ := valueobjects.NewClassificationDimensionName(name)
+	dimensionValues, err := valueobjects.NewClassificationValues(values)
+	require.NoError(t, err)
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+	id, err := config.AddClassificationDimension(dimensionName, dimensionValues, modifiedBy)
+	require.NoError(t, err)
+	return id
+}
+
+func TestAddClassificationDimension_RaisesEvent(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+
+	id := addClassificationDimension(t, config, "Hosting model", "SaaS", "On-premise")
+
+	changes := config.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	event, ok := changes[0].(events.ClassificationDimensionAdded)
+	require.True(t, ok)
+	assert.Equal(t, id.Value(), event.DimensionID)
+	assert.Equal(t, "Hosting model", event.Name)
+	assert.Equal(t, []string{"SaaS", "On-premise"}, event.Values)
+}
+
+func TestAddClassificationDimension_RejectsDuplicateName(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+	addClassificationDimension(t, config, "Hosting model", "SaaS")
+
+	name, _ := valueobjects.NewClassificationDimensionName("hosting MODEL")
+	values, _ := valueobjects.NewClassificationValues([]string{"Cloud"})
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+	_, err := config.AddClassificationDimension(name, values, modifiedBy)
+
+	assert.ErrorIs(t, err, valueobjects.ErrClassificationDimensionNameDuplicate)
+}
+
+func TestRemoveClassificationDimension_Deactivates(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+	id := addClassificationDimension(t, config, "Business criticality", "High", "Low")
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+
+	require.NoError(t, config.RemoveClassificationDimension(id, modifiedBy))
+
+	removed, found := config.ClassificationDimensions().FindByID(id)
+	require.True(t, found)
+	assert.False(t, removed.IsActive())
+	assert.ErrorIs(t, config.RemoveClassificationDimension(id, modifiedBy), valueobjects.ErrClassificationDimensionAlreadyInactive)
+}
+
+func TestClassificationDimensions_RebuiltFromHistory(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+	history := []domain.DomainEvent{newDefaultConfigCreatedEvent()}
+
+	id := addClassificationDimension(t, config, "User base", "Internal", "External")
+	history = append(history, config.GetUncommittedChanges()...)
+	config.MarkChangesAsCommitted()
+
+	name, _ := valueobjects.NewClassificationDimensionName("Audience")
+	values, _ := valueobjects.NewClassificationValues([]string{"Employees", "Customers", "Partners"})
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+	require.NoError(t, config.UpdateClassificationDimension(id, name, values, modifiedBy))
+	history = append(history, config.GetUncommittedChanges()...)
+
+	loaded, err := LoadMetaModelConfigurationFromHistory(history)
+	require.NoError(t, err)
+
+	rebuilt, found := loaded.ClassificationDimensions().FindByID(id)
+	require.True(t, found)
+	assert.Equal(t, "Audience", rebuilt.Name().Value())
+	assert.Equal(t, []string{"Employees", "Customers", "Partners"}, rebuilt.Values().Values())
+}
+
+func TestCapabilityHierarchy_DefaultsToFourLevels(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+
+	assert.Equal(t, []string{"L1", "L2", "L3", "L4"}, config.CapabilityHierarchy().Labels())
+}
+
+func TestUpdateCapabilityHierarchy_RaisesEventAndRebuildsFromHistory(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+	history := []domain.DomainEvent{newDefaultConfigCreatedEvent()}
+
+	hierarchy, err := valueobjects.NewCapabilityHierarchyConfig([]string{"Domain", "Area", "Capability", "Sub-capability", "Function"})
+	require.NoError(t, err)
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+	require.NoError(t, config.UpdateCapabilityHierarchy(hierarchy, 4, modifiedBy))
+
+	changes := config.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	event, ok := changes[0].(events.CapabilityHierarchyConfigUpdated)
+	require.True(t, ok)
+	assert.Equal(t, hierarchy.Labels(), event.Labels)
+
+	loaded, err := LoadMetaModelConfigurationFromHistory(append(history, changes...))
+	require.NoError(t, err)
+	assert.Equal(t, 5, loaded.CapabilityHierarchy().MaxDepth())
+	assert.Equal(t, "Sub-capability", loaded.CapabilityHierarchy().Labels()[3])
+}
+
+func TestUpdateCapabilityHierarchy_RejectsDepthBelowDeepestExistingCapability(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+
+	hierarchy, err := valueobjects.NewCapabilityHierarchyConfig([]string{"Domain", "Area", "Capability"})
+	require.NoError(t, err)
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+
+	err = config.UpdateCapabilityHierarchy(hierarchy, 5, modifiedBy)
+
+	assert.ErrorIs(t, err, valueobjects.ErrCapabilityHierarchyBelowExistingLevels)
+	assert.Empty(t, config.GetUncommittedChanges())
+	require.NoError(t, config.UpdateCapabilityHierarchy(hierarchy, 3, modifiedBy))
+}
+
+func TestUpdateCapabilityTagVocabulary_RaisesEventAndRebuildsFromHistory(t *testing.T) {
+	config := newCommittedMetaModelConfig(t)
+	history := []domain.DomainEvent{newDefaultConfigCreatedEvent()}
+
+	vocabulary, err := valueobjects.NewCapabilityTagVocabulary([]valueobjects.CapabilityTagTermInput{
+		{Tag: "regulated", Category: "Compliance"},
+		{Tag: "core"},
+	}, true)
+	require.NoError(t, err)
+	modifiedBy, _ := valueobjects.NewUserEmail("admin@example.com")
+	require.NoError(t, config.UpdateCapabilityTagVocabulary(vocabulary, modifiedBy))
+
+	changes := config.GetUncommittedChanges()
+	require.Len(t, changes, 1)
+	event, ok := changes[0].(events.CapabilityTagVocabularyUpdated)
+	require.True(t, ok)
+	assert.True(t, event.Restricted)
+	assert.Equal(t, []events.CapabilityTagTermData{{Tag: "regulated", Category: "Compliance"}, {Tag: "core"}}, event.Terms)
+
+	loaded, err := LoadMetaModelConfigurationFromHistory(append(history, changes...))
+	require.NoError(t, err)
+	assert.True(t, loaded.CapabilityTagVocabulary().Equals(vocabulary))
+}
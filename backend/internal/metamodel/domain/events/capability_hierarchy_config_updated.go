@@ -0,0 +1,51 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type CapabilityHierarchyConfigUpdated struct {
+	domain.BaseEvent
+	ID         string    `json:"id"`
+	TenantID   string    `json:"tenantId"`
+	Version    int       `json:"version"`
+	Labels     []string  `json:"labels"`
+	ModifiedAt time.Time `json:"modifiedAt"`
+	ModifiedBy string    `json:"modifiedBy"`
+}
+
+func (e CapabilityHierarchyConfigUpdated) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func NewCapabilityHierarchyConfigUpdated(id, tenantID string, version int, labels []string, modifiedBy string) CapabilityHierarchyConfigUpdated {
+	return CapabilityHierarchyConfigUpdated{
+		BaseEvent:  domain.NewBaseEvent(id),
+		ID:         id,
+		TenantID:   tenantID,
+		Version:    version,
+		Labels:     labels,
+		ModifiedAt: time.Now().UTC(),
+		ModifiedBy: modifiedBy,
+	}
+}
+
+func (e CapabilityHierarchyConfigUpdated) EventType() string {
+	return "CapabilityHierarchyConfigUpdated"
+}
+
+func (e CapabilityHierarchyConfigUpdated) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"tenantId":   e.TenantID,
+		"version":    e.Version,
+		"labels":     e.Labels,
+		"modifiedAt": e.ModifiedAt,
+		"modifiedBy": e.ModifiedBy,
+	}
+}
@@ -0,0 +1,68 @@
+package events
+
+import (
+	"time"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type CapabilityTagTermData struct {
+	Tag      string `json:"tag"`
+	Category string `json:"category,omitempty"`
+}
+
+type CapabilityTagVocabularyUpdated struct {
+	domain.BaseEvent
+	ID         string                  `json:"id"`
+	TenantID   string                  `json:"tenantId"`
+	Version    int                     `json:"version"`
+	Terms      []CapabilityTagTermData `json:"terms"`
+	Restricted bool                    `json:"restricted"`
+	ModifiedAt time.Time               `json:"modifiedAt"`
+	ModifiedBy string                  `json:"modifiedBy"`
+}
+
+type CapabilityTagVocabularyParams struct {
+	ConfigID   string
+	TenantID   string
+	Version    int
+	Terms      []CapabilityTagTermData
+	Restricted bool
+	ModifiedBy string
+}
+
+func (e CapabilityTagVocabularyUpdated) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func NewCapabilityTagVocabularyUpdated(params CapabilityTagVocabularyParams) CapabilityTagVocabularyUpdated {
+	return CapabilityTagVocabularyUpdated{
+		BaseEvent:  domain.NewBaseEvent(params.ConfigID),
+		ID:         params.ConfigID,
+		TenantID:   params.TenantID,
+		Version:    params.Version,
+		Terms:      params.Terms,
+		Restricted: params.Restricted,
+		ModifiedAt: time.Now().UTC(),
+		ModifiedBy: params.ModifiedBy,
+	}
+}
+
+func (e CapabilityTagVocabularyUpdated) EventType() string {
+	return "CapabilityTagVocabularyUpdated"
+}
+
+func (e CapabilityTagVocabularyUpdated) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         e.ID,
+		"tenantId":   e.TenantID,
+		"version":    e.Version,
+		"terms":      e.Terms,
+		"restricted": e.Restricted,
+		"modifiedAt": e.ModifiedAt,
+		"modifiedBy": e.ModifiedBy,
+	}
+}
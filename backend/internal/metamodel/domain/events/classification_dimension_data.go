@@ -0,0 +1,15 @@
+package events
+
+type ClassificationDimensionEventParams struct {
+	ConfigID    string
+	TenantID    string
+	Version     int
+	DimensionID string
+	ModifiedBy  string
+}
+
+type ClassificationDimensionDetailsParams struct {
+	ClassificationDimensionEventParams
+	Name   string
+	Values []string
+}
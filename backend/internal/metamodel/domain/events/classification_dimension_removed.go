@@ -0,0 +1,50 @@
+package events
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	"time"
+)
+
+type ClassificationDimensionRemoved struct {
+	domain.BaseEvent
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenantId"`
+	Version     int       `json:"version"`
+	DimensionID string    `json:"dimensionId"`
+	ModifiedAt  time.Time `json:"modifiedAt"`
+	ModifiedBy  string    `json:"modifiedBy"`
+}
+
+func (e ClassificationDimensionRemoved) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func NewClassificationDimensionRemoved(params ClassificationDimensionEventParams) ClassificationDimensionRemoved {
+	return ClassificationDimensionRemoved{
+		BaseEvent:   domain.NewBaseEvent(params.ConfigID),
+		ID:          params.ConfigID,
+		TenantID:    params.TenantID,
+		Version:     params.Version,
+		DimensionID: params.DimensionID,
+		ModifiedAt:  time.Now().UTC(),
+		ModifiedBy:  params.ModifiedBy,
+	}
+}
+
+func (e ClassificationDimensionRemoved) EventType() string {
+	return "ClassificationDimensionRemoved"
+}
+
+func (e ClassificationDimensionRemoved) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          e.ID,
+		"tenantId":    e.TenantID,
+		"version":     e.Version,
+		"dimensionId": e.DimensionID,
+		"modifiedAt":  e.ModifiedAt,
+		"modifiedBy":  e.ModifiedBy,
+	}
+}
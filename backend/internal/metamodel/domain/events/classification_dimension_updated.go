@@ -0,0 +1,56 @@
+package events
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	"time"
+)
+
+type ClassificationDimensionUpdated struct {
+	domain.BaseEvent
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenantId"`
+	Version     int       `json:"version"`
+	DimensionID string    `json:"dimensionId"`
+	Name        string    `json:"name"`
+	Values      []string  `json:"values"`
+	ModifiedAt  time.Time `json:"modifiedAt"`
+	ModifiedBy  string    `json:"modifiedBy"`
+}
+
+func (e ClassificationDimensionUpdated) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func NewClassificationDimensionUpdated(params ClassificationDimensionDetailsParams) ClassificationDimensionUpdated {
+	return ClassificationDimensionUpdated{
+		BaseEvent:   domain.NewBaseEvent(params.ConfigID),
+		ID:          params.ConfigID,
+		TenantID:    params.TenantID,
+		Version:     params.Version,
+		DimensionID: params.DimensionID,
+		Name:        params.Name,
+		Values:      params.Values,
+		ModifiedAt:  time.Now().UTC(),
+		ModifiedBy:  params.ModifiedBy,
+	}
+}
+
+func (e ClassificationDimensionUpdated) EventType() string {
+	return "ClassificationDimensionUpdated"
+}
+
+func (e ClassificationDimensionUpdated) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          e.ID,
+		"tenantId":    e.TenantID,
+		"version":     e.Version,
+		"dimensionId": e.DimensionID,
+		"name":        e.Name,
+		"values":      e.Values,
+		"modifiedAt":  e.ModifiedAt,
+		"modifiedBy":  e.ModifiedBy,
+	}
+}
@@ -0,0 +1,17 @@
+package events
+
+type CustomRelationTypeEventParams struct {
+	ConfigID       string
+	TenantID       string
+	Version        int
+	RelationTypeID string
+	ModifiedBy     string
+}
+
+type CustomRelationTypeDetailsParams struct {
+	CustomRelationTypeEventParams
+	Name        string
+	Description string
+	LineStyle   string
+	Color       string
+}
@@ -0,0 +1,50 @@
+package events
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	"time"
+)
+
+type CustomRelationTypeRemoved struct {
+	domain.BaseEvent
+	ID             string    `json:"id"`
+	TenantID       string    `json:"tenantId"`
+	Version        int       `json:"version"`
+	RelationTypeID string    `json:"relationTypeId"`
+	ModifiedAt     time.Time `json:"modifiedAt"`
+	ModifiedBy     string    `json:"modifiedBy"`
+}
+
+func (e CustomRelationTypeRemoved) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func NewCustomRelationTypeRemoved(params CustomRelationTypeEventParams) CustomRelationTypeRemoved {
+	return CustomRelationTypeRemoved{
+		BaseEvent:      domain.NewBaseEvent(params.ConfigID),
+		ID:             params.ConfigID,
+		TenantID:       params.TenantID,
+		Version:        params.Version,
+		RelationTypeID: params.RelationTypeID,
+		ModifiedAt:     time.Now().UTC(),
+		ModifiedBy:     params.ModifiedBy,
+	}
+}
+
+func (e CustomRelationTypeRemoved) EventType() string {
+	return "CustomRelationTypeRemoved"
+}
+
+func (e CustomRelationTypeRemoved) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":             e.ID,
+		"tenantId":       e.TenantID,
+		"version":        e.Version,
+		"relationTypeId": e.RelationTypeID,
+		"modifiedAt":     e.ModifiedAt,
+		"modifiedBy":     e.ModifiedBy,
+	}
+}
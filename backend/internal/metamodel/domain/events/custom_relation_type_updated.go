@@ -0,0 +1,62 @@
+package events
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	"time"
+)
+
+type CustomRelationTypeUpdated struct {
+	domain.BaseEvent
+	ID             string    `json:"id"`
+	TenantID       string    `json:"tenantId"`
+	Version        int       `json:"version"`
+	RelationTypeID string    `json:"relationTypeId"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	LineStyle      string    `json:"lineStyle"`
+	Color          string    `json:"color"`
+	ModifiedAt     time.Time `json:"modifiedAt"`
+	ModifiedBy     string    `json:"modifiedBy"`
+}
+
+func (e CustomRelationTypeUpdated) AggregateID() string {
+	if baseID := e.BaseEvent.AggregateID(); baseID != "" {
+		return baseID
+	}
+	return e.ID
+}
+
+func NewCustomRelationTypeUpdated(params CustomRelationTypeDetailsParams) CustomRelationTypeUpdated {
+	return CustomRelationTypeUpdated{
+		BaseEvent:      domain.NewBaseEvent(params.ConfigID),
+		ID:             params.ConfigID,
+		TenantID:       params.TenantID,
+		Version:        params.Version,
+		RelationTypeID: params.RelationTypeID,
+		Name:           params.Name,
+		Description:    params.Description,
+		LineStyle:      params.LineStyle,
+		Color:          params.Color,
+		ModifiedAt:     time.Now().UTC(),
+		ModifiedBy:     params.ModifiedBy,
+	}
+}
+
+func (e CustomRelationTypeUpdated) EventType() string {
+	return "CustomRelationTypeUpdated"
+}
+
+func (e CustomRelationTypeUpdated) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":             e.ID,
+		"tenantId":       e.TenantID,
+		"version":        e.Version,
+		"relationTypeId": e.RelationTypeID,
+		"name":           e.Name,
+		"description":    e.Description,
+		"lineStyle":      e.LineStyle,
+		"color":          e.Color,
+		"modifiedAt":     e.ModifiedAt,
+		"modifiedBy":     e.ModifiedBy,
+	}
+}
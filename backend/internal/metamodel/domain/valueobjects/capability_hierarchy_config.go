@@ -0,0 +1,99 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const (
+	MaxCapabilityHierarchyDepth      = 6
+	MaxCapabilityLevelLabelLength    = 50
+	defaultCapabilityHierarchyLevels = 4
+)
+
+var (
+	ErrCapabilityHierarchyEmpty      = errors.New("capability hierarchy must have at least one level")
+	ErrCapabilityHierarchyTooDeep    = errors.New("capability hierarchy cannot have more than 6 levels")
+	ErrCapabilityLevelLabelEmpty     = errors.New("capability level label cannot be empty or whitespace only")
+	ErrCapabilityLevelLabelTooLong   = errors.New("capability level label cannot exceed 50 characters")
+	ErrCapabilityLevelLabelDuplicate = errors.New("capability level labels must be unique")
+
+	ErrCapabilityHierarchyBelowExistingLevels = errors.New("capability hierarchy cannot be shallower than the deepest existing capability")
+)
+
+// CapabilityHierarchyConfig holds the labels of the capability levels a tenant
+// models, outermost first, e.g. "Domain", "Area", "Capability". The number of
+// labels is the allowed hierarchy depth.
+type CapabilityHierarchyConfig struct {
+	labels []string
+}
+
+func NewCapabilityHierarchyConfig(raw []string) (CapabilityHierarchyConfig, error) {
+	if len(raw) == 0 {
+		return CapabilityHierarchyConfig{}, ErrCapabilityHierarchyEmpty
+	}
+	if len(raw) > MaxCapabilityHierarchyDepth {
+		return CapabilityHierarchyConfig{}, ErrCapabilityHierarchyTooDeep
+	}
+
+	labels := make([]string, 0, len(raw))
+	seen := make(map[string]struct{}, len(raw))
+	for _, l := range raw {
+		trimmed := strings.TrimSpace(l)
+		if trimmed == "" {
+			return CapabilityHierarchyConfig{}, ErrCapabilityLevelLabelEmpty
+		}
+		if len(trimmed) > MaxCapabilityLevelLabelLength {
+			return CapabilityHierarchyConfig{}, ErrCapabilityLevelLabelTooLong
+		}
+		key := strings.ToLower(trimmed)
+		if _, dup := seen[key]; dup {
+			return CapabilityHierarchyConfig{}, ErrCapabilityLevelLabelDuplicate
+		}
+		seen[key] = struct{}{}
+		labels = append(labels, trimmed)
+	}
+	return CapabilityHierarchyConfig{labels: labels}, nil
+}
+
+func DefaultCapabilityHierarchyConfig() CapabilityHierarchyConfig {
+	labels := make([]string, defaultCapabilityHierarchyLevels)
+	for i := range labels {
+		labels[i] = DefaultCapabilityLevelLabel(i + 1)
+	}
+	return CapabilityHierarchyConfig{labels: labels}
+}
+
+func DefaultCapabilityLevelLabel(depth int) string {
+	return fmt.Sprintf("L%d", depth)
+}
+
+func (c CapabilityHierarchyConfig) Labels() []string {
+	result := make([]string, len(c.labels))
+	copy(result, c.labels)
+	return result
+}
+
+func (c CapabilityHierarchyConfig) MaxDepth() int {
+	return len(c.labels)
+}
+
+func (c CapabilityHierarchyConfig) IsDefault() bool {
+	return c.Equals(DefaultCapabilityHierarchyConfig())
+}
+
+func (c CapabilityHierarchyConfig) Equals(other domain.ValueObject) bool {
+	otherConfig, ok := other.(CapabilityHierarchyConfig)
+	if !ok || len(c.labels) != len(otherConfig.labels) {
+		return false
+	}
+	for i := range c.labels {
+		if c.labels[i] != otherConfig.labels[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package valueobjects
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCapabilityHierarchyConfig_TrimsAndPreservesOrder(t *testing.T) {
+	config, err := NewCapabilityHierarchyConfig([]string{" Domain ", "Area", "Capability", "Sub-capability", "Function"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Domain", "Area", "Capability", "Sub-capability", "Function"}, config.Labels())
+	assert.Equal(t, 5, config.MaxDepth())
+	assert.False(t, config.IsDefault())
+}
+
+func TestNewCapabilityHierarchyConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  []string
+		wantErr error
+	}{
+		{"empty list", nil, ErrCapabilityHierarchyEmpty},
+		{"too deep", []string{"1", "2", "3", "4", "5", "6", "7"}, ErrCapabilityHierarchyTooDeep},
+		{"blank label", []string{"Domain", " "}, ErrCapabilityLevelLabelEmpty},
+		{"too long", []string{strings.Repeat("x", 51)}, ErrCapabilityLevelLabelTooLong},
+		{"case-insensitive duplicate", []string{"Area", "area"}, ErrCapabilityLevelLabelDuplicate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCapabilityHierarchyConfig(tt.labels)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestDefaultCapabilityHierarchyConfig(t *testing.T) {
+	config := DefaultCapabilityHierarchyConfig()
+
+	assert.Equal(t, []string{"L1", "L2", "L3", "L4"}, config.Labels())
+	assert.True(t, config.IsDefault())
+}
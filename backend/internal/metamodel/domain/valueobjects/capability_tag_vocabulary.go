@@ -0,0 +1,99 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const (
+	MaxCapabilityTagLength         = 50
+	MaxCapabilityTagCategoryLength = 50
+	MaxCapabilityTagTerms          = 500
+)
+
+var (
+	ErrCapabilityTagTermEmpty       = errors.New("vocabulary tag cannot be empty or whitespace only")
+	ErrCapabilityTagTermTooLong     = errors.New("vocabulary tag cannot exceed 50 characters")
+	ErrCapabilityTagTermDuplicate   = errors.New("vocabulary tags must be unique")
+	ErrCapabilityTagCategoryTooLong = errors.New("vocabulary tag category cannot exceed 50 characters")
+	ErrTooManyCapabilityTagTerms    = errors.New("tag vocabulary cannot contain more than 500 tags")
+	ErrRestrictedTagVocabularyEmpty = errors.New("a restricted tag vocabulary must contain at least one tag")
+)
+
+type CapabilityTagTerm struct {
+	tag      string
+	category string
+}
+
+func (t CapabilityTagTerm) Tag() string      { return t.tag }
+func (t CapabilityTagTerm) Category() string { return t.category }
+
+// CapabilityTagVocabulary is the tenant's list of sanctioned capability tags,
+// each optionally grouped under a category. When restricted, capabilities can
+// only be tagged with terms from the vocabulary.
+type CapabilityTagVocabulary struct {
+	terms      []CapabilityTagTerm
+	restricted bool
+}
+
+type CapabilityTagTermInput struct {
+	Tag      string
+	Category string
+}
+
+func NewCapabilityTagVocabulary(inputs []CapabilityTagTermInput, restricted bool) (CapabilityTagVocabulary, error) {
+	if len(inputs) > MaxCapabilityTagTerms {
+		return CapabilityTagVocabulary{}, ErrTooManyCapabilityTagTerms
+	}
+	if restricted && len(inputs) == 0 {
+		return CapabilityTagVocabulary{}, ErrRestrictedTagVocabularyEmpty
+	}
+
+	terms := make([]CapabilityTagTerm, 0, len(inputs))
+	seen := make(map[string]struct{}, len(inputs))
+	for _, in := range inputs {
+		tag := strings.TrimSpace(in.Tag)
+		if tag == "" {
+			return CapabilityTagVocabulary{}, ErrCapabilityTagTermEmpty
+		}
+		if len(tag) > MaxCapabilityTagLength {
+			return CapabilityTagVocabulary{}, ErrCapabilityTagTermTooLong
+		}
+		category := strings.TrimSpace(in.Category)
+		if len(category) > MaxCapabilityTagCategoryLength {
+			return CapabilityTagVocabulary{}, ErrCapabilityTagCategoryTooLong
+		}
+		key := strings.ToLower(tag)
+		if _, dup := seen[key]; dup {
+			return CapabilityTagVocabulary{}, ErrCapabilityTagTermDuplicate
+		}
+		seen[key] = struct{}{}
+		terms = append(terms, CapabilityTagTerm{tag: tag, category: category})
+	}
+	return CapabilityTagVocabulary{terms: terms, restricted: restricted}, nil
+}
+
+func (v CapabilityTagVocabulary) Terms() []CapabilityTagTerm {
+	result := make([]CapabilityTagTerm, len(v.terms))
+	copy(result, v.terms)
+	return result
+}
+
+func (v CapabilityTagVocabulary) Restricted() bool {
+	return v.restricted
+}
+
+func (v CapabilityTagVocabulary) Equals(other domain.ValueObject) bool {
+	otherVocabulary, ok := other.(CapabilityTagVocabulary)
+	if !ok || v.restricted != otherVocabulary.restricted || len(v.terms) != len(otherVocabulary.terms) {
+		return false
+	}
+	for i := range v.terms {
+		if v.terms[i] != otherVocabulary.terms[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+package valueobjects
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCapabilityTagVocabulary_TrimsTermsAndCategories(t *testing.T) {
+	vocabulary, err := NewCapabilityTagVocabulary([]CapabilityTagTermInput{
+		{Tag: " regulated ", Category: " Compliance "},
+		{Tag: "core"},
+	}, true)
+
+	require.NoError(t, err)
+	require.Len(t, vocabulary.Terms(), 2)
+	assert.Equal(t, "regulated", vocabulary.Terms()[0].Tag())
+	assert.Equal(t, "Compliance", vocabulary.Terms()[0].Category())
+	assert.Equal(t, "", vocabulary.Terms()[1].Category())
+	assert.True(t, vocabulary.Restricted())
+}
+
+func TestNewCapabilityTagVocabulary_EmptyUnrestrictedIsAllowed(t *testing.T) {
+	vocabulary, err := NewCapabilityTagVocabulary(nil, false)
+
+	require.NoError(t, err)
+	assert.Empty(t, vocabulary.Terms())
+}
+
+func TestNewCapabilityTagVocabulary_Invalid(t *testing.T) {
+	tooMany := make([]CapabilityTagTermInput, MaxCapabilityTagTerms+1)
+
+	tests := []struct {
+		name       string
+		inputs     []CapabilityTagTermInput
+		restricted bool
+		wantErr    error
+	}{
+		{"restricted without terms", nil, true, ErrRestrictedTagVocabularyEmpty},
+		{"blank tag", []CapabilityTagTermInput{{Tag: " "}}, false, ErrCapabilityTagTermEmpty},
+		{"tag too long", []CapabilityTagTermInput{{Tag: strings.Repeat("x", 51)}}, false, ErrCapabilityTagTermTooLong},
+		{"category too long", []CapabilityTagTermInput{{Tag: "core", Category: strings.Repeat("x", 51)}}, false, ErrCapabilityTagCategoryTooLong},
+		{"case-insensitive duplicate", []CapabilityTagTermInput{{Tag: "Core"}, {Tag: "core"}}, false, ErrCapabilityTagTermDuplicate},
+		{"too many terms", tooMany, false, ErrTooManyCapabilityTagTerms},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCapabilityTagVocabulary(tt.inputs, tt.restricted)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
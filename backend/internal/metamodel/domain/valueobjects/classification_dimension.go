@@ -0,0 +1,57 @@
+package valueobjects
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type ClassificationDimension struct {
+	id     ClassificationDimensionID
+	name   ClassificationDimensionName
+	values ClassificationValues
+	active bool
+}
+
+func NewClassificationDimension(id ClassificationDimensionID, name ClassificationDimensionName, values ClassificationValues) ClassificationDimension {
+	return ClassificationDimension{
+		id:     id,
+		name:   name,
+		values: values,
+		active: true,
+	}
+}
+
+func (c ClassificationDimension) ID() ClassificationDimensionID {
+	return c.id
+}
+
+func (c ClassificationDimension) Name() ClassificationDimensionName {
+	return c.name
+}
+
+func (c ClassificationDimension) Values() ClassificationValues {
+	return c.values
+}
+
+func (c ClassificationDimension) IsActive() bool {
+	return c.active
+}
+
+func (c ClassificationDimension) WithUpdatedDetails(name ClassificationDimensionName, values ClassificationValues) ClassificationDimension {
+	c.name, c.values = name, values
+	return c
+}
+
+func (c ClassificationDimension) Deactivate() ClassificationDimension {
+	c.active = false
+	return c
+}
+
+func (c ClassificationDimension) Equals(other domain.ValueObject) bool {
+	if otherDimension, ok := other.(ClassificationDimension); ok {
+		return c.id.Equals(otherDimension.id) &&
+			c.name.Equals(otherDimension.name) &&
+			c.values.Equals(otherDimension.values) &&
+			c.active == otherDimension.active
+	}
+	return false
+}
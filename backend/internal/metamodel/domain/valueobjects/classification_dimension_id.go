@@ -0,0 +1,29 @@
+package valueobjects
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+type ClassificationDimensionID struct {
+	sharedvo.UUIDValue
+}
+
+func NewClassificationDimensionID() ClassificationDimensionID {
+	return ClassificationDimensionID{UUIDValue: sharedvo.NewUUIDValue()}
+}
+
+func NewClassificationDimensionIDFromString(value string) (ClassificationDimensionID, error) {
+	uuid, err := sharedvo.NewUUIDValueFromString(value)
+	if err != nil {
+		return ClassificationDimensionID{}, err
+	}
+	return ClassificationDimensionID{UUIDValue: uuid}, nil
+}
+
+func (s ClassificationDimensionID) Equals(other domain.ValueObject) bool {
+	if otherID, ok := other.(ClassificationDimensionID); ok {
+		return s.EqualsValue(otherID.UUIDValue)
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const MaxClassificationDimensionNameLength = 50
+
+var (
+	ErrClassificationDimensionNameEmpty   = errors.New("classification dimension name cannot be empty or whitespace only")
+	ErrClassificationDimensionNameTooLong = errors.New("classification dimension name cannot exceed 50 characters")
+)
+
+type ClassificationDimensionName struct {
+	value string
+}
+
+func NewClassificationDimensionName(value string) (ClassificationDimensionName, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return ClassificationDimensionName{}, ErrClassificationDimensionNameEmpty
+	}
+	if len(trimmed) > MaxClassificationDimensionNameLength {
+		return ClassificationDimensionName{}, ErrClassificationDimensionNameTooLong
+	}
+	return ClassificationDimensionName{value: trimmed}, nil
+}
+
+func (n ClassificationDimensionName) Value() string {
+	return n.value
+}
+
+func (n ClassificationDimensionName) EqualsIgnoreCase(other ClassificationDimensionName) bool {
+	return strings.EqualFold(n.value, other.value)
+}
+
+func (n ClassificationDimensionName) Equals(other domain.ValueObject) bool {
+	if otherName, ok := other.(ClassificationDimensionName); ok {
+		return n.value == otherName.value
+	}
+	return false
+}
+
+func (n ClassificationDimensionName) String() string {
+	return n.value
+}
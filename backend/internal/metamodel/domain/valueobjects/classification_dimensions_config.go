@@ -0,0 +1,106 @@
+package valueobjects
+
+import (
+	"errors"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var (
+	ErrTooManyClassificationDimensions        = errors.New("cannot have more than 20 classification dimensions")
+	ErrClassificationDimensionNameDuplicate   = errors.New("classification dimension name already exists")
+	ErrClassificationDimensionNotFound        = errors.New("classification dimension not found")
+	ErrClassificationDimensionAlreadyInactive = errors.New("classification dimension is already inactive")
+)
+
+const MaxClassificationDimensions = 20
+
+type ClassificationDimensionsConfig struct {
+	dimensions []ClassificationDimension
+}
+
+func (c ClassificationDimensionsConfig) Dimensions() []ClassificationDimension {
+	result := make([]ClassificationDimension, len(c.dimensions))
+	copy(result, c.dimensions)
+	return result
+}
+
+func (c ClassificationDimensionsConfig) FindByID(id ClassificationDimensionID) (ClassificationDimension, bool) {
+	idx := c.indexOf(id)
+	if idx < 0 {
+		return ClassificationDimension{}, false
+	}
+	return c.dimensions[idx], true
+}
+
+func (c ClassificationDimensionsConfig) indexOf(id ClassificationDimensionID) int {
+	for i, d := range c.dimensions {
+		if d.ID().Equals(id) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c ClassificationDimensionsConfig) hasActiveNameExcluding(name ClassificationDimensionName, excludeID *ClassificationDimensionID) bool {
+	for _, d := range c.dimensions {
+		if !d.IsActive() || (excludeID != nil && d.ID().Equals(*excludeID)) {
+			continue
+		}
+		if d.Name().EqualsIgnoreCase(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c ClassificationDimensionsConfig) WithAdded(dimension ClassificationDimension) (ClassificationDimensionsConfig, error) {
+	if len(c.dimensions) >= MaxClassificationDimensions {
+		return ClassificationDimensionsConfig{}, ErrTooManyClassificationDimensions
+	}
+	if c.hasActiveNameExcluding(dimension.Name(), nil) {
+		return ClassificationDimensionsConfig{}, ErrClassificationDimensionNameDuplicate
+	}
+	dimensions := make([]ClassificationDimension, len(c.dimensions), len(c.dimensions)+1)
+	copy(dimensions, c.dimensions)
+	return ClassificationDimensionsConfig{dimensions: append(dimensions, dimension)}, nil
+}
+
+func (c ClassificationDimensionsConfig) WithUpdated(id ClassificationDimensionID, name ClassificationDimensionName, values ClassificationValues) (ClassificationDimensionsConfig, error) {
+	idx := c.indexOf(id)
+	if idx < 0 || !c.dimensions[idx].IsActive() {
+		return ClassificationDimensionsConfig{}, ErrClassificationDimensionNotFound
+	}
+	if c.hasActiveNameExcluding(name, &id) {
+		return ClassificationDimensionsConfig{}, ErrClassificationDimensionNameDuplicate
+	}
+	dimensions := c.Dimensions()
+	dimensions[idx] = dimensions[idx].WithUpdatedDetails(name, values)
+	return ClassificationDimensionsConfig{dimensions: dimensions}, nil
+}
+
+func (c ClassificationDimensionsConfig) WithRemoved(id ClassificationDimensionID) (ClassificationDimensionsConfig, error) {
+	idx := c.indexOf(id)
+	if idx < 0 {
+		return ClassificationDimensionsConfig{}, ErrClassificationDimensionNotFound
+	}
+	if !c.dimensions[idx].IsActive() {
+		return ClassificationDimensionsConfig{}, ErrClassificationDimensionAlreadyInactive
+	}
+	dimensions := c.Dimensions()
+	dimensions[idx] = dimensions[idx].Deactivate()
+	return ClassificationDimensionsConfig{dimensions: dimensions}, nil
+}
+
+func (c ClassificationDimensionsConfig) Equals(other domain.ValueObject) bool {
+	otherConfig, ok := other.(ClassificationDimensionsConfig)
+	if !ok || len(c.dimensions) != len(otherConfig.dimensions) {
+		return false
+	}
+	for i := range c.dimensions {
+		if !c.dimensions[i].Equals(otherConfig.dimensions[i]) {
+			return false
+		}
+	}
+	return true
+}
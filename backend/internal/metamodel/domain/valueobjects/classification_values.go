@@ -0,0 +1,74 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const (
+	MaxClassificationValues      = 20
+	MaxClassificationValueLength = 50
+)
+
+var (
+	ErrClassificationValuesEmpty    = errors.New("classification dimension must have at least one value")
+	ErrTooManyClassificationValues  = errors.New("classification dimension cannot have more than 20 values")
+	ErrClassificationValueEmpty     = errors.New("classification value cannot be empty or whitespace only")
+	ErrClassificationValueTooLong   = errors.New("classification value cannot exceed 50 characters")
+	ErrClassificationValueDuplicate = errors.New("classification values must be unique")
+)
+
+// ClassificationValues is the ordered list of values a classification
+// dimension allows, e.g. "Mission critical", "Business critical", "Supporting".
+type ClassificationValues struct {
+	values []string
+}
+
+func NewClassificationValues(raw []string) (ClassificationValues, error) {
+	if len(raw) == 0 {
+		return ClassificationValues{}, ErrClassificationValuesEmpty
+	}
+	if len(raw) > MaxClassificationValues {
+		return ClassificationValues{}, ErrTooManyClassificationValues
+	}
+
+	values := make([]string, 0, len(raw))
+	seen := make(map[string]struct{}, len(raw))
+	for _, v := range raw {
+		trimmed := strings.TrimSpace(v)
+		if trimmed == "" {
+			return ClassificationValues{}, ErrClassificationValueEmpty
+		}
+		if len(trimmed) > MaxClassificationValueLength {
+			return ClassificationValues{}, ErrClassificationValueTooLong
+		}
+		key := strings.ToLower(trimmed)
+		if _, dup := seen[key]; dup {
+			return ClassificationValues{}, ErrClassificationValueDuplicate
+		}
+		seen[key] = struct{}{}
+		values = append(values, trimmed)
+	}
+	return ClassificationValues{values: values}, nil
+}
+
+func (v ClassificationValues) Values() []string {
+	result := make([]string, len(v.values))
+	copy(result, v.values)
+	return result
+}
+
+func (v ClassificationValues) Equals(other domain.ValueObject) bool {
+	otherValues, ok := other.(ClassificationValues)
+	if !ok || len(v.values) != len(otherValues.values) {
+		return false
+	}
+	for i := range v.values {
+		if v.values[i] != otherValues.values[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package valueobjects
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClassificationValues_TrimsAndPreservesOrder(t *testing.T) {
+	values, err := NewClassificationValues([]string{" SaaS ", "On-premise", "Private cloud"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"SaaS", "On-premise", "Private cloud"}, values.Values())
+}
+
+func TestNewClassificationValues_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		wantErr error
+	}{
+		{"empty list", nil, ErrClassificationValuesEmpty},
+		{"blank value", []string{"SaaS", "  "}, ErrClassificationValueEmpty},
+		{"too long", []string{strings.Repeat("x", 51)}, ErrClassificationValueTooLong},
+		{"case-insensitive duplicate", []string{"SaaS", "saas"}, ErrClassificationValueDuplicate},
+		{"too many", make21Values(), ErrTooManyClassificationValues},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClassificationValues(tt.values)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func make21Values() []string {
+	values := make([]string, 21)
+	for i := range values {
+		values[i] = strings.Repeat("v", i+1)
+	}
+	return values
+}
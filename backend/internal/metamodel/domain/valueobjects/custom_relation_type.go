@@ -0,0 +1,64 @@
+package valueobjects
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type CustomRelationType struct {
+	id          CustomRelationTypeID
+	name        RelationTypeName
+	description RelationTypeDescription
+	style       EdgeStyle
+	active      bool
+}
+
+func NewCustomRelationType(id CustomRelationTypeID, name RelationTypeName, description RelationTypeDescription, style EdgeStyle) CustomRelationType {
+	return CustomRelationType{
+		id:          id,
+		name:        name,
+		description: description,
+		style:       style,
+		active:      true,
+	}
+}
+
+func (c CustomRelationType) ID() CustomRelationTypeID {
+	return c.id
+}
+
+func (c CustomRelationType) Name() RelationTypeName {
+	return c.name
+}
+
+func (c CustomRelationType) Description() RelationTypeDescription {
+	return c.description
+}
+
+func (c CustomRelationType) Style() EdgeStyle {
+	return c.style
+}
+
+func (c CustomRelationType) IsActive() bool {
+	return c.active
+}
+
+func (c CustomRelationType) WithUpdatedDetails(name RelationTypeName, description RelationTypeDescription, style EdgeStyle) CustomRelationType {
+	c.name, c.description, c.style = name, description, style
+	return c
+}
+
+func (c CustomRelationType) Deactivate() CustomRelationType {
+	c.active = false
+	return c
+}
+
+func (c CustomRelationType) Equals(other domain.ValueObject) bool {
+	if otherType, ok := other.(CustomRelationType); ok {
+		return c.id.Equals(otherType.id) &&
+			c.name.Equals(otherType.name) &&
+			c.description.Equals(otherType.description) &&
+			c.style.Equals(otherType.style) &&
+			c.active == otherType.active
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package valueobjects
+
+import (
+	domain "easi/backend/internal/shared/eventsourcing"
+	sharedvo "easi/backend/internal/shared/eventsourcing/valueobjects"
+)
+
+type CustomRelationTypeID struct {
+	sharedvo.UUIDValue
+}
+
+func NewCustomRelationTypeID() CustomRelationTypeID {
+	return CustomRelationTypeID{UUIDValue: sharedvo.NewUUIDValue()}
+}
+
+func NewCustomRelationTypeIDFromString(value string) (CustomRelationTypeID, error) {
+	uuid, err := sharedvo.NewUUIDValueFromString(value)
+	if err != nil {
+		return CustomRelationTypeID{}, err
+	}
+	return CustomRelationTypeID{UUIDValue: uuid}, nil
+}
+
+func (s CustomRelationTypeID) Equals(other domain.ValueObject) bool {
+	if otherID, ok := other.(CustomRelationTypeID); ok {
+		return s.EqualsValue(otherID.UUIDValue)
+	}
+	return false
+}
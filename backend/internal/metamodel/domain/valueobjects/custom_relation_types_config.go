@@ -0,0 +1,106 @@
+package valueobjects
+
+import (
+	"errors"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var (
+	ErrTooManyCustomRelationTypes        = errors.New("cannot have more than 30 custom relation types")
+	ErrCustomRelationTypeNameDuplicate   = errors.New("custom relation type name already exists")
+	ErrCustomRelationTypeNotFound        = errors.New("custom relation type not found")
+	ErrCustomRelationTypeAlreadyInactive = errors.New("custom relation type is already inactive")
+)
+
+const MaxCustomRelationTypes = 30
+
+type CustomRelationTypesConfig struct {
+	types []CustomRelationType
+}
+
+func (c CustomRelationTypesConfig) Types() []CustomRelationType {
+	result := make([]CustomRelationType, len(c.types))
+	copy(result, c.types)
+	return result
+}
+
+func (c CustomRelationTypesConfig) FindByID(id CustomRelationTypeID) (CustomRelationType, bool) {
+	idx := c.indexOf(id)
+	if idx < 0 {
+		return CustomRelationType{}, false
+	}
+	return c.types[idx], true
+}
+
+func (c CustomRelationTypesConfig) indexOf(id CustomRelationTypeID) int {
+	for i, t := range c.types {
+		if t.ID().Equals(id) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c CustomRelationTypesConfig) hasActiveNameExcluding(name RelationTypeName, excludeID *CustomRelationTypeID) bool {
+	for _, t := range c.types {
+		if !t.IsActive() || (excludeID != nil && t.ID().Equals(*excludeID)) {
+			continue
+		}
+		if t.Name().EqualsIgnoreCase(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c CustomRelationTypesConfig) WithAdded(relationType CustomRelationType) (CustomRelationTypesConfig, error) {
+	if len(c.types) >= MaxCustomRelationTypes {
+		return CustomRelationTypesConfig{}, ErrTooManyCustomRelationTypes
+	}
+	if c.hasActiveNameExcluding(relationType.Name(), nil) {
+		return CustomRelationTypesConfig{}, ErrCustomRelationTypeNameDuplicate
+	}
+	types := make([]CustomRelationType, len(c.types), len(c.types)+1)
+	copy(types, c.types)
+	return CustomRelationTypesConfig{types: append(types, relationType)}, nil
+}
+
+func (c CustomRelationTypesConfig) WithUpdated(id CustomRelationTypeID, name RelationTypeName, description RelationTypeDescription, style EdgeStyle) (CustomRelationTypesConfig, error) {
+	idx := c.indexOf(id)
+	if idx < 0 || !c.types[idx].IsActive() {
+		return CustomRelationTypesConfig{}, ErrCustomRelationTypeNotFound
+	}
+	if c.hasActiveNameExcluding(name, &id) {
+		return CustomRelationTypesConfig{}, ErrCustomRelationTypeNameDuplicate
+	}
+	types := c.Types()
+	types[idx] = types[idx].WithUpdatedDetails(name, description, style)
+	return CustomRelationTypesConfig{types: types}, nil
+}
+
+func (c CustomRelationTypesConfig) WithRemoved(id CustomRelationTypeID) (CustomRelationTypesConfig, error) {
+	idx := c.indexOf(id)
+	if idx < 0 {
+		return CustomRelationTypesConfig{}, ErrCustomRelationTypeNotFound
+	}
+	if !c.types[idx].IsActive() {
+		return CustomRelationTypesConfig{}, ErrCustomRelationTypeAlreadyInactive
+	}
+	types := c.Types()
+	types[idx] = types[idx].Deactivate()
+	return CustomRelationTypesConfig{types: types}, nil
+}
+
+func (c CustomRelationTypesConfig) Equals(other domain.ValueObject) bool {
+	otherConfig, ok := other.(CustomRelationTypesConfig)
+	if !ok || len(c.types) != len(otherConfig.types) {
+		return false
+	}
+	for i := range c.types {
+		if !c.types[i].Equals(otherConfig.types[i]) {
+			return false
+		}
+	}
+	return true
+}
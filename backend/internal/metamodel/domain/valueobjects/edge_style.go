@@ -0,0 +1,63 @@
+package valueobjects
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const (
+	EdgeLineStyleSolid  = "solid"
+	EdgeLineStyleDashed = "dashed"
+	EdgeLineStyleDotted = "dotted"
+)
+
+var (
+	ErrInvalidEdgeLineStyle = errors.New("edge line style must be solid, dashed, or dotted")
+	ErrInvalidEdgeColor     = errors.New("edge color must be a hex color in the form #RRGGBB or empty")
+)
+
+var edgeColorPattern = regexp.MustCompile(`^#[0-9A-F]{6}$`)
+
+type EdgeStyle struct {
+	lineStyle string
+	color     string
+}
+
+func NewEdgeStyle(lineStyle, color string) (EdgeStyle, error) {
+	normalizedLine := strings.ToLower(strings.TrimSpace(lineStyle))
+	if normalizedLine == "" {
+		normalizedLine = EdgeLineStyleSolid
+	}
+	if !isValidEdgeLineStyle(normalizedLine) {
+		return EdgeStyle{}, ErrInvalidEdgeLineStyle
+	}
+
+	normalizedColor := strings.ToUpper(strings.TrimSpace(color))
+	if normalizedColor != "" && !edgeColorPattern.MatchString(normalizedColor) {
+		return EdgeStyle{}, ErrInvalidEdgeColor
+	}
+
+	return EdgeStyle{lineStyle: normalizedLine, color: normalizedColor}, nil
+}
+
+func isValidEdgeLineStyle(value string) bool {
+	return value == EdgeLineStyleSolid || value == EdgeLineStyleDashed || value == EdgeLineStyleDotted
+}
+
+func (s EdgeStyle) LineStyle() string {
+	return s.lineStyle
+}
+
+func (s EdgeStyle) Color() string {
+	return s.color
+}
+
+func (s EdgeStyle) Equals(other domain.ValueObject) bool {
+	if otherStyle, ok := other.(EdgeStyle); ok {
+		return s.lineStyle == otherStyle.lineStyle && s.color == otherStyle.color
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEdgeStyle_DefaultsToSolid(t *testing.T) {
+	style, err := NewEdgeStyle("", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, EdgeLineStyleSolid, style.LineStyle())
+	assert.Empty(t, style.Color())
+}
+
+func TestNewEdgeStyle_NormalizesInput(t *testing.T) {
+	style, err := NewEdgeStyle(" Dashed ", "#abcdef")
+
+	require.NoError(t, err)
+	assert.Equal(t, EdgeLineStyleDashed, style.LineStyle())
+	assert.Equal(t, "#ABCDEF", style.Color())
+}
+
+func TestNewEdgeStyle_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		lineStyle string
+		color     string
+		wantErr   error
+	}{
+		{name: "unknown line style", lineStyle: "wavy", wantErr: ErrInvalidEdgeLineStyle},
+		{name: "named color", lineStyle: "solid", color: "red", wantErr: ErrInvalidEdgeColor},
+		{name: "short hex", lineStyle: "solid", color: "#abc", wantErr: ErrInvalidEdgeColor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewEdgeStyle(tt.lineStyle, tt.color)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+var (
+	ErrRelationTypeDescriptionTooLong = errors.New("relation type description cannot exceed 500 characters")
+)
+
+type RelationTypeDescription struct {
+	value string
+}
+
+func NewRelationTypeDescription(value string) (RelationTypeDescription, error) {
+	trimmed := strings.TrimSpace(value)
+	if len(trimmed) > 500 {
+		return RelationTypeDescription{}, ErrRelationTypeDescriptionTooLong
+	}
+	return RelationTypeDescription{value: trimmed}, nil
+}
+
+func (p RelationTypeDescription) Value() string {
+	return p.value
+}
+
+func (p RelationTypeDescription) IsEmpty() bool {
+	return p.value == ""
+}
+
+func (p RelationTypeDescription) Equals(other domain.ValueObject) bool {
+	if otherDesc, ok := other.(RelationTypeDescription); ok {
+		return p.value == otherDesc.value
+	}
+	return false
+}
+
+func (p RelationTypeDescription) String() string {
+	return p.value
+}
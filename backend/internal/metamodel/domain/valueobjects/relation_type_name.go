@@ -0,0 +1,49 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+const MaxRelationTypeNameLength = 50
+
+var (
+	ErrRelationTypeNameEmpty   = errors.New("relation type name cannot be empty or whitespace only")
+	ErrRelationTypeNameTooLong = errors.New("relation type name cannot exceed 50 characters")
+)
+
+type RelationTypeName struct {
+	value string
+}
+
+func NewRelationTypeName(value string) (RelationTypeName, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return RelationTypeName{}, ErrRelationTypeNameEmpty
+	}
+	if len(trimmed) > MaxRelationTypeNameLength {
+		return RelationTypeName{}, ErrRelationTypeNameTooLong
+	}
+	return RelationTypeName{value: trimmed}, nil
+}
+
+func (n RelationTypeName) Value() string {
+	return n.value
+}
+
+func (n RelationTypeName) EqualsIgnoreCase(other RelationTypeName) bool {
+	return strings.EqualFold(n.value, other.value)
+}
+
+func (n RelationTypeName) Equals(other domain.ValueObject) bool {
+	if otherName, ok := other.(RelationTypeName); ok {
+		return n.value == otherName.value
+	}
+	return false
+}
+
+func (n RelationTypeName) String() string {
+	return n.value
+}
@@ -0,0 +1,122 @@
+package api
+
+import (
+	"net/http"
+
+	authPL "easi/backend/internal/auth/publishedlanguage"
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/application/readmodels"
+	"easi/backend/internal/metamodel/domain/valueobjects"
+	sharedAPI "easi/backend/internal/shared/api"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type CapabilityHierarchyHandlers struct {
+	commandBus      cqrs.CommandBus
+	configs         configIDResolver
+	readModel       *readmodels.CapabilityHierarchyReadModel
+	hateoas         *MetaModelLinks
+	sessionProvider authPL.SessionProvider
+}
+
+func NewCapabilityHierarchyHandlers(
+	commandBus cqrs.CommandBus,
+	configReadModel *readmodels.MetaModelConfigurationReadModel,
+	readModel *readmodels.CapabilityHierarchyReadModel,
+	hateoas *MetaModelLinks,
+	sessionProvider authPL.SessionProvider,
+) *CapabilityHierarchyHandlers {
+	return &CapabilityHierarchyHandlers{
+		commandBus:      commandBus,
+		configs:         configIDResolver{commandBus: commandBus, configReadModel: configReadModel},
+		readModel:       readModel,
+		hateoas:         hateoas,
+		sessionProvider: sessionProvider,
+	}
+}
+
+type UpdateCapabilityHierarchyRequest struct {
+	Labels []string `json:"labels"`
+}
+
+// GetCapabilityHierarchy godoc
+// @Summary Get the capability hierarchy
+// @Description Retrieves how many capability levels the tenant models and the label of each level. Tenants that never configured it get the default four levels L1 to L4.
+// @Tags meta-model
+// @Produce json
+// @Success 200 {object} readmodels.CapabilityHierarchyDTO
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/capability-hierarchy [get]
+func (h *CapabilityHierarchyHandlers) GetCapabilityHierarchy(w http.ResponseWriter, r *http.Request) {
+	h.respondWithHierarchy(w, r, http.StatusOK)
+}
+
+// UpdateCapabilityHierarchy godoc
+// @Summary Update the capability hierarchy
+// @Description Sets the capability level labels, outermost first. The number of labels (one to six) is the maximum hierarchy depth and cannot be lower than the level of the deepest existing capability.
+// @Tags meta-model
+// @Accept json
+// @Produce json
+// @Param hierarchy body UpdateCapabilityHierarchyRequest true "Level labels"
+// @Success 200 {object} readmodels.CapabilityHierarchyDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/capability-hierarchy [put]
+func (h *CapabilityHierarchyHandlers) UpdateCapabilityHierarchy(w http.ResponseWriter, r *http.Request) {
+	email, err := h.sessionProvider.GetCurrentUserEmail(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusUnauthorized, err, "Authentication required")
+		return
+	}
+
+	req, ok := sharedAPI.DecodeRequestOrFail[UpdateCapabilityHierarchyRequest](w, r)
+	if !ok {
+		return
+	}
+
+	configID, err := h.configs.ensureConfigID(r.Context(), email)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to initialize configuration")
+		return
+	}
+
+	if _, err := h.commandBus.Dispatch(r.Context(), &commands.UpdateCapabilityHierarchy{
+		ID:         configID,
+		Labels:     req.Labels,
+		ModifiedBy: email,
+	}); err != nil {
+		statusCode := sharedAPI.MapErrorToStatusCode(err, http.StatusBadRequest)
+		sharedAPI.RespondError(w, statusCode, err, "Failed to update capability hierarchy")
+		return
+	}
+
+	h.respondWithHierarchy(w, r, http.StatusOK)
+}
+
+func (h *CapabilityHierarchyHandlers) respondWithHierarchy(w http.ResponseWriter, r *http.Request, status int) {
+	hierarchy, err := h.readModel.Get(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve capability hierarchy")
+		return
+	}
+	if hierarchy == nil {
+		defaultHierarchy := readmodels.NewCapabilityHierarchyDTO(valueobjects.DefaultCapabilityHierarchyConfig().Labels(), true)
+		hierarchy = &defaultHierarchy
+	} else {
+		hierarchy.IsDefault = isDefaultHierarchy(hierarchy.Levels)
+	}
+
+	hierarchy.Links = h.hateoas.CapabilityHierarchyLinks()
+	sharedAPI.RespondJSON(w, status, hierarchy)
+}
+
+func isDefaultHierarchy(levels []readmodels.CapabilityLevelLabelDTO) bool {
+	labels := make([]string, len(levels))
+	for i, level := range levels {
+		labels[i] = level.Label
+	}
+	config, err := valueobjects.NewCapabilityHierarchyConfig(labels)
+	return err == nil && config.IsDefault()
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"net/http"
+
+	authPL "easi/backend/internal/auth/publishedlanguage"
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	"easi/backend/internal/shared/cqrs"
+)
+
+type CapabilityTagVocabularyHandlers struct {
+	commandBus      cqrs.CommandBus
+	configs         configIDResolver
+	readModel       *readmodels.CapabilityTagVocabularyReadModel
+	hateoas         *MetaModelLinks
+	sessionProvider authPL.SessionProvider
+}
+
+func NewCapabilityTagVocabularyHandlers(
+	commandBus cqrs.CommandBus,
+	configReadModel *readmodels.MetaModelConfigurationReadModel,
+	readModel *readmodels.CapabilityTagVocabularyReadModel,
+	hateoas *MetaModelLinks,
+	sessionProvider authPL.SessionProvider,
+) *CapabilityTagVocabularyHandlers {
+	return &CapabilityTagVocabularyHandlers{
+		commandBus:      commandBus,
+		configs:         configIDResolver{commandBus: commandBus, configReadModel: configReadModel},
+		readModel:       readModel,
+		hateoas:         hateoas,
+		sessionProvider: sessionProvider,
+	}
+}
+
+type CapabilityTagTermRequest struct {
+	Tag      string `json:"tag"`
+	Category string `json:"category,omitempty"`
+}
+
+type UpdateCapabilityTagVocabularyRequest struct {
+	Terms      []CapabilityTagTermRequest `json:"terms"`
+	Restricted bool                       `json:"restricted"`
+}
+
+// GetCapabilityTagVocabulary godoc
+// @Summary Get the capability tag vocabulary
+// @Description Retrieves the tenant's approved capability tags with their optional categories, and whether capability tags are restricted to this vocabulary. Tenants that never defined one get an empty, unrestricted vocabulary.
+// @Tags meta-model
+// @Produce json
+// @Success 200 {object} readmodels.CapabilityTagVocabularyDTO
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/capability-tag-vocabulary [get]
+func (h *CapabilityTagVocabularyHandlers) GetCapabilityTagVocabulary(w http.ResponseWriter, r *http.Request) {
+	h.respondWithVocabulary(w, r)
+}
+
+// UpdateCapabilityTagVocabulary godoc
+// @Summary Update the capability tag vocabulary
+// @Description Replaces the approved capability tags (at most 500, unique ignoring case) and their categories. When restricted is true, capabilities can only be tagged with vocabulary terms; existing tags outside the vocabulary are kept until they are removed, renamed or merged.
+// @Tags meta-model
+// @Accept json
+// @Produce json
+// @Param vocabulary body UpdateCapabilityTagVocabularyRequest true "Vocabulary terms and restriction mode"
+// @Success 200 {object} readmodels.CapabilityTagVocabularyDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/capability-tag-vocabulary [put]
+func (h *CapabilityTagVocabularyHandlers) UpdateCapabilityTagVocabulary(w http.ResponseWriter, r *http.Request) {
+	email, err := h.sessionProvider.GetCurrentUserEmail(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusUnauthorized, err, "Authentication required")
+		return
+	}
+
+	req, ok := sharedAPI.DecodeRequestOrFail[UpdateCapabilityTagVocabularyRequest](w, r)
+	if !ok {
+		return
+	}
+
+	configID, err := h.configs.ensureConfigID(r.Context(), email)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to initialize configuration")
+		return
+	}
+
+	terms := make([]commands.CapabilityTagTerm, len(req.Terms))
+	for i, t := range req.Terms {
+		terms[i] = commands.CapabilityTagTerm{Tag: t.Tag, Category: t.Category}
+	}
+
+	if _, err := h.commandBus.Dispatch(r.Context(), &commands.UpdateCapabilityTagVocabulary{
+		ID:         configID,
+		Terms:      terms,
+		Restricted: req.Restricted,
+		ModifiedBy: email,
+	}); err != nil {
+		statusCode := sharedAPI.MapErrorToStatusCode(err, http.StatusBadRequest)
+		sharedAPI.RespondError(w, statusCode, err, "Failed to update capability tag vocabulary")
+		return
+	}
+
+	h.respondWithVocabulary(w, r)
+}
+
+func (h *CapabilityTagVocabularyHandlers) respondWithVocabulary(w http.ResponseWriter, r *http.Request) {
+	vocabulary, err := h.readModel.Get(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve capability tag vocabulary")
+		return
+	}
+	if vocabulary == nil {
+		vocabulary = &readmodels.CapabilityTagVocabularyDTO{Terms: []readmodels.CapabilityTagTermDTO{}}
+	}
+
+	vocabulary.Links = h.hateoas.CapabilityTagVocabularyLinks()
+	sharedAPI.RespondJSON(w, http.StatusOK, vocabulary)
+}
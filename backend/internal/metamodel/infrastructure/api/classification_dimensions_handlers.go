@@ -0,0 +1,226 @@
+package api
+
+import (
+	"net/http"
+
+	authPL "easi/backend/internal/auth/publishedlanguage"
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ClassificationDimensionsHandlers struct {
+	commandBus      cqrs.CommandBus
+	configs         configIDResolver
+	readModel       *readmodels.ClassificationDimensionReadModel
+	hateoas         *MetaModelLinks
+	sessionProvider authPL.SessionProvider
+}
+
+func NewClassificationDimensionsHandlers(
+	commandBus cqrs.CommandBus,
+	configReadModel *readmodels.MetaModelConfigurationReadModel,
+	readModel *readmodels.ClassificationDimensionReadModel,
+	hateoas *MetaModelLinks,
+	sessionProvider authPL.SessionProvider,
+) *ClassificationDimensionsHandlers {
+	return &ClassificationDimensionsHandlers{
+		commandBus:      commandBus,
+		configs:         configIDResolver{commandBus: commandBus, configReadModel: configReadModel},
+		readModel:       readModel,
+		hateoas:         hateoas,
+		sessionProvider: sessionProvider,
+	}
+}
+
+type ClassificationDimensionRequest struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// GetClassificationDimensions godoc
+// @Summary Get classification dimensions
+// @Description Retrieves the tenant-configured dimensions used to classify application components, such as hosting model or business criticality
+// @Tags meta-model
+// @Produce json
+// @Param includeInactive query bool false "Include removed dimensions"
+// @Success 200 {object} sharedAPI.CollectionResponse{data=[]readmodels.ClassificationDimensionDTO}
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/classification-dimensions [get]
+func (h *ClassificationDimensionsHandlers) GetClassificationDimensions(w http.ResponseWriter, r *http.Request) {
+	includeInactive := r.URL.Query().Get("includeInactive") == "true"
+
+	dimensions, err := h.readModel.GetAll(r.Context(), includeInactive)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve classification dimensions")
+		return
+	}
+
+	for i := range dimensions {
+		dimensions[i].Links = h.hateoas.ClassificationDimensionLinks(dimensions[i].ID, dimensions[i].Active)
+	}
+
+	sharedAPI.RespondCollection(w, http.StatusOK, dimensions, h.hateoas.ClassificationDimensionsCollectionLinks())
+}
+
+// GetClassificationDimensionByID godoc
+// @Summary Get classification dimension by ID
+// @Description Retrieves a single tenant-configured classification dimension with its allowed values
+// @Tags meta-model
+// @Produce json
+// @Param id path string true "Classification dimension ID"
+// @Success 200 {object} readmodels.ClassificationDimensionDTO
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/classification-dimensions/{id} [get]
+func (h *ClassificationDimensionsHandlers) GetClassificationDimensionByID(w http.ResponseWriter, r *http.Request) {
+	h.respondWithDimension(w, r, chi.URLParam(r, "id"), http.StatusOK)
+}
+
+// CreateClassificationDimension godoc
+// @Summary Create a classification dimension
+// @Description Defines a new component classification dimension and its allowed values for the current tenant
+// @Tags meta-model
+// @Accept json
+// @Produce json
+// @Param dimension body ClassificationDimensionRequest true "Dimension to create"
+// @Success 201 {object} readmodels.ClassificationDimensionDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/classification-dimensions [post]
+func (h *ClassificationDimensionsHandlers) CreateClassificationDimension(w http.ResponseWriter, r *http.Request) {
+	email, err := h.sessionProvider.GetCurrentUserEmail(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusUnauthorized, err, "Authentication required")
+		return
+	}
+
+	req, ok := sharedAPI.DecodeRequestOrFail[ClassificationDimensionRequest](w, r)
+	if !ok {
+		return
+	}
+
+	configID, err := h.configs.ensureConfigID(r.Context(), email)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to initialize configuration")
+		return
+	}
+
+	result, err := h.commandBus.Dispatch(r.Context(), &commands.AddClassificationDimension{
+		ConfigID:   configID,
+		Name:       req.Name,
+		Values:     req.Values,
+		ModifiedBy: email,
+	})
+	if err != nil {
+		statusCode := sharedAPI.MapErrorToStatusCode(err, http.StatusBadRequest)
+		sharedAPI.RespondError(w, statusCode, err, "Failed to create classification dimension")
+		return
+	}
+
+	w.Header().Set("Location", "/api/v1/meta-model/classification-dimensions/"+result.CreatedID)
+	h.respondWithDimension(w, r, result.CreatedID, http.StatusCreated)
+}
+
+// UpdateClassificationDimension godoc
+// @Summary Update a classification dimension
+// @Description Renames a classification dimension or replaces its allowed values. Components keep values that are no longer allowed until reclassified.
+// @Tags meta-model
+// @Accept json
+// @Produce json
+// @Param id path string true "Classification dimension ID"
+// @Param dimension body ClassificationDimensionRequest true "Dimension updates"
+// @Success 200 {object} readmodels.ClassificationDimensionDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/classification-dimensions/{id} [put]
+func (h *ClassificationDimensionsHandlers) UpdateClassificationDimension(w http.ResponseWriter, r *http.Request) {
+	dimensionID := chi.URLParam(r, "id")
+
+	email, err := h.sessionProvider.GetCurrentUserEmail(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusUnauthorized, err, "Authentication required")
+		return
+	}
+
+	req, ok := sharedAPI.DecodeRequestOrFail[ClassificationDimensionRequest](w, r)
+	if !ok {
+		return
+	}
+
+	configID, ok := h.configs.requireConfigID(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.commandBus.Dispatch(r.Context(), &commands.UpdateClassificationDimension{
+		ConfigID:    configID,
+		DimensionID: dimensionID,
+		Name:        req.Name,
+		Values:      req.Values,
+		ModifiedBy:  email,
+	}); err != nil {
+		statusCode := sharedAPI.MapErrorToStatusCode(err, http.StatusBadRequest)
+		sharedAPI.RespondError(w, statusCode, err, "Failed to update classification dimension")
+		return
+	}
+
+	h.respondWithDimension(w, r, dimensionID, http.StatusOK)
+}
+
+// DeleteClassificationDimension godoc
+// @Summary Delete a classification dimension
+// @Description Soft deletes a classification dimension. It can no longer be used to classify components and drops out of search facets.
+// @Tags meta-model
+// @Param id path string true "Classification dimension ID"
+// @Success 204
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/classification-dimensions/{id} [delete]
+func (h *ClassificationDimensionsHandlers) DeleteClassificationDimension(w http.ResponseWriter, r *http.Request) {
+	email, err := h.sessionProvider.GetCurrentUserEmail(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusUnauthorized, err, "Authentication required")
+		return
+	}
+
+	configID, ok := h.configs.requireConfigID(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.commandBus.Dispatch(r.Context(), &commands.RemoveClassificationDimension{
+		ConfigID:    configID,
+		DimensionID: chi.URLParam(r, "id"),
+		ModifiedBy:  email,
+	}); err != nil {
+		statusCode := sharedAPI.MapErrorToStatusCode(err, http.StatusBadRequest)
+		sharedAPI.RespondError(w, statusCode, err, "Failed to delete classification dimension")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ClassificationDimensionsHandlers) respondWithDimension(w http.ResponseWriter, r *http.Request, id string, status int) {
+	dimension, err := h.readModel.GetByID(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve classification dimension")
+		return
+	}
+	if dimension == nil {
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "Classification dimension not found")
+		return
+	}
+
+	dimension.Links = h.hateoas.ClassificationDimensionLinks(dimension.ID, dimension.Active)
+	sharedAPI.RespondJSON(w, status, dimension)
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	sharedctx "easi/backend/internal/shared/context"
+	"easi/backend/internal/shared/cqrs"
+)
+
+// configIDResolver locates the tenant's meta-model configuration for handlers
+// that manage entries inside it, creating the configuration on first write.
+type configIDResolver struct {
+	commandBus      cqrs.CommandBus
+	configReadModel *readmodels.MetaModelConfigurationReadModel
+}
+
+func (c configIDResolver) requireConfigID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	config, err := c.configReadModel.GetByTenantID(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve configuration")
+		return "", false
+	}
+	if config == nil {
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "Configuration not found")
+		return "", false
+	}
+	return config.ID, true
+}
+
+func (c configIDResolver) ensureConfigID(ctx context.Context, email string) (string, error) {
+	config, err := c.configReadModel.GetByTenantID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if config != nil {
+		return config.ID, nil
+	}
+
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := c.commandBus.Dispatch(ctx, &commands.CreateMetaModelConfiguration{
+		TenantID:  tenantID.Value(),
+		CreatedBy: email,
+	})
+	if err != nil {
+		return "", err
+	}
+	return result.CreatedID, nil
+}
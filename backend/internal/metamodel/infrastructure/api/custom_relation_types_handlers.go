@@ -0,0 +1,232 @@
+package api
+
+import (
+	"net/http"
+
+	authPL "easi/backend/internal/auth/publishedlanguage"
+	"easi/backend/internal/metamodel/application/commands"
+	"easi/backend/internal/metamodel/application/readmodels"
+	sharedAPI "easi/backend/internal/shared/api"
+	"easi/backend/internal/shared/cqrs"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CustomRelationTypesHandlers struct {
+	commandBus      cqrs.CommandBus
+	configs         configIDResolver
+	readModel       *readmodels.CustomRelationTypeReadModel
+	hateoas         *MetaModelLinks
+	sessionProvider authPL.SessionProvider
+}
+
+func NewCustomRelationTypesHandlers(
+	commandBus cqrs.CommandBus,
+	configReadModel *readmodels.MetaModelConfigurationReadModel,
+	readModel *readmodels.CustomRelationTypeReadModel,
+	hateoas *MetaModelLinks,
+	sessionProvider authPL.SessionProvider,
+) *CustomRelationTypesHandlers {
+	return &CustomRelationTypesHandlers{
+		commandBus:      commandBus,
+		configs:         configIDResolver{commandBus: commandBus, configReadModel: configReadModel},
+		readModel:       readModel,
+		hateoas:         hateoas,
+		sessionProvider: sessionProvider,
+	}
+}
+
+type CustomRelationTypeRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	LineStyle   string `json:"lineStyle"`
+	Color       string `json:"color"`
+}
+
+// GetCustomRelationTypes godoc
+// @Summary Get custom relation types
+// @Description Retrieves the tenant-defined component relation types that extend the built-in ArchiMate relation types
+// @Tags meta-model
+// @Produce json
+// @Param includeInactive query bool false "Include removed relation types"
+// @Success 200 {object} sharedAPI.CollectionResponse{data=[]readmodels.CustomRelationTypeDTO}
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/relation-types [get]
+func (h *CustomRelationTypesHandlers) GetCustomRelationTypes(w http.ResponseWriter, r *http.Request) {
+	includeInactive := r.URL.Query().Get("includeInactive") == "true"
+
+	relationTypes, err := h.readModel.GetAll(r.Context(), includeInactive)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve relation types")
+		return
+	}
+
+	for i := range relationTypes {
+		relationTypes[i].Links = h.hateoas.CustomRelationTypeLinks(relationTypes[i].ID, relationTypes[i].Active)
+	}
+
+	sharedAPI.RespondCollection(w, http.StatusOK, relationTypes, h.hateoas.CustomRelationTypesCollectionLinks())
+}
+
+// GetCustomRelationTypeByID godoc
+// @Summary Get custom relation type by ID
+// @Description Retrieves a single tenant-defined component relation type
+// @Tags meta-model
+// @Produce json
+// @Param id path string true "Relation type ID"
+// @Success 200 {object} readmodels.CustomRelationTypeDTO
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/relation-types/{id} [get]
+func (h *CustomRelationTypesHandlers) GetCustomRelationTypeByID(w http.ResponseWriter, r *http.Request) {
+	h.respondWithRelationType(w, r, chi.URLParam(r, "id"), http.StatusOK)
+}
+
+// CreateCustomRelationType godoc
+// @Summary Create a custom relation type
+// @Description Defines a new component relation type for the current tenant
+// @Tags meta-model
+// @Accept json
+// @Produce json
+// @Param relationType body CustomRelationTypeRequest true "Relation type to create"
+// @Success 201 {object} readmodels.CustomRelationTypeDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/relation-types [post]
+func (h *CustomRelationTypesHandlers) CreateCustomRelationType(w http.ResponseWriter, r *http.Request) {
+	email, err := h.sessionProvider.GetCurrentUserEmail(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusUnauthorized, err, "Authentication required")
+		return
+	}
+
+	req, ok := sharedAPI.DecodeRequestOrFail[CustomRelationTypeRequest](w, r)
+	if !ok {
+		return
+	}
+
+	configID, err := h.configs.ensureConfigID(r.Context(), email)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to initialize configuration")
+		return
+	}
+
+	result, err := h.commandBus.Dispatch(r.Context(), &commands.AddCustomRelationType{
+		ConfigID:    configID,
+		Name:        req.Name,
+		Description: req.Description,
+		LineStyle:   req.LineStyle,
+		Color:       req.Color,
+		ModifiedBy:  email,
+	})
+	if err != nil {
+		statusCode := sharedAPI.MapErrorToStatusCode(err, http.StatusBadRequest)
+		sharedAPI.RespondError(w, statusCode, err, "Failed to create relation type")
+		return
+	}
+
+	w.Header().Set("Location", "/api/v1/meta-model/relation-types/"+result.CreatedID)
+	h.respondWithRelationType(w, r, result.CreatedID, http.StatusCreated)
+}
+
+// UpdateCustomRelationType godoc
+// @Summary Update a custom relation type
+// @Description Updates the name, description and edge style of a tenant-defined relation type
+// @Tags meta-model
+// @Accept json
+// @Produce json
+// @Param id path string true "Relation type ID"
+// @Param relationType body CustomRelationTypeRequest true "Relation type updates"
+// @Success 200 {object} readmodels.CustomRelationTypeDTO
+// @Failure 400 {object} sharedAPI.ErrorResponse
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/relation-types/{id} [put]
+func (h *CustomRelationTypesHandlers) UpdateCustomRelationType(w http.ResponseWriter, r *http.Request) {
+	relationTypeID := chi.URLParam(r, "id")
+
+	email, err := h.sessionProvider.GetCurrentUserEmail(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusUnauthorized, err, "Authentication required")
+		return
+	}
+
+	req, ok := sharedAPI.DecodeRequestOrFail[CustomRelationTypeRequest](w, r)
+	if !ok {
+		return
+	}
+
+	configID, ok := h.configs.requireConfigID(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.commandBus.Dispatch(r.Context(), &commands.UpdateCustomRelationType{
+		ConfigID:       configID,
+		RelationTypeID: relationTypeID,
+		Name:           req.Name,
+		Description:    req.Description,
+		LineStyle:      req.LineStyle,
+		Color:          req.Color,
+		ModifiedBy:     email,
+	}); err != nil {
+		statusCode := sharedAPI.MapErrorToStatusCode(err, http.StatusBadRequest)
+		sharedAPI.RespondError(w, statusCode, err, "Failed to update relation type")
+		return
+	}
+
+	h.respondWithRelationType(w, r, relationTypeID, http.StatusOK)
+}
+
+// DeleteCustomRelationType godoc
+// @Summary Delete a custom relation type
+// @Description Soft deletes a custom relation type. Existing relations keep their type; it can no longer be used for new relations.
+// @Tags meta-model
+// @Param id path string true "Relation type ID"
+// @Success 204
+// @Failure 401 {object} sharedAPI.ErrorResponse
+// @Failure 404 {object} sharedAPI.ErrorResponse
+// @Failure 409 {object} sharedAPI.ErrorResponse
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Router /meta-model/relation-types/{id} [delete]
+func (h *CustomRelationTypesHandlers) DeleteCustomRelationType(w http.ResponseWriter, r *http.Request) {
+	email, err := h.sessionProvider.GetCurrentUserEmail(r.Context())
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusUnauthorized, err, "Authentication required")
+		return
+	}
+
+	configID, ok := h.configs.requireConfigID(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.commandBus.Dispatch(r.Context(), &commands.RemoveCustomRelationType{
+		ConfigID:       configID,
+		RelationTypeID: chi.URLParam(r, "id"),
+		ModifiedBy:     email,
+	}); err != nil {
+		statusCode := sharedAPI.MapErrorToStatusCode(err, http.StatusBadRequest)
+		sharedAPI.RespondError(w, statusCode, err, "Failed to delete relation type")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CustomRelationTypesHandlers) respondWithRelationType(w http.ResponseWriter, r *http.Request, id string, status int) {
+	relationType, err := h.readModel.GetByID(r.Context(), id)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve relation type")
+		return
+	}
+	if relationType == nil {
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "Relation type not found")
+		return
+	}
+
+	relationType.Links = h.hateoas.CustomRelationTypeLinks(relationType.ID, relationType.Active)
+	sharedAPI.RespondJSON(w, status, relationType)
+}
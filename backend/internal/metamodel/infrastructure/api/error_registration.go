@@ -17,4 +17,40 @@ func init() {
 	registry.RegisterValidation(valueobjects.ErrTooManyPillars, "Cannot have more than 20 pillars")
 	registry.RegisterValidation(valueobjects.ErrPillarNameDuplicate, "Pillar name already exists")
 	registry.RegisterValidation(valueobjects.ErrPillarNotFound, "Pillar not found")
+
+	registry.RegisterNotFound(valueobjects.ErrCustomRelationTypeNotFound, "Custom relation type not found")
+	registry.RegisterConflict(valueobjects.ErrCustomRelationTypeAlreadyInactive, "Custom relation type is already inactive")
+	registry.RegisterValidation(valueobjects.ErrTooManyCustomRelationTypes, "Cannot have more than 30 custom relation types")
+	registry.RegisterValidation(valueobjects.ErrCustomRelationTypeNameDuplicate, "Custom relation type name already exists")
+	registry.RegisterValidation(valueobjects.ErrRelationTypeNameEmpty, "Relation type name is required")
+	registry.RegisterValidation(valueobjects.ErrRelationTypeNameTooLong, "Relation type name cannot exceed 50 characters")
+	registry.RegisterValidation(valueobjects.ErrRelationTypeDescriptionTooLong, "Relation type description cannot exceed 500 characters")
+	registry.RegisterValidation(valueobjects.ErrInvalidEdgeLineStyle, "Edge line style must be solid, dashed, or dotted")
+	registry.RegisterValidation(valueobjects.ErrInvalidEdgeColor, "Edge color must be a hex color in the form #RRGGBB")
+
+	registry.RegisterNotFound(valueobjects.ErrClassificationDimensionNotFound, "Classification dimension not found")
+	registry.RegisterConflict(valueobjects.ErrClassificationDimensionAlreadyInactive, "Classification dimension is already inactive")
+	registry.RegisterValidation(valueobjects.ErrTooManyClassificationDimensions, "Cannot have more than 20 classification dimensions")
+	registry.RegisterValidation(valueobjects.ErrClassificationDimensionNameDuplicate, "Classification dimension name already exists")
+	registry.RegisterValidation(valueobjects.ErrClassificationDimensionNameEmpty, "Classification dimension name is required")
+	registry.RegisterValidation(valueobjects.ErrClassificationDimensionNameTooLong, "Classification dimension name cannot exceed 50 characters")
+	registry.RegisterValidation(valueobjects.ErrClassificationValuesEmpty, "Classification dimension must have at least one value")
+	registry.RegisterValidation(valueobjects.ErrTooManyClassificationValues, "Classification dimension cannot have more than 20 values")
+	registry.RegisterValidation(valueobjects.ErrClassificationValueEmpty, "Classification values cannot be empty")
+	registry.RegisterValidation(valueobjects.ErrClassificationValueTooLong, "Classification values cannot exceed 50 characters")
+	registry.RegisterValidation(valueobjects.ErrClassificationValueDuplicate, "Classification values must be unique")
+
+	registry.RegisterValidation(valueobjects.ErrCapabilityHierarchyEmpty, "Capability hierarchy must have at least one level")
+	registry.RegisterValidation(valueobjects.ErrCapabilityHierarchyTooDeep, "Capability hierarchy cannot have more than 6 levels")
+	registry.RegisterValidation(valueobjects.ErrCapabilityLevelLabelEmpty, "Capability level labels cannot be empty")
+	registry.RegisterValidation(valueobjects.ErrCapabilityLevelLabelTooLong, "Capability level labels cannot exceed 50 characters")
+	registry.RegisterValidation(valueobjects.ErrCapabilityLevelLabelDuplicate, "Capability level labels must be unique")
+	registry.RegisterConflict(valueobjects.ErrCapabilityHierarchyBelowExistingLevels, "Capability hierarchy cannot have fewer levels than the deepest existing capability; move those capabilities up first")
+
+	registry.RegisterValidation(valueobjects.ErrCapabilityTagTermEmpty, "Vocabulary tags cannot be empty")
+	registry.RegisterValidation(valueobjects.ErrCapabilityTagTermTooLong, "Vocabulary tags cannot exceed 50 characters")
+	registry.RegisterValidation(valueobjects.ErrCapabilityTagTermDuplicate, "Vocabulary tags must be unique")
+	registry.RegisterValidation(valueobjects.ErrCapabilityTagCategoryTooLong, "Vocabulary tag categories cannot exceed 50 characters")
+	registry.RegisterValidation(valueobjects.ErrTooManyCapabilityTagTerms, "Tag vocabulary cannot have more than 500 tags")
+	registry.RegisterValidation(valueobjects.ErrRestrictedTagVocabularyEmpty, "A restricted tag vocabulary must contain at least one tag")
 }
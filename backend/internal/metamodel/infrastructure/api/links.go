@@ -45,3 +45,39 @@ func (h *MetaModelLinks) StrategyPillarLinks(id string, isActive bool) sharedAPI
 func (h *MetaModelLinks) StrategyPillarsCollectionLinks() sharedAPI.Links {
 	return sharedAPI.Links{"self": h.Get("/meta-model/strategy-pillars"), "create": h.Post("/meta-model/strategy-pillars")}
 }
+
+func (h *MetaModelLinks) CustomRelationTypeLinks(id string, isActive bool) sharedAPI.Links {
+	p := "/meta-model/relation-types/" + id
+	links := sharedAPI.Links{"self": h.Get(p), "collection": h.Get("/meta-model/relation-types")}
+	if isActive {
+		links["edit"] = h.Put(p)
+		links["delete"] = h.Del(p)
+	}
+	return links
+}
+
+func (h *MetaModelLinks) CustomRelationTypesCollectionLinks() sharedAPI.Links {
+	return sharedAPI.Links{"self": h.Get("/meta-model/relation-types"), "create": h.Post("/meta-model/relation-types")}
+}
+
+func (h *MetaModelLinks) ClassificationDimensionLinks(id string, isActive bool) sharedAPI.Links {
+	p := "/meta-model/classification-dimensions/" + id
+	links := sharedAPI.Links{"self": h.Get(p), "collection": h.Get("/meta-model/classification-dimensions")}
+	if isActive {
+		links["edit"] = h.Put(p)
+		links["delete"] = h.Del(p)
+	}
+	return links
+}
+
+func (h *MetaModelLinks) ClassificationDimensionsCollectionLinks() sharedAPI.Links {
+	return sharedAPI.Links{"self": h.Get("/meta-model/classification-dimensions"), "create": h.Post("/meta-model/classification-dimensions")}
+}
+
+func (h *MetaModelLinks) CapabilityHierarchyLinks() sharedAPI.Links {
+	return sharedAPI.Links{"self": h.Get("/meta-model/capability-hierarchy"), "edit": h.Put("/meta-model/capability-hierarchy")}
+}
+
+func (h *MetaModelLinks) CapabilityTagVocabularyLinks() sharedAPI.Links {
+	return sharedAPI.Links{"self": h.Get("/meta-model/capability-tag-vocabulary"), "edit": h.Put("/meta-model/capability-tag-vocabulary")}
+}
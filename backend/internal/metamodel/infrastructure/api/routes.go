@@ -24,14 +24,15 @@ type AuthMiddleware interface {
 }
 
 type MetaModelRoutesDeps struct {
-	Router          chi.Router
-	CommandBus      *cqrs.InMemoryCommandBus
-	EventStore      eventstore.EventStore
-	EventBus        events.EventBus
-	DB              *database.TenantAwareDB
-	Hateoas         *sharedAPI.HATEOASLinks
-	AuthMiddleware  AuthMiddleware
-	SessionProvider authPL.SessionProvider
+	Router           chi.Router
+	CommandBus       *cqrs.InMemoryCommandBus
+	EventStore       eventstore.EventStore
+	EventBus         events.EventBus
+	DB               *database.TenantAwareDB
+	Hateoas          *sharedAPI.HATEOASLinks
+	AuthMiddleware   AuthMiddleware
+	SessionProvider  authPL.SessionProvider
+	CapabilityLevels handlers.CapabilityLevelReader
 }
 
 func SetupMetaModelRoutes(deps MetaModelRoutesDeps) error {
@@ -39,7 +40,16 @@ func SetupMetaModelRoutes(deps MetaModelRoutesDeps) error {
 
 	configReadModel := readmodels.NewMetaModelConfigurationReadModel(deps.DB)
 
+	customRelationTypeReadModel := readmodels.NewCustomRelationTypeReadModel(deps.DB)
+	classificationDimensionReadModel := readmodels.NewClassificationDimensionReadModel(deps.DB)
+	capabilityHierarchyReadModel := readmodels.NewCapabilityHierarchyReadModel(deps.DB)
+	capabilityTagVocabularyReadModel := readmodels.NewCapabilityTagVocabularyReadModel(deps.DB)
+
 	configProjector := projectors.NewMetaModelConfigurationProjector(configReadModel)
+	customRelationTypeProjector := projectors.NewCustomRelationTypeProjector(customRelationTypeReadModel, configReadModel)
+	classificationDimensionProjector := projectors.NewClassificationDimensionProjector(classificationDimensionReadModel, configReadModel)
+	capabilityHierarchyProjector := projectors.NewCapabilityHierarchyProjector(capabilityHierarchyReadModel, configReadModel)
+	capabilityTagVocabularyProjector := projectors.NewCapabilityTagVocabularyProjector(capabilityTagVocabularyReadModel, configReadModel)
 
 	deps.EventBus.Subscribe(mmPL.MetaModelConfigurationCreated, configProjector)
 	deps.EventBus.Subscribe(mmPL.MaturityScaleConfigUpdated, configProjector)
@@ -48,6 +58,14 @@ func SetupMetaModelRoutes(deps MetaModelRoutesDeps) error {
 	deps.EventBus.Subscribe(mmPL.StrategyPillarUpdated, configProjector)
 	deps.EventBus.Subscribe(mmPL.StrategyPillarRemoved, configProjector)
 	deps.EventBus.Subscribe(mmPL.PillarFitConfigurationUpdated, configProjector)
+	deps.EventBus.Subscribe(mmPL.CustomRelationTypeAdded, customRelationTypeProjector)
+	deps.EventBus.Subscribe(mmPL.CustomRelationTypeUpdated, customRelationTypeProjector)
+	deps.EventBus.Subscribe(mmPL.CustomRelationTypeRemoved, customRelationTypeProjector)
+	deps.EventBus.Subscribe(mmPL.ClassificationDimensionAdded, classificationDimensionProjector)
+	deps.EventBus.Subscribe(mmPL.ClassificationDimensionUpdated, classificationDimensionProjector)
+	deps.EventBus.Subscribe(mmPL.ClassificationDimensionRemoved, classificationDimensionProjector)
+	deps.EventBus.Subscribe(mmPL.CapabilityHierarchyConfigUpdated, capabilityHierarchyProjector)
+	deps.EventBus.Subscribe(mmPL.CapabilityTagVocabularyUpdated, capabilityTagVocabularyProjector)
 
 	createConfigHandler := handlers.NewCreateMetaModelConfigurationHandler(configRepo)
 	updateScaleHandler := handlers.NewUpdateMaturityScaleHandler(configRepo)
@@ -69,12 +87,27 @@ func SetupMetaModelRoutes(deps MetaModelRoutesDeps) error {
 	deps.CommandBus.Register("BatchUpdateStrategyPillars", batchUpdatePillarsHandler)
 	deps.CommandBus.Register("UpdatePillarFitConfiguration", updatePillarFitConfigHandler)
 
+	deps.CommandBus.Register("AddCustomRelationType", handlers.NewAddCustomRelationTypeHandler(configRepo))
+	deps.CommandBus.Register("UpdateCustomRelationType", handlers.NewUpdateCustomRelationTypeHandler(configRepo))
+	deps.CommandBus.Register("RemoveCustomRelationType", handlers.NewRemoveCustomRelationTypeHandler(configRepo))
+
+	deps.CommandBus.Register("AddClassificationDimension", handlers.NewAddClassificationDimensionHandler(configRepo))
+	deps.CommandBus.Register("UpdateClassificationDimension", handlers.NewUpdateClassificationDimensionHandler(configRepo))
+	deps.CommandBus.Register("RemoveClassificationDimension", handlers.NewRemoveClassificationDimensionHandler(configRepo))
+
+	deps.CommandBus.Register("UpdateCapabilityHierarchy", handlers.NewUpdateCapabilityHierarchyHandler(configRepo, deps.CapabilityLevels))
+	deps.CommandBus.Register("UpdateCapabilityTagVocabulary", handlers.NewUpdateCapabilityTagVocabularyHandler(configRepo))
+
 	tenantCreatedHandler := handlers.NewTenantCreatedHandler(deps.CommandBus)
 	deps.EventBus.Subscribe(platformPL.TenantCreated, tenantCreatedHandler)
 
 	links := NewMetaModelLinks(deps.Hateoas)
 	metaModelHandlers := NewMetaModelHandlers(deps.CommandBus, configReadModel, links, deps.SessionProvider)
 	strategyPillarsHandlers := NewStrategyPillarsHandlers(deps.CommandBus, configReadModel, links, deps.SessionProvider)
+	customRelationTypesHandlers := NewCustomRelationTypesHandlers(deps.CommandBus, configReadModel, customRelationTypeReadModel, links, deps.SessionProvider)
+	classificationDimensionsHandlers := NewClassificationDimensionsHandlers(deps.CommandBus, configReadModel, classificationDimensionReadModel, links, deps.SessionProvider)
+	capabilityHierarchyHandlers := NewCapabilityHierarchyHandlers(deps.CommandBus, configReadModel, capabilityHierarchyReadModel, links, deps.SessionProvider)
+	capabilityTagVocabularyHandlers := NewCapabilityTagVocabularyHandlers(deps.CommandBus, configReadModel, capabilityTagVocabularyReadModel, links, deps.SessionProvider)
 
 	deps.Router.Route("/meta-model", func(r chi.Router) {
 		r.Group(func(r chi.Router) {
@@ -83,6 +116,12 @@ func SetupMetaModelRoutes(deps MetaModelRoutesDeps) error {
 			r.Get("/configurations/{id}", metaModelHandlers.GetMaturityScaleByID)
 			r.Get("/strategy-pillars", strategyPillarsHandlers.GetStrategyPillars)
 			r.Get("/strategy-pillars/{id}", strategyPillarsHandlers.GetStrategyPillarByID)
+			r.Get("/relation-types", customRelationTypesHandlers.GetCustomRelationTypes)
+			r.Get("/relation-types/{id}", customRelationTypesHandlers.GetCustomRelationTypeByID)
+			r.Get("/classification-dimensions", classificationDimensionsHandlers.GetClassificationDimensions)
+			r.Get("/classification-dimensions/{id}", classificationDimensionsHandlers.GetClassificationDimensionByID)
+			r.Get("/capability-hierarchy", capabilityHierarchyHandlers.GetCapabilityHierarchy)
+			r.Get("/capability-tag-vocabulary", capabilityTagVocabularyHandlers.GetCapabilityTagVocabulary)
 		})
 
 		r.Group(func(r chi.Router) {
@@ -94,6 +133,14 @@ func SetupMetaModelRoutes(deps MetaModelRoutesDeps) error {
 			r.Put("/strategy-pillars/{id}", strategyPillarsHandlers.UpdateStrategyPillar)
 			r.Put("/strategy-pillars/{id}/fit-configuration", strategyPillarsHandlers.UpdatePillarFitConfiguration)
 			r.Delete("/strategy-pillars/{id}", strategyPillarsHandlers.DeleteStrategyPillar)
+			r.Post("/relation-types", customRelationTypesHandlers.CreateCustomRelationType)
+			r.Put("/relation-types/{id}", customRelationTypesHandlers.UpdateCustomRelationType)
+			r.Delete("/relation-types/{id}", customRelationTypesHandlers.DeleteCustomRelationType)
+			r.Post("/classification-dimensions", classificationDimensionsHandlers.CreateClassificationDimension)
+			r.Put("/classification-dimensions/{id}", classificationDimensionsHandlers.UpdateClassificationDimension)
+			r.Delete("/classification-dimensions/{id}", classificationDimensionsHandlers.DeleteClassificationDimension)
+			r.Put("/capability-hierarchy", capabilityHierarchyHandlers.UpdateCapabilityHierarchy)
+			r.Put("/capability-tag-vocabulary", capabilityTagVocabularyHandlers.UpdateCapabilityTagVocabulary)
 		})
 	})
 
@@ -28,12 +28,20 @@ func NewMetaModelConfigurationRepository(eventStore eventstore.EventStore) *Meta
 
 var metaModelEventDeserializers = repository.NewEventDeserializers(
 	map[string]repository.EventDeserializerFunc{
-		"MetaModelConfigurationCreated": repository.JSONDeserializer[events.MetaModelConfigurationCreated],
-		"MaturityScaleConfigUpdated":    repository.JSONDeserializer[events.MaturityScaleConfigUpdated],
-		"MaturityScaleConfigReset":      repository.JSONDeserializer[events.MaturityScaleConfigReset],
-		"StrategyPillarAdded":           repository.JSONDeserializer[events.StrategyPillarAdded],
-		"StrategyPillarUpdated":         repository.JSONDeserializer[events.StrategyPillarUpdated],
-		"StrategyPillarRemoved":         repository.JSONDeserializer[events.StrategyPillarRemoved],
-		"PillarFitConfigurationUpdated": repository.JSONDeserializer[events.PillarFitConfigurationUpdated],
+		"MetaModelConfigurationCreated":    repository.JSONDeserializer[events.MetaModelConfigurationCreated],
+		"MaturityScaleConfigUpdated":       repository.JSONDeserializer[events.MaturityScaleConfigUpdated],
+		"MaturityScaleConfigReset":         repository.JSONDeserializer[events.MaturityScaleConfigReset],
+		"StrategyPillarAdded":              repository.JSONDeserializer[events.StrategyPillarAdded],
+		"StrategyPillarUpdated":            repository.JSONDeserializer[events.StrategyPillarUpdated],
+		"StrategyPillarRemoved":            repository.JSONDeserializer[events.StrategyPillarRemoved],
+		"PillarFitConfigurationUpdated":    repository.JSONDeserializer[events.PillarFitConfigurationUpdated],
+		"CustomRelationTypeAdded":          repository.JSONDeserializer[events.CustomRelationTypeAdded],
+		"CustomRelationTypeUpdated":        repository.JSONDeserializer[events.CustomRelationTypeUpdated],
+		"CustomRelationTypeRemoved":        repository.JSONDeserializer[events.CustomRelationTypeRemoved],
+		"ClassificationDimensionAdded":     repository.JSONDeserializer[events.ClassificationDimensionAdded],
+		"ClassificationDimensionUpdated":   repository.JSONDeserializer[events.ClassificationDimensionUpdated],
+		"ClassificationDimensionRemoved":   repository.JSONDeserializer[events.ClassificationDimensionRemoved],
+		"CapabilityHierarchyConfigUpdated": repository.JSONDeserializer[events.CapabilityHierarchyConfigUpdated],
+		"CapabilityTagVocabularyUpdated":   repository.JSONDeserializer[events.CapabilityTagVocabularyUpdated],
 	},
 )
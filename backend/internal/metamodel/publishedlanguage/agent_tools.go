@@ -16,5 +16,15 @@ func AgentTools() []pl.AgentToolSpec {
 			Access: pl.AccessRead, Permission: "metamodel:read",
 			Method: "GET", Path: "/meta-model/maturity-scale",
 		},
+		{
+			Name: "get_capability_hierarchy", Description: "Get the configured capability hierarchy. Returns how many capability levels the tenant models (one to six) and the label used for each level, e.g. L1 = Domain, L2 = Area, L3 = Capability. Defined in the MetaModel by enterprise architects.",
+			Access: pl.AccessRead, Permission: "metamodel:read",
+			Method: "GET", Path: "/meta-model/capability-hierarchy",
+		},
+		{
+			Name: "get_capability_tag_vocabulary", Description: "Get the capability tag vocabulary. Returns the approved capability tags with their optional categories, and whether capability tags are restricted to this vocabulary. Defined in the MetaModel by enterprise architects.",
+			Access: pl.AccessRead, Permission: "metamodel:read",
+			Method: "GET", Path: "/meta-model/capability-tag-vocabulary",
+		},
 	}
 }
@@ -62,3 +62,93 @@ type PillarFitConfigurationUpdatedPayload struct {
 	ModifiedAt        time.Time `json:"modifiedAt"`
 	ModifiedBy        string    `json:"modifiedBy"`
 }
+
+type CustomRelationTypeAddedPayload struct {
+	ID             string    `json:"id"`
+	TenantID       string    `json:"tenantId"`
+	Version        int       `json:"version"`
+	RelationTypeID string    `json:"relationTypeId"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	LineStyle      string    `json:"lineStyle"`
+	Color          string    `json:"color"`
+	ModifiedAt     time.Time `json:"modifiedAt"`
+	ModifiedBy     string    `json:"modifiedBy"`
+}
+
+type CustomRelationTypeUpdatedPayload struct {
+	ID             string    `json:"id"`
+	TenantID       string    `json:"tenantId"`
+	Version        int       `json:"version"`
+	RelationTypeID string    `json:"relationTypeId"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	LineStyle      string    `json:"lineStyle"`
+	Color          string    `json:"color"`
+	ModifiedAt     time.Time `json:"modifiedAt"`
+	ModifiedBy     string    `json:"modifiedBy"`
+}
+
+type CustomRelationTypeRemovedPayload struct {
+	ID             string    `json:"id"`
+	TenantID       string    `json:"tenantId"`
+	Version        int       `json:"version"`
+	RelationTypeID string    `json:"relationTypeId"`
+	ModifiedAt     time.Time `json:"modifiedAt"`
+	ModifiedBy     string    `json:"modifiedBy"`
+}
+
+type ClassificationDimensionAddedPayload struct {
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenantId"`
+	Version     int       `json:"version"`
+	DimensionID string    `json:"dimensionId"`
+	Name        string    `json:"name"`
+	Values      []string  `json:"values"`
+	ModifiedAt  time.Time `json:"modifiedAt"`
+	ModifiedBy  string    `json:"modifiedBy"`
+}
+
+type ClassificationDimensionUpdatedPayload struct {
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenantId"`
+	Version     int       `json:"version"`
+	DimensionID string    `json:"dimensionId"`
+	Name        string    `json:"name"`
+	Values      []string  `json:"values"`
+	ModifiedAt  time.Time `json:"modifiedAt"`
+	ModifiedBy  string    `json:"modifiedBy"`
+}
+
+type ClassificationDimensionRemovedPayload struct {
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenantId"`
+	Version     int       `json:"version"`
+	DimensionID string    `json:"dimensionId"`
+	ModifiedAt  time.Time `json:"modifiedAt"`
+	ModifiedBy  string    `json:"modifiedBy"`
+}
+
+type CapabilityHierarchyConfigUpdatedPayload struct {
+	ID         string    `json:"id"`
+	TenantID   string    `json:"tenantId"`
+	Version    int       `json:"version"`
+	Labels     []string  `json:"labels"`
+	ModifiedAt time.Time `json:"modifiedAt"`
+	ModifiedBy string    `json:"modifiedBy"`
+}
+
+type CapabilityTagTermPayload struct {
+	Tag      string `json:"tag"`
+	Category string `json:"category,omitempty"`
+}
+
+type CapabilityTagVocabularyUpdatedPayload struct {
+	ID         string                     `json:"id"`
+	TenantID   string                     `json:"tenantId"`
+	Version    int                        `json:"version"`
+	Terms      []CapabilityTagTermPayload `json:"terms"`
+	Restricted bool                       `json:"restricted"`
+	ModifiedAt time.Time                  `json:"modifiedAt"`
+	ModifiedBy string                     `json:"modifiedBy"`
+}
@@ -1,11 +1,20 @@
 package publishedlanguage
 
 const (
-	MetaModelConfigurationCreated = "MetaModelConfigurationCreated"
-	StrategyPillarAdded           = "StrategyPillarAdded"
-	StrategyPillarUpdated         = "StrategyPillarUpdated"
-	StrategyPillarRemoved         = "StrategyPillarRemoved"
-	PillarFitConfigurationUpdated = "PillarFitConfigurationUpdated"
-	MaturityScaleConfigUpdated    = "MaturityScaleConfigUpdated"
-	MaturityScaleConfigReset      = "MaturityScaleConfigReset"
+	MetaModelConfigurationCreated  = "MetaModelConfigurationCreated"
+	StrategyPillarAdded            = "StrategyPillarAdded"
+	StrategyPillarUpdated          = "StrategyPillarUpdated"
+	StrategyPillarRemoved          = "StrategyPillarRemoved"
+	PillarFitConfigurationUpdated  = "PillarFitConfigurationUpdated"
+	MaturityScaleConfigUpdated     = "MaturityScaleConfigUpdated"
+	MaturityScaleConfigReset       = "MaturityScaleConfigReset"
+	CustomRelationTypeAdded        = "CustomRelationTypeAdded"
+	CustomRelationTypeUpdated      = "CustomRelationTypeUpdated"
+	CustomRelationTypeRemoved      = "CustomRelationTypeRemoved"
+	ClassificationDimensionAdded   = "ClassificationDimensionAdded"
+	ClassificationDimensionUpdated = "ClassificationDimensionUpdated"
+	ClassificationDimensionRemoved = "ClassificationDimensionRemoved"
+
+	CapabilityHierarchyConfigUpdated = "CapabilityHierarchyConfigUpdated"
+	CapabilityTagVocabularyUpdated   = "CapabilityTagVocabularyUpdated"
 )
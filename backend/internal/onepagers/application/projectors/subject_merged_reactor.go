@@ -0,0 +1,138 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	amPL "easi/backend/internal/architecturemodeling/publishedlanguage"
+	capPL "easi/backend/internal/capabilitymapping/publishedlanguage"
+	"easi/backend/internal/onepagers/application/commands"
+	"easi/backend/internal/onepagers/application/readmodels"
+	domain "easi/backend/internal/shared/eventsourcing"
+)
+
+type FactsReader interface {
+	GetForSubject(ctx context.Context, subject readmodels.SubjectKey) ([]readmodels.FactRecord, error)
+}
+
+// SubjectMergedReactor copies the one-pager facts of a merged duplicate
+// application or capability onto the survivor for every field the survivor has
+// not filled in. A split capability's facts are copied onto each of its parts.
+// The original subject's own facts are archived when its deletion follows.
+type SubjectMergedReactor struct {
+	facts    FactsReader
+	commands CommandDispatcher
+}
+
+func NewSubjectMergedReactor(facts FactsReader, commandDispatcher CommandDispatcher) *SubjectMergedReactor {
+	return &SubjectMergedReactor{facts: facts, commands: commandDispatcher}
+}
+
+func (r *SubjectMergedReactor) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return fmt.Errorf("marshal %s event for aggregate %s: %w", event.EventType(), event.AggregateID(), err)
+	}
+	return r.ProjectEvent(ctx, event.EventType(), eventData)
+}
+
+type subjectMergedEvent struct {
+	ID         string `json:"id"`
+	SurvivorID string `json:"survivorId"`
+	MergedBy   string `json:"mergedBy"`
+	SplitBy    string `json:"splitBy"`
+	Parts      []struct {
+		ID string `json:"id"`
+	} `json:"parts"`
+}
+
+func (e subjectMergedEvent) survivorIDs() []string {
+	if e.SurvivorID != "" {
+		return []string{e.SurvivorID}
+	}
+	ids := make([]string, len(e.Parts))
+	for i, part := range e.Parts {
+		ids[i] = part.ID
+	}
+	return ids
+}
+
+func (e subjectMergedEvent) actor() string {
+	if e.MergedBy != "" {
+		return e.MergedBy
+	}
+	return e.SplitBy
+}
+
+var subjectTypeByMergeEvent = map[string]string{
+	amPL.ApplicationComponentMergedInto: "application",
+	capPL.CapabilityMergedInto:          "capability",
+	capPL.CapabilitySplit:               "capability",
+}
+
+func (r *SubjectMergedReactor) ProjectEvent(ctx context.Context, eventType string, eventData []byte) error {
+	subjectType, ok := subjectTypeByMergeEvent[eventType]
+	if !ok {
+		return nil
+	}
+
+	var event subjectMergedEvent
+	if err := json.Unmarshal(eventData, &event); err != nil {
+		return fmt.Errorf("unmarshal %s event: %w", eventType, err)
+	}
+
+	duplicateFacts, err := r.facts.GetForSubject(ctx, readmodels.SubjectKey{SubjectType: subjectType, SubjectID: event.ID})
+	if err != nil {
+		return fmt.Errorf("load one-pager facts for merged %s %s: %w", subjectType, event.ID, err)
+	}
+	if len(duplicateFacts) == 0 {
+		return nil
+	}
+
+	for _, survivorID := range event.survivorIDs() {
+		if err := r.copyMissingFacts(ctx, subjectType, survivorID, duplicateFacts, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *SubjectMergedReactor) copyMissingFacts(ctx context.Context, subjectType, survivorID string, duplicateFacts []readmodels.FactRecord, event subjectMergedEvent) error {
+	survivorFacts, err := r.facts.GetForSubject(ctx, readmodels.SubjectKey{SubjectType: subjectType, SubjectID: survivorID})
+	if err != nil {
+		return fmt.Errorf("load one-pager facts for surviving %s %s: %w", subjectType, survivorID, err)
+	}
+	filled := make(map[string]bool, len(survivorFacts))
+	for _, fact := range survivorFacts {
+		filled[fact.FieldID] = true
+	}
+
+	for _, fact := range duplicateFacts {
+		if filled[fact.FieldID] || fact.Value == nil {
+			continue
+		}
+		r.copyFact(ctx, fact, survivorID, event)
+	}
+	return nil
+}
+
+func (r *SubjectMergedReactor) copyFact(ctx context.Context, fact readmodels.FactRecord, survivorID string, event subjectMergedEvent) {
+	modifiedBy := event.actor()
+	if modifiedBy == "" {
+		modifiedBy = fact.ModifiedBy
+	}
+	if _, err := r.commands.Dispatch(ctx, &commands.RecordFieldValue{
+		FactsSubjectField: commands.FactsSubjectField{
+			TenantID:    fact.TenantID,
+			SubjectType: fact.SubjectType,
+			SubjectID:   survivorID,
+			FieldID:     fact.FieldID,
+			ModifiedBy:  modifiedBy,
+		},
+		Value: *fact.Value,
+	}); err != nil {
+		log.Printf("copy one-pager field %s from %s %s to %s: %v", fact.FieldID, fact.SubjectType, event.ID, survivorID, err)
+	}
+}
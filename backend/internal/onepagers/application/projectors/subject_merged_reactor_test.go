@@ -0,0 +1,88 @@
+package projectors
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"easi/backend/internal/onepagers/application/commands"
+	"easi/backend/internal/onepagers/application/readmodels"
+	"easi/backend/internal/onepagers/domain/valueobjects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFactsReader struct {
+	records map[string][]readmodels.FactRecord
+}
+
+func (f *fakeFactsReader) GetForSubject(_ context.Context, subject readmodels.SubjectKey) ([]readmodels.FactRecord, error) {
+	return f.records[subject.SubjectType+"/"+subject.SubjectID], nil
+}
+
+func textFact(subjectID, fieldID, text string) readmodels.FactRecord {
+	return readmodels.FactRecord{
+		TenantID:    "acme",
+		SubjectType: "application",
+		SubjectID:   subjectID,
+		FieldID:     fieldID,
+		Value:       &valueobjects.ValueEnvelope{Type: "text", Version: 1, Value: []byte(fmt.Sprintf("%q", text))},
+	}
+}
+
+func TestSubjectMergedReactor_CopiesOnlyFieldsTheSurvivorLacks(t *testing.T) {
+	duplicateID, survivorID := uuid.New().String(), uuid.New().String()
+	reader := &fakeFactsReader{records: map[string][]readmodels.FactRecord{
+		"application/" + duplicateID: {textFact(duplicateID, "purpose", "CRM"), textFact(duplicateID, "owner", "Sales")},
+		"application/" + survivorID:  {textFact(survivorID, "owner", "Marketing")},
+	}}
+	dispatcher := &fakeDispatcher{}
+	reactor := NewSubjectMergedReactor(reader, dispatcher)
+
+	err := reactor.ProjectEvent(context.Background(), "ApplicationComponentMergedInto",
+		[]byte(fmt.Sprintf(`{"id":%q,"survivorId":%q,"mergedBy":"jane@example.com"}`, duplicateID, survivorID)))
+
+	require.NoError(t, err)
+	require.Len(t, dispatcher.dispatched, 1)
+	cmd, ok := dispatcher.dispatched[0].(*commands.RecordFieldValue)
+	require.True(t, ok)
+	assert.Equal(t, survivorID, cmd.SubjectID)
+	assert.Equal(t, "purpose", cmd.FieldID)
+	assert.Equal(t, "jane@example.com", cmd.ModifiedBy)
+}
+
+func TestSubjectMergedReactor_OtherEventTypes_Ignored(t *testing.T) {
+	dispatcher := &fakeDispatcher{}
+	reactor := NewSubjectMergedReactor(&fakeFactsReader{}, dispatcher)
+
+	err := reactor.ProjectEvent(context.Background(), "ApplicationComponentDeleted", []byte(`{"id":"x"}`))
+
+	require.NoError(t, err)
+	assert.Empty(t, dispatcher.dispatched)
+}
+
+func TestSubjectMergedReactor_CapabilitySplit_CopiesFactsToEveryPart(t *testing.T) {
+	sourceID, firstPartID, secondPartID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	purpose := textFact(sourceID, "purpose", "Billing")
+	purpose.SubjectType = "capability"
+	reader := &fakeFactsReader{records: map[string][]readmodels.FactRecord{
+		"capability/" + sourceID: {purpose},
+	}}
+	dispatcher := &fakeDispatcher{}
+	reactor := NewSubjectMergedReactor(reader, dispatcher)
+
+	err := reactor.ProjectEvent(context.Background(), "CapabilitySplit",
+		[]byte(fmt.Sprintf(`{"id":%q,"parts":[{"id":%q},{"id":%q}],"splitBy":"jane@example.com"}`, sourceID, firstPartID, secondPartID)))
+
+	require.NoError(t, err)
+	require.Len(t, dispatcher.dispatched, 2)
+	for i, partID := range []string{firstPartID, secondPartID} {
+		cmd, ok := dispatcher.dispatched[i].(*commands.RecordFieldValue)
+		require.True(t, ok)
+		assert.Equal(t, partID, cmd.SubjectID)
+		assert.Equal(t, "capability", cmd.SubjectType)
+		assert.Equal(t, "jane@example.com", cmd.ModifiedBy)
+	}
+}
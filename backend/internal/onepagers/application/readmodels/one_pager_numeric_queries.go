@@ -0,0 +1,49 @@
+package readmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	sharedctx "easi/backend/internal/shared/context"
+
+	"github.com/lib/pq"
+)
+
+func (rm *OnePagerFactsReadModel) NumericFieldValues(ctx context.Context, subjectType, fieldID string, subjectIDs []string) (map[string]float64, error) {
+	values := make(map[string]float64)
+	if len(subjectIDs) == 0 || fieldID == "" {
+		return values, nil
+	}
+
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT subject_id, (value->>'value')::float8 FROM onepagers.one_pager_facts
+			WHERE tenant_id = $1 AND subject_type = $2 AND field_id = $3 AND subject_id = ANY($4) AND value_type = 'number'`,
+			tenantID.Value(), subjectType, fieldID, pq.Array(subjectIDs),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var subjectID string
+			var value float64
+			if err := rows.Scan(&subjectID, &value); err != nil {
+				return err
+			}
+			values[subjectID] = value
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query numeric values of field %s for subject type %s: %w", fieldID, subjectType, err)
+	}
+	return values, nil
+}
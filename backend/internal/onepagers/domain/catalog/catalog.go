@@ -21,12 +21,14 @@ var entriesBySubjectType = map[string][]Entry{
 		{ID: "parent-capability", Label: "Parent Capability", Relation: true},
 		{ID: "child-capabilities", Label: "Child Capabilities", Relation: true},
 		{ID: "depends-on", Label: "Depends On", Relation: true},
+		{ID: "decision-records", Label: "Decision Records", Relation: true},
 	},
 	"enterprise-capability": {
 		{ID: "name", Label: "Name"},
 		{ID: "description", Label: "Description"},
 		{ID: "category", Label: "Category"},
 		{ID: "included-capabilities", Label: "Included Capabilities", Relation: true},
+		{ID: "decision-records", Label: "Decision Records", Relation: true},
 	},
 	"application": {
 		{ID: "name", Label: "Name"},
@@ -37,6 +39,7 @@ var entriesBySubjectType = map[string][]Entry{
 		{ID: "purchased-from", Label: "Purchased From", Relation: true},
 		{ID: "acquired-via", Label: "Acquired Via", Relation: true},
 		{ID: "component-relations", Label: "Triggers / Serves", Relation: true},
+		{ID: "decision-records", Label: "Decision Records", Relation: true},
 	},
 	"acquired-entity": {
 		{ID: "name", Label: "Name"},
@@ -26,9 +26,9 @@ func entryIDs(entries []Entry) []string {
 
 func TestEntriesFor_MatchesFullCatalogPerSubjectType(t *testing.T) {
 	cases := map[string][]string{
-		"capability":            {"name", "description", "maturity", "experts", "realizing-applications", "business-domains", "parent-capability", "child-capabilities", "depends-on"},
-		"enterprise-capability": {"name", "description", "category", "included-capabilities"},
-		"application":           {"name", "description", "experts", "realized-capabilities", "built-by", "purchased-from", "acquired-via", "component-relations"},
+		"capability":            {"name", "description", "maturity", "experts", "realizing-applications", "business-domains", "parent-capability", "child-capabilities", "depends-on", "decision-records"},
+		"enterprise-capability": {"name", "description", "category", "included-capabilities", "decision-records"},
+		"application":           {"name", "description", "experts", "realized-capabilities", "built-by", "purchased-from", "acquired-via", "component-relations", "decision-records"},
 		"acquired-entity":       {"name", "acquisition-date", "integration-status", "acquired-applications"},
 		"vendor":                {"name", "implementation-partner", "notes", "purchased-applications"},
 		"internal-team":         {"name", "department", "contact-person", "built-applications"},
@@ -62,14 +62,19 @@ func TestEntriesFor_ExposesRelationLabelsPerSubjectType(t *testing.T) {
 			"parent-capability":      "Parent Capability",
 			"child-capabilities":     "Child Capabilities",
 			"depends-on":             "Depends On",
+			"decision-records":       "Decision Records",
+		},
+		"enterprise-capability": {
+			"included-capabilities": "Included Capabilities",
+			"decision-records":      "Decision Records",
 		},
-		"enterprise-capability": {"included-capabilities": "Included Capabilities"},
 		"application": {
 			"realized-capabilities": "Realized Capabilities",
 			"built-by":              "Built By",
 			"purchased-from":        "Purchased From",
 			"acquired-via":          "Acquired Via",
 			"component-relations":   "Triggers / Serves",
+			"decision-records":      "Decision Records",
 		},
 		"acquired-entity": {"acquired-applications": "Applications"},
 		"vendor":          {"purchased-applications": "Applications"},
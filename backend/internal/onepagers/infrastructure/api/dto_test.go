@@ -76,7 +76,7 @@ func TestBuildConfigurationDTO_MergesCatalogWithInclusionState(t *testing.T) {
 	assert.Equal(t, "application", dto.SubjectType)
 	assert.Equal(t, 4, dto.Version)
 
-	require.Len(t, dto.BuiltInFields, 8)
+	require.Len(t, dto.BuiltInFields, 9)
 	inclusion := map[string]bool{}
 	for _, field := range dto.BuiltInFields {
 		inclusion[field.ID] = field.Included
@@ -3,7 +3,9 @@ package api
 import (
 	"net/http"
 
+	amPL "easi/backend/internal/architecturemodeling/publishedlanguage"
 	authPL "easi/backend/internal/auth/publishedlanguage"
+	capPL "easi/backend/internal/capabilitymapping/publishedlanguage"
 	"easi/backend/internal/infrastructure/database"
 	"easi/backend/internal/infrastructure/eventstore"
 	"easi/backend/internal/onepagers/application/handlers"
@@ -63,6 +65,10 @@ func SetupOnePagersRoutes(deps OnePagersRoutesDeps) error {
 	for _, eventType := range projectors.SubjectDeletionEventTypes() {
 		deps.EventBus.Subscribe(eventType, deletionReactor)
 	}
+	mergedReactor := projectors.NewSubjectMergedReactor(factsReadModel, deps.CommandBus)
+	deps.EventBus.Subscribe(amPL.ApplicationComponentMergedInto, mergedReactor)
+	deps.EventBus.Subscribe(capPL.CapabilityMergedInto, mergedReactor)
+	deps.EventBus.Subscribe(capPL.CapabilitySplit, mergedReactor)
 
 	subjectIndexReadModel := readmodels.NewOnePagerSubjectIndexReadModel(deps.DB)
 	completenessCounter := queries.NewCompletenessIndicators(readModel, factsReadModel, deps.BuiltInFields)
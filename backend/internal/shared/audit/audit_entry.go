@@ -10,15 +10,16 @@ import (
 )
 
 type AuditEntry struct {
-	EventID     int64                  `json:"eventId"`
-	AggregateID string                 `json:"aggregateId"`
-	EventType   string                 `json:"eventType"`
-	DisplayName string                 `json:"displayName"`
-	EventData   map[string]interface{} `json:"eventData"`
-	OccurredAt  time.Time              `json:"occurredAt"`
-	Version     int                    `json:"version"`
-	ActorID     string                 `json:"actorId"`
-	ActorEmail  string                 `json:"actorEmail"`
+	EventID       int64                  `json:"eventId"`
+	AggregateID   string                 `json:"aggregateId"`
+	EventType     string                 `json:"eventType"`
+	DisplayName   string                 `json:"displayName"`
+	EventData     map[string]interface{} `json:"eventData"`
+	OccurredAt    time.Time              `json:"occurredAt"`
+	Version       int                    `json:"version"`
+	ActorID       string                 `json:"actorId"`
+	ActorEmail    string                 `json:"actorEmail"`
+	CorrelationID string                 `json:"correlationId,omitempty"`
 }
 
 type AuditHistoryResponse struct {
@@ -27,6 +28,12 @@ type AuditHistoryResponse struct {
 	Links      map[string]string `json:"_links"`
 }
 
+type CorrelatedChangeResponse struct {
+	CorrelationID string            `json:"correlationId"`
+	Entries       []AuditEntry      `json:"entries"`
+	Links         map[string]string `json:"_links"`
+}
+
 type PaginationInfo struct {
 	HasMore    bool   `json:"hasMore"`
 	NextCursor string `json:"nextCursor,omitempty"`
@@ -76,3 +76,43 @@ func (h *AuditHandlers) GetAuditHistory(w http.ResponseWriter, r *http.Request)
 
 	sharedAPI.RespondJSON(w, http.StatusOK, response)
 }
+
+// GetCorrelatedChange godoc
+// @Summary Get all events of a correlated change
+// @Description Retrieves every audit entry recorded under one correlation ID, such as all per-item changes made by a single bulk edit, in the order they were written. Requires audit:read permission.
+// @Tags audit
+// @Produce json
+// @Param correlationId path string true "Correlation ID returned by the originating request"
+// @Success 200 {object} CorrelatedChangeResponse
+// @Failure 400 {object} sharedAPI.ErrorResponse "Missing correlationId"
+// @Failure 401 {object} sharedAPI.ErrorResponse "Authentication required"
+// @Failure 403 {object} sharedAPI.ErrorResponse "Insufficient permissions - requires audit:read"
+// @Failure 404 {object} sharedAPI.ErrorResponse "No events recorded for the correlation ID"
+// @Failure 500 {object} sharedAPI.ErrorResponse
+// @Security ApiKeyAuth
+// @Router /audit/changes/{correlationId} [get]
+func (h *AuditHandlers) GetCorrelatedChange(w http.ResponseWriter, r *http.Request) {
+	correlationID := chi.URLParam(r, "correlationId")
+	if correlationID == "" {
+		sharedAPI.RespondError(w, http.StatusBadRequest, nil, "correlationId is required")
+		return
+	}
+
+	entries, err := h.readModel.GetChangeByCorrelationID(r.Context(), correlationID)
+	if err != nil {
+		sharedAPI.RespondError(w, http.StatusInternalServerError, err, "Failed to retrieve correlated change")
+		return
+	}
+	if len(entries) == 0 {
+		sharedAPI.RespondError(w, http.StatusNotFound, nil, "No changes recorded for correlation ID")
+		return
+	}
+
+	sharedAPI.RespondJSON(w, http.StatusOK, CorrelatedChangeResponse{
+		CorrelationID: correlationID,
+		Entries:       entries,
+		Links: map[string]string{
+			"self": h.hateoas.CorrelatedChange(correlationID),
+		},
+	})
+}
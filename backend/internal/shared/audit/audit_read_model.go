@@ -11,6 +11,8 @@ import (
 	sharedctx "easi/backend/internal/shared/context"
 )
 
+const auditEntryColumns = "id, aggregate_id, event_type, event_data, version, occurred_at, actor_id, actor_email, correlation_id"
+
 type AuditHistoryReadModel struct {
 	db *database.TenantAwareDB
 }
@@ -37,10 +39,9 @@ func (rm *AuditHistoryReadModel) GetHistoryByAggregateID(ctx context.Context, ag
 	var nextCursor string
 
 	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
-		query := `
-			SELECT id, aggregate_id, event_type, event_data, version, occurred_at, actor_id, actor_email
+		query := `SELECT ` + auditEntryColumns + `
 			FROM infrastructure.events
-			WHERE tenant_id = $1 AND (aggregate_id = $2 OR event_data->>'componentId' = $2)
+			WHERE tenant_id = $1 AND (aggregate_id = $2 OR event_data->>'componentId' = $2 OR event_data->'subjectIds' ? $2)
 		`
 		args := []any{tenantID.Value(), aggregateID}
 
@@ -61,33 +62,8 @@ func (rm *AuditHistoryReadModel) GetHistoryByAggregateID(ctx context.Context, ag
 		}
 		defer func() { _ = rows.Close() }()
 
-		for rows.Next() {
-			var entry AuditEntry
-			var eventDataJSON string
-
-			if err := rows.Scan(
-				&entry.EventID,
-				&entry.AggregateID,
-				&entry.EventType,
-				&eventDataJSON,
-				&entry.Version,
-				&entry.OccurredAt,
-				&entry.ActorID,
-				&entry.ActorEmail,
-			); err != nil {
-				return fmt.Errorf("failed to scan event: %w", err)
-			}
-
-			if err := json.Unmarshal([]byte(eventDataJSON), &entry.EventData); err != nil {
-				entry.EventData = map[string]any{"raw": eventDataJSON}
-			}
-
-			entry.DisplayName = FormatEventTypeDisplayName(entry.EventType)
-
-			entries = append(entries, entry)
-		}
-
-		return rows.Err()
+		entries, err = scanAuditEntries(rows)
+		return err
 	})
 
 	if err != nil {
@@ -102,3 +78,66 @@ func (rm *AuditHistoryReadModel) GetHistoryByAggregateID(ctx context.Context, ag
 
 	return entries, hasMore, nextCursor, nil
 }
+
+// GetChangeByCorrelationID returns every event persisted under one correlated change,
+// such as a bulk edit, in the order it was written.
+func (rm *AuditHistoryReadModel) GetChangeByCorrelationID(ctx context.Context, correlationID string) ([]AuditEntry, error) {
+	tenantID, err := sharedctx.GetTenant(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant from context: %w", err)
+	}
+
+	var entries []AuditEntry
+	err = rm.db.WithReadOnlyTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `SELECT `+auditEntryColumns+`
+			FROM infrastructure.events
+			WHERE tenant_id = $1 AND correlation_id = $2
+			ORDER BY id ASC`,
+			tenantID.Value(), correlationID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to query events: %w", err)
+		}
+		defer func() { _ = rows.Close() }()
+
+		entries, err = scanAuditEntries(rows)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func scanAuditEntries(rows *sql.Rows) ([]AuditEntry, error) {
+	var entries []AuditEntry
+	for rows.Next() {
+		var entry AuditEntry
+		var eventDataJSON string
+		var correlationID sql.NullString
+
+		if err := rows.Scan(
+			&entry.EventID,
+			&entry.AggregateID,
+			&entry.EventType,
+			&eventDataJSON,
+			&entry.Version,
+			&entry.OccurredAt,
+			&entry.ActorID,
+			&entry.ActorEmail,
+			&correlationID,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+
+		if err := json.Unmarshal([]byte(eventDataJSON), &entry.EventData); err != nil {
+			entry.EventData = map[string]any{"raw": eventDataJSON}
+		}
+
+		entry.DisplayName = FormatEventTypeDisplayName(entry.EventType)
+		entry.CorrelationID = correlationID.String
+
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
@@ -72,13 +72,14 @@ func (tc *testContext) uniqueID(suffix string) string {
 }
 
 type eventRow struct {
-	aggregateID string
-	eventType   string
-	data        map[string]any
-	version     int
-	occurredAt  time.Time
-	actorID     string
-	actorEmail  string
+	aggregateID   string
+	eventType     string
+	data          map[string]any
+	version       int
+	occurredAt    time.Time
+	actorID       string
+	actorEmail    string
+	correlationID sql.NullString
 }
 
 type eventInserter struct {
@@ -95,9 +96,9 @@ func (ei eventInserter) insert(row eventRow) {
 	require.NoError(ei.t, err)
 
 	_, err = ei.tx.ExecContext(ei.ctx,
-		`INSERT INTO infrastructure.events (tenant_id, aggregate_id, event_type, event_data, version, occurred_at, actor_id, actor_email)
-		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
-		ei.tenantID.Value(), row.aggregateID, row.eventType, dataJSON, row.version, row.occurredAt, row.actorID, row.actorEmail,
+		`INSERT INTO infrastructure.events (tenant_id, aggregate_id, event_type, event_data, version, occurred_at, actor_id, actor_email, correlation_id)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		ei.tenantID.Value(), row.aggregateID, row.eventType, dataJSON, row.version, row.occurredAt, row.actorID, row.actorEmail, row.correlationID,
 	)
 	require.NoError(ei.t, err)
 }
@@ -353,3 +354,90 @@ func TestAuditHistory_DoesNotIncludeUnrelatedFitScoreEvents(t *testing.T) {
 	assert.Len(t, entriesB, 2, "Component B should have 2 events: created + its fit score")
 	assertFitScoreComponent(t, entriesB, componentB, "Should only include fit score for component B")
 }
+
+func TestAuditHistory_IncludesEventsNamingTheAggregateAsSubject(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	tenantID, err := sharedvo.NewTenantID("test-tenant")
+	require.NoError(t, err)
+
+	tenantCtx := sharedctx.WithTenant(context.Background(), tenantID)
+
+	capabilityID := ctx.uniqueID("capability")
+	otherCapabilityID := ctx.uniqueID("capability-other")
+	recordID := ctx.uniqueID("decision-record")
+
+	tx, err := ctx.tenantDB.BeginTxWithTenant(tenantCtx, nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	inserter := eventInserter{t: t, tx: tx, ctx: tenantCtx, tenantID: tenantID}
+
+	inserter.insert(eventRow{
+		aggregateID: recordID,
+		eventType:   "DecisionRecordSubjectLinked",
+		data:        map[string]any{"id": recordID, "subjectId": capabilityID, "subjectIds": []string{capabilityID}},
+		version:     1,
+		occurredAt:  now,
+		actorID:     "user-1",
+		actorEmail:  "architect@test.com",
+	})
+
+	inserter.insert(eventRow{
+		aggregateID: recordID,
+		eventType:   "DecisionRecordAccepted",
+		data:        map[string]any{"id": recordID, "subjectIds": []string{otherCapabilityID, capabilityID}},
+		version:     2,
+		occurredAt:  now,
+		actorID:     "user-1",
+		actorEmail:  "architect@test.com",
+	})
+
+	require.NoError(t, tx.Commit())
+
+	readModel := NewAuditHistoryReadModel(ctx.tenantDB)
+
+	entries, _, _, err := readModel.GetHistoryByAggregateID(tenantCtx, capabilityID, 50, "")
+	require.NoError(t, err)
+	require.Len(t, entries, 2, "both decision record events name the capability as a subject")
+	assert.Equal(t, "DecisionRecordAccepted", entries[0].EventType)
+	assert.Equal(t, recordID, entries[0].AggregateID)
+
+	otherEntries, _, _, err := readModel.GetHistoryByAggregateID(tenantCtx, otherCapabilityID, 50, "")
+	require.NoError(t, err)
+	assert.Len(t, otherEntries, 1, "only the event listing the other capability applies to it")
+}
+
+func TestAuditHistory_GetChangeByCorrelationID(t *testing.T) {
+	seed, cleanup := newSeedContext(t)
+	defer cleanup()
+
+	correlationID := seed.tc.uniqueID("bulk")
+	correlated := sql.NullString{String: correlationID, Valid: true}
+	firstCapability := seed.tc.uniqueID("capability")
+	secondCapability := seed.tc.uniqueID("capability")
+	now := time.Now().UTC()
+
+	seed.withTransaction(func(ei eventInserter) {
+		ei.insert(eventRow{aggregateID: firstCapability, eventType: "CapabilityTagAdded", data: map[string]any{"tag": "core"}, version: 1, occurredAt: now, actorID: "user-1", actorEmail: "ea@example.com", correlationID: correlated})
+		ei.insert(eventRow{aggregateID: secondCapability, eventType: "CapabilityTagAdded", data: map[string]any{"tag": "core"}, version: 1, occurredAt: now, actorID: "user-1", actorEmail: "ea@example.com", correlationID: correlated})
+		ei.insert(eventRow{aggregateID: secondCapability, eventType: "CapabilityUpdated", data: map[string]any{}, version: 2, occurredAt: now, actorID: "user-1", actorEmail: "ea@example.com"})
+	})
+
+	readModel := NewAuditHistoryReadModel(seed.tc.tenantDB)
+
+	entries, err := readModel.GetChangeByCorrelationID(seed.ctx, correlationID)
+	require.NoError(t, err)
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, firstCapability, entries[0].AggregateID)
+	assert.Equal(t, secondCapability, entries[1].AggregateID)
+	for _, entry := range entries {
+		assert.Equal(t, correlationID, entry.CorrelationID)
+	}
+}
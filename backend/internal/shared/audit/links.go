@@ -15,3 +15,7 @@ func NewAuditLinks(h *sharedAPI.HATEOASLinks) *AuditLinks {
 func (h *AuditLinks) AuditHistory(id string) string {
 	return h.Base() + "/audit/" + id
 }
+
+func (h *AuditLinks) CorrelatedChange(correlationID string) string {
+	return h.Base() + "/audit/changes/" + correlationID
+}
@@ -32,6 +32,7 @@ func SetupAuditRoutes(deps AuditRoutesDeps) error {
 
 	deps.Router.Route("/audit", func(r chi.Router) {
 		r.Use(deps.AuthMiddleware.RequirePermission(authPL.PermAuditRead))
+		r.Get("/changes/{correlationId}", handlers.GetCorrelatedChange)
 		r.Get("/{aggregateId}", handlers.GetAuditHistory)
 	})
 
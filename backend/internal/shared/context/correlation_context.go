@@ -0,0 +1,17 @@
+package context
+
+import (
+	"context"
+)
+
+// CorrelationIDContextKey groups every event persisted under one logical change.
+const CorrelationIDContextKey contextKey = "correlation_id"
+
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, CorrelationIDContextKey, correlationID)
+}
+
+func GetCorrelationID(ctx context.Context) (string, bool) {
+	correlationID, ok := ctx.Value(CorrelationIDContextKey).(string)
+	return correlationID, ok && correlationID != ""
+}
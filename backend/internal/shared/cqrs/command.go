@@ -12,6 +12,8 @@ type Command interface {
 type CommandResult struct {
 	// CreatedID is the ID of the newly created aggregate (for create commands)
 	CreatedID string
+	// CreatedIDs lists every aggregate created when a command creates several; CreatedID is the first
+	CreatedIDs []string
 }
 
 // EmptyResult returns an empty command result (for commands that don't create new aggregates)
@@ -24,6 +26,14 @@ func NewResult(createdID string) CommandResult {
 	return CommandResult{CreatedID: createdID}
 }
 
+// NewMultiResult creates a result for a command that created several aggregates
+func NewMultiResult(createdIDs []string) CommandResult {
+	if len(createdIDs) == 0 {
+		return EmptyResult()
+	}
+	return CommandResult{CreatedID: createdIDs[0], CreatedIDs: createdIDs}
+}
+
 // CommandHandler handles a specific command type
 type CommandHandler interface {
 	// Handle processes the command and returns a result and error
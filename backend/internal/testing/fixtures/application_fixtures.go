@@ -37,8 +37,8 @@ func NewApplicationFixtures(tc *TestContext) *ApplicationFixtures {
 
 	createComponentHandler := handlers.NewCreateApplicationComponentHandler(componentRepo)
 	updateComponentHandler := handlers.NewUpdateApplicationComponentHandler(componentRepo)
-	deleteComponentHandler := handlers.NewDeleteApplicationComponentHandler(componentRepo, relationReadModel, tc.CommandBus)
-	createRelationHandler := handlers.NewCreateComponentRelationHandler(relationRepo)
+	deleteComponentHandler := handlers.NewDeleteApplicationComponentHandler(componentRepo, relationReadModel, componentReadModel, tc.CommandBus)
+	createRelationHandler := handlers.NewCreateComponentRelationHandler(relationRepo, readmodels.NewCustomRelationTypeCacheReadModel(tc.TenantDB))
 	updateRelationHandler := handlers.NewUpdateComponentRelationHandler(relationRepo)
 	deleteRelationHandler := handlers.NewDeleteComponentRelationHandler(relationRepo)
 
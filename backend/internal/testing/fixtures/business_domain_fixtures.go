@@ -42,7 +42,8 @@ func NewBusinessDomainFixtures(tc *TestContext) *BusinessDomainFixtures {
 	tc.EventBus.Subscribe("CapabilityUnassignedFromDomain", assignmentProjector)
 
 	assignmentChecker := adapters.NewBusinessDomainAssignmentCheckerAdapter(assignmentReadModel)
-	deletionService := services.NewBusinessDomainDeletionService(assignmentChecker)
+	childrenChecker := adapters.NewBusinessDomainChildrenCheckerAdapter(domainReadModel)
+	deletionService := services.NewBusinessDomainDeletionService(assignmentChecker, childrenChecker)
 
 	tc.CommandBus.Register("CreateBusinessDomain", handlers.NewCreateBusinessDomainHandler(domainRepo, domainReadModel))
 	tc.CommandBus.Register("UpdateBusinessDomain", handlers.NewUpdateBusinessDomainHandler(domainRepo, domainReadModel))
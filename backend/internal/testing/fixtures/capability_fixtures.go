@@ -9,6 +9,7 @@ import (
 	"easi/backend/internal/capabilitymapping/application/readmodels"
 	"easi/backend/internal/capabilitymapping/domain/services"
 	"easi/backend/internal/capabilitymapping/infrastructure/adapters"
+	"easi/backend/internal/capabilitymapping/infrastructure/metamodel"
 	"easi/backend/internal/capabilitymapping/infrastructure/repositories"
 
 	"github.com/stretchr/testify/require"
@@ -34,13 +35,14 @@ func NewCapabilityFixtures(tc *TestContext) *CapabilityFixtures {
 	tc.EventBus.Subscribe("CapabilityMetadataUpdated", projector)
 	tc.EventBus.Subscribe("CapabilityExpertAdded", projector)
 	tc.EventBus.Subscribe("CapabilityTagAdded", projector)
+	tc.EventBus.Subscribe("CapabilityTagRemoved", projector)
+	tc.EventBus.Subscribe("CapabilityTagRenamed", projector)
 	tc.EventBus.Subscribe("CapabilityDeleted", projector)
 
-	tc.CommandBus.Register("CreateCapability", handlers.NewCreateCapabilityHandler(capabilityRepo))
+	tc.CommandBus.Register("CreateCapability", handlers.NewCreateCapabilityHandler(capabilityRepo, metamodel.NewLocalCapabilityHierarchyGateway(readmodels.NewCapabilityHierarchyCacheReadModel(tc.TenantDB))))
 	tc.CommandBus.Register("UpdateCapability", handlers.NewUpdateCapabilityHandler(capabilityRepo))
 	tc.CommandBus.Register("UpdateCapabilityMetadata", handlers.NewUpdateCapabilityMetadataHandler(capabilityRepo))
-	tc.CommandBus.Register("AddCapabilityExpert", handlers.NewAddCapabilityExpertHandler(capabilityRepo))
-	tc.CommandBus.Register("AddCapabilityTag", handlers.NewAddCapabilityTagHandler(capabilityRepo))
+	tc.CommandBus.Register("AddCapabilityTag", handlers.NewAddCapabilityTagHandler(capabilityRepo, metamodel.NewLocalCapabilityTagVocabularyGateway(readmodels.NewCapabilityTagVocabularyCacheReadModel(tc.TenantDB))))
 	realizationReadModel := readmodels.NewRealizationReadModel(tc.TenantDB)
 	tc.CommandBus.Register("DeleteCapability", handlers.NewDeleteCapabilityHandler(capabilityRepo, deletionService, realizationReadModel, capabilityReadModel))
 
@@ -0,0 +1,12 @@
+package commands
+
+// ReplaceReorganisedCapability maps every stage that references a merged or split
+// capability to its replacements instead.
+type ReplaceReorganisedCapability struct {
+	CapabilityID   string
+	ReplacementIDs []string
+}
+
+func (c ReplaceReorganisedCapability) CommandName() string {
+	return "ReplaceReorganisedCapability"
+}
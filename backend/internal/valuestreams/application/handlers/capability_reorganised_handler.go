@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+
+	cmPL "easi/backend/internal/capabilitymapping/publishedlanguage"
+	"easi/backend/internal/shared/cqrs"
+	domain "easi/backend/internal/shared/eventsourcing"
+	"easi/backend/internal/valuestreams/application/commands"
+)
+
+// CapabilityReorganisedHandler keeps stages mapped when capabilities are merged or
+// split: a merged capability is replaced by its survivor, a split one by all of its
+// parts. Both events arrive before the capability is deleted.
+type CapabilityReorganisedHandler struct {
+	commandBus cqrs.CommandBus
+}
+
+func NewCapabilityReorganisedHandler(commandBus cqrs.CommandBus) *CapabilityReorganisedHandler {
+	return &CapabilityReorganisedHandler{commandBus: commandBus}
+}
+
+type capabilityReorganisedEvent struct {
+	ID         string `json:"id"`
+	SurvivorID string `json:"survivorId"`
+	Parts      []struct {
+		ID string `json:"id"`
+	} `json:"parts"`
+}
+
+func (h *CapabilityReorganisedHandler) Handle(ctx context.Context, event domain.DomainEvent) error {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return err
+	}
+
+	var data capabilityReorganisedEvent
+	if err := json.Unmarshal(eventData, &data); err != nil {
+		return err
+	}
+
+	var replacementIDs []string
+	switch event.EventType() {
+	case cmPL.CapabilityMergedInto:
+		replacementIDs = []string{data.SurvivorID}
+	case cmPL.CapabilitySplit:
+		for _, part := range data.Parts {
+			replacementIDs = append(replacementIDs, part.ID)
+		}
+	}
+	if data.ID == "" || len(replacementIDs) == 0 {
+		return nil
+	}
+
+	_, err = h.commandBus.Dispatch(ctx, &commands.ReplaceReorganisedCapability{
+		CapabilityID:   data.ID,
+		ReplacementIDs: replacementIDs,
+	})
+	return err
+}
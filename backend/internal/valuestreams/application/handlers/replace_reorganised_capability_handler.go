@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"easi/backend/internal/shared/cqrs"
+	"easi/backend/internal/valuestreams/application/commands"
+	"easi/backend/internal/valuestreams/application/gateways"
+	"easi/backend/internal/valuestreams/domain/aggregates"
+	"easi/backend/internal/valuestreams/domain/valueobjects"
+)
+
+type ReplaceReorganisedCapabilityHandler struct {
+	repository        RemoveDeletedCapabilityRepository
+	readModel         RemoveDeletedCapabilityReadModel
+	capabilityGateway gateways.CapabilityGateway
+}
+
+func NewReplaceReorganisedCapabilityHandler(
+	repository RemoveDeletedCapabilityRepository,
+	readModel RemoveDeletedCapabilityReadModel,
+	capabilityGateway gateways.CapabilityGateway,
+) *ReplaceReorganisedCapabilityHandler {
+	return &ReplaceReorganisedCapabilityHandler{
+		repository:        repository,
+		readModel:         readModel,
+		capabilityGateway: capabilityGateway,
+	}
+}
+
+func (h *ReplaceReorganisedCapabilityHandler) Handle(ctx context.Context, cmd cqrs.Command) (cqrs.CommandResult, error) {
+	command, ok := cmd.(*commands.ReplaceReorganisedCapability)
+	if !ok {
+		return cqrs.EmptyResult(), cqrs.ErrInvalidCommand
+	}
+
+	mappings, err := h.readModel.GetStagesByCapabilityID(ctx, command.CapabilityID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+	if len(mappings) == 0 {
+		return cqrs.EmptyResult(), nil
+	}
+
+	oldRef, err := valueobjects.NewCapabilityRef(command.CapabilityID)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	replacements, err := h.resolveReplacements(ctx, command.ReplacementIDs)
+	if err != nil {
+		return cqrs.EmptyResult(), err
+	}
+
+	for _, mapping := range mappings {
+		if err := h.replaceInValueStream(ctx, mapping.ValueStreamID, mapping.StageID, oldRef, replacements); err != nil {
+			log.Printf("Failed to replace capability %s in value stream %s stage %s: %v",
+				command.CapabilityID, mapping.ValueStreamID, mapping.StageID, err)
+			return cqrs.EmptyResult(), err
+		}
+	}
+
+	return cqrs.EmptyResult(), nil
+}
+
+func (h *ReplaceReorganisedCapabilityHandler) resolveReplacements(ctx context.Context, ids []string) ([]resolvedCapability, error) {
+	replacements := make([]resolvedCapability, 0, len(ids))
+	for _, id := range ids {
+		info, err := h.capabilityGateway.GetCapability(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if info == nil {
+			log.Printf("Replacement capability %s not found in cache, skipping", id)
+			continue
+		}
+		ref, err := valueobjects.NewCapabilityRef(id)
+		if err != nil {
+			return nil, err
+		}
+		replacements = append(replacements, resolvedCapability{Ref: ref, Name: info.Name})
+	}
+	return replacements, nil
+}
+
+func (h *ReplaceReorganisedCapabilityHandler) replaceInValueStream(
+	ctx context.Context,
+	valueStreamID, stageID string,
+	oldRef valueobjects.CapabilityRef,
+	replacements []resolvedCapability,
+) error {
+	vs, err := h.repository.GetByID(ctx, valueStreamID)
+	if err != nil {
+		return mapRepositoryError(err)
+	}
+
+	sid, err := valueobjects.NewStageIDFromString(stageID)
+	if err != nil {
+		return err
+	}
+
+	for _, replacement := range replacements {
+		err := vs.AddCapabilityToStage(sid, replacement.Ref, replacement.Name)
+		if err != nil && !errors.Is(err, aggregates.ErrCapabilityAlreadyMapped) {
+			return mapStageError(err)
+		}
+	}
+
+	if err := vs.RemoveCapabilityFromStage(sid, oldRef); err != nil {
+		return mapStageError(err)
+	}
+
+	return h.repository.Save(ctx, vs)
+}
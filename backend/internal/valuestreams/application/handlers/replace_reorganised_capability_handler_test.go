@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"easi/backend/internal/valuestreams/application/commands"
+	"easi/backend/internal/valuestreams/application/gateways"
+	"easi/backend/internal/valuestreams/application/readmodels"
+	"easi/backend/internal/valuestreams/domain/valueobjects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStageMappingReadModel struct {
+	mappings []readmodels.StageCapabilityMapping
+}
+
+func (m *mockStageMappingReadModel) GetStagesByCapabilityID(ctx context.Context, capabilityID string) ([]readmodels.StageCapabilityMapping, error) {
+	return m.mappings, nil
+}
+
+const (
+	mergedCapabilityID   = "00000000-0000-0000-0000-000000000123"
+	survivorCapabilityID = "00000000-0000-0000-0000-000000000456"
+)
+
+func TestReplaceReorganisedCapabilityHandler_ReplacesStageMapping(t *testing.T) {
+	vs := newTestValueStream(t)
+	name, _ := valueobjects.NewStageName("Discovery")
+	stageID, _ := vs.AddStage(name, valueobjects.MustNewDescription(""), nil)
+	mergedRef, _ := valueobjects.NewCapabilityRef(mergedCapabilityID)
+	require.NoError(t, vs.AddCapabilityToStage(stageID, mergedRef, "Merged"))
+	vs.MarkChangesAsCommitted()
+
+	repo := &mockStageRepository{stream: vs}
+	rm := &mockStageMappingReadModel{mappings: []readmodels.StageCapabilityMapping{{ValueStreamID: vs.ID(), StageID: stageID.Value()}}}
+	gateway := &mockCapabilityGateway{info: &gateways.CapabilityInfo{ID: survivorCapabilityID, Name: "Survivor"}}
+	handler := NewReplaceReorganisedCapabilityHandler(repo, rm, gateway)
+
+	_, err := handler.Handle(context.Background(), &commands.ReplaceReorganisedCapability{
+		CapabilityID:   mergedCapabilityID,
+		ReplacementIDs: []string{survivorCapabilityID},
+	})
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+
+	survivorRef, _ := valueobjects.NewCapabilityRef(survivorCapabilityID)
+	assert.ErrorContains(t, vs.AddCapabilityToStage(stageID, survivorRef, "Survivor"), "already mapped")
+	assert.NoError(t, vs.AddCapabilityToStage(stageID, mergedRef, "Merged"))
+}
+
+func TestReplaceReorganisedCapabilityHandler_NoMappings(t *testing.T) {
+	repo := &mockStageRepository{stream: newTestValueStream(t)}
+	handler := NewReplaceReorganisedCapabilityHandler(repo, &mockStageMappingReadModel{}, &mockCapabilityGateway{})
+
+	_, err := handler.Handle(context.Background(), &commands.ReplaceReorganisedCapability{
+		CapabilityID:   mergedCapabilityID,
+		ReplacementIDs: []string{survivorCapabilityID},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, repo.saved)
+}
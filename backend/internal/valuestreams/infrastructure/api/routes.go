@@ -70,9 +70,14 @@ func SetupValueStreamsRoutes(config *RouteConfig) error {
 	config.CommandBus.Register("AddStageCapability", handlers.NewAddStageCapabilityHandler(repo, capGateway))
 	config.CommandBus.Register("RemoveStageCapability", handlers.NewRemoveStageCapabilityHandler(repo))
 	config.CommandBus.Register("RemoveDeletedCapability", handlers.NewRemoveDeletedCapabilityHandler(repo, rm))
+	config.CommandBus.Register("ReplaceReorganisedCapability", handlers.NewReplaceReorganisedCapabilityHandler(repo, rm, capGateway))
 
 	config.EventBus.Subscribe(cmPL.CapabilityDeleted, handlers.NewCapabilityDeletedHandler(config.CommandBus))
 
+	reorganisedHandler := handlers.NewCapabilityReorganisedHandler(config.CommandBus)
+	config.EventBus.Subscribe(cmPL.CapabilityMergedInto, reorganisedHandler)
+	config.EventBus.Subscribe(cmPL.CapabilitySplit, reorganisedHandler)
+
 	links := NewValueStreamsLinks(config.HATEOAS)
 	httpHandlers := NewValueStreamHandlers(config.CommandBus, rm, links)
 	stageHttpHandlers := NewStageHandlers(config.CommandBus, rm, links)
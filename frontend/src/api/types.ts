@@ -109,17 +109,63 @@ export interface Component {
   id: ComponentId;
   name: string;
   description?: string;
+  parentId?: ComponentId;
   experts?: Expert[];
+  tags?: string[];
+  classifications?: ComponentClassification[];
   createdAt: string;
   onePagerComplete?: boolean;
   _links: HATEOASLinks;
 }
 
+export interface ComponentClassification {
+  dimensionId: string;
+  dimensionName: string;
+  value: string;
+}
+
+export interface ClassificationDimension {
+  id: string;
+  name: string;
+  values: string[];
+  active: boolean;
+}
+
+export type BuiltInRelationType =
+  | 'Triggers'
+  | 'Serves'
+  | 'Flow'
+  | 'AccessRead'
+  | 'AccessWrite'
+  | 'AccessReadWrite'
+  | 'Composition'
+  | 'Aggregation';
+
+export type CustomRelationTypeRef = `custom:${string}`;
+
+export type RelationTypeValue = BuiltInRelationType | CustomRelationTypeRef;
+
+export interface RelationEdgeStyle {
+  lineStyle: 'solid' | 'dashed' | 'dotted';
+  color?: string;
+}
+
+export interface RelationTypeOption {
+  value: RelationTypeValue;
+  name: string;
+  description?: string;
+  builtIn: boolean;
+  style?: RelationEdgeStyle;
+  _links?: HATEOASLinks;
+}
+
 export interface Relation {
   id: RelationId;
   sourceComponentId: ComponentId;
   targetComponentId: ComponentId;
-  relationType: 'Triggers' | 'Serves';
+  relationType: RelationTypeValue;
+  relationTypeName?: string;
+  relationTypeStyle?: RelationEdgeStyle;
   name?: string;
   description?: string;
   createdAt: string;
@@ -192,7 +238,7 @@ export interface AddComponentExpertRequest {
 export interface CreateRelationRequest {
   sourceComponentId: ComponentId;
   targetComponentId: ComponentId;
-  relationType: 'Triggers' | 'Serves';
+  relationType: RelationTypeValue;
   name?: string;
   description?: string;
 }
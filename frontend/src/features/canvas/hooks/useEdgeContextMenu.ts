@@ -17,6 +17,7 @@ import { useCapabilities, useRealizations } from '../../capabilities/hooks/useCa
 import { useComponents } from '../../components/hooks/useComponents';
 import { useOriginRelationshipsQuery } from '../../origin-entities/hooks/useOriginRelationships';
 import { useRelations } from '../../relations/hooks/useRelations';
+import { relationTypeLabel } from '../../relations/relationTypeLabels';
 
 export interface EdgeContextMenu {
   x: number;
@@ -89,7 +90,7 @@ function resolveRelationEdge(edge: Edge, relations: Relation[], position: MenuPo
   return {
     ...position,
     edgeId: edge.id,
-    edgeName: relation.name || relation.relationType,
+    edgeName: relation.name || relationTypeLabel(relation),
     edgeType: 'relation',
     _links: relation._links,
   };
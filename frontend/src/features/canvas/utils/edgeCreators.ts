@@ -1,11 +1,13 @@
 import type { Edge, Node } from '@xyflow/react';
 import { MarkerType } from '@xyflow/react';
 import type {
+  BuiltInRelationType,
   Capability,
   CapabilityRealization,
   OriginRelationship,
   OriginRelationshipType,
   Relation,
+  RelationEdgeStyle,
   ViewCapability,
   ViewComponent,
 } from '../../../api/types';
@@ -15,6 +17,7 @@ import {
   RELATIONSHIP_TO_ENTITY_TYPE,
 } from '../../../constants/entityIdentifiers';
 import { resolveToken } from '../../../theme/resolveToken';
+import { relationTypeLabel } from '../../relations/relationTypeLabels';
 import { getBestHandles } from './handleCalculation';
 
 const CLASSIC_EDGE_COLOR = '#000000';
@@ -69,17 +72,50 @@ function buildEdgeVisuals({
   };
 }
 
+const LINE_STYLE_DASHARRAY: Record<RelationEdgeStyle['lineStyle'], string | undefined> = {
+  solid: undefined,
+  dashed: '6,4',
+  dotted: '2,4',
+};
+
+interface RelationTypeVisual {
+  colorToken: [string, string];
+  lineStyle: RelationEdgeStyle['lineStyle'];
+}
+
+const BUILT_IN_RELATION_VISUALS: Record<BuiltInRelationType, RelationTypeVisual> = {
+  Triggers: { colorToken: ['--color-triggers', '#C25E0A'], lineStyle: 'solid' },
+  Serves: { colorToken: ['--color-serves', '#4768A8'], lineStyle: 'solid' },
+  Flow: { colorToken: ['--color-flow', '#2B7A78'], lineStyle: 'dashed' },
+  AccessRead: { colorToken: ['--color-access', '#7A4FA8'], lineStyle: 'dotted' },
+  AccessWrite: { colorToken: ['--color-access', '#7A4FA8'], lineStyle: 'dotted' },
+  AccessReadWrite: { colorToken: ['--color-access', '#7A4FA8'], lineStyle: 'dotted' },
+  Composition: { colorToken: ['--color-gray-700', '#3D4A54'], lineStyle: 'solid' },
+  Aggregation: { colorToken: ['--color-gray-700', '#3D4A54'], lineStyle: 'solid' },
+};
+
+const CUSTOM_RELATION_COLOR_TOKEN: [string, string] = ['--color-gray-700', '#3D4A54'];
+
+function resolveRelationVisual(relation: Relation, isClassicScheme: boolean) {
+  const builtIn = BUILT_IN_RELATION_VISUALS[relation.relationType as BuiltInRelationType];
+  const lineStyle = builtIn?.lineStyle ?? relation.relationTypeStyle?.lineStyle ?? 'solid';
+  const strokeDasharray = LINE_STYLE_DASHARRAY[lineStyle];
+  const extraStyle = strokeDasharray ? { strokeDasharray } : undefined;
+
+  if (isClassicScheme) {
+    return { color: CLASSIC_EDGE_COLOR, extraStyle };
+  }
+  if (builtIn) {
+    return { color: resolveToken(...builtIn.colorToken), extraStyle };
+  }
+  return { color: relation.relationTypeStyle?.color || resolveToken(...CUSTOM_RELATION_COLOR_TOKEN), extraStyle };
+}
+
 export function createRelationEdges(relations: Relation[], ctx: EdgeCreationContext): Edge[] {
   return relations.map((relation) => {
     const isSelected = ctx.selectedEdgeId === relation.id;
-    const isTriggers = relation.relationType === 'Triggers';
     const { sourceHandle, targetHandle } = resolveHandles(ctx, relation.sourceComponentId, relation.targetComponentId);
-
-    const edgeColor = ctx.isClassicScheme
-      ? CLASSIC_EDGE_COLOR
-      : isTriggers
-        ? resolveToken('--color-triggers', '#C25E0A')
-        : resolveToken('--color-serves', '#4768A8');
+    const { color, extraStyle } = resolveRelationVisual(relation, ctx.isClassicScheme);
 
     return {
       id: relation.id,
@@ -87,10 +123,10 @@ export function createRelationEdges(relations: Relation[], ctx: EdgeCreationCont
       target: relation.targetComponentId,
       sourceHandle,
       targetHandle,
-      label: relation.name || relation.relationType,
+      label: relation.name || relationTypeLabel(relation),
       type: ctx.edgeType,
       animated: isSelected,
-      ...buildEdgeVisuals({ color: edgeColor, isSelected }),
+      ...buildEdgeVisuals({ color, isSelected, extraStyle }),
     };
   });
 }
@@ -1,6 +1,12 @@
 import { httpClient } from '../../../api/core/httpClient';
 import { fetchAllPaginated } from '../../../api/core/pagination';
-import type { CreateRelationRequest, Relation, RelationId } from '../../../api/types';
+import type {
+  CollectionResponse,
+  CreateRelationRequest,
+  Relation,
+  RelationId,
+  RelationTypeOption,
+} from '../../../api/types';
 import { followLink } from '../../../utils/hateoas';
 
 export const relationsApi = {
@@ -8,6 +14,11 @@ export const relationsApi = {
     return fetchAllPaginated<Relation>('/api/v1/relations');
   },
 
+  async getRelationTypes(): Promise<RelationTypeOption[]> {
+    const response = await httpClient.get<CollectionResponse<RelationTypeOption>>('/api/v1/relation-types');
+    return response.data.data;
+  },
+
   async getById(id: RelationId): Promise<Relation> {
     const response = await httpClient.get<Relation>(`/api/v1/relations/${id}`);
     return response.data;
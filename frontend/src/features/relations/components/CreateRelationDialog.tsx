@@ -5,7 +5,8 @@ import { type Control, Controller, type FieldErrors, type UseFormRegister, useFo
 import { toComponentId } from '../../../api/types';
 import { type CreateRelationFormData, createRelationSchema } from '../../../lib/schemas';
 import { useComponents } from '../../components/hooks/useComponents';
-import { useCreateRelation } from '../hooks/useRelations';
+import { useCreateRelation, useRelationTypes } from '../hooks/useRelations';
+import { BUILT_IN_RELATION_TYPE_LABELS } from '../relationTypeLabels';
 
 interface CreateRelationDialogProps {
   isOpen: boolean;
@@ -14,10 +15,16 @@ interface CreateRelationDialogProps {
   targetComponentId?: string;
 }
 
-const RELATION_TYPE_OPTIONS = [
-  { value: 'Triggers', label: 'Triggers' },
-  { value: 'Serves', label: 'Serves' },
-];
+const BUILT_IN_RELATION_TYPE_OPTIONS = Object.entries(BUILT_IN_RELATION_TYPE_LABELS).map(([value, label]) => ({
+  value,
+  label,
+}));
+
+function useRelationTypeOptions(): { value: string; label: string }[] {
+  const { data: relationTypes } = useRelationTypes();
+  if (!relationTypes?.length) return BUILT_IN_RELATION_TYPE_OPTIONS;
+  return relationTypes.map((rt) => ({ value: rt.value, label: rt.name }));
+}
 
 function getDefaultValues(initialSource?: string, initialTarget?: string): CreateRelationFormData {
   return {
@@ -103,6 +110,8 @@ interface RelationDetailFieldsProps {
 }
 
 function RelationDetailFields({ control, register, errors, isPending }: RelationDetailFieldsProps) {
+  const relationTypeOptions = useRelationTypeOptions();
+
   return (
     <>
       <Controller
@@ -111,7 +120,7 @@ function RelationDetailFields({ control, register, errors, isPending }: Relation
         render={({ field }) => (
           <Select
             label="Relation Type"
-            data={RELATION_TYPE_OPTIONS}
+            data={relationTypeOptions}
             required
             withAsterisk
             disabled={isPending}
@@ -1,12 +1,13 @@
 import { Anchor, Badge, Box, Button, Group, Stack, Text, Title } from '@mantine/core';
 import { IconExternalLink } from '@tabler/icons-react';
 import React from 'react';
-import type { Component, Relation } from '../../../api/types';
+import type { BuiltInRelationType, Component, Relation } from '../../../api/types';
 import { DetailField } from '../../../components/shared/DetailField';
 import { useAppStore } from '../../../store/appStore';
 import { AuditHistorySection } from '../../audit';
 import { useComponents } from '../../components/hooks/useComponents';
 import { useRelations } from '../hooks/useRelations';
+import { relationTypeLabel } from '../relationTypeLabels';
 
 interface RelationDetailsProps {
   onEdit: () => void;
@@ -20,9 +21,15 @@ interface RelationData {
   formattedDate: string;
 }
 
-const RELATION_TYPE_COLOR: Record<Relation['relationType'], string> = {
+const RELATION_TYPE_COLOR: Partial<Record<BuiltInRelationType, string>> = {
   Triggers: 'orange',
   Serves: 'blue',
+  Flow: 'teal',
+  AccessRead: 'grape',
+  AccessWrite: 'grape',
+  AccessReadWrite: 'grape',
+  Composition: 'dark',
+  Aggregation: 'dark',
 };
 
 const useRelationData = (selectedEdgeId: string | null): RelationData | null => {
@@ -104,8 +111,12 @@ export const RelationDetails: React.FC<RelationDetailsProps> = ({ onEdit }) => {
       {relation.name && <DetailField label="Name">{relation.name}</DetailField>}
 
       <DetailField label="Type">
-        <Badge color={RELATION_TYPE_COLOR[relation.relationType] ?? 'gray'} variant="light" size="sm">
-          {relation.relationType}
+        <Badge
+          color={RELATION_TYPE_COLOR[relation.relationType as BuiltInRelationType] ?? 'gray'}
+          variant="light"
+          size="sm"
+        >
+          {relationTypeLabel(relation)}
         </Badge>
       </DetailField>
 
@@ -13,6 +13,14 @@ export function useRelations() {
   });
 }
 
+export function useRelationTypes() {
+  return useQuery({
+    queryKey: relationsQueryKeys.types(),
+    queryFn: () => relationsApi.getRelationTypes(),
+    staleTime: 5 * 60 * 1000,
+  });
+}
+
 export function useRelation(id: RelationId | undefined) {
   return useQuery({
     queryKey: relationsQueryKeys.detail(id!),
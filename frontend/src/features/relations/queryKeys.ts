@@ -4,4 +4,5 @@ export const relationsQueryKeys = {
   list: (filters?: Record<string, unknown>) => [...relationsQueryKeys.lists(), filters] as const,
   details: () => [...relationsQueryKeys.all, 'detail'] as const,
   detail: (id: string) => [...relationsQueryKeys.details(), id] as const,
+  types: () => [...relationsQueryKeys.all, 'types'] as const,
 };
@@ -0,0 +1,17 @@
+import type { BuiltInRelationType, Relation } from '../../api/types';
+
+export const BUILT_IN_RELATION_TYPE_LABELS: Record<BuiltInRelationType, string> = {
+  Triggers: 'Triggers',
+  Serves: 'Serves',
+  Flow: 'Flow',
+  AccessRead: 'Access (read)',
+  AccessWrite: 'Access (write)',
+  AccessReadWrite: 'Access (read/write)',
+  Composition: 'Composition',
+  Aggregation: 'Aggregation',
+};
+
+export function relationTypeLabel(relation: Pick<Relation, 'relationType' | 'relationTypeName'>): string {
+  const builtIn = BUILT_IN_RELATION_TYPE_LABELS[relation.relationType as BuiltInRelationType];
+  return builtIn ?? relation.relationTypeName ?? relation.relationType;
+}
@@ -1,6 +1,22 @@
 import { z } from 'zod';
 
-export const relationTypeSchema = z.enum(['Triggers', 'Serves']);
+export const builtInRelationTypeSchema = z.enum([
+  'Triggers',
+  'Serves',
+  'Flow',
+  'AccessRead',
+  'AccessWrite',
+  'AccessReadWrite',
+  'Composition',
+  'Aggregation',
+]);
+
+const customRelationTypeSchema = z.custom<`custom:${string}`>(
+  (val) => typeof val === 'string' && /^custom:[0-9a-f-]{36}$/i.test(val),
+  'Invalid custom relation type',
+);
+
+export const relationTypeSchema = z.union([builtInRelationTypeSchema, customRelationTypeSchema]);
 
 export type RelationType = z.infer<typeof relationTypeSchema>;
 
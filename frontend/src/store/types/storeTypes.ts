@@ -4,13 +4,14 @@ import type {
   ComponentId,
   RealizationId,
   RelationId,
+  RelationTypeValue,
   ViewId,
 } from '../../api/types';
 
 export type { CapabilityId, ComponentId, RealizationId, RelationId, ViewId };
 export type DependencyId = CapabilityDependencyId;
 
-export type RelationType = 'Triggers' | 'Serves';
+export type RelationType = RelationTypeValue;
 export type EdgeType = string;
 
 export interface Position {
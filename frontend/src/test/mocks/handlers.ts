@@ -261,6 +261,17 @@ export const handlers = [
     });
   }),
 
+  http.get(`${BASE_URL}/api/v1/relation-types`, () => {
+    return HttpResponse.json({
+      data: [
+        { value: 'Triggers', name: 'Triggers', builtIn: true },
+        { value: 'Serves', name: 'Serves', builtIn: true },
+        { value: 'Flow', name: 'Flow', builtIn: true },
+      ],
+      _links: { self: '/api/v1/relation-types' },
+    });
+  }),
+
   http.post(`${BASE_URL}/api/v1/relations`, async ({ request }) => {
     const body = (await request.json()) as Record<string, unknown>;
     const relation = addRelation(body);